@@ -0,0 +1,85 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/slotmachine/backend/internal/game/benchmarks"
+)
+
+func main() {
+	testing.Init()
+
+	out := flag.String("out", "", "JSON file to write the results to, empty for none")
+	budgetPath := flag.String("budget", "", "Budget JSON file, empty for the built-in budget")
+	baselinePath := flag.String("baseline", "", "Results JSON of an earlier run to compare against, empty for none")
+	tolerance := flag.Float64("tolerance", 0.15, "Slowdown over the baseline tolerated before failing (0.15 = 15%)")
+	filter := flag.String("run", "", "Only run benchmarks matching this regular expression")
+	benchtime := flag.String("benchtime", "1s", "Run time (or Nx iterations) per benchmark")
+	flag.Parse()
+
+	if err := flag.Set("test.benchtime", *benchtime); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid -benchtime: %v\n", err)
+		os.Exit(2)
+	}
+	var match *regexp.Regexp
+	if *filter != "" {
+		var err error
+		if match, err = regexp.Compile(*filter); err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid -run: %v\n", err)
+			os.Exit(2)
+		}
+	}
+
+	budget, err := benchmarks.DefaultBudget()
+	if *budgetPath != "" {
+		budget, err = benchmarks.ReadBudget(*budgetPath)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load budget: %v\n", err)
+		os.Exit(1)
+	}
+
+	fixture, err := benchmarks.NewFixture()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to build fixture: %v\n", err)
+		os.Exit(1)
+	}
+
+	report := benchmarks.Run(fixture.Cases(), match)
+	fmt.Printf("%-26s %12s %12s %12s %10s\n", "Benchmark", "Iterations", "ns/op", "B/op", "allocs/op")
+	for _, r := range report.Results {
+		fmt.Printf("%-26s %12d %12.0f %12d %10d\n", r.Name, r.Iterations, r.NsPerOp, r.BytesPerOp, r.AllocsPerOp)
+	}
+
+	if *out != "" {
+		if err := report.WriteFile(*out); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write results: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	regressions := budget.Check(report)
+	if *baselinePath != "" {
+		baseline, err := benchmarks.ReadReport(*baselinePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to read baseline: %v\n", err)
+			os.Exit(1)
+		}
+		regressions = append(regressions, benchmarks.CompareBaseline(*baseline, report, *tolerance)...)
+	}
+
+	if len(regressions) > 0 {
+		fmt.Println()
+		fmt.Println("✗ Performance regressions:")
+		for _, r := range regressions {
+			fmt.Printf("  %s\n", r)
+		}
+		os.Exit(1)
+	}
+	fmt.Println()
+	fmt.Println("✓ Within the performance budget")
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/slotmachine/backend/internal/game/golden"
+)
+
+func main() {
+	dir := flag.String("dir", golden.DefaultDir, "Golden suite directory holding the corpus, goldens and changelog")
+	bless := flag.Bool("bless", false, "Rewrite the goldens from the current engine and record the change in the changelog")
+	reason := flag.String("reason", "", "Why the outcomes changed, required with -bless")
+	flag.Parse()
+
+	if *bless && *reason == "" {
+		fmt.Fprintln(os.Stderr, "-bless needs a -reason for the changelog")
+		os.Exit(2)
+	}
+
+	corpus, err := golden.ReadCorpus(*dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read corpus: %v\n", err)
+		os.Exit(1)
+	}
+	// A suite without goldens yet is blessed from scratch
+	want, err := golden.ReadGoldens(*dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		fmt.Fprintf(os.Stderr, "Failed to read goldens: %v\n", err)
+		os.Exit(1)
+	}
+
+	got, err := golden.Replay(context.Background(), corpus)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to replay corpus: %v\n", err)
+		os.Exit(1)
+	}
+
+	drifts := golden.Compare(want, got)
+	if len(drifts) == 0 {
+		fmt.Printf("✓ %d cases match their goldens\n", len(got))
+		return
+	}
+
+	fmt.Printf("%d of %d cases drifted:\n", len(drifts), len(got))
+	for _, d := range drifts {
+		fmt.Printf("  %s\n", d)
+	}
+
+	if !*bless {
+		fmt.Println()
+		fmt.Println("✗ Math drift. If the change is intended, re-bless with -bless -reason \"...\"")
+		os.Exit(1)
+	}
+
+	if err := golden.WriteGoldens(*dir, got); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write goldens: %v\n", err)
+		os.Exit(1)
+	}
+	if err := golden.AppendChangelog(*dir, *reason, drifts, time.Now()); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to update changelog: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println()
+	fmt.Printf("✓ Goldens re-blessed, changelog updated in %s\n", *dir)
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/slotmachine/backend/internal/api/dto"
+)
+
+// apiError is a non-2xx response or a transport failure
+type apiError struct {
+	Status int    // 0 for transport errors
+	Code   string // catalogued error code, or a transport error class
+	Err    error
+}
+
+func (e *apiError) Error() string {
+	if e.Status == 0 {
+		return fmt.Sprintf("%s: %v", e.Code, e.Err)
+	}
+	return fmt.Sprintf("HTTP %d %s", e.Status, e.Code)
+}
+
+// client is one virtual player talking to the real HTTP API
+type client struct {
+	baseURL string
+	http    *http.Client
+	metrics *metrics
+	token   string
+}
+
+func newClient(baseURL string, httpClient *http.Client, m *metrics) *client {
+	return &client{baseURL: baseURL, http: httpClient, metrics: m}
+}
+
+// do sends a JSON request, timing it under op and decoding a 2xx body into out
+func (c *client) do(ctx context.Context, op, method, path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	start := time.Now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		apiErr := &apiError{Code: transportErrorCode(ctx, err), Err: err}
+		c.metrics.record(op, time.Since(start), apiErr)
+		return apiErr
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	elapsed := time.Since(start)
+	if err != nil {
+		apiErr := &apiError{Code: "read_error", Err: err}
+		c.metrics.record(op, elapsed, apiErr)
+		return apiErr
+	}
+
+	if resp.StatusCode >= 300 {
+		var errResp dto.ErrorResponse
+		code := "unknown"
+		if json.Unmarshal(data, &errResp) == nil && errResp.Error != "" {
+			code = string(errResp.Error)
+		}
+		apiErr := &apiError{Status: resp.StatusCode, Code: code}
+		c.metrics.record(op, elapsed, apiErr)
+		return apiErr
+	}
+
+	c.metrics.record(op, elapsed, nil)
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", op, err)
+	}
+	return nil
+}
+
+func transportErrorCode(ctx context.Context, err error) string {
+	if ctx.Err() != nil {
+		return "canceled"
+	}
+	if netErr, ok := err.(interface{ Timeout() bool }); ok && netErr.Timeout() {
+		return "timeout"
+	}
+	return "transport_error"
+}
+
+func (c *client) register(ctx context.Context, username, password string) error {
+	req := dto.RegisterRequest{
+		Username: username,
+		Email:    username + "@loadtest.local",
+		Password: password,
+	}
+	return c.do(ctx, "register", http.MethodPost, "/v1/auth/register", req, nil)
+}
+
+func (c *client) login(ctx context.Context, username, password string) (*dto.AuthResponse, error) {
+	req := dto.LoginRequest{Username: username, Password: password, ForceLogout: true}
+	var resp dto.AuthResponse
+	if err := c.do(ctx, "login", http.MethodPost, "/v1/auth/login", req, &resp); err != nil {
+		return nil, err
+	}
+	c.token = resp.SessionToken
+	return &resp, nil
+}
+
+func (c *client) startSession(ctx context.Context, betAmount float64) (*dto.SessionResponse, error) {
+	req := dto.StartSessionRequest{BetAmount: betAmount, Takeover: true}
+	var resp dto.SessionResponse
+	if err := c.do(ctx, "session_start", http.MethodPost, "/v1/session/start", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *client) endSession(ctx context.Context, sessionID string) error {
+	return c.do(ctx, "session_end", http.MethodPost, "/v1/session/"+sessionID+"/end", nil, nil)
+}
+
+func (c *client) acknowledgeRealityCheck(ctx context.Context, sessionID string) error {
+	return c.do(ctx, "reality_check", http.MethodPost, "/v1/session/"+sessionID+"/reality-check", nil, nil)
+}
+
+func (c *client) startPFSession(ctx context.Context) (*dto.StartPFSessionResponse, error) {
+	var resp dto.StartPFSessionResponse
+	if err := c.do(ctx, "pf_start", http.MethodPost, "/v1/pf/sessions", dto.StartPFSessionRequest{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *client) spin(ctx context.Context, sessionID string, betAmount float64, clientSeed string) (*dto.SpinResponse, error) {
+	req := dto.ExecuteSpinRequest{SessionID: sessionID, BetAmount: betAmount, ClientSeed: clientSeed}
+	var resp dto.SpinResponse
+	if err := c.do(ctx, "spin", http.MethodPost, "/v1/base-spins/spin", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *client) freeSpin(ctx context.Context, freeSpinsSessionID, clientSeed string) (*dto.SpinResponse, error) {
+	req := dto.ExecuteFreeSpinRequest{FreeSpinsSessionID: freeSpinsSessionID, ClientSeed: clientSeed}
+	var resp dto.SpinResponse
+	if err := c.do(ctx, "free_spin", http.MethodPost, "/v1/free-spins/spin", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,302 @@
+// Command loadtest drives the real HTTP API with concurrent virtual players and reports
+// latency percentiles, error breakdowns and the observed game statistics.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/internal/game/simstats"
+)
+
+// maxConsecutiveErrors stops a player whose spins keep failing
+const maxConsecutiveErrors = 10
+
+type options struct {
+	baseURL        string
+	users          int
+	spins          int
+	duration       time.Duration
+	rampUp         time.Duration
+	betAmount      float64
+	usernamePrefix string
+	password       string
+	register       bool
+	timeout        time.Duration
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.baseURL, "url", "http://localhost:8080", "API base URL")
+	flag.IntVar(&opts.users, "users", 10, "Concurrent virtual players (one spin loop each)")
+	flag.IntVar(&opts.spins, "spins", 100, "Paid spins per player, 0 to spin until -duration")
+	flag.DurationVar(&opts.duration, "duration", 0, "Stop after this long, 0 for no limit")
+	flag.DurationVar(&opts.rampUp, "ramp-up", 0, "Spread player start-up over this long")
+	flag.Float64Var(&opts.betAmount, "bet", 1.0, "Bet amount per spin")
+	flag.StringVar(&opts.usernamePrefix, "username-prefix", "loadtest", "Players log in as <prefix>-<n>")
+	flag.StringVar(&opts.password, "password", "LoadTest123!", "Password for every load test player")
+	flag.BoolVar(&opts.register, "register", true, "Register players that can't log in")
+	flag.DurationVar(&opts.timeout, "timeout", 10*time.Second, "Per-request timeout")
+	targetRTP := flag.Float64("target-rtp", 96.7, "Target RTP")
+	progressInterval := flag.Duration("progress", 5*time.Second, "Progress report interval")
+	flag.Parse()
+
+	if opts.users < 1 {
+		fmt.Fprintln(os.Stderr, "-users must be at least 1")
+		os.Exit(1)
+	}
+	if opts.spins <= 0 && opts.duration <= 0 {
+		fmt.Fprintln(os.Stderr, "-spins 0 needs a -duration")
+		os.Exit(1)
+	}
+	opts.baseURL = strings.TrimRight(opts.baseURL, "/")
+
+	fmt.Println("╔════════════════════════════════════════════════════════════╗")
+	fmt.Println("║         SLOT MACHINE LOAD TEST                             ║")
+	fmt.Println("╚════════════════════════════════════════════════════════════╝")
+	fmt.Println()
+	fmt.Printf("Configuration:\n")
+	fmt.Printf("  URL:          %s\n", opts.baseURL)
+	fmt.Printf("  Players:      %d\n", opts.users)
+	fmt.Printf("  Spins/Player: %d\n", opts.spins)
+	fmt.Printf("  Duration:     %s\n", opts.duration)
+	fmt.Printf("  Bet Amount:   %.2f\n", opts.betAmount)
+	fmt.Println()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if opts.duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.duration)
+		defer cancel()
+	}
+
+	httpClient := &http.Client{
+		Timeout: opts.timeout,
+		Transport: &http.Transport{
+			MaxIdleConns:        opts.users * 2,
+			MaxIdleConnsPerHost: opts.users * 2,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+	m := newMetrics()
+	var spinsDone atomic.Int64
+
+	fmt.Println("Starting load test...")
+	fmt.Println()
+
+	startTime := time.Now()
+	progressDone := make(chan struct{})
+	go reportProgress(progressDone, &spinsDone, opts, startTime, *progressInterval)
+
+	results := make([]simstats.Stats, opts.users)
+	var wg sync.WaitGroup
+	for i := 0; i < opts.users; i++ {
+		if opts.rampUp > 0 && i > 0 {
+			select {
+			case <-time.After(opts.rampUp / time.Duration(opts.users)):
+			case <-ctx.Done():
+			}
+		}
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			p := &player{
+				opts:      opts,
+				client:    newClient(opts.baseURL, httpClient, m),
+				username:  fmt.Sprintf("%s-%d", opts.usernamePrefix, idx+1),
+				spinsDone: &spinsDone,
+			}
+			results[idx] = p.run(ctx)
+		}(i)
+	}
+	wg.Wait()
+	close(progressDone)
+	elapsed := time.Since(startTime)
+
+	var stats simstats.Stats
+	for _, r := range results {
+		stats.Merge(r)
+	}
+	stats.Finalize()
+
+	fmt.Println()
+	fmt.Printf("Elapsed:               %s\n", elapsed.Round(time.Millisecond))
+	fmt.Printf("Paid Spins/sec:        %.1f\n", float64(stats.TotalSpins)/elapsed.Seconds())
+	fmt.Println()
+	printLatencies(m, elapsed)
+	printErrors(m)
+
+	if stats.TotalSpins == 0 {
+		fmt.Fprintln(os.Stderr, "No spins completed")
+		os.Exit(1)
+	}
+	simstats.Print(stats, opts.betAmount, *targetRTP)
+}
+
+func reportProgress(done <-chan struct{}, spinsDone *atomic.Int64, opts options, startTime time.Time, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			n := spinsDone.Load()
+			elapsed := time.Since(startTime)
+			line := fmt.Sprintf("Progress: %d spins | %.0f spins/sec | %s elapsed",
+				n, float64(n)/elapsed.Seconds(), elapsed.Round(time.Second))
+			if opts.spins > 0 {
+				total := int64(opts.spins * opts.users)
+				line += fmt.Sprintf(" | %.1f%%", float64(n)/float64(total)*100)
+			}
+			fmt.Println(line)
+		}
+	}
+}
+
+// player is one virtual player: log in, start a game and PF session, spin, end the session
+type player struct {
+	opts      options
+	client    *client
+	username  string
+	spinsDone *atomic.Int64
+}
+
+func (p *player) run(ctx context.Context) simstats.Stats {
+	var stats simstats.Stats
+
+	if err := p.authenticate(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: login failed: %v\n", p.username, err)
+		return stats
+	}
+
+	sess, err := p.client.startSession(ctx, p.opts.betAmount)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: failed to start session: %v\n", p.username, err)
+		return stats
+	}
+	defer func() {
+		// End the session even when the run was cancelled
+		endCtx, cancel := context.WithTimeout(context.Background(), p.opts.timeout)
+		defer cancel()
+		if err := p.client.endSession(endCtx, sess.ID); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: failed to end session: %v\n", p.username, err)
+		}
+	}()
+
+	// The session start opens a PF session when provably fair is enabled; open one explicitly otherwise
+	if sess.ProvablyFair == nil {
+		if _, err := p.client.startPFSession(ctx); err != nil && !isCode(err, domainErrors.CodePFSessionExists) {
+			fmt.Fprintf(os.Stderr, "%s: failed to start PF session, spinning without it: %v\n", p.username, err)
+		}
+	}
+
+	consecutiveErrors := 0
+	for spinNumber := 1; p.opts.spins <= 0 || spinNumber <= p.opts.spins; {
+		if ctx.Err() != nil {
+			break
+		}
+
+		clientSeed := fmt.Sprintf("%s-%d-%d", p.username, spinNumber, time.Now().UnixNano())
+		result, err := p.client.spin(ctx, sess.ID, p.opts.betAmount, clientSeed)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			switch {
+			case isCode(err, domainErrors.CodeRealityCheckRequired):
+				if ackErr := p.client.acknowledgeRealityCheck(ctx, sess.ID); ackErr == nil {
+					continue
+				}
+			case isCode(err, domainErrors.CodeInsufficientBalance):
+				fmt.Fprintf(os.Stderr, "%s: out of balance after %d spins\n", p.username, spinNumber-1)
+				return stats
+			}
+			consecutiveErrors++
+			if consecutiveErrors >= maxConsecutiveErrors {
+				fmt.Fprintf(os.Stderr, "%s: stopping after %d consecutive errors, last: %v\n", p.username, consecutiveErrors, err)
+				return stats
+			}
+			continue
+		}
+		consecutiveErrors = 0
+
+		stats.RecordSpin(spinNumber, p.opts.betAmount, result.SpinTotalWin, len(result.Cascades))
+		p.spinsDone.Add(1)
+		spinNumber++
+
+		spinReturn := result.SpinTotalWin
+		if result.FreeSpinsTriggered {
+			stats.FreeSpinsTriggered++
+			spinReturn += p.playFreeSpins(ctx, &stats, result.FreeSpinsSessionID, result.FreeSpinsRemainingSpins)
+		}
+		stats.RecordReturn(p.opts.betAmount, spinReturn)
+	}
+
+	return stats
+}
+
+// authenticate logs in, registering the player first if it doesn't exist yet
+func (p *player) authenticate(ctx context.Context) error {
+	_, err := p.client.login(ctx, p.username, p.opts.password)
+	var apiErr *apiError
+	if err == nil || !p.opts.register || !errors.As(err, &apiErr) || apiErr.Status != http.StatusUnauthorized {
+		return err
+	}
+
+	if err := p.client.register(ctx, p.username, p.opts.password); err != nil {
+		return fmt.Errorf("register: %w", err)
+	}
+	_, err = p.client.login(ctx, p.username, p.opts.password)
+	return err
+}
+
+// playFreeSpins plays out a free spins session, retriggers included, and returns its total win
+func (p *player) playFreeSpins(ctx context.Context, stats *simstats.Stats, freeSpinsSessionID string, remaining int) float64 {
+	consecutiveErrors := 0
+	totalWon := 0.0
+	for remaining > 0 && ctx.Err() == nil {
+		clientSeed := fmt.Sprintf("%s-free-%d-%d", p.username, remaining, time.Now().UnixNano())
+		result, err := p.client.freeSpin(ctx, freeSpinsSessionID, clientSeed)
+		if err != nil {
+			consecutiveErrors++
+			if consecutiveErrors >= maxConsecutiveErrors {
+				fmt.Fprintf(os.Stderr, "%s: abandoning free spins after %d consecutive errors, last: %v\n", p.username, consecutiveErrors, err)
+				return totalWon
+			}
+			continue
+		}
+		consecutiveErrors = 0
+
+		stats.FreeSpinsTotalWon += result.SpinTotalWin
+		stats.TotalWon += result.SpinTotalWin
+		stats.TotalFreeSpins++
+		totalWon += result.SpinTotalWin
+		if result.FreeSpinsRetriggered {
+			stats.FreeSpinsRetriggered++
+		}
+		remaining = result.FreeSpinsRemainingSpins
+	}
+	return totalWon
+}
+
+func isCode(err error, code domainErrors.Code) bool {
+	var apiErr *apiError
+	return errors.As(err, &apiErr) && apiErr.Code == string(code)
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// opOrder is the report order for known operations
+var opOrder = []string{"register", "login", "session_start", "pf_start", "spin", "free_spin", "reality_check", "session_end"}
+
+// metrics collects request latencies and errors per operation across all workers
+type metrics struct {
+	mu        sync.Mutex
+	latencies map[string][]time.Duration
+	errors    map[string]map[string]int // op -> "status code" -> count
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		latencies: make(map[string][]time.Duration),
+		errors:    make(map[string]map[string]int),
+	}
+}
+
+func (m *metrics) record(op string, d time.Duration, err *apiError) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.latencies[op] = append(m.latencies[op], d)
+	if err == nil {
+		return
+	}
+	if m.errors[op] == nil {
+		m.errors[op] = make(map[string]int)
+	}
+	key := err.Code
+	if err.Status != 0 {
+		key = fmt.Sprintf("%d %s", err.Status, err.Code)
+	}
+	m.errors[op][key]++
+}
+
+// latencySummary is the latency distribution of one operation
+type latencySummary struct {
+	Count              int
+	Errors             int
+	Min, P50, P90, P95 time.Duration
+	P99, Max           time.Duration
+}
+
+// percentile returns the nearest-rank percentile of sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(p/100*float64(len(sorted))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+func (m *metrics) summaries() map[string]latencySummary {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make(map[string]latencySummary, len(m.latencies))
+	for op, durations := range m.latencies {
+		sorted := append([]time.Duration(nil), durations...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+		errCount := 0
+		for _, n := range m.errors[op] {
+			errCount += n
+		}
+		out[op] = latencySummary{
+			Count:  len(sorted),
+			Errors: errCount,
+			Min:    sorted[0],
+			P50:    percentile(sorted, 50),
+			P90:    percentile(sorted, 90),
+			P95:    percentile(sorted, 95),
+			P99:    percentile(sorted, 99),
+			Max:    sorted[len(sorted)-1],
+		}
+	}
+	return out
+}
+
+// orderedOps returns recorded operations, known ones first
+func orderedOps[V any](recorded map[string]V) []string {
+	ops := make([]string, 0, len(recorded))
+	seen := make(map[string]bool, len(opOrder))
+	for _, op := range opOrder {
+		seen[op] = true
+		if _, ok := recorded[op]; ok {
+			ops = append(ops, op)
+		}
+	}
+	var rest []string
+	for op := range recorded {
+		if !seen[op] {
+			rest = append(rest, op)
+		}
+	}
+	sort.Strings(rest)
+	return append(ops, rest...)
+}
+
+func printLatencies(m *metrics, elapsed time.Duration) {
+	summaries := m.summaries()
+
+	fmt.Println("═══ LATENCY (ms) ═══")
+	fmt.Printf("%-14s %8s %7s %8s %8s %8s %8s %8s %8s %9s\n",
+		"Operation", "Requests", "Errors", "Min", "P50", "P90", "P95", "P99", "Max", "Req/sec")
+	for _, op := range orderedOps(summaries) {
+		s := summaries[op]
+		fmt.Printf("%-14s %8d %7d %8.1f %8.1f %8.1f %8.1f %8.1f %8.1f %9.1f\n",
+			op, s.Count, s.Errors, ms(s.Min), ms(s.P50), ms(s.P90), ms(s.P95), ms(s.P99), ms(s.Max),
+			float64(s.Count)/elapsed.Seconds())
+	}
+	fmt.Println()
+}
+
+func printErrors(m *metrics) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fmt.Println("═══ ERRORS ═══")
+	if len(m.errors) == 0 {
+		fmt.Println("None")
+		fmt.Println()
+		return
+	}
+	for _, op := range orderedOps(m.errors) {
+		keys := make([]string, 0, len(m.errors[op]))
+		for key := range m.errors[op] {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool { return m.errors[op][keys[i]] > m.errors[op][keys[j]] })
+		for _, key := range keys {
+			fmt.Printf("%-14s %-50s %d\n", op, strings.TrimSpace(key), m.errors[op][key])
+		}
+	}
+	fmt.Println()
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/db"
+)
+
+const usage = `Usage: migrate [flags] <command> [arg]
+
+Commands:
+  up [N]         Apply all pending migrations, or the next N
+  down [N]       Revert the last N migrations (default 1)
+  down-all       Revert every migration
+  goto V         Migrate up or down to version V
+  force V        Record V as applied and clean, after fixing a failed migration by hand
+  version        Show the applied version and whether the schema is behind this build
+  create NAME    Create the next NNNNNN_NAME.up.sql and .down.sql pair in -dir
+
+The database is configured by the DB_* environment variables, as for the server.
+
+Flags:
+`
+
+// migrationName is what create accepts as a migration name
+var migrationName = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+func main() {
+	dir := flag.String("dir", "migrations", "Migrations directory, for create")
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 || len(args) > 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	command, arg := args[0], ""
+	if len(args) == 2 {
+		arg = args[1]
+	}
+
+	if command == "create" {
+		if err := create(*dir, arg); err != nil {
+			fail(err)
+		}
+		return
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fail(err)
+	}
+	m, err := db.NewMigrator(cfg.Database.DSN())
+	if err != nil {
+		fail(err)
+	}
+	defer m.Close()
+
+	switch command {
+	case "up":
+		if arg == "" {
+			err = m.Up()
+		} else {
+			err = m.Steps(count(arg))
+		}
+	case "down":
+		n := 1
+		if arg != "" {
+			n = count(arg)
+		}
+		err = m.Steps(-n)
+	case "down-all":
+		err = m.DownAll()
+	case "goto":
+		err = m.Goto(uint(version(arg)))
+	case "force":
+		err = m.Force(version(arg))
+	case "version":
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fail(err)
+	}
+
+	status, err := m.Status()
+	if err != nil {
+		fail(err)
+	}
+	fmt.Printf("Schema version %d (latest %d)", status.Version, status.Latest)
+	if status.Dirty {
+		fmt.Print(", dirty")
+	}
+	fmt.Println()
+	if err := status.Err(); err != nil && command == "version" {
+		fmt.Printf("✗ %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// create writes an empty up and down migration numbered after the newest in dir
+func create(dir, name string) error {
+	if !migrationName.MatchString(name) {
+		return fmt.Errorf("migration name must be lower_snake_case, got %q", name)
+	}
+	latest, err := db.LatestMigration(os.DirFS(dir))
+	if err != nil {
+		return err
+	}
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(dir, fmt.Sprintf("%06d_%s.%s.sql", latest+1, name, direction))
+		if err := os.WriteFile(path, nil, 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("Created %s\n", path)
+	}
+	return nil
+}
+
+// count parses a positive number of migrations
+func count(arg string) int {
+	n, err := strconv.Atoi(arg)
+	if err != nil || n <= 0 {
+		fail(fmt.Errorf("expected a positive number of migrations, got %q", arg))
+	}
+	return n
+}
+
+// version parses a migration version
+func version(arg string) int {
+	v, err := strconv.Atoi(arg)
+	if err != nil || v < 0 {
+		fail(fmt.Errorf("expected a migration version, got %q", arg))
+	}
+	return v
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "migrate: %v\n", err)
+	os.Exit(1)
+}
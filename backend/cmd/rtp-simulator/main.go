@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,8 +19,9 @@ import (
 	"github.com/slotmachine/backend/internal/game/engine"
 	"github.com/slotmachine/backend/internal/game/freespins"
 	freespinsEngine "github.com/slotmachine/backend/internal/game/freespins"
-	"github.com/slotmachine/backend/internal/game/reels"
 	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/game/simstats"
+	"github.com/slotmachine/backend/internal/game/wilds"
 	infraCache "github.com/slotmachine/backend/internal/infra/cache"
 	"github.com/slotmachine/backend/internal/infra/repository"
 	"github.com/slotmachine/backend/internal/pkg/cache"
@@ -27,40 +29,6 @@ import (
 	"github.com/slotmachine/backend/internal/service"
 )
 
-// SimulationStats holds the statistics from simulation
-type SimulationStats struct {
-	TotalSpins             int     `json:"total_spins"`
-	TotalWagered           float64 `json:"total_wagered"`
-	TotalWon               float64 `json:"total_won"`
-	RTP                    float64 `json:"rtp"`
-	BaseGameWins           int     `json:"base_game_wins"`
-	BaseGameTotalWon       float64 `json:"base_game_total_won"`
-	BaseRTP                float64 `json:"base_rtp"`
-	FreeSpinsTriggered     int     `json:"free_spins_triggered"`
-	FreeSpinsTriggeredRate float64 `json:"free_spins_triggered_rate"`
-	FreeSpinsTotalWon      float64 `json:"free_spins_total_won"`
-	FreeRTP                float64 `json:"free_rtp"`
-	FreeSpinsRetriggered   int     `json:"free_spins_retriggered"`
-	MaxWin                 float64 `json:"max_win"`
-	MaxWinSpin             int     `json:"max_win_spin"`
-
-	// Hit frequency
-	NoWinSpins int `json:"no_win_spins"`
-	SmallWins  int `json:"small_wins"`  // < 5x bet
-	MediumWins int `json:"medium_wins"` // 5x - 20x bet
-	BigWins    int `json:"big_wins"`    // 20x - 100x bet
-	MegaWins   int `json:"mega_wins"`   // > 100x bet
-
-	// Cascade statistics
-	TotalCascades     int     `json:"total_cascades"`
-	MaxCascades       int     `json:"max_cascades"`
-	AvgCascadesPerWin float64 `json:"avg_cascades_per_win"`
-
-	// Free spins statistics
-	TotalFreeSpins      int     `json:"total_free_spins"`
-	AvgFreeSpinsAwarded float64 `json:"avg_free_spins_awarded"`
-}
-
 func main() {
 
 	// Parse command line flags
@@ -70,6 +38,15 @@ func main() {
 	isRealMode := flag.Bool("real", false, "Run real mode")
 	targetRTP := flag.Float64("target-rtp", 96.7, "Target RTP")
 	playerId := flag.String("player-id", "b76f37bc-8014-41eb-a710-d105a8ae6293", "Player ID")
+	minScatters := flag.Int("min-scatters", freespins.DefaultTriggerRules.MinScatters, "Free spins trigger: minimum scatters (overrides the player's game rules)")
+	baseAward := flag.Int("base-award", freespins.DefaultTriggerRules.BaseAward, "Free spins trigger: spins awarded for the minimum scatters (overrides the player's game rules)")
+	extraPerScatter := flag.Int("extra-per-scatter", freespins.DefaultTriggerRules.ExtraPerScatter, "Free spins trigger: extra spins per additional scatter (overrides the player's game rules)")
+	retrigger := flag.Bool("retrigger", freespins.DefaultTriggerRules.Retrigger, "Free spins trigger: allow retriggers (overrides the player's game rules)")
+	stickyWilds := flag.Bool("sticky-wilds", false, "Wilds: hold wilds in place for the rest of free spins (overrides the player's game rules)")
+	expandingWilds := flag.Bool("expanding-wilds", false, "Wilds: expand wilds in winning cascades to fill their reel (overrides the player's game rules)")
+	wildMultiplier := flag.Int("wild-multiplier", 0, "Wilds: multiplier on each way a wild completes, below 2 disables (overrides the player's game rules)")
+	compare := flag.String("compare", "", "Compare two reel strip configs: <config-id-a>,<config-id-b>, played with the same seed")
+	seed := flag.Int64("seed", 1, "RNG seed for -compare; both configs replay the same random sequence")
 	flag.Parse()
 
 	// playerID := uuid.Nil
@@ -126,6 +103,7 @@ func main() {
 	spinRepo := repository.NewSpinGormRepository(database)
 	freespinsRepo := repository.NewFreeSpinsGormRepository(database)
 	playerRepo := repository.NewPlayerGormRepository(database)
+	gameRepo := repository.NewGameGormRepository(database)
 	pfRepo := repository.NewProvablyFairGormRepository(database)
 	txManager := repository.NewTxManager(database)
 
@@ -134,6 +112,35 @@ func main() {
 	sessionService := service.NewSessionService(sessionRepo, playerRepo, log)
 	gameEngine = engine.NewGameEngine(reelStripService, cacheClient, true)
 
+	// Start from the player's game rules; explicitly set flags override them
+	rules := service.NewGameRulesService(playerRepo, gameRepo, cacheClient, log).RulesForPlayer(context.Background(), playerID)
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "min-scatters":
+			rules.FreeSpins.MinScatters = *minScatters
+		case "base-award":
+			rules.FreeSpins.BaseAward = *baseAward
+		case "extra-per-scatter":
+			rules.FreeSpins.ExtraPerScatter = *extraPerScatter
+		case "retrigger":
+			rules.FreeSpins.Retrigger = *retrigger
+		case "sticky-wilds":
+			rules.Wilds.StickyWilds = *stickyWilds
+		case "expanding-wilds":
+			rules.Wilds.ExpandingWilds = *expandingWilds
+		case "wild-multiplier":
+			rules.Wilds.WildMultiplier = *wildMultiplier
+		}
+	})
+	gameEngine.SetRulesResolver(fixedRules(rules))
+
+	fmt.Printf("Free Spins Trigger:\n")
+	fmt.Printf("  Min Scatters: %d\n", rules.FreeSpins.MinScatters)
+	fmt.Printf("  Base Award:   %d (+%d per extra scatter)\n", rules.FreeSpins.BaseAward, rules.FreeSpins.ExtraPerScatter)
+	fmt.Printf("  Retrigger:    %v\n", rules.FreeSpins.Retrigger)
+	fmt.Printf("Multipliers:    base %v, free spins %v\n", rules.Multipliers.Steps(false), rules.Multipliers.Steps(true))
+	fmt.Printf("Wilds:          sticky %v, expanding %v, multiplier x%d\n", rules.Wilds.StickyWilds, rules.Wilds.ExpandingWilds, rules.Wilds.Multiplier())
+
 	// Initialize provably fair service
 	pfCache := infraCache.NewPFSessionCache(redisClient, log)
 	pfService, err := service.NewProvablyFairService(pfRepo, pfCache, reelStripRepo, cfg, log)
@@ -150,22 +157,84 @@ func main() {
 	fmt.Println("Starting simulation...")
 	fmt.Println()
 
-	if *isRealMode {
+	if *compare != "" {
+		// Compare mode: both configs play the same seeded random sequence under the same rules
+		ids := strings.Split(*compare, ",")
+		if len(ids) != 2 {
+			fmt.Fprintf(os.Stderr, "-compare wants two config IDs separated by a comma\n")
+			os.Exit(1)
+		}
+		var results [2]simstats.Stats
+		var labels [2]string
+		for i, id := range ids {
+			configID, err := uuid.Parse(strings.TrimSpace(id))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to parse config ID %q: %v\n", id, err)
+				os.Exit(1)
+			}
+			strips, label, err := configStrips(gameEngine, playerID, configID)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to load config %s: %v\n", configID, err)
+				os.Exit(1)
+			}
+			fmt.Printf("Simulating %s\n", label)
+			results[i] = runSimulation(strips, rng.NewFastRNGWithSeed(*seed), rules, *numSpins, *betAmount, *progressInterval)
+			labels[i] = label
+		}
+		simstats.PrintComparison(labels[0], labels[1], results[0], results[1], *betAmount)
+	} else if *isRealMode {
 		// Real mode: uses actual services with provably fair sessions (like real client requests)
 		stats := runRTPCheck(sessionService, spinService, freeSpinsService, pfService.(*service.ProvablyFairService), playerID, *betAmount, *numSpins, *progressInterval)
-		printResults(stats, *betAmount, *targetRTP)
+		simstats.Print(stats, *betAmount, *targetRTP)
 	} else {
 		// Run simulation
-		stats := runSimulation(gameEngine, playerID, *numSpins, *betAmount, *progressInterval)
+		strips := func(isFreeSpin bool) (*engine.ReelStripsResult, error) {
+			return gameEngine.GetReelStripsWithConfigID(context.Background(), playerID, isFreeSpin)
+		}
+		stats := runSimulation(strips, rng.NewCryptoRNG(), rules, *numSpins, *betAmount, *progressInterval)
 
 		// Print results
-		printResults(stats, *betAmount, *targetRTP)
+		simstats.Print(stats, *betAmount, *targetRTP)
+	}
+}
+
+// fixedRules resolves the same game rules for every player
+type fixedRules engine.GameRules
+
+// RulesForPlayer implements engine.RulesResolver
+func (r fixedRules) RulesForPlayer(ctx context.Context, playerID uuid.UUID) engine.GameRules {
+	return engine.GameRules(r)
+}
+
+// stripSource returns the strips base spins or free spins are played on
+type stripSource func(isFreeSpin bool) (*engine.ReelStripsResult, error)
+
+// configStrips plays a config's strips in the mode(s) it is for and the player's strips in the other
+func configStrips(gameEngine *engine.GameEngine, playerID uuid.UUID, configID uuid.UUID) (stripSource, string, error) {
+	strips, config, err := gameEngine.GetReelStripsForConfig(context.Background(), configID)
+	if err != nil {
+		return nil, "", err
+	}
+	source := func(isFreeSpin bool) (*engine.ReelStripsResult, error) {
+		switch reelstrip.GameMode(config.GameMode) {
+		case reelstrip.Both:
+			return strips, nil
+		case reelstrip.FreeSpins:
+			if isFreeSpin {
+				return strips, nil
+			}
+		default:
+			if !isFreeSpin {
+				return strips, nil
+			}
+		}
+		return gameEngine.GetReelStripsWithConfigID(context.Background(), playerID, isFreeSpin)
 	}
+	return source, fmt.Sprintf("%s (%s, %s)", config.Name, config.GameMode, config.ID), nil
 }
 
-func runSimulation(gameEngine *engine.GameEngine, playerID uuid.UUID, numSpins int, betAmount float64, progressInterval int) SimulationStats {
-	stats := SimulationStats{}
-	cryptoRNG := rng.NewCryptoRNG()
+func runSimulation(strips stripSource, rngInstance rng.RNG, rules engine.GameRules, numSpins int, betAmount float64, progressInterval int) simstats.Stats {
+	stats := simstats.Stats{}
 	startTime := time.Now()
 	for i := 0; i < numSpins; i++ {
 		// Progress reporting
@@ -178,7 +247,7 @@ func runSimulation(gameEngine *engine.GameEngine, playerID uuid.UUID, numSpins i
 				i+1, numSpins, float64(i+1)/float64(numSpins)*100, spinsPerSec, remaining.Round(time.Second))
 		}
 
-		reelStrips, err := gameEngine.GetReelStripsForPlayer(context.Background(), playerID, false)
+		reelStrips, err := strips(false)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Failed to get reel strips: %v\n", err)
 			os.Exit(1)
@@ -186,105 +255,60 @@ func runSimulation(gameEngine *engine.GameEngine, playerID uuid.UUID, numSpins i
 
 		// Execute base spin (now uses DB-backed reel strips if enabled)
 		// Use uuid.Nil for RTP simulation (will use default configuration)
-		result, err := executeBaseSpin(reelStrips, cryptoRNG, betAmount)
+		result, err := executeBaseSpin(reelStrips, rngInstance, betAmount, rules)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error executing spin %d: %v\n", i+1, err)
 			continue
 		}
 
 		// Update statistics
-		stats.TotalSpins++
-		stats.TotalWagered += betAmount
-		stats.TotalWon += result.TotalWin
+		stats.RecordSpin(i+1, betAmount, result.TotalWin, len(result.Cascades))
+		spinReturn := result.TotalWin
 
 		// Execute free spins if triggered
 		if result.FreeSpinsTriggered {
-			freeSpinStrips, err := gameEngine.GetReelStripsForPlayer(context.Background(), playerID, true)
+			stats.FreeSpinsTriggered++
+
+			freeSpinStrips, err := strips(true)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Failed to get reel strips: %v\n", err)
 				os.Exit(1)
 			}
 
-			freeSpinsTotalWin := executeFreeSpins(freeSpinStrips, cryptoRNG, result.ScatterCount, betAmount)
+			freeSpinsTotalWin, freeSpinsPlayed := executeFreeSpins(freeSpinStrips, rngInstance, result.ScatterCount, betAmount, rules)
 			stats.FreeSpinsTotalWon += freeSpinsTotalWin
 			stats.TotalWon += freeSpinsTotalWin
+			stats.TotalFreeSpins += freeSpinsPlayed
+			spinReturn += freeSpinsTotalWin
 		}
-
-		// Track max win
-		if result.TotalWin > stats.MaxWin {
-			stats.MaxWin = result.TotalWin
-			stats.MaxWinSpin = i + 1
-		}
-
-		// Categorize win size
-		winMultiplier := result.TotalWin / betAmount
-		if result.TotalWin == 0 {
-			stats.NoWinSpins++
-		} else if winMultiplier < 5 {
-			stats.SmallWins++
-		} else if winMultiplier < 20 {
-			stats.MediumWins++
-		} else if winMultiplier < 100 {
-			stats.BigWins++
-		} else {
-			stats.MegaWins++
-		}
-
-		// Track cascades
-		numCascades := len(result.Cascades)
-		stats.TotalCascades += numCascades
-		if numCascades > stats.MaxCascades {
-			stats.MaxCascades = numCascades
-		}
-		if result.TotalWin > 0 {
-			stats.BaseGameWins++
-			stats.BaseGameTotalWon += result.TotalWin
-		}
-
-		// Track free spins trigger
-		if result.FreeSpinsTriggered {
-			stats.FreeSpinsTriggered++
-			stats.AvgFreeSpinsAwarded += float64(result.FreeSpinsAwarded)
-		}
-	}
-
-	// Calculate final statistics
-	if stats.TotalWagered > 0 {
-		stats.RTP = (stats.TotalWon / stats.TotalWagered) * 100
-		stats.BaseRTP = (stats.BaseGameTotalWon / stats.TotalWagered) * 100
-		stats.FreeRTP = (stats.FreeSpinsTotalWon / stats.TotalWagered) * 100
-	}
-
-	if stats.BaseGameWins > 0 {
-		stats.AvgCascadesPerWin = float64(stats.TotalCascades) / float64(stats.BaseGameWins)
-	}
-
-	if stats.FreeSpinsTriggered > 0 {
-		stats.AvgFreeSpinsAwarded /= float64(stats.FreeSpinsTriggered)
-		stats.FreeSpinsTriggeredRate = float64(stats.FreeSpinsTriggered) / float64(stats.TotalSpins) * 100
+		stats.RecordReturn(betAmount, spinReturn)
 	}
 
+	stats.Finalize()
 	return stats
 }
 
-func executeBaseSpin(reelStrips []reels.ReelStrip, cryptoRNG *rng.CryptoRNG, betAmount float64) (*engine.SpinResult, error) {
+func executeBaseSpin(strips *engine.ReelStripsResult, rngInstance rng.RNG, betAmount float64, rules engine.GameRules) (*engine.SpinResult, error) {
 	spinID := uuid.New()
 	isFreeSpin := false
+	reelStrips := strips.Strips
 
-	// Generate initial grid
-	initialGrid, reelPositions, err := reels.GenerateGrid(reelStrips, cryptoRNG)
+	// Generate initial grid (from strips compiled once per config when DB strips are used)
+	initialGrid, reelPositions, err := strips.GenerateGrid(rngInstance)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate grid: %w", err)
 	}
 
 	// Execute cascades
-	cascadeResults, finalGrid, err := cascade.ExecuteCascades(
+	cascadeResults, finalGrid, err := cascade.ExecuteCascadesWithFeatures(
 		initialGrid,
 		reelStrips,
 		reelPositions,
 		betAmount,
 		isFreeSpin,
-		cryptoRNG,
+		rngInstance,
+		rules.Multipliers,
+		rules.Wilds,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute cascades: %w", err)
@@ -294,7 +318,7 @@ func executeBaseSpin(reelStrips []reels.ReelStrip, cryptoRNG *rng.CryptoRNG, bet
 	totalWin := cascade.GetTotalWinFromCascades(cascadeResults, betAmount)
 
 	// Check for free spins trigger
-	triggerResult := freespins.CheckTrigger(finalGrid)
+	triggerResult := rules.FreeSpins.Check(finalGrid)
 
 	result := &engine.SpinResult{
 		SpinID:             spinID,
@@ -311,31 +335,39 @@ func executeBaseSpin(reelStrips []reels.ReelStrip, cryptoRNG *rng.CryptoRNG, bet
 	return result, nil
 }
 
-// executeFreeSpins executes all free spins in a session and returns total win
-func executeFreeSpins(reelStrips []reels.ReelStrip, cryptoRNG *rng.CryptoRNG, scatterCount int, betAmount float64) float64 {
+// executeFreeSpins executes all free spins in a session and returns total win and spins played
+func executeFreeSpins(strips *engine.ReelStripsResult, rngInstance rng.RNG, scatterCount int, betAmount float64, rules engine.GameRules) (float64, int) {
 	isFreeSpin := true
+	reelStrips := strips.Strips
 	// Create a free spins session
-	session := freespinsEngine.NewSession(uuid.Nil, scatterCount, betAmount, nil)
+	session := freespinsEngine.NewSessionWithRules(uuid.Nil, scatterCount, betAmount, nil, rules.FreeSpins)
 	totalWin := 0.0
 	spinNumber := 1
 
 	// Execute all free spins in the session
 	for !session.IsComplete() {
 		// Generate initial grid
-		initialGrid, reelPositions, err := reels.GenerateGrid(reelStrips, cryptoRNG)
+		initialGrid, reelPositions, err := strips.GenerateGrid(rngInstance)
 		if err != nil {
 			fmt.Printf("failed to generate grid: %s", err.Error())
 			break
 		}
 
+		// Sticky wilds from earlier free spins hold their positions
+		if rules.Wilds.StickyWilds {
+			initialGrid = wilds.ApplySticky(initialGrid, session.StickyWilds)
+		}
+
 		// Execute cascades with free spin multipliers
-		cascadeResults, finalGrid, err := cascade.ExecuteCascades(
+		cascadeResults, finalGrid, err := cascade.ExecuteCascadesWithFeatures(
 			initialGrid,
 			reelStrips,
 			reelPositions,
 			betAmount,
 			isFreeSpin,
-			cryptoRNG,
+			rngInstance,
+			rules.Multipliers,
+			rules.Wilds,
 		)
 		if err != nil {
 			fmt.Printf("failed to execute cascades: %s", err.Error())
@@ -346,7 +378,7 @@ func executeFreeSpins(reelStrips []reels.ReelStrip, cryptoRNG *rng.CryptoRNG, sc
 		totalCascadeWin := cascade.GetTotalWinFromCascades(cascadeResults, betAmount)
 
 		// Check for retrigger
-		retriggerResult := freespins.CheckRetrigger(finalGrid, session.RemainingSpins-1)
+		retriggerResult := rules.FreeSpins.CheckRetrigger(finalGrid, session.RemainingSpins-1)
 
 		result := &engine.FreeSpinResult{
 			TotalWin:        totalCascadeWin,
@@ -356,6 +388,9 @@ func executeFreeSpins(reelStrips []reels.ReelStrip, cryptoRNG *rng.CryptoRNG, sc
 
 		totalWin += result.TotalWin
 		session.ExecuteSpin(result.TotalWin)
+		if rules.Wilds.StickyWilds {
+			session.StickyWilds = wilds.CollectSticky(initialGrid, session.StickyWilds)
+		}
 
 		// Handle retrigger
 		if result.Retriggered {
@@ -365,90 +400,7 @@ func executeFreeSpins(reelStrips []reels.ReelStrip, cryptoRNG *rng.CryptoRNG, sc
 		spinNumber++
 	}
 
-	return totalWin
-}
-
-func printResults(stats SimulationStats, betAmount float64, targetRTP float64) {
-	fmt.Println()
-	fmt.Println("╔════════════════════════════════════════════════════════════╗")
-	fmt.Println("║                    SIMULATION RESULTS                      ║")
-	fmt.Println("╚════════════════════════════════════════════════════════════╝")
-	fmt.Println()
-
-	// Overall RTP
-	fmt.Println("═══ OVERALL STATISTICS ═══")
-	fmt.Printf("Total Spins:           %d\n", stats.TotalSpins)
-	fmt.Printf("Total Wagered:         %.2f\n", stats.TotalWagered)
-	fmt.Printf("Total Won:             %.2f\n", stats.TotalWon)
-	fmt.Printf("RTP:                   %.4f%% ", stats.RTP)
-
-	// Color-code RTP result
-	diff := stats.RTP - targetRTP
-	if diff > -0.3 && diff < 0.3 {
-		fmt.Printf("✓ (target: %.2f%%)\n", targetRTP)
-	} else if diff > -1.0 && diff < 1.0 {
-		fmt.Printf("⚠ (target: %.2f%%, diff: %+.2f%%)\n", targetRTP, diff)
-	} else {
-		fmt.Printf("✗ (target: %.2f%%, diff: %+.2f%%)\n", targetRTP, diff)
-	}
-	fmt.Println()
-
-	// Hit frequency
-	fmt.Println("═══ HIT FREQUENCY ═══")
-	totalWinSpins := stats.SmallWins + stats.MediumWins + stats.BigWins + stats.MegaWins
-	hitFrequency := float64(totalWinSpins) / float64(stats.TotalSpins) * 100
-
-	fmt.Printf("Winning Spins:         %d (%.2f%%)\n", totalWinSpins, hitFrequency)
-	fmt.Printf("No Win:                %d (%.2f%%)\n", stats.NoWinSpins,
-		float64(stats.NoWinSpins)/float64(stats.TotalSpins)*100)
-	fmt.Printf("Small Wins (<5x):      %d (%.2f%%)\n", stats.SmallWins,
-		float64(stats.SmallWins)/float64(stats.TotalSpins)*100)
-	fmt.Printf("Medium Wins (5-20x):   %d (%.2f%%)\n", stats.MediumWins,
-		float64(stats.MediumWins)/float64(stats.TotalSpins)*100)
-	fmt.Printf("Big Wins (20-100x):    %d (%.2f%%)\n", stats.BigWins,
-		float64(stats.BigWins)/float64(stats.TotalSpins)*100)
-	fmt.Printf("Mega Wins (>100x):     %d (%.2f%%)\n", stats.MegaWins,
-		float64(stats.MegaWins)/float64(stats.TotalSpins)*100)
-	fmt.Println()
-
-	// Base game statistics
-	fmt.Println("═══ BASE GAME ═══")
-	fmt.Printf("Base Game Wins:        %d (%.2f%%)\n", stats.BaseGameWins,
-		float64(stats.BaseGameWins)/float64(stats.TotalSpins)*100)
-	fmt.Printf("Base Game RTP:         %.4f%%\n", stats.BaseRTP)
-	fmt.Printf("Avg Cascades/Win:      %.2f\n", stats.AvgCascadesPerWin)
-	fmt.Printf("Max Cascades:          %d\n", stats.MaxCascades)
-	fmt.Println()
-
-	// Free spins statistics
-	fmt.Println("═══ FREE SPINS ═══")
-	fmt.Printf("Triggered:             %d times (%.4f%%)\n", stats.FreeSpinsTriggered, stats.FreeSpinsTriggeredRate)
-	fmt.Printf("Avg Spins Awarded:     %.2f\n", stats.AvgFreeSpinsAwarded)
-	fmt.Printf("Free Spins RTP:        %.4f%%\n", stats.FreeRTP)
-	fmt.Printf("Avg Trigger Frequency: 1 in %.0f spins\n", float64(stats.TotalSpins)/float64(stats.FreeSpinsTriggered))
-	fmt.Println()
-
-	// Max win
-	fmt.Println("═══ MAX WIN ═══")
-	maxWinMultiplier := stats.MaxWin / betAmount
-	fmt.Printf("Max Win:               %.2f (%.1fx bet)\n", stats.MaxWin, maxWinMultiplier)
-	fmt.Printf("Occurred at Spin:      %d\n", stats.MaxWinSpin)
-	fmt.Println()
-
-	// Volatility indicator
-	fmt.Println("═══ VOLATILITY INDICATORS ═══")
-	avgWin := stats.TotalWon / float64(totalWinSpins)
-	fmt.Printf("Average Win:           %.2f (%.2fx bet)\n", avgWin, avgWin/betAmount)
-	fmt.Printf("Max/Avg Win Ratio:     %.1fx\n", stats.MaxWin/avgWin)
-
-	volatility := "MEDIUM"
-	if maxWinMultiplier > 500 {
-		volatility = "HIGH"
-	} else if maxWinMultiplier < 100 {
-		volatility = "LOW"
-	}
-	fmt.Printf("Volatility:            %s\n", volatility)
-	fmt.Println()
+	return totalWin, spinNumber - 1
 }
 
 // runRTPCheck runs RTP simulation using real services with provably fair sessions
@@ -462,9 +414,9 @@ func runRTPCheck(
 	betAmount float64,
 	numSpins int,
 	progressInterval int,
-) SimulationStats {
+) simstats.Stats {
 	ctx := context.Background()
-	stats := SimulationStats{}
+	stats := simstats.Stats{}
 
 	// Start a game session
 	gameSession, err := sessionService.StartSession(ctx, playerID, betAmount)
@@ -505,9 +457,8 @@ func runRTPCheck(
 		}
 
 		// Update statistics
-		stats.TotalSpins++
-		stats.TotalWagered += betAmount
-		stats.TotalWon += result.SpinTotalWin
+		stats.RecordSpin(i+1, betAmount, result.SpinTotalWin, len(result.Cascades))
+		spinReturn := result.SpinTotalWin
 
 		// Execute free spins if triggered
 		if result.FreeSpinsTriggered {
@@ -532,6 +483,7 @@ func runRTPCheck(
 				stats.FreeSpinsTotalWon += freeResult.SpinTotalWin
 				stats.TotalWon += freeResult.SpinTotalWin
 				stats.TotalFreeSpins++
+				spinReturn += freeResult.SpinTotalWin
 				remainingSpins = freeResult.FreeSpinsRemainingSpins
 
 				// Check for retrigger
@@ -540,54 +492,9 @@ func runRTPCheck(
 				}
 			}
 		}
-
-		// Track max win
-		if result.SpinTotalWin > stats.MaxWin {
-			stats.MaxWin = result.SpinTotalWin
-			stats.MaxWinSpin = i + 1
-		}
-
-		// Categorize win size
-		winMultiplier := result.SpinTotalWin / betAmount
-		if result.SpinTotalWin == 0 {
-			stats.NoWinSpins++
-		} else if winMultiplier < 5 {
-			stats.SmallWins++
-		} else if winMultiplier < 20 {
-			stats.MediumWins++
-		} else if winMultiplier < 100 {
-			stats.BigWins++
-		} else {
-			stats.MegaWins++
-		}
-
-		// Track cascades
-		numCascades := len(result.Cascades)
-		stats.TotalCascades += numCascades
-		if numCascades > stats.MaxCascades {
-			stats.MaxCascades = numCascades
-		}
-		if result.SpinTotalWin > 0 {
-			stats.BaseGameWins++
-			stats.BaseGameTotalWon += result.SpinTotalWin
-		}
-	}
-
-	// Calculate final statistics
-	if stats.TotalWagered > 0 {
-		stats.RTP = (stats.TotalWon / stats.TotalWagered) * 100
-		stats.BaseRTP = (stats.BaseGameTotalWon / stats.TotalWagered) * 100
-		stats.FreeRTP = (stats.FreeSpinsTotalWon / stats.TotalWagered) * 100
-	}
-
-	if stats.BaseGameWins > 0 {
-		stats.AvgCascadesPerWin = float64(stats.TotalCascades) / float64(stats.BaseGameWins)
-	}
-
-	if stats.FreeSpinsTriggered > 0 {
-		stats.AvgFreeSpinsAwarded = float64(stats.TotalFreeSpins) / float64(stats.FreeSpinsTriggered)
-		stats.FreeSpinsTriggeredRate = float64(stats.FreeSpinsTriggered) / float64(stats.TotalSpins) * 100
+		stats.RecordReturn(betAmount, spinReturn)
 	}
 
+	stats.Finalize()
 	return stats
 }
@@ -1,11 +1,352 @@
 package main
 
 import (
-	tuningbasespin "github.com/slotmachine/backend/cmd/rtp-tuning/tuning-basespin"
-	// tuningfreespin "github.com/slotmachine/backend/cmd/rtp-tuning/tuning-freespin"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/slotmachine/backend/internal/game/tuning"
+	tuningbasespin "github.com/slotmachine/backend/internal/game/tuning/basespin"
+	tuningfreespin "github.com/slotmachine/backend/internal/game/tuning/freespin"
+	tuningmodes "github.com/slotmachine/backend/internal/game/tuning/modes"
 )
 
+var commands = map[string]func(args []string) error{
+	"generate":      runGenerate,
+	"simulate":      runSimulate,
+	"adjust":        runAdjust,
+	"validate":      runValidate,
+	"feature-value": runFeatureValue,
+	"export-to-db":  runExportToDB,
+	"tune":          runTune,
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Usage: rtp-tuning <command> [flags]
+
+Commands:
+  generate       Generate reel strips from topologies and write them to a strip set file
+  simulate       Simulate a strip set and record the statistics in it
+  adjust         Regenerate and simulate until the symbol RTP contribution reaches its targets
+  validate       Check a strip set against the spacing, cluster and gold rules of its mode
+  feature-value  Estimate the free spins feature RTP with a confidence interval
+  export-to-db   Store a simulated strip set as reel strips and a reel strip config
+  tune           Run the full tuning loop of a mode and save the result
+
+Run "rtp-tuning <command> -h" for the flags of a command.
+`)
+}
+
 func main() {
-	tuningbasespin.ExecuteTuning()
-	// tuningfreespin.ExecuteTuning()
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	run, ok := commands[os.Args[1]]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+	if err := run(os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+// newGenerator builds a reel generator for the mode, optionally from a topology file
+func newGenerator(m tuningmodes.Mode, seed int64, topologiesPath string) (*tuning.PGReelGenerator, error) {
+	topologies := m.Topologies()
+	if topologiesPath != "" {
+		var err error
+		if topologies, err = tuning.ReadTopologies(topologiesPath); err != nil {
+			return nil, err
+		}
+	}
+	return tuning.NewPGReelGenerator(seed, topologies), nil
+}
+
+// simulationFlags are the simulator settings shared by simulate and adjust
+type simulationFlags struct {
+	spins    int
+	bet      float64
+	workers  int
+	progress int
+}
+
+func (f *simulationFlags) register(fs *flag.FlagSet) {
+	fs.IntVar(&f.spins, "spins", 0, "Spins (sessions for free spins) per simulation, 0 for the mode default")
+	fs.Float64Var(&f.bet, "bet", 0, "Bet amount, 0 for the mode default")
+	fs.IntVar(&f.workers, "workers", 0, "Simulation workers, 0 for one per CPU")
+	fs.IntVar(&f.progress, "progress", 100000, "Report progress every N spins")
+}
+
+// config applies the flags to the mode's default tuning config
+func (f *simulationFlags) config(m tuningmodes.Mode) tuning.TuningConfig {
+	cfg := m.NewConfig()
+	if f.spins > 0 {
+		cfg.TotalSpin = f.spins
+	}
+	if f.bet > 0 {
+		// The buy cost is priced in bets, so it follows the bet
+		cfg.BuyCost *= f.bet / cfg.BetAmount
+		cfg.BetAmount = f.bet
+	}
+	if f.workers > 0 {
+		cfg.ParallelCfg.NumWorkers = f.workers
+	}
+	return cfg
+}
+
+func runGenerate(args []string) error {
+	fs := flag.NewFlagSet("generate", flag.ExitOnError)
+	modeName := fs.String("mode", "base", "Game mode to generate strips for ("+tuningmodes.Names()+")")
+	seed := fs.Int64("seed", 0, "Generator seed, 0 for the mode default")
+	topologiesPath := fs.String("topologies", "", "JSON file with the five reel topologies, empty for the mode defaults")
+	out := fs.String("out", "strips.json", "Strip set file to write")
+	fs.Parse(args)
+
+	m, err := tuningmodes.Lookup(*modeName)
+	if err != nil {
+		return err
+	}
+	if *seed == 0 {
+		*seed = m.Seed
+	}
+	gen, err := newGenerator(m, *seed, *topologiesPath)
+	if err != nil {
+		return err
+	}
+
+	strips, err := gen.GenerateAllReelStrips(m.Weights())
+	if err != nil {
+		return err
+	}
+	if err := tuning.NewStripSet(m.GameMode(), *seed, gen, strips).WriteFile(*out); err != nil {
+		return err
+	}
+	fmt.Printf("✓ Wrote %s strips to %s\n", *modeName, *out)
+	return nil
+}
+
+func runSimulate(args []string) error {
+	fs := flag.NewFlagSet("simulate", flag.ExitOnError)
+	in := fs.String("in", "strips.json", "Strip set file to simulate")
+	out := fs.String("out", "", "Strip set file to write with the statistics, empty to update -in")
+	var sim simulationFlags
+	sim.register(fs)
+	fs.Parse(args)
+
+	set, err := tuning.ReadStripSet(*in)
+	if err != nil {
+		return err
+	}
+	m, ok := tuningmodes.ForGameMode(set.GameMode)
+	if !ok {
+		return fmt.Errorf("unknown game mode %q", set.GameMode)
+	}
+
+	cfg := sim.config(m)
+	stats := m.Simulate(set.ReelStrips(), &cfg, sim.progress)
+	tuning.PrintResults(stats, cfg.BetAmount, cfg.TargetRTP)
+
+	set.Stats = &stats
+	if *out == "" {
+		*out = *in
+	}
+	return set.WriteFile(*out)
+}
+
+func runAdjust(args []string) error {
+	fs := flag.NewFlagSet("adjust", flag.ExitOnError)
+	modeName := fs.String("mode", "base", "Game mode to tune ("+tuningmodes.Names()+")")
+	seed := fs.Int64("seed", 0, "Generator seed, 0 for the mode default")
+	topologiesPath := fs.String("topologies", "", "JSON file with the starting reel topologies, empty for the mode defaults")
+	maxIter := fs.Int("max-iter", 50, "Maximum generate/simulate/adjust iterations")
+	learningRate := fs.Float64("learning-rate", 0, "Topology density learning rate, 0 for the mode default")
+	targets := tuning.DefaultSymbolContributionTargets()
+	fs.Float64Var(&targets.LowPct, "low", targets.LowPct, "Target RTP share of low symbols (%)")
+	fs.Float64Var(&targets.MidPct, "mid", targets.MidPct, "Target RTP share of mid symbols (%)")
+	fs.Float64Var(&targets.HighPct, "high", targets.HighPct, "Target RTP share of high symbols (%)")
+	out := fs.String("out", "strips.json", "Strip set file to write")
+	reportDir := fs.String("report-dir", "", "Directory for the convergence report, empty for <out>-report")
+	var sim simulationFlags
+	sim.register(fs)
+	fs.Parse(args)
+
+	m, err := tuningmodes.Lookup(*modeName)
+	if err != nil {
+		return err
+	}
+	if *seed == 0 {
+		*seed = m.Seed
+	}
+	if *reportDir == "" {
+		*reportDir = strings.TrimSuffix(*out, filepath.Ext(*out)) + "-report"
+	}
+	cfg := sim.config(m)
+	if *learningRate <= 0 {
+		*learningRate = cfg.TopologyLearningRate
+	}
+	gen, err := newGenerator(m, *seed, *topologiesPath)
+	if err != nil {
+		return err
+	}
+
+	simulate := func(strips []reels.ReelStrip) tuning.SimulationStats {
+		return m.Simulate(strips, &cfg, sim.progress)
+	}
+	result, err := gen.AutoTune(m.Weights(), simulate, targets, *learningRate, *maxIter)
+	if err != nil {
+		return err
+	}
+
+	report := result.Report
+	report.GameMode, report.Seed, report.SpinsPerIter = cfg.GameMode, *seed, cfg.TotalSpin
+	report.TargetRTP, report.TargetRTPTolerance, report.Ladder = cfg.TargetRTP, cfg.TargetRTPTolerance, cfg.Ladder
+	if err := report.WriteDir(*reportDir); err != nil {
+		return err
+	}
+
+	set := tuning.NewStripSet(cfg.GameMode, *seed, gen, result.Strips)
+	set.Topologies = result.Topologies
+	set.Stats = &result.Stats
+	set.Report = report
+	if err := set.WriteFile(*out); err != nil {
+		return err
+	}
+	tuning.PrintResults(result.Stats, cfg.BetAmount, cfg.TargetRTP)
+
+	if !report.RTPWithinTarget() {
+		fmt.Printf("⚠ RTP %.3f%% is outside the %s target of %.2f%% ± %.2f%%\n",
+			result.Stats.RTP, cfg.GameMode, cfg.TargetRTP, cfg.TargetRTPTolerance)
+	}
+	if !result.Converged {
+		return fmt.Errorf("did not converge in %d iterations, last strips written to %s, report to %s", result.Iterations, *out, *reportDir)
+	}
+	fmt.Printf("✓ Converged after %d iterations, wrote %s and report to %s\n", result.Iterations, *out, *reportDir)
+	return nil
+}
+
+func runValidate(args []string) error {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	in := fs.String("in", "strips.json", "Strip set file to check")
+	fs.Parse(args)
+
+	set, err := tuning.ReadStripSet(*in)
+	if err != nil {
+		return err
+	}
+	violations, err := tuningmodes.NewValidator().Validate(set.GameMode, set.Strips)
+	if err != nil {
+		return err
+	}
+	for _, v := range violations {
+		fmt.Printf("R%d @%d %-18s %s\n", v.Reel+1, v.Position, v.Rule, v.Message)
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("%d violations in %s", len(violations), *in)
+	}
+	fmt.Printf("✓ %s passes the %s rules\n", *in, set.GameMode)
+	return nil
+}
+
+func runFeatureValue(args []string) error {
+	fs := flag.NewFlagSet("feature-value", flag.ExitOnError)
+	basePath := fs.String("base", "base.json", "Base game strip set the feature is triggered on")
+	freePath := fs.String("free", "free.json", "Free spins strip set the feature is played on")
+	baseSpins := fs.Int("base-spins", 10_000_000, "Base spins to measure the trigger rates on")
+	sessions := fs.Int("sessions", 100_000, "Feature sessions to play across the scatter counts")
+	confidence := fs.Float64("confidence", 0.95, "Confidence level of the interval")
+	workers := fs.Int("workers", 0, "Simulation workers, 0 for one per CPU")
+	out := fs.String("out", "", "JSON file to write the estimate to, empty for none")
+	fs.Parse(args)
+
+	base, err := readStripSetFor(*basePath, tuningbasespin.NewConfig().GameMode)
+	if err != nil {
+		return err
+	}
+	free, err := readStripSetFor(*freePath, tuningfreespin.NewFreeSpinsConfig().GameMode)
+	if err != nil {
+		return err
+	}
+	baseCfg, freeCfg := tuningbasespin.NewConfig(), tuningfreespin.NewFreeSpinsConfig()
+	if *workers > 0 {
+		baseCfg.ParallelCfg.NumWorkers = *workers
+		freeCfg.ParallelCfg.NumWorkers = *workers
+	}
+
+	baseStrips, freeStrips := base.ReelStrips(), free.ReelStrips()
+	estimate, err := tuning.EstimateFeatureValue(tuning.FeatureSampler{
+		CountTriggers: func(spins int) map[int]int {
+			return tuningbasespin.CountTriggers(baseStrips, &baseCfg, spins)
+		},
+		PlaySessions: func(scatterCount, n int) []float64 {
+			return tuningfreespin.PlaySessions(freeStrips, &freeCfg, scatterCount, n)
+		},
+	}, *baseSpins, *sessions, *confidence)
+	if err != nil {
+		return err
+	}
+	tuning.PrintFeatureEstimate(estimate)
+
+	if *out != "" {
+		data, err := json.MarshalIndent(estimate, "", "  ")
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(*out, data, 0o644)
+	}
+	return nil
+}
+
+// readStripSetFor reads a strip set and checks it holds strips for gameMode
+func readStripSetFor(path, gameMode string) (*tuning.StripSet, error) {
+	set, err := tuning.ReadStripSet(path)
+	if err != nil {
+		return nil, err
+	}
+	if set.GameMode != gameMode {
+		return nil, fmt.Errorf("%s holds %s strips, want %s", path, set.GameMode, gameMode)
+	}
+	return set, nil
+}
+
+func runExportToDB(args []string) error {
+	fs := flag.NewFlagSet("export-to-db", flag.ExitOnError)
+	in := fs.String("in", "strips.json", "Simulated strip set file to store")
+	name := fs.String("name", "", "Reel strip config name, empty for <game mode>-<rtp>-<timestamp>")
+	fs.Parse(args)
+
+	set, err := tuning.ReadStripSet(*in)
+	if err != nil {
+		return err
+	}
+	if set.Stats == nil {
+		return fmt.Errorf("%s has not been simulated; run simulate first", *in)
+	}
+
+	config, err := tuning.SaveToDB(context.Background(), set.GameMode, *name, set.ReelStrips(), *set.Stats, set.Report)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✓ Saved reel strip config %s (%s)\n", config.Name, config.ID)
+	return nil
+}
+
+func runTune(args []string) error {
+	fs := flag.NewFlagSet("tune", flag.ExitOnError)
+	modeName := fs.String("mode", "base", "Game mode to tune ("+tuningmodes.Names()+")")
+	fs.Parse(args)
+
+	m, err := tuningmodes.Lookup(*modeName)
+	if err != nil {
+		return err
+	}
+	m.Tune()
+	return nil
 }
@@ -0,0 +1,347 @@
+//go:build integration
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/api/openapi"
+	"github.com/slotmachine/backend/internal/db"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/minio"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/modules/redis"
+)
+
+// backendRoot is the module root, relative to this package
+const backendRoot = "../.."
+
+// Images the integration environment runs
+const (
+	postgresImage = "postgres:16-alpine"
+	redisImage    = "redis:7-alpine"
+	minioImage    = "minio/minio:RELEASE.2024-01-16T16-07-38Z"
+)
+
+// integrationEnv is the application wired against real Postgres, Redis and MinIO containers
+type integrationEnv struct {
+	app        *Application
+	containers []testcontainers.Container
+
+	mu         sync.Mutex
+	violations []openapi.Violation
+}
+
+var (
+	envOnce sync.Once
+	env     *integrationEnv
+	envErr  error
+)
+
+// TestMain tears the shared environment down after every test ran
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if env != nil {
+		env.close()
+	}
+	os.Exit(code)
+}
+
+// integration returns the shared environment, booting it on first use
+// Tests skip when no container runtime is reachable.
+func integration(t *testing.T) *integrationEnv {
+	t.Helper()
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+
+	envOnce.Do(func() {
+		env, envErr = startIntegrationEnv(context.Background())
+	})
+	require.NoError(t, envErr, "boot integration environment")
+	return env
+}
+
+// startIntegrationEnv boots the containers, migrates and seeds the database and wires the application
+// It goes through the same startup checks as main.
+func startIntegrationEnv(ctx context.Context) (*integrationEnv, error) {
+	e := &integrationEnv{}
+	if err := e.startContainers(ctx); err != nil {
+		e.close()
+		return nil, err
+	}
+	if err := migrateAndSeed(ctx); err != nil {
+		e.close()
+		return nil, err
+	}
+
+	application, err := InitializeApplication()
+	if err != nil {
+		e.close()
+		return nil, fmt.Errorf("initialize application: %w", err)
+	}
+	e.app = application
+	if err := db.CheckSchema(application.Config, application.Logger); err != nil {
+		e.close()
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	if err := application.SeedDataValidator.Validate(ctx); err != nil {
+		e.close()
+		return nil, fmt.Errorf("seed data: %w", err)
+	}
+
+	doc, err := openapi.Spec()
+	if err != nil {
+		e.close()
+		return nil, err
+	}
+	// Mounted ahead of the routes, so every player-facing response is checked against the spec
+	application.App.Use(openapi.Contract(doc, func(v openapi.Violation) {
+		e.mu.Lock()
+		e.violations = append(e.violations, v)
+		e.mu.Unlock()
+	}))
+	mountRoutes(application)
+	startWorkers(application)
+	return e, nil
+}
+
+// startContainers runs Postgres, Redis and MinIO,
+// and points the configuration at them through the environment
+func (e *integrationEnv) startContainers(ctx context.Context) error {
+	pg, err := postgres.Run(ctx, postgresImage,
+		postgres.WithDatabase("slotmachine"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		postgres.BasicWaitStrategies(),
+	)
+	if pg != nil {
+		e.containers = append(e.containers, pg)
+	}
+	if err != nil {
+		return fmt.Errorf("start postgres: %w", err)
+	}
+	pgHost, err := pg.Host(ctx)
+	if err != nil {
+		return err
+	}
+	pgPort, err := pg.MappedPort(ctx, "5432/tcp")
+	if err != nil {
+		return err
+	}
+
+	rd, err := redis.Run(ctx, redisImage)
+	if rd != nil {
+		e.containers = append(e.containers, rd)
+	}
+	if err != nil {
+		return fmt.Errorf("start redis: %w", err)
+	}
+	redisAddr, err := rd.PortEndpoint(ctx, "6379/tcp", "")
+	if err != nil {
+		return err
+	}
+
+	mn, err := minio.Run(ctx, minioImage, minio.WithUsername("minioadmin"), minio.WithPassword("minioadmin"))
+	if mn != nil {
+		e.containers = append(e.containers, mn)
+	}
+	if err != nil {
+		return fmt.Errorf("start minio: %w", err)
+	}
+	minioAddr, err := mn.ConnectionString(ctx)
+	if err != nil {
+		return err
+	}
+
+	settings := map[string]string{
+		"APP_ENV":            "test",
+		"LOG_LEVEL":          "error",
+		"DB_HOST":            pgHost,
+		"DB_PORT":            pgPort.Port(),
+		"DB_USER":            "postgres",
+		"DB_PASSWORD":        "postgres",
+		"DB_NAME":            "slotmachine",
+		"REDIS_ADDR":         redisAddr,
+		"REDIS_ENABLED":      "true",
+		"STORAGE_PROVIDER":   "minio",
+		"STORAGE_ENDPOINT":   minioAddr,
+		"STORAGE_ACCESS_KEY": "minioadmin",
+		"STORAGE_SECRET_KEY": "minioadmin",
+		"STORAGE_PUBLIC_URL": "http://" + minioAddr,
+		// The flows spin faster than a player could
+		"RATE_LIMIT_SPIN":    "10000",
+		"RATE_LIMIT_GENERAL": "10000",
+		// Sweepers and schedulers run on a clock the tests move
+		"CLOCK_FAKE_START": time.Now().UTC().Format(time.RFC3339),
+	}
+	for key, value := range settings {
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateAndSeed runs the migrate CLI and the reel strip and asset seed scripts against the
+// containers, the way make migrate, make seed-reelstrips-both and make seed-assets do
+func migrateAndSeed(ctx context.Context) error {
+	dir, err := os.MkdirTemp("", "slot-seed-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	// seed_reelstrips reads its CSVs from next to its binary
+	for _, csv := range []string{"reel_strips_masterdata.csv", "reel_strip_configs_masterdata.csv"} {
+		data, err := os.ReadFile(filepath.Join(backendRoot, "scripts", "seed_reelstrips", csv))
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, csv), data, 0o644); err != nil {
+			return err
+		}
+	}
+	manifests, err := filepath.Abs(filepath.Join(backendRoot, "scripts", "seed_assets", "manifests"))
+	if err != nil {
+		return err
+	}
+
+	steps := []struct {
+		name string
+		pkg  string
+		args []string
+	}{
+		{"migrate", "./cmd/migrate", []string{"up"}},
+		{"seed_reelstrips", "./scripts/seed_reelstrips", []string{"-mode=both"}},
+		{"seed_assets", "./scripts/seed_assets", []string{"-manifest=" + manifests}},
+	}
+	for _, s := range steps {
+		binary := filepath.Join(dir, s.name)
+		if err := runCommand(ctx, backendRoot, "go", "build", "-o", binary, s.pkg); err != nil {
+			return fmt.Errorf("build %s: %w", s.name, err)
+		}
+		if err := runCommand(ctx, dir, binary, s.args...); err != nil {
+			return fmt.Errorf("run %s: %w", s.name, err)
+		}
+	}
+	return nil
+}
+
+// runCommand runs a command in dir with the test's environment, returning its output on failure
+func runCommand(ctx context.Context, dir, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmd.Env = os.Environ()
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w\n%s", err, out)
+	}
+	return nil
+}
+
+// close stops the application and terminates the containers
+func (e *integrationEnv) close() {
+	if e.app != nil {
+		_ = e.app.Shutdown()
+	}
+	for i := len(e.containers) - 1; i >= 0; i-- {
+		_ = testcontainers.TerminateContainer(e.containers[i])
+	}
+}
+
+// takeViolations returns the contract violations reported since the last call
+func (e *integrationEnv) takeViolations() []openapi.Violation {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	violations := e.violations
+	e.violations = nil
+	return violations
+}
+
+// request sends a JSON request through the application and decodes a JSON answer into out
+// token authenticates the request when set; out may be nil.
+func (e *integrationEnv) request(t *testing.T, method, path, token string, body, out any) int {
+	t.Helper()
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+		reader = bytes.NewReader(data)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := e.app.App.Test(req, -1)
+	require.NoError(t, err, "%s %s", method, path)
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	if out != nil && resp.StatusCode < 300 {
+		require.NoError(t, json.Unmarshal(data, out), "%s %s: %s", method, path, data)
+	}
+	return resp.StatusCode
+}
+
+// mustRequest is request, failing the test on any status other than want
+func (e *integrationEnv) mustRequest(t *testing.T, want int, method, path, token string, body, out any) {
+	t.Helper()
+	status := e.request(t, method, path, token, body, out)
+	require.Equal(t, want, status, "%s %s", method, path)
+}
+
+// newPlayer registers a player and logs them in, returning their session token
+func (e *integrationEnv) newPlayer(t *testing.T, username string) string {
+	t.Helper()
+	password := "integration-password"
+	e.mustRequest(t, 201, "POST", "/v1/auth/register", "", dto.RegisterRequest{
+		Username: username,
+		Email:    username + "@example.com",
+		Password: password,
+	}, nil)
+
+	var auth dto.AuthResponse
+	e.mustRequest(t, 200, "POST", "/v1/auth/login", "", dto.LoginRequest{Username: username, Password: password}, &auth)
+	require.NotEmpty(t, auth.SessionToken)
+	return auth.SessionToken
+}
+
+// startSession starts a game session and returns it
+func (e *integrationEnv) startSession(t *testing.T, token string, bet float64) dto.SessionResponse {
+	t.Helper()
+	var session dto.SessionResponse
+	e.mustRequest(t, 201, "POST", "/v1/session/start", token, dto.StartSessionRequest{BetAmount: bet}, &session)
+	require.NotEmpty(t, session.ID)
+	return session
+}
+
+// fakeClock returns the clock the application runs on
+func (e *integrationEnv) fakeClock(t *testing.T) *clock.Fake {
+	t.Helper()
+	fake, ok := e.app.Clock.(*clock.Fake)
+	require.True(t, ok, "the application runs on a fake clock")
+	return fake
+}
+
+// requireContract fails the test for every response that did not match the OpenAPI document
+func (e *integrationEnv) requireContract(t *testing.T) {
+	t.Helper()
+	for _, v := range e.takeViolations() {
+		t.Errorf("contract violation: %s", v)
+	}
+}
@@ -0,0 +1,195 @@
+//go:build integration
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startingBalance is the balance players are registered with
+const startingBalance = 100000.0
+
+func TestIntegration_Readiness(t *testing.T) {
+	e := integration(t)
+
+	e.mustRequest(t, 200, "GET", "/healthz", "", nil, nil)
+	e.mustRequest(t, 200, "GET", "/readyz", "", nil, nil)
+}
+
+func TestIntegration_BaseSpins(t *testing.T) {
+	e := integration(t)
+	token := e.newPlayer(t, "int_base_spins")
+	session := e.startSession(t, token, 1)
+
+	balance := startingBalance
+	for i := 0; i < 5; i++ {
+		var spin dto.SpinResponse
+		e.mustRequest(t, 200, "POST", "/v1/base-spins/spin", token, dto.ExecuteSpinRequest{SessionID: session.ID, BetAmount: 1}, &spin)
+
+		assert.Equal(t, session.ID, spin.SessionID)
+		assert.InDelta(t, balance, spin.BalanceBefore, 1e-9, "spin %d starts from the previous balance", i)
+		assert.InDelta(t, balance-1, spin.BalanceAfterBet, 1e-9)
+		assert.InDelta(t, spin.BalanceAfterBet+spin.SpinTotalWin, spin.NewBalance, 1e-9)
+		assert.Len(t, spin.Grid, 5)
+		balance = spin.NewBalance
+	}
+
+	var current dto.GetBalanceResponse
+	e.mustRequest(t, 200, "GET", "/v1/player/balance", token, nil, &current)
+	assert.InDelta(t, balance, current.Balance, 1e-9)
+
+	var history dto.SpinHistoryResponse
+	e.mustRequest(t, 200, "GET", "/v1/base-spins/histories", token, nil, &history)
+	assert.EqualValues(t, 5, history.Total)
+
+	// The spins reached Postgres, not only the response
+	var stored int64
+	require.NoError(t, e.app.DB.Table("spins").Where("player_id = ?", session.PlayerID).Count(&stored).Error)
+	assert.EqualValues(t, 5, stored)
+
+	var ended dto.SessionResponse
+	e.mustRequest(t, 200, "POST", fmt.Sprintf("/v1/session/%s/end", session.ID), token, nil, &ended)
+	assert.Equal(t, 5, ended.TotalSpins)
+	assert.InDelta(t, 5, ended.TotalWagered, 1e-9)
+	if assert.NotNil(t, ended.EndingBalance) {
+		assert.InDelta(t, balance, *ended.EndingBalance, 1e-9)
+	}
+
+	// Rejected bets leave the balance alone
+	status := e.request(t, "POST", "/v1/base-spins/spin", token, dto.ExecuteSpinRequest{BetAmount: 2 * startingBalance}, nil)
+	assert.GreaterOrEqual(t, status, 400)
+	e.mustRequest(t, 200, "GET", "/v1/player/balance", token, nil, &current)
+	assert.InDelta(t, balance, current.Balance, 1e-9)
+
+	e.requireContract(t)
+}
+
+func TestIntegration_FreeSpins(t *testing.T) {
+	e := integration(t)
+	token := e.newPlayer(t, "int_free_spins")
+	session := e.startSession(t, token, 20)
+
+	// A bonus buy always triggers free spins
+	var trigger dto.SpinResponse
+	e.mustRequest(t, 200, "POST", "/v1/base-spins/spin", token, dto.ExecuteSpinRequest{
+		SessionID: session.ID, BetAmount: 20, GameMode: "bonus_spin_trigger",
+	}, &trigger)
+	require.True(t, trigger.FreeSpinsTriggered)
+	require.NotEmpty(t, trigger.FreeSpinsSessionID)
+	require.Positive(t, trigger.FreeSpinsRemainingSpins)
+	assert.InDelta(t, startingBalance-trigger.GameModeCost, trigger.BalanceAfterBet, 1e-9)
+
+	var status dto.FreeSpinsStatusResponse
+	e.mustRequest(t, 200, "GET", "/v1/free-spins/status", token, nil, &status)
+	require.True(t, status.Active)
+	assert.Equal(t, trigger.FreeSpinsSessionID, status.FreeSpinsSessionID)
+	assert.Equal(t, trigger.FreeSpinsRemainingSpins, status.RemainingSpins)
+	assert.InDelta(t, 20, status.LockedBetAmount, 1e-9)
+
+	// One free spin by hand, then the rest server-side
+	var free dto.SpinResponse
+	e.mustRequest(t, 200, "POST", "/v1/free-spins/spin", token, dto.ExecuteFreeSpinRequest{FreeSpinsSessionID: status.FreeSpinsSessionID}, &free)
+	assert.True(t, free.IsFreeSpin)
+	assert.InDelta(t, 0, free.BalanceBefore-free.BalanceAfterBet, 1e-9, "free spins cost nothing")
+
+	balance := free.NewBalance
+	for remaining := free.FreeSpinsRemainingSpins; remaining > 0; {
+		var autoplay dto.FreeSpinsAutoplayResponse
+		e.mustRequest(t, 200, "POST", "/v1/free-spins/autoplay", token, dto.ExecuteAllFreeSpinsRequest{FreeSpinsSessionID: status.FreeSpinsSessionID}, &autoplay)
+		require.Positive(t, autoplay.SpinsPlayed)
+		balance = autoplay.NewBalance
+		remaining = autoplay.RemainingSpins
+	}
+
+	e.mustRequest(t, 200, "GET", "/v1/free-spins/status", token, nil, &status)
+	assert.False(t, status.Active)
+
+	var current dto.GetBalanceResponse
+	e.mustRequest(t, 200, "GET", "/v1/player/balance", token, nil, &current)
+	assert.InDelta(t, balance, current.Balance, 1e-9)
+
+	e.requireContract(t)
+}
+
+func TestIntegration_ProvablyFair(t *testing.T) {
+	e := integration(t)
+	token := e.newPlayer(t, "int_provably_fair")
+	session := e.startSession(t, token, 1)
+	require.NotNil(t, session.ProvablyFair, "sessions commit to a server seed")
+	commitment := session.ProvablyFair.ServerSeedHash
+	require.Len(t, commitment, 64)
+
+	prevHash := commitment
+	var hashes []string
+	for i := 1; i <= 3; i++ {
+		var spin dto.SpinResponse
+		e.mustRequest(t, 200, "POST", "/v1/base-spins/spin", token, dto.ExecuteSpinRequest{
+			SessionID: session.ID, BetAmount: 1, ClientSeed: fmt.Sprintf("integration-seed-%d", i),
+		}, &spin)
+		require.NotNil(t, spin.ProvablyFair)
+		assert.EqualValues(t, i, spin.ProvablyFair.Nonce)
+		assert.Equal(t, prevHash, spin.ProvablyFair.PrevSpinHash, "spin %d chains to the previous hash", i)
+		prevHash = spin.ProvablyFair.SpinHash
+		hashes = append(hashes, prevHash)
+	}
+
+	var ended dto.SessionResponse
+	e.mustRequest(t, 200, "POST", fmt.Sprintf("/v1/session/%s/end", session.ID), token, nil, &ended)
+	require.NotNil(t, ended.ProvablyFair, "ending the session reveals the server seed")
+	revealed := ended.ProvablyFair
+	sum := sha256.Sum256([]byte(revealed.ServerSeed))
+	assert.Equal(t, commitment, hex.EncodeToString(sum[:]), "the revealed seed matches the commitment")
+	require.Len(t, revealed.Spins, len(hashes))
+
+	for i, s := range revealed.Spins {
+		assert.Equal(t, hashes[i], s.SpinHash)
+		var result dto.VerifySpinResponse
+		e.mustRequest(t, 200, "POST", "/v1/pf/verify/spin", token, dto.VerifySpinRequest{
+			ServerSeed:   revealed.ServerSeed,
+			ClientSeed:   s.ClientSeed,
+			Nonce:        s.Nonce,
+			PrevSpinHash: s.PrevSpinHash,
+			SpinHash:     s.SpinHash,
+		}, &result)
+		assert.True(t, result.Valid, "spin %d verifies: %s", s.Nonce, result.Message)
+	}
+
+	// A tampered hash does not
+	var tampered dto.VerifySpinResponse
+	e.mustRequest(t, 200, "POST", "/v1/pf/verify/spin", token, dto.VerifySpinRequest{
+		ServerSeed:   revealed.ServerSeed,
+		ClientSeed:   revealed.Spins[0].ClientSeed,
+		Nonce:        revealed.Spins[0].Nonce,
+		PrevSpinHash: revealed.Spins[0].PrevSpinHash,
+		SpinHash:     revealed.Spins[1].SpinHash,
+	}, &tampered)
+	assert.False(t, tampered.Valid)
+
+	e.requireContract(t)
+}
+
+func TestIntegration_IdleSessionExpiry(t *testing.T) {
+	e := integration(t)
+	token := e.newPlayer(t, "int_idle_expiry")
+	session := e.startSession(t, token, 1)
+	e.mustRequest(t, 200, "POST", "/v1/base-spins/spin", token, dto.ExecuteSpinRequest{SessionID: session.ID, BetAmount: 1}, nil)
+
+	// Jump past the idle timeout; the sweeper's next tick ends the session
+	cfg := e.app.Config.SessionExpiry
+	idle := time.Duration(cfg.IdleMinutes)*time.Minute + time.Duration(cfg.SweepIntervalSeconds)*time.Second
+	e.fakeClock(t).Set(time.Now().Add(idle + time.Minute))
+
+	require.Eventually(t, func() bool {
+		var ended int64
+		err := e.app.DB.Table("game_sessions").Where("id = ? AND ended_at IS NOT NULL", session.ID).Count(&ended).Error
+		return err == nil && ended == 1
+	}, 30*time.Second, 100*time.Millisecond, "the idle session is ended")
+}
@@ -1,16 +1,22 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"github.com/slotmachine/backend/internal/db"
 	"github.com/slotmachine/backend/internal/server"
 )
 
 func main() {
-	// Initialize application with Wire
+	validateOnly := flag.Bool("validate-config", false, "validate the configuration, schema and seed data, then exit")
+	flag.Parse()
+
+	// Initialize application with Wire; an invalid configuration fails here with every problem listed
 	application, err := InitializeApplication()
 	if err != nil {
 		fmt.Printf("Failed to initialize application: %v\n", err)
@@ -20,15 +26,67 @@ func main() {
 	log := application.Logger
 	cfg := application.Config
 
+	// Fail fast on a schema that is behind this build, or on missing seed data, rather than on
+	// the first request that needs them
+	if err := db.CheckSchema(cfg, log); err != nil {
+		fmt.Printf("Startup validation failed: %v\n", err)
+		os.Exit(1)
+	}
+	if err := application.SeedDataValidator.Validate(context.Background()); err != nil {
+		fmt.Printf("Startup validation failed: %v\n", err)
+		os.Exit(1)
+	}
+	if *validateOnly {
+		fmt.Println("Configuration, schema and seed data are valid")
+		os.Exit(0)
+	}
+
 	log.Info().
 		Str("env", cfg.App.Env).
 		Str("addr", cfg.App.Addr).
 		Msg("Starting Slot Machine Backend Server")
 
+	mountRoutes(application)
+
+	startWorkers(application)
+
+	// Start server in a goroutine
+	go func() {
+		log.Info().Str("addr", cfg.App.Addr).Msg("Server listening")
+		if err := application.App.Listen(cfg.App.Addr); err != nil {
+			log.Error().Err(err).Msg("Failed to start server")
+			os.Exit(1)
+		}
+	}()
+
+	// Wait for interrupt signal to gracefully shutdown the server
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	<-quit
+
+	log.Info().Msg("Shutting down server...")
+
+	// Gracefully shutdown all resources (Fiber, Redis, Database)
+	if err := application.Shutdown(); err != nil {
+		log.Error().Err(err).Msg("Shutdown error")
+		os.Exit(1)
+	}
+
+	log.Info().Msg("Server stopped")
+}
+
+// mountRoutes injects the services wired after construction and mounts every route on the Fiber app
+func mountRoutes(application *Application) {
+	log := application.Logger
+	cfg := application.Config
+
 	// Inject PF service into session handler (for integrated PF session management)
 	application.SessionHandler.SetProvablyFairService(application.ProvablyFairService)
 	log.Info().Msg("PF service injected into session handler")
 
+	// Inject VIP service into session handler (tier info on session responses)
+	application.SessionHandler.SetVIPService(application.VIPService)
+
 	// Inject trial service into spin service (for trial mode support)
 	application.SpinService.SetTrialService(application.TrialService)
 	log.Info().Msg("Trial service injected into spin service")
@@ -42,21 +100,61 @@ func main() {
 		application.TrialRateLimiter, // Security: DoS protection for trial mode
 		application.AuthHandler,
 		application.PlayerHandler,
+		application.StatsHandler,
 		application.SessionHandler,
+		application.AutoplayHandler,
 		application.SpinHandler,
 		application.FreeSpinsHandler,
 		application.ProvablyFairHandler,
 		application.AdminReelStripHandler,
+		application.AdminReelStripCanaryHandler,
+		application.AdminExposureHandler,
+		application.AdminRuntimeConfigHandler,
 		application.AdminPlayerAssignmentHandler,
+		application.AdminSegmentHandler,
+		application.AdminVIPHandler,
+		application.JurisdictionHandler,
+		application.AdminJurisdictionHandler,
+		application.AdminBigWinHandler,
+		application.AdminArchiveHandler,
+		application.AdminFinancialReportHandler,
+		application.AdminNotificationHandler,
+		application.AdminSessionHandler,
+		application.AdminImpersonationHandler,
+		application.AdminPlayerNoteHandler,
+		application.AdminDashboardHandler,
+		application.AdminSpinFeedHandler,
+		application.FeatureFlagHandler,
+		application.AdminFeatureFlagHandler,
+		application.TranslationHandler,
+		application.AdminTranslationHandler,
+		application.AdminTrialHandler,
 		application.AdminAuthHandler,
+		application.JWKSHandler,
+		application.HealthHandler,
+		application.LaunchHandler,
+		application.TransparencyHandler,
 		application.AdminManagementHandler,
 		application.AdminPlayerHandler,
+		application.AdminGraphQLHandler,
+		application.AdminTimelineHandler,
+		application.AdminDisputeHandler,
+		application.AdminApprovalHandler,
+		application.KYCHandler,
+		application.AdminKYCHandler,
+		application.PrivacyHandler,
+		application.HistoryExportHandler,
+		application.NotificationHandler,
+		application.BalanceHandler,
+		application.AdminPrivacyHandler,
+		application.AdminCertificationHandler,
 		application.GameHandler,
 		application.AdminGameHandler,
 		application.AdminUploadHandler,
 		application.AdminChunkedUploadHandler,
 		application.AdminDirectUploadHandler,
 		application.TrialHandler,
+		application.TrialConversionHandler,
 		// Trial-specific handlers (separate from production)
 		application.TrialSpinHandler,
 		application.TrialFreeSpinsHandler,
@@ -64,30 +162,69 @@ func main() {
 		application.TrialPlayerHandler,
 		application.AdminService,
 		application.PlayerService,
+		application.DashboardService,
 		application.TrialService,
+		application.ImpersonationService,
+		application.Translator,
 	)
+}
 
-	// Start server in a goroutine
-	go func() {
-		log.Info().Str("addr", cfg.App.Addr).Msg("Server listening")
-		if err := application.App.Listen(cfg.App.Addr); err != nil {
-			log.Error().Err(err).Msg("Failed to start server")
-			os.Exit(1)
-		}
-	}()
+// startWorkers starts the background workers; Application.Shutdown stops them
+func startWorkers(application *Application) {
+	// Load (or create) the admin token signing keys and rotate them on schedule
+	application.JWTKeyring.Start()
 
-	// Wait for interrupt signal to gracefully shutdown the server
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
-	<-quit
+	// End PF sessions left active by crashed clients
+	application.PFSessionSweeper.Start()
 
-	log.Info().Msg("Shutting down server...")
+	// End idle game sessions, settling their unplayed free spins
+	application.SessionExpirySweeper.Start()
 
-	// Gracefully shutdown all resources (Fiber, Redis, Database)
-	if err := application.Shutdown(); err != nil {
-		log.Error().Err(err).Msg("Shutdown error")
-		os.Exit(1)
-	}
+	// Award free spins that settled spins triggered but never received
+	application.SpinReconciler.Start()
 
-	log.Info().Msg("Server stopped")
+	// Re-verify ended PF sessions' hash chains and alert on mismatches
+	application.PFChainAuditor.Start()
+
+	// Flush batched PF session state in the background
+	application.PFStateWriter.Start()
+
+	// Commit concurrent spins in shared transactions
+	application.SpinBatchWriter.Start()
+
+	// Mirror settled spins into the analytics store
+	application.AnalyticsMirror.Start()
+
+	// Keep spin table partitions ahead of the clock
+	application.PartitionMaintainer.Start()
+
+	// Move spin partitions past retention to cold storage
+	application.ArchiveWorker.Start()
+
+	// Generate the daily financial reports of ended days
+	application.FinancialReportWorker.Start()
+
+	// Generate the game-history exports players have requested
+	application.HistoryExportWorker.Start()
+
+	// Expire change requests left unreviewed past their expiry
+	application.ApprovalExpiryWorker.Start()
+
+	// Revert reel strip canaries whose realized RTP drifts, and promote those that pass their trial
+	application.ReelStripCanaryMonitor.Start()
+
+	// Alert when a config's realized RTP strays further from target than chance explains
+	application.RTPMonitor.Start()
+
+	// Measure open liability and pause bonus buys while it exceeds its limits
+	application.ExposureMonitor.Start()
+
+	// Apply RUNTIME_CONFIG_FILE and hot-reload log level, rate limits, flags and reality checks from it
+	application.RuntimeConfigWatcher.Start()
+
+	// Preload reel strip configs so the first spins don't read them cold, and re-warm after changes
+	application.ReelStripCacheWarmer.Start()
+
+	// Anchor Merkle roots of new spin hashes in the public transparency log
+	application.TransparencyPublisher.Start()
 }
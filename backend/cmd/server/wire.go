@@ -7,16 +7,28 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/wire"
 	adminDomain "github.com/slotmachine/backend/domain/admin"
+	dashboardDomain "github.com/slotmachine/backend/domain/dashboard"
 	playerDomain "github.com/slotmachine/backend/domain/player"
+	vipDomain "github.com/slotmachine/backend/domain/vip"
 	"github.com/slotmachine/backend/internal/api/handler"
 	"github.com/slotmachine/backend/internal/api/middleware"
 	"github.com/slotmachine/backend/internal/config"
 	"github.com/slotmachine/backend/internal/db"
 	"github.com/slotmachine/backend/internal/game/engine"
+	"github.com/slotmachine/backend/internal/game/rng"
+	tuningmodes "github.com/slotmachine/backend/internal/game/tuning/modes"
+	"github.com/slotmachine/backend/internal/infra/analytics"
+	"github.com/slotmachine/backend/internal/infra/anchor"
+	"github.com/slotmachine/backend/internal/infra/kycprovider"
+	"github.com/slotmachine/backend/internal/infra/notifier"
 	"github.com/slotmachine/backend/internal/infra/repository"
 	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/infra/wallet"
 	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/i18n"
 	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/pkg/runtimeconfig"
 	"github.com/slotmachine/backend/internal/server"
 	"github.com/slotmachine/backend/internal/service"
 	"gorm.io/gorm"
@@ -26,40 +38,106 @@ import (
 type Application struct {
 	Config                       *config.Config
 	Logger                       *logger.Logger
+	Clock                        clock.Clock
 	DB                           *gorm.DB
+	DBRouter                     *db.Router
 	Cache                        *cache.Cache
 	App                          *fiber.App
+	Translator                   *i18n.Translator
 	RateLimiter                  *middleware.RateLimiter
 	TrialRateLimiter             *middleware.TrialRateLimiter // Security: DoS protection for trial mode
 	AuthHandler                  *handler.AuthHandler
 	PlayerHandler                *handler.PlayerHandler
+	StatsHandler                 *handler.StatsHandler
 	SessionHandler               *handler.SessionHandler
+	AutoplayHandler              *handler.AutoplayHandler
 	SpinHandler                  *handler.SpinHandler
 	FreeSpinsHandler             *handler.FreeSpinsHandler
 	ProvablyFairHandler          *handler.ProvablyFairHandler
 	ProvablyFairService          *service.ProvablyFairService
 	SpinService                  *service.SpinService      // For PF injection
 	FreeSpinsService             *service.FreeSpinsService // For PF injection
+	PFSessionSweeper             *service.PFSessionSweeper
+	SessionExpirySweeper         *service.SessionExpirySweeper
+	SpinReconciler               *service.SpinReconciler
+	PFChainAuditor               *service.PFChainAuditor
+	PFStateWriter                *service.PFStateWriter
+	SpinBatchWriter              *service.SpinBatchWriter
+	AnalyticsMirror              *service.AnalyticsMirror
+	PartitionMaintainer          *service.PartitionMaintainer
+	ArchiveWorker                *service.ArchiveWorker
+	FinancialReportWorker        *service.FinancialReportWorker
+	HistoryExportWorker          *service.HistoryExportWorker
+	ApprovalExpiryWorker         *service.ApprovalExpiryWorker
+	ReelStripCanaryMonitor       *service.ReelStripCanaryMonitor
+	RTPMonitor                   *service.RTPMonitor
+	ExposureMonitor              *service.ExposureMonitor
+	ReelStripCacheWarmer         *service.ReelStripCacheWarmer
+	RuntimeConfigWatcher         *runtimeconfig.Watcher
+	TransparencyPublisher        *service.TransparencyPublisher
+	JWTKeyring                   *service.JWTKeyring
 	AdminReelStripHandler        *handler.AdminReelStripHandler
+	AdminReelStripCanaryHandler  *handler.AdminReelStripCanaryHandler
+	AdminExposureHandler         *handler.AdminExposureHandler
+	AdminRuntimeConfigHandler    *handler.AdminRuntimeConfigHandler
 	AdminPlayerAssignmentHandler *handler.AdminPlayerAssignmentHandler
+	AdminSegmentHandler          *handler.AdminSegmentHandler
+	AdminVIPHandler              *handler.AdminVIPHandler
+	JurisdictionHandler          *handler.JurisdictionHandler
+	AdminJurisdictionHandler     *handler.AdminJurisdictionHandler
+	AdminBigWinHandler           *handler.AdminBigWinHandler
+	AdminArchiveHandler          *handler.AdminArchiveHandler
+	AdminFinancialReportHandler  *handler.AdminFinancialReportHandler
+	AdminNotificationHandler     *handler.AdminNotificationHandler
+	AdminSessionHandler          *handler.AdminSessionHandler
+	AdminImpersonationHandler    *handler.AdminImpersonationHandler
+	AdminPlayerNoteHandler       *handler.AdminPlayerNoteHandler
+	AdminDashboardHandler        *handler.AdminDashboardHandler
+	AdminSpinFeedHandler         *handler.AdminSpinFeedHandler
+	FeatureFlagHandler           *handler.FeatureFlagHandler
+	AdminFeatureFlagHandler      *handler.AdminFeatureFlagHandler
+	TranslationHandler           *handler.TranslationHandler
+	AdminTranslationHandler      *handler.AdminTranslationHandler
+	AdminTrialHandler            *handler.AdminTrialHandler
 	AdminAuthHandler             *handler.AdminAuthHandler
+	JWKSHandler                  *handler.JWKSHandler
+	HealthHandler                *handler.HealthHandler
+	SeedDataValidator            *service.SeedDataValidator
+	LaunchHandler                *handler.LaunchHandler
+	TransparencyHandler          *handler.TransparencyHandler
 	AdminManagementHandler       *handler.AdminManagementHandler
 	AdminPlayerHandler           *handler.AdminPlayerHandler
+	AdminGraphQLHandler          *handler.AdminGraphQLHandler
+	AdminTimelineHandler         *handler.AdminTimelineHandler
+	AdminDisputeHandler          *handler.AdminDisputeHandler
+	AdminApprovalHandler         *handler.AdminApprovalHandler
+	KYCHandler                   *handler.KYCHandler
+	AdminKYCHandler              *handler.AdminKYCHandler
+	PrivacyHandler               *handler.PrivacyHandler
+	HistoryExportHandler         *handler.HistoryExportHandler
+	NotificationHandler          *handler.NotificationHandler
+	BalanceHandler               *handler.BalanceHandler
+	AdminPrivacyHandler          *handler.AdminPrivacyHandler
+	AdminCertificationHandler    *handler.AdminCertificationHandler
 	GameHandler                  *handler.GameHandler
 	AdminGameHandler             *handler.AdminGameHandler
 	AdminUploadHandler           *handler.AdminUploadHandler
 	AdminChunkedUploadHandler    *handler.AdminChunkedUploadHandler
 	AdminDirectUploadHandler     *handler.AdminDirectUploadHandler
 	TrialHandler                 *handler.TrialHandler
+	TrialConversionHandler       *handler.TrialConversionHandler
 	// Trial-specific handlers (separate from production)
-	TrialSpinHandler             *handler.TrialSpinHandler
-	TrialFreeSpinsHandler        *handler.TrialFreeSpinsHandler
-	TrialSessionHandler          *handler.TrialSessionHandler
-	TrialPlayerHandler           *handler.TrialPlayerHandler
-	AdminService                 adminDomain.Service
-	PlayerService                playerDomain.Service
-	TrialService                 *service.TrialService
-	Storage                      storage.Storage
+	TrialSpinHandler      *handler.TrialSpinHandler
+	TrialFreeSpinsHandler *handler.TrialFreeSpinsHandler
+	TrialSessionHandler   *handler.TrialSessionHandler
+	TrialPlayerHandler    *handler.TrialPlayerHandler
+	AdminService          adminDomain.Service
+	DashboardService      dashboardDomain.Service
+	PlayerService         playerDomain.Service
+	TrialService          *service.TrialService
+	ImpersonationService  *service.ImpersonationService
+	VIPService            vipDomain.Service
+	Storage               storage.Storage
 }
 
 // InitializeApplication creates a fully initialized application using Wire
@@ -71,18 +149,42 @@ func InitializeApplication() (*Application, error) {
 		// Logger
 		logger.ProviderSet,
 
+		// Clock
+		clock.ProviderSet,
+
 		// Database
 		db.ProviderSet,
 
 		// Game Engine
 		engine.ProviderSet,
 
+		// Spin RNG provider
+		rng.ProviderSet,
+
+		// Analytics store
+		analytics.ProviderSet,
+
 		// Repositories
 		repository.ProviderSet,
 
 		// Storage
 		storage.ProviderSet,
 
+		// Notifiers
+		notifier.ProviderSet,
+
+		// Operator wallet integration
+		wallet.ProviderSet,
+
+		// Transparency log anchors
+		anchor.ProviderSet,
+
+		// KYC providers
+		kycprovider.ProviderSet,
+
+		// Reel strip rules
+		tuningmodes.ProviderSet,
+
 		// Services
 		service.ProviderSet,
 
@@ -98,6 +200,9 @@ func InitializeApplication() (*Application, error) {
 		// Middleware
 		middleware.ProviderSet,
 
+		// Localization
+		i18n.ProviderSet,
+
 		// Application struct
 		wire.Struct(new(Application), "*"),
 	)
@@ -116,6 +221,104 @@ func (a *Application) Shutdown() error {
 		a.Logger.Info().Msg("Fiber server shutdown complete")
 	}
 
+	// Stop background jobs before their dependencies close
+	if a.SessionExpirySweeper != nil {
+		a.SessionExpirySweeper.Stop()
+		a.Logger.Info().Msg("Session expiry sweeper stopped")
+	}
+
+	if a.PFSessionSweeper != nil {
+		a.PFSessionSweeper.Stop()
+		a.Logger.Info().Msg("PF session sweeper stopped")
+	}
+
+	if a.SpinReconciler != nil {
+		a.SpinReconciler.Stop()
+		a.Logger.Info().Msg("Spin reconciler stopped")
+	}
+
+	if a.PFChainAuditor != nil {
+		a.PFChainAuditor.Stop()
+		a.Logger.Info().Msg("PF chain auditor stopped")
+	}
+
+	// Commit queued spins before the PF state their after-commit hooks buffer is flushed
+	if a.SpinBatchWriter != nil {
+		a.SpinBatchWriter.Stop()
+		a.Logger.Info().Msg("Spin batch writer stopped")
+	}
+
+	if a.PFStateWriter != nil {
+		a.PFStateWriter.Stop()
+		a.Logger.Info().Msg("PF state writer stopped")
+	}
+
+	// Write the spin events settled spins left buffered
+	if a.AnalyticsMirror != nil {
+		a.AnalyticsMirror.Stop()
+		a.Logger.Info().Msg("Analytics mirror stopped")
+	}
+
+	if a.PartitionMaintainer != nil {
+		a.PartitionMaintainer.Stop()
+		a.Logger.Info().Msg("Partition maintainer stopped")
+	}
+
+	if a.ArchiveWorker != nil {
+		a.ArchiveWorker.Stop()
+		a.Logger.Info().Msg("Archive worker stopped")
+	}
+
+	if a.FinancialReportWorker != nil {
+		a.FinancialReportWorker.Stop()
+		a.Logger.Info().Msg("Financial report worker stopped")
+	}
+
+	if a.HistoryExportWorker != nil {
+		a.HistoryExportWorker.Stop()
+		a.Logger.Info().Msg("History export worker stopped")
+	}
+
+	if a.ApprovalExpiryWorker != nil {
+		a.ApprovalExpiryWorker.Stop()
+		a.Logger.Info().Msg("Change request expiry worker stopped")
+	}
+
+	if a.ReelStripCanaryMonitor != nil {
+		a.ReelStripCanaryMonitor.Stop()
+		a.Logger.Info().Msg("Reel strip canary monitor stopped")
+	}
+
+	if a.RTPMonitor != nil {
+		a.RTPMonitor.Stop()
+		a.Logger.Info().Msg("RTP monitor stopped")
+	}
+
+	if a.ExposureMonitor != nil {
+		a.ExposureMonitor.Stop()
+		a.Logger.Info().Msg("Exposure monitor stopped")
+	}
+
+	if a.ReelStripCacheWarmer != nil {
+		a.ReelStripCacheWarmer.Stop()
+		a.Logger.Info().Msg("Reel strip cache warmer stopped")
+	}
+
+	if a.RuntimeConfigWatcher != nil {
+		a.RuntimeConfigWatcher.Stop()
+		a.Logger.Info().Msg("Runtime config watcher stopped")
+	}
+
+	if a.TransparencyPublisher != nil {
+		a.TransparencyPublisher.Stop()
+		a.Logger.Info().Msg("Transparency publisher stopped")
+	}
+
+	if a.JWTKeyring != nil {
+		a.JWTKeyring.Stop()
+		a.Logger.Info().Msg("JWT keyring stopped")
+	}
+
 	// Close cache (which includes Redis pub/sub cleanup)
 	if a.Cache != nil {
 		a.Cache.Close()
@@ -123,6 +326,12 @@ func (a *Application) Shutdown() error {
 	}
 
 	// Close database connection
+	if a.DBRouter != nil {
+		if err := a.DBRouter.Close(a.Logger); err != nil {
+			a.Logger.Error().Err(err).Msg("Failed to close read replicas")
+		}
+	}
+
 	if a.DB != nil {
 		if err := db.Close(a.DB, a.Logger); err != nil {
 			a.Logger.Error().Err(err).Msg("Failed to close database")
@@ -9,16 +9,28 @@ package main
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/slotmachine/backend/domain/admin"
+	"github.com/slotmachine/backend/domain/dashboard"
 	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/vip"
 	"github.com/slotmachine/backend/internal/api/handler"
 	"github.com/slotmachine/backend/internal/api/middleware"
 	"github.com/slotmachine/backend/internal/config"
 	"github.com/slotmachine/backend/internal/db"
 	"github.com/slotmachine/backend/internal/game/engine"
+	"github.com/slotmachine/backend/internal/game/rng"
+	tuningmodes "github.com/slotmachine/backend/internal/game/tuning/modes"
+	"github.com/slotmachine/backend/internal/infra/analytics"
+	"github.com/slotmachine/backend/internal/infra/anchor"
+	"github.com/slotmachine/backend/internal/infra/kycprovider"
+	"github.com/slotmachine/backend/internal/infra/notifier"
 	"github.com/slotmachine/backend/internal/infra/repository"
 	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/infra/wallet"
 	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/i18n"
 	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/pkg/runtimeconfig"
 	"github.com/slotmachine/backend/internal/server"
 	"github.com/slotmachine/backend/internal/service"
 	"gorm.io/gorm"
@@ -33,98 +45,341 @@ func InitializeApplication() (*Application, error) {
 		return nil, err
 	}
 	loggerLogger := logger.ProvideLogger(configConfig)
+	clockClock, err := clock.ProvideClock(configConfig)
+	if err != nil {
+		return nil, err
+	}
 	gormDB, err := db.ProvideDatabase(configConfig, loggerLogger)
 	if err != nil {
 		return nil, err
 	}
+	router := db.ProvideRouter(configConfig, gormDB, loggerLogger)
 	cacheCache := cache.ProvideCache(configConfig, loggerLogger)
 	app := server.ProvideFiberApp(configConfig, loggerLogger)
-	rateLimiter := middleware.ProvideRateLimiter(configConfig, loggerLogger)
+	segmentRepository := repository.NewSegmentGormRepository(gormDB)
+	playerRepository := repository.ProvidePlayerRepository(router)
+	reelstripRepository := repository.NewReelStripGormRepository(gormDB, cacheCache)
+	clickHouse, err := analytics.ProvideClickHouse(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	analyticsStore := analytics.ProvideStore(configConfig, clickHouse)
+	canaryRepository := repository.ProvideReelStripCanaryRepository(gormDB, cacheCache, analyticsStore)
+	vipRepository := repository.NewVIPGormRepository(gormDB)
+	vipService := service.NewVIPService(vipRepository, cacheCache, configConfig, loggerLogger)
+	segmentService := service.ProvideSegmentService(segmentRepository, playerRepository, reelstripRepository, cacheCache, vipService, loggerLogger)
+	rateLimiter := middleware.ProvideRateLimiter(configConfig, segmentService, loggerLogger)
 	redisClient := cache.ProvideRedisClient(configConfig, loggerLogger)
 	trialRateLimiter := middleware.ProvideTrialRateLimiter(configConfig, redisClient, loggerLogger)
-	playerRepository := repository.NewPlayerGormRepository(gormDB)
 	gameRepository := repository.NewGameGormRepository(gormDB)
 	playerSessionRepository := repository.NewPlayerSessionGormRepository(gormDB)
-	playerService := service.NewPlayerService(playerRepository, gameRepository, playerSessionRepository, redisClient, configConfig, loggerLogger)
+	playernoteRepository := repository.NewPlayerNoteGormRepository(gormDB)
+	refreshTokenStore := cache.ProvideRefreshTokenStore(redisClient)
+	playerService := service.NewPlayerService(playerRepository, gameRepository, playerSessionRepository, redisClient, refreshTokenStore, clockClock, configConfig, loggerLogger)
 	authHandler := handler.NewAuthHandler(playerService, loggerLogger)
 	playerHandler := handler.NewPlayerHandler(playerService, loggerLogger)
-	sessionRepository := repository.NewSessionGormRepository(gormDB)
-	sessionService := service.NewSessionService(sessionRepository, playerRepository, loggerLogger)
+	sessionRepository := repository.ProvideSessionRepository(router)
+	jurisdictionRepository := repository.NewJurisdictionGormRepository(gormDB)
+	jurisdictionService := service.NewJurisdictionService(jurisdictionRepository, configConfig, loggerLogger)
+	txManager := repository.NewTxManager(gormDB)
+	sessionService := service.ProvideSessionService(sessionRepository, playerRepository, jurisdictionService, txManager, clockClock, loggerLogger)
 	sessionHandler := handler.NewSessionHandler(sessionService, loggerLogger)
-	spinRepository := repository.NewSpinGormRepository(gormDB)
-	reelstripRepository := repository.NewReelStripGormRepository(gormDB, cacheCache)
-	reelstripService := service.NewReelStripService(reelstripRepository, loggerLogger)
-	gameEngine := engine.ProvideGameEngine(cacheCache, reelstripService)
+	spinRepository := repository.ProvideSpinRepository(router)
+	launchRepository := repository.NewLaunchGormRepository(gormDB)
+	reelstripService := service.ProvideReelStripService(reelstripRepository, segmentService, launchRepository, canaryRepository, cacheCache, clockClock, loggerLogger)
+	gameEngine := engine.ProvideGameEngine(cacheCache, reelstripService, clockClock)
+	gameRulesService := service.ProvideGameRulesService(playerRepository, gameRepository, cacheCache, gameEngine, loggerLogger)
 	freespinsRepository := repository.NewFreeSpinsGormRepository(gormDB)
-	txManager := repository.NewTxManager(gormDB)
 	provablyFairGormRepository := repository.NewProvablyFairGormRepository(gormDB)
 	pfSessionCache := cache.ProvidePFSessionCache(redisClient, loggerLogger)
-	provablyFairService, err := service.ProvideProvablyFairService(provablyFairGormRepository, pfSessionCache, reelstripRepository, configConfig, loggerLogger)
+	pfStateWriter := service.ProvidePFStateWriter(configConfig, provablyFairGormRepository, loggerLogger)
+	provider, err := rng.ProvideProvider(configConfig)
 	if err != nil {
 		return nil, err
 	}
-	spinService := service.ProvideSpinService(spinRepository, playerRepository, sessionRepository, gameEngine, freespinsRepository, reelstripRepository, txManager, provablyFairService, loggerLogger)
-	spinHandler := handler.NewSpinHandler(spinService, loggerLogger)
-	freeSpinsService := service.ProvideFreeSpinsService(sessionRepository, freespinsRepository, spinRepository, playerRepository, gameEngine, provablyFairService, loggerLogger)
-	freeSpinsHandler := handler.NewFreeSpinsHandler(freeSpinsService, loggerLogger)
+	provablyFairService, err := service.ProvideProvablyFairService(provablyFairGormRepository, pfSessionCache, reelstripRepository, pfStateWriter, launchRepository, provider, clockClock, configConfig, loggerLogger)
+	if err != nil {
+		return nil, err
+	}
+	pfSessionSweeper := service.NewPFSessionSweeper(configConfig, provablyFairService, clockClock, loggerLogger)
+	chainAlertNotifier := notifier.ProvideChainAlertNotifier(configConfig)
+	pfChainAuditor := service.NewPFChainAuditor(configConfig, provablyFairService, chainAlertNotifier, loggerLogger)
+	partitionManager := repository.NewPartitionManager(gormDB)
+	partitionMaintainer := service.NewPartitionMaintainer(configConfig, partitionManager, clockClock, loggerLogger)
+	statsRepository := repository.ProvideStatsRepository(router)
+	statsService := service.NewStatsService(statsRepository, loggerLogger)
+	bigwinRepository := repository.ProvideBigWinRepository(router)
+	bigwinNotifier := notifier.ProvideBigWinNotifier(configConfig)
+	bigWinService := service.NewBigWinService(bigwinRepository, bigwinNotifier, configConfig, loggerLogger)
+	spinFeedStore := cache.ProvideSpinFeedStore(redisClient, loggerLogger)
+	spinFeedService := service.ProvideSpinFeedService(spinFeedStore, configConfig, loggerLogger)
+	dashboardStore := cache.ProvideDashboardStore(redisClient, loggerLogger)
+	dashboardService := service.ProvideDashboardService(dashboardStore, configConfig, loggerLogger)
+	featureFlagService, err := service.ProvideFeatureFlagService(configConfig, redisClient, segmentService, loggerLogger)
+	if err != nil {
+		return nil, err
+	}
+	kycRepository := repository.NewKYCGormRepository(gormDB)
+	kycProvider, err := kycprovider.ProvideProvider(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	kycService := service.NewKYCService(configConfig, kycRepository, playerRepository, kycProvider, loggerLogger)
+	certificationRepository := repository.NewCertificationGormRepository(gormDB)
+	certificationService := service.NewCertificationService(configConfig, certificationRepository, loggerLogger)
+	divergenceNotifier := notifier.ProvideDivergenceNotifier(configConfig)
+	shadowEngine := service.ProvideShadowEngine(configConfig, reelstripService, cacheCache, gameRulesService, divergenceNotifier, loggerLogger)
+	autoplayRepository := repository.NewAutoplayGormRepository(gormDB)
+	autoplayService := service.NewAutoplayService(configConfig, autoplayRepository, sessionRepository, loggerLogger)
+	locker := cache.ProvideBalanceLock(redisClient, configConfig, loggerLogger)
+	exposureRepository := repository.NewExposureGormRepository(gormDB)
+	exposureService := service.NewExposureService(configConfig, exposureRepository, loggerLogger)
+	spinBatchWriter := service.NewSpinBatchWriter(configConfig, txManager, loggerLogger)
+	sink := analytics.ProvideSink(clickHouse)
+	analyticsMirror := service.NewAnalyticsMirror(configConfig, sink, loggerLogger)
+	spinService := service.ProvideSpinService(spinRepository, playerRepository, sessionRepository, gameEngine, freespinsRepository, reelstripRepository, txManager, provablyFairService, segmentService, vipService, statsService, bigWinService, spinFeedService, jurisdictionService, kycService, featureFlagService, certificationService, shadowEngine, autoplayService, locker, exposureService, spinBatchWriter, analyticsMirror, dashboardService, clockClock, configConfig, loggerLogger)
+	ed25519Signer, err := handler.ProvideSpinSigner(configConfig, loggerLogger)
+	if err != nil {
+		return nil, err
+	}
+	spinReconciler := service.NewSpinReconciler(configConfig, spinService, clockClock, loggerLogger)
+	statsHandler := handler.NewStatsHandler(statsService, loggerLogger)
+	freeSpinsService := service.ProvideFreeSpinsService(sessionRepository, freespinsRepository, spinRepository, playerRepository, gameEngine, provablyFairService, statsService, bigWinService, spinFeedService, featureFlagService, certificationService, shadowEngine, analyticsMirror, dashboardService, txManager, configConfig, loggerLogger)
+	freeSpinsHandler := handler.NewFreeSpinsHandler(freeSpinsService, ed25519Signer, loggerLogger)
+	autoplayHandler := handler.NewAutoplayHandler(autoplayService, loggerLogger)
+	spinHandler := handler.NewSpinHandler(spinService, freeSpinsService, ed25519Signer, loggerLogger)
 	provablyFairHandler := handler.NewProvablyFairHandler(provablyFairService, loggerLogger)
-	adminReelStripHandler := handler.NewAdminReelStripHandler(reelstripService, loggerLogger, cacheCache)
-	adminPlayerAssignmentHandler := handler.NewAdminPlayerAssignmentHandler(reelstripService, loggerLogger, cacheCache)
-	adminRepository := repository.NewAdminGormRepository(gormDB)
-	adminService := service.NewAdminService(adminRepository, playerRepository, reelstripRepository, gameRepository, playerSessionRepository, redisClient, configConfig, loggerLogger)
-	adminAuthHandler := handler.NewAdminAuthHandler(adminService, loggerLogger)
-	adminManagementHandler := handler.NewAdminManagementHandler(adminService, loggerLogger)
-	adminPlayerHandler := handler.NewAdminPlayerHandler(adminService, loggerLogger)
-	gameHandler := handler.NewGameHandler(gameRepository, loggerLogger)
+	validator := tuningmodes.NewValidator()
+	simulator := tuningmodes.NewSimulator()
+	generator := tuningmodes.NewGenerator()
+	approvalRepository := repository.NewApprovalGormRepository(gormDB)
+	balanceRepository := repository.NewBalanceGormRepository(gormDB)
+	notificationRepository := repository.NewNotificationGormRepository(gormDB)
+	notificationStore := cache.ProvideNotificationEventStore(redisClient, loggerLogger)
+	notificationService := service.NewNotificationService(notificationRepository, notificationStore, loggerLogger)
+	balanceStore := cache.ProvideBalanceEventStore(redisClient, loggerLogger)
+	balanceService := service.NewBalanceService(balanceRepository, balanceStore, launchRepository, segmentService, notificationService, loggerLogger)
+	approvalNotifier := notifier.ProvideApprovalNotifier(configConfig)
+	canaryNotifier := notifier.ProvideCanaryNotifier(configConfig)
+	reelStripCanaryService := service.NewReelStripCanaryService(configConfig, canaryRepository, reelstripService, cacheCache, canaryNotifier, loggerLogger)
+	approvalService := service.NewApprovalService(configConfig, approvalRepository, reelstripService, reelStripCanaryService, gameRulesService, balanceService, cacheCache, approvalNotifier, clockClock, loggerLogger)
+	adminReelStripHandler := handler.NewAdminReelStripHandler(reelstripService, validator, simulator, generator, approvalService, loggerLogger, cacheCache)
+	adminReelStripCanaryHandler := handler.NewAdminReelStripCanaryHandler(reelStripCanaryService, approvalService, loggerLogger)
+	adminExposureHandler := handler.NewAdminExposureHandler(exposureService, loggerLogger)
+	watcher := server.ProvideRuntimeConfigWatcher(configConfig, rateLimiter, featureFlagService, jurisdictionService, loggerLogger)
+	adminRuntimeConfigHandler := handler.NewAdminRuntimeConfigHandler(watcher, loggerLogger)
+	adminPlayerAssignmentHandler := handler.NewAdminPlayerAssignmentHandler(reelstripService, approvalService, loggerLogger, cacheCache)
+	adminSegmentHandler := handler.NewAdminSegmentHandler(segmentService, loggerLogger)
+	adminVIPHandler := handler.NewAdminVIPHandler(vipService, loggerLogger)
 	storageStorage, err := storage.ProvideStorage(configConfig)
 	if err != nil {
 		return nil, err
 	}
-	adminGameHandler := handler.NewAdminGameHandler(gameRepository, storageStorage, loggerLogger)
-	adminUploadHandler := handler.NewAdminUploadHandler(storageStorage, loggerLogger)
-	adminChunkedUploadHandler := handler.NewAdminChunkedUploadHandler(storageStorage, loggerLogger, redisClient)
-	adminDirectUploadHandler := handler.NewAdminDirectUploadHandler(storageStorage, loggerLogger)
-	trialService := service.ProvideTrialService(redisClient, loggerLogger)
+	translator, err := i18n.NewFromConfig(configConfig, storageStorage, loggerLogger)
+	if err != nil {
+		return nil, err
+	}
+	archiveRepository := repository.NewArchiveGormRepository(gormDB)
+	archiveService := service.NewArchiveService(configConfig, archiveRepository, partitionManager, storageStorage, clockClock, loggerLogger)
+	adminArchiveHandler := handler.NewAdminArchiveHandler(archiveService, loggerLogger)
+	archiveWorker := service.NewArchiveWorker(configConfig, archiveService, clockClock, loggerLogger)
+	financialReportRepository := repository.NewFinancialReportGormRepository(gormDB)
+	financialReportService := service.NewFinancialReportService(configConfig, financialReportRepository, clockClock, loggerLogger)
+	adminFinancialReportHandler := handler.NewAdminFinancialReportHandler(financialReportService, loggerLogger)
+	adminNotificationHandler := handler.NewAdminNotificationHandler(notificationService, loggerLogger)
+	freeSpinsSettlementRepository := repository.NewFreeSpinsSettlementGormRepository(gormDB)
+	sessionExpiryService := service.NewSessionExpiryService(configConfig, sessionService, sessionRepository, freespinsRepository, freeSpinsService, freeSpinsSettlementRepository, playerRepository, clockClock, loggerLogger)
+	adminSessionHandler := handler.NewAdminSessionHandler(sessionExpiryService, loggerLogger)
+	impersonationRepository := repository.NewImpersonationGormRepository(gormDB)
+	impersonationService := service.NewImpersonationService(configConfig, impersonationRepository, playerRepository, loggerLogger)
+	adminImpersonationHandler := handler.NewAdminImpersonationHandler(impersonationService, loggerLogger)
+	playerNoteService := service.NewPlayerNoteService(playernoteRepository, playerRepository, loggerLogger)
+	adminPlayerNoteHandler := handler.NewAdminPlayerNoteHandler(playerNoteService, loggerLogger)
+	sessionExpirySweeper := service.NewSessionExpirySweeper(configConfig, sessionExpiryService, clockClock, loggerLogger)
+	financialReportWorker := service.NewFinancialReportWorker(configConfig, financialReportService, clockClock, loggerLogger)
+	transparencyRepository := repository.NewTransparencyGormRepository(gormDB)
+	transparencyAnchor, err := anchor.ProvideAnchor(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	transparencyService := service.NewTransparencyService(configConfig, transparencyRepository, transparencyAnchor, loggerLogger)
+	transparencyPublisher := service.NewTransparencyPublisher(configConfig, transparencyService, loggerLogger)
+	jurisdictionHandler := handler.NewJurisdictionHandler(jurisdictionService, playerService, translator, loggerLogger)
+	adminJurisdictionHandler := handler.NewAdminJurisdictionHandler(jurisdictionService, loggerLogger)
+	adminBigWinHandler := handler.NewAdminBigWinHandler(bigWinService, loggerLogger)
+	adminDashboardHandler := handler.NewAdminDashboardHandler(dashboardService, loggerLogger)
+	adminSpinFeedHandler := handler.NewAdminSpinFeedHandler(spinFeedService, loggerLogger)
+	featureFlagHandler := handler.NewFeatureFlagHandler(featureFlagService, loggerLogger)
+	adminFeatureFlagHandler := handler.NewAdminFeatureFlagHandler(featureFlagService, loggerLogger)
+	translationHandler := handler.NewTranslationHandler(translator, loggerLogger)
+	adminTranslationHandler := handler.NewAdminTranslationHandler(translator, loggerLogger)
+	adminRepository := repository.ProvideAdminRepository(router)
+	signingkeyRepository := repository.NewSigningKeyGormRepository(gormDB)
+	jwtKeyring, err := service.NewJWTKeyring(configConfig, signingkeyRepository, loggerLogger)
+	if err != nil {
+		return nil, err
+	}
+	adminService := service.NewAdminService(adminRepository, playerRepository, reelstripRepository, gameRepository, playerSessionRepository, playernoteRepository, redisClient, refreshTokenStore, jwtKeyring, configConfig, loggerLogger)
+	adminAuthHandler := handler.NewAdminAuthHandler(adminService, loggerLogger)
+	jwksHandler := handler.NewJWKSHandler(jwtKeyring, loggerLogger)
+	healthService := service.NewHealthService(configConfig, gormDB, redisClient, storageStorage, reelstripRepository, loggerLogger)
+	healthHandler := handler.NewHealthHandler(healthService, loggerLogger)
+	seedDataValidator := service.NewSeedDataValidator(adminRepository, reelstripRepository)
+	adminManagementHandler := handler.NewAdminManagementHandler(adminService, loggerLogger)
+	adminPlayerHandler := handler.NewAdminPlayerHandler(adminService, loggerLogger)
+	adminGraphQLHandler := handler.NewAdminGraphQLHandler(playerRepository, sessionRepository, spinRepository, reelstripService, gameRepository, loggerLogger)
+	timelineRepository := repository.ProvideTimelineRepository(router)
+	timelineService := service.NewTimelineService(timelineRepository, playerRepository, loggerLogger)
+	adminTimelineHandler := handler.NewAdminTimelineHandler(timelineService, loggerLogger)
+	disputeRepository := repository.NewDisputeGormRepository(gormDB)
+	disputeService := service.NewDisputeService(disputeRepository, spinRepository, provablyFairService, loggerLogger)
+	adminDisputeHandler := handler.NewAdminDisputeHandler(disputeService, loggerLogger)
+	adminApprovalHandler := handler.NewAdminApprovalHandler(approvalService, loggerLogger)
+	kycHandler := handler.NewKYCHandler(kycService, loggerLogger)
+	adminKYCHandler := handler.NewAdminKYCHandler(kycService, loggerLogger)
+	privacyRepository := repository.NewPrivacyGormRepository(gormDB)
+	privacyService := service.NewPrivacyService(privacyRepository, sessionRepository, playerService, loggerLogger)
+	privacyHandler := handler.NewPrivacyHandler(privacyService, loggerLogger)
+	adminPrivacyHandler := handler.NewAdminPrivacyHandler(privacyService, loggerLogger)
+	historyExportRepository := repository.NewHistoryExportGormRepository(gormDB)
+	historyExportService := service.NewHistoryExportService(configConfig, historyExportRepository, spinRepository, playerRepository, storageStorage, clockClock, loggerLogger)
+	historyExportHandler := handler.NewHistoryExportHandler(historyExportService, loggerLogger)
+	notificationHandler := handler.NewNotificationHandler(notificationService, loggerLogger)
+	historyExportWorker := service.NewHistoryExportWorker(configConfig, historyExportService, clockClock, loggerLogger)
+	approvalExpiryWorker := service.NewApprovalExpiryWorker(configConfig, approvalService, clockClock, loggerLogger)
+	reelStripCanaryMonitor := service.NewReelStripCanaryMonitor(configConfig, reelStripCanaryService, loggerLogger)
+	rtpAlertRepository := repository.ProvideRTPAlertRepository(gormDB, analyticsStore)
+	rtpAlertNotifier := notifier.ProvideRTPAlertNotifier(configConfig)
+	rtpMonitor := service.NewRTPMonitor(configConfig, reelstripService, rtpAlertRepository, rtpAlertNotifier, loggerLogger)
+	exposureMonitor := service.NewExposureMonitor(configConfig, exposureService, loggerLogger)
+	reelStripCacheWarmer := service.NewReelStripCacheWarmer(configConfig, reelstripRepository, canaryRepository, cacheCache, loggerLogger)
+	balanceHandler := handler.NewBalanceHandler(balanceService, notificationService, loggerLogger)
+	adminCertificationHandler := handler.NewAdminCertificationHandler(certificationService, loggerLogger)
+	gameHandler := handler.NewGameHandler(gameRepository, storageStorage, gameRulesService, translator, loggerLogger)
+	processingStatusStore := cache.ProvideProcessingStatusStore(redisClient)
+	assetFileService := service.NewAssetFileService(gameRepository, storageStorage, loggerLogger)
+	assetImageWorker := service.NewAssetImageWorker(configConfig, gameRepository, storageStorage, processingStatusStore, assetFileService, loggerLogger)
+	adminGameHandler := handler.NewAdminGameHandler(gameRepository, storageStorage, assetImageWorker, assetFileService, gameRulesService, approvalService, loggerLogger)
+	adminUploadHandler := handler.NewAdminUploadHandler(storageStorage, assetFileService, loggerLogger)
+	adminChunkedUploadHandler := handler.NewAdminChunkedUploadHandler(storageStorage, loggerLogger, processingStatusStore, assetFileService, clockClock)
+	adminDirectUploadHandler := handler.NewAdminDirectUploadHandler(storageStorage, assetFileService, loggerLogger)
+	settingsRepository := repository.NewTrialSettingsGormRepository(gormDB)
+	trialService := service.ProvideTrialService(redisClient, settingsRepository, reelstripService, featureFlagService, loggerLogger)
+	adminTrialHandler := handler.NewAdminTrialHandler(trialService, loggerLogger)
+	conversionRepository := repository.NewTrialConversionGormRepository(gormDB)
+	trialConversionService := service.NewTrialConversionService(trialService, playerService, conversionRepository, txManager, loggerLogger)
+	trialConversionHandler := handler.NewTrialConversionHandler(trialConversionService, loggerLogger)
+	launchWallet := wallet.ProvideWallet(configConfig)
+	grantStore := cache.ProvideLaunchGrantStore(redisClient)
+	launchService := service.NewLaunchService(launchWallet, launchRepository, grantStore, playerService, playerRepository, gameRepository, configConfig, loggerLogger)
+	launchHandler := handler.NewLaunchHandler(launchService, loggerLogger)
+	transparencyHandler := handler.NewTransparencyHandler(transparencyService, loggerLogger)
 	trialHandler := handler.NewTrialHandler(trialService, trialRateLimiter, loggerLogger)
-	trialSpinHandler := handler.NewTrialSpinHandler(spinService, loggerLogger)
-	trialFreeSpinsHandler := handler.NewTrialFreeSpinsHandler(trialService, gameEngine, loggerLogger)
+	trialSpinHandler := handler.NewTrialSpinHandler(spinService, ed25519Signer, loggerLogger)
+	trialFreeSpinsHandler := handler.NewTrialFreeSpinsHandler(trialService, gameEngine, ed25519Signer, loggerLogger)
 	trialSessionHandler := handler.NewTrialSessionHandler(loggerLogger)
 	trialPlayerHandler := handler.NewTrialPlayerHandler(loggerLogger)
 	application := &Application{
 		Config:                       configConfig,
 		Logger:                       loggerLogger,
+		Clock:                        clockClock,
 		DB:                           gormDB,
+		DBRouter:                     router,
 		Cache:                        cacheCache,
 		App:                          app,
+		Translator:                   translator,
 		RateLimiter:                  rateLimiter,
 		TrialRateLimiter:             trialRateLimiter,
 		AuthHandler:                  authHandler,
 		PlayerHandler:                playerHandler,
+		StatsHandler:                 statsHandler,
 		SessionHandler:               sessionHandler,
+		AutoplayHandler:              autoplayHandler,
 		SpinHandler:                  spinHandler,
 		FreeSpinsHandler:             freeSpinsHandler,
 		ProvablyFairHandler:          provablyFairHandler,
 		ProvablyFairService:          provablyFairService,
 		SpinService:                  spinService,
 		FreeSpinsService:             freeSpinsService,
+		PFSessionSweeper:             pfSessionSweeper,
+		SessionExpirySweeper:         sessionExpirySweeper,
+		SpinReconciler:               spinReconciler,
+		PFChainAuditor:               pfChainAuditor,
+		PFStateWriter:                pfStateWriter,
+		SpinBatchWriter:              spinBatchWriter,
+		AnalyticsMirror:              analyticsMirror,
+		PartitionMaintainer:          partitionMaintainer,
+		ArchiveWorker:                archiveWorker,
+		FinancialReportWorker:        financialReportWorker,
+		HistoryExportWorker:          historyExportWorker,
+		ApprovalExpiryWorker:         approvalExpiryWorker,
+		ReelStripCanaryMonitor:       reelStripCanaryMonitor,
+		RTPMonitor:                   rtpMonitor,
+		ExposureMonitor:              exposureMonitor,
+		ReelStripCacheWarmer:         reelStripCacheWarmer,
+		RuntimeConfigWatcher:         watcher,
+		TransparencyPublisher:        transparencyPublisher,
+		JWTKeyring:                   jwtKeyring,
 		AdminReelStripHandler:        adminReelStripHandler,
+		AdminReelStripCanaryHandler:  adminReelStripCanaryHandler,
+		AdminExposureHandler:         adminExposureHandler,
+		AdminRuntimeConfigHandler:    adminRuntimeConfigHandler,
 		AdminPlayerAssignmentHandler: adminPlayerAssignmentHandler,
+		AdminSegmentHandler:          adminSegmentHandler,
+		AdminVIPHandler:              adminVIPHandler,
+		JurisdictionHandler:          jurisdictionHandler,
+		AdminJurisdictionHandler:     adminJurisdictionHandler,
+		AdminBigWinHandler:           adminBigWinHandler,
+		AdminArchiveHandler:          adminArchiveHandler,
+		AdminFinancialReportHandler:  adminFinancialReportHandler,
+		AdminNotificationHandler:     adminNotificationHandler,
+		AdminSessionHandler:          adminSessionHandler,
+		AdminImpersonationHandler:    adminImpersonationHandler,
+		AdminPlayerNoteHandler:       adminPlayerNoteHandler,
+		AdminDashboardHandler:        adminDashboardHandler,
+		AdminSpinFeedHandler:         adminSpinFeedHandler,
+		FeatureFlagHandler:           featureFlagHandler,
+		AdminFeatureFlagHandler:      adminFeatureFlagHandler,
+		TranslationHandler:           translationHandler,
+		AdminTranslationHandler:      adminTranslationHandler,
+		AdminTrialHandler:            adminTrialHandler,
 		AdminAuthHandler:             adminAuthHandler,
+		JWKSHandler:                  jwksHandler,
+		HealthHandler:                healthHandler,
+		SeedDataValidator:            seedDataValidator,
+		LaunchHandler:                launchHandler,
+		TransparencyHandler:          transparencyHandler,
 		AdminManagementHandler:       adminManagementHandler,
 		AdminPlayerHandler:           adminPlayerHandler,
+		AdminGraphQLHandler:          adminGraphQLHandler,
+		AdminTimelineHandler:         adminTimelineHandler,
+		AdminDisputeHandler:          adminDisputeHandler,
+		AdminApprovalHandler:         adminApprovalHandler,
+		KYCHandler:                   kycHandler,
+		AdminKYCHandler:              adminKYCHandler,
+		PrivacyHandler:               privacyHandler,
+		HistoryExportHandler:         historyExportHandler,
+		NotificationHandler:          notificationHandler,
+		BalanceHandler:               balanceHandler,
+		AdminPrivacyHandler:          adminPrivacyHandler,
+		AdminCertificationHandler:    adminCertificationHandler,
 		GameHandler:                  gameHandler,
 		AdminGameHandler:             adminGameHandler,
 		AdminUploadHandler:           adminUploadHandler,
 		AdminChunkedUploadHandler:    adminChunkedUploadHandler,
 		AdminDirectUploadHandler:     adminDirectUploadHandler,
 		TrialHandler:                 trialHandler,
+		TrialConversionHandler:       trialConversionHandler,
 		TrialSpinHandler:             trialSpinHandler,
 		TrialFreeSpinsHandler:        trialFreeSpinsHandler,
 		TrialSessionHandler:          trialSessionHandler,
 		TrialPlayerHandler:           trialPlayerHandler,
 		AdminService:                 adminService,
+		DashboardService:             dashboardService,
 		PlayerService:                playerService,
 		TrialService:                 trialService,
+		ImpersonationService:         impersonationService,
+		VIPService:                   vipService,
 		Storage:                      storageStorage,
 	}
 	return application, nil
@@ -136,39 +391,105 @@ func InitializeApplication() (*Application, error) {
 type Application struct {
 	Config                       *config.Config
 	Logger                       *logger.Logger
+	Clock                        clock.Clock
 	DB                           *gorm.DB
+	DBRouter                     *db.Router
 	Cache                        *cache.Cache
 	App                          *fiber.App
+	Translator                   *i18n.Translator
 	RateLimiter                  *middleware.RateLimiter
 	TrialRateLimiter             *middleware.TrialRateLimiter // Security: DoS protection for trial mode
 	AuthHandler                  *handler.AuthHandler
 	PlayerHandler                *handler.PlayerHandler
+	StatsHandler                 *handler.StatsHandler
 	SessionHandler               *handler.SessionHandler
+	AutoplayHandler              *handler.AutoplayHandler
 	SpinHandler                  *handler.SpinHandler
 	FreeSpinsHandler             *handler.FreeSpinsHandler
 	ProvablyFairHandler          *handler.ProvablyFairHandler
 	ProvablyFairService          *service.ProvablyFairService
 	SpinService                  *service.SpinService      // For PF injection
 	FreeSpinsService             *service.FreeSpinsService // For PF injection
+	PFSessionSweeper             *service.PFSessionSweeper
+	SessionExpirySweeper         *service.SessionExpirySweeper
+	SpinReconciler               *service.SpinReconciler
+	PFChainAuditor               *service.PFChainAuditor
+	PFStateWriter                *service.PFStateWriter
+	SpinBatchWriter              *service.SpinBatchWriter
+	AnalyticsMirror              *service.AnalyticsMirror
+	PartitionMaintainer          *service.PartitionMaintainer
+	ArchiveWorker                *service.ArchiveWorker
+	FinancialReportWorker        *service.FinancialReportWorker
+	HistoryExportWorker          *service.HistoryExportWorker
+	ApprovalExpiryWorker         *service.ApprovalExpiryWorker
+	ReelStripCanaryMonitor       *service.ReelStripCanaryMonitor
+	RTPMonitor                   *service.RTPMonitor
+	ExposureMonitor              *service.ExposureMonitor
+	ReelStripCacheWarmer         *service.ReelStripCacheWarmer
+	RuntimeConfigWatcher         *runtimeconfig.Watcher
+	TransparencyPublisher        *service.TransparencyPublisher
+	JWTKeyring                   *service.JWTKeyring
 	AdminReelStripHandler        *handler.AdminReelStripHandler
+	AdminReelStripCanaryHandler  *handler.AdminReelStripCanaryHandler
+	AdminExposureHandler         *handler.AdminExposureHandler
+	AdminRuntimeConfigHandler    *handler.AdminRuntimeConfigHandler
 	AdminPlayerAssignmentHandler *handler.AdminPlayerAssignmentHandler
+	AdminSegmentHandler          *handler.AdminSegmentHandler
+	AdminVIPHandler              *handler.AdminVIPHandler
+	JurisdictionHandler          *handler.JurisdictionHandler
+	AdminJurisdictionHandler     *handler.AdminJurisdictionHandler
+	AdminBigWinHandler           *handler.AdminBigWinHandler
+	AdminArchiveHandler          *handler.AdminArchiveHandler
+	AdminFinancialReportHandler  *handler.AdminFinancialReportHandler
+	AdminNotificationHandler     *handler.AdminNotificationHandler
+	AdminSessionHandler          *handler.AdminSessionHandler
+	AdminImpersonationHandler    *handler.AdminImpersonationHandler
+	AdminPlayerNoteHandler       *handler.AdminPlayerNoteHandler
+	AdminDashboardHandler        *handler.AdminDashboardHandler
+	AdminSpinFeedHandler         *handler.AdminSpinFeedHandler
+	FeatureFlagHandler           *handler.FeatureFlagHandler
+	AdminFeatureFlagHandler      *handler.AdminFeatureFlagHandler
+	TranslationHandler           *handler.TranslationHandler
+	AdminTranslationHandler      *handler.AdminTranslationHandler
+	AdminTrialHandler            *handler.AdminTrialHandler
 	AdminAuthHandler             *handler.AdminAuthHandler
+	JWKSHandler                  *handler.JWKSHandler
+	HealthHandler                *handler.HealthHandler
+	SeedDataValidator            *service.SeedDataValidator
+	LaunchHandler                *handler.LaunchHandler
+	TransparencyHandler          *handler.TransparencyHandler
 	AdminManagementHandler       *handler.AdminManagementHandler
 	AdminPlayerHandler           *handler.AdminPlayerHandler
+	AdminGraphQLHandler          *handler.AdminGraphQLHandler
+	AdminTimelineHandler         *handler.AdminTimelineHandler
+	AdminDisputeHandler          *handler.AdminDisputeHandler
+	AdminApprovalHandler         *handler.AdminApprovalHandler
+	KYCHandler                   *handler.KYCHandler
+	AdminKYCHandler              *handler.AdminKYCHandler
+	PrivacyHandler               *handler.PrivacyHandler
+	HistoryExportHandler         *handler.HistoryExportHandler
+	NotificationHandler          *handler.NotificationHandler
+	BalanceHandler               *handler.BalanceHandler
+	AdminPrivacyHandler          *handler.AdminPrivacyHandler
+	AdminCertificationHandler    *handler.AdminCertificationHandler
 	GameHandler                  *handler.GameHandler
 	AdminGameHandler             *handler.AdminGameHandler
 	AdminUploadHandler           *handler.AdminUploadHandler
 	AdminChunkedUploadHandler    *handler.AdminChunkedUploadHandler
 	AdminDirectUploadHandler     *handler.AdminDirectUploadHandler
 	TrialHandler                 *handler.TrialHandler
+	TrialConversionHandler       *handler.TrialConversionHandler
 	// Trial-specific handlers (separate from production)
 	TrialSpinHandler      *handler.TrialSpinHandler
 	TrialFreeSpinsHandler *handler.TrialFreeSpinsHandler
 	TrialSessionHandler   *handler.TrialSessionHandler
 	TrialPlayerHandler    *handler.TrialPlayerHandler
 	AdminService          admin.Service
+	DashboardService      dashboard.Service
 	PlayerService         player.Service
 	TrialService          *service.TrialService
+	ImpersonationService  *service.ImpersonationService
+	VIPService            vip.Service
 	Storage               storage.Storage
 }
 
@@ -182,11 +503,114 @@ func (a *Application) Shutdown() error {
 		a.Logger.Info().Msg("Fiber server shutdown complete")
 	}
 
+	if a.SessionExpirySweeper != nil {
+		a.SessionExpirySweeper.Stop()
+		a.Logger.Info().Msg("Session expiry sweeper stopped")
+	}
+
+	if a.PFSessionSweeper != nil {
+		a.PFSessionSweeper.Stop()
+		a.Logger.Info().Msg("PF session sweeper stopped")
+	}
+
+	if a.SpinReconciler != nil {
+		a.SpinReconciler.Stop()
+		a.Logger.Info().Msg("Spin reconciler stopped")
+	}
+
+	if a.PFChainAuditor != nil {
+		a.PFChainAuditor.Stop()
+		a.Logger.Info().Msg("PF chain auditor stopped")
+	}
+
+	// Commit queued spins before the PF state their after-commit hooks buffer is flushed
+	if a.SpinBatchWriter != nil {
+		a.SpinBatchWriter.Stop()
+		a.Logger.Info().Msg("Spin batch writer stopped")
+	}
+
+	if a.PFStateWriter != nil {
+		a.PFStateWriter.Stop()
+		a.Logger.Info().Msg("PF state writer stopped")
+	}
+
+	// Write the spin events settled spins left buffered
+	if a.AnalyticsMirror != nil {
+		a.AnalyticsMirror.Stop()
+		a.Logger.Info().Msg("Analytics mirror stopped")
+	}
+
+	if a.PartitionMaintainer != nil {
+		a.PartitionMaintainer.Stop()
+		a.Logger.Info().Msg("Partition maintainer stopped")
+	}
+
+	if a.ArchiveWorker != nil {
+		a.ArchiveWorker.Stop()
+		a.Logger.Info().Msg("Archive worker stopped")
+	}
+
+	if a.FinancialReportWorker != nil {
+		a.FinancialReportWorker.Stop()
+		a.Logger.Info().Msg("Financial report worker stopped")
+	}
+
+	if a.HistoryExportWorker != nil {
+		a.HistoryExportWorker.Stop()
+		a.Logger.Info().Msg("History export worker stopped")
+	}
+
+	if a.ApprovalExpiryWorker != nil {
+		a.ApprovalExpiryWorker.Stop()
+		a.Logger.Info().Msg("Change request expiry worker stopped")
+	}
+
+	if a.ReelStripCanaryMonitor != nil {
+		a.ReelStripCanaryMonitor.Stop()
+		a.Logger.Info().Msg("Reel strip canary monitor stopped")
+	}
+
+	if a.RTPMonitor != nil {
+		a.RTPMonitor.Stop()
+		a.Logger.Info().Msg("RTP monitor stopped")
+	}
+
+	if a.ExposureMonitor != nil {
+		a.ExposureMonitor.Stop()
+		a.Logger.Info().Msg("Exposure monitor stopped")
+	}
+
+	if a.ReelStripCacheWarmer != nil {
+		a.ReelStripCacheWarmer.Stop()
+		a.Logger.Info().Msg("Reel strip cache warmer stopped")
+	}
+
+	if a.RuntimeConfigWatcher != nil {
+		a.RuntimeConfigWatcher.Stop()
+		a.Logger.Info().Msg("Runtime config watcher stopped")
+	}
+
+	if a.TransparencyPublisher != nil {
+		a.TransparencyPublisher.Stop()
+		a.Logger.Info().Msg("Transparency publisher stopped")
+	}
+
+	if a.JWTKeyring != nil {
+		a.JWTKeyring.Stop()
+		a.Logger.Info().Msg("JWT keyring stopped")
+	}
+
 	if a.Cache != nil {
 		a.Cache.Close()
 		a.Logger.Info().Msg("Cache closed")
 	}
 
+	if a.DBRouter != nil {
+		if err := a.DBRouter.Close(a.Logger); err != nil {
+			a.Logger.Error().Err(err).Msg("Failed to close read replicas")
+		}
+	}
+
 	if a.DB != nil {
 		if err := db.Close(a.DB, a.Logger); err != nil {
 			a.Logger.Error().Err(err).Msg("Failed to close database")
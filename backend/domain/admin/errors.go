@@ -41,4 +41,16 @@ var (
 
 	// ErrCannotModifySuperAdmin is returned when trying to modify super admin without permission
 	ErrCannotModifySuperAdmin = errors.New("cannot modify super admin account")
+
+	// ErrTwoFactorAlreadyEnabled is returned when enrolling an admin who already has 2FA on
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+
+	// ErrTwoFactorNotEnrolled is returned when confirming without a pending enrollment
+	ErrTwoFactorNotEnrolled = errors.New("no two-factor enrollment in progress")
+
+	// ErrTwoFactorNotEnabled is returned when verifying for an admin without 2FA
+	ErrTwoFactorNotEnabled = errors.New("two-factor authentication is not enabled")
+
+	// ErrInvalidTwoFactorCode is returned for a wrong, expired, reused or already-spent code
+	ErrInvalidTwoFactorCode = errors.New("invalid two-factor code")
 )
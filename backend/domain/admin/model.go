@@ -44,6 +44,22 @@ const (
 	RoleOperator   AdminRole = "operator"    // Limited operational access
 )
 
+// Read permissions checked field by field by the admin GraphQL gateway
+// Admins and super admins hold all of them; operators only those granted in Permissions.
+const (
+	PermissionPlayersRead    = "players:read"
+	PermissionPlayersPII     = "players:pii" // Email addresses
+	PermissionBalancesRead   = "balances:read"
+	PermissionSessionsRead   = "sessions:read"
+	PermissionSpinsRead      = "spins:read"
+	PermissionReelStripsRead = "reel_strips:read"
+	PermissionAssetsRead     = "assets:read"
+)
+
+// PermissionPlayersImpersonate allows issuing read-only impersonation tokens for players
+// Checked with CanAccess, so admins need it granted explicitly; super admins always hold it.
+const PermissionPlayersImpersonate = "players:impersonate"
+
 // AdminStatus represents the status of an admin account
 type AdminStatus string
 
@@ -74,6 +90,13 @@ type Admin struct {
 	FailedLoginAttempts int        `gorm:"default:0" json:"-"`
 	LockedUntil         *time.Time `gorm:"type:timestamp with time zone" json:"locked_until,omitempty"`
 
+	// Two-factor authentication (TOTP)
+	TOTPSecret          string     `gorm:"column:totp_secret;type:text" json:"-"` // Encrypted; set from enrollment until disabled
+	TwoFactorEnabled    bool       `gorm:"default:false" json:"two_factor_enabled"`
+	TwoFactorEnabledAt  *time.Time `gorm:"type:timestamp with time zone" json:"two_factor_enabled_at,omitempty"`
+	TwoFactorLastStep   int64      `gorm:"default:0" json:"-"` // Last accepted TOTP step, for replay protection
+	TwoFactorVerifiedAt *time.Time `gorm:"type:timestamp with time zone" json:"-"`
+
 	// Audit
 	CreatedAt time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
 	UpdatedAt time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
@@ -117,6 +140,11 @@ func (a *Admin) CanAccess(permission string) bool {
 	return false
 }
 
+// CanRead checks if admin may read data guarded by a read permission
+func (a *Admin) CanRead(permission string) bool {
+	return a.HasRole(RoleAdmin) || a.CanAccess(permission)
+}
+
 // HasRole checks if admin has a specific role or higher
 func (a *Admin) HasRole(role AdminRole) bool {
 	if a.Role == RoleSuperAdmin {
@@ -145,9 +173,63 @@ func (a *Admin) IncrementFailedAttempts() {
 	}
 }
 
+// TwoFactorVerifiedWithin reports whether the second factor was verified within window of now
+func (a *Admin) TwoFactorVerifiedWithin(window time.Duration, now time.Time) bool {
+	return a.TwoFactorEnabled && a.TwoFactorVerifiedAt != nil && now.Sub(*a.TwoFactorVerifiedAt) < window
+}
+
 // Sanitize removes sensitive information before returning to client
 func (a *Admin) Sanitize() *Admin {
 	a.PasswordHash = ""
+	a.TOTPSecret = ""
 	a.FailedLoginAttempts = 0
 	return a
 }
+
+// TwoFactorEnrollment is a pending TOTP enrollment for the admin to add to an authenticator app
+type TwoFactorEnrollment struct {
+	Secret     string // Base32 secret, for manual entry
+	OTPAuthURL string // otpauth:// URL, for QR codes
+}
+
+// RecoveryCode is a single-use code that stands in for a TOTP code
+type RecoveryCode struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	AdminID   uuid.UUID  `gorm:"type:uuid;not null"`
+	CodeHash  string     `gorm:"type:varchar(64);not null"` // SHA-256 hex of the normalized code
+	UsedAt    *time.Time `gorm:"type:timestamp with time zone"`
+	CreatedAt time.Time  `gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+// TableName specifies the table name for GORM
+func (RecoveryCode) TableName() string {
+	return "admin_recovery_codes"
+}
+
+// TwoFactorEventType is the kind of a two-factor audit event
+type TwoFactorEventType string
+
+const (
+	TwoFactorEnrollmentStarted  TwoFactorEventType = "enrollment_started"
+	TwoFactorEnabled            TwoFactorEventType = "enabled"
+	TwoFactorDisabled           TwoFactorEventType = "disabled"
+	TwoFactorVerified           TwoFactorEventType = "verified"
+	TwoFactorVerifyFailed       TwoFactorEventType = "verify_failed"
+	TwoFactorRecoveryCodeUsed   TwoFactorEventType = "recovery_code_used"
+	TwoFactorRecoveryCodesReset TwoFactorEventType = "recovery_codes_regenerated"
+)
+
+// TwoFactorEvent is an audit record of a two-factor enrollment or verification
+type TwoFactorEvent struct {
+	ID        uuid.UUID          `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	AdminID   uuid.UUID          `gorm:"type:uuid;not null" json:"admin_id"`
+	Event     TwoFactorEventType `gorm:"type:varchar(50);not null" json:"event"`
+	IPAddress string             `gorm:"type:varchar(45)" json:"ip_address,omitempty"`
+	Detail    string             `gorm:"type:varchar(255)" json:"detail,omitempty"` // e.g. the operation a step-up unlocked
+	CreatedAt time.Time          `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (TwoFactorEvent) TableName() string {
+	return "admin_two_factor_events"
+}
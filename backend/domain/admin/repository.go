@@ -37,6 +37,35 @@ type Repository interface {
 
 	// ResetFailedAttempts resets failed login attempts
 	ResetFailedAttempts(ctx context.Context, id uuid.UUID) error
+
+	// SetTOTPSecret stores a pending (not yet enabled) encrypted TOTP secret
+	SetTOTPSecret(ctx context.Context, id uuid.UUID, encryptedSecret string) error
+
+	// EnableTwoFactor turns 2FA on with the confirming code's step and replaces the recovery codes
+	EnableTwoFactor(ctx context.Context, id uuid.UUID, step int64, recoveryCodeHashes []string) error
+
+	// DisableTwoFactor turns 2FA off, clearing the secret and recovery codes
+	DisableTwoFactor(ctx context.Context, id uuid.UUID) error
+
+	// AcceptTOTPStep records a verified TOTP step if it is newer than the last one accepted
+	// Returns false for a replayed step.
+	AcceptTOTPStep(ctx context.Context, id uuid.UUID, step int64) (bool, error)
+
+	// UseRecoveryCode spends an unused recovery code and records the verification
+	// Returns false if no unused code has that hash.
+	UseRecoveryCode(ctx context.Context, id uuid.UUID, codeHash string) (bool, error)
+
+	// ReplaceRecoveryCodes discards an admin's recovery codes and stores new ones
+	ReplaceRecoveryCodes(ctx context.Context, id uuid.UUID, codeHashes []string) error
+
+	// CountUnusedRecoveryCodes counts an admin's remaining recovery codes
+	CountUnusedRecoveryCodes(ctx context.Context, id uuid.UUID) (int64, error)
+
+	// CreateTwoFactorEvent records a two-factor audit event
+	CreateTwoFactorEvent(ctx context.Context, event *TwoFactorEvent) error
+
+	// ListTwoFactorEvents returns an admin's most recent two-factor events, newest first
+	ListTwoFactorEvents(ctx context.Context, id uuid.UUID, limit int) ([]*TwoFactorEvent, error)
 }
 
 // ListFilters represents filters for listing admins
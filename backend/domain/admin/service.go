@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -23,6 +24,15 @@ type Service interface {
 	ChangePassword(ctx context.Context, adminID uuid.UUID, oldPassword, newPassword string) error
 	ResetPassword(ctx context.Context, adminID uuid.UUID, newPassword string, resetBy uuid.UUID) error
 
+	// Two-Factor Authentication
+	BeginTwoFactorEnrollment(ctx context.Context, adminID uuid.UUID, ip string) (*TwoFactorEnrollment, error)
+	ConfirmTwoFactorEnrollment(ctx context.Context, adminID uuid.UUID, code, ip string) ([]string, error)
+	VerifyTwoFactor(ctx context.Context, adminID uuid.UUID, code, ip, detail string) (time.Time, error)
+	DisableTwoFactor(ctx context.Context, adminID uuid.UUID, code, ip string) error
+	RegenerateRecoveryCodes(ctx context.Context, adminID uuid.UUID, code, ip string) ([]string, error)
+	GetTwoFactorStatus(ctx context.Context, adminID uuid.UUID) (*TwoFactorStatus, error)
+	ListTwoFactorEvents(ctx context.Context, adminID uuid.UUID, limit int) ([]*TwoFactorEvent, error)
+
 	// Status Management
 	ActivateAdmin(ctx context.Context, id uuid.UUID, updatedBy uuid.UUID) error
 	DeactivateAdmin(ctx context.Context, id uuid.UUID, updatedBy uuid.UUID) error
@@ -32,11 +42,20 @@ type Service interface {
 	CreatePlayer(ctx context.Context, req CreatePlayerRequest, createdBy uuid.UUID) (interface{}, error)
 	GetPlayer(ctx context.Context, id uuid.UUID) (interface{}, error)
 	ListPlayers(ctx context.Context, filters PlayerListFilters) (interface{}, int64, error)
+	SearchPlayers(ctx context.Context, filters PlayerSearchFilters) (interface{}, string, error)
 	ActivatePlayer(ctx context.Context, playerID uuid.UUID, updatedBy uuid.UUID) error
 	DeactivatePlayer(ctx context.Context, playerID uuid.UUID, updatedBy uuid.UUID) error
 	ForceLogoutPlayer(ctx context.Context, playerID uuid.UUID, adminID uuid.UUID) error
 }
 
+// TwoFactorStatus summarises an admin's two-factor state
+type TwoFactorStatus struct {
+	Enabled                bool
+	EnabledAt              *time.Time
+	VerifiedUntil          *time.Time // End of the current step-up window, if any
+	RecoveryCodesRemaining int64
+}
+
 // CreateAdminRequest represents a request to create an admin
 type CreateAdminRequest struct {
 	Username    string
@@ -75,3 +94,24 @@ type PlayerListFilters struct {
 	SortBy   string
 	SortDesc bool
 }
+
+// PlayerSearchFilters represents filters for searching players
+// Results are paged by the opaque Cursor returned with the previous page.
+type PlayerSearchFilters struct {
+	Username         string
+	Email            string
+	GameID           *uuid.UUID
+	IsActive         *bool
+	MinBalance       *float64
+	MaxBalance       *float64
+	RegisteredFrom   *time.Time
+	RegisteredTo     *time.Time
+	LastLoginFrom    *time.Time
+	LastLoginTo      *time.Time
+	Tags             []string
+	AssignedConfigID *uuid.UUID
+	SortBy           string
+	SortDesc         bool
+	Cursor           string
+	Limit            int
+}
@@ -0,0 +1,50 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/spin"
+)
+
+// SpinEvent is a settled spin as mirrored into the analytics store
+type SpinEvent struct {
+	SpinID             uuid.UUID `json:"spin_id"`
+	PlayerID           uuid.UUID `json:"player_id"`
+	SessionID          uuid.UUID `json:"session_id"`
+	ReelStripConfigID  uuid.UUID `json:"reel_strip_config_id"` // uuid.Nil for generated strips
+	GameMode           string    `json:"game_mode"`
+	BetAmount          float64   `json:"bet_amount"`
+	Stake              float64   `json:"stake"` // What the spin cost, or the locked bet for free spins
+	TotalWin           float64   `json:"total_win"`
+	IsFreeSpin         bool      `json:"is_free_spin"`
+	FreeSpinsTriggered bool      `json:"free_spins_triggered"`
+	ScatterCount       int       `json:"scatter_count"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// NewSpinEvent builds the event for a settled spin played on the given reel strip config
+func NewSpinEvent(s *spin.Spin, reelStripConfigID *uuid.UUID) *SpinEvent {
+	e := &SpinEvent{
+		SpinID:             s.ID,
+		PlayerID:           s.PlayerID,
+		SessionID:          s.SessionID,
+		BetAmount:          s.BetAmount,
+		Stake:              s.BetAmount,
+		TotalWin:           s.TotalWin,
+		IsFreeSpin:         s.IsFreeSpin,
+		FreeSpinsTriggered: s.FreeSpinsTriggered,
+		ScatterCount:       s.ScatterCount,
+		CreatedAt:          s.CreatedAt,
+	}
+	if reelStripConfigID != nil {
+		e.ReelStripConfigID = *reelStripConfigID
+	}
+	if s.GameMode != nil {
+		e.GameMode = *s.GameMode
+	}
+	if s.GameModeCost != nil {
+		e.Stake = *s.GameModeCost
+	}
+	return e
+}
@@ -0,0 +1,31 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+)
+
+// Publisher mirrors settled spins into the analytics store
+type Publisher interface {
+	// Publish queues the event without waiting for the store; it never fails the spin
+	Publish(ctx context.Context, event *SpinEvent)
+}
+
+// Sink writes spin events to an OLAP store
+type Sink interface {
+	// Name identifies the sink in logs
+	Name() string
+	// Write inserts a batch of events; writing the same batch again must not count it twice
+	Write(ctx context.Context, events []*SpinEvent) error
+}
+
+// Store answers the aggregate queries over spins that are too heavy for the transactional database
+type Store interface {
+	// ConfigPerformance sums the stakes and wins of spins played on a config since a time
+	ConfigPerformance(ctx context.Context, configID uuid.UUID, since time.Time) (*reelstrip.CanaryPerformance, error)
+	// ConfigSample sums the stakes, wins and per-spin returns of paid spins played on a config in [since, until)
+	ConfigSample(ctx context.Context, configID uuid.UUID, since, until time.Time) (*reelstrip.RTPSample, error)
+}
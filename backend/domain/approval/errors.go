@@ -0,0 +1,26 @@
+package approval
+
+import "errors"
+
+var (
+	// ErrChangeRequestNotFound is returned when a change request does not exist
+	ErrChangeRequestNotFound = errors.New("change request not found")
+
+	// ErrNotPending is returned when a change request was already reviewed or expired
+	ErrNotPending = errors.New("change request is not pending")
+
+	// ErrExpired is returned when a change request is reviewed after its expiry
+	ErrExpired = errors.New("change request has expired")
+
+	// ErrSelfApproval is returned when an admin approves their own change request
+	ErrSelfApproval = errors.New("change request must be approved by a different admin")
+
+	// ErrInvalidAction is returned when a change request has an unknown action
+	ErrInvalidAction = errors.New("unknown change request action")
+
+	// ErrInvalidParams is returned when a change request's params do not fit its action
+	ErrInvalidParams = errors.New("invalid change request params")
+
+	// ErrApplyFailed is returned when an approved change could not be applied
+	ErrApplyFailed = errors.New("approved change could not be applied")
+)
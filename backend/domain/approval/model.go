@@ -0,0 +1,161 @@
+package approval
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/reelstrip"
+)
+
+// Action is a change that needs a second admin's approval before it takes effect
+type Action string
+
+const (
+	ActionActivateReelStripConfig   Action = "reel_strip_config.activate"
+	ActionSetDefaultReelStripConfig Action = "reel_strip_config.set_default"
+	ActionSetOperatorDefault        Action = "operator_reel_strip_default.set" // Changes the operator's target RTP
+	ActionAdjustBalance             Action = "balance.adjust"
+	ActionStartReelStripCanary      Action = "reel_strip_canary.start"  // Promotes the config to default if the trial passes
+	ActionUpdateReelStripConfig     Action = "reel_strip_config.update" // Edits the config's details, its target RTP among them
+	ActionAssignPlayerReelStrips    Action = "player_reel_strip_assignment.assign"
+	ActionSetGameConfigMultipliers  Action = "game_config.multipliers.set"
+	ActionSetGameConfigTriggerRules Action = "game_config.trigger_rules.set"
+	ActionSetGameConfigWildFeatures Action = "game_config.wild_features.set"
+)
+
+// Valid reports whether a is a known action
+func (a Action) Valid() bool {
+	switch a {
+	case ActionActivateReelStripConfig, ActionSetDefaultReelStripConfig, ActionSetOperatorDefault, ActionAdjustBalance, ActionStartReelStripCanary,
+		ActionUpdateReelStripConfig, ActionAssignPlayerReelStrips,
+		ActionSetGameConfigMultipliers, ActionSetGameConfigTriggerRules, ActionSetGameConfigWildFeatures:
+		return true
+	}
+	return false
+}
+
+// Status is the state of a change request
+// pending -> approved | rejected | expired; an approved change that could not be applied is failed.
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved" // Approved and applied
+	StatusRejected Status = "rejected"
+	StatusExpired  Status = "expired" // Not reviewed before its expiry
+	StatusFailed   Status = "failed"  // Approved, but applying it failed
+)
+
+// Valid reports whether s is a known status
+func (s Status) Valid() bool {
+	switch s {
+	case StatusPending, StatusApproved, StatusRejected, StatusExpired, StatusFailed:
+		return true
+	}
+	return false
+}
+
+// Params are the arguments of a change, applied as proposed once approved
+// Only the fields of the change request's action are set.
+type Params struct {
+	// Reel strip configs
+	ConfigID *uuid.UUID `json:"config_id,omitempty"`
+	GameMode string     `json:"game_mode,omitempty"`
+
+	// Reel strip canaries of ConfigID; proposals fill in the configured defaults
+	CanaryPercent   int     `json:"canary_percent,omitempty"`
+	MaxRTPDeviation float64 `json:"max_rtp_deviation,omitempty"`
+	MinSpins        int64   `json:"min_spins,omitempty"`
+	TrialHours      int     `json:"trial_hours,omitempty"`
+
+	// Reel strip config edits of ConfigID
+	ConfigUpdate *reelstrip.ConfigUpdate `json:"config_update,omitempty"`
+
+	// Operator defaults
+	OperatorID        string     `json:"operator_id,omitempty"`
+	TargetRTP         float64    `json:"target_rtp,omitempty"`
+	BaseGameConfigID  *uuid.UUID `json:"base_game_config_id,omitempty"`
+	FreeSpinsConfigID *uuid.UUID `json:"free_spins_config_id,omitempty"`
+
+	// Player assignments of PlayerID to BaseGameConfigID and FreeSpinsConfigID, with Reason
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Game config rules of GameConfigID; the rule of the action left nil resets it to the default
+	GameConfigID     *uuid.UUID             `json:"game_config_id,omitempty"`
+	MultiplierLadder *game.MultiplierLadder `json:"multiplier_ladder,omitempty"`
+	TriggerRules     *game.TriggerRules     `json:"trigger_rules,omitempty"`
+	WildFeatures     *game.WildFeatures     `json:"wild_features,omitempty"`
+
+	// Balance adjustments; a negative amount debits the player
+	PlayerID *uuid.UUID `json:"player_id,omitempty"`
+	Amount   float64    `json:"amount,omitempty"`
+	Reason   string     `json:"reason,omitempty"`
+}
+
+// Scan implements the sql.Scanner interface for Params
+func (p *Params) Scan(value interface{}) error {
+	switch b := value.(type) {
+	case []byte:
+		return json.Unmarshal(b, p)
+	case string:
+		return json.Unmarshal([]byte(b), p)
+	case nil:
+		return nil
+	}
+	return errors.New("failed to scan change request params")
+}
+
+// Value implements the driver.Valuer interface for Params
+func (p *Params) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return json.Marshal(p)
+}
+
+// ChangeRequest is a proposed admin change awaiting, or given, a second admin's review
+type ChangeRequest struct {
+	ID      uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Action  Action    `gorm:"type:varchar(50);not null" json:"action"`
+	Params  *Params   `gorm:"type:jsonb;not null" json:"params"`
+	Summary string    `gorm:"type:text;not null" json:"summary"` // Human readable description for reviewers
+	Status  Status    `gorm:"type:varchar(20);not null;index" json:"status"`
+
+	ProposedBy     uuid.UUID  `gorm:"type:uuid;not null;index" json:"proposed_by"`
+	ProposedByName string     `gorm:"type:varchar(100);not null" json:"proposed_by_name"`
+	ReviewedBy     *uuid.UUID `gorm:"type:uuid" json:"reviewed_by,omitempty"`
+	ReviewedByName string     `gorm:"type:varchar(100)" json:"reviewed_by_name,omitempty"`
+	ReviewNote     string     `gorm:"type:text" json:"review_note,omitempty"`
+	ReviewedAt     *time.Time `json:"reviewed_at,omitempty"`
+
+	Error     string     `gorm:"type:text" json:"error,omitempty"` // Why an approved change could not be applied
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+
+	ExpiresAt time.Time `gorm:"not null" json:"expires_at"`
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (ChangeRequest) TableName() string {
+	return "change_requests"
+}
+
+// Actor is the admin proposing or reviewing a change
+type Actor struct {
+	ID       uuid.UUID
+	Username string
+}
+
+// ListFilters represents filters for listing change requests
+type ListFilters struct {
+	Status     Status
+	Action     Action
+	ProposedBy *uuid.UUID
+	Page       int
+	Limit      int
+}
@@ -0,0 +1,22 @@
+package approval
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for change request persistence
+type Repository interface {
+	Create(ctx context.Context, cr *ChangeRequest) error
+	GetByID(ctx context.Context, id uuid.UUID) (*ChangeRequest, error)
+	List(ctx context.Context, filters ListFilters) ([]*ChangeRequest, int64, error)
+	// Update saves a change request
+	Update(ctx context.Context, cr *ChangeRequest) error
+	// Review saves a change request moving out of pending; ErrNotPending if it was already reviewed,
+	// so two admins reviewing at once cannot both apply it
+	Review(ctx context.Context, cr *ChangeRequest) error
+	// ListStale lists up to limit pending change requests that expired before now, oldest first
+	ListStale(ctx context.Context, now time.Time, limit int) ([]*ChangeRequest, error)
+}
@@ -0,0 +1,32 @@
+package approval
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for four-eyes approval of admin changes
+type Service interface {
+	// Required reports whether reel strip changes must be proposed instead of applied directly
+	Required() bool
+	// Propose records a pending change; nothing is applied until another admin approves it
+	Propose(ctx context.Context, action Action, params *Params, proposer Actor) (*ChangeRequest, error)
+	Get(ctx context.Context, id uuid.UUID) (*ChangeRequest, error)
+	List(ctx context.Context, filters ListFilters) ([]*ChangeRequest, int64, error)
+
+	// Approve applies a pending change; the reviewer must not be its proposer
+	Approve(ctx context.Context, id uuid.UUID, reviewer Actor, note string) (*ChangeRequest, error)
+	// Reject discards a pending change; a proposer rejecting their own change withdraws it
+	Reject(ctx context.Context, id uuid.UUID, reviewer Actor, note string) (*ChangeRequest, error)
+	// ExpireStale expires pending changes past their expiry and returns how many it expired
+	ExpireStale(ctx context.Context) (int, error)
+}
+
+// Notifier tells admins about proposed and reviewed changes
+type Notifier interface {
+	// Name identifies the notifier in logs
+	Name() string
+	// NotifyChangeRequest delivers the change request in its current status
+	NotifyChangeRequest(ctx context.Context, cr *ChangeRequest) error
+}
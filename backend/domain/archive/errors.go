@@ -0,0 +1,17 @@
+package archive
+
+import "errors"
+
+var (
+	// ErrArchiveNotFound is returned when an archive record does not exist
+	ErrArchiveNotFound = errors.New("archive not found")
+
+	// ErrAlreadyRestored is returned when restoring an archive whose rows are already in the hot DB
+	ErrAlreadyRestored = errors.New("archive already restored")
+
+	// ErrNotRestored is returned when evicting an archive that is not restored
+	ErrNotRestored = errors.New("archive not restored")
+
+	// ErrChecksumMismatch is returned when an archive object does not match its recorded checksum
+	ErrChecksumMismatch = errors.New("archive checksum mismatch")
+)
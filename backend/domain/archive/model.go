@@ -0,0 +1,35 @@
+package archive
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Archive records one spin table partition exported to cold storage and dropped from the hot DB
+type Archive struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	SourceTable   string     `gorm:"not null" json:"source_table"`
+	PartitionName string     `gorm:"uniqueIndex;not null" json:"partition_name"`
+	RangeStart    *time.Time `json:"range_start,omitempty"` // nil for the legacy partition (unbounded)
+	RangeEnd      time.Time  `gorm:"not null" json:"range_end"`
+	ObjectName    string     `gorm:"not null" json:"object_name"`
+	RowCount      int64      `gorm:"not null" json:"row_count"`
+	SizeBytes     int64      `gorm:"not null" json:"size_bytes"`
+	Checksum      string     `gorm:"not null" json:"checksum"` // SHA-256 of the compressed object
+	ArchivedAt    time.Time  `gorm:"not null" json:"archived_at"`
+	RestoredAt    *time.Time `json:"restored_at,omitempty"` // Set while the rows are restored for an audit
+	RestoredBy    string     `json:"restored_by,omitempty"`
+}
+
+// TableName specifies the table name for GORM
+func (Archive) TableName() string {
+	return "spin_archives"
+}
+
+// ListFilters filters the archive list
+type ListFilters struct {
+	SourceTable string
+	Page        int
+	Limit       int
+}
@@ -0,0 +1,18 @@
+package archive
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for archive record data access
+type Repository interface {
+	Create(ctx context.Context, a *Archive) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Archive, error)
+	GetByPartition(ctx context.Context, partitionName string) (*Archive, error)
+	List(ctx context.Context, filters ListFilters) ([]*Archive, int64, error) // newest range first
+	MarkRestored(ctx context.Context, id uuid.UUID, at time.Time, by string) error
+	ClearRestored(ctx context.Context, id uuid.UUID) error
+}
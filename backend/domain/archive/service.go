@@ -0,0 +1,23 @@
+package archive
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for spin archival
+type Service interface {
+	// ArchiveExpired exports and drops every partition that ended before the retention
+	// window relative to now, returning the archives it created
+	ArchiveExpired(ctx context.Context, now time.Time) ([]*Archive, error)
+
+	GetArchive(ctx context.Context, id uuid.UUID) (*Archive, error)
+	ListArchives(ctx context.Context, filters ListFilters) ([]*Archive, int64, error)
+
+	// Restore loads an archive back into its partition for an audit
+	Restore(ctx context.Context, id uuid.UUID, restoredBy string) (*Archive, error)
+	// Evict drops a restored archive's partition again; the archive object is kept
+	Evict(ctx context.Context, id uuid.UUID) (*Archive, error)
+}
@@ -0,0 +1,20 @@
+package autoplay
+
+import "errors"
+
+var (
+	// ErrAutoplayNotFound is returned when a session has no running autoplay
+	ErrAutoplayNotFound = errors.New("autoplay not found")
+
+	// ErrAutoplayNotActive is returned when an autoplay spin is played without a running autoplay
+	ErrAutoplayNotActive = errors.New("autoplay is not running for this session")
+
+	// ErrAutoplayActive is returned when autoplay is started while it is already running
+	ErrAutoplayActive = errors.New("autoplay is already running for this session")
+
+	// ErrLossLimitReached is returned when the next spin could take losses past the loss limit
+	ErrLossLimitReached = errors.New("autoplay loss limit reached")
+
+	// ErrInvalidLimits is returned when autoplay is started with invalid stop conditions
+	ErrInvalidLimits = errors.New("invalid autoplay limits")
+)
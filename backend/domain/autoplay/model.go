@@ -0,0 +1,119 @@
+package autoplay
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status is the state of an autoplay contract
+type Status string
+
+const (
+	StatusActive  Status = "active"
+	StatusStopped Status = "stopped"
+)
+
+// StopReason records why an autoplay contract stopped
+type StopReason string
+
+const (
+	StopSpinsCompleted StopReason = "spins_completed" // Every requested spin was played
+	StopSingleWin      StopReason = "single_win"      // A spin won at least the single win limit
+	StopLossLimit      StopReason = "loss_limit"      // Net loss reached, or the next spin could exceed, the loss limit
+	StopCancelled      StopReason = "cancelled"       // Stopped by the player
+)
+
+// Limits are the stop conditions a player sets when starting autoplay
+type Limits struct {
+	Spins          int      // Number of spins to play
+	SingleWinLimit *float64 // Optional: stop after a spin wins at least this amount
+	LossLimit      *float64 // Optional: stop before net loss could exceed this amount
+}
+
+// Validate checks the limits, allowing at most maxSpins spins
+func (l Limits) Validate(maxSpins int) error {
+	if l.Spins <= 0 || l.Spins > maxSpins {
+		return fmt.Errorf("%w: spins must be between 1 and %d", ErrInvalidLimits, maxSpins)
+	}
+	if l.SingleWinLimit != nil && *l.SingleWinLimit <= 0 {
+		return fmt.Errorf("%w: single win limit must be positive", ErrInvalidLimits)
+	}
+	if l.LossLimit != nil && *l.LossLimit <= 0 {
+		return fmt.Errorf("%w: loss limit must be positive", ErrInvalidLimits)
+	}
+	return nil
+}
+
+// Contract is a server-tracked autoplay run within a game session
+// Autoplay spins are counted against it as they settle, and stop being accepted once a stop
+// condition is met, whatever the client asks for.
+type Contract struct {
+	ID             uuid.UUID   `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	PlayerID       uuid.UUID   `gorm:"type:uuid;not null;index" json:"player_id"`
+	SessionID      uuid.UUID   `gorm:"type:uuid;not null;index" json:"session_id"`
+	TotalSpins     int         `gorm:"not null" json:"total_spins"`
+	SingleWinLimit *float64    `gorm:"type:decimal(15,2)" json:"single_win_limit,omitempty"`
+	LossLimit      *float64    `gorm:"type:decimal(15,2)" json:"loss_limit,omitempty"`
+	SpinsPlayed    int         `gorm:"not null;default:0" json:"spins_played"`
+	TotalWagered   float64     `gorm:"type:decimal(15,2);not null;default:0" json:"total_wagered"`
+	TotalWon       float64     `gorm:"type:decimal(15,2);not null;default:0" json:"total_won"`
+	Status         Status      `gorm:"type:varchar(20);not null;index" json:"status"`
+	StopReason     *StopReason `gorm:"type:varchar(20)" json:"stop_reason,omitempty"`
+	StoppedAt      *time.Time  `json:"stopped_at,omitempty"`
+	CreatedAt      time.Time   `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt      time.Time   `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Contract) TableName() string {
+	return "autoplay_contracts"
+}
+
+// NetLoss is the amount wagered and not won back so far; negative when ahead
+func (c *Contract) NetLoss() float64 {
+	return c.TotalWagered - c.TotalWon
+}
+
+// RemainingSpins is the number of spins left to play
+func (c *Contract) RemainingSpins() int {
+	if c.Status != StatusActive {
+		return 0
+	}
+	return c.TotalSpins - c.SpinsPlayed
+}
+
+// CheckSpin returns ErrLossLimitReached when losing a spin of bet could take net loss past the loss limit
+func (c *Contract) CheckSpin(bet float64) error {
+	if c.Status != StatusActive {
+		return ErrAutoplayNotActive
+	}
+	if c.LossLimit != nil && c.NetLoss()+bet > *c.LossLimit {
+		return ErrLossLimitReached
+	}
+	return nil
+}
+
+// RecordSpin counts a settled spin and stops the contract when a stop condition is met
+func (c *Contract) RecordSpin(bet, win float64, now time.Time) {
+	c.SpinsPlayed++
+	c.TotalWagered += bet
+	c.TotalWon += win
+
+	switch {
+	case c.SingleWinLimit != nil && win >= *c.SingleWinLimit:
+		c.Stop(StopSingleWin, now)
+	case c.LossLimit != nil && c.NetLoss() >= *c.LossLimit:
+		c.Stop(StopLossLimit, now)
+	case c.SpinsPlayed >= c.TotalSpins:
+		c.Stop(StopSpinsCompleted, now)
+	}
+}
+
+// Stop ends the contract for reason
+func (c *Contract) Stop(reason StopReason, now time.Time) {
+	c.Status = StatusStopped
+	c.StopReason = &reason
+	c.StoppedAt = &now
+}
@@ -0,0 +1,15 @@
+package autoplay
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for autoplay contract persistence
+type Repository interface {
+	Create(ctx context.Context, c *Contract) error
+	// GetActiveBySession returns the session's running contract, or ErrAutoplayNotFound
+	GetActiveBySession(ctx context.Context, sessionID uuid.UUID) (*Contract, error)
+	Update(ctx context.Context, c *Contract) error
+}
@@ -0,0 +1,29 @@
+package autoplay
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Enforcer holds autoplay spins to the player's autoplay contract
+type Enforcer interface {
+	// CheckSpin returns the session's running contract if an autoplay spin of bet may be played
+	// It returns ErrAutoplayNotActive without a running contract, and stops the contract with
+	// ErrLossLimitReached when the spin could take losses past the loss limit.
+	CheckSpin(ctx context.Context, playerID, sessionID uuid.UUID, bet float64) (*Contract, error)
+	// RecordSpin counts a settled spin against the contract, stopping it when a stop condition is met
+	RecordSpin(ctx context.Context, c *Contract, bet, win float64) error
+}
+
+// Service defines the business logic interface for autoplay
+type Service interface {
+	Enforcer
+
+	// Start begins autoplay in the player's active game session
+	Start(ctx context.Context, playerID, sessionID uuid.UUID, limits Limits) (*Contract, error)
+	// GetActive returns the session's running contract
+	GetActive(ctx context.Context, playerID, sessionID uuid.UUID) (*Contract, error)
+	// Stop cancels the session's running contract
+	Stop(ctx context.Context, playerID, sessionID uuid.UUID) (*Contract, error)
+}
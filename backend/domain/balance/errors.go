@@ -0,0 +1,20 @@
+package balance
+
+import "errors"
+
+var (
+	// ErrInvalidAmount is returned when a credit is not a positive amount
+	ErrInvalidAmount = errors.New("credit amount must be positive")
+
+	// ErrInvalidAdjustment is returned when an adjustment is zero or not a finite amount
+	ErrInvalidAdjustment = errors.New("adjustment amount must be non-zero")
+
+	// ErrInvalidSource is returned when a credit comes from an unknown source
+	ErrInvalidSource = errors.New("source must be wallet or bonus")
+
+	// ErrReferenceRequired is returned when a credit has no reference
+	ErrReferenceRequired = errors.New("reference is required")
+
+	// ErrDuplicateCredit is returned when the player was already credited with the reference
+	ErrDuplicateCredit = errors.New("credit with this reference was already applied")
+)
@@ -0,0 +1,106 @@
+package balance
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Source is the system that credited a player outside the spin flow
+type Source string
+
+const (
+	SourceWallet Source = "wallet" // Operator wallet deposits and transfers
+	SourceBonus  Source = "bonus"  // Bonus and promotion payouts
+
+	// SourceAdjustment marks admin balance adjustments; other systems cannot credit with it
+	SourceAdjustment Source = "adjustment"
+)
+
+// Valid reports whether s is a source other systems may credit from
+func (s Source) Valid() bool {
+	return s == SourceWallet || s == SourceBonus
+}
+
+// TransactionType is the ledger type recorded for credits from the source
+func (s Source) TransactionType() string {
+	if s == SourceBonus {
+		return "bonus"
+	}
+	return "deposit"
+}
+
+// Credit is funds added to a player's balance by another system
+type Credit struct {
+	PlayerID    uuid.UUID
+	Amount      float64
+	Source      Source
+	Reference   string // The crediting system's unique ID for the credit, so retries are not applied twice
+	Description string
+}
+
+// Validate checks the amount, source and reference of the credit
+func (c *Credit) Validate() error {
+	if c.Amount <= 0 || math.IsInf(c.Amount, 0) || math.IsNaN(c.Amount) {
+		return ErrInvalidAmount
+	}
+	if !c.Source.Valid() {
+		return ErrInvalidSource
+	}
+	if c.Reference == "" {
+		return ErrReferenceRequired
+	}
+	return nil
+}
+
+// Adjustment is an admin correction of a player's balance, approved by a second admin
+type Adjustment struct {
+	PlayerID    uuid.UUID
+	Amount      float64 // Negative to debit the player
+	Reference   string  // Unique ID of the approved change, so it is not applied twice
+	Description string
+}
+
+// Validate checks the amount and reference of the adjustment
+func (a *Adjustment) Validate() error {
+	if a.Amount == 0 || math.IsInf(a.Amount, 0) || math.IsNaN(a.Amount) {
+		return ErrInvalidAdjustment
+	}
+	if a.Reference == "" {
+		return ErrReferenceRequired
+	}
+	return nil
+}
+
+// Transaction is an entry of the player balance ledger
+type Transaction struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	PlayerID      uuid.UUID `gorm:"type:uuid;not null;index" json:"player_id"`
+	Type          string    `gorm:"type:transaction_type;not null" json:"type"`
+	Amount        float64   `gorm:"type:decimal(15,2);not null" json:"amount"`
+	BalanceBefore float64   `gorm:"type:decimal(15,2);not null" json:"balance_before"`
+	BalanceAfter  float64   `gorm:"type:decimal(15,2);not null" json:"balance_after"`
+	Description   *string   `gorm:"type:text" json:"description,omitempty"`
+	Reference     *string   `gorm:"type:varchar(100)" json:"reference,omitempty"`
+	CreatedAt     time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Transaction) TableName() string {
+	return "transactions"
+}
+
+// EventBalanceUpdated is the type of events sent when a player's balance changes outside the spin flow
+const EventBalanceUpdated = "balance_updated"
+
+// Event is pushed to a player's connected clients so they can refresh the balance they show
+type Event struct {
+	Type      string    `json:"type"`
+	PlayerID  uuid.UUID `json:"player_id"`
+	Balance   float64   `json:"balance"`
+	Delta     float64   `json:"delta"`
+	Source    Source    `json:"source"`
+	Reference string    `json:"reference,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,23 @@
+package balance
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for balance ledger persistence
+type Repository interface {
+	// Credit adds the transaction amount to the player's balance and records the transaction,
+	// filling in the balance before and after; ErrDuplicateCredit if its reference was already applied.
+	// A negative amount debits the balance, and fails with player.ErrInsufficientBalance rather than overdraw it.
+	Credit(ctx context.Context, t *Transaction) error
+}
+
+// Store fans balance events out to the player's connected clients, across instances
+type Store interface {
+	// Publish sends the event to the player's subscribers
+	Publish(ctx context.Context, event *Event) error
+	// Subscribe streams the player's events until ctx is cancelled, then closes the channel
+	Subscribe(ctx context.Context, playerID uuid.UUID) (<-chan *Event, error)
+}
@@ -0,0 +1,19 @@
+package balance
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for balance changes made outside the spin flow
+type Service interface {
+	// Credit applies a credit and pushes the new balance to the player's clients
+	Credit(ctx context.Context, credit *Credit) (*Transaction, error)
+	// CreditOperatorPlayer applies a credit to the local player linked to an operator's player
+	CreditOperatorPlayer(ctx context.Context, operatorID, externalID string, credit *Credit) (*Transaction, error)
+	// Adjust applies an approved admin adjustment and pushes the new balance to the player's clients
+	Adjust(ctx context.Context, adj *Adjustment) (*Transaction, error)
+	// Subscribe streams the player's balance events until ctx is cancelled
+	Subscribe(ctx context.Context, playerID uuid.UUID) (<-chan *Event, error)
+}
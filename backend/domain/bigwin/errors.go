@@ -0,0 +1,8 @@
+package bigwin
+
+import "errors"
+
+var (
+	// ErrBigWinNotFound is returned when a big win does not exist
+	ErrBigWinNotFound = errors.New("big win not found")
+)
@@ -0,0 +1,64 @@
+package bigwin
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BigWin records a spin whose win crossed the configured multiple of the bet
+type BigWin struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	SpinID     uuid.UUID  `gorm:"type:uuid;uniqueIndex;not null" json:"spin_id"`
+	PlayerID   uuid.UUID  `gorm:"type:uuid;index;not null" json:"player_id"`
+	SessionID  uuid.UUID  `gorm:"type:uuid;not null" json:"session_id"`
+	BetAmount  float64    `gorm:"type:decimal(10,2);not null" json:"bet_amount"`
+	WinAmount  float64    `gorm:"type:decimal(15,2);not null" json:"win_amount"`
+	Multiplier float64    `gorm:"type:decimal(12,2);not null" json:"multiplier"`
+	IsFreeSpin bool       `json:"is_free_spin"`
+	Proof      *Proof     `gorm:"type:jsonb" json:"proof,omitempty"`
+	NotifiedAt *time.Time `json:"notified_at,omitempty"`
+	CreatedAt  time.Time  `gorm:"default:CURRENT_TIMESTAMP;index" json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (BigWin) TableName() string {
+	return "big_wins"
+}
+
+// Proof is the provably fair data needed to verify the winning spin independently
+// The server seed itself is only revealed when the PF session ends.
+type Proof struct {
+	PFSessionID    uuid.UUID `json:"pf_session_id"`
+	ServerSeedHash string    `json:"server_seed_hash"`
+	ClientSeed     string    `json:"client_seed,omitempty"`
+	SpinIndex      int64     `json:"spin_index"`
+	Nonce          int64     `json:"nonce"`
+	SpinHash       string    `json:"spin_hash"`
+	PrevSpinHash   string    `json:"prev_spin_hash"`
+}
+
+// Scan implements the sql.Scanner interface for Proof
+func (p *Proof) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, p)
+	case string:
+		return json.Unmarshal([]byte(v), p)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface for Proof
+func (p Proof) Value() (driver.Value, error) {
+	return json.Marshal(p)
+}
+
+// ListFilters filters the big win list
+type ListFilters struct {
+	PlayerID *uuid.UUID
+	Page     int
+	Limit    int
+}
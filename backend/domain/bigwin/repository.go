@@ -0,0 +1,16 @@
+package bigwin
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for big win data access
+type Repository interface {
+	Create(ctx context.Context, win *BigWin) error
+	GetByID(ctx context.Context, id uuid.UUID) (*BigWin, error)
+	List(ctx context.Context, filters ListFilters) ([]*BigWin, int64, error) // newest first
+	MarkNotified(ctx context.Context, id uuid.UUID, at time.Time) error
+}
@@ -0,0 +1,29 @@
+package bigwin
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/spin"
+)
+
+// Notifier delivers big win events to an external system (webhook, chat, message bus)
+type Notifier interface {
+	// Name identifies the notifier in logs
+	Name() string
+	Notify(ctx context.Context, win *BigWin) error
+}
+
+// Detector records big wins as spins are played
+type Detector interface {
+	// Check records and announces the spin if it is a big win; it returns nil when it is not
+	Check(ctx context.Context, s *spin.Spin, proof *Proof) (*BigWin, error)
+}
+
+// Service defines the business logic interface for big wins
+type Service interface {
+	Detector
+
+	GetBigWin(ctx context.Context, id uuid.UUID) (*BigWin, error)
+	ListBigWins(ctx context.Context, filters ListFilters) ([]*BigWin, int64, error)
+}
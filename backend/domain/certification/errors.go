@@ -0,0 +1,8 @@
+package certification
+
+import "errors"
+
+var (
+	// ErrInvalidRange is returned when an export date range is malformed or too long
+	ErrInvalidRange = errors.New("invalid certification export range")
+)
@@ -0,0 +1,57 @@
+package certification
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/spin"
+)
+
+// Event is the certification record of one spin: the inputs and outputs a test lab
+// (GLI-19, BMM) needs to reproduce and audit it. Events are append-only; the table
+// rejects updates and deletes.
+type Event struct {
+	ID                 uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	SpinID             uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex" json:"spin_id"`
+	SessionID          uuid.UUID  `gorm:"type:uuid;not null" json:"session_id"`
+	PlayerID           uuid.UUID  `gorm:"type:uuid;not null" json:"player_id"`
+	IsFreeSpin         bool       `gorm:"not null" json:"is_free_spin"`
+	FreeSpinsSessionID *uuid.UUID `gorm:"type:uuid" json:"free_spins_session_id,omitempty"`
+	GameMode           *string    `gorm:"type:varchar(32)" json:"game_mode,omitempty"`
+
+	// Inputs
+	BetAmount         float64    `gorm:"type:decimal(10,2);not null" json:"bet_amount"`
+	EngineVersion     string     `gorm:"type:varchar(32);not null" json:"engine_version"`
+	PaytableVersion   string     `gorm:"type:varchar(32);not null" json:"paytable_version"`
+	RNGProvider       string     `gorm:"type:varchar(32);not null" json:"rng_provider"`
+	RNGDraws          []RNGDraw  `gorm:"type:jsonb;not null;serializer:json" json:"rng_draws"` // Every value the spin consumed, in order
+	Nonce             int64      `gorm:"not null" json:"nonce"`
+	SpinHash          string     `gorm:"type:varchar(64);not null" json:"spin_hash"` // Provably fair hash chain link
+	ReelStripConfigID *uuid.UUID `gorm:"type:uuid" json:"reel_strip_config_id,omitempty"`
+	StripChecksums    []string   `gorm:"type:jsonb;not null;serializer:json" json:"strip_checksums"`
+	Multipliers       []int      `gorm:"type:jsonb;serializer:json" json:"multipliers"`
+
+	// Outputs
+	ReelPositions      []int         `gorm:"type:jsonb;not null;serializer:json" json:"reel_positions"`
+	Grid               spin.Grid     `gorm:"type:jsonb;not null" json:"grid"`
+	Cascades           spin.Cascades `gorm:"type:jsonb" json:"cascades"`
+	TotalWin           float64       `gorm:"type:decimal(15,2);not null" json:"total_win"`
+	ScatterCount       int           `gorm:"not null" json:"scatter_count"`
+	FreeSpinsTriggered bool          `gorm:"not null" json:"free_spins_triggered"`
+	BalanceBefore      float64       `gorm:"type:decimal(15,2);not null" json:"balance_before"`
+	BalanceAfter       float64       `gorm:"type:decimal(15,2);not null" json:"balance_after"`
+
+	CreatedAt time.Time `gorm:"not null;index" json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Event) TableName() string {
+	return "certification_events"
+}
+
+// RNGDraw is one call the spin made on its RNG and the value it was served
+type RNGDraw struct {
+	Call   string `json:"call"`
+	Args   []int  `json:"args,omitempty"`
+	Result string `json:"result,omitempty"` // Decimal for numbers, hex for bytes, "i:j" swaps for shuffles
+}
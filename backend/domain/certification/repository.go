@@ -0,0 +1,16 @@
+package certification
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for the append-only certification event store
+type Repository interface {
+	Create(ctx context.Context, event *Event) error
+	// ListAfter returns events created in [from, to) ordered by (created_at, id), strictly after
+	// the cursor; a zero cursor starts at from
+	ListAfter(ctx context.Context, from, to, afterCreatedAt time.Time, afterID uuid.UUID, limit int) ([]*Event, error)
+}
@@ -0,0 +1,22 @@
+package certification
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Recorder records certification events as spins are played
+type Recorder interface {
+	// Enabled reports whether certification logging is on; spins skip recording RNG draws when it is off
+	Enabled() bool
+	Record(ctx context.Context, event *Event) error
+}
+
+// Service defines the business logic interface for certification logging
+type Service interface {
+	Recorder
+
+	// Export writes the events created in [from, to) as newline-delimited JSON and returns how many it wrote
+	Export(ctx context.Context, from, to time.Time, w io.Writer) (int, error)
+}
@@ -0,0 +1,85 @@
+package dashboard
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/domain/spinfeed"
+)
+
+// Metrics are the live figures on the ops home screen
+// Day totals cover the current UTC day. Rates cover the last WindowMinutes whole minutes.
+type Metrics struct {
+	ActiveSessions int64   `json:"active_sessions"` // Game sessions with a spin in the active session window
+	SpinsPerMinute float64 `json:"spins_per_minute"`
+
+	SpinsToday       int64    `json:"spins_today"`
+	WageredToday     float64  `json:"wagered_today"` // What paid spins cost; free spins count nothing
+	WonToday         float64  `json:"won_today"`
+	RealizedRTPToday *float64 `json:"realized_rtp_today"` // Won over wagered, in percent; nil before the first paid spin
+	BiggestWinToday  *BigWin  `json:"biggest_win_today"`
+
+	SpinRequests int64   `json:"spin_requests"`
+	SpinErrors   int64   `json:"spin_errors"` // Spin requests that failed with a server error
+	ErrorRate    float64 `json:"error_rate"`  // Spin errors over spin requests, in percent
+
+	WindowMinutes int       `json:"window_minutes"`
+	ComputedAt    time.Time `json:"computed_at"`
+}
+
+// BigWin is the biggest win of the day, with the player's username masked
+type BigWin struct {
+	SpinID     uuid.UUID `json:"spin_id"`
+	Player     string    `json:"player"`
+	BetAmount  float64   `json:"bet_amount"`
+	TotalWin   float64   `json:"total_win"`
+	IsFreeSpin bool      `json:"is_free_spin"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Sample is one settled spin as counted by the dashboard
+type Sample struct {
+	SessionID uuid.UUID
+	Stake     float64 // What the spin cost: nothing for free spins, the mode cost for bought modes
+	Win       float64
+	BigWin    *BigWin
+	At        time.Time
+}
+
+// NewSample builds the dashboard sample of a settled spin
+func NewSample(s *spin.Spin, username string) *Sample {
+	stake := s.BetAmount
+	switch {
+	case s.IsFreeSpin:
+		stake = 0
+	case s.GameModeCost != nil:
+		stake = *s.GameModeCost
+	}
+	return &Sample{
+		SessionID: s.SessionID,
+		Stake:     stake,
+		Win:       s.TotalWin,
+		BigWin: &BigWin{
+			SpinID:     s.ID,
+			Player:     spinfeed.MaskUsername(username),
+			BetAmount:  s.BetAmount,
+			TotalWin:   s.TotalWin,
+			IsFreeSpin: s.IsFreeSpin,
+			CreatedAt:  s.CreatedAt,
+		},
+		At: s.CreatedAt,
+	}
+}
+
+// Counters are the raw counts the dashboard metrics are computed from
+type Counters struct {
+	ActiveSessions int64
+	WindowSpins    int64
+	WindowRequests int64
+	WindowErrors   int64
+	DaySpins       int64
+	DayWagered     float64
+	DayWon         float64
+	BiggestWin     *BigWin
+}
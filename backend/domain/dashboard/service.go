@@ -0,0 +1,32 @@
+package dashboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/slotmachine/backend/domain/spin"
+)
+
+// Recorder counts spins and spin requests for the live dashboard
+type Recorder interface {
+	// RecordSpin counts a settled spin
+	RecordSpin(ctx context.Context, s *spin.Spin, username string)
+	// RecordSpinRequest counts a spin request and whether it failed with a server error
+	RecordSpinRequest(ctx context.Context, failed bool)
+}
+
+// Store keeps the dashboard counters shared by every instance
+type Store interface {
+	RecordSpin(ctx context.Context, sample *Sample, activeWindow time.Duration) error
+	RecordRequest(ctx context.Context, at time.Time, failed bool) error
+	// Counters reads the counters of the minutes in [now-window, now) and of now's UTC day
+	Counters(ctx context.Context, now time.Time, window, activeWindow time.Duration) (*Counters, error)
+}
+
+// Service defines the business logic interface for the live dashboard
+type Service interface {
+	Recorder
+
+	// Metrics computes the live metrics from the counters
+	Metrics(ctx context.Context) (*Metrics, error)
+}
@@ -0,0 +1,26 @@
+package dispute
+
+import "errors"
+
+var (
+	// ErrDisputeNotFound is returned when a dispute does not exist
+	ErrDisputeNotFound = errors.New("dispute not found")
+
+	// ErrDisputeExists is returned when the spin already has a dispute that is not closed
+	ErrDisputeExists = errors.New("spin already has an open dispute")
+
+	// ErrInvalidTransition is returned when a dispute cannot move to the requested status
+	ErrInvalidTransition = errors.New("invalid dispute status transition")
+
+	// ErrDisputeClosed is returned when a closed dispute is changed
+	ErrDisputeClosed = errors.New("dispute is closed")
+
+	// ErrReasonRequired is returned when a dispute is opened without a reason
+	ErrReasonRequired = errors.New("dispute reason is required")
+
+	// ErrNoteRequired is returned when an empty note is added to a dispute
+	ErrNoteRequired = errors.New("note is required")
+
+	// ErrResolutionNotesRequired is returned when a dispute is closed without resolution notes
+	ErrResolutionNotesRequired = errors.New("resolution notes are required")
+)
@@ -0,0 +1,122 @@
+package dispute
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/provablyfair"
+)
+
+// Status is the state of a dispute
+// open -> investigating -> resolved | rejected; open disputes may also be closed directly.
+type Status string
+
+const (
+	StatusOpen          Status = "open"
+	StatusInvestigating Status = "investigating"
+	StatusResolved      Status = "resolved" // Upheld in the player's favour
+	StatusRejected      Status = "rejected" // The spin was found to be correct
+)
+
+// Valid reports whether s is a known status
+func (s Status) Valid() bool {
+	switch s {
+	case StatusOpen, StatusInvestigating, StatusResolved, StatusRejected:
+		return true
+	}
+	return false
+}
+
+// Closed reports whether s is a final status
+func (s Status) Closed() bool {
+	return s == StatusResolved || s == StatusRejected
+}
+
+// Dispute is a player's challenge of a spin outcome, worked by support staff
+type Dispute struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	SpinID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"spin_id"`
+	PlayerID   uuid.UUID  `gorm:"type:uuid;not null;index" json:"player_id"`
+	Status     Status     `gorm:"type:varchar(20);not null;index" json:"status"`
+	Reason     string     `gorm:"type:text;not null" json:"reason"`
+	OpenedBy   string     `gorm:"type:varchar(100)" json:"opened_by,omitempty"`
+	AssignedTo *uuid.UUID `gorm:"type:uuid;index" json:"assigned_to,omitempty"` // Admin working the dispute
+
+	// Provably fair replay of the spin, attached by support
+	Verification *Verification `gorm:"type:jsonb" json:"verification,omitempty"`
+
+	ResolutionNotes string     `gorm:"type:text" json:"resolution_notes,omitempty"`
+	ResolvedBy      string     `gorm:"type:varchar(100)" json:"resolved_by,omitempty"`
+	ResolvedAt      *time.Time `json:"resolved_at,omitempty"`
+
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Dispute) TableName() string {
+	return "disputes"
+}
+
+// Verification is the provably fair verification output attached to a dispute
+type Verification provablyfair.RecordedSpinVerification
+
+// Scan implements the sql.Scanner interface for Verification
+func (v *Verification) Scan(value interface{}) error {
+	switch b := value.(type) {
+	case []byte:
+		return json.Unmarshal(b, v)
+	case string:
+		return json.Unmarshal([]byte(b), v)
+	case nil:
+		return nil
+	}
+	return errors.New("failed to scan dispute verification")
+}
+
+// Value implements the driver.Valuer interface for Verification
+func (v *Verification) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+// EventKind identifies an entry in a dispute's history
+type EventKind string
+
+const (
+	EventOpened        EventKind = "opened"
+	EventAssigned      EventKind = "assigned"
+	EventStatusChanged EventKind = "status_changed"
+	EventVerified      EventKind = "verified"
+	EventNote          EventKind = "note"
+)
+
+// Event is an entry in a dispute's history
+type Event struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	DisputeID uuid.UUID `gorm:"type:uuid;not null;index" json:"dispute_id"`
+	Kind      EventKind `gorm:"type:varchar(20);not null" json:"kind"`
+	Actor     string    `gorm:"type:varchar(100)" json:"actor,omitempty"`
+	Message   string    `gorm:"type:text" json:"message,omitempty"`
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Event) TableName() string {
+	return "dispute_events"
+}
+
+// ListFilters represents filters for listing disputes
+type ListFilters struct {
+	Status     Status
+	PlayerID   *uuid.UUID
+	SpinID     *uuid.UUID
+	AssignedTo *uuid.UUID
+	Page       int
+	Limit      int
+}
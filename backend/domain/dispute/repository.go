@@ -0,0 +1,22 @@
+package dispute
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for dispute persistence
+type Repository interface {
+	Create(ctx context.Context, d *Dispute) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Dispute, error)
+	// GetOpenBySpin returns the spin's dispute that is not closed, if any
+	GetOpenBySpin(ctx context.Context, spinID uuid.UUID) (*Dispute, error)
+	Update(ctx context.Context, d *Dispute) error
+	List(ctx context.Context, filters ListFilters) ([]*Dispute, int64, error)
+
+	// AddEvent appends to a dispute's history
+	AddEvent(ctx context.Context, event *Event) error
+	// ListEvents lists a dispute's history oldest first
+	ListEvents(ctx context.Context, disputeID uuid.UUID) ([]*Event, error)
+}
@@ -0,0 +1,24 @@
+package dispute
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for disputes
+// actor is the username of the admin making the change, recorded in the history.
+type Service interface {
+	Open(ctx context.Context, spinID uuid.UUID, reason, actor string) (*Dispute, error)
+	Get(ctx context.Context, id uuid.UUID) (*Dispute, []*Event, error)
+	List(ctx context.Context, filters ListFilters) ([]*Dispute, int64, error)
+
+	// Assign hands a dispute to an admin; an open dispute moves to investigating
+	Assign(ctx context.Context, id, adminID uuid.UUID, actor string) (*Dispute, error)
+	// Verify replays the disputed spin with provably fair verification and attaches the output
+	Verify(ctx context.Context, id uuid.UUID, actor string) (*Dispute, error)
+	// AddNote records a support note in the dispute's history
+	AddNote(ctx context.Context, id uuid.UUID, note, actor string) (*Event, error)
+	// Close resolves or rejects a dispute with resolution notes
+	Close(ctx context.Context, id uuid.UUID, status Status, notes, actor string) (*Dispute, error)
+}
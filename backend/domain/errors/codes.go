@@ -0,0 +1,616 @@
+package errors
+
+import "net/http"
+
+// Error codes returned in the "error" field of API error responses.
+// Codes are part of the API contract: never rename or reuse one, add a new code instead.
+const (
+	// Request validation
+	CodeBatchTooLarge             Code = "batch_too_large"
+	CodeBetAboveLimit             Code = "bet_above_limit"
+	CodeBetIncreaseNotAllowed     Code = "bet_increase_not_allowed"
+	CodeBetLocked                 Code = "bet_locked"
+	CodeChecksumMismatch          Code = "checksum_mismatch"
+	CodeChunkTooLarge             Code = "chunk_too_large"
+	CodeClientNonceRequired       Code = "client_nonce_required"
+	CodeCreatePlayerFailed        Code = "create_player_failed"
+	CodeFileTooLarge              Code = "file_too_large"
+	CodeGameIDRequired            Code = "game_id_required"
+	CodeIncompleteUpload          Code = "incomplete_upload"
+	CodeInsufficientBalance       Code = "insufficient_balance"
+	CodeInvalidAdminID            Code = "invalid_admin_id"
+	CodeInvalidAssetID            Code = "invalid_asset_id"
+	CodeInvalidAudiosJSON         Code = "invalid_audios_json"
+	CodeInvalidBetAmount          Code = "invalid_bet_amount"
+	CodeInvalidChunk              Code = "invalid_chunk"
+	CodeInvalidChunkIndex         Code = "invalid_chunk_index"
+	CodeInvalidChunkSize          Code = "invalid_chunk_size"
+	CodeInvalidClientSeed         Code = "invalid_client_seed"
+	CodeInvalidConfigID           Code = "invalid_config_id"
+	CodeInvalidCursor             Code = "invalid_cursor"
+	CodeInvalidFeatureFlag        Code = "invalid_feature_flag"
+	CodeInvalidFile               Code = "invalid_file"
+	CodeInvalidFileContent        Code = "invalid_file_content"
+	CodeInvalidFileName           Code = "invalid_file_name"
+	CodeInvalidFileType           Code = "invalid_file_type"
+	CodeInvalidForm               Code = "invalid_form"
+	CodeInvalidFormat             Code = "invalid_format"
+	CodeInvalidFreeSpinsSessionID Code = "invalid_free_spins_session_id"
+	CodeInvalidGameID             Code = "invalid_game_id"
+	CodeInvalidID                 Code = "invalid_id"
+	CodeInvalidImagesJSON         Code = "invalid_images_json"
+	CodeInvalidMultiplierLadder   Code = "invalid_multiplier_ladder"
+	CodeInvalidNonce              Code = "invalid_nonce"
+	CodeInvalidNote               Code = "invalid_note"
+	CodeInvalidParams             Code = "invalid_params"
+	CodeInvalidPassword           Code = "invalid_password"
+	CodeInvalidPlayerID           Code = "invalid_player_id"
+	CodeInvalidPrevSpinHash       Code = "invalid_prev_spin_hash"
+	CodeInvalidRange              Code = "invalid_range"
+	CodeInvalidReelPositions      Code = "invalid_reel_positions"
+	CodeInvalidReelStripConfigID  Code = "invalid_reel_strip_config_id"
+	CodeInvalidRequest            Code = "invalid_request"
+	CodeInvalidServerSeed         Code = "invalid_server_seed"
+	CodeInvalidSession            Code = "invalid_session"
+	CodeInvalidSessionID          Code = "invalid_session_id"
+	CodeInvalidSize               Code = "invalid_size"
+	CodeInvalidSpinHash           Code = "invalid_spin_hash"
+	CodeInvalidSpinID             Code = "invalid_spin_id"
+	CodeInvalidSpritesheetJSON    Code = "invalid_spritesheet_json"
+	CodeInvalidThetaCommitment    Code = "invalid_theta_commitment"
+	CodeInvalidTheme              Code = "invalid_theme"
+	CodeInvalidTranslations       Code = "invalid_translations"
+	CodeInvalidTriggerRules       Code = "invalid_trigger_rules"
+	CodeInvalidUserID             Code = "invalid_user_id"
+	CodeInvalidVideosJSON         Code = "invalid_videos_json"
+	CodeInvalidWildFeatures       Code = "invalid_wild_features"
+	CodeInvalidWinTiers           Code = "invalid_win_tiers"
+	CodeInvalidTimingProfiles     Code = "invalid_timing_profiles"
+	CodeLaunchTokenRequired       Code = "launch_token_required"
+	CodeMissingReelStripConfigID  Code = "missing_reel_strip_config_id"
+	CodeNoActiveSession           Code = "no_active_session"
+	CodeNoFiles                   Code = "no_files"
+	CodeRefreshTokenRequired      Code = "refresh_token_required"
+	CodeSessionTokenRequired      Code = "session_token_required"
+	CodeThetaCommitmentRequired   Code = "theta_commitment_required"
+	CodeThetaSeedRequired         Code = "theta_seed_required"
+	CodeThetaVerificationFailed   Code = "theta_verification_failed"
+	CodeThemeMismatch             Code = "theme_mismatch"
+	CodeValidationError           Code = "validation_error"
+	CodeWeakPassword              Code = "weak_password"
+	CodeZipNotSupported           Code = "zip_not_supported"
+
+	// Authentication
+	CodeInvalidCredentials       Code = "invalid_credentials"
+	CodeInvalidLaunchToken       Code = "invalid_launch_token"
+	CodeInvalidOperatorSignature Code = "invalid_operator_signature"
+	CodeInvalidOperatorToken     Code = "invalid_operator_token"
+	CodeInvalidRefreshToken      Code = "invalid_refresh_token"
+	CodeInvalidToken             Code = "invalid_token"
+	CodeInvalidTwoFactorCode     Code = "invalid_two_factor_code"
+	CodeRefreshTokenReused       Code = "refresh_token_reused"
+	CodeRefreshTokenRevoked      Code = "refresh_token_revoked"
+	CodeUnauthorized             Code = "unauthorized"
+
+	// Authorization and account state
+	CodeAccountInactive             Code = "account_inactive"
+	CodeAccountLocked               Code = "account_locked"
+	CodeAccountSuspended            Code = "account_suspended"
+	CodeAutoplayNotAllowed          Code = "autoplay_not_allowed"
+	CodeCannotDeleteSelf            Code = "cannot_delete_self"
+	CodeCannotModifySuperAdmin      Code = "cannot_modify_super_admin"
+	CodeForbidden                   Code = "forbidden"
+	CodeGameAccessDenied            Code = "game_access_denied"
+	CodeImpersonationReadOnly       Code = "impersonation_read_only"
+	CodeImpersonationForbidden      Code = "impersonation_forbidden"
+	CodeKYCRequired                 Code = "kyc_required"
+	CodeSelfApproval                Code = "self_approval"
+	CodeTwoFactorEnrollmentRequired Code = "two_factor_enrollment_required"
+	CodeTwoFactorRequired           Code = "two_factor_required"
+
+	// Missing resources
+	CodeAdminNotFound         Code = "admin_not_found"
+	CodeArchiveNotFound       Code = "archive_not_found"
+	CodeAssignmentNotFound    Code = "assignment_not_found"
+	CodeAutoplayNotFound      Code = "autoplay_not_found"
+	CodeCanaryNotFound        Code = "canary_not_found"
+	CodeChangeRequestNotFound Code = "change_request_not_found"
+	CodeCheckpointNotFound    Code = "checkpoint_not_found"
+	CodeConfigNotFound        Code = "config_not_found"
+	CodeDisputeNotFound       Code = "dispute_not_found"
+	CodeExportNotFound        Code = "export_not_found"
+	CodeFileNotFound          Code = "file_not_found"
+	CodeFreeSpinsNotFound     Code = "free_spins_not_found"
+	CodeGameNotFound          Code = "game_not_found"
+	CodeGrantNotFound         Code = "grant_not_found"
+	CodeNoActiveConfig        Code = "no_active_config"
+	CodeNoPendingSpin         Code = "no_pending_spin"
+	CodeNotFound              Code = "not_found"
+	CodeNoteNotFound          Code = "note_not_found"
+	CodeNotificationNotFound  Code = "notification_not_found"
+	CodePFSessionNotFound     Code = "pf_session_not_found"
+	CodePlayerNotFound        Code = "player_not_found"
+	CodeSessionNotFound       Code = "session_not_found"
+	CodeSpinLogNotFound       Code = "spin_log_not_found"
+	CodeSpinNotFound          Code = "spin_not_found"
+	CodeStatusNotFound        Code = "status_not_found"
+
+	// State conflicts
+	CodeActiveSessionExists     Code = "active_session_exists"
+	CodeAlreadyConverted        Code = "already_converted"
+	CodeAlreadyLoggedIn         Code = "already_logged_in"
+	CodeArchiveAlreadyRestored  Code = "archive_already_restored"
+	CodeArchiveNotRestored      Code = "archive_not_restored"
+	CodeAssetInUse              Code = "asset_in_use"
+	CodeAutoplayActive          Code = "autoplay_active"
+	CodeAutoplayLossLimit       Code = "autoplay_loss_limit"
+	CodeAutoplayNotActive       Code = "autoplay_not_active"
+	CodeBalanceBusy             Code = "balance_busy"
+	CodeCanaryNotRunning        Code = "canary_not_running"
+	CodeCanaryRunning           Code = "canary_running"
+	CodeChangeApplyFailed       Code = "change_apply_failed"
+	CodeChangeRequestNotPending Code = "change_request_not_pending"
+	CodeCheckpointMismatch      Code = "checkpoint_mismatch"
+	CodeClientNonceMismatch     Code = "client_nonce_mismatch"
+	CodeConfigIsDefault         Code = "config_is_default"
+	CodeConfigInUse             Code = "config_in_use"
+	CodeDisputeClosed           Code = "dispute_closed"
+	CodeDisputeExists           Code = "dispute_exists"
+	CodeDuplicateCode           Code = "duplicate_code"
+	CodeDuplicateCredit         Code = "duplicate_credit"
+	CodeDuplicateEmail          Code = "duplicate_email"
+	CodeDuplicateLevel          Code = "duplicate_level"
+	CodeDuplicateName           Code = "duplicate_name"
+	CodeDuplicateUsername       Code = "duplicate_username"
+	CodeExportNotReady          Code = "export_not_ready"
+	CodeFlagExists              Code = "flag_exists"
+	CodeFreeSpinsNotActive      Code = "free_spins_not_active"
+	CodeKYCAlreadyVerified      Code = "kyc_already_verified"
+	CodeKYCNotPending           Code = "kyc_not_pending"
+	CodePFSessionAlreadyEnded   Code = "pf_session_already_ended"
+	CodePFSessionExists         Code = "pf_session_exists"
+	CodePlayerErased            Code = "player_erased"
+	CodePlayerExists            Code = "player_exists"
+	CodeRealityCheckRequired    Code = "reality_check_required"
+	CodeSessionAlreadyEnded     Code = "session_already_ended"
+	CodeSpinNotCheckpointed     Code = "spin_not_checkpointed"
+	CodeTwoFactorAlreadyEnabled Code = "two_factor_already_enabled"
+	CodeTwoFactorNotEnabled     Code = "two_factor_not_enabled"
+	CodeTwoFactorNotEnrolled    Code = "two_factor_not_enrolled"
+
+	// Expired resources
+	CodeChangeRequestExpired Code = "change_request_expired"
+	CodeSessionExpired       Code = "session_expired"
+	CodeSpinLogsArchived     Code = "spin_logs_archived"
+
+	// Throttling
+	CodeQueueFull          Code = "queue_full"
+	CodeRateLimitExceeded  Code = "rate_limit_exceeded"
+	CodeTooManyExports     Code = "too_many_exports"
+	CodeTooManySimulations Code = "too_many_simulations"
+	CodeTooManyUploads     Code = "too_many_uploads"
+	CodeTrialAtCapacity    Code = "trial_at_capacity"
+
+	// Server failures
+	CodeActivatePlayerFailed            Code = "activate_player_failed"
+	CodeChunkReadFailed                 Code = "chunk_read_failed"
+	CodeChunkWriteFailed                Code = "chunk_write_failed"
+	CodeConversionFailed                Code = "conversion_failed"
+	CodeDeactivatePlayerFailed          Code = "deactivate_player_failed"
+	CodeDeleteFailed                    Code = "delete_failed"
+	CodeFailedToAcknowledgeRealityCheck Code = "failed_to_acknowledge_reality_check"
+	CodeFailedToAcknowledgeSpin         Code = "failed_to_acknowledge_spin"
+	CodeFailedToActivate                Code = "failed_to_activate"
+	CodeFailedToActivateAsset           Code = "failed_to_activate_asset"
+	CodeFailedToActivateConfig          Code = "failed_to_activate_config"
+	CodeFailedToActivateGame            Code = "failed_to_activate_game"
+	CodeFailedToActivateGameConfig      Code = "failed_to_activate_game_config"
+	CodeFailedToAssign                  Code = "failed_to_assign"
+	CodeFailedToAssignBaseGame          Code = "failed_to_assign_base_game"
+	CodeFailedToAssignFreeSpins         Code = "failed_to_assign_free_spins"
+	CodeFailedToChangePassword          Code = "failed_to_change_password"
+	CodeFailedToCreateAdmin             Code = "failed_to_create_admin"
+	CodeFailedToCreateAsset             Code = "failed_to_create_asset"
+	CodeFailedToCreateConfig            Code = "failed_to_create_config"
+	CodeFailedToCreateGame              Code = "failed_to_create_game"
+	CodeFailedToCreateGameConfig        Code = "failed_to_create_game_config"
+	CodeFailedToCreateStorageFolder     Code = "failed_to_create_storage_folder"
+	CodeFailedToDeactivate              Code = "failed_to_deactivate"
+	CodeFailedToDeactivateAsset         Code = "failed_to_deactivate_asset"
+	CodeFailedToDeactivateConfig        Code = "failed_to_deactivate_config"
+	CodeFailedToDeactivateGame          Code = "failed_to_deactivate_game"
+	CodeFailedToDeactivateGameConfig    Code = "failed_to_deactivate_game_config"
+	CodeFailedToDeleteAdmin             Code = "failed_to_delete_admin"
+	CodeFailedToDeleteAsset             Code = "failed_to_delete_asset"
+	CodeFailedToDeleteConfig            Code = "failed_to_delete_config"
+	CodeFailedToDeleteGame              Code = "failed_to_delete_game"
+	CodeFailedToDeleteGameConfig        Code = "failed_to_delete_game_config"
+	CodeFailedToDisableTwoFactor        Code = "failed_to_disable_two_factor"
+	CodeFailedToEndPFSession            Code = "failed_to_end_pf_session"
+	CodeFailedToEvictArchive            Code = "failed_to_evict_archive"
+	CodeFailedToEndSession              Code = "failed_to_end_session"
+	CodeFailedToEnrollTwoFactor         Code = "failed_to_enroll_two_factor"
+	CodeFailedToExecuteFreeSpin         Code = "failed_to_execute_free_spin"
+	CodeFailedToExecuteSpin             Code = "failed_to_execute_spin"
+	CodeFailedToGenerateGrid            Code = "failed_to_generate_grid"
+	CodeFailedToGetAdmin                Code = "failed_to_get_admin"
+	CodeFailedToGetAsset                Code = "failed_to_get_asset"
+	CodeFailedToGetAssetUsage           Code = "failed_to_get_asset_usage"
+	CodeFailedToGetAssignment           Code = "failed_to_get_assignment"
+	CodeFailedToGetBalance              Code = "failed_to_get_balance"
+	CodeFailedToGetConfig               Code = "failed_to_get_config"
+	CodeFailedToGetGame                 Code = "failed_to_get_game"
+	CodeFailedToGetGameConfig           Code = "failed_to_get_game_config"
+	CodeFailedToGetHistory              Code = "failed_to_get_history"
+	CodeFailedToGetPendingSpin          Code = "failed_to_get_pending_spin"
+	CodeFailedToGetSessions             Code = "failed_to_get_sessions"
+	CodeFailedToGetVerificationData     Code = "failed_to_get_verification_data"
+	CodeFailedToListAdmins              Code = "failed_to_list_admins"
+	CodeFailedToListAssets              Code = "failed_to_list_assets"
+	CodeFailedToListConfigs             Code = "failed_to_list_configs"
+	CodeFailedToListGameConfigs         Code = "failed_to_list_game_configs"
+	CodeFailedToListGames               Code = "failed_to_list_games"
+	CodeFailedToListSegments            Code = "failed_to_list_segments"
+	CodeFailedToOptimizeImages          Code = "failed_to_optimize_images"
+	CodeFailedToRehashAsset             Code = "failed_to_rehash_asset"
+	CodeFailedToRemoveAssignment        Code = "failed_to_remove_assignment"
+	CodeFailedToRenameStorageFolder     Code = "failed_to_rename_storage_folder"
+	CodeFailedToResetPassword           Code = "failed_to_reset_password"
+	CodeFailedToRestoreArchive          Code = "failed_to_restore_archive"
+	CodeFailedToRestoreAsset            Code = "failed_to_restore_asset"
+	CodeFailedToRestoreConfig           Code = "failed_to_restore_config"
+	CodeFailedToRestoreGame             Code = "failed_to_restore_game"
+	CodeFailedToRestoreGameConfig       Code = "failed_to_restore_game_config"
+	CodeFailedToRotateSigningKey        Code = "failed_to_rotate_signing_key"
+	CodeFailedToSetDefault              Code = "failed_to_set_default"
+	CodeFailedToSetMultiplierLadder     Code = "failed_to_set_multiplier_ladder"
+	CodeFailedToSetTriggerRules         Code = "failed_to_set_trigger_rules"
+	CodeFailedToSetWildFeatures         Code = "failed_to_set_wild_features"
+	CodeFailedToSetWinTiers             Code = "failed_to_set_win_tiers"
+	CodeFailedToSetTimingProfiles       Code = "failed_to_set_timing_profiles"
+	CodeFailedToStartPFSession          Code = "failed_to_start_pf_session"
+	CodeFailedToStartSession            Code = "failed_to_start_session"
+	CodeFailedToSuspend                 Code = "failed_to_suspend"
+	CodeFailedToUpdateAdmin             Code = "failed_to_update_admin"
+	CodeFailedToUpdateAsset             Code = "failed_to_update_asset"
+	CodeFailedToUpdateBaseGame          Code = "failed_to_update_base_game"
+	CodeFailedToUpdateConfig            Code = "failed_to_update_config"
+	CodeFailedToUpdateFreeSpins         Code = "failed_to_update_free_spins"
+	CodeFailedToUpdateGame              Code = "failed_to_update_game"
+	CodeFailedToVerifyTwoFactor         Code = "failed_to_verify_two_factor"
+	CodeFileOpenFailed                  Code = "file_open_failed"
+	CodeFileReadFailed                  Code = "file_read_failed"
+	CodeForceLogoutFailed               Code = "force_logout_failed"
+	CodeGetPlayerFailed                 Code = "get_player_failed"
+	CodeInitFailed                      Code = "init_failed"
+	CodeInternalError                   Code = "internal_error"
+	CodeLaunchFailed                    Code = "launch_failed"
+	CodeListFailed                      Code = "list_failed"
+	CodeListPlayersFailed               Code = "list_players_failed"
+	CodeLoginFailed                     Code = "login_failed"
+	CodeLogoutFailed                    Code = "logout_failed"
+	CodePresignFailed                   Code = "presign_failed"
+	CodeRefreshFailed                   Code = "refresh_failed"
+	CodeRegistrationFailed              Code = "registration_failed"
+	CodeSearchPlayersFailed             Code = "search_players_failed"
+	CodeSimulationTimeout               Code = "simulation_timeout"
+	CodeSpinTimeout                     Code = "spin_timeout"
+	CodeStatusError                     Code = "status_error"
+	CodeTrialError                      Code = "trial_error"
+	CodeUploadFailed                    Code = "upload_failed"
+	CodeVerificationFailed              Code = "verification_failed"
+
+	// Unavailable features
+	CodeBonusBuysPaused    Code = "bonus_buys_paused"
+	CodeEventsUnavailable  Code = "events_unavailable"
+	CodeFeedUnavailable    Code = "feed_unavailable"
+	CodeKYCUnavailable     Code = "kyc_unavailable"
+	CodeServiceUnavailable Code = "service_unavailable"
+	CodeWalletUnavailable  Code = "wallet_unavailable"
+)
+
+// catalog maps every code to its HTTP status
+var catalog = map[Code]int{
+	// Request validation
+	CodeBatchTooLarge:             http.StatusBadRequest,
+	CodeBetAboveLimit:             http.StatusBadRequest,
+	CodeBetIncreaseNotAllowed:     http.StatusBadRequest,
+	CodeBetLocked:                 http.StatusBadRequest,
+	CodeChecksumMismatch:          http.StatusBadRequest,
+	CodeChunkTooLarge:             http.StatusBadRequest,
+	CodeClientNonceRequired:       http.StatusBadRequest,
+	CodeCreatePlayerFailed:        http.StatusBadRequest,
+	CodeFileTooLarge:              http.StatusBadRequest,
+	CodeGameIDRequired:            http.StatusBadRequest,
+	CodeIncompleteUpload:          http.StatusBadRequest,
+	CodeInsufficientBalance:       http.StatusBadRequest,
+	CodeInvalidAdminID:            http.StatusBadRequest,
+	CodeInvalidAssetID:            http.StatusBadRequest,
+	CodeInvalidAudiosJSON:         http.StatusBadRequest,
+	CodeInvalidBetAmount:          http.StatusBadRequest,
+	CodeInvalidChunk:              http.StatusBadRequest,
+	CodeInvalidChunkIndex:         http.StatusBadRequest,
+	CodeInvalidChunkSize:          http.StatusBadRequest,
+	CodeInvalidClientSeed:         http.StatusBadRequest,
+	CodeInvalidConfigID:           http.StatusBadRequest,
+	CodeInvalidCursor:             http.StatusBadRequest,
+	CodeInvalidFeatureFlag:        http.StatusBadRequest,
+	CodeInvalidFile:               http.StatusBadRequest,
+	CodeInvalidFileContent:        http.StatusBadRequest,
+	CodeInvalidFileName:           http.StatusBadRequest,
+	CodeInvalidFileType:           http.StatusBadRequest,
+	CodeInvalidForm:               http.StatusBadRequest,
+	CodeInvalidFormat:             http.StatusBadRequest,
+	CodeInvalidFreeSpinsSessionID: http.StatusBadRequest,
+	CodeInvalidGameID:             http.StatusBadRequest,
+	CodeInvalidID:                 http.StatusBadRequest,
+	CodeInvalidImagesJSON:         http.StatusBadRequest,
+	CodeInvalidMultiplierLadder:   http.StatusBadRequest,
+	CodeInvalidNonce:              http.StatusBadRequest,
+	CodeInvalidNote:               http.StatusBadRequest,
+	CodeInvalidParams:             http.StatusBadRequest,
+	CodeInvalidPassword:           http.StatusBadRequest,
+	CodeInvalidPlayerID:           http.StatusBadRequest,
+	CodeInvalidPrevSpinHash:       http.StatusBadRequest,
+	CodeInvalidRange:              http.StatusBadRequest,
+	CodeInvalidReelPositions:      http.StatusBadRequest,
+	CodeInvalidReelStripConfigID:  http.StatusBadRequest,
+	CodeInvalidRequest:            http.StatusBadRequest,
+	CodeInvalidServerSeed:         http.StatusBadRequest,
+	CodeInvalidSession:            http.StatusBadRequest,
+	CodeInvalidSessionID:          http.StatusBadRequest,
+	CodeInvalidSize:               http.StatusBadRequest,
+	CodeInvalidSpinHash:           http.StatusBadRequest,
+	CodeInvalidSpinID:             http.StatusBadRequest,
+	CodeInvalidSpritesheetJSON:    http.StatusBadRequest,
+	CodeInvalidThetaCommitment:    http.StatusBadRequest,
+	CodeInvalidTheme:              http.StatusBadRequest,
+	CodeInvalidTranslations:       http.StatusBadRequest,
+	CodeInvalidTriggerRules:       http.StatusBadRequest,
+	CodeInvalidUserID:             http.StatusBadRequest,
+	CodeInvalidVideosJSON:         http.StatusBadRequest,
+	CodeInvalidWildFeatures:       http.StatusBadRequest,
+	CodeInvalidWinTiers:           http.StatusBadRequest,
+	CodeInvalidTimingProfiles:     http.StatusBadRequest,
+	CodeLaunchTokenRequired:       http.StatusBadRequest,
+	CodeMissingReelStripConfigID:  http.StatusBadRequest,
+	CodeNoActiveSession:           http.StatusBadRequest,
+	CodeNoFiles:                   http.StatusBadRequest,
+	CodeRefreshTokenRequired:      http.StatusBadRequest,
+	CodeSessionTokenRequired:      http.StatusBadRequest,
+	CodeThetaCommitmentRequired:   http.StatusBadRequest,
+	CodeThetaSeedRequired:         http.StatusBadRequest,
+	CodeThetaVerificationFailed:   http.StatusBadRequest,
+	CodeThemeMismatch:             http.StatusBadRequest,
+	CodeValidationError:           http.StatusBadRequest,
+	CodeWeakPassword:              http.StatusBadRequest,
+	CodeZipNotSupported:           http.StatusBadRequest,
+
+	// Authentication
+	CodeInvalidCredentials:       http.StatusUnauthorized,
+	CodeInvalidLaunchToken:       http.StatusUnauthorized,
+	CodeInvalidOperatorSignature: http.StatusUnauthorized,
+	CodeInvalidOperatorToken:     http.StatusUnauthorized,
+	CodeInvalidRefreshToken:      http.StatusUnauthorized,
+	CodeInvalidToken:             http.StatusUnauthorized,
+	CodeInvalidTwoFactorCode:     http.StatusUnauthorized,
+	CodeRefreshTokenReused:       http.StatusUnauthorized,
+	CodeRefreshTokenRevoked:      http.StatusUnauthorized,
+	CodeUnauthorized:             http.StatusUnauthorized,
+
+	// Authorization and account state
+	CodeAccountInactive:             http.StatusForbidden,
+	CodeAccountLocked:               http.StatusForbidden,
+	CodeAccountSuspended:            http.StatusForbidden,
+	CodeAutoplayNotAllowed:          http.StatusForbidden,
+	CodeCannotDeleteSelf:            http.StatusForbidden,
+	CodeCannotModifySuperAdmin:      http.StatusForbidden,
+	CodeForbidden:                   http.StatusForbidden,
+	CodeGameAccessDenied:            http.StatusForbidden,
+	CodeImpersonationReadOnly:       http.StatusForbidden,
+	CodeImpersonationForbidden:      http.StatusForbidden,
+	CodeKYCRequired:                 http.StatusForbidden,
+	CodeSelfApproval:                http.StatusForbidden,
+	CodeTwoFactorEnrollmentRequired: http.StatusForbidden,
+	CodeTwoFactorRequired:           http.StatusForbidden,
+
+	// Missing resources
+	CodeAdminNotFound:         http.StatusNotFound,
+	CodeArchiveNotFound:       http.StatusNotFound,
+	CodeAssignmentNotFound:    http.StatusNotFound,
+	CodeAutoplayNotFound:      http.StatusNotFound,
+	CodeCanaryNotFound:        http.StatusNotFound,
+	CodeChangeRequestNotFound: http.StatusNotFound,
+	CodeCheckpointNotFound:    http.StatusNotFound,
+	CodeConfigNotFound:        http.StatusNotFound,
+	CodeDisputeNotFound:       http.StatusNotFound,
+	CodeExportNotFound:        http.StatusNotFound,
+	CodeFileNotFound:          http.StatusNotFound,
+	CodeFreeSpinsNotFound:     http.StatusNotFound,
+	CodeGameNotFound:          http.StatusNotFound,
+	CodeGrantNotFound:         http.StatusNotFound,
+	CodeNoActiveConfig:        http.StatusNotFound,
+	CodeNoPendingSpin:         http.StatusNotFound,
+	CodeNoteNotFound:          http.StatusNotFound,
+	CodeNotificationNotFound:  http.StatusNotFound,
+	CodeNotFound:              http.StatusNotFound,
+	CodePFSessionNotFound:     http.StatusNotFound,
+	CodePlayerNotFound:        http.StatusNotFound,
+	CodeSessionNotFound:       http.StatusNotFound,
+	CodeSpinLogNotFound:       http.StatusNotFound,
+	CodeSpinNotFound:          http.StatusNotFound,
+	CodeStatusNotFound:        http.StatusNotFound,
+
+	// State conflicts
+	CodeActiveSessionExists:     http.StatusConflict,
+	CodeAlreadyConverted:        http.StatusConflict,
+	CodeAlreadyLoggedIn:         http.StatusConflict,
+	CodeArchiveAlreadyRestored:  http.StatusConflict,
+	CodeArchiveNotRestored:      http.StatusConflict,
+	CodeAssetInUse:              http.StatusConflict,
+	CodeAutoplayActive:          http.StatusConflict,
+	CodeAutoplayLossLimit:       http.StatusConflict,
+	CodeAutoplayNotActive:       http.StatusConflict,
+	CodeBalanceBusy:             http.StatusConflict,
+	CodeCanaryNotRunning:        http.StatusConflict,
+	CodeCanaryRunning:           http.StatusConflict,
+	CodeChangeApplyFailed:       http.StatusConflict,
+	CodeChangeRequestNotPending: http.StatusConflict,
+	CodeCheckpointMismatch:      http.StatusConflict,
+	CodeClientNonceMismatch:     http.StatusConflict,
+	CodeConfigIsDefault:         http.StatusConflict,
+	CodeConfigInUse:             http.StatusConflict,
+	CodeDisputeClosed:           http.StatusConflict,
+	CodeDisputeExists:           http.StatusConflict,
+	CodeDuplicateCode:           http.StatusConflict,
+	CodeDuplicateCredit:         http.StatusConflict,
+	CodeDuplicateEmail:          http.StatusConflict,
+	CodeDuplicateLevel:          http.StatusConflict,
+	CodeDuplicateName:           http.StatusConflict,
+	CodeDuplicateUsername:       http.StatusConflict,
+	CodeExportNotReady:          http.StatusConflict,
+	CodeFlagExists:              http.StatusConflict,
+	CodeFreeSpinsNotActive:      http.StatusConflict,
+	CodeKYCAlreadyVerified:      http.StatusConflict,
+	CodeKYCNotPending:           http.StatusConflict,
+	CodePFSessionAlreadyEnded:   http.StatusConflict,
+	CodePFSessionExists:         http.StatusConflict,
+	CodePlayerErased:            http.StatusConflict,
+	CodePlayerExists:            http.StatusConflict,
+	CodeRealityCheckRequired:    http.StatusConflict,
+	CodeSessionAlreadyEnded:     http.StatusConflict,
+	CodeSpinNotCheckpointed:     http.StatusConflict,
+	CodeTwoFactorAlreadyEnabled: http.StatusConflict,
+	CodeTwoFactorNotEnabled:     http.StatusConflict,
+	CodeTwoFactorNotEnrolled:    http.StatusConflict,
+
+	// Expired resources
+	CodeChangeRequestExpired: http.StatusGone,
+	CodeSessionExpired:       http.StatusGone,
+	CodeSpinLogsArchived:     http.StatusGone,
+
+	// Throttling
+	CodeQueueFull:          http.StatusTooManyRequests,
+	CodeRateLimitExceeded:  http.StatusTooManyRequests,
+	CodeTooManyExports:     http.StatusTooManyRequests,
+	CodeTooManySimulations: http.StatusTooManyRequests,
+	CodeTooManyUploads:     http.StatusTooManyRequests,
+	CodeTrialAtCapacity:    http.StatusTooManyRequests,
+
+	// Server failures
+	CodeActivatePlayerFailed:            http.StatusInternalServerError,
+	CodeChunkReadFailed:                 http.StatusInternalServerError,
+	CodeChunkWriteFailed:                http.StatusInternalServerError,
+	CodeConversionFailed:                http.StatusInternalServerError,
+	CodeDeactivatePlayerFailed:          http.StatusInternalServerError,
+	CodeDeleteFailed:                    http.StatusInternalServerError,
+	CodeFailedToAcknowledgeRealityCheck: http.StatusInternalServerError,
+	CodeFailedToAcknowledgeSpin:         http.StatusInternalServerError,
+	CodeFailedToActivate:                http.StatusInternalServerError,
+	CodeFailedToActivateAsset:           http.StatusInternalServerError,
+	CodeFailedToActivateConfig:          http.StatusInternalServerError,
+	CodeFailedToActivateGame:            http.StatusInternalServerError,
+	CodeFailedToActivateGameConfig:      http.StatusInternalServerError,
+	CodeFailedToAssign:                  http.StatusInternalServerError,
+	CodeFailedToAssignBaseGame:          http.StatusInternalServerError,
+	CodeFailedToAssignFreeSpins:         http.StatusInternalServerError,
+	CodeFailedToChangePassword:          http.StatusInternalServerError,
+	CodeFailedToCreateAdmin:             http.StatusInternalServerError,
+	CodeFailedToCreateAsset:             http.StatusInternalServerError,
+	CodeFailedToCreateConfig:            http.StatusInternalServerError,
+	CodeFailedToCreateGame:              http.StatusInternalServerError,
+	CodeFailedToCreateGameConfig:        http.StatusInternalServerError,
+	CodeFailedToCreateStorageFolder:     http.StatusInternalServerError,
+	CodeFailedToDeactivate:              http.StatusInternalServerError,
+	CodeFailedToDeactivateAsset:         http.StatusInternalServerError,
+	CodeFailedToDeactivateConfig:        http.StatusInternalServerError,
+	CodeFailedToDeactivateGame:          http.StatusInternalServerError,
+	CodeFailedToDeactivateGameConfig:    http.StatusInternalServerError,
+	CodeFailedToDeleteAdmin:             http.StatusInternalServerError,
+	CodeFailedToDeleteAsset:             http.StatusInternalServerError,
+	CodeFailedToDeleteConfig:            http.StatusInternalServerError,
+	CodeFailedToDeleteGame:              http.StatusInternalServerError,
+	CodeFailedToDeleteGameConfig:        http.StatusInternalServerError,
+	CodeFailedToDisableTwoFactor:        http.StatusInternalServerError,
+	CodeFailedToEndPFSession:            http.StatusInternalServerError,
+	CodeFailedToEvictArchive:            http.StatusInternalServerError,
+	CodeFailedToEndSession:              http.StatusInternalServerError,
+	CodeFailedToEnrollTwoFactor:         http.StatusInternalServerError,
+	CodeFailedToExecuteFreeSpin:         http.StatusInternalServerError,
+	CodeFailedToExecuteSpin:             http.StatusInternalServerError,
+	CodeFailedToGenerateGrid:            http.StatusInternalServerError,
+	CodeFailedToGetAdmin:                http.StatusInternalServerError,
+	CodeFailedToGetAsset:                http.StatusInternalServerError,
+	CodeFailedToGetAssetUsage:           http.StatusInternalServerError,
+	CodeFailedToGetAssignment:           http.StatusInternalServerError,
+	CodeFailedToGetBalance:              http.StatusInternalServerError,
+	CodeFailedToGetConfig:               http.StatusInternalServerError,
+	CodeFailedToGetGame:                 http.StatusInternalServerError,
+	CodeFailedToGetGameConfig:           http.StatusInternalServerError,
+	CodeFailedToGetHistory:              http.StatusInternalServerError,
+	CodeFailedToGetPendingSpin:          http.StatusInternalServerError,
+	CodeFailedToGetSessions:             http.StatusInternalServerError,
+	CodeFailedToGetVerificationData:     http.StatusInternalServerError,
+	CodeFailedToListAdmins:              http.StatusInternalServerError,
+	CodeFailedToListAssets:              http.StatusInternalServerError,
+	CodeFailedToListConfigs:             http.StatusInternalServerError,
+	CodeFailedToListGameConfigs:         http.StatusInternalServerError,
+	CodeFailedToListGames:               http.StatusInternalServerError,
+	CodeFailedToListSegments:            http.StatusInternalServerError,
+	CodeFailedToOptimizeImages:          http.StatusInternalServerError,
+	CodeFailedToRehashAsset:             http.StatusInternalServerError,
+	CodeFailedToRemoveAssignment:        http.StatusInternalServerError,
+	CodeFailedToRenameStorageFolder:     http.StatusInternalServerError,
+	CodeFailedToResetPassword:           http.StatusInternalServerError,
+	CodeFailedToRestoreArchive:          http.StatusInternalServerError,
+	CodeFailedToRestoreAsset:            http.StatusInternalServerError,
+	CodeFailedToRestoreConfig:           http.StatusInternalServerError,
+	CodeFailedToRestoreGame:             http.StatusInternalServerError,
+	CodeFailedToRestoreGameConfig:       http.StatusInternalServerError,
+	CodeFailedToRotateSigningKey:        http.StatusInternalServerError,
+	CodeFailedToSetDefault:              http.StatusInternalServerError,
+	CodeFailedToSetMultiplierLadder:     http.StatusInternalServerError,
+	CodeFailedToSetTriggerRules:         http.StatusInternalServerError,
+	CodeFailedToSetWildFeatures:         http.StatusInternalServerError,
+	CodeFailedToSetWinTiers:             http.StatusInternalServerError,
+	CodeFailedToSetTimingProfiles:       http.StatusInternalServerError,
+	CodeFailedToStartPFSession:          http.StatusInternalServerError,
+	CodeFailedToStartSession:            http.StatusInternalServerError,
+	CodeFailedToSuspend:                 http.StatusInternalServerError,
+	CodeFailedToUpdateAdmin:             http.StatusInternalServerError,
+	CodeFailedToUpdateAsset:             http.StatusInternalServerError,
+	CodeFailedToUpdateBaseGame:          http.StatusInternalServerError,
+	CodeFailedToUpdateConfig:            http.StatusInternalServerError,
+	CodeFailedToUpdateFreeSpins:         http.StatusInternalServerError,
+	CodeFailedToUpdateGame:              http.StatusInternalServerError,
+	CodeFailedToVerifyTwoFactor:         http.StatusInternalServerError,
+	CodeFileOpenFailed:                  http.StatusInternalServerError,
+	CodeFileReadFailed:                  http.StatusInternalServerError,
+	CodeForceLogoutFailed:               http.StatusInternalServerError,
+	CodeGetPlayerFailed:                 http.StatusInternalServerError,
+	CodeInitFailed:                      http.StatusInternalServerError,
+	CodeInternalError:                   http.StatusInternalServerError,
+	CodeLaunchFailed:                    http.StatusInternalServerError,
+	CodeListFailed:                      http.StatusInternalServerError,
+	CodeListPlayersFailed:               http.StatusInternalServerError,
+	CodeLoginFailed:                     http.StatusInternalServerError,
+	CodeLogoutFailed:                    http.StatusInternalServerError,
+	CodePresignFailed:                   http.StatusInternalServerError,
+	CodeRefreshFailed:                   http.StatusInternalServerError,
+	CodeRegistrationFailed:              http.StatusInternalServerError,
+	CodeSearchPlayersFailed:             http.StatusInternalServerError,
+	CodeSimulationTimeout:               http.StatusGatewayTimeout,
+	CodeSpinTimeout:                     http.StatusGatewayTimeout,
+	CodeStatusError:                     http.StatusInternalServerError,
+	CodeTrialError:                      http.StatusInternalServerError,
+	CodeUploadFailed:                    http.StatusInternalServerError,
+	CodeVerificationFailed:              http.StatusInternalServerError,
+
+	// Unavailable features
+	CodeBonusBuysPaused:    http.StatusServiceUnavailable,
+	CodeEventsUnavailable:  http.StatusServiceUnavailable,
+	CodeFeedUnavailable:    http.StatusServiceUnavailable,
+	CodeKYCUnavailable:     http.StatusServiceUnavailable,
+	CodeServiceUnavailable: http.StatusServiceUnavailable,
+	CodeWalletUnavailable:  http.StatusServiceUnavailable,
+}
@@ -0,0 +1,94 @@
+// Package errors is the catalog of machine-readable API error codes.
+// Every error response carries a stable code, the HTTP status it maps to and a
+// localization key, so clients can branch on codes instead of parsing messages.
+package errors
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// Code identifies an API error
+type Code string
+
+// Definition describes a catalogued error code
+type Definition struct {
+	Code       Code   `json:"code"`
+	Status     int    `json:"status"`
+	MessageKey string `json:"message_key"`
+}
+
+// Status returns the HTTP status for the code, 500 for unknown codes
+func (c Code) Status() int {
+	if status, ok := catalog[c]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// MessageKey returns the localization key clients use to translate the error
+func (c Code) MessageKey() string {
+	return "errors." + string(c)
+}
+
+// Lookup returns the definition of a catalogued code
+func Lookup(code Code) (Definition, bool) {
+	status, ok := catalog[code]
+	if !ok {
+		return Definition{}, false
+	}
+	return Definition{Code: code, Status: status, MessageKey: code.MessageKey()}, true
+}
+
+// All returns every catalogued code, by code
+func All() []Definition {
+	defs := make([]Definition, 0, len(catalog))
+	for code, status := range catalog {
+		defs = append(defs, Definition{Code: code, Status: status, MessageKey: code.MessageKey()})
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Code < defs[j].Code })
+	return defs
+}
+
+// Error is an error carrying an API error code
+type Error struct {
+	Code    Code
+	Message string
+	Details interface{}
+	Err     error
+}
+
+// New creates a new coded error
+func New(code Code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+// WithDetails attaches response details to the error
+func (e *Error) WithDetails(details interface{}) *Error {
+	e.Details = details
+	return e
+}
+
+// Wrap creates a coded error wrapping a cause
+func Wrap(code Code, message string, err error) *Error {
+	return &Error{Code: code, Message: message, Err: err}
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s (%v)", e.Code, e.Message, e.Err)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the wrapped error
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Status returns the HTTP status for the error's code
+func (e *Error) Status() int {
+	return e.Code.Status()
+}
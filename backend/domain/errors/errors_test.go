@@ -0,0 +1,40 @@
+package errors
+
+import (
+	stderrors "errors"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog(t *testing.T) {
+	format := regexp.MustCompile(`^[a-z][a-z0-9_]*[a-z0-9]$`)
+	for _, def := range All() {
+		assert.Regexp(t, format, string(def.Code))
+		assert.GreaterOrEqual(t, def.Status, 400, def.Code)
+		assert.Equal(t, "errors."+string(def.Code), def.MessageKey)
+	}
+}
+
+func TestCode_Status(t *testing.T) {
+	assert.Equal(t, http.StatusNotFound, CodeNotFound.Status())
+	assert.Equal(t, http.StatusTooManyRequests, CodeRateLimitExceeded.Status())
+	assert.Equal(t, http.StatusInternalServerError, Code("unknown_code").Status())
+
+	_, ok := Lookup("unknown_code")
+	assert.False(t, ok)
+}
+
+func TestError(t *testing.T) {
+	cause := stderrors.New("redis down")
+	err := Wrap(CodeServiceUnavailable, "Trial mode temporarily unavailable", cause)
+
+	assert.ErrorIs(t, err, cause)
+	assert.Equal(t, http.StatusServiceUnavailable, err.Status())
+
+	var coded *Error
+	assert.True(t, stderrors.As(err, &coded))
+	assert.Equal(t, CodeServiceUnavailable, coded.Code)
+}
@@ -0,0 +1,8 @@
+package exposure
+
+import "errors"
+
+var (
+	// ErrBonusBuysPaused is returned for bonus buys while open liability exceeds its limits
+	ErrBonusBuysPaused = errors.New("bonus buys are paused while open liability exceeds its limits")
+)
@@ -0,0 +1,59 @@
+package exposure
+
+import (
+	"fmt"
+	"time"
+)
+
+// Exposure is the open liability on play players have already paid for
+// Free spins run at the bet they were won or bought with, and every free spin may pay up to
+// the max win cap, so open free spins are what the game could still owe.
+type Exposure struct {
+	ActiveFreeSpinsSessions int64   `json:"active_free_spins_sessions"`
+	RemainingFreeSpins      int64   `json:"remaining_free_spins"`
+	RemainingFreeSpinsStake float64 `json:"remaining_free_spins_stake"` // Remaining spins times their locked bet
+
+	// Triggering spins whose free spins session is still to be created
+	PendingTriggers     int64   `json:"pending_triggers"`
+	PendingTriggerSpins int64   `json:"pending_trigger_spins"` // Free spins the pending triggers award
+	PendingTriggerStake float64 `json:"pending_trigger_stake"` // Pending free spins times their bet
+
+	MaxWinMultiplier int     `json:"max_win_multiplier"`
+	MaxLiability     float64 `json:"max_liability"` // Most the open and pending free spins could pay at the max win cap
+
+	Limits          Limits    `json:"limits"`
+	BonusBuysPaused bool      `json:"bonus_buys_paused"`
+	PausedReason    string    `json:"paused_reason,omitempty"`
+	ComputedAt      time.Time `json:"computed_at"`
+}
+
+// Limits are the circuit breakers on open liability; a zero limit is not enforced
+type Limits struct {
+	MaxLiability               float64 `json:"max_liability,omitempty"`
+	MaxActiveFreeSpinsSessions int64   `json:"max_active_free_spins_sessions,omitempty"`
+}
+
+// Breach describes the first limit the exposure exceeds, or returns "" when it is within all of them
+func (e *Exposure) Breach(limits Limits) string {
+	switch {
+	case limits.MaxLiability > 0 && e.MaxLiability > limits.MaxLiability:
+		return fmt.Sprintf("max liability %.2f exceeds the %.2f limit", e.MaxLiability, limits.MaxLiability)
+	case limits.MaxActiveFreeSpinsSessions > 0 && e.ActiveFreeSpinsSessions > limits.MaxActiveFreeSpinsSessions:
+		return fmt.Sprintf("%d active free spins sessions exceed the %d limit", e.ActiveFreeSpinsSessions, limits.MaxActiveFreeSpinsSessions)
+	}
+	return ""
+}
+
+// FreeSpinsTotals sums the active free spins sessions
+type FreeSpinsTotals struct {
+	Sessions       int64
+	RemainingSpins int64
+	RemainingStake float64
+}
+
+// TriggerTotals sums the pending free spins triggers with one scatter count
+type TriggerTotals struct {
+	ScatterCount int
+	Triggers     int64
+	Stake        float64 // Sum of the triggering bets
+}
@@ -0,0 +1,14 @@
+package exposure
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for reading open liability
+type Repository interface {
+	// OpenFreeSpins sums the active free spins sessions with spins left
+	OpenFreeSpins(ctx context.Context) (*FreeSpinsTotals, error)
+	// PendingTriggers sums, by scatter count, triggering spins since a time whose free spins were not awarded yet
+	PendingTriggers(ctx context.Context, since time.Time) ([]TriggerTotals, error)
+}
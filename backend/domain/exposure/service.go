@@ -0,0 +1,17 @@
+package exposure
+
+import "context"
+
+// Gate enforces the liability circuit breakers on bonus buys
+type Gate interface {
+	// CheckBonusBuy returns ErrBonusBuysPaused while the last measured exposure exceeds its limits
+	CheckBonusBuy(ctx context.Context) error
+}
+
+// Service defines the business logic interface for liability exposure
+type Service interface {
+	Gate
+
+	// Refresh measures the exposure now and trips or resets the circuit breakers
+	Refresh(ctx context.Context) (*Exposure, error)
+}
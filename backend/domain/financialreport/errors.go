@@ -0,0 +1,11 @@
+package financialreport
+
+import "errors"
+
+var (
+	// ErrInvalidRange is returned when a report range is inverted or too long
+	ErrInvalidRange = errors.New("invalid report date range")
+
+	// ErrDayNotOver is returned when reporting a day that has not ended yet
+	ErrDayNotOver = errors.New("only days that have ended can be reported")
+)
@@ -0,0 +1,62 @@
+package financialreport
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DailySummary is one UTC day of real-money play for one game and operator
+// Wagered counts what paid spins cost, so a bonus buy counts its price and a free spin counts
+// nothing; free spin wins are in TotalWon and broken out as FreeSpinCost. GGR is wagered minus
+// won; bonus credits are reported beside it as BonusCost, not deducted from it.
+type DailySummary struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ReportDate time.Time  `gorm:"type:date;not null" json:"report_date"`
+	GameID     *uuid.UUID `gorm:"type:uuid" json:"game_id,omitempty"` // nil for cross-game accounts
+	OperatorID string     `gorm:"not null" json:"operator_id"`        // Empty for players who registered directly
+
+	Spins        int64   `gorm:"not null" json:"spins"`
+	PaidSpins    int64   `gorm:"not null" json:"paid_spins"`
+	TotalWagered float64 `gorm:"type:decimal(20,2);not null" json:"total_wagered"`
+	TotalWon     float64 `gorm:"type:decimal(20,2);not null" json:"total_won"`
+	GGR          float64 `gorm:"column:ggr;type:decimal(20,2);not null" json:"ggr"`
+	FreeSpinCost float64 `gorm:"type:decimal(20,2);not null" json:"free_spin_cost"`
+	BonusCost    float64 `gorm:"type:decimal(20,2);not null" json:"bonus_cost"`
+
+	// The game has no jackpots, so nothing is contributed; the column keeps the report format stable
+	JackpotContributions float64 `gorm:"type:decimal(20,2);not null" json:"jackpot_contributions"`
+
+	GeneratedAt time.Time `gorm:"not null" json:"generated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (DailySummary) TableName() string {
+	return "daily_financial_summaries"
+}
+
+// SpinTotals sums one day's spins for one game and operator
+type SpinTotals struct {
+	GameID       *uuid.UUID
+	OperatorID   string
+	Spins        int64
+	PaidSpins    int64
+	Wagered      float64
+	Won          float64
+	FreeSpinsWon float64
+}
+
+// BonusTotals sums one day's bonus credits for one game and operator
+type BonusTotals struct {
+	GameID     *uuid.UUID
+	OperatorID string
+	Amount     float64
+}
+
+// ListFilters selects report rows; From and To are inclusive UTC days
+type ListFilters struct {
+	From       time.Time
+	To         time.Time
+	GameID     *uuid.UUID
+	OperatorID *string
+}
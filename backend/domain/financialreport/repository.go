@@ -0,0 +1,21 @@
+package financialreport
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for financial report data access
+type Repository interface {
+	// SpinTotals sums spins in [from, to) by game and operator
+	SpinTotals(ctx context.Context, from, to time.Time) ([]SpinTotals, error)
+	// BonusTotals sums bonus credits in [from, to) by game and operator
+	BonusTotals(ctx context.Context, from, to time.Time) ([]BonusTotals, error)
+
+	// ReplaceDay swaps the day's report rows for rows in one transaction
+	ReplaceDay(ctx context.Context, day time.Time, rows []*DailySummary) error
+	// LatestDay returns the most recent reported day, or nil if none is
+	LatestDay(ctx context.Context) (*time.Time, error)
+	// List returns report rows ordered by day, game and operator
+	List(ctx context.Context, filters ListFilters) ([]*DailySummary, error)
+}
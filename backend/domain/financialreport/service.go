@@ -0,0 +1,19 @@
+package financialreport
+
+import (
+	"context"
+	"time"
+)
+
+// Service defines the business logic interface for daily financial reports
+type Service interface {
+	// Generate (re)builds the report rows of one ended UTC day
+	Generate(ctx context.Context, day time.Time) ([]*DailySummary, error)
+	// GenerateDue builds every ended day since the latest report, within the lookback, and
+	// returns how many days it built
+	GenerateDue(ctx context.Context, now time.Time) (int, error)
+
+	List(ctx context.Context, filters ListFilters) ([]*DailySummary, error)
+	// CSV renders the selected report rows as CSV
+	CSV(ctx context.Context, filters ListFilters) ([]byte, error)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
 )
 
 // Repository defines the interface for free spins data access
@@ -32,6 +33,9 @@ type Repository interface {
 	// CompleteSession marks a free spins session as completed
 	CompleteSession(ctx context.Context, id uuid.UUID) error
 
+	// SetStickyWilds replaces the wilds held in place for the next spin
+	SetStickyWilds(ctx context.Context, id uuid.UUID, positions game.GridPositions) error
+
 	// AddSpins adds additional spins (for retrigger)
 	AddSpins(ctx context.Context, id uuid.UUID, additionalSpins int) error
 
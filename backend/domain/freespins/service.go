@@ -24,6 +24,10 @@ type Service interface {
 
 	// RetriggerFreeSpins adds additional spins to an active session
 	RetriggerFreeSpins(ctx context.Context, freeSpinsSessionID uuid.UUID, scatterCount int) error
+
+	// ExecuteAllFreeSpins plays the player's free spins session to completion in one call
+	// Each spin is settled and provably fair exactly as if requested one by one
+	ExecuteAllFreeSpins(ctx context.Context, playerID, freeSpinsSessionID uuid.UUID, clientSeed string) (*AutoplayResult, error)
 }
 
 // FreeSpinsStatus represents the status of a free spins session
@@ -36,3 +40,26 @@ type FreeSpinsStatus struct {
 	LockedBetAmount    float64   `json:"locked_bet_amount"`
 	TotalWon           float64   `json:"total_won"`
 }
+
+// AutoplayResult summarises a free spins session played to completion server-side
+type AutoplayResult struct {
+	FreeSpinsSessionID uuid.UUID      `json:"free_spins_session_id"`
+	SpinsPlayed        int            `json:"spins_played"`
+	TotalWon           float64        `json:"total_won"`
+	RemainingSpins     int            `json:"remaining_spins"` // Non-zero only when the per-request spin cap was reached
+	NewBalance         float64        `json:"new_balance"`
+	Spins              []AutoplaySpin `json:"spins"`
+}
+
+// AutoplaySpin is the compact outcome of one free spin, enough for a fast-forward presentation
+type AutoplaySpin struct {
+	SpinID          uuid.UUID `json:"spin_id"`
+	Grid            spin.Grid `json:"grid"`
+	CascadeCount    int       `json:"cascade_count"`
+	TotalWin        float64   `json:"total_win"`
+	ScatterCount    int       `json:"scatter_count"`
+	Retriggered     bool      `json:"retriggered,omitempty"`
+	AdditionalSpins int       `json:"additional_spins,omitempty"`
+	Nonce           int64     `json:"nonce,omitempty"`
+	SpinHash        string    `json:"spin_hash,omitempty"`
+}
@@ -0,0 +1,58 @@
+package freespins
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/session"
+)
+
+// SettlementPolicy decides how unplayed free spins are paid out when their game session is force-ended
+type SettlementPolicy string
+
+const (
+	// SettlementPolicyAutoplay plays the remaining spins server-side, exactly as the player would have
+	SettlementPolicyAutoplay SettlementPolicy = "autoplay"
+	// SettlementPolicyAverage credits each remaining spin at the configured average feature value
+	SettlementPolicyAverage SettlementPolicy = "average"
+)
+
+// Valid reports whether p is a known settlement policy
+func (p SettlementPolicy) Valid() bool {
+	switch p {
+	case SettlementPolicyAutoplay, SettlementPolicyAverage:
+		return true
+	}
+	return false
+}
+
+// Settlement is the audit record of an incomplete free spins session paid out on a forced session end
+type Settlement struct {
+	ID                 uuid.UUID         `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	FreeSpinsSessionID uuid.UUID         `gorm:"type:uuid;not null;index" json:"free_spins_session_id"`
+	SessionID          uuid.UUID         `gorm:"type:uuid;not null;index" json:"session_id"`
+	PlayerID           uuid.UUID         `gorm:"type:uuid;not null;index" json:"player_id"`
+	Reason             session.EndReason `gorm:"type:varchar(20);not null" json:"reason"`
+	Policy             SettlementPolicy  `gorm:"type:varchar(20);not null" json:"policy"` // Policy actually applied, average when autoplay fell back
+	RemainingSpins     int               `gorm:"not null" json:"remaining_spins"`         // Unplayed spins when settlement started
+	SpinsPlayed        int               `gorm:"not null;default:0" json:"spins_played"`  // Spins played server-side under autoplay
+	SpinsValued        int               `gorm:"not null;default:0" json:"spins_valued"`  // Spins paid at the average value
+	AmountCredited     float64           `gorm:"type:decimal(15,2);not null;default:0" json:"amount_credited"`
+	Note               string            `gorm:"type:text;not null;default:''" json:"note,omitempty"`
+	CreatedAt          time.Time         `gorm:"not null;default:now()" json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Settlement) TableName() string {
+	return "free_spins_settlements"
+}
+
+// SettlementRepository stores free spins settlement audit records
+type SettlementRepository interface {
+	// Create records a settlement
+	Create(ctx context.Context, settlement *Settlement) error
+
+	// ListBySession returns the settlements made when a game session was force-ended, oldest first
+	ListBySession(ctx context.Context, sessionID uuid.UUID) ([]*Settlement, error)
+}
@@ -12,9 +12,30 @@ var (
 	// ErrGameConfigNotFound is returned when a game config is not found
 	ErrGameConfigNotFound = errors.New("game config not found")
 
+	// ErrNameTaken is returned when restoring a game or asset whose name a live one now uses
+	ErrNameTaken = errors.New("name is already used by another game or asset")
+
+	// ErrAssetInUse is returned when deleting an asset a game config still uses
+	ErrAssetInUse = errors.New("asset is used by a game config")
+
 	// ErrNoActiveConfig is returned when no active config exists for a game
 	ErrNoActiveConfig = errors.New("no active asset configuration for this game")
 
 	// ErrInvalidGameID is returned when the game ID format is invalid
 	ErrInvalidGameID = errors.New("invalid game ID format")
+
+	// ErrInvalidMultiplierLadder is returned when a multiplier ladder fails validation
+	ErrInvalidMultiplierLadder = errors.New("invalid multiplier ladder")
+
+	// ErrInvalidTriggerRules is returned when free spins trigger rules fail validation
+	ErrInvalidTriggerRules = errors.New("invalid free spins trigger rules")
+
+	// ErrInvalidWildFeatures is returned when wild features fail validation
+	ErrInvalidWildFeatures = errors.New("invalid wild features")
+
+	// ErrInvalidWinTiers is returned when win presentation tiers fail validation
+	ErrInvalidWinTiers = errors.New("invalid win tiers")
+
+	// ErrInvalidTimingProfiles is returned when animation timing profiles fail validation
+	ErrInvalidTimingProfiles = errors.New("invalid timing profiles")
 )
@@ -2,10 +2,13 @@ package game
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"path"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,14 +17,15 @@ import (
 
 // Game represents a game definition
 type Game struct {
-	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	Name        string    `gorm:"type:varchar(100);uniqueIndex;not null" json:"name"`
-	Description *string   `gorm:"type:text" json:"description"`
-	DevURL      *string   `gorm:"column:dev_url;type:text" json:"dev_url"`
-	ProdURL     *string   `gorm:"column:prod_url;type:text" json:"prod_url"`
-	IsActive    bool      `gorm:"default:true" json:"is_active"`
-	CreatedAt   time.Time `gorm:"default:now()" json:"created_at"`
-	UpdatedAt   time.Time `gorm:"default:now()" json:"updated_at"`
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Name        string     `gorm:"type:varchar(100);uniqueIndex;not null" json:"name"`
+	Description *string    `gorm:"type:text" json:"description"`
+	DevURL      *string    `gorm:"column:dev_url;type:text" json:"dev_url"`
+	ProdURL     *string    `gorm:"column:prod_url;type:text" json:"prod_url"`
+	IsActive    bool       `gorm:"default:true" json:"is_active"`
+	CreatedAt   time.Time  `gorm:"default:now()" json:"created_at"`
+	UpdatedAt   time.Time  `gorm:"default:now()" json:"updated_at"`
+	DeletedAt   *time.Time `gorm:"type:timestamp with time zone" json:"deleted_at,omitempty"`
 }
 
 // TableName specifies the table name for GORM
@@ -40,9 +44,11 @@ type Asset struct {
 	Images          json.RawMessage `gorm:"type:jsonb;not null" json:"images"`
 	Audios          json.RawMessage `gorm:"type:jsonb;default:'{}'" json:"audios"`
 	Videos          json.RawMessage `gorm:"type:jsonb;default:'{}'" json:"videos"`
+	IsPrivate       bool            `gorm:"column:is_private;default:false" json:"is_private"`
 	IsActive        bool            `gorm:"default:true" json:"is_active"`
 	CreatedAt       time.Time       `gorm:"default:now()" json:"created_at"`
 	UpdatedAt       time.Time       `gorm:"default:now()" json:"updated_at"`
+	DeletedAt       *time.Time      `gorm:"type:timestamp with time zone" json:"deleted_at,omitempty"`
 }
 
 // TableName specifies the table name for GORM
@@ -80,12 +86,24 @@ func GenerateObjectName(assetName string) string {
 
 // GameConfig represents the configuration linking a game to its assets
 type GameConfig struct {
-	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	GameID    uuid.UUID `gorm:"type:uuid;not null" json:"game_id"`
-	AssetID   uuid.UUID `gorm:"type:uuid;not null" json:"asset_id"`
-	IsActive  bool      `gorm:"default:true" json:"is_active"`
-	CreatedAt time.Time `gorm:"default:now()" json:"created_at"`
-	UpdatedAt time.Time `gorm:"default:now()" json:"updated_at"`
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	GameID    uuid.UUID  `gorm:"type:uuid;not null" json:"game_id"`
+	AssetID   uuid.UUID  `gorm:"type:uuid;not null" json:"asset_id"`
+	IsActive  bool       `gorm:"default:true" json:"is_active"`
+	CreatedAt time.Time  `gorm:"default:now()" json:"created_at"`
+	UpdatedAt time.Time  `gorm:"default:now()" json:"updated_at"`
+	DeletedAt *time.Time `gorm:"type:timestamp with time zone" json:"deleted_at,omitempty"`
+
+	// Cascade multipliers for the game while this config is active (nil uses the engine default)
+	MultiplierLadder *MultiplierLadder `gorm:"type:jsonb" json:"multiplier_ladder,omitempty"`
+	// Free spins trigger rules while this config is active (nil uses the engine default)
+	TriggerRules *TriggerRules `gorm:"type:jsonb" json:"trigger_rules,omitempty"`
+	// Wild behaviors while this config is active (nil disables all wild features)
+	WildFeatures *WildFeatures `gorm:"type:jsonb" json:"wild_features,omitempty"`
+	// Win presentation tiers while this config is active (nil uses the default tiers)
+	WinTiers *WinTiers `gorm:"type:jsonb" json:"win_tiers,omitempty"`
+	// Normal and turbo animation timing while this config is active (nil uses the default timing)
+	TimingProfiles *TimingProfiles `gorm:"type:jsonb" json:"timing_profiles,omitempty"`
 
 	// Relations
 	Game  *Game  `gorm:"foreignKey:GameID" json:"game,omitempty"`
@@ -97,14 +115,157 @@ func (GameConfig) TableName() string {
 	return "game_configs"
 }
 
+// AssetFile records the content hash and size of one file stored under an asset's folder
+// Path is relative to the asset base URL (same form as the paths in Images/Audios/Videos)
+type AssetFile struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	AssetID     uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_asset_files_asset_path" json:"asset_id"`
+	Path        string    `gorm:"type:varchar(500);not null;uniqueIndex:idx_asset_files_asset_path" json:"path"`
+	SHA256      string    `gorm:"column:sha256;type:char(64);not null" json:"sha256"`
+	Size        int64     `gorm:"not null" json:"size"`
+	ContentType string    `gorm:"type:varchar(100);not null;default:''" json:"content_type"`
+	CreatedAt   time.Time `gorm:"default:now()" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"default:now()" json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (AssetFile) TableName() string {
+	return "asset_files"
+}
+
 // GameAssetsResponse is the API response for game assets
 type GameAssetsResponse struct {
-	ID              uuid.UUID         `json:"id"`
-	Name            string            `json:"name"`
-	SpritesheetJSON json.RawMessage   `json:"spritesheetJson"`
-	Images          map[string]string `json:"images"`
-	Audios          map[string]any    `json:"audios"`
-	Videos          map[string]any    `json:"videos"`
+	ID              uuid.UUID                 `json:"id"`
+	Name            string                    `json:"name"`
+	SpritesheetJSON json.RawMessage           `json:"spritesheetJson"`
+	Images          map[string]string         `json:"images"`
+	ImageVariants   map[string][]ImageVariant `json:"imageVariants,omitempty"`
+	Audios          map[string]any            `json:"audios"`
+	Videos          map[string]any            `json:"videos"`
+	// URLsExpireAt is set for private assets whose URLs are signed and expire
+	URLsExpireAt *time.Time `json:"urlsExpireAt,omitempty"`
+}
+
+// AssetManifestFile is one entry of the asset manifest
+type AssetManifestFile struct {
+	Path   string `json:"path"`
+	URL    string `json:"url"`
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// AssetManifestResponse is the API response for a game's asset manifest
+// Hash changes whenever any file is added, removed or modified, so clients can
+// skip the per-file comparison when it matches their cached manifest
+type AssetManifestResponse struct {
+	GameID    uuid.UUID           `json:"gameId"`
+	AssetID   uuid.UUID           `json:"assetId"`
+	BaseURL   string              `json:"baseUrl,omitempty"`
+	Hash      string              `json:"hash"`
+	TotalSize int64               `json:"totalSize"`
+	Files     []AssetManifestFile `json:"files"`
+	// URLsExpireAt is set for private assets whose file URLs are signed and expire
+	URLsExpireAt *time.Time `json:"urlsExpireAt,omitempty"`
+}
+
+// ManifestHash computes a stable hash over file paths and content hashes
+// Files are hashed in path order, so the result does not depend on input order
+func ManifestHash(files []*AssetFile) string {
+	sorted := make([]*AssetFile, len(files))
+	copy(sorted, files)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Path < sorted[j].Path })
+
+	h := sha256.New()
+	for _, f := range sorted {
+		fmt.Fprintf(h, "%s\x00%s\n", f.Path, f.SHA256)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ImageVariantsKey is the reserved key in Asset.Images holding optimized image variants
+// Value format: { "<image key>": [ImageVariant, ...] }
+const ImageVariantsKey = "variants"
+
+// ImageVariant is an optimized (resized and/or re-encoded) rendition of a theme image
+// Path is relative to the asset base URL when stored, and a full URL in API responses
+type ImageVariant struct {
+	Path   string `json:"path"`
+	Format string `json:"format"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// ParseAssetImages splits Asset.Images JSON into plain image paths and optimized variants
+// Non-string entries other than the variants key are ignored
+func ParseAssetImages(raw json.RawMessage) (map[string]string, map[string][]ImageVariant, error) {
+	images := make(map[string]string)
+	variants := make(map[string][]ImageVariant)
+	if len(raw) == 0 {
+		return images, variants, nil
+	}
+
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse images JSON: %w", err)
+	}
+
+	for key, value := range entries {
+		if key == ImageVariantsKey {
+			if err := json.Unmarshal(value, &variants); err != nil {
+				return nil, nil, fmt.Errorf("failed to parse image variants: %w", err)
+			}
+			continue
+		}
+		var path string
+		if err := json.Unmarshal(value, &path); err == nil {
+			images[key] = path
+		}
+	}
+
+	return images, variants, nil
+}
+
+// CollectAssetReferences returns every file path referenced by an asset's JSON fields
+// Walks images, audios, videos and spritesheet values recursively (including image
+// variants and {png, json} animation objects). Only string values that carry a file
+// extension count as paths; spritesheet frame names are keys and are not collected.
+func CollectAssetReferences(a *Asset) (map[string]struct{}, error) {
+	refs := make(map[string]struct{})
+	fields := map[string]json.RawMessage{
+		"images":           a.Images,
+		"audios":           a.Audios,
+		"videos":           a.Videos,
+		"spritesheet_json": a.SpritesheetJSON,
+	}
+	for name, raw := range fields {
+		if len(raw) == 0 {
+			continue
+		}
+		var value any
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+		collectPaths(value, refs)
+	}
+	return refs, nil
+}
+
+// collectPaths adds path-like string leaves of a decoded JSON value to refs
+func collectPaths(value any, refs map[string]struct{}) {
+	switch v := value.(type) {
+	case string:
+		if path.Ext(v) != "" && !strings.Contains(v, "://") {
+			refs[strings.TrimPrefix(v, "/")] = struct{}{}
+		}
+	case []any:
+		for _, item := range v {
+			collectPaths(item, refs)
+		}
+	case map[string]any:
+		for _, item := range v {
+			collectPaths(item, refs)
+		}
+	}
 }
 
 // SymbolVideos represents win and loop videos for a symbol
@@ -0,0 +1,61 @@
+package game
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// MaxLadderSteps is the maximum number of steps in a multiplier progression
+	MaxLadderSteps = 20
+	// MaxLadderMultiplier is the highest multiplier a step may award
+	MaxLadderMultiplier = 1000
+)
+
+// MultiplierLadder is a game's cascade multiplier progression
+// Step N applies to cascade N; the last step applies to every further cascade.
+type MultiplierLadder struct {
+	BaseGame  []int `json:"base_game"`
+	FreeSpins []int `json:"free_spins"`
+}
+
+// Validate checks that both progressions are usable
+func (l *MultiplierLadder) Validate() error {
+	if err := validateSteps("base_game", l.BaseGame); err != nil {
+		return err
+	}
+	return validateSteps("free_spins", l.FreeSpins)
+}
+
+// validateSteps checks one progression: 1-20 steps, 1-1000x, never decreasing
+func validateSteps(name string, steps []int) error {
+	if len(steps) == 0 || len(steps) > MaxLadderSteps {
+		return fmt.Errorf("%w: %s must have 1-%d steps", ErrInvalidMultiplierLadder, name, MaxLadderSteps)
+	}
+	for i, step := range steps {
+		if step < 1 || step > MaxLadderMultiplier {
+			return fmt.Errorf("%w: %s multipliers must be between 1 and %d", ErrInvalidMultiplierLadder, name, MaxLadderMultiplier)
+		}
+		if i > 0 && step < steps[i-1] {
+			return fmt.Errorf("%w: %s multipliers must not decrease", ErrInvalidMultiplierLadder, name)
+		}
+	}
+	return nil
+}
+
+// Scan implements the sql.Scanner interface for MultiplierLadder
+func (l *MultiplierLadder) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, l)
+	case string:
+		return json.Unmarshal([]byte(v), l)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface for MultiplierLadder
+func (l MultiplierLadder) Value() (driver.Value, error) {
+	return json.Marshal(l)
+}
@@ -26,10 +26,13 @@ type AssetUpdate struct {
 	Images          json.RawMessage
 	Audios          json.RawMessage
 	Videos          json.RawMessage
+	IsPrivate       *bool
 	IsActive        *bool
 }
 
 // Repository defines the interface for game data access
+// Games, assets and game configs are soft-deleted: deleting one deactivates it and hides it
+// from every lookup until it is restored. Restored rows stay inactive.
 type Repository interface {
 	// Game methods
 	GetGameByID(ctx context.Context, id uuid.UUID) (*Game, error)
@@ -38,20 +41,36 @@ type Repository interface {
 	CreateGame(ctx context.Context, g *Game) error
 	UpdateGame(ctx context.Context, id uuid.UUID, update *GameUpdate) (*Game, error)
 	DeleteGame(ctx context.Context, id uuid.UUID) error
+	RestoreGame(ctx context.Context, id uuid.UUID) (*Game, error)
 
 	// Asset methods
 	GetAssetByID(ctx context.Context, id uuid.UUID) (*Asset, error)
+	GetAssetByObjectName(ctx context.Context, objectName string) (*Asset, error)
 	ListAssets(ctx context.Context, page, pageSize int) ([]*Asset, int64, error)
 	CreateAsset(ctx context.Context, a *Asset) error
 	UpdateAsset(ctx context.Context, id uuid.UUID, update *AssetUpdate) (*Asset, error)
 	DeleteAsset(ctx context.Context, id uuid.UUID) error
+	RestoreAsset(ctx context.Context, id uuid.UUID) (*Asset, error)
+
+	// AssetFile methods
+	UpsertAssetFile(ctx context.Context, f *AssetFile) error
+	ListAssetFiles(ctx context.Context, assetID uuid.UUID) ([]*AssetFile, error)
+	DeleteAssetFile(ctx context.Context, assetID uuid.UUID, path string) error
+	DeleteAssetFiles(ctx context.Context, assetID uuid.UUID) error
 
 	// GameConfig methods
 	GetActiveAssetForGame(ctx context.Context, gameID uuid.UUID) (*Asset, error)
+	GetActiveGameConfig(ctx context.Context, gameID uuid.UUID) (*GameConfig, error)
+	UpdateGameConfigLadder(ctx context.Context, id uuid.UUID, ladder *MultiplierLadder) (*GameConfig, error)
+	UpdateGameConfigTriggerRules(ctx context.Context, id uuid.UUID, rules *TriggerRules) (*GameConfig, error)
+	UpdateGameConfigWildFeatures(ctx context.Context, id uuid.UUID, features *WildFeatures) (*GameConfig, error)
+	UpdateGameConfigWinTiers(ctx context.Context, id uuid.UUID, tiers *WinTiers) (*GameConfig, error)
+	UpdateGameConfigTimingProfiles(ctx context.Context, id uuid.UUID, profiles *TimingProfiles) (*GameConfig, error)
 	GetGameConfigByID(ctx context.Context, id uuid.UUID) (*GameConfig, error)
 	ListGameConfigs(ctx context.Context, page, pageSize int) ([]*GameConfig, int64, error)
 	CreateGameConfig(ctx context.Context, c *GameConfig) error
 	DeleteGameConfig(ctx context.Context, id uuid.UUID) error
+	RestoreGameConfig(ctx context.Context, id uuid.UUID) (*GameConfig, error)
 	ActivateGameConfig(ctx context.Context, id uuid.UUID) (*GameConfig, error)
 	DeactivateGameConfig(ctx context.Context, id uuid.UUID) (*GameConfig, error)
 }
@@ -0,0 +1,66 @@
+package game
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// MaxTimingMs is the longest any single timing value may be
+const MaxTimingMs = 10000
+
+// TimingProfile is the suggested animation timing of one play speed, in milliseconds
+type TimingProfile struct {
+	ReelSpinMs int `json:"reel_spin_ms"` // Reels spinning before the grid lands
+	CascadeMs  int `json:"cascade_ms"`   // Highlighting a cascade's wins, clearing them and dropping new tiles
+	ExpandMs   int `json:"expand_ms"`    // Extra time for a cascade whose wilds expanded first
+}
+
+// TimingProfiles are the animation timings of a game at normal and turbo speed
+type TimingProfiles struct {
+	Normal TimingProfile `json:"normal"`
+	Turbo  TimingProfile `json:"turbo"`
+	// Shortest a spin may be presented at either speed, e.g. a regulator's minimum spin duration (0 for none)
+	MinSpinMs int `json:"min_spin_ms"`
+}
+
+// DefaultTimingProfiles are used when a game config sets no timing
+var DefaultTimingProfiles = TimingProfiles{
+	Normal: TimingProfile{ReelSpinMs: 1200, CascadeMs: 900, ExpandMs: 500},
+	Turbo:  TimingProfile{ReelSpinMs: 400, CascadeMs: 350, ExpandMs: 200},
+}
+
+// Validate checks that every timing is in range and turbo is never slower than normal
+func (t *TimingProfiles) Validate() error {
+	for _, ms := range []int{
+		t.Normal.ReelSpinMs, t.Normal.CascadeMs, t.Normal.ExpandMs,
+		t.Turbo.ReelSpinMs, t.Turbo.CascadeMs, t.Turbo.ExpandMs,
+		t.MinSpinMs,
+	} {
+		if ms < 0 || ms > MaxTimingMs {
+			return fmt.Errorf("%w: timings must be between 0 and %d ms", ErrInvalidTimingProfiles, MaxTimingMs)
+		}
+	}
+	if t.Turbo.ReelSpinMs > t.Normal.ReelSpinMs ||
+		t.Turbo.CascadeMs > t.Normal.CascadeMs ||
+		t.Turbo.ExpandMs > t.Normal.ExpandMs {
+		return fmt.Errorf("%w: turbo timings must not be slower than normal", ErrInvalidTimingProfiles)
+	}
+	return nil
+}
+
+// Scan implements the sql.Scanner interface for TimingProfiles
+func (t *TimingProfiles) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, t)
+	case string:
+		return json.Unmarshal([]byte(v), t)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface for TimingProfiles
+func (t TimingProfiles) Value() (driver.Value, error) {
+	return json.Marshal(t)
+}
@@ -0,0 +1,53 @@
+package game
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// MaxTriggerScatters is the most scatters a trigger may require (the visible 5x4 grid)
+	MaxTriggerScatters = 20
+	// MaxFreeSpinsAward is the most spins a trigger may award per scatter count step
+	MaxFreeSpinsAward = 100
+)
+
+// TriggerRules are a game's free spins trigger rules
+// MinScatters award BaseAward spins; each further scatter adds ExtraPerScatter.
+type TriggerRules struct {
+	MinScatters     int  `json:"min_scatters"`
+	BaseAward       int  `json:"base_award"`
+	ExtraPerScatter int  `json:"extra_per_scatter"`
+	Retrigger       bool `json:"retrigger"`
+}
+
+// Validate checks that the rules can trigger and award a sane number of spins
+func (r *TriggerRules) Validate() error {
+	if r.MinScatters < 2 || r.MinScatters > MaxTriggerScatters {
+		return fmt.Errorf("%w: min_scatters must be between 2 and %d", ErrInvalidTriggerRules, MaxTriggerScatters)
+	}
+	if r.BaseAward < 1 || r.BaseAward > MaxFreeSpinsAward {
+		return fmt.Errorf("%w: base_award must be between 1 and %d", ErrInvalidTriggerRules, MaxFreeSpinsAward)
+	}
+	if r.ExtraPerScatter < 0 || r.ExtraPerScatter > MaxFreeSpinsAward {
+		return fmt.Errorf("%w: extra_per_scatter must be between 0 and %d", ErrInvalidTriggerRules, MaxFreeSpinsAward)
+	}
+	return nil
+}
+
+// Scan implements the sql.Scanner interface for TriggerRules
+func (r *TriggerRules) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface for TriggerRules
+func (r TriggerRules) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
@@ -0,0 +1,70 @@
+package game
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// MaxWildMultiplier is the highest multiplier a wild may apply to the ways it completes
+const MaxWildMultiplier = 10
+
+// WildFeatures are a game's optional wild behaviors
+// A WildMultiplier below 2 disables wild multipliers.
+type WildFeatures struct {
+	StickyWilds    bool `json:"sticky_wilds"`
+	ExpandingWilds bool `json:"expanding_wilds"`
+	WildMultiplier int  `json:"wild_multiplier"`
+}
+
+// Validate checks that the wild multiplier is in range
+func (f *WildFeatures) Validate() error {
+	if f.WildMultiplier < 0 || f.WildMultiplier > MaxWildMultiplier {
+		return fmt.Errorf("%w: wild_multiplier must be between 0 and %d", ErrInvalidWildFeatures, MaxWildMultiplier)
+	}
+	return nil
+}
+
+// Scan implements the sql.Scanner interface for WildFeatures
+func (f *WildFeatures) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, f)
+	case string:
+		return json.Unmarshal([]byte(v), f)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface for WildFeatures
+func (f WildFeatures) Value() (driver.Value, error) {
+	return json.Marshal(f)
+}
+
+// GridPosition is a reel/row position on the spin grid
+type GridPosition struct {
+	Reel int `json:"reel"`
+	Row  int `json:"row"`
+}
+
+// GridPositions is a list of grid positions stored as JSONB
+type GridPositions []GridPosition
+
+// Scan implements the sql.Scanner interface for GridPositions
+func (p *GridPositions) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, p)
+	case string:
+		return json.Unmarshal([]byte(v), p)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface for GridPositions
+func (p GridPositions) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return json.Marshal(p)
+}
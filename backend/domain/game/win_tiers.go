@@ -0,0 +1,88 @@
+package game
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// MaxWinTiers is the most win tiers a config may define
+const MaxWinTiers = 10
+
+// WinTier is a band of win sizes the client presents the same way
+// A win belongs to the highest tier whose MinMultiplier it reaches, measured against the bet.
+// Video and Audio are keys into the config asset's videos and audios; empty plays nothing.
+type WinTier struct {
+	Name          string  `json:"name"`
+	MinMultiplier float64 `json:"min_multiplier"`
+	Video         string  `json:"video,omitempty"`
+	Audio         string  `json:"audio,omitempty"`
+}
+
+// WinTiers are a game's win presentation tiers, in ascending MinMultiplier order
+type WinTiers []WinTier
+
+// DefaultWinTiers are used when a game config sets no tiers
+var DefaultWinTiers = WinTiers{
+	{Name: "small", MinMultiplier: 0, Video: "win_small", Audio: "win_small"},
+	{Name: "medium", MinMultiplier: 5, Video: "win_medium", Audio: "win_medium"},
+	{Name: "big", MinMultiplier: 20, Video: "win_big", Audio: "win_big"},
+	{Name: "mega", MinMultiplier: 100, Video: "win_mega", Audio: "win_mega"},
+	{Name: "jackpot", MinMultiplier: 500, Video: "win_jackpot", Audio: "win_jackpot"},
+}
+
+// Validate checks that the tiers are named, unique and in ascending order
+func (t WinTiers) Validate() error {
+	if len(t) == 0 || len(t) > MaxWinTiers {
+		return fmt.Errorf("%w: must have 1-%d tiers", ErrInvalidWinTiers, MaxWinTiers)
+	}
+	names := make(map[string]bool, len(t))
+	for i, tier := range t {
+		if tier.Name == "" || len(tier.Name) > 50 {
+			return fmt.Errorf("%w: tier names must be 1-50 characters", ErrInvalidWinTiers)
+		}
+		if names[tier.Name] {
+			return fmt.Errorf("%w: tier %q is defined twice", ErrInvalidWinTiers, tier.Name)
+		}
+		names[tier.Name] = true
+		if tier.MinMultiplier < 0 {
+			return fmt.Errorf("%w: min_multiplier must not be negative", ErrInvalidWinTiers)
+		}
+		if i > 0 && tier.MinMultiplier <= t[i-1].MinMultiplier {
+			return fmt.Errorf("%w: min_multiplier must increase from tier to tier", ErrInvalidWinTiers)
+		}
+	}
+	return nil
+}
+
+// Classify returns the tier of a win, or nil for no win or a win below the lowest tier
+func (t WinTiers) Classify(win, bet float64) *WinTier {
+	if win <= 0 || bet <= 0 {
+		return nil
+	}
+	multiplier := win / bet
+	var tier *WinTier
+	for i := range t {
+		if multiplier < t[i].MinMultiplier {
+			break
+		}
+		tier = &t[i]
+	}
+	return tier
+}
+
+// Scan implements the sql.Scanner interface for WinTiers
+func (t *WinTiers) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, t)
+	case string:
+		return json.Unmarshal([]byte(v), t)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface for WinTiers
+func (t WinTiers) Value() (driver.Value, error) {
+	return json.Marshal(t)
+}
@@ -0,0 +1,20 @@
+package historyexport
+
+import "errors"
+
+var (
+	// ErrExportNotFound is returned when a history export does not exist or belongs to another player
+	ErrExportNotFound = errors.New("history export not found")
+
+	// ErrInvalidFormat is returned when an export is requested in an unknown format
+	ErrInvalidFormat = errors.New("format must be csv or pdf")
+
+	// ErrInvalidRange is returned when the export range is empty, inverted or too long
+	ErrInvalidRange = errors.New("invalid export date range")
+
+	// ErrExportNotReady is returned when the download of an export that has not completed is requested
+	ErrExportNotReady = errors.New("history export is not ready")
+
+	// ErrTooManyPending is returned when a player already has the maximum number of exports in progress
+	ErrTooManyPending = errors.New("too many history exports in progress")
+)
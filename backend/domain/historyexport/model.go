@@ -0,0 +1,73 @@
+package historyexport
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Format is the file format of a history export
+type Format string
+
+const (
+	FormatCSV Format = "csv" // Every spin in the range
+	FormatPDF Format = "pdf" // Summarized statement with a daily breakdown
+)
+
+// Valid reports whether f is a known format
+func (f Format) Valid() bool {
+	return f == FormatCSV || f == FormatPDF
+}
+
+// ContentType is the MIME type of the export file
+func (f Format) ContentType() string {
+	if f == FormatPDF {
+		return "application/pdf"
+	}
+	return "text/csv"
+}
+
+// Status is the state of a history export
+// pending -> processing -> completed | failed
+type Status string
+
+const (
+	StatusPending    Status = "pending"
+	StatusProcessing Status = "processing"
+	StatusCompleted  Status = "completed"
+	StatusFailed     Status = "failed"
+)
+
+// Export is a player's request for their game history over a date range, generated in the background
+type Export struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	PlayerID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"player_id"`
+	Format      Format     `gorm:"type:varchar(10);not null" json:"format"`
+	RangeStart  time.Time  `gorm:"not null" json:"from"`
+	RangeEnd    time.Time  `gorm:"not null" json:"to"`
+	Status      Status     `gorm:"type:varchar(20);not null;index" json:"status"`
+	ObjectName  string     `gorm:"type:varchar(255)" json:"-"` // Storage object of the generated file
+	SpinCount   int        `gorm:"not null;default:0" json:"spin_count"`
+	SizeBytes   int64      `gorm:"not null;default:0" json:"size_bytes"`
+	Error       string     `gorm:"type:text" json:"error,omitempty"`
+	CreatedAt   time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// TableName specifies the table name for GORM
+func (Export) TableName() string {
+	return "history_exports"
+}
+
+// FileName is the download file name of the export, named after the first and last day covered
+func (e *Export) FileName() string {
+	last := e.RangeEnd.AddDate(0, 0, -1)
+	return "game-history-" + e.RangeStart.Format("2006-01-02") + "-to-" + last.Format("2006-01-02") + "." + string(e.Format)
+}
+
+// Download is a signed, expiring link to a completed export
+type Download struct {
+	URL       string    `json:"url"`
+	FileName  string    `json:"file_name"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
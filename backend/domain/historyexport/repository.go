@@ -0,0 +1,22 @@
+package historyexport
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for history export persistence
+type Repository interface {
+	Create(ctx context.Context, e *Export) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Export, error)
+	// ListByPlayer lists a player's exports newest first
+	ListByPlayer(ctx context.Context, playerID uuid.UUID, limit int) ([]*Export, error)
+	// CountUnfinished counts a player's pending and processing exports
+	CountUnfinished(ctx context.Context, playerID uuid.UUID) (int64, error)
+	// ListPending lists pending exports oldest first
+	ListPending(ctx context.Context, limit int) ([]*Export, error)
+	// Claim moves a pending export to processing; false when another worker claimed it first
+	Claim(ctx context.Context, id uuid.UUID) (bool, error)
+	Update(ctx context.Context, e *Export) error
+}
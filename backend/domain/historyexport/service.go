@@ -0,0 +1,23 @@
+package historyexport
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for player game-history exports
+type Service interface {
+	// Request queues an export of the player's spins in [from, to)
+	Request(ctx context.Context, playerID uuid.UUID, format Format, from, to time.Time) (*Export, error)
+	// Get returns one of the player's exports
+	Get(ctx context.Context, playerID, id uuid.UUID) (*Export, error)
+	// List lists the player's recent exports newest first
+	List(ctx context.Context, playerID uuid.UUID) ([]*Export, error)
+	// Download returns a signed link to one of the player's completed exports
+	Download(ctx context.Context, playerID, id uuid.UUID) (*Download, error)
+
+	// ProcessPending generates queued exports and returns how many it completed
+	ProcessPending(ctx context.Context) (int, error)
+}
@@ -0,0 +1,16 @@
+package impersonation
+
+import "errors"
+
+var (
+	// ErrGrantNotFound is returned when an impersonation grant does not exist
+	ErrGrantNotFound = errors.New("impersonation grant not found")
+	// ErrTokenInvalid is returned for an unknown, expired or revoked impersonation token
+	ErrTokenInvalid = errors.New("impersonation token is invalid, expired or revoked")
+	// ErrReasonRequired is returned when an impersonation is requested without a reason
+	ErrReasonRequired = errors.New("a reason is required to impersonate a player")
+	// ErrReadOnly is returned when an impersonation token is used for anything but a read
+	ErrReadOnly = errors.New("impersonation tokens are read-only")
+	// ErrRouteForbidden is returned when an impersonation token is used on a route that exposes the player's personal data
+	ErrRouteForbidden = errors.New("impersonation tokens cannot access personal data exports")
+)
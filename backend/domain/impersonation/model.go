@@ -0,0 +1,62 @@
+package impersonation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenPrefix identifies impersonation tokens among player session tokens
+const TokenPrefix = "imp_"
+
+// Grant is a read-only impersonation token issued to an admin for one player
+// Only the token's SHA-256 hash is stored; the token itself is shown once, when issued.
+type Grant struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	AdminID       uuid.UUID  `gorm:"type:uuid;not null;index" json:"admin_id"`
+	AdminUsername string     `gorm:"type:varchar(50);not null" json:"admin_username"`
+	PlayerID      uuid.UUID  `gorm:"type:uuid;not null;index" json:"player_id"`
+	Reason        string     `gorm:"type:text;not null" json:"reason"`
+	TokenHash     string     `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	IPAddress     string     `gorm:"type:varchar(45)" json:"ip_address,omitempty"`
+	ExpiresAt     time.Time  `gorm:"not null" json:"expires_at"`
+	RevokedAt     *time.Time `json:"revoked_at,omitempty"`
+	RevokedBy     *uuid.UUID `gorm:"type:uuid" json:"revoked_by,omitempty"`
+	CreatedAt     time.Time  `gorm:"not null;default:now()" json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Grant) TableName() string {
+	return "impersonation_grants"
+}
+
+// Active reports whether the grant can still be used at now
+func (g *Grant) Active(now time.Time) bool {
+	return g.RevokedAt == nil && now.Before(g.ExpiresAt)
+}
+
+// Access is the audit record of one player API request made with an impersonation token
+// Refused writes are recorded too, with the status they were refused with.
+type Access struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	GrantID   uuid.UUID `gorm:"type:uuid;not null;index" json:"grant_id"`
+	AdminID   uuid.UUID `gorm:"type:uuid;not null" json:"admin_id"`
+	PlayerID  uuid.UUID `gorm:"type:uuid;not null" json:"player_id"`
+	Method    string    `gorm:"type:varchar(10);not null" json:"method"`
+	Path      string    `gorm:"type:text;not null" json:"path"`
+	Status    int       `gorm:"not null" json:"status"`
+	IPAddress string    `gorm:"type:varchar(45)" json:"ip_address,omitempty"`
+	CreatedAt time.Time `gorm:"not null;default:now()" json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Access) TableName() string {
+	return "impersonation_accesses"
+}
+
+// ListFilters narrows a grant listing; zero values match everything
+type ListFilters struct {
+	AdminID  *uuid.UUID
+	PlayerID *uuid.UUID
+	Limit    int
+}
@@ -0,0 +1,32 @@
+package impersonation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for impersonation grant and access data access
+type Repository interface {
+	// CreateGrant stores a new grant
+	CreateGrant(ctx context.Context, grant *Grant) error
+
+	// GetGrant retrieves a grant by ID
+	GetGrant(ctx context.Context, id uuid.UUID) (*Grant, error)
+
+	// GetGrantByTokenHash retrieves the grant a token was issued for
+	GetGrantByTokenHash(ctx context.Context, tokenHash string) (*Grant, error)
+
+	// RevokeGrant marks an unrevoked grant revoked; revoking twice keeps the first revocation
+	RevokeGrant(ctx context.Context, id, revokedBy uuid.UUID, at time.Time) error
+
+	// ListGrants returns grants matching the filters, newest first
+	ListGrants(ctx context.Context, filters ListFilters) ([]*Grant, error)
+
+	// RecordAccess stores the audit record of a request made with a grant
+	RecordAccess(ctx context.Context, access *Access) error
+
+	// ListAccesses returns the requests made with a grant, oldest first
+	ListAccesses(ctx context.Context, grantID uuid.UUID) ([]*Access, error)
+}
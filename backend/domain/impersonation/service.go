@@ -0,0 +1,29 @@
+package impersonation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/admin"
+)
+
+// Service defines the business logic interface for read-only player impersonation
+type Service interface {
+	// Issue grants the admin a read-only token for the player's APIs and returns it with its grant
+	Issue(ctx context.Context, by *admin.Admin, playerID uuid.UUID, reason, ipAddress string) (*Grant, string, error)
+
+	// Validate returns the active grant a token was issued for, or ErrTokenInvalid
+	Validate(ctx context.Context, token string) (*Grant, error)
+
+	// Revoke ends a grant before it expires
+	Revoke(ctx context.Context, grantID, revokedBy uuid.UUID) (*Grant, error)
+
+	// RecordAccess audits one request made with a grant
+	RecordAccess(ctx context.Context, access *Access) error
+
+	// ListGrants returns grants matching the filters, newest first
+	ListGrants(ctx context.Context, filters ListFilters) ([]*Grant, error)
+
+	// ListAccesses returns the requests made with a grant, oldest first
+	ListAccesses(ctx context.Context, grantID uuid.UUID) ([]*Access, error)
+}
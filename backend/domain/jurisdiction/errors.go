@@ -0,0 +1,15 @@
+package jurisdiction
+
+import "errors"
+
+var (
+	// Jurisdiction errors
+	ErrJurisdictionNotFound = errors.New("jurisdiction not found")
+	ErrJurisdictionExists   = errors.New("jurisdiction already exists")
+	ErrInvalidJurisdiction  = errors.New("invalid jurisdiction")
+
+	// Enforcement errors
+	ErrBetAboveLimit      = errors.New("bet exceeds jurisdiction limit")
+	ErrAutoplayNotAllowed = errors.New("autoplay is not allowed")
+	ErrRealityCheckDue    = errors.New("reality check must be acknowledged")
+)
@@ -0,0 +1,90 @@
+package jurisdiction
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Jurisdiction holds the compliance rules of a licensing regime (e.g. UKGC, MGA, Curacao)
+// Zero limits mean the rule is not enforced.
+type Jurisdiction struct {
+	Code                string    `gorm:"type:varchar(20);primaryKey" json:"code"`
+	Name                string    `gorm:"type:varchar(100);not null" json:"name"`
+	MaxBet              float64   `gorm:"type:decimal(10,2);not null" json:"max_bet"`      // Max stake per spin, including bonus buy cost
+	MaxWin              float64   `gorm:"type:decimal(15,2);not null" json:"max_win"`      // Max win credited per spin
+	RealityCheckMinutes int       `gorm:"not null" json:"reality_check_minutes"`           // Interval between reality checks in a session
+	AutoplayAllowed     bool      `gorm:"not null" json:"autoplay_allowed"`                // Whether autoplay spins are accepted
+	RTPDisclosure       bool      `gorm:"not null" json:"rtp_disclosure"`                  // Whether DisclosedRTP must be shown to players
+	DisclosedRTP        float64   `gorm:"type:decimal(5,2);not null" json:"disclosed_rtp"` // e.g. 96.50
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Jurisdiction) TableName() string {
+	return "jurisdictions"
+}
+
+// NormalizeCode returns the canonical form of a jurisdiction code
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate checks that the rules are usable
+func (j *Jurisdiction) Validate() error {
+	switch {
+	case j.Code == "" || len(j.Code) > 20:
+		return fmt.Errorf("%w: code must be 1-20 characters", ErrInvalidJurisdiction)
+	case strings.TrimSpace(j.Name) == "":
+		return fmt.Errorf("%w: name is required", ErrInvalidJurisdiction)
+	case j.MaxBet < 0 || j.MaxWin < 0:
+		return fmt.Errorf("%w: limits must not be negative", ErrInvalidJurisdiction)
+	case j.RealityCheckMinutes < 0:
+		return fmt.Errorf("%w: reality check interval must not be negative", ErrInvalidJurisdiction)
+	case j.DisclosedRTP < 0 || j.DisclosedRTP > 100:
+		return fmt.Errorf("%w: disclosed RTP must be between 0 and 100", ErrInvalidJurisdiction)
+	case j.RTPDisclosure && j.DisclosedRTP == 0:
+		return fmt.Errorf("%w: disclosed RTP is required when RTP disclosure is on", ErrInvalidJurisdiction)
+	}
+	return nil
+}
+
+// CheckStake returns ErrBetAboveLimit when the stake exceeds the max bet
+func (j *Jurisdiction) CheckStake(stake float64) error {
+	if j.MaxBet > 0 && stake > j.MaxBet {
+		return fmt.Errorf("%w: max stake in %s is %.2f", ErrBetAboveLimit, j.Code, j.MaxBet)
+	}
+	return nil
+}
+
+// CapWin limits a spin win to the max win, reporting whether it was reduced
+func (j *Jurisdiction) CapWin(win float64) (float64, bool) {
+	if j.MaxWin > 0 && win > j.MaxWin {
+		return j.MaxWin, true
+	}
+	return win, false
+}
+
+// RealityCheckDue reports whether a reality check is due since the last one (or session start)
+func (j *Jurisdiction) RealityCheckDue(since, now time.Time) bool {
+	if j.RealityCheckMinutes <= 0 {
+		return false
+	}
+	return now.Sub(since) >= time.Duration(j.RealityCheckMinutes)*time.Minute
+}
+
+// CheckAutoplay returns ErrAutoplayNotAllowed when autoplay is restricted
+func (j *Jurisdiction) CheckAutoplay() error {
+	if !j.AutoplayAllowed {
+		return fmt.Errorf("%w in %s", ErrAutoplayNotAllowed, j.Code)
+	}
+	return nil
+}
+
+// Resolver looks up the jurisdiction that applies to a player
+// Returns nil (no restrictions) when none applies.
+type Resolver interface {
+	ForPlayer(ctx context.Context, jurisdictionCode *string) (*Jurisdiction, error)
+}
@@ -0,0 +1,19 @@
+package jurisdiction
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for jurisdiction data access
+type Repository interface {
+	Create(ctx context.Context, j *Jurisdiction) error
+	GetByCode(ctx context.Context, code string) (*Jurisdiction, error)
+	List(ctx context.Context) ([]*Jurisdiction, error)
+	Update(ctx context.Context, j *Jurisdiction) error
+	Delete(ctx context.Context, code string) error
+
+	// SetPlayerJurisdiction attaches a player to a jurisdiction (nil detaches)
+	SetPlayerJurisdiction(ctx context.Context, playerID uuid.UUID, code *string) error
+}
@@ -0,0 +1,22 @@
+package jurisdiction
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for jurisdictions
+type Service interface {
+	Resolver
+
+	// Jurisdiction management
+	Create(ctx context.Context, j *Jurisdiction) error
+	Get(ctx context.Context, code string) (*Jurisdiction, error)
+	List(ctx context.Context) ([]*Jurisdiction, error)
+	Update(ctx context.Context, j *Jurisdiction) error
+	Delete(ctx context.Context, code string) error
+
+	// Player assignment
+	SetPlayerJurisdiction(ctx context.Context, playerID uuid.UUID, code *string) error
+}
@@ -0,0 +1,15 @@
+package kyc
+
+import "errors"
+
+var (
+	// Enforcement errors
+	ErrVerificationRequired = errors.New("identity verification required")
+
+	// Verification errors
+	ErrProviderNotConfigured = errors.New("no KYC provider is configured")
+	ErrProviderUnavailable   = errors.New("KYC provider unavailable")
+	ErrAlreadyVerified       = errors.New("player is already verified")
+	ErrNoVerification        = errors.New("player has no verification in progress")
+	ErrInvalidStatus         = errors.New("invalid KYC status")
+)
@@ -0,0 +1,75 @@
+package kyc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/player"
+)
+
+// Status is a player's identity verification state
+type Status string
+
+const (
+	StatusNone     Status = "none"     // Never submitted
+	StatusPending  Status = "pending"  // Submitted, awaiting the provider's decision
+	StatusVerified Status = "verified" // Identity confirmed
+	StatusRejected Status = "rejected" // Identity could not be confirmed
+)
+
+// Valid reports whether s is a known status
+func (s Status) Valid() bool {
+	switch s {
+	case StatusNone, StatusPending, StatusVerified, StatusRejected:
+		return true
+	}
+	return false
+}
+
+// PlayerStatus returns the KYC status recorded on a player
+// Players created before KYC was introduced have no status and count as none.
+func PlayerStatus(p *player.Player) Status {
+	if p.KYCStatus == "" {
+		return StatusNone
+	}
+	return Status(p.KYCStatus)
+}
+
+// Verification is a player's KYC state as reported to players and admins
+type Verification struct {
+	PlayerID   uuid.UUID  `json:"player_id"`
+	Status     Status     `json:"status"`
+	Provider   *string    `json:"provider,omitempty"`
+	Reference  *string    `json:"reference,omitempty"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+
+	// Required reports whether the player must verify before wagering more;
+	// RemainingWager is how much more they may wager until then (nil when unlimited).
+	Required       bool     `json:"required"`
+	RemainingWager *float64 `json:"remaining_wager,omitempty"`
+}
+
+// Session is a verification started with a provider
+type Session struct {
+	Reference string `json:"reference"`
+	URL       string `json:"url,omitempty"` // Where the player completes the verification, if hosted by the provider
+}
+
+// Update is a change to a player's KYC fields
+type Update struct {
+	Status     Status
+	Provider   *string
+	Reference  *string
+	VerifiedAt *time.Time
+}
+
+// Provider is a pluggable identity verification service
+type Provider interface {
+	// Name identifies the provider in player records
+	Name() string
+	// Start submits a player for verification
+	Start(ctx context.Context, p *player.Player) (*Session, error)
+	// Status reports the current outcome of a verification
+	Status(ctx context.Context, reference string) (Status, error)
+}
@@ -0,0 +1,14 @@
+package kyc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for KYC data access
+// KYC fields live on the player record; reads go through player.Repository.
+type Repository interface {
+	// UpdatePlayerKYC overwrites a player's KYC fields
+	UpdatePlayerKYC(ctx context.Context, playerID uuid.UUID, update Update) error
+}
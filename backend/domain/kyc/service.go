@@ -0,0 +1,28 @@
+package kyc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/player"
+)
+
+// Gate enforces KYC restrictions on real-money play
+type Gate interface {
+	// CheckSpin returns ErrVerificationRequired when an unverified player's stake
+	// would take their cumulative wagering over the verification threshold
+	CheckSpin(ctx context.Context, p *player.Player, stake float64) error
+}
+
+// Service defines the business logic interface for KYC
+type Service interface {
+	Gate
+
+	GetStatus(ctx context.Context, playerID uuid.UUID) (*Verification, error)
+	// StartVerification submits a player to the configured provider
+	StartVerification(ctx context.Context, playerID uuid.UUID) (*Session, error)
+	// Refresh polls the provider for the outcome of a player's verification
+	Refresh(ctx context.Context, playerID uuid.UUID) (*Verification, error)
+	// SetStatus records a manual decision made by an admin
+	SetStatus(ctx context.Context, playerID uuid.UUID, status Status, actor string) (*Verification, error)
+}
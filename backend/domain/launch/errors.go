@@ -0,0 +1,20 @@
+package launch
+
+import "errors"
+
+var (
+	// ErrLaunchDisabled is returned when no operator wallet is configured
+	ErrLaunchDisabled = errors.New("game launch is not configured")
+
+	// ErrInvalidOperatorToken is returned when the wallet rejects an operator token
+	ErrInvalidOperatorToken = errors.New("invalid operator token")
+
+	// ErrWalletUnavailable is returned when the wallet cannot be reached or answers unexpectedly
+	ErrWalletUnavailable = errors.New("operator wallet unavailable")
+
+	// ErrLaunchTokenInvalid is returned when a launch token is unknown, expired or already used
+	ErrLaunchTokenInvalid = errors.New("launch token is invalid or already used")
+
+	// ErrLinkNotFound is returned when an operator player is not linked to a player yet
+	ErrLinkNotFound = errors.New("operator player link not found")
+)
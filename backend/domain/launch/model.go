@@ -0,0 +1,55 @@
+package launch
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OperatorPlayer is the operator's view of a player, returned by the wallet for a valid token
+type OperatorPlayer struct {
+	ExternalID string  `json:"player_id"`
+	Username   string  `json:"username"`
+	Currency   string  `json:"currency"`
+	Balance    float64 `json:"balance"`
+}
+
+// PlayerLink maps an operator's player to the local player account created for it
+type PlayerLink struct {
+	ID               uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	OperatorID       string    `gorm:"not null" json:"operator_id"`
+	ExternalPlayerID string    `gorm:"not null" json:"external_player_id"`
+	PlayerID         uuid.UUID `gorm:"type:uuid;not null" json:"player_id"`
+	CreatedAt        time.Time `gorm:"not null" json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (PlayerLink) TableName() string {
+	return "operator_player_links"
+}
+
+// Request is an operator's request to launch a game for one of its players
+type Request struct {
+	OperatorToken string
+	GameID        uuid.UUID
+	Language      string
+	IPAddress     string // Player IP as seen by the operator
+	UserAgent     string
+}
+
+// Result is the one-time game URL handed back to the operator
+type Result struct {
+	URL         string    `json:"url"`
+	LaunchToken string    `json:"launch_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	PlayerID    uuid.UUID `json:"player_id"`
+}
+
+// Grant is the session a launch token redeems to, held until first use
+type Grant struct {
+	PlayerID         uuid.UUID `json:"player_id"`
+	SessionToken     string    `json:"session_token"`
+	ExpiresAt        int64     `json:"expires_at"`
+	RefreshToken     string    `json:"refresh_token,omitempty"`
+	RefreshExpiresAt int64     `json:"refresh_expires_at,omitempty"`
+}
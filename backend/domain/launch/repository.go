@@ -0,0 +1,28 @@
+package launch
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Wallet validates operator tokens against the operator's wallet integration
+type Wallet interface {
+	// Authenticate resolves an operator token to the operator's player, or ErrInvalidOperatorToken
+	Authenticate(ctx context.Context, operatorToken string) (*OperatorPlayer, error)
+}
+
+// Repository defines the interface for operator player link data access
+type Repository interface {
+	GetLink(ctx context.Context, operatorID, externalPlayerID string) (*PlayerLink, error)
+	GetLinkByPlayer(ctx context.Context, playerID uuid.UUID) (*PlayerLink, error)
+	CreateLink(ctx context.Context, link *PlayerLink) error
+}
+
+// GrantStore holds launch grants until they are redeemed once
+type GrantStore interface {
+	Put(ctx context.Context, launchToken string, grant *Grant, ttl time.Duration) error
+	// Take returns and deletes a grant atomically, or ErrLaunchTokenInvalid
+	Take(ctx context.Context, launchToken string) (*Grant, error)
+}
@@ -0,0 +1,17 @@
+package launch
+
+import (
+	"context"
+
+	"github.com/slotmachine/backend/domain/player"
+)
+
+// Service defines the business logic interface for operator game launches
+type Service interface {
+	// Launch validates the operator token with the wallet, creates or links the player,
+	// starts a login session and returns a one-time game URL
+	Launch(ctx context.Context, req Request) (*Result, error)
+
+	// Redeem exchanges a launch token for the session it was issued with; tokens work once
+	Redeem(ctx context.Context, launchToken string) (*player.LoginResult, error)
+}
@@ -0,0 +1,12 @@
+package notification
+
+import "errors"
+
+var (
+	// ErrNotificationNotFound is returned when a notification does not exist or is not the player's
+	ErrNotificationNotFound = errors.New("notification not found")
+	// ErrInvalidType is returned for an unknown notification type
+	ErrInvalidType = errors.New("notification type must be bonus_granted, tournament_started or maintenance")
+	// ErrContentRequired is returned when a notification has no title or body
+	ErrContentRequired = errors.New("notification title and body are required")
+)
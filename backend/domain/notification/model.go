@@ -0,0 +1,75 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Type is the kind of message a notification carries
+type Type string
+
+const (
+	TypeBonusGranted      Type = "bonus_granted"
+	TypeTournamentStarted Type = "tournament_started"
+	TypeMaintenance       Type = "maintenance"
+)
+
+// Valid reports whether t is a known notification type
+func (t Type) Valid() bool {
+	return t == TypeBonusGranted || t == TypeTournamentStarted || t == TypeMaintenance
+}
+
+// Notification is an in-game message for one player, or for every player when PlayerID is nil
+type Notification struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	PlayerID  *uuid.UUID     `gorm:"type:uuid;index" json:"player_id,omitempty"`
+	Type      Type           `gorm:"type:varchar(50);not null" json:"type"`
+	Title     string         `gorm:"type:varchar(200);not null" json:"title"`
+	Body      string         `gorm:"type:text;not null" json:"body"`
+	Data      map[string]any `gorm:"type:jsonb;serializer:json" json:"data,omitempty"` // Details the client renders, e.g. the bonus amount
+	ExpiresAt *time.Time     `json:"expires_at,omitempty"`                             // Not shown once passed; nil never expires
+	CreatedAt time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// Broadcast reports whether the notification is for every player
+func (n *Notification) Broadcast() bool {
+	return n.PlayerID == nil
+}
+
+// Validate checks the type and text of the notification
+func (n *Notification) Validate() error {
+	if !n.Type.Valid() {
+		return ErrInvalidType
+	}
+	if n.Title == "" || n.Body == "" {
+		return ErrContentRequired
+	}
+	return nil
+}
+
+// Read is a player's receipt for a notification they read
+type Read struct {
+	NotificationID uuid.UUID `gorm:"type:uuid;primaryKey" json:"notification_id"`
+	PlayerID       uuid.UUID `gorm:"type:uuid;primaryKey" json:"player_id"`
+	ReadAt         time.Time `gorm:"not null" json:"read_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Read) TableName() string {
+	return "notification_reads"
+}
+
+// EventNotification is the type of events sent when a notification is delivered
+const EventNotification = "notification"
+
+// Event is pushed to the connected clients of the notification's player, or of every player
+type Event struct {
+	Type         string        `json:"type"`
+	Notification *Notification `json:"notification"`
+}
@@ -0,0 +1,29 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for notification persistence
+type Repository interface {
+	Create(ctx context.Context, n *Notification) error
+	// Unread lists the player's unexpired notifications without a read receipt, oldest first
+	// Broadcasts sent before the player registered are left out.
+	Unread(ctx context.Context, playerID uuid.UUID, now time.Time, limit int) ([]*Notification, error)
+	// MarkRead records the player's receipt; ErrNotificationNotFound unless the notification is theirs
+	// or a broadcast. Reading a notification twice keeps the first receipt.
+	MarkRead(ctx context.Context, playerID, notificationID uuid.UUID, at time.Time) error
+	// MarkAllRead records receipts for all the player's unread notifications and returns how many
+	MarkAllRead(ctx context.Context, playerID uuid.UUID, at time.Time) (int64, error)
+}
+
+// Store fans notification events out to players' connected clients, across instances
+type Store interface {
+	// Publish sends the event to the notification's player, or to every subscriber for a broadcast
+	Publish(ctx context.Context, event *Event) error
+	// Subscribe streams the player's events until ctx is cancelled, then closes the channel
+	Subscribe(ctx context.Context, playerID uuid.UUID) (<-chan *Event, error)
+}
@@ -0,0 +1,26 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Sender sends notifications; other services use it to tell players about what happened to them
+type Sender interface {
+	// Send stores the notification and pushes it to the connected clients it is for
+	Send(ctx context.Context, n *Notification) error
+}
+
+// Service defines the business logic interface for in-game notifications
+type Service interface {
+	Sender
+	// Unread lists the player's unread notifications, oldest first
+	Unread(ctx context.Context, playerID uuid.UUID, limit int) ([]*Notification, error)
+	// MarkRead records that the player read a notification
+	MarkRead(ctx context.Context, playerID, notificationID uuid.UUID) error
+	// MarkAllRead marks all the player's unread notifications read and returns how many
+	MarkAllRead(ctx context.Context, playerID uuid.UUID) (int64, error)
+	// Subscribe streams notifications for the player until ctx is cancelled
+	Subscribe(ctx context.Context, playerID uuid.UUID) (<-chan *Event, error)
+}
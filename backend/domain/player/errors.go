@@ -29,6 +29,9 @@ var (
 
 	ErrNotFoundOrLockChanged = errors.New("player not found or updated by another session")
 
+	// ErrBalanceBusy is returned when another balance update for the player outlasts the wait for its lock
+	ErrBalanceBusy = errors.New("another balance update for this player is in progress")
+
 	// ErrGameAccessDenied is returned when player tries to access a game they're not bound to
 	ErrGameAccessDenied = errors.New("player not authorized for this game")
 
@@ -37,4 +40,7 @@ var (
 
 	// ErrGameIDRequired is returned when game_id is missing for player registration
 	ErrGameIDRequired = errors.New("game_id is required for registration")
+
+	// ErrInvalidSearchCursor is returned when a search cursor is malformed or issued for another sort
+	ErrInvalidSearchCursor = errors.New("invalid search cursor")
 )
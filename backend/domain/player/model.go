@@ -17,6 +17,15 @@ type Player struct {
 	// Game association - NULL means cross-game account (can login to any game)
 	GameID *uuid.UUID `gorm:"type:uuid;index"`
 
+	// Compliance - NULL uses the configured default jurisdiction
+	JurisdictionCode *string `gorm:"type:varchar(20);index"`
+
+	// KYC - see kyc.Status; the provider's reference identifies the latest verification
+	KYCStatus     string  `gorm:"type:varchar(20);default:'none';not null"`
+	KYCProvider   *string `gorm:"type:varchar(50)"`
+	KYCReference  *string `gorm:"type:varchar(255)"`
+	KYCVerifiedAt *time.Time
+
 	// Statistics
 	TotalSpins   int     `gorm:"default:0"`
 	TotalWagered float64 `gorm:"type:decimal(15,2);default:0.00"`
@@ -31,6 +40,9 @@ type Player struct {
 	UpdatedAt   time.Time `gorm:"default:CURRENT_TIMESTAMP"`
 	LockVersion int       `gorm:"default:0"`
 	LastLoginAt *time.Time
+
+	// Set once the player's personal data has been erased; see privacy.Service
+	ErasedAt *time.Time
 }
 
 // TableName specifies the table name for GORM
@@ -44,6 +44,10 @@ type Repository interface {
 	UpdateBalanceWithTx(ctx context.Context, id uuid.UUID, amount float64) error
 	UpdateBalanceWithLockAndTx(ctx context.Context, id uuid.UUID, amount float64, lockVersion int) error
 
+	// LockBalanceWithTx holds the player's balance lock until the transaction in ctx ends
+	// Every replica's spin settlement takes it first, so settlements for one player never overlap.
+	LockBalanceWithTx(ctx context.Context, id uuid.UUID) error
+
 	// UpdateStatistics updates player statistics
 	UpdateStatistics(ctx context.Context, id uuid.UUID, spins int, wagered, won float64) error
 
@@ -55,6 +59,16 @@ type Repository interface {
 
 	// List retrieves a list of players with filters and pagination
 	List(ctx context.Context, filters ListFilters) ([]*Player, int64, error)
+
+	// Search retrieves one keyset page of players matching the filters
+	Search(ctx context.Context, filters SearchFilters) ([]*Player, error)
+}
+
+// Locker serializes balance updates per player, across server instances
+type Locker interface {
+	// Lock waits in line for the player's lock; it returns ErrBalanceBusy if the wait runs out
+	// The returned unlock releases the lock and must be called exactly once.
+	Lock(ctx context.Context, playerID uuid.UUID) (unlock func(), err error)
 }
 
 // ListFilters represents filters for listing players
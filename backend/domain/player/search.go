@@ -0,0 +1,125 @@
+package player
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SearchSort is a column player search results can be ordered by
+type SearchSort string
+
+const (
+	SearchSortCreatedAt    SearchSort = "created_at"
+	SearchSortBalance      SearchSort = "balance"
+	SearchSortTotalWagered SearchSort = "total_wagered"
+	SearchSortUsername     SearchSort = "username"
+)
+
+// Valid reports whether s is a supported sort column
+func (s SearchSort) Valid() bool {
+	switch s {
+	case SearchSortCreatedAt, SearchSortBalance, SearchSortTotalWagered, SearchSortUsername:
+		return true
+	}
+	return false
+}
+
+// SearchFilters represents the filters of an admin player search
+// Empty fields are not applied; all applied filters must match.
+type SearchFilters struct {
+	Username string // Case-insensitive partial match
+	Email    string // Case-insensitive partial match
+	GameID   *uuid.UUID
+	IsActive *bool
+
+	MinBalance *float64
+	MaxBalance *float64
+
+	RegisteredFrom *time.Time
+	RegisteredTo   *time.Time
+	LastLoginFrom  *time.Time
+	LastLoginTo    *time.Time
+
+	// Tags the player must all carry
+	Tags []string
+
+	// AssignedConfigID matches players with an active reel strip assignment
+	// to this config, for either the base game or free spins
+	AssignedConfigID *uuid.UUID
+
+	Sort     SearchSort
+	SortDesc bool
+	After    *SearchCursor // Keyset position of the previous page's last row
+	Limit    int
+}
+
+// SearchCursor is the keyset position of a player in search results
+// It is only valid for the sort it was issued under.
+type SearchCursor struct {
+	Sort  SearchSort `json:"s"`
+	Desc  bool       `json:"d"`
+	Value string     `json:"v"`
+	ID    uuid.UUID  `json:"id"`
+}
+
+// NewSearchCursor returns the cursor positioned after p under the given sort
+func NewSearchCursor(p *Player, sort SearchSort, desc bool) *SearchCursor {
+	c := &SearchCursor{Sort: sort, Desc: desc, ID: p.ID}
+	switch sort {
+	case SearchSortBalance:
+		c.Value = strconv.FormatFloat(p.Balance, 'f', -1, 64)
+	case SearchSortTotalWagered:
+		c.Value = strconv.FormatFloat(p.TotalWagered, 'f', -1, 64)
+	case SearchSortUsername:
+		c.Value = p.Username
+	default:
+		c.Value = p.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return c
+}
+
+// Encode returns the opaque form of the cursor handed to clients
+func (c *SearchCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeSearchCursor parses a cursor returned by Encode
+func DecodeSearchCursor(s string) (*SearchCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidSearchCursor
+	}
+	var c SearchCursor
+	if err := json.Unmarshal(raw, &c); err != nil || !c.Sort.Valid() || c.ID == uuid.Nil {
+		return nil, ErrInvalidSearchCursor
+	}
+	if _, err := c.SortValue(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// SortValue returns the cursor's sort key typed for comparison with its column
+func (c *SearchCursor) SortValue() (interface{}, error) {
+	switch c.Sort {
+	case SearchSortBalance, SearchSortTotalWagered:
+		v, err := strconv.ParseFloat(c.Value, 64)
+		if err != nil {
+			return nil, ErrInvalidSearchCursor
+		}
+		return v, nil
+	case SearchSortUsername:
+		return c.Value, nil
+	default:
+		v, err := time.Parse(time.RFC3339Nano, c.Value)
+		if err != nil {
+			return nil, ErrInvalidSearchCursor
+		}
+		return v, nil
+	}
+}
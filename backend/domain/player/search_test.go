@@ -0,0 +1,55 @@
+package player
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchCursor(t *testing.T) {
+	p := &Player{
+		ID:           uuid.New(),
+		Username:     "alice",
+		Balance:      1234.5,
+		TotalWagered: 99.25,
+		CreatedAt:    time.Date(2026, 3, 1, 12, 0, 0, 123456789, time.UTC),
+	}
+
+	tests := []struct {
+		sort SearchSort
+		want interface{}
+	}{
+		{SearchSortCreatedAt, p.CreatedAt},
+		{SearchSortBalance, 1234.5},
+		{SearchSortTotalWagered, 99.25},
+		{SearchSortUsername, "alice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			decoded, err := DecodeSearchCursor(NewSearchCursor(p, tt.sort, true).Encode())
+			require.NoError(t, err)
+
+			assert.Equal(t, p.ID, decoded.ID)
+			assert.True(t, decoded.Desc)
+			value, err := decoded.SortValue()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, value)
+		})
+	}
+
+	t.Run("should reject malformed cursors", func(t *testing.T) {
+		for _, s := range []string{
+			"not base64!",
+			(&SearchCursor{Sort: "password_hash", ID: p.ID}).Encode(),
+			(&SearchCursor{Sort: SearchSortBalance, Value: "lots", ID: p.ID}).Encode(),
+			(&SearchCursor{Sort: SearchSortUsername, Value: "alice"}).Encode(),
+		} {
+			_, err := DecodeSearchCursor(s)
+			assert.ErrorIs(t, err, ErrInvalidSearchCursor, s)
+		}
+	})
+}
@@ -8,9 +8,11 @@ import (
 
 // LoginResult contains the result of a successful login
 type LoginResult struct {
-	Player       *Player
-	SessionToken string
-	ExpiresAt    int64 // Unix timestamp
+	Player           *Player
+	SessionToken     string
+	ExpiresAt        int64  // Unix timestamp
+	RefreshToken     string // Empty when refresh tokens are disabled
+	RefreshExpiresAt int64  // Unix timestamp
 }
 
 // LoginOptions contains options for login
@@ -33,9 +35,23 @@ type Service interface {
 	// Returns error if player already logged in on another device (unless forceLogout is true)
 	Login(ctx context.Context, username, password string, gameID *uuid.UUID, opts *LoginOptions) (*LoginResult, error)
 
-	// Logout invalidates the player's session
+	// LoginByID creates a session for a player authenticated elsewhere (e.g. by an operator)
+	// Any existing session for the game is force logged out
+	LoginByID(ctx context.Context, playerID uuid.UUID, gameID *uuid.UUID, opts *LoginOptions) (*LoginResult, error)
+
+	// Logout invalidates the player's session and revokes its refresh token
 	Logout(ctx context.Context, sessionToken string) error
 
+	// Refresh exchanges a refresh token for a new session and a rotated refresh token
+	// Reusing a rotated refresh token revokes its whole token family
+	Refresh(ctx context.Context, refreshToken string, opts *LoginOptions) (*LoginResult, error)
+
+	// RevokeRefreshToken revokes a refresh token's family; unknown tokens are ignored
+	RevokeRefreshToken(ctx context.Context, refreshToken string) error
+
+	// LogoutEverywhere ends all sessions of a player and revokes all its refresh tokens
+	LogoutEverywhere(ctx context.Context, playerID uuid.UUID) error
+
 	// ValidateSession validates a session token and returns session info
 	// Also validates that the session's game_id matches the requested game
 	ValidateSession(ctx context.Context, sessionToken string, requestedGameID *uuid.UUID) (*LoginResult, error)
@@ -0,0 +1,16 @@
+package playernote
+
+import "errors"
+
+var (
+	// ErrNoteNotFound is returned when a note does not exist, was deleted or belongs to another player
+	ErrNoteNotFound = errors.New("player note not found")
+	// ErrInvalidKind is returned for a kind other than note or flag
+	ErrInvalidKind = errors.New("note kind must be note or flag")
+	// ErrBodyRequired is returned when a note or flag is empty
+	ErrBodyRequired = errors.New("note body is required")
+	// ErrBodyTooLong is returned when a note or flag exceeds its kind's maximum length
+	ErrBodyTooLong = errors.New("note body is too long")
+	// ErrFlagExists is returned when the player already carries the same flag
+	ErrFlagExists = errors.New("player already has this flag")
+)
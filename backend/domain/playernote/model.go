@@ -0,0 +1,54 @@
+package playernote
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kind tells a free-text note from a flag
+type Kind string
+
+const (
+	KindNote Kind = "note" // Free-text remark, e.g. what a support call was about
+	KindFlag Kind = "flag" // Short label support must see first, e.g. "charge-back risk"
+)
+
+// Valid reports whether the kind is known
+func (k Kind) Valid() bool {
+	return k == KindNote || k == KindFlag
+}
+
+// MaxLength returns the longest body a note of this kind may have
+func (k Kind) MaxLength() int {
+	if k == KindFlag {
+		return MaxFlagLength
+	}
+	return MaxNoteLength
+}
+
+const (
+	MaxNoteLength = 4000
+	MaxFlagLength = 100
+)
+
+// Note is an internal note or flag support staff keep on a player
+// Players never see notes. Deleted notes are kept, with who deleted them, but no longer listed.
+type Note struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	PlayerID       uuid.UUID  `gorm:"type:uuid;not null;index" json:"player_id"`
+	Kind           Kind       `gorm:"type:varchar(10);not null" json:"kind"`
+	Body           string     `gorm:"type:text;not null" json:"body"`
+	AuthorID       uuid.UUID  `gorm:"type:uuid;not null" json:"author_id"`
+	AuthorUsername string     `gorm:"type:varchar(50);not null" json:"author_username"`
+	CreatedAt      time.Time  `gorm:"not null;default:now()" json:"created_at"`
+	EditedBy       string     `gorm:"type:varchar(50)" json:"edited_by,omitempty"`
+	EditedAt       *time.Time `json:"edited_at,omitempty"`
+	DeletedBy      string     `gorm:"type:varchar(50)" json:"-"`
+	DeletedAt      *time.Time `json:"-"`
+}
+
+// TableName specifies the table name for GORM
+func (Note) TableName() string {
+	return "player_notes"
+}
@@ -0,0 +1,27 @@
+package playernote
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the data access interface for player notes
+type Repository interface {
+	// Create stores a new note
+	Create(ctx context.Context, note *Note) error
+
+	// GetByID returns a note that has not been deleted
+	GetByID(ctx context.Context, id uuid.UUID) (*Note, error)
+
+	// UpdateBody replaces a note's body and records who edited it
+	UpdateBody(ctx context.Context, id uuid.UUID, body, editedBy string, at time.Time) error
+
+	// Delete hides a note from listings and records who deleted it
+	Delete(ctx context.Context, id uuid.UUID, deletedBy string, at time.Time) error
+
+	// ListByPlayer returns a player's notes that have not been deleted, newest first
+	// A nil kind lists notes and flags alike.
+	ListByPlayer(ctx context.Context, playerID uuid.UUID, kind *Kind) ([]*Note, error)
+}
@@ -0,0 +1,23 @@
+package playernote
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/admin"
+)
+
+// Service defines the business logic interface for support notes and flags on players
+type Service interface {
+	// Add records a note or flag on the player, authored by the admin
+	Add(ctx context.Context, by *admin.Admin, playerID uuid.UUID, kind Kind, body string) (*Note, error)
+
+	// Edit replaces the body of one of the player's notes
+	Edit(ctx context.Context, by *admin.Admin, playerID, noteID uuid.UUID, body string) (*Note, error)
+
+	// Delete removes one of the player's notes from listings
+	Delete(ctx context.Context, by *admin.Admin, playerID, noteID uuid.UUID) error
+
+	// List returns the player's notes, newest first; a nil kind lists notes and flags alike
+	List(ctx context.Context, playerID uuid.UUID, kind *Kind) ([]*Note, error)
+}
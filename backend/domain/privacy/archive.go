@@ -0,0 +1,62 @@
+package privacy
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// manifest describes the contents of an archive
+type manifest struct {
+	PlayerID    uuid.UUID       `json:"player_id"`
+	GeneratedAt time.Time       `json:"generated_at"`
+	Files       []manifestEntry `json:"files"`
+}
+
+type manifestEntry struct {
+	Name    string `json:"name"`
+	Records int    `json:"records"`
+}
+
+// FileName is the name the archive is downloaded under
+func (a *Archive) FileName() string {
+	return "player-" + a.PlayerID.String() + "-" + a.GeneratedAt.UTC().Format("20060102T150405Z") + ".zip"
+}
+
+// WriteZip writes the archive as a zip of JSON files
+// manifest.json lists the sections; each section is written to <name>.json.
+func (a *Archive) WriteZip(w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	m := manifest{PlayerID: a.PlayerID, GeneratedAt: a.GeneratedAt, Files: make([]manifestEntry, 0, len(a.Sections))}
+	for _, section := range a.Sections {
+		m.Files = append(m.Files, manifestEntry{Name: section.Name + ".json", Records: len(section.Records)})
+	}
+	if err := writeJSON(zw, "manifest.json", a.GeneratedAt, m); err != nil {
+		return err
+	}
+
+	for _, section := range a.Sections {
+		records := section.Records
+		if records == nil {
+			records = []Record{}
+		}
+		if err := writeJSON(zw, section.Name+".json", a.GeneratedAt, records); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func writeJSON(zw *zip.Writer, name string, modified time.Time, v interface{}) error {
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,9 @@
+package privacy
+
+import "errors"
+
+var (
+	ErrAlreadyErased  = errors.New("player has already been erased")
+	ErrActiveSession  = errors.New("player has an active game session")
+	ErrReasonRequired = errors.New("erasure reason is required")
+)
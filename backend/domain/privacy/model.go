@@ -0,0 +1,59 @@
+package privacy
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Record is one exported row, keyed by column name
+type Record map[string]interface{}
+
+// Section holds the records of a player read from one source table
+type Section struct {
+	Name    string   `json:"name"`
+	Records []Record `json:"records"`
+}
+
+// Archive is every record held about a player
+type Archive struct {
+	PlayerID    uuid.UUID `json:"player_id"`
+	GeneratedAt time.Time `json:"generated_at"`
+	Sections    []Section `json:"sections"`
+}
+
+// Erasure reports what erasing a player changed
+type Erasure struct {
+	PlayerID  uuid.UUID        `json:"player_id"`
+	Pseudonym string           `json:"pseudonym"`
+	Reason    string           `json:"reason"`
+	ErasedBy  string           `json:"erased_by"`
+	ErasedAt  time.Time        `json:"erased_at"`
+	Deleted   map[string]int64 `json:"deleted"`  // Rows removed, by table
+	Scrubbed  map[string]int64 `json:"scrubbed"` // Rows whose personal fields were cleared, by table
+	Retained  []string         `json:"retained"` // Tables kept as is, linked only to the pseudonymous player
+}
+
+// RetainedTables are kept on erasure
+// Provably fair spin logs and reveals are append-only and must stay verifiable, and
+// financial and gaming records are required for regulatory record keeping. None of
+// them holds personal data: they reference the player by ID only, so replacing the
+// player's identity with a pseudonym leaves them pseudonymized.
+var RetainedTables = []string{
+	"game_sessions",
+	"spins",
+	"free_spins_sessions",
+	"transactions",
+	"pf_sessions",
+	"spin_logs",
+	"session_audits",
+	"pf_chain_audits",
+	"disputes",
+}
+
+// Pseudonym is the username an erased player is left with
+// It is derived from the player ID, so it is unique and carries no personal data.
+func Pseudonym(playerID uuid.UUID) string {
+	return "erased-" + strings.ReplaceAll(playerID.String(), "-", "")
+}
@@ -0,0 +1,18 @@
+package privacy
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository reads and erases the personal data held about players across tables
+type Repository interface {
+	// Export reads every record of a player, one section per source table
+	// Secrets such as password hashes and session tokens are left out.
+	Export(ctx context.Context, playerID uuid.UUID) ([]Section, error)
+	// Erase replaces a player's identity with pseudonym and removes its personal data
+	// Returns the Deleted and Scrubbed row counts; it runs in a single transaction.
+	Erase(ctx context.Context, playerID uuid.UUID, pseudonym string, erasedAt time.Time) (*Erasure, error)
+}
@@ -0,0 +1,15 @@
+package privacy
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Service handles data subject requests: access (export) and erasure
+type Service interface {
+	// Export returns every record held about a player
+	Export(ctx context.Context, playerID uuid.UUID) (*Archive, error)
+	// Erase anonymizes a player and signs it out everywhere
+	Erase(ctx context.Context, playerID uuid.UUID, reason, actor string) (*Erasure, error)
+}
@@ -27,7 +27,13 @@ var (
 	ErrStateCorrupted   = errors.New("session state is corrupted")
 
 	// Dual Commitment Protocol errors
-	ErrThetaSeedRequired        = errors.New("theta_seed is required on first spin when theta_commitment was provided")
-	ErrThetaVerificationFailed  = errors.New("theta_seed verification failed: SHA256(theta_seed) does not match theta_commitment")
-	ErrThetaAlreadyVerified     = errors.New("theta_seed has already been verified")
+	ErrThetaSeedRequired       = errors.New("theta_seed is required on first spin when theta_commitment was provided")
+	ErrThetaVerificationFailed = errors.New("theta_seed verification failed: SHA256(theta_seed) does not match theta_commitment")
+	ErrThetaAlreadyVerified    = errors.New("theta_seed has already been verified")
+	ErrThetaCommitmentRequired = errors.New("theta_commitment is required to start a provably fair session")
+	ErrInvalidThetaCommitment  = errors.New("theta_commitment must be a 64-character hex SHA256 digest")
+
+	// Replay protection errors
+	ErrClientNonceRequired = errors.New("client_nonce is required on spin requests")
+	ErrClientNonceMismatch = errors.New("client_nonce is not the session's next spin nonce")
 )
@@ -3,9 +3,11 @@ package provablyfair
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
 )
 
 // PFSession represents a Provably Fair gaming session
@@ -25,6 +27,7 @@ type PFSession struct {
 	EncryptedServerSeed string     `gorm:"type:text;not null"`                               // AES-256-GCM encrypted server seed for recovery
 	NonceStart          int64      `gorm:"not null;default:1"`                               // Starting nonce (always 1)
 	LastNonce           int64      `gorm:"not null;default:0"`                               // Last used nonce
+	LastClientNonce     int64      `gorm:"not null;default:0"`                               // Last nonce a spin request claimed
 	LastSpinHash        string     `gorm:"type:varchar(64)"`                                 // Hash of the last spin
 	Status              string     `gorm:"type:varchar(20);not null;default:'active';index"` // active, ended
 	CreatedAt           time.Time  `gorm:"not null;default:now()"`
@@ -50,19 +53,23 @@ const (
 // CRITICAL: This table is append-only - NO UPDATE, NO DELETE
 // Client seed is stored per-spin to ensure server cannot predict outcomes
 type SpinLog struct {
-	ID                uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	PFSessionID       uuid.UUID  `gorm:"type:uuid;not null;index"`
-	SpinID            uuid.UUID  `gorm:"type:uuid;not null;index"`  // Links to spins table
-	SpinIndex         int64      `gorm:"not null;index"`            // Sequential index within session
-	Nonce             int64      `gorm:"not null"`
-	ClientSeed        string     `gorm:"type:varchar(64);not null"` // Client-provided seed for this spin
-	SpinHash          string     `gorm:"type:varchar(64);not null"` // SHA256(prev_spin_hash + server_seed + client_seed + nonce)
-	PrevSpinHash      string     `gorm:"type:varchar(64);not null"` // Previous spin hash (server_seed_hash for first spin)
-	ReelPositions     IntSlice   `gorm:"type:jsonb;not null"`       // Array of 5 reel positions from RNG
-	ReelStripConfigID *uuid.UUID `gorm:"type:uuid;index"`           // Reference to reel_strip_configs for verification
-	GameMode          *string    `gorm:"type:varchar(32)"`          // Game mode: nil for normal, or bonus_spin_trigger etc.
-	IsFreeSpin        bool       `gorm:"not null;default:false"`    // Whether this was a free spin
-	CreatedAt         time.Time  `gorm:"not null;default:now();index"`
+	ID                uuid.UUID          `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	PFSessionID       uuid.UUID          `gorm:"type:uuid;not null;index"`
+	SpinID            uuid.UUID          `gorm:"type:uuid;not null;index"` // Links to spins table
+	SpinIndex         int64              `gorm:"not null;index"`           // Sequential index within session
+	Nonce             int64              `gorm:"not null"`
+	ClientSeed        string             `gorm:"type:varchar(64);not null"` // Client-provided seed for this spin
+	SpinHash          string             `gorm:"type:varchar(64);not null"` // SHA256(prev_spin_hash + server_seed + client_seed + nonce)
+	PrevSpinHash      string             `gorm:"type:varchar(64);not null"` // Previous spin hash (server_seed_hash for first spin)
+	ReelPositions     IntSlice           `gorm:"type:jsonb;not null"`       // Array of 5 reel positions from RNG
+	ReelStripConfigID *uuid.UUID         `gorm:"type:uuid;index"`           // Reference to reel_strip_configs for verification
+	GameMode          *string            `gorm:"type:varchar(32)"`          // Game mode: nil for normal, or bonus_spin_trigger etc.
+	IsFreeSpin        bool               `gorm:"not null;default:false"`    // Whether this was a free spin
+	Multipliers       IntSlice           `gorm:"type:jsonb"`                // Cascade multiplier ladder applied to the spin
+	WildFeatures      *game.WildFeatures `gorm:"type:jsonb"`                // Wild features applied to the spin
+	StickyWilds       game.GridPositions `gorm:"type:jsonb"`                // Sticky wilds carried into the spin
+	MathVersion       string             `gorm:"type:varchar(64)"`          // Engine build, paytable and multiplier ladder the spin was played with
+	CreatedAt         time.Time          `gorm:"not null;default:now();index"`
 }
 
 // TableName specifies the table name for GORM
@@ -86,6 +93,24 @@ func (SessionAudit) TableName() string {
 	return "session_audits"
 }
 
+// ChainAudit records one self-audit of an ended session's hash chain
+// The auditor re-verifies chains with the revealed server seed to catch storage corruption
+// or hash-chain regressions before players or regulators do.
+type ChainAudit struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	PFSessionID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex" json:"pf_session_id"`
+	PlayerID    uuid.UUID `gorm:"type:uuid;not null" json:"player_id"`
+	SpinCount   int64     `gorm:"not null" json:"spin_count"`
+	Valid       bool      `gorm:"not null;index" json:"valid"`
+	Error       string    `gorm:"type:text" json:"error,omitempty"` // Why the chain failed; empty when valid
+	CheckedAt   time.Time `gorm:"not null" json:"checked_at"`
+}
+
+// TableName specifies the table name for GORM
+func (ChainAudit) TableName() string {
+	return "pf_chain_audits"
+}
+
 // PFSessionState represents the runtime state stored in Redis
 // This is the source of truth during active sessions
 // Client seed is provided per-spin, not stored in session state
@@ -105,6 +130,15 @@ type PFSessionState struct {
 	ThetaVerified   bool   `json:"theta_verified"`             // True after theta_seed is verified
 }
 
+// CheckClientNonce verifies a spin request names the session's next nonce
+// Every spin advances the nonce, so a captured request is rejected once its spin has been played.
+func (s *PFSessionState) CheckClientNonce(nonce int64) error {
+	if next := s.Nonce + 1; nonce != next {
+		return fmt.Errorf("%w: expected %d", ErrClientNonceMismatch, next)
+	}
+	return nil
+}
+
 // MarshalBinary implements encoding.BinaryMarshaler for Redis
 func (s *PFSessionState) MarshalBinary() ([]byte, error) {
 	return json.Marshal(s)
@@ -134,15 +168,19 @@ type VerificationData struct {
 
 // SpinVerification contains data for verifying a single spin
 type SpinVerification struct {
-	SpinIndex         int64      `json:"spin_index"`
-	Nonce             int64      `json:"nonce"`
-	ClientSeed        string     `json:"client_seed"`          // Client-provided seed for this spin
-	SpinHash          string     `json:"spin_hash"`
-	PrevSpinHash      string     `json:"prev_spin_hash"`
-	ReelPositions     []int      `json:"reel_positions"`       // Array of 5 reel positions
-	ReelStripConfigID *uuid.UUID `json:"reel_strip_config_id"` // Which reel strip config was used
-	GameMode          *string    `json:"game_mode"`            // Game mode if any
-	IsFreeSpin        bool       `json:"is_free_spin"`
+	SpinIndex         int64              `json:"spin_index"`
+	Nonce             int64              `json:"nonce"`
+	ClientSeed        string             `json:"client_seed"` // Client-provided seed for this spin
+	SpinHash          string             `json:"spin_hash"`
+	PrevSpinHash      string             `json:"prev_spin_hash"`
+	ReelPositions     []int              `json:"reel_positions"`       // Array of 5 reel positions
+	ReelStripConfigID *uuid.UUID         `json:"reel_strip_config_id"` // Which reel strip config was used
+	GameMode          *string            `json:"game_mode"`            // Game mode if any
+	IsFreeSpin        bool               `json:"is_free_spin"`
+	Multipliers       []int              `json:"multipliers,omitempty"`   // Cascade multiplier ladder applied
+	WildFeatures      *game.WildFeatures `json:"wild_features,omitempty"` // Wild features applied
+	StickyWilds       game.GridPositions `json:"sticky_wilds,omitempty"`  // Sticky wilds carried into the spin
+	MathVersion       string             `json:"math_version,omitempty"`  // Engine build, paytable and multiplier ladder to replay the spin with
 }
 
 // StringSlice is a helper type for storing string slices in JSONB
@@ -2,6 +2,7 @@ package provablyfair
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,17 +15,41 @@ type Repository interface {
 	GetActiveSessionByPlayer(ctx context.Context, playerID uuid.UUID) (*PFSession, error)
 	GetActiveSessionByGameSession(ctx context.Context, gameSessionID uuid.UUID) (*PFSession, error)
 	UpdateSession(ctx context.Context, session *PFSession) error
+	// UpdateSessionProgress records the last nonce and spin hash, ignoring writes older than the stored nonce
+	UpdateSessionProgress(ctx context.Context, id uuid.UUID, nonce int64, lastSpinHash string) error
+	// ClaimClientNonce records nonce as the last one a spin request claimed in the session
+	// Returns ErrClientNonceMismatch when it or a later nonce was already claimed.
+	ClaimClientNonce(ctx context.Context, id uuid.UUID, nonce int64) error
 	EndSession(ctx context.Context, id uuid.UUID) error
+	// ListOrphanedSessions returns active sessions whose game session has ended or is missing,
+	// or whose last spin (or start, without spins) is older than idleSince
+	ListOrphanedSessions(ctx context.Context, idleSince time.Time, limit int) ([]PFSession, error)
 
 	// SpinLog operations (append-only)
 	CreateSpinLog(ctx context.Context, log *SpinLog) error
 	GetSpinLogsBySession(ctx context.Context, pfSessionID uuid.UUID) ([]SpinLog, error)
 	GetSpinLogByIndex(ctx context.Context, pfSessionID uuid.UUID, spinIndex int64) (*SpinLog, error)
 	GetLastSpinLog(ctx context.Context, pfSessionID uuid.UUID) (*SpinLog, error)
+	// GetSpinLogBySpinID returns the spin log recorded for a game spin
+	GetSpinLogBySpinID(ctx context.Context, spinID uuid.UUID) (*SpinLog, error)
 
 	// SessionAudit operations
 	CreateSessionAudit(ctx context.Context, audit *SessionAudit) error
 	GetSessionAudit(ctx context.Context, pfSessionID uuid.UUID) (*SessionAudit, error)
+
+	// ChainAudit operations
+	// ListUnauditedSessions returns sessions ended since endedSince without a chain audit, oldest first
+	ListUnauditedSessions(ctx context.Context, endedSince time.Time, limit int) ([]PFSession, error)
+	CreateChainAudit(ctx context.Context, audit *ChainAudit) error
+	// ListChainAudits returns chain audits newest first, only failed ones when failedOnly is set
+	ListChainAudits(ctx context.Context, failedOnly bool, limit, offset int) ([]ChainAudit, int64, error)
+}
+
+// ChainAlertNotifier alerts operations when a session's hash chain fails its self-audit
+type ChainAlertNotifier interface {
+	// Name identifies the notifier in logs
+	Name() string
+	NotifyChainFailure(ctx context.Context, audit *ChainAudit) error
 }
 
 // CacheRepository defines the interface for Redis-based session state
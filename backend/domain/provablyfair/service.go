@@ -2,8 +2,10 @@ package provablyfair
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
 )
 
 // Service defines the interface for provably fair operations
@@ -49,17 +51,29 @@ type Service interface {
 	// GetActiveSessionByPlayer retrieves the active PF session state by player ID
 	// Used for verify-spin endpoint where we need to find the player's active session
 	GetActiveSessionByPlayer(ctx context.Context, playerID uuid.UUID) (*PFSessionState, error)
+
+	// ListChainAudits lists hash chain self-audit results newest first, only failures when failedOnly is set
+	ListChainAudits(ctx context.Context, failedOnly bool, limit, offset int) ([]ChainAudit, int64, error)
+
+	// VerifyRecordedSpin replays a recorded spin against its session's hash chain
+	// Used by support to settle disputes; works for active sessions without revealing their seed
+	VerifyRecordedSpin(ctx context.Context, spinID uuid.UUID) (*RecordedSpinVerification, error)
 }
 
 // RecordSpinInput contains all data needed to record a spin in the PF system
 type RecordSpinInput struct {
-	GameSessionID     uuid.UUID  // Active game session
-	SpinID            uuid.UUID  // The spin ID from spins table
-	ClientSeed        string     // Client-provided seed for this spin (required for provably fair)
-	ReelPositions     []int      // Array of 5 reel positions from RNG
-	ReelStripConfigID *uuid.UUID // Which reel strip config was used
-	GameMode          *string    // Game mode: nil for normal, or bonus_spin_trigger, etc.
-	IsFreeSpin        bool       // Whether this was a free spin
+	GameSessionID     uuid.UUID          // Active game session
+	SpinID            uuid.UUID          // The spin ID from spins table
+	ClientSeed        string             // Client-provided seed for this spin (required for provably fair)
+	NextClientSeed    string             // Seed the client committed to for its next spin; ClientSeed when empty
+	ReelPositions     []int              // Array of 5 reel positions from RNG
+	ReelStripConfigID *uuid.UUID         // Which reel strip config was used
+	GameMode          *string            // Game mode: nil for normal, or bonus_spin_trigger, etc.
+	IsFreeSpin        bool               // Whether this was a free spin
+	Multipliers       []int              // Cascade multiplier ladder applied to the spin
+	WildFeatures      *game.WildFeatures // Wild features applied to the spin (nil when none)
+	StickyWilds       game.GridPositions // Sticky wilds carried into the spin, needed to replay it
+	MathVersion       string             // Engine build, paytable and multiplier ladder the spin was played with
 	// Dual Commitment Protocol: theta_seed is revealed on first spin
 	ThetaSeed string // Client's session seed - only required for first spin (nonce=1)
 }
@@ -148,6 +162,34 @@ type VerifyActiveSpinResult struct {
 	PrevSpinHash          string // The prev_spin_hash used for calculation (for debugging)
 }
 
+// RecordedSpinVerification is the server-side replay of a recorded spin
+type RecordedSpinVerification struct {
+	SpinID         uuid.UUID `json:"spin_id"`
+	PFSessionID    uuid.UUID `json:"pf_session_id"`
+	SessionEnded   bool      `json:"session_ended"`
+	ServerSeed     string    `json:"server_seed,omitempty"` // Only once revealed by the session ending
+	ServerSeedHash string    `json:"server_seed_hash"`
+	ClientSeed     string    `json:"client_seed"`
+	SpinIndex      int64     `json:"spin_index"`
+	Nonce          int64     `json:"nonce"`
+
+	PrevSpinHash         string `json:"prev_spin_hash"`
+	ExpectedPrevSpinHash string `json:"expected_prev_spin_hash"` // Previous spin's hash, or the genesis hash for the first spin
+	ChainLinkValid       bool   `json:"chain_link_valid"`
+
+	SpinHash         string `json:"spin_hash"`
+	ExpectedSpinHash string `json:"expected_spin_hash"`
+	SpinHashValid    bool   `json:"spin_hash_valid"`
+
+	ReelStripConfigID     *uuid.UUID `json:"reel_strip_config_id,omitempty"`
+	ReelPositions         []int      `json:"reel_positions"`
+	ExpectedReelPositions []int      `json:"expected_reel_positions,omitempty"`
+	ReelPositionsValid    *bool      `json:"reel_positions_valid,omitempty"` // nil when no reel strip config was recorded
+
+	Valid      bool      `json:"valid"`
+	VerifiedAt time.Time `json:"verified_at"`
+}
+
 // HashGenerator defines the interface for hash chain operations
 type HashGenerator interface {
 	// GenerateServerSeed generates a cryptographically secure server seed (256-bit)
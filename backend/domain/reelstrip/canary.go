@@ -0,0 +1,144 @@
+package reelstrip
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CanaryStatus is the state of a canary rollout
+// running -> promoted | reverted | aborted
+type CanaryStatus string
+
+const (
+	CanaryRunning  CanaryStatus = "running"
+	CanaryPromoted CanaryStatus = "promoted" // Trial passed; the config became the game mode default
+	CanaryReverted CanaryStatus = "reverted" // Realized RTP drifted, or too few spins to judge it; players went back to the baseline
+	CanaryAborted  CanaryStatus = "aborted"  // Stopped by an admin, or the default changed during the trial
+)
+
+// Valid reports whether s is a known status
+func (s CanaryStatus) Valid() bool {
+	switch s {
+	case CanaryRunning, CanaryPromoted, CanaryReverted, CanaryAborted:
+		return true
+	}
+	return false
+}
+
+// Canary routes a percentage of players to a newly activated config for a trial period
+// Players outside the canary keep the baseline, the default it would replace. The config is
+// promoted to default when the trial ends within its RTP tolerance, and reverted otherwise.
+type Canary struct {
+	ID               uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	GameMode         string       `gorm:"type:varchar(20);not null" json:"game_mode"`
+	ConfigID         uuid.UUID    `gorm:"type:uuid;not null" json:"config_id"`
+	BaselineConfigID uuid.UUID    `gorm:"type:uuid;not null" json:"baseline_config_id"`
+	Percent          int          `gorm:"not null" json:"percent"`                             // Share of players routed to the config, 1-99
+	MaxRTPDeviation  float64      `gorm:"type:decimal(5,2);not null" json:"max_rtp_deviation"` // Tolerated gap between realized and target RTP, in RTP points
+	MinSpins         int64        `gorm:"not null" json:"min_spins"`                           // Spins needed before the realized RTP is judged
+	Status           CanaryStatus `gorm:"type:varchar(20);not null" json:"status"`
+
+	// Realized performance as of the last monitor pass
+	Spins       int64      `gorm:"not null;default:0" json:"spins"`
+	Wagered     float64    `gorm:"type:decimal(20,2);not null;default:0" json:"wagered"`
+	Won         float64    `gorm:"type:decimal(20,2);not null;default:0" json:"won"`
+	RealizedRTP *float64   `gorm:"type:decimal(7,2)" json:"realized_rtp,omitempty"`
+	CheckedAt   *time.Time `json:"checked_at,omitempty"`
+
+	Reason     string     `gorm:"type:text" json:"reason,omitempty"` // Why the canary finished as it did
+	StartedBy  string     `gorm:"type:varchar(100);not null" json:"started_by"`
+	StartedAt  time.Time  `gorm:"not null" json:"started_at"`
+	EndsAt     time.Time  `gorm:"not null" json:"ends_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	CreatedAt  time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt  time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Canary) TableName() string {
+	return "reel_strip_canaries"
+}
+
+// Includes reports whether the player is routed to the canary config
+// Players are bucketed by a hash of the canary and player IDs, so a player stays in or out
+// for the whole trial and every canary draws a fresh cohort.
+func (c *Canary) Includes(playerID uuid.UUID) bool {
+	h := sha256.New()
+	h.Write(c.ID[:])
+	h.Write(playerID[:])
+	return binary.BigEndian.Uint64(h.Sum(nil)[:8])%100 < uint64(c.Percent)
+}
+
+// CanaryPerformance is what players staked and won on a config
+type CanaryPerformance struct {
+	Spins   int64
+	Wagered float64 // What the spins cost, or the locked bet for free spins
+	Won     float64
+}
+
+// RTP returns the realized RTP in percent, or false before anything was wagered
+func (p *CanaryPerformance) RTP() (float64, bool) {
+	if p.Wagered <= 0 {
+		return 0, false
+	}
+	return p.Won / p.Wagered * 100, true
+}
+
+// StartCanaryRequest starts a canary rollout of an active config
+type StartCanaryRequest struct {
+	ConfigID        uuid.UUID
+	Percent         int
+	MaxRTPDeviation float64
+	MinSpins        int64
+	Trial           time.Duration
+	StartedBy       string
+}
+
+// CanaryListFilters represents filters for listing canaries
+type CanaryListFilters struct {
+	GameMode string
+	Status   CanaryStatus
+	Page     int
+	Limit    int
+}
+
+// CanaryRepository defines the interface for canary data access
+type CanaryRepository interface {
+	// Create returns ErrCanaryRunning when a canary already runs for the game mode
+	Create(ctx context.Context, canary *Canary) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Canary, error)
+	// GetRunning returns ErrCanaryNotFound when no canary runs for the game mode
+	GetRunning(ctx context.Context, gameMode string) (*Canary, error)
+	ListRunning(ctx context.Context) ([]*Canary, error)
+	List(ctx context.Context, filters CanaryListFilters) ([]*Canary, int64, error)
+	// UpdateProgress saves the realized performance of a running canary
+	UpdateProgress(ctx context.Context, canary *Canary) error
+	// Finish saves the outcome of a canary, returning ErrCanaryNotRunning if it already finished
+	Finish(ctx context.Context, canary *Canary) error
+	// Performance sums the spins played on a config since a time
+	Performance(ctx context.Context, configID uuid.UUID, since time.Time) (*CanaryPerformance, error)
+}
+
+// CanaryService defines the business logic interface for canary rollouts
+type CanaryService interface {
+	// Prepare fills in the configured defaults and checks a request without starting it
+	Prepare(ctx context.Context, req *StartCanaryRequest) (*ReelStripConfig, error)
+	// Start returns ErrCanaryRunning when a canary already runs for the config's game mode
+	Start(ctx context.Context, req *StartCanaryRequest) (*Canary, error)
+	Get(ctx context.Context, id uuid.UUID) (*Canary, error)
+	List(ctx context.Context, filters CanaryListFilters) ([]*Canary, int64, error)
+	// Abort stops a running canary, sending its players back to the baseline
+	Abort(ctx context.Context, id uuid.UUID, by, reason string) (*Canary, error)
+	// Evaluate checks every running canary, reverting or promoting those that are done, and returns how many finished
+	Evaluate(ctx context.Context) (int, error)
+}
+
+// CanaryNotifier delivers finished canaries to an external channel
+type CanaryNotifier interface {
+	Name() string
+	NotifyCanary(ctx context.Context, canary *Canary) error
+}
@@ -26,8 +26,10 @@ type ReelStripConfig struct {
 	TargetRTP     float64         `gorm:"type:decimal(5,2)" json:"target_rtp,omitempty"` // e.g., 96.50
 	IsActive      bool            `gorm:"default:true;index" json:"is_active"`
 	IsDefault     bool            `gorm:"default:false;index" json:"is_default"` // Default config for new players
+	IsDemo        bool            `gorm:"not null;index" json:"is_demo"`         // Demo-only (trial) math; never resolved for real-money play
 	ActivatedAt   *time.Time      `json:"activated_at,omitempty"`
 	DeactivatedAt *time.Time      `json:"deactivated_at,omitempty"`
+	DeletedAt     *time.Time      `gorm:"type:timestamp with time zone" json:"deleted_at,omitempty"` // Soft-deleted configs are never resolved for play
 	CreatedAt     time.Time       `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
 	UpdatedAt     time.Time       `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
 	CreatedBy     string          `gorm:"type:varchar(100)" json:"created_by,omitempty"` // Admin username who created this config
@@ -40,12 +42,38 @@ func (ReelStripConfig) TableName() string {
 	return "reel_strip_configs"
 }
 
+// ConfigUpdate holds edits to a config's details; nil fields keep their value
+type ConfigUpdate struct {
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	TargetRTP   *float64 `json:"target_rtp,omitempty"`
+	Notes       *string  `json:"notes,omitempty"`
+}
+
+// Apply sets the edited fields on config
+func (u *ConfigUpdate) Apply(config *ReelStripConfig) {
+	if u.Name != nil {
+		config.Name = *u.Name
+	}
+	if u.Description != nil {
+		config.Description = *u.Description
+	}
+	if u.TargetRTP != nil {
+		config.TargetRTP = *u.TargetRTP
+	}
+	if u.Notes != nil {
+		config.Notes = *u.Notes
+	}
+}
+
 // ConfigListFilters represents filters for listing reel strip configurations
 type ConfigListFilters struct {
 	GameMode  *string // Filter by game mode (base_game, free_spins, both)
 	IsActive  *bool   // Filter by active status
 	IsDefault *bool   // Filter by default status
+	IsDemo    *bool   // Filter by demo-only status
 	Name      *string // Filter by name (partial match)
+	Deleted   bool    // List soft-deleted configs instead of live ones
 	Page      int     // Page number (1-indexed)
 	Limit     int     // Items per page
 }
@@ -75,6 +103,32 @@ func (PlayerReelStripAssignment) TableName() string {
 	return "player_reel_strip_assignments"
 }
 
+// OperatorReelStripDefault is the reel strip configuration an operator's players get by default
+// It sits between segment targets and the global default, so each operator runs its contracted
+// RTP without per-player assignments.
+type OperatorReelStripDefault struct {
+	OperatorID        string     `gorm:"type:varchar(100);primary_key" json:"operator_id"`
+	TargetRTP         float64    `gorm:"type:decimal(5,2);not null" json:"target_rtp"` // Contracted RTP, e.g. 94.00
+	BaseGameConfigID  *uuid.UUID `gorm:"type:uuid" json:"base_game_config_id,omitempty"`
+	FreeSpinsConfigID *uuid.UUID `gorm:"type:uuid" json:"free_spins_config_id,omitempty"`
+	UpdatedBy         string     `gorm:"type:varchar(100)" json:"updated_by,omitempty"` // Admin who last changed it
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (OperatorReelStripDefault) TableName() string {
+	return "operator_reel_strip_defaults"
+}
+
+// ConfigID returns the default config for a game mode, or nil if the operator has none for it
+func (d *OperatorReelStripDefault) ConfigID(gameMode string) *uuid.UUID {
+	if gameMode == string(FreeSpins) {
+		return d.FreeSpinsConfigID
+	}
+	return d.BaseGameConfigID
+}
+
 // ReelStripConfigSet represents a complete configuration with loaded reel strips
 type ReelStripConfigSet struct {
 	Config *ReelStripConfig
@@ -94,3 +148,16 @@ func (s *ReelStripConfigSet) IsComplete() bool {
 	}
 	return true
 }
+
+// IsDemo reports whether the set is built from a demo-only config
+func (s *ReelStripConfigSet) IsDemo() bool {
+	return s.Config != nil && s.Config.IsDemo
+}
+
+// RequireRealMoney returns ErrDemoConfig when the set must not be used for real-money play
+func (s *ReelStripConfigSet) RequireRealMoney() error {
+	if s.IsDemo() {
+		return ErrDemoConfig
+	}
+	return nil
+}
@@ -11,13 +11,30 @@ var (
 	ErrInvalidStripLength = errors.New("invalid strip length")
 	ErrChecksumMismatch   = errors.New("checksum mismatch")
 	ErrNoActiveStrips     = errors.New("no active reel strips available")
+	ErrInvalidWeights     = errors.New("invalid symbol weights")
 
 	// ReelStripConfig errors
 	ErrConfigNotFound  = errors.New("reel strip config not found")
 	ErrNoDefaultConfig = errors.New("no default config found for game mode")
 	ErrInvalidConfig   = errors.New("invalid reel strip config")
+	ErrDemoConfig      = errors.New("demo reel strip config cannot be used for real-money play")
+	ErrNotDemoConfig   = errors.New("reel strip config is not a demo config")
+	ErrConfigIsDefault = errors.New("reel strip config is a game mode default")
+	ErrConfigNameTaken = errors.New("reel strip config name is used by another config")
+	ErrConfigInUse     = errors.New("reel strip config is still assigned to players, operators, canaries, segments or demo play")
 
 	// PlayerReelStripAssignment errors
 	ErrAssignmentNotFound = errors.New("player reel strip assignment not found")
 	ErrInvalidAssignment  = errors.New("invalid player assignment")
+
+	// OperatorReelStripDefault errors
+	ErrOperatorDefaultNotFound = errors.New("operator reel strip default not found")
+	ErrInvalidOperatorDefault  = errors.New("invalid operator reel strip default")
+	ErrTargetRTPMismatch       = errors.New("reel strip config target RTP does not match the operator's")
+
+	// Canary errors
+	ErrCanaryNotFound   = errors.New("reel strip canary not found")
+	ErrCanaryRunning    = errors.New("a canary is already running for the game mode")
+	ErrCanaryNotRunning = errors.New("reel strip canary is not running")
+	ErrInvalidCanary    = errors.New("invalid reel strip canary")
 )
@@ -1,6 +1,9 @@
 package reelstrip
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -65,3 +68,11 @@ func (s *ReelStripSet) GetStripData() [][]string {
 	}
 	return result
 }
+
+// ComputeChecksum returns the SHA256 checksum of strip data as stored in ReelStrip.Checksum
+// The strip is hashed in its JSON array form for consistent hashing
+func ComputeChecksum(stripData []string) string {
+	jsonData, _ := json.Marshal(stripData)
+	hash := sha256.Sum256(jsonData)
+	return hex.EncodeToString(hash[:])
+}
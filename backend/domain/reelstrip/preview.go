@@ -0,0 +1,34 @@
+package reelstrip
+
+import "context"
+
+// PreviewSpins is how many spins a strip set preview simulates by default, and at most
+const PreviewSpins = 1_000_000
+
+// StripAnalysis describes how the symbols of a reel strip are laid out
+type StripAnalysis struct {
+	Length         int                `json:"length"`
+	SymbolCounts   map[string]int     `json:"symbol_counts"`
+	AvgSpacing     map[string]float64 `json:"avg_spacing"`      // Mean distance between consecutive occurrences, for symbols seen twice or more
+	MaxClusterSize map[string]int     `json:"max_cluster_size"` // Longest run of the symbol
+}
+
+// RTPEstimate is the outcome of simulating spins on a strip set
+type RTPEstimate struct {
+	Spins                int     `json:"spins"`
+	RTP                  float64 `json:"rtp"`                     // Percent of the amount wagered that is won back
+	HitRate              float64 `json:"hit_rate"`                // Percent of spins that win
+	FreeSpinsTriggerRate float64 `json:"free_spins_trigger_rate"` // Percent of spins that trigger free spins
+	MaxWin               float64 `json:"max_win"`                 // Largest single spin win, in bets
+}
+
+// Simulator analyzes reel strips and estimates how a strip set pays before it is saved
+type Simulator interface {
+	// AnalyzeStrip returns the symbol counts, spacing and clusters of a strip
+	AnalyzeStrip(strip []string) StripAnalysis
+
+	// EstimateRTP plays spins spins of gameMode on strips, one strip per reel
+	// Returns ErrInvalidGameMode for modes it cannot simulate and ErrIncompleteSet unless there are 5 strips.
+	// It stops early with ctx's error once ctx is done.
+	EstimateRTP(ctx context.Context, gameMode string, strips [][]string, spins int) (*RTPEstimate, error)
+}
@@ -24,15 +24,17 @@ type Repository interface {
 
 	// ReelStripConfig operations
 	CreateConfig(ctx context.Context, config *ReelStripConfig) error
-	GetConfigByID(ctx context.Context, id uuid.UUID) (*ReelStripConfig, error)
+	GetConfigByID(ctx context.Context, id uuid.UUID) (*ReelStripConfig, error) // Soft-deleted configs are not found
 	GetConfigByName(ctx context.Context, name string) (*ReelStripConfig, error)
 	GetDefaultConfig(ctx context.Context, gameMode string) (*ReelStripConfig, error)
 	ListConfigs(ctx context.Context, filters *ConfigListFilters) ([]*ReelStripConfig, int64, error)
 	UpdateConfig(ctx context.Context, config *ReelStripConfig) error
-	DeleteConfig(ctx context.Context, id uuid.UUID) error
+	DeleteConfig(ctx context.Context, id uuid.UUID) error  // Soft-deletes and deactivates; a default config cannot be deleted
+	RestoreConfig(ctx context.Context, id uuid.UUID) error // Undeletes a config, still inactive
 	SetDefaultConfig(ctx context.Context, id uuid.UUID, gameMode string) error
 
 	// Get complete reel strip set by config ID
+	// Soft-deleted configs still load so past spins stay verifiable; play must not resolve them.
 	GetSetByConfigID(ctx context.Context, configID uuid.UUID) (*ReelStripConfigSet, error)
 
 	// PlayerReelStripAssignment operations
@@ -41,4 +43,10 @@ type Repository interface {
 	GetPlayerAssignmentsByPlayerIDs(ctx context.Context, playerIDs []uuid.UUID) (map[uuid.UUID]*PlayerReelStripAssignment, error)
 	UpdateAssignment(ctx context.Context, assignment *PlayerReelStripAssignment) error
 	DeleteAssignment(ctx context.Context, id uuid.UUID) error
+
+	// OperatorReelStripDefault operations
+	GetOperatorDefault(ctx context.Context, operatorID string) (*OperatorReelStripDefault, error)
+	ListOperatorDefaults(ctx context.Context) ([]*OperatorReelStripDefault, error)
+	UpsertOperatorDefault(ctx context.Context, def *OperatorReelStripDefault) error
+	DeleteOperatorDefault(ctx context.Context, operatorID string) error
 }
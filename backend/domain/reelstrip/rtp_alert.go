@@ -0,0 +1,84 @@
+package reelstrip
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RTPAlert records a config whose realized RTP over a rolling window fell outside the range
+// its target RTP allows for the sample size
+// A realized RTP that far off is rarely bad luck; it usually means a math, config or data bug.
+type RTPAlert struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ConfigID    uuid.UUID `gorm:"type:uuid;not null" json:"config_id"`
+	ConfigName  string    `gorm:"type:varchar(100);not null" json:"config_name"`
+	GameMode    string    `gorm:"type:varchar(20);not null" json:"game_mode"`
+	TargetRTP   float64   `gorm:"type:decimal(5,2);not null" json:"target_rtp"`
+	RealizedRTP float64   `gorm:"type:decimal(7,2);not null" json:"realized_rtp"`
+	LowerBound  float64   `gorm:"type:decimal(7,2);not null" json:"lower_bound"` // Lowest realized RTP expected for the sample size
+	UpperBound  float64   `gorm:"type:decimal(7,2);not null" json:"upper_bound"` // Highest realized RTP expected for the sample size
+	ZScore      float64   `gorm:"type:decimal(8,2);not null" json:"z_score"`     // Standard errors between realized and target RTP
+	Spins       int64     `gorm:"not null" json:"spins"`
+	Wagered     float64   `gorm:"type:decimal(20,2);not null" json:"wagered"`
+	Won         float64   `gorm:"type:decimal(20,2);not null" json:"won"`
+	WindowStart time.Time `gorm:"not null" json:"window_start"`
+	WindowEnd   time.Time `gorm:"not null" json:"window_end"`
+	CreatedAt   time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (RTPAlert) TableName() string {
+	return "rtp_alerts"
+}
+
+// RTPSample sums the spins played on a config over a window
+// Returns are each spin's win divided by its stake, whose spread sets how far the realized
+// RTP may stray from target by chance.
+type RTPSample struct {
+	Spins             int64
+	Wagered           float64 // What the spins cost, or the locked bet for free spins
+	Won               float64
+	SumReturns        float64
+	SumSquaredReturns float64
+}
+
+// RTP returns the realized RTP in percent, or false before anything was wagered
+func (s *RTPSample) RTP() (float64, bool) {
+	if s.Wagered <= 0 {
+		return 0, false
+	}
+	return s.Won / s.Wagered * 100, true
+}
+
+// StandardError returns the standard error of the realized RTP in RTP points, from the
+// sample variance of spin returns
+func (s *RTPSample) StandardError() float64 {
+	if s.Spins < 2 {
+		return math.Inf(1)
+	}
+	n := float64(s.Spins)
+	mean := s.SumReturns / n
+	variance := (s.SumSquaredReturns - n*mean*mean) / (n - 1)
+	if variance < 0 {
+		variance = 0 // Rounding on near-constant returns
+	}
+	return math.Sqrt(variance/n) * 100
+}
+
+// RTPAlertRepository defines the interface for RTP alert data access
+type RTPAlertRepository interface {
+	// Sample sums the spins played on a config in [since, until)
+	Sample(ctx context.Context, configID uuid.UUID, since, until time.Time) (*RTPSample, error)
+	Create(ctx context.Context, alert *RTPAlert) error
+	// LastAlertedAt returns when the config was last alerted on, or nil if it never was
+	LastAlertedAt(ctx context.Context, configID uuid.UUID) (*time.Time, error)
+}
+
+// RTPAlertNotifier delivers RTP alerts to operations
+type RTPAlertNotifier interface {
+	Name() string
+	NotifyRTPAlert(ctx context.Context, alert *RTPAlert) error
+}
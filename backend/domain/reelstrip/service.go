@@ -19,23 +19,40 @@ type Service interface {
 	// GetDefaultReelSet retrieves the default reel strip set for a game mode
 	GetDefaultReelSet(ctx context.Context, gameMode string) (*ReelStripConfigSet, error)
 
+	// GetDemoReelSet retrieves a demo-only reel strip set for trial play
+	// Returns ErrNotDemoConfig for configs that are not flagged as demo
+	GetDemoReelSet(ctx context.Context, configID uuid.UUID) (*ReelStripConfigSet, error)
+
 	// ReelStripConfig management
 	CreateConfig(ctx context.Context, name, gameMode, description string, reelStripIDs [5]uuid.UUID, targetRTP float64, extraInfoJSON []byte) (*ReelStripConfig, error)
+	CreateDemoConfig(ctx context.Context, name, gameMode, description string, reelStripIDs [5]uuid.UUID, targetRTP float64) (*ReelStripConfig, error) // Demo flag cannot be changed later
 	GetConfigByID(ctx context.Context, id uuid.UUID) (*ReelStripConfig, error)
 	GetConfigByName(ctx context.Context, name string) (*ReelStripConfig, error)
 	ListConfigs(ctx context.Context, filters *ConfigListFilters) ([]*ReelStripConfig, int64, error)
+	// UpdateConfig edits a config's name, description, target RTP and notes
+	UpdateConfig(ctx context.Context, configID uuid.UUID, update *ConfigUpdate) (*ReelStripConfig, error)
 	SetDefaultConfig(ctx context.Context, configID uuid.UUID, gameMode string) error
 	ActivateConfig(ctx context.Context, configID uuid.UUID) error
 	DeactivateConfig(ctx context.Context, configID uuid.UUID) error
+	DeleteConfig(ctx context.Context, configID uuid.UUID) error
+	RestoreConfig(ctx context.Context, configID uuid.UUID) error
 
 	// Player assignment management
 	AssignConfigToPlayer(ctx context.Context, playerID, configID uuid.UUID, gameMode, reason, assignedBy string, expiresAt *time.Time) error
 	GetPlayerAssignment(ctx context.Context, playerID uuid.UUID) (*PlayerReelStripAssignment, error)
 	RemovePlayerAssignment(ctx context.Context, playerID uuid.UUID) error
 
+	// Operator default management
+	// SetOperatorDefault returns ErrTargetRTPMismatch for configs tuned to a different RTP than the operator's
+	SetOperatorDefault(ctx context.Context, def *OperatorReelStripDefault) error
+	GetOperatorDefault(ctx context.Context, operatorID string) (*OperatorReelStripDefault, error)
+	ListOperatorDefaults(ctx context.Context) ([]*OperatorReelStripDefault, error)
+	RemoveOperatorDefault(ctx context.Context, operatorID string) error
+
 	// ReelStrip operations (for creating configs)
 	GenerateAndSaveStrips(ctx context.Context, gameMode string, count int, version int) error
-	GenerateAndSaveStripSet(ctx context.Context, gameMode string) ([5]uuid.UUID, error) // Generates one complete set and returns strip IDs
+	GenerateAndSaveStripSet(ctx context.Context, gameMode string) ([5]uuid.UUID, error)           // Generates one complete set and returns strip IDs
+	CreateStripSet(ctx context.Context, gameMode string, strips [][]string) ([5]uuid.UUID, error) // Saves one strip per reel and returns strip IDs
 	GetStripByID(ctx context.Context, id uuid.UUID) (*ReelStrip, error)
 	GetActiveStripsCount(ctx context.Context, gameMode string) (map[int]int, error)
 	ValidateStripIntegrity(strip *ReelStrip) error
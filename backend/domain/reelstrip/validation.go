@@ -0,0 +1,30 @@
+package reelstrip
+
+// Rules a reel strip can violate, named after the generator constraint they come from
+const (
+	RuleUnknownSymbol    = "unknown_symbol"
+	RuleMinSpacing       = "min_spacing"
+	RuleMaxCluster       = "max_cluster"
+	RuleForbiddenPair    = "forbidden_pair"
+	RuleClusterForbidden = "cluster_forbidden"
+	RuleGoldNotAllowed   = "gold_not_allowed"
+	RuleGoldSpacing      = "gold_spacing"
+	RuleGoldCluster      = "gold_cluster"
+	RuleGoldRatio        = "gold_ratio"
+)
+
+// Violation is a place where a reel strip breaks a rule generated strips follow
+type Violation struct {
+	Reel     int    `json:"reel"`             // 0-4
+	Position int    `json:"position"`         // Index in the strip; -1 for rules about the whole strip
+	Rule     string `json:"rule"`             // One of the Rule constants
+	Symbol   string `json:"symbol,omitempty"` // Symbol at Position
+	Message  string `json:"message"`
+}
+
+// Validator checks a set of reel strips against the generation rules of a game mode
+type Validator interface {
+	// Validate returns every violation in strips, one strip per reel
+	// Returns ErrInvalidGameMode for modes without rules and ErrIncompleteSet unless there are 5 strips.
+	Validate(gameMode string, strips [][]string) ([]Violation, error)
+}
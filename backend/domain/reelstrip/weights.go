@@ -0,0 +1,66 @@
+package reelstrip
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SymbolWeights is how many of each symbol, gold variants included, a reel strip is generated
+// with, before the mode's topology scales them
+type SymbolWeights map[string]int
+
+// ReelWeights holds the symbol weights of each reel
+type ReelWeights [5]SymbolWeights
+
+// Validate returns ErrInvalidWeights unless every reel has weights, none negative and at least one positive
+func (w ReelWeights) Validate() error {
+	for reel, weights := range w {
+		total := 0
+		for sym, weight := range weights {
+			if weight < 0 {
+				return fmt.Errorf("%w: reel %d: negative weight for %q", ErrInvalidWeights, reel, sym)
+			}
+			total += weight
+		}
+		if total == 0 {
+			return fmt.Errorf("%w: reel %d has no symbols", ErrInvalidWeights, reel)
+		}
+	}
+	return nil
+}
+
+// GeneratorInput is what a strip set is generated from
+// A config generated from weights keeps its input in its options, so it can be edited and
+// generated again.
+type GeneratorInput struct {
+	Weights ReelWeights `json:"symbol_weights"`
+	Seed    int64       `json:"generator_seed"`
+}
+
+// GeneratorInput returns the weights and seed the config's strips were generated from
+// ok is false for configs whose strips were uploaded, seeded or tuned offline.
+func (c *ReelStripConfig) GeneratorInput() (input GeneratorInput, ok bool) {
+	if len(c.Options) == 0 {
+		return input, false
+	}
+	if err := json.Unmarshal(c.Options, &input); err != nil {
+		return GeneratorInput{}, false
+	}
+	for _, weights := range input.Weights {
+		if weights == nil {
+			return GeneratorInput{}, false
+		}
+	}
+	return input, true
+}
+
+// Generator builds strip sets from symbol weights, as the tuning pipeline does
+type Generator interface {
+	// DefaultInput returns the weights and seed gameMode's strips are tuned from
+	// Returns ErrInvalidGameMode for modes it cannot generate.
+	DefaultInput(gameMode string) (GeneratorInput, error)
+
+	// Generate builds a strip set of gameMode from input, one strip per reel
+	// Returns ErrInvalidGameMode for modes it cannot generate and ErrInvalidWeights for weights it cannot use.
+	Generate(gameMode string, input GeneratorInput) ([][]string, error)
+}
@@ -0,0 +1,19 @@
+package segment
+
+import "errors"
+
+var (
+	// Segment errors
+	ErrSegmentNotFound  = errors.New("segment not found")
+	ErrSegmentNameTaken = errors.New("segment name already exists")
+	ErrInvalidRule      = errors.New("invalid segment rule")
+
+	// Target errors
+	ErrTargetNotFound    = errors.New("segment target not found")
+	ErrInvalidTargetKind = errors.New("invalid segment target kind")
+	ErrInvalidSettings   = errors.New("invalid segment target settings")
+	ErrNoTarget          = errors.New("no segment target matches player")
+
+	// Tag errors
+	ErrInvalidTag = errors.New("invalid tag")
+)
@@ -0,0 +1,217 @@
+package segment
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PlayerTag is a free-form label attached to a player (e.g. "vip", "affiliate:acme")
+type PlayerTag struct {
+	PlayerID  uuid.UUID `gorm:"type:uuid;primaryKey" json:"player_id"`
+	Tag       string    `gorm:"type:varchar(64);primaryKey" json:"tag"`
+	CreatedBy string    `gorm:"type:varchar(100)" json:"created_by,omitempty"`
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (PlayerTag) TableName() string {
+	return "player_tags"
+}
+
+// Segment is a named group of players defined by rules
+// A player belongs to a segment when every rule matches. A segment made only of tag
+// rules is static; rules on player activity make it dynamic.
+// IsActive has no GORM default so segments and targets can be created inactive.
+type Segment struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	Name        string    `gorm:"type:varchar(100);uniqueIndex;not null" json:"name"`
+	Description string    `gorm:"type:text" json:"description,omitempty"`
+	Rules       Rules     `gorm:"type:jsonb;not null" json:"rules"`
+	IsActive    bool      `gorm:"index" json:"is_active"`
+	CreatedBy   string    `gorm:"type:varchar(100)" json:"created_by,omitempty"`
+	CreatedAt   time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Segment) TableName() string {
+	return "segments"
+}
+
+// IsDynamic reports whether membership depends on player activity rather than tags only
+func (s *Segment) IsDynamic() bool {
+	for _, r := range s.Rules {
+		if r.Field != FieldTag {
+			return true
+		}
+	}
+	return false
+}
+
+// Rules is the list of conditions a player must all match
+type Rules []Rule
+
+// Scan implements the sql.Scanner interface for Rules
+func (r *Rules) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface for Rules
+func (r Rules) Value() (driver.Value, error) {
+	if r == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(r)
+}
+
+// TargetKind identifies what a segment target configures
+type TargetKind string
+
+const (
+	// TargetReelStrip assigns reel strip configs to segment members
+	TargetReelStrip TargetKind = "reel_strip"
+	// TargetBonus grants extra free spins to segment members when free spins trigger
+	TargetBonus TargetKind = "bonus"
+	// TargetRateLimit overrides the authenticated rate limit of segment members
+	TargetRateLimit TargetKind = "rate_limit"
+)
+
+// TargetKinds lists every target kind
+var TargetKinds = []TargetKind{TargetReelStrip, TargetBonus, TargetRateLimit}
+
+// Valid reports whether the kind is known
+func (k TargetKind) Valid() bool {
+	switch k {
+	case TargetReelStrip, TargetBonus, TargetRateLimit:
+		return true
+	}
+	return false
+}
+
+// Target applies settings of one kind to every member of a segment
+// When a player matches several targets of the same kind, the highest priority wins.
+type Target struct {
+	ID        uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	SegmentID uuid.UUID       `gorm:"type:uuid;not null;index" json:"segment_id"`
+	Kind      TargetKind      `gorm:"type:varchar(20);not null;index" json:"kind"`
+	Priority  int             `gorm:"default:0" json:"priority"`
+	Settings  json.RawMessage `gorm:"type:jsonb;not null" json:"settings"`
+	IsActive  bool            `gorm:"index" json:"is_active"`
+	CreatedBy string          `gorm:"type:varchar(100)" json:"created_by,omitempty"`
+	CreatedAt time.Time       `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time       `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Target) TableName() string {
+	return "segment_targets"
+}
+
+// ReelStripSettings are the settings of a reel_strip target
+type ReelStripSettings struct {
+	BaseGameConfigID  *uuid.UUID `json:"base_game_config_id,omitempty"`
+	FreeSpinsConfigID *uuid.UUID `json:"free_spins_config_id,omitempty"`
+}
+
+// BonusSettings are the settings of a bonus target
+type BonusSettings struct {
+	ExtraFreeSpins int `json:"extra_free_spins"`
+}
+
+// RateLimitSettings are the settings of a rate_limit target
+type RateLimitSettings struct {
+	RPS int `json:"rps"`
+}
+
+// ReelStripSettings decodes the settings of a reel_strip target
+func (t *Target) ReelStripSettings() (*ReelStripSettings, error) {
+	var s ReelStripSettings
+	if err := t.decodeSettings(TargetReelStrip, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// BonusSettings decodes the settings of a bonus target
+func (t *Target) BonusSettings() (*BonusSettings, error) {
+	var s BonusSettings
+	if err := t.decodeSettings(TargetBonus, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// RateLimitSettings decodes the settings of a rate_limit target
+func (t *Target) RateLimitSettings() (*RateLimitSettings, error) {
+	var s RateLimitSettings
+	if err := t.decodeSettings(TargetRateLimit, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Validate checks the kind and decodes the settings to make sure they are usable
+func (t *Target) Validate() error {
+	switch t.Kind {
+	case TargetReelStrip:
+		s, err := t.ReelStripSettings()
+		if err != nil {
+			return err
+		}
+		if s.BaseGameConfigID == nil && s.FreeSpinsConfigID == nil {
+			return ErrInvalidSettings
+		}
+	case TargetBonus:
+		s, err := t.BonusSettings()
+		if err != nil {
+			return err
+		}
+		if s.ExtraFreeSpins <= 0 {
+			return ErrInvalidSettings
+		}
+	case TargetRateLimit:
+		s, err := t.RateLimitSettings()
+		if err != nil {
+			return err
+		}
+		if s.RPS <= 0 {
+			return ErrInvalidSettings
+		}
+	default:
+		return ErrInvalidTargetKind
+	}
+	return nil
+}
+
+func (t *Target) decodeSettings(kind TargetKind, dst any) error {
+	if t.Kind != kind {
+		return ErrInvalidTargetKind
+	}
+	if err := json.Unmarshal(t.Settings, dst); err != nil {
+		return ErrInvalidSettings
+	}
+	return nil
+}
+
+// TargetListFilters represents filters for listing segment targets
+type TargetListFilters struct {
+	SegmentID *uuid.UUID
+	Kind      *TargetKind
+	IsActive  *bool
+}
+
+// ActivityStats aggregates a player's spins over a time window
+type ActivityStats struct {
+	Spins   int     `json:"spins"`
+	Wagered float64 `json:"wagered"`
+	Won     float64 `json:"won"`
+}
@@ -0,0 +1,36 @@
+package segment
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for segment data access
+type Repository interface {
+	// Tag operations
+	AddTag(ctx context.Context, tag *PlayerTag) error
+	RemoveTag(ctx context.Context, playerID uuid.UUID, tag string) error
+	ListTags(ctx context.Context, playerID uuid.UUID) ([]*PlayerTag, error)
+
+	// Segment operations
+	CreateSegment(ctx context.Context, segment *Segment) error
+	GetSegmentByID(ctx context.Context, id uuid.UUID) (*Segment, error)
+	GetSegmentByName(ctx context.Context, name string) (*Segment, error)
+	GetSegmentsByIDs(ctx context.Context, ids []uuid.UUID) ([]*Segment, error)
+	ListSegments(ctx context.Context, isActive *bool) ([]*Segment, error)
+	UpdateSegment(ctx context.Context, segment *Segment) error
+	DeleteSegment(ctx context.Context, id uuid.UUID) error
+
+	// Target operations
+	CreateTarget(ctx context.Context, target *Target) error
+	GetTargetByID(ctx context.Context, id uuid.UUID) (*Target, error)
+	// ListTargets returns targets ordered by priority, highest first
+	ListTargets(ctx context.Context, filters TargetListFilters) ([]*Target, error)
+	UpdateTarget(ctx context.Context, target *Target) error
+	DeleteTarget(ctx context.Context, id uuid.UUID) error
+
+	// GetActivityStats aggregates a player's spins created since the given time
+	GetActivityStats(ctx context.Context, playerID uuid.UUID, since time.Time) (*ActivityStats, error)
+}
@@ -0,0 +1,207 @@
+package segment
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Rule fields
+const (
+	FieldTag          = "tag"              // player carries Tag
+	FieldBalance      = "balance"          // current balance
+	FieldTotalSpins   = "total_spins"      // lifetime spins
+	FieldTotalWagered = "total_wagered"    // lifetime amount wagered
+	FieldTotalWon     = "total_won"        // lifetime amount won
+	FieldAccountAge   = "account_age_days" // days since registration
+	FieldVIPLevel     = "vip_level"        // current VIP tier level (0 = no tier)
+	FieldSpins        = "spins"            // spins within WindowDays
+	FieldWagered      = "wagered"          // amount wagered within WindowDays
+	FieldWon          = "won"              // amount won within WindowDays
+)
+
+const (
+	// MaxRuleWindowDays is the longest activity window a rule may aggregate
+	MaxRuleWindowDays = 365
+
+	maxTagLength = 64
+)
+
+// Rule operators
+const (
+	OpHas    = "has"
+	OpNotHas = "not_has"
+	OpGT     = "gt"
+	OpGTE    = "gte"
+	OpLT     = "lt"
+	OpLTE    = "lte"
+	OpEQ     = "eq"
+)
+
+// Rule is a single membership condition
+// Tag rules use Op has/not_has with Tag; numeric rules compare the field to Value.
+// Activity fields (spins, wagered, won) are aggregated over the last WindowDays days,
+// e.g. {"field":"wagered","op":"gt","value":1000,"window_days":7}.
+type Rule struct {
+	Field      string  `json:"field"`
+	Op         string  `json:"op"`
+	Tag        string  `json:"tag,omitempty"`
+	Value      float64 `json:"value,omitempty"`
+	WindowDays int     `json:"window_days,omitempty"`
+}
+
+// Validate checks that the rule can be evaluated
+func (r Rule) Validate() error {
+	switch r.Field {
+	case FieldTag:
+		if r.Op != OpHas && r.Op != OpNotHas {
+			return fmt.Errorf("%w: tag rules support has/not_has, got %q", ErrInvalidRule, r.Op)
+		}
+		if NormalizeTag(r.Tag) == "" {
+			return fmt.Errorf("%w: tag is required", ErrInvalidRule)
+		}
+		return nil
+	case FieldBalance, FieldTotalSpins, FieldTotalWagered, FieldTotalWon, FieldAccountAge, FieldVIPLevel:
+		if r.WindowDays != 0 {
+			return fmt.Errorf("%w: %s does not take window_days", ErrInvalidRule, r.Field)
+		}
+	case FieldSpins, FieldWagered, FieldWon:
+		if r.WindowDays < 1 || r.WindowDays > MaxRuleWindowDays {
+			return fmt.Errorf("%w: %s requires window_days between 1 and %d", ErrInvalidRule, r.Field, MaxRuleWindowDays)
+		}
+	default:
+		return fmt.Errorf("%w: unknown field %q", ErrInvalidRule, r.Field)
+	}
+
+	switch r.Op {
+	case OpGT, OpGTE, OpLT, OpLTE, OpEQ:
+		return nil
+	}
+	return fmt.Errorf("%w: unknown operator %q for %s", ErrInvalidRule, r.Op, r.Field)
+}
+
+// IsActivity reports whether the rule is aggregated over a time window
+func (r Rule) IsActivity() bool {
+	return r.Field == FieldSpins || r.Field == FieldWagered || r.Field == FieldWon
+}
+
+// Validate checks every rule; an empty rule list is rejected so a segment never matches everyone by accident
+func (rs Rules) Validate() error {
+	if len(rs) == 0 {
+		return fmt.Errorf("%w: at least one rule is required", ErrInvalidRule)
+	}
+	for i, r := range rs {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Facts is what rules are evaluated against
+type Facts struct {
+	Tags         map[string]bool
+	Balance      float64
+	TotalSpins   int
+	TotalWagered float64
+	TotalWon     float64
+	CreatedAt    time.Time
+	Now          time.Time
+	VIPLevel     int
+	// Activity holds aggregated stats keyed by window length in days
+	Activity map[int]ActivityStats
+}
+
+// Matches reports whether the facts satisfy the rule
+// Activity rules whose window is missing from facts never match.
+func (r Rule) Matches(f *Facts) bool {
+	var v float64
+	switch r.Field {
+	case FieldTag:
+		has := f.Tags[NormalizeTag(r.Tag)]
+		if r.Op == OpNotHas {
+			return !has
+		}
+		return has
+	case FieldBalance:
+		v = f.Balance
+	case FieldTotalSpins:
+		v = float64(f.TotalSpins)
+	case FieldTotalWagered:
+		v = f.TotalWagered
+	case FieldTotalWon:
+		v = f.TotalWon
+	case FieldAccountAge:
+		v = float64(int(f.Now.Sub(f.CreatedAt) / (24 * time.Hour)))
+	case FieldVIPLevel:
+		v = float64(f.VIPLevel)
+	case FieldSpins, FieldWagered, FieldWon:
+		stats, ok := f.Activity[r.WindowDays]
+		if !ok {
+			return false
+		}
+		switch r.Field {
+		case FieldSpins:
+			v = float64(stats.Spins)
+		case FieldWagered:
+			v = stats.Wagered
+		default:
+			v = stats.Won
+		}
+	default:
+		return false
+	}
+
+	switch r.Op {
+	case OpGT:
+		return v > r.Value
+	case OpGTE:
+		return v >= r.Value
+	case OpLT:
+		return v < r.Value
+	case OpLTE:
+		return v <= r.Value
+	case OpEQ:
+		return v == r.Value
+	}
+	return false
+}
+
+// Matches reports whether the facts satisfy every rule
+func (rs Rules) Matches(f *Facts) bool {
+	for _, r := range rs {
+		if !r.Matches(f) {
+			return false
+		}
+	}
+	return len(rs) > 0
+}
+
+// Windows returns the distinct activity windows (in days) the rules need
+func (rs Rules) Windows() []int {
+	var windows []int
+	seen := make(map[int]bool)
+	for _, r := range rs {
+		if r.IsActivity() && !seen[r.WindowDays] {
+			seen[r.WindowDays] = true
+			windows = append(windows, r.WindowDays)
+		}
+	}
+	return windows
+}
+
+// NormalizeTag lowercases and trims a tag
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// ValidateTag checks a normalized tag
+func ValidateTag(tag string) error {
+	if tag == "" || len(tag) > maxTagLength {
+		return fmt.Errorf("%w: tags must be 1-%d characters", ErrInvalidTag, maxTagLength)
+	}
+	if strings.ContainsAny(tag, " \t\n,") {
+		return fmt.Errorf("%w: tags cannot contain whitespace or commas", ErrInvalidTag)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package segment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Resolver resolves which segment target applies to a player
+type Resolver interface {
+	// ResolveTarget returns the highest priority active target of the kind whose segment
+	// contains the player, or ErrNoTarget
+	ResolveTarget(ctx context.Context, playerID uuid.UUID, kind TargetKind) (*Target, error)
+}
+
+// Service defines the business logic interface for player tags and segments
+type Service interface {
+	Resolver
+
+	// Tag management
+	AddTag(ctx context.Context, playerID uuid.UUID, tag, createdBy string) (*PlayerTag, error)
+	RemoveTag(ctx context.Context, playerID uuid.UUID, tag string) error
+	ListTags(ctx context.Context, playerID uuid.UUID) ([]*PlayerTag, error)
+
+	// Segment management
+	CreateSegment(ctx context.Context, segment *Segment) error
+	GetSegment(ctx context.Context, id uuid.UUID) (*Segment, error)
+	ListSegments(ctx context.Context, isActive *bool) ([]*Segment, error)
+	UpdateSegment(ctx context.Context, segment *Segment) error
+	DeleteSegment(ctx context.Context, id uuid.UUID) error
+
+	// Target management
+	CreateTarget(ctx context.Context, target *Target) error
+	GetTarget(ctx context.Context, id uuid.UUID) (*Target, error)
+	ListTargets(ctx context.Context, filters TargetListFilters) ([]*Target, error)
+	UpdateTarget(ctx context.Context, target *Target) error
+	DeleteTarget(ctx context.Context, id uuid.UUID) error
+
+	// Evaluation
+	IsMember(ctx context.Context, segmentID, playerID uuid.UUID) (bool, error)
+	EvaluatePlayer(ctx context.Context, playerID uuid.UUID) ([]*Segment, error)
+	// InvalidatePlayer drops the player's cached target resolutions after facts such as the balance changed
+	InvalidatePlayer(ctx context.Context, playerID uuid.UUID)
+}
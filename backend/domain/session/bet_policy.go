@@ -0,0 +1,34 @@
+package session
+
+import "math"
+
+// BetChangePolicy is the rule for changing the bet amount within a game session
+type BetChangePolicy string
+
+const (
+	BetChangeAllowed      BetChangePolicy = "allow"         // Any bet; every change is recorded
+	BetChangeLocked       BetChangePolicy = "locked"        // Every spin uses the bet the session started with
+	BetChangeDecreaseOnly BetChangePolicy = "decrease_only" // The bet may go down but never up
+)
+
+// Check returns an error when a spin's bet breaks the policy, given the session's current bet
+// An empty policy allows any bet.
+func (p BetChangePolicy) Check(sessionBet, bet float64) error {
+	if !BetChanged(sessionBet, bet) {
+		return nil
+	}
+	switch p {
+	case BetChangeLocked:
+		return ErrBetLocked
+	case BetChangeDecreaseOnly:
+		if bet > sessionBet {
+			return ErrBetIncreaseNotAllowed
+		}
+	}
+	return nil
+}
+
+// BetChanged reports whether bet differs from the session's bet, compared in cents
+func BetChanged(sessionBet, bet float64) bool {
+	return math.Round(sessionBet*100) != math.Round(bet*100)
+}
@@ -0,0 +1,20 @@
+package session
+
+// EndReason records why a session was ended by something other than the player
+type EndReason string
+
+const (
+	// EndReasonTimeout is used when a session went idle for longer than the configured limit
+	EndReasonTimeout EndReason = "timeout"
+	// EndReasonAdmin is used when an operator ended the session from the admin API
+	EndReasonAdmin EndReason = "admin"
+)
+
+// Valid reports whether r is a known end reason
+func (r EndReason) Valid() bool {
+	switch r {
+	case EndReasonTimeout, EndReasonAdmin:
+		return true
+	}
+	return false
+}
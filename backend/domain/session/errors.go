@@ -20,4 +20,10 @@ var (
 
 	// ErrInvalidBetAmount is returned when bet amount is invalid
 	ErrInvalidBetAmount = errors.New("invalid bet amount")
+
+	// ErrBetLocked is returned when a spin's bet differs from the session's under the locked policy
+	ErrBetLocked = errors.New("bet amount is locked for this session")
+
+	// ErrBetIncreaseNotAllowed is returned when a spin raises the session's bet under the decrease-only policy
+	ErrBetIncreaseNotAllowed = errors.New("bet amount cannot be increased during this session")
 )
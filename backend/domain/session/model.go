@@ -22,8 +22,18 @@ type GameSession struct {
 	NetChange    float64 `gorm:"type:decimal(15,2);default:0.00"`
 
 	// Timestamps
-	CreatedAt time.Time  `gorm:"default:CURRENT_TIMESTAMP"`
-	EndedAt   *time.Time `gorm:"index"`
+	CreatedAt      time.Time  `gorm:"default:CURRENT_TIMESTAMP"`
+	EndedAt        *time.Time `gorm:"index"`
+	RealityCheckAt *time.Time // Last acknowledged reality check; nil means none since session start
+	SpinAckedAt    *time.Time // Creation time of the newest spin the client confirmed receiving; later spins are pending
+}
+
+// RealityCheckSince returns when the current reality check interval started
+func (s *GameSession) RealityCheckSince() time.Time {
+	if s.RealityCheckAt != nil {
+		return *s.RealityCheckAt
+	}
+	return s.CreatedAt
 }
 
 // TableName specifies the table name for GORM
@@ -31,6 +41,22 @@ func (GameSession) TableName() string {
 	return "game_sessions"
 }
 
+// BetChange records a spin that changed its session's bet amount
+type BetChange struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	SessionID   uuid.UUID `gorm:"type:uuid;not null;index"`
+	PlayerID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	SpinID      uuid.UUID `gorm:"type:uuid;not null"`
+	PreviousBet float64   `gorm:"type:decimal(10,2);not null"`
+	NewBet      float64   `gorm:"type:decimal(10,2);not null"`
+	CreatedAt   time.Time `gorm:"not null;default:now()"`
+}
+
+// TableName specifies the table name for GORM
+func (BetChange) TableName() string {
+	return "session_bet_changes"
+}
+
 // PlayerSession represents an active login session for a player
 // Used for single-device enforcement and force logout capability
 type PlayerSession struct {
@@ -69,6 +95,8 @@ const (
 	LogoutReasonManual  = "manual"  // User clicked logout
 	LogoutReasonForced  = "forced"  // New device login forced logout
 	LogoutReasonExpired = "expired" // Token expired
+	LogoutReasonRefresh = "refresh" // Replaced by a session issued from a refresh token
+	LogoutReasonRevoked = "revoked" // Logged out everywhere or refresh token reuse detected
 )
 
 // PlayerSession errors
@@ -80,3 +108,11 @@ var (
 	ErrPlayerAlreadyLoggedIn     = errors.New("player is already logged in on another device")
 	ErrPlayerSessionGameMismatch = errors.New("session game does not match requested game")
 )
+
+// Refresh token errors
+var (
+	ErrRefreshTokenInvalid     = errors.New("refresh token is invalid or expired")
+	ErrRefreshTokenRevoked     = errors.New("refresh token has been revoked")
+	ErrRefreshTokenReused      = errors.New("refresh token was already used")
+	ErrRefreshTokenUnavailable = errors.New("refresh tokens are not available")
+)
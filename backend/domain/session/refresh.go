@@ -0,0 +1,42 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken is the server-side record of a player refresh token. Each refresh
+// rotates the token within its family; presenting a rotated token again revokes
+// the whole family
+type RefreshToken struct {
+	FamilyID   string     `json:"family_id"`
+	PlayerID   uuid.UUID  `json:"player_id"`
+	GameID     *uuid.UUID `json:"game_id,omitempty"` // nil for cross-game sessions
+	SessionID  uuid.UUID  `json:"session_id"`        // Login session issued alongside this token
+	DeviceInfo string     `json:"device_info,omitempty"`
+	IssuedAt   time.Time  `json:"issued_at"`
+	ExpiresAt  time.Time  `json:"expires_at"` // Absolute family expiry, not extended by rotation
+}
+
+// RefreshTokenStore keeps refresh tokens (by hash) and the revocation list
+type RefreshTokenStore interface {
+	// Save stores a token record under its hash until the record expires
+	Save(ctx context.Context, tokenHash string, token *RefreshToken) error
+	// Get returns the record of a token hash, or ErrRefreshTokenInvalid
+	Get(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// MarkUsed atomically marks a token as rotated; false means it was already used
+	MarkUsed(ctx context.Context, tokenHash string, expiresAt time.Time) (bool, error)
+	// FamilyForSession returns the family of the token issued with a login session, or ""
+	FamilyForSession(ctx context.Context, sessionID uuid.UUID) (string, error)
+
+	// RevokeFamily adds a token family to the revocation list until expiresAt
+	RevokeFamily(ctx context.Context, familyID string, expiresAt time.Time) error
+	// IsFamilyRevoked reports whether a token family is on the revocation list
+	IsFamilyRevoked(ctx context.Context, familyID string) (bool, error)
+	// RevokePlayer revokes every token of a player issued at or before at
+	RevokePlayer(ctx context.Context, playerID uuid.UUID, at time.Time, ttl time.Duration) error
+	// PlayerRevokedAt returns the latest RevokePlayer time, or the zero time
+	PlayerRevokedAt(ctx context.Context, playerID uuid.UUID) (time.Time, error)
+}
@@ -2,6 +2,7 @@ package session
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -26,8 +27,21 @@ type Repository interface {
 	// UpdateStatistics updates session statistics
 	UpdateStatistics(ctx context.Context, id uuid.UUID, spins int, wagered, won float64) error
 
+	// RecordBetChange records a bet change and makes its new bet the session's bet amount
+	RecordBetChange(ctx context.Context, change *BetChange) error
+
+	// MarkRealityCheck records when the player acknowledged a reality check
+	MarkRealityCheck(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// MarkSpinAcked records the creation time of the newest spin the client confirmed receiving
+	// The mark only moves forward, so a late acknowledgement of an older spin is a no-op
+	MarkSpinAcked(ctx context.Context, id uuid.UUID, spinCreatedAt time.Time) error
+
 	// GetByPlayer retrieves all sessions for a player (paginated)
 	GetByPlayer(ctx context.Context, playerID uuid.UUID, limit, offset int) ([]*GameSession, error)
+
+	// ListIdleSessions returns active sessions with no spin since idleSince, oldest first
+	ListIdleSessions(ctx context.Context, idleSince time.Time, limit int) ([]*GameSession, error)
 }
 
 // PlayerSessionRepository defines the interface for player login session data access
@@ -11,6 +11,10 @@ type Service interface {
 	// StartSession creates a new game session
 	StartSession(ctx context.Context, playerID uuid.UUID, betAmount float64) (*GameSession, error)
 
+	// TakeoverSession ends the player's active session, if any, and starts a new one atomically.
+	// Returns the new session and the ended previous session (nil if none was active)
+	TakeoverSession(ctx context.Context, playerID uuid.UUID, betAmount float64) (*GameSession, *GameSession, error)
+
 	// EndSession ends the current game session
 	EndSession(ctx context.Context, sessionID uuid.UUID) (*GameSession, error)
 
@@ -19,4 +23,7 @@ type Service interface {
 
 	// GetPlayerSessions retrieves all sessions for a player
 	GetPlayerSessions(ctx context.Context, playerID uuid.UUID, page, limit int) ([]*GameSession, error)
+
+	// AcknowledgeRealityCheck records a reality check acknowledgement, restarting the interval
+	AcknowledgeRealityCheck(ctx context.Context, playerID, sessionID uuid.UUID) (*GameSession, error)
 }
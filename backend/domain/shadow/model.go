@@ -0,0 +1,31 @@
+package shadow
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Divergence is a sampled spin whose shadow engine replay differed from the production outcome
+// Shadow outcomes are only compared and reported, never returned to players.
+type Divergence struct {
+	SpinID     uuid.UUID `json:"spin_id"`
+	PlayerID   uuid.UUID `json:"player_id"`
+	IsFreeSpin bool      `json:"is_free_spin"`
+	Fields     []string  `json:"fields,omitempty"` // Outcome fields that differ
+	Error      string    `json:"error,omitempty"`  // Why the shadow engine could not replay the spin
+	Production Outcome   `json:"production"`
+	Shadow     *Outcome  `json:"shadow,omitempty"` // Nil when the replay failed
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// Outcome summarizes one engine's result for a spin
+type Outcome struct {
+	ReelPositions    []int      `json:"reel_positions"`
+	Grid             [][]string `json:"grid"`
+	CascadeCount     int        `json:"cascade_count"`
+	TotalWin         float64    `json:"total_win"`
+	ScatterCount     int        `json:"scatter_count"`
+	FreeSpinsAwarded int        `json:"free_spins_awarded"` // Spins awarded by a trigger or retrigger
+	RNGDraws         int        `json:"rng_draws"`          // Values drawn from the spin's RNG
+}
@@ -0,0 +1,10 @@
+package shadow
+
+import "context"
+
+// AlertNotifier delivers shadow engine divergences to operations
+type AlertNotifier interface {
+	// Name identifies the notifier in logs
+	Name() string
+	NotifyDivergence(ctx context.Context, divergence *Divergence) error
+}
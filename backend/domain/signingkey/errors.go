@@ -0,0 +1,14 @@
+package signingkey
+
+import "errors"
+
+var (
+	// ErrKeyNotFound is returned when a signing key does not exist
+	ErrKeyNotFound = errors.New("signing key not found")
+
+	// ErrNoActiveKey is returned when no signing key has activated yet
+	ErrNoActiveKey = errors.New("no active signing key")
+
+	// ErrUnknownKeyID is returned when a token names a key that is not published
+	ErrUnknownKeyID = errors.New("unknown signing key id")
+)
@@ -0,0 +1,40 @@
+package signingkey
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AlgorithmES256 is the JWS algorithm of generated signing keys
+const AlgorithmES256 = "ES256"
+
+// SigningKey is one asymmetric key used to sign auth tokens, identified in
+// token headers by its KID. A key is published for verification before it
+// activates and after it is superseded, until tokens it signed have expired
+type SigningKey struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	KID         string     `gorm:"column:kid;uniqueIndex;not null" json:"kid"`
+	Algorithm   string     `gorm:"not null" json:"algorithm"`
+	PrivateKey  string     `gorm:"not null" json:"-"` // AES-256-GCM encrypted PKCS#8 PEM
+	PublicKey   string     `gorm:"not null" json:"public_key"`
+	CreatedAt   time.Time  `gorm:"not null" json:"created_at"`
+	ActivatesAt time.Time  `gorm:"not null" json:"activates_at"` // First moment the key signs tokens
+	RetiredAt   *time.Time `json:"retired_at,omitempty"`         // Activation of the key that supersedes it
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`         // Tokens it signed are no longer accepted after this
+}
+
+// TableName specifies the table name for GORM
+func (SigningKey) TableName() string {
+	return "jwt_signing_keys"
+}
+
+// IsActive reports whether the key may sign tokens at now
+func (k *SigningKey) IsActive(now time.Time) bool {
+	return !now.Before(k.ActivatesAt) && (k.RetiredAt == nil || now.Before(*k.RetiredAt))
+}
+
+// IsExpired reports whether tokens signed by the key are no longer accepted at now
+func (k *SigningKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
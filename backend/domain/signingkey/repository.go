@@ -0,0 +1,18 @@
+package signingkey
+
+import (
+	"context"
+	"time"
+)
+
+// Repository defines the interface for signing key data access
+type Repository interface {
+	Create(ctx context.Context, key *SigningKey) error
+	// ListValid lists keys not expired at now, oldest activation first
+	ListValid(ctx context.Context, now time.Time) ([]*SigningKey, error)
+	// RetireBefore retires every unretired key activated before at, so it stops signing
+	// at at and expires at expiresAt; keys already retired keep their times
+	RetireBefore(ctx context.Context, at, expiresAt time.Time) (int64, error)
+	// DeleteExpired removes keys expired at now
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
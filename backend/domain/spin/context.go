@@ -0,0 +1,42 @@
+package spin
+
+import "context"
+
+type autoplayKey struct{}
+
+type clientNonceKey struct{}
+
+type nextClientSeedKey struct{}
+
+// WithAutoplay marks a spin request as issued by autoplay
+func WithAutoplay(ctx context.Context) context.Context {
+	return context.WithValue(ctx, autoplayKey{}, true)
+}
+
+// IsAutoplay reports whether the spin request was issued by autoplay
+func IsAutoplay(ctx context.Context) bool {
+	autoplay, _ := ctx.Value(autoplayKey{}).(bool)
+	return autoplay
+}
+
+// WithClientNonce attaches the nonce the client expects its spin to be played at
+func WithClientNonce(ctx context.Context, nonce int64) context.Context {
+	return context.WithValue(ctx, clientNonceKey{}, nonce)
+}
+
+// ClientNonce returns the nonce the client sent with its spin request, if any
+func ClientNonce(ctx context.Context) (int64, bool) {
+	nonce, ok := ctx.Value(clientNonceKey{}).(int64)
+	return nonce, ok
+}
+
+// WithNextClientSeed attaches the client seed the player committed to for their next spin
+func WithNextClientSeed(ctx context.Context, seed string) context.Context {
+	return context.WithValue(ctx, nextClientSeedKey{}, seed)
+}
+
+// NextClientSeed returns the client seed committed to for the next spin, if any
+func NextClientSeed(ctx context.Context) string {
+	seed, _ := ctx.Value(nextClientSeedKey{}).(string)
+	return seed
+}
@@ -20,4 +20,10 @@ var (
 
 	// ErrGameEngineFailure is returned when game engine fails
 	ErrGameEngineFailure = errors.New("game engine failure")
+
+	// ErrNoPendingSpin is returned when every spin of the session has been acknowledged
+	ErrNoPendingSpin = errors.New("no pending spin")
+
+	// ErrSpinTimeout is returned when a spin does not settle within the spin deadline
+	ErrSpinTimeout = errors.New("spin timed out")
 )
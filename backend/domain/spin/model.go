@@ -24,8 +24,10 @@ type Spin struct {
 	IsFreeSpin         bool       `gorm:"default:false;index"`
 	FreeSpinsSessionID *uuid.UUID `gorm:"type:uuid;index"`
 	FreeSpinsTriggered bool       `gorm:"default:false"`
-	GameMode           *string    `gorm:"type:varchar(32);index"` // NULL for normal spin, or: free_spin_trigger, wild_spin_trigger, bonus_spin_trigger
-	GameModeCost       *float64   `gorm:"type:decimal(10,2)"`     // Cost paid for game mode (500, 750, 1000), NULL for normal spin
+	GameMode           *string    `gorm:"type:varchar(32);index"`                 // NULL for normal spin, or: free_spin_trigger, wild_spin_trigger, bonus_spin_trigger
+	GameModeCost       *float64   `gorm:"type:decimal(10,2)"`                     // Cost paid for game mode (500, 750, 1000), NULL for normal spin
+	RNGProvider        string     `gorm:"type:varchar(32);not null;default:hkdf"` // RNG provider the outcome was drawn with: hkdf, crypto, deterministic or hardware
+	MathVersion        string     `gorm:"type:varchar(64);not null;default:''"`   // Engine build, paytable and multiplier ladder the outcome was computed with, empty for spins before versioning
 	CreatedAt          time.Time  `gorm:"default:CURRENT_TIMESTAMP;index"`
 }
 
@@ -43,6 +45,16 @@ type Cascade struct {
 	Wins            []CascadeWin `json:"wins"`
 	TotalCascadeWin float64      `json:"total_cascade_win"`
 	WinningTileKind string       `json:"winning_tile_kind,omitempty"` // Highest priority winning symbol (fa > zhong > bai > bawan), empty if no high-value win
+	ExpandedReels   []int        `json:"expanded_reels,omitempty"`    // Reels filled by expanding wilds
+}
+
+// Expansions reports per cascade whether wilds expanded before it, for PlanTiming
+func (c Cascades) Expansions() []bool {
+	expanded := make([]bool, len(c))
+	for i, cascade := range c {
+		expanded[i] = len(cascade.ExpandedReels) > 0
+	}
+	return expanded
 }
 
 // Position represents a grid position [reel, row]
@@ -54,12 +66,13 @@ type Position struct {
 
 // CascadeWin represents a win in a cascade
 type CascadeWin struct {
-	Symbol    string     `json:"symbol"`
-	Count     int        `json:"count"`
-	Ways      int        `json:"ways"`
-	Payout    float64    `json:"payout"`
-	WinAmount float64    `json:"win_amount"`
-	Positions []Position `json:"positions"` // Grid positions that form this win
+	Symbol        string     `json:"symbol"`
+	Count         int        `json:"count"`
+	Ways          int        `json:"ways"`
+	Payout        float64    `json:"payout"`
+	WinAmount     float64    `json:"win_amount"`
+	Positions     []Position `json:"positions"`                // Grid positions that form this win
+	EffectiveWays int        `json:"effective_ways,omitempty"` // Ways after wild multipliers, set only when wilds multiplied the win
 }
 
 // TableName specifies the table name for GORM
@@ -35,4 +35,11 @@ type Repository interface {
 
 	// UpdateFreeSpinsSessionId updates the free spins session ID for a spin
 	UpdateFreeSpinsSessionId(ctx context.Context, id uuid.UUID, freeSpinsSessionID uuid.UUID) error
+
+	// GetLatestBySession retrieves the session's newest spin created after since (any spin when since is nil)
+	GetLatestBySession(ctx context.Context, sessionID uuid.UUID, since *time.Time) (*Spin, error)
+
+	// ListUnresolvedTriggers lists base spins created in [since, before) that triggered
+	// free spins but were never linked to a free spins session, oldest first
+	ListUnresolvedTriggers(ctx context.Context, since, before time.Time, limit int) ([]*Spin, error)
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/autoplay"
+	"github.com/slotmachine/backend/domain/game"
 )
 
 // Service defines the interface for spin business logic
@@ -23,6 +25,13 @@ type Service interface {
 
 	// GetSpinHistory retrieves spin history for a player
 	GetSpinHistory(ctx context.Context, playerID uuid.UUID, page, limit int) (*SpinHistoryResult, error)
+
+	// GetPendingSpin returns the session's newest spin the client has not acknowledged
+	// Lets a client recover an outcome whose response was lost after the spin settled
+	GetPendingSpin(ctx context.Context, playerID, sessionID uuid.UUID) (*SpinResult, error)
+
+	// AcknowledgeSpin confirms the client received a spin, along with every earlier spin of the session
+	AcknowledgeSpin(ctx context.Context, playerID, sessionID, spinID uuid.UUID) error
 }
 
 // GridPosition represents a position on the grid (reel, row)
@@ -33,29 +42,100 @@ type GridPosition struct {
 
 // SpinResult represents the result of a spin execution
 type SpinResult struct {
-	SpinID                  uuid.UUID `json:"spin_id"`
-	SessionID               uuid.UUID `json:"session_id"`
-	BetAmount               float64   `json:"bet_amount"`
-	BalanceBefore           float64   `json:"balance_before"`
-	BalanceAfterBet         float64   `json:"balance_after_bet"`
-	NewBalance              float64   `json:"new_balance"`
-	Grid                    Grid      `json:"grid"`
-	Cascades                Cascades  `json:"cascades"`
-	SpinTotalWin            float64   `json:"spin_total_win"`
-	ScatterCount            int       `json:"scatter_count"`
-	IsFreeSpin              bool      `json:"is_free_spin"`
-	FreeSpinsTriggered      bool      `json:"free_spins_triggered"`
-	FreeSpinsRetriggered    bool      `json:"free_spins_retriggered,omitempty"`
-	FreeSpinsAdditional     int       `json:"free_spins_additional,omitempty"`
-	FreeSpinsSessionID      string    `json:"free_spins_session_id,omitempty"`
-	FreeSpinsRemainingSpins int       `json:"free_spins_remaining_spins,omitempty"`
-	FreeSessionTotalWin     float64   `json:"free_session_total_win,omitempty"`
-	GameMode                string    `json:"game_mode,omitempty"`      // Game mode used: bonus_spin_trigger (guaranteed free spins)
-	GameModeCost            float64   `json:"game_mode_cost,omitempty"` // Cost paid for game mode (1000)
-	Timestamp               string    `json:"timestamp"`
+	SpinID                  uuid.UUID      `json:"spin_id"`
+	SessionID               uuid.UUID      `json:"session_id"`
+	BetAmount               float64        `json:"bet_amount"`
+	BalanceBefore           float64        `json:"balance_before"`
+	BalanceAfterBet         float64        `json:"balance_after_bet"`
+	NewBalance              float64        `json:"new_balance"`
+	Grid                    Grid           `json:"grid"`
+	Cascades                Cascades       `json:"cascades"`
+	SpinTotalWin            float64        `json:"spin_total_win"`
+	WinCapped               bool           `json:"win_capped,omitempty"` // SpinTotalWin was reduced to the jurisdiction's max win
+	ScatterCount            int            `json:"scatter_count"`
+	IsFreeSpin              bool           `json:"is_free_spin"`
+	FreeSpinsTriggered      bool           `json:"free_spins_triggered"`
+	FreeSpinsRetriggered    bool           `json:"free_spins_retriggered,omitempty"`
+	FreeSpinsAdditional     int            `json:"free_spins_additional,omitempty"`
+	FreeSpinsSessionID      string         `json:"free_spins_session_id,omitempty"`
+	FreeSpinsRemainingSpins int            `json:"free_spins_remaining_spins,omitempty"`
+	FreeSessionTotalWin     float64        `json:"free_session_total_win,omitempty"`
+	GameMode                string         `json:"game_mode,omitempty"`      // Game mode used: bonus_spin_trigger (guaranteed free spins)
+	GameModeCost            float64        `json:"game_mode_cost,omitempty"` // Cost paid for game mode (1000)
+	StickyWilds             []GridPosition `json:"sticky_wilds,omitempty"`   // Wilds held for the next free spin
+	WinTier                 *WinTier       `json:"win_tier,omitempty"`       // Presentation tier of SpinTotalWin, nil for no win
+	Timing                  *Timing        `json:"timing,omitempty"`         // Suggested animation timing at each play speed
+	Timestamp               string         `json:"timestamp"`
 
 	// Provably Fair data (only present if PF session is active)
 	ProvablyFair *SpinProvablyFairData `json:"provably_fair,omitempty"`
+
+	// Autoplay contract the spin was counted against (only present for autoplay spins)
+	Autoplay *autoplay.Contract `json:"autoplay,omitempty"`
+}
+
+// WinTier is the presentation tier a spin's win falls in and the assets the client plays for it
+type WinTier struct {
+	Name       string  `json:"name"`
+	Multiplier float64 `json:"multiplier"` // Win divided by bet
+	Video      string  `json:"video,omitempty"`
+	Audio      string  `json:"audio,omitempty"`
+}
+
+// ClassifyWin returns the tier of a win against its bet, or nil when the win falls in no tier
+func ClassifyWin(tiers game.WinTiers, win, bet float64) *WinTier {
+	tier := tiers.Classify(win, bet)
+	if tier == nil {
+		return nil
+	}
+	return &WinTier{
+		Name:       tier.Name,
+		Multiplier: win / bet,
+		Video:      tier.Video,
+		Audio:      tier.Audio,
+	}
+}
+
+// Timing is the suggested animation timing of a spin at normal and turbo speed, in milliseconds
+type Timing struct {
+	Normal    TimingPlan `json:"normal"`
+	Turbo     TimingPlan `json:"turbo"`
+	MinSpinMs int        `json:"min_spin_ms,omitempty"` // Shortest the spin may be presented, already included in both plans
+}
+
+// TimingPlan is the suggested duration of each step of a spin's presentation
+type TimingPlan struct {
+	ReelSpinMs int   `json:"reel_spin_ms"`
+	CascadeMs  []int `json:"cascade_ms"` // One entry per cascade
+	TotalMs    int   `json:"total_ms"`
+}
+
+// PlanTiming plans a spin's presentation from the game's timing profiles
+// expanded has one entry per cascade, reporting whether wilds expanded before it.
+// A plan shorter than the minimum spin duration holds the reels spinning for longer.
+func PlanTiming(profiles game.TimingProfiles, expanded []bool) *Timing {
+	plan := func(p game.TimingProfile) TimingPlan {
+		tp := TimingPlan{ReelSpinMs: p.ReelSpinMs, CascadeMs: make([]int, len(expanded))}
+		total := p.ReelSpinMs
+		for i, exp := range expanded {
+			tp.CascadeMs[i] = p.CascadeMs
+			if exp {
+				tp.CascadeMs[i] += p.ExpandMs
+			}
+			total += tp.CascadeMs[i]
+		}
+		if total < profiles.MinSpinMs {
+			tp.ReelSpinMs += profiles.MinSpinMs - total
+			total = profiles.MinSpinMs
+		}
+		tp.TotalMs = total
+		return tp
+	}
+	return &Timing{
+		Normal:    plan(profiles.Normal),
+		Turbo:     plan(profiles.Turbo),
+		MinSpinMs: profiles.MinSpinMs,
+	}
 }
 
 // SpinProvablyFairData contains provably fair data for a spin
@@ -0,0 +1,51 @@
+package spinfeed
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/spin"
+)
+
+// Entry is a spin as shown on the live ops feed
+// Players are identified by a masked username only.
+type Entry struct {
+	SpinID             uuid.UUID `json:"spin_id"`
+	Player             string    `json:"player"`
+	BetAmount          float64   `json:"bet_amount"`
+	TotalWin           float64   `json:"total_win"`
+	Cascades           int       `json:"cascades"`
+	IsFreeSpin         bool      `json:"is_free_spin"`
+	FreeSpinsTriggered bool      `json:"free_spins_triggered"`
+	GameMode           string    `json:"game_mode,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// NewEntry builds a feed entry from a spin and the player's username
+func NewEntry(s *spin.Spin, username string) *Entry {
+	e := &Entry{
+		SpinID:             s.ID,
+		Player:             MaskUsername(username),
+		BetAmount:          s.BetAmount,
+		TotalWin:           s.TotalWin,
+		Cascades:           len(s.Cascades),
+		IsFreeSpin:         s.IsFreeSpin,
+		FreeSpinsTriggered: s.FreeSpinsTriggered,
+		CreatedAt:          s.CreatedAt,
+	}
+	if s.GameMode != nil {
+		e.GameMode = *s.GameMode
+	}
+	return e
+}
+
+// MaskUsername keeps the first and last character of a username and hides the rest
+// Usernames of three characters or fewer are fully masked.
+func MaskUsername(username string) string {
+	r := []rune(username)
+	if len(r) <= 3 {
+		return "***"
+	}
+	return string(r[0]) + strings.Repeat("*", len(r)-2) + string(r[len(r)-1])
+}
@@ -0,0 +1,22 @@
+package spinfeed
+
+import (
+	"context"
+
+	"github.com/slotmachine/backend/domain/spin"
+)
+
+// Publisher adds spins to the live feed
+type Publisher interface {
+	Publish(ctx context.Context, s *spin.Spin, username string) error
+}
+
+// Service defines the business logic interface for the live spin feed
+type Service interface {
+	Publisher
+
+	// Recent returns up to limit of the most recent spins, newest first
+	Recent(ctx context.Context, limit int) ([]*Entry, error)
+	// Subscribe streams spins as they are published until ctx is cancelled
+	Subscribe(ctx context.Context) (<-chan *Entry, error)
+}
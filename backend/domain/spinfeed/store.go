@@ -0,0 +1,13 @@
+package spinfeed
+
+import "context"
+
+// Store holds the capped list of recent feed entries and fans new entries out to subscribers
+type Store interface {
+	// Push adds the entry to the front of the list, trims it to size and notifies subscribers
+	Push(ctx context.Context, entry *Entry, size int) error
+	// Recent returns up to n entries, newest first
+	Recent(ctx context.Context, n int) ([]*Entry, error)
+	// Subscribe streams new entries until ctx is cancelled, then closes the channel
+	Subscribe(ctx context.Context) (<-chan *Entry, error)
+}
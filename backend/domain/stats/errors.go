@@ -0,0 +1,8 @@
+package stats
+
+import "errors"
+
+var (
+	// ErrInvalidRange is returned when a daily stats date range is malformed or too long
+	ErrInvalidRange = errors.New("invalid stats date range")
+)
@@ -0,0 +1,85 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/spin"
+)
+
+// MaxDailyRangeDays is the longest range of daily stats returned at once
+const MaxDailyRangeDays = 366
+
+// Totals are the counters kept by every rollup
+// Spins and Wagered cover paid spins only; free spins are counted separately and
+// contribute to Won and BiggestWin.
+type Totals struct {
+	Spins              int64   `gorm:"not null;default:0" json:"spins"`
+	FreeSpins          int64   `gorm:"not null;default:0" json:"free_spins"`
+	FreeSpinsTriggered int64   `gorm:"not null;default:0" json:"free_spins_triggered"`
+	Wagered            float64 `gorm:"type:decimal(15,2);not null;default:0" json:"wagered"`
+	Won                float64 `gorm:"type:decimal(15,2);not null;default:0" json:"won"`
+	BiggestWin         float64 `gorm:"type:decimal(15,2);not null;default:0" json:"biggest_win"`
+}
+
+// DailyStats is a player's rollup for one UTC day
+type DailyStats struct {
+	PlayerID  uuid.UUID `gorm:"type:uuid;primaryKey" json:"player_id"`
+	Day       time.Time `gorm:"type:date;primaryKey" json:"day"`
+	Totals    `gorm:"embedded"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (DailyStats) TableName() string {
+	return "player_daily_stats"
+}
+
+// LifetimeStats is a player's all-time rollup
+type LifetimeStats struct {
+	PlayerID    uuid.UUID `gorm:"type:uuid;primaryKey" json:"player_id"`
+	Totals      `gorm:"embedded"`
+	FirstSpinAt *time.Time `json:"first_spin_at,omitempty"`
+	LastSpinAt  *time.Time `json:"last_spin_at,omitempty"`
+	UpdatedAt   time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (LifetimeStats) TableName() string {
+	return "player_lifetime_stats"
+}
+
+// Delta is the change a single spin applies to a player's rollups
+type Delta struct {
+	PlayerID uuid.UUID
+	At       time.Time
+	Totals
+}
+
+// DeltaFromSpin converts a recorded spin into a rollup delta
+func DeltaFromSpin(s *spin.Spin) *Delta {
+	d := &Delta{
+		PlayerID: s.PlayerID,
+		At:       s.CreatedAt.UTC(),
+		Totals: Totals{
+			Won:        s.TotalWin,
+			BiggestWin: s.TotalWin,
+		},
+	}
+	if s.IsFreeSpin {
+		d.FreeSpins = 1
+	} else {
+		d.Spins = 1
+		d.Wagered = s.BetAmount
+	}
+	if s.FreeSpinsTriggered {
+		d.FreeSpinsTriggered = 1
+	}
+	return d
+}
+
+// Day truncates a time to its UTC day
+func Day(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,20 @@
+package stats
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for player stats rollups
+type Repository interface {
+	// Record applies a spin delta to the player's daily and lifetime rollups atomically
+	Record(ctx context.Context, delta *Delta) error
+
+	// GetLifetime returns the player's lifetime rollup (zero totals if the player has not spun)
+	GetLifetime(ctx context.Context, playerID uuid.UUID) (*LifetimeStats, error)
+
+	// ListDaily returns the player's daily rollups for days in [from, to], oldest first
+	ListDaily(ctx context.Context, playerID uuid.UUID, from, to time.Time) ([]*DailyStats, error)
+}
@@ -0,0 +1,22 @@
+package stats
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/spin"
+)
+
+// Recorder updates rollups as spins are played
+type Recorder interface {
+	RecordSpin(ctx context.Context, s *spin.Spin) error
+}
+
+// Service defines the business logic interface for player stats
+type Service interface {
+	Recorder
+
+	GetLifetime(ctx context.Context, playerID uuid.UUID) (*LifetimeStats, error)
+	GetDaily(ctx context.Context, playerID uuid.UUID, from, to time.Time) ([]*DailyStats, error)
+}
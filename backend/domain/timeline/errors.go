@@ -0,0 +1,8 @@
+package timeline
+
+import "errors"
+
+var (
+	// ErrInvalidCursor is returned when a timeline cursor is malformed
+	ErrInvalidCursor = errors.New("invalid timeline cursor")
+)
@@ -0,0 +1,87 @@
+package timeline
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kind identifies what happened in a timeline event
+// Kinds also break ties between events of the same row at the same instant, so a
+// balance adjustment sorts before the spin that revealed it.
+type Kind string
+
+const (
+	KindBalanceAdjusted    Kind = "balance_adjusted" // Balance changed between two spins outside of play
+	KindFreeSpinsCompleted Kind = "free_spins_completed"
+	KindFreeSpinsTriggered Kind = "free_spins_triggered"
+	KindNoteAdded          Kind = "note_added" // Support note or flag added to the player
+	KindPFSessionEnded     Kind = "pf_session_ended"
+	KindPFSessionStarted   Kind = "pf_session_started"
+	KindSessionEnded       Kind = "session_ended"
+	KindSessionStarted     Kind = "session_started"
+	KindSpin               Kind = "spin"
+)
+
+// Event is one entry of a player's timeline
+// ID is the row the event was read from: a game session, spin, free spins session
+// or provably fair session.
+type Event struct {
+	At        time.Time              `json:"at"`
+	Kind      Kind                   `json:"kind"`
+	ID        uuid.UUID              `json:"id"`
+	SessionID *uuid.UUID             `json:"session_id,omitempty"` // Game session the event belongs to
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+// Key returns the position of the event in the timeline
+func (e *Event) Key() Cursor {
+	return Cursor{At: e.At, ID: e.ID, Kind: e.Kind}
+}
+
+// Page is one page of a player's timeline, newest first
+type Page struct {
+	Events     []Event `json:"events"`
+	NextCursor string  `json:"next_cursor,omitempty"` // Empty on the last page
+}
+
+// Cursor is the position of an event in a timeline
+// Events are ordered by time, then row ID, then kind.
+type Cursor struct {
+	At   time.Time `json:"at"`
+	ID   uuid.UUID `json:"id"`
+	Kind Kind      `json:"k"`
+}
+
+// Before reports whether c sorts before (is older than) o
+func (c Cursor) Before(o Cursor) bool {
+	if !c.At.Equal(o.At) {
+		return c.At.Before(o.At)
+	}
+	if cmp := bytes.Compare(c.ID[:], o.ID[:]); cmp != 0 {
+		return cmp < 0
+	}
+	return c.Kind < o.Kind
+}
+
+// Encode returns the opaque form of the cursor handed to clients
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor parses a cursor returned by Encode
+func DecodeCursor(s string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.At.IsZero() || c.ID == uuid.Nil || c.Kind == "" {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
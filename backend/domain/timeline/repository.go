@@ -0,0 +1,13 @@
+package timeline
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository reads timeline events from the tables that record player activity
+type Repository interface {
+	// ListEvents lists a player's events older than before (nil for the newest), newest first
+	ListEvents(ctx context.Context, playerID uuid.UUID, before *Cursor, limit int) ([]Event, error)
+}
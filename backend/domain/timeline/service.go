@@ -0,0 +1,13 @@
+package timeline
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for player timelines
+type Service interface {
+	// GetPlayerTimeline returns the page of a player's timeline after cursor (empty for the newest)
+	GetPlayerTimeline(ctx context.Context, playerID uuid.UUID, cursor string, limit int) (*Page, error)
+}
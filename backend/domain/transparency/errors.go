@@ -0,0 +1,17 @@
+package transparency
+
+import "errors"
+
+var (
+	// ErrCheckpointNotFound is returned when a checkpoint does not exist
+	ErrCheckpointNotFound = errors.New("transparency checkpoint not found")
+
+	// ErrSpinNotCheckpointed is returned when a spin is not covered by any checkpoint yet
+	ErrSpinNotCheckpointed = errors.New("spin is not covered by a transparency checkpoint yet")
+
+	// ErrCheckpointMismatch is returned when stored spin logs no longer hash to a checkpoint's Merkle root
+	ErrCheckpointMismatch = errors.New("spin logs do not match the transparency checkpoint")
+
+	// ErrLeavesUnavailable is returned when a checkpoint's spin logs were archived out of the hot DB
+	ErrLeavesUnavailable = errors.New("spin logs of this checkpoint are no longer available")
+)
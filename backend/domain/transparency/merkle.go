@@ -0,0 +1,126 @@
+package transparency
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// The Merkle tree follows RFC 6962 (Certificate Transparency): leaves and interior
+// nodes are domain separated, and an n-leaf tree splits at the largest power of two below n.
+
+// LeafHash hashes a spin hash into a Merkle leaf
+func LeafHash(spinHash string) []byte {
+	h := sha256.New()
+	h.Write([]byte{0x00})
+	h.Write([]byte(spinHash))
+	return h.Sum(nil)
+}
+
+// nodeHash hashes two child nodes
+func nodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{0x01})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// MerkleRoot returns the hex root of the tree over the spin hashes; empty input hashes to SHA256("")
+func MerkleRoot(spinHashes []string) string {
+	if len(spinHashes) == 0 {
+		sum := sha256.Sum256(nil)
+		return hex.EncodeToString(sum[:])
+	}
+	leaves := make([][]byte, len(spinHashes))
+	for i, h := range spinHashes {
+		leaves[i] = LeafHash(h)
+	}
+	return hex.EncodeToString(subtreeRoot(leaves))
+}
+
+// AuditPath returns the hex sibling hashes proving leaf index of the tree over spinHashes
+func AuditPath(spinHashes []string, index int) []string {
+	if index < 0 || index >= len(spinHashes) {
+		return nil
+	}
+	leaves := make([][]byte, len(spinHashes))
+	for i, h := range spinHashes {
+		leaves[i] = LeafHash(h)
+	}
+
+	var path []string
+	for len(leaves) > 1 {
+		k := splitPoint(len(leaves))
+		if index < k {
+			path = append(path, hex.EncodeToString(subtreeRoot(leaves[k:])))
+			leaves = leaves[:k]
+		} else {
+			path = append(path, hex.EncodeToString(subtreeRoot(leaves[:k])))
+			leaves = leaves[k:]
+			index -= k
+		}
+	}
+
+	// Siblings were collected root-first; verifiers walk leaf-first
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// VerifyInclusion checks an audit path for leaf index of a treeSize-leaf tree against a hex root
+func VerifyInclusion(spinHash string, index, treeSize int, auditPath []string, root string) bool {
+	if index < 0 || index >= treeSize {
+		return false
+	}
+	expected, err := hex.DecodeString(root)
+	if err != nil {
+		return false
+	}
+	siblings := make([][]byte, len(auditPath))
+	for i, s := range auditPath {
+		if siblings[i], err = hex.DecodeString(s); err != nil {
+			return false
+		}
+	}
+
+	computed, ok := rootFromPath(LeafHash(spinHash), index, treeSize, siblings)
+	return ok && bytes.Equal(computed, expected)
+}
+
+// rootFromPath recomputes the root of an n-leaf tree from a leaf and its leaf-first siblings
+func rootFromPath(leaf []byte, index, n int, siblings [][]byte) ([]byte, bool) {
+	if n == 1 {
+		return leaf, len(siblings) == 0
+	}
+	if len(siblings) == 0 {
+		return nil, false
+	}
+	k := splitPoint(n)
+	last := siblings[len(siblings)-1]
+	if index < k {
+		left, ok := rootFromPath(leaf, index, k, siblings[:len(siblings)-1])
+		return nodeHash(left, last), ok
+	}
+	right, ok := rootFromPath(leaf, index-k, n-k, siblings[:len(siblings)-1])
+	return nodeHash(last, right), ok
+}
+
+// subtreeRoot hashes a non-empty run of leaf hashes
+func subtreeRoot(leaves [][]byte) []byte {
+	if len(leaves) == 1 {
+		return leaves[0]
+	}
+	k := splitPoint(len(leaves))
+	return nodeHash(subtreeRoot(leaves[:k]), subtreeRoot(leaves[k:]))
+}
+
+// splitPoint is the largest power of two strictly less than n (n > 1)
+func splitPoint(n int) int {
+	k := 1
+	for k<<1 < n {
+		k <<= 1
+	}
+	return k
+}
@@ -0,0 +1,32 @@
+package transparency
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerkle_InclusionProofs(t *testing.T) {
+	for n := 1; n <= 9; n++ {
+		hashes := make([]string, n)
+		for i := range hashes {
+			hashes[i] = fmt.Sprintf("spin-%d", i)
+		}
+		root := MerkleRoot(hashes)
+
+		for i := range hashes {
+			path := AuditPath(hashes, i)
+			assert.True(t, VerifyInclusion(hashes[i], i, n, path, root), "n=%d i=%d", n, i)
+			assert.False(t, VerifyInclusion("tampered", i, n, path, root), "n=%d i=%d", n, i)
+		}
+	}
+}
+
+func TestMerkleRoot_DetectsChanges(t *testing.T) {
+	root := MerkleRoot([]string{"a", "b", "c"})
+	assert.NotEqual(t, root, MerkleRoot([]string{"a", "b", "x"}))
+	assert.NotEqual(t, root, MerkleRoot([]string{"b", "a", "c"}))
+	assert.NotEqual(t, root, MerkleRoot([]string{"a", "b"}))
+	assert.Len(t, MerkleRoot(nil), 64)
+}
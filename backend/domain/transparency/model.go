@@ -0,0 +1,67 @@
+package transparency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Checkpoint anchors the Merkle root of a contiguous run of spin logs
+// Checkpoints form a hash chain: each hash covers the previous one, so rewriting any
+// anchored spin log or checkpoint changes every later checkpoint hash.
+type Checkpoint struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Sequence       int64      `gorm:"not null;uniqueIndex" json:"sequence"`
+	FirstSpinLogID uuid.UUID  `gorm:"type:uuid;not null" json:"first_spin_log_id"`
+	LastSpinLogID  uuid.UUID  `gorm:"type:uuid;not null" json:"last_spin_log_id"`
+	FromCreatedAt  time.Time  `gorm:"not null" json:"from_created_at"` // created_at of the first spin log
+	ToCreatedAt    time.Time  `gorm:"not null" json:"to_created_at"`   // created_at of the last spin log
+	LeafCount      int        `gorm:"not null" json:"leaf_count"`
+	MerkleRoot     string     `gorm:"type:varchar(64);not null" json:"merkle_root"`
+	PrevHash       string     `gorm:"type:varchar(64);not null" json:"prev_hash"` // Empty hash of zeros for the first checkpoint
+	Hash           string     `gorm:"type:varchar(64);not null" json:"hash"`
+	AnchorName     *string    `gorm:"type:varchar(32)" json:"anchor_name,omitempty"`
+	AnchorRef      *string    `gorm:"type:text" json:"anchor_ref,omitempty"` // Adapter-specific proof or transaction reference
+	AnchoredAt     *time.Time `json:"anchored_at,omitempty"`
+	CreatedAt      time.Time  `gorm:"not null" json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Checkpoint) TableName() string {
+	return "transparency_checkpoints"
+}
+
+// GenesisHash is the prev_hash of the first checkpoint
+var GenesisHash = hex.EncodeToString(make([]byte, sha256.Size))
+
+// ComputeHash returns SHA256 over the checkpoint's sequence, range, root and previous hash
+func (c *Checkpoint) ComputeHash() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d|%s|%s|%d|%s|%s",
+		c.Sequence,
+		c.FirstSpinLogID,
+		c.LastSpinLogID,
+		c.LeafCount,
+		c.MerkleRoot,
+		c.PrevHash,
+	)))
+	return hex.EncodeToString(sum[:])
+}
+
+// Leaf is one spin log committed to a checkpoint
+type Leaf struct {
+	SpinLogID uuid.UUID `json:"spin_log_id"`
+	SpinID    uuid.UUID `json:"spin_id"`
+	SpinHash  string    `json:"spin_hash"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// InclusionProof proves a spin hash is a leaf of a checkpoint's Merkle tree
+type InclusionProof struct {
+	Checkpoint *Checkpoint `json:"checkpoint"`
+	Leaf       Leaf        `json:"leaf"`
+	LeafIndex  int         `json:"leaf_index"`
+	AuditPath  []string    `json:"audit_path"` // Hex sibling hashes from the leaf up to the root
+}
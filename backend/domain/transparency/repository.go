@@ -0,0 +1,39 @@
+package transparency
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for transparency log data access
+type Repository interface {
+	// GetLatest returns the checkpoint with the highest sequence, or ErrCheckpointNotFound
+	GetLatest(ctx context.Context) (*Checkpoint, error)
+	GetBySequence(ctx context.Context, sequence int64) (*Checkpoint, error)
+	// GetCovering returns the checkpoint whose spin log range contains a spin log
+	GetCovering(ctx context.Context, createdAt time.Time, spinLogID uuid.UUID) (*Checkpoint, error)
+	// List returns checkpoints newest first
+	List(ctx context.Context, limit, offset int) ([]*Checkpoint, int64, error)
+	Create(ctx context.Context, checkpoint *Checkpoint) error
+	// ListUnanchored returns checkpoints oldest first that no anchor has accepted yet
+	ListUnanchored(ctx context.Context, limit int) ([]*Checkpoint, error)
+	SetAnchor(ctx context.Context, id uuid.UUID, name, ref string, anchoredAt time.Time) error
+
+	// ListLeavesAfter returns spin logs ordered by (created_at, id) strictly after the cursor
+	// and created before until; a zero cursor starts at the oldest spin log
+	ListLeavesAfter(ctx context.Context, afterCreatedAt time.Time, afterID uuid.UUID, until time.Time, limit int) ([]Leaf, error)
+	// ListLeaves returns the spin logs of a checkpoint's range in leaf order
+	ListLeaves(ctx context.Context, checkpoint *Checkpoint) ([]Leaf, error)
+	// GetLeafBySpin returns the spin log of a spin
+	GetLeafBySpin(ctx context.Context, spinID uuid.UUID) (*Leaf, error)
+}
+
+// Anchor publishes checkpoint hashes to an external append-only system (e.g. a blockchain)
+type Anchor interface {
+	// Name identifies the anchor in stored checkpoints
+	Name() string
+	// Anchor submits a checkpoint hash and returns a reference proving the submission
+	Anchor(ctx context.Context, checkpoint *Checkpoint) (string, error)
+}
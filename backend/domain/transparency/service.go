@@ -0,0 +1,25 @@
+package transparency
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for the spin hash transparency log
+type Service interface {
+	// Publish checkpoints every spin log settled by now not yet covered and returns the new checkpoints
+	Publish(ctx context.Context, now time.Time) ([]*Checkpoint, error)
+
+	// AnchorPending submits unanchored checkpoints to the configured anchor and returns how many succeeded
+	AnchorPending(ctx context.Context) (int, error)
+
+	List(ctx context.Context, limit, offset int) ([]*Checkpoint, int64, error)
+
+	// Get returns a checkpoint with its leaves so auditors can recompute its Merkle root
+	Get(ctx context.Context, sequence int64) (*Checkpoint, []Leaf, error)
+
+	// Prove returns the inclusion proof of a spin in its checkpoint
+	Prove(ctx context.Context, spinID uuid.UUID) (*InclusionProof, error)
+}
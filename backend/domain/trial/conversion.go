@@ -0,0 +1,52 @@
+package trial
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Conversion maps a trial session to the real player account it was converted into
+// The trial statistics are kept here for reporting only; they are never added to the
+// player's real-money totals, and trial spins are never written to the spins table.
+type Conversion struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primary_key" json:"id"`
+	TrialSessionID uuid.UUID  `gorm:"type:uuid;uniqueIndex;not null" json:"trial_session_id"`
+	PlayerID       uuid.UUID  `gorm:"type:uuid;uniqueIndex;not null" json:"player_id"`
+	GameID         *uuid.UUID `gorm:"type:uuid" json:"game_id,omitempty"`
+	TrialSpins     int        `gorm:"not null" json:"trial_spins"`
+	TrialWagered   float64    `gorm:"type:decimal(15,2);not null" json:"trial_wagered"`
+	TrialWon       float64    `gorm:"type:decimal(15,2);not null" json:"trial_won"`
+	TrialBalance   float64    `gorm:"type:decimal(15,2);not null" json:"trial_balance"` // Demo balance at conversion, not carried over
+	TrialStartedAt time.Time  `json:"trial_started_at"`
+	ConvertedAt    time.Time  `json:"converted_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Conversion) TableName() string {
+	return "trial_conversions"
+}
+
+// NewConversion records the conversion of a trial session into a player
+func NewConversion(session *TrialSession, playerID uuid.UUID) *Conversion {
+	return &Conversion{
+		ID:             uuid.New(),
+		TrialSessionID: session.ID,
+		PlayerID:       playerID,
+		GameID:         session.GameID,
+		TrialSpins:     session.TotalSpins,
+		TrialWagered:   session.TotalWagered,
+		TrialWon:       session.TotalWon,
+		TrialBalance:   session.Balance,
+		TrialStartedAt: session.CreatedAt,
+		ConvertedAt:    time.Now().UTC(),
+	}
+}
+
+// ConversionRepository defines the interface for trial conversion records
+type ConversionRepository interface {
+	CreateConversion(ctx context.Context, conversion *Conversion) error
+	GetConversionByTrialSession(ctx context.Context, trialSessionID uuid.UUID) (*Conversion, error)
+	GetConversionByPlayer(ctx context.Context, playerID uuid.UUID) (*Conversion, error)
+}
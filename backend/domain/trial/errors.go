@@ -0,0 +1,18 @@
+package trial
+
+import "errors"
+
+var (
+	// ErrSettingsNotFound is returned when a game has no demo settings
+	ErrSettingsNotFound = errors.New("trial settings not found")
+	// ErrInvalidSettings is returned when demo settings fail validation
+	ErrInvalidSettings = errors.New("invalid trial settings")
+	// ErrBetOutOfRange is returned when a demo bet is outside the game's bet limits
+	ErrBetOutOfRange = errors.New("bet amount outside trial bet limits")
+	// ErrInvalidTopUp is returned when a top-up amount is not positive
+	ErrInvalidTopUp = errors.New("top-up amount must be positive")
+	// ErrAlreadyConverted is returned when a trial session was already converted to a real account
+	ErrAlreadyConverted = errors.New("trial session already converted")
+	// ErrConversionNotFound is returned when no conversion exists
+	ErrConversionNotFound = errors.New("trial conversion not found")
+)
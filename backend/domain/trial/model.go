@@ -33,6 +33,7 @@ type TrialSession struct {
 	TotalWon       float64    `json:"total_won"`
 	CreatedAt      time.Time  `json:"created_at"`
 	LastActivityAt time.Time  `json:"last_activity_at"`
+	LastResetAt    time.Time  `json:"last_reset_at"` // Last time the balance was restored to the starting balance
 	ExpiresAt      time.Time  `json:"expires_at"`
 }
 
@@ -76,6 +77,7 @@ func NewTrialSession(gameID *uuid.UUID, sessionToken string) *TrialSession {
 		TotalWon:       0,
 		CreatedAt:      now,
 		LastActivityAt: now,
+		LastResetAt:    now,
 		ExpiresAt:      now.Add(TrialSessionDuration),
 	}
 }
@@ -0,0 +1,93 @@
+package trial
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Settings configures demo play for a game
+// Games without settings use DefaultSettings.
+type Settings struct {
+	GameID          uuid.UUID `gorm:"type:uuid;primary_key" json:"game_id"`
+	StartingBalance float64   `gorm:"type:decimal(15,2);not null" json:"starting_balance"`
+	MinBet          float64   `gorm:"type:decimal(10,2);not null" json:"min_bet"`
+	MaxBet          float64   `gorm:"type:decimal(10,2);not null" json:"max_bet"` // 0 means no upper limit
+	DailyReset      bool      `gorm:"not null" json:"daily_reset"`                // Restore the starting balance once per UTC day
+
+	// Demo-only reel strip configs; nil uses the built-in trial strips
+	BaseGameConfigID  *uuid.UUID `gorm:"type:uuid" json:"base_game_config_id,omitempty"`
+	FreeSpinsConfigID *uuid.UUID `gorm:"type:uuid" json:"free_spins_config_id,omitempty"`
+
+	UpdatedBy string    `gorm:"type:varchar(100)" json:"updated_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Settings) TableName() string {
+	return "trial_game_settings"
+}
+
+// DefaultSettings returns the settings used for games without their own
+func DefaultSettings() *Settings {
+	return &Settings{
+		StartingBalance: TrialStartingBalance,
+		DailyReset:      true,
+	}
+}
+
+// Validate checks that the settings are usable
+func (s *Settings) Validate() error {
+	switch {
+	case s.StartingBalance <= 0:
+		return fmt.Errorf("%w: starting balance must be positive", ErrInvalidSettings)
+	case s.MinBet < 0 || s.MaxBet < 0:
+		return fmt.Errorf("%w: bet limits must not be negative", ErrInvalidSettings)
+	case s.MaxBet > 0 && s.MinBet > s.MaxBet:
+		return fmt.Errorf("%w: min bet must not exceed max bet", ErrInvalidSettings)
+	case s.MinBet > s.StartingBalance:
+		return fmt.Errorf("%w: min bet must not exceed the starting balance", ErrInvalidSettings)
+	}
+	return nil
+}
+
+// CheckBet returns ErrBetOutOfRange when the bet is outside the configured limits
+func (s *Settings) CheckBet(bet float64) error {
+	if bet < s.MinBet || (s.MaxBet > 0 && bet > s.MaxBet) {
+		return fmt.Errorf("%w: bet must be between %.2f and %s", ErrBetOutOfRange, s.MinBet, s.maxBetLabel())
+	}
+	return nil
+}
+
+// DemoConfigID returns the demo reel strip config for the game mode, if any
+func (s *Settings) DemoConfigID(isFreeSpin bool) *uuid.UUID {
+	if isFreeSpin {
+		return s.FreeSpinsConfigID
+	}
+	return s.BaseGameConfigID
+}
+
+func (s *Settings) maxBetLabel() string {
+	if s.MaxBet <= 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%.2f", s.MaxBet)
+}
+
+// DailyResetDue reports whether now falls on a later UTC day than the last reset
+func DailyResetDue(lastReset, now time.Time) bool {
+	y1, m1, d1 := lastReset.UTC().Date()
+	y2, m2, d2 := now.UTC().Date()
+	return time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC).After(time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC))
+}
+
+// SettingsRepository defines the interface for per-game demo settings
+type SettingsRepository interface {
+	GetSettings(ctx context.Context, gameID uuid.UUID) (*Settings, error)
+	ListSettings(ctx context.Context) ([]*Settings, error)
+	UpsertSettings(ctx context.Context, settings *Settings) error
+	DeleteSettings(ctx context.Context, gameID uuid.UUID) error
+}
@@ -0,0 +1,10 @@
+package vip
+
+import "errors"
+
+var (
+	// Tier errors
+	ErrTierNotFound   = errors.New("vip tier not found")
+	ErrTierLevelTaken = errors.New("vip tier level already exists")
+	ErrInvalidTier    = errors.New("invalid vip tier")
+)
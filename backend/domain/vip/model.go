@@ -0,0 +1,127 @@
+package vip
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tier is a loyalty level reached once a player's lifetime points reach MinPoints
+type Tier struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	Level     int       `gorm:"uniqueIndex;not null" json:"level"`
+	Name      string    `gorm:"type:varchar(50);not null" json:"name"`
+	MinPoints int64     `gorm:"not null;default:0" json:"min_points"`
+	Perks     Perks     `gorm:"type:jsonb;not null" json:"perks"`
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Tier) TableName() string {
+	return "vip_tiers"
+}
+
+// Perks are the benefits granted to players in a tier
+type Perks struct {
+	// PointsMultiplier scales points accrued while in the tier (0 means 1x)
+	PointsMultiplier float64 `json:"points_multiplier,omitempty"`
+	// ExtraFreeSpins is added to every free spins award
+	ExtraFreeSpins int `json:"extra_free_spins,omitempty"`
+}
+
+// Multiplier returns the effective points multiplier
+func (p Perks) Multiplier() float64 {
+	if p.PointsMultiplier <= 0 {
+		return 1
+	}
+	return p.PointsMultiplier
+}
+
+// Scan implements the sql.Scanner interface for Perks
+func (p *Perks) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, p)
+	case string:
+		return json.Unmarshal([]byte(v), p)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface for Perks
+func (p Perks) Value() (driver.Value, error) {
+	return json.Marshal(p)
+}
+
+// PlayerPoints holds a player's lifetime loyalty points
+type PlayerPoints struct {
+	PlayerID  uuid.UUID `gorm:"type:uuid;primaryKey" json:"player_id"`
+	Points    int64     `gorm:"not null;default:0" json:"points"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (PlayerPoints) TableName() string {
+	return "player_vip_points"
+}
+
+// Status is a player's current standing in the loyalty program
+// Tier is nil until the player reaches the lowest tier; NextTier is nil at the top tier.
+type Status struct {
+	PlayerID uuid.UUID
+	Points   int64
+	Tier     *Tier
+	NextTier *Tier
+}
+
+// Level returns the player's tier level, 0 when the player has no tier yet
+func (s *Status) Level() int {
+	if s.Tier == nil {
+		return 0
+	}
+	return s.Tier.Level
+}
+
+// PointsToNext returns the points still needed to reach the next tier
+func (s *Status) PointsToNext() int64 {
+	if s.NextTier == nil {
+		return 0
+	}
+	return max(s.NextTier.MinPoints-s.Points, 0)
+}
+
+// Perks returns the perks of the player's tier
+func (s *Status) Perks() Perks {
+	if s.Tier == nil {
+		return Perks{}
+	}
+	return s.Tier.Perks
+}
+
+// NewStatus places points on the tier ladder
+// Tiers must be ordered by MinPoints ascending.
+func NewStatus(playerID uuid.UUID, points int64, tiers []*Tier) *Status {
+	status := &Status{PlayerID: playerID, Points: points}
+	for _, t := range tiers {
+		if points >= t.MinPoints {
+			status.Tier = t
+			continue
+		}
+		status.NextTier = t
+		break
+	}
+	return status
+}
+
+// PointsForWager converts a wager to points at the given rate and tier multiplier
+// Fractional points are truncated per wager.
+func PointsForWager(wager, pointsPerUnit float64, perks Perks) int64 {
+	if wager <= 0 || pointsPerUnit <= 0 {
+		return 0
+	}
+	return int64(math.Floor(wager * pointsPerUnit * perks.Multiplier()))
+}
@@ -0,0 +1,22 @@
+package vip
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for VIP data access
+type Repository interface {
+	// Tier operations
+	CreateTier(ctx context.Context, tier *Tier) error
+	GetTierByID(ctx context.Context, id uuid.UUID) (*Tier, error)
+	GetTierByLevel(ctx context.Context, level int) (*Tier, error)
+	ListTiers(ctx context.Context) ([]*Tier, error) // ordered by min_points ASC
+	UpdateTier(ctx context.Context, tier *Tier) error
+	DeleteTier(ctx context.Context, id uuid.UUID) error
+
+	// Points operations
+	GetPoints(ctx context.Context, playerID uuid.UUID) (int64, error)
+	AddPoints(ctx context.Context, playerID uuid.UUID, points int64) (int64, error)
+}
@@ -0,0 +1,21 @@
+package vip
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the business logic interface for the VIP/loyalty program
+type Service interface {
+	// Tier management
+	CreateTier(ctx context.Context, tier *Tier) error
+	GetTier(ctx context.Context, id uuid.UUID) (*Tier, error)
+	ListTiers(ctx context.Context) ([]*Tier, error)
+	UpdateTier(ctx context.Context, tier *Tier) error
+	DeleteTier(ctx context.Context, id uuid.UUID) error
+
+	// Player status
+	GetStatus(ctx context.Context, playerID uuid.UUID) (*Status, error)
+	AccruePoints(ctx context.Context, playerID uuid.UUID, wager float64) (*Status, error)
+}
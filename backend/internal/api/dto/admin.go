@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
 )
 
 // ============= Admin Auth DTOs =============
@@ -31,6 +32,8 @@ type AdminDetail struct {
 	Permissions []string   `json:"permissions,omitempty"`
 	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
 	CreatedAt   time.Time  `json:"created_at"`
+
+	TwoFactorEnabled bool `json:"two_factor_enabled"`
 }
 
 // CreateAdminRequest represents a request to create a new admin
@@ -57,6 +60,35 @@ type ChangePasswordRequest struct {
 	NewPassword string `json:"new_password" validate:"required,min=8"`
 }
 
+// TwoFactorCodeRequest carries a TOTP code or a recovery code
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// TwoFactorEnrollmentResponse is a pending enrollment to add to an authenticator app
+type TwoFactorEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURL string `json:"otpauth_url"`
+}
+
+// RecoveryCodesResponse lists freshly generated recovery codes; they are not retrievable later
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// TwoFactorVerifyResponse reports how long destructive operations stay unlocked
+type TwoFactorVerifyResponse struct {
+	VerifiedUntil time.Time `json:"verified_until"`
+}
+
+// TwoFactorStatusResponse describes an admin's two-factor state
+type TwoFactorStatusResponse struct {
+	Enabled                bool       `json:"enabled"`
+	EnabledAt              *time.Time `json:"enabled_at,omitempty"`
+	VerifiedUntil          *time.Time `json:"verified_until,omitempty"`
+	RecoveryCodesRemaining int64      `json:"recovery_codes_remaining"`
+}
+
 // ResetPasswordRequest represents a password reset request (admin action)
 type ResetPasswordRequest struct {
 	NewPassword string `json:"new_password" validate:"required,min=8"`
@@ -79,8 +111,9 @@ type CreateReelStripConfigRequest struct {
 	Description string      `json:"description,omitempty"`
 	TargetRTP   float64     `json:"target_rtp,omitempty" validate:"omitempty,gte=0,lte=100"`
 	ReelStripIDs [5]uuid.UUID `json:"reel_strip_ids" validate:"required"`
-	CreatedBy   string      `json:"created_by,omitempty"`
-	Notes       string      `json:"notes,omitempty"`
+	IsDemo       bool         `json:"is_demo,omitempty"` // Demo-only config for trial play; cannot be changed later
+	CreatedBy    string       `json:"created_by,omitempty"`
+	Notes        string       `json:"notes,omitempty"`
 }
 
 // UpdateReelStripConfigRequest represents a request to update a reel strip configuration
@@ -97,6 +130,84 @@ type SetDefaultConfigRequest struct {
 	GameMode string    `json:"game_mode" validate:"required,oneof=base_game free_spins both"`
 }
 
+// ValidateReelStripsRequest represents a strip set to check against the generation rules of a mode
+type ValidateReelStripsRequest struct {
+	GameMode string     `json:"game_mode" validate:"required"`
+	Strips   [][]string `json:"strips" validate:"required,len=5"` // One strip per reel
+}
+
+// ValidateReelStripsResponse lists the rule violations in a strip set
+type ValidateReelStripsResponse struct {
+	GameMode       string                `json:"game_mode"`
+	Valid          bool                  `json:"valid"`
+	ViolationCount int                   `json:"violation_count"`
+	Violations     []reelstrip.Violation `json:"violations"`
+}
+
+// PreviewReelStripsRequest represents a draft strip set to preview before it is saved
+// With ConfigID set, reels left empty keep the config's strip, so an edit to one reel can be
+// previewed on its own; GameMode then defaults to the config's.
+type PreviewReelStripsRequest struct {
+	GameMode string     `json:"game_mode,omitempty"`
+	ConfigID *uuid.UUID `json:"config_id,omitempty"`
+	Strips   [][]string `json:"strips" validate:"required,len=5"` // One strip per reel
+	Spins    int        `json:"spins,omitempty"`                  // Spins to simulate, at most reelstrip.PreviewSpins (the default)
+}
+
+// ReelStripPreview is the checksum and layout of one reel of a previewed strip set
+type ReelStripPreview struct {
+	Reel     int                     `json:"reel"`
+	Checksum string                  `json:"checksum"`
+	Edited   bool                    `json:"edited"` // Differs from the base config's strip; always true without one
+	Analysis reelstrip.StripAnalysis `json:"analysis"`
+}
+
+// PreviewReelStripsResponse describes a draft strip set: each reel's checksum and layout, its rule
+// violations and a simulated RTP estimate
+type PreviewReelStripsResponse struct {
+	GameMode       string                 `json:"game_mode"`
+	ConfigID       *uuid.UUID             `json:"config_id,omitempty"`
+	Reels          []ReelStripPreview     `json:"reels"`
+	Valid          bool                   `json:"valid"`
+	ViolationCount int                    `json:"violation_count"`
+	Violations     []reelstrip.Violation  `json:"violations"`
+	Simulation     *reelstrip.RTPEstimate `json:"simulation,omitempty"` // Omitted when a strip is empty or holds unknown symbols
+}
+
+// ReelStripWeightsResponse is the generator input of a strip set: each reel's symbol weights and the seed
+type ReelStripWeightsResponse struct {
+	GameMode string                `json:"game_mode"`
+	ConfigID *uuid.UUID            `json:"config_id,omitempty"`
+	Source   string                `json:"source"` // "config" for a config generated from weights, else "default" (the mode's tuned weights)
+	Weights  reelstrip.ReelWeights `json:"symbol_weights"`
+	Seed     int64                 `json:"generator_seed"`
+}
+
+// GenerateReelStripsRequest represents symbol weights to generate a strip set from
+// Reels without weights, and a missing seed, keep the base input: the config's when ConfigID names
+// one generated from weights, else the mode's tuned weights. With Name set, the strips are saved
+// as a new config that records its weights and seed.
+type GenerateReelStripsRequest struct {
+	GameMode    string                    `json:"game_mode,omitempty"`
+	ConfigID    *uuid.UUID                `json:"config_id,omitempty"`
+	Weights     []reelstrip.SymbolWeights `json:"symbol_weights,omitempty"` // One table per reel; null keeps the reel's base weights
+	Seed        *int64                    `json:"generator_seed,omitempty"`
+	Spins       int                       `json:"spins,omitempty"` // Spins to simulate, at most reelstrip.PreviewSpins (the default)
+	Name        string                    `json:"name,omitempty" validate:"omitempty,max=100"`
+	Description string                    `json:"description,omitempty"`
+	TargetRTP   float64                   `json:"target_rtp,omitempty" validate:"omitempty,gte=0,lte=100"`
+}
+
+// GenerateReelStripsResponse is a strip set generated from symbol weights, its preview and,
+// when saved, its config
+type GenerateReelStripsResponse struct {
+	PreviewReelStripsResponse
+	Weights reelstrip.ReelWeights    `json:"symbol_weights"`
+	Seed    int64                    `json:"generator_seed"`
+	Strips  [][]string               `json:"strips"`
+	Config  *ReelStripConfigResponse `json:"config,omitempty"`
+}
+
 // ReelStripConfigResponse represents a reel strip configuration response
 type ReelStripConfigResponse struct {
 	ID            uuid.UUID  `json:"id"`
@@ -111,8 +222,10 @@ type ReelStripConfigResponse struct {
 	TargetRTP     float64    `json:"target_rtp,omitempty"`
 	IsActive      bool       `json:"is_active"`
 	IsDefault     bool       `json:"is_default"`
+	IsDemo        bool       `json:"is_demo"`
 	ActivatedAt   *time.Time `json:"activated_at,omitempty"`
 	DeactivatedAt *time.Time `json:"deactivated_at,omitempty"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
 	CreatedAt     time.Time  `json:"created_at"`
 	UpdatedAt     time.Time  `json:"updated_at"`
 	CreatedBy     string     `json:"created_by,omitempty"`
@@ -164,3 +277,12 @@ type ListPlayerAssignmentsResponse struct {
 	Assignments []PlayerAssignmentResponse `json:"assignments"`
 	Total       int                        `json:"total"`
 }
+
+// ============= Operator Reel Strip Default DTOs =============
+
+// SetOperatorReelStripDefaultRequest represents a request to set an operator's default configs
+type SetOperatorReelStripDefaultRequest struct {
+	TargetRTP         float64    `json:"target_rtp" validate:"required,gt=0,lte=100"`
+	BaseGameConfigID  *uuid.UUID `json:"base_game_config_id,omitempty"`
+	FreeSpinsConfigID *uuid.UUID `json:"free_spins_config_id,omitempty"`
+}
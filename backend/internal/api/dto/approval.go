@@ -0,0 +1,13 @@
+package dto
+
+// ReviewChangeRequestRequest is the request body for approving or rejecting a change request
+type ReviewChangeRequestRequest struct {
+	Note string `json:"note"`
+}
+
+// ProposeBalanceAdjustmentRequest is the request body for proposing a player balance adjustment
+// A negative amount debits the player; the adjustment applies once another admin approves it.
+type ProposeBalanceAdjustmentRequest struct {
+	Amount float64 `json:"amount"`
+	Reason string  `json:"reason"`
+}
@@ -1,6 +1,11 @@
 package dto
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+)
 
 // RegisterRequest represents a registration request
 type RegisterRequest struct {
@@ -21,9 +26,11 @@ type LoginRequest struct {
 
 // AuthResponse represents an authentication response (login)
 type AuthResponse struct {
-	SessionToken string        `json:"session_token"` // Session token for authentication
-	ExpiresAt    int64         `json:"expires_at"`    // Unix timestamp when session expires
-	Player       PlayerProfile `json:"player"`
+	SessionToken     string        `json:"session_token"`                // Session token for authentication
+	ExpiresAt        int64         `json:"expires_at"`                   // Unix timestamp when session expires
+	RefreshToken     string        `json:"refresh_token,omitempty"`      // Exchanged at /auth/refresh for a new session (rotated on use)
+	RefreshExpiresAt int64         `json:"refresh_expires_at,omitempty"` // Unix timestamp when the refresh token family expires
+	Player           PlayerProfile `json:"player"`
 }
 
 // RegisterResponse represents a registration response
@@ -32,6 +39,12 @@ type RegisterResponse struct {
 	Player  PlayerProfile `json:"player"`
 }
 
+// RefreshRequest represents a refresh token exchange or revocation request
+type RefreshRequest struct {
+	RefreshToken string  `json:"refresh_token" validate:"required"`
+	DeviceInfo   *string `json:"device_info,omitempty"` // Defaults to the device recorded at login
+}
+
 // LogoutRequest represents a logout request
 type LogoutRequest struct {
 	SessionToken string `json:"session_token,omitempty"` // If not provided, uses token from header
@@ -55,10 +68,21 @@ type PlayerProfile struct {
 
 
 // ErrorResponse represents an error response
+// Error is a catalogued code; MessageKey is filled from it when encoding
 type ErrorResponse struct {
-	Error   string      `json:"error"`
-	Message string      `json:"message"`
-	Details interface{} `json:"details,omitempty"`
+	Error      domainErrors.Code `json:"error"`
+	MessageKey string            `json:"message_key,omitempty"`
+	Message    string            `json:"message"`
+	Details    interface{}       `json:"details,omitempty"`
+}
+
+// MarshalJSON adds the code's localization key to the response
+func (r ErrorResponse) MarshalJSON() ([]byte, error) {
+	type response ErrorResponse
+	if r.MessageKey == "" && r.Error != "" {
+		r.MessageKey = r.Error.MessageKey()
+	}
+	return json.Marshal(response(r))
 }
 
 // SuccessResponse represents a generic success response
@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// StartAutoplayRequest starts server-tracked autoplay in a game session
+type StartAutoplayRequest struct {
+	Spins          int      `json:"spins" validate:"required,gt=0"`
+	SingleWinLimit *float64 `json:"single_win_limit,omitempty"` // Optional: stop after a spin wins at least this amount
+	LossLimit      *float64 `json:"loss_limit,omitempty"`       // Optional: stop before net loss could exceed this amount
+}
+
+// AutoplayResponse represents an autoplay contract and its progress
+type AutoplayResponse struct {
+	ID             string     `json:"id" protobuf:"1"`
+	SessionID      string     `json:"session_id" protobuf:"2"`
+	Status         string     `json:"status" protobuf:"3"`                // active or stopped
+	StopReason     string     `json:"stop_reason,omitempty" protobuf:"4"` // spins_completed, single_win, loss_limit or cancelled
+	TotalSpins     int        `json:"total_spins" protobuf:"5"`
+	SpinsPlayed    int        `json:"spins_played" protobuf:"6"`
+	RemainingSpins int        `json:"remaining_spins" protobuf:"7"`
+	SingleWinLimit *float64   `json:"single_win_limit,omitempty" protobuf:"8"`
+	LossLimit      *float64   `json:"loss_limit,omitempty" protobuf:"9"`
+	TotalWagered   float64    `json:"total_wagered" protobuf:"10"`
+	TotalWon       float64    `json:"total_won" protobuf:"11"`
+	NetLoss        float64    `json:"net_loss" protobuf:"12"`
+	CreatedAt      time.Time  `json:"created_at" protobuf:"13"`
+	StoppedAt      *time.Time `json:"stopped_at,omitempty" protobuf:"14"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+// OperatorCreditRequest is an operator's credit to one of its players made outside the game,
+// such as a deposit into the operator wallet or a bonus payout
+type OperatorCreditRequest struct {
+	PlayerID    string  `json:"player_id" validate:"required"` // The operator's player ID, as returned by its wallet on launch
+	Amount      float64 `json:"amount" validate:"required,gt=0"`
+	Source      string  `json:"source" validate:"required"`    // wallet or bonus
+	Reference   string  `json:"reference" validate:"required"` // Unique per credit; a retried reference is rejected with duplicate_credit
+	Description string  `json:"description,omitempty"`
+}
+
+// CreditResponse is the result of an applied credit
+type CreditResponse struct {
+	TransactionID string    `json:"transaction_id"`
+	PlayerID      string    `json:"player_id"`
+	Amount        float64   `json:"amount"`
+	BalanceBefore float64   `json:"balance_before"`
+	BalanceAfter  float64   `json:"balance_after"`
+	Reference     string    `json:"reference"`
+	CreatedAt     time.Time `json:"created_at"`
+}
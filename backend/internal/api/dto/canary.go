@@ -0,0 +1,16 @@
+package dto
+
+// StartCanaryRequest is the request body for starting a reel strip canary
+// Omitted settings take the configured defaults.
+type StartCanaryRequest struct {
+	ConfigID        string  `json:"config_id"`
+	Percent         int     `json:"percent,omitempty"`           // Share of players routed to the config, 1-99
+	MaxRTPDeviation float64 `json:"max_rtp_deviation,omitempty"` // Tolerated gap between realized and target RTP, in RTP points
+	MinSpins        int64   `json:"min_spins,omitempty"`         // Spins needed before the realized RTP is judged
+	TrialHours      int     `json:"trial_hours,omitempty"`
+}
+
+// AbortCanaryRequest is the request body for aborting a running reel strip canary
+type AbortCanaryRequest struct {
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "github.com/slotmachine/backend/domain/dispute"
+
+// OpenDisputeRequest is the request body for opening a dispute on a spin
+type OpenDisputeRequest struct {
+	SpinID string `json:"spin_id"`
+	Reason string `json:"reason"`
+}
+
+// AssignDisputeRequest is the request body for assigning a dispute to an admin
+type AssignDisputeRequest struct {
+	AdminID string `json:"admin_id"`
+}
+
+// DisputeNoteRequest is the request body for adding a note to a dispute
+type DisputeNoteRequest struct {
+	Note string `json:"note"`
+}
+
+// CloseDisputeRequest is the request body for closing a dispute
+// Status is resolved (upheld for the player) or rejected.
+type CloseDisputeRequest struct {
+	Status dispute.Status `json:"status"`
+	Notes  string         `json:"notes"`
+}
+
+// DisputeResponse is a dispute with its history
+type DisputeResponse struct {
+	*dispute.Dispute
+	Events []*dispute.Event `json:"events"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorResponse_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(ErrorResponse{
+		Error:   domainErrors.CodeInsufficientBalance,
+		Message: "Insufficient balance",
+	})
+	require.NoError(t, err)
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &body))
+	assert.Equal(t, "insufficient_balance", body["error"])
+	assert.Equal(t, "errors.insufficient_balance", body["message_key"])
+	assert.NotContains(t, body, "details")
+}
@@ -0,0 +1,15 @@
+package dto
+
+import "github.com/google/uuid"
+
+// SetFeatureFlagRequest is the request body for overriding a feature flag
+type SetFeatureFlagRequest struct {
+	Enabled    bool        `json:"enabled"`
+	Percentage int         `json:"percentage"` // Share of players (0-100)
+	Segments   []uuid.UUID `json:"segments"`   // Segments that always get the flag
+}
+
+// FeaturesResponse lists the feature flags evaluated for the caller
+type FeaturesResponse struct {
+	Features map[string]bool `json:"features"`
+}
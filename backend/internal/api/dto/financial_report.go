@@ -0,0 +1,6 @@
+package dto
+
+// GenerateFinancialReportRequest rebuilds one day's financial report
+type GenerateFinancialReportRequest struct {
+	Date string `json:"date"` // Ended UTC day, YYYY-MM-DD
+}
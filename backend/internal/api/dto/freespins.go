@@ -16,4 +16,35 @@ type FreeSpinsStatusResponse struct {
 type ExecuteFreeSpinRequest struct {
 	FreeSpinsSessionID string `json:"free_spins_session_id" validate:"required,uuid"`
 	ClientSeed         string `json:"client_seed,omitempty"` // Optional: for provably fair, client provides per-spin seed
+	// Replay protection: the provably fair nonce this spin should be played at, the last seen nonce + 1
+	ClientNonce *int64 `json:"client_nonce,omitempty"`
+}
+
+// ExecuteAllFreeSpinsRequest asks the server to play a free spins session to completion
+type ExecuteAllFreeSpinsRequest struct {
+	FreeSpinsSessionID string `json:"free_spins_session_id" validate:"required,uuid"`
+	ClientSeed         string `json:"client_seed,omitempty"` // Optional: used for every spin, each still gets its own nonce
+}
+
+// FreeSpinsAutoplayResponse is a free spins session played to completion server-side
+type FreeSpinsAutoplayResponse struct {
+	FreeSpinsSessionID string             `json:"free_spins_session_id" protobuf:"1"`
+	SpinsPlayed        int                `json:"spins_played" protobuf:"2"`
+	TotalWon           float64            `json:"total_won" protobuf:"3"`
+	RemainingSpins     int                `json:"remaining_spins" protobuf:"4"` // Non-zero when the per-request cap was reached; call again to finish
+	NewBalance         float64            `json:"new_balance" protobuf:"5"`
+	Spins              []AutoplayFreeSpin `json:"spins" protobuf:"6"`
+}
+
+// AutoplayFreeSpin is the compact outcome of one free spin
+type AutoplayFreeSpin struct {
+	SpinID          string  `json:"spin_id" protobuf:"1"`
+	Grid            [][]int `json:"grid" protobuf:"2"`
+	CascadeCount    int     `json:"cascade_count" protobuf:"3"`
+	TotalWin        float64 `json:"total_win" protobuf:"4"`
+	ScatterCount    int     `json:"scatter_count" protobuf:"5"`
+	Retriggered     bool    `json:"retriggered,omitempty" protobuf:"6"`
+	AdditionalSpins int     `json:"additional_spins,omitempty" protobuf:"7"`
+	Nonce           int64   `json:"nonce,omitempty" protobuf:"8"`
+	SpinHash        string  `json:"spin_hash,omitempty" protobuf:"9"`
 }
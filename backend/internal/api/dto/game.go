@@ -1,5 +1,10 @@
 package dto
 
+import (
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/internal/game/rules"
+)
+
 // CreateGameRequest is the request body for creating a game
 type CreateGameRequest struct {
 	Name        string  `json:"name"`
@@ -27,6 +32,7 @@ type CreateAssetRequest struct {
 	Images          map[string]any `json:"images"`
 	Audios          map[string]any `json:"audios"`
 	Videos          map[string]any `json:"videos"`
+	IsPrivate       bool           `json:"is_private"`
 	IsActive        bool           `json:"is_active"`
 }
 
@@ -39,12 +45,57 @@ type UpdateAssetRequest struct {
 	Images          map[string]any `json:"images"`
 	Audios          map[string]any `json:"audios"`
 	Videos          map[string]any `json:"videos"`
+	IsPrivate       *bool          `json:"is_private"`
 	IsActive        *bool          `json:"is_active"`
 }
 
 // CreateGameConfigRequest is the request body for creating a game config
 type CreateGameConfigRequest struct {
-	GameID   string `json:"game_id"`
-	AssetID  string `json:"asset_id"`
-	IsActive bool   `json:"is_active"`
+	GameID           string                 `json:"game_id"`
+	AssetID          string                 `json:"asset_id"`
+	IsActive         bool                   `json:"is_active"`
+	MultiplierLadder *game.MultiplierLadder `json:"multiplier_ladder"` // Optional: nil uses the default ladder
+	TriggerRules     *game.TriggerRules     `json:"trigger_rules"`     // Optional: nil uses the default trigger rules
+	WildFeatures     *game.WildFeatures     `json:"wild_features"`     // Optional: nil disables wild features
+	WinTiers         *game.WinTiers         `json:"win_tiers"`         // Optional: nil uses the default win tiers
+	TimingProfiles   *game.TimingProfiles   `json:"timing_profiles"`   // Optional: nil uses the default timing
+}
+
+// SetTimingProfilesRequest is the request body for setting a game config's animation timing
+type SetTimingProfilesRequest struct {
+	Normal    game.TimingProfile `json:"normal"`
+	Turbo     game.TimingProfile `json:"turbo"`
+	MinSpinMs int                `json:"min_spin_ms"` // Shortest a spin may be presented at either speed (0 for none)
+}
+
+// SetWinTiersRequest is the request body for setting a game config's win presentation tiers
+type SetWinTiersRequest struct {
+	Tiers game.WinTiers `json:"tiers"` // Ascending by min_multiplier; a win takes the highest tier it reaches
+}
+
+// SetWildFeaturesRequest is the request body for setting a game config's wild features
+type SetWildFeaturesRequest struct {
+	StickyWilds    bool `json:"sticky_wilds"`    // Wilds landing in free spins stay for the rest of the session
+	ExpandingWilds bool `json:"expanding_wilds"` // Wilds in a winning cascade fill their reel
+	WildMultiplier int  `json:"wild_multiplier"` // Multiplies each way a wild completes (0 or 1 disables)
+}
+
+// SetTriggerRulesRequest is the request body for setting a game config's free spins trigger rules
+type SetTriggerRulesRequest struct {
+	MinScatters     int   `json:"min_scatters"`
+	BaseAward       int   `json:"base_award"`
+	ExtraPerScatter int   `json:"extra_per_scatter"`
+	Retrigger       *bool `json:"retrigger"` // Defaults to true
+}
+
+// SetMultiplierLadderRequest is the request body for setting a game config's multiplier ladder
+type SetMultiplierLadderRequest struct {
+	BaseGame  []int `json:"base_game"`
+	FreeSpins []int `json:"free_spins"`
+}
+
+// PaytableResponse is the game's rules with localized bonus feature descriptions
+type PaytableResponse struct {
+	*rules.Rules
+	Descriptions []string `json:"descriptions"`
 }
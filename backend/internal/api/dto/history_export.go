@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+// CreateHistoryExportRequest requests a download of the player's game history
+type CreateHistoryExportRequest struct {
+	Format string `json:"format"` // csv for every spin, pdf for a summarized statement
+	From   string `json:"from"`   // First day covered, YYYY-MM-DD (UTC)
+	To     string `json:"to"`     // Last day covered, inclusive, YYYY-MM-DD (UTC)
+}
+
+// HistoryExportResponse represents a game-history export and its progress
+type HistoryExportResponse struct {
+	ID          string     `json:"id"`
+	Format      string     `json:"format"`
+	From        string     `json:"from"`
+	To          string     `json:"to"`
+	Status      string     `json:"status"` // pending, processing, completed or failed
+	FileName    string     `json:"file_name"`
+	SpinCount   int        `json:"spin_count"`
+	SizeBytes   int64      `json:"size_bytes"`
+	Error       string     `json:"error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// HistoryExportListResponse lists the player's recent game-history exports
+type HistoryExportListResponse struct {
+	Exports []HistoryExportResponse `json:"exports"`
+}
+
+// HistoryExportDownloadResponse is a signed, expiring link to a completed export
+type HistoryExportDownloadResponse struct {
+	URL       string    `json:"url"`
+	FileName  string    `json:"file_name"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/slotmachine/backend/domain/impersonation"
+)
+
+// ImpersonatePlayerRequest asks for a read-only impersonation token for a player
+type ImpersonatePlayerRequest struct {
+	Reason string `json:"reason"` // Why the player's view is needed, e.g. a support ticket
+}
+
+// ImpersonationTokenResponse is a newly issued impersonation token, shown only once
+// The token is sent as "Authorization: Bearer <token>" to the player APIs.
+type ImpersonationTokenResponse struct {
+	Token     string               `json:"token"`
+	ExpiresAt time.Time            `json:"expires_at"`
+	Grant     *impersonation.Grant `json:"grant"`
+}
@@ -0,0 +1,30 @@
+package dto
+
+// JurisdictionRequest is the request body for creating or updating a jurisdiction
+type JurisdictionRequest struct {
+	Code                string  `json:"code"`
+	Name                string  `json:"name"`
+	MaxBet              float64 `json:"max_bet"`
+	MaxWin              float64 `json:"max_win"`
+	RealityCheckMinutes int     `json:"reality_check_minutes"`
+	AutoplayAllowed     bool    `json:"autoplay_allowed"`
+	RTPDisclosure       bool    `json:"rtp_disclosure"`
+	DisclosedRTP        float64 `json:"disclosed_rtp"`
+}
+
+// SetPlayerJurisdictionRequest is the request body for attaching a player to a jurisdiction
+type SetPlayerJurisdictionRequest struct {
+	Code *string `json:"code"` // null detaches the player (the default jurisdiction applies)
+}
+
+// PlayerJurisdictionResponse describes the rules that apply to the authenticated player
+type PlayerJurisdictionResponse struct {
+	Code                string   `json:"code,omitempty"`
+	Name                string   `json:"name,omitempty"`
+	MaxBet              float64  `json:"max_bet,omitempty"`
+	MaxWin              float64  `json:"max_win,omitempty"`
+	RealityCheckMinutes int      `json:"reality_check_minutes,omitempty"`
+	AutoplayAllowed     bool     `json:"autoplay_allowed"`
+	RealityCheckMessage string   `json:"reality_check_message,omitempty"` // Localized reality check text
+	RTP                 *float64 `json:"rtp,omitempty"`                   // Only present when the jurisdiction requires RTP disclosure
+}
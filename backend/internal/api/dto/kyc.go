@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/slotmachine/backend/domain/kyc"
+
+// SetPlayerKYCRequest is the request body for recording a manual KYC decision
+type SetPlayerKYCRequest struct {
+	Status kyc.Status `json:"status"`
+}
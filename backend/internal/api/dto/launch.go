@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+// LaunchRequest is an operator's request to launch a game for one of its players
+type LaunchRequest struct {
+	OperatorToken string `json:"operator_token" validate:"required"` // Validated against the operator wallet
+	GameID        string `json:"game_id" validate:"required,uuid"`
+	Language      string `json:"language,omitempty"`
+	PlayerIP      string `json:"player_ip,omitempty"`  // Player IP as seen by the operator
+	UserAgent     string `json:"user_agent,omitempty"` // Player user agent as seen by the operator
+}
+
+// LaunchResponse carries the one-time game URL for the operator to redirect the player to
+type LaunchResponse struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"` // The URL must be opened before this time
+	PlayerID  string    `json:"player_id"`
+}
+
+// RedeemLaunchRequest exchanges the launch token from a game URL for a session
+type RedeemLaunchRequest struct {
+	LaunchToken string `json:"launch_token" validate:"required"`
+}
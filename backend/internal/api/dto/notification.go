@@ -0,0 +1,13 @@
+package dto
+
+import "time"
+
+// SendNotificationRequest sends a notification to one player, or to every player without a player ID
+type SendNotificationRequest struct {
+	PlayerID  string         `json:"player_id,omitempty"`
+	Type      string         `json:"type"` // bonus_granted, tournament_started or maintenance
+	Title     string         `json:"title"`
+	Body      string         `json:"body"`
+	Data      map[string]any `json:"data,omitempty"`
+	ExpiresAt *time.Time     `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+// CreatePlayerNoteRequest adds a support note or flag to a player
+type CreatePlayerNoteRequest struct {
+	Kind string `json:"kind"` // "note" or "flag"
+	Body string `json:"body"`
+}
+
+// UpdatePlayerNoteRequest replaces the body of a support note or flag
+type UpdatePlayerNoteRequest struct {
+	Body string `json:"body"`
+}
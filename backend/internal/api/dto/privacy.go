@@ -0,0 +1,6 @@
+package dto
+
+// ErasePlayerRequest is the request body for erasing a player's personal data
+type ErasePlayerRequest struct {
+	Reason string `json:"reason"` // Required; e.g. the reference of the data subject request
+}
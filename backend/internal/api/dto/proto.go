@@ -0,0 +1,15 @@
+package dto
+
+// ProtoPackage is the Protobuf package of the binary response schema
+const ProtoPackage = "slotmachine.v1"
+
+// ProtoMessages lists the responses served as Protobuf
+// The schema (api/proto/slot.proto, regenerated with make proto-schema) covers every
+// message reachable from these through fields tagged `protobuf:"N"`.
+var ProtoMessages = []any{
+	SpinResponse{},
+	FreeSpinsAutoplayResponse{},
+	SessionResponse{},
+	SessionHistoryResponse{},
+	AutoplayResponse{},
+}
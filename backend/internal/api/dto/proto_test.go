@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"os"
+	"testing"
+
+	"github.com/slotmachine/backend/internal/pkg/wireformat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtoSchemaIsCurrent(t *testing.T) {
+	schema, err := wireformat.Schema(ProtoPackage, ProtoMessages...)
+	require.NoError(t, err)
+
+	checkedIn, err := os.ReadFile("../../../api/proto/slot.proto")
+	require.NoError(t, err)
+	assert.Equal(t, schema, string(checkedIn), "DTO protobuf tags changed: run make proto-schema")
+}
@@ -5,8 +5,8 @@ import "time"
 // StartPFSessionRequest represents a request to start a provably fair session
 // No client_seed here - it's provided per-spin now
 type StartPFSessionRequest struct {
-	// Empty - no fields needed for starting a PF session
-	// Client seed is now provided per-spin
+	// Dual Commitment Protocol: SHA256(theta_seed), required when PF_DUAL_COMMITMENT_REQUIRED applies
+	ThetaCommitment string `json:"theta_commitment,omitempty"`
 }
 
 // StartPFSessionResponse represents the response after starting a PF session
@@ -30,15 +30,15 @@ type EndPFSessionResponse struct {
 // SpinVerificationData contains data for verifying a single spin
 // Includes per-spin client_seed for provably fair verification
 type SpinVerificationData struct {
-	SpinIndex         int64   `json:"spin_index"`
-	Nonce             int64   `json:"nonce"`
-	ClientSeed        string  `json:"client_seed"`                    // Per-spin client seed
-	SpinHash          string  `json:"spin_hash"`
-	PrevSpinHash      string  `json:"prev_spin_hash"`
-	ReelPositions     []int   `json:"reel_positions"`                 // Array of 5 reel positions from RNG
-	ReelStripConfigID *string `json:"reel_strip_config_id,omitempty"` // Which reel strip config was used
-	GameMode          *string `json:"game_mode,omitempty"`            // Game mode if any
-	IsFreeSpin        bool    `json:"is_free_spin"`
+	SpinIndex         int64   `json:"spin_index" protobuf:"1"`
+	Nonce             int64   `json:"nonce" protobuf:"2"`
+	ClientSeed        string  `json:"client_seed" protobuf:"3"` // Per-spin client seed
+	SpinHash          string  `json:"spin_hash" protobuf:"4"`
+	PrevSpinHash      string  `json:"prev_spin_hash" protobuf:"5"`
+	ReelPositions     []int   `json:"reel_positions" protobuf:"6"`                 // Array of 5 reel positions from RNG
+	ReelStripConfigID *string `json:"reel_strip_config_id,omitempty" protobuf:"7"` // Which reel strip config was used
+	GameMode          *string `json:"game_mode,omitempty" protobuf:"8"`            // Game mode if any
+	IsFreeSpin        bool    `json:"is_free_spin" protobuf:"9"`
 }
 
 // PFSessionStatusResponse represents the current status of a PF session
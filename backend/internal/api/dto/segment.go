@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/slotmachine/backend/domain/segment"
+)
+
+// CreateSegmentRequest is the request body for creating a segment
+type CreateSegmentRequest struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Rules       segment.Rules `json:"rules"`
+	IsActive    *bool         `json:"is_active"`
+}
+
+// UpdateSegmentRequest is the request body for updating a segment
+type UpdateSegmentRequest struct {
+	Name        *string        `json:"name"`
+	Description *string        `json:"description"`
+	Rules       *segment.Rules `json:"rules"`
+	IsActive    *bool          `json:"is_active"`
+}
+
+// CreateSegmentTargetRequest is the request body for targeting a segment
+// Settings depend on kind: reel_strip {base_game_config_id, free_spins_config_id},
+// bonus {extra_free_spins}, rate_limit {rps}.
+type CreateSegmentTargetRequest struct {
+	Kind     segment.TargetKind `json:"kind"`
+	Priority int                `json:"priority"`
+	Settings json.RawMessage    `json:"settings"`
+	IsActive *bool              `json:"is_active"`
+}
+
+// UpdateSegmentTargetRequest is the request body for updating a segment target
+type UpdateSegmentTargetRequest struct {
+	Priority *int            `json:"priority"`
+	Settings json.RawMessage `json:"settings"`
+	IsActive *bool           `json:"is_active"`
+}
+
+// AddPlayerTagRequest is the request body for tagging a player
+type AddPlayerTagRequest struct {
+	Tag string `json:"tag"`
+}
+
+// SegmentMembershipResponse reports whether a player belongs to a segment
+type SegmentMembershipResponse struct {
+	SegmentID string `json:"segment_id"`
+	PlayerID  string `json:"player_id"`
+	IsMember  bool   `json:"is_member"`
+}
@@ -9,41 +9,51 @@ type StartSessionRequest struct {
 	// Dual Commitment Protocol: Client sends theta_commitment BEFORE seeing server_seed
 	// This is SHA256(theta_seed) where theta_seed will be revealed on first spin
 	ThetaCommitment string `json:"theta_commitment,omitempty"`
+
+	// Takeover ends any active session (revealing its server seed) instead of failing with active_session_exists
+	Takeover bool `json:"takeover,omitempty"`
 }
 
 // SessionResponse represents a game session
 type SessionResponse struct {
-	ID              string     `json:"id"`
-	PlayerID        string     `json:"player_id"`
-	BetAmount       float64    `json:"bet_amount"`
-	StartingBalance float64    `json:"starting_balance"`
-	EndingBalance   *float64   `json:"ending_balance,omitempty"`
-	TotalSpins      int        `json:"total_spins"`
-	TotalWagered    float64    `json:"total_wagered"`
-	TotalWon        float64    `json:"total_won"`
-	NetChange       float64    `json:"net_change"`
-	CreatedAt       time.Time  `json:"created_at"`
-	EndedAt         *time.Time `json:"ended_at,omitempty"`
+	ID              string     `json:"id" protobuf:"1"`
+	PlayerID        string     `json:"player_id" protobuf:"2"`
+	BetAmount       float64    `json:"bet_amount" protobuf:"3"`
+	StartingBalance float64    `json:"starting_balance" protobuf:"4"`
+	EndingBalance   *float64   `json:"ending_balance,omitempty" protobuf:"5"`
+	TotalSpins      int        `json:"total_spins" protobuf:"6"`
+	TotalWagered    float64    `json:"total_wagered" protobuf:"7"`
+	TotalWon        float64    `json:"total_won" protobuf:"8"`
+	NetChange       float64    `json:"net_change" protobuf:"9"`
+	CreatedAt       time.Time  `json:"created_at" protobuf:"10"`
+	EndedAt         *time.Time `json:"ended_at,omitempty" protobuf:"11"`
+	RealityCheckAt  *time.Time `json:"reality_check_at,omitempty" protobuf:"12"`
 
 	// Provably Fair data (only present if PF is enabled)
-	ProvablyFair *SessionProvablyFairData `json:"provably_fair,omitempty"`
+	ProvablyFair *SessionProvablyFairData `json:"provably_fair,omitempty" protobuf:"13"`
+
+	// Player's VIP tier (only present if the loyalty program is enabled)
+	VIP *VIPStatusResponse `json:"vip,omitempty" protobuf:"14"`
+
+	// Session ended by a takeover start, with its revealed PF data (only present on takeover)
+	PreviousSession *SessionResponse `json:"previous_session,omitempty" protobuf:"15"`
 }
 
 // SessionProvablyFairData contains provably fair data for a session
 // On start: shows server_seed_hash (commitment)
 // On end: reveals server_seed and all spin data for verification
 type SessionProvablyFairData struct {
-	SessionID      string                 `json:"session_id"`
-	ServerSeedHash string                 `json:"server_seed_hash"` // Always present
-	NonceStart     int64                  `json:"nonce_start,omitempty"`
-	ServerSeed     string                 `json:"server_seed,omitempty"` // Only present on end (revealed)
-	TotalSpins     int64                  `json:"total_spins,omitempty"`
-	Spins          []SpinVerificationData `json:"spins,omitempty"` // Only present on end
+	SessionID      string                 `json:"session_id" protobuf:"1"`
+	ServerSeedHash string                 `json:"server_seed_hash" protobuf:"2"` // Always present
+	NonceStart     int64                  `json:"nonce_start,omitempty" protobuf:"3"`
+	ServerSeed     string                 `json:"server_seed,omitempty" protobuf:"4"` // Only present on end (revealed)
+	TotalSpins     int64                  `json:"total_spins,omitempty" protobuf:"5"`
+	Spins          []SpinVerificationData `json:"spins,omitempty" protobuf:"6"` // Only present on end
 }
 
 // SessionHistoryResponse represents paginated session history
 type SessionHistoryResponse struct {
-	Page     int               `json:"page"`
-	Limit    int               `json:"limit"`
-	Sessions []SessionResponse `json:"sessions"`
+	Page     int               `json:"page" protobuf:"1"`
+	Limit    int               `json:"limit" protobuf:"2"`
+	Sessions []SessionResponse `json:"sessions" protobuf:"3"`
 }
@@ -13,68 +13,160 @@ type ExecuteSpinRequest struct {
 	BetAmount  float64 `json:"bet_amount" validate:"required,gt=0"`
 	GameMode   string  `json:"game_mode,omitempty"`   // Optional: bonus_spin_trigger (guaranteed free spins)
 	ClientSeed string  `json:"client_seed,omitempty"` // Optional: for provably fair, client provides per-spin seed
+	// Optional: the seed the client will send with its next spin, so that spin can be derived ahead
+	NextClientSeed string `json:"next_client_seed,omitempty"`
 	// Dual Commitment Protocol: theta_seed is revealed on first spin
 	ThetaSeed string `json:"theta_seed,omitempty"` // Required on first spin if theta_commitment was provided
+	Autoplay  bool   `json:"autoplay,omitempty"`   // Set by clients for autoplay spins (restricted in some jurisdictions)
+	// Play any free spins this spin triggers in the same request, for fast-forward presentation
+	AutoplayFreeSpins bool `json:"autoplay_free_spins,omitempty"`
+	// Replay protection: the provably fair nonce this spin should be played at, the last seen nonce + 1
+	ClientNonce *int64 `json:"client_nonce,omitempty"`
+}
+
+// AcknowledgeSpinRequest confirms the client received a spin result
+type AcknowledgeSpinRequest struct {
+	SessionID string `json:"session_id" validate:"required,uuid"`
+	SpinID    string `json:"spin_id" validate:"required,uuid"`
 }
 
 // SpinProvablyFairData contains provably fair data for a spin response
 type SpinProvablyFairData struct {
-	SpinHash     string `json:"spin_hash"`      // Current spin's hash (for client tracking)
-	PrevSpinHash string `json:"prev_spin_hash"` // Previous spin's hash (or server_seed_hash for first spin)
-	Nonce        int64  `json:"nonce"`          // Spin nonce in session
+	SpinHash     string `json:"spin_hash" protobuf:"1"`      // Current spin's hash (for client tracking)
+	PrevSpinHash string `json:"prev_spin_hash" protobuf:"2"` // Previous spin's hash (or server_seed_hash for first spin)
+	Nonce        int64  `json:"nonce" protobuf:"3"`          // Spin nonce in session
 }
 
 // SpinResponse represents a spin result
 type SpinResponse struct {
-	SpinID                  string                `json:"spin_id"`
-	SessionID               string                `json:"session_id"`
-	BetAmount               float64               `json:"bet_amount"`
-	BalanceBefore           float64               `json:"balance_before"`
-	BalanceAfterBet         float64               `json:"balance_after_bet"`
-	NewBalance              float64               `json:"new_balance"`
-	Grid                    [][]int               `json:"grid"`
-	Cascades                []CascadeInfo         `json:"cascades"`
-	SpinTotalWin            float64               `json:"spin_total_win"`
-	ScatterCount            int                   `json:"scatter_count"`
-	IsFreeSpin              bool                  `json:"is_free_spin"`
-	FreeSpinsTriggered      bool                  `json:"free_spins_triggered"`
-	FreeSpinsRetriggered    bool                  `json:"free_spins_retriggered"`
-	FreeSpinsAdditional     int                   `json:"free_spins_additional,omitempty"`
-	FreeSpinsSessionID      string                `json:"free_spins_session_id,omitempty"`
-	FreeSpinsRemainingSpins int                   `json:"free_spins_remaining_spins"`
-	FreeSessionTotalWin     float64               `json:"free_session_total_win"`
-	GameMode                string                `json:"game_mode,omitempty"`      // Game mode used: bonus_spin_trigger (guaranteed free spins)
-	GameModeCost            float64               `json:"game_mode_cost,omitempty"` // Cost paid for game mode (1000)
-	Timestamp               string                `json:"timestamp"`
-	ProvablyFair            *SpinProvablyFairData `json:"provably_fair,omitempty"` // Present if PF session is active
+	SpinID                  string                     `json:"spin_id" protobuf:"1"`
+	SessionID               string                     `json:"session_id" protobuf:"2"`
+	BetAmount               float64                    `json:"bet_amount" protobuf:"3"`
+	BalanceBefore           float64                    `json:"balance_before" protobuf:"4"`
+	BalanceAfterBet         float64                    `json:"balance_after_bet" protobuf:"5"`
+	NewBalance              float64                    `json:"new_balance" protobuf:"6"`
+	Grid                    [][]int                    `json:"grid" protobuf:"7"`
+	Cascades                []CascadeInfo              `json:"cascades" protobuf:"8"`
+	SpinTotalWin            float64                    `json:"spin_total_win" protobuf:"9"`
+	WinCapped               bool                       `json:"win_capped,omitempty" protobuf:"10"`
+	ScatterCount            int                        `json:"scatter_count" protobuf:"11"`
+	IsFreeSpin              bool                       `json:"is_free_spin" protobuf:"12"`
+	FreeSpinsTriggered      bool                       `json:"free_spins_triggered" protobuf:"13"`
+	FreeSpinsRetriggered    bool                       `json:"free_spins_retriggered" protobuf:"14"`
+	FreeSpinsAdditional     int                        `json:"free_spins_additional,omitempty" protobuf:"15"`
+	FreeSpinsSessionID      string                     `json:"free_spins_session_id,omitempty" protobuf:"16"`
+	FreeSpinsRemainingSpins int                        `json:"free_spins_remaining_spins" protobuf:"17"`
+	FreeSessionTotalWin     float64                    `json:"free_session_total_win" protobuf:"18"`
+	GameMode                string                     `json:"game_mode,omitempty" protobuf:"19"`      // Game mode used: bonus_spin_trigger (guaranteed free spins)
+	GameModeCost            float64                    `json:"game_mode_cost,omitempty" protobuf:"20"` // Cost paid for game mode (1000)
+	StickyWilds             []Position                 `json:"sticky_wilds,omitempty" protobuf:"21"`   // Wilds held for the next free spin
+	Timestamp               string                     `json:"timestamp" protobuf:"22"`
+	ProvablyFair            *SpinProvablyFairData      `json:"provably_fair,omitempty" protobuf:"23"`       // Present if PF session is active
+	FreeSpinsAutoplay       *FreeSpinsAutoplayResponse `json:"free_spins_autoplay,omitempty" protobuf:"24"` // Triggered free spins, when autoplay_free_spins was requested
+	Autoplay                *AutoplayResponse          `json:"autoplay,omitempty" protobuf:"25"`            // Autoplay progress, for autoplay spins
+	WinTier                 *WinTierInfo               `json:"win_tier,omitempty" protobuf:"26"`            // Win presentation tier, absent for no win
+	Timing                  *SpinTimingInfo            `json:"timing,omitempty" protobuf:"27"`              // Suggested animation timing at normal and turbo speed
+	Signature               *SpinSignature             `json:"signature,omitempty"`                         // Must stay last: it signs the bytes before it
+}
+
+// WinTierInfo is the presentation tier of a spin's win and the asset keys to play for it
+type WinTierInfo struct {
+	Name       string  `json:"name" protobuf:"1"`
+	Multiplier float64 `json:"multiplier" protobuf:"2"` // Win divided by bet
+	Video      string  `json:"video,omitempty" protobuf:"3"`
+	Audio      string  `json:"audio,omitempty" protobuf:"4"`
+}
+
+// SpinTimingInfo is the suggested animation timing of a spin, in milliseconds
+// Clients should not present a spin faster than its plan's total_ms.
+type SpinTimingInfo struct {
+	Normal    TimingPlanInfo `json:"normal" protobuf:"1"`
+	Turbo     TimingPlanInfo `json:"turbo" protobuf:"2"`
+	MinSpinMs int            `json:"min_spin_ms,omitempty" protobuf:"3"` // Shortest the spin may be presented, already included in both plans
+}
+
+// TimingPlanInfo is the suggested duration of each step of a spin's presentation
+type TimingPlanInfo struct {
+	ReelSpinMs int   `json:"reel_spin_ms" protobuf:"1"`
+	CascadeMs  []int `json:"cascade_ms" protobuf:"2"` // One entry per cascade
+	TotalMs    int   `json:"total_ms" protobuf:"3"`
+}
+
+// CompactSpinResponse is the compact spin response format
+// Negotiated with ?format=compact or "Accept: application/vnd.slotmachine.compact+json".
+// Identical to SpinResponse except cascades, which carry only the cells each cascade changed.
+type CompactSpinResponse struct {
+	SpinResponse
+	Format   string               `json:"format"` // Always "compact"
+	Cascades []CompactCascadeInfo `json:"cascades"`
+}
+
+// CompactCascadeInfo is a cascade step delta-encoded against the grid before it
+type CompactCascadeInfo struct {
+	CascadeNumber   int              `json:"n"`
+	Changes         [][3]int         `json:"d"` // [reel, row, symbol] for every cell that changed
+	Multiplier      int              `json:"m"`
+	Wins            []CompactWinInfo `json:"w,omitempty"`
+	TotalCascadeWin float64          `json:"t"`
+	WinningTileKind string           `json:"k,omitempty"`
+	ExpandedReels   []int            `json:"x,omitempty"`
+}
+
+// CompactWinInfo is a winning combination with positions packed as [reel, row] pairs
+type CompactWinInfo struct {
+	Symbol        int      `json:"s"`
+	Count         int      `json:"c"`
+	Ways          int      `json:"w"`
+	Payout        float64  `json:"p"`
+	WinAmount     float64  `json:"a"`
+	Positions     [][2]int `json:"pos"`
+	GoldToWild    []int    `json:"g,omitempty"` // Indexes into Positions of gold tiles that turn wild
+	EffectiveWays int      `json:"e,omitempty"`
+}
+
+// SpinSignature is the Ed25519 signature of a spin response
+// It covers the exact response body with the trailing "signature" member removed.
+type SpinSignature struct {
+	Algorithm string `json:"alg"` // Always "Ed25519"
+	KeyID     string `json:"kid"`
+	Value     string `json:"value"` // Base64 (standard encoding) signature
+}
+
+// SpinSigningKeyResponse publishes the key spin responses are signed with
+type SpinSigningKeyResponse struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	PublicKey string `json:"public_key"` // Base64 (standard encoding) raw 32-byte public key
 }
 
 // CascadeInfo represents cascade information
 type CascadeInfo struct {
-	CascadeNumber   int       `json:"cascade_number"`
-	GridAfter       [][]int   `json:"grid_after"` // Grid state after this cascade
-	Multiplier      int       `json:"multiplier"`
-	Wins            []WinInfo `json:"wins"`
-	TotalCascadeWin float64   `json:"total_cascade_win"`
-	WinningTileKind string    `json:"winning_tile_kind,omitempty"` // Highest priority winning symbol (fa > zhong > bai > bawan)
+	CascadeNumber   int       `json:"cascade_number" protobuf:"1"`
+	GridAfter       [][]int   `json:"grid_after" protobuf:"2"` // Grid state after this cascade
+	Multiplier      int       `json:"multiplier" protobuf:"3"`
+	Wins            []WinInfo `json:"wins" protobuf:"4"`
+	TotalCascadeWin float64   `json:"total_cascade_win" protobuf:"5"`
+	WinningTileKind string    `json:"winning_tile_kind,omitempty" protobuf:"6"` // Highest priority winning symbol (fa > zhong > bai > bawan)
+	ExpandedReels   []int     `json:"expanded_reels,omitempty" protobuf:"7"`    // Reels filled by expanding wilds
 }
 
 // Position represents a grid position [reel, row]
 type Position struct {
-	Reel          int  `json:"reel"`
-	Row           int  `json:"row"`
-	IsGoldToWild  bool `json:"is_gold_to_wild,omitempty"` // True if this gold tile transforms to wild
+	Reel         int  `json:"reel" protobuf:"1"`
+	Row          int  `json:"row" protobuf:"2"`
+	IsGoldToWild bool `json:"is_gold_to_wild,omitempty" protobuf:"3"` // True if this gold tile transforms to wild
 }
 
 // WinInfo represents a win in a cascade
 type WinInfo struct {
-	Symbol       int        `json:"symbol"`        // Symbol ID (same as grid values)
-	Count        int        `json:"count"`
-	Ways         int        `json:"ways"`
-	Payout       float64    `json:"payout"`
-	WinAmount    float64    `json:"win_amount"`
-	Positions    []Position `json:"positions"`     // Grid positions that form this win
-	WinIntensity string     `json:"win_intensity"` // Visual intensity: small, medium, big, mega
+	Symbol        int        `json:"symbol" protobuf:"1"` // Symbol ID (same as grid values)
+	Count         int        `json:"count" protobuf:"2"`
+	Ways          int        `json:"ways" protobuf:"3"`
+	Payout        float64    `json:"payout" protobuf:"4"`
+	WinAmount     float64    `json:"win_amount" protobuf:"5"`
+	Positions     []Position `json:"positions" protobuf:"6"`                // Grid positions that form this win
+	WinIntensity  string     `json:"win_intensity" protobuf:"7"`            // Visual intensity: small, medium, big, mega
+	EffectiveWays int        `json:"effective_ways,omitempty" protobuf:"8"` // Ways after wild multipliers, set only when wilds multiplied the win
 }
 
 // SpinHistoryResponse represents paginated spin history
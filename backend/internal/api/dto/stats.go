@@ -0,0 +1,10 @@
+package dto
+
+import "github.com/slotmachine/backend/domain/stats"
+
+// DailyStatsResponse represents a player's daily stats over a date range
+type DailyStatsResponse struct {
+	From string              `json:"from"` // YYYY-MM-DD (UTC)
+	To   string              `json:"to"`   // YYYY-MM-DD (UTC)
+	Days []*stats.DailyStats `json:"days"`
+}
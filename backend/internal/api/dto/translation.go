@@ -0,0 +1,12 @@
+package dto
+
+// TranslationsResponse is a locale's merged translation bundle
+type TranslationsResponse struct {
+	Locale   string            `json:"locale"`
+	Messages map[string]string `json:"messages"`
+}
+
+// SetTranslationsRequest is the request body for replacing a locale's admin bundle
+type SetTranslationsRequest struct {
+	Messages map[string]string `json:"messages"`
+}
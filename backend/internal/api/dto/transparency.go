@@ -0,0 +1,23 @@
+package dto
+
+import "github.com/slotmachine/backend/domain/transparency"
+
+// TransparencyCheckpointListResponse pages the transparency log newest first
+type TransparencyCheckpointListResponse struct {
+	Checkpoints []*transparency.Checkpoint `json:"checkpoints"`
+	Total       int64                      `json:"total"`
+	Limit       int                        `json:"limit"`
+	Offset      int                        `json:"offset"`
+}
+
+// TransparencyCheckpointResponse is a checkpoint with the spin hashes it commits to, in leaf order
+type TransparencyCheckpointResponse struct {
+	Checkpoint *transparency.Checkpoint `json:"checkpoint"`
+	Leaves     []transparency.Leaf      `json:"leaves"`
+}
+
+// TransparencyProofResponse proves a spin's hash is committed to a checkpoint
+// Leaf hashes are SHA256(0x00 || spin_hash) and nodes SHA256(0x01 || left || right), as in RFC 6962.
+type TransparencyProofResponse struct {
+	*transparency.InclusionProof
+}
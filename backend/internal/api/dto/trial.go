@@ -1,5 +1,7 @@
 package dto
 
+import "github.com/google/uuid"
+
 // StartTrialRequest represents the request to start a trial session
 type StartTrialRequest struct {
 	GameID string `json:"game_id,omitempty"` // Optional game ID
@@ -27,3 +29,45 @@ type TrialResponse struct {
 type TrialBalanceResponse struct {
 	Balance float64 `json:"balance"`
 }
+
+// TopUpTrialBalanceRequest is the request body for topping up a trial balance
+type TopUpTrialBalanceRequest struct {
+	Amount float64 `json:"amount"`
+}
+
+// TrialSettingsRequest is the request body for a game's demo settings
+type TrialSettingsRequest struct {
+	StartingBalance float64 `json:"starting_balance"`
+	MinBet          float64 `json:"min_bet"`
+	MaxBet          float64 `json:"max_bet"`
+	DailyReset      bool    `json:"daily_reset"`
+
+	// Demo-only reel strip configs; omit to use the built-in trial strips
+	BaseGameConfigID  *uuid.UUID `json:"base_game_config_id,omitempty"`
+	FreeSpinsConfigID *uuid.UUID `json:"free_spins_config_id,omitempty"`
+}
+
+// ConvertTrialRequest is the request body for converting a trial player into a real account
+type ConvertTrialRequest struct {
+	Username string  `json:"username" validate:"required,min=3,max=50"`
+	Email    string  `json:"email" validate:"required,email"`
+	Password string  `json:"password" validate:"required,min=8"`
+	GameID   *string `json:"game_id,omitempty" validate:"omitempty,uuid"` // Defaults to the trial session's game
+}
+
+// TrialStats are the demo statistics of a converted trial session
+// They are reported separately and are not part of the player's real-money totals.
+type TrialStats struct {
+	TrialSessionID string  `json:"trial_session_id"`
+	TotalSpins     int     `json:"total_spins"`
+	TotalWagered   float64 `json:"total_wagered"`
+	TotalWon       float64 `json:"total_won"`
+	FinalBalance   float64 `json:"final_balance"`
+}
+
+// ConvertTrialResponse is the response for a trial conversion
+type ConvertTrialResponse struct {
+	Message    string        `json:"message"`
+	Player     PlayerProfile `json:"player"`
+	TrialStats TrialStats    `json:"trial_stats"`
+}
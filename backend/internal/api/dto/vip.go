@@ -0,0 +1,30 @@
+package dto
+
+import "github.com/slotmachine/backend/domain/vip"
+
+// CreateVIPTierRequest is the request body for creating a VIP tier
+type CreateVIPTierRequest struct {
+	Level     int       `json:"level"`
+	Name      string    `json:"name"`
+	MinPoints int64     `json:"min_points"`
+	Perks     vip.Perks `json:"perks"`
+}
+
+// UpdateVIPTierRequest is the request body for updating a VIP tier
+type UpdateVIPTierRequest struct {
+	Level     *int       `json:"level"`
+	Name      *string    `json:"name"`
+	MinPoints *int64     `json:"min_points"`
+	Perks     *vip.Perks `json:"perks"`
+}
+
+// VIPStatusResponse represents a player's standing in the loyalty program
+type VIPStatusResponse struct {
+	Level        int       `json:"level" protobuf:"1"`
+	TierName     string    `json:"tier_name,omitempty" protobuf:"2"`
+	Points       int64     `json:"points" protobuf:"3"`
+	Perks        vip.Perks `json:"perks"`
+	NextLevel    int       `json:"next_level,omitempty" protobuf:"4"`
+	NextTierName string    `json:"next_tier_name,omitempty" protobuf:"5"`
+	PointsToNext int64     `json:"points_to_next,omitempty" protobuf:"6"`
+}
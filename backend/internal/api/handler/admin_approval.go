@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/approval"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminApprovalHandler handles admin endpoints for four-eyes review of sensitive changes
+type AdminApprovalHandler struct {
+	approvalService approval.Service
+	logger          *logger.Logger
+}
+
+// NewAdminApprovalHandler creates a new admin approval handler
+func NewAdminApprovalHandler(approvalService approval.Service, log *logger.Logger) *AdminApprovalHandler {
+	return &AdminApprovalHandler{
+		approvalService: approvalService,
+		logger:          log,
+	}
+}
+
+// ListChangeRequests lists change requests newest first
+// GET /admin/change-requests?status=&action=&proposed_by=&page=&limit=
+func (h *AdminApprovalHandler) ListChangeRequests(c *fiber.Ctx) error {
+	filters := approval.ListFilters{Page: 1, Limit: 20}
+	if page := c.Query("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil && p > 0 {
+			filters.Page = p
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 100 {
+			filters.Limit = l
+		}
+	}
+	if status := c.Query("status"); status != "" {
+		filters.Status = approval.Status(status)
+		if !filters.Status.Valid() {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidParams,
+				Message: "Invalid status parameter",
+			})
+		}
+	}
+	if action := c.Query("action"); action != "" {
+		filters.Action = approval.Action(action)
+		if !filters.Action.Valid() {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidParams,
+				Message: "Invalid action parameter",
+			})
+		}
+	}
+	if v := c.Query("proposed_by"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidParams,
+				Message: "Invalid proposed_by parameter",
+			})
+		}
+		filters.ProposedBy = &id
+	}
+
+	requests, total, err := h.approvalService.List(c.Context(), filters)
+	if err != nil {
+		return approvalError(c, h.logger, err, "Failed to list change requests")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"change_requests": requests,
+			"total":           total,
+			"page":            filters.Page,
+			"limit":           filters.Limit,
+		},
+	})
+}
+
+// GetChangeRequest gets a change request
+// GET /admin/change-requests/:id
+func (h *AdminApprovalHandler) GetChangeRequest(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid change request ID")
+	if !ok {
+		return nil
+	}
+
+	cr, err := h.approvalService.Get(c.Context(), id)
+	if err != nil {
+		return approvalError(c, h.logger, err, "Failed to get change request")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    cr,
+	})
+}
+
+// ApproveChangeRequest approves a change proposed by another admin, applying it
+// POST /admin/change-requests/:id/approve
+func (h *AdminApprovalHandler) ApproveChangeRequest(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid change request ID")
+	if !ok {
+		return nil
+	}
+	var req dto.ReviewChangeRequestRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidRequest,
+				Message: "Invalid request body",
+			})
+		}
+	}
+
+	cr, err := h.approvalService.Approve(c.Context(), id, adminActor(c), req.Note)
+	if err != nil {
+		return approvalError(c, h.logger, err, "Failed to approve change request")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    cr,
+	})
+}
+
+// RejectChangeRequest rejects a pending change; the proposer rejecting it withdraws it
+// POST /admin/change-requests/:id/reject
+func (h *AdminApprovalHandler) RejectChangeRequest(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid change request ID")
+	if !ok {
+		return nil
+	}
+	var req dto.ReviewChangeRequestRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidRequest,
+				Message: "Invalid request body",
+			})
+		}
+	}
+
+	cr, err := h.approvalService.Reject(c.Context(), id, adminActor(c), req.Note)
+	if err != nil {
+		return approvalError(c, h.logger, err, "Failed to reject change request")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    cr,
+	})
+}
+
+// ProposeBalanceAdjustment proposes crediting or debiting a player's balance
+// The adjustment always waits for a second admin's approval.
+// POST /admin/players/:id/balance-adjustments
+func (h *AdminApprovalHandler) ProposeBalanceAdjustment(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+	var req dto.ProposeBalanceAdjustmentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	params := &approval.Params{PlayerID: &playerID, Amount: req.Amount, Reason: req.Reason}
+	return proposeChange(c, h.approvalService, h.logger, approval.ActionAdjustBalance, params)
+}
+
+// proposeChange records a change for a second admin's approval and responds 202 Accepted
+func proposeChange(c *fiber.Ctx, approvals approval.Service, log *logger.Logger, action approval.Action, params *approval.Params) error {
+	cr, err := approvals.Propose(c.Context(), action, params, adminActor(c))
+	if err != nil {
+		return approvalError(c, log, err, "Failed to propose change")
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"success": true,
+		"message": "Change proposed; it takes effect once another admin approves it",
+		"data":    cr,
+	})
+}
+
+// adminActor identifies the authenticated admin proposing or reviewing a change
+func adminActor(c *fiber.Ctx) approval.Actor {
+	if admin := getAdminFromContext(c); admin != nil {
+		return approval.Actor{ID: admin.ID, Username: admin.Username}
+	}
+	return approval.Actor{}
+}
+
+// approvalError maps approval service errors to responses
+func approvalError(c *fiber.Ctx, log *logger.Logger, err error, message string) error {
+	switch {
+	case errors.Is(err, approval.ErrChangeRequestNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeChangeRequestNotFound, Message: "Change request not found"})
+	case errors.Is(err, reelstrip.ErrConfigNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeConfigNotFound, Message: "Reel strip configuration not found"})
+	case errors.Is(err, game.ErrGameConfigNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeNotFound, Message: "Game config not found"})
+	case errors.Is(err, approval.ErrSelfApproval):
+		return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{Error: domainErrors.CodeSelfApproval, Message: err.Error()})
+	case errors.Is(err, approval.ErrNotPending):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeChangeRequestNotPending, Message: err.Error()})
+	case errors.Is(err, approval.ErrApplyFailed):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeChangeApplyFailed, Message: err.Error()})
+	case errors.Is(err, approval.ErrExpired):
+		return c.Status(fiber.StatusGone).JSON(dto.ErrorResponse{Error: domainErrors.CodeChangeRequestExpired, Message: err.Error()})
+	case errors.Is(err, approval.ErrInvalidAction),
+		errors.Is(err, approval.ErrInvalidParams),
+		errors.Is(err, reelstrip.ErrInvalidOperatorDefault),
+		errors.Is(err, reelstrip.ErrInvalidCanary),
+		errors.Is(err, reelstrip.ErrDemoConfig),
+		errors.Is(err, game.ErrInvalidMultiplierLadder),
+		errors.Is(err, game.ErrInvalidTriggerRules),
+		errors.Is(err, game.ErrInvalidWildFeatures):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeValidationError, Message: err.Error()})
+	}
+
+	log.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
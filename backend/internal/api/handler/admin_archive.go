@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/slotmachine/backend/domain/archive"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminArchiveHandler handles admin endpoints for archived spin partitions
+type AdminArchiveHandler struct {
+	archiveService archive.Service
+	logger         *logger.Logger
+}
+
+// NewAdminArchiveHandler creates a new admin archive handler
+func NewAdminArchiveHandler(archiveService archive.Service, log *logger.Logger) *AdminArchiveHandler {
+	return &AdminArchiveHandler{
+		archiveService: archiveService,
+		logger:         log,
+	}
+}
+
+// ListArchives lists archived partitions newest first, optionally for one table
+// GET /admin/archives?table=&page=&limit=
+func (h *AdminArchiveHandler) ListArchives(c *fiber.Ctx) error {
+	filters := archive.ListFilters{SourceTable: c.Query("table"), Page: 1, Limit: 20}
+	if page := c.Query("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil && p > 0 {
+			filters.Page = p
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 100 {
+			filters.Limit = l
+		}
+	}
+
+	archives, total, err := h.archiveService.ListArchives(c.Context(), filters)
+	if err != nil {
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to list archives")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeListFailed,
+			Message: "Failed to list archives",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"archives": archives,
+			"total":    total,
+			"page":     filters.Page,
+			"limit":    filters.Limit,
+		},
+	})
+}
+
+// GetArchive returns one archive record
+// GET /admin/archives/:id
+func (h *AdminArchiveHandler) GetArchive(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid archive ID")
+	if !ok {
+		return nil
+	}
+
+	a, err := h.archiveService.GetArchive(c.Context(), id)
+	if err != nil {
+		if errors.Is(err, archive.ErrArchiveNotFound) {
+			return archiveNotFound(c)
+		}
+		h.logger.WithTrace(c).Error().Err(err).Str("archive_id", id.String()).Msg("Failed to get archive")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to get archive",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    a,
+	})
+}
+
+// RestoreArchive loads an archived partition back into the database for an audit
+// POST /admin/archives/:id/restore
+func (h *AdminArchiveHandler) RestoreArchive(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	id, ok := parseUUIDParam(c, "id", "Invalid archive ID")
+	if !ok {
+		return nil
+	}
+
+	a, err := h.archiveService.Restore(c.Context(), id, adminUsername(c))
+	if err != nil {
+		switch {
+		case errors.Is(err, archive.ErrArchiveNotFound):
+			return archiveNotFound(c)
+		case errors.Is(err, archive.ErrAlreadyRestored):
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeArchiveAlreadyRestored,
+				Message: "Archive is already restored",
+			})
+		}
+		log.Error().Err(err).Str("archive_id", id.String()).Msg("Failed to restore archive")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToRestoreArchive,
+			Message: "Failed to restore archive",
+		})
+	}
+
+	log.Info().Str("partition", a.PartitionName).Str("admin", adminUsername(c)).Msg("Archive restored")
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    a,
+	})
+}
+
+// EvictArchive drops a restored partition from the database again
+// DELETE /admin/archives/:id/restore
+func (h *AdminArchiveHandler) EvictArchive(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	id, ok := parseUUIDParam(c, "id", "Invalid archive ID")
+	if !ok {
+		return nil
+	}
+
+	a, err := h.archiveService.Evict(c.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, archive.ErrArchiveNotFound):
+			return archiveNotFound(c)
+		case errors.Is(err, archive.ErrNotRestored):
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeArchiveNotRestored,
+				Message: "Archive is not restored",
+			})
+		}
+		log.Error().Err(err).Str("archive_id", id.String()).Msg("Failed to evict archive")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToEvictArchive,
+			Message: "Failed to evict archive",
+		})
+	}
+
+	log.Info().Str("partition", a.PartitionName).Str("admin", adminUsername(c)).Msg("Restored archive evicted")
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    a,
+	})
+}
+
+func archiveNotFound(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeArchiveNotFound,
+		Message: "Archive not found",
+	})
+}
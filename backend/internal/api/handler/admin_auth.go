@@ -1,8 +1,11 @@
 package handler
 
 import (
+	"strconv"
+
 	"github.com/gofiber/fiber/v2"
 	adminDomain "github.com/slotmachine/backend/domain/admin"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
@@ -33,19 +36,13 @@ func (h *AdminAuthHandler) Login(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		log.Warn().Err(err).Msg("Invalid request body")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
 
-	// Get client IP
-	ip := c.Get("x-real-ip")
-	if ip == "" {
-		ip = c.IP()
-	}
-
 	// Authenticate admin
-	admin, token, err := h.adminService.Login(c.Context(), req.Username, req.Password, ip)
+	admin, token, err := h.adminService.Login(c.Context(), req.Username, req.Password, adminClientIP(c))
 	if err != nil {
 		log.Warn().
 			Err(err).
@@ -56,27 +53,27 @@ func (h *AdminAuthHandler) Login(c *fiber.Ctx) error {
 		switch err {
 		case adminDomain.ErrInvalidCredentials:
 			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-				Error:   "invalid_credentials",
+				Error:   domainErrors.CodeInvalidCredentials,
 				Message: "Invalid username or password",
 			})
 		case adminDomain.ErrAccountLocked:
 			return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
-				Error:   "account_locked",
+				Error:   domainErrors.CodeAccountLocked,
 				Message: "Account is locked due to too many failed login attempts",
 			})
 		case adminDomain.ErrAccountInactive:
 			return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
-				Error:   "account_inactive",
+				Error:   domainErrors.CodeAccountInactive,
 				Message: "Account is inactive",
 			})
 		case adminDomain.ErrAccountSuspended:
 			return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
-				Error:   "account_suspended",
+				Error:   domainErrors.CodeAccountSuspended,
 				Message: "Account has been suspended",
 			})
 		default:
 			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-				Error:   "login_failed",
+				Error:   domainErrors.CodeLoginFailed,
 				Message: "Login failed. Please try again.",
 			})
 		}
@@ -105,7 +102,7 @@ func (h *AdminAuthHandler) GetProfile(c *fiber.Ctx) error {
 	admin, ok := c.Locals("admin").(*adminDomain.Admin)
 	if !ok || admin == nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "unauthorized",
+			Error:   domainErrors.CodeUnauthorized,
 			Message: "Admin authentication required",
 		})
 	}
@@ -125,7 +122,7 @@ func (h *AdminAuthHandler) ChangePassword(c *fiber.Ctx) error {
 	admin, ok := c.Locals("admin").(*adminDomain.Admin)
 	if !ok || admin == nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "unauthorized",
+			Error:   domainErrors.CodeUnauthorized,
 			Message: "Admin authentication required",
 		})
 	}
@@ -134,7 +131,7 @@ func (h *AdminAuthHandler) ChangePassword(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		log.Warn().Err(err).Msg("Invalid request body")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -144,18 +141,18 @@ func (h *AdminAuthHandler) ChangePassword(c *fiber.Ctx) error {
 		switch err {
 		case adminDomain.ErrInvalidPassword:
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "invalid_password",
+				Error:   domainErrors.CodeInvalidPassword,
 				Message: "Current password is incorrect",
 			})
 		case adminDomain.ErrWeakPassword:
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "weak_password",
+				Error:   domainErrors.CodeWeakPassword,
 				Message: "Password must be at least 8 characters long",
 			})
 		default:
 			log.Error().Err(err).Msg("Failed to change password")
 			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-				Error:   "failed_to_change_password",
+				Error:   domainErrors.CodeFailedToChangePassword,
 				Message: "Failed to change password",
 			})
 		}
@@ -181,5 +178,231 @@ func mapAdminToDetail(admin *adminDomain.Admin) *dto.AdminDetail {
 		Permissions: []string(admin.Permissions),
 		LastLoginAt: admin.LastLoginAt,
 		CreatedAt:   admin.CreatedAt,
+
+		TwoFactorEnabled: admin.TwoFactorEnabled,
+	}
+}
+
+// adminClientIP returns the admin's IP, preferring the proxy-set header
+func adminClientIP(c *fiber.Ctx) string {
+	if ip := c.Get("x-real-ip"); ip != "" {
+		return ip
+	}
+	return c.IP()
+}
+
+// GetTwoFactorStatus returns the current admin's two-factor state
+// GET /admin/auth/2fa
+func (h *AdminAuthHandler) GetTwoFactorStatus(c *fiber.Ctx) error {
+	admin, ok := c.Locals("admin").(*adminDomain.Admin)
+	if !ok || admin == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeUnauthorized,
+			Message: "Admin authentication required",
+		})
+	}
+
+	status, err := h.adminService.GetTwoFactorStatus(c.Context(), admin.ID)
+	if err != nil {
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to get two-factor status")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToGetAdmin,
+			Message: "Failed to get two-factor status",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": dto.TwoFactorStatusResponse{
+			Enabled:                status.Enabled,
+			EnabledAt:              status.EnabledAt,
+			VerifiedUntil:          status.VerifiedUntil,
+			RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+		},
+	})
+}
+
+// BeginTwoFactorEnrollment starts TOTP enrollment, returning the secret to add to an authenticator app
+// POST /admin/auth/2fa/enroll
+func (h *AdminAuthHandler) BeginTwoFactorEnrollment(c *fiber.Ctx) error {
+	admin, ok := c.Locals("admin").(*adminDomain.Admin)
+	if !ok || admin == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeUnauthorized,
+			Message: "Admin authentication required",
+		})
+	}
+
+	enrollment, err := h.adminService.BeginTwoFactorEnrollment(c.Context(), admin.ID, adminClientIP(c))
+	if err != nil {
+		return h.twoFactorError(c, err, domainErrors.CodeFailedToEnrollTwoFactor, "Failed to start two-factor enrollment")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": dto.TwoFactorEnrollmentResponse{
+			Secret:     enrollment.Secret,
+			OTPAuthURL: enrollment.OTPAuthURL,
+		},
+	})
+}
+
+// ConfirmTwoFactorEnrollment enables 2FA with a code from the enrolled app and returns recovery codes
+// POST /admin/auth/2fa/enroll/confirm
+func (h *AdminAuthHandler) ConfirmTwoFactorEnrollment(c *fiber.Ctx) error {
+	admin, req, ok, err := h.twoFactorRequest(c)
+	if !ok {
+		return err
+	}
+
+	codes, err := h.adminService.ConfirmTwoFactorEnrollment(c.Context(), admin.ID, req.Code, adminClientIP(c))
+	if err != nil {
+		return h.twoFactorError(c, err, domainErrors.CodeFailedToEnrollTwoFactor, "Failed to enable two-factor authentication")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    dto.RecoveryCodesResponse{RecoveryCodes: codes},
+	})
+}
+
+// VerifyTwoFactor checks a code and unlocks destructive operations for the step-up window
+// POST /admin/auth/2fa/verify
+func (h *AdminAuthHandler) VerifyTwoFactor(c *fiber.Ctx) error {
+	admin, req, ok, err := h.twoFactorRequest(c)
+	if !ok {
+		return err
+	}
+
+	until, err := h.adminService.VerifyTwoFactor(c.Context(), admin.ID, req.Code, adminClientIP(c), "step-up")
+	if err != nil {
+		return h.twoFactorError(c, err, domainErrors.CodeFailedToVerifyTwoFactor, "Failed to verify two-factor code")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    dto.TwoFactorVerifyResponse{VerifiedUntil: until},
+	})
+}
+
+// DisableTwoFactor turns 2FA off for the current admin
+// POST /admin/auth/2fa/disable
+func (h *AdminAuthHandler) DisableTwoFactor(c *fiber.Ctx) error {
+	admin, req, ok, err := h.twoFactorRequest(c)
+	if !ok {
+		return err
 	}
+
+	if err := h.adminService.DisableTwoFactor(c.Context(), admin.ID, req.Code, adminClientIP(c)); err != nil {
+		return h.twoFactorError(c, err, domainErrors.CodeFailedToDisableTwoFactor, "Failed to disable two-factor authentication")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Two-factor authentication disabled",
+	})
+}
+
+// RegenerateRecoveryCodes replaces the current admin's recovery codes
+// POST /admin/auth/2fa/recovery-codes
+func (h *AdminAuthHandler) RegenerateRecoveryCodes(c *fiber.Ctx) error {
+	admin, req, ok, err := h.twoFactorRequest(c)
+	if !ok {
+		return err
+	}
+
+	codes, err := h.adminService.RegenerateRecoveryCodes(c.Context(), admin.ID, req.Code, adminClientIP(c))
+	if err != nil {
+		return h.twoFactorError(c, err, domainErrors.CodeFailedToEnrollTwoFactor, "Failed to regenerate recovery codes")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    dto.RecoveryCodesResponse{RecoveryCodes: codes},
+	})
+}
+
+// ListTwoFactorEvents returns the current admin's two-factor audit log
+// GET /admin/auth/2fa/events?limit=50
+func (h *AdminAuthHandler) ListTwoFactorEvents(c *fiber.Ctx) error {
+	admin, ok := c.Locals("admin").(*adminDomain.Admin)
+	if !ok || admin == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeUnauthorized,
+			Message: "Admin authentication required",
+		})
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "50"))
+	events, err := h.adminService.ListTwoFactorEvents(c.Context(), admin.ID, limit)
+	if err != nil {
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to list two-factor events")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeListFailed,
+			Message: "Failed to list two-factor events",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    events,
+	})
+}
+
+// twoFactorRequest returns the authenticated admin and the parsed code
+// ok is false when a response has already been written.
+func (h *AdminAuthHandler) twoFactorRequest(c *fiber.Ctx) (*adminDomain.Admin, *dto.TwoFactorCodeRequest, bool, error) {
+	admin, ok := c.Locals("admin").(*adminDomain.Admin)
+	if !ok || admin == nil {
+		return nil, nil, false, c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeUnauthorized,
+			Message: "Admin authentication required",
+		})
+	}
+
+	var req dto.TwoFactorCodeRequest
+	if err := c.BodyParser(&req); err != nil || req.Code == "" {
+		return nil, nil, false, c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "A two-factor code is required",
+		})
+	}
+	return admin, &req, true, nil
+}
+
+// twoFactorError maps two-factor service errors to responses
+func (h *AdminAuthHandler) twoFactorError(c *fiber.Ctx, err error, fallback domainErrors.Code, message string) error {
+	switch err {
+	case adminDomain.ErrInvalidTwoFactorCode:
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidTwoFactorCode,
+			Message: "Invalid two-factor code",
+		})
+	case adminDomain.ErrTwoFactorAlreadyEnabled:
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeTwoFactorAlreadyEnabled,
+			Message: "Two-factor authentication is already enabled",
+		})
+	case adminDomain.ErrTwoFactorNotEnrolled:
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeTwoFactorNotEnrolled,
+			Message: "Start two-factor enrollment first",
+		})
+	case adminDomain.ErrTwoFactorNotEnabled:
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeTwoFactorNotEnabled,
+			Message: "Two-factor authentication is not enabled",
+		})
+	case adminDomain.ErrAccountLocked:
+		return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeAccountLocked,
+			Message: "Account is locked due to too many failed attempts",
+		})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   fallback,
+		Message: message,
+	})
 }
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/bigwin"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminBigWinHandler handles admin endpoints for recorded big wins
+type AdminBigWinHandler struct {
+	bigWinService bigwin.Service
+	logger        *logger.Logger
+}
+
+// NewAdminBigWinHandler creates a new admin big win handler
+func NewAdminBigWinHandler(bigWinService bigwin.Service, log *logger.Logger) *AdminBigWinHandler {
+	return &AdminBigWinHandler{
+		bigWinService: bigWinService,
+		logger:        log,
+	}
+}
+
+// ListBigWins lists big wins newest first, optionally for a single player
+// GET /admin/big-wins?player_id=&page=&limit=
+func (h *AdminBigWinHandler) ListBigWins(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	filters := bigwin.ListFilters{Page: 1, Limit: 20}
+	if page := c.Query("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil && p > 0 {
+			filters.Page = p
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 100 {
+			filters.Limit = l
+		}
+	}
+	if playerID := c.Query("player_id"); playerID != "" {
+		id, err := uuid.Parse(playerID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidPlayerID,
+				Message: "Invalid player ID format",
+			})
+		}
+		filters.PlayerID = &id
+	}
+
+	wins, total, err := h.bigWinService.ListBigWins(c.Context(), filters)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list big wins")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to list big wins",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"big_wins": wins,
+			"total":    total,
+			"page":     filters.Page,
+			"limit":    filters.Limit,
+		},
+	})
+}
+
+// GetBigWin gets a big win with its provably fair proof
+// GET /admin/big-wins/:id
+func (h *AdminBigWinHandler) GetBigWin(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid big win ID")
+	if !ok {
+		return nil
+	}
+
+	win, err := h.bigWinService.GetBigWin(c.Context(), id)
+	if err != nil {
+		if errors.Is(err, bigwin.ErrBigWinNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNotFound,
+				Message: "Big win not found",
+			})
+		}
+		h.logger.WithTrace(c).Error().Err(err).Str("big_win_id", id.String()).Msg("Failed to get big win")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to get big win",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    win,
+	})
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/slotmachine/backend/domain/certification"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminCertificationHandler handles admin endpoints for the certification event log
+type AdminCertificationHandler struct {
+	certService certification.Service
+	logger      *logger.Logger
+}
+
+// NewAdminCertificationHandler creates a new admin certification handler
+func NewAdminCertificationHandler(certService certification.Service, log *logger.Logger) *AdminCertificationHandler {
+	return &AdminCertificationHandler{
+		certService: certService,
+		logger:      log,
+	}
+}
+
+// ExportEvents downloads the certification events of a date range as newline-delimited JSON
+// Both dates are inclusive UTC days.
+// GET /admin/certification/events?from=YYYY-MM-DD&to=YYYY-MM-DD
+func (h *AdminCertificationHandler) ExportEvents(c *fiber.Ctx) error {
+	from, errFrom := time.Parse(statsDateLayout, c.Query("from"))
+	to, errTo := time.Parse(statsDateLayout, c.Query("to"))
+	if errFrom != nil || errTo != nil {
+		return h.invalidRange(c)
+	}
+
+	var buf bytes.Buffer
+	count, err := h.certService.Export(c.Context(), from, to.AddDate(0, 0, 1), &buf)
+	if err != nil {
+		if errors.Is(err, certification.ErrInvalidRange) {
+			return h.invalidRange(c)
+		}
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to export certification events")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to export certification events",
+		})
+	}
+
+	h.logger.WithTrace(c).Info().
+		Str("from", from.Format(statsDateLayout)).
+		Str("to", to.Format(statsDateLayout)).
+		Int("events", count).
+		Str("admin", adminUsername(c)).
+		Msg("Certification events exported")
+
+	c.Attachment(fmt.Sprintf("certification-events-%s-%s.ndjson", from.Format(statsDateLayout), to.Format(statsDateLayout)))
+	return c.Send(buf.Bytes())
+}
+
+func (h *AdminCertificationHandler) invalidRange(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInvalidRange,
+		Message: "Invalid date range: use from/to as YYYY-MM-DD, at most 31 days apart",
+	})
+}
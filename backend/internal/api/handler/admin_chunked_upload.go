@@ -17,11 +17,14 @@ import (
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/internal/api/dto"
 	infraCache "github.com/slotmachine/backend/internal/infra/cache"
 	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/clock"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/slotmachine/backend/internal/pkg/security"
+	"github.com/slotmachine/backend/internal/service"
 )
 
 // ChunkedUploadSession tracks an ongoing chunked upload
@@ -43,88 +46,57 @@ type ChunkedUploadSession struct {
 }
 
 // ProcessingStatus tracks the status of background file processing
-type ProcessingStatus struct {
-	UploadID    string          `json:"upload_id"`
-	Status      string          `json:"status"` // "processing", "completed", "failed"
-	Progress    int             `json:"progress"` // 0-100
-	Message     string          `json:"message,omitempty"`
-	Error       string          `json:"error,omitempty"`
-	Result      json.RawMessage `json:"result,omitempty"`
-	StartedAt   time.Time       `json:"started_at"`
-	CompletedAt *time.Time      `json:"completed_at,omitempty"`
-}
-
-// Redis key prefix for processing status
-const (
-	ProcessingStatusKeyPrefix = "chunked_upload_status:"
-	ProcessingStatusTTL       = 1 * time.Hour // TTL for processing status in Redis
-)
+type ProcessingStatus = infraCache.ProcessingStatus
 
 // MaxConcurrentUploads limits the number of concurrent upload sessions to prevent memory exhaustion
 const MaxConcurrentUploads = 100
 
 // AdminChunkedUploadHandler handles chunked file upload endpoints
 type AdminChunkedUploadHandler struct {
-	storage      storage.Storage
-	logger       *logger.Logger
-	validator    *security.FileValidator
-	redis        *infraCache.RedisClient
-	sessions     map[string]*ChunkedUploadSession
-	sessionMu    sync.RWMutex
-	processing   map[string]*ProcessingStatus // In-memory fallback when Redis unavailable
-	processingMu sync.RWMutex
-	tempBase     string
+	storage     storage.Storage
+	logger      *logger.Logger
+	validator   *security.FileValidator
+	statusStore *infraCache.ProcessingStatusStore
+	assetFiles  *service.AssetFileService
+	sessions    map[string]*ChunkedUploadSession
+	sessionMu   sync.RWMutex
+	tempBase    string
+	clock       clock.Clock
 }
 
 // NewAdminChunkedUploadHandler creates a new chunked upload handler
 func NewAdminChunkedUploadHandler(
 	s storage.Storage,
 	log *logger.Logger,
-	redis *infraCache.RedisClient,
+	statusStore *infraCache.ProcessingStatusStore,
+	assetFiles *service.AssetFileService,
+	clk clock.Clock,
 ) *AdminChunkedUploadHandler {
 	handler := &AdminChunkedUploadHandler{
-		storage:    s,
-		logger:     log,
-		validator:  security.NewFileValidator(nil),
-		redis:      redis,
-		sessions:   make(map[string]*ChunkedUploadSession),
-		processing: make(map[string]*ProcessingStatus),
-		tempBase:   os.TempDir(),
+		storage:     s,
+		logger:      log,
+		validator:   security.NewFileValidator(nil),
+		statusStore: statusStore,
+		assetFiles:  assetFiles,
+		sessions:    make(map[string]*ChunkedUploadSession),
+		tempBase:    os.TempDir(),
+		clock:       clk,
 	}
 
-	// Start cleanup goroutines
+	// Start cleanup goroutine
 	go handler.cleanupExpiredSessions()
-	go handler.cleanupCompletedProcessing()
 
 	return handler
 }
 
-// cleanupCompletedProcessing periodically removes completed processing statuses from in-memory fallback
-func (h *AdminChunkedUploadHandler) cleanupCompletedProcessing() {
-	ticker := time.NewTicker(10 * time.Minute)
-	defer ticker.Stop()
-
-	for range ticker.C {
-		h.processingMu.Lock()
-		now := time.Now()
-		for id, status := range h.processing {
-			// Remove completed/failed statuses older than 30 minutes
-			if status.CompletedAt != nil && now.Sub(*status.CompletedAt) > 30*time.Minute {
-				delete(h.processing, id)
-			}
-		}
-		h.processingMu.Unlock()
-	}
-}
-
 // cleanupExpiredSessions periodically removes expired upload sessions
 func (h *AdminChunkedUploadHandler) cleanupExpiredSessions() {
-	ticker := time.NewTicker(5 * time.Minute)
+	ticker := h.clock.NewTicker(5 * time.Minute)
 	defer ticker.Stop()
 
-	for range ticker.C {
+	for range ticker.C() {
 		h.sessionMu.Lock()
-		now := time.Now()
+		now := h.clock.Now()
 		for id, session := range h.sessions {
 			if now.After(session.ExpiresAt) {
 				// Clean up temp directory
@@ -150,7 +122,7 @@ func (h *AdminChunkedUploadHandler) InitChunkedUpload(c *fiber.Ctx) error {
 	if currentSessions >= MaxConcurrentUploads {
 		log.Warn().Int("current_sessions", currentSessions).Msg("Max concurrent uploads reached")
 		return c.Status(fiber.StatusTooManyRequests).JSON(dto.ErrorResponse{
-			Error:   "too_many_uploads",
+			Error:   domainErrors.CodeTooManyUploads,
 			Message: fmt.Sprintf("Maximum concurrent uploads (%d) reached. Please try again later.", MaxConcurrentUploads),
 		})
 	}
@@ -158,7 +130,7 @@ func (h *AdminChunkedUploadHandler) InitChunkedUpload(c *fiber.Ctx) error {
 	themeName := c.Params("theme")
 	if err := validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -174,7 +146,7 @@ func (h *AdminChunkedUploadHandler) InitChunkedUpload(c *fiber.Ctx) error {
 
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -182,7 +154,7 @@ func (h *AdminChunkedUploadHandler) InitChunkedUpload(c *fiber.Ctx) error {
 	// Validate file name
 	if req.FileName == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_file_name",
+			Error:   domainErrors.CodeInvalidFileName,
 			Message: "File name is required",
 		})
 	}
@@ -190,7 +162,7 @@ func (h *AdminChunkedUploadHandler) InitChunkedUpload(c *fiber.Ctx) error {
 	// Validate file type
 	if !h.validator.IsAllowedExtension(req.FileName) {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_file_type",
+			Error:   domainErrors.CodeInvalidFileType,
 			Message: "File type not allowed",
 		})
 	}
@@ -198,7 +170,7 @@ func (h *AdminChunkedUploadHandler) InitChunkedUpload(c *fiber.Ctx) error {
 	// Reject ZIP files - use folder upload via direct-upload endpoint instead
 	if h.validator.IsZipFile(req.FileName) {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "zip_not_supported",
+			Error:   domainErrors.CodeZipNotSupported,
 			Message: "ZIP files are not supported. Please use folder upload via direct-upload endpoint instead.",
 		})
 	}
@@ -207,7 +179,7 @@ func (h *AdminChunkedUploadHandler) InitChunkedUpload(c *fiber.Ctx) error {
 	maxChunkedSize := int64(1024 * 1024 * 1024) // 1GB
 	if req.TotalSize <= 0 || req.TotalSize > maxChunkedSize {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_size",
+			Error:   domainErrors.CodeInvalidSize,
 			Message: fmt.Sprintf("File size must be between 1 byte and %d bytes", maxChunkedSize),
 		})
 	}
@@ -217,7 +189,7 @@ func (h *AdminChunkedUploadHandler) InitChunkedUpload(c *fiber.Ctx) error {
 	maxChunkSize := int64(50 * 1024 * 1024)  // 50MB
 	if req.ChunkSize < minChunkSize || req.ChunkSize > maxChunkSize {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_chunk_size",
+			Error:   domainErrors.CodeInvalidChunkSize,
 			Message: fmt.Sprintf("Chunk size must be between %d and %d bytes", minChunkSize, maxChunkSize),
 		})
 	}
@@ -226,14 +198,14 @@ func (h *AdminChunkedUploadHandler) InitChunkedUpload(c *fiber.Ctx) error {
 	totalChunks := int((req.TotalSize + req.ChunkSize - 1) / req.ChunkSize)
 
 	// Generate unique upload ID
-	uploadID := generateUploadID(themeName, req.FileName)
+	uploadID := generateUploadID(themeName, req.FileName, h.clock.Now())
 
 	// Create temp directory for chunks
 	tempDir := filepath.Join(h.tempBase, "chunked_uploads", uploadID)
 	if err := os.MkdirAll(tempDir, 0755); err != nil {
 		log.Error().Err(err).Msg("Failed to create temp directory")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "init_failed",
+			Error:   domainErrors.CodeInitFailed,
 			Message: "Failed to initialize upload",
 		})
 	}
@@ -249,8 +221,8 @@ func (h *AdminChunkedUploadHandler) InitChunkedUpload(c *fiber.Ctx) error {
 		UploadedChunks: make(map[int]bool),
 		ChunkChecksums: make(map[int]string),
 		TempDir:        tempDir,
-		CreatedAt:      time.Now(),
-		ExpiresAt:      time.Now().Add(2 * time.Hour), // 2 hour expiry
+		CreatedAt:      h.clock.Now(),
+		ExpiresAt:      h.clock.Now().Add(2 * time.Hour), // 2 hour expiry
 		CustomPath:     req.CustomPath,
 		FileChecksum:   req.FileChecksum,
 	}
@@ -288,7 +260,7 @@ func (h *AdminChunkedUploadHandler) UploadChunk(c *fiber.Ctx) error {
 
 	if err := validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -300,7 +272,7 @@ func (h *AdminChunkedUploadHandler) UploadChunk(c *fiber.Ctx) error {
 
 	if !exists {
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-			Error:   "session_not_found",
+			Error:   domainErrors.CodeSessionNotFound,
 			Message: "Upload session not found or expired",
 		})
 	}
@@ -308,19 +280,19 @@ func (h *AdminChunkedUploadHandler) UploadChunk(c *fiber.Ctx) error {
 	// Verify theme matches
 	if session.ThemeName != themeName {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "theme_mismatch",
+			Error:   domainErrors.CodeThemeMismatch,
 			Message: "Theme name does not match upload session",
 		})
 	}
 
 	// Check if session expired
-	if time.Now().After(session.ExpiresAt) {
+	if h.clock.Now().After(session.ExpiresAt) {
 		h.sessionMu.Lock()
 		delete(h.sessions, uploadID)
 		h.sessionMu.Unlock()
 		os.RemoveAll(session.TempDir)
 		return c.Status(fiber.StatusGone).JSON(dto.ErrorResponse{
-			Error:   "session_expired",
+			Error:   domainErrors.CodeSessionExpired,
 			Message: "Upload session has expired",
 		})
 	}
@@ -330,7 +302,7 @@ func (h *AdminChunkedUploadHandler) UploadChunk(c *fiber.Ctx) error {
 	chunkIndex, err := strconv.Atoi(chunkIndexStr)
 	if err != nil || chunkIndex < 0 || chunkIndex >= session.TotalChunks {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_chunk_index",
+			Error:   domainErrors.CodeInvalidChunkIndex,
 			Message: fmt.Sprintf("Chunk index must be between 0 and %d", session.TotalChunks-1),
 		})
 	}
@@ -342,7 +314,7 @@ func (h *AdminChunkedUploadHandler) UploadChunk(c *fiber.Ctx) error {
 	file, err := c.FormFile("chunk")
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_chunk",
+			Error:   domainErrors.CodeInvalidChunk,
 			Message: "Chunk data is required",
 		})
 	}
@@ -356,7 +328,7 @@ func (h *AdminChunkedUploadHandler) UploadChunk(c *fiber.Ctx) error {
 
 	if file.Size > expectedSize {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "chunk_too_large",
+			Error:   domainErrors.CodeChunkTooLarge,
 			Message: "Chunk size exceeds expected size",
 		})
 	}
@@ -365,7 +337,7 @@ func (h *AdminChunkedUploadHandler) UploadChunk(c *fiber.Ctx) error {
 	src, err := file.Open()
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "chunk_read_failed",
+			Error:   domainErrors.CodeChunkReadFailed,
 			Message: "Failed to read chunk data",
 		})
 	}
@@ -377,7 +349,7 @@ func (h *AdminChunkedUploadHandler) UploadChunk(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Int("chunk_index", chunkIndex).Msg("Failed to create chunk file")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "chunk_write_failed",
+			Error:   domainErrors.CodeChunkWriteFailed,
 			Message: "Failed to save chunk",
 		})
 	}
@@ -394,7 +366,7 @@ func (h *AdminChunkedUploadHandler) UploadChunk(c *fiber.Ctx) error {
 		os.Remove(chunkPath) // Clean up on error
 		log.Error().Err(err).Int("chunk_index", chunkIndex).Msg("Failed to write chunk")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "chunk_write_failed",
+			Error:   domainErrors.CodeChunkWriteFailed,
 			Message: "Failed to save chunk",
 		})
 	}
@@ -404,7 +376,7 @@ func (h *AdminChunkedUploadHandler) UploadChunk(c *fiber.Ctx) error {
 		os.Remove(chunkPath)
 		log.Error().Int64("expected", file.Size).Int64("written", written).Msg("Chunk size mismatch")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "chunk_write_failed",
+			Error:   domainErrors.CodeChunkWriteFailed,
 			Message: "Chunk data was corrupted during transfer",
 		})
 	}
@@ -421,7 +393,7 @@ func (h *AdminChunkedUploadHandler) UploadChunk(c *fiber.Ctx) error {
 			Str("calculated", calculatedChecksum).
 			Msg("Chunk checksum mismatch")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "checksum_mismatch",
+			Error:   domainErrors.CodeChecksumMismatch,
 			Message: "Chunk data integrity check failed",
 		})
 	}
@@ -463,7 +435,7 @@ func (h *AdminChunkedUploadHandler) CompleteChunkedUpload(c *fiber.Ctx) error {
 
 	if err := validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -478,7 +450,7 @@ func (h *AdminChunkedUploadHandler) CompleteChunkedUpload(c *fiber.Ctx) error {
 
 	if !exists {
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-			Error:   "session_not_found",
+			Error:   domainErrors.CodeSessionNotFound,
 			Message: "Upload session not found or expired",
 		})
 	}
@@ -488,7 +460,7 @@ func (h *AdminChunkedUploadHandler) CompleteChunkedUpload(c *fiber.Ctx) error {
 		// Clean up temp dir since we're rejecting
 		os.RemoveAll(session.TempDir)
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "theme_mismatch",
+			Error:   domainErrors.CodeThemeMismatch,
 			Message: "Theme name does not match upload session",
 		})
 	}
@@ -502,7 +474,7 @@ func (h *AdminChunkedUploadHandler) CompleteChunkedUpload(c *fiber.Ctx) error {
 		// Clean up temp dir since we're rejecting
 		os.RemoveAll(session.TempDir)
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "incomplete_upload",
+			Error:   domainErrors.CodeIncompleteUpload,
 			Message: fmt.Sprintf("Only %d of %d chunks uploaded", uploadedCount, session.TotalChunks),
 		})
 	}
@@ -510,13 +482,13 @@ func (h *AdminChunkedUploadHandler) CompleteChunkedUpload(c *fiber.Ctx) error {
 	// Create processing status and save to Redis
 	status := &ProcessingStatus{
 		UploadID:  uploadID,
-		Status:    "processing",
+		Status:    infraCache.ProcessingStatusProcessing,
 		Progress:  0,
 		Message:   "Assembling file chunks...",
-		StartedAt: time.Now(),
+		StartedAt: h.clock.Now(),
 	}
 
-	if err := h.saveProcessingStatus(c.Context(), status); err != nil {
+	if err := h.statusStore.Save(c.Context(), status); err != nil {
 		log.Error().Err(err).Msg("Failed to save processing status to Redis")
 		// Continue anyway - processing will still work, just status polling might fail
 	}
@@ -535,23 +507,24 @@ func (h *AdminChunkedUploadHandler) CompleteChunkedUpload(c *fiber.Ctx) error {
 	})
 }
 
-// GetProcessingStatus returns the current status of a background upload processing
+// GetProcessingStatus returns the current status of a background processing job
+// (chunked upload assembly or asset image optimization)
 // GET /admin/upload/status/:uploadId
 func (h *AdminChunkedUploadHandler) GetProcessingStatus(c *fiber.Ctx) error {
 	uploadID := c.Params("uploadId")
 
-	status, err := h.getProcessingStatus(c.Context(), uploadID)
+	status, err := h.statusStore.Get(c.Context(), uploadID)
 	if err != nil {
-		h.logger.Error().Err(err).Str("upload_id", uploadID).Msg("Failed to get processing status from Redis")
+		h.logger.Error().Err(err).Str("upload_id", uploadID).Msg("Failed to get processing status")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "status_error",
+			Error:   domainErrors.CodeStatusError,
 			Message: "Failed to retrieve processing status",
 		})
 	}
 
 	if status == nil {
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-			Error:   "status_not_found",
+			Error:   domainErrors.CodeStatusNotFound,
 			Message: "Processing status not found. Upload may have completed and been cleaned up.",
 		})
 	}
@@ -579,78 +552,6 @@ func (h *AdminChunkedUploadHandler) GetProcessingStatus(c *fiber.Ctx) error {
 	})
 }
 
-// saveProcessingStatus saves processing status to Redis (with in-memory fallback)
-func (h *AdminChunkedUploadHandler) saveProcessingStatus(ctx context.Context, status *ProcessingStatus) error {
-	// Try Redis first
-	if h.redis != nil {
-		data, err := json.Marshal(status)
-		if err != nil {
-			return fmt.Errorf("failed to marshal status: %w", err)
-		}
-		key := ProcessingStatusKeyPrefix + status.UploadID
-		if err := h.redis.Set(ctx, key, string(data), ProcessingStatusTTL); err == nil {
-			return nil
-		}
-		// Fall through to in-memory if Redis fails
-	}
-
-	// Fallback to in-memory storage
-	h.processingMu.Lock()
-	// Deep copy the status to avoid race conditions
-	statusCopy := *status
-	h.processing[status.UploadID] = &statusCopy
-	h.processingMu.Unlock()
-	return nil
-}
-
-// getProcessingStatus retrieves processing status from Redis (with in-memory fallback)
-func (h *AdminChunkedUploadHandler) getProcessingStatus(ctx context.Context, uploadID string) (*ProcessingStatus, error) {
-	// Try Redis first
-	if h.redis != nil {
-		key := ProcessingStatusKeyPrefix + uploadID
-		data, err := h.redis.Get(ctx, key)
-		if err == nil && data != "" {
-			var status ProcessingStatus
-			if err := json.Unmarshal([]byte(data), &status); err == nil {
-				return &status, nil
-			}
-		}
-		// Fall through to in-memory if Redis fails or not found
-	}
-
-	// Fallback to in-memory storage
-	h.processingMu.RLock()
-	status, exists := h.processing[uploadID]
-	h.processingMu.RUnlock()
-
-	if !exists {
-		return nil, nil
-	}
-
-	// Return a copy to avoid race conditions
-	statusCopy := *status
-	return &statusCopy, nil
-}
-
-// updateProcessingStatus updates processing status in Redis (with in-memory fallback)
-func (h *AdminChunkedUploadHandler) updateProcessingStatus(ctx context.Context, uploadID string, updateFn func(*ProcessingStatus)) error {
-	status, err := h.getProcessingStatus(ctx, uploadID)
-	if err != nil {
-		return err
-	}
-	if status == nil {
-		status = &ProcessingStatus{
-			UploadID:  uploadID,
-			Status:    "processing",
-			StartedAt: time.Now(),
-		}
-	}
-
-	updateFn(status)
-
-	return h.saveProcessingStatus(ctx, status)
-}
-
 // processUploadInBackground handles the actual file assembly and upload
 func (h *AdminChunkedUploadHandler) processUploadInBackground(
 	session *ChunkedUploadSession,
@@ -664,7 +565,7 @@ func (h *AdminChunkedUploadHandler) processUploadInBackground(
 	defer os.RemoveAll(session.TempDir)
 
 	updateStatus := func(progress int, message string) {
-		if err := h.updateProcessingStatus(ctx, uploadID, func(s *ProcessingStatus) {
+		if err := h.statusStore.Update(ctx, uploadID, func(s *ProcessingStatus) {
 			s.Progress = progress
 			s.Message = message
 		}); err != nil {
@@ -673,9 +574,9 @@ func (h *AdminChunkedUploadHandler) processUploadInBackground(
 	}
 
 	failWithError := func(errMsg string) {
-		now := time.Now()
-		if err := h.updateProcessingStatus(ctx, uploadID, func(s *ProcessingStatus) {
-			s.Status = "failed"
+		now := h.clock.Now()
+		if err := h.statusStore.Update(ctx, uploadID, func(s *ProcessingStatus) {
+			s.Status = infraCache.ProcessingStatusFailed
 			s.Error = errMsg
 			s.CompletedAt = &now
 		}); err != nil {
@@ -684,14 +585,14 @@ func (h *AdminChunkedUploadHandler) processUploadInBackground(
 	}
 
 	completeWithResult := func(result interface{}) {
-		now := time.Now()
+		now := h.clock.Now()
 		resultJSON, err := json.Marshal(result)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to marshal result")
 			resultJSON = []byte("{}")
 		}
-		if err := h.updateProcessingStatus(ctx, uploadID, func(s *ProcessingStatus) {
-			s.Status = "completed"
+		if err := h.statusStore.Update(ctx, uploadID, func(s *ProcessingStatus) {
+			s.Status = infraCache.ProcessingStatusCompleted
 			s.Progress = 100
 			s.Message = "Upload completed successfully"
 			s.Result = resultJSON
@@ -833,6 +734,10 @@ func (h *AdminChunkedUploadHandler) processFileUploadBackground(
 		return nil, fmt.Errorf("failed to upload file to storage")
 	}
 
+	if err := h.assetFiles.Record(context.Background(), session.ThemeName, objectName, calculatedFileChecksum, session.TotalSize); err != nil {
+		log.Warn().Err(err).Msg("Failed to record file hash")
+	}
+
 	updateStatus(100, "Upload completed")
 
 	log.Info().
@@ -858,7 +763,7 @@ func (h *AdminChunkedUploadHandler) GetUploadStatus(c *fiber.Ctx) error {
 
 	if err := validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -869,7 +774,7 @@ func (h *AdminChunkedUploadHandler) GetUploadStatus(c *fiber.Ctx) error {
 
 	if !exists {
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-			Error:   "session_not_found",
+			Error:   domainErrors.CodeSessionNotFound,
 			Message: "Upload session not found or expired",
 		})
 	}
@@ -907,7 +812,7 @@ func (h *AdminChunkedUploadHandler) AbortChunkedUpload(c *fiber.Ctx) error {
 
 	if err := validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -921,7 +826,7 @@ func (h *AdminChunkedUploadHandler) AbortChunkedUpload(c *fiber.Ctx) error {
 
 	if !exists {
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-			Error:   "session_not_found",
+			Error:   domainErrors.CodeSessionNotFound,
 			Message: "Upload session not found or expired",
 		})
 	}
@@ -938,8 +843,8 @@ func (h *AdminChunkedUploadHandler) AbortChunkedUpload(c *fiber.Ctx) error {
 }
 
 // generateUploadID creates a unique upload ID
-func generateUploadID(themeName, fileName string) string {
-	data := fmt.Sprintf("%s_%s_%d_%d", themeName, fileName, time.Now().UnixNano(), time.Now().Unix())
+func generateUploadID(themeName, fileName string, now time.Time) string {
+	data := fmt.Sprintf("%s_%s_%d_%d", themeName, fileName, now.UnixNano(), now.Unix())
 	hash := sha256.Sum256([]byte(data))
 	return hex.EncodeToString(hash[:16])
 }
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/slotmachine/backend/domain/dashboard"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminDashboardHandler handles the admin ops dashboard endpoint
+type AdminDashboardHandler struct {
+	dashboardService dashboard.Service
+	logger           *logger.Logger
+}
+
+// NewAdminDashboardHandler creates a new admin dashboard handler
+func NewAdminDashboardHandler(dashboardService dashboard.Service, log *logger.Logger) *AdminDashboardHandler {
+	return &AdminDashboardHandler{
+		dashboardService: dashboardService,
+		logger:           log,
+	}
+}
+
+// GetDashboard returns the live metrics of the ops home screen
+// GET /admin/dashboard
+func (h *AdminDashboardHandler) GetDashboard(c *fiber.Ctx) error {
+	metrics, err := h.dashboardService.Metrics(c.Context())
+	if err != nil {
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to compute dashboard metrics")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to compute dashboard metrics",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    metrics,
+	})
+}
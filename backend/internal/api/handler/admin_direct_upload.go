@@ -7,28 +7,33 @@ import (
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/infra/storage"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/slotmachine/backend/internal/pkg/security"
+	"github.com/slotmachine/backend/internal/service"
 )
 
 // AdminDirectUploadHandler handles direct upload endpoints (presigned URL based)
 type AdminDirectUploadHandler struct {
-	storage   storage.Storage
-	logger    *logger.Logger
-	validator *security.FileValidator
+	storage    storage.Storage
+	assetFiles *service.AssetFileService
+	logger     *logger.Logger
+	validator  *security.FileValidator
 }
 
 // NewAdminDirectUploadHandler creates a new direct upload handler
 func NewAdminDirectUploadHandler(
 	s storage.Storage,
+	assetFiles *service.AssetFileService,
 	log *logger.Logger,
 ) *AdminDirectUploadHandler {
 	return &AdminDirectUploadHandler{
-		storage:   s,
-		logger:    log,
-		validator: security.NewFileValidator(nil),
+		storage:    s,
+		assetFiles: assetFiles,
+		logger:     log,
+		validator:  security.NewFileValidator(nil),
 	}
 }
 
@@ -83,7 +88,7 @@ func (h *AdminDirectUploadHandler) GeneratePresignedURL(c *fiber.Ctx) error {
 	themeName := c.Params("theme")
 	if err := h.validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -91,14 +96,14 @@ func (h *AdminDirectUploadHandler) GeneratePresignedURL(c *fiber.Ctx) error {
 	var req PresignedURLRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
 
 	if req.FileName == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "file_name is required",
 		})
 	}
@@ -106,14 +111,14 @@ func (h *AdminDirectUploadHandler) GeneratePresignedURL(c *fiber.Ctx) error {
 	// Validate file type (no ZIP files allowed - use folder upload instead)
 	if h.validator.IsZipFile(req.FileName) {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "zip_not_supported",
+			Error:   domainErrors.CodeZipNotSupported,
 			Message: "ZIP files are not supported. Please use folder upload instead.",
 		})
 	}
 
 	if !h.validator.IsAllowedExtension(req.FileName) {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_file_type",
+			Error:   domainErrors.CodeInvalidFileType,
 			Message: "File type not allowed",
 		})
 	}
@@ -129,7 +134,7 @@ func (h *AdminDirectUploadHandler) GeneratePresignedURL(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Str("theme", themeName).Str("file", fileName).Msg("Failed to generate presigned URL")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "presign_failed",
+			Error:   domainErrors.CodePresignFailed,
 			Message: "Failed to generate upload URL",
 		})
 	}
@@ -159,7 +164,7 @@ func (h *AdminDirectUploadHandler) GenerateBatchPresignedURLs(c *fiber.Ctx) erro
 	themeName := c.Params("theme")
 	if err := h.validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -167,14 +172,14 @@ func (h *AdminDirectUploadHandler) GenerateBatchPresignedURLs(c *fiber.Ctx) erro
 	var req BatchPresignedURLRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
 
 	if len(req.Files) == 0 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "files array is required and must not be empty",
 		})
 	}
@@ -182,7 +187,7 @@ func (h *AdminDirectUploadHandler) GenerateBatchPresignedURLs(c *fiber.Ctx) erro
 	// Limit batch size
 	if len(req.Files) > 100 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "batch_too_large",
+			Error:   domainErrors.CodeBatchTooLarge,
 			Message: "Maximum 100 files per batch",
 		})
 	}
@@ -288,7 +293,7 @@ func (h *AdminDirectUploadHandler) ConfirmUpload(c *fiber.Ctx) error {
 	themeName := c.Params("theme")
 	if err := h.validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -296,14 +301,14 @@ func (h *AdminDirectUploadHandler) ConfirmUpload(c *fiber.Ctx) error {
 	var req ConfirmUploadRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
 
 	if req.FileName == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "file_name is required",
 		})
 	}
@@ -319,18 +324,23 @@ func (h *AdminDirectUploadHandler) ConfirmUpload(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Str("theme", themeName).Str("file", fileName).Msg("Failed to check file existence")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "verification_failed",
+			Error:   domainErrors.CodeVerificationFailed,
 			Message: "Failed to verify upload",
 		})
 	}
 
 	if !exists {
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-			Error:   "file_not_found",
+			Error:   domainErrors.CodeFileNotFound,
 			Message: "File was not uploaded or upload failed",
 		})
 	}
 
+	// The bytes never passed through the server, so hash them from storage
+	if err := h.assetFiles.RecordFromStorage(c.Context(), themeName, fileName); err != nil {
+		log.Warn().Err(err).Str("theme", themeName).Str("file", fileName).Msg("Failed to record file hash")
+	}
+
 	publicURL := h.storage.GetPublicURL(themeName, fileName)
 
 	log.Info().Str("theme", themeName).Str("file", fileName).Msg("Confirmed direct upload")
@@ -354,7 +364,7 @@ func (h *AdminDirectUploadHandler) ConfirmBatchUpload(c *fiber.Ctx) error {
 	themeName := c.Params("theme")
 	if err := h.validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -362,14 +372,14 @@ func (h *AdminDirectUploadHandler) ConfirmBatchUpload(c *fiber.Ctx) error {
 	var req BatchConfirmUploadRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
 
 	if len(req.Files) == 0 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "files array is required",
 		})
 	}
@@ -412,6 +422,10 @@ func (h *AdminDirectUploadHandler) ConfirmBatchUpload(c *fiber.Ctx) error {
 			continue
 		}
 
+		if err := h.assetFiles.RecordFromStorage(c.Context(), themeName, fileName); err != nil {
+			log.Warn().Err(err).Str("file", fileName).Msg("Failed to record file hash")
+		}
+
 		publicURL := h.storage.GetPublicURL(themeName, fileName)
 		confirmed = append(confirmed, fiber.Map{
 			"file_name":  file.FileName,
@@ -0,0 +1,264 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/dispute"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminDisputeHandler handles admin endpoints for spin disputes
+type AdminDisputeHandler struct {
+	disputeService dispute.Service
+	logger         *logger.Logger
+}
+
+// NewAdminDisputeHandler creates a new admin dispute handler
+func NewAdminDisputeHandler(disputeService dispute.Service, log *logger.Logger) *AdminDisputeHandler {
+	return &AdminDisputeHandler{
+		disputeService: disputeService,
+		logger:         log,
+	}
+}
+
+// ListDisputes lists disputes newest first
+// GET /admin/disputes?status=&player_id=&spin_id=&assigned_to=&page=&limit=
+func (h *AdminDisputeHandler) ListDisputes(c *fiber.Ctx) error {
+	filters := dispute.ListFilters{Page: 1, Limit: 20}
+	if page := c.Query("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil && p > 0 {
+			filters.Page = p
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 100 {
+			filters.Limit = l
+		}
+	}
+	if status := c.Query("status"); status != "" {
+		filters.Status = dispute.Status(status)
+		if !filters.Status.Valid() {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidParams,
+				Message: "Invalid status parameter",
+			})
+		}
+	}
+	for param, target := range map[string]**uuid.UUID{
+		"player_id":   &filters.PlayerID,
+		"spin_id":     &filters.SpinID,
+		"assigned_to": &filters.AssignedTo,
+	} {
+		if v := c.Query(param); v != "" {
+			id, err := uuid.Parse(v)
+			if err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+					Error:   domainErrors.CodeInvalidParams,
+					Message: "Invalid " + param + " parameter",
+				})
+			}
+			*target = &id
+		}
+	}
+
+	disputes, total, err := h.disputeService.List(c.Context(), filters)
+	if err != nil {
+		return h.disputeError(c, err, "Failed to list disputes")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"disputes": disputes,
+			"total":    total,
+			"page":     filters.Page,
+			"limit":    filters.Limit,
+		},
+	})
+}
+
+// OpenDispute opens a dispute on a spin
+// POST /admin/disputes
+func (h *AdminDisputeHandler) OpenDispute(c *fiber.Ctx) error {
+	var req dto.OpenDisputeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+	spinID, err := uuid.Parse(req.SpinID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeValidationError,
+			Message: "Invalid spin_id",
+		})
+	}
+
+	d, err := h.disputeService.Open(c.Context(), spinID, req.Reason, adminUsername(c))
+	if err != nil {
+		return h.disputeError(c, err, "Failed to open dispute")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    d,
+	})
+}
+
+// GetDispute gets a dispute with its history
+// GET /admin/disputes/:id
+func (h *AdminDisputeHandler) GetDispute(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid dispute ID")
+	if !ok {
+		return nil
+	}
+
+	d, events, err := h.disputeService.Get(c.Context(), id)
+	if err != nil {
+		return h.disputeError(c, err, "Failed to get dispute")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    dto.DisputeResponse{Dispute: d, Events: events},
+	})
+}
+
+// AssignDispute assigns a dispute to an admin
+// POST /admin/disputes/:id/assign
+func (h *AdminDisputeHandler) AssignDispute(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid dispute ID")
+	if !ok {
+		return nil
+	}
+	var req dto.AssignDisputeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+	adminID, err := uuid.Parse(req.AdminID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeValidationError,
+			Message: "Invalid admin_id",
+		})
+	}
+
+	d, err := h.disputeService.Assign(c.Context(), id, adminID, adminUsername(c))
+	if err != nil {
+		return h.disputeError(c, err, "Failed to assign dispute")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    d,
+	})
+}
+
+// VerifyDispute replays the disputed spin and attaches the verification output
+// POST /admin/disputes/:id/verify
+func (h *AdminDisputeHandler) VerifyDispute(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid dispute ID")
+	if !ok {
+		return nil
+	}
+
+	d, err := h.disputeService.Verify(c.Context(), id, adminUsername(c))
+	if err != nil {
+		return h.disputeError(c, err, "Failed to verify disputed spin")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    d,
+	})
+}
+
+// AddDisputeNote adds a support note to a dispute
+// POST /admin/disputes/:id/notes
+func (h *AdminDisputeHandler) AddDisputeNote(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid dispute ID")
+	if !ok {
+		return nil
+	}
+	var req dto.DisputeNoteRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	event, err := h.disputeService.AddNote(c.Context(), id, req.Note, adminUsername(c))
+	if err != nil {
+		return h.disputeError(c, err, "Failed to add dispute note")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    event,
+	})
+}
+
+// CloseDispute resolves or rejects a dispute
+// POST /admin/disputes/:id/close
+func (h *AdminDisputeHandler) CloseDispute(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid dispute ID")
+	if !ok {
+		return nil
+	}
+	var req dto.CloseDisputeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	d, err := h.disputeService.Close(c.Context(), id, req.Status, req.Notes, adminUsername(c))
+	if err != nil {
+		return h.disputeError(c, err, "Failed to close dispute")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    d,
+	})
+}
+
+// disputeError maps dispute service errors to responses
+func (h *AdminDisputeHandler) disputeError(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, dispute.ErrDisputeNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeDisputeNotFound, Message: "Dispute not found"})
+	case errors.Is(err, spin.ErrSpinNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeSpinNotFound, Message: "Spin not found"})
+	case errors.Is(err, provablyfair.ErrSpinNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeSpinLogNotFound, Message: "Spin was not played in a provably fair session"})
+	case errors.Is(err, dispute.ErrDisputeExists):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeDisputeExists, Message: err.Error()})
+	case errors.Is(err, dispute.ErrDisputeClosed):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeDisputeClosed, Message: err.Error()})
+	case errors.Is(err, dispute.ErrInvalidTransition),
+		errors.Is(err, dispute.ErrReasonRequired),
+		errors.Is(err, dispute.ErrNoteRequired),
+		errors.Is(err, dispute.ErrResolutionNotesRequired):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeValidationError, Message: err.Error()})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/exposure"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminExposureHandler handles admin endpoints for open liability
+type AdminExposureHandler struct {
+	exposureService exposure.Service
+	logger          *logger.Logger
+}
+
+// NewAdminExposureHandler creates a new admin exposure handler
+func NewAdminExposureHandler(exposureService exposure.Service, log *logger.Logger) *AdminExposureHandler {
+	return &AdminExposureHandler{
+		exposureService: exposureService,
+		logger:          log,
+	}
+}
+
+// GetExposure measures the open liability now, with its limits and whether bonus buys are paused
+// GET /admin/exposure
+func (h *AdminExposureHandler) GetExposure(c *fiber.Ctx) error {
+	exp, err := h.exposureService.Refresh(c.Context())
+	if err != nil {
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to measure open liability")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to measure open liability",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    exp,
+	})
+}
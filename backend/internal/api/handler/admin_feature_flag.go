@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/featureflags"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminFeatureFlagHandler handles feature flag rollouts
+type AdminFeatureFlagHandler struct {
+	flags  *featureflags.Service
+	logger *logger.Logger
+}
+
+// NewAdminFeatureFlagHandler creates a new admin feature flag handler
+func NewAdminFeatureFlagHandler(flags *featureflags.Service, log *logger.Logger) *AdminFeatureFlagHandler {
+	return &AdminFeatureFlagHandler{
+		flags:  flags,
+		logger: log,
+	}
+}
+
+// ListFlags lists every known flag with admin overrides applied
+// GET /admin/feature-flags
+func (h *AdminFeatureFlagHandler) ListFlags(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    h.flags.List(c.Context()),
+	})
+}
+
+// GetFlag returns a flag with its admin override applied
+// GET /admin/feature-flags/:name
+func (h *AdminFeatureFlagHandler) GetFlag(c *fiber.Ctx) error {
+	flag, err := h.flags.Get(c.Context(), c.Params("name"))
+	if err != nil {
+		return h.flagError(c, err, "Failed to get feature flag")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    flag,
+	})
+}
+
+// SetFlag overrides a flag's rollout on every server
+// PUT /admin/feature-flags/:name
+func (h *AdminFeatureFlagHandler) SetFlag(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.SetFeatureFlagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	flag, err := h.flags.SetOverride(c.Context(), featureflags.Flag{
+		Name:       c.Params("name"),
+		Enabled:    req.Enabled,
+		Percentage: req.Percentage,
+		Segments:   req.Segments,
+	}, adminUsername(c))
+	if err != nil {
+		return h.flagError(c, err, "Failed to set feature flag")
+	}
+
+	log.Info().
+		Str("flag", flag.Name).
+		Bool("enabled", flag.Enabled).
+		Int("percentage", flag.Percentage).
+		Int("segments", len(flag.Segments)).
+		Msg("Feature flag overridden")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    flag,
+	})
+}
+
+// ResetFlag drops a flag's override, restoring its configured default
+// DELETE /admin/feature-flags/:name
+func (h *AdminFeatureFlagHandler) ResetFlag(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	flag, err := h.flags.ClearOverride(c.Context(), c.Params("name"))
+	if err != nil {
+		return h.flagError(c, err, "Failed to reset feature flag")
+	}
+
+	log.Info().Str("flag", flag.Name).Msg("Feature flag reset to default")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    flag,
+	})
+}
+
+// flagError maps feature flag errors to responses
+func (h *AdminFeatureFlagHandler) flagError(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, featureflags.ErrFlagNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeNotFound,
+			Message: "Feature flag not found",
+		})
+	case errors.Is(err, featureflags.ErrInvalidFlag):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidFeatureFlag,
+			Message: err.Error(),
+		})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
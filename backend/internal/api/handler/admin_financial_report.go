@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/financialreport"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminFinancialReportHandler handles admin endpoints for daily financial reports
+type AdminFinancialReportHandler struct {
+	reportService financialreport.Service
+	logger        *logger.Logger
+}
+
+// NewAdminFinancialReportHandler creates a new admin financial report handler
+func NewAdminFinancialReportHandler(reportService financialreport.Service, log *logger.Logger) *AdminFinancialReportHandler {
+	return &AdminFinancialReportHandler{
+		reportService: reportService,
+		logger:        log,
+	}
+}
+
+// ListReports returns the daily report rows of a date range, optionally for one game or operator
+// Both dates are inclusive UTC days.
+// GET /admin/financial-reports?from=YYYY-MM-DD&to=YYYY-MM-DD&game_id=&operator_id=
+func (h *AdminFinancialReportHandler) ListReports(c *fiber.Ctx) error {
+	filters, ok := h.filters(c)
+	if !ok {
+		return nil
+	}
+
+	rows, err := h.reportService.List(c.Context(), filters)
+	if err != nil {
+		return h.error(c, err, "Failed to list financial reports")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"reports": rows,
+			"from":    filters.From.Format(statsDateLayout),
+			"to":      filters.To.Format(statsDateLayout),
+		},
+	})
+}
+
+// DownloadReports downloads the daily report rows of a date range as CSV
+// GET /admin/financial-reports/csv?from=YYYY-MM-DD&to=YYYY-MM-DD&game_id=&operator_id=
+func (h *AdminFinancialReportHandler) DownloadReports(c *fiber.Ctx) error {
+	filters, ok := h.filters(c)
+	if !ok {
+		return nil
+	}
+
+	data, err := h.reportService.CSV(c.Context(), filters)
+	if err != nil {
+		return h.error(c, err, "Failed to download financial reports")
+	}
+
+	c.Attachment(fmt.Sprintf("financial-report-%s-%s.csv", filters.From.Format(statsDateLayout), filters.To.Format(statsDateLayout)))
+	c.Set(fiber.HeaderContentType, "text/csv")
+	return c.Send(data)
+}
+
+// GenerateReport rebuilds one ended day's report rows, e.g. after late corrections
+// POST /admin/financial-reports/generate
+func (h *AdminFinancialReportHandler) GenerateReport(c *fiber.Ctx) error {
+	var req dto.GenerateFinancialReportRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+	day, err := time.Parse(statsDateLayout, req.Date)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRange,
+			Message: "Invalid date: use YYYY-MM-DD",
+		})
+	}
+
+	rows, err := h.reportService.Generate(c.Context(), day)
+	if err != nil {
+		return h.error(c, err, "Failed to generate financial report")
+	}
+
+	h.logger.WithTrace(c).Info().
+		Str("day", req.Date).
+		Int("rows", len(rows)).
+		Str("admin", adminUsername(c)).
+		Msg("Financial report regenerated")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"reports": rows,
+		},
+	})
+}
+
+// filters parses the report range and filters, writing the error response if they are invalid
+func (h *AdminFinancialReportHandler) filters(c *fiber.Ctx) (financialreport.ListFilters, bool) {
+	from, errFrom := time.Parse(statsDateLayout, c.Query("from"))
+	to, errTo := time.Parse(statsDateLayout, c.Query("to"))
+	if errFrom != nil || errTo != nil {
+		_ = c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRange,
+			Message: "Invalid date range: use from/to as YYYY-MM-DD",
+		})
+		return financialreport.ListFilters{}, false
+	}
+
+	filters := financialreport.ListFilters{From: from, To: to}
+	if gameID := c.Query("game_id"); gameID != "" {
+		id, err := uuid.Parse(gameID)
+		if err != nil {
+			_ = c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidGameID,
+				Message: "Invalid game ID",
+			})
+			return financialreport.ListFilters{}, false
+		}
+		filters.GameID = &id
+	}
+	if c.Context().QueryArgs().Has("operator_id") {
+		operatorID := c.Query("operator_id")
+		filters.OperatorID = &operatorID
+	}
+	return filters, true
+}
+
+// error maps financial report service errors to responses
+func (h *AdminFinancialReportHandler) error(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, financialreport.ErrInvalidRange), errors.Is(err, financialreport.ErrDayNotOver):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeInvalidRange, Message: err.Error()})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
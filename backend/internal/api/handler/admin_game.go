@@ -2,32 +2,48 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/approval"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/domain/game"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/infra/storage"
 	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/service"
 )
 
 // AdminGameHandler handles admin game management endpoints
 type AdminGameHandler struct {
-	gameRepo game.Repository
-	storage  storage.Storage
-	logger   *logger.Logger
+	gameRepo    game.Repository
+	storage     storage.Storage
+	imageWorker *service.AssetImageWorker
+	assetFiles  *service.AssetFileService
+	rules       *service.GameRulesService
+	approvals   approval.Service
+	logger      *logger.Logger
 }
 
 // NewAdminGameHandler creates a new admin game handler
 func NewAdminGameHandler(
 	gameRepo game.Repository,
 	storage storage.Storage,
+	imageWorker *service.AssetImageWorker,
+	assetFiles *service.AssetFileService,
+	gameRules *service.GameRulesService,
+	approvals approval.Service,
 	log *logger.Logger,
 ) *AdminGameHandler {
 	return &AdminGameHandler{
-		gameRepo: gameRepo,
-		storage:  storage,
-		logger:   log,
+		gameRepo:    gameRepo,
+		storage:     storage,
+		imageWorker: imageWorker,
+		assetFiles:  assetFiles,
+		rules:       gameRules,
+		approvals:   approvals,
+		logger:      log,
 	}
 }
 
@@ -43,7 +59,7 @@ func (h *AdminGameHandler) ListGames(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to list games")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_list_games",
+			Error:   domainErrors.CodeFailedToListGames,
 			Message: "Failed to list games",
 		})
 	}
@@ -67,7 +83,7 @@ func (h *AdminGameHandler) GetGame(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid game ID",
 		})
 	}
@@ -76,13 +92,13 @@ func (h *AdminGameHandler) GetGame(c *fiber.Ctx) error {
 	if err != nil {
 		if err == game.ErrGameNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "not_found",
+				Error:   domainErrors.CodeNotFound,
 				Message: "Game not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to get game")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_game",
+			Error:   domainErrors.CodeFailedToGetGame,
 			Message: "Failed to get game",
 		})
 	}
@@ -101,7 +117,7 @@ func (h *AdminGameHandler) CreateGame(c *fiber.Ctx) error {
 	var req dto.CreateGameRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -118,7 +134,7 @@ func (h *AdminGameHandler) CreateGame(c *fiber.Ctx) error {
 	if err := h.gameRepo.CreateGame(c.Context(), g); err != nil {
 		log.Error().Err(err).Msg("Failed to create game")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_create_game",
+			Error:   domainErrors.CodeFailedToCreateGame,
 			Message: "Failed to create game",
 		})
 	}
@@ -139,7 +155,7 @@ func (h *AdminGameHandler) UpdateGame(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid game ID",
 		})
 	}
@@ -147,7 +163,7 @@ func (h *AdminGameHandler) UpdateGame(c *fiber.Ctx) error {
 	var req dto.UpdateGameRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -162,13 +178,13 @@ func (h *AdminGameHandler) UpdateGame(c *fiber.Ctx) error {
 	if err != nil {
 		if err == game.ErrGameNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "not_found",
+				Error:   domainErrors.CodeNotFound,
 				Message: "Game not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to update game")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_update_game",
+			Error:   domainErrors.CodeFailedToUpdateGame,
 			Message: "Failed to update game",
 		})
 	}
@@ -189,7 +205,7 @@ func (h *AdminGameHandler) DeleteGame(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid game ID",
 		})
 	}
@@ -197,17 +213,19 @@ func (h *AdminGameHandler) DeleteGame(c *fiber.Ctx) error {
 	if err := h.gameRepo.DeleteGame(c.Context(), id); err != nil {
 		if err == game.ErrGameNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "not_found",
+				Error:   domainErrors.CodeNotFound,
 				Message: "Game not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to delete game")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_delete_game",
+			Error:   domainErrors.CodeFailedToDeleteGame,
 			Message: "Failed to delete game",
 		})
 	}
 
+	h.rules.Expire(c.Context(), id)
+
 	log.Info().Str("game_id", id.String()).Msg("Game deleted")
 
 	return c.JSON(fiber.Map{
@@ -216,6 +234,48 @@ func (h *AdminGameHandler) DeleteGame(c *fiber.Ctx) error {
 	})
 }
 
+// RestoreGame restores a deleted game, leaving it inactive
+// POST /admin/games/:id/restore
+func (h *AdminGameHandler) RestoreGame(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid game ID",
+		})
+	}
+
+	g, err := h.gameRepo.RestoreGame(c.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, game.ErrGameNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNotFound,
+				Message: "Deleted game not found",
+			})
+		case errors.Is(err, game.ErrNameTaken):
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeDuplicateName,
+				Message: "Another game already uses this name",
+			})
+		}
+		log.Error().Err(err).Msg("Failed to restore game")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToRestoreGame,
+			Message: "Failed to restore game",
+		})
+	}
+
+	log.Info().Str("game_id", id.String()).Msg("Game restored")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    g,
+	})
+}
+
 // ActivateGame activates a game
 // POST /admin/games/:id/activate
 func (h *AdminGameHandler) ActivateGame(c *fiber.Ctx) error {
@@ -224,7 +284,7 @@ func (h *AdminGameHandler) ActivateGame(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid game ID",
 		})
 	}
@@ -234,7 +294,7 @@ func (h *AdminGameHandler) ActivateGame(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to activate game")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_activate_game",
+			Error:   domainErrors.CodeFailedToActivateGame,
 			Message: "Failed to activate game",
 		})
 	}
@@ -253,7 +313,7 @@ func (h *AdminGameHandler) DeactivateGame(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid game ID",
 		})
 	}
@@ -263,7 +323,7 @@ func (h *AdminGameHandler) DeactivateGame(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to deactivate game")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_deactivate_game",
+			Error:   domainErrors.CodeFailedToDeactivateGame,
 			Message: "Failed to deactivate game",
 		})
 	}
@@ -286,7 +346,7 @@ func (h *AdminGameHandler) ListAssets(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to list assets")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_list_assets",
+			Error:   domainErrors.CodeFailedToListAssets,
 			Message: "Failed to list assets",
 		})
 	}
@@ -310,7 +370,7 @@ func (h *AdminGameHandler) GetAsset(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid asset ID",
 		})
 	}
@@ -319,13 +379,13 @@ func (h *AdminGameHandler) GetAsset(c *fiber.Ctx) error {
 	if err != nil {
 		if err == game.ErrAssetNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "not_found",
+				Error:   domainErrors.CodeNotFound,
 				Message: "Asset not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to get asset")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_asset",
+			Error:   domainErrors.CodeFailedToGetAsset,
 			Message: "Failed to get asset",
 		})
 	}
@@ -344,7 +404,7 @@ func (h *AdminGameHandler) CreateAsset(c *fiber.Ctx) error {
 	var req dto.CreateAssetRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -352,7 +412,7 @@ func (h *AdminGameHandler) CreateAsset(c *fiber.Ctx) error {
 	spritesheetJSON, err := json.Marshal(req.SpritesheetJSON)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_spritesheet_json",
+			Error:   domainErrors.CodeInvalidSpritesheetJSON,
 			Message: "Invalid spritesheet JSON",
 		})
 	}
@@ -360,7 +420,7 @@ func (h *AdminGameHandler) CreateAsset(c *fiber.Ctx) error {
 	imagesJSON, err := json.Marshal(req.Images)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_images_json",
+			Error:   domainErrors.CodeInvalidImagesJSON,
 			Message: "Invalid images JSON",
 		})
 	}
@@ -368,7 +428,7 @@ func (h *AdminGameHandler) CreateAsset(c *fiber.Ctx) error {
 	audiosJSON, err := json.Marshal(req.Audios)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_audios_json",
+			Error:   domainErrors.CodeInvalidAudiosJSON,
 			Message: "Invalid audios JSON",
 		})
 	}
@@ -376,7 +436,7 @@ func (h *AdminGameHandler) CreateAsset(c *fiber.Ctx) error {
 	videosJSON, err := json.Marshal(req.Videos)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_videos_json",
+			Error:   domainErrors.CodeInvalidVideosJSON,
 			Message: "Invalid videos JSON",
 		})
 	}
@@ -391,7 +451,7 @@ func (h *AdminGameHandler) CreateAsset(c *fiber.Ctx) error {
 	if err := h.storage.CreateFolder(c.Context(), objectName); err != nil {
 		log.Error().Err(err).Str("object_name", objectName).Msg("Failed to create storage folder")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_create_storage_folder",
+			Error:   domainErrors.CodeFailedToCreateStorageFolder,
 			Message: "Failed to create storage folder",
 		})
 	}
@@ -409,6 +469,7 @@ func (h *AdminGameHandler) CreateAsset(c *fiber.Ctx) error {
 		Images:          imagesJSON,
 		Audios:          audiosJSON,
 		Videos:          videosJSON,
+		IsPrivate:       req.IsPrivate,
 		IsActive:        req.IsActive,
 	}
 
@@ -419,7 +480,7 @@ func (h *AdminGameHandler) CreateAsset(c *fiber.Ctx) error {
 			log.Error().Err(delErr).Str("object_name", objectName).Msg("Failed to cleanup storage folder after asset creation failed")
 		}
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_create_asset",
+			Error:   domainErrors.CodeFailedToCreateAsset,
 			Message: "Failed to create asset",
 		})
 	}
@@ -440,7 +501,7 @@ func (h *AdminGameHandler) UpdateAsset(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid asset ID",
 		})
 	}
@@ -448,7 +509,7 @@ func (h *AdminGameHandler) UpdateAsset(c *fiber.Ctx) error {
 	var req dto.UpdateAssetRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -458,13 +519,13 @@ func (h *AdminGameHandler) UpdateAsset(c *fiber.Ctx) error {
 	if err != nil {
 		if err == game.ErrAssetNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "not_found",
+				Error:   domainErrors.CodeNotFound,
 				Message: "Asset not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to get current asset")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_asset",
+			Error:   domainErrors.CodeFailedToGetAsset,
 			Message: "Failed to get asset",
 		})
 	}
@@ -473,6 +534,7 @@ func (h *AdminGameHandler) UpdateAsset(c *fiber.Ctx) error {
 		Name:        req.Name,
 		Description: req.Description,
 		ObjectName:  req.ObjectName,
+		IsPrivate:   req.IsPrivate,
 		IsActive:    req.IsActive,
 	}
 
@@ -487,7 +549,7 @@ func (h *AdminGameHandler) UpdateAsset(c *fiber.Ctx) error {
 				Str("new_object_name", newObjectName).
 				Msg("Failed to rename storage folder")
 			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-				Error:   "failed_to_rename_storage_folder",
+				Error:   domainErrors.CodeFailedToRenameStorageFolder,
 				Message: "Failed to rename storage folder",
 			})
 		}
@@ -507,7 +569,7 @@ func (h *AdminGameHandler) UpdateAsset(c *fiber.Ctx) error {
 		spritesheetJSON, err := json.Marshal(req.SpritesheetJSON)
 		if err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "invalid_spritesheet_json",
+				Error:   domainErrors.CodeInvalidSpritesheetJSON,
 				Message: "Invalid spritesheet JSON",
 			})
 		}
@@ -518,7 +580,7 @@ func (h *AdminGameHandler) UpdateAsset(c *fiber.Ctx) error {
 		imagesJSON, err := json.Marshal(req.Images)
 		if err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "invalid_images_json",
+				Error:   domainErrors.CodeInvalidImagesJSON,
 				Message: "Invalid images JSON",
 			})
 		}
@@ -529,7 +591,7 @@ func (h *AdminGameHandler) UpdateAsset(c *fiber.Ctx) error {
 		audiosJSON, err := json.Marshal(req.Audios)
 		if err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "invalid_audios_json",
+				Error:   domainErrors.CodeInvalidAudiosJSON,
 				Message: "Invalid audios JSON",
 			})
 		}
@@ -540,7 +602,7 @@ func (h *AdminGameHandler) UpdateAsset(c *fiber.Ctx) error {
 		videosJSON, err := json.Marshal(req.Videos)
 		if err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "invalid_videos_json",
+				Error:   domainErrors.CodeInvalidVideosJSON,
 				Message: "Invalid videos JSON",
 			})
 		}
@@ -551,13 +613,13 @@ func (h *AdminGameHandler) UpdateAsset(c *fiber.Ctx) error {
 	if err != nil {
 		if err == game.ErrAssetNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "not_found",
+				Error:   domainErrors.CodeNotFound,
 				Message: "Asset not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to update asset")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_update_asset",
+			Error:   domainErrors.CodeFailedToUpdateAsset,
 			Message: "Failed to update asset",
 		})
 	}
@@ -578,7 +640,7 @@ func (h *AdminGameHandler) DeleteAsset(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid asset ID",
 		})
 	}
@@ -586,13 +648,19 @@ func (h *AdminGameHandler) DeleteAsset(c *fiber.Ctx) error {
 	if err := h.gameRepo.DeleteAsset(c.Context(), id); err != nil {
 		if err == game.ErrAssetNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "not_found",
+				Error:   domainErrors.CodeNotFound,
 				Message: "Asset not found",
 			})
 		}
+		if err == game.ErrAssetInUse {
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeAssetInUse,
+				Message: "Asset is used by a game config",
+			})
+		}
 		log.Error().Err(err).Msg("Failed to delete asset")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_delete_asset",
+			Error:   domainErrors.CodeFailedToDeleteAsset,
 			Message: "Failed to delete asset",
 		})
 	}
@@ -605,6 +673,48 @@ func (h *AdminGameHandler) DeleteAsset(c *fiber.Ctx) error {
 	})
 }
 
+// RestoreAsset restores a deleted asset, leaving it inactive
+// POST /admin/assets/:id/restore
+func (h *AdminGameHandler) RestoreAsset(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid asset ID",
+		})
+	}
+
+	a, err := h.gameRepo.RestoreAsset(c.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, game.ErrAssetNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNotFound,
+				Message: "Deleted asset not found",
+			})
+		case errors.Is(err, game.ErrNameTaken):
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeDuplicateName,
+				Message: "Another asset already uses this name or object name",
+			})
+		}
+		log.Error().Err(err).Msg("Failed to restore asset")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToRestoreAsset,
+			Message: "Failed to restore asset",
+		})
+	}
+
+	log.Info().Str("asset_id", id.String()).Msg("Asset restored")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    a,
+	})
+}
+
 // ActivateAsset activates an asset
 // POST /admin/assets/:id/activate
 func (h *AdminGameHandler) ActivateAsset(c *fiber.Ctx) error {
@@ -613,7 +723,7 @@ func (h *AdminGameHandler) ActivateAsset(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid asset ID",
 		})
 	}
@@ -623,7 +733,7 @@ func (h *AdminGameHandler) ActivateAsset(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to activate asset")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_activate_asset",
+			Error:   domainErrors.CodeFailedToActivateAsset,
 			Message: "Failed to activate asset",
 		})
 	}
@@ -642,7 +752,7 @@ func (h *AdminGameHandler) DeactivateAsset(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid asset ID",
 		})
 	}
@@ -652,7 +762,7 @@ func (h *AdminGameHandler) DeactivateAsset(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to deactivate asset")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_deactivate_asset",
+			Error:   domainErrors.CodeFailedToDeactivateAsset,
 			Message: "Failed to deactivate asset",
 		})
 	}
@@ -663,6 +773,136 @@ func (h *AdminGameHandler) DeactivateAsset(c *fiber.Ctx) error {
 	})
 }
 
+// OptimizeAssetImages queues generation of WebP/AVIF variants for an asset's images
+// Progress is available via GET /admin/upload/status/:uploadId using the returned job ID
+// POST /admin/assets/:id/optimize-images
+func (h *AdminGameHandler) OptimizeAssetImages(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid asset ID",
+		})
+	}
+
+	jobID, err := h.imageWorker.Enqueue(c.Context(), id)
+	if err != nil {
+		if errors.Is(err, game.ErrAssetNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNotFound,
+				Message: "Asset not found",
+			})
+		}
+		if errors.Is(err, service.ErrImageQueueFull) {
+			return c.Status(fiber.StatusTooManyRequests).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeQueueFull,
+				Message: "Image optimization queue is full. Please try again later.",
+			})
+		}
+		log.Error().Err(err).Msg("Failed to queue image optimization")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToOptimizeImages,
+			Message: "Failed to queue image optimization",
+		})
+	}
+
+	log.Info().Str("asset_id", id.String()).Str("job_id", jobID).Msg("Asset image optimization queued")
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"upload_id": jobID,
+			"status":    "processing",
+			"message":   "Images are being optimized in background. Poll status endpoint for updates.",
+		},
+	})
+}
+
+// RehashAssetFiles rebuilds the content hash records of an asset from storage
+// Needed for files uploaded before the asset existed or before hashing was introduced
+// POST /admin/assets/:id/rehash
+func (h *AdminGameHandler) RehashAssetFiles(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid asset ID",
+		})
+	}
+
+	count, err := h.assetFiles.Rehash(c.Context(), id)
+	if err != nil {
+		if errors.Is(err, game.ErrAssetNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNotFound,
+				Message: "Asset not found",
+			})
+		}
+		log.Error().Err(err).Str("asset_id", id.String()).Msg("Failed to rehash asset files")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToRehashAsset,
+			Message: "Failed to rebuild asset file hashes",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"asset_id":    id,
+			"files_count": count,
+		},
+	})
+}
+
+// GetAssetUsage reports orphaned and missing files of an asset
+// GET /admin/assets/:id/usage
+func (h *AdminGameHandler) GetAssetUsage(c *fiber.Ctx) error {
+	return h.assetUsage(c, false)
+}
+
+// CleanupAssetOrphans deletes the asset's stored files that no asset field references
+// POST /admin/assets/:id/usage/cleanup
+func (h *AdminGameHandler) CleanupAssetOrphans(c *fiber.Ctx) error {
+	return h.assetUsage(c, true)
+}
+
+// assetUsage builds the usage report of an asset, optionally deleting orphans
+func (h *AdminGameHandler) assetUsage(c *fiber.Ctx, cleanup bool) error {
+	log := h.logger.WithTrace(c)
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid asset ID",
+		})
+	}
+
+	report, err := h.assetFiles.Usage(c.Context(), id, cleanup)
+	if err != nil {
+		if errors.Is(err, game.ErrAssetNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNotFound,
+				Message: "Asset not found",
+			})
+		}
+		log.Error().Err(err).Str("asset_id", id.String()).Bool("cleanup", cleanup).Msg("Failed to build asset usage report")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToGetAssetUsage,
+			Message: "Failed to build asset usage report",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    report,
+	})
+}
+
 // ListGameConfigs lists all game configs
 // GET /admin/game-configs
 func (h *AdminGameHandler) ListGameConfigs(c *fiber.Ctx) error {
@@ -675,7 +915,7 @@ func (h *AdminGameHandler) ListGameConfigs(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to list game configs")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_list_game_configs",
+			Error:   domainErrors.CodeFailedToListGameConfigs,
 			Message: "Failed to list game configs",
 		})
 	}
@@ -699,7 +939,7 @@ func (h *AdminGameHandler) GetGameConfig(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid game config ID",
 		})
 	}
@@ -708,13 +948,13 @@ func (h *AdminGameHandler) GetGameConfig(c *fiber.Ctx) error {
 	if err != nil {
 		if err == game.ErrGameConfigNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "not_found",
+				Error:   domainErrors.CodeNotFound,
 				Message: "Game config not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to get game config")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_game_config",
+			Error:   domainErrors.CodeFailedToGetGameConfig,
 			Message: "Failed to get game config",
 		})
 	}
@@ -733,7 +973,7 @@ func (h *AdminGameHandler) CreateGameConfig(c *fiber.Ctx) error {
 	var req dto.CreateGameConfigRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -741,7 +981,7 @@ func (h *AdminGameHandler) CreateGameConfig(c *fiber.Ctx) error {
 	gameID, err := uuid.Parse(req.GameID)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_game_id",
+			Error:   domainErrors.CodeInvalidGameID,
 			Message: "Invalid game ID",
 		})
 	}
@@ -749,26 +989,73 @@ func (h *AdminGameHandler) CreateGameConfig(c *fiber.Ctx) error {
 	assetID, err := uuid.Parse(req.AssetID)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_asset_id",
+			Error:   domainErrors.CodeInvalidAssetID,
 			Message: "Invalid asset ID",
 		})
 	}
 
+	if req.MultiplierLadder != nil {
+		if err := req.MultiplierLadder.Validate(); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidMultiplierLadder,
+				Message: err.Error(),
+			})
+		}
+	}
+	if req.TriggerRules != nil {
+		if err := req.TriggerRules.Validate(); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidTriggerRules,
+				Message: err.Error(),
+			})
+		}
+	}
+	if req.WildFeatures != nil {
+		if err := req.WildFeatures.Validate(); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidWildFeatures,
+				Message: err.Error(),
+			})
+		}
+	}
+	if req.WinTiers != nil {
+		if err := req.WinTiers.Validate(); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidWinTiers,
+				Message: err.Error(),
+			})
+		}
+	}
+	if req.TimingProfiles != nil {
+		if err := req.TimingProfiles.Validate(); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidTimingProfiles,
+				Message: err.Error(),
+			})
+		}
+	}
+
 	config := &game.GameConfig{
-		ID:       uuid.New(),
-		GameID:   gameID,
-		AssetID:  assetID,
-		IsActive: req.IsActive,
+		ID:               uuid.New(),
+		GameID:           gameID,
+		AssetID:          assetID,
+		IsActive:         req.IsActive,
+		MultiplierLadder: req.MultiplierLadder,
+		TriggerRules:     req.TriggerRules,
+		WildFeatures:     req.WildFeatures,
+		WinTiers:         req.WinTiers,
+		TimingProfiles:   req.TimingProfiles,
 	}
 
 	if err := h.gameRepo.CreateGameConfig(c.Context(), config); err != nil {
 		log.Error().Err(err).Msg("Failed to create game config")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_create_game_config",
+			Error:   domainErrors.CodeFailedToCreateGameConfig,
 			Message: "Failed to create game config",
 		})
 	}
 
+	h.rules.Expire(c.Context(), config.GameID)
 	log.Info().Str("config_id", config.ID.String()).Msg("Game config created")
 
 	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
@@ -785,24 +1072,29 @@ func (h *AdminGameHandler) DeleteGameConfig(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid game config ID",
 		})
 	}
 
-	if err := h.gameRepo.DeleteGameConfig(c.Context(), id); err != nil {
+	config, err := h.gameRepo.GetGameConfigByID(c.Context(), id)
+	if err == nil {
+		err = h.gameRepo.DeleteGameConfig(c.Context(), id)
+	}
+	if err != nil {
 		if err == game.ErrGameConfigNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "not_found",
+				Error:   domainErrors.CodeNotFound,
 				Message: "Game config not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to delete game config")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_delete_game_config",
+			Error:   domainErrors.CodeFailedToDeleteGameConfig,
 			Message: "Failed to delete game config",
 		})
 	}
+	h.rules.Expire(c.Context(), config.GameID)
 
 	log.Info().Str("config_id", id.String()).Msg("Game config deleted")
 
@@ -812,6 +1104,43 @@ func (h *AdminGameHandler) DeleteGameConfig(c *fiber.Ctx) error {
 	})
 }
 
+// RestoreGameConfig restores a deleted game config, leaving it inactive
+// POST /admin/game-configs/:id/restore
+func (h *AdminGameHandler) RestoreGameConfig(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid game config ID",
+		})
+	}
+
+	config, err := h.gameRepo.RestoreGameConfig(c.Context(), id)
+	if err != nil {
+		if errors.Is(err, game.ErrGameConfigNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNotFound,
+				Message: "Deleted game config not found",
+			})
+		}
+		log.Error().Err(err).Msg("Failed to restore game config")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToRestoreGameConfig,
+			Message: "Failed to restore game config",
+		})
+	}
+	h.rules.Expire(c.Context(), config.GameID)
+
+	log.Info().Str("config_id", id.String()).Msg("Game config restored")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    config,
+	})
+}
+
 // ActivateGameConfig activates a game config
 // POST /admin/game-configs/:id/activate
 func (h *AdminGameHandler) ActivateGameConfig(c *fiber.Ctx) error {
@@ -820,7 +1149,7 @@ func (h *AdminGameHandler) ActivateGameConfig(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid game config ID",
 		})
 	}
@@ -829,10 +1158,11 @@ func (h *AdminGameHandler) ActivateGameConfig(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to activate game config")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_activate_game_config",
+			Error:   domainErrors.CodeFailedToActivateGameConfig,
 			Message: "Failed to activate game config",
 		})
 	}
+	h.rules.Expire(c.Context(), config.GameID)
 
 	return c.JSON(fiber.Map{
 		"success": true,
@@ -848,7 +1178,7 @@ func (h *AdminGameHandler) DeactivateGameConfig(c *fiber.Ctx) error {
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_id",
+			Error:   domainErrors.CodeInvalidID,
 			Message: "Invalid game config ID",
 		})
 	}
@@ -857,10 +1187,403 @@ func (h *AdminGameHandler) DeactivateGameConfig(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to deactivate game config")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_deactivate_game_config",
+			Error:   domainErrors.CodeFailedToDeactivateGameConfig,
 			Message: "Failed to deactivate game config",
 		})
 	}
+	h.rules.Expire(c.Context(), config.GameID)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    config,
+	})
+}
+
+// SetGameConfigMultipliers sets a game config's cascade multiplier ladder
+// PUT /admin/game-configs/:id/multipliers
+func (h *AdminGameHandler) SetGameConfigMultipliers(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid game config ID",
+		})
+	}
+
+	var req dto.SetMultiplierLadderRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	return h.setMultiplierLadder(c, id, &game.MultiplierLadder{
+		BaseGame:  req.BaseGame,
+		FreeSpins: req.FreeSpins,
+	})
+}
+
+// ResetGameConfigMultipliers resets a game config to the default multiplier ladder
+// DELETE /admin/game-configs/:id/multipliers
+func (h *AdminGameHandler) ResetGameConfigMultipliers(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid game config ID",
+		})
+	}
+
+	return h.setMultiplierLadder(c, id, nil)
+}
+
+// setMultiplierLadder stores the ladder and maps ladder errors to responses
+func (h *AdminGameHandler) setMultiplierLadder(c *fiber.Ctx, id uuid.UUID, ladder *game.MultiplierLadder) error {
+	log := h.logger.WithTrace(c)
+
+	if h.approvals.Required() {
+		return proposeChange(c, h.approvals, h.logger, approval.ActionSetGameConfigMultipliers, &approval.Params{GameConfigID: &id, MultiplierLadder: ladder})
+	}
+
+	config, err := h.rules.SetGameConfigLadder(c.Context(), id, ladder)
+	if err != nil {
+		switch {
+		case errors.Is(err, game.ErrInvalidMultiplierLadder):
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidMultiplierLadder,
+				Message: err.Error(),
+			})
+		case errors.Is(err, game.ErrGameConfigNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNotFound,
+				Message: "Game config not found",
+			})
+		}
+		log.Error().Err(err).Str("config_id", id.String()).Msg("Failed to set multiplier ladder")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToSetMultiplierLadder,
+			Message: "Failed to set multiplier ladder",
+		})
+	}
+
+	log.Info().
+		Str("config_id", id.String()).
+		Bool("default", ladder == nil).
+		Msg("Game config multiplier ladder updated")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    config,
+	})
+}
+
+// SetGameConfigTriggerRules sets a game config's free spins trigger rules
+// PUT /admin/game-configs/:id/trigger-rules
+func (h *AdminGameHandler) SetGameConfigTriggerRules(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid game config ID",
+		})
+	}
+
+	var req dto.SetTriggerRulesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	retrigger := true
+	if req.Retrigger != nil {
+		retrigger = *req.Retrigger
+	}
+	return h.setTriggerRules(c, id, &game.TriggerRules{
+		MinScatters:     req.MinScatters,
+		BaseAward:       req.BaseAward,
+		ExtraPerScatter: req.ExtraPerScatter,
+		Retrigger:       retrigger,
+	})
+}
+
+// ResetGameConfigTriggerRules resets a game config to the default free spins trigger rules
+// DELETE /admin/game-configs/:id/trigger-rules
+func (h *AdminGameHandler) ResetGameConfigTriggerRules(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid game config ID",
+		})
+	}
+
+	return h.setTriggerRules(c, id, nil)
+}
+
+// setTriggerRules stores the trigger rules and maps rule errors to responses
+func (h *AdminGameHandler) setTriggerRules(c *fiber.Ctx, id uuid.UUID, rules *game.TriggerRules) error {
+	log := h.logger.WithTrace(c)
+
+	if h.approvals.Required() {
+		return proposeChange(c, h.approvals, h.logger, approval.ActionSetGameConfigTriggerRules, &approval.Params{GameConfigID: &id, TriggerRules: rules})
+	}
+
+	config, err := h.rules.SetGameConfigTriggerRules(c.Context(), id, rules)
+	if err != nil {
+		switch {
+		case errors.Is(err, game.ErrInvalidTriggerRules):
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidTriggerRules,
+				Message: err.Error(),
+			})
+		case errors.Is(err, game.ErrGameConfigNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNotFound,
+				Message: "Game config not found",
+			})
+		}
+		log.Error().Err(err).Str("config_id", id.String()).Msg("Failed to set trigger rules")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToSetTriggerRules,
+			Message: "Failed to set trigger rules",
+		})
+	}
+
+	log.Info().
+		Str("config_id", id.String()).
+		Bool("default", rules == nil).
+		Msg("Game config trigger rules updated")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    config,
+	})
+}
+
+// SetGameConfigWildFeatures sets a game config's wild features
+// PUT /admin/game-configs/:id/wild-features
+func (h *AdminGameHandler) SetGameConfigWildFeatures(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid game config ID",
+		})
+	}
+
+	var req dto.SetWildFeaturesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	return h.setWildFeatures(c, id, &game.WildFeatures{
+		StickyWilds:    req.StickyWilds,
+		ExpandingWilds: req.ExpandingWilds,
+		WildMultiplier: req.WildMultiplier,
+	})
+}
+
+// ResetGameConfigWildFeatures disables a game config's wild features
+// DELETE /admin/game-configs/:id/wild-features
+func (h *AdminGameHandler) ResetGameConfigWildFeatures(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid game config ID",
+		})
+	}
+
+	return h.setWildFeatures(c, id, nil)
+}
+
+// setWildFeatures stores the wild features and maps feature errors to responses
+func (h *AdminGameHandler) setWildFeatures(c *fiber.Ctx, id uuid.UUID, features *game.WildFeatures) error {
+	log := h.logger.WithTrace(c)
+
+	if h.approvals.Required() {
+		return proposeChange(c, h.approvals, h.logger, approval.ActionSetGameConfigWildFeatures, &approval.Params{GameConfigID: &id, WildFeatures: features})
+	}
+
+	config, err := h.rules.SetGameConfigWildFeatures(c.Context(), id, features)
+	if err != nil {
+		switch {
+		case errors.Is(err, game.ErrInvalidWildFeatures):
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidWildFeatures,
+				Message: err.Error(),
+			})
+		case errors.Is(err, game.ErrGameConfigNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNotFound,
+				Message: "Game config not found",
+			})
+		}
+		log.Error().Err(err).Str("config_id", id.String()).Msg("Failed to set wild features")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToSetWildFeatures,
+			Message: "Failed to set wild features",
+		})
+	}
+
+	log.Info().
+		Str("config_id", id.String()).
+		Bool("disabled", features == nil).
+		Msg("Game config wild features updated")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    config,
+	})
+}
+
+// SetGameConfigWinTiers sets a game config's win presentation tiers
+// PUT /admin/game-configs/:id/win-tiers
+func (h *AdminGameHandler) SetGameConfigWinTiers(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid game config ID",
+		})
+	}
+
+	var req dto.SetWinTiersRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	return h.setWinTiers(c, id, &req.Tiers)
+}
+
+// ResetGameConfigWinTiers resets a game config's win presentation tiers to the default
+// DELETE /admin/game-configs/:id/win-tiers
+func (h *AdminGameHandler) ResetGameConfigWinTiers(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid game config ID",
+		})
+	}
+
+	return h.setWinTiers(c, id, nil)
+}
+
+// setWinTiers stores the win tiers and maps tier errors to responses
+func (h *AdminGameHandler) setWinTiers(c *fiber.Ctx, id uuid.UUID, tiers *game.WinTiers) error {
+	log := h.logger.WithTrace(c)
+
+	config, err := h.rules.SetGameConfigWinTiers(c.Context(), id, tiers)
+	if err != nil {
+		switch {
+		case errors.Is(err, game.ErrInvalidWinTiers):
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidWinTiers,
+				Message: err.Error(),
+			})
+		case errors.Is(err, game.ErrGameConfigNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNotFound,
+				Message: "Game config not found",
+			})
+		}
+		log.Error().Err(err).Str("config_id", id.String()).Msg("Failed to set win tiers")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToSetWinTiers,
+			Message: "Failed to set win tiers",
+		})
+	}
+
+	log.Info().
+		Str("config_id", id.String()).
+		Bool("reset", tiers == nil).
+		Msg("Game config win tiers updated")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    config,
+	})
+}
+
+// SetGameConfigTimingProfiles sets a game config's normal and turbo animation timing
+// PUT /admin/game-configs/:id/timing
+func (h *AdminGameHandler) SetGameConfigTimingProfiles(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid game config ID",
+		})
+	}
+
+	var req dto.SetTimingProfilesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	return h.setTimingProfiles(c, id, &game.TimingProfiles{
+		Normal:    req.Normal,
+		Turbo:     req.Turbo,
+		MinSpinMs: req.MinSpinMs,
+	})
+}
+
+// ResetGameConfigTimingProfiles resets a game config's animation timing to the default
+// DELETE /admin/game-configs/:id/timing
+func (h *AdminGameHandler) ResetGameConfigTimingProfiles(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid game config ID",
+		})
+	}
+
+	return h.setTimingProfiles(c, id, nil)
+}
+
+// setTimingProfiles stores the timing profiles and maps timing errors to responses
+func (h *AdminGameHandler) setTimingProfiles(c *fiber.Ctx, id uuid.UUID, profiles *game.TimingProfiles) error {
+	log := h.logger.WithTrace(c)
+
+	config, err := h.rules.SetGameConfigTimingProfiles(c.Context(), id, profiles)
+	if err != nil {
+		switch {
+		case errors.Is(err, game.ErrInvalidTimingProfiles):
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidTimingProfiles,
+				Message: err.Error(),
+			})
+		case errors.Is(err, game.ErrGameConfigNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNotFound,
+				Message: "Game config not found",
+			})
+		}
+		log.Error().Err(err).Str("config_id", id.String()).Msg("Failed to set timing profiles")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToSetTimingProfiles,
+			Message: "Failed to set timing profiles",
+		})
+	}
+
+	log.Info().
+		Str("config_id", id.String()).
+		Bool("reset", profiles == nil).
+		Msg("Game config timing profiles updated")
 
 	return c.JSON(fiber.Map{
 		"success": true,
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	adminDomain "github.com/slotmachine/backend/domain/admin"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/graphql"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminGraphQLHandler serves the admin console's GraphQL gateway
+// One query composes players, sessions, spins, reel strip configs and assets that
+// would otherwise take a REST call each; every field is checked against the
+// calling admin's read permissions.
+type AdminGraphQLHandler struct {
+	schema *graphql.Schema
+	logger *logger.Logger
+}
+
+// NewAdminGraphQLHandler creates a new admin GraphQL handler
+func NewAdminGraphQLHandler(
+	playerRepo player.Repository,
+	sessionRepo session.Repository,
+	spinRepo spin.Repository,
+	reelStripService reelstrip.Service,
+	gameRepo game.Repository,
+	log *logger.Logger,
+) *AdminGraphQLHandler {
+	r := &adminGraphQLResolver{
+		players:    playerRepo,
+		sessions:   sessionRepo,
+		spins:      spinRepo,
+		reelStrips: reelStripService,
+		games:      gameRepo,
+		logger:     log,
+	}
+	return &AdminGraphQLHandler{
+		schema: &graphql.Schema{
+			Query:     r.queryType(),
+			Authorize: authorizeAdminGraphQL,
+			MaxDepth:  adminGraphQLMaxDepth,
+		},
+		logger: log,
+	}
+}
+
+// adminGraphQLCallerKey carries the authenticated caller into resolvers
+type adminGraphQLCallerKey struct{}
+
+type adminGraphQLCaller struct {
+	admin   *adminDomain.Admin
+	service bool // Service token: full access
+}
+
+// authorizeAdminGraphQL grants a field's permission to the caller stored in ctx
+func authorizeAdminGraphQL(ctx context.Context, permission string) bool {
+	caller, ok := ctx.Value(adminGraphQLCallerKey{}).(*adminGraphQLCaller)
+	if !ok {
+		return false
+	}
+	return caller.service || (caller.admin != nil && caller.admin.CanRead(permission))
+}
+
+// Query executes a GraphQL query
+// POST /admin/graphql
+func (h *AdminGraphQLHandler) Query(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req graphql.Request
+	if err := c.BodyParser(&req); err != nil || req.Query == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Request body must be JSON with a query",
+		})
+	}
+
+	caller := &adminGraphQLCaller{admin: getAdminFromContext(c)}
+	caller.service, _ = c.Locals("is_service").(bool)
+	ctx := context.WithValue(c.Context(), adminGraphQLCallerKey{}, caller)
+
+	result := h.schema.Execute(ctx, req)
+	if len(result.Errors) > 0 {
+		log.Debug().Str("operation", req.OperationName).Int("errors", len(result.Errors)).Msg("GraphQL query returned errors")
+	}
+	return c.JSON(result)
+}
+
+// Schema returns the gateway's schema in GraphQL SDL for client code generation
+// GET /admin/graphql/schema
+func (h *AdminGraphQLHandler) Schema(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, "application/graphql; charset=utf-8")
+	return c.SendString(h.schema.SDL())
+}
+
+// adminGraphQLResolver resolves the gateway's fields from the repositories behind the REST endpoints
+type adminGraphQLResolver struct {
+	players    player.Repository
+	sessions   session.Repository
+	spins      spin.Repository
+	reelStrips reelstrip.Service
+	games      game.Repository
+	logger     *logger.Logger
+}
+
+// fail logs a data access error and returns one safe to show to the client
+func (r *adminGraphQLResolver) fail(ctx context.Context, err error, what string) error {
+	r.logger.WithTraceContext(ctx).Error().Err(err).Msg("GraphQL: failed to " + what)
+	return fmt.Errorf("failed to %s", what)
+}
+
+// graphQLNotFound reports whether err means the entity is missing, which resolves to null
+func graphQLNotFound(err error) bool {
+	return errors.Is(err, player.ErrPlayerNotFound) ||
+		errors.Is(err, session.ErrSessionNotFound) ||
+		errors.Is(err, spin.ErrSpinNotFound) ||
+		errors.Is(err, reelstrip.ErrConfigNotFound) ||
+		errors.Is(err, reelstrip.ErrAssignmentNotFound) ||
+		errors.Is(err, game.ErrAssetNotFound)
+}
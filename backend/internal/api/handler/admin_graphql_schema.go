@@ -0,0 +1,538 @@
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	adminDomain "github.com/slotmachine/backend/domain/admin"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/pkg/graphql"
+)
+
+const (
+	adminGraphQLMaxDepth  = 6   // Deep enough for player -> sessions -> spins -> session
+	adminGraphQLMaxLimit  = 100 // Cap on list arguments, per list
+	adminGraphQLListLimit = 20  // Default for list arguments
+)
+
+// adminGraphQLPage is a page of a paginated root list
+type adminGraphQLPage struct {
+	Items any
+	Total int64
+}
+
+var dateTimeScalar = &graphql.Scalar{
+	Name:        "DateTime",
+	Description: "RFC 3339 timestamp",
+	Serialize: func(v any) (any, error) {
+		t, ok := v.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("DateTime cannot represent %T", v)
+		}
+		return t.UTC().Format(time.RFC3339Nano), nil
+	},
+	Parse: func(v any) (any, error) {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("DateTime cannot represent %v", v)
+		}
+		return time.Parse(time.RFC3339, s)
+	},
+}
+
+// prop is a field read straight off the parent value
+func prop[T any](t graphql.Type, get func(T) any) *graphql.Field {
+	return &graphql.Field{
+		Type:    t,
+		Resolve: func(p graphql.ResolveParams) (any, error) { return get(p.Source.(T)), nil },
+	}
+}
+
+// guarded requires permission before f resolves
+func guarded(permission string, f *graphql.Field) *graphql.Field {
+	f.Permission = permission
+	return f
+}
+
+var (
+	listArgs = graphql.Args{
+		"limit": {Type: graphql.Int, Default: adminGraphQLListLimit},
+	}
+	pageArgs = graphql.Args{
+		"page":  {Type: graphql.Int, Default: 1},
+		"limit": {Type: graphql.Int, Default: adminGraphQLListLimit},
+	}
+	idArgs = graphql.Args{
+		"id": {Type: graphql.NewNonNull(graphql.ID)},
+	}
+)
+
+// limitArg returns the limit argument clamped to 1..adminGraphQLMaxLimit
+func limitArg(args map[string]any) int {
+	limit, _ := args["limit"].(int)
+	if limit < 1 {
+		return 1
+	}
+	if limit > adminGraphQLMaxLimit {
+		return adminGraphQLMaxLimit
+	}
+	return limit
+}
+
+func pageArg(args map[string]any) int {
+	page, _ := args["page"].(int)
+	if page < 1 {
+		return 1
+	}
+	return page
+}
+
+func idArg(args map[string]any) (uuid.UUID, error) {
+	id, err := uuid.Parse(args["id"].(string))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid id %q", args["id"])
+	}
+	return id, nil
+}
+
+func pageType(name string, item *graphql.Object) *graphql.Object {
+	return &graphql.Object{
+		Name: name,
+		Fields: graphql.Fields{
+			"items": prop(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(item))), func(p *adminGraphQLPage) any { return p.Items }),
+			"total": prop(graphql.NewNonNull(graphql.Int), func(p *adminGraphQLPage) any { return p.Total }),
+		},
+	}
+}
+
+// queryType builds the schema's object types and root query
+func (r *adminGraphQLResolver) queryType() *graphql.Object {
+	playerType := &graphql.Object{Name: "Player"}
+	sessionType := &graphql.Object{Name: "GameSession"}
+	spinType := &graphql.Object{Name: "Spin"}
+	assignmentType := &graphql.Object{Name: "ReelStripAssignment"}
+	configType := r.reelStripConfigType()
+	assetType := r.assetType()
+
+	playerType.Fields = graphql.Fields{
+		"id":               prop(graphql.NewNonNull(graphql.ID), func(p *player.Player) any { return p.ID }),
+		"username":         prop(graphql.NewNonNull(graphql.String), func(p *player.Player) any { return p.Username }),
+		"email":            guarded(adminDomain.PermissionPlayersPII, prop(graphql.String, func(p *player.Player) any { return p.Email })),
+		"balance":          guarded(adminDomain.PermissionBalancesRead, prop(graphql.Float, func(p *player.Player) any { return p.Balance })),
+		"gameId":           prop(graphql.ID, func(p *player.Player) any { return p.GameID }),
+		"jurisdictionCode": prop(graphql.String, func(p *player.Player) any { return p.JurisdictionCode }),
+		"totalSpins":       prop(graphql.NewNonNull(graphql.Int), func(p *player.Player) any { return p.TotalSpins }),
+		"totalWagered":     guarded(adminDomain.PermissionBalancesRead, prop(graphql.Float, func(p *player.Player) any { return p.TotalWagered })),
+		"totalWon":         guarded(adminDomain.PermissionBalancesRead, prop(graphql.Float, func(p *player.Player) any { return p.TotalWon })),
+		"isActive":         prop(graphql.NewNonNull(graphql.Boolean), func(p *player.Player) any { return p.IsActive }),
+		"isVerified":       prop(graphql.NewNonNull(graphql.Boolean), func(p *player.Player) any { return p.IsVerified }),
+		"createdAt":        prop(graphql.NewNonNull(dateTimeScalar), func(p *player.Player) any { return p.CreatedAt }),
+		"lastLoginAt":      prop(dateTimeScalar, func(p *player.Player) any { return p.LastLoginAt }),
+		"assignment": {
+			Type:        assignmentType,
+			Description: "Active reel strip assignment; null when the player uses the defaults",
+			Permission:  adminDomain.PermissionReelStripsRead,
+			Resolve:     r.playerAssignment,
+		},
+		"activeSession": {
+			Type:       sessionType,
+			Permission: adminDomain.PermissionSessionsRead,
+			Resolve:    r.playerActiveSession,
+		},
+		"sessions": {
+			Type:        graphql.NewList(graphql.NewNonNull(sessionType)),
+			Description: "Game sessions, newest first",
+			Args:        pageArgs,
+			Permission:  adminDomain.PermissionSessionsRead,
+			Resolve:     r.playerSessions,
+		},
+		"recentSpins": {
+			Type:        graphql.NewList(graphql.NewNonNull(spinType)),
+			Description: "Spins, newest first",
+			Args:        listArgs,
+			Permission:  adminDomain.PermissionSpinsRead,
+			Resolve:     r.playerRecentSpins,
+		},
+	}
+
+	sessionType.Fields = graphql.Fields{
+		"id":              prop(graphql.NewNonNull(graphql.ID), func(s *session.GameSession) any { return s.ID }),
+		"playerId":        prop(graphql.NewNonNull(graphql.ID), func(s *session.GameSession) any { return s.PlayerID }),
+		"betAmount":       prop(graphql.NewNonNull(graphql.Float), func(s *session.GameSession) any { return s.BetAmount }),
+		"startingBalance": guarded(adminDomain.PermissionBalancesRead, prop(graphql.Float, func(s *session.GameSession) any { return s.StartingBalance })),
+		"endingBalance":   guarded(adminDomain.PermissionBalancesRead, prop(graphql.Float, func(s *session.GameSession) any { return s.EndingBalance })),
+		"totalSpins":      prop(graphql.NewNonNull(graphql.Int), func(s *session.GameSession) any { return s.TotalSpins }),
+		"totalWagered":    guarded(adminDomain.PermissionBalancesRead, prop(graphql.Float, func(s *session.GameSession) any { return s.TotalWagered })),
+		"totalWon":        guarded(adminDomain.PermissionBalancesRead, prop(graphql.Float, func(s *session.GameSession) any { return s.TotalWon })),
+		"netChange":       guarded(adminDomain.PermissionBalancesRead, prop(graphql.Float, func(s *session.GameSession) any { return s.NetChange })),
+		"createdAt":       prop(graphql.NewNonNull(dateTimeScalar), func(s *session.GameSession) any { return s.CreatedAt }),
+		"endedAt":         prop(dateTimeScalar, func(s *session.GameSession) any { return s.EndedAt }),
+		"player": {
+			Type:       playerType,
+			Permission: adminDomain.PermissionPlayersRead,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return r.player(p, p.Source.(*session.GameSession).PlayerID)
+			},
+		},
+		"spins": {
+			Type:        graphql.NewList(graphql.NewNonNull(spinType)),
+			Description: "Spins in play order",
+			Args:        listArgs,
+			Permission:  adminDomain.PermissionSpinsRead,
+			Resolve:     r.sessionSpins,
+		},
+	}
+
+	spinType.Fields = graphql.Fields{
+		"id":                 prop(graphql.NewNonNull(graphql.ID), func(s *spin.Spin) any { return s.ID }),
+		"sessionId":          prop(graphql.NewNonNull(graphql.ID), func(s *spin.Spin) any { return s.SessionID }),
+		"playerId":           prop(graphql.NewNonNull(graphql.ID), func(s *spin.Spin) any { return s.PlayerID }),
+		"betAmount":          prop(graphql.NewNonNull(graphql.Float), func(s *spin.Spin) any { return s.BetAmount }),
+		"balanceBefore":      guarded(adminDomain.PermissionBalancesRead, prop(graphql.Float, func(s *spin.Spin) any { return s.BalanceBefore })),
+		"balanceAfter":       guarded(adminDomain.PermissionBalancesRead, prop(graphql.Float, func(s *spin.Spin) any { return s.BalanceAfter })),
+		"totalWin":           prop(graphql.NewNonNull(graphql.Float), func(s *spin.Spin) any { return s.TotalWin }),
+		"scatterCount":       prop(graphql.NewNonNull(graphql.Int), func(s *spin.Spin) any { return s.ScatterCount }),
+		"cascadeCount":       prop(graphql.NewNonNull(graphql.Int), func(s *spin.Spin) any { return len(s.Cascades) }),
+		"grid":               prop(graphql.NewList(graphql.NewList(graphql.String)), func(s *spin.Spin) any { return s.Grid }),
+		"isFreeSpin":         prop(graphql.NewNonNull(graphql.Boolean), func(s *spin.Spin) any { return s.IsFreeSpin }),
+		"freeSpinsSessionId": prop(graphql.ID, func(s *spin.Spin) any { return s.FreeSpinsSessionID }),
+		"freeSpinsTriggered": prop(graphql.NewNonNull(graphql.Boolean), func(s *spin.Spin) any { return s.FreeSpinsTriggered }),
+		"gameMode":           prop(graphql.String, func(s *spin.Spin) any { return s.GameMode }),
+		"gameModeCost":       prop(graphql.Float, func(s *spin.Spin) any { return s.GameModeCost }),
+		"rngProvider":        prop(graphql.NewNonNull(graphql.String), func(s *spin.Spin) any { return s.RNGProvider }),
+		"mathVersion":        prop(graphql.NewNonNull(graphql.String), func(s *spin.Spin) any { return s.MathVersion }),
+		"createdAt":          prop(graphql.NewNonNull(dateTimeScalar), func(s *spin.Spin) any { return s.CreatedAt }),
+		"player": {
+			Type:       playerType,
+			Permission: adminDomain.PermissionPlayersRead,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return r.player(p, p.Source.(*spin.Spin).PlayerID)
+			},
+		},
+		"session": {
+			Type:       sessionType,
+			Permission: adminDomain.PermissionSessionsRead,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return r.session(p, p.Source.(*spin.Spin).SessionID)
+			},
+		},
+	}
+
+	assignmentType.Fields = graphql.Fields{
+		"id":         prop(graphql.NewNonNull(graphql.ID), func(a *reelstrip.PlayerReelStripAssignment) any { return a.ID }),
+		"assignedAt": prop(graphql.NewNonNull(dateTimeScalar), func(a *reelstrip.PlayerReelStripAssignment) any { return a.AssignedAt }),
+		"assignedBy": prop(graphql.String, func(a *reelstrip.PlayerReelStripAssignment) any { return a.AssignedBy }),
+		"reason":     prop(graphql.String, func(a *reelstrip.PlayerReelStripAssignment) any { return a.Reason }),
+		"expiresAt":  prop(dateTimeScalar, func(a *reelstrip.PlayerReelStripAssignment) any { return a.ExpiresAt }),
+		"baseGameConfig": {
+			Type: configType,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return r.reelStripConfig(p, p.Source.(*reelstrip.PlayerReelStripAssignment).BaseGameConfigID)
+			},
+		},
+		"freeSpinsConfig": {
+			Type: configType,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return r.reelStripConfig(p, p.Source.(*reelstrip.PlayerReelStripAssignment).FreeSpinsConfigID)
+			},
+		},
+	}
+
+	return &graphql.Object{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"player": {
+				Type:       playerType,
+				Args:       idArgs,
+				Permission: adminDomain.PermissionPlayersRead,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					id, err := idArg(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					return r.player(p, id)
+				},
+			},
+			"players": {
+				Type: graphql.NewNonNull(pageType("PlayerPage", playerType)),
+				Args: graphql.Args{
+					"username": {Type: graphql.String, Description: "Partial match"},
+					"email":    {Type: graphql.String, Description: "Partial match"},
+					"gameId":   {Type: graphql.ID},
+					"isActive": {Type: graphql.Boolean},
+					"page":     pageArgs["page"],
+					"limit":    pageArgs["limit"],
+				},
+				Permission: adminDomain.PermissionPlayersRead,
+				Resolve:    r.listPlayers,
+			},
+			"session": {
+				Type:       sessionType,
+				Args:       idArgs,
+				Permission: adminDomain.PermissionSessionsRead,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					id, err := idArg(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					return r.session(p, id)
+				},
+			},
+			"spin": {
+				Type:       spinType,
+				Args:       idArgs,
+				Permission: adminDomain.PermissionSpinsRead,
+				Resolve:    r.spin,
+			},
+			"reelStripConfig": {
+				Type:       configType,
+				Args:       idArgs,
+				Permission: adminDomain.PermissionReelStripsRead,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					id, err := idArg(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					return r.reelStripConfig(p, &id)
+				},
+			},
+			"reelStripConfigs": {
+				Type: graphql.NewNonNull(pageType("ReelStripConfigPage", configType)),
+				Args: graphql.Args{
+					"gameMode": {Type: graphql.String},
+					"isActive": {Type: graphql.Boolean},
+					"page":     pageArgs["page"],
+					"limit":    pageArgs["limit"],
+				},
+				Permission: adminDomain.PermissionReelStripsRead,
+				Resolve:    r.listReelStripConfigs,
+			},
+			"asset": {
+				Type:       assetType,
+				Args:       idArgs,
+				Permission: adminDomain.PermissionAssetsRead,
+				Resolve:    r.asset,
+			},
+			"assets": {
+				Type:       graphql.NewNonNull(pageType("AssetPage", assetType)),
+				Args:       pageArgs,
+				Permission: adminDomain.PermissionAssetsRead,
+				Resolve:    r.listAssets,
+			},
+		},
+	}
+}
+
+func (r *adminGraphQLResolver) reelStripConfigType() *graphql.Object {
+	return &graphql.Object{
+		Name: "ReelStripConfig",
+		Fields: graphql.Fields{
+			"id":          prop(graphql.NewNonNull(graphql.ID), func(c *reelstrip.ReelStripConfig) any { return c.ID }),
+			"name":        prop(graphql.NewNonNull(graphql.String), func(c *reelstrip.ReelStripConfig) any { return c.Name }),
+			"gameMode":    prop(graphql.NewNonNull(graphql.String), func(c *reelstrip.ReelStripConfig) any { return c.GameMode }),
+			"description": prop(graphql.String, func(c *reelstrip.ReelStripConfig) any { return c.Description }),
+			"targetRtp":   prop(graphql.Float, func(c *reelstrip.ReelStripConfig) any { return c.TargetRTP }),
+			"isActive":    prop(graphql.NewNonNull(graphql.Boolean), func(c *reelstrip.ReelStripConfig) any { return c.IsActive }),
+			"isDefault":   prop(graphql.NewNonNull(graphql.Boolean), func(c *reelstrip.ReelStripConfig) any { return c.IsDefault }),
+			"isDemo":      prop(graphql.NewNonNull(graphql.Boolean), func(c *reelstrip.ReelStripConfig) any { return c.IsDemo }),
+			"activatedAt": prop(dateTimeScalar, func(c *reelstrip.ReelStripConfig) any { return c.ActivatedAt }),
+			"createdAt":   prop(graphql.NewNonNull(dateTimeScalar), func(c *reelstrip.ReelStripConfig) any { return c.CreatedAt }),
+			"createdBy":   prop(graphql.String, func(c *reelstrip.ReelStripConfig) any { return c.CreatedBy }),
+		},
+	}
+}
+
+func (r *adminGraphQLResolver) assetType() *graphql.Object {
+	return &graphql.Object{
+		Name: "Asset",
+		Fields: graphql.Fields{
+			"id":          prop(graphql.NewNonNull(graphql.ID), func(a *game.Asset) any { return a.ID }),
+			"name":        prop(graphql.NewNonNull(graphql.String), func(a *game.Asset) any { return a.Name }),
+			"description": prop(graphql.String, func(a *game.Asset) any { return a.Description }),
+			"objectName":  prop(graphql.NewNonNull(graphql.String), func(a *game.Asset) any { return a.ObjectName }),
+			"baseUrl":     prop(graphql.NewNonNull(graphql.String), func(a *game.Asset) any { return a.BaseURL }),
+			"isPrivate":   prop(graphql.NewNonNull(graphql.Boolean), func(a *game.Asset) any { return a.IsPrivate }),
+			"isActive":    prop(graphql.NewNonNull(graphql.Boolean), func(a *game.Asset) any { return a.IsActive }),
+			"createdAt":   prop(graphql.NewNonNull(dateTimeScalar), func(a *game.Asset) any { return a.CreatedAt }),
+			"updatedAt":   prop(graphql.NewNonNull(dateTimeScalar), func(a *game.Asset) any { return a.UpdatedAt }),
+		},
+	}
+}
+
+func (r *adminGraphQLResolver) player(p graphql.ResolveParams, id uuid.UUID) (any, error) {
+	pl, err := r.players.GetByID(p.Context, id)
+	if err != nil {
+		if graphQLNotFound(err) {
+			return nil, nil
+		}
+		return nil, r.fail(p.Context, err, "get player")
+	}
+	return pl, nil
+}
+
+func (r *adminGraphQLResolver) listPlayers(p graphql.ResolveParams) (any, error) {
+	filters := player.ListFilters{
+		Page:     pageArg(p.Args),
+		Limit:    limitArg(p.Args),
+		SortBy:   "created_at",
+		SortDesc: true,
+	}
+	filters.Username, _ = p.Args["username"].(string)
+	filters.Email, _ = p.Args["email"].(string)
+	if gameID, ok := p.Args["gameId"].(string); ok {
+		id, err := uuid.Parse(gameID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gameId %q", gameID)
+		}
+		filters.GameID = &id
+	}
+	if isActive, ok := p.Args["isActive"].(bool); ok {
+		filters.IsActive = &isActive
+	}
+
+	players, total, err := r.players.List(p.Context, filters)
+	if err != nil {
+		return nil, r.fail(p.Context, err, "list players")
+	}
+	return &adminGraphQLPage{Items: players, Total: total}, nil
+}
+
+func (r *adminGraphQLResolver) playerAssignment(p graphql.ResolveParams) (any, error) {
+	assignment, err := r.reelStrips.GetPlayerAssignment(p.Context, p.Source.(*player.Player).ID)
+	if err != nil {
+		if graphQLNotFound(err) {
+			return nil, nil
+		}
+		return nil, r.fail(p.Context, err, "get player assignment")
+	}
+	if assignment.ID == uuid.Nil {
+		return nil, nil // Placeholder cached for players without an assignment
+	}
+	return assignment, nil
+}
+
+func (r *adminGraphQLResolver) playerActiveSession(p graphql.ResolveParams) (any, error) {
+	s, err := r.sessions.GetActiveSessionByPlayer(p.Context, p.Source.(*player.Player).ID)
+	if err != nil {
+		if graphQLNotFound(err) {
+			return nil, nil
+		}
+		return nil, r.fail(p.Context, err, "get active session")
+	}
+	return s, nil
+}
+
+func (r *adminGraphQLResolver) playerSessions(p graphql.ResolveParams) (any, error) {
+	limit := limitArg(p.Args)
+	sessions, err := r.sessions.GetByPlayer(p.Context, p.Source.(*player.Player).ID, limit, (pageArg(p.Args)-1)*limit)
+	if err != nil {
+		return nil, r.fail(p.Context, err, "list sessions")
+	}
+	return sessions, nil
+}
+
+func (r *adminGraphQLResolver) playerRecentSpins(p graphql.ResolveParams) (any, error) {
+	spins, err := r.spins.GetByPlayer(p.Context, p.Source.(*player.Player).ID, limitArg(p.Args), 0)
+	if err != nil {
+		return nil, r.fail(p.Context, err, "list spins")
+	}
+	return spins, nil
+}
+
+func (r *adminGraphQLResolver) session(p graphql.ResolveParams, id uuid.UUID) (any, error) {
+	s, err := r.sessions.GetByID(p.Context, id)
+	if err != nil {
+		if graphQLNotFound(err) {
+			return nil, nil
+		}
+		return nil, r.fail(p.Context, err, "get session")
+	}
+	return s, nil
+}
+
+func (r *adminGraphQLResolver) sessionSpins(p graphql.ResolveParams) (any, error) {
+	spins, err := r.spins.GetBySession(p.Context, p.Source.(*session.GameSession).ID)
+	if err != nil {
+		return nil, r.fail(p.Context, err, "list session spins")
+	}
+	if limit := limitArg(p.Args); len(spins) > limit {
+		spins = spins[:limit]
+	}
+	return spins, nil
+}
+
+func (r *adminGraphQLResolver) spin(p graphql.ResolveParams) (any, error) {
+	id, err := idArg(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	s, err := r.spins.GetByID(p.Context, id)
+	if err != nil {
+		if graphQLNotFound(err) {
+			return nil, nil
+		}
+		return nil, r.fail(p.Context, err, "get spin")
+	}
+	return s, nil
+}
+
+func (r *adminGraphQLResolver) reelStripConfig(p graphql.ResolveParams, id *uuid.UUID) (any, error) {
+	if id == nil {
+		return nil, nil
+	}
+	config, err := r.reelStrips.GetConfigByID(p.Context, *id)
+	if err != nil {
+		if graphQLNotFound(err) {
+			return nil, nil
+		}
+		return nil, r.fail(p.Context, err, "get reel strip config")
+	}
+	return config, nil
+}
+
+func (r *adminGraphQLResolver) listReelStripConfigs(p graphql.ResolveParams) (any, error) {
+	filters := &reelstrip.ConfigListFilters{Page: pageArg(p.Args), Limit: limitArg(p.Args)}
+	if gameMode, ok := p.Args["gameMode"].(string); ok {
+		filters.GameMode = &gameMode
+	}
+	if isActive, ok := p.Args["isActive"].(bool); ok {
+		filters.IsActive = &isActive
+	}
+
+	configs, total, err := r.reelStrips.ListConfigs(p.Context, filters)
+	if err != nil {
+		if err == reelstrip.ErrInvalidGameMode {
+			return nil, err
+		}
+		return nil, r.fail(p.Context, err, "list reel strip configs")
+	}
+	return &adminGraphQLPage{Items: configs, Total: total}, nil
+}
+
+func (r *adminGraphQLResolver) asset(p graphql.ResolveParams) (any, error) {
+	id, err := idArg(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	asset, err := r.games.GetAssetByID(p.Context, id)
+	if err != nil {
+		if graphQLNotFound(err) {
+			return nil, nil
+		}
+		return nil, r.fail(p.Context, err, "get asset")
+	}
+	return asset, nil
+}
+
+func (r *adminGraphQLResolver) listAssets(p graphql.ResolveParams) (any, error) {
+	assets, total, err := r.games.ListAssets(p.Context, pageArg(p.Args), limitArg(p.Args))
+	if err != nil {
+		return nil, r.fail(p.Context, err, "list assets")
+	}
+	return &adminGraphQLPage{Items: assets, Total: total}, nil
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	adminDomain "github.com/slotmachine/backend/domain/admin"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubGraphQLPlayers struct {
+	player.Repository
+	players map[uuid.UUID]*player.Player
+}
+
+func (s *stubGraphQLPlayers) GetByID(_ context.Context, id uuid.UUID) (*player.Player, error) {
+	if p, ok := s.players[id]; ok {
+		return p, nil
+	}
+	return nil, player.ErrPlayerNotFound
+}
+
+type stubGraphQLSpins struct {
+	spin.Repository
+	spins []*spin.Spin
+}
+
+func (s *stubGraphQLSpins) GetByPlayer(_ context.Context, _ uuid.UUID, limit, _ int) ([]*spin.Spin, error) {
+	if len(s.spins) > limit {
+		return s.spins[:limit], nil
+	}
+	return s.spins, nil
+}
+
+type stubGraphQLReelStrips struct {
+	reelstrip.Service
+	assignment *reelstrip.PlayerReelStripAssignment
+	configs    map[uuid.UUID]*reelstrip.ReelStripConfig
+}
+
+func (s *stubGraphQLReelStrips) GetPlayerAssignment(_ context.Context, playerID uuid.UUID) (*reelstrip.PlayerReelStripAssignment, error) {
+	if s.assignment == nil {
+		return &reelstrip.PlayerReelStripAssignment{PlayerID: playerID}, nil
+	}
+	return s.assignment, nil
+}
+
+func (s *stubGraphQLReelStrips) GetConfigByID(_ context.Context, id uuid.UUID) (*reelstrip.ReelStripConfig, error) {
+	if c, ok := s.configs[id]; ok {
+		return c, nil
+	}
+	return nil, reelstrip.ErrConfigNotFound
+}
+
+func TestAdminGraphQLHandler_Query(t *testing.T) {
+	playerID := uuid.New()
+	configID := uuid.New()
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	players := &stubGraphQLPlayers{players: map[uuid.UUID]*player.Player{
+		playerID: {ID: playerID, Username: "alice", Email: "alice@example.com", Balance: 250, CreatedAt: created},
+	}}
+	spins := &stubGraphQLSpins{spins: []*spin.Spin{
+		{ID: uuid.New(), PlayerID: playerID, BetAmount: 1, TotalWin: 4, CreatedAt: created},
+		{ID: uuid.New(), PlayerID: playerID, BetAmount: 1, CreatedAt: created},
+	}}
+	reelStrips := &stubGraphQLReelStrips{
+		assignment: &reelstrip.PlayerReelStripAssignment{ID: uuid.New(), PlayerID: playerID, BaseGameConfigID: &configID, Reason: "VIP"},
+		configs:    map[uuid.UUID]*reelstrip.ReelStripConfig{configID: {ID: configID, Name: "v2-high-rtp"}},
+	}
+	h := NewAdminGraphQLHandler(players, nil, spins, reelStrips, nil, logger.New("error", "json"))
+
+	query := func(t *testing.T, admin *adminDomain.Admin, body string) map[string]any {
+		t.Helper()
+		app := fiber.New()
+		app.Post("/graphql", func(c *fiber.Ctx) error {
+			c.Locals("admin", admin)
+			return c.Next()
+		}, h.Query)
+
+		req := httptest.NewRequest("POST", "/graphql", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		raw, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		var result map[string]any
+		require.NoError(t, json.Unmarshal(raw, &result))
+		return result
+	}
+
+	composed := `{"query":"query($id: ID!) { player(id: $id) { username email balance assignment { reason baseGameConfig { name } } recentSpins(limit: 1) { totalWin } } }",` +
+		`"variables":{"id":"` + playerID.String() + `"}}`
+
+	t.Run("should compose player, assignment and recent spins for an admin", func(t *testing.T) {
+		result := query(t, &adminDomain.Admin{Role: adminDomain.RoleAdmin}, composed)
+
+		assert.Nil(t, result["errors"])
+		assert.Equal(t, map[string]any{"player": map[string]any{
+			"username":    "alice",
+			"email":       "alice@example.com",
+			"balance":     float64(250),
+			"assignment":  map[string]any{"reason": "VIP", "baseGameConfig": map[string]any{"name": "v2-high-rtp"}},
+			"recentSpins": []any{map[string]any{"totalWin": float64(4)}},
+		}}, result["data"])
+	})
+
+	t.Run("should withhold fields an operator was not granted", func(t *testing.T) {
+		operator := &adminDomain.Admin{
+			Role:        adminDomain.RoleOperator,
+			Permissions: adminDomain.StringArray{adminDomain.PermissionPlayersRead, adminDomain.PermissionSpinsRead},
+		}
+		result := query(t, operator, composed)
+
+		data := result["data"].(map[string]any)["player"].(map[string]any)
+		assert.Equal(t, "alice", data["username"])
+		assert.Nil(t, data["email"])
+		assert.Nil(t, data["balance"])
+		assert.Nil(t, data["assignment"])
+		assert.Len(t, data["recentSpins"], 1)
+
+		errs := result["errors"].([]any)
+		require.Len(t, errs, 3)
+		for _, e := range errs {
+			assert.Equal(t, "FORBIDDEN", e.(map[string]any)["extensions"].(map[string]any)["code"])
+		}
+	})
+
+	t.Run("should resolve a missing player and an unassigned player to null", func(t *testing.T) {
+		reelStrips.assignment = nil
+		defer func() {
+			reelStrips.assignment = &reelstrip.PlayerReelStripAssignment{ID: uuid.New(), BaseGameConfigID: &configID}
+		}()
+
+		result := query(t, &adminDomain.Admin{Role: adminDomain.RoleSuperAdmin},
+			`{"query":"{ missing: player(id: \"`+uuid.NewString()+`\") { username } me: player(id: \"`+playerID.String()+`\") { assignment { reason } } }"}`)
+
+		assert.Nil(t, result["errors"])
+		assert.Equal(t, map[string]any{"missing": nil, "me": map[string]any{"assignment": nil}}, result["data"])
+	})
+
+	t.Run("should reject a body without a query", func(t *testing.T) {
+		app := fiber.New()
+		app.Post("/graphql", h.Query)
+		req := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestAdminGraphQLHandler_Schema(t *testing.T) {
+	h := NewAdminGraphQLHandler(nil, nil, nil, nil, nil, logger.New("error", "json"))
+
+	sdl := h.schema.SDL()
+	assert.Contains(t, sdl, `email: String @auth(permission: "players:pii")`)
+	assert.Contains(t, sdl, "players(email: String, gameId: ID, isActive: Boolean, limit: Int = 20, page: Int = 1, username: String): PlayerPage!")
+	assert.Contains(t, sdl, "scalar DateTime")
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	adminDomain "github.com/slotmachine/backend/domain/admin"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/impersonation"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/service"
+)
+
+// AdminImpersonationHandler handles admin endpoints for read-only player impersonation
+type AdminImpersonationHandler struct {
+	impersonationService *service.ImpersonationService
+	logger               *logger.Logger
+}
+
+// NewAdminImpersonationHandler creates a new admin impersonation handler
+func NewAdminImpersonationHandler(impersonationService *service.ImpersonationService, log *logger.Logger) *AdminImpersonationHandler {
+	return &AdminImpersonationHandler{
+		impersonationService: impersonationService,
+		logger:               log,
+	}
+}
+
+// ImpersonatePlayer issues a read-only token for the player's APIs
+// POST /admin/players/:id/impersonate
+func (h *AdminImpersonationHandler) ImpersonatePlayer(c *fiber.Ctx) error {
+	admin, ok := c.Locals("admin").(*adminDomain.Admin)
+	if !ok || admin == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeUnauthorized,
+			Message: "Admin authentication required",
+		})
+	}
+
+	playerID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidPlayerID,
+			Message: "Invalid player ID format",
+		})
+	}
+
+	var req dto.ImpersonatePlayerRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	grant, token, err := h.impersonationService.Issue(c.Context(), admin, playerID, req.Reason, c.IP())
+	if err != nil {
+		switch {
+		case errors.Is(err, impersonation.ErrReasonRequired):
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeValidationError, Message: err.Error()})
+		case errors.Is(err, player.ErrPlayerNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodePlayerNotFound, Message: "Player not found"})
+		}
+		h.logger.WithTrace(c).Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to issue impersonation token")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to issue impersonation token",
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data": dto.ImpersonationTokenResponse{
+			Token:     token,
+			ExpiresAt: grant.ExpiresAt,
+			Grant:     grant,
+		},
+	})
+}
+
+// ListGrants lists impersonation grants, newest first
+// GET /admin/impersonations?player_id=&admin_id=&limit=
+func (h *AdminImpersonationHandler) ListGrants(c *fiber.Ctx) error {
+	filters := impersonation.ListFilters{Limit: c.QueryInt("limit", 0)}
+	if v := c.Query("player_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidPlayerID,
+				Message: "Invalid player ID format",
+			})
+		}
+		filters.PlayerID = &id
+	}
+	if v := c.Query("admin_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidAdminID,
+				Message: "Invalid admin ID format",
+			})
+		}
+		filters.AdminID = &id
+	}
+
+	grants, err := h.impersonationService.ListGrants(c.Context(), filters)
+	if err != nil {
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to list impersonation grants")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to list impersonation grants",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    grants,
+	})
+}
+
+// ListAccesses lists the player API requests made with a grant, oldest first
+// GET /admin/impersonations/:id/accesses
+func (h *AdminImpersonationHandler) ListAccesses(c *fiber.Ctx) error {
+	grantID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid grant ID format",
+		})
+	}
+
+	accesses, err := h.impersonationService.ListAccesses(c.Context(), grantID)
+	if err != nil {
+		if errors.Is(err, impersonation.ErrGrantNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeGrantNotFound, Message: "Impersonation grant not found"})
+		}
+		h.logger.WithTrace(c).Error().Err(err).Str("grant_id", grantID.String()).Msg("Failed to list impersonation accesses")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to list impersonation accesses",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    accesses,
+	})
+}
+
+// RevokeGrant ends an impersonation grant before it expires
+// DELETE /admin/impersonations/:id
+func (h *AdminImpersonationHandler) RevokeGrant(c *fiber.Ctx) error {
+	admin, ok := c.Locals("admin").(*adminDomain.Admin)
+	if !ok || admin == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeUnauthorized,
+			Message: "Admin authentication required",
+		})
+	}
+
+	grantID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid grant ID format",
+		})
+	}
+
+	grant, err := h.impersonationService.Revoke(c.Context(), grantID, admin.ID)
+	if err != nil {
+		if errors.Is(err, impersonation.ErrGrantNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeGrantNotFound, Message: "Impersonation grant not found"})
+		}
+		h.logger.WithTrace(c).Error().Err(err).Str("grant_id", grantID.String()).Msg("Failed to revoke impersonation grant")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to revoke impersonation grant",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    grant,
+	})
+}
@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/jurisdiction"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminJurisdictionHandler handles admin endpoints for jurisdiction rules and player assignment
+type AdminJurisdictionHandler struct {
+	jurisdictionService jurisdiction.Service
+	logger              *logger.Logger
+}
+
+// NewAdminJurisdictionHandler creates a new admin jurisdiction handler
+func NewAdminJurisdictionHandler(jurisdictionService jurisdiction.Service, log *logger.Logger) *AdminJurisdictionHandler {
+	return &AdminJurisdictionHandler{
+		jurisdictionService: jurisdictionService,
+		logger:              log,
+	}
+}
+
+// ListJurisdictions lists all jurisdictions
+// GET /admin/jurisdictions
+func (h *AdminJurisdictionHandler) ListJurisdictions(c *fiber.Ctx) error {
+	list, err := h.jurisdictionService.List(c.Context())
+	if err != nil {
+		return h.jurisdictionError(c, err, "Failed to list jurisdictions")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    list,
+	})
+}
+
+// GetJurisdiction gets a jurisdiction by code
+// GET /admin/jurisdictions/:code
+func (h *AdminJurisdictionHandler) GetJurisdiction(c *fiber.Ctx) error {
+	j, err := h.jurisdictionService.Get(c.Context(), c.Params("code"))
+	if err != nil {
+		return h.jurisdictionError(c, err, "Failed to get jurisdiction")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    j,
+	})
+}
+
+// CreateJurisdiction creates a new jurisdiction
+// POST /admin/jurisdictions
+func (h *AdminJurisdictionHandler) CreateJurisdiction(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.JurisdictionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	j := jurisdictionFromRequest(req.Code, &req)
+	if err := h.jurisdictionService.Create(c.Context(), j); err != nil {
+		return h.jurisdictionError(c, err, "Failed to create jurisdiction")
+	}
+
+	log.Info().Str("jurisdiction", j.Code).Str("admin", adminUsername(c)).Msg("Jurisdiction created")
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    j,
+	})
+}
+
+// UpdateJurisdiction replaces a jurisdiction's rules
+// PUT /admin/jurisdictions/:code
+func (h *AdminJurisdictionHandler) UpdateJurisdiction(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.JurisdictionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	j := jurisdictionFromRequest(c.Params("code"), &req)
+	if err := h.jurisdictionService.Update(c.Context(), j); err != nil {
+		return h.jurisdictionError(c, err, "Failed to update jurisdiction")
+	}
+
+	log.Info().Str("jurisdiction", j.Code).Str("admin", adminUsername(c)).Msg("Jurisdiction updated")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    j,
+	})
+}
+
+// DeleteJurisdiction deletes a jurisdiction; its players fall back to the default
+// DELETE /admin/jurisdictions/:code
+func (h *AdminJurisdictionHandler) DeleteJurisdiction(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	code := c.Params("code")
+	if err := h.jurisdictionService.Delete(c.Context(), code); err != nil {
+		return h.jurisdictionError(c, err, "Failed to delete jurisdiction")
+	}
+
+	log.Info().Str("jurisdiction", code).Str("admin", adminUsername(c)).Msg("Jurisdiction deleted")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Jurisdiction deleted successfully",
+	})
+}
+
+// SetPlayerJurisdiction attaches a player to a jurisdiction
+// PUT /admin/players/:id/jurisdiction
+func (h *AdminJurisdictionHandler) SetPlayerJurisdiction(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	var req dto.SetPlayerJurisdictionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := h.jurisdictionService.SetPlayerJurisdiction(c.Context(), playerID, req.Code); err != nil {
+		return h.jurisdictionError(c, err, "Failed to set player jurisdiction")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Player jurisdiction updated successfully",
+	})
+}
+
+// jurisdictionError maps jurisdiction service errors to responses
+func (h *AdminJurisdictionHandler) jurisdictionError(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, jurisdiction.ErrJurisdictionNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeNotFound, Message: "Jurisdiction not found"})
+	case errors.Is(err, player.ErrPlayerNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeNotFound, Message: "Player not found"})
+	case errors.Is(err, jurisdiction.ErrJurisdictionExists):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeDuplicateCode, Message: err.Error()})
+	case errors.Is(err, jurisdiction.ErrInvalidJurisdiction):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeValidationError, Message: err.Error()})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
+
+// jurisdictionFromRequest builds a jurisdiction from a request body
+func jurisdictionFromRequest(code string, req *dto.JurisdictionRequest) *jurisdiction.Jurisdiction {
+	return &jurisdiction.Jurisdiction{
+		Code:                code,
+		Name:                req.Name,
+		MaxBet:              req.MaxBet,
+		MaxWin:              req.MaxWin,
+		RealityCheckMinutes: req.RealityCheckMinutes,
+		AutoplayAllowed:     req.AutoplayAllowed,
+		RTPDisclosure:       req.RTPDisclosure,
+		DisclosedRTP:        req.DisclosedRTP,
+	}
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/kyc"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminKYCHandler handles admin endpoints for player identity verification
+type AdminKYCHandler struct {
+	kycService kyc.Service
+	logger     *logger.Logger
+}
+
+// NewAdminKYCHandler creates a new admin KYC handler
+func NewAdminKYCHandler(kycService kyc.Service, log *logger.Logger) *AdminKYCHandler {
+	return &AdminKYCHandler{
+		kycService: kycService,
+		logger:     log,
+	}
+}
+
+// GetPlayerKYC returns a player's verification status
+// GET /admin/players/:id/kyc
+func (h *AdminKYCHandler) GetPlayerKYC(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	v, err := h.kycService.GetStatus(c.Context(), playerID)
+	if err != nil {
+		return kycError(c, h.logger, err, "Failed to get player KYC status")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    v,
+	})
+}
+
+// SetPlayerKYC records a manual verification decision
+// PUT /admin/players/:id/kyc
+func (h *AdminKYCHandler) SetPlayerKYC(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	var req dto.SetPlayerKYCRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	v, err := h.kycService.SetStatus(c.Context(), playerID, req.Status, adminUsername(c))
+	if err != nil {
+		return kycError(c, h.logger, err, "Failed to set player KYC status")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    v,
+	})
+}
+
+// RefreshPlayerKYC polls the provider for the outcome of a pending verification
+// POST /admin/players/:id/kyc/refresh
+func (h *AdminKYCHandler) RefreshPlayerKYC(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	v, err := h.kycService.Refresh(c.Context(), playerID)
+	if err != nil {
+		return kycError(c, h.logger, err, "Failed to refresh player KYC status")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    v,
+	})
+}
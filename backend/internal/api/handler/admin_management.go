@@ -6,6 +6,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	adminDomain "github.com/slotmachine/backend/domain/admin"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
@@ -43,7 +44,7 @@ func (h *AdminManagementHandler) CreateAdmin(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		log.Warn().Err(err).Msg("Invalid request body")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -63,23 +64,23 @@ func (h *AdminManagementHandler) CreateAdmin(c *fiber.Ctx) error {
 		switch err {
 		case adminDomain.ErrDuplicateUsername:
 			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
-				Error:   "duplicate_username",
+				Error:   domainErrors.CodeDuplicateUsername,
 				Message: "Username already exists",
 			})
 		case adminDomain.ErrDuplicateEmail:
 			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
-				Error:   "duplicate_email",
+				Error:   domainErrors.CodeDuplicateEmail,
 				Message: "Email already exists",
 			})
 		case adminDomain.ErrWeakPassword:
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "weak_password",
+				Error:   domainErrors.CodeWeakPassword,
 				Message: "Password must be at least 8 characters long",
 			})
 		default:
 			log.Error().Err(err).Msg("Failed to create admin")
 			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-				Error:   "failed_to_create_admin",
+				Error:   domainErrors.CodeFailedToCreateAdmin,
 				Message: "Failed to create admin",
 			})
 		}
@@ -105,7 +106,7 @@ func (h *AdminManagementHandler) GetAdmin(c *fiber.Ctx) error {
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid admin ID")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_admin_id",
+			Error:   domainErrors.CodeInvalidAdminID,
 			Message: "Invalid admin ID",
 		})
 	}
@@ -114,13 +115,13 @@ func (h *AdminManagementHandler) GetAdmin(c *fiber.Ctx) error {
 	if err != nil {
 		if err == adminDomain.ErrAdminNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "admin_not_found",
+				Error:   domainErrors.CodeAdminNotFound,
 				Message: "Admin not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to get admin")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_admin",
+			Error:   domainErrors.CodeFailedToGetAdmin,
 			Message: "Failed to retrieve admin",
 		})
 	}
@@ -159,7 +160,7 @@ func (h *AdminManagementHandler) ListAdmins(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to list admins")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_list_admins",
+			Error:   domainErrors.CodeFailedToListAdmins,
 			Message: "Failed to retrieve admins",
 		})
 	}
@@ -199,7 +200,7 @@ func (h *AdminManagementHandler) UpdateAdmin(c *fiber.Ctx) error {
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid admin ID")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_admin_id",
+			Error:   domainErrors.CodeInvalidAdminID,
 			Message: "Invalid admin ID",
 		})
 	}
@@ -208,7 +209,7 @@ func (h *AdminManagementHandler) UpdateAdmin(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		log.Warn().Err(err).Msg("Invalid request body")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -229,18 +230,18 @@ func (h *AdminManagementHandler) UpdateAdmin(c *fiber.Ctx) error {
 		switch err {
 		case adminDomain.ErrAdminNotFound:
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "admin_not_found",
+				Error:   domainErrors.CodeAdminNotFound,
 				Message: "Admin not found",
 			})
 		case adminDomain.ErrDuplicateEmail:
 			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
-				Error:   "duplicate_email",
+				Error:   domainErrors.CodeDuplicateEmail,
 				Message: "Email already exists",
 			})
 		default:
 			log.Error().Err(err).Msg("Failed to update admin")
 			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-				Error:   "failed_to_update_admin",
+				Error:   domainErrors.CodeFailedToUpdateAdmin,
 				Message: "Failed to update admin",
 			})
 		}
@@ -273,7 +274,7 @@ func (h *AdminManagementHandler) DeleteAdmin(c *fiber.Ctx) error {
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid admin ID")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_admin_id",
+			Error:   domainErrors.CodeInvalidAdminID,
 			Message: "Invalid admin ID",
 		})
 	}
@@ -282,23 +283,23 @@ func (h *AdminManagementHandler) DeleteAdmin(c *fiber.Ctx) error {
 		switch err {
 		case adminDomain.ErrAdminNotFound:
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "admin_not_found",
+				Error:   domainErrors.CodeAdminNotFound,
 				Message: "Admin not found",
 			})
 		case adminDomain.ErrCannotDeleteSelf:
 			return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
-				Error:   "cannot_delete_self",
+				Error:   domainErrors.CodeCannotDeleteSelf,
 				Message: "Cannot delete your own account",
 			})
 		case adminDomain.ErrCannotModifySuperAdmin:
 			return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
-				Error:   "cannot_modify_super_admin",
+				Error:   domainErrors.CodeCannotModifySuperAdmin,
 				Message: "Insufficient permissions to modify super admin",
 			})
 		default:
 			log.Error().Err(err).Msg("Failed to delete admin")
 			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-				Error:   "failed_to_delete_admin",
+				Error:   domainErrors.CodeFailedToDeleteAdmin,
 				Message: "Failed to delete admin",
 			})
 		}
@@ -331,7 +332,7 @@ func (h *AdminManagementHandler) ResetPassword(c *fiber.Ctx) error {
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid admin ID")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_admin_id",
+			Error:   domainErrors.CodeInvalidAdminID,
 			Message: "Invalid admin ID",
 		})
 	}
@@ -340,7 +341,7 @@ func (h *AdminManagementHandler) ResetPassword(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		log.Warn().Err(err).Msg("Invalid request body")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -349,18 +350,18 @@ func (h *AdminManagementHandler) ResetPassword(c *fiber.Ctx) error {
 		switch err {
 		case adminDomain.ErrAdminNotFound:
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "admin_not_found",
+				Error:   domainErrors.CodeAdminNotFound,
 				Message: "Admin not found",
 			})
 		case adminDomain.ErrWeakPassword:
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "weak_password",
+				Error:   domainErrors.CodeWeakPassword,
 				Message: "Password must be at least 8 characters long",
 			})
 		default:
 			log.Error().Err(err).Msg("Failed to reset password")
 			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-				Error:   "failed_to_reset_password",
+				Error:   domainErrors.CodeFailedToResetPassword,
 				Message: "Failed to reset password",
 			})
 		}
@@ -395,6 +396,13 @@ func (h *AdminManagementHandler) SuspendAdmin(c *fiber.Ctx) error {
 	return h.updateAdminStatus(c, "suspend")
 }
 
+// adminStatusFailureCodes maps status actions to their failure codes
+var adminStatusFailureCodes = map[string]domainErrors.Code{
+	"activate":   domainErrors.CodeFailedToActivate,
+	"deactivate": domainErrors.CodeFailedToDeactivate,
+	"suspend":    domainErrors.CodeFailedToSuspend,
+}
+
 // Helper function to update admin status
 func (h *AdminManagementHandler) updateAdminStatus(c *fiber.Ctx, action string) error {
 	log := h.logger.WithTrace(c)
@@ -410,7 +418,7 @@ func (h *AdminManagementHandler) updateAdminStatus(c *fiber.Ctx, action string)
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid admin ID")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_admin_id",
+			Error:   domainErrors.CodeInvalidAdminID,
 			Message: "Invalid admin ID",
 		})
 	}
@@ -428,13 +436,13 @@ func (h *AdminManagementHandler) updateAdminStatus(c *fiber.Ctx, action string)
 	if serviceErr != nil {
 		if serviceErr == adminDomain.ErrAdminNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "admin_not_found",
+				Error:   domainErrors.CodeAdminNotFound,
 				Message: "Admin not found",
 			})
 		}
 		log.Error().Err(serviceErr).Msg("Failed to " + action + " admin")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_" + action,
+			Error:   adminStatusFailureCodes[action],
 			Message: "Failed to " + action + " admin",
 		})
 	}
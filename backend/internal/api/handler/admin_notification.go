@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/notification"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminNotificationHandler handles admin endpoints for sending in-game notifications
+type AdminNotificationHandler struct {
+	notificationService notification.Service
+	logger              *logger.Logger
+}
+
+// NewAdminNotificationHandler creates a new admin notification handler
+func NewAdminNotificationHandler(notificationService notification.Service, log *logger.Logger) *AdminNotificationHandler {
+	return &AdminNotificationHandler{
+		notificationService: notificationService,
+		logger:              log,
+	}
+}
+
+// SendNotification sends a notification to one player, or broadcasts it to every player
+// POST /admin/notifications
+func (h *AdminNotificationHandler) SendNotification(c *fiber.Ctx) error {
+	var req dto.SendNotificationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	n := &notification.Notification{
+		Type:      notification.Type(req.Type),
+		Title:     req.Title,
+		Body:      req.Body,
+		Data:      req.Data,
+		ExpiresAt: req.ExpiresAt,
+	}
+	if req.PlayerID != "" {
+		playerID, err := uuid.Parse(req.PlayerID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidPlayerID,
+				Message: "Invalid player ID format",
+			})
+		}
+		n.PlayerID = &playerID
+	}
+
+	if err := h.notificationService.Send(c.Context(), n); err != nil {
+		if errors.Is(err, notification.ErrInvalidType) || errors.Is(err, notification.ErrContentRequired) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeValidationError, Message: err.Error()})
+		}
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to send notification")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to send notification",
+		})
+	}
+
+	h.logger.WithTrace(c).Info().
+		Str("notification_id", n.ID.String()).
+		Str("type", req.Type).
+		Bool("broadcast", n.Broadcast()).
+		Str("admin", adminUsername(c)).
+		Msg("Notification sent by admin")
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    n,
+	})
+}
@@ -1,11 +1,16 @@
 package handler
 
 import (
+	"errors"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	adminDomain "github.com/slotmachine/backend/domain/admin"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/player"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
@@ -41,7 +46,7 @@ func (h *AdminPlayerHandler) GetPlayer(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_player_id",
+			Error:   domainErrors.CodeInvalidPlayerID,
 			Message: "Invalid player ID format",
 		})
 	}
@@ -51,7 +56,7 @@ func (h *AdminPlayerHandler) GetPlayer(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to get player")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "get_player_failed",
+			Error:   domainErrors.CodeGetPlayerFailed,
 			Message: "Failed to retrieve player",
 		})
 	}
@@ -74,7 +79,7 @@ func (h *AdminPlayerHandler) CreatePlayer(c *fiber.Ctx) error {
 
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -82,19 +87,19 @@ func (h *AdminPlayerHandler) CreatePlayer(c *fiber.Ctx) error {
 	// Validate required fields
 	if req.Username == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "validation_error",
+			Error:   domainErrors.CodeValidationError,
 			Message: "Username is required",
 		})
 	}
 	if req.Email == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "validation_error",
+			Error:   domainErrors.CodeValidationError,
 			Message: "Email is required",
 		})
 	}
 	if req.Password == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "validation_error",
+			Error:   domainErrors.CodeValidationError,
 			Message: "Password is required",
 		})
 	}
@@ -105,7 +110,7 @@ func (h *AdminPlayerHandler) CreatePlayer(c *fiber.Ctx) error {
 		parsedID, err := uuid.Parse(*req.GameID)
 		if err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "invalid_game_id",
+				Error:   domainErrors.CodeInvalidGameID,
 				Message: "Invalid game ID format",
 			})
 		}
@@ -133,7 +138,7 @@ func (h *AdminPlayerHandler) CreatePlayer(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Str("username", req.Username).Msg("Failed to create player")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "create_player_failed",
+			Error:   domainErrors.CodeCreatePlayerFailed,
 			Message: err.Error(),
 		})
 	}
@@ -208,7 +213,7 @@ func (h *AdminPlayerHandler) ListPlayers(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to list players")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "list_players_failed",
+			Error:   domainErrors.CodeListPlayersFailed,
 			Message: "Failed to retrieve players list",
 		})
 	}
@@ -221,6 +226,116 @@ func (h *AdminPlayerHandler) ListPlayers(c *fiber.Ctx) error {
 	})
 }
 
+// SearchPlayers searches players by partial username/email, balance range,
+// registration and last login windows, tags and assigned reel strip config
+// GET /admin/players/search
+func (h *AdminPlayerHandler) SearchPlayers(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	filters := adminDomain.PlayerSearchFilters{
+		Username: c.Query("username"),
+		Email:    c.Query("email"),
+		SortBy:   c.Query("sort_by", "created_at"),
+		SortDesc: true,
+		Cursor:   c.Query("cursor"),
+		Limit:    20,
+	}
+
+	invalid := func(param string) error {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidParams,
+			Message: "Invalid " + param + " parameter",
+		})
+	}
+
+	for param, dst := range map[string]**uuid.UUID{
+		"game_id":            &filters.GameID,
+		"assigned_config_id": &filters.AssignedConfigID,
+	} {
+		if v := c.Query(param); v != "" {
+			id, err := uuid.Parse(v)
+			if err != nil {
+				return invalid(param)
+			}
+			*dst = &id
+		}
+	}
+	for param, dst := range map[string]**float64{
+		"min_balance": &filters.MinBalance,
+		"max_balance": &filters.MaxBalance,
+	} {
+		if v := c.Query(param); v != "" {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return invalid(param)
+			}
+			*dst = &f
+		}
+	}
+	for param, dst := range map[string]**time.Time{
+		"registered_from": &filters.RegisteredFrom,
+		"registered_to":   &filters.RegisteredTo,
+		"last_login_from": &filters.LastLoginFrom,
+		"last_login_to":   &filters.LastLoginTo,
+	} {
+		if v := c.Query(param); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return invalid(param)
+			}
+			*dst = &t
+		}
+	}
+	if v := c.Query("is_active"); v != "" {
+		active, err := strconv.ParseBool(v)
+		if err != nil {
+			return invalid("is_active")
+		}
+		filters.IsActive = &active
+	}
+	if v := c.Query("sort_desc"); v != "" {
+		desc, err := strconv.ParseBool(v)
+		if err != nil {
+			return invalid("sort_desc")
+		}
+		filters.SortDesc = desc
+	}
+	if v := c.Query("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l <= 0 || l > 100 {
+			return invalid("limit")
+		}
+		filters.Limit = l
+	}
+	if v := c.Query("tags"); v != "" {
+		filters.Tags = strings.Split(v, ",")
+	}
+
+	players, nextCursor, err := h.adminService.SearchPlayers(c.Context(), filters)
+	if err != nil {
+		switch {
+		case errors.Is(err, player.ErrInvalidSearchCursor):
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidCursor,
+				Message: "Cursor is malformed or was issued for a different sort",
+			})
+		case errors.Is(err, player.ErrInvalidInput):
+			return invalid("sort_by")
+		}
+		log.Error().Err(err).Msg("Failed to search players")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeSearchPlayersFailed,
+			Message: "Failed to search players",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"players":     players,
+		"next_cursor": nextCursor,
+		"limit":       filters.Limit,
+	})
+}
+
 // ActivatePlayer activates a player account
 func (h *AdminPlayerHandler) ActivatePlayer(c *fiber.Ctx) error {
 	log := h.logger.WithTrace(c)
@@ -229,7 +344,7 @@ func (h *AdminPlayerHandler) ActivatePlayer(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_player_id",
+			Error:   domainErrors.CodeInvalidPlayerID,
 			Message: "Invalid player ID format",
 		})
 	}
@@ -245,7 +360,7 @@ func (h *AdminPlayerHandler) ActivatePlayer(c *fiber.Ctx) error {
 	if err := h.adminService.ActivatePlayer(c.Context(), playerID, updatedBy); err != nil {
 		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to activate player")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "activate_player_failed",
+			Error:   domainErrors.CodeActivatePlayerFailed,
 			Message: err.Error(),
 		})
 	}
@@ -268,7 +383,7 @@ func (h *AdminPlayerHandler) DeactivatePlayer(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_player_id",
+			Error:   domainErrors.CodeInvalidPlayerID,
 			Message: "Invalid player ID format",
 		})
 	}
@@ -284,7 +399,7 @@ func (h *AdminPlayerHandler) DeactivatePlayer(c *fiber.Ctx) error {
 	if err := h.adminService.DeactivatePlayer(c.Context(), playerID, updatedBy); err != nil {
 		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to deactivate player")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "deactivate_player_failed",
+			Error:   domainErrors.CodeDeactivatePlayerFailed,
 			Message: err.Error(),
 		})
 	}
@@ -307,7 +422,7 @@ func (h *AdminPlayerHandler) ForceLogoutPlayer(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_player_id",
+			Error:   domainErrors.CodeInvalidPlayerID,
 			Message: "Invalid player ID format",
 		})
 	}
@@ -323,7 +438,7 @@ func (h *AdminPlayerHandler) ForceLogoutPlayer(c *fiber.Ctx) error {
 	if err := h.adminService.ForceLogoutPlayer(c.Context(), playerID, adminUUID); err != nil {
 		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to force logout player")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "force_logout_failed",
+			Error:   domainErrors.CodeForceLogoutFailed,
 			Message: err.Error(),
 		})
 	}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/approval"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/domain/reelstrip"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/pkg/cache"
@@ -14,6 +16,7 @@ import (
 // AdminPlayerAssignmentHandler handles admin endpoints for player reel strip assignments
 type AdminPlayerAssignmentHandler struct {
 	reelStripService reelstrip.Service
+	approvals        approval.Service
 	logger           *logger.Logger
 	cache            *cache.Cache
 }
@@ -21,11 +24,13 @@ type AdminPlayerAssignmentHandler struct {
 // NewAdminPlayerAssignmentHandler creates a new admin player assignment handler
 func NewAdminPlayerAssignmentHandler(
 	reelStripService reelstrip.Service,
+	approvals approval.Service,
 	log *logger.Logger,
 	cache *cache.Cache,
 ) *AdminPlayerAssignmentHandler {
 	return &AdminPlayerAssignmentHandler{
 		reelStripService: reelStripService,
+		approvals:        approvals,
 		logger:           log,
 		cache:            cache,
 	}
@@ -40,7 +45,7 @@ func (h *AdminPlayerAssignmentHandler) CreateAssignment(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		log.Warn().Err(err).Msg("Invalid request body")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -48,11 +53,21 @@ func (h *AdminPlayerAssignmentHandler) CreateAssignment(c *fiber.Ctx) error {
 	// Validate that at least one config is provided
 	if req.BaseGameConfigID == nil && req.FreeSpinsConfigID == nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "At least one config (base_game or free_spins) must be provided",
 		})
 	}
 
+	if h.approvals.Required() {
+		return proposeChange(c, h.approvals, h.logger, approval.ActionAssignPlayerReelStrips, &approval.Params{
+			PlayerID:          &req.PlayerID,
+			BaseGameConfigID:  req.BaseGameConfigID,
+			FreeSpinsConfigID: req.FreeSpinsConfigID,
+			Reason:            req.Reason,
+			ExpiresAt:         req.ExpiresAt,
+		})
+	}
+
 	log.Info().
 		Str("player_id", req.PlayerID.String()).
 		Msg("Creating player assignment")
@@ -78,7 +93,7 @@ func (h *AdminPlayerAssignmentHandler) CreateAssignment(c *fiber.Ctx) error {
 			); err != nil {
 				log.Error().Err(err).Msg("Failed to update base game config")
 				return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-					Error:   "failed_to_update_base_game",
+					Error:   domainErrors.CodeFailedToUpdateBaseGame,
 					Message: "Failed to update base game configuration",
 				})
 			}
@@ -96,7 +111,7 @@ func (h *AdminPlayerAssignmentHandler) CreateAssignment(c *fiber.Ctx) error {
 			); err != nil {
 				log.Error().Err(err).Msg("Failed to update free spins config")
 				return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-					Error:   "failed_to_update_free_spins",
+					Error:   domainErrors.CodeFailedToUpdateFreeSpins,
 					Message: "Failed to update free spins configuration",
 				})
 			}
@@ -116,7 +131,7 @@ func (h *AdminPlayerAssignmentHandler) CreateAssignment(c *fiber.Ctx) error {
 			); err != nil {
 				log.Error().Err(err).Msg("Failed to assign base game config")
 				return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-					Error:   "failed_to_assign_base_game",
+					Error:   domainErrors.CodeFailedToAssignBaseGame,
 					Message: "Failed to assign base game configuration",
 				})
 			}
@@ -135,7 +150,7 @@ func (h *AdminPlayerAssignmentHandler) CreateAssignment(c *fiber.Ctx) error {
 			); err != nil {
 				log.Error().Err(err).Msg("Failed to assign free spins config")
 				return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-					Error:   "failed_to_assign_free_spins",
+					Error:   domainErrors.CodeFailedToAssignFreeSpins,
 					Message: "Failed to assign free spins configuration",
 				})
 			}
@@ -150,7 +165,7 @@ func (h *AdminPlayerAssignmentHandler) CreateAssignment(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get created assignment")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_assignment",
+			Error:   domainErrors.CodeFailedToGetAssignment,
 			Message: "Assignment created but failed to retrieve details",
 		})
 	}
@@ -172,7 +187,7 @@ func (h *AdminPlayerAssignmentHandler) GetPlayerAssignment(c *fiber.Ctx) error {
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid player ID")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_player_id",
+			Error:   domainErrors.CodeInvalidPlayerID,
 			Message: "Invalid player ID",
 		})
 	}
@@ -181,14 +196,14 @@ func (h *AdminPlayerAssignmentHandler) GetPlayerAssignment(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get player assignment")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_assignment",
+			Error:   domainErrors.CodeFailedToGetAssignment,
 			Message: "Failed to retrieve player assignment",
 		})
 	}
 
 	if assignment == nil || !assignment.IsActive {
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-			Error:   "assignment_not_found",
+			Error:   domainErrors.CodeAssignmentNotFound,
 			Message: "No active assignment found for this player",
 		})
 	}
@@ -209,7 +224,7 @@ func (h *AdminPlayerAssignmentHandler) UpdateAssignment(c *fiber.Ctx) error {
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid player ID")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_player_id",
+			Error:   domainErrors.CodeInvalidPlayerID,
 			Message: "Invalid player ID",
 		})
 	}
@@ -218,7 +233,7 @@ func (h *AdminPlayerAssignmentHandler) UpdateAssignment(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		log.Warn().Err(err).Msg("Invalid request body")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -228,14 +243,14 @@ func (h *AdminPlayerAssignmentHandler) UpdateAssignment(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get player assignment")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_assignment",
+			Error:   domainErrors.CodeFailedToGetAssignment,
 			Message: "Failed to retrieve player assignment",
 		})
 	}
 
 	if assignment == nil || !assignment.IsActive {
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-			Error:   "assignment_not_found",
+			Error:   domainErrors.CodeAssignmentNotFound,
 			Message: "No active assignment found for this player",
 		})
 	}
@@ -255,6 +270,17 @@ func (h *AdminPlayerAssignmentHandler) UpdateAssignment(c *fiber.Ctx) error {
 		expiresAt = req.ExpiresAt
 	}
 
+	deactivate := req.IsActive != nil && !*req.IsActive
+	if h.approvals.Required() && !deactivate && (req.BaseGameConfigID != nil || req.FreeSpinsConfigID != nil) {
+		return proposeChange(c, h.approvals, h.logger, approval.ActionAssignPlayerReelStrips, &approval.Params{
+			PlayerID:          &playerID,
+			BaseGameConfigID:  req.BaseGameConfigID,
+			FreeSpinsConfigID: req.FreeSpinsConfigID,
+			Reason:            reason,
+			ExpiresAt:         expiresAt,
+		})
+	}
+
 	// Update base game config if provided
 	if req.BaseGameConfigID != nil {
 		if err := h.reelStripService.AssignConfigToPlayer(
@@ -268,7 +294,7 @@ func (h *AdminPlayerAssignmentHandler) UpdateAssignment(c *fiber.Ctx) error {
 		); err != nil {
 			log.Error().Err(err).Msg("Failed to update base game config")
 			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-				Error:   "failed_to_update_base_game",
+				Error:   domainErrors.CodeFailedToUpdateBaseGame,
 				Message: "Failed to update base game configuration",
 			})
 		}
@@ -287,18 +313,18 @@ func (h *AdminPlayerAssignmentHandler) UpdateAssignment(c *fiber.Ctx) error {
 		); err != nil {
 			log.Error().Err(err).Msg("Failed to update free spins config")
 			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-				Error:   "failed_to_update_free_spins",
+				Error:   domainErrors.CodeFailedToUpdateFreeSpins,
 				Message: "Failed to update free spins configuration",
 			})
 		}
 	}
 
 	// If IsActive is being set to false, remove the assignment
-	if req.IsActive != nil && !*req.IsActive {
+	if deactivate {
 		if err := h.reelStripService.RemovePlayerAssignment(c.Context(), playerID); err != nil {
 			log.Error().Err(err).Msg("Failed to deactivate assignment")
 			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-				Error:   "failed_to_deactivate",
+				Error:   domainErrors.CodeFailedToDeactivate,
 				Message: "Failed to deactivate player assignment",
 			})
 		}
@@ -320,7 +346,7 @@ func (h *AdminPlayerAssignmentHandler) UpdateAssignment(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get updated assignment")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_assignment",
+			Error:   domainErrors.CodeFailedToGetAssignment,
 			Message: "Assignment updated but failed to retrieve details",
 		})
 	}
@@ -342,7 +368,7 @@ func (h *AdminPlayerAssignmentHandler) AssignConfigToPlayer(c *fiber.Ctx) error
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid player ID")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_player_id",
+			Error:   domainErrors.CodeInvalidPlayerID,
 			Message: "Invalid player ID",
 		})
 	}
@@ -359,11 +385,22 @@ func (h *AdminPlayerAssignmentHandler) AssignConfigToPlayer(c *fiber.Ctx) error
 	if err := c.BodyParser(&req); err != nil {
 		log.Warn().Err(err).Msg("Invalid request body")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
 
+	if h.approvals.Required() {
+		params := &approval.Params{PlayerID: &playerID, Reason: req.Reason, ExpiresAt: req.ExpiresAt}
+		switch req.GameMode {
+		case "base_game":
+			params.BaseGameConfigID = &req.ConfigID
+		case "free_spins":
+			params.FreeSpinsConfigID = &req.ConfigID
+		}
+		return proposeChange(c, h.approvals, h.logger, approval.ActionAssignPlayerReelStrips, params)
+	}
+
 	if err := h.reelStripService.AssignConfigToPlayer(
 		c.Context(),
 		playerID,
@@ -375,7 +412,7 @@ func (h *AdminPlayerAssignmentHandler) AssignConfigToPlayer(c *fiber.Ctx) error
 	); err != nil {
 		log.Error().Err(err).Msg("Failed to assign config to player")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_assign",
+			Error:   domainErrors.CodeFailedToAssign,
 			Message: "Failed to assign configuration to player",
 		})
 	}
@@ -404,7 +441,7 @@ func (h *AdminPlayerAssignmentHandler) RemoveAssignment(c *fiber.Ctx) error {
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid player ID")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_player_id",
+			Error:   domainErrors.CodeInvalidPlayerID,
 			Message: "Invalid player ID",
 		})
 	}
@@ -412,13 +449,13 @@ func (h *AdminPlayerAssignmentHandler) RemoveAssignment(c *fiber.Ctx) error {
 	if err := h.reelStripService.RemovePlayerAssignment(c.Context(), playerID); err != nil {
 		if err == reelstrip.ErrAssignmentNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "assignment_not_found",
+				Error:   domainErrors.CodeAssignmentNotFound,
 				Message: "No assignment found for this player",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to remove assignment")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_remove_assignment",
+			Error:   domainErrors.CodeFailedToRemoveAssignment,
 			Message: "Failed to remove player assignment",
 		})
 	}
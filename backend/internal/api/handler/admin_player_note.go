@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	adminDomain "github.com/slotmachine/backend/domain/admin"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/playernote"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminPlayerNoteHandler handles admin endpoints for support notes and flags on players
+type AdminPlayerNoteHandler struct {
+	noteService playernote.Service
+	logger      *logger.Logger
+}
+
+// NewAdminPlayerNoteHandler creates a new admin player note handler
+func NewAdminPlayerNoteHandler(noteService playernote.Service, log *logger.Logger) *AdminPlayerNoteHandler {
+	return &AdminPlayerNoteHandler{
+		noteService: noteService,
+		logger:      log,
+	}
+}
+
+// ListNotes returns a player's notes and flags, newest first
+// GET /admin/players/:id/notes?kind=flag
+func (h *AdminPlayerNoteHandler) ListNotes(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	var kind *playernote.Kind
+	if v := c.Query("kind"); v != "" {
+		k := playernote.Kind(v)
+		kind = &k
+	}
+
+	notes, err := h.noteService.List(c.Context(), playerID, kind)
+	if err != nil {
+		return h.noteError(c, err, "Failed to list player notes")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    notes,
+	})
+}
+
+// CreateNote adds a note or flag to a player
+// POST /admin/players/:id/notes
+func (h *AdminPlayerNoteHandler) CreateNote(c *fiber.Ctx) error {
+	admin, ok := c.Locals("admin").(*adminDomain.Admin)
+	if !ok || admin == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeUnauthorized,
+			Message: "Admin authentication required",
+		})
+	}
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	var req dto.CreatePlayerNoteRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	note, err := h.noteService.Add(c.Context(), admin, playerID, playernote.Kind(req.Kind), req.Body)
+	if err != nil {
+		return h.noteError(c, err, "Failed to add player note")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    note,
+	})
+}
+
+// UpdateNote replaces the body of a player's note or flag
+// PUT /admin/players/:id/notes/:noteId
+func (h *AdminPlayerNoteHandler) UpdateNote(c *fiber.Ctx) error {
+	admin, ok := c.Locals("admin").(*adminDomain.Admin)
+	if !ok || admin == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeUnauthorized,
+			Message: "Admin authentication required",
+		})
+	}
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+	noteID, ok := parseUUIDParam(c, "noteId", "Invalid note ID")
+	if !ok {
+		return nil
+	}
+
+	var req dto.UpdatePlayerNoteRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	note, err := h.noteService.Edit(c.Context(), admin, playerID, noteID, req.Body)
+	if err != nil {
+		return h.noteError(c, err, "Failed to update player note")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    note,
+	})
+}
+
+// DeleteNote removes a player's note or flag from listings
+// DELETE /admin/players/:id/notes/:noteId
+func (h *AdminPlayerNoteHandler) DeleteNote(c *fiber.Ctx) error {
+	admin, ok := c.Locals("admin").(*adminDomain.Admin)
+	if !ok || admin == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeUnauthorized,
+			Message: "Admin authentication required",
+		})
+	}
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+	noteID, ok := parseUUIDParam(c, "noteId", "Invalid note ID")
+	if !ok {
+		return nil
+	}
+
+	if err := h.noteService.Delete(c.Context(), admin, playerID, noteID); err != nil {
+		return h.noteError(c, err, "Failed to delete player note")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Note deleted successfully",
+	})
+}
+
+// noteError maps a player note service error to its response
+func (h *AdminPlayerNoteHandler) noteError(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, playernote.ErrInvalidKind),
+		errors.Is(err, playernote.ErrBodyRequired),
+		errors.Is(err, playernote.ErrBodyTooLong):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeInvalidNote, Message: err.Error()})
+	case errors.Is(err, playernote.ErrFlagExists):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeFlagExists, Message: err.Error()})
+	case errors.Is(err, playernote.ErrNoteNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeNoteNotFound, Message: "Note not found"})
+	case errors.Is(err, player.ErrPlayerNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodePlayerNotFound, Message: "Player not found"})
+	}
+	h.logger.WithTrace(c).Error().Err(err).Str("player_id", c.Params("id")).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
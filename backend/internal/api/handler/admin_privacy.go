@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/privacy"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminPrivacyHandler handles admin endpoints for data subject requests
+type AdminPrivacyHandler struct {
+	privacyService privacy.Service
+	logger         *logger.Logger
+}
+
+// NewAdminPrivacyHandler creates a new admin privacy handler
+func NewAdminPrivacyHandler(privacyService privacy.Service, log *logger.Logger) *AdminPrivacyHandler {
+	return &AdminPrivacyHandler{
+		privacyService: privacyService,
+		logger:         log,
+	}
+}
+
+// ExportPlayerData downloads every record held about a player as a zip archive
+// GET /admin/players/:id/data-export
+func (h *AdminPrivacyHandler) ExportPlayerData(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	archive, err := h.privacyService.Export(c.Context(), playerID)
+	if err != nil {
+		return privacyError(c, h.logger, err, "Failed to export player data")
+	}
+	return sendArchive(c, h.logger, archive)
+}
+
+// ErasePlayer anonymizes a player on request
+// Provably fair and financial records are kept, linked only to the pseudonymized player.
+// POST /admin/players/:id/erase
+func (h *AdminPrivacyHandler) ErasePlayer(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	var req dto.ErasePlayerRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	erasure, err := h.privacyService.Erase(c.Context(), playerID, req.Reason, adminUsername(c))
+	if err != nil {
+		return privacyError(c, h.logger, err, "Failed to erase player")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    erasure,
+	})
+}
@@ -1,33 +1,64 @@
 package handler
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/approval"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/domain/reelstrip"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/pkg/cache"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
+// MaxConcurrentSimulations limits the draft strip simulations run at once; each already spreads over every CPU
+const MaxConcurrentSimulations = 2
+
+// SimulationTimeout bounds a draft strip simulation, finishing it before the server's write timeout
+const SimulationTimeout = 25 * time.Second
+
+// errTooManySimulations is returned by previewStrips while MaxConcurrentSimulations are running
+var errTooManySimulations = errors.New("too many reel strip simulations in progress")
+
 // AdminReelStripHandler handles admin endpoints for reel strip configuration management
 type AdminReelStripHandler struct {
-	reelStripService reelstrip.Service
-	logger           *logger.Logger
-	cache            *cache.Cache
+	reelStripService  reelstrip.Service
+	validator         reelstrip.Validator
+	simulator         reelstrip.Simulator
+	generator         reelstrip.Generator
+	approvals         approval.Service // Proposes activations, defaults and operator RTP changes when approval is required
+	logger            *logger.Logger
+	cache             *cache.Cache
+	simulations       chan struct{} // Holds a token per running simulation
+	simulationTimeout time.Duration
 }
 
 // NewAdminReelStripHandler creates a new admin reel strip handler
 func NewAdminReelStripHandler(
 	reelStripService reelstrip.Service,
+	validator reelstrip.Validator,
+	simulator reelstrip.Simulator,
+	generator reelstrip.Generator,
+	approvals approval.Service,
 	log *logger.Logger,
 	cache *cache.Cache,
 ) *AdminReelStripHandler {
 	return &AdminReelStripHandler{
-		reelStripService: reelStripService,
-		logger:           log,
-		cache:            cache,
+		reelStripService:  reelStripService,
+		validator:         validator,
+		simulator:         simulator,
+		generator:         generator,
+		approvals:         approvals,
+		logger:            log,
+		cache:             cache,
+		simulations:       make(chan struct{}, MaxConcurrentSimulations),
+		simulationTimeout: SimulationTimeout,
 	}
 }
 
@@ -40,7 +71,7 @@ func (h *AdminReelStripHandler) CreateConfig(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		log.Warn().Err(err).Msg("Invalid request body")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -48,21 +79,28 @@ func (h *AdminReelStripHandler) CreateConfig(c *fiber.Ctx) error {
 	log.Info().
 		Str("name", req.Name).
 		Str("game_mode", req.GameMode).
+		Bool("is_demo", req.IsDemo).
 		Msg("Creating reel strip config")
 
-	config, err := h.reelStripService.CreateConfig(
-		c.Context(),
-		req.Name,
-		req.GameMode,
-		req.Description,
-		req.ReelStripIDs,
-		req.TargetRTP,
-		nil,
-	)
+	var config *reelstrip.ReelStripConfig
+	var err error
+	if req.IsDemo {
+		config, err = h.reelStripService.CreateDemoConfig(c.Context(), req.Name, req.GameMode, req.Description, req.ReelStripIDs, req.TargetRTP)
+	} else {
+		config, err = h.reelStripService.CreateConfig(
+			c.Context(),
+			req.Name,
+			req.GameMode,
+			req.Description,
+			req.ReelStripIDs,
+			req.TargetRTP,
+			nil,
+		)
+	}
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to create config")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_create_config",
+			Error:   domainErrors.CodeFailedToCreateConfig,
 			Message: "Failed to create reel strip configuration",
 		})
 	}
@@ -91,7 +129,7 @@ func (h *AdminReelStripHandler) GetConfig(c *fiber.Ctx) error {
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid config ID")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_config_id",
+			Error:   domainErrors.CodeInvalidConfigID,
 			Message: "Invalid configuration ID",
 		})
 	}
@@ -100,13 +138,13 @@ func (h *AdminReelStripHandler) GetConfig(c *fiber.Ctx) error {
 	if err != nil {
 		if err == reelstrip.ErrConfigNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "config_not_found",
+				Error:   domainErrors.CodeConfigNotFound,
 				Message: "Reel strip configuration not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to get config")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_config",
+			Error:   domainErrors.CodeFailedToGetConfig,
 			Message: "Failed to retrieve configuration",
 		})
 	}
@@ -143,11 +181,20 @@ func (h *AdminReelStripHandler) ListConfigs(c *fiber.Ctx) error {
 		filters.IsDefault = &isDefault
 	}
 
+	// is_demo filter
+	if isDemoStr := c.Query("is_demo"); isDemoStr != "" {
+		isDemo := isDemoStr == "true"
+		filters.IsDemo = &isDemo
+	}
+
 	// name filter
 	if name := c.Query("name"); name != "" {
 		filters.Name = &name
 	}
 
+	// deleted=true lists soft-deleted configs instead of live ones
+	filters.Deleted = c.Query("deleted") == "true"
+
 	// Pagination
 	filters.Page = c.QueryInt("page", 1)
 	filters.Limit = c.QueryInt("limit", 20)
@@ -156,7 +203,7 @@ func (h *AdminReelStripHandler) ListConfigs(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to list configs")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_list_configs",
+			Error:   domainErrors.CodeFailedToListConfigs,
 			Message: "Failed to retrieve configurations",
 		})
 	}
@@ -177,7 +224,7 @@ func (h *AdminReelStripHandler) ListConfigs(c *fiber.Ctx) error {
 	})
 }
 
-// UpdateConfig updates a reel strip configuration
+// UpdateConfig updates a reel strip configuration, or proposes it when approval is required
 // PUT /admin/reel-strip-configs/:id
 func (h *AdminReelStripHandler) UpdateConfig(c *fiber.Ctx) error {
 	log := h.logger.WithTrace(c)
@@ -186,7 +233,7 @@ func (h *AdminReelStripHandler) UpdateConfig(c *fiber.Ctx) error {
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid config ID")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_config_id",
+			Error:   domainErrors.CodeInvalidConfigID,
 			Message: "Invalid configuration ID",
 		})
 	}
@@ -195,7 +242,7 @@ func (h *AdminReelStripHandler) UpdateConfig(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		log.Warn().Err(err).Msg("Invalid request body")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -204,40 +251,39 @@ func (h *AdminReelStripHandler) UpdateConfig(c *fiber.Ctx) error {
 	if err != nil {
 		if err == reelstrip.ErrConfigNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "config_not_found",
+				Error:   domainErrors.CodeConfigNotFound,
 				Message: "Reel strip configuration not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to get config")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_config",
+			Error:   domainErrors.CodeFailedToGetConfig,
 			Message: "Failed to retrieve configuration",
 		})
 	}
 
-	// Update fields if provided
-	if req.Name != nil {
-		config.Name = *req.Name
-	}
-	if req.Description != nil {
-		config.Description = *req.Description
+	update := &reelstrip.ConfigUpdate{
+		Name:        req.Name,
+		Description: req.Description,
+		TargetRTP:   req.TargetRTP,
+		Notes:       req.Notes,
 	}
-	if req.TargetRTP != nil {
-		config.TargetRTP = *req.TargetRTP
-	}
-	if req.Notes != nil {
-		config.Notes = *req.Notes
+	if h.approvals.Required() {
+		return proposeChange(c, h.approvals, h.logger, approval.ActionUpdateReelStripConfig, &approval.Params{ConfigID: &configID, ConfigUpdate: update})
 	}
 
-	config.UpdatedAt = time.Now()
+	config, err = h.reelStripService.UpdateConfig(c.Context(), configID, update)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to update config")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToUpdateConfig,
+			Message: "Failed to update configuration",
+		})
+	}
 
 	// Clear cache for this config and related data
 	h.clearConfigCache(c, configID, config.GameMode)
 
-	// Save the updated config (you'll need to implement UpdateConfig in the service)
-	// For now, this assumes you have the repo method available
-	// You may need to add this to the service interface
-
 	response := mapConfigToResponse(config)
 	return c.JSON(fiber.Map{
 		"success": true,
@@ -245,7 +291,7 @@ func (h *AdminReelStripHandler) UpdateConfig(c *fiber.Ctx) error {
 	})
 }
 
-// ActivateConfig activates a reel strip configuration
+// ActivateConfig activates a reel strip configuration, or proposes it when approval is required
 // POST /admin/reel-strip-configs/:id/activate
 func (h *AdminReelStripHandler) ActivateConfig(c *fiber.Ctx) error {
 	log := h.logger.WithTrace(c)
@@ -254,7 +300,7 @@ func (h *AdminReelStripHandler) ActivateConfig(c *fiber.Ctx) error {
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid config ID")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_config_id",
+			Error:   domainErrors.CodeInvalidConfigID,
 			Message: "Invalid configuration ID",
 		})
 	}
@@ -264,21 +310,25 @@ func (h *AdminReelStripHandler) ActivateConfig(c *fiber.Ctx) error {
 	if err != nil {
 		if err == reelstrip.ErrConfigNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "config_not_found",
+				Error:   domainErrors.CodeConfigNotFound,
 				Message: "Reel strip configuration not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to get config")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_config",
+			Error:   domainErrors.CodeFailedToGetConfig,
 			Message: "Failed to retrieve configuration",
 		})
 	}
 
+	if h.approvals.Required() {
+		return proposeChange(c, h.approvals, h.logger, approval.ActionActivateReelStripConfig, &approval.Params{ConfigID: &configID})
+	}
+
 	if err := h.reelStripService.ActivateConfig(c.Context(), configID); err != nil {
 		log.Error().Err(err).Msg("Failed to activate config")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_activate_config",
+			Error:   domainErrors.CodeFailedToActivateConfig,
 			Message: "Failed to activate configuration",
 		})
 	}
@@ -303,7 +353,7 @@ func (h *AdminReelStripHandler) DeactivateConfig(c *fiber.Ctx) error {
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid config ID")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_config_id",
+			Error:   domainErrors.CodeInvalidConfigID,
 			Message: "Invalid configuration ID",
 		})
 	}
@@ -313,13 +363,13 @@ func (h *AdminReelStripHandler) DeactivateConfig(c *fiber.Ctx) error {
 	if err != nil {
 		if err == reelstrip.ErrConfigNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "config_not_found",
+				Error:   domainErrors.CodeConfigNotFound,
 				Message: "Reel strip configuration not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to get config")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_config",
+			Error:   domainErrors.CodeFailedToGetConfig,
 			Message: "Failed to retrieve configuration",
 		})
 	}
@@ -327,7 +377,7 @@ func (h *AdminReelStripHandler) DeactivateConfig(c *fiber.Ctx) error {
 	if err := h.reelStripService.DeactivateConfig(c.Context(), configID); err != nil {
 		log.Error().Err(err).Msg("Failed to deactivate config")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_deactivate_config",
+			Error:   domainErrors.CodeFailedToDeactivateConfig,
 			Message: "Failed to deactivate configuration",
 		})
 	}
@@ -343,7 +393,114 @@ func (h *AdminReelStripHandler) DeactivateConfig(c *fiber.Ctx) error {
 	})
 }
 
-// SetDefaultConfig sets a configuration as the default for its game mode
+// DeleteConfig soft deletes a reel strip configuration
+// DELETE /admin/reel-strip-configs/:id
+func (h *AdminReelStripHandler) DeleteConfig(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	configID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		log.Warn().Err(err).Msg("Invalid config ID")
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidConfigID,
+			Message: "Invalid configuration ID",
+		})
+	}
+
+	// Get config to know game mode for cache invalidation
+	config, err := h.reelStripService.GetConfigByID(c.Context(), configID)
+	if err == nil {
+		err = h.reelStripService.DeleteConfig(c.Context(), configID)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, reelstrip.ErrConfigNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeConfigNotFound,
+				Message: "Reel strip configuration not found",
+			})
+		case errors.Is(err, reelstrip.ErrConfigIsDefault):
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeConfigIsDefault,
+				Message: "Set another default before deleting this configuration",
+			})
+		case errors.Is(err, reelstrip.ErrConfigInUse):
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeConfigInUse,
+				Message: "Remove the assignments, operator defaults, canaries, segment targets and demo settings using this configuration before deleting it",
+			})
+		}
+		log.Error().Err(err).Msg("Failed to delete config")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToDeleteConfig,
+			Message: "Failed to delete configuration",
+		})
+	}
+
+	// Clear cache for this config and related data
+	h.clearConfigCache(c, configID, config.GameMode)
+
+	log.Info().Str("config_id", configID.String()).Msg("Deleted config")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Configuration deleted successfully",
+	})
+}
+
+// RestoreConfig restores a soft-deleted reel strip configuration, leaving it inactive
+// POST /admin/reel-strip-configs/:id/restore
+func (h *AdminReelStripHandler) RestoreConfig(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	configID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		log.Warn().Err(err).Msg("Invalid config ID")
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidConfigID,
+			Message: "Invalid configuration ID",
+		})
+	}
+
+	if err := h.reelStripService.RestoreConfig(c.Context(), configID); err != nil {
+		switch {
+		case errors.Is(err, reelstrip.ErrConfigNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeConfigNotFound,
+				Message: "Deleted reel strip configuration not found",
+			})
+		case errors.Is(err, reelstrip.ErrConfigNameTaken):
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeDuplicateName,
+				Message: "Another configuration already uses this name",
+			})
+		}
+		log.Error().Err(err).Msg("Failed to restore config")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToRestoreConfig,
+			Message: "Failed to restore configuration",
+		})
+	}
+
+	config, err := h.reelStripService.GetConfigByID(c.Context(), configID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get restored config")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToGetConfig,
+			Message: "Failed to retrieve configuration",
+		})
+	}
+	h.clearConfigCache(c, configID, config.GameMode)
+
+	log.Info().Str("config_id", configID.String()).Msg("Restored config")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    mapConfigToResponse(config),
+	})
+}
+
+// SetDefaultConfig sets a configuration as the default for its game mode, or proposes it when approval is required
 // POST /admin/reel-strip-configs/set-default
 func (h *AdminReelStripHandler) SetDefaultConfig(c *fiber.Ctx) error {
 	log := h.logger.WithTrace(c)
@@ -352,21 +509,26 @@ func (h *AdminReelStripHandler) SetDefaultConfig(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		log.Warn().Err(err).Msg("Invalid request body")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
 
+	if h.approvals.Required() {
+		params := &approval.Params{ConfigID: &req.ConfigID, GameMode: req.GameMode}
+		return proposeChange(c, h.approvals, h.logger, approval.ActionSetDefaultReelStripConfig, params)
+	}
+
 	if err := h.reelStripService.SetDefaultConfig(c.Context(), req.ConfigID, req.GameMode); err != nil {
 		if err == reelstrip.ErrConfigNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "config_not_found",
+				Error:   domainErrors.CodeConfigNotFound,
 				Message: "Reel strip configuration not found",
 			})
 		}
 		log.Error().Err(err).Msg("Failed to set default config")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_set_default",
+			Error:   domainErrors.CodeFailedToSetDefault,
 			Message: "Failed to set default configuration",
 		})
 	}
@@ -385,6 +547,575 @@ func (h *AdminReelStripHandler) SetDefaultConfig(c *fiber.Ctx) error {
 	})
 }
 
+// CacheStats reports in-process cache hits, misses and invalidations for reel strip data
+// GET /admin/reel-strip-configs/cache-stats
+func (h *AdminReelStripHandler) CacheStats(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    h.cache.Stats(cache.ReelStripFamilies...),
+	})
+}
+
+// ValidateStrips checks an uploaded strip set against the rules generated strips follow
+// POST /admin/reel-strip-configs/validate
+func (h *AdminReelStripHandler) ValidateStrips(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.ValidateReelStripsRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Warn().Err(err).Msg("Invalid request body")
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	violations, err := h.validator.Validate(req.GameMode, req.Strips)
+	if err != nil {
+		if err == reelstrip.ErrInvalidGameMode || err == reelstrip.ErrIncompleteSet {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeValidationError,
+				Message: err.Error(),
+			})
+		}
+		log.Error().Err(err).Str("game_mode", req.GameMode).Msg("Failed to validate reel strips")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to validate reel strips",
+		})
+	}
+
+	log.Info().
+		Str("game_mode", req.GameMode).
+		Int("violations", len(violations)).
+		Msg("Validated reel strips")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": dto.ValidateReelStripsResponse{
+			GameMode:       req.GameMode,
+			Valid:          len(violations) == 0,
+			ViolationCount: len(violations),
+			Violations:     violations,
+		},
+	})
+}
+
+// PreviewStrips describes a draft strip set before it is saved: each reel's checksum and layout,
+// the rules it breaks and an RTP estimate from a quick simulation
+// POST /admin/reel-strip-configs/preview
+func (h *AdminReelStripHandler) PreviewStrips(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.PreviewReelStripsRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Warn().Err(err).Msg("Invalid request body")
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+	if len(req.Strips) != 5 {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeValidationError,
+			Message: reelstrip.ErrIncompleteSet.Error(),
+		})
+	}
+	spins, ok := previewSpins(req.Spins)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeValidationError,
+			Message: fmt.Sprintf("spins must be between 1 and %d", reelstrip.PreviewSpins),
+		})
+	}
+
+	// Reels left empty keep the base config's strip
+	strips := req.Strips
+	var base [5][]string
+	if req.ConfigID != nil {
+		set, err := h.reelStripService.GetReelSetByConfig(c.Context(), *req.ConfigID)
+		if err != nil {
+			return h.baseConfigError(c, *req.ConfigID, err)
+		}
+		if req.GameMode == "" {
+			req.GameMode = set.Config.GameMode
+		}
+		base = baseStrips(set)
+		strips = make([][]string, 5)
+		for reel := range strips {
+			strips[reel] = req.Strips[reel]
+			if len(strips[reel]) == 0 {
+				strips[reel] = base[reel]
+			}
+		}
+	}
+
+	response, err := h.previewStrips(c, req.GameMode, req.ConfigID, strips, base, spins)
+	if err != nil {
+		return h.previewError(c, req.GameMode, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    response,
+	})
+}
+
+// GetWeights returns the symbol weights and seed strips are generated from: a config's, when it
+// was generated from weights, else the game mode's tuned ones
+// GET /admin/reel-strip-configs/weights?game_mode=base_game or ?config_id=<id>
+func (h *AdminReelStripHandler) GetWeights(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	response := dto.ReelStripWeightsResponse{GameMode: c.Query("game_mode")}
+	if id := c.Query("config_id"); id != "" {
+		configID, err := uuid.Parse(id)
+		if err != nil {
+			log.Warn().Err(err).Msg("Invalid config ID")
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidConfigID,
+				Message: "Invalid configuration ID",
+			})
+		}
+		config, err := h.reelStripService.GetConfigByID(c.Context(), configID)
+		if err != nil {
+			if errors.Is(err, reelstrip.ErrConfigNotFound) {
+				return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+					Error:   domainErrors.CodeConfigNotFound,
+					Message: "Reel strip configuration not found",
+				})
+			}
+			log.Error().Err(err).Str("config_id", configID.String()).Msg("Failed to get config for weights")
+			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeFailedToGetConfig,
+				Message: "Failed to retrieve configuration",
+			})
+		}
+		response.ConfigID = &configID
+		response.GameMode = config.GameMode
+		if input, ok := config.GeneratorInput(); ok {
+			response.Source = "config"
+			response.Weights, response.Seed = input.Weights, input.Seed
+			return c.JSON(fiber.Map{
+				"success": true,
+				"data":    response,
+			})
+		}
+	}
+
+	input, err := h.generator.DefaultInput(response.GameMode)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeValidationError,
+			Message: err.Error(),
+		})
+	}
+	response.Source = "default"
+	response.Weights, response.Seed = input.Weights, input.Seed
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    response,
+	})
+}
+
+// GenerateStrips generates a strip set from symbol weights with the tuning reel generator and
+// previews it; with a name, the strips are saved as a new config that records its weights and seed
+// POST /admin/reel-strip-configs/generate
+func (h *AdminReelStripHandler) GenerateStrips(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.GenerateReelStripsRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Warn().Err(err).Msg("Invalid request body")
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+	if len(req.Weights) != 0 && len(req.Weights) != 5 {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeValidationError,
+			Message: "symbol_weights must hold one table per reel",
+		})
+	}
+	spins, ok := previewSpins(req.Spins)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeValidationError,
+			Message: fmt.Sprintf("spins must be between 1 and %d", reelstrip.PreviewSpins),
+		})
+	}
+
+	// The base input is the config's when it was generated from weights, else the mode's
+	var base [5][]string
+	var input reelstrip.GeneratorInput
+	haveInput := false
+	if req.ConfigID != nil {
+		set, err := h.reelStripService.GetReelSetByConfig(c.Context(), *req.ConfigID)
+		if err != nil {
+			return h.baseConfigError(c, *req.ConfigID, err)
+		}
+		if req.GameMode == "" {
+			req.GameMode = set.Config.GameMode
+		}
+		base = baseStrips(set)
+		if set.Config.GameMode == req.GameMode {
+			input, haveInput = set.Config.GeneratorInput()
+		}
+	}
+	if !haveInput {
+		var err error
+		if input, err = h.generator.DefaultInput(req.GameMode); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeValidationError,
+				Message: err.Error(),
+			})
+		}
+	}
+	for reel, weights := range req.Weights {
+		if weights != nil {
+			input.Weights[reel] = weights
+		}
+	}
+	if req.Seed != nil {
+		input.Seed = *req.Seed
+	}
+
+	strips, err := h.generator.Generate(req.GameMode, input)
+	if err != nil {
+		if errors.Is(err, reelstrip.ErrInvalidWeights) || errors.Is(err, reelstrip.ErrInvalidGameMode) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeValidationError,
+				Message: err.Error(),
+			})
+		}
+		log.Error().Err(err).Str("game_mode", req.GameMode).Msg("Failed to generate reel strips")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to generate reel strips",
+		})
+	}
+
+	preview, err := h.previewStrips(c, req.GameMode, req.ConfigID, strips, base, spins)
+	if err != nil {
+		return h.previewError(c, req.GameMode, err)
+	}
+	response := dto.GenerateReelStripsResponse{
+		PreviewReelStripsResponse: *preview,
+		Weights:                   input.Weights,
+		Seed:                      input.Seed,
+		Strips:                    strips,
+	}
+	if req.Name == "" {
+		return c.JSON(fiber.Map{
+			"success": true,
+			"data":    response,
+		})
+	}
+
+	// Strips that break the generation rules are previewed, never saved
+	if !preview.Valid {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeValidationError,
+			Message: fmt.Sprintf("generated strips break %d generation rules and were not saved", preview.ViolationCount),
+		})
+	}
+
+	config, err := h.saveGeneratedStrips(c, req, input, strips, preview.Simulation)
+	if err != nil {
+		log.Error().Err(err).Str("name", req.Name).Msg("Failed to save generated reel strips")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToCreateConfig,
+			Message: "Failed to create reel strip configuration",
+		})
+	}
+	configResponse := mapConfigToResponse(config)
+	response.Config = &configResponse
+
+	log.Info().
+		Str("config_id", config.ID.String()).
+		Str("name", config.Name).
+		Str("game_mode", config.GameMode).
+		Int64("seed", input.Seed).
+		Msg("Saved reel strips generated from symbol weights")
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    response,
+	})
+}
+
+// saveGeneratedStrips saves strips and a config for them whose options record the generator input
+// and the preview's simulation
+func (h *AdminReelStripHandler) saveGeneratedStrips(c *fiber.Ctx, req dto.GenerateReelStripsRequest, input reelstrip.GeneratorInput, strips [][]string, simulation *reelstrip.RTPEstimate) (*reelstrip.ReelStripConfig, error) {
+	stripIDs, err := h.reelStripService.CreateStripSet(c.Context(), req.GameMode, strips)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := json.Marshal(struct {
+		reelstrip.GeneratorInput
+		Simulation *reelstrip.RTPEstimate `json:"simulation,omitempty"`
+	}{input, simulation})
+	if err != nil {
+		return nil, err
+	}
+
+	description := req.Description
+	if description == "" {
+		description = fmt.Sprintf("Generated from symbol weights (seed %d)", input.Seed)
+	}
+	return h.reelStripService.CreateConfig(c.Context(), req.Name, req.GameMode, description, stripIDs, req.TargetRTP, options)
+}
+
+// previewStrips checks, analyzes and simulates strips of gameMode; base holds the strips they
+// were edited from, if any
+func (h *AdminReelStripHandler) previewStrips(c *fiber.Ctx, gameMode string, configID *uuid.UUID, strips [][]string, base [5][]string, spins int) (*dto.PreviewReelStripsResponse, error) {
+	violations, err := h.validator.Validate(gameMode, strips)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate reel strips: %w", err)
+	}
+
+	response := &dto.PreviewReelStripsResponse{
+		GameMode:       gameMode,
+		ConfigID:       configID,
+		Reels:          make([]dto.ReelStripPreview, 5),
+		Valid:          len(violations) == 0,
+		ViolationCount: len(violations),
+		Violations:     violations,
+	}
+	simulate := true
+	for reel, strip := range strips {
+		checksum := reelstrip.ComputeChecksum(strip)
+		response.Reels[reel] = dto.ReelStripPreview{
+			Reel:     reel,
+			Checksum: checksum,
+			Edited:   base[reel] == nil || checksum != reelstrip.ComputeChecksum(base[reel]),
+			Analysis: h.simulator.AnalyzeStrip(strip),
+		}
+		simulate = simulate && len(strip) > 0
+	}
+	// The engine cannot play symbols it does not know
+	for _, v := range violations {
+		simulate = simulate && v.Rule != reelstrip.RuleUnknownSymbol
+	}
+
+	if simulate {
+		select {
+		case h.simulations <- struct{}{}:
+			defer func() { <-h.simulations }()
+		default:
+			return nil, errTooManySimulations
+		}
+		ctx, cancel := context.WithTimeout(c.Context(), h.simulationTimeout)
+		defer cancel()
+
+		started := time.Now()
+		estimate, err := h.simulator.EstimateRTP(ctx, gameMode, strips, spins)
+		if err != nil {
+			return nil, fmt.Errorf("failed to simulate reel strips: %w", err)
+		}
+		response.Simulation = estimate
+		h.logger.WithTrace(c).Info().
+			Str("game_mode", gameMode).
+			Int("spins", estimate.Spins).
+			Float64("rtp", estimate.RTP).
+			Dur("took", time.Since(started)).
+			Msg("Simulated draft reel strips")
+	}
+	return response, nil
+}
+
+// previewError answers a failure of previewStrips
+func (h *AdminReelStripHandler) previewError(c *fiber.Ctx, gameMode string, err error) error {
+	switch {
+	case errors.Is(err, reelstrip.ErrInvalidGameMode), errors.Is(err, reelstrip.ErrIncompleteSet):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeValidationError,
+			Message: errors.Unwrap(err).Error(),
+		})
+	case errors.Is(err, errTooManySimulations):
+		return c.Status(fiber.StatusTooManyRequests).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeTooManySimulations,
+			Message: fmt.Sprintf("Maximum concurrent simulations (%d) reached. Please try again later.", MaxConcurrentSimulations),
+		})
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return c.Status(fiber.StatusGatewayTimeout).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeSimulationTimeout,
+			Message: "The simulation did not finish in time; simulate fewer spins",
+		})
+	}
+	h.logger.WithTrace(c).Error().Err(err).Str("game_mode", gameMode).Msg("Failed to preview reel strips")
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: "Failed to preview reel strips",
+	})
+}
+
+// baseConfigError answers a failure to load the reel set of the config a draft is based on
+func (h *AdminReelStripHandler) baseConfigError(c *fiber.Ctx, configID uuid.UUID, err error) error {
+	switch {
+	case errors.Is(err, reelstrip.ErrConfigNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeConfigNotFound,
+			Message: "Reel strip configuration not found",
+		})
+	case errors.Is(err, reelstrip.ErrDemoConfig):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeValidationError,
+			Message: err.Error(),
+		})
+	}
+	h.logger.WithTrace(c).Error().Err(err).Str("config_id", configID.String()).Msg("Failed to get base reel set")
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeFailedToGetConfig,
+		Message: "Failed to retrieve configuration",
+	})
+}
+
+// baseStrips returns the strip of each reel of set
+func baseStrips(set *reelstrip.ReelStripConfigSet) [5][]string {
+	var base [5][]string
+	for reel, strip := range set.Strips {
+		if strip != nil {
+			base[reel] = strip.StripData
+		}
+	}
+	return base
+}
+
+// previewSpins returns the spins a preview simulates for a requested count, 0 meaning the default
+func previewSpins(requested int) (int, bool) {
+	if requested == 0 {
+		return reelstrip.PreviewSpins, true
+	}
+	return requested, requested > 0 && requested <= reelstrip.PreviewSpins
+}
+
+// ListOperatorDefaults lists every operator's default configs
+// GET /admin/operator-reel-strip-defaults
+func (h *AdminReelStripHandler) ListOperatorDefaults(c *fiber.Ctx) error {
+	defaults, err := h.reelStripService.ListOperatorDefaults(c.Context())
+	if err != nil {
+		return h.operatorDefaultError(c, err, "Failed to list operator defaults")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    defaults,
+	})
+}
+
+// GetOperatorDefault gets an operator's default configs
+// GET /admin/operator-reel-strip-defaults/:operatorId
+func (h *AdminReelStripHandler) GetOperatorDefault(c *fiber.Ctx) error {
+	def, err := h.reelStripService.GetOperatorDefault(c.Context(), c.Params("operatorId"))
+	if err != nil {
+		return h.operatorDefaultError(c, err, "Failed to get operator default")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    def,
+	})
+}
+
+// SetOperatorDefault creates or replaces an operator's default configs, or proposes it when approval is required
+// PUT /admin/operator-reel-strip-defaults/:operatorId
+func (h *AdminReelStripHandler) SetOperatorDefault(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.SetOperatorReelStripDefaultRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	operatorID := c.Params("operatorId")
+	if h.approvals.Required() {
+		params := &approval.Params{
+			OperatorID:        operatorID,
+			TargetRTP:         req.TargetRTP,
+			BaseGameConfigID:  req.BaseGameConfigID,
+			FreeSpinsConfigID: req.FreeSpinsConfigID,
+		}
+		return proposeChange(c, h.approvals, h.logger, approval.ActionSetOperatorDefault, params)
+	}
+
+	def := &reelstrip.OperatorReelStripDefault{
+		OperatorID:        operatorID,
+		TargetRTP:         req.TargetRTP,
+		BaseGameConfigID:  req.BaseGameConfigID,
+		FreeSpinsConfigID: req.FreeSpinsConfigID,
+		UpdatedBy:         adminUsername(c),
+	}
+	if err := h.reelStripService.SetOperatorDefault(c.Context(), def); err != nil {
+		return h.operatorDefaultError(c, err, "Failed to set operator default")
+	}
+	h.clearOperatorDefaultCache(c, operatorID)
+
+	log.Info().
+		Str("operator_id", operatorID).
+		Float64("target_rtp", def.TargetRTP).
+		Str("admin", def.UpdatedBy).
+		Msg("Set operator reel strip default")
+
+	saved, err := h.reelStripService.GetOperatorDefault(c.Context(), operatorID)
+	if err != nil {
+		return h.operatorDefaultError(c, err, "Operator default set but failed to retrieve details")
+	}
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    saved,
+	})
+}
+
+// RemoveOperatorDefault removes an operator's default configs
+// DELETE /admin/operator-reel-strip-defaults/:operatorId
+func (h *AdminReelStripHandler) RemoveOperatorDefault(c *fiber.Ctx) error {
+	operatorID := c.Params("operatorId")
+	if err := h.reelStripService.RemoveOperatorDefault(c.Context(), operatorID); err != nil {
+		return h.operatorDefaultError(c, err, "Failed to remove operator default")
+	}
+	h.clearOperatorDefaultCache(c, operatorID)
+
+	h.logger.WithTrace(c).Info().Str("operator_id", operatorID).Str("admin", adminUsername(c)).Msg("Removed operator reel strip default")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Operator default removed successfully",
+	})
+}
+
+// operatorDefaultError maps operator default errors to responses
+func (h *AdminReelStripHandler) operatorDefaultError(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, reelstrip.ErrOperatorDefaultNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeNotFound, Message: "Operator default not found"})
+	case errors.Is(err, reelstrip.ErrInvalidOperatorDefault),
+		errors.Is(err, reelstrip.ErrTargetRTPMismatch),
+		errors.Is(err, reelstrip.ErrDemoConfig),
+		errors.Is(err, reelstrip.ErrConfigNotFound):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeValidationError, Message: err.Error()})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
+
+// clearOperatorDefaultCache clears the cached default of an operator, including a cached miss
+func (h *AdminReelStripHandler) clearOperatorDefaultCache(ctx *fiber.Ctx, operatorID string) {
+	if err := h.cache.Expire(ctx.Context(), h.cache.OperatorDefaultKey(operatorID)); err != nil {
+		h.logger.WithTrace(ctx).Warn().Err(err).Str("operator_id", operatorID).Msg("Failed to clear operator default cache")
+	}
+}
+
 // clearConfigCache clears all cache entries related to a reel strip configuration
 func (h *AdminReelStripHandler) clearConfigCache(ctx *fiber.Ctx, configID uuid.UUID, gameMode string) {
 	log := h.logger.WithTrace(ctx)
@@ -435,8 +1166,10 @@ func mapConfigToResponse(config *reelstrip.ReelStripConfig) dto.ReelStripConfigR
 		TargetRTP:     config.TargetRTP,
 		IsActive:      config.IsActive,
 		IsDefault:     config.IsDefault,
+		IsDemo:        config.IsDemo,
 		ActivatedAt:   config.ActivatedAt,
 		DeactivatedAt: config.DeactivatedAt,
+		DeletedAt:     config.DeletedAt,
 		CreatedAt:     config.CreatedAt,
 		UpdatedAt:     config.UpdatedAt,
 		CreatedBy:     config.CreatedBy,
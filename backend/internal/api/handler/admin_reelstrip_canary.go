@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/approval"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminReelStripCanaryHandler handles admin endpoints for canary rollouts of reel strip configs
+type AdminReelStripCanaryHandler struct {
+	canaries  reelstrip.CanaryService
+	approvals approval.Service // Proposes canaries when approval is required, as they end in a new default
+	logger    *logger.Logger
+}
+
+// NewAdminReelStripCanaryHandler creates a new admin reel strip canary handler
+func NewAdminReelStripCanaryHandler(canaries reelstrip.CanaryService, approvals approval.Service, log *logger.Logger) *AdminReelStripCanaryHandler {
+	return &AdminReelStripCanaryHandler{
+		canaries:  canaries,
+		approvals: approvals,
+		logger:    log,
+	}
+}
+
+// ListCanaries lists canaries newest first
+// GET /admin/reel-strip-canaries?game_mode=&status=&page=&limit=
+func (h *AdminReelStripCanaryHandler) ListCanaries(c *fiber.Ctx) error {
+	filters := reelstrip.CanaryListFilters{Page: 1, Limit: 20, GameMode: c.Query("game_mode")}
+	if page := c.Query("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil && p > 0 {
+			filters.Page = p
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 100 {
+			filters.Limit = l
+		}
+	}
+	if status := c.Query("status"); status != "" {
+		filters.Status = reelstrip.CanaryStatus(status)
+		if !filters.Status.Valid() {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidParams,
+				Message: "Invalid status parameter",
+			})
+		}
+	}
+
+	canaries, total, err := h.canaries.List(c.Context(), filters)
+	if err != nil {
+		return canaryError(c, h.logger, err, "Failed to list canaries")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"canaries": canaries,
+			"total":    total,
+			"page":     filters.Page,
+			"limit":    filters.Limit,
+		},
+	})
+}
+
+// GetCanary gets a canary with its realized performance as of the last monitor pass
+// GET /admin/reel-strip-canaries/:id
+func (h *AdminReelStripCanaryHandler) GetCanary(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid canary ID")
+	if !ok {
+		return nil
+	}
+
+	canary, err := h.canaries.Get(c.Context(), id)
+	if err != nil {
+		return canaryError(c, h.logger, err, "Failed to get canary")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    canary,
+	})
+}
+
+// StartCanary starts a canary of an active config, or proposes it when approval is required
+// POST /admin/reel-strip-canaries
+func (h *AdminReelStripCanaryHandler) StartCanary(c *fiber.Ctx) error {
+	var req dto.StartCanaryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+	configID, err := uuid.Parse(req.ConfigID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidConfigID,
+			Message: "Invalid configuration ID",
+		})
+	}
+
+	if h.approvals.Required() {
+		return proposeChange(c, h.approvals, h.logger, approval.ActionStartReelStripCanary, &approval.Params{
+			ConfigID:        &configID,
+			CanaryPercent:   req.Percent,
+			MaxRTPDeviation: req.MaxRTPDeviation,
+			MinSpins:        req.MinSpins,
+			TrialHours:      req.TrialHours,
+		})
+	}
+
+	canary, err := h.canaries.Start(c.Context(), &reelstrip.StartCanaryRequest{
+		ConfigID:        configID,
+		Percent:         req.Percent,
+		MaxRTPDeviation: req.MaxRTPDeviation,
+		MinSpins:        req.MinSpins,
+		Trial:           time.Duration(req.TrialHours) * time.Hour,
+		StartedBy:       adminUsername(c),
+	})
+	if err != nil {
+		return canaryError(c, h.logger, err, "Failed to start canary")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    canary,
+	})
+}
+
+// AbortCanary stops a running canary, sending its players back to the baseline default
+// POST /admin/reel-strip-canaries/:id/abort
+func (h *AdminReelStripCanaryHandler) AbortCanary(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid canary ID")
+	if !ok {
+		return nil
+	}
+	var req dto.AbortCanaryRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidRequest,
+				Message: "Invalid request body",
+			})
+		}
+	}
+
+	canary, err := h.canaries.Abort(c.Context(), id, adminUsername(c), req.Reason)
+	if err != nil {
+		return canaryError(c, h.logger, err, "Failed to abort canary")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    canary,
+	})
+}
+
+// canaryError maps canary service errors to responses, deferring the rest to approvalError
+func canaryError(c *fiber.Ctx, log *logger.Logger, err error, message string) error {
+	switch {
+	case errors.Is(err, reelstrip.ErrCanaryNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeCanaryNotFound, Message: "Canary not found"})
+	case errors.Is(err, reelstrip.ErrCanaryRunning):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeCanaryRunning, Message: err.Error()})
+	case errors.Is(err, reelstrip.ErrCanaryNotRunning):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeCanaryNotRunning, Message: err.Error()})
+	}
+	return approvalError(c, log, err, message)
+}
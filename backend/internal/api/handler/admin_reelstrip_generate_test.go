@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// generateReelStripService serves a single config and records the strips and configs saved
+type generateReelStripService struct {
+	previewReelStripService
+	savedStrips [][]string
+	created     *reelstrip.ReelStripConfig
+}
+
+func (s *generateReelStripService) GetConfigByID(ctx context.Context, id uuid.UUID) (*reelstrip.ReelStripConfig, error) {
+	if s.set == nil || s.set.Config.ID != id {
+		return nil, reelstrip.ErrConfigNotFound
+	}
+	return s.set.Config, nil
+}
+
+func (s *generateReelStripService) CreateStripSet(ctx context.Context, gameMode string, strips [][]string) ([5]uuid.UUID, error) {
+	s.savedStrips = strips
+	return [5]uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()}, nil
+}
+
+func (s *generateReelStripService) CreateConfig(ctx context.Context, name, gameMode, description string, reelStripIDs [5]uuid.UUID, targetRTP float64, extraInfoJSON []byte) (*reelstrip.ReelStripConfig, error) {
+	s.created = &reelstrip.ReelStripConfig{ID: uuid.New(), Name: name, GameMode: gameMode, Description: description, Reel0StripID: reelStripIDs[0], TargetRTP: targetRTP, Options: extraInfoJSON}
+	return s.created, nil
+}
+
+// expandingGenerator lays each reel's symbols out in name order, as many times as they weigh
+type expandingGenerator struct {
+	input reelstrip.GeneratorInput
+}
+
+func (g *expandingGenerator) DefaultInput(gameMode string) (reelstrip.GeneratorInput, error) {
+	if gameMode != "base_game" {
+		return reelstrip.GeneratorInput{}, reelstrip.ErrInvalidGameMode
+	}
+	input := reelstrip.GeneratorInput{Seed: 42}
+	for reel := range input.Weights {
+		input.Weights[reel] = reelstrip.SymbolWeights{"fa": 1, "bai": 2}
+	}
+	return input, nil
+}
+
+func (g *expandingGenerator) Generate(gameMode string, input reelstrip.GeneratorInput) ([][]string, error) {
+	if err := input.Weights.Validate(); err != nil {
+		return nil, err
+	}
+	g.input = input
+	strips := make([][]string, 5)
+	for reel, weights := range input.Weights {
+		syms := make([]string, 0, len(weights))
+		for sym := range weights {
+			syms = append(syms, sym)
+		}
+		sort.Strings(syms)
+		for _, sym := range syms {
+			for i := 0; i < weights[sym]; i++ {
+				strips[reel] = append(strips[reel], sym)
+			}
+		}
+	}
+	return strips, nil
+}
+
+func TestAdminReelStripHandler_GenerateStrips(t *testing.T) {
+	generatedID, uploadedID := uuid.New(), uuid.New()
+	generatedOptions, err := json.Marshal(map[string]any{
+		"symbol_weights": []map[string]int{{"zhong": 1}, {"zhong": 1}, {"zhong": 1}, {"zhong": 1}, {"zhong": 1}},
+		"generator_seed": 7,
+		"stats":          map[string]any{"rtp": 96.5},
+	})
+	require.NoError(t, err)
+	configSet := func(id uuid.UUID, options json.RawMessage) *reelstrip.ReelStripConfigSet {
+		set := &reelstrip.ReelStripConfigSet{Config: &reelstrip.ReelStripConfig{ID: id, GameMode: "base_game", Options: options}}
+		for reel := range set.Strips {
+			set.Strips[reel] = &reelstrip.ReelStrip{StripData: []string{"zhong"}}
+		}
+		return set
+	}
+
+	generator := &expandingGenerator{}
+	simulator := &recordingSimulator{}
+	service := &generateReelStripService{}
+	h := NewAdminReelStripHandler(service, unknownSymbolValidator{}, simulator, generator, nil, logger.New("error", "json"), nil)
+
+	app := fiber.New()
+	app.Get("/weights", h.GetWeights)
+	app.Post("/generate", h.GenerateStrips)
+	call := func(method, path string, req any, out any) int {
+		t.Helper()
+		var body io.Reader
+		if req != nil {
+			data, err := json.Marshal(req)
+			require.NoError(t, err)
+			body = bytes.NewReader(data)
+		}
+		httpReq := httptest.NewRequest(method, path, body)
+		httpReq.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(httpReq, -1)
+		require.NoError(t, err)
+		data, _ := io.ReadAll(resp.Body)
+		if out != nil && resp.StatusCode < 300 {
+			envelope := struct {
+				Data any `json:"data"`
+			}{out}
+			require.NoError(t, json.Unmarshal(data, &envelope), string(data))
+		}
+		return resp.StatusCode
+	}
+
+	t.Run("weights of a generated config", func(t *testing.T) {
+		service.set = configSet(generatedID, generatedOptions)
+		var got dto.ReelStripWeightsResponse
+		require.Equal(t, 200, call("GET", "/weights?config_id="+generatedID.String(), nil, &got))
+		assert.Equal(t, "config", got.Source)
+		assert.Equal(t, "base_game", got.GameMode)
+		assert.EqualValues(t, 7, got.Seed)
+		assert.Equal(t, reelstrip.SymbolWeights{"zhong": 1}, got.Weights[4])
+	})
+
+	t.Run("configs not generated from weights fall back to the mode's", func(t *testing.T) {
+		service.set = configSet(uploadedID, json.RawMessage(`{"stats":{"rtp":96.5}}`))
+		var got dto.ReelStripWeightsResponse
+		require.Equal(t, 200, call("GET", "/weights?config_id="+uploadedID.String(), nil, &got))
+		assert.Equal(t, "default", got.Source)
+		assert.EqualValues(t, 42, got.Seed)
+		assert.Equal(t, reelstrip.SymbolWeights{"fa": 1, "bai": 2}, got.Weights[0])
+
+		assert.Equal(t, 400, call("GET", "/weights?game_mode=bogus", nil, nil))
+		assert.Equal(t, 400, call("GET", "/weights?config_id=nope", nil, nil))
+		assert.Equal(t, 404, call("GET", "/weights?config_id="+uuid.NewString(), nil, nil))
+	})
+
+	t.Run("edited reel over a generated config", func(t *testing.T) {
+		service.set = configSet(generatedID, generatedOptions)
+		service.created = nil
+		var got dto.GenerateReelStripsResponse
+		require.Equal(t, 200, call("POST", "/generate", dto.GenerateReelStripsRequest{
+			ConfigID: &generatedID,
+			Weights:  []reelstrip.SymbolWeights{nil, {"fa": 2}, nil, nil, nil},
+			Spins:    1000,
+		}, &got))
+
+		assert.Equal(t, "base_game", got.GameMode, "the game mode defaults to the config's")
+		assert.EqualValues(t, 7, got.Seed, "the seed defaults to the config's")
+		assert.Equal(t, []string{"fa", "fa"}, got.Strips[1])
+		assert.Equal(t, []string{"zhong"}, got.Strips[0], "reels without weights keep the config's")
+		assert.True(t, got.Reels[1].Edited)
+		assert.False(t, got.Reels[0].Edited)
+		require.NotNil(t, got.Simulation)
+		assert.Equal(t, 1000, simulator.spins)
+		assert.Nil(t, got.Config, "nothing is saved without a name")
+		assert.Nil(t, service.created)
+	})
+
+	t.Run("saves a named config with its weights", func(t *testing.T) {
+		service.set = nil
+		seed := int64(99)
+		var got dto.GenerateReelStripsResponse
+		require.Equal(t, 201, call("POST", "/generate", dto.GenerateReelStripsRequest{
+			GameMode:  "base_game",
+			Weights:   []reelstrip.SymbolWeights{{"fa": 3}, nil, nil, nil, nil},
+			Seed:      &seed,
+			Spins:     10,
+			Name:      "base-heavy-fa",
+			TargetRTP: 96,
+		}, &got))
+
+		require.NotNil(t, got.Config)
+		require.NotNil(t, service.created)
+		assert.Equal(t, "base-heavy-fa", got.Config.Name)
+		assert.Equal(t, got.Strips, service.savedStrips)
+		assert.Equal(t, []string{"fa", "fa", "fa"}, service.savedStrips[0])
+		assert.Equal(t, "Generated from symbol weights (seed 99)", service.created.Description)
+
+		input, ok := service.created.GeneratorInput()
+		require.True(t, ok, "the config records what it was generated from")
+		assert.EqualValues(t, 99, input.Seed)
+		assert.Equal(t, reelstrip.SymbolWeights{"fa": 3}, input.Weights[0])
+		assert.Equal(t, reelstrip.SymbolWeights{"fa": 1, "bai": 2}, input.Weights[1])
+		assert.Equal(t, generator.input, input)
+	})
+
+	t.Run("rejected requests", func(t *testing.T) {
+		service.set, service.created = nil, nil
+		status := call("POST", "/generate", dto.GenerateReelStripsRequest{GameMode: "base_game", Weights: []reelstrip.SymbolWeights{{"fa": 1}}}, nil)
+		assert.Equal(t, 400, status, "weights for some reels only")
+
+		status = call("POST", "/generate", dto.GenerateReelStripsRequest{GameMode: "base_game", Weights: []reelstrip.SymbolWeights{{"fa": -1}, nil, nil, nil, nil}}, nil)
+		assert.Equal(t, 400, status, "negative weights")
+
+		status = call("POST", "/generate", dto.GenerateReelStripsRequest{GameMode: "base_game", Weights: []reelstrip.SymbolWeights{{"fa": 0}, nil, nil, nil, nil}}, nil)
+		assert.Equal(t, 400, status, "an empty reel")
+
+		status = call("POST", "/generate", dto.GenerateReelStripsRequest{GameMode: "bogus"}, nil)
+		assert.Equal(t, 400, status)
+
+		missing := uuid.New()
+		status = call("POST", "/generate", dto.GenerateReelStripsRequest{ConfigID: &missing}, nil)
+		assert.Equal(t, 404, status)
+
+		status = call("POST", "/generate", dto.GenerateReelStripsRequest{
+			GameMode: "base_game",
+			Weights:  []reelstrip.SymbolWeights{{"?": 1}, nil, nil, nil, nil},
+			Spins:    10,
+			Name:     "broken",
+		}, nil)
+		assert.Equal(t, 400, status, "strips that break the rules are not saved")
+		assert.Nil(t, service.created)
+	})
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// previewReelStripService serves a single config's strips
+type previewReelStripService struct {
+	reelstrip.Service
+	set *reelstrip.ReelStripConfigSet
+}
+
+func (s *previewReelStripService) GetReelSetByConfig(ctx context.Context, configID uuid.UUID) (*reelstrip.ReelStripConfigSet, error) {
+	if s.set == nil || s.set.Config.ID != configID {
+		return nil, reelstrip.ErrConfigNotFound
+	}
+	return s.set, nil
+}
+
+// unknownSymbolValidator flags every "?" as an unknown symbol
+type unknownSymbolValidator struct{}
+
+func (unknownSymbolValidator) Validate(gameMode string, strips [][]string) ([]reelstrip.Violation, error) {
+	violations := []reelstrip.Violation{}
+	for reel, strip := range strips {
+		for pos, sym := range strip {
+			if sym == "?" {
+				violations = append(violations, reelstrip.Violation{Reel: reel, Position: pos, Rule: reelstrip.RuleUnknownSymbol, Symbol: sym})
+			}
+		}
+	}
+	return violations, nil
+}
+
+// recordingSimulator counts symbols and records the strips it simulated
+type recordingSimulator struct {
+	simulated [][]string
+	spins     int
+	stall     bool // Simulate until the context is done
+}
+
+func (s *recordingSimulator) AnalyzeStrip(strip []string) reelstrip.StripAnalysis {
+	counts := map[string]int{}
+	for _, sym := range strip {
+		counts[sym]++
+	}
+	return reelstrip.StripAnalysis{Length: len(strip), SymbolCounts: counts}
+}
+
+func (s *recordingSimulator) EstimateRTP(ctx context.Context, gameMode string, strips [][]string, spins int) (*reelstrip.RTPEstimate, error) {
+	s.simulated, s.spins = strips, spins
+	if s.stall {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return &reelstrip.RTPEstimate{Spins: spins, RTP: 96.5}, nil
+}
+
+func TestAdminReelStripHandler_PreviewStrips(t *testing.T) {
+	configID := uuid.New()
+	set := &reelstrip.ReelStripConfigSet{Config: &reelstrip.ReelStripConfig{ID: configID, GameMode: "base_game"}}
+	for reel := range set.Strips {
+		set.Strips[reel] = &reelstrip.ReelStrip{StripData: []string{"fa", "bai", "zhong"}}
+	}
+	simulator := &recordingSimulator{}
+	h := NewAdminReelStripHandler(&previewReelStripService{set: set}, unknownSymbolValidator{}, simulator, nil, nil, logger.New("error", "json"), nil)
+
+	app := fiber.New()
+	app.Post("/preview", h.PreviewStrips)
+	preview := func(req dto.PreviewReelStripsRequest) (int, dto.PreviewReelStripsResponse) {
+		t.Helper()
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+		httpReq := httptest.NewRequest("POST", "/preview", bytes.NewReader(body))
+		httpReq.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(httpReq, -1)
+		require.NoError(t, err)
+		data, _ := io.ReadAll(resp.Body)
+
+		var envelope struct {
+			Data dto.PreviewReelStripsResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(data, &envelope), string(data))
+		return resp.StatusCode, envelope.Data
+	}
+
+	t.Run("edited reel over a config", func(t *testing.T) {
+		edited := []string{"fa", "fa", "bai"}
+		status, got := preview(dto.PreviewReelStripsRequest{ConfigID: &configID, Strips: [][]string{nil, edited, nil, nil, nil}, Spins: 1000})
+		require.Equal(t, 200, status)
+
+		assert.Equal(t, "base_game", got.GameMode, "the game mode defaults to the config's")
+		require.Len(t, got.Reels, 5)
+		assert.Equal(t, reelstrip.ComputeChecksum(edited), got.Reels[1].Checksum)
+		assert.True(t, got.Reels[1].Edited)
+		assert.Equal(t, 2, got.Reels[1].Analysis.SymbolCounts["fa"])
+		assert.Equal(t, reelstrip.ComputeChecksum(set.Strips[0].StripData), got.Reels[0].Checksum)
+		assert.False(t, got.Reels[0].Edited)
+
+		require.NotNil(t, got.Simulation)
+		assert.Equal(t, 1000, simulator.spins)
+		assert.Equal(t, edited, simulator.simulated[1])
+		assert.Equal(t, set.Strips[4].StripData, simulator.simulated[4], "unedited reels keep the config's strip")
+	})
+
+	t.Run("defaults to a million spins", func(t *testing.T) {
+		strip := []string{"fa", "bai"}
+		status, got := preview(dto.PreviewReelStripsRequest{GameMode: "base_game", Strips: [][]string{strip, strip, strip, strip, strip}})
+		require.Equal(t, 200, status)
+		assert.True(t, got.Valid)
+		assert.True(t, got.Reels[0].Edited, "every reel is new without a base config")
+		require.NotNil(t, got.Simulation)
+		assert.Equal(t, reelstrip.PreviewSpins, got.Simulation.Spins)
+	})
+
+	t.Run("unknown symbols are not simulated", func(t *testing.T) {
+		strip := []string{"fa", "?"}
+		simulator.simulated = nil
+		status, got := preview(dto.PreviewReelStripsRequest{GameMode: "base_game", Strips: [][]string{strip, strip, strip, strip, strip}})
+		require.Equal(t, 200, status)
+		assert.False(t, got.Valid)
+		assert.Equal(t, 5, got.ViolationCount)
+		assert.Nil(t, got.Simulation)
+		assert.Nil(t, simulator.simulated)
+	})
+
+	t.Run("simulations are bounded in number and time", func(t *testing.T) {
+		strip := []string{"fa", "bai"}
+		req := dto.PreviewReelStripsRequest{GameMode: "base_game", Strips: [][]string{strip, strip, strip, strip, strip}, Spins: 10}
+
+		for range MaxConcurrentSimulations {
+			h.simulations <- struct{}{}
+		}
+		status, _ := preview(req)
+		assert.Equal(t, 429, status, "every simulation slot is taken")
+		for range MaxConcurrentSimulations {
+			<-h.simulations
+		}
+
+		simulator.stall = true
+		h.simulationTimeout = 10 * time.Millisecond
+		defer func() { simulator.stall, h.simulationTimeout = false, SimulationTimeout }()
+		status, _ = preview(req)
+		assert.Equal(t, 504, status)
+		assert.Empty(t, h.simulations, "a stopped simulation frees its slot")
+	})
+
+	t.Run("rejected requests", func(t *testing.T) {
+		strip := []string{"fa"}
+		status, _ := preview(dto.PreviewReelStripsRequest{GameMode: "base_game", Strips: [][]string{strip, strip}})
+		assert.Equal(t, 400, status)
+
+		status, _ = preview(dto.PreviewReelStripsRequest{GameMode: "base_game", Strips: [][]string{strip, strip, strip, strip, strip}, Spins: reelstrip.PreviewSpins + 1})
+		assert.Equal(t, 400, status)
+
+		missing := uuid.New()
+		status, _ = preview(dto.PreviewReelStripsRequest{ConfigID: &missing, Strips: make([][]string, 5)})
+		assert.Equal(t, 404, status)
+	})
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/pkg/runtimeconfig"
+)
+
+// AdminRuntimeConfigHandler handles admin endpoints for the hot-reloadable runtime config
+type AdminRuntimeConfigHandler struct {
+	watcher *runtimeconfig.Watcher
+	logger  *logger.Logger
+}
+
+// NewAdminRuntimeConfigHandler creates a new admin runtime config handler
+func NewAdminRuntimeConfigHandler(watcher *runtimeconfig.Watcher, log *logger.Logger) *AdminRuntimeConfigHandler {
+	return &AdminRuntimeConfigHandler{
+		watcher: watcher,
+		logger:  log,
+	}
+}
+
+// GetRuntimeConfig returns the runtime settings in effect on this instance and the file version applied
+// GET /admin/runtime-config
+func (h *AdminRuntimeConfigHandler) GetRuntimeConfig(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    h.watcher.State(),
+	})
+}
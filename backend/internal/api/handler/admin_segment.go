@@ -0,0 +1,486 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/segment"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminSegmentHandler handles admin endpoints for player tags, segments and segment targets
+type AdminSegmentHandler struct {
+	segmentService segment.Service
+	logger         *logger.Logger
+}
+
+// NewAdminSegmentHandler creates a new admin segment handler
+func NewAdminSegmentHandler(segmentService segment.Service, log *logger.Logger) *AdminSegmentHandler {
+	return &AdminSegmentHandler{
+		segmentService: segmentService,
+		logger:         log,
+	}
+}
+
+// ListSegments lists segments
+// GET /admin/segments?is_active=true
+func (h *AdminSegmentHandler) ListSegments(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var isActive *bool
+	if v := c.Query("is_active"); v != "" {
+		active := v == "true"
+		isActive = &active
+	}
+
+	segments, err := h.segmentService.ListSegments(c.Context(), isActive)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list segments")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToListSegments,
+			Message: "Failed to list segments",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    segments,
+	})
+}
+
+// GetSegment gets a segment by ID
+// GET /admin/segments/:id
+func (h *AdminSegmentHandler) GetSegment(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid segment ID")
+	if !ok {
+		return nil
+	}
+
+	seg, err := h.segmentService.GetSegment(c.Context(), id)
+	if err != nil {
+		return h.segmentError(c, err, "Failed to get segment")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    seg,
+	})
+}
+
+// CreateSegment creates a new segment
+// POST /admin/segments
+func (h *AdminSegmentHandler) CreateSegment(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.CreateSegmentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	seg := &segment.Segment{
+		Name:        req.Name,
+		Description: req.Description,
+		Rules:       req.Rules,
+		IsActive:    req.IsActive == nil || *req.IsActive,
+		CreatedBy:   adminUsername(c),
+	}
+	if err := h.segmentService.CreateSegment(c.Context(), seg); err != nil {
+		return h.segmentError(c, err, "Failed to create segment")
+	}
+
+	log.Info().Str("segment_id", seg.ID.String()).Str("name", seg.Name).Msg("Segment created")
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    seg,
+	})
+}
+
+// UpdateSegment updates a segment
+// PUT /admin/segments/:id
+func (h *AdminSegmentHandler) UpdateSegment(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	id, ok := parseUUIDParam(c, "id", "Invalid segment ID")
+	if !ok {
+		return nil
+	}
+
+	var req dto.UpdateSegmentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	seg, err := h.segmentService.GetSegment(c.Context(), id)
+	if err != nil {
+		return h.segmentError(c, err, "Failed to get segment")
+	}
+
+	if req.Name != nil {
+		seg.Name = *req.Name
+	}
+	if req.Description != nil {
+		seg.Description = *req.Description
+	}
+	if req.Rules != nil {
+		seg.Rules = *req.Rules
+	}
+	if req.IsActive != nil {
+		seg.IsActive = *req.IsActive
+	}
+
+	if err := h.segmentService.UpdateSegment(c.Context(), seg); err != nil {
+		return h.segmentError(c, err, "Failed to update segment")
+	}
+
+	log.Info().Str("segment_id", id.String()).Msg("Segment updated")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    seg,
+	})
+}
+
+// DeleteSegment deletes a segment and its targets
+// DELETE /admin/segments/:id
+func (h *AdminSegmentHandler) DeleteSegment(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	id, ok := parseUUIDParam(c, "id", "Invalid segment ID")
+	if !ok {
+		return nil
+	}
+
+	if err := h.segmentService.DeleteSegment(c.Context(), id); err != nil {
+		return h.segmentError(c, err, "Failed to delete segment")
+	}
+
+	log.Info().Str("segment_id", id.String()).Msg("Segment deleted")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Segment deleted successfully",
+	})
+}
+
+// CheckMembership evaluates whether a player currently belongs to a segment
+// GET /admin/segments/:id/members/:playerId
+func (h *AdminSegmentHandler) CheckMembership(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid segment ID")
+	if !ok {
+		return nil
+	}
+	playerID, ok := parseUUIDParam(c, "playerId", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	isMember, err := h.segmentService.IsMember(c.Context(), id, playerID)
+	if err != nil {
+		return h.segmentError(c, err, "Failed to evaluate segment membership")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": dto.SegmentMembershipResponse{
+			SegmentID: id.String(),
+			PlayerID:  playerID.String(),
+			IsMember:  isMember,
+		},
+	})
+}
+
+// ListTargets lists the targets of a segment
+// GET /admin/segments/:id/targets
+func (h *AdminSegmentHandler) ListTargets(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid segment ID")
+	if !ok {
+		return nil
+	}
+
+	targets, err := h.segmentService.ListTargets(c.Context(), segment.TargetListFilters{SegmentID: &id})
+	if err != nil {
+		return h.segmentError(c, err, "Failed to list segment targets")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    targets,
+	})
+}
+
+// CreateTarget targets a segment with reel strip configs, a bonus or a rate limit policy
+// POST /admin/segments/:id/targets
+func (h *AdminSegmentHandler) CreateTarget(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	id, ok := parseUUIDParam(c, "id", "Invalid segment ID")
+	if !ok {
+		return nil
+	}
+
+	var req dto.CreateSegmentTargetRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	target := &segment.Target{
+		SegmentID: id,
+		Kind:      req.Kind,
+		Priority:  req.Priority,
+		Settings:  req.Settings,
+		IsActive:  req.IsActive == nil || *req.IsActive,
+		CreatedBy: adminUsername(c),
+	}
+	if err := h.segmentService.CreateTarget(c.Context(), target); err != nil {
+		return h.segmentError(c, err, "Failed to create segment target")
+	}
+
+	log.Info().
+		Str("segment_id", id.String()).
+		Str("target_id", target.ID.String()).
+		Str("kind", string(target.Kind)).
+		Msg("Segment target created")
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    target,
+	})
+}
+
+// UpdateTarget updates a segment target
+// PUT /admin/segments/:id/targets/:targetId
+func (h *AdminSegmentHandler) UpdateTarget(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	target, ok, err := h.segmentTarget(c)
+	if !ok {
+		return err
+	}
+
+	var req dto.UpdateSegmentTargetRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	if req.Priority != nil {
+		target.Priority = *req.Priority
+	}
+	if len(req.Settings) > 0 {
+		target.Settings = req.Settings
+	}
+	if req.IsActive != nil {
+		target.IsActive = *req.IsActive
+	}
+
+	if err := h.segmentService.UpdateTarget(c.Context(), target); err != nil {
+		return h.segmentError(c, err, "Failed to update segment target")
+	}
+
+	log.Info().Str("target_id", target.ID.String()).Msg("Segment target updated")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    target,
+	})
+}
+
+// DeleteTarget deletes a segment target
+// DELETE /admin/segments/:id/targets/:targetId
+func (h *AdminSegmentHandler) DeleteTarget(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	target, ok, err := h.segmentTarget(c)
+	if !ok {
+		return err
+	}
+
+	if err := h.segmentService.DeleteTarget(c.Context(), target.ID); err != nil {
+		return h.segmentError(c, err, "Failed to delete segment target")
+	}
+
+	log.Info().Str("target_id", target.ID.String()).Msg("Segment target deleted")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Segment target deleted successfully",
+	})
+}
+
+// ListPlayerTags lists a player's tags
+// GET /admin/players/:id/tags
+func (h *AdminSegmentHandler) ListPlayerTags(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	tags, err := h.segmentService.ListTags(c.Context(), playerID)
+	if err != nil {
+		return h.segmentError(c, err, "Failed to list player tags")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    tags,
+	})
+}
+
+// AddPlayerTag tags a player
+// POST /admin/players/:id/tags
+func (h *AdminSegmentHandler) AddPlayerTag(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	var req dto.AddPlayerTagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	tag, err := h.segmentService.AddTag(c.Context(), playerID, req.Tag, adminUsername(c))
+	if err != nil {
+		return h.segmentError(c, err, "Failed to tag player")
+	}
+
+	log.Info().Str("player_id", playerID.String()).Str("tag", tag.Tag).Msg("Player tagged")
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    tag,
+	})
+}
+
+// RemovePlayerTag removes a tag from a player
+// DELETE /admin/players/:id/tags/:tag
+func (h *AdminSegmentHandler) RemovePlayerTag(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+	tag := c.Params("tag")
+
+	if err := h.segmentService.RemoveTag(c.Context(), playerID, tag); err != nil {
+		return h.segmentError(c, err, "Failed to remove player tag")
+	}
+
+	log.Info().Str("player_id", playerID.String()).Str("tag", tag).Msg("Player tag removed")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Player tag removed successfully",
+	})
+}
+
+// GetPlayerSegments evaluates the segments a player currently belongs to
+// GET /admin/players/:id/segments
+func (h *AdminSegmentHandler) GetPlayerSegments(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	segments, err := h.segmentService.EvaluatePlayer(c.Context(), playerID)
+	if err != nil {
+		return h.segmentError(c, err, "Failed to evaluate player segments")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    segments,
+	})
+}
+
+// segmentTarget loads the target in the path and checks it belongs to the segment in the path
+// ok is false when a response has already been written.
+func (h *AdminSegmentHandler) segmentTarget(c *fiber.Ctx) (*segment.Target, bool, error) {
+	segmentID, ok := parseUUIDParam(c, "id", "Invalid segment ID")
+	if !ok {
+		return nil, false, nil
+	}
+	targetID, ok := parseUUIDParam(c, "targetId", "Invalid target ID")
+	if !ok {
+		return nil, false, nil
+	}
+
+	target, err := h.segmentService.GetTarget(c.Context(), targetID)
+	if err == nil && target.SegmentID != segmentID {
+		err = segment.ErrTargetNotFound
+	}
+	if err != nil {
+		return nil, false, h.segmentError(c, err, "Failed to get segment target")
+	}
+	return target, true, nil
+}
+
+// segmentError maps segment service errors to responses
+func (h *AdminSegmentHandler) segmentError(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, segment.ErrSegmentNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeNotFound, Message: "Segment not found"})
+	case errors.Is(err, segment.ErrTargetNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeNotFound, Message: "Segment target not found"})
+	case errors.Is(err, player.ErrPlayerNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeNotFound, Message: "Player not found"})
+	case errors.Is(err, segment.ErrSegmentNameTaken):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeDuplicateName, Message: err.Error()})
+	case errors.Is(err, segment.ErrInvalidRule),
+		errors.Is(err, segment.ErrInvalidTargetKind),
+		errors.Is(err, segment.ErrInvalidSettings),
+		errors.Is(err, segment.ErrInvalidTag),
+		errors.Is(err, reelstrip.ErrConfigNotFound):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeValidationError, Message: err.Error()})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
+
+// parseUUIDParam parses a UUID path parameter, writing a 400 response if it is invalid
+func parseUUIDParam(c *fiber.Ctx, name, message string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Params(name))
+	if err != nil {
+		_ = c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: message,
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// adminUsername returns the username of the authenticated admin, if any
+func adminUsername(c *fiber.Ctx) string {
+	if admin := getAdminFromContext(c); admin != nil {
+		return admin.Username
+	}
+	return ""
+}
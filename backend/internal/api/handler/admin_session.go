@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/service"
+)
+
+// AdminSessionHandler handles admin endpoints for force-ending game sessions
+type AdminSessionHandler struct {
+	expiry *service.SessionExpiryService
+	logger *logger.Logger
+}
+
+// NewAdminSessionHandler creates a new admin session handler
+func NewAdminSessionHandler(expiry *service.SessionExpiryService, log *logger.Logger) *AdminSessionHandler {
+	return &AdminSessionHandler{
+		expiry: expiry,
+		logger: log,
+	}
+}
+
+// EndSession force-ends a game session, settling its incomplete free spins first
+// POST /admin/sessions/:id/end
+func (h *AdminSessionHandler) EndSession(c *fiber.Ctx) error {
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidSessionID,
+			Message: "Invalid session ID",
+		})
+	}
+
+	sess, settlement, err := h.expiry.ForceEndSession(c.Context(), sessionID, session.EndReasonAdmin)
+	if err != nil {
+		switch {
+		case errors.Is(err, session.ErrSessionNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeSessionNotFound,
+				Message: "Session not found",
+			})
+		case errors.Is(err, session.ErrSessionAlreadyEnded):
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeSessionAlreadyEnded,
+				Message: "Session already ended",
+			})
+		}
+
+		h.logger.WithTrace(c).Error().Err(err).Str("session_id", sessionID.String()).Msg("Failed to force-end session")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToEndSession,
+			Message: "Failed to end game session",
+		})
+	}
+
+	h.logger.WithTrace(c).Info().
+		Str("session_id", sessionID.String()).
+		Str("player_id", sess.PlayerID.String()).
+		Str("admin", adminUsername(c)).
+		Msg("Session force-ended by admin")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"session":    sess,
+			"settlement": settlement,
+		},
+	})
+}
+
+// ListSettlements lists the free spins settlements made when a game session was force-ended
+// GET /admin/sessions/:id/settlements
+func (h *AdminSessionHandler) ListSettlements(c *fiber.Ctx) error {
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidSessionID,
+			Message: "Invalid session ID",
+		})
+	}
+
+	settlements, err := h.expiry.GetSettlements(c.Context(), sessionID)
+	if err != nil {
+		h.logger.WithTrace(c).Error().Err(err).Str("session_id", sessionID.String()).Msg("Failed to list free spins settlements")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to list free spins settlements",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    settlements,
+	})
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/spinfeed"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// spinFeedHeartbeat keeps idle feed streams open through proxies
+const spinFeedHeartbeat = 15 * time.Second
+
+// AdminSpinFeedHandler handles the live ops spin feed
+type AdminSpinFeedHandler struct {
+	feedService spinfeed.Service
+	logger      *logger.Logger
+}
+
+// NewAdminSpinFeedHandler creates a new admin spin feed handler
+func NewAdminSpinFeedHandler(feedService spinfeed.Service, log *logger.Logger) *AdminSpinFeedHandler {
+	return &AdminSpinFeedHandler{
+		feedService: feedService,
+		logger:      log,
+	}
+}
+
+// GetFeed returns the most recent spins across the platform, newest first
+// GET /admin/spin-feed?limit=
+func (h *AdminSpinFeedHandler) GetFeed(c *fiber.Ctx) error {
+	limit := 50
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 {
+		limit = l
+	}
+
+	entries, err := h.feedService.Recent(c.Context(), limit)
+	if err != nil {
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to read spin feed")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to read spin feed",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    entries,
+	})
+}
+
+// StreamFeed streams spins as they happen using Server-Sent Events
+// Each spin is sent as a "spin" event whose data is the JSON feed entry.
+// GET /admin/spin-feed/stream
+func (h *AdminSpinFeedHandler) StreamFeed(c *fiber.Ctx) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	entries, err := h.feedService.Subscribe(ctx)
+	if err != nil {
+		cancel()
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to subscribe to spin feed")
+		return c.Status(fiber.StatusServiceUnavailable).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFeedUnavailable,
+			Message: "Spin feed stream is unavailable",
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		// The stream ends when the admin disconnects and a write fails
+		defer cancel()
+
+		heartbeat := time.NewTicker(spinFeedHeartbeat)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case entry, ok := <-entries:
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(entry)
+				if err != nil {
+					continue
+				}
+				fmt.Fprintf(w, "event: spin\ndata: %s\n\n", data)
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": ping\n\n")
+			}
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+	return nil
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/timeline"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminTimelineHandler serves player timelines to support staff
+type AdminTimelineHandler struct {
+	timelineService timeline.Service
+	logger          *logger.Logger
+}
+
+// NewAdminTimelineHandler creates a new admin timeline handler
+func NewAdminTimelineHandler(timelineService timeline.Service, log *logger.Logger) *AdminTimelineHandler {
+	return &AdminTimelineHandler{
+		timelineService: timelineService,
+		logger:          log,
+	}
+}
+
+// GetPlayerTimeline returns a player's sessions, spins, free spins, provably fair
+// sessions, balance adjustments and support notes as one chronological feed, newest first
+// GET /admin/players/:id/timeline?cursor=...&limit=50
+func (h *AdminTimelineHandler) GetPlayerTimeline(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	limit := 0
+	if v := c.Query("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l <= 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidParams,
+				Message: "Invalid limit parameter",
+			})
+		}
+		limit = l
+	}
+
+	page, err := h.timelineService.GetPlayerTimeline(c.Context(), playerID, c.Query("cursor"), limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, timeline.ErrInvalidCursor):
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidCursor,
+				Message: "Cursor is malformed",
+			})
+		case errors.Is(err, player.ErrPlayerNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodePlayerNotFound,
+				Message: "Player not found",
+			})
+		}
+		h.logger.WithTrace(c).Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to get player timeline")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to get player timeline",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    page,
+	})
+}
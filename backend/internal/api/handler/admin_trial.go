@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/trial"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/service"
+)
+
+// AdminTrialHandler handles admin endpoints for per-game demo settings
+type AdminTrialHandler struct {
+	trialService *service.TrialService
+	logger       *logger.Logger
+}
+
+// NewAdminTrialHandler creates a new admin trial handler
+func NewAdminTrialHandler(trialService *service.TrialService, log *logger.Logger) *AdminTrialHandler {
+	return &AdminTrialHandler{
+		trialService: trialService,
+		logger:       log,
+	}
+}
+
+// ListSettings lists the games with their own demo settings
+// GET /admin/trial-settings
+func (h *AdminTrialHandler) ListSettings(c *fiber.Ctx) error {
+	settings, err := h.trialService.ListGameSettings(c.Context())
+	if err != nil {
+		return h.trialError(c, err, "Failed to list trial settings")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"defaults": trial.DefaultSettings(),
+			"games":    settings,
+		},
+	})
+}
+
+// GetSettings gets the demo settings that apply to a game
+// GET /admin/games/:id/trial-settings
+func (h *AdminTrialHandler) GetSettings(c *fiber.Ctx) error {
+	gameID, ok := parseUUIDParam(c, "id", "Invalid game ID")
+	if !ok {
+		return nil
+	}
+
+	settings, err := h.trialService.GetGameSettings(c.Context(), gameID)
+	isDefault := errors.Is(err, trial.ErrSettingsNotFound)
+	if isDefault {
+		settings, err = trial.DefaultSettings(), nil
+		settings.GameID = gameID
+	}
+	if err != nil {
+		return h.trialError(c, err, "Failed to get trial settings")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"settings":   settings,
+			"is_default": isDefault,
+		},
+	})
+}
+
+// UpdateSettings creates or replaces a game's demo settings
+// PUT /admin/games/:id/trial-settings
+func (h *AdminTrialHandler) UpdateSettings(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	gameID, ok := parseUUIDParam(c, "id", "Invalid game ID")
+	if !ok {
+		return nil
+	}
+
+	var req dto.TrialSettingsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	settings := &trial.Settings{
+		GameID:            gameID,
+		StartingBalance:   req.StartingBalance,
+		MinBet:            req.MinBet,
+		MaxBet:            req.MaxBet,
+		DailyReset:        req.DailyReset,
+		BaseGameConfigID:  req.BaseGameConfigID,
+		FreeSpinsConfigID: req.FreeSpinsConfigID,
+		UpdatedBy:         adminUsername(c),
+	}
+	if err := h.trialService.SaveGameSettings(c.Context(), settings); err != nil {
+		return h.trialError(c, err, "Failed to save trial settings")
+	}
+
+	log.Info().
+		Str("game_id", gameID.String()).
+		Float64("starting_balance", settings.StartingBalance).
+		Str("updated_by", settings.UpdatedBy).
+		Msg("Trial settings updated")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    settings,
+	})
+}
+
+// DeleteSettings reverts a game to the default demo settings
+// DELETE /admin/games/:id/trial-settings
+func (h *AdminTrialHandler) DeleteSettings(c *fiber.Ctx) error {
+	gameID, ok := parseUUIDParam(c, "id", "Invalid game ID")
+	if !ok {
+		return nil
+	}
+
+	if err := h.trialService.DeleteGameSettings(c.Context(), gameID); err != nil {
+		return h.trialError(c, err, "Failed to delete trial settings")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Trial settings reset to defaults",
+	})
+}
+
+// trialError maps trial domain errors to HTTP responses
+func (h *AdminTrialHandler) trialError(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, trial.ErrSettingsNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeNotFound, Message: "Trial settings not found"})
+	case errors.Is(err, trial.ErrInvalidSettings):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeValidationError, Message: err.Error()})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
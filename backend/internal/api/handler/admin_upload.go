@@ -10,28 +10,33 @@ import (
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/infra/storage"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/slotmachine/backend/internal/pkg/security"
+	"github.com/slotmachine/backend/internal/service"
 )
 
 // AdminUploadHandler handles file upload endpoints
 type AdminUploadHandler struct {
-	storage   storage.Storage
-	logger    *logger.Logger
-	validator *security.FileValidator
+	storage    storage.Storage
+	assetFiles *service.AssetFileService
+	logger     *logger.Logger
+	validator  *security.FileValidator
 }
 
 // NewAdminUploadHandler creates a new admin upload handler
 func NewAdminUploadHandler(
 	s storage.Storage,
+	assetFiles *service.AssetFileService,
 	log *logger.Logger,
 ) *AdminUploadHandler {
 	return &AdminUploadHandler{
-		storage:   s,
-		logger:    log,
-		validator: security.NewFileValidator(nil),
+		storage:    s,
+		assetFiles: assetFiles,
+		logger:     log,
+		validator:  security.NewFileValidator(nil),
 	}
 }
 
@@ -64,7 +69,7 @@ func (h *AdminUploadHandler) UploadFile(c *fiber.Ctx) error {
 	themeName := c.Params("theme")
 	if err := h.validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -73,7 +78,7 @@ func (h *AdminUploadHandler) UploadFile(c *fiber.Ctx) error {
 	file, err := c.FormFile("file")
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_file",
+			Error:   domainErrors.CodeInvalidFile,
 			Message: "File is required",
 		})
 	}
@@ -84,7 +89,7 @@ func (h *AdminUploadHandler) UploadFile(c *fiber.Ctx) error {
 	// Reject ZIP files - use folder upload instead
 	if h.validator.IsZipFile(file.Filename) {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "zip_not_supported",
+			Error:   domainErrors.CodeZipNotSupported,
 			Message: "ZIP files are not supported. Please use folder upload via direct-upload endpoint instead.",
 		})
 	}
@@ -109,7 +114,7 @@ func (h *AdminUploadHandler) handleSingleFileUpload(
 	// Validate file type
 	if !h.validator.IsAllowedExtension(fileName) {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_file_type",
+			Error:   domainErrors.CodeInvalidFileType,
 			Message: "File type not allowed. Allowed types: png, jpg, jpeg, gif, webp, svg, json, mp3, wav, ogg, mp4, webm",
 		})
 	}
@@ -117,7 +122,7 @@ func (h *AdminUploadHandler) handleSingleFileUpload(
 	// Validate file size
 	if err := h.validator.ValidateFileSize(file.Size, false); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "file_too_large",
+			Error:   domainErrors.CodeFileTooLarge,
 			Message: err.Error(),
 		})
 	}
@@ -127,7 +132,7 @@ func (h *AdminUploadHandler) handleSingleFileUpload(
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to open uploaded file")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "file_open_failed",
+			Error:   domainErrors.CodeFileOpenFailed,
 			Message: "Failed to process uploaded file",
 		})
 	}
@@ -139,7 +144,7 @@ func (h *AdminUploadHandler) handleSingleFileUpload(
 	if err != nil && err != io.EOF {
 		log.Error().Err(err).Msg("Failed to read file header")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "file_read_failed",
+			Error:   domainErrors.CodeFileReadFailed,
 			Message: "Failed to read file",
 		})
 	}
@@ -148,7 +153,7 @@ func (h *AdminUploadHandler) handleSingleFileUpload(
 	if err := h.validator.ValidateMagicBytes(fileName, bytes.NewReader(magicBytes[:n])); err != nil {
 		log.Warn().Err(err).Str("filename", fileName).Msg("Magic bytes validation failed")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_file_content",
+			Error:   domainErrors.CodeInvalidFileContent,
 			Message: "File content does not match file type",
 		})
 	}
@@ -158,29 +163,34 @@ func (h *AdminUploadHandler) handleSingleFileUpload(
 		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
 			log.Error().Err(err).Msg("Failed to reset file position")
 			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-				Error:   "file_read_failed",
+				Error:   domainErrors.CodeFileReadFailed,
 				Message: "Failed to process file",
 			})
 		}
 	}
 
-	// Upload to storage (streaming - no full file in memory)
+	// Upload to storage (streaming - no full file in memory), hashing on the way through
+	hr := service.NewHashingReader(src)
 	url, err := h.storage.UploadFile(
 		c.Context(),
 		themeName,
 		fileName,
-		src,
+		hr,
 		file.Size,
 		security.GetContentType(fileName),
 	)
 	if err != nil {
 		log.Error().Err(err).Str("theme", themeName).Str("file", fileName).Msg("Failed to upload file to storage")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "upload_failed",
+			Error:   domainErrors.CodeUploadFailed,
 			Message: "Failed to upload file to storage",
 		})
 	}
 
+	if err := h.assetFiles.Record(c.Context(), themeName, fileName, hr.Sum(), hr.Size()); err != nil {
+		log.Warn().Err(err).Str("theme", themeName).Str("file", fileName).Msg("Failed to record file hash")
+	}
+
 	log.Info().Str("theme", themeName).Str("file", fileName).Str("url", url).Msg("File uploaded")
 
 	return c.JSON(fiber.Map{
@@ -189,6 +199,7 @@ func (h *AdminUploadHandler) handleSingleFileUpload(
 			"url":      url,
 			"filename": fileName,
 			"size":     file.Size,
+			"sha256":   hr.Sum(),
 		},
 	})
 }
@@ -201,7 +212,7 @@ func (h *AdminUploadHandler) UploadMultipleFiles(c *fiber.Ctx) error {
 	themeName := c.Params("theme")
 	if err := h.validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -210,7 +221,7 @@ func (h *AdminUploadHandler) UploadMultipleFiles(c *fiber.Ctx) error {
 	form, err := c.MultipartForm()
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_form",
+			Error:   domainErrors.CodeInvalidForm,
 			Message: "Invalid multipart form",
 		})
 	}
@@ -218,7 +229,7 @@ func (h *AdminUploadHandler) UploadMultipleFiles(c *fiber.Ctx) error {
 	files := form.File["files"]
 	if len(files) == 0 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "no_files",
+			Error:   domainErrors.CodeNoFiles,
 			Message: "No files provided",
 		})
 	}
@@ -240,7 +251,7 @@ func (h *AdminUploadHandler) UploadMultipleFiles(c *fiber.Ctx) error {
 	maxBatchSize := config.MaxTotalExtractSize // Reuse this limit for batch uploads
 	if totalSize > maxBatchSize {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "batch_too_large",
+			Error:   domainErrors.CodeBatchTooLarge,
 			Message: fmt.Sprintf("Total batch size %d bytes exceeds limit of %d bytes", totalSize, maxBatchSize),
 		})
 	}
@@ -325,12 +336,13 @@ func (h *AdminUploadHandler) processSingleFileStreaming(
 		}
 	}
 
-	// Upload to storage with streaming
+	// Upload to storage with streaming, hashing on the way through
+	hr := service.NewHashingReader(src)
 	url, err := h.storage.UploadFile(
 		c.Context(),
 		themeName,
 		fileName,
-		src,
+		hr,
 		file.Size,
 		security.GetContentType(fileName),
 	)
@@ -339,12 +351,17 @@ func (h *AdminUploadHandler) processSingleFileStreaming(
 		return nil, fmt.Sprintf("%s: upload failed", file.Filename)
 	}
 
+	if err := h.assetFiles.Record(c.Context(), themeName, fileName, hr.Sum(), hr.Size()); err != nil {
+		log.Warn().Err(err).Str("theme", themeName).Str("file", fileName).Msg("Failed to record file hash")
+	}
+
 	log.Info().Str("theme", themeName).Str("file", fileName).Msg("File uploaded")
 
 	return fiber.Map{
 		"url":      url,
 		"filename": fileName,
 		"size":     file.Size,
+		"sha256":   hr.Sum(),
 	}, ""
 }
 
@@ -356,7 +373,7 @@ func (h *AdminUploadHandler) ListFiles(c *fiber.Ctx) error {
 	themeName := c.Params("theme")
 	if err := h.validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -365,7 +382,7 @@ func (h *AdminUploadHandler) ListFiles(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Str("theme", themeName).Msg("Failed to list files")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "list_failed",
+			Error:   domainErrors.CodeListFailed,
 			Message: "Failed to list files",
 		})
 	}
@@ -388,7 +405,7 @@ func (h *AdminUploadHandler) DeleteFile(c *fiber.Ctx) error {
 	themeName := c.Params("theme")
 	if err := h.validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -396,7 +413,7 @@ func (h *AdminUploadHandler) DeleteFile(c *fiber.Ctx) error {
 	fileName := c.Params("*") // Capture rest of path as filename
 	if fileName == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_params",
+			Error:   domainErrors.CodeInvalidParams,
 			Message: "File name is required",
 		})
 	}
@@ -405,11 +422,15 @@ func (h *AdminUploadHandler) DeleteFile(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Str("theme", themeName).Str("file", fileName).Msg("Failed to delete file")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "delete_failed",
+			Error:   domainErrors.CodeDeleteFailed,
 			Message: "Failed to delete file",
 		})
 	}
 
+	if err := h.assetFiles.Forget(c.Context(), themeName, fileName); err != nil {
+		log.Warn().Err(err).Str("theme", themeName).Str("file", fileName).Msg("Failed to remove file hash")
+	}
+
 	log.Info().Str("theme", themeName).Str("file", fileName).Msg("File deleted")
 
 	return c.JSON(fiber.Map{
@@ -426,7 +447,7 @@ func (h *AdminUploadHandler) DeleteTheme(c *fiber.Ctx) error {
 	themeName := c.Params("theme")
 	if err := h.validateThemeName(themeName); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_theme",
+			Error:   domainErrors.CodeInvalidTheme,
 			Message: err.Error(),
 		})
 	}
@@ -435,11 +456,15 @@ func (h *AdminUploadHandler) DeleteTheme(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Str("theme", themeName).Msg("Failed to delete theme files")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "delete_failed",
+			Error:   domainErrors.CodeDeleteFailed,
 			Message: "Failed to delete theme files",
 		})
 	}
 
+	if err := h.assetFiles.ForgetAll(c.Context(), themeName); err != nil {
+		log.Warn().Err(err).Str("theme", themeName).Msg("Failed to remove theme file hashes")
+	}
+
 	log.Info().Str("theme", themeName).Msg("Theme files deleted")
 
 	return c.JSON(fiber.Map{
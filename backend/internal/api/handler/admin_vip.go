@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/vip"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AdminVIPHandler handles admin endpoints for VIP tiers and player loyalty status
+type AdminVIPHandler struct {
+	vipService vip.Service
+	logger     *logger.Logger
+}
+
+// NewAdminVIPHandler creates a new admin VIP handler
+func NewAdminVIPHandler(vipService vip.Service, log *logger.Logger) *AdminVIPHandler {
+	return &AdminVIPHandler{
+		vipService: vipService,
+		logger:     log,
+	}
+}
+
+// ListTiers lists VIP tiers ordered by points threshold
+// GET /admin/vip/tiers
+func (h *AdminVIPHandler) ListTiers(c *fiber.Ctx) error {
+	tiers, err := h.vipService.ListTiers(c.Context())
+	if err != nil {
+		return h.vipError(c, err, "Failed to list VIP tiers")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    tiers,
+	})
+}
+
+// GetTier gets a VIP tier by ID
+// GET /admin/vip/tiers/:id
+func (h *AdminVIPHandler) GetTier(c *fiber.Ctx) error {
+	id, ok := parseUUIDParam(c, "id", "Invalid tier ID")
+	if !ok {
+		return nil
+	}
+
+	tier, err := h.vipService.GetTier(c.Context(), id)
+	if err != nil {
+		return h.vipError(c, err, "Failed to get VIP tier")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    tier,
+	})
+}
+
+// CreateTier creates a new VIP tier
+// POST /admin/vip/tiers
+func (h *AdminVIPHandler) CreateTier(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.CreateVIPTierRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	tier := &vip.Tier{
+		Level:     req.Level,
+		Name:      req.Name,
+		MinPoints: req.MinPoints,
+		Perks:     req.Perks,
+	}
+	if err := h.vipService.CreateTier(c.Context(), tier); err != nil {
+		return h.vipError(c, err, "Failed to create VIP tier")
+	}
+
+	log.Info().Str("tier_id", tier.ID.String()).Int("level", tier.Level).Msg("VIP tier created")
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    tier,
+	})
+}
+
+// UpdateTier updates a VIP tier
+// PUT /admin/vip/tiers/:id
+func (h *AdminVIPHandler) UpdateTier(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	id, ok := parseUUIDParam(c, "id", "Invalid tier ID")
+	if !ok {
+		return nil
+	}
+
+	var req dto.UpdateVIPTierRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	tier, err := h.vipService.GetTier(c.Context(), id)
+	if err != nil {
+		return h.vipError(c, err, "Failed to get VIP tier")
+	}
+
+	if req.Level != nil {
+		tier.Level = *req.Level
+	}
+	if req.Name != nil {
+		tier.Name = *req.Name
+	}
+	if req.MinPoints != nil {
+		tier.MinPoints = *req.MinPoints
+	}
+	if req.Perks != nil {
+		tier.Perks = *req.Perks
+	}
+
+	if err := h.vipService.UpdateTier(c.Context(), tier); err != nil {
+		return h.vipError(c, err, "Failed to update VIP tier")
+	}
+
+	log.Info().Str("tier_id", id.String()).Msg("VIP tier updated")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    tier,
+	})
+}
+
+// DeleteTier deletes a VIP tier
+// DELETE /admin/vip/tiers/:id
+func (h *AdminVIPHandler) DeleteTier(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	id, ok := parseUUIDParam(c, "id", "Invalid tier ID")
+	if !ok {
+		return nil
+	}
+
+	if err := h.vipService.DeleteTier(c.Context(), id); err != nil {
+		return h.vipError(c, err, "Failed to delete VIP tier")
+	}
+
+	log.Info().Str("tier_id", id.String()).Msg("VIP tier deleted")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "VIP tier deleted successfully",
+	})
+}
+
+// GetPlayerStatus gets a player's VIP points and tier
+// GET /admin/players/:id/vip
+func (h *AdminVIPHandler) GetPlayerStatus(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	status, err := h.vipService.GetStatus(c.Context(), playerID)
+	if err != nil {
+		return h.vipError(c, err, "Failed to get player VIP status")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    vipStatusResponse(status),
+	})
+}
+
+// vipError maps VIP service errors to responses
+func (h *AdminVIPHandler) vipError(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, vip.ErrTierNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeNotFound, Message: "VIP tier not found"})
+	case errors.Is(err, vip.ErrTierLevelTaken):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeDuplicateLevel, Message: err.Error()})
+	case errors.Is(err, vip.ErrInvalidTier):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeValidationError, Message: err.Error()})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
+
+// vipStatusResponse converts a VIP status to its response
+func vipStatusResponse(status *vip.Status) *dto.VIPStatusResponse {
+	resp := &dto.VIPStatusResponse{
+		Level:        status.Level(),
+		Points:       status.Points,
+		Perks:        status.Perks(),
+		PointsToNext: status.PointsToNext(),
+	}
+	if status.Tier != nil {
+		resp.TierName = status.Tier.Name
+	}
+	if status.NextTier != nil {
+		resp.NextLevel = status.NextTier.Level
+		resp.NextTierName = status.NextTier.Name
+	}
+	return resp
+}
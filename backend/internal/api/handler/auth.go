@@ -5,6 +5,7 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/domain/player"
 	"github.com/slotmachine/backend/domain/session"
 	"github.com/slotmachine/backend/internal/api/dto"
@@ -35,7 +36,7 @@ func (h *AuthHandler) Register(c *fiber.Ctx) error {
 	var req dto.RegisterRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -46,7 +47,7 @@ func (h *AuthHandler) Register(c *fiber.Ctx) error {
 		parsed, err := uuid.Parse(*req.GameID)
 		if err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "invalid_game_id",
+				Error:   domainErrors.CodeInvalidGameID,
 				Message: "Invalid game ID format",
 			})
 		}
@@ -60,27 +61,27 @@ func (h *AuthHandler) Register(c *fiber.Ctx) error {
 
 		if err == player.ErrPlayerAlreadyExists {
 			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
-				Error:   "player_exists",
+				Error:   domainErrors.CodePlayerExists,
 				Message: "Username or email already exists",
 			})
 		}
 
 		if err == player.ErrGameNotFound {
-			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "game_not_found",
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeGameNotFound,
 				Message: "Specified game does not exist",
 			})
 		}
 
 		if err == player.ErrGameIDRequired {
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "game_id_required",
+				Error:   domainErrors.CodeGameIDRequired,
 				Message: "game_id is required for registration",
 			})
 		}
 
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "registration_failed",
+			Error:   domainErrors.CodeRegistrationFailed,
 			Message: "Failed to register player",
 		})
 	}
@@ -123,7 +124,7 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 	var req dto.LoginRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -134,7 +135,7 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 		parsed, err := uuid.Parse(*req.GameID)
 		if err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "invalid_game_id",
+				Error:   domainErrors.CodeInvalidGameID,
 				Message: "Invalid game ID format",
 			})
 		}
@@ -163,31 +164,41 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 
 		if err == player.ErrInvalidCredentials {
 			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-				Error:   "invalid_credentials",
+				Error:   domainErrors.CodeInvalidCredentials,
 				Message: "Invalid username or password",
 			})
 		}
 
 		if err == player.ErrGameAccessDenied {
 			return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
-				Error:   "game_access_denied",
+				Error:   domainErrors.CodeGameAccessDenied,
 				Message: "Player not authorized for this game",
 			})
 		}
 
 		if errors.Is(err, session.ErrPlayerAlreadyLoggedIn) {
 			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
-				Error:   "already_logged_in",
+				Error:   domainErrors.CodeAlreadyLoggedIn,
 				Message: "Player is already logged in on another device. Set force_logout=true to logout other device.",
 			})
 		}
 
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "login_failed",
+			Error:   domainErrors.CodeLoginFailed,
 			Message: "Failed to authenticate player",
 		})
 	}
 
+	p := result.Player
+	response := newAuthResponse(result)
+
+	log.Info().Str("player_id", p.ID.String()).Msg("Player logged in successfully")
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// newAuthResponse builds the login/refresh response - session-based auth, no JWT
+func newAuthResponse(result *player.LoginResult) dto.AuthResponse {
 	p := result.Player
 
 	// Build game_id string for response
@@ -197,10 +208,11 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 		gameIDStr = &s
 	}
 
-	// Build response - session-based auth, no JWT
-	response := dto.AuthResponse{
-		SessionToken: result.SessionToken,
-		ExpiresAt:    result.ExpiresAt,
+	return dto.AuthResponse{
+		SessionToken:     result.SessionToken,
+		ExpiresAt:        result.ExpiresAt,
+		RefreshToken:     result.RefreshToken,
+		RefreshExpiresAt: result.RefreshExpiresAt,
 		Player: dto.PlayerProfile{
 			ID:           p.ID.String(),
 			Username:     p.Username,
@@ -216,10 +228,143 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 			LastLoginAt:  p.LastLoginAt,
 		},
 	}
+}
 
-	log.Info().Str("player_id", p.ID.String()).Msg("Player logged in successfully")
+// Refresh exchanges a refresh token for a new session token and a rotated refresh token
+// POST /auth/refresh
+func (h *AuthHandler) Refresh(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
 
-	return c.Status(fiber.StatusOK).JSON(response)
+	var req dto.RefreshRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+	if req.RefreshToken == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeRefreshTokenRequired,
+			Message: "Refresh token is required",
+		})
+	}
+
+	clientIp := c.Get("x-real-ip")
+	if clientIp == "" {
+		clientIp = c.IP()
+	}
+	opts := &player.LoginOptions{
+		IPAddress: clientIp,
+		UserAgent: string(c.Request().Header.UserAgent()),
+	}
+	if req.DeviceInfo != nil {
+		opts.DeviceInfo = *req.DeviceInfo
+	}
+
+	result, err := h.playerService.Refresh(c.Context(), req.RefreshToken, opts)
+	if err != nil {
+		log.Warn().Err(err).Msg("Refresh failed")
+
+		switch {
+		case errors.Is(err, session.ErrRefreshTokenInvalid):
+			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidRefreshToken,
+				Message: "Refresh token is invalid or expired",
+			})
+		case errors.Is(err, session.ErrRefreshTokenRevoked):
+			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeRefreshTokenRevoked,
+				Message: "Refresh token has been revoked",
+			})
+		case errors.Is(err, session.ErrRefreshTokenReused):
+			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeRefreshTokenReused,
+				Message: "Refresh token was already used; all sessions of this login were ended",
+			})
+		case errors.Is(err, session.ErrPlayerAlreadyLoggedIn):
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeAlreadyLoggedIn,
+				Message: "Player is logged in on another device",
+			})
+		case errors.Is(err, session.ErrRefreshTokenUnavailable):
+			return c.Status(fiber.StatusServiceUnavailable).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeServiceUnavailable,
+				Message: "Refresh tokens are not available",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeRefreshFailed,
+			Message: "Failed to refresh session",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(newAuthResponse(result))
+}
+
+// RevokeRefreshToken revokes a refresh token and every token rotated from it
+// POST /auth/revoke
+func (h *AuthHandler) RevokeRefreshToken(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.RefreshRequest
+	if err := c.BodyParser(&req); err != nil || req.RefreshToken == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeRefreshTokenRequired,
+			Message: "Refresh token is required",
+		})
+	}
+
+	if err := h.playerService.RevokeRefreshToken(c.Context(), req.RefreshToken); err != nil {
+		log.Error().Err(err).Msg("Refresh token revocation failed")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeLogoutFailed,
+			Message: "Failed to revoke refresh token",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.SuccessResponse{
+		Success: true,
+		Message: "Refresh token revoked",
+	})
+}
+
+// LogoutEverywhere ends all of the player's sessions and revokes all refresh tokens
+// POST /auth/logout-all
+func (h *AuthHandler) LogoutEverywhere(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	// Trial sessions have no player account to log out
+	if isTrial, _ := c.Locals("is_trial").(bool); isTrial {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Trial sessions cannot log out everywhere",
+		})
+	}
+
+	playerIDStr, _ := c.Locals("user_id").(string)
+	playerID, err := uuid.Parse(playerIDStr)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+	}
+
+	if err := h.playerService.LogoutEverywhere(c.Context(), playerID); err != nil {
+		log.Error().Err(err).Msg("Logout everywhere failed")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeLogoutFailed,
+			Message: "Failed to logout everywhere",
+		})
+	}
+
+	log.Info().Str("player_id", playerID.String()).Msg("Player logged out everywhere")
+
+	return c.Status(fiber.StatusOK).JSON(dto.SuccessResponse{
+		Success: true,
+		Message: "Logged out from all devices",
+	})
 }
 
 // Logout handles player logout
@@ -238,7 +383,7 @@ func (h *AuthHandler) Logout(c *fiber.Ctx) error {
 
 	if sessionToken == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "session_token_required",
+			Error:   domainErrors.CodeSessionTokenRequired,
 			Message: "Session token is required for logout",
 		})
 	}
@@ -247,7 +392,7 @@ func (h *AuthHandler) Logout(c *fiber.Ctx) error {
 	if err := h.playerService.Logout(c.Context(), sessionToken); err != nil {
 		log.Error().Err(err).Msg("Logout failed")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "logout_failed",
+			Error:   domainErrors.CodeLogoutFailed,
 			Message: "Failed to logout",
 		})
 	}
@@ -269,7 +414,7 @@ func (h *AuthHandler) GetProfile(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(playerIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "invalid_token",
+			Error:   domainErrors.CodeInvalidToken,
 			Message: "Invalid player ID in token",
 		})
 	}
@@ -279,7 +424,7 @@ func (h *AuthHandler) GetProfile(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to get profile")
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-			Error:   "player_not_found",
+			Error:   domainErrors.CodePlayerNotFound,
 			Message: "Player not found",
 		})
 	}
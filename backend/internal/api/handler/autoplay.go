@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/autoplay"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AutoplayHandler handles server-tracked autoplay endpoints
+type AutoplayHandler struct {
+	autoplayService autoplay.Service
+	logger          *logger.Logger
+}
+
+// NewAutoplayHandler creates a new autoplay handler
+func NewAutoplayHandler(autoplayService autoplay.Service, log *logger.Logger) *AutoplayHandler {
+	return &AutoplayHandler{
+		autoplayService: autoplayService,
+		logger:          log,
+	}
+}
+
+// StartAutoplay starts autoplay in a game session with the player's stop conditions
+// Spins sent with "autoplay": true are then counted against it until a stop condition is met.
+// POST /v1/session/:sessionId/autoplay
+func (h *AutoplayHandler) StartAutoplay(c *fiber.Ctx) error {
+	playerID, sessionID, ok := autoplayParams(c)
+	if !ok {
+		return nil
+	}
+
+	var req dto.StartAutoplayRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	contract, err := h.autoplayService.Start(c.Context(), playerID, sessionID, autoplay.Limits{
+		Spins:          req.Spins,
+		SingleWinLimit: req.SingleWinLimit,
+		LossLimit:      req.LossLimit,
+	})
+	if err != nil {
+		return h.error(c, err, "Failed to start autoplay")
+	}
+
+	return sendEncoded(c, fiber.StatusCreated, toAutoplayResponse(contract))
+}
+
+// GetAutoplay returns the session's running autoplay
+// GET /v1/session/:sessionId/autoplay
+func (h *AutoplayHandler) GetAutoplay(c *fiber.Ctx) error {
+	playerID, sessionID, ok := autoplayParams(c)
+	if !ok {
+		return nil
+	}
+
+	contract, err := h.autoplayService.GetActive(c.Context(), playerID, sessionID)
+	if err != nil {
+		return h.error(c, err, "Failed to get autoplay")
+	}
+
+	return sendEncoded(c, fiber.StatusOK, toAutoplayResponse(contract))
+}
+
+// StopAutoplay cancels the session's running autoplay
+// DELETE /v1/session/:sessionId/autoplay
+func (h *AutoplayHandler) StopAutoplay(c *fiber.Ctx) error {
+	playerID, sessionID, ok := autoplayParams(c)
+	if !ok {
+		return nil
+	}
+
+	contract, err := h.autoplayService.Stop(c.Context(), playerID, sessionID)
+	if err != nil {
+		return h.error(c, err, "Failed to stop autoplay")
+	}
+
+	return sendEncoded(c, fiber.StatusOK, toAutoplayResponse(contract))
+}
+
+// autoplayParams parses the player and session IDs, writing the error response if either is invalid
+func autoplayParams(c *fiber.Ctx) (uuid.UUID, uuid.UUID, bool) {
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		_ = c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	sessionID, err := uuid.Parse(c.Params("sessionId"))
+	if err != nil {
+		_ = c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidSessionID,
+			Message: "Invalid session ID",
+		})
+		return uuid.Nil, uuid.Nil, false
+	}
+	return playerID, sessionID, true
+}
+
+// error maps autoplay service errors to responses
+func (h *AutoplayHandler) error(c *fiber.Ctx, err error, message string) error {
+	if handled, resp := autoplayError(c, err); handled {
+		return resp
+	}
+	switch {
+	case errors.Is(err, autoplay.ErrAutoplayNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeAutoplayNotFound, Message: "Autoplay is not running"})
+	case errors.Is(err, autoplay.ErrAutoplayActive):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeAutoplayActive, Message: err.Error()})
+	case errors.Is(err, autoplay.ErrInvalidLimits):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeValidationError, Message: err.Error()})
+	case errors.Is(err, session.ErrSessionNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeSessionNotFound, Message: "Session not found"})
+	case errors.Is(err, session.ErrSessionAlreadyEnded):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeSessionAlreadyEnded, Message: "Session already ended"})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
+
+// autoplayError maps errors from holding a spin to its autoplay contract
+func autoplayError(c *fiber.Ctx, err error) (bool, error) {
+	switch {
+	case errors.Is(err, autoplay.ErrAutoplayNotActive):
+		return true, c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeAutoplayNotActive, Message: "Start autoplay for this session before sending autoplay spins"})
+	case errors.Is(err, autoplay.ErrLossLimitReached):
+		return true, c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeAutoplayLossLimit, Message: "Autoplay stopped: the next spin could exceed the loss limit"})
+	}
+	return false, nil
+}
+
+// toAutoplayResponse converts an autoplay contract to its API response
+func toAutoplayResponse(c *autoplay.Contract) *dto.AutoplayResponse {
+	response := &dto.AutoplayResponse{
+		ID:             c.ID.String(),
+		SessionID:      c.SessionID.String(),
+		Status:         string(c.Status),
+		TotalSpins:     c.TotalSpins,
+		SpinsPlayed:    c.SpinsPlayed,
+		RemainingSpins: c.RemainingSpins(),
+		SingleWinLimit: c.SingleWinLimit,
+		LossLimit:      c.LossLimit,
+		TotalWagered:   c.TotalWagered,
+		TotalWon:       c.TotalWon,
+		NetLoss:        c.NetLoss(),
+		CreatedAt:      c.CreatedAt,
+		StoppedAt:      c.StoppedAt,
+	}
+	if c.StopReason != nil {
+		response.StopReason = string(*c.StopReason)
+	}
+	return response
+}
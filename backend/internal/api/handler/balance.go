@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/balance"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/notification"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// balanceEventsHeartbeat keeps idle event streams open through proxies
+const balanceEventsHeartbeat = 15 * time.Second
+
+// BalanceHandler handles credits from outside the spin flow and the player's event stream
+type BalanceHandler struct {
+	balanceService      balance.Service
+	notificationService notification.Service
+	logger              *logger.Logger
+}
+
+// NewBalanceHandler creates a new balance handler
+func NewBalanceHandler(balanceService balance.Service, notificationService notification.Service, log *logger.Logger) *BalanceHandler {
+	return &BalanceHandler{
+		balanceService:      balanceService,
+		notificationService: notificationService,
+		logger:              log,
+	}
+}
+
+// OperatorCredit credits one of the operator's players and pushes the new balance to the player
+// POST /v1/operator/credits
+func (h *BalanceHandler) OperatorCredit(c *fiber.Ctx) error {
+	var req dto.OperatorCreditRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+	if req.PlayerID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidPlayerID,
+			Message: "player_id is required",
+		})
+	}
+
+	operatorID, _ := c.Locals("operator_id").(string)
+	t, err := h.balanceService.CreditOperatorPlayer(c.Context(), operatorID, req.PlayerID, &balance.Credit{
+		Amount:      req.Amount,
+		Source:      balance.Source(req.Source),
+		Reference:   req.Reference,
+		Description: req.Description,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, player.ErrPlayerNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodePlayerNotFound, Message: "Player has never launched a game"})
+		case errors.Is(err, balance.ErrDuplicateCredit):
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeDuplicateCredit, Message: err.Error()})
+		case errors.Is(err, balance.ErrInvalidAmount), errors.Is(err, balance.ErrInvalidSource), errors.Is(err, balance.ErrReferenceRequired):
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeValidationError, Message: err.Error()})
+		}
+		h.logger.WithTrace(c).Error().Err(err).Str("reference", req.Reference).Msg("Failed to apply operator credit")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to apply credit",
+		})
+	}
+
+	return c.JSON(dto.CreditResponse{
+		TransactionID: t.ID.String(),
+		PlayerID:      req.PlayerID,
+		Amount:        t.Amount,
+		BalanceBefore: t.BalanceBefore,
+		BalanceAfter:  t.BalanceAfter,
+		Reference:     req.Reference,
+		CreatedAt:     t.CreatedAt,
+	})
+}
+
+// StreamEvents streams the player's balance changes and notifications using Server-Sent Events
+// Each change is sent as a "balance_updated" event whose data is the JSON balance event, and each
+// notification as a "notification" event whose data is the JSON notification event.
+// GET /v1/player/events
+func (h *BalanceHandler) StreamEvents(c *fiber.Ctx) error {
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := h.balanceService.Subscribe(ctx, playerID)
+	if err != nil {
+		cancel()
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to subscribe to balance events")
+		return c.Status(fiber.StatusServiceUnavailable).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeEventsUnavailable,
+			Message: "Balance event stream is unavailable",
+		})
+	}
+	notifications, err := h.notificationService.Subscribe(ctx, playerID)
+	if err != nil {
+		cancel()
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to subscribe to notifications")
+		return c.Status(fiber.StatusServiceUnavailable).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeEventsUnavailable,
+			Message: "Notification stream is unavailable",
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		// The stream ends when the player disconnects and a write fails
+		defer cancel()
+
+		heartbeat := time.NewTicker(balanceEventsHeartbeat)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(event)
+				if err != nil {
+					continue
+				}
+				fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+			case event, ok := <-notifications:
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(event)
+				if err != nil {
+					continue
+				}
+				fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": ping\n\n")
+			}
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+	return nil
+}
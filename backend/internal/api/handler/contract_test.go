@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/freespins"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/api/openapi"
+	"github.com/slotmachine/backend/internal/pkg/crypto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// contractPlayerID is the player every contract request is authenticated as
+var contractPlayerID = uuid.MustParse("00000000-0000-0000-0000-00000000c0de")
+
+type contractSpins struct {
+	spin.Service
+}
+
+func (s *contractSpins) GenerateInitialGrid(ctx context.Context) (spin.Grid, error) {
+	return contractGrid("fa"), nil
+}
+
+func (s *contractSpins) ExecuteSpin(ctx context.Context, playerID, sessionID uuid.UUID, betAmount float64, gameMode, clientSeed, thetaSeed string) (*spin.SpinResult, error) {
+	if betAmount > 100 {
+		return nil, player.ErrInsufficientBalance
+	}
+	return &spin.SpinResult{
+		SpinID:          uuid.New(),
+		SessionID:       uuid.New(),
+		BetAmount:       betAmount,
+		BalanceBefore:   100,
+		BalanceAfterBet: 100 - betAmount,
+		NewBalance:      100 - betAmount + 2.5,
+		Grid:            contractGrid("zhong"),
+		Cascades: spin.Cascades{{
+			CascadeNumber: 1,
+			GridAfter:     contractGrid("bai"),
+			Multiplier:    1,
+			Wins: []spin.CascadeWin{{
+				Symbol: "zhong", Count: 3, Ways: 1, Payout: 2.5, WinAmount: 2.5,
+				Positions: []spin.Position{{Reel: 0, Row: 5}, {Reel: 1, Row: 5, IsGoldToWild: true}, {Reel: 2, Row: 5}},
+			}},
+			TotalCascadeWin: 2.5,
+			WinningTileKind: "zhong",
+		}},
+		SpinTotalWin: 2.5,
+		ScatterCount: 1,
+		WinTier:      &spin.WinTier{Name: "small", Multiplier: 2.5},
+		Timestamp:    time.Now().UTC().Format(time.RFC3339),
+	}, nil
+}
+
+func (s *contractSpins) GetSpinHistory(ctx context.Context, playerID uuid.UUID, page, limit int) (*spin.SpinHistoryResult, error) {
+	return &spin.SpinHistoryResult{
+		Page: page, Limit: limit, Total: 1,
+		Spins: []*spin.Spin{{ID: uuid.New(), SessionID: uuid.New(), PlayerID: playerID, BetAmount: 1, TotalWin: 2.5, CreatedAt: time.Now()}},
+	}, nil
+}
+
+type contractFreeSpins struct {
+	freespins.Service
+	session *freespins.FreeSpinsSession
+}
+
+func (s *contractFreeSpins) GetActiveSession(ctx context.Context, playerID uuid.UUID) (*freespins.FreeSpinsSession, error) {
+	if s.session == nil {
+		return nil, freespins.ErrNotFound
+	}
+	return s.session, nil
+}
+
+type contractPlayers struct {
+	player.Service
+}
+
+func (s *contractPlayers) GetBalance(ctx context.Context, playerID uuid.UUID) (float64, error) {
+	return 97.5, nil
+}
+
+func (s *contractPlayers) Register(ctx context.Context, username, email, password string, gameID *uuid.UUID) (*player.Player, error) {
+	return &player.Player{ID: uuid.New(), Username: username, Email: email, Balance: 100, IsActive: true, CreatedAt: time.Now()}, nil
+}
+
+// contractGrid is a full grid of one symbol
+func contractGrid(symbol string) spin.Grid {
+	grid := make(spin.Grid, 5)
+	for reel := range grid {
+		grid[reel] = make([]string, 10)
+		for row := range grid[reel] {
+			grid[reel][row] = symbol
+		}
+	}
+	return grid
+}
+
+// newContractApp mounts the handlers at their production paths behind the contract check
+func newContractApp(t *testing.T, freeSpinsSession *freespins.FreeSpinsSession) (*fiber.App, *[]openapi.Violation) {
+	t.Helper()
+	doc, err := openapi.Spec()
+	require.NoError(t, err)
+
+	log := logger.New("error", "json")
+	signer, err := crypto.NewEd25519Signer("spin-signing-dev-seed-32-bytes!!")
+	require.NoError(t, err)
+
+	spins := &contractSpins{}
+	freeSpins := &contractFreeSpins{session: freeSpinsSession}
+	players := &contractPlayers{}
+	spinHandler := NewSpinHandler(spins, freeSpins, signer, log)
+	freeSpinsHandler := NewFreeSpinsHandler(freeSpins, signer, log)
+	playerHandler := NewPlayerHandler(players, log)
+	authHandler := NewAuthHandler(players, log)
+
+	var violations []openapi.Violation
+	app := fiber.New()
+	app.Use(openapi.Contract(doc, func(v openapi.Violation) { violations = append(violations, v) }))
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user_id", contractPlayerID.String())
+		return c.Next()
+	})
+
+	app.Post("/v1/auth/register", authHandler.Register)
+	app.Get("/v1/player/balance", playerHandler.GetBalance)
+	app.Get("/v1/initial-grid", spinHandler.GetInitialGrid)
+	app.Post("/v1/base-spins/spin", spinHandler.ExecuteSpin)
+	app.Get("/v1/base-spins/histories", spinHandler.GetSpinHistory)
+	app.Get("/v1/free-spins/status", freeSpinsHandler.GetStatus)
+	return app, &violations
+}
+
+func TestHandlers_MatchOpenAPIContract(t *testing.T) {
+	requests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		status int
+	}{
+		{"register", "POST", "/v1/auth/register", `{"username":"alice","email":"alice@example.com","password":"secret123"}`, fiber.StatusCreated},
+		{"balance", "GET", "/v1/player/balance", "", fiber.StatusOK},
+		{"initial grid", "GET", "/v1/initial-grid", "", fiber.StatusOK},
+		{"spin", "POST", "/v1/base-spins/spin", `{"bet_amount":1}`, fiber.StatusOK},
+		{"compact spin", "POST", "/v1/base-spins/spin?format=compact", `{"bet_amount":1}`, fiber.StatusOK},
+		{"spin error", "POST", "/v1/base-spins/spin", `{"bet_amount":1000}`, fiber.StatusBadRequest},
+		{"spin history", "GET", "/v1/base-spins/histories", "", fiber.StatusOK},
+		{"free spins status", "GET", "/v1/free-spins/status", "", fiber.StatusOK},
+	}
+
+	sessions := map[string]*freespins.FreeSpinsSession{
+		"without free spins": nil,
+		"with free spins": {
+			ID: uuid.New(), PlayerID: contractPlayerID, SessionID: uuid.New(),
+			TotalSpinsAwarded: 10, SpinsCompleted: 3, RemainingSpins: 7, LockedBetAmount: 1, TotalWon: 12, IsActive: true,
+		},
+	}
+
+	for name, session := range sessions {
+		t.Run(name, func(t *testing.T) {
+			app, violations := newContractApp(t, session)
+			for _, r := range requests {
+				var body io.Reader
+				if r.body != "" {
+					body = strings.NewReader(r.body)
+				}
+				req := httptest.NewRequest(r.method, r.path, body)
+				req.Header.Set("Content-Type", "application/json")
+				resp, err := app.Test(req)
+				require.NoError(t, err, r.name)
+				assert.Equal(t, r.status, resp.StatusCode, r.name)
+			}
+			for _, v := range *violations {
+				t.Errorf("contract violation: %s", v)
+			}
+		})
+	}
+}
+
+func TestContract_ReportsDrift(t *testing.T) {
+	doc, err := openapi.Spec()
+	require.NoError(t, err)
+
+	var violations []openapi.Violation
+	app := fiber.New()
+	app.Use(openapi.Contract(doc, func(v openapi.Violation) { violations = append(violations, v) }))
+	// A handler that renamed a field and answers the balance as a string
+	app.Get("/v1/player/balance", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"player_balance": "97.50"})
+	})
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/v1/player/balance", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	require.Len(t, violations, 1)
+	assert.Contains(t, violations[0].String(), `GET /v1/player/balance -> 200`)
+	assert.Contains(t, violations[0].Err.Error(), `missing required property "balance"`)
+}
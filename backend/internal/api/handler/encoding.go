@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/slotmachine/backend/internal/pkg/wireformat"
+)
+
+// sendEncoded writes v in the format the client negotiated through Accept
+// JSON unless MessagePack or Protobuf is asked for; error responses always stay JSON.
+func sendEncoded(c *fiber.Ctx, status int, v any) error {
+	c.Vary(fiber.HeaderAccept)
+
+	format := wireformat.Negotiate(c.Get(fiber.HeaderAccept))
+	if format == wireformat.JSON {
+		return c.Status(status).JSON(v)
+	}
+
+	body, err := wireformat.Marshal(format, v)
+	if err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderContentType, format.ContentType())
+	return c.Status(status).Send(body)
+}
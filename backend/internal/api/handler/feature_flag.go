@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/featureflags"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// FeatureFlagHandler exposes the caller's feature flags so clients can match server behaviour
+type FeatureFlagHandler struct {
+	flags  *featureflags.Service
+	logger *logger.Logger
+}
+
+// NewFeatureFlagHandler creates a new feature flag handler
+func NewFeatureFlagHandler(flags *featureflags.Service, log *logger.Logger) *FeatureFlagHandler {
+	return &FeatureFlagHandler{
+		flags:  flags,
+		logger: log,
+	}
+}
+
+// GetMyFeatures returns the flags evaluated for the authenticated player
+// GET /v1/player/features
+func (h *FeatureFlagHandler) GetMyFeatures(c *fiber.Ctx) error {
+	playerIDStr, _ := c.Locals("user_id").(string)
+	playerID, err := uuid.Parse(playerIDStr)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+	}
+
+	return c.JSON(dto.FeaturesResponse{
+		Features: h.flags.Evaluate(c.Context(), playerID),
+	})
+}
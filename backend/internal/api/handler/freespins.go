@@ -1,11 +1,18 @@
 package handler
 
 import (
+	"context"
+	"errors"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/domain/freespins"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/spin"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/api/testdata"
+	"github.com/slotmachine/backend/internal/pkg/crypto"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
@@ -16,16 +23,19 @@ var _ = testdata.SpinScenarios
 // FreeSpinsHandler handles free spins endpoints
 type FreeSpinsHandler struct {
 	freeSpinsService freespins.Service
+	signer           *crypto.Ed25519Signer // nil leaves spin responses unsigned
 	logger           *logger.Logger
 }
 
 // NewFreeSpinsHandler creates a new free spins handler
 func NewFreeSpinsHandler(
 	freeSpinsService freespins.Service,
+	signer *crypto.Ed25519Signer,
 	log *logger.Logger,
 ) *FreeSpinsHandler {
 	return &FreeSpinsHandler{
 		freeSpinsService: freeSpinsService,
+		signer:           signer,
 		logger:           log,
 	}
 }
@@ -37,7 +47,7 @@ func (h *FreeSpinsHandler) GetStatus(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(playerIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "invalid_token",
+			Error:   domainErrors.CodeInvalidToken,
 			Message: "Invalid player ID in token",
 		})
 	}
@@ -86,7 +96,7 @@ func (h *FreeSpinsHandler) ExecuteFreeSpin(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(playerIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "invalid_token",
+			Error:   domainErrors.CodeInvalidToken,
 			Message: "Invalid player ID in token",
 		})
 	}
@@ -95,7 +105,7 @@ func (h *FreeSpinsHandler) ExecuteFreeSpin(c *fiber.Ctx) error {
 	var req dto.ExecuteFreeSpinRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -104,32 +114,50 @@ func (h *FreeSpinsHandler) ExecuteFreeSpin(c *fiber.Ctx) error {
 	freeSpinsSessionID, err := uuid.Parse(req.FreeSpinsSessionID)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_free_spins_session_id",
+			Error:   domainErrors.CodeInvalidFreeSpinsSessionID,
 			Message: "Invalid free spins session ID",
 		})
 	}
 
 	// Execute free spin (pass client seed for provably fair)
-	result, err := h.freeSpinsService.ExecuteFreeSpin(c.Context(), freeSpinsSessionID, req.ClientSeed)
+	ctx := context.Context(c.Context())
+	if req.ClientNonce != nil {
+		ctx = spin.WithClientNonce(ctx, *req.ClientNonce)
+	}
+	result, err := h.freeSpinsService.ExecuteFreeSpin(ctx, freeSpinsSessionID, req.ClientSeed)
 	if err != nil {
 		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to execute free spin")
 
 		if err == freespins.ErrFreeSpinsNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "free_spins_not_found",
+				Error:   domainErrors.CodeFreeSpinsNotFound,
 				Message: "Free spins session not found",
 			})
 		}
 
 		if err == freespins.ErrFreeSpinsNotActive {
 			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
-				Error:   "free_spins_not_active",
+				Error:   domainErrors.CodeFreeSpinsNotActive,
 				Message: "Free spins session is not active",
 			})
 		}
 
+		if err == provablyfair.ErrClientNonceRequired {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeClientNonceRequired,
+				Message: "Free spin requests must include client_nonce",
+			})
+		}
+
+		if errors.Is(err, provablyfair.ErrClientNonceMismatch) {
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeClientNonceMismatch,
+				Message: err.Error(),
+			})
+		}
+
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_execute_free_spin",
+			Error:   domainErrors.CodeFailedToExecuteFreeSpin,
 			Message: "Failed to execute free spin",
 		})
 	}
@@ -153,6 +181,9 @@ func (h *FreeSpinsHandler) ExecuteFreeSpin(c *fiber.Ctx) error {
 		FreeSpinsAdditional:     result.FreeSpinsAdditional,
 		FreeSpinsRemainingSpins: result.FreeSpinsRemainingSpins,
 		FreeSessionTotalWin:     result.FreeSessionTotalWin,
+		StickyWilds:             convertStickyWilds(result.StickyWilds),
+		WinTier:                 toWinTierInfo(result.WinTier),
+		Timing:                  toSpinTimingInfo(result.Timing),
 		Timestamp:               result.Timestamp,
 	}
 
@@ -165,5 +196,81 @@ func (h *FreeSpinsHandler) ExecuteFreeSpin(c *fiber.Ctx) error {
 		}
 	}
 
-	return c.Status(fiber.StatusOK).JSON(response)
+	return sendSpinResponse(c, h.signer, &response)
+}
+
+// ExecuteAllFreeSpins plays the player's free spins session to completion in one request
+// POST /v1/free-spins/autoplay
+func (h *FreeSpinsHandler) ExecuteAllFreeSpins(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+	}
+
+	var req dto.ExecuteAllFreeSpinsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	freeSpinsSessionID, err := uuid.Parse(req.FreeSpinsSessionID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidFreeSpinsSessionID,
+			Message: "Invalid free spins session ID",
+		})
+	}
+
+	result, err := h.freeSpinsService.ExecuteAllFreeSpins(c.Context(), playerID, freeSpinsSessionID, req.ClientSeed)
+	if err != nil {
+		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to autoplay free spins")
+
+		if err == freespins.ErrFreeSpinsNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeFreeSpinsNotFound,
+				Message: "Free spins session not found",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToExecuteFreeSpin,
+			Message: "Failed to execute free spins",
+		})
+	}
+
+	return sendEncoded(c, fiber.StatusOK, toFreeSpinsAutoplayResponse(result))
+}
+
+// toFreeSpinsAutoplayResponse converts a server-side free spins run to its compact API response
+func toFreeSpinsAutoplayResponse(result *freespins.AutoplayResult) *dto.FreeSpinsAutoplayResponse {
+	spins := make([]dto.AutoplayFreeSpin, len(result.Spins))
+	for i, s := range result.Spins {
+		spins[i] = dto.AutoplayFreeSpin{
+			SpinID:          s.SpinID.String(),
+			Grid:            convertGrid(s.Grid),
+			CascadeCount:    s.CascadeCount,
+			TotalWin:        s.TotalWin,
+			ScatterCount:    s.ScatterCount,
+			Retriggered:     s.Retriggered,
+			AdditionalSpins: s.AdditionalSpins,
+			Nonce:           s.Nonce,
+			SpinHash:        s.SpinHash,
+		}
+	}
+
+	return &dto.FreeSpinsAutoplayResponse{
+		FreeSpinsSessionID: result.FreeSpinsSessionID.String(),
+		SpinsPlayed:        result.SpinsPlayed,
+		TotalWon:           result.TotalWon,
+		RemainingSpins:     result.RemainingSpins,
+		NewBalance:         result.NewBalance,
+		Spins:              spins,
+	}
 }
@@ -1,34 +1,95 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/domain/game"
 	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/api/middleware"
+	"github.com/slotmachine/backend/internal/game/rules"
+	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/i18n"
 	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/service"
 )
 
 // GameHandler handles game-related endpoints
 type GameHandler struct {
-	gameRepo game.Repository
-	logger   *logger.Logger
+	gameRepo   game.Repository
+	storage    storage.Storage
+	rules      *service.GameRulesService
+	translator *i18n.Translator
+	logger     *logger.Logger
 }
 
 // NewGameHandler creates a new game handler
 func NewGameHandler(
 	gameRepo game.Repository,
+	s storage.Storage,
+	gameRules *service.GameRulesService,
+	translator *i18n.Translator,
 	log *logger.Logger,
 ) *GameHandler {
 	return &GameHandler{
-		gameRepo: gameRepo,
-		logger:   log,
+		gameRepo:   gameRepo,
+		storage:    s,
+		rules:      gameRules,
+		translator: translator,
+		logger:     log,
 	}
 }
 
+// assetURLBuilder turns asset-relative paths into URLs the client can fetch.
+// Public assets use the stored base_url; private assets get signed, expiring URLs.
+// The first signing error is kept in err and later paths resolve to "".
+type assetURLBuilder struct {
+	ctx       context.Context
+	storage   storage.Storage
+	asset     *game.Asset
+	baseURL   string
+	expiresAt *time.Time
+	err       error
+}
+
+// newAssetURLBuilder creates a URL builder for one request
+func (h *GameHandler) newAssetURLBuilder(ctx context.Context, asset *game.Asset) *assetURLBuilder {
+	return &assetURLBuilder{
+		ctx:     ctx,
+		storage: h.storage,
+		asset:   asset,
+		baseURL: strings.TrimSuffix(asset.BaseURL, "/"),
+	}
+}
+
+// URL returns the fetchable URL for a path relative to the asset folder
+func (b *assetURLBuilder) URL(path string) string {
+	if !b.asset.IsPrivate {
+		return b.baseURL + "/" + path
+	}
+	if b.err != nil {
+		return ""
+	}
+
+	info, err := b.storage.GeneratePresignedDownloadURL(b.ctx, b.asset.ObjectName, path, 0)
+	if err != nil {
+		b.err = err
+		return ""
+	}
+	// Report the earliest expiry so clients know when to refetch
+	if b.expiresAt == nil || info.ExpiresAt.Before(*b.expiresAt) {
+		b.expiresAt = &info.ExpiresAt
+	}
+	return info.URL
+}
+
 // GetGameAssets retrieves the assets for a game
 func (h *GameHandler) GetGameAssets(c *fiber.Ctx) error {
 	log := h.logger.WithTrace(c)
@@ -39,7 +100,7 @@ func (h *GameHandler) GetGameAssets(c *fiber.Ctx) error {
 	if err != nil {
 		log.Warn().Err(err).Str("game_id", gameIDStr).Msg("Invalid game ID format")
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_game_id",
+			Error:   domainErrors.CodeInvalidGameID,
 			Message: "Invalid game ID format",
 		})
 	}
@@ -52,13 +113,13 @@ func (h *GameHandler) GetGameAssets(c *fiber.Ctx) error {
 		if errors.Is(err, game.ErrGameNotFound) {
 			log.Warn().Str("game_id", gameID.String()).Msg("Game not found")
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "game_not_found",
+				Error:   domainErrors.CodeGameNotFound,
 				Message: "Game not found",
 			})
 		}
 		log.Error().Err(err).Str("game_id", gameID.String()).Msg("Failed to get game")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "internal_error",
+			Error:   domainErrors.CodeInternalError,
 			Message: "Failed to retrieve game",
 		})
 	}
@@ -69,30 +130,30 @@ func (h *GameHandler) GetGameAssets(c *fiber.Ctx) error {
 		if errors.Is(err, game.ErrGameNotFound) {
 			log.Warn().Str("game_id", gameID.String()).Msg("Game not found")
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "game_not_found",
+				Error:   domainErrors.CodeGameNotFound,
 				Message: "Game not found",
 			})
 		}
 		if errors.Is(err, game.ErrNoActiveConfig) {
 			log.Warn().Str("game_id", gameID.String()).Msg("No active asset configuration")
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "no_active_config",
+				Error:   domainErrors.CodeNoActiveConfig,
 				Message: "No active asset configuration for this game",
 			})
 		}
 		log.Error().Err(err).Str("game_id", gameID.String()).Msg("Failed to get game assets")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "internal_error",
+			Error:   domainErrors.CodeInternalError,
 			Message: "Failed to retrieve game assets",
 		})
 	}
 
-	// Parse the images JSON and build full URLs
-	var images map[string]string
-	if err := json.Unmarshal(asset.Images, &images); err != nil {
+	// Parse the images JSON (plain paths + optimized variants) and build full URLs
+	images, imageVariants, err := game.ParseAssetImages(asset.Images)
+	if err != nil {
 		log.Error().Err(err).Msg("Failed to parse images JSON")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "internal_error",
+			Error:   domainErrors.CodeInternalError,
 			Message: "Failed to process asset images",
 		})
 	}
@@ -119,13 +180,28 @@ func (h *GameHandler) GetGameAssets(c *fiber.Ctx) error {
 		videos = make(map[string]any)
 	}
 
-	// Build full URLs using stored base_url (set once at asset creation)
-	baseURL := strings.TrimSuffix(asset.BaseURL, "/")
+	// Build full URLs using stored base_url (set once at asset creation),
+	// or signed expiring URLs when the asset is private
+	assetURLs := h.newAssetURLBuilder(c.Context(), asset)
 
 	// Build full URLs for images
 	fullURLImages := make(map[string]string)
 	for key, path := range images {
-		fullURLImages[key] = baseURL + "/" + path
+		fullURLImages[key] = assetURLs.URL(path)
+	}
+
+	// Build full URLs for optimized image variants
+	var fullURLImageVariants map[string][]game.ImageVariant
+	if len(imageVariants) > 0 {
+		fullURLImageVariants = make(map[string][]game.ImageVariant, len(imageVariants))
+		for key, variants := range imageVariants {
+			urls := make([]game.ImageVariant, len(variants))
+			for i, v := range variants {
+				v.Path = assetURLs.URL(v.Path)
+				urls[i] = v
+			}
+			fullURLImageVariants[key] = urls
+		}
 	}
 
 	// Build full URLs for audios (handle both string and []string values)
@@ -133,12 +209,12 @@ func (h *GameHandler) GetGameAssets(c *fiber.Ctx) error {
 	for key, value := range audios {
 		switch v := value.(type) {
 		case string:
-			fullURLAudios[key] = baseURL + "/" + v
+			fullURLAudios[key] = assetURLs.URL(v)
 		case []interface{}:
 			urls := make([]string, len(v))
 			for i, item := range v {
 				if s, ok := item.(string); ok {
-					urls[i] = baseURL + "/" + s
+					urls[i] = assetURLs.URL(s)
 				}
 			}
 			fullURLAudios[key] = urls
@@ -157,15 +233,15 @@ func (h *GameHandler) GetGameAssets(c *fiber.Ctx) error {
 		switch v := value.(type) {
 		case string:
 			// Simple string path (e.g., "default": "videos/default.mp4")
-			fullURLVideos[key] = baseURL + "/" + v
+			fullURLVideos[key] = assetURLs.URL(v)
 		case map[string]interface{}:
 			// Check if it's a spritesheet object with png/json keys
 			if pngPath, hasPng := v["png"].(string); hasPng {
 				if jsonPath, hasJson := v["json"].(string); hasJson {
 					// Spritesheet animation object: { png: "...", json: "..." }
 					fullURLVideos[key] = map[string]string{
-						"png":  baseURL + "/" + pngPath,
-						"json": baseURL + "/" + jsonPath,
+						"png":  assetURLs.URL(pngPath),
+						"json": assetURLs.URL(jsonPath),
 					}
 					continue
 				}
@@ -184,10 +260,10 @@ func (h *GameHandler) GetGameAssets(c *fiber.Ctx) error {
 								if animObj, ok := item.(map[string]interface{}); ok {
 									spritesheet := make(map[string]string)
 									if png, ok := animObj["png"].(string); ok {
-										spritesheet["png"] = baseURL + "/" + png
+										spritesheet["png"] = assetURLs.URL(png)
 									}
 									if jsonPath, ok := animObj["json"].(string); ok {
-										spritesheet["json"] = baseURL + "/" + jsonPath
+										spritesheet["json"] = assetURLs.URL(jsonPath)
 									}
 									spritesheets = append(spritesheets, spritesheet)
 								}
@@ -198,7 +274,7 @@ func (h *GameHandler) GetGameAssets(c *fiber.Ctx) error {
 							urls := make([]string, 0, len(paths))
 							for _, item := range paths {
 								if s, ok := item.(string); ok {
-									urls = append(urls, baseURL+"/"+s)
+									urls = append(urls, assetURLs.URL(s))
 								}
 							}
 							symbolAnims[subKey] = urls
@@ -214,6 +290,14 @@ func (h *GameHandler) GetGameAssets(c *fiber.Ctx) error {
 		}
 	}
 
+	if assetURLs.err != nil {
+		log.Error().Err(assetURLs.err).Str("asset_id", asset.ID.String()).Msg("Failed to sign asset URLs")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to generate asset URLs",
+		})
+	}
+
 	log.Info().
 		Str("game_id", gameID.String()).
 		Str("game_name", gameEntity.Name).
@@ -227,9 +311,182 @@ func (h *GameHandler) GetGameAssets(c *fiber.Ctx) error {
 		Name:            gameEntity.Name,
 		SpritesheetJSON: asset.SpritesheetJSON,
 		Images:          fullURLImages,
+		ImageVariants:   fullURLImageVariants,
 		Audios:          fullURLAudios,
 		Videos:          fullURLVideos,
+		URLsExpireAt:    assetURLs.expiresAt,
 	}
 
 	return c.Status(fiber.StatusOK).JSON(response)
 }
+
+// GetAssetManifest returns every file of the game's active asset with its SHA256 and size
+// Clients compare against a cached manifest to download only changed files
+// GET /v1/games/:id/assets/manifest
+func (h *GameHandler) GetAssetManifest(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	gameIDStr := c.Params("id")
+	gameID, err := uuid.Parse(gameIDStr)
+	if err != nil {
+		log.Warn().Err(err).Str("game_id", gameIDStr).Msg("Invalid game ID format")
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidGameID,
+			Message: "Invalid game ID format",
+		})
+	}
+
+	asset, err := h.gameRepo.GetActiveAssetForGame(c.Context(), gameID)
+	if err != nil {
+		if errors.Is(err, game.ErrGameNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeGameNotFound,
+				Message: "Game not found",
+			})
+		}
+		if errors.Is(err, game.ErrNoActiveConfig) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNoActiveConfig,
+				Message: "No active asset configuration for this game",
+			})
+		}
+		log.Error().Err(err).Str("game_id", gameID.String()).Msg("Failed to get game assets")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to retrieve game assets",
+		})
+	}
+
+	files, err := h.gameRepo.ListAssetFiles(c.Context(), asset.ID)
+	if err != nil {
+		log.Error().Err(err).Str("asset_id", asset.ID.String()).Msg("Failed to list asset files")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to retrieve asset manifest",
+		})
+	}
+
+	assetURLs := h.newAssetURLBuilder(c.Context(), asset)
+
+	response := game.AssetManifestResponse{
+		GameID:  gameID,
+		AssetID: asset.ID,
+		Hash:    game.ManifestHash(files),
+		Files:   make([]game.AssetManifestFile, 0, len(files)),
+	}
+	// Private assets have no usable base URL - every file URL is signed individually
+	if !asset.IsPrivate {
+		response.BaseURL = strings.TrimSuffix(asset.BaseURL, "/")
+	}
+	for _, f := range files {
+		response.TotalSize += f.Size
+		response.Files = append(response.Files, game.AssetManifestFile{
+			Path:   f.Path,
+			URL:    assetURLs.URL(f.Path),
+			SHA256: f.SHA256,
+			Size:   f.Size,
+		})
+	}
+	if assetURLs.err != nil {
+		log.Error().Err(assetURLs.err).Str("asset_id", asset.ID.String()).Msg("Failed to sign asset URLs")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to generate asset URLs",
+		})
+	}
+	response.URLsExpireAt = assetURLs.expiresAt
+
+	// Let clients revalidate cheaply with If-None-Match
+	// Skipped for private assets: a 304 would leave the client holding expired signed URLs
+	if !asset.IsPrivate {
+		etag := `"` + response.Hash + `"`
+		c.Set(fiber.HeaderETag, etag)
+		if c.Get(fiber.HeaderIfNoneMatch) == etag {
+			return c.SendStatus(fiber.StatusNotModified)
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// GetPaytable returns the game rules and paytable as applied by the engine
+// GET /v1/games/:id/paytable
+func (h *GameHandler) GetPaytable(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	gameIDStr := c.Params("id")
+	gameID, err := uuid.Parse(gameIDStr)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidGameID,
+			Message: "Invalid game ID format",
+		})
+	}
+
+	if _, err := h.gameRepo.GetGameByID(c.Context(), gameID); err != nil {
+		if errors.Is(err, game.ErrGameNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeGameNotFound,
+				Message: "Game not found",
+			})
+		}
+		log.Error().Err(err).Str("game_id", gameID.String()).Msg("Failed to get game")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to retrieve game",
+		})
+	}
+
+	// Rule changes reach clients within the cache lifetime
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	gameRules := h.rules.RulesForGame(c.Context(), gameID)
+	paytable := rules.Build(gameRules.Multipliers, gameRules.FreeSpins, gameRules.Wilds)
+	return c.JSON(dto.PaytableResponse{
+		Rules:        paytable,
+		Descriptions: h.bonusDescriptions(c.Context(), middleware.Locale(c), paytable),
+	})
+}
+
+// bonusDescriptions describes the game's bonus features in the player's locale
+func (h *GameHandler) bonusDescriptions(ctx context.Context, locale string, r *rules.Rules) []string {
+	t := func(key string, args map[string]string) string {
+		return h.translator.Translate(ctx, locale, key, "", args)
+	}
+
+	descriptions := make([]string, 0, 6)
+	if spins, ok := r.FreeSpins.Awards[r.FreeSpins.MinScatters]; ok {
+		descriptions = append(descriptions, t("bonus.free_spins", map[string]string{
+			"min_scatters": strconv.Itoa(r.FreeSpins.MinScatters),
+			"spins":        strconv.Itoa(spins),
+		}))
+	}
+	if r.FreeSpins.Retrigger {
+		descriptions = append(descriptions, t("bonus.free_spins_retrigger", nil))
+	}
+	if len(r.Multipliers.BaseGame) > 0 && len(r.Multipliers.FreeSpins) > 0 {
+		descriptions = append(descriptions, t("bonus.cascade_multipliers", map[string]string{
+			"max_multiplier":            strconv.Itoa(r.Multipliers.BaseGame[len(r.Multipliers.BaseGame)-1]),
+			"max_free_spins_multiplier": strconv.Itoa(r.Multipliers.FreeSpins[len(r.Multipliers.FreeSpins)-1]),
+		}))
+	}
+	if r.Wild.Sticky {
+		descriptions = append(descriptions, t("bonus.sticky_wilds", nil))
+	}
+	if r.Wild.Expanding {
+		descriptions = append(descriptions, t("bonus.expanding_wilds", nil))
+	}
+	if r.Wild.Multiplier > 1 {
+		descriptions = append(descriptions, t("bonus.wild_multiplier", map[string]string{
+			"multiplier": strconv.Itoa(r.Wild.Multiplier),
+		}))
+	}
+
+	// Keys missing from every bundle translate to ""
+	kept := descriptions[:0]
+	for _, d := range descriptions {
+		if d != "" {
+			kept = append(kept, d)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/service"
+)
+
+// HealthHandler handles the liveness and readiness probes
+type HealthHandler struct {
+	healthService *service.HealthService
+	logger        *logger.Logger
+}
+
+// NewHealthHandler creates a new health handler
+func NewHealthHandler(healthService *service.HealthService, log *logger.Logger) *HealthHandler {
+	return &HealthHandler{
+		healthService: healthService,
+		logger:        log,
+	}
+}
+
+// Liveness reports every dependency's status but always answers 200 while the process serves,
+// so an outage of a shared dependency doesn't restart every instance at once
+// GET /healthz
+func (h *HealthHandler) Liveness(c *fiber.Ctx) error {
+	report := h.healthService.Check(c.Context())
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    report,
+	})
+}
+
+// Readiness answers 503 while any dependency is down, taking the instance out of rotation
+// GET /readyz
+func (h *HealthHandler) Readiness(c *fiber.Ctx) error {
+	report := h.healthService.Check(c.Context())
+	if report.Status == service.HealthDown {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"success": false,
+			"data":    report,
+		})
+	}
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    report,
+	})
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/historyexport"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// HistoryExportHandler handles player game-history downloads
+type HistoryExportHandler struct {
+	exportService historyexport.Service
+	logger        *logger.Logger
+}
+
+// NewHistoryExportHandler creates a new history export handler
+func NewHistoryExportHandler(exportService historyexport.Service, log *logger.Logger) *HistoryExportHandler {
+	return &HistoryExportHandler{
+		exportService: exportService,
+		logger:        log,
+	}
+}
+
+// CreateExport queues an export of the player's spins as CSV or a PDF statement
+// Both dates are inclusive UTC days. The export is generated in the background; poll it
+// until it completes, then fetch its download link.
+// POST /player/history-exports
+func (h *HistoryExportHandler) CreateExport(c *fiber.Ctx) error {
+	playerID, ok := historyExportPlayer(c)
+	if !ok {
+		return nil
+	}
+
+	var req dto.CreateHistoryExportRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	from, errFrom := time.Parse(statsDateLayout, req.From)
+	to, errTo := time.Parse(statsDateLayout, req.To)
+	if errFrom != nil || errTo != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRange,
+			Message: "Invalid date range: use from/to as YYYY-MM-DD",
+		})
+	}
+
+	export, err := h.exportService.Request(c.Context(), playerID, historyexport.Format(req.Format), from, to.AddDate(0, 0, 1))
+	if err != nil {
+		return h.error(c, err, "Failed to request history export")
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(toHistoryExportResponse(export))
+}
+
+// ListExports lists the player's recent exports
+// GET /player/history-exports
+func (h *HistoryExportHandler) ListExports(c *fiber.Ctx) error {
+	playerID, ok := historyExportPlayer(c)
+	if !ok {
+		return nil
+	}
+
+	exports, err := h.exportService.List(c.Context(), playerID)
+	if err != nil {
+		return h.error(c, err, "Failed to list history exports")
+	}
+
+	response := dto.HistoryExportListResponse{Exports: make([]dto.HistoryExportResponse, 0, len(exports))}
+	for _, e := range exports {
+		response.Exports = append(response.Exports, *toHistoryExportResponse(e))
+	}
+	return c.JSON(response)
+}
+
+// GetExport returns one of the player's exports
+// GET /player/history-exports/:id
+func (h *HistoryExportHandler) GetExport(c *fiber.Ctx) error {
+	playerID, ok := historyExportPlayer(c)
+	if !ok {
+		return nil
+	}
+	id, ok := parseUUIDParam(c, "id", "Invalid export ID")
+	if !ok {
+		return nil
+	}
+
+	export, err := h.exportService.Get(c.Context(), playerID, id)
+	if err != nil {
+		return h.error(c, err, "Failed to get history export")
+	}
+	return c.JSON(toHistoryExportResponse(export))
+}
+
+// DownloadExport returns a short-lived download link to a completed export
+// GET /player/history-exports/:id/download
+func (h *HistoryExportHandler) DownloadExport(c *fiber.Ctx) error {
+	playerID, ok := historyExportPlayer(c)
+	if !ok {
+		return nil
+	}
+	id, ok := parseUUIDParam(c, "id", "Invalid export ID")
+	if !ok {
+		return nil
+	}
+
+	download, err := h.exportService.Download(c.Context(), playerID, id)
+	if err != nil {
+		return h.error(c, err, "Failed to sign history export download")
+	}
+
+	return c.JSON(dto.HistoryExportDownloadResponse{
+		URL:       download.URL,
+		FileName:  download.FileName,
+		ExpiresAt: download.ExpiresAt,
+	})
+}
+
+// historyExportPlayer parses the player ID, writing the error response if it is invalid
+func historyExportPlayer(c *fiber.Ctx) (uuid.UUID, bool) {
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		_ = c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+		return uuid.Nil, false
+	}
+	return playerID, true
+}
+
+// error maps history export service errors to responses
+func (h *HistoryExportHandler) error(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, historyexport.ErrExportNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeExportNotFound, Message: "History export not found"})
+	case errors.Is(err, historyexport.ErrInvalidFormat):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeInvalidFormat, Message: err.Error()})
+	case errors.Is(err, historyexport.ErrInvalidRange):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeInvalidRange, Message: err.Error()})
+	case errors.Is(err, historyexport.ErrExportNotReady):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeExportNotReady, Message: "History export has not completed"})
+	case errors.Is(err, historyexport.ErrTooManyPending):
+		return c.Status(fiber.StatusTooManyRequests).JSON(dto.ErrorResponse{Error: domainErrors.CodeTooManyExports, Message: "Wait for your exports in progress to finish before requesting another"})
+	case errors.Is(err, player.ErrPlayerNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodePlayerNotFound, Message: "Player not found"})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
+
+// toHistoryExportResponse converts a history export to its API response
+func toHistoryExportResponse(e *historyexport.Export) *dto.HistoryExportResponse {
+	return &dto.HistoryExportResponse{
+		ID:          e.ID.String(),
+		Format:      string(e.Format),
+		From:        e.RangeStart.Format(statsDateLayout),
+		To:          e.RangeEnd.AddDate(0, 0, -1).Format(statsDateLayout),
+		Status:      string(e.Status),
+		FileName:    e.FileName(),
+		SpinCount:   e.SpinCount,
+		SizeBytes:   e.SizeBytes,
+		Error:       e.Error,
+		CreatedAt:   e.CreatedAt,
+		CompletedAt: e.CompletedAt,
+	}
+}
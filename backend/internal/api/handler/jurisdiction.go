@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/jurisdiction"
+	"github.com/slotmachine/backend/domain/kyc"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/api/middleware"
+	"github.com/slotmachine/backend/internal/pkg/i18n"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// JurisdictionHandler handles player-facing compliance endpoints
+type JurisdictionHandler struct {
+	jurisdictionService jurisdiction.Service
+	playerService       player.Service
+	translator          *i18n.Translator
+	logger              *logger.Logger
+}
+
+// NewJurisdictionHandler creates a new jurisdiction handler
+func NewJurisdictionHandler(
+	jurisdictionService jurisdiction.Service,
+	playerService player.Service,
+	translator *i18n.Translator,
+	log *logger.Logger,
+) *JurisdictionHandler {
+	return &JurisdictionHandler{
+		jurisdictionService: jurisdictionService,
+		playerService:       playerService,
+		translator:          translator,
+		logger:              log,
+	}
+}
+
+// GetMyJurisdiction returns the compliance rules that apply to the authenticated player
+// GET /player/jurisdiction
+func (h *JurisdictionHandler) GetMyJurisdiction(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+	}
+
+	p, err := h.playerService.GetProfile(c.Context(), playerID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodePlayerNotFound,
+			Message: "Player not found",
+		})
+	}
+
+	j, err := h.jurisdictionService.ForPlayer(c.Context(), p.JurisdictionCode)
+	if err != nil {
+		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to resolve player jurisdiction")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to get jurisdiction",
+		})
+	}
+
+	// No jurisdiction applies: no restrictions
+	if j == nil {
+		return c.JSON(dto.PlayerJurisdictionResponse{AutoplayAllowed: true})
+	}
+
+	resp := dto.PlayerJurisdictionResponse{
+		Code:                j.Code,
+		Name:                j.Name,
+		MaxBet:              j.MaxBet,
+		MaxWin:              j.MaxWin,
+		RealityCheckMinutes: j.RealityCheckMinutes,
+		AutoplayAllowed:     j.AutoplayAllowed,
+	}
+	if j.RealityCheckMinutes > 0 {
+		resp.RealityCheckMessage = h.translator.Translate(c.Context(), middleware.Locale(c), "reality_check.message", "",
+			map[string]string{"minutes": strconv.Itoa(j.RealityCheckMinutes)})
+	}
+	if j.RTPDisclosure {
+		rtp := j.DisclosedRTP
+		resp.RTP = &rtp
+	}
+	return c.JSON(resp)
+}
+
+// complianceError writes the response for a jurisdiction or KYC rule violation, reporting whether err was one
+func complianceError(c *fiber.Ctx, err error) (bool, error) {
+	switch {
+	case errors.Is(err, jurisdiction.ErrBetAboveLimit):
+		return true, c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeBetAboveLimit, Message: err.Error()})
+	case errors.Is(err, jurisdiction.ErrAutoplayNotAllowed):
+		return true, c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{Error: domainErrors.CodeAutoplayNotAllowed, Message: err.Error()})
+	case errors.Is(err, jurisdiction.ErrRealityCheckDue):
+		return true, c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeRealityCheckRequired, Message: "Acknowledge the reality check to continue playing"})
+	case errors.Is(err, kyc.ErrVerificationRequired):
+		return true, c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{Error: domainErrors.CodeKYCRequired, Message: "Verify your identity to continue playing"})
+	}
+	return false, nil
+}
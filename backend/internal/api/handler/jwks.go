@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/service"
+)
+
+// JWKSHandler publishes the token signing keys and lets admins rotate them
+type JWKSHandler struct {
+	keyring *service.JWTKeyring
+	logger  *logger.Logger
+}
+
+// NewJWKSHandler creates a new JWKS handler
+func NewJWKSHandler(keyring *service.JWTKeyring, log *logger.Logger) *JWKSHandler {
+	return &JWKSHandler{
+		keyring: keyring,
+		logger:  log,
+	}
+}
+
+// GetJWKS returns the public signing keys as a JSON Web Key Set for token verifiers
+// GET /.well-known/jwks.json
+func (h *JWKSHandler) GetJWKS(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(h.keyring.CacheMaxAge().Seconds())))
+	return c.JSON(h.keyring.JWKS())
+}
+
+// ListSigningKeys lists the published signing keys with their lifecycle
+// GET /admin/auth/signing-keys
+func (h *JWKSHandler) ListSigningKeys(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    h.keyring.Keys(),
+	})
+}
+
+// RotateSigningKey creates a new signing key; it starts signing once every instance
+// has published it, and the current key stays valid until its tokens expire
+// POST /admin/auth/signing-keys/rotate
+func (h *JWKSHandler) RotateSigningKey(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	key, err := h.keyring.Rotate(c.Context(), time.Now())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to rotate signing key")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToRotateSigningKey,
+			Message: "Failed to rotate signing key",
+		})
+	}
+
+	log.Info().
+		Str("kid", key.KID).
+		Interface("admin_id", c.Locals("user_id")).
+		Msg("Signing key rotated by admin")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    key,
+	})
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/kyc"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// KYCHandler handles player-facing identity verification endpoints
+type KYCHandler struct {
+	kycService kyc.Service
+	logger     *logger.Logger
+}
+
+// NewKYCHandler creates a new KYC handler
+func NewKYCHandler(kycService kyc.Service, log *logger.Logger) *KYCHandler {
+	return &KYCHandler{
+		kycService: kycService,
+		logger:     log,
+	}
+}
+
+// GetMyKYC returns the authenticated player's verification status
+// GET /player/kyc
+func (h *KYCHandler) GetMyKYC(c *fiber.Ctx) error {
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+	}
+
+	v, err := h.kycService.GetStatus(c.Context(), playerID)
+	if err != nil {
+		return kycError(c, h.logger, err, "Failed to get KYC status")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    v,
+	})
+}
+
+// StartMyKYC submits the authenticated player for verification
+// Returns the provider's URL the player completes the verification at.
+// POST /player/kyc
+func (h *KYCHandler) StartMyKYC(c *fiber.Ctx) error {
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+	}
+
+	session, err := h.kycService.StartVerification(c.Context(), playerID)
+	if err != nil {
+		return kycError(c, h.logger, err, "Failed to start KYC verification")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    session,
+	})
+}
+
+// kycError maps KYC service errors to responses
+func kycError(c *fiber.Ctx, log *logger.Logger, err error, message string) error {
+	switch {
+	case errors.Is(err, player.ErrPlayerNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodePlayerNotFound, Message: "Player not found"})
+	case errors.Is(err, kyc.ErrAlreadyVerified):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeKYCAlreadyVerified, Message: err.Error()})
+	case errors.Is(err, kyc.ErrNoVerification):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeKYCNotPending, Message: err.Error()})
+	case errors.Is(err, kyc.ErrInvalidStatus):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeValidationError, Message: err.Error()})
+	case errors.Is(err, kyc.ErrProviderUnavailable):
+		log.WithTrace(c).Warn().Err(err).Msg(message)
+		return c.Status(fiber.StatusServiceUnavailable).JSON(dto.ErrorResponse{Error: domainErrors.CodeKYCUnavailable, Message: "Identity verification is unavailable"})
+	case errors.Is(err, kyc.ErrProviderNotConfigured):
+		return c.Status(fiber.StatusServiceUnavailable).JSON(dto.ErrorResponse{Error: domainErrors.CodeKYCUnavailable, Message: "Identity verification is unavailable"})
+	}
+
+	log.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
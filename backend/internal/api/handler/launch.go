@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/launch"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// LaunchHandler handles operator game launches and launch token redemption
+type LaunchHandler struct {
+	launchService launch.Service
+	logger        *logger.Logger
+}
+
+// NewLaunchHandler creates a new launch handler
+func NewLaunchHandler(launchService launch.Service, log *logger.Logger) *LaunchHandler {
+	return &LaunchHandler{
+		launchService: launchService,
+		logger:        log,
+	}
+}
+
+// Launch validates an operator token and returns a one-time game URL for the player
+// POST /v1/operator/launch
+func (h *LaunchHandler) Launch(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.LaunchRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	gameID, err := uuid.Parse(req.GameID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidGameID,
+			Message: "Invalid game ID format",
+		})
+	}
+
+	result, err := h.launchService.Launch(c.Context(), launch.Request{
+		OperatorToken: req.OperatorToken,
+		GameID:        gameID,
+		Language:      req.Language,
+		IPAddress:     req.PlayerIP,
+		UserAgent:     req.UserAgent,
+	})
+	if err != nil {
+		log.Warn().Err(err).Str("game_id", req.GameID).Msg("Game launch failed")
+
+		switch {
+		case errors.Is(err, launch.ErrInvalidOperatorToken):
+			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidOperatorToken,
+				Message: "Operator token was rejected by the wallet",
+			})
+		case errors.Is(err, launch.ErrWalletUnavailable):
+			return c.Status(fiber.StatusServiceUnavailable).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeWalletUnavailable,
+				Message: "Operator wallet is unavailable",
+			})
+		case errors.Is(err, launch.ErrLaunchDisabled):
+			return c.Status(fiber.StatusServiceUnavailable).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeServiceUnavailable,
+				Message: "Game launch is not configured",
+			})
+		case errors.Is(err, player.ErrGameNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeGameNotFound,
+				Message: "Specified game does not exist",
+			})
+		case errors.Is(err, player.ErrGameAccessDenied):
+			return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeGameAccessDenied,
+				Message: "Player cannot access this game",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeLaunchFailed,
+			Message: "Failed to launch game",
+		})
+	}
+
+	return c.JSON(dto.LaunchResponse{
+		URL:       result.URL,
+		ExpiresAt: result.ExpiresAt,
+		PlayerID:  result.PlayerID.String(),
+	})
+}
+
+// Redeem exchanges the launch token from a game URL for the player's session
+// POST /v1/auth/launch
+func (h *LaunchHandler) Redeem(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.RedeemLaunchRequest
+	if err := c.BodyParser(&req); err != nil || req.LaunchToken == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeLaunchTokenRequired,
+			Message: "Launch token is required",
+		})
+	}
+
+	result, err := h.launchService.Redeem(c.Context(), req.LaunchToken)
+	if err != nil {
+		log.Warn().Err(err).Msg("Launch token redemption failed")
+
+		switch {
+		case errors.Is(err, launch.ErrLaunchTokenInvalid):
+			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidLaunchToken,
+				Message: "Launch token is invalid, expired or already used",
+			})
+		case errors.Is(err, launch.ErrLaunchDisabled):
+			return c.Status(fiber.StatusServiceUnavailable).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeServiceUnavailable,
+				Message: "Game launch is not configured",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeLoginFailed,
+			Message: "Failed to start session",
+		})
+	}
+
+	return c.JSON(newAuthResponse(result))
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/notification"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// NotificationHandler handles the player's in-game notifications
+// Notifications are pushed on the player event stream as they are sent; these endpoints are the
+// fallback for fetching what arrived while the player was away, typically at session start.
+type NotificationHandler struct {
+	notificationService notification.Service
+	logger              *logger.Logger
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(notificationService notification.Service, log *logger.Logger) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+		logger:              log,
+	}
+}
+
+// GetUnread lists the player's unread notifications, oldest first
+// GET /player/notifications?limit=
+func (h *NotificationHandler) GetUnread(c *fiber.Ctx) error {
+	playerID, ok := notificationPlayer(c)
+	if !ok {
+		return nil
+	}
+
+	limit := 50
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+
+	notifications, err := h.notificationService.Unread(c.Context(), playerID, limit)
+	if err != nil {
+		return h.error(c, err, "Failed to list notifications")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"notifications": notifications,
+		},
+	})
+}
+
+// MarkRead marks one of the player's notifications read
+// POST /player/notifications/:id/read
+func (h *NotificationHandler) MarkRead(c *fiber.Ctx) error {
+	playerID, ok := notificationPlayer(c)
+	if !ok {
+		return nil
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid notification ID",
+		})
+	}
+
+	if err := h.notificationService.MarkRead(c.Context(), playerID, id); err != nil {
+		return h.error(c, err, "Failed to mark notification read")
+	}
+
+	return c.JSON(fiber.Map{"success": true})
+}
+
+// MarkAllRead marks all the player's unread notifications read
+// POST /player/notifications/read-all
+func (h *NotificationHandler) MarkAllRead(c *fiber.Ctx) error {
+	playerID, ok := notificationPlayer(c)
+	if !ok {
+		return nil
+	}
+
+	marked, err := h.notificationService.MarkAllRead(c.Context(), playerID)
+	if err != nil {
+		return h.error(c, err, "Failed to mark notifications read")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"marked": marked,
+		},
+	})
+}
+
+// notificationPlayer reads the player ID from the session, writing the error response if it is invalid
+func notificationPlayer(c *fiber.Ctx) (uuid.UUID, bool) {
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		_ = c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+		return uuid.Nil, false
+	}
+	return playerID, true
+}
+
+// error maps notification service errors to responses
+func (h *NotificationHandler) error(c *fiber.Ctx, err error, message string) error {
+	if errors.Is(err, notification.ErrNotificationNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeNotificationNotFound, Message: "Notification not found"})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
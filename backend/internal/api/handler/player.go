@@ -3,6 +3,7 @@ package handler
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/domain/player"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/pkg/logger"
@@ -36,7 +37,7 @@ func (h *PlayerHandler) GetBalance(c *fiber.Ctx) error {
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid player ID in token")
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "invalid_token",
+			Error:   domainErrors.CodeInvalidToken,
 			Message: "Invalid player ID in token",
 		})
 	}
@@ -48,7 +49,7 @@ func (h *PlayerHandler) GetBalance(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to get balance")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_balance",
+			Error:   domainErrors.CodeFailedToGetBalance,
 			Message: "Failed to retrieve balance",
 		})
 	}
@@ -59,5 +60,7 @@ func (h *PlayerHandler) GetBalance(c *fiber.Ctx) error {
 		Balance: balance,
 	}
 
+	// The balance changes outside the game too; never let an HTTP cache serve a stale one
+	c.Set(fiber.HeaderCacheControl, "no-store")
 	return c.Status(fiber.StatusOK).JSON(response)
 }
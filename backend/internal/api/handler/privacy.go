@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/privacy"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// PrivacyHandler handles player-facing data subject requests
+type PrivacyHandler struct {
+	privacyService privacy.Service
+	logger         *logger.Logger
+}
+
+// NewPrivacyHandler creates a new privacy handler
+func NewPrivacyHandler(privacyService privacy.Service, log *logger.Logger) *PrivacyHandler {
+	return &PrivacyHandler{
+		privacyService: privacyService,
+		logger:         log,
+	}
+}
+
+// ExportMyData downloads every record held about the authenticated player as a zip archive
+// GET /player/data-export
+func (h *PrivacyHandler) ExportMyData(c *fiber.Ctx) error {
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+	}
+
+	archive, err := h.privacyService.Export(c.Context(), playerID)
+	if err != nil {
+		return privacyError(c, h.logger, err, "Failed to export player data")
+	}
+	return sendArchive(c, h.logger, archive)
+}
+
+// sendArchive writes a data archive as a zip download
+func sendArchive(c *fiber.Ctx, log *logger.Logger, archive *privacy.Archive) error {
+	var buf bytes.Buffer
+	if err := archive.WriteZip(&buf); err != nil {
+		return privacyError(c, log, err, "Failed to write data archive")
+	}
+	c.Attachment(archive.FileName())
+	return c.Send(buf.Bytes())
+}
+
+// privacyError maps privacy service errors to responses
+func privacyError(c *fiber.Ctx, log *logger.Logger, err error, message string) error {
+	switch {
+	case errors.Is(err, player.ErrPlayerNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodePlayerNotFound, Message: "Player not found"})
+	case errors.Is(err, privacy.ErrReasonRequired):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeValidationError, Message: err.Error()})
+	case errors.Is(err, privacy.ErrAlreadyErased):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodePlayerErased, Message: err.Error()})
+	case errors.Is(err, privacy.ErrActiveSession):
+		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeActiveSessionExists, Message: err.Error()})
+	}
+
+	log.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
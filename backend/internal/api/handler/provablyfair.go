@@ -1,8 +1,12 @@
 package handler
 
 import (
+	"errors"
+	"strconv"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/domain/provablyfair"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/pkg/logger"
@@ -36,7 +40,7 @@ func (h *ProvablyFairHandler) StartPFSession(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(playerIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "invalid_token",
+			Error:   domainErrors.CodeInvalidToken,
 			Message: "Invalid player ID in token",
 		})
 	}
@@ -45,34 +49,47 @@ func (h *ProvablyFairHandler) StartPFSession(c *fiber.Ctx) error {
 	gameSessionIDStr, ok := c.Locals("session_id").(string)
 	if !ok || gameSessionIDStr == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "no_active_session",
+			Error:   domainErrors.CodeNoActiveSession,
 			Message: "No active game session. Start a game session first.",
 		})
 	}
 	gameSessionID, err := uuid.Parse(gameSessionIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_session",
+			Error:   domainErrors.CodeInvalidSession,
 			Message: "Invalid game session ID",
 		})
 	}
 
+	// Dual Commitment Protocol: theta_commitment is optional unless the player's operator requires it
+	var req dto.StartPFSessionRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidRequest,
+				Message: "Invalid request body",
+			})
+		}
+	}
+
 	// Start PF session (no client_seed needed - it's per-spin now)
-	// Note: This standalone endpoint doesn't use Dual Commitment Protocol
-	// For Dual Commitment, use the session handler which passes theta_commitment from StartSessionRequest
-	result, err := h.pfService.StartSession(c.Context(), playerID, gameSessionID, "")
+	result, err := h.pfService.StartSession(c.Context(), playerID, gameSessionID, req.ThetaCommitment)
 	if err != nil {
 		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to start PF session")
 
+		if handled, resp := thetaError(c, err); handled {
+			return resp
+		}
+
 		if err == provablyfair.ErrSessionAlreadyActive {
 			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
-				Error:   "pf_session_exists",
+				Error:   domainErrors.CodePFSessionExists,
 				Message: "Player already has an active provably fair session",
 			})
 		}
 
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_start_pf_session",
+			Error:   domainErrors.CodeFailedToStartPFSession,
 			Message: "Failed to start provably fair session",
 		})
 	}
@@ -103,7 +120,7 @@ func (h *ProvablyFairHandler) EndPFSession(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(playerIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "invalid_token",
+			Error:   domainErrors.CodeInvalidToken,
 			Message: "Invalid player ID in token",
 		})
 	}
@@ -112,14 +129,14 @@ func (h *ProvablyFairHandler) EndPFSession(c *fiber.Ctx) error {
 	gameSessionIDStr, ok := c.Locals("session_id").(string)
 	if !ok || gameSessionIDStr == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "no_active_session",
+			Error:   domainErrors.CodeNoActiveSession,
 			Message: "No active game session",
 		})
 	}
 	gameSessionID, err := uuid.Parse(gameSessionIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_session",
+			Error:   domainErrors.CodeInvalidSession,
 			Message: "Invalid game session ID",
 		})
 	}
@@ -131,20 +148,20 @@ func (h *ProvablyFairHandler) EndPFSession(c *fiber.Ctx) error {
 
 		if err == provablyfair.ErrStateNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "pf_session_not_found",
+				Error:   domainErrors.CodePFSessionNotFound,
 				Message: "No active provably fair session found",
 			})
 		}
 
 		if err == provablyfair.ErrSessionAlreadyEnded {
 			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
-				Error:   "pf_session_already_ended",
+				Error:   domainErrors.CodePFSessionAlreadyEnded,
 				Message: "Provably fair session already ended",
 			})
 		}
 
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_end_pf_session",
+			Error:   domainErrors.CodeFailedToEndPFSession,
 			Message: "Failed to end provably fair session",
 		})
 	}
@@ -197,14 +214,14 @@ func (h *ProvablyFairHandler) GetPFSessionStatus(c *fiber.Ctx) error {
 	gameSessionIDStr, ok := c.Locals("session_id").(string)
 	if !ok || gameSessionIDStr == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "no_active_session",
+			Error:   domainErrors.CodeNoActiveSession,
 			Message: "No active game session",
 		})
 	}
 	gameSessionID, err := uuid.Parse(gameSessionIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_session",
+			Error:   domainErrors.CodeInvalidSession,
 			Message: "Invalid game session ID",
 		})
 	}
@@ -214,7 +231,7 @@ func (h *ProvablyFairHandler) GetPFSessionStatus(c *fiber.Ctx) error {
 	if err != nil {
 		log.Debug().Err(err).Str("game_session_id", gameSessionID.String()).Msg("PF session not found")
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-			Error:   "pf_session_not_found",
+			Error:   domainErrors.CodePFSessionNotFound,
 			Message: "No provably fair session found",
 		})
 	}
@@ -242,7 +259,7 @@ func (h *ProvablyFairHandler) GetVerificationData(c *fiber.Ctx) error {
 	pfSessionID, err := uuid.Parse(pfSessionIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_session_id",
+			Error:   domainErrors.CodeInvalidSessionID,
 			Message: "Invalid provably fair session ID",
 		})
 	}
@@ -254,13 +271,13 @@ func (h *ProvablyFairHandler) GetVerificationData(c *fiber.Ctx) error {
 
 		if err == provablyfair.ErrSessionNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "pf_session_not_found",
+				Error:   domainErrors.CodePFSessionNotFound,
 				Message: "Provably fair session not found",
 			})
 		}
 
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_verification_data",
+			Error:   domainErrors.CodeFailedToGetVerificationData,
 			Message: "Failed to retrieve verification data",
 		})
 	}
@@ -307,7 +324,7 @@ func (h *ProvablyFairHandler) VerifySession(c *fiber.Ctx) error {
 	pfSessionID, err := uuid.Parse(pfSessionIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_session_id",
+			Error:   domainErrors.CodeInvalidSessionID,
 			Message: "Invalid provably fair session ID",
 		})
 	}
@@ -316,7 +333,7 @@ func (h *ProvablyFairHandler) VerifySession(c *fiber.Ctx) error {
 	var req dto.VerifySessionRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -328,7 +345,7 @@ func (h *ProvablyFairHandler) VerifySession(c *fiber.Ctx) error {
 
 		if err == provablyfair.ErrSessionNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "pf_session_not_found",
+				Error:   domainErrors.CodePFSessionNotFound,
 				Message: "Provably fair session not found",
 			})
 		}
@@ -373,7 +390,7 @@ func (h *ProvablyFairHandler) VerifySpin(c *fiber.Ctx) error {
 	var req dto.VerifySpinRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -381,35 +398,35 @@ func (h *ProvablyFairHandler) VerifySpin(c *fiber.Ctx) error {
 	// Validate required fields
 	if req.ServerSeed == "" || len(req.ServerSeed) != 64 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_server_seed",
+			Error:   domainErrors.CodeInvalidServerSeed,
 			Message: "Server seed must be a 64-character hex string",
 		})
 	}
 
 	if req.ClientSeed == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_client_seed",
+			Error:   domainErrors.CodeInvalidClientSeed,
 			Message: "Client seed is required",
 		})
 	}
 
 	if req.Nonce < 1 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_nonce",
+			Error:   domainErrors.CodeInvalidNonce,
 			Message: "Nonce must be at least 1",
 		})
 	}
 
 	if req.PrevSpinHash == "" || len(req.PrevSpinHash) != 64 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_prev_spin_hash",
+			Error:   domainErrors.CodeInvalidPrevSpinHash,
 			Message: "Previous spin hash must be a 64-character hex string",
 		})
 	}
 
 	if req.SpinHash == "" || len(req.SpinHash) != 64 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_spin_hash",
+			Error:   domainErrors.CodeInvalidSpinHash,
 			Message: "Spin hash must be a 64-character hex string",
 		})
 	}
@@ -425,7 +442,7 @@ func (h *ProvablyFairHandler) VerifySpin(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Spin verification failed")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "verification_failed",
+			Error:   domainErrors.CodeVerificationFailed,
 			Message: "Failed to verify spin",
 		})
 	}
@@ -462,7 +479,7 @@ func (h *ProvablyFairHandler) VerifySpinWithReel(c *fiber.Ctx) error {
 	var req dto.VerifySpinWithReelRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -470,42 +487,42 @@ func (h *ProvablyFairHandler) VerifySpinWithReel(c *fiber.Ctx) error {
 	// Validate required fields
 	if req.ServerSeed == "" || len(req.ServerSeed) != 64 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_server_seed",
+			Error:   domainErrors.CodeInvalidServerSeed,
 			Message: "Server seed must be a 64-character hex string",
 		})
 	}
 
 	if req.ClientSeed == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_client_seed",
+			Error:   domainErrors.CodeInvalidClientSeed,
 			Message: "Client seed is required",
 		})
 	}
 
 	if req.Nonce < 1 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_nonce",
+			Error:   domainErrors.CodeInvalidNonce,
 			Message: "Nonce must be at least 1",
 		})
 	}
 
 	if req.PrevSpinHash == "" || len(req.PrevSpinHash) != 64 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_prev_spin_hash",
+			Error:   domainErrors.CodeInvalidPrevSpinHash,
 			Message: "Previous spin hash must be a 64-character hex string",
 		})
 	}
 
 	if req.SpinHash == "" || len(req.SpinHash) != 64 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_spin_hash",
+			Error:   domainErrors.CodeInvalidSpinHash,
 			Message: "Spin hash must be a 64-character hex string",
 		})
 	}
 
 	if len(req.ReelPositions) != 5 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_reel_positions",
+			Error:   domainErrors.CodeInvalidReelPositions,
 			Message: "Reel positions must be an array of exactly 5 integers",
 		})
 	}
@@ -514,7 +531,7 @@ func (h *ProvablyFairHandler) VerifySpinWithReel(c *fiber.Ctx) error {
 	reelStripConfigID, err := uuid.Parse(req.ReelStripConfigID)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_reel_strip_config_id",
+			Error:   domainErrors.CodeInvalidReelStripConfigID,
 			Message: "Invalid reel strip config ID format",
 		})
 	}
@@ -532,7 +549,7 @@ func (h *ProvablyFairHandler) VerifySpinWithReel(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Spin verification with reel failed")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "verification_failed",
+			Error:   domainErrors.CodeVerificationFailed,
 			Message: "Failed to verify spin",
 		})
 	}
@@ -580,7 +597,7 @@ func (h *ProvablyFairHandler) VerifyActiveSpin(c *fiber.Ctx) error {
 	userIDStr, ok := c.Locals("user_id").(string)
 	if !ok || userIDStr == "" {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "unauthorized",
+			Error:   domainErrors.CodeUnauthorized,
 			Message: "Authentication required",
 		})
 	}
@@ -588,7 +605,7 @@ func (h *ProvablyFairHandler) VerifyActiveSpin(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(userIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_user_id",
+			Error:   domainErrors.CodeInvalidUserID,
 			Message: "Invalid user ID format",
 		})
 	}
@@ -597,7 +614,7 @@ func (h *ProvablyFairHandler) VerifyActiveSpin(c *fiber.Ctx) error {
 	pfState, err := h.pfService.GetActiveSessionByPlayer(c.Context(), playerID)
 	if err != nil || pfState == nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "no_active_session",
+			Error:   domainErrors.CodeNoActiveSession,
 			Message: "No active provably fair session found. Start a session first.",
 		})
 	}
@@ -607,7 +624,7 @@ func (h *ProvablyFairHandler) VerifyActiveSpin(c *fiber.Ctx) error {
 	var req dto.VerifyActiveSpinRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -615,21 +632,21 @@ func (h *ProvablyFairHandler) VerifyActiveSpin(c *fiber.Ctx) error {
 	// Validate required fields
 	if req.ClientSeed == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_client_seed",
+			Error:   domainErrors.CodeInvalidClientSeed,
 			Message: "Client seed is required",
 		})
 	}
 
 	if req.Nonce < 1 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_nonce",
+			Error:   domainErrors.CodeInvalidNonce,
 			Message: "Nonce must be at least 1",
 		})
 	}
 
 	if req.SpinHash == "" || len(req.SpinHash) != 64 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_spin_hash",
+			Error:   domainErrors.CodeInvalidSpinHash,
 			Message: "Spin hash must be a 64-character hex string",
 		})
 	}
@@ -641,7 +658,7 @@ func (h *ProvablyFairHandler) VerifyActiveSpin(c *fiber.Ctx) error {
 		reelStripConfigID, err = uuid.Parse(req.ReelStripConfigID)
 		if err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "invalid_reel_strip_config_id",
+				Error:   domainErrors.CodeInvalidReelStripConfigID,
 				Message: "Invalid reel strip config ID format",
 			})
 		}
@@ -650,14 +667,14 @@ func (h *ProvablyFairHandler) VerifyActiveSpin(c *fiber.Ctx) error {
 	// Validate reel positions if provided
 	if len(req.ReelPositions) > 0 && len(req.ReelPositions) != 5 {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_reel_positions",
+			Error:   domainErrors.CodeInvalidReelPositions,
 			Message: "Reel positions must be an array of exactly 5 integers",
 		})
 	}
 
 	if len(req.ReelPositions) == 5 && reelStripConfigID == uuid.Nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "missing_reel_strip_config_id",
+			Error:   domainErrors.CodeMissingReelStripConfigID,
 			Message: "reel_strip_config_id is required when verifying reel positions",
 		})
 	}
@@ -674,7 +691,7 @@ func (h *ProvablyFairHandler) VerifyActiveSpin(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Active spin verification failed")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "verification_failed",
+			Error:   domainErrors.CodeVerificationFailed,
 			Message: err.Error(),
 		})
 	}
@@ -712,3 +729,50 @@ func (h *ProvablyFairHandler) VerifyActiveSpin(c *fiber.Ctx) error {
 
 	return c.Status(fiber.StatusOK).JSON(response)
 }
+
+// thetaError maps Dual Commitment Protocol errors to responses; it reports whether err was handled
+func thetaError(c *fiber.Ctx, err error) (bool, error) {
+	switch {
+	case errors.Is(err, provablyfair.ErrThetaCommitmentRequired):
+		return true, c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeThetaCommitmentRequired, Message: err.Error()})
+	case errors.Is(err, provablyfair.ErrInvalidThetaCommitment):
+		return true, c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeInvalidThetaCommitment, Message: err.Error()})
+	case errors.Is(err, provablyfair.ErrThetaSeedRequired):
+		return true, c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeThetaSeedRequired, Message: err.Error()})
+	case errors.Is(err, provablyfair.ErrThetaVerificationFailed):
+		return true, c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{Error: domainErrors.CodeThetaVerificationFailed, Message: err.Error()})
+	}
+	return false, nil
+}
+
+// ListChainAudits lists PF hash chain self-audit results newest first
+// GET /admin/provably-fair/chain-audits?failed=true&page=&limit=
+func (h *ProvablyFairHandler) ListChainAudits(c *fiber.Ctx) error {
+	page, limit := 1, 20
+	if p, err := strconv.Atoi(c.Query("page")); err == nil && p > 0 {
+		page = p
+	}
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	failedOnly := c.QueryBool("failed", false)
+
+	audits, total, err := h.pfService.ListChainAudits(c.Context(), failedOnly, limit, (page-1)*limit)
+	if err != nil {
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to list PF chain audits")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeListFailed,
+			Message: "Failed to list chain audits",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"audits": audits,
+			"total":  total,
+			"page":   page,
+			"limit":  limit,
+		},
+	})
+}
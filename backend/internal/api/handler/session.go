@@ -5,8 +5,10 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/domain/provablyfair"
 	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/domain/vip"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/slotmachine/backend/internal/service"
@@ -16,6 +18,7 @@ import (
 type SessionHandler struct {
 	sessionService session.Service
 	pfService      *service.ProvablyFairService // Optional: nil if PF is disabled
+	vipService     vip.Service                  // Optional: nil omits VIP status from responses
 	logger         *logger.Logger
 }
 
@@ -36,6 +39,24 @@ func (h *SessionHandler) SetProvablyFairService(pfService *service.ProvablyFairS
 	h.pfService = pfService
 }
 
+// SetVIPService sets the VIP service used to include tier info in session responses
+func (h *SessionHandler) SetVIPService(vipService vip.Service) {
+	h.vipService = vipService
+}
+
+// vipStatus returns the player's VIP status for a session response, nil if unavailable
+func (h *SessionHandler) vipStatus(c *fiber.Ctx, playerID uuid.UUID) *dto.VIPStatusResponse {
+	if h.vipService == nil {
+		return nil
+	}
+	status, err := h.vipService.GetStatus(c.Context(), playerID)
+	if err != nil {
+		h.logger.WithTrace(c).Warn().Err(err).Str("player_id", playerID.String()).Msg("Failed to get VIP status")
+		return nil
+	}
+	return vipStatusResponse(status)
+}
+
 // StartSession starts a new game session
 func (h *SessionHandler) StartSession(c *fiber.Ctx) error {
 	log := h.logger.WithTrace(c)
@@ -45,7 +66,7 @@ func (h *SessionHandler) StartSession(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(playerIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "invalid_token",
+			Error:   domainErrors.CodeInvalidToken,
 			Message: "Invalid player ID in token",
 		})
 	}
@@ -54,25 +75,41 @@ func (h *SessionHandler) StartSession(c *fiber.Ctx) error {
 	var req dto.StartSessionRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
 
-	// Start session
-	sess, err := h.sessionService.StartSession(c.Context(), playerID, req.BetAmount)
+	// Reject a missing or malformed theta_commitment before any session is created
+	if h.pfService != nil {
+		if err := h.pfService.ValidateThetaCommitment(c.Context(), playerID, req.ThetaCommitment); err != nil {
+			_, resp := thetaError(c, err)
+			return resp
+		}
+	}
+
+	// Start session, ending the active one first when the client asked for a takeover
+	var sess, previous *session.GameSession
+	if req.Takeover {
+		sess, previous, err = h.sessionService.TakeoverSession(c.Context(), playerID, req.BetAmount)
+	} else {
+		sess, err = h.sessionService.StartSession(c.Context(), playerID, req.BetAmount)
+	}
 	if err != nil {
 		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to start session")
 
 		if err == session.ErrActiveSessionExists {
 			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
-				Error:   "active_session_exists",
-				Message: "Player already has an active session",
+				Error:   domainErrors.CodeActiveSessionExists,
+				Message: "Player already has an active session; retry with takeover to replace it",
 			})
 		}
+		if handled, resp := complianceError(c, err); handled {
+			return resp
+		}
 
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_start_session",
+			Error:   domainErrors.CodeFailedToStartSession,
 			Message: "Failed to start game session",
 		})
 	}
@@ -90,6 +127,38 @@ func (h *SessionHandler) StartSession(c *fiber.Ctx) error {
 		NetChange:       sess.NetChange,
 		CreatedAt:       sess.CreatedAt,
 		EndedAt:         sess.EndedAt,
+		VIP:             h.vipStatus(c, playerID),
+	}
+	if previous != nil {
+		response.PreviousSession = &dto.SessionResponse{
+			ID:              previous.ID.String(),
+			PlayerID:        previous.PlayerID.String(),
+			BetAmount:       previous.BetAmount,
+			StartingBalance: previous.StartingBalance,
+			EndingBalance:   previous.EndingBalance,
+			TotalSpins:      previous.TotalSpins,
+			TotalWagered:    previous.TotalWagered,
+			TotalWon:        previous.TotalWon,
+			NetChange:       previous.NetChange,
+			CreatedAt:       previous.CreatedAt,
+			EndedAt:         previous.EndedAt,
+		}
+	}
+
+	// On takeover, end the player's PF session so its server seed is revealed and a new one can start
+	if req.Takeover && h.pfService != nil {
+		pfResult, err := h.pfService.EndPlayerSession(c.Context(), playerID)
+		if err != nil {
+			if err != provablyfair.ErrStateNotFound && err != provablyfair.ErrSessionAlreadyEnded {
+				log.Warn().Err(err).Msg("Failed to end previous PF session on takeover")
+			}
+		} else if response.PreviousSession != nil {
+			response.PreviousSession.ProvablyFair = pfRevealData(pfResult)
+		} else {
+			log.Info().
+				Str("pf_session_id", pfResult.SessionID.String()).
+				Msg("Ended orphaned PF session on takeover")
+		}
 	}
 
 	// Start PF session if PF service is enabled
@@ -113,7 +182,63 @@ func (h *SessionHandler) StartSession(c *fiber.Ctx) error {
 		}
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(response)
+	return sendEncoded(c, fiber.StatusCreated, response)
+}
+
+// AcknowledgeRealityCheck records the player's acknowledgement of a reality check
+// POST /v1/session/:sessionId/reality-check
+func (h *SessionHandler) AcknowledgeRealityCheck(c *fiber.Ctx) error {
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+	}
+
+	sessionID, err := uuid.Parse(c.Params("sessionId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidSessionID,
+			Message: "Invalid session ID",
+		})
+	}
+
+	sess, err := h.sessionService.AcknowledgeRealityCheck(c.Context(), playerID, sessionID)
+	if err != nil {
+		switch err {
+		case session.ErrSessionNotFound:
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeSessionNotFound,
+				Message: "Session not found",
+			})
+		case session.ErrSessionAlreadyEnded:
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeSessionAlreadyEnded,
+				Message: "Session already ended",
+			})
+		}
+
+		h.logger.WithTrace(c).Error().Err(err).Str("session_id", sessionID.String()).Msg("Failed to acknowledge reality check")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToAcknowledgeRealityCheck,
+			Message: "Failed to acknowledge reality check",
+		})
+	}
+
+	// Summarise play so far so the client can show it alongside the acknowledgement
+	return sendEncoded(c, fiber.StatusOK, dto.SessionResponse{
+		ID:              sess.ID.String(),
+		PlayerID:        sess.PlayerID.String(),
+		BetAmount:       sess.BetAmount,
+		StartingBalance: sess.StartingBalance,
+		TotalSpins:      sess.TotalSpins,
+		TotalWagered:    sess.TotalWagered,
+		TotalWon:        sess.TotalWon,
+		NetChange:       sess.TotalWon - sess.TotalWagered,
+		CreatedAt:       sess.CreatedAt,
+		RealityCheckAt:  sess.RealityCheckAt,
+	})
 }
 
 // EndSession ends the current game session
@@ -125,7 +250,7 @@ func (h *SessionHandler) EndSession(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(playerIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "invalid_token",
+			Error:   domainErrors.CodeInvalidToken,
 			Message: "Invalid player ID in token",
 		})
 	}
@@ -135,7 +260,7 @@ func (h *SessionHandler) EndSession(c *fiber.Ctx) error {
 	sessionID, err := uuid.Parse(sessionIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_session_id",
+			Error:   domainErrors.CodeInvalidSessionID,
 			Message: "Invalid session ID",
 		})
 	}
@@ -147,20 +272,20 @@ func (h *SessionHandler) EndSession(c *fiber.Ctx) error {
 
 		if err == session.ErrSessionNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error:   "session_not_found",
+				Error:   domainErrors.CodeSessionNotFound,
 				Message: "Session not found",
 			})
 		}
 
 		if err == session.ErrSessionAlreadyEnded {
 			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
-				Error:   "session_already_ended",
+				Error:   domainErrors.CodeSessionAlreadyEnded,
 				Message: "Session already ended",
 			})
 		}
 
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_end_session",
+			Error:   domainErrors.CodeFailedToEndSession,
 			Message: "Failed to end game session",
 		})
 	}
@@ -178,6 +303,7 @@ func (h *SessionHandler) EndSession(c *fiber.Ctx) error {
 		NetChange:       sess.NetChange,
 		CreatedAt:       sess.CreatedAt,
 		EndedAt:         sess.EndedAt,
+		VIP:             h.vipStatus(c, playerID),
 	}
 
 	// End PF session if PF service is enabled (reveals server_seed)
@@ -189,34 +315,7 @@ func (h *SessionHandler) EndSession(c *fiber.Ctx) error {
 				log.Warn().Err(err).Msg("Failed to end PF session")
 			}
 		} else {
-			// Convert spins to DTO format
-			spins := make([]dto.SpinVerificationData, len(pfResult.Spins))
-			for i, s := range pfResult.Spins {
-				var configIDStr *string
-				if s.ReelStripConfigID != nil {
-					str := s.ReelStripConfigID.String()
-					configIDStr = &str
-				}
-				spins[i] = dto.SpinVerificationData{
-					SpinIndex:         s.SpinIndex,
-					Nonce:             s.Nonce,
-					ClientSeed:        s.ClientSeed,
-					SpinHash:          s.SpinHash,
-					PrevSpinHash:      s.PrevSpinHash,
-					ReelPositions:     s.ReelPositions,
-					ReelStripConfigID: configIDStr,
-					GameMode:          s.GameMode,
-					IsFreeSpin:        s.IsFreeSpin,
-				}
-			}
-
-			response.ProvablyFair = &dto.SessionProvablyFairData{
-				SessionID:      pfResult.SessionID.String(),
-				ServerSeedHash: pfResult.ServerSeedHash,
-				ServerSeed:     pfResult.ServerSeed, // Revealed!
-				TotalSpins:     pfResult.TotalSpins,
-				Spins:          spins,
-			}
+			response.ProvablyFair = pfRevealData(pfResult)
 			log.Info().
 				Str("pf_session_id", pfResult.SessionID.String()).
 				Int64("pf_total_spins", pfResult.TotalSpins).
@@ -229,7 +328,7 @@ func (h *SessionHandler) EndSession(c *fiber.Ctx) error {
 		Str("player_id", playerID.String()).
 		Msg("Session ended successfully")
 
-	return c.Status(fiber.StatusOK).JSON(response)
+	return sendEncoded(c, fiber.StatusOK, response)
 }
 
 // GetSessionHistory retrieves the player's session history
@@ -241,7 +340,7 @@ func (h *SessionHandler) GetSessionHistory(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(playerIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "invalid_token",
+			Error:   domainErrors.CodeInvalidToken,
 			Message: "Invalid player ID in token",
 		})
 	}
@@ -255,7 +354,7 @@ func (h *SessionHandler) GetSessionHistory(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to get session history")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_sessions",
+			Error:   domainErrors.CodeFailedToGetSessions,
 			Message: "Failed to retrieve session history",
 		})
 	}
@@ -284,5 +383,36 @@ func (h *SessionHandler) GetSessionHistory(c *fiber.Ctx) error {
 		Sessions: sessionResponses,
 	}
 
-	return c.Status(fiber.StatusOK).JSON(response)
+	return sendEncoded(c, fiber.StatusOK, response)
+}
+
+// pfRevealData converts an ended PF session into its revealed response form
+func pfRevealData(result *provablyfair.EndSessionResult) *dto.SessionProvablyFairData {
+	spins := make([]dto.SpinVerificationData, len(result.Spins))
+	for i, s := range result.Spins {
+		var configIDStr *string
+		if s.ReelStripConfigID != nil {
+			str := s.ReelStripConfigID.String()
+			configIDStr = &str
+		}
+		spins[i] = dto.SpinVerificationData{
+			SpinIndex:         s.SpinIndex,
+			Nonce:             s.Nonce,
+			ClientSeed:        s.ClientSeed,
+			SpinHash:          s.SpinHash,
+			PrevSpinHash:      s.PrevSpinHash,
+			ReelPositions:     s.ReelPositions,
+			ReelStripConfigID: configIDStr,
+			GameMode:          s.GameMode,
+			IsFreeSpin:        s.IsFreeSpin,
+		}
+	}
+
+	return &dto.SessionProvablyFairData{
+		SessionID:      result.SessionID.String(),
+		ServerSeedHash: result.ServerSeedHash,
+		ServerSeed:     result.ServerSeed, // Revealed!
+		TotalSpins:     result.TotalSpins,
+		Spins:          spins,
+	}
 }
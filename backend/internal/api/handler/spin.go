@@ -1,15 +1,23 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/exposure"
+	"github.com/slotmachine/backend/domain/freespins"
 	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/session"
 	"github.com/slotmachine/backend/domain/spin"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/api/testdata"
 	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/pkg/crypto"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
@@ -19,18 +27,24 @@ var _ = testdata.SpinScenarios
 
 // SpinHandler handles spin-related endpoints
 type SpinHandler struct {
-	spinService spin.Service
-	logger      *logger.Logger
+	spinService      spin.Service
+	freeSpinsService freespins.Service     // Plays triggered free spins when the client asks for autoplay
+	signer           *crypto.Ed25519Signer // nil leaves spin responses unsigned
+	logger           *logger.Logger
 }
 
 // NewSpinHandler creates a new spin handler
 func NewSpinHandler(
 	spinService spin.Service,
+	freeSpinsService freespins.Service,
+	signer *crypto.Ed25519Signer,
 	log *logger.Logger,
 ) *SpinHandler {
 	return &SpinHandler{
-		spinService: spinService,
-		logger:      log,
+		spinService:      spinService,
+		freeSpinsService: freeSpinsService,
+		signer:           signer,
+		logger:           log,
 	}
 }
 
@@ -45,7 +59,7 @@ func (h *SpinHandler) GetInitialGrid(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to generate initial grid")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_generate_grid",
+			Error:   domainErrors.CodeFailedToGenerateGrid,
 			Message: "Failed to generate initial grid",
 		})
 	}
@@ -72,7 +86,7 @@ func (h *SpinHandler) ExecuteSpin(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(playerIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "invalid_token",
+			Error:   domainErrors.CodeInvalidToken,
 			Message: "Invalid player ID in token",
 		})
 	}
@@ -81,7 +95,7 @@ func (h *SpinHandler) ExecuteSpin(c *fiber.Ctx) error {
 	var req dto.ExecuteSpinRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -98,7 +112,7 @@ func (h *SpinHandler) ExecuteSpin(c *fiber.Ctx) error {
 		parsedSessionID, err := uuid.Parse(req.SessionID)
 		if err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "invalid_session_id",
+				Error:   domainErrors.CodeInvalidSessionID,
 				Message: "Invalid session ID",
 			})
 		}
@@ -108,54 +122,100 @@ func (h *SpinHandler) ExecuteSpin(c *fiber.Ctx) error {
 	// Execute spin (uuid.Nil if no session provided, service will handle it)
 	// ClientSeed is optional - for provably fair sessions, client provides per-spin seed
 	// ThetaSeed is required on first spin if theta_commitment was provided (Dual Commitment Protocol)
-	result, err := h.spinService.ExecuteSpin(c.Context(), playerID, sessionID, req.BetAmount, req.GameMode, req.ClientSeed, req.ThetaSeed)
+	ctx := context.Context(c.Context())
+	if req.Autoplay {
+		ctx = spin.WithAutoplay(ctx)
+	}
+	if req.ClientNonce != nil {
+		ctx = spin.WithClientNonce(ctx, *req.ClientNonce)
+	}
+	if req.NextClientSeed != "" {
+		ctx = spin.WithNextClientSeed(ctx, req.NextClientSeed)
+	}
+	result, err := h.spinService.ExecuteSpin(ctx, playerID, sessionID, req.BetAmount, req.GameMode, req.ClientSeed, req.ThetaSeed)
 	if err != nil {
 		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to execute spin")
 
 		if err == player.ErrInsufficientBalance {
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "insufficient_balance",
+				Error:   domainErrors.CodeInsufficientBalance,
 				Message: "Insufficient balance for this bet",
 			})
 		}
+		if err == player.ErrBalanceBusy {
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeBalanceBusy,
+				Message: "Another spin is still in progress, try again",
+			})
+		}
+		if errors.Is(err, spin.ErrSpinTimeout) {
+			return c.Status(fiber.StatusGatewayTimeout).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeSpinTimeout,
+				Message: "The spin took too long and was not played, check the pending spin before retrying",
+			})
+		}
+		if errors.Is(err, provablyfair.ErrClientNonceRequired) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeClientNonceRequired,
+				Message: "Spin requests must include client_nonce",
+			})
+		}
+		if errors.Is(err, provablyfair.ErrClientNonceMismatch) {
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeClientNonceMismatch,
+				Message: err.Error(),
+			})
+		}
+		if err == session.ErrBetLocked {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeBetLocked,
+				Message: "The bet amount cannot change during this session",
+			})
+		}
+		if err == session.ErrBetIncreaseNotAllowed {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeBetIncreaseNotAllowed,
+				Message: "The bet amount cannot be raised during this session",
+			})
+		}
+		if errors.Is(err, exposure.ErrBonusBuysPaused) {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeBonusBuysPaused,
+				Message: "Bonus buys are temporarily unavailable",
+			})
+		}
+		if handled, resp := complianceError(c, err); handled {
+			return resp
+		}
+		if handled, resp := autoplayError(c, err); handled {
+			return resp
+		}
+		if handled, resp := thetaError(c, err); handled {
+			return resp
+		}
 
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_execute_spin",
+			Error:   domainErrors.CodeFailedToExecuteSpin,
 			Message: "Failed to execute spin",
 		})
 	}
 
-	// Build response
-	response := dto.SpinResponse{
-		SpinID:                  result.SpinID.String(),
-		SessionID:               result.SessionID.String(),
-		BetAmount:               result.BetAmount,
-		BalanceBefore:           result.BalanceBefore,
-		BalanceAfterBet:         result.BalanceAfterBet,
-		NewBalance:              result.NewBalance,
-		Grid:                    convertGrid(result.Grid),
-		Cascades:                convertCascades(result.Cascades),
-		SpinTotalWin:            result.SpinTotalWin,
-		ScatterCount:            result.ScatterCount,
-		IsFreeSpin:              result.IsFreeSpin,
-		FreeSpinsSessionID:      result.FreeSpinsSessionID,
-		FreeSpinsTriggered:      result.FreeSpinsTriggered,
-		FreeSpinsRemainingSpins: result.FreeSpinsRemainingSpins,
-		GameMode:                result.GameMode,
-		GameModeCost:            result.GameModeCost,
-		Timestamp:               result.Timestamp,
-	}
+	response := toSpinResponse(result)
 
-	// Add provably fair data if present
-	if result.ProvablyFair != nil {
-		response.ProvablyFair = &dto.SpinProvablyFairData{
-			SpinHash:     result.ProvablyFair.SpinHash,
-			PrevSpinHash: result.ProvablyFair.PrevSpinHash,
-			Nonce:        result.ProvablyFair.Nonce,
+	// Fast-forward: play the triggered free spins now and return them with the trigger
+	if req.AutoplayFreeSpins && result.FreeSpinsSessionID != "" {
+		freeSpinsSessionID, _ := uuid.Parse(result.FreeSpinsSessionID)
+		autoplay, err := h.freeSpinsService.ExecuteAllFreeSpins(ctx, playerID, freeSpinsSessionID, req.ClientSeed)
+		if err != nil {
+			// The trigger itself succeeded; the client can still play the session spin by spin
+			log.Error().Err(err).Str("free_spins_session_id", result.FreeSpinsSessionID).Msg("Failed to autoplay triggered free spins")
+		} else {
+			response.FreeSpinsAutoplay = toFreeSpinsAutoplayResponse(autoplay)
+			response.NewBalance = autoplay.NewBalance
 		}
 	}
 
-	return c.Status(fiber.StatusOK).JSON(response)
+	return sendSpinResponse(c, h.signer, &response)
 }
 
 // GetSpinHistory retrieves the player's spin history
@@ -167,7 +227,7 @@ func (h *SpinHandler) GetSpinHistory(c *fiber.Ctx) error {
 	playerID, err := uuid.Parse(playerIDStr)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "invalid_token",
+			Error:   domainErrors.CodeInvalidToken,
 			Message: "Invalid player ID in token",
 		})
 	}
@@ -181,7 +241,7 @@ func (h *SpinHandler) GetSpinHistory(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to get spin history")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_get_history",
+			Error:   domainErrors.CodeFailedToGetHistory,
 			Message: "Failed to retrieve spin history",
 		})
 	}
@@ -211,6 +271,180 @@ func (h *SpinHandler) GetSpinHistory(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(response)
 }
 
+// GetPendingSpin returns the session's newest spin the client has not acknowledged
+// GET /v1/base-spins/pending?session_id=
+func (h *SpinHandler) GetPendingSpin(c *fiber.Ctx) error {
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+	}
+
+	sessionID, err := uuid.Parse(c.Query("session_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidSessionID,
+			Message: "Invalid session ID",
+		})
+	}
+
+	result, err := h.spinService.GetPendingSpin(c.Context(), playerID, sessionID)
+	if err != nil {
+		switch err {
+		case session.ErrSessionNotFound:
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeSessionNotFound,
+				Message: "Session not found",
+			})
+		case spin.ErrNoPendingSpin:
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNoPendingSpin,
+				Message: "No unacknowledged spin for this session",
+			})
+		}
+
+		h.logger.WithTrace(c).Error().Err(err).Str("session_id", sessionID.String()).Msg("Failed to get pending spin")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToGetPendingSpin,
+			Message: "Failed to get pending spin",
+		})
+	}
+
+	response := toSpinResponse(result)
+	return sendSpinResponse(c, h.signer, &response)
+}
+
+// AcknowledgeSpin confirms the client received a spin, clearing it and every earlier spin from pending
+// POST /v1/base-spins/pending/ack
+func (h *SpinHandler) AcknowledgeSpin(c *fiber.Ctx) error {
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+	}
+
+	var req dto.AcknowledgeSpinRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	sessionID, err := uuid.Parse(req.SessionID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidSessionID,
+			Message: "Invalid session ID",
+		})
+	}
+
+	spinID, err := uuid.Parse(req.SpinID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidSpinID,
+			Message: "Invalid spin ID",
+		})
+	}
+
+	if err := h.spinService.AcknowledgeSpin(c.Context(), playerID, sessionID, spinID); err != nil {
+		switch err {
+		case session.ErrSessionNotFound:
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeSessionNotFound,
+				Message: "Session not found",
+			})
+		case spin.ErrSpinNotFound:
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeSpinNotFound,
+				Message: "Spin not found in this session",
+			})
+		}
+
+		h.logger.WithTrace(c).Error().Err(err).Str("spin_id", spinID.String()).Msg("Failed to acknowledge spin")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeFailedToAcknowledgeSpin,
+			Message: "Failed to acknowledge spin",
+		})
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// toSpinResponse converts a spin result to its API response
+func toSpinResponse(result *spin.SpinResult) dto.SpinResponse {
+	response := dto.SpinResponse{
+		SpinID:                  result.SpinID.String(),
+		SessionID:               result.SessionID.String(),
+		BetAmount:               result.BetAmount,
+		BalanceBefore:           result.BalanceBefore,
+		BalanceAfterBet:         result.BalanceAfterBet,
+		NewBalance:              result.NewBalance,
+		Grid:                    convertGrid(result.Grid),
+		Cascades:                convertCascades(result.Cascades),
+		SpinTotalWin:            result.SpinTotalWin,
+		WinCapped:               result.WinCapped,
+		ScatterCount:            result.ScatterCount,
+		IsFreeSpin:              result.IsFreeSpin,
+		FreeSpinsSessionID:      result.FreeSpinsSessionID,
+		FreeSpinsTriggered:      result.FreeSpinsTriggered,
+		FreeSpinsRemainingSpins: result.FreeSpinsRemainingSpins,
+		FreeSessionTotalWin:     result.FreeSessionTotalWin,
+		GameMode:                result.GameMode,
+		GameModeCost:            result.GameModeCost,
+		WinTier:                 toWinTierInfo(result.WinTier),
+		Timing:                  toSpinTimingInfo(result.Timing),
+		Timestamp:               result.Timestamp,
+	}
+
+	if result.Autoplay != nil {
+		response.Autoplay = toAutoplayResponse(result.Autoplay)
+	}
+
+	// Add provably fair data if present
+	if result.ProvablyFair != nil {
+		response.ProvablyFair = &dto.SpinProvablyFairData{
+			SpinHash:     result.ProvablyFair.SpinHash,
+			PrevSpinHash: result.ProvablyFair.PrevSpinHash,
+			Nonce:        result.ProvablyFair.Nonce,
+		}
+	}
+
+	return response
+}
+
+// toWinTierInfo converts a spin's win tier for the response
+func toWinTierInfo(tier *spin.WinTier) *dto.WinTierInfo {
+	if tier == nil {
+		return nil
+	}
+	return &dto.WinTierInfo{
+		Name:       tier.Name,
+		Multiplier: tier.Multiplier,
+		Video:      tier.Video,
+		Audio:      tier.Audio,
+	}
+}
+
+// toSpinTimingInfo converts a spin's timing plans for the response
+func toSpinTimingInfo(timing *spin.Timing) *dto.SpinTimingInfo {
+	if timing == nil {
+		return nil
+	}
+	plan := func(p spin.TimingPlan) dto.TimingPlanInfo {
+		return dto.TimingPlanInfo{ReelSpinMs: p.ReelSpinMs, CascadeMs: p.CascadeMs, TotalMs: p.TotalMs}
+	}
+	return &dto.SpinTimingInfo{
+		Normal:    plan(timing.Normal),
+		Turbo:     plan(timing.Turbo),
+		MinSpinMs: timing.MinSpinMs,
+	}
+}
+
 // convertCascades converts spin.Cascades to dto.CascadeInfo
 func convertCascades(cascades spin.Cascades) []dto.CascadeInfo {
 	result := make([]dto.CascadeInfo, len(cascades))
@@ -227,13 +461,14 @@ func convertCascades(cascades spin.Cascades) []dto.CascadeInfo {
 			}
 
 			wins[j] = dto.WinInfo{
-				Symbol:       symbols.SymbolNumber(win.Symbol),
-				Count:        win.Count,
-				Ways:         win.Ways,
-				Payout:       win.Payout,
-				WinAmount:    win.WinAmount,
-				Positions:    positions,
-				WinIntensity: string(symbols.GetWinIntensity(symbols.Symbol(win.Symbol), win.Count)),
+				Symbol:        symbols.SymbolNumber(win.Symbol),
+				Count:         win.Count,
+				Ways:          win.Ways,
+				Payout:        win.Payout,
+				WinAmount:     win.WinAmount,
+				Positions:     positions,
+				WinIntensity:  string(symbols.GetWinIntensity(symbols.Symbol(win.Symbol), win.Count)),
+				EffectiveWays: win.EffectiveWays,
 			}
 		}
 		result[i] = dto.CascadeInfo{
@@ -243,6 +478,7 @@ func convertCascades(cascades spin.Cascades) []dto.CascadeInfo {
 			Wins:            wins,
 			TotalCascadeWin: cascade.TotalCascadeWin,
 			WinningTileKind: cascade.WinningTileKind,
+			ExpandedReels:   cascade.ExpandedReels,
 		}
 	}
 	return result
@@ -259,3 +495,14 @@ func convertGrid(grid spin.Grid) [][]int {
 	return result
 }
 
+// convertStickyWilds converts sticky wild grid positions to response positions
+func convertStickyWilds(positions []spin.GridPosition) []dto.Position {
+	if len(positions) == 0 {
+		return nil
+	}
+	result := make([]dto.Position, len(positions))
+	for i, pos := range positions {
+		result[i] = dto.Position{Reel: pos.Reel, Row: pos.Row}
+	}
+	return result
+}
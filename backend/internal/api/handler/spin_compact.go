@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/slotmachine/backend/internal/api/dto"
+)
+
+// compactSpinMediaType is the Accept media type that selects the compact spin format
+const compactSpinMediaType = "application/vnd.slotmachine.compact+json"
+
+// wantsCompactSpin reports whether the client negotiated the compact spin format
+func wantsCompactSpin(c *fiber.Ctx) bool {
+	if c.Query("format") == "compact" {
+		return true
+	}
+	return strings.Contains(c.Get(fiber.HeaderAccept), compactSpinMediaType)
+}
+
+// toCompactSpinResponse delta-encodes a spin response's cascades
+// Each cascade lists only the cells that differ from the grid before it: the spin grid
+// for the first cascade, the previous cascade's grid after that.
+func toCompactSpinResponse(response *dto.SpinResponse) *dto.CompactSpinResponse {
+	compact := &dto.CompactSpinResponse{
+		SpinResponse: *response,
+		Format:       "compact",
+		Cascades:     make([]dto.CompactCascadeInfo, len(response.Cascades)),
+	}
+	compact.SpinResponse.Cascades = nil
+
+	prev := response.Grid
+	for i, cascade := range response.Cascades {
+		wins := make([]dto.CompactWinInfo, len(cascade.Wins))
+		for j, win := range cascade.Wins {
+			positions := make([][2]int, len(win.Positions))
+			var goldToWild []int
+			for k, pos := range win.Positions {
+				positions[k] = [2]int{pos.Reel, pos.Row}
+				if pos.IsGoldToWild {
+					goldToWild = append(goldToWild, k)
+				}
+			}
+			wins[j] = dto.CompactWinInfo{
+				Symbol:        win.Symbol,
+				Count:         win.Count,
+				Ways:          win.Ways,
+				Payout:        win.Payout,
+				WinAmount:     win.WinAmount,
+				Positions:     positions,
+				GoldToWild:    goldToWild,
+				EffectiveWays: win.EffectiveWays,
+			}
+		}
+
+		compact.Cascades[i] = dto.CompactCascadeInfo{
+			CascadeNumber:   cascade.CascadeNumber,
+			Changes:         gridDelta(prev, cascade.GridAfter),
+			Multiplier:      cascade.Multiplier,
+			Wins:            wins,
+			TotalCascadeWin: cascade.TotalCascadeWin,
+			WinningTileKind: cascade.WinningTileKind,
+			ExpandedReels:   cascade.ExpandedReels,
+		}
+		prev = cascade.GridAfter
+	}
+
+	return compact
+}
+
+// gridDelta lists the [reel, row, symbol] cells of next that differ from prev
+// Cells outside prev count as changed, so a reshaped grid is still reconstructible.
+func gridDelta(prev, next [][]int) [][3]int {
+	changes := make([][3]int, 0)
+	for reel, column := range next {
+		for row, symbol := range column {
+			if reel < len(prev) && row < len(prev[reel]) && prev[reel][row] == symbol {
+				continue
+			}
+			changes = append(changes, [3]int{reel, row, symbol})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGridDelta(t *testing.T) {
+	prev := [][]int{{1, 2}, {3, 4}}
+
+	assert.Empty(t, gridDelta(prev, [][]int{{1, 2}, {3, 4}}))
+	assert.Equal(t, [][3]int{{0, 1, 9}, {1, 0, 7}}, gridDelta(prev, [][]int{{1, 9}, {7, 4}}))
+	assert.Equal(t, [][3]int{{2, 0, 5}}, gridDelta(prev, [][]int{{1, 2}, {3, 4}, {5}}), "cells outside the previous grid count as changed")
+}
+
+func TestSendSpinResponse_CompactNegotiation(t *testing.T) {
+	response := func() *dto.SpinResponse {
+		return &dto.SpinResponse{
+			SpinID: "s1",
+			Grid:   [][]int{{1, 2}, {3, 4}},
+			Cascades: []dto.CascadeInfo{
+				{CascadeNumber: 1, GridAfter: [][]int{{5, 2}, {3, 4}}, Multiplier: 1, Wins: []dto.WinInfo{
+					{Symbol: 1, Count: 3, Positions: []dto.Position{{Reel: 0, Row: 0}, {Reel: 1, Row: 1, IsGoldToWild: true}}},
+				}},
+				{CascadeNumber: 2, GridAfter: [][]int{{5, 6}, {3, 4}}, Multiplier: 2},
+			},
+		}
+	}
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return sendSpinResponse(c, nil, response())
+	})
+
+	byHeader := httptest.NewRequest("GET", "/", nil)
+	byHeader.Header.Set("Accept", compactSpinMediaType)
+	requests := map[string]*http.Request{
+		"query":         httptest.NewRequest("GET", "/?format=compact", nil),
+		"accept header": byHeader,
+	}
+
+	for name, req := range requests {
+		resp, err := app.Test(req)
+		require.NoError(t, err, name)
+		body, _ := io.ReadAll(resp.Body)
+
+		var decoded dto.CompactSpinResponse
+		require.NoError(t, json.Unmarshal(body, &decoded), name)
+		assert.Equal(t, "compact", decoded.Format, name)
+		require.Len(t, decoded.Cascades, 2, name)
+		assert.Equal(t, [][3]int{{0, 0, 5}}, decoded.Cascades[0].Changes, name)
+		assert.Equal(t, [][3]int{{0, 1, 6}}, decoded.Cascades[1].Changes, name)
+		assert.Equal(t, [][2]int{{0, 0}, {1, 1}}, decoded.Cascades[0].Wins[0].Positions, name)
+		assert.Equal(t, []int{1}, decoded.Cascades[0].Wins[0].GoldToWild, name)
+		assert.Contains(t, resp.Header.Get("Vary"), "Accept", name)
+	}
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	assert.NotContains(t, string(body), `"format"`)
+	assert.Contains(t, string(body), `"grid_after"`)
+}
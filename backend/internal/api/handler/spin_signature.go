@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/crypto"
+	"github.com/slotmachine/backend/internal/pkg/wireformat"
+)
+
+// spinSignatureAlgorithm is the only algorithm spin responses are signed with
+const spinSignatureAlgorithm = "Ed25519"
+
+// Headers carrying the signature of binary (MessagePack/Protobuf) spin responses
+const (
+	headerSpinSignature      = "X-Spin-Signature"
+	headerSpinSignatureKeyID = "X-Spin-Signature-Kid"
+)
+
+// sendSpinResponse writes a spin response, signed when a signer is configured
+// Clients that negotiate the compact format receive delta-encoded cascades instead.
+// For JSON the signature covers the body exactly as sent up to the appended "signature" member,
+// so clients verify the raw response text without re-serializing it. Binary formats have no
+// room for a trailing member, so the signature of the whole body travels in headers instead.
+func sendSpinResponse(c *fiber.Ctx, signer *crypto.Ed25519Signer, response *dto.SpinResponse) error {
+	response.Signature = nil
+	var body any = response
+	c.Vary(fiber.HeaderAccept)
+
+	if format := wireformat.Negotiate(c.Get(fiber.HeaderAccept)); format != wireformat.JSON {
+		payload, err := wireformat.Marshal(format, response)
+		if err != nil {
+			return err
+		}
+		if signer != nil {
+			c.Set(headerSpinSignature, base64.StdEncoding.EncodeToString(signer.Sign(payload)))
+			c.Set(headerSpinSignatureKeyID, signer.KeyID())
+		}
+		c.Set(fiber.HeaderContentType, format.ContentType())
+		return c.Status(fiber.StatusOK).Send(payload)
+	}
+
+	if wantsCompactSpin(c) {
+		body = toCompactSpinResponse(response)
+	}
+
+	if signer == nil {
+		return c.Status(fiber.StatusOK).JSON(body)
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	signature, err := json.Marshal(dto.SpinSignature{
+		Algorithm: spinSignatureAlgorithm,
+		KeyID:     signer.KeyID(),
+		Value:     base64.StdEncoding.EncodeToString(signer.Sign(payload)),
+	})
+	if err != nil {
+		return err
+	}
+
+	signed := make([]byte, 0, len(payload)+len(signature)+len(`,"signature":`))
+	signed = append(signed, payload[:len(payload)-1]...)
+	signed = append(signed, `,"signature":`...)
+	signed = append(signed, signature...)
+	signed = append(signed, '}')
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	return c.Status(fiber.StatusOK).Send(signed)
+}
+
+// GetSigningKey publishes the public key spin responses are signed with
+// GET /v1/pf/verify/signing-key
+func (h *SpinHandler) GetSigningKey(c *fiber.Ctx) error {
+	if h.signer == nil {
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeNotFound,
+			Message: "Spin results are not signed",
+		})
+	}
+
+	return c.JSON(dto.SpinSigningKeyResponse{
+		Algorithm: spinSignatureAlgorithm,
+		KeyID:     h.signer.KeyID(),
+		PublicKey: base64.StdEncoding.EncodeToString(h.signer.PublicKey()),
+	})
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/crypto"
+	"github.com/slotmachine/backend/internal/pkg/wireformat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendSpinResponse_SignsBodyBeforeSignature(t *testing.T) {
+	signer, err := crypto.NewEd25519Signer("spin-signing-dev-seed-32-bytes!!")
+	require.NoError(t, err)
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return sendSpinResponse(c, signer, &dto.SpinResponse{SpinID: "s1", Grid: [][]int{{1, 2}}, SpinTotalWin: 1.5})
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var decoded dto.SpinResponse
+	require.NoError(t, json.Unmarshal(body, &decoded))
+	require.NotNil(t, decoded.Signature)
+	assert.Equal(t, "Ed25519", decoded.Signature.Algorithm)
+	assert.Equal(t, signer.KeyID(), decoded.Signature.KeyID)
+
+	// Verifiers strip the trailing signature member from the raw body
+	idx := bytes.LastIndex(body, []byte(`,"signature":`))
+	require.Positive(t, idx)
+	signed := append(append([]byte{}, body[:idx]...), '}')
+	sig, err := base64.StdEncoding.DecodeString(decoded.Signature.Value)
+	require.NoError(t, err)
+	assert.True(t, crypto.VerifyEd25519(signer.PublicKey(), signed, sig))
+}
+
+func TestSendSpinResponse_Unsigned(t *testing.T) {
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return sendSpinResponse(c, nil, &dto.SpinResponse{SpinID: "s1"})
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	assert.NotContains(t, string(body), "signature")
+}
+
+func TestSendSpinResponse_BinarySignedInHeaders(t *testing.T) {
+	signer, err := crypto.NewEd25519Signer("spin-signing-dev-seed-32-bytes!!")
+	require.NoError(t, err)
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return sendSpinResponse(c, signer, &dto.SpinResponse{SpinID: "s1", Grid: [][]int{{1, 2}}})
+	})
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Accept", wireformat.MIMEProtobuf)
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, wireformat.MIMEProtobuf, resp.Header.Get("Content-Type"))
+	assert.Equal(t, signer.KeyID(), resp.Header.Get(headerSpinSignatureKeyID))
+	sig, err := base64.StdEncoding.DecodeString(resp.Header.Get(headerSpinSignature))
+	require.NoError(t, err)
+	assert.True(t, crypto.VerifyEd25519(signer.PublicKey(), body, sig))
+}
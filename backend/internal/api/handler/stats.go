@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/stats"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+const (
+	statsDateLayout       = "2006-01-02"
+	defaultDailyStatsDays = 30
+)
+
+// StatsHandler handles player stats endpoints for players and admins
+type StatsHandler struct {
+	statsService stats.Service
+	logger       *logger.Logger
+}
+
+// NewStatsHandler creates a new stats handler
+func NewStatsHandler(statsService stats.Service, log *logger.Logger) *StatsHandler {
+	return &StatsHandler{
+		statsService: statsService,
+		logger:       log,
+	}
+}
+
+// GetMyStats returns the authenticated player's lifetime stats
+// GET /player/stats
+func (h *StatsHandler) GetMyStats(c *fiber.Ctx) error {
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+	}
+
+	lifetime, err := h.statsService.GetLifetime(c.Context(), playerID)
+	if err != nil {
+		return h.statsError(c, err, "Failed to get player stats")
+	}
+	return c.JSON(lifetime)
+}
+
+// GetMyDailyStats returns the authenticated player's daily stats
+// GET /player/stats/daily?from=2026-01-01&to=2026-01-31
+func (h *StatsHandler) GetMyDailyStats(c *fiber.Ctx) error {
+	playerID, err := uuid.Parse(c.Locals("user_id").(string))
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidToken,
+			Message: "Invalid player ID in token",
+		})
+	}
+
+	resp, err := h.dailyStats(c, playerID)
+	if err != nil {
+		return h.statsError(c, err, "Failed to get daily player stats")
+	}
+	return c.JSON(resp)
+}
+
+// GetPlayerStats returns a player's lifetime stats
+// GET /admin/players/:id/stats
+func (h *StatsHandler) GetPlayerStats(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	lifetime, err := h.statsService.GetLifetime(c.Context(), playerID)
+	if err != nil {
+		return h.statsError(c, err, "Failed to get player stats")
+	}
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    lifetime,
+	})
+}
+
+// GetPlayerDailyStats returns a player's daily stats
+// GET /admin/players/:id/stats/daily?from=2026-01-01&to=2026-01-31
+func (h *StatsHandler) GetPlayerDailyStats(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	resp, err := h.dailyStats(c, playerID)
+	if err != nil {
+		return h.statsError(c, err, "Failed to get daily player stats")
+	}
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    resp,
+	})
+}
+
+// dailyStats parses the from/to query (default: the last 30 days) and loads the rollups
+func (h *StatsHandler) dailyStats(c *fiber.Ctx, playerID uuid.UUID) (*dto.DailyStatsResponse, error) {
+	to := stats.Day(time.Now())
+	if v := c.Query("to"); v != "" {
+		parsed, err := time.Parse(statsDateLayout, v)
+		if err != nil {
+			return nil, stats.ErrInvalidRange
+		}
+		to = parsed
+	}
+
+	from := to.AddDate(0, 0, -(defaultDailyStatsDays - 1))
+	if v := c.Query("from"); v != "" {
+		parsed, err := time.Parse(statsDateLayout, v)
+		if err != nil {
+			return nil, stats.ErrInvalidRange
+		}
+		from = parsed
+	}
+
+	days, err := h.statsService.GetDaily(c.Context(), playerID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.DailyStatsResponse{
+		From: from.Format(statsDateLayout),
+		To:   to.Format(statsDateLayout),
+		Days: days,
+	}, nil
+}
+
+// statsError maps stats service errors to responses
+func (h *StatsHandler) statsError(c *fiber.Ctx, err error, message string) error {
+	if errors.Is(err, stats.ErrInvalidRange) {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRange,
+			Message: "Invalid date range: use from/to as YYYY-MM-DD, at most 366 days apart",
+		})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
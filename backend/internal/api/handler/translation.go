@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/api/middleware"
+	"github.com/slotmachine/backend/internal/pkg/i18n"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// TranslationHandler serves translation bundles to clients
+type TranslationHandler struct {
+	translator *i18n.Translator
+	logger     *logger.Logger
+}
+
+// NewTranslationHandler creates a new translation handler
+func NewTranslationHandler(translator *i18n.Translator, log *logger.Logger) *TranslationHandler {
+	return &TranslationHandler{
+		translator: translator,
+		logger:     log,
+	}
+}
+
+// GetTranslations returns the bundle for ?locale=, or the locale negotiated from Accept-Language
+// GET /v1/translations
+func (h *TranslationHandler) GetTranslations(c *fiber.Ctx) error {
+	locale := middleware.Locale(c)
+	if requested := c.Query("locale"); requested != "" {
+		locale = i18n.NormalizeLocale(requested)
+	}
+
+	bundle, err := h.translator.Bundle(c.Context(), locale)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeNotFound,
+			Message: "Locale not available",
+		})
+	}
+
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return c.JSON(dto.TranslationsResponse{Locale: locale, Messages: bundle})
+}
+
+// AdminTranslationHandler manages admin translation bundles
+type AdminTranslationHandler struct {
+	translator *i18n.Translator
+	logger     *logger.Logger
+}
+
+// NewAdminTranslationHandler creates a new admin translation handler
+func NewAdminTranslationHandler(translator *i18n.Translator, log *logger.Logger) *AdminTranslationHandler {
+	return &AdminTranslationHandler{
+		translator: translator,
+		logger:     log,
+	}
+}
+
+// ListLocales lists the available locales
+// GET /admin/translations
+func (h *AdminTranslationHandler) ListLocales(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    h.translator.Locales(c.Context()),
+	})
+}
+
+// GetBundle returns a locale's admin bundle
+// GET /admin/translations/:locale
+func (h *AdminTranslationHandler) GetBundle(c *fiber.Ctx) error {
+	locale := i18n.NormalizeLocale(c.Params("locale"))
+	bundle, err := h.translator.StoredBundle(c.Context(), locale)
+	if err != nil {
+		return h.bundleError(c, err, "Failed to get translations")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    dto.TranslationsResponse{Locale: locale, Messages: bundle},
+	})
+}
+
+// SetBundle replaces a locale's admin bundle
+// PUT /admin/translations/:locale
+func (h *AdminTranslationHandler) SetBundle(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.SetTranslationsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	locale, err := h.translator.PutBundle(c.Context(), c.Params("locale"), req.Messages)
+	if err != nil {
+		return h.bundleError(c, err, "Failed to save translations")
+	}
+
+	log.Info().
+		Str("locale", locale).
+		Int("keys", len(req.Messages)).
+		Str("updated_by", adminUsername(c)).
+		Msg("Translation bundle updated")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    dto.TranslationsResponse{Locale: locale, Messages: req.Messages},
+	})
+}
+
+// DeleteBundle removes a locale's admin bundle
+// DELETE /admin/translations/:locale
+func (h *AdminTranslationHandler) DeleteBundle(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	locale := i18n.NormalizeLocale(c.Params("locale"))
+	if err := h.translator.DeleteBundle(c.Context(), locale); err != nil {
+		return h.bundleError(c, err, "Failed to delete translations")
+	}
+
+	log.Info().Str("locale", locale).Str("deleted_by", adminUsername(c)).Msg("Translation bundle deleted")
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Translations deleted",
+	})
+}
+
+// bundleError maps translation errors to responses
+func (h *AdminTranslationHandler) bundleError(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, i18n.ErrBundleNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeNotFound,
+			Message: "Translations not found",
+		})
+	case errors.Is(err, i18n.ErrInvalidBundle):
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidTranslations,
+			Message: err.Error(),
+		})
+	}
+
+	h.logger.WithTrace(c).Error().Err(err).Msg(message)
+	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+		Error:   domainErrors.CodeInternalError,
+		Message: message,
+	})
+}
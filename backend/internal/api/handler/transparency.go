@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/transparency"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// TransparencyHandler serves the public spin hash transparency log
+type TransparencyHandler struct {
+	transparencyService transparency.Service
+	logger              *logger.Logger
+}
+
+// NewTransparencyHandler creates a new transparency handler
+func NewTransparencyHandler(transparencyService transparency.Service, log *logger.Logger) *TransparencyHandler {
+	return &TransparencyHandler{
+		transparencyService: transparencyService,
+		logger:              log,
+	}
+}
+
+// ListCheckpoints lists checkpoints newest first
+// GET /v1/transparency/checkpoints?limit=&offset=
+func (h *TransparencyHandler) ListCheckpoints(c *fiber.Ctx) error {
+	limit, offset := 20, 0
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(c.Query("offset")); err == nil && o > 0 {
+		offset = o
+	}
+
+	checkpoints, total, err := h.transparencyService.List(c.Context(), limit, offset)
+	if err != nil {
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to list transparency checkpoints")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeListFailed,
+			Message: "Failed to list transparency checkpoints",
+		})
+	}
+
+	return c.JSON(dto.TransparencyCheckpointListResponse{
+		Checkpoints: checkpoints,
+		Total:       total,
+		Limit:       limit,
+		Offset:      offset,
+	})
+}
+
+// GetCheckpoint returns a checkpoint and its leaves so its Merkle root can be recomputed
+// GET /v1/transparency/checkpoints/:sequence
+func (h *TransparencyHandler) GetCheckpoint(c *fiber.Ctx) error {
+	sequence, err := strconv.ParseInt(c.Params("sequence"), 10, 64)
+	if err != nil || sequence <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidID,
+			Message: "Invalid checkpoint sequence",
+		})
+	}
+
+	checkpoint, leaves, err := h.transparencyService.Get(c.Context(), sequence)
+	if err != nil {
+		if ok, err := transparencyError(c, err); ok {
+			return err
+		}
+		h.logger.WithTrace(c).Error().Err(err).Int64("sequence", sequence).Msg("Failed to get transparency checkpoint")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to get transparency checkpoint",
+		})
+	}
+
+	return c.JSON(dto.TransparencyCheckpointResponse{
+		Checkpoint: checkpoint,
+		Leaves:     leaves,
+	})
+}
+
+// GetProof returns the inclusion proof of a spin in its checkpoint
+// GET /v1/transparency/proof/:spinId
+func (h *TransparencyHandler) GetProof(c *fiber.Ctx) error {
+	spinID, ok := parseUUIDParam(c, "spinId", "Invalid spin ID")
+	if !ok {
+		return nil
+	}
+
+	proof, err := h.transparencyService.Prove(c.Context(), spinID)
+	if err != nil {
+		if ok, err := transparencyError(c, err); ok {
+			return err
+		}
+		h.logger.WithTrace(c).Error().Err(err).Str("spin_id", spinID.String()).Msg("Failed to build transparency proof")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to build transparency proof",
+		})
+	}
+
+	return c.JSON(dto.TransparencyProofResponse{InclusionProof: proof})
+}
+
+// transparencyError writes the response for transparency log errors and reports whether it did
+func transparencyError(c *fiber.Ctx, err error) (bool, error) {
+	switch {
+	case errors.Is(err, transparency.ErrCheckpointNotFound):
+		return true, c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeCheckpointNotFound, Message: "Transparency checkpoint not found"})
+	case errors.Is(err, provablyfair.ErrSpinNotFound):
+		return true, c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{Error: domainErrors.CodeSpinLogNotFound, Message: "No provably fair spin log for this spin"})
+	case errors.Is(err, transparency.ErrSpinNotCheckpointed):
+		return true, c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeSpinNotCheckpointed, Message: err.Error()})
+	case errors.Is(err, transparency.ErrCheckpointMismatch):
+		return true, c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{Error: domainErrors.CodeCheckpointMismatch, Message: err.Error()})
+	case errors.Is(err, transparency.ErrLeavesUnavailable):
+		return true, c.Status(fiber.StatusGone).JSON(dto.ErrorResponse{Error: domainErrors.CodeSpinLogsArchived, Message: err.Error()})
+	}
+	return false, nil
+}
@@ -1,11 +1,15 @@
 package handler
 
 import (
+	"errors"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/domain/trial"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/api/middleware"
+	"github.com/slotmachine/backend/internal/pkg/featureflags"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/slotmachine/backend/internal/service"
 )
@@ -49,7 +53,7 @@ func (h *TrialHandler) StartTrial(c *fiber.Ctx) error {
 		parsed, err := uuid.Parse(req.GameID)
 		if err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "invalid_game_id",
+				Error:   domainErrors.CodeInvalidGameID,
 				Message: "Invalid game ID format",
 			})
 		}
@@ -77,7 +81,7 @@ func (h *TrialHandler) StartTrial(c *fiber.Ctx) error {
 	if err != nil {
 		log.Error().Err(err).Str("ip", clientIP).Msg("Failed to start trial session")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "trial_error",
+			Error:   domainErrors.CodeTrialError,
 			Message: "Failed to start trial session",
 		})
 	}
@@ -120,7 +124,7 @@ func (h *TrialHandler) GetTrialProfile(c *fiber.Ctx) error {
 	trialSession, ok := c.Locals("trial_session").(*trial.TrialSession)
 	if !ok || trialSession == nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "unauthorized",
+			Error:   domainErrors.CodeUnauthorized,
 			Message: "Invalid trial session",
 		})
 	}
@@ -136,6 +140,24 @@ func (h *TrialHandler) GetTrialProfile(c *fiber.Ctx) error {
 	})
 }
 
+// GetTrialFeatures returns the feature flags evaluated for the trial session
+// GET /v1/trial/features
+func (h *TrialHandler) GetTrialFeatures(c *fiber.Ctx) error {
+	trialSession, ok := c.Locals("trial_session").(*trial.TrialSession)
+	if !ok || trialSession == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeUnauthorized,
+			Message: "Invalid trial session",
+		})
+	}
+
+	features := h.trialService.FeatureFlags(c.Context(), trialSession.ID)
+	if features == nil {
+		features = featureflags.Set{}
+	}
+	return c.JSON(dto.FeaturesResponse{Features: features})
+}
+
 // GetTrialBalance returns current trial balance
 // GET /v1/trial/balance
 func (h *TrialHandler) GetTrialBalance(c *fiber.Ctx) error {
@@ -143,7 +165,7 @@ func (h *TrialHandler) GetTrialBalance(c *fiber.Ctx) error {
 	trialSession, ok := c.Locals("trial_session").(*trial.TrialSession)
 	if !ok || trialSession == nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-			Error:   "unauthorized",
+			Error:   domainErrors.CodeUnauthorized,
 			Message: "Invalid trial session",
 		})
 	}
@@ -152,3 +174,55 @@ func (h *TrialHandler) GetTrialBalance(c *fiber.Ctx) error {
 		Balance: trialSession.Balance,
 	})
 }
+
+// ResetTrialBalance restores the demo balance to the game's starting balance
+// POST /v1/trial/balance/reset
+func (h *TrialHandler) ResetTrialBalance(c *fiber.Ctx) error {
+	sessionToken, _ := c.Locals("session_token").(string)
+
+	balance, err := h.trialService.ResetTrialBalance(c.Context(), sessionToken)
+	if err != nil {
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to reset trial balance")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeTrialError,
+			Message: "Failed to reset trial balance",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.TrialBalanceResponse{
+		Balance: balance,
+	})
+}
+
+// TopUpTrialBalance adds to the demo balance, up to the game's starting balance
+// POST /v1/trial/balance/top-up
+func (h *TrialHandler) TopUpTrialBalance(c *fiber.Ctx) error {
+	sessionToken, _ := c.Locals("session_token").(string)
+
+	var req dto.TopUpTrialBalanceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	balance, err := h.trialService.TopUpTrialBalance(c.Context(), sessionToken, req.Amount)
+	if err != nil {
+		if errors.Is(err, trial.ErrInvalidTopUp) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeValidationError,
+				Message: err.Error(),
+			})
+		}
+		h.logger.WithTrace(c).Error().Err(err).Msg("Failed to top up trial balance")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeTrialError,
+			Message: "Failed to top up trial balance",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.TrialBalanceResponse{
+		Balance: balance,
+	})
+}
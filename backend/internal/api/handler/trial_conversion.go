@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/trial"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/service"
+)
+
+// TrialConversionHandler handles converting trial players into real accounts
+type TrialConversionHandler struct {
+	conversionService *service.TrialConversionService
+	logger            *logger.Logger
+}
+
+// NewTrialConversionHandler creates a new trial conversion handler
+func NewTrialConversionHandler(conversionService *service.TrialConversionService, log *logger.Logger) *TrialConversionHandler {
+	return &TrialConversionHandler{
+		conversionService: conversionService,
+		logger:            log,
+	}
+}
+
+// ConvertTrial registers a real account for the current trial player
+// POST /v1/trial/convert
+func (h *TrialConversionHandler) ConvertTrial(c *fiber.Ctx) error {
+	log := h.logger.WithTrace(c)
+
+	var req dto.ConvertTrialRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInvalidRequest,
+			Message: "Invalid request body",
+		})
+	}
+
+	input := service.ConvertTrialInput{
+		SessionToken: c.Locals("session_token").(string),
+		Username:     req.Username,
+		Email:        req.Email,
+		Password:     req.Password,
+	}
+	if req.GameID != nil && *req.GameID != "" {
+		parsed, err := uuid.Parse(*req.GameID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidGameID,
+				Message: "Invalid game ID format",
+			})
+		}
+		input.GameID = &parsed
+	}
+
+	conversion, p, err := h.conversionService.Convert(c.Context(), input)
+	if err != nil {
+		log.Error().Err(err).Str("username", req.Username).Msg("Trial conversion failed")
+
+		switch {
+		case errors.Is(err, trial.ErrAlreadyConverted):
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeAlreadyConverted,
+				Message: "This trial session has already been converted",
+			})
+		case errors.Is(err, player.ErrPlayerAlreadyExists):
+			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodePlayerExists,
+				Message: "Username or email already exists",
+			})
+		case errors.Is(err, player.ErrGameNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeGameNotFound,
+				Message: "Specified game does not exist",
+			})
+		case errors.Is(err, player.ErrGameIDRequired):
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeGameIDRequired,
+				Message: "game_id is required when the trial is not bound to a game",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeConversionFailed,
+			Message: "Failed to convert trial account",
+		})
+	}
+
+	var gameIDStr *string
+	if p.GameID != nil {
+		s := p.GameID.String()
+		gameIDStr = &s
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(dto.ConvertTrialResponse{
+		Message: "Account created. Please login to continue; demo balance and history are not carried over.",
+		Player: dto.PlayerProfile{
+			ID:           p.ID.String(),
+			Username:     p.Username,
+			Email:        p.Email,
+			Balance:      p.Balance,
+			GameID:       gameIDStr,
+			TotalSpins:   p.TotalSpins,
+			TotalWagered: p.TotalWagered,
+			TotalWon:     p.TotalWon,
+			IsActive:     p.IsActive,
+			IsVerified:   p.IsVerified,
+			CreatedAt:    p.CreatedAt,
+			LastLoginAt:  p.LastLoginAt,
+		},
+		TrialStats: trialStatsResponse(conversion),
+	})
+}
+
+// GetPlayerConversion gets the trial conversion that created a player
+// GET /admin/players/:id/trial-conversion
+func (h *TrialConversionHandler) GetPlayerConversion(c *fiber.Ctx) error {
+	playerID, ok := parseUUIDParam(c, "id", "Invalid player ID")
+	if !ok {
+		return nil
+	}
+
+	conversion, err := h.conversionService.GetConversionByPlayer(c.Context(), playerID)
+	if err != nil {
+		if errors.Is(err, trial.ErrConversionNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeNotFound,
+				Message: "Player was not converted from a trial",
+			})
+		}
+		h.logger.WithTrace(c).Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to get trial conversion")
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Failed to get trial conversion",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    conversion,
+	})
+}
+
+// trialStatsResponse converts a conversion record to its trial stats response
+func trialStatsResponse(conversion *trial.Conversion) dto.TrialStats {
+	return dto.TrialStats{
+		TrialSessionID: conversion.TrialSessionID.String(),
+		TotalSpins:     conversion.TrialSpins,
+		TotalWagered:   conversion.TrialWagered,
+		TotalWon:       conversion.TrialWon,
+		FinalBalance:   conversion.TrialBalance,
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/spin"
 	"github.com/slotmachine/backend/domain/trial"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/game/cascade"
@@ -12,6 +14,7 @@ import (
 	"github.com/slotmachine/backend/internal/game/reels"
 	"github.com/slotmachine/backend/internal/game/symbols"
 	"github.com/slotmachine/backend/internal/game/wins"
+	"github.com/slotmachine/backend/internal/pkg/crypto"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/slotmachine/backend/internal/service"
 )
@@ -20,6 +23,7 @@ import (
 type TrialFreeSpinsHandler struct {
 	trialService *service.TrialService
 	gameEngine   *engine.GameEngine
+	signer       *crypto.Ed25519Signer // nil leaves spin responses unsigned
 	logger       *logger.Logger
 }
 
@@ -27,11 +31,13 @@ type TrialFreeSpinsHandler struct {
 func NewTrialFreeSpinsHandler(
 	trialService *service.TrialService,
 	gameEngine *engine.GameEngine,
+	signer *crypto.Ed25519Signer,
 	log *logger.Logger,
 ) *TrialFreeSpinsHandler {
 	return &TrialFreeSpinsHandler{
 		trialService: trialService,
 		gameEngine:   gameEngine,
+		signer:       signer,
 		logger:       log,
 	}
 }
@@ -79,7 +85,7 @@ func (h *TrialFreeSpinsHandler) ExecuteFreeSpin(c *fiber.Ctx) error {
 	var req dto.ExecuteFreeSpinRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -88,7 +94,7 @@ func (h *TrialFreeSpinsHandler) ExecuteFreeSpin(c *fiber.Ctx) error {
 	freeSpinsSessionID, err := uuid.Parse(req.FreeSpinsSessionID)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_free_spins_session_id",
+			Error:   domainErrors.CodeInvalidFreeSpinsSessionID,
 			Message: "Invalid free spins session ID",
 		})
 	}
@@ -97,14 +103,14 @@ func (h *TrialFreeSpinsHandler) ExecuteFreeSpin(c *fiber.Ctx) error {
 	freeSpins, err := h.trialService.GetTrialFreeSpins(c.Context(), freeSpinsSessionID)
 	if err != nil || freeSpins == nil {
 		return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-			Error:   "free_spins_not_found",
+			Error:   domainErrors.CodeFreeSpinsNotFound,
 			Message: "Free spins session not found",
 		})
 	}
 
 	if !freeSpins.IsActive || freeSpins.RemainingSpins <= 0 {
 		return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
-			Error:   "free_spins_not_active",
+			Error:   domainErrors.CodeFreeSpinsNotActive,
 			Message: "Free spins session is not active",
 		})
 	}
@@ -115,14 +121,16 @@ func (h *TrialFreeSpinsHandler) ExecuteFreeSpin(c *fiber.Ctx) error {
 	// Execute trial free spin using game engine with HUGE RTP
 	spinNumber := freeSpins.CompletedSpins + 1
 	engineResult, err := h.gameEngine.ExecuteTrialFreeSpin(
+		c.Context(),
 		freeSpins.LockedBetAmount,
 		freeSpins.RemainingSpins,
 		spinNumber,
+		h.trialService.DemoConfigID(c.Context(), trialSession.GameID, true),
 	)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to execute trial free spin")
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_execute_free_spin",
+			Error:   domainErrors.CodeFailedToExecuteFreeSpin,
 			Message: "Failed to execute free spin",
 		})
 	}
@@ -180,14 +188,25 @@ func (h *TrialFreeSpinsHandler) ExecuteFreeSpin(c *fiber.Ctx) error {
 		FreeSpinsAdditional:     engineResult.AdditionalSpins,
 		FreeSpinsRemainingSpins: freeSpins.RemainingSpins,
 		FreeSessionTotalWin:     freeSpins.TotalWon,
+		WinTier:                 toWinTierInfo(spin.ClassifyWin(engineResult.WinTiers, engineResult.TotalWin, freeSpins.LockedBetAmount)),
+		Timing:                  toSpinTimingInfo(spin.PlanTiming(engineResult.Timing, trialExpansions(engineResult.Cascades))),
 		Timestamp:               time.Now().UTC().Format(time.RFC3339),
 	}
 
-	return c.Status(fiber.StatusOK).JSON(response)
+	return sendSpinResponse(c, h.signer, &response)
 }
 
 // Trial-specific conversion functions
 
+// trialExpansions reports per cascade whether wilds expanded before it
+func trialExpansions(cascades []cascade.CascadeResult) []bool {
+	expanded := make([]bool, len(cascades))
+	for i, result := range cascades {
+		expanded[i] = len(result.ExpandedReels) > 0
+	}
+	return expanded
+}
+
 // convertTrialGrid converts engine reels.Grid to [][]int for JSON response
 func convertTrialGrid(grid reels.Grid) [][]int {
 	result := make([][]int, len(grid))
@@ -1,10 +1,14 @@
 package handler
 
 import (
+	"errors"
+
 	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/domain/player"
 	"github.com/slotmachine/backend/domain/trial"
 	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/crypto"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/slotmachine/backend/internal/service"
 )
@@ -12,16 +16,19 @@ import (
 // TrialSpinHandler handles spin endpoints for trial sessions
 type TrialSpinHandler struct {
 	spinService *service.SpinService
+	signer      *crypto.Ed25519Signer // nil leaves spin responses unsigned
 	logger      *logger.Logger
 }
 
 // NewTrialSpinHandler creates a new trial spin handler
 func NewTrialSpinHandler(
 	spinService *service.SpinService,
+	signer *crypto.Ed25519Signer,
 	log *logger.Logger,
 ) *TrialSpinHandler {
 	return &TrialSpinHandler{
 		spinService: spinService,
+		signer:      signer,
 		logger:      log,
 	}
 }
@@ -39,7 +46,7 @@ func (h *TrialSpinHandler) ExecuteSpin(c *fiber.Ctx) error {
 	var req dto.ExecuteSpinRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "invalid_request",
+			Error:   domainErrors.CodeInvalidRequest,
 			Message: "Invalid request body",
 		})
 	}
@@ -63,13 +70,20 @@ func (h *TrialSpinHandler) ExecuteSpin(c *fiber.Ctx) error {
 
 		if err == player.ErrInsufficientBalance {
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error:   "insufficient_balance",
+				Error:   domainErrors.CodeInsufficientBalance,
 				Message: "Insufficient balance for this bet",
 			})
 		}
 
+		if errors.Is(err, trial.ErrBetOutOfRange) {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidBetAmount,
+				Message: err.Error(),
+			})
+		}
+
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-			Error:   "failed_to_execute_spin",
+			Error:   domainErrors.CodeFailedToExecuteSpin,
 			Message: "Failed to execute spin",
 		})
 	}
@@ -92,8 +106,10 @@ func (h *TrialSpinHandler) ExecuteSpin(c *fiber.Ctx) error {
 		FreeSpinsRemainingSpins: result.FreeSpinsRemainingSpins,
 		GameMode:                result.GameMode,
 		GameModeCost:            result.GameModeCost,
+		WinTier:                 toWinTierInfo(result.WinTier),
+		Timing:                  toSpinTimingInfo(result.Timing),
 		Timestamp:               result.Timestamp,
 	}
 
-	return c.Status(fiber.StatusOK).JSON(response)
+	return sendSpinResponse(c, h.signer, &response)
 }
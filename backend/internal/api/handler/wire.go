@@ -1,21 +1,66 @@
 package handler
 
 import (
+	"fmt"
+
 	"github.com/google/wire"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/crypto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
 // ProviderSet is the Wire provider set for HTTP handlers
 var ProviderSet = wire.NewSet(
+	ProvideSpinSigner,
 	NewAuthHandler,
 	NewPlayerHandler,
+	NewStatsHandler,
 	NewSessionHandler,
+	NewAutoplayHandler,
 	NewSpinHandler,
 	NewFreeSpinsHandler,
 	NewAdminReelStripHandler,
+	NewAdminReelStripCanaryHandler,
+	NewAdminExposureHandler,
+	NewAdminRuntimeConfigHandler,
 	NewAdminPlayerAssignmentHandler,
+	NewAdminSegmentHandler,
+	NewAdminVIPHandler,
+	NewJurisdictionHandler,
+	NewAdminJurisdictionHandler,
+	NewAdminBigWinHandler,
+	NewAdminArchiveHandler,
+	NewAdminFinancialReportHandler,
+	NewAdminNotificationHandler,
+	NewAdminSessionHandler,
+	NewAdminImpersonationHandler,
+	NewAdminPlayerNoteHandler,
+	NewAdminDashboardHandler,
+	NewAdminSpinFeedHandler,
+	NewFeatureFlagHandler,
+	NewAdminFeatureFlagHandler,
+	NewTranslationHandler,
+	NewAdminTranslationHandler,
+	NewAdminTrialHandler,
 	NewAdminAuthHandler,
+	NewJWKSHandler,
+	NewHealthHandler,
+	NewLaunchHandler,
+	NewTransparencyHandler,
 	NewAdminManagementHandler,
 	NewAdminPlayerHandler,
+	NewAdminGraphQLHandler,
+	NewAdminTimelineHandler,
+	NewAdminDisputeHandler,
+	NewAdminApprovalHandler,
+	NewKYCHandler,
+	NewAdminKYCHandler,
+	NewPrivacyHandler,
+	NewHistoryExportHandler,
+	NewNotificationHandler,
+	NewBalanceHandler,
+	NewAdminPrivacyHandler,
+	NewAdminCertificationHandler,
 	NewGameHandler,
 	NewAdminGameHandler,
 	NewAdminUploadHandler,
@@ -23,9 +68,24 @@ var ProviderSet = wire.NewSet(
 	NewAdminDirectUploadHandler,
 	NewProvablyFairHandler,
 	NewTrialHandler,
+	NewTrialConversionHandler,
 	// Trial-specific handlers (separate from production)
 	NewTrialSpinHandler,
 	NewTrialFreeSpinsHandler,
 	NewTrialSessionHandler,
 	NewTrialPlayerHandler,
 )
+
+// ProvideSpinSigner builds the spin response signer, or nil when PF_SIGNING_KEY is empty
+func ProvideSpinSigner(cfg *config.Config, log *logger.Logger) (*crypto.Ed25519Signer, error) {
+	if cfg.ProvablyFair.SigningKey == "" {
+		log.Warn().Msg("PF_SIGNING_KEY is empty, spin responses will not be signed")
+		return nil, nil
+	}
+	signer, err := crypto.NewEd25519Signer(cfg.ProvablyFair.SigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PF_SIGNING_KEY: %w", err)
+	}
+	log.Info().Str("kid", signer.KeyID()).Msg("Spin response signing enabled")
+	return signer, nil
+}
@@ -2,13 +2,14 @@ package middleware
 
 import (
 	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	adminDomain "github.com/slotmachine/backend/domain/admin"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/config"
 	"github.com/slotmachine/backend/internal/pkg/logger"
-	"github.com/slotmachine/backend/internal/pkg/util"
 )
 
 // AdminAuthMiddleware validates admin JWT tokens
@@ -19,7 +20,7 @@ func AdminAuthMiddleware(cfg *config.Config, log *logger.Logger, adminService ad
 		if authHeader == "" {
 			log.Warn().Msg("Missing authorization header")
 			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-				Error:   "unauthorized",
+				Error:   domainErrors.CodeUnauthorized,
 				Message: "Missing authorization token",
 			})
 		}
@@ -29,7 +30,7 @@ func AdminAuthMiddleware(cfg *config.Config, log *logger.Logger, adminService ad
 		if len(parts) != 2 || parts[0] != "Bearer" {
 			log.Warn().Msg("Invalid authorization header format")
 			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-				Error:   "unauthorized",
+				Error:   domainErrors.CodeUnauthorized,
 				Message: "Invalid authorization header format",
 			})
 		}
@@ -47,29 +48,19 @@ func AdminAuthMiddleware(cfg *config.Config, log *logger.Logger, adminService ad
 			return c.Next()
 		}
 
-		// Parse and validate JWT
-		claims, err := util.ValidateJWT(token, cfg.JWT.Secret)
-		if err != nil {
-			log.Warn().Err(err).Msg("Invalid token")
-			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-				Error:   "unauthorized",
-				Message: "Invalid or expired token",
-			})
-		}
-
-		// Validate admin exists and is active
+		// Validate the JWT (any published signing key) and that the admin exists and is active
 		admin, err := adminService.ValidateToken(c.Context(), token)
 		if err != nil {
-			log.Warn().Err(err).Str("user_id", claims.UserID).Msg("Admin validation failed")
+			log.Warn().Err(err).Msg("Admin token validation failed")
 			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-				Error:   "unauthorized",
-				Message: "Invalid or inactive admin account",
+				Error:   domainErrors.CodeUnauthorized,
+				Message: "Invalid or expired token",
 			})
 		}
 
 		// Store admin info in context
-		c.Locals("user_id", claims.UserID)
-		c.Locals("username", claims.Username)
+		c.Locals("user_id", admin.ID.String())
+		c.Locals("username", admin.Username)
 		c.Locals("admin_role", admin.Role)
 		c.Locals("admin", admin)
 
@@ -83,14 +74,14 @@ func AdminRoleMiddleware(requiredRole adminDomain.AdminRole) fiber.Handler {
 		admin, ok := c.Locals("admin").(*adminDomain.Admin)
 		if !ok {
 			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-				Error:   "unauthorized",
+				Error:   domainErrors.CodeUnauthorized,
 				Message: "Admin authentication required",
 			})
 		}
 
 		if !admin.HasRole(requiredRole) {
 			return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
-				Error:   "forbidden",
+				Error:   domainErrors.CodeForbidden,
 				Message: "Insufficient permissions",
 			})
 		}
@@ -105,14 +96,14 @@ func AdminPermissionMiddleware(permission string) fiber.Handler {
 		admin, ok := c.Locals("admin").(*adminDomain.Admin)
 		if !ok {
 			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
-				Error:   "unauthorized",
+				Error:   domainErrors.CodeUnauthorized,
 				Message: "Admin authentication required",
 			})
 		}
 
 		if !admin.CanAccess(permission) {
 			return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
-				Error:   "forbidden",
+				Error:   domainErrors.CodeForbidden,
 				Message: "Insufficient permissions for this action",
 			})
 		}
@@ -120,3 +111,75 @@ func AdminPermissionMiddleware(permission string) fiber.Handler {
 		return c.Next()
 	}
 }
+
+// TwoFactorCodeHeader carries a TOTP or recovery code for a one-off step-up on a destructive request
+const TwoFactorCodeHeader = "X-Admin-OTP"
+
+// AdminTwoFactorMiddleware guards destructive operations behind the admin's second factor
+// It passes when the admin verified a code within the step-up window, or verifies one sent in
+// TwoFactorCodeHeader. Admins without 2FA are refused unless ADMIN_2FA_REQUIRED is off.
+func AdminTwoFactorMiddleware(cfg *config.Config, log *logger.Logger, adminService adminDomain.Service) fiber.Handler {
+	window := time.Duration(cfg.AdminAuth.TwoFactorStepUpMinutes) * time.Minute
+
+	return func(c *fiber.Ctx) error {
+		if isService, _ := c.Locals("is_service").(bool); isService {
+			return c.Next()
+		}
+
+		admin, ok := c.Locals("admin").(*adminDomain.Admin)
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeUnauthorized,
+				Message: "Admin authentication required",
+			})
+		}
+
+		if !admin.TwoFactorEnabled {
+			if !cfg.AdminAuth.TwoFactorRequired {
+				return c.Next()
+			}
+			return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeTwoFactorEnrollmentRequired,
+				Message: "Enable two-factor authentication to perform this operation",
+			})
+		}
+
+		if admin.TwoFactorVerifiedWithin(window, time.Now()) {
+			return c.Next()
+		}
+
+		code := c.Get(TwoFactorCodeHeader)
+		if code == "" {
+			return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeTwoFactorRequired,
+				Message: "Two-factor verification required for this operation",
+			})
+		}
+
+		ip := c.Get("x-real-ip")
+		if ip == "" {
+			ip = c.IP()
+		}
+		if _, err := adminService.VerifyTwoFactor(c.Context(), admin.ID, code, ip, c.Method()+" "+c.Path()); err != nil {
+			switch err {
+			case adminDomain.ErrInvalidTwoFactorCode:
+				return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+					Error:   domainErrors.CodeInvalidTwoFactorCode,
+					Message: "Invalid two-factor code",
+				})
+			case adminDomain.ErrAccountLocked:
+				return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
+					Error:   domainErrors.CodeAccountLocked,
+					Message: "Account is locked due to too many failed attempts",
+				})
+			}
+			log.Error().Err(err).Str("admin_id", admin.ID.String()).Msg("Failed to verify two-factor code")
+			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeFailedToVerifyTwoFactor,
+				Message: "Failed to verify two-factor code",
+			})
+		}
+
+		return c.Next()
+	}
+}
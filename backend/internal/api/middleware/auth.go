@@ -1,12 +1,16 @@
 package middleware
 
 import (
+	"errors"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/impersonation"
 	"github.com/slotmachine/backend/domain/player"
-	"github.com/slotmachine/backend/internal/pkg/errors"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/slotmachine/backend/internal/service"
 )
@@ -14,24 +18,24 @@ import (
 // SessionAuthMiddleware validates session tokens directly (no JWT)
 // Extracts session token from Authorization header and validates against Redis/DB
 // Also validates that the session's game matches the requested game (X-Game-ID header)
-// Supports trial tokens (prefixed with "trial_")
-func SessionAuthMiddleware(log *logger.Logger, playerService player.Service, trialService *service.TrialService) fiber.Handler {
+// Supports trial tokens (prefixed with "trial_") and read-only impersonation tokens (prefixed with "imp_")
+func SessionAuthMiddleware(log *logger.Logger, playerService player.Service, trialService *service.TrialService, impersonationService *service.ImpersonationService) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		// Get Authorization header
 		authHeader := c.Get("Authorization")
 		if authHeader == "" {
-			return respondError(c, errors.Unauthorized("Missing authorization header"))
+			return respondError(c, domainErrors.New(domainErrors.CodeUnauthorized, "Missing authorization header"))
 		}
 
 		// Check Bearer scheme and extract session token
 		parts := strings.Split(authHeader, " ")
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			return respondError(c, errors.Unauthorized("Invalid authorization header format"))
+			return respondError(c, domainErrors.New(domainErrors.CodeUnauthorized, "Invalid authorization header format"))
 		}
 		sessionToken := parts[1]
 
 		if sessionToken == "" {
-			return respondError(c, errors.Unauthorized("Missing session token"))
+			return respondError(c, domainErrors.New(domainErrors.CodeUnauthorized, "Missing session token"))
 		}
 
 		// Get client IP for logging
@@ -46,7 +50,7 @@ func SessionAuthMiddleware(log *logger.Logger, playerService player.Service, tri
 		if gameIDHeader != "" {
 			parsed, err := uuid.Parse(gameIDHeader)
 			if err != nil {
-				return respondError(c, errors.BadRequest("Invalid X-Game-ID format"))
+				return respondError(c, domainErrors.New(domainErrors.CodeInvalidRequest, "Invalid X-Game-ID format"))
 			}
 			requestedGameID = &parsed
 		}
@@ -56,6 +60,11 @@ func SessionAuthMiddleware(log *logger.Logger, playerService player.Service, tri
 			return handleTrialSession(c, log, trialService, sessionToken, requestedGameID, clientIP)
 		}
 
+		// Check if this is an admin impersonating a player
+		if service.IsImpersonationToken(sessionToken) {
+			return handleImpersonation(c, log, playerService, impersonationService, sessionToken, requestedGameID, clientIP)
+		}
+
 		// Validate session with player service (checks Redis first, then DB)
 		result, err := playerService.ValidateSession(c.Context(), sessionToken, requestedGameID)
 		if err != nil {
@@ -69,7 +78,7 @@ func SessionAuthMiddleware(log *logger.Logger, playerService player.Service, tri
 				Str("ip", clientIP).
 				Err(err).
 				Msg("Session validation failed")
-			return respondError(c, errors.Unauthorized(err.Error()))
+			return respondError(c, domainErrors.New(domainErrors.CodeUnauthorized, err.Error()))
 		}
 
 		// Store user info in context for handlers
@@ -86,11 +95,22 @@ func SessionAuthMiddleware(log *logger.Logger, playerService player.Service, tri
 	}
 }
 
+// DenyImpersonation refuses impersonation tokens on player routes support must not see through,
+// such as personal data exports. It runs after SessionAuthMiddleware, so the refusal is still audited.
+func DenyImpersonation() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if impersonating, _ := c.Locals("is_impersonation").(bool); impersonating {
+			return respondError(c, domainErrors.New(domainErrors.CodeImpersonationForbidden, impersonation.ErrRouteForbidden.Error()))
+		}
+		return c.Next()
+	}
+}
+
 // handleTrialSession handles authentication for trial session tokens
 func handleTrialSession(c *fiber.Ctx, log *logger.Logger, trialService *service.TrialService, sessionToken string, requestedGameID *uuid.UUID, clientIP string) error {
 	if trialService == nil {
 		log.Warn().Str("ip", clientIP).Msg("Trial mode attempted but trial service not available")
-		return respondError(c, errors.Unauthorized("Trial mode not available"))
+		return respondError(c, domainErrors.New(domainErrors.CodeUnauthorized, "Trial mode not available"))
 	}
 
 	// Validate trial session from Redis
@@ -105,7 +125,7 @@ func handleTrialSession(c *fiber.Ctx, log *logger.Logger, trialService *service.
 			Str("ip", clientIP).
 			Err(err).
 			Msg("Trial session validation failed")
-		return respondError(c, errors.Unauthorized(err.Error()))
+		return respondError(c, domainErrors.New(domainErrors.CodeUnauthorized, err.Error()))
 	}
 
 	// Store trial session info in context for handlers
@@ -121,14 +141,73 @@ func handleTrialSession(c *fiber.Ctx, log *logger.Logger, trialService *service.
 	return c.Next()
 }
 
-// respondError sends an error response
-func respondError(c *fiber.Ctx, err *errors.HTTPError) error {
-	return c.Status(err.StatusCode).JSON(fiber.Map{
-		"success": false,
-		"error": fiber.Map{
-			"code":    err.Code,
-			"message": err.Message,
-			"details": err.Details,
-		},
+// handleImpersonation authenticates an admin's read-only impersonation token as its player
+// Only reads are let through, and every request, refused or not, is audited against the grant.
+func handleImpersonation(c *fiber.Ctx, log *logger.Logger, playerService player.Service, impersonationService *service.ImpersonationService, token string, requestedGameID *uuid.UUID, clientIP string) error {
+	if impersonationService == nil {
+		return respondError(c, domainErrors.New(domainErrors.CodeUnauthorized, "Impersonation not available"))
+	}
+
+	grant, err := impersonationService.Validate(c.Context(), token)
+	if err != nil {
+		log.Warn().Str("ip", clientIP).Err(err).Msg("Impersonation token validation failed")
+		return respondError(c, domainErrors.New(domainErrors.CodeUnauthorized, err.Error()))
+	}
+
+	p, err := playerService.GetProfile(c.Context(), grant.PlayerID)
+	if err != nil {
+		return respondError(c, domainErrors.New(domainErrors.CodeUnauthorized, err.Error()))
+	}
+	if requestedGameID != nil && p.GameID != nil && *p.GameID != *requestedGameID {
+		return respondError(c, domainErrors.New(domainErrors.CodeUnauthorized, session.ErrPlayerSessionGameMismatch.Error()))
+	}
+
+	var handlerErr error
+	if c.Method() == fiber.MethodGet || c.Method() == fiber.MethodHead {
+		c.Locals("user_id", p.ID.String())
+		c.Locals("username", p.Username)
+		c.Locals("player", p)
+		c.Locals("is_trial", false)
+		c.Locals("is_impersonation", true)
+		c.Locals("impersonation_grant", grant)
+		if p.GameID != nil {
+			c.Locals("game_id", p.GameID.String())
+		}
+		handlerErr = c.Next()
+	} else {
+		handlerErr = respondError(c, domainErrors.New(domainErrors.CodeImpersonationReadOnly, impersonation.ErrReadOnly.Error()))
+	}
+
+	status := c.Response().StatusCode()
+	if handlerErr != nil {
+		// Errors returned to Fiber's error handler have not been written yet
+		status = fiber.StatusInternalServerError
+		var fe *fiber.Error
+		if errors.As(handlerErr, &fe) {
+			status = fe.Code
+		}
+	}
+	access := &impersonation.Access{
+		GrantID:   grant.ID,
+		AdminID:   grant.AdminID,
+		PlayerID:  grant.PlayerID,
+		Method:    c.Method(),
+		Path:      c.Path(),
+		Status:    status,
+		IPAddress: clientIP,
+	}
+	if err := impersonationService.RecordAccess(c.Context(), access); err != nil {
+		log.Error().Err(err).Str("grant_id", grant.ID.String()).Msg("Failed to record impersonation access")
+	}
+
+	return handlerErr
+}
+
+// respondError sends a catalogued error response
+func respondError(c *fiber.Ctx, err *domainErrors.Error) error {
+	return c.Status(err.Status()).JSON(dto.ErrorResponse{
+		Error:   err.Code,
+		Message: err.Message,
+		Details: err.Details,
 	})
 }
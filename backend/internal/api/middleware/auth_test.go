@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenyImpersonation(t *testing.T) {
+	request := func(t *testing.T, impersonating bool) int {
+		t.Helper()
+		app := fiber.New()
+		app.Get("/player/data-export", func(c *fiber.Ctx) error {
+			if impersonating {
+				c.Locals("is_impersonation", true)
+			}
+			return c.Next()
+		}, DenyImpersonation(), func(c *fiber.Ctx) error {
+			return c.SendString("export")
+		})
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/player/data-export", nil))
+		require.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	t.Run("should refuse impersonation tokens", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusForbidden, request(t, true))
+	})
+
+	t.Run("should let the player through", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusOK, request(t, false))
+	})
+}
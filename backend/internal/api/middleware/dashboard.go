@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/slotmachine/backend/domain/dashboard"
+)
+
+// CountSpinRequests counts spin requests and their server errors on the ops dashboard
+// A request fails when it answers 5xx; an error handed back to the error handler counts
+// unless it is a client error.
+func CountSpinRequests(recorder dashboard.Recorder) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		err := c.Next()
+
+		failed := c.Response().StatusCode() >= fiber.StatusInternalServerError
+		if err != nil {
+			var fiberErr *fiber.Error
+			failed = !errors.As(err, &fiberErr) || fiberErr.Code >= fiber.StatusInternalServerError
+		}
+		recorder.RecordSpinRequest(c.Context(), failed)
+
+		return err
+	}
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/i18n"
+)
+
+// LocaleKey is the context key of the negotiated locale
+const LocaleKey = "locale"
+
+// LocaleMiddleware negotiates the response locale from Accept-Language
+// and localizes error messages that have a translation in that locale.
+func LocaleMiddleware(translator *i18n.Translator) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		locale := translator.Negotiate(c.Context(), c.Get(fiber.HeaderAcceptLanguage))
+		c.Locals(LocaleKey, locale)
+		c.Set(fiber.HeaderContentLanguage, locale)
+		c.Vary(fiber.HeaderAcceptLanguage)
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		if c.Response().StatusCode() >= fiber.StatusBadRequest &&
+			strings.HasPrefix(string(c.Response().Header.ContentType()), fiber.MIMEApplicationJSON) {
+			localizeError(c, translator, locale)
+		}
+		return nil
+	}
+}
+
+// localizeError replaces an error response's message with its translation, if any
+func localizeError(c *fiber.Ctx, translator *i18n.Translator, locale string) {
+	var resp dto.ErrorResponse
+	if err := json.Unmarshal(c.Response().Body(), &resp); err != nil || resp.Error == "" {
+		return
+	}
+	message, ok := translator.Lookup(c.Context(), locale, resp.Error.MessageKey())
+	if !ok {
+		return
+	}
+	resp.Message = message
+	if data, err := json.Marshal(resp); err == nil {
+		c.Response().SetBodyRaw(data)
+	}
+}
+
+// Locale returns the locale negotiated for the request
+func Locale(c *fiber.Ctx) string {
+	locale, _ := c.Locals(LocaleKey).(string)
+	return locale
+}
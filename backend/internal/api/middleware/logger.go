@@ -22,7 +22,7 @@ func LoggerMiddleware(log *logger.Logger) fiber.Handler {
 		if clientIP == "" {
 			clientIP = c.IP()
 		}
-		if c.Path() != "/health" {
+		if path := c.Path(); path != "/health" && path != "/healthz" && path != "/readyz" {
 			log.Info().
 				Str("method", c.Method()).
 				Str("path", c.Path()).
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"crypto/hmac"
+
+	"github.com/gofiber/fiber/v2"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/infra/notifier"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// OperatorSignatureHeader carries the hex HMAC-SHA256 of the request body signed with the operator secret
+const OperatorSignatureHeader = "X-Operator-Signature"
+
+// OperatorAuthMiddleware verifies operator requests signed with LAUNCH_OPERATOR_SECRET
+func OperatorAuthMiddleware(cfg *config.Config, log *logger.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if cfg.Launch.OperatorSecret == "" {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeServiceUnavailable,
+				Message: "Operator integration is not configured",
+			})
+		}
+
+		signature := c.Get(OperatorSignatureHeader)
+		expected := notifier.Sign(cfg.Launch.OperatorSecret, c.Body())
+		if signature == "" || !hmac.Equal([]byte(signature), []byte(expected)) {
+			log.Warn().Str("ip", c.IP()).Msg("Invalid operator signature")
+			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{
+				Error:   domainErrors.CodeInvalidOperatorSignature,
+				Message: "Invalid operator signature",
+			})
+		}
+
+		c.Locals("operator_id", cfg.Launch.OperatorID)
+		return c.Next()
+	}
+}
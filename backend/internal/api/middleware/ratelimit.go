@@ -2,13 +2,17 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/domain/segment"
 	"github.com/slotmachine/backend/internal/infra/cache"
-	"github.com/slotmachine/backend/internal/pkg/errors"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
@@ -20,18 +24,60 @@ type RateLimiterConfig struct {
 
 // NewRateLimiter creates a new rate limiter with Redis backend
 func NewRateLimiter(redis *cache.RedisClient, config RateLimiterConfig, log *logger.Logger) *RateLimiter {
-	return &RateLimiter{
+	rl := &RateLimiter{
 		redis:  redis,
-		config: config,
 		logger: log,
 	}
+	rl.config.Store(&config)
+	return rl
 }
 
 // RateLimiter implements Redis-based rate limiting
 type RateLimiter struct {
-	redis  *cache.RedisClient
-	config RateLimiterConfig
-	logger *logger.Logger
+	redis    *cache.RedisClient
+	config   atomic.Pointer[RateLimiterConfig] // Replaced whole by SetLimits
+	policies segment.Resolver                  // Optional: per-segment rate limit overrides
+	logger   *logger.Logger
+}
+
+// SetLimits changes the default limits at runtime; segment policies still override them
+func (rl *RateLimiter) SetLimits(config RateLimiterConfig) {
+	rl.config.Store(&config)
+}
+
+// Limits returns the default limits in effect
+func (rl *RateLimiter) Limits() RateLimiterConfig {
+	return *rl.config.Load()
+}
+
+// SetPolicyResolver enables per-segment rate limit policies for authenticated endpoints
+func (rl *RateLimiter) SetPolicyResolver(policies segment.Resolver) {
+	rl.policies = policies
+}
+
+// authLimit returns the authenticated rate limit for a user, honoring segment policies
+func (rl *RateLimiter) authLimit(c *fiber.Ctx, userID string) int {
+	defaultRPS := rl.config.Load().AuthRPS
+	if rl.policies == nil {
+		return defaultRPS
+	}
+	playerID, err := uuid.Parse(userID)
+	if err != nil {
+		return defaultRPS
+	}
+
+	target, err := rl.policies.ResolveTarget(c.Context(), playerID, segment.TargetRateLimit)
+	if err != nil {
+		if !errors.Is(err, segment.ErrNoTarget) {
+			rl.logger.WithTrace(c).Warn().Err(err).Str("user_id", userID).Msg("Failed to resolve rate limit policy")
+		}
+		return defaultRPS
+	}
+	settings, err := target.RateLimitSettings()
+	if err != nil {
+		return defaultRPS
+	}
+	return settings.RPS
 }
 
 // AuthenticatedMiddleware returns middleware for authenticated endpoints
@@ -62,7 +108,7 @@ func (rl *RateLimiter) AuthenticatedMiddleware() fiber.Handler {
 		}
 
 		path := c.Path()
-		limit := rl.config.AuthRPS
+		limit := rl.authLimit(c, userID)
 		window := time.Second
 
 		// Create Redis key: ratelimit:auth:{userID}:{path}:{timestamp}
@@ -88,7 +134,7 @@ func (rl *RateLimiter) AuthenticatedMiddleware() fiber.Handler {
 				Str("user_agent", c.Get("User-Agent")).
 				Msg("Rate limit exceeded for authenticated user")
 
-			return respondError(c, errors.RateLimitExceeded(int(window.Seconds())))
+			return respondError(c, rateLimitExceeded(window))
 		}
 
 		return c.Next()
@@ -113,7 +159,7 @@ func (rl *RateLimiter) PublicMiddleware() fiber.Handler {
 		}
 
 		path := c.Path()
-		limit := rl.config.PublicRPS
+		limit := rl.config.Load().PublicRPS
 		window := time.Second
 
 		// Create Redis key: ratelimit:public:{ip}:{path}:{timestamp}
@@ -137,13 +183,19 @@ func (rl *RateLimiter) PublicMiddleware() fiber.Handler {
 				Str("user_agent", c.Get("User-Agent")).
 				Msg("Rate limit exceeded for public endpoint")
 
-			return respondError(c, errors.RateLimitExceeded(int(window.Seconds())))
+			return respondError(c, rateLimitExceeded(window))
 		}
 
 		return c.Next()
 	}
 }
 
+// rateLimitExceeded builds the error returned once a rate limit is hit
+func rateLimitExceeded(window time.Duration) *domainErrors.Error {
+	return domainErrors.New(domainErrors.CodeRateLimitExceeded, "Too many requests. Please try again later.").
+		WithDetails(map[string]int{"retry_after": int(window.Seconds())})
+}
+
 // checkLimit checks if the request is within rate limit
 func (rl *RateLimiter) checkLimit(key string, limit int, window time.Duration) (allowed bool, remaining int, resetTime int64) {
 	ctx := context.Background()
@@ -11,9 +11,9 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/redis/go-redis/v9"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	"github.com/slotmachine/backend/internal/config"
 	"github.com/slotmachine/backend/internal/infra/cache"
-	"github.com/slotmachine/backend/internal/pkg/errors"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
@@ -260,13 +260,13 @@ func (trl *TrialRateLimiter) TrialCreationMiddleware() fiber.Handler {
 
 		// Check if trial mode is enabled
 		if !trl.config.Enabled {
-			return respondError(c, errors.ServiceUnavailable("Trial mode is currently disabled"))
+			return respondError(c, domainErrors.New(domainErrors.CodeServiceUnavailable, "Trial mode is currently disabled"))
 		}
 
 		// If Redis is not available, deny trial (security-first approach)
 		if trl.redis == nil {
 			log.Warn().Msg("Trial creation denied: Redis not available")
-			return respondError(c, errors.ServiceUnavailable("Trial mode temporarily unavailable"))
+			return respondError(c, domainErrors.New(domainErrors.CodeServiceUnavailable, "Trial mode temporarily unavailable"))
 		}
 
 		// Extract client IP with trusted proxy validation (HIGH-1 fix: prevents IP spoofing)
@@ -288,7 +288,7 @@ func (trl *TrialRateLimiter) TrialCreationMiddleware() fiber.Handler {
 				Str("ip", clientIP).
 				Bool("whitelisted", isWhitelisted).
 				Msg("Trial creation denied: global session limit reached")
-			return respondError(c, errors.TooManyRequests("Trial service is at capacity. Please try again later."))
+			return respondError(c, domainErrors.New(domainErrors.CodeTrialAtCapacity, "Trial service is at capacity. Please try again later."))
 		}
 
 		// Check 2: IP cooldown (prevent rapid session creation) - SKIP for whitelisted IPs
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"github.com/google/wire"
+	"github.com/slotmachine/backend/domain/segment"
 	"github.com/slotmachine/backend/internal/config"
 	infraCache "github.com/slotmachine/backend/internal/infra/cache"
 	"github.com/slotmachine/backend/internal/pkg/logger"
@@ -14,7 +15,8 @@ var ProviderSet = wire.NewSet(
 )
 
 // ProvideRateLimiter creates a new rate limiter instance
-func ProvideRateLimiter(cfg *config.Config, log *logger.Logger) *RateLimiter {
+// Segment rate_limit targets override the authenticated limit of their members.
+func ProvideRateLimiter(cfg *config.Config, policies segment.Service, log *logger.Logger) *RateLimiter {
 	// Initialize Redis client for rate limiting
 	redisClient, err := infraCache.NewRedisClient(cfg, log)
 	if err != nil {
@@ -28,10 +30,12 @@ func ProvideRateLimiter(cfg *config.Config, log *logger.Logger) *RateLimiter {
 
 	log.Info().Msg("Rate limiter initialized with Redis")
 
-	return NewRateLimiter(redisClient, RateLimiterConfig{
+	rateLimiter := NewRateLimiter(redisClient, RateLimiterConfig{
 		AuthRPS:   50, // 50 requests per second for authenticated endpoints
 		PublicRPS: 50, // 50 requests per second for public endpoints
 	}, log)
+	rateLimiter.SetPolicyResolver(policies)
+	return rateLimiter
 }
 
 // ProvideTrialRateLimiter creates a new trial rate limiter instance
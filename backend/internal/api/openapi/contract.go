@@ -0,0 +1,47 @@
+package openapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Violation is a response that does not match the document
+type Violation struct {
+	Method string
+	Path   string // Request path
+	Status int
+	Err    error
+}
+
+// String describes the violation in one line
+func (v Violation) String() string {
+	return fmt.Sprintf("%s %s -> %d: %v", v.Method, v.Path, v.Status, v.Err)
+}
+
+// Contract checks every JSON response of a documented operation against its schema
+// Responses of routes the document does not cover, and bodies in other formats, pass unchecked.
+// Mount it ahead of the routes under test; report receives each mismatch.
+func Contract(doc *Document, report func(Violation)) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		_, op := doc.Operation(c.Method(), c.Path())
+		if op == nil {
+			return nil
+		}
+		contentType := string(c.Response().Header.ContentType())
+		if !strings.HasPrefix(contentType, jsonContentType) {
+			return nil
+		}
+
+		status := c.Response().StatusCode()
+		if err := doc.ValidateResponse(op, status, c.Response().Body()); err != nil {
+			report(Violation{Method: c.Method(), Path: c.Path(), Status: status, Err: err})
+		}
+		return nil
+	}
+}
@@ -0,0 +1,195 @@
+// Package openapi describes the player-facing API as an OpenAPI 3.0 document generated from the handler DTOs
+// The document is built by reflecting over the request and response types each route is declared with,
+// so it cannot drift from what the handlers encode. api/openapi.json is the generated copy the frontend
+// builds against; Contract checks live responses against the same schemas in tests.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Version is the OpenAPI version of the generated document
+const Version = "3.0.3"
+
+// SpecFile is the generated document's path relative to the backend module root
+const SpecFile = "api/openapi.json"
+
+// jsonContentType is the media type request and response schemas are documented under
+const jsonContentType = "application/json"
+
+// sessionSecurity names the bearer session token scheme of authenticated routes
+const sessionSecurity = "session"
+
+// Document is an OpenAPI document
+type Document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       Info                             `json:"info"`
+	Paths      map[string]map[string]*Operation `json:"paths"` // Path template -> lower-case method -> operation
+	Components Components                       `json:"components"`
+}
+
+// Info describes the API
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Components holds the named schemas operations refer to
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme describes how a request authenticates
+type SecurityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme,omitempty"`
+}
+
+// Operation is one method on one path
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Security    []map[string][]string `json:"security,omitempty"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"` // Status code or "default" -> response
+}
+
+// Parameter is a path parameter
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody is the JSON body an operation accepts
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response is a response an operation answers with
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a body
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Route declares an operation by the DTOs its handler decodes and encodes
+// Paths use Fiber's :param syntax; Request is nil for routes without a body.
+type Route struct {
+	Method    string
+	Path      string
+	Name      string // operationId
+	Summary   string
+	Tag       string
+	Auth      bool        // Requires a session token
+	Request   any         // Zero value of the request DTO
+	Responses map[int]any // Status -> zero value of the response DTO, or OneOf
+}
+
+// OneOf declares a response that is any one of several DTOs, such as a negotiated format
+type OneOf []any
+
+// Build generates the document for a set of routes
+// Every operation also documents ErrorResponse as its default response.
+func Build(info Info, routes []Route, errorResponse any) (*Document, error) {
+	r := newReflector()
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   make(map[string]map[string]*Operation),
+		Components: Components{
+			SecuritySchemes: map[string]*SecurityScheme{
+				sessionSecurity: {Type: "http", Scheme: "bearer"},
+			},
+		},
+	}
+
+	seen := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		if seen[route.Name] {
+			return nil, fmt.Errorf("operation %q is declared twice", route.Name)
+		}
+		seen[route.Name] = true
+
+		path, params := pathTemplate(route.Path)
+		method := strings.ToLower(route.Method)
+		if doc.Paths[path][method] != nil {
+			return nil, fmt.Errorf("%s %s is declared twice", route.Method, path)
+		}
+
+		op := &Operation{
+			OperationID: route.Name,
+			Summary:     route.Summary,
+			Responses:   make(map[string]*Response, len(route.Responses)+1),
+		}
+		if route.Tag != "" {
+			op.Tags = []string{route.Tag}
+		}
+		if route.Auth {
+			op.Security = []map[string][]string{{sessionSecurity: {}}}
+		}
+		for _, name := range params {
+			op.Parameters = append(op.Parameters, &Parameter{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"}})
+		}
+		if route.Request != nil {
+			op.RequestBody = &RequestBody{Required: true, Content: jsonContent(r.schema(reflect.TypeOf(route.Request)))}
+		}
+		for status, body := range route.Responses {
+			op.Responses[strconv.Itoa(status)] = &Response{Description: http.StatusText(status), Content: jsonContent(r.bodySchema(body))}
+		}
+		op.Responses["default"] = &Response{Description: "Error", Content: jsonContent(r.bodySchema(errorResponse))}
+
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]*Operation)
+		}
+		doc.Paths[path][method] = op
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+	doc.Components.Schemas = r.components
+	return doc, nil
+}
+
+// MarshalIndent encodes the document as it is committed: indented, with a trailing newline
+func (d *Document) MarshalIndent() ([]byte, error) {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// pathTemplate turns a Fiber path into an OpenAPI template and lists its parameters
+func pathTemplate(path string) (string, []string) {
+	segments := strings.Split(path, "/")
+	var params []string
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			name := strings.TrimSuffix(segment[1:], "?")
+			params = append(params, name)
+			segments[i] = "{" + name + "}"
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{jsonContentType: {Schema: schema}}
+}
@@ -0,0 +1,169 @@
+package openapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testError struct {
+	Error   string `json:"error"`
+	Details any    `json:"details,omitempty"`
+}
+
+type testEmbedded struct {
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type testItem struct {
+	testEmbedded
+	ID       uuid.UUID      `json:"id"`
+	Count    int            `json:"count"`
+	Amount   float64        `json:"amount"`
+	Note     *string        `json:"note"`
+	Tags     []string       `json:"tags,omitempty"`
+	Pair     [2]int         `json:"pair"`
+	Meta     map[string]int `json:"meta,omitempty"`
+	Parent   *testItem      `json:"parent,omitempty"`
+	internal string
+	Skipped  string            `json:"-"`
+	Labels   map[string]string `json:"labels,omitempty"`
+}
+
+type testCompact struct {
+	Format string `json:"format"`
+}
+
+func testDocument(t *testing.T) *Document {
+	t.Helper()
+	doc, err := Build(Info{Title: "test", Version: "1"}, []Route{
+		{Method: "GET", Path: "/items/:id", Name: "getItem", Responses: map[int]any{200: testItem{}}},
+		{Method: "GET", Path: "/items/latest", Name: "getLatest", Responses: map[int]any{200: OneOf{testItem{}, testCompact{}}}},
+		{Method: "POST", Path: "/items", Name: "createItem", Auth: true, Request: testItem{}, Responses: map[int]any{201: testItem{}}},
+	}, testError{})
+	require.NoError(t, err)
+	return doc
+}
+
+func TestSpecIsCurrent(t *testing.T) {
+	doc, err := Spec()
+	require.NoError(t, err)
+	data, err := doc.MarshalIndent()
+	require.NoError(t, err)
+
+	checkedIn, err := os.ReadFile(filepath.Join("..", "..", "..", SpecFile))
+	require.NoError(t, err)
+	assert.Equal(t, string(data), string(checkedIn), "DTOs or routes changed: run make openapi-spec")
+}
+
+func TestBuild_Schemas(t *testing.T) {
+	doc := testDocument(t)
+
+	item := doc.Components.Schemas["testItem"]
+	require.NotNil(t, item)
+	assert.Equal(t, "object", item.Type)
+	assert.Equal(t, false, item.AdditionalProperties, "objects are closed")
+	assert.ElementsMatch(t, []string{"created_at", "id", "count", "amount", "note", "pair"}, item.Required)
+	assert.NotContains(t, item.Properties, "internal")
+	assert.NotContains(t, item.Properties, "Skipped")
+
+	assert.Equal(t, &Schema{Type: "string", Format: "date-time"}, item.Properties["created_at"], "embedded fields are flattened")
+	assert.Equal(t, &Schema{Type: "string", Format: "uuid"}, item.Properties["id"])
+	assert.Equal(t, "integer", item.Properties["count"].Type)
+	assert.Equal(t, "number", item.Properties["amount"].Type)
+	assert.True(t, item.Properties["note"].Nullable)
+	assert.True(t, item.Properties["tags"].Nullable, "nil slices encode as null")
+	assert.Equal(t, 2, *item.Properties["pair"].MinItems)
+	assert.Equal(t, 2, *item.Properties["pair"].MaxItems)
+	assert.Equal(t, "integer", item.Properties["meta"].AdditionalProperties.(*Schema).Type)
+	assert.Equal(t, []*Schema{{Ref: refPrefix + "testItem"}}, item.Properties["parent"].AllOf, "recursive types refer to themselves")
+
+	op := doc.Paths["/items/{id}"]["get"]
+	require.NotNil(t, op)
+	require.Len(t, op.Parameters, 1)
+	assert.Equal(t, "id", op.Parameters[0].Name)
+	assert.Contains(t, op.Responses, "default")
+
+	create := doc.Paths["/items"]["post"]
+	require.NotNil(t, create.RequestBody)
+	assert.Equal(t, []map[string][]string{{sessionSecurity: {}}}, create.Security)
+	assert.Len(t, doc.Paths["/items/latest"]["get"].Responses["200"].Content[jsonContentType].Schema.OneOf, 2)
+}
+
+func TestBuild_RejectsConflicts(t *testing.T) {
+	_, err := Build(Info{}, []Route{
+		{Method: "GET", Path: "/a", Name: "same", Responses: map[int]any{200: testCompact{}}},
+		{Method: "GET", Path: "/b", Name: "same", Responses: map[int]any{200: testCompact{}}},
+	}, testError{})
+	assert.ErrorContains(t, err, "declared twice")
+
+	_, err = Build(Info{}, []Route{
+		{Method: "GET", Path: "/a", Name: "channel", Responses: map[int]any{200: struct{ C chan int }{}}},
+	}, testError{})
+	assert.ErrorContains(t, err, "no JSON encoding")
+}
+
+func TestDocument_Operation(t *testing.T) {
+	doc := testDocument(t)
+
+	template, op := doc.Operation("GET", "/items/latest")
+	assert.Equal(t, "/items/latest", template, "literal segments win over parameters")
+	assert.Equal(t, "getLatest", op.OperationID)
+
+	template, op = doc.Operation("GET", "/items/42")
+	assert.Equal(t, "/items/{id}", template)
+	assert.Equal(t, "getItem", op.OperationID)
+
+	_, op = doc.Operation("DELETE", "/items/42")
+	assert.Nil(t, op)
+	_, op = doc.Operation("GET", "/items/42/extra")
+	assert.Nil(t, op)
+}
+
+func TestDocument_ValidateResponse(t *testing.T) {
+	doc := testDocument(t)
+	_, op := doc.Operation("GET", "/items/1")
+	valid := `{"created_at":"2026-01-02T03:04:05Z","id":"5f1c0a7e-0000-4000-8000-000000000001","count":3,"amount":1.5,"note":null,"pair":[1,2]}`
+
+	require.NoError(t, doc.ValidateResponse(op, 200, []byte(valid)))
+	require.NoError(t, doc.ValidateResponse(op, 200, []byte(`{"created_at":"2026-01-02T03:04:05Z","id":"5f1c0a7e-0000-4000-8000-000000000001","count":3,"amount":1,"note":"hi","pair":[1,2],"tags":null,"meta":{"a":1},"parent":{"created_at":"2026-01-02T03:04:05Z","id":"5f1c0a7e-0000-4000-8000-000000000002","count":0,"amount":0,"note":null,"pair":[0,0]}}`)))
+	require.NoError(t, doc.ValidateResponse(op, 404, []byte(`{"error":"not_found","details":{"any":["thing"]}}`)), "errors fall back to the default response")
+
+	invalid := map[string]struct {
+		body string
+		want string
+	}{
+		"missing property":     {`{"id":"5f1c0a7e-0000-4000-8000-000000000001"}`, `missing required property "created_at"`},
+		"undocumented field":   {valid[:len(valid)-1] + `,"extra":1}`, `undocumented property "extra"`},
+		"wrong type":           {`{"created_at":"2026-01-02T03:04:05Z","id":"5f1c0a7e-0000-4000-8000-000000000001","count":"3","amount":1.5,"note":null,"pair":[1,2]}`, "$.count: expected integer, got string"},
+		"fraction for integer": {`{"created_at":"2026-01-02T03:04:05Z","id":"5f1c0a7e-0000-4000-8000-000000000001","count":1.5,"amount":1.5,"note":null,"pair":[1,2]}`, "is not an integer"},
+		"bad format":           {`{"created_at":"yesterday","id":"5f1c0a7e-0000-4000-8000-000000000001","count":3,"amount":1.5,"note":null,"pair":[1,2]}`, "is not a valid date-time"},
+		"null not allowed":     {`{"created_at":"2026-01-02T03:04:05Z","id":"5f1c0a7e-0000-4000-8000-000000000001","count":null,"amount":1.5,"note":null,"pair":[1,2]}`, "$.count: null is not allowed"},
+		"fixed length array":   {`{"created_at":"2026-01-02T03:04:05Z","id":"5f1c0a7e-0000-4000-8000-000000000001","count":3,"amount":1.5,"note":null,"pair":[1]}`, "out of bounds"},
+		"nested value":         {`{"created_at":"2026-01-02T03:04:05Z","id":"5f1c0a7e-0000-4000-8000-000000000001","count":3,"amount":1.5,"note":null,"pair":[1,2],"meta":{"a":"b"}}`, "$.meta.a: expected integer"},
+		"not json":             {`<html>`, "body is not JSON"},
+	}
+	for name, tc := range invalid {
+		err := doc.ValidateResponse(op, 200, []byte(tc.body))
+		if assert.Error(t, err, name) {
+			assert.Contains(t, err.Error(), tc.want, name)
+		}
+	}
+
+	assert.ErrorIs(t, doc.ValidateResponse(op, 204, nil), ErrUndocumented)
+
+	_, latest := doc.Operation("GET", "/items/latest")
+	require.NoError(t, doc.ValidateResponse(latest, 200, []byte(`{"format":"compact"}`)), "any one variant matches")
+	assert.ErrorContains(t, doc.ValidateResponse(latest, 200, []byte(`{"format":1}`)), "matches none of its variants")
+}
+
+func TestPathTemplate(t *testing.T) {
+	template, params := pathTemplate("/v1/session/:sessionId/end")
+	assert.Equal(t, "/v1/session/{sessionId}/end", template)
+	assert.Equal(t, []string{"sessionId"}, params)
+}
@@ -0,0 +1,150 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/internal/api/dto"
+)
+
+// Tags group the operations by the part of the game client that calls them
+const (
+	tagAuth         = "auth"
+	tagPlayer       = "player"
+	tagSession      = "session"
+	tagSpins        = "spins"
+	tagFreeSpins    = "free-spins"
+	tagProvablyFair = "provably-fair"
+	tagGame         = "game"
+	tagTrial        = "trial"
+	tagTransparency = "transparency"
+)
+
+// spinResponse is a spin in the negotiated JSON format: full, or compact with ?format=compact
+var spinResponse = OneOf{dto.SpinResponse{}, dto.CompactSpinResponse{}}
+
+// Routes are the player-facing operations the game client calls
+// Admin and operator routes are out of scope. Keep this in step with server.SetupRoutes, then
+// regenerate the checked-in document with make openapi-spec.
+var Routes = []Route{
+	// Auth
+	{Method: http.MethodPost, Path: "/v1/auth/register", Name: "register", Summary: "Register a player", Tag: tagAuth,
+		Request: dto.RegisterRequest{}, Responses: map[int]any{http.StatusCreated: dto.RegisterResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/auth/login", Name: "login", Summary: "Log in and open a session", Tag: tagAuth,
+		Request: dto.LoginRequest{}, Responses: map[int]any{http.StatusOK: dto.AuthResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/auth/refresh", Name: "refresh", Summary: "Exchange a refresh token for a new session", Tag: tagAuth,
+		Request: dto.RefreshRequest{}, Responses: map[int]any{http.StatusOK: dto.AuthResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/auth/revoke", Name: "revokeRefreshToken", Summary: "Revoke a refresh token", Tag: tagAuth,
+		Request: dto.RefreshRequest{}, Responses: map[int]any{http.StatusOK: dto.SuccessResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/auth/logout", Name: "logout", Summary: "End the current session", Tag: tagAuth, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.SuccessResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/auth/logout-all", Name: "logoutEverywhere", Summary: "End every session of the player", Tag: tagAuth, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.SuccessResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/auth/launch", Name: "redeemLaunch", Summary: "Redeem an operator launch token", Tag: tagAuth,
+		Request: dto.RedeemLaunchRequest{}, Responses: map[int]any{http.StatusOK: dto.AuthResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/auth/trial", Name: "startTrial", Summary: "Start a trial session", Tag: tagTrial,
+		Request: dto.StartTrialRequest{}, Responses: map[int]any{http.StatusOK: dto.TrialResponse{}}},
+
+	// Player
+	{Method: http.MethodGet, Path: "/v1/player/balance", Name: "getBalance", Summary: "Current balance", Tag: tagPlayer, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.GetBalanceResponse{}}},
+	{Method: http.MethodGet, Path: "/v1/player/features", Name: "getFeatures", Summary: "Feature flags enabled for the player", Tag: tagPlayer, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.FeaturesResponse{}}},
+
+	// Game session
+	{Method: http.MethodPost, Path: "/v1/session/start", Name: "startSession", Summary: "Start a game session", Tag: tagSession, Auth: true,
+		Request: dto.StartSessionRequest{}, Responses: map[int]any{http.StatusCreated: dto.SessionResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/session/:sessionId/end", Name: "endSession", Summary: "End a game session", Tag: tagSession, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.SessionResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/session/:sessionId/reality-check", Name: "acknowledgeRealityCheck", Summary: "Acknowledge a reality check", Tag: tagSession, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.SessionResponse{}}},
+	{Method: http.MethodGet, Path: "/v1/session/history", Name: "getSessionHistory", Summary: "Past game sessions", Tag: tagSession, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.SessionHistoryResponse{}}},
+
+	// Spins
+	{Method: http.MethodGet, Path: "/v1/initial-grid", Name: "getInitialGrid", Summary: "Grid to show before the first spin", Tag: tagSpins,
+		Responses: map[int]any{http.StatusOK: dto.InitialGridResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/base-spins/spin", Name: "executeSpin", Summary: "Play a base game spin", Tag: tagSpins, Auth: true,
+		Request: dto.ExecuteSpinRequest{}, Responses: map[int]any{http.StatusOK: spinResponse}},
+	{Method: http.MethodGet, Path: "/v1/base-spins/histories", Name: "getSpinHistory", Summary: "Past spins", Tag: tagSpins, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.SpinHistoryResponse{}}},
+
+	// Free spins
+	{Method: http.MethodGet, Path: "/v1/free-spins/status", Name: "getFreeSpinsStatus", Summary: "Active free spins session", Tag: tagFreeSpins, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.FreeSpinsStatusResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/free-spins/spin", Name: "executeFreeSpin", Summary: "Play a free spin", Tag: tagFreeSpins, Auth: true,
+		Request: dto.ExecuteFreeSpinRequest{}, Responses: map[int]any{http.StatusOK: spinResponse}},
+	{Method: http.MethodPost, Path: "/v1/free-spins/autoplay", Name: "executeAllFreeSpins", Summary: "Play every remaining free spin", Tag: tagFreeSpins, Auth: true,
+		Request: dto.ExecuteAllFreeSpinsRequest{}, Responses: map[int]any{http.StatusOK: dto.FreeSpinsAutoplayResponse{}}},
+
+	// Provably fair
+	{Method: http.MethodPost, Path: "/v1/pf/sessions", Name: "startPFSession", Summary: "Commit to a server seed", Tag: tagProvablyFair, Auth: true,
+		Request: dto.StartPFSessionRequest{}, Responses: map[int]any{http.StatusCreated: dto.StartPFSessionResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/pf/sessions/end", Name: "endPFSession", Summary: "End the session and reveal its server seed", Tag: tagProvablyFair, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.EndPFSessionResponse{}}},
+	{Method: http.MethodGet, Path: "/v1/pf/sessions/status", Name: "getPFSessionStatus", Summary: "Current provably fair session", Tag: tagProvablyFair, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.PFSessionStatusResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/pf/sessions/verify-spin", Name: "verifyActiveSpin", Summary: "Verify a spin of the active session", Tag: tagProvablyFair, Auth: true,
+		Request: dto.VerifyActiveSpinRequest{}, Responses: map[int]any{http.StatusOK: dto.VerifyActiveSpinResponse{}}},
+	{Method: http.MethodGet, Path: "/v1/pf/verify/signing-key", Name: "getSigningKey", Summary: "Public key spin responses are signed with", Tag: tagProvablyFair,
+		Responses: map[int]any{http.StatusOK: dto.SpinSigningKeyResponse{}}},
+	{Method: http.MethodGet, Path: "/v1/pf/verify/:sessionId", Name: "getVerificationData", Summary: "Verification data of an ended session", Tag: tagProvablyFair,
+		Responses: map[int]any{http.StatusOK: dto.VerificationDataResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/pf/verify/spin", Name: "verifySpin", Summary: "Verify a spin hash", Tag: tagProvablyFair,
+		Request: dto.VerifySpinRequest{}, Responses: map[int]any{http.StatusOK: dto.VerifySpinResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/pf/verify/spin-with-reel", Name: "verifySpinWithReel", Summary: "Verify a spin hash and its reel positions", Tag: tagProvablyFair,
+		Request: dto.VerifySpinWithReelRequest{}, Responses: map[int]any{http.StatusOK: dto.VerifySpinWithReelResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/pf/verify/:sessionId", Name: "verifySession", Summary: "Verify a session's hash chain", Tag: tagProvablyFair,
+		Request: dto.VerifySessionRequest{}, Responses: map[int]any{http.StatusOK: dto.VerifySessionResponse{}}},
+
+	// Game content
+	{Method: http.MethodGet, Path: "/v1/game-assets", Name: "getGameAssets", Summary: "Assets of the active game", Tag: tagGame,
+		Responses: map[int]any{http.StatusOK: game.GameAssetsResponse{}}},
+	{Method: http.MethodGet, Path: "/v1/games/:id/assets/manifest", Name: "getAssetManifest", Summary: "Asset manifest of a game", Tag: tagGame,
+		Responses: map[int]any{http.StatusOK: game.AssetManifestResponse{}}},
+	{Method: http.MethodGet, Path: "/v1/games/:id/paytable", Name: "getPaytable", Summary: "Paytable of a game", Tag: tagGame,
+		Responses: map[int]any{http.StatusOK: dto.PaytableResponse{}}},
+	{Method: http.MethodGet, Path: "/v1/translations", Name: "getTranslations", Summary: "Client strings for the request locale", Tag: tagGame,
+		Responses: map[int]any{http.StatusOK: dto.TranslationsResponse{}}},
+
+	// Trial
+	{Method: http.MethodGet, Path: "/v1/trial/profile", Name: "getTrialProfile", Summary: "Trial profile", Tag: tagTrial, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.TrialProfile{}}},
+	{Method: http.MethodGet, Path: "/v1/trial/balance", Name: "getTrialBalance", Summary: "Trial balance", Tag: tagTrial, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.TrialBalanceResponse{}}},
+	{Method: http.MethodGet, Path: "/v1/trial/features", Name: "getTrialFeatures", Summary: "Feature flags enabled in trial mode", Tag: tagTrial, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.FeaturesResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/trial/balance/reset", Name: "resetTrialBalance", Summary: "Reset the trial balance", Tag: tagTrial, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.TrialBalanceResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/trial/balance/top-up", Name: "topUpTrialBalance", Summary: "Top up the trial balance", Tag: tagTrial, Auth: true,
+		Request: dto.TopUpTrialBalanceRequest{}, Responses: map[int]any{http.StatusOK: dto.TrialBalanceResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/trial/convert", Name: "convertTrial", Summary: "Convert the trial into a registered player", Tag: tagTrial, Auth: true,
+		Request: dto.ConvertTrialRequest{}, Responses: map[int]any{http.StatusCreated: dto.ConvertTrialResponse{}}},
+	{Method: http.MethodGet, Path: "/v1/trial/player/balance", Name: "getTrialPlayerBalance", Summary: "Trial balance in the player format", Tag: tagTrial, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.GetBalanceResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/trial/session/start", Name: "startTrialSession", Summary: "Start a trial game session", Tag: tagTrial, Auth: true,
+		Request: dto.StartSessionRequest{}, Responses: map[int]any{http.StatusCreated: dto.SessionResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/trial/spin", Name: "executeTrialSpin", Summary: "Play a trial spin", Tag: tagTrial, Auth: true,
+		Request: dto.ExecuteSpinRequest{}, Responses: map[int]any{http.StatusOK: spinResponse}},
+	{Method: http.MethodGet, Path: "/v1/trial/free-spins/status", Name: "getTrialFreeSpinsStatus", Summary: "Active trial free spins", Tag: tagTrial, Auth: true,
+		Responses: map[int]any{http.StatusOK: dto.FreeSpinsStatusResponse{}}},
+	{Method: http.MethodPost, Path: "/v1/trial/free-spins/spin", Name: "executeTrialFreeSpin", Summary: "Play a trial free spin", Tag: tagTrial, Auth: true,
+		Request: dto.ExecuteFreeSpinRequest{}, Responses: map[int]any{http.StatusOK: spinResponse}},
+
+	// Transparency log
+	{Method: http.MethodGet, Path: "/v1/transparency/checkpoints", Name: "listCheckpoints", Summary: "Signed checkpoints of the spin hash log", Tag: tagTransparency,
+		Responses: map[int]any{http.StatusOK: dto.TransparencyCheckpointListResponse{}}},
+	{Method: http.MethodGet, Path: "/v1/transparency/checkpoints/:sequence", Name: "getCheckpoint", Summary: "A checkpoint with its leaves", Tag: tagTransparency,
+		Responses: map[int]any{http.StatusOK: dto.TransparencyCheckpointResponse{}}},
+	{Method: http.MethodGet, Path: "/v1/transparency/proof/:spinId", Name: "getInclusionProof", Summary: "Merkle inclusion proof of a spin", Tag: tagTransparency,
+		Responses: map[int]any{http.StatusOK: dto.TransparencyProofResponse{}}},
+}
+
+// Spec builds the document of Routes
+func Spec() (*Document, error) {
+	return Build(Info{
+		Title:       "Slot Machine Player API",
+		Version:     "1.0.0",
+		Description: "Player-facing API of the slot machine backend, generated from the handler DTOs.",
+	}, Routes, dto.ErrorResponse{})
+}
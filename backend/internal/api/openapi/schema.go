@@ -0,0 +1,192 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Schema is an OpenAPI 3.0 schema object, limited to what encoding/json produces from Go types
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties any                `json:"additionalProperties,omitempty"` // false, or the schema of every value
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+}
+
+// refPrefix is where component schemas are referenced from
+const refPrefix = "#/components/schemas/"
+
+// Types whose JSON encoding is not their Go structure
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	uuidType       = reflect.TypeOf(uuid.UUID{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// reflector derives schemas from Go types, collecting structs as named components
+type reflector struct {
+	components map[string]*Schema
+	types      map[string]reflect.Type // Component name -> the type it was derived from
+	err        error
+}
+
+func newReflector() *reflector {
+	return &reflector{components: make(map[string]*Schema), types: make(map[string]reflect.Type)}
+}
+
+// bodySchema is the schema of a declared body: a DTO zero value, or OneOf several
+func (r *reflector) bodySchema(body any) *Schema {
+	if variants, ok := body.(OneOf); ok {
+		s := &Schema{}
+		for _, v := range variants {
+			s.OneOf = append(s.OneOf, r.schema(reflect.TypeOf(v)))
+		}
+		return s
+	}
+	return r.schema(reflect.TypeOf(body))
+}
+
+// schema derives the schema encoding/json follows for a type
+func (r *reflector) schema(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case uuidType:
+		return &Schema{Type: "string", Format: "uuid"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return nullable(r.schema(t.Elem()))
+	case reflect.Interface:
+		return &Schema{}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte", Nullable: true}
+		}
+		// A nil slice encodes as null
+		return &Schema{Type: "array", Items: r.schema(t.Elem()), Nullable: true}
+	case reflect.Array:
+		n := t.Len()
+		return &Schema{Type: "array", Items: r.schema(t.Elem()), MinItems: &n, MaxItems: &n}
+	case reflect.Map:
+		// A nil map encodes as null
+		return &Schema{Type: "object", AdditionalProperties: r.schema(t.Elem()), Nullable: true}
+	case reflect.Struct:
+		return r.component(t)
+	default:
+		r.fail(fmt.Errorf("%s: %s values have no JSON encoding", t, t.Kind()))
+		return &Schema{}
+	}
+}
+
+// component registers a struct as a named schema and refers to it
+func (r *reflector) component(t reflect.Type) *Schema {
+	name := t.Name()
+	if name == "" {
+		return r.object(t)
+	}
+	ref := &Schema{Ref: refPrefix + name}
+	if existing, ok := r.types[name]; ok {
+		if existing != t {
+			r.fail(fmt.Errorf("schema %s names both %s and %s", name, existing, t))
+		}
+		return ref
+	}
+	// Registered before its fields, so recursive types refer to themselves
+	r.types[name] = t
+	r.components[name] = &Schema{}
+	*r.components[name] = *r.object(t)
+	return ref
+}
+
+// object derives a struct's schema from its encoded fields
+// Objects are closed: a field the schema does not list is drift.
+func (r *reflector) object(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema), AdditionalProperties: false}
+	r.fields(t, s)
+	return s
+}
+
+// fields adds a struct's encoded fields to an object schema, flattening embedded structs as encoding/json does
+func (r *reflector) fields(t reflect.Type, s *Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				r.fields(ft, s)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		s.Properties[name] = r.schema(f.Type)
+		if !hasOption(opts, "omitempty") && !hasOption(opts, "omitzero") {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// nullable marks a schema as also accepting null; references are wrapped, as 3.0 ignores siblings of $ref
+func nullable(s *Schema) *Schema {
+	if s.Ref != "" {
+		return &Schema{AllOf: []*Schema{s}, Nullable: true}
+	}
+	s.Nullable = true
+	return s
+}
+
+func hasOption(opts, option string) bool {
+	for _, o := range strings.Split(opts, ",") {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *reflector) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
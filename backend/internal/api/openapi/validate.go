@@ -0,0 +1,240 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrUndocumented is returned for a response whose status the operation does not document
+var ErrUndocumented = errors.New("response status is not documented")
+
+// Operation finds the operation a request is served by
+// Literal segments take precedence over parameters, as in the router.
+func (d *Document) Operation(method, path string) (string, *Operation) {
+	method = strings.ToLower(method)
+	segments := strings.Split(strings.TrimSuffix(path, "/"), "/")
+
+	best, bestParams := "", -1
+	for template, ops := range d.Paths {
+		if ops[method] == nil {
+			continue
+		}
+		params, ok := matchTemplate(strings.Split(template, "/"), segments)
+		if !ok {
+			continue
+		}
+		if bestParams < 0 || params < bestParams || (params == bestParams && template < best) {
+			best, bestParams = template, params
+		}
+	}
+	if bestParams < 0 {
+		return "", nil
+	}
+	return best, d.Paths[best][method]
+}
+
+// matchTemplate reports whether path segments fit a template, and how many parameters they filled
+func matchTemplate(template, segments []string) (int, bool) {
+	if len(template) != len(segments) {
+		return 0, false
+	}
+	params := 0
+	for i, t := range template {
+		if strings.HasPrefix(t, "{") {
+			if segments[i] == "" {
+				return 0, false
+			}
+			params++
+			continue
+		}
+		if t != segments[i] {
+			return 0, false
+		}
+	}
+	return params, true
+}
+
+// ValidateResponse checks a JSON response body against the schema an operation documents for its status
+func (d *Document) ValidateResponse(op *Operation, status int, body []byte) error {
+	resp := op.Responses[strconv.Itoa(status)]
+	if resp == nil && status >= 400 {
+		resp = op.Responses["default"]
+	}
+	if resp == nil {
+		return fmt.Errorf("%w: %d", ErrUndocumented, status)
+	}
+	media := resp.Content[jsonContentType]
+	if media == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return fmt.Errorf("body is not JSON: %w", err)
+	}
+	return d.validate(media.Schema, value, "$")
+}
+
+// validate checks a decoded JSON value against a schema, naming the first mismatch by its JSON path
+func (d *Document) validate(s *Schema, value any, path string) error {
+	if s.Ref != "" {
+		target := d.Components.Schemas[strings.TrimPrefix(s.Ref, refPrefix)]
+		if target == nil {
+			return fmt.Errorf("%s: unknown schema %s", path, s.Ref)
+		}
+		return d.validate(target, value, path)
+	}
+	if value == nil {
+		if s.Nullable || s.Type == "" && s.AllOf == nil && s.OneOf == nil {
+			return nil
+		}
+		return fmt.Errorf("%s: null is not allowed", path)
+	}
+
+	for _, sub := range s.AllOf {
+		if err := d.validate(sub, value, path); err != nil {
+			return err
+		}
+	}
+	if len(s.OneOf) > 0 {
+		var errs []string
+		for _, sub := range s.OneOf {
+			err := d.validate(sub, value, path)
+			if err == nil {
+				break
+			}
+			errs = append(errs, err.Error())
+		}
+		if len(errs) == len(s.OneOf) {
+			return fmt.Errorf("%s: matches none of its variants (%s)", path, strings.Join(errs, "; "))
+		}
+	}
+
+	switch s.Type {
+	case "":
+		return nil
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return typeError(path, s, value)
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return typeError(path, s, value)
+		}
+		return validateFormat(s.Format, str, path)
+	case "integer", "number":
+		n, ok := value.(json.Number)
+		if !ok {
+			return typeError(path, s, value)
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if s.Type == "integer" && f != math.Trunc(f) {
+			return fmt.Errorf("%s: %s is not an integer", path, n)
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			return typeError(path, s, value)
+		}
+		if s.MinItems != nil && len(items) < *s.MinItems || s.MaxItems != nil && len(items) > *s.MaxItems {
+			return fmt.Errorf("%s: %d items is out of bounds", path, len(items))
+		}
+		for i, item := range items {
+			if err := d.validate(s.Items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return typeError(path, s, value)
+		}
+		return d.validateObject(s, obj, path)
+	}
+	return nil
+}
+
+// validateObject checks required, documented and additional properties
+func (d *Document) validateObject(s *Schema, obj map[string]any, path string) error {
+	for _, name := range s.Required {
+		if _, ok := obj[name]; !ok {
+			return fmt.Errorf("%s: missing required property %q", path, name)
+		}
+	}
+
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		sub := s.Properties[k]
+		if sub == nil {
+			switch extra := s.AdditionalProperties.(type) {
+			case bool:
+				if !extra {
+					return fmt.Errorf("%s: undocumented property %q", path, k)
+				}
+				continue
+			case *Schema:
+				sub = extra
+			default:
+				continue
+			}
+		}
+		if err := d.validate(sub, obj[k], path+"."+k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateFormat(format, value, path string) error {
+	var err error
+	switch format {
+	case "date-time":
+		_, err = time.Parse(time.RFC3339Nano, value)
+	case "uuid":
+		_, err = uuid.Parse(value)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %q is not a valid %s", path, value, format)
+	}
+	return nil
+}
+
+func typeError(path string, s *Schema, value any) error {
+	return fmt.Errorf("%s: expected %s, got %s", path, s.Type, jsonType(value))
+}
+
+func jsonType(value any) string {
+	switch value.(type) {
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -21,7 +22,39 @@ type Config struct {
 	Trial        TrialConfig
 	Game         GameConfig
 	Storage      StorageConfig
+	Imaging      ImagingConfig
 	ProvablyFair ProvablyFairConfig
+	RNG          RNGConfig
+	Clock        ClockConfig
+	VIP          VIPConfig
+	BigWin       BigWinConfig
+	SpinFeed     SpinFeedConfig
+	Jurisdiction JurisdictionConfig
+	KYC          KYCConfig
+	FeatureFlags FeatureFlagsConfig
+	I18n         I18nConfig
+	Archive      ArchiveConfig
+	History      HistoryConfig
+	AdminAuth    AdminAuthConfig
+	PlayerAuth   PlayerAuthConfig
+	Launch       LaunchConfig
+	Transparency TransparencyConfig
+	CertLog      CertLogConfig
+	Shadow       ShadowConfig
+	Approval     ApprovalConfig
+	Canary       CanaryConfig
+	RTPMonitor   RTPMonitorConfig
+	Exposure     ExposureConfig
+	CacheWarm    CacheWarmConfig
+	Health       HealthConfig
+	Runtime      RuntimeConfig
+	SpinBatch    SpinBatchConfig
+	SpinPregen   SpinPregenConfig
+	Analytics    AnalyticsConfig
+
+	FinancialReport FinancialReportConfig
+	Dashboard       DashboardConfig
+	SessionExpiry   SessionExpiryConfig
 }
 
 // AppConfig holds application-level settings
@@ -42,6 +75,19 @@ type DatabaseConfig struct {
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+
+	// Comma-separated read replica hosts ("host" or "host:port"); credentials match the primary
+	ReplicaHosts string
+
+	// PartitionIntervalMinutes is how often spin table partitions are maintained (0 disables the maintainer)
+	PartitionIntervalMinutes int
+	// PartitionPremakeMonths is how many months ahead partitions are created
+	PartitionPremakeMonths int
+	// PartitionRetentionMonths is how many whole months stay attached before detaching (0 keeps all)
+	PartitionRetentionMonths int
+
+	// MigrationCheck is what startup does when the schema is not at the latest migration: fail, warn or off
+	MigrationCheck string
 }
 
 // RedisConfig holds Redis connection settings
@@ -59,6 +105,15 @@ type JWTConfig struct {
 	// ServiceToken is a static token for service-to-service auth (never expires)
 	// Used by internal services
 	ServiceToken string
+	// KeyEncryptionKey is the 32-byte key encrypting stored ES256 signing keys
+	KeyEncryptionKey string
+	// KeyRotationHours is how often a new signing key is rotated in (0 disables scheduled rotation)
+	KeyRotationHours int
+	// KeyRefreshSeconds is how often the key set is reloaded from the database; new keys
+	// are published two refresh intervals before they sign
+	KeyRefreshSeconds int
+	// AcceptLegacyHS256 keeps accepting kid-less HS256 tokens signed with Secret
+	AcceptLegacyHS256 bool
 }
 
 // LoggingConfig holds logging settings
@@ -112,6 +167,26 @@ type GameConfig struct {
 	DefaultBalance   float64
 	TargetRTP        float64
 	MaxWinMultiplier int
+	// BetChangePolicy is whether spins may change the session's bet: "allow" (default), "locked" or "decrease_only"
+	BetChangePolicy string
+	// AutoplayMaxSpins is the most spins a player may start autoplay for
+	AutoplayMaxSpins int
+	// ReconcileIntervalSeconds is how often settled spins with unresolved free spins triggers are repaired (0 disables)
+	ReconcileIntervalSeconds int
+	// ReconcileGraceSeconds leaves recent spins alone so in-flight requests can resolve them first
+	ReconcileGraceSeconds int
+	// ReconcileLookbackHours is how far back unresolved spins are picked up
+	ReconcileLookbackHours int
+	// BalanceLockTTLSeconds is how long a player's balance lock outlives a holder that never releases it
+	BalanceLockTTLSeconds int
+	// BalanceLockWaitMillis is how long a spin queues behind the player's in-flight spin before giving up
+	BalanceLockWaitMillis int
+	// BalanceRetryAttempts is how many times a spin that lost an optimistic lock race is retried
+	BalanceRetryAttempts int
+	// SpinNonceRequired rejects spin requests that do not carry a client nonce
+	SpinNonceRequired bool
+	// SpinTimeoutMillis bounds a spin from the balance lock wait to its commit (0 disables)
+	SpinTimeoutMillis int
 }
 
 // StorageConfig holds S3/MinIO/GCS storage settings
@@ -126,6 +201,24 @@ type StorageConfig struct {
 	BucketName string
 	UseSSL     bool
 	PublicURL  string
+	// SignedURLTTLMinutes is the lifetime of signed download URLs issued for private assets
+	SignedURLTTLMinutes int
+}
+
+// ImagingConfig holds settings for the uploaded image optimization worker
+type ImagingConfig struct {
+	// Workers is the number of concurrent image optimization jobs
+	Workers int
+	// VariantWidths is a comma-separated list of target widths in pixels (e.g. "480,960,1920")
+	// Widths larger than the source image are skipped (images are never upscaled)
+	VariantWidths string
+	// VariantFormats is a comma-separated list of output formats ("webp", "avif")
+	VariantFormats string
+	// Quality is the encoder quality (0-100)
+	Quality int
+	// CWebPPath and AvifEncPath point to the external encoder binaries
+	CWebPPath   string
+	AvifEncPath string
 }
 
 // ProvablyFairConfig holds provably fair gaming settings
@@ -133,6 +226,364 @@ type ProvablyFairConfig struct {
 	// EncryptionKey is the 32-byte key for AES-256-GCM encryption of server seeds
 	// Used to encrypt server_seed before storing in database for recovery
 	EncryptionKey string
+	// SigningKey is the 32-byte Ed25519 seed signing spin results (empty disables signing)
+	SigningKey string
+	// SweepIntervalSeconds is how often orphaned PF sessions are force-ended (0 disables the sweeper)
+	SweepIntervalSeconds int
+	// SessionIdleMinutes is how long a PF session may go without spins before it counts as orphaned
+	SessionIdleMinutes int
+	// StateFlushSpins batches session-state DB writes, flushing a session every N spins (0 writes every spin)
+	StateFlushSpins int
+	// StateFlushSeconds is the longest batched session state may wait before it is flushed
+	StateFlushSeconds int
+	// DualCommitmentRequired rejects PF sessions without a valid theta_commitment
+	DualCommitmentRequired bool
+	// DualCommitmentOperators overrides DualCommitmentRequired per operator as operator=true|false pairs
+	DualCommitmentOperators string
+	// AuditIntervalMinutes is how often ended sessions' hash chains are re-verified (0 disables the auditor)
+	AuditIntervalMinutes int
+	// AuditLookbackHours is how far back ended sessions are picked up for auditing
+	AuditLookbackHours int
+	// AuditAlertWebhookURL receives a signed JSON POST for every failed chain audit (optional)
+	AuditAlertWebhookURL    string
+	AuditAlertWebhookSecret string
+	// AuditAlertSlackWebhookURL is a Slack incoming webhook alerted on every failed chain audit (optional)
+	AuditAlertSlackWebhookURL string
+}
+
+// ClockConfig selects the clock services read the time from
+type ClockConfig struct {
+	// FakeStart (RFC 3339) stops the clock at that time for tests that move it forward by hand (never in production)
+	FakeStart string
+}
+
+// RNGConfig selects the random number source spins are drawn from
+type RNGConfig struct {
+	// Provider is "hkdf" (provably fair, default), "crypto", "deterministic" (never in production) or "hardware"
+	Provider string
+	// DeterministicSeed is mixed into every deterministic spin so test runs can be varied
+	DeterministicSeed int64
+	// HardwareURL is the hardware/QRNG entropy service endpoint
+	HardwareURL string
+	// HardwareAPIKey is sent as a bearer token to the entropy service (optional)
+	HardwareAPIKey         string
+	HardwareTimeoutSeconds int
+	// HardwareBlockBytes is how much entropy is fetched per request and buffered between spins
+	HardwareBlockBytes int
+}
+
+// CertLogConfig holds test lab (GLI/BMM) certification logging settings
+type CertLogConfig struct {
+	// Enabled records every spin's inputs, RNG draws and outputs in the append-only certification log
+	Enabled bool
+}
+
+// ShadowConfig holds shadow-mode engine comparison settings
+type ShadowConfig struct {
+	// Enabled replays a sample of spins through the candidate engine and reports differences
+	Enabled bool
+	// SampleRate is the fraction of spins replayed (0-1)
+	SampleRate float64
+	// AlertWebhookURL receives a signed JSON POST for every divergence (optional)
+	AlertWebhookURL    string
+	AlertWebhookSecret string
+	// AlertSlackWebhookURL is a Slack incoming webhook alerted on every divergence (optional)
+	AlertSlackWebhookURL string
+}
+
+// ApprovalConfig holds four-eyes approval settings for sensitive admin changes
+type ApprovalConfig struct {
+	// Required makes reel strip activations, defaults and operator RTP changes wait for a second admin
+	// (balance adjustments always do)
+	Required bool
+	// TTLHours is how long a proposed change may wait for review before it expires
+	TTLHours int
+	// ExpiryIntervalMinutes is how often stale proposals are expired (0 disables the expiry worker)
+	ExpiryIntervalMinutes int
+	// WebhookURL receives a signed JSON POST for every proposed, reviewed or expired change (optional)
+	WebhookURL    string
+	WebhookSecret string
+	// SlackWebhookURL is a Slack incoming webhook told about every proposed, reviewed or expired change (optional)
+	SlackWebhookURL string
+}
+
+// CanaryConfig holds reel strip canary rollout settings
+type CanaryConfig struct {
+	// MonitorIntervalMinutes is how often running canaries are checked (0 disables the monitor)
+	MonitorIntervalMinutes int
+	// DefaultPercent is the share of players routed to a canary started without one
+	DefaultPercent int
+	// DefaultTrialHours is how long a canary started without a trial period runs
+	DefaultTrialHours int
+	// DefaultMaxRTPDeviation is the tolerated gap, in RTP points, between realized and target RTP
+	DefaultMaxRTPDeviation float64
+	// DefaultMinSpins is how many spins a canary needs before its realized RTP is judged
+	DefaultMinSpins int
+	// AlertWebhookURL receives a signed JSON POST for every promoted, reverted or aborted canary (optional)
+	AlertWebhookURL    string
+	AlertWebhookSecret string
+	// AlertSlackWebhookURL is a Slack incoming webhook told about every finished canary (optional)
+	AlertSlackWebhookURL string
+}
+
+// RTPMonitorConfig holds settings for alerting on configs whose realized RTP drifts from target
+type RTPMonitorConfig struct {
+	// IntervalMinutes is how often realized RTP is checked (0 disables the monitor)
+	IntervalMinutes int
+	// WindowHours is the rolling window realized RTP is measured over
+	WindowHours int
+	// MinSpins is how many spins a config needs in the window before its RTP is judged
+	MinSpins int
+	// ZScore is how many standard errors realized RTP may stray from target before alerting
+	ZScore float64
+	// CooldownHours is how long after an alert the same config is not alerted on again
+	CooldownHours int
+	// AlertWebhookURL receives a signed JSON POST for every RTP alert (optional)
+	AlertWebhookURL    string
+	AlertWebhookSecret string
+	// AlertSlackWebhookURL is a Slack incoming webhook alerted on every RTP alert (optional)
+	AlertSlackWebhookURL string
+}
+
+// ExposureConfig holds open liability monitoring and circuit breaker settings
+type ExposureConfig struct {
+	// MonitorIntervalSeconds is how often open liability is measured (0 disables the monitor and its breakers)
+	MonitorIntervalSeconds int
+	// MaxLiability pauses bonus buys while open free spins could pay more than this at the max win cap (0 disables)
+	MaxLiability float64
+	// MaxActiveFreeSpinsSessions pauses bonus buys while more free spins sessions are active (0 disables)
+	MaxActiveFreeSpinsSessions int
+}
+
+// CacheWarmConfig holds reel strip cache warming settings
+type CacheWarmConfig struct {
+	// Enabled preloads reel strip configs into the cache at startup and after config changes
+	Enabled bool
+	// DebounceMillis is how long to wait after a config change before re-warming
+	DebounceMillis int
+}
+
+// HealthConfig holds health and readiness probe settings
+type HealthConfig struct {
+	// CheckTimeoutMillis bounds each dependency check; keep it under the probe timeout
+	CheckTimeoutMillis int
+}
+
+// RuntimeConfig holds hot reload settings for the runtime config file
+type RuntimeConfig struct {
+	// File is a YAML or JSON file of settings applied without a restart (empty disables hot reload)
+	File string
+	// PollSeconds is how often the file is checked for changes
+	PollSeconds int
+}
+
+// SpinBatchConfig holds settings for committing spins in groups
+type SpinBatchConfig struct {
+	// Enabled commits concurrent spins in shared transactions, inserting their spins and spin logs together
+	Enabled bool
+	// MaxSpins is the most spins one transaction commits
+	MaxSpins int
+	// FlushMillis is how long a transaction waits for more spins before it commits
+	FlushMillis int
+	// Workers is how many shared transactions run at once
+	Workers int
+	// UnitTimeoutMillis bounds each statement and lock wait of a spin in a shared transaction (0 disables)
+	UnitTimeoutMillis int
+}
+
+// SpinPregenConfig holds settings for deriving each session's next spin ahead of its request
+type SpinPregenConfig struct {
+	// Enabled derives the next spin's reel position draws as soon as a spin settles
+	Enabled bool
+	// Draws is how many leading draws of the next spin are derived ahead
+	Draws int
+	// TTLSeconds is how long a derived spin is held for its request
+	TTLSeconds int
+	// MaxSessions caps how many sessions hold a derived spin at once
+	MaxSessions int
+}
+
+// AnalyticsConfig holds settings for mirroring spins into an OLAP store
+type AnalyticsConfig struct {
+	// Sink is the OLAP store spins are mirrored into: "clickhouse", or empty to disable mirroring
+	Sink string
+	// ServeQueries runs heavy analytics queries (canary performance, realized RTP) on the sink
+	ServeQueries bool
+	// ClickHouseURL is the base URL of the ClickHouse HTTP interface
+	ClickHouseURL      string
+	ClickHouseDatabase string
+	ClickHouseUser     string
+	ClickHousePassword string
+	// TimeoutSeconds bounds each request to the sink
+	TimeoutSeconds int
+	// BatchSize is the most spin events written in one insert
+	BatchSize int
+	// FlushMillis is how long spin events wait for a batch to fill before they are written
+	FlushMillis int
+	// BufferSize is how many spin events are held while the sink is slow or down; more are dropped
+	BufferSize int
+}
+
+// DashboardConfig holds live ops dashboard settings
+type DashboardConfig struct {
+	// RateWindowMinutes is how many whole minutes spins per minute and the error rate are averaged over
+	RateWindowMinutes int
+	// ActiveSessionMinutes is how recently a session must have spun to count as active
+	ActiveSessionMinutes int
+}
+
+// SessionExpiryConfig holds idle game session expiry and feature settlement settings
+type SessionExpiryConfig struct {
+	// SweepIntervalSeconds is how often idle game sessions are force-ended (0 disables the sweeper)
+	SweepIntervalSeconds int
+	// IdleMinutes is how long a game session may go without spins before it is force-ended
+	IdleMinutes int
+	// SettlementPolicy is how unplayed free spins are paid out on a forced end: autoplay or average
+	SettlementPolicy string
+	// AverageSpinMultiplier values one unplayed free spin as this multiple of its locked bet
+	AverageSpinMultiplier float64
+}
+
+// FinancialReportConfig holds daily financial report settings
+type FinancialReportConfig struct {
+	// IntervalMinutes is how often ended days are checked for reports to generate (0 disables the worker)
+	IntervalMinutes int
+	// LookbackDays is how many ended days back unreported days are still generated
+	LookbackDays int
+	// MaxRangeDays is the longest date range one listing or CSV download may cover
+	MaxRangeDays int
+}
+
+// VIPConfig holds loyalty program settings
+type VIPConfig struct {
+	// PointsPerUnit is the number of loyalty points earned per 1.00 wagered (0 disables accrual)
+	PointsPerUnit float64
+}
+
+// BigWinConfig holds big win detection and notification settings
+type BigWinConfig struct {
+	// ThresholdMultiplier is the win/bet ratio at which a spin is recorded as a big win (0 disables detection)
+	ThresholdMultiplier float64
+	// WebhookURL receives a signed JSON POST for every big win (optional)
+	WebhookURL string
+	// WebhookSecret signs webhook payloads with HMAC-SHA256 in the X-Signature header (optional)
+	WebhookSecret string
+	// SlackWebhookURL is a Slack incoming webhook that receives a message for every big win (optional)
+	SlackWebhookURL string
+	// NotifyTimeoutSeconds bounds each notifier call
+	NotifyTimeoutSeconds int
+}
+
+// SpinFeedConfig holds live ops spin feed settings
+type SpinFeedConfig struct {
+	// Size is how many recent spins the feed keeps (0 disables the feed)
+	Size int
+}
+
+// JurisdictionConfig holds compliance settings
+type JurisdictionConfig struct {
+	// Default is the jurisdiction code applied to players without one (empty means no restrictions)
+	Default string
+}
+
+// KYCConfig holds identity verification settings
+type KYCConfig struct {
+	// Provider is the identity verification provider ("" for manual admin decisions only, or "http")
+	Provider string
+	// ProviderURL is the HTTP provider's base URL
+	ProviderURL string
+	// ProviderSecret signs requests to the HTTP provider
+	ProviderSecret         string
+	ProviderTimeoutSeconds int
+	// SpinThreshold is the cumulative wagering above which unverified players cannot spin (0 disables the restriction)
+	SpinThreshold float64
+}
+
+// I18nConfig holds localization settings
+type I18nConfig struct {
+	// DefaultLocale is used when Accept-Language matches no available locale
+	DefaultLocale string
+	// RefreshSeconds is how often each server reloads admin translation bundles
+	RefreshSeconds int
+}
+
+// ArchiveConfig holds spin archival settings
+type ArchiveConfig struct {
+	// IntervalMinutes is how often expired spin partitions are archived (0 disables the worker)
+	IntervalMinutes int
+	// RetentionMonths is how many whole months of spins stay in the hot DB
+	RetentionMonths int
+}
+
+// HistoryConfig holds player game-history export settings
+type HistoryConfig struct {
+	// ExportIntervalSeconds is how often queued exports are generated (0 disables the worker)
+	ExportIntervalSeconds int
+	// ExportMaxDays is the longest date range one export may cover
+	ExportMaxDays int
+	// ExportMaxPending is how many unfinished exports a player may have queued
+	ExportMaxPending int
+}
+
+// AdminAuthConfig holds admin two-factor authentication settings
+type AdminAuthConfig struct {
+	// TwoFactorRequired makes destructive admin operations require an enrolled, recently verified second factor
+	TwoFactorRequired bool
+	// TwoFactorIssuer is the issuer name shown in authenticator apps
+	TwoFactorIssuer string
+	// TwoFactorEncryptionKey is the 32-byte AES-256-GCM key for stored TOTP secrets
+	TwoFactorEncryptionKey string
+	// TwoFactorStepUpMinutes is how long a verified code unlocks destructive operations
+	TwoFactorStepUpMinutes int
+	// ImpersonationTokenMinutes is how long a read-only player impersonation token lasts
+	ImpersonationTokenMinutes int
+}
+
+// PlayerAuthConfig holds player session and refresh token settings
+type PlayerAuthConfig struct {
+	// AccessTokenMinutes is the session token lifetime when refresh tokens are issued
+	// (0 keeps JWT_EXPIRATION_HOURS)
+	AccessTokenMinutes int
+	// RefreshTokenHours is the absolute lifetime of a refresh token family (0 disables refresh tokens)
+	RefreshTokenHours int
+}
+
+// LaunchConfig holds operator game launch settings
+type LaunchConfig struct {
+	// OperatorID identifies the operator whose players are linked to local accounts
+	OperatorID string
+	// OperatorSecret is the shared HMAC secret for launch requests and wallet calls
+	OperatorSecret string
+	// WalletURL is the operator wallet base URL (empty disables game launch)
+	WalletURL            string
+	WalletTimeoutSeconds int
+	// GameURL is the game client URL the one-time launch token is appended to
+	GameURL string
+	// TokenSeconds is how long a launch URL can be redeemed
+	TokenSeconds int
+}
+
+// TransparencyConfig holds spin hash transparency log settings
+type TransparencyConfig struct {
+	// IntervalSeconds is how often new spin logs are checkpointed (0 disables the publisher)
+	IntervalSeconds int
+	// BatchSize is the maximum number of spin logs per checkpoint
+	BatchSize int
+	// SettleSeconds delays checkpointing so spin logs of in-flight transactions are not skipped
+	SettleSeconds int
+	// Anchor is the external anchor for checkpoint hashes ("" or "opentimestamps")
+	Anchor string
+	// AnchorURL overrides the anchor endpoint (e.g. the OpenTimestamps calendar)
+	AnchorURL string
+}
+
+// FeatureFlagsConfig holds feature flag defaults
+type FeatureFlagsConfig struct {
+	// Flags is a comma-separated list of name=percentage rollout defaults (e.g. "wild_features=25")
+	// Admin overrides stored in Redis take precedence.
+	Flags string
+	// RefreshSeconds is how often each server reloads admin overrides
+	RefreshSeconds int
 }
 
 // Load loads configuration from environment variables
@@ -152,15 +603,20 @@ func Load() (*Config, error) {
 			Name: getEnv("APP_NAME", "SlotMachine"),
 		},
 		Database: DatabaseConfig{
-			Host:            getEnv("DB_HOST", "localhost"),
-			Port:            getEnv("DB_PORT", "5432"),
-			User:            getEnv("DB_USER", "postgres"),
-			Password:        getEnv("DB_PASSWORD", ""),
-			DBName:          getEnv("DB_NAME", "slotmachine"),
-			SSLMode:         getEnv("DB_SSL_MODE", "disable"),
-			MaxOpenConns:    getEnvAsInt("DB_MAX_OPEN_CONNS", 25),
-			MaxIdleConns:    getEnvAsInt("DB_MAX_IDLE_CONNS", 5),
-			ConnMaxLifetime: getEnvAsDuration("DB_CONN_MAX_LIFETIME", 5*time.Minute),
+			Host:                     getEnv("DB_HOST", "localhost"),
+			Port:                     getEnv("DB_PORT", "5432"),
+			User:                     getEnv("DB_USER", "postgres"),
+			Password:                 getEnv("DB_PASSWORD", ""),
+			DBName:                   getEnv("DB_NAME", "slotmachine"),
+			SSLMode:                  getEnv("DB_SSL_MODE", "disable"),
+			MaxOpenConns:             getEnvAsInt("DB_MAX_OPEN_CONNS", 25),
+			MaxIdleConns:             getEnvAsInt("DB_MAX_IDLE_CONNS", 5),
+			ConnMaxLifetime:          getEnvAsDuration("DB_CONN_MAX_LIFETIME", 5*time.Minute),
+			ReplicaHosts:             getEnv("DB_REPLICA_HOSTS", ""),
+			PartitionIntervalMinutes: getEnvAsInt("DB_PARTITION_INTERVAL_MINUTES", 360),
+			PartitionPremakeMonths:   getEnvAsInt("DB_PARTITION_PREMAKE_MONTHS", 3),
+			PartitionRetentionMonths: getEnvAsInt("DB_PARTITION_RETENTION_MONTHS", 0),
+			MigrationCheck:           getEnv("DB_MIGRATION_CHECK", "fail"),
 		},
 		Redis: RedisConfig{
 			Addr:     getEnv("REDIS_ADDR", "localhost:6379"),
@@ -169,9 +625,13 @@ func Load() (*Config, error) {
 			Enabled:  getEnvAsBool("REDIS_ENABLED", true),
 		},
 		JWT: JWTConfig{
-			Secret:          getEnv("JWT_SECRET", "change-this-secret-in-production"),
-			ExpirationHours: getEnvAsInt("JWT_EXPIRATION_HOURS", 24),
-			ServiceToken:    getEnv("SERVICE_TOKEN", ""),
+			Secret:            getEnv("JWT_SECRET", "change-this-secret-in-production"),
+			ExpirationHours:   getEnvAsInt("JWT_EXPIRATION_HOURS", 24),
+			ServiceToken:      getEnv("SERVICE_TOKEN", ""),
+			KeyEncryptionKey:  getEnv("JWT_KEY_ENCRYPTION_KEY", "jwt-signing-key-dev-key-32bytes!"),
+			KeyRotationHours:  getEnvAsInt("JWT_KEY_ROTATION_HOURS", 720),
+			KeyRefreshSeconds: getEnvAsInt("JWT_KEY_REFRESH_SECONDS", 60),
+			AcceptLegacyHS256: getEnvAsBool("JWT_ACCEPT_LEGACY_HS256", true),
 		},
 		Logging: LoggingConfig{
 			Level:                    getEnv("LOG_LEVEL", "debug"),
@@ -205,29 +665,228 @@ func Load() (*Config, error) {
 			DefaultBalance:   getEnvAsFloat("DEFAULT_BALANCE", 100000.00),
 			TargetRTP:        getEnvAsFloat("TARGET_RTP", 96.5),
 			MaxWinMultiplier: getEnvAsInt("MAX_WIN_MULTIPLIER", 25000),
+			BetChangePolicy:  getEnv("BET_CHANGE_POLICY", "allow"),
+			AutoplayMaxSpins: getEnvAsInt("AUTOPLAY_MAX_SPINS", 100),
+
+			ReconcileIntervalSeconds: getEnvAsInt("SPIN_RECONCILE_INTERVAL_SECONDS", 60),
+			ReconcileGraceSeconds:    getEnvAsInt("SPIN_RECONCILE_GRACE_SECONDS", 30),
+			ReconcileLookbackHours:   getEnvAsInt("SPIN_RECONCILE_LOOKBACK_HOURS", 72),
+
+			BalanceLockTTLSeconds: getEnvAsInt("BALANCE_LOCK_TTL_SECONDS", 10),
+			BalanceLockWaitMillis: getEnvAsInt("BALANCE_LOCK_WAIT_MS", 5000),
+			BalanceRetryAttempts:  getEnvAsInt("BALANCE_RETRY_ATTEMPTS", 3),
+			SpinNonceRequired:     getEnvAsBool("SPIN_NONCE_REQUIRED", false),
+			SpinTimeoutMillis:     getEnvAsInt("SPIN_TIMEOUT_MS", 10000),
 		},
 		Storage: StorageConfig{
-			Provider:        getEnv("STORAGE_PROVIDER", "minio"), // "minio" or "gcs"
-			Endpoint:        getEnv("STORAGE_ENDPOINT", "localhost:9000"),
-			AccessKeyID:     getEnv("STORAGE_ACCESS_KEY", "minioadmin"),
-			SecretAccessKey: getEnv("STORAGE_SECRET_KEY", "minioadmin"),
-			BucketName:      getEnv("STORAGE_BUCKET", "slot-assets"),
-			UseSSL:          getEnvAsBool("STORAGE_USE_SSL", false),
-			PublicURL:       getEnv("STORAGE_PUBLIC_URL", "http://localhost:9000"),
+			Provider:            getEnv("STORAGE_PROVIDER", "minio"), // "minio" or "gcs"
+			Endpoint:            getEnv("STORAGE_ENDPOINT", "localhost:9000"),
+			AccessKeyID:         getEnv("STORAGE_ACCESS_KEY", "minioadmin"),
+			SecretAccessKey:     getEnv("STORAGE_SECRET_KEY", "minioadmin"),
+			BucketName:          getEnv("STORAGE_BUCKET", "slot-assets"),
+			UseSSL:              getEnvAsBool("STORAGE_USE_SSL", false),
+			PublicURL:           getEnv("STORAGE_PUBLIC_URL", "http://localhost:9000"),
+			SignedURLTTLMinutes: getEnvAsInt("STORAGE_SIGNED_URL_TTL_MINUTES", 60),
+		},
+		Imaging: ImagingConfig{
+			Workers:        getEnvAsInt("IMAGING_WORKERS", 2),
+			VariantWidths:  getEnv("IMAGING_VARIANT_WIDTHS", "480,960,1920"),
+			VariantFormats: getEnv("IMAGING_VARIANT_FORMATS", "webp,avif"),
+			Quality:        getEnvAsInt("IMAGING_QUALITY", 80),
+			CWebPPath:      getEnv("IMAGING_CWEBP_PATH", "cwebp"),
+			AvifEncPath:    getEnv("IMAGING_AVIFENC_PATH", "avifenc"),
 		},
 		ProvablyFair: ProvablyFairConfig{
 			// Default key for development only - MUST be overridden in production
-			EncryptionKey: getEnv("PF_ENCRYPTION_KEY", "provablyfair-dev-key-32bytes!!!!"),
+			EncryptionKey:             getEnv("PF_ENCRYPTION_KEY", "provablyfair-dev-key-32bytes!!!!"),
+			SigningKey:                getEnv("PF_SIGNING_KEY", "spin-signing-dev-seed-32-bytes!!"),
+			SweepIntervalSeconds:      getEnvAsInt("PF_SWEEP_INTERVAL_SECONDS", 300),
+			SessionIdleMinutes:        getEnvAsInt("PF_SESSION_IDLE_MINUTES", 120),
+			StateFlushSpins:           getEnvAsInt("PF_STATE_FLUSH_SPINS", 0),
+			StateFlushSeconds:         getEnvAsInt("PF_STATE_FLUSH_SECONDS", 5),
+			DualCommitmentRequired:    getEnvAsBool("PF_DUAL_COMMITMENT_REQUIRED", false),
+			DualCommitmentOperators:   getEnv("PF_DUAL_COMMITMENT_OPERATORS", ""),
+			AuditIntervalMinutes:      getEnvAsInt("PF_AUDIT_INTERVAL_MINUTES", 15),
+			AuditLookbackHours:        getEnvAsInt("PF_AUDIT_LOOKBACK_HOURS", 24),
+			AuditAlertWebhookURL:      getEnv("PF_AUDIT_ALERT_WEBHOOK_URL", ""),
+			AuditAlertWebhookSecret:   getEnv("PF_AUDIT_ALERT_WEBHOOK_SECRET", ""),
+			AuditAlertSlackWebhookURL: getEnv("PF_AUDIT_ALERT_SLACK_WEBHOOK_URL", ""),
+		},
+		RNG: RNGConfig{
+			Provider:               getEnv("RNG_PROVIDER", "hkdf"),
+			DeterministicSeed:      int64(getEnvAsInt("RNG_DETERMINISTIC_SEED", 0)),
+			HardwareURL:            getEnv("RNG_HARDWARE_URL", ""),
+			HardwareAPIKey:         getEnv("RNG_HARDWARE_API_KEY", ""),
+			HardwareTimeoutSeconds: getEnvAsInt("RNG_HARDWARE_TIMEOUT_SECONDS", 5),
+			HardwareBlockBytes:     getEnvAsInt("RNG_HARDWARE_BLOCK_BYTES", 4096),
+		},
+		Clock: ClockConfig{
+			FakeStart: getEnv("CLOCK_FAKE_START", ""),
+		},
+		VIP: VIPConfig{
+			PointsPerUnit: getEnvAsFloat("VIP_POINTS_PER_UNIT", 1.0),
+		},
+		BigWin: BigWinConfig{
+			ThresholdMultiplier:  getEnvAsFloat("BIG_WIN_THRESHOLD_MULTIPLIER", 100),
+			WebhookURL:           getEnv("BIG_WIN_WEBHOOK_URL", ""),
+			WebhookSecret:        getEnv("BIG_WIN_WEBHOOK_SECRET", ""),
+			SlackWebhookURL:      getEnv("BIG_WIN_SLACK_WEBHOOK_URL", ""),
+			NotifyTimeoutSeconds: getEnvAsInt("BIG_WIN_NOTIFY_TIMEOUT_SECONDS", 10),
+		},
+		SpinFeed: SpinFeedConfig{
+			Size: getEnvAsInt("SPIN_FEED_SIZE", 200),
+		},
+		Jurisdiction: JurisdictionConfig{
+			Default: getEnv("JURISDICTION_DEFAULT", ""),
+		},
+		KYC: KYCConfig{
+			Provider:               getEnv("KYC_PROVIDER", ""),
+			ProviderURL:            getEnv("KYC_PROVIDER_URL", ""),
+			ProviderSecret:         getEnv("KYC_PROVIDER_SECRET", ""),
+			ProviderTimeoutSeconds: getEnvAsInt("KYC_PROVIDER_TIMEOUT_SECONDS", 10),
+			SpinThreshold:          getEnvAsFloat("KYC_SPIN_THRESHOLD", 0),
+		},
+		FeatureFlags: FeatureFlagsConfig{
+			Flags:          getEnv("FEATURE_FLAGS", ""),
+			RefreshSeconds: getEnvAsInt("FEATURE_FLAGS_REFRESH_SECONDS", 10),
+		},
+		I18n: I18nConfig{
+			DefaultLocale:  getEnv("I18N_DEFAULT_LOCALE", "en"),
+			RefreshSeconds: getEnvAsInt("I18N_REFRESH_SECONDS", 60),
+		},
+		Archive: ArchiveConfig{
+			IntervalMinutes: getEnvAsInt("ARCHIVE_INTERVAL_MINUTES", 1440),
+			RetentionMonths: getEnvAsInt("ARCHIVE_RETENTION_MONTHS", 12),
+		},
+		History: HistoryConfig{
+			ExportIntervalSeconds: getEnvAsInt("HISTORY_EXPORT_INTERVAL_SECONDS", 10),
+			ExportMaxDays:         getEnvAsInt("HISTORY_EXPORT_MAX_DAYS", 366),
+			ExportMaxPending:      getEnvAsInt("HISTORY_EXPORT_MAX_PENDING", 3),
+		},
+		AdminAuth: AdminAuthConfig{
+			TwoFactorRequired:         getEnvAsBool("ADMIN_2FA_REQUIRED", true),
+			TwoFactorIssuer:           getEnv("ADMIN_2FA_ISSUER", "Slot Admin"),
+			TwoFactorEncryptionKey:    getEnv("ADMIN_2FA_ENCRYPTION_KEY", "admin-2fa-dev-key-32-bytes!!!!!!"),
+			TwoFactorStepUpMinutes:    getEnvAsInt("ADMIN_2FA_STEP_UP_MINUTES", 5),
+			ImpersonationTokenMinutes: getEnvAsInt("ADMIN_IMPERSONATION_TOKEN_MINUTES", 30),
+		},
+		PlayerAuth: PlayerAuthConfig{
+			AccessTokenMinutes: getEnvAsInt("PLAYER_ACCESS_TOKEN_MINUTES", 0),
+			RefreshTokenHours:  getEnvAsInt("PLAYER_REFRESH_TOKEN_HOURS", 720),
+		},
+		Launch: LaunchConfig{
+			OperatorID:           getEnv("LAUNCH_OPERATOR_ID", "default"),
+			OperatorSecret:       getEnv("LAUNCH_OPERATOR_SECRET", ""),
+			WalletURL:            getEnv("LAUNCH_WALLET_URL", ""),
+			WalletTimeoutSeconds: getEnvAsInt("LAUNCH_WALLET_TIMEOUT_SECONDS", 10),
+			GameURL:              getEnv("LAUNCH_GAME_URL", "http://localhost:3000"),
+			TokenSeconds:         getEnvAsInt("LAUNCH_TOKEN_SECONDS", 60),
+		},
+		Transparency: TransparencyConfig{
+			IntervalSeconds: getEnvAsInt("TRANSPARENCY_INTERVAL_SECONDS", 300),
+			BatchSize:       getEnvAsInt("TRANSPARENCY_BATCH_SIZE", 10000),
+			SettleSeconds:   getEnvAsInt("TRANSPARENCY_SETTLE_SECONDS", 60),
+			Anchor:          getEnv("TRANSPARENCY_ANCHOR", ""),
+			AnchorURL:       getEnv("TRANSPARENCY_ANCHOR_URL", ""),
+		},
+		CertLog: CertLogConfig{
+			Enabled: getEnvAsBool("CERTIFICATION_LOG_ENABLED", false),
+		},
+		Shadow: ShadowConfig{
+			Enabled:              getEnvAsBool("SHADOW_ENGINE_ENABLED", false),
+			SampleRate:           getEnvAsFloat("SHADOW_ENGINE_SAMPLE_RATE", 0.01),
+			AlertWebhookURL:      getEnv("SHADOW_ENGINE_ALERT_WEBHOOK_URL", ""),
+			AlertWebhookSecret:   getEnv("SHADOW_ENGINE_ALERT_WEBHOOK_SECRET", ""),
+			AlertSlackWebhookURL: getEnv("SHADOW_ENGINE_ALERT_SLACK_WEBHOOK_URL", ""),
+		},
+		Approval: ApprovalConfig{
+			Required:              getEnvAsBool("CHANGE_APPROVAL_REQUIRED", true),
+			TTLHours:              getEnvAsInt("CHANGE_APPROVAL_TTL_HOURS", 24),
+			ExpiryIntervalMinutes: getEnvAsInt("CHANGE_APPROVAL_EXPIRY_INTERVAL_MINUTES", 5),
+			WebhookURL:            getEnv("CHANGE_APPROVAL_WEBHOOK_URL", ""),
+			WebhookSecret:         getEnv("CHANGE_APPROVAL_WEBHOOK_SECRET", ""),
+			SlackWebhookURL:       getEnv("CHANGE_APPROVAL_SLACK_WEBHOOK_URL", ""),
+		},
+		Canary: CanaryConfig{
+			MonitorIntervalMinutes: getEnvAsInt("REEL_STRIP_CANARY_MONITOR_INTERVAL_MINUTES", 5),
+			DefaultPercent:         getEnvAsInt("REEL_STRIP_CANARY_PERCENT", 10),
+			DefaultTrialHours:      getEnvAsInt("REEL_STRIP_CANARY_TRIAL_HOURS", 72),
+			DefaultMaxRTPDeviation: getEnvAsFloat("REEL_STRIP_CANARY_MAX_RTP_DEVIATION", 2.0),
+			DefaultMinSpins:        getEnvAsInt("REEL_STRIP_CANARY_MIN_SPINS", 10000),
+			AlertWebhookURL:        getEnv("REEL_STRIP_CANARY_ALERT_WEBHOOK_URL", ""),
+			AlertWebhookSecret:     getEnv("REEL_STRIP_CANARY_ALERT_WEBHOOK_SECRET", ""),
+			AlertSlackWebhookURL:   getEnv("REEL_STRIP_CANARY_ALERT_SLACK_WEBHOOK_URL", ""),
+		},
+		RTPMonitor: RTPMonitorConfig{
+			IntervalMinutes:      getEnvAsInt("RTP_MONITOR_INTERVAL_MINUTES", 60),
+			WindowHours:          getEnvAsInt("RTP_MONITOR_WINDOW_HOURS", 24),
+			MinSpins:             getEnvAsInt("RTP_MONITOR_MIN_SPINS", 1000),
+			ZScore:               getEnvAsFloat("RTP_MONITOR_Z_SCORE", 4.0),
+			CooldownHours:        getEnvAsInt("RTP_MONITOR_COOLDOWN_HOURS", 24),
+			AlertWebhookURL:      getEnv("RTP_MONITOR_ALERT_WEBHOOK_URL", ""),
+			AlertWebhookSecret:   getEnv("RTP_MONITOR_ALERT_WEBHOOK_SECRET", ""),
+			AlertSlackWebhookURL: getEnv("RTP_MONITOR_ALERT_SLACK_WEBHOOK_URL", ""),
+		},
+		Exposure: ExposureConfig{
+			MonitorIntervalSeconds:     getEnvAsInt("EXPOSURE_MONITOR_INTERVAL_SECONDS", 30),
+			MaxLiability:               getEnvAsFloat("EXPOSURE_MAX_LIABILITY", 0),
+			MaxActiveFreeSpinsSessions: getEnvAsInt("EXPOSURE_MAX_ACTIVE_FREE_SPINS_SESSIONS", 0),
+		},
+		CacheWarm: CacheWarmConfig{
+			Enabled:        getEnvAsBool("REEL_STRIP_CACHE_WARM_ENABLED", true),
+			DebounceMillis: getEnvAsInt("REEL_STRIP_CACHE_WARM_DEBOUNCE_MILLIS", 500),
+		},
+		Health: HealthConfig{
+			CheckTimeoutMillis: getEnvAsInt("HEALTH_CHECK_TIMEOUT_MILLIS", 800),
+		},
+		Runtime: RuntimeConfig{
+			File:        getEnv("RUNTIME_CONFIG_FILE", ""),
+			PollSeconds: getEnvAsInt("RUNTIME_CONFIG_POLL_SECONDS", 10),
+		},
+		SpinBatch: SpinBatchConfig{
+			Enabled:           getEnvAsBool("SPIN_BATCH_ENABLED", false),
+			MaxSpins:          getEnvAsInt("SPIN_BATCH_MAX_SPINS", 50),
+			FlushMillis:       getEnvAsInt("SPIN_BATCH_FLUSH_MS", 5),
+			Workers:           getEnvAsInt("SPIN_BATCH_WORKERS", 4),
+			UnitTimeoutMillis: getEnvAsInt("SPIN_BATCH_UNIT_TIMEOUT_MS", 2000),
+		},
+		SpinPregen: SpinPregenConfig{
+			Enabled:     getEnvAsBool("SPIN_PREGEN_ENABLED", false),
+			Draws:       getEnvAsInt("SPIN_PREGEN_DRAWS", 5),
+			TTLSeconds:  getEnvAsInt("SPIN_PREGEN_TTL_SECONDS", 60),
+			MaxSessions: getEnvAsInt("SPIN_PREGEN_MAX_SESSIONS", 100000),
+		},
+		Analytics: AnalyticsConfig{
+			Sink:               getEnv("ANALYTICS_SINK", ""),
+			ServeQueries:       getEnvAsBool("ANALYTICS_SERVE_QUERIES", true),
+			ClickHouseURL:      getEnv("CLICKHOUSE_URL", "http://localhost:8123"),
+			ClickHouseDatabase: getEnv("CLICKHOUSE_DATABASE", "default"),
+			ClickHouseUser:     getEnv("CLICKHOUSE_USER", ""),
+			ClickHousePassword: getEnv("CLICKHOUSE_PASSWORD", ""),
+			TimeoutSeconds:     getEnvAsInt("ANALYTICS_TIMEOUT_SECONDS", 10),
+			BatchSize:          getEnvAsInt("ANALYTICS_BATCH_SIZE", 1000),
+			FlushMillis:        getEnvAsInt("ANALYTICS_FLUSH_MS", 1000),
+			BufferSize:         getEnvAsInt("ANALYTICS_BUFFER_SIZE", 100000),
+		},
+		FinancialReport: FinancialReportConfig{
+			IntervalMinutes: getEnvAsInt("FINANCIAL_REPORT_INTERVAL_MINUTES", 60),
+			LookbackDays:    getEnvAsInt("FINANCIAL_REPORT_LOOKBACK_DAYS", 7),
+			MaxRangeDays:    getEnvAsInt("FINANCIAL_REPORT_MAX_RANGE_DAYS", 366),
+		},
+		Dashboard: DashboardConfig{
+			RateWindowMinutes:    getEnvAsInt("DASHBOARD_RATE_WINDOW_MINUTES", 5),
+			ActiveSessionMinutes: getEnvAsInt("DASHBOARD_ACTIVE_SESSION_MINUTES", 5),
+		},
+		SessionExpiry: SessionExpiryConfig{
+			SweepIntervalSeconds:  getEnvAsInt("SESSION_EXPIRY_SWEEP_INTERVAL_SECONDS", 300),
+			IdleMinutes:           getEnvAsInt("SESSION_IDLE_MINUTES", 90),
+			SettlementPolicy:      getEnv("FEATURE_SETTLEMENT_POLICY", "autoplay"),
+			AverageSpinMultiplier: getEnvAsFloat("FEATURE_SETTLEMENT_AVERAGE_SPIN_MULTIPLIER", 1.5),
 		},
 	}
 
-	// Validate critical settings
-	if cfg.JWT.Secret == "change-this-secret-in-production" && cfg.App.Env == "production" {
-		return nil, fmt.Errorf("JWT_SECRET must be set in production")
-	}
-
-	if cfg.Database.Password == "" && cfg.App.Env == "production" {
-		return nil, fmt.Errorf("DB_PASSWORD must be set in production")
+	if err := cfg.Validate(); err != nil {
+		return nil, err
 	}
 
 	return cfg, nil
@@ -241,6 +900,43 @@ func (c *DatabaseConfig) DSN() string {
 	)
 }
 
+// ReplicaDSNs returns a connection string for each configured read replica
+func (c *DatabaseConfig) ReplicaDSNs() []string {
+	var dsns []string
+	for _, entry := range strings.Split(c.ReplicaHosts, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		host, port := entry, c.Port
+		if h, p, ok := strings.Cut(entry, ":"); ok {
+			host, port = h, p
+		}
+		dsns = append(dsns, fmt.Sprintf(
+			"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+			host, port, c.User, c.Password, c.DBName, c.SSLMode,
+		))
+	}
+	return dsns
+}
+
+// DualCommitmentRequiredFor reports whether players of an operator must use the Dual
+// Commitment Protocol; an empty operator ID means a directly registered player
+func (c *ProvablyFairConfig) DualCommitmentRequiredFor(operatorID string) bool {
+	if operatorID != "" {
+		for _, entry := range strings.Split(c.DualCommitmentOperators, ",") {
+			name, value, ok := strings.Cut(strings.TrimSpace(entry), "=")
+			if !ok || strings.TrimSpace(name) != operatorID {
+				continue
+			}
+			if required, err := strconv.ParseBool(strings.TrimSpace(value)); err == nil {
+				return required
+			}
+		}
+	}
+	return c.DualCommitmentRequired
+}
+
 // Helper functions
 
 func getEnv(key, defaultValue string) string {
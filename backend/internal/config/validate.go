@@ -0,0 +1,167 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// keySize is the length in bytes of the AES-256 keys and Ed25519 seeds read from the environment
+const keySize = 32
+
+// ValidationError lists every problem found in a configuration, so one restart can fix them all
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "invalid configuration (%d problems):", len(e.Problems))
+	for _, p := range e.Problems {
+		b.WriteString("\n  - ")
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+// Validate checks the configuration and returns a *ValidationError listing every problem found
+// Settings that can only fail once in use, such as encryption keys, storage credentials and
+// RTP parameters, are checked here so a bad deploy fails at boot rather than mid-request.
+func (c *Config) Validate() error {
+	v := &validator{}
+	production := c.App.Env == "production"
+
+	// Secrets
+	if production {
+		v.check(c.JWT.Secret != "change-this-secret-in-production", "JWT_SECRET must be set in production")
+		v.check(c.JWT.KeyEncryptionKey != "jwt-signing-key-dev-key-32bytes!", "JWT_KEY_ENCRYPTION_KEY must be set in production")
+		v.check(c.ProvablyFair.EncryptionKey != "provablyfair-dev-key-32bytes!!!!", "PF_ENCRYPTION_KEY must be set in production")
+		v.check(c.ProvablyFair.SigningKey != "spin-signing-dev-seed-32-bytes!!", "PF_SIGNING_KEY must be set in production")
+		v.check(c.AdminAuth.TwoFactorEncryptionKey != "admin-2fa-dev-key-32-bytes!!!!!!", "ADMIN_2FA_ENCRYPTION_KEY must be set in production")
+		v.check(c.Database.Password != "", "DB_PASSWORD must be set in production")
+		v.check(c.RNG.Provider != "deterministic", "RNG_PROVIDER=deterministic is not allowed in production")
+		v.check(c.Clock.FakeStart == "", "CLOCK_FAKE_START is not allowed in production")
+	}
+	v.keySize("JWT_KEY_ENCRYPTION_KEY", c.JWT.KeyEncryptionKey)
+	v.keySize("PF_ENCRYPTION_KEY", c.ProvablyFair.EncryptionKey)
+	if c.ProvablyFair.SigningKey != "" {
+		v.keySize("PF_SIGNING_KEY", c.ProvablyFair.SigningKey)
+	}
+	v.keySize("ADMIN_2FA_ENCRYPTION_KEY", c.AdminAuth.TwoFactorEncryptionKey)
+	v.check(c.AdminAuth.ImpersonationTokenMinutes > 0 && c.AdminAuth.ImpersonationTokenMinutes <= 24*60,
+		"ADMIN_IMPERSONATION_TOKEN_MINUTES must be in [1, 1440], got %d", c.AdminAuth.ImpersonationTokenMinutes)
+	v.check(c.Launch.WalletURL == "" || c.Launch.OperatorSecret != "", "LAUNCH_OPERATOR_SECRET must be set when LAUNCH_WALLET_URL is set")
+	if c.Clock.FakeStart != "" {
+		_, err := time.Parse(time.RFC3339, c.Clock.FakeStart)
+		v.check(err == nil, "CLOCK_FAKE_START must be an RFC 3339 time, got %q", c.Clock.FakeStart)
+	}
+
+	switch c.Database.MigrationCheck {
+	case "fail", "warn", "off":
+	default:
+		v.add("DB_MIGRATION_CHECK must be fail, warn or off, got %q", c.Database.MigrationCheck)
+	}
+
+	// Storage
+	switch c.Storage.Provider {
+	case "minio", "":
+		v.check(c.Storage.Endpoint != "", "STORAGE_ENDPOINT must be set for MinIO storage")
+		v.check(c.Storage.AccessKeyID != "" && c.Storage.SecretAccessKey != "", "STORAGE_ACCESS_KEY and STORAGE_SECRET_KEY must be set for MinIO storage")
+		if production {
+			v.check(c.Storage.AccessKeyID != "minioadmin" || c.Storage.SecretAccessKey != "minioadmin", "STORAGE_ACCESS_KEY and STORAGE_SECRET_KEY must not be the MinIO defaults in production")
+		}
+	case "gcs":
+	default:
+		v.add("STORAGE_PROVIDER must be minio or gcs, got %q", c.Storage.Provider)
+	}
+	v.check(c.Storage.BucketName != "", "STORAGE_BUCKET must be set")
+	v.check(c.Storage.SignedURLTTLMinutes > 0, "STORAGE_SIGNED_URL_TTL_MINUTES must be positive")
+
+	// Game and RTP
+	v.check(c.Game.TargetRTP > 0 && c.Game.TargetRTP <= 100, "TARGET_RTP must be in (0, 100], got %v", c.Game.TargetRTP)
+	v.check(c.Game.MaxWinMultiplier > 0, "MAX_WIN_MULTIPLIER must be positive, got %d", c.Game.MaxWinMultiplier)
+	v.check(c.Game.MinBet > 0 && c.Game.MaxBet >= c.Game.MinBet, "MIN_BET must be positive and at most MAX_BET, got %v and %v", c.Game.MinBet, c.Game.MaxBet)
+	v.check(c.Game.BetStep > 0, "BET_STEP must be positive, got %v", c.Game.BetStep)
+	switch c.Game.BetChangePolicy {
+	case "allow", "locked", "decrease_only":
+	default:
+		v.add("BET_CHANGE_POLICY must be allow, locked or decrease_only, got %q", c.Game.BetChangePolicy)
+	}
+	v.check(c.Canary.DefaultPercent > 0 && c.Canary.DefaultPercent <= 100, "REEL_STRIP_CANARY_PERCENT must be in [1, 100], got %d", c.Canary.DefaultPercent)
+	v.check(c.Canary.DefaultMaxRTPDeviation > 0, "REEL_STRIP_CANARY_MAX_RTP_DEVIATION must be positive, got %v", c.Canary.DefaultMaxRTPDeviation)
+	v.check(c.Canary.DefaultMinSpins >= 0, "REEL_STRIP_CANARY_MIN_SPINS must not be negative, got %d", c.Canary.DefaultMinSpins)
+	v.check(c.RTPMonitor.ZScore >= 0, "RTP_MONITOR_Z_SCORE must not be negative, got %v", c.RTPMonitor.ZScore)
+	v.check(c.RTPMonitor.MinSpins >= 0, "RTP_MONITOR_MIN_SPINS must not be negative, got %d", c.RTPMonitor.MinSpins)
+	v.check(c.Game.SpinTimeoutMillis >= 0, "SPIN_TIMEOUT_MS must not be negative, got %d", c.Game.SpinTimeoutMillis)
+	v.check(c.Game.SpinTimeoutMillis <= 0 || c.Game.ReconcileIntervalSeconds <= 0 || c.Game.SpinTimeoutMillis < c.Game.ReconcileGraceSeconds*1000,
+		"SPIN_RECONCILE_GRACE_SECONDS must outlast SPIN_TIMEOUT_MS so the reconciler never races an in-flight spin")
+	v.check(!production || c.Game.SpinNonceRequired, "SPIN_NONCE_REQUIRED must be true in production")
+	if c.SpinBatch.Enabled {
+		v.check(c.SpinBatch.MaxSpins > 0, "SPIN_BATCH_MAX_SPINS must be positive, got %d", c.SpinBatch.MaxSpins)
+		v.check(c.SpinBatch.FlushMillis > 0, "SPIN_BATCH_FLUSH_MS must be positive, got %d", c.SpinBatch.FlushMillis)
+		v.check(c.SpinBatch.Workers > 0, "SPIN_BATCH_WORKERS must be positive, got %d", c.SpinBatch.Workers)
+	}
+	if c.SpinPregen.Enabled {
+		v.check(c.SpinPregen.Draws > 0, "SPIN_PREGEN_DRAWS must be positive, got %d", c.SpinPregen.Draws)
+		v.check(c.SpinPregen.TTLSeconds > 0, "SPIN_PREGEN_TTL_SECONDS must be positive, got %d", c.SpinPregen.TTLSeconds)
+		v.check(c.SpinPregen.MaxSessions > 0, "SPIN_PREGEN_MAX_SESSIONS must be positive, got %d", c.SpinPregen.MaxSessions)
+	}
+	switch c.Analytics.Sink {
+	case "":
+	case "clickhouse":
+		v.check(c.Analytics.ClickHouseURL != "", "CLICKHOUSE_URL must be set for the ClickHouse analytics sink")
+		v.check(c.Analytics.TimeoutSeconds > 0, "ANALYTICS_TIMEOUT_SECONDS must be positive, got %d", c.Analytics.TimeoutSeconds)
+		v.check(c.Analytics.BatchSize > 0 && c.Analytics.BufferSize >= c.Analytics.BatchSize,
+			"ANALYTICS_BATCH_SIZE must be positive and at most ANALYTICS_BUFFER_SIZE, got %d and %d", c.Analytics.BatchSize, c.Analytics.BufferSize)
+		v.check(c.Analytics.FlushMillis > 0, "ANALYTICS_FLUSH_MS must be positive, got %d", c.Analytics.FlushMillis)
+	default:
+		v.add("ANALYTICS_SINK must be clickhouse or empty, got %q", c.Analytics.Sink)
+	}
+	if c.FinancialReport.IntervalMinutes > 0 {
+		v.check(c.FinancialReport.LookbackDays > 0, "FINANCIAL_REPORT_LOOKBACK_DAYS must be positive, got %d", c.FinancialReport.LookbackDays)
+	}
+	v.check(c.FinancialReport.MaxRangeDays > 0, "FINANCIAL_REPORT_MAX_RANGE_DAYS must be positive, got %d", c.FinancialReport.MaxRangeDays)
+	v.check(c.Dashboard.RateWindowMinutes > 0 && c.Dashboard.RateWindowMinutes <= 60, "DASHBOARD_RATE_WINDOW_MINUTES must be in [1, 60], got %d", c.Dashboard.RateWindowMinutes)
+	v.check(c.Dashboard.ActiveSessionMinutes > 0, "DASHBOARD_ACTIVE_SESSION_MINUTES must be positive, got %d", c.Dashboard.ActiveSessionMinutes)
+	if c.SessionExpiry.SweepIntervalSeconds > 0 {
+		v.check(c.SessionExpiry.IdleMinutes > 0, "SESSION_IDLE_MINUTES must be positive, got %d", c.SessionExpiry.IdleMinutes)
+		v.check(c.ProvablyFair.SweepIntervalSeconds <= 0 || c.SessionExpiry.IdleMinutes < c.ProvablyFair.SessionIdleMinutes,
+			"SESSION_IDLE_MINUTES must be below PF_SESSION_IDLE_MINUTES so free spins are settled before their PF session is ended")
+	}
+	switch c.SessionExpiry.SettlementPolicy {
+	case "autoplay", "average":
+	default:
+		v.add("FEATURE_SETTLEMENT_POLICY must be autoplay or average, got %q", c.SessionExpiry.SettlementPolicy)
+	}
+	v.check(c.SessionExpiry.AverageSpinMultiplier >= 0, "FEATURE_SETTLEMENT_AVERAGE_SPIN_MULTIPLIER must not be negative, got %v", c.SessionExpiry.AverageSpinMultiplier)
+	v.check(c.Exposure.MaxLiability >= 0, "EXPOSURE_MAX_LIABILITY must not be negative, got %v", c.Exposure.MaxLiability)
+
+	return v.err()
+}
+
+// validator collects problems instead of stopping at the first
+type validator struct {
+	problems []string
+}
+
+func (v *validator) add(format string, args ...any) {
+	v.problems = append(v.problems, fmt.Sprintf(format, args...))
+}
+
+func (v *validator) check(ok bool, format string, args ...any) {
+	if !ok {
+		v.add(format, args...)
+	}
+}
+
+// keySize checks a key's length without echoing the key
+func (v *validator) keySize(name, key string) {
+	v.check(len(key) == keySize, "%s must be %d bytes, got %d", name, keySize, len(key))
+}
+
+func (v *validator) err() error {
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: v.problems}
+}
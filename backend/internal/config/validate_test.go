@@ -0,0 +1,62 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_DefaultsAreValid(t *testing.T) {
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestValidate_ReportsEveryProblem(t *testing.T) {
+	cfg, err := Load()
+	require.NoError(t, err)
+
+	cfg.App.Env = "production"
+	cfg.JWT.KeyEncryptionKey = "too-short"
+	cfg.Storage.Provider = "s3"
+	cfg.Game.TargetRTP = 120
+	cfg.Database.MigrationCheck = "skip"
+
+	err = cfg.Validate()
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Contains(t, verr.Problems, "JWT_KEY_ENCRYPTION_KEY must be 32 bytes, got 9")
+	assert.Contains(t, verr.Problems, `STORAGE_PROVIDER must be minio or gcs, got "s3"`)
+	assert.Contains(t, verr.Problems, "TARGET_RTP must be in (0, 100], got 120")
+	assert.Contains(t, verr.Problems, `DB_MIGRATION_CHECK must be fail, warn or off, got "skip"`)
+	assert.Contains(t, verr.Problems, "JWT_SECRET must be set in production")
+	assert.NotContains(t, err.Error(), "too-short", "keys are never echoed")
+}
+
+func TestValidate_FakeClock(t *testing.T) {
+	cfg, err := Load()
+	require.NoError(t, err)
+
+	cfg.Clock.FakeStart = "2026-01-02T03:04:05Z"
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Clock.FakeStart = "yesterday"
+	assert.ErrorContains(t, cfg.Validate(), `CLOCK_FAKE_START must be an RFC 3339 time, got "yesterday"`)
+
+	cfg.Clock.FakeStart = "2026-01-02T03:04:05Z"
+	cfg.App.Env = "production"
+	assert.ErrorContains(t, cfg.Validate(), "CLOCK_FAKE_START is not allowed in production")
+}
+
+func TestValidate_SpinNonceRequiredInProduction(t *testing.T) {
+	cfg, err := Load()
+	require.NoError(t, err)
+
+	cfg.App.Env = "production"
+	cfg.Game.SpinNonceRequired = false
+	assert.ErrorContains(t, cfg.Validate(), "SPIN_NONCE_REQUIRED must be true in production")
+
+	cfg.Game.SpinNonceRequired = true
+	assert.NotContains(t, cfg.Validate().Error(), "SPIN_NONCE_REQUIRED")
+}
@@ -13,6 +13,21 @@ import (
 
 // NewGormDB creates a new GORM database connection
 func NewGormDB(cfg *config.Config, log *logger.Logger) (*gorm.DB, error) {
+	db, err := open(cfg.Database.DSN(), cfg, log)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("host", cfg.Database.Host).
+		Str("dbname", cfg.Database.DBName).
+		Msg("Database connection established")
+
+	return db, nil
+}
+
+// open connects to dsn with the shared GORM and pool settings
+func open(dsn string, cfg *config.Config, log *logger.Logger) (*gorm.DB, error) {
 	// Configure GORM logger with traceID and clientIP support
 	var gormLogLevel gormlogger.LogLevel
 	switch cfg.Logging.Level {
@@ -36,7 +51,7 @@ func NewGormDB(cfg *config.Config, log *logger.Logger) (*gorm.DB, error) {
 	}
 
 	// Open database connection
-	db, err := gorm.Open(postgres.Open(cfg.Database.DSN()), gormConfig)
+	db, err := gorm.Open(postgres.Open(dsn), gormConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
@@ -57,11 +72,6 @@ func NewGormDB(cfg *config.Config, log *logger.Logger) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	log.Info().
-		Str("host", cfg.Database.Host).
-		Str("dbname", cfg.Database.DBName).
-		Msg("Database connection established")
-
 	return db, nil
 }
 
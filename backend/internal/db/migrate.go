@@ -0,0 +1,179 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/golang-migrate/migrate/v4"
+	pgxmigrate "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/migrations"
+)
+
+// Migrator applies the embedded migrations and reports the schema version
+// It records the applied version in schema_migrations, as the golang-migrate CLI does, so
+// databases migrated with either are interchangeable.
+type Migrator struct {
+	m      *migrate.Migrate
+	latest uint
+}
+
+// NewMigrator connects to dsn on a connection of its own; Close releases it
+func NewMigrator(dsn string) (*Migrator, error) {
+	latest, err := LatestMigration(migrations.FS)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	driver, err := pgxmigrate.WithInstance(sqlDB, &pgxmigrate.Config{})
+	if err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to open migration driver: %w", err)
+	}
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		driver.Close()
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+	m, err := migrate.NewWithInstance("iofs", src, "postgres", driver)
+	if err != nil {
+		driver.Close()
+		return nil, fmt.Errorf("failed to create migrator: %w", err)
+	}
+	return &Migrator{m: m, latest: latest}, nil
+}
+
+// Up applies every pending migration
+func (m *Migrator) Up() error {
+	return ignoreNoChange(m.m.Up())
+}
+
+// Steps applies n migrations, or reverts -n when n is negative
+func (m *Migrator) Steps(n int) error {
+	return ignoreNoChange(m.m.Steps(n))
+}
+
+// DownAll reverts every applied migration
+func (m *Migrator) DownAll() error {
+	return ignoreNoChange(m.m.Down())
+}
+
+// Goto migrates up or down to version
+func (m *Migrator) Goto(version uint) error {
+	return ignoreNoChange(m.m.Migrate(version))
+}
+
+// Force records version as applied and clean without running anything
+// It is how a dirty schema is recovered once the failed migration was fixed by hand.
+func (m *Migrator) Force(version int) error {
+	return m.m.Force(version)
+}
+
+// Status returns the schema's applied version next to the newest migration
+func (m *Migrator) Status() (SchemaStatus, error) {
+	version, dirty, err := m.m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return SchemaStatus{}, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return SchemaStatus{Version: version, Dirty: dirty, Latest: m.latest}, nil
+}
+
+// Close releases the migrator's connection
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.m.Close()
+	return errors.Join(srcErr, dbErr)
+}
+
+func ignoreNoChange(err error) error {
+	if errors.Is(err, migrate.ErrNoChange) {
+		return nil
+	}
+	return err
+}
+
+// SchemaStatus is a database's applied migration version next to the newest one this build has
+type SchemaStatus struct {
+	Version uint // 0 when no migration was ever applied
+	Dirty   bool // A migration failed midway and was not recovered
+	Latest  uint
+}
+
+// Err describes why the schema cannot serve this build, or returns nil
+// A schema ahead of this build is fine: during a rolling deploy older replicas run
+// against the migrated schema until they are replaced.
+func (s SchemaStatus) Err() error {
+	switch {
+	case s.Dirty:
+		return fmt.Errorf("schema is dirty at migration %d: fix the failed migration, then run migrate force %d", s.Version, s.Version)
+	case s.Version == 0:
+		return fmt.Errorf("no migrations applied: run migrate up to apply the %d migrations", s.Latest)
+	case s.Version < s.Latest:
+		return fmt.Errorf("schema is at migration %d but this build needs %d: run migrate up", s.Version, s.Latest)
+	}
+	return nil
+}
+
+// Ahead reports whether the schema was migrated past this build's newest migration
+func (s SchemaStatus) Ahead() bool {
+	return s.Version > s.Latest
+}
+
+// CheckSchema compares the database's schema with the newest migration, as DB_MIGRATION_CHECK says
+// It fails startup on a dirty or outdated schema, or only logs it with warn.
+func CheckSchema(cfg *config.Config, log *logger.Logger) error {
+	if cfg.Database.MigrationCheck == "off" {
+		return nil
+	}
+
+	m, err := NewMigrator(cfg.Database.DSN())
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	status, err := m.Status()
+	if err != nil {
+		return err
+	}
+	if status.Ahead() {
+		log.Warn().
+			Uint("version", status.Version).
+			Uint("latest", status.Latest).
+			Msg("Schema is ahead of this build's migrations")
+	}
+	if err := status.Err(); err != nil {
+		if cfg.Database.MigrationCheck == "warn" {
+			log.Warn().Err(err).Msg("Schema drift")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// LatestMigration returns the highest migration version in fsys
+func LatestMigration(fsys fs.FS) (uint, error) {
+	names, err := fs.Glob(fsys, "*.up.sql")
+	if err != nil {
+		return 0, err
+	}
+	var latest uint
+	for _, name := range names {
+		migration, err := source.DefaultParse(name)
+		if err != nil {
+			return 0, fmt.Errorf("migration %s: %w", name, err)
+		}
+		latest = max(latest, migration.Version)
+	}
+	return latest, nil
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"io/fs"
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/slotmachine/backend/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrations_EveryUpHasADown(t *testing.T) {
+	names, err := fs.Glob(migrations.FS, "*.sql")
+	require.NoError(t, err)
+
+	ups := map[uint]string{}
+	downs := map[uint]string{}
+	for _, name := range names {
+		m, err := source.DefaultParse(name)
+		require.NoError(t, err, name)
+		byDirection := ups
+		if m.Direction == source.Down {
+			byDirection = downs
+		}
+		other, taken := byDirection[m.Version]
+		require.False(t, taken, "%s and %s share version %d", name, other, m.Version)
+		byDirection[m.Version] = m.Identifier
+	}
+
+	for version, name := range ups {
+		assert.Equal(t, name, downs[version], "migration %d has no matching down", version)
+	}
+	assert.Len(t, downs, len(ups))
+}
+
+func TestLatestMigration(t *testing.T) {
+	fsys := fstest.MapFS{
+		"000001_a.up.sql":   {},
+		"000001_a.down.sql": {},
+		"000012_b.up.sql":   {},
+		"000012_b.down.sql": {},
+		"README.md":         {},
+	}
+	latest, err := LatestMigration(fsys)
+	require.NoError(t, err)
+	assert.EqualValues(t, 12, latest)
+
+	latest, err = LatestMigration(fstest.MapFS{})
+	require.NoError(t, err)
+	assert.Zero(t, latest)
+}
+
+func TestSchemaStatus(t *testing.T) {
+	assert.NoError(t, SchemaStatus{Version: 68, Latest: 68}.Err())
+	assert.ErrorContains(t, SchemaStatus{Version: 0, Latest: 68}.Err(), "no migrations applied")
+	assert.ErrorContains(t, SchemaStatus{Version: 60, Latest: 68}.Err(), "schema is at migration 60 but this build needs 68")
+	assert.ErrorContains(t, SchemaStatus{Version: 68, Latest: 68, Dirty: true}.Err(), "migrate force 68")
+
+	// Rolling deploys run older builds against a newer schema
+	ahead := SchemaStatus{Version: 69, Latest: 68}
+	assert.NoError(t, ahead.Err())
+	assert.True(t, ahead.Ahead())
+}
+
+// TestMigrator_UpDown applies every migration, reverts them all and applies them again,
+// so each down really undoes its up. It needs an empty PostgreSQL database.
+func TestMigrator_UpDown(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set")
+	}
+	m, err := NewMigrator(dsn)
+	require.NoError(t, err)
+	defer m.Close()
+
+	status, err := m.Status()
+	require.NoError(t, err)
+	require.Zero(t, status.Version, "the database must be empty")
+
+	require.NoError(t, m.Up())
+	status, err = m.Status()
+	require.NoError(t, err)
+	assert.Equal(t, status.Latest, status.Version)
+	assert.NoError(t, status.Err())
+
+	require.NoError(t, m.DownAll())
+	status, err = m.Status()
+	require.NoError(t, err)
+	assert.Zero(t, status.Version)
+	assert.ErrorContains(t, status.Err(), "no migrations applied")
+
+	require.NoError(t, m.Up())
+	status, err = m.Status()
+	require.NoError(t, err)
+	assert.NoError(t, status.Err())
+}
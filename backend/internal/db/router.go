@@ -0,0 +1,70 @@
+package db
+
+import (
+	"sync/atomic"
+
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"gorm.io/gorm"
+)
+
+// Router routes lag-tolerant reads to read replicas and everything else to the primary
+type Router struct {
+	primary  *gorm.DB
+	replicas []*gorm.DB
+	next     atomic.Uint64
+}
+
+// NewRouter creates a router over primary and zero or more replicas
+func NewRouter(primary *gorm.DB, replicas ...*gorm.DB) *Router {
+	return &Router{primary: primary, replicas: replicas}
+}
+
+// NewRouterFromConfig connects to the configured replicas. Replicas that cannot be
+// reached are skipped, so reads fall back to the primary rather than failing startup
+func NewRouterFromConfig(cfg *config.Config, primary *gorm.DB, log *logger.Logger) *Router {
+	var replicas []*gorm.DB
+	for i, dsn := range cfg.Database.ReplicaDSNs() {
+		replica, err := open(dsn, cfg, log)
+		if err != nil {
+			log.Warn().Err(err).Int("replica", i).Msg("Read replica unavailable, routing its reads to primary")
+			continue
+		}
+		replicas = append(replicas, replica)
+	}
+
+	if len(replicas) > 0 {
+		log.Info().Int("replicas", len(replicas)).Msg("Read replica connections established")
+	}
+	return NewRouter(primary, replicas...)
+}
+
+// Primary returns the primary connection, used for writes and consistency-sensitive reads
+func (r *Router) Primary() *gorm.DB {
+	return r.primary
+}
+
+// Reader returns a replica connection in round-robin order, or the primary when none are configured
+func (r *Router) Reader() *gorm.DB {
+	if len(r.replicas) == 0 {
+		return r.primary
+	}
+	n := r.next.Add(1) - 1
+	return r.replicas[n%uint64(len(r.replicas))]
+}
+
+// Replicas returns the number of replica connections
+func (r *Router) Replicas() int {
+	return len(r.replicas)
+}
+
+// Close closes the replica connections; the primary is closed separately
+func (r *Router) Close(log *logger.Logger) error {
+	var firstErr error
+	for _, replica := range r.replicas {
+		if err := Close(replica, log); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
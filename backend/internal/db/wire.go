@@ -10,9 +10,15 @@ import (
 // ProviderSet is the Wire provider set for database
 var ProviderSet = wire.NewSet(
 	ProvideDatabase,
+	ProvideRouter,
 )
 
 // ProvideDatabase creates a new GORM database connection
 func ProvideDatabase(cfg *config.Config, log *logger.Logger) (*gorm.DB, error) {
 	return NewGormDB(cfg, log)
 }
+
+// ProvideRouter creates the read/write router over the primary connection
+func ProvideRouter(cfg *config.Config, primary *gorm.DB, log *logger.Logger) *Router {
+	return NewRouterFromConfig(cfg, primary, log)
+}
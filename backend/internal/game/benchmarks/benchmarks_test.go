@@ -0,0 +1,29 @@
+package benchmarks
+
+import (
+	"testing"
+)
+
+func runCase(b *testing.B, name string) {
+	f, err := NewFixture()
+	if err != nil {
+		b.Fatal(err)
+	}
+	for _, c := range f.Cases() {
+		if c.Name == name {
+			b.ResetTimer()
+			c.Run(b)
+			return
+		}
+	}
+	b.Fatalf("no benchmark case %q", name)
+}
+
+func BenchmarkGenerateGrid(b *testing.B)            { runCase(b, "GenerateGrid") }
+func BenchmarkGenerateGridUncompiled(b *testing.B)  { runCase(b, "GenerateGridUncompiled") }
+func BenchmarkCalculateWays(b *testing.B)           { runCase(b, "CalculateWays") }
+func BenchmarkCalculateCascadeWin(b *testing.B)     { runCase(b, "CalculateCascadeWin") }
+func BenchmarkExecuteCascades(b *testing.B)         { runCase(b, "ExecuteCascades") }
+func BenchmarkExecuteSpin(b *testing.B)             { runCase(b, "ExecuteSpin") }
+func BenchmarkExecuteSpinProvablyFair(b *testing.B) { runCase(b, "ExecuteSpinProvablyFair") }
+func BenchmarkExecuteFreeSpin(b *testing.B)         { runCase(b, "ExecuteFreeSpin") }
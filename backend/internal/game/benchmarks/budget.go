@@ -0,0 +1,171 @@
+package benchmarks
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"runtime"
+	"sort"
+	"testing"
+	"time"
+)
+
+// Result is one benchmark's measurement, in the units of go test -benchmem
+type Result struct {
+	Name        string  `json:"name"`
+	Iterations  int     `json:"iterations"`
+	NsPerOp     float64 `json:"ns_per_op"`
+	AllocsPerOp int64   `json:"allocs_per_op"`
+	BytesPerOp  int64   `json:"bytes_per_op"`
+}
+
+// Report is a suite run, written as JSON so CI runs can be compared
+type Report struct {
+	GoVersion string    `json:"go_version"`
+	GOOS      string    `json:"goos"`
+	GOARCH    string    `json:"goarch"`
+	CPUs      int       `json:"cpus"`
+	StartedAt time.Time `json:"started_at"`
+	Results   []Result  `json:"results"`
+}
+
+// Run runs the cases whose name matches filter (all when nil)
+// testing.Init must have been called first outside of tests.
+func Run(cases []Case, filter *regexp.Regexp) Report {
+	report := Report{
+		GoVersion: runtime.Version(),
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+		CPUs:      runtime.NumCPU(),
+		StartedAt: time.Now().UTC(),
+	}
+	for _, c := range cases {
+		if filter != nil && !filter.MatchString(c.Name) {
+			continue
+		}
+		r := testing.Benchmark(c.Run)
+		report.Results = append(report.Results, Result{
+			Name:        c.Name,
+			Iterations:  r.N,
+			NsPerOp:     float64(r.T.Nanoseconds()) / float64(max(r.N, 1)),
+			AllocsPerOp: r.AllocsPerOp(),
+			BytesPerOp:  r.AllocedBytesPerOp(),
+		})
+	}
+	return report
+}
+
+// ReadReport reads a report written by a previous run
+func ReadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return &report, nil
+}
+
+// WriteFile writes the report as indented JSON
+func (r Report) WriteFile(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Limit is the budget of one benchmark; zero fields are not checked
+type Limit struct {
+	MaxNsPerOp     float64 `json:"max_ns_per_op,omitempty"`
+	MaxAllocsPerOp int64   `json:"max_allocs_per_op,omitempty"`
+}
+
+// Budget maps benchmark names to their limits
+type Budget map[string]Limit
+
+//go:embed budget.json
+var defaultBudget []byte
+
+// DefaultBudget is the budget the hot path is released against
+// Time limits leave headroom for slower CI machines; allocation limits are tight since
+// allocations do not depend on the machine.
+func DefaultBudget() (Budget, error) {
+	var budget Budget
+	if err := json.Unmarshal(defaultBudget, &budget); err != nil {
+		return nil, fmt.Errorf("parse default budget: %w", err)
+	}
+	return budget, nil
+}
+
+// ReadBudget reads a budget file
+func ReadBudget(path string) (Budget, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var budget Budget
+	if err := json.Unmarshal(data, &budget); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return budget, nil
+}
+
+// Regression is a benchmark over its budget or slower than its baseline
+type Regression struct {
+	Name   string  `json:"name"`
+	Metric string  `json:"metric"` // ns_per_op or allocs_per_op
+	Value  float64 `json:"value"`
+	Limit  float64 `json:"limit"`
+}
+
+func (r Regression) String() string {
+	return fmt.Sprintf("%s: %s %.0f over %.0f", r.Name, r.Metric, r.Value, r.Limit)
+}
+
+// Check returns the results over their limits
+func (b Budget) Check(report Report) []Regression {
+	regressions := make([]Regression, 0)
+	for _, r := range report.Results {
+		limit, ok := b[r.Name]
+		if !ok {
+			continue
+		}
+		if limit.MaxNsPerOp > 0 && r.NsPerOp > limit.MaxNsPerOp {
+			regressions = append(regressions, Regression{r.Name, "ns_per_op", r.NsPerOp, limit.MaxNsPerOp})
+		}
+		if limit.MaxAllocsPerOp > 0 && r.AllocsPerOp > limit.MaxAllocsPerOp {
+			regressions = append(regressions, Regression{r.Name, "allocs_per_op", float64(r.AllocsPerOp), float64(limit.MaxAllocsPerOp)})
+		}
+	}
+	return regressions
+}
+
+// CompareBaseline returns the results more than tolerance (0.1 = 10%) slower, or allocating
+// more, than the same benchmark in baseline; benchmarks missing from baseline are skipped
+func CompareBaseline(baseline, report Report, tolerance float64) []Regression {
+	previous := make(map[string]Result, len(baseline.Results))
+	for _, r := range baseline.Results {
+		previous[r.Name] = r
+	}
+
+	regressions := make([]Regression, 0)
+	for _, r := range report.Results {
+		base, ok := previous[r.Name]
+		if !ok {
+			continue
+		}
+		if limit := base.NsPerOp * (1 + tolerance); r.NsPerOp > limit {
+			regressions = append(regressions, Regression{r.Name, "ns_per_op", r.NsPerOp, limit})
+		}
+		if limit := float64(base.AllocsPerOp) * (1 + tolerance); float64(r.AllocsPerOp) > limit {
+			regressions = append(regressions, Regression{r.Name, "allocs_per_op", float64(r.AllocsPerOp), limit})
+		}
+	}
+	sort.Slice(regressions, func(i, j int) bool { return regressions[i].Name < regressions[j].Name })
+	return regressions
+}
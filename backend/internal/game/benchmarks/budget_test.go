@@ -0,0 +1,79 @@
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultBudget_CoversSuite(t *testing.T) {
+	budget, err := DefaultBudget()
+	require.NoError(t, err)
+	f, err := NewFixture()
+	require.NoError(t, err)
+
+	for _, c := range f.Cases() {
+		limit, ok := budget[c.Name]
+		if assert.True(t, ok, "%s has no budget", c.Name) {
+			assert.Positive(t, limit.MaxNsPerOp, c.Name)
+			assert.Positive(t, limit.MaxAllocsPerOp, c.Name)
+		}
+	}
+	assert.Len(t, budget, len(f.Cases()), "budget entries without a benchmark")
+}
+
+func TestFixture_DBBackedStrips(t *testing.T) {
+	f, err := NewFixture()
+	require.NoError(t, err)
+
+	assert.NotNil(t, f.Base.ConfigID, "base strips come from a config")
+	assert.NotNil(t, f.Free.ConfigID, "free spins strips come from a config")
+	assert.NotNil(t, f.Base.Compiled)
+	assert.NotEqual(t, f.Base.Strips, f.Free.Strips)
+
+	again, err := NewFixture()
+	require.NoError(t, err)
+	assert.Equal(t, f.Base.Strips, again.Base.Strips, "strips are fixed by the seed")
+	assert.Equal(t, f.Grid, again.Grid)
+}
+
+func TestBudget_Check(t *testing.T) {
+	budget := Budget{
+		"Fast":  {MaxNsPerOp: 100, MaxAllocsPerOp: 2},
+		"Loose": {MaxNsPerOp: 100},
+	}
+	report := Report{Results: []Result{
+		{Name: "Fast", NsPerOp: 150, AllocsPerOp: 3},
+		{Name: "Loose", NsPerOp: 90, AllocsPerOp: 50},
+		{Name: "Unbudgeted", NsPerOp: 1e9, AllocsPerOp: 1e6},
+	}}
+
+	regressions := budget.Check(report)
+
+	assert.Equal(t, []Regression{
+		{Name: "Fast", Metric: "ns_per_op", Value: 150, Limit: 100},
+		{Name: "Fast", Metric: "allocs_per_op", Value: 3, Limit: 2},
+	}, regressions)
+}
+
+func TestCompareBaseline(t *testing.T) {
+	baseline := Report{Results: []Result{
+		{Name: "Spin", NsPerOp: 1000, AllocsPerOp: 10},
+		{Name: "Grid", NsPerOp: 100, AllocsPerOp: 2},
+	}}
+	report := Report{Results: []Result{
+		{Name: "Spin", NsPerOp: 1150, AllocsPerOp: 10},
+		{Name: "Grid", NsPerOp: 105, AllocsPerOp: 3},
+		{Name: "New", NsPerOp: 5000, AllocsPerOp: 40},
+	}}
+
+	regressions := CompareBaseline(baseline, report, 0.1)
+
+	require.Len(t, regressions, 2)
+	assert.Equal(t, "Grid", regressions[0].Name)
+	assert.Equal(t, "allocs_per_op", regressions[0].Metric)
+	assert.Equal(t, "Spin", regressions[1].Name)
+	assert.Equal(t, "ns_per_op", regressions[1].Metric)
+	assert.InDelta(t, 1100, regressions[1].Limit, 1e-9)
+}
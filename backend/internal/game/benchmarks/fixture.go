@@ -0,0 +1,159 @@
+// Package benchmarks measures the spin engine hot path and checks it against a performance budget
+package benchmarks
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/game/engine"
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/game/wins"
+)
+
+// Seed fixes the strips and the spin outcomes so runs are comparable
+const Seed = 20240601
+
+// BetAmount is the bet every benchmark spins at
+const BetAmount = 1.0
+
+// PlayerID is the player the engine benchmarks spin as
+var PlayerID = uuid.MustParse("00000000-0000-0000-0000-00000000be4c")
+
+// Fixture is a game engine serving reel strips the way it does from the database
+// The strips are shuffled from the same weights the stored strips are generated from and come
+// through a reel strip service as complete config sets, so spins take the compiled-strip path.
+type Fixture struct {
+	Engine    *engine.GameEngine
+	Base      *engine.ReelStripsResult // Base game strips as the engine resolves them for PlayerID
+	Free      *engine.ReelStripsResult // Free spins strips as the engine resolves them for PlayerID
+	Rules     engine.GameRules
+	Grid      reels.Grid // A base game grid with at least one win, for cascade and win evaluation
+	Positions []int      // Reel positions Grid was drawn at
+}
+
+// NewFixture builds the engine and strips the benchmarks run against
+func NewFixture() (*Fixture, error) {
+	service := &stripService{
+		base: newConfigSet(reelstrip.BaseGame, false),
+		free: newConfigSet(reelstrip.FreeSpins, true),
+	}
+	f := &Fixture{
+		Engine: engine.NewGameEngine(service, nil, true),
+		Rules:  engine.DefaultGameRules,
+	}
+
+	var err error
+	ctx := context.Background()
+	if f.Base, err = f.Engine.GetReelStripsWithConfigID(ctx, PlayerID, false); err != nil {
+		return nil, fmt.Errorf("load base game strips: %w", err)
+	}
+	if f.Free, err = f.Engine.GetReelStripsWithConfigID(ctx, PlayerID, true); err != nil {
+		return nil, fmt.Errorf("load free spins strips: %w", err)
+	}
+	if f.Base.Compiled == nil || f.Free.Compiled == nil {
+		return nil, fmt.Errorf("strips did not compile")
+	}
+
+	// The first winning grid of the seeded sequence
+	seeded := rng.NewFastRNGWithSeed(Seed)
+	for i := 0; i < 1000 && f.Grid == nil; i++ {
+		grid, positions, err := f.Base.GenerateGrid(seeded)
+		if err != nil {
+			return nil, err
+		}
+		if wins.HasAnyWins(grid) {
+			f.Grid, f.Positions = grid, positions
+		}
+	}
+	if f.Grid == nil {
+		return nil, fmt.Errorf("no winning grid in 1000 draws")
+	}
+	return f, nil
+}
+
+// newConfigSet builds a complete, active config set of seeded strips for gameMode
+func newConfigSet(gameMode reelstrip.GameMode, isFreeSpin bool) *reelstrip.ReelStripConfigSet {
+	shuffle := rand.New(rand.NewSource(Seed))
+	set := &reelstrip.ReelStripConfigSet{
+		Config: &reelstrip.ReelStripConfig{
+			ID:        uuid.NewSHA1(uuid.NameSpaceOID, []byte("benchmarks/"+string(gameMode))),
+			Name:      "benchmarks-" + string(gameMode),
+			GameMode:  string(gameMode),
+			IsActive:  true,
+			IsDefault: true,
+		},
+	}
+	for reel := 0; reel < reels.ReelCount; reel++ {
+		weights := symbols.GetBaseGameWeights(reel)
+		if isFreeSpin {
+			weights = symbols.GetFreeSpinsWeights(reel)
+		}
+
+		// Weights are a map; build the pool in symbol order so the seed fixes the strip
+		names := make([]string, 0, len(weights))
+		for sym := range weights {
+			names = append(names, sym)
+		}
+		sort.Strings(names)
+		strip := make([]string, 0)
+		for _, sym := range names {
+			for i := 0; i < weights[sym]; i++ {
+				strip = append(strip, sym)
+			}
+		}
+		shuffle.Shuffle(len(strip), func(i, j int) { strip[i], strip[j] = strip[j], strip[i] })
+
+		set.Strips[reel] = &reelstrip.ReelStrip{
+			ID:          uuid.NewSHA1(set.Config.ID, []byte{byte(reel)}),
+			GameMode:    string(gameMode),
+			ReelNumber:  reel,
+			StripData:   strip,
+			Checksum:    reelstrip.ComputeChecksum(strip),
+			StripLength: len(strip),
+			IsActive:    true,
+		}
+	}
+	return set
+}
+
+// stripService serves the fixture's config sets as the reel strip service would from its cache
+type stripService struct {
+	reelstrip.Service
+	base, free *reelstrip.ReelStripConfigSet
+}
+
+func (s *stripService) set(gameMode string) (*reelstrip.ReelStripConfigSet, error) {
+	switch reelstrip.GameMode(gameMode) {
+	case reelstrip.BaseGame:
+		return s.base, nil
+	case reelstrip.FreeSpins:
+		return s.free, nil
+	}
+	return nil, reelstrip.ErrInvalidGameMode
+}
+
+// GetReelSetForPlayer implements reelstrip.Service
+func (s *stripService) GetReelSetForPlayer(ctx context.Context, playerID uuid.UUID, gameMode string) (*reelstrip.ReelStripConfigSet, error) {
+	return s.set(gameMode)
+}
+
+// GetDefaultReelSet implements reelstrip.Service
+func (s *stripService) GetDefaultReelSet(ctx context.Context, gameMode string) (*reelstrip.ReelStripConfigSet, error) {
+	return s.set(gameMode)
+}
+
+// GetReelSetByConfig implements reelstrip.Service
+func (s *stripService) GetReelSetByConfig(ctx context.Context, configID uuid.UUID) (*reelstrip.ReelStripConfigSet, error) {
+	for _, set := range []*reelstrip.ReelStripConfigSet{s.base, s.free} {
+		if set.Config.ID == configID {
+			return set, nil
+		}
+	}
+	return nil, reelstrip.ErrConfigNotFound
+}
@@ -0,0 +1,124 @@
+package benchmarks
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/game/cascade"
+	"github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/game/wins"
+)
+
+// Case is one benchmark of the suite
+type Case struct {
+	Name string
+	Run  func(b *testing.B)
+}
+
+// Cases returns the hot path benchmarks, from drawing a grid up to a full provably fair spin
+func (f *Fixture) Cases() []Case {
+	return []Case{
+		{"GenerateGrid", f.benchGenerateGrid},
+		{"GenerateGridUncompiled", f.benchGenerateGridUncompiled},
+		{"CalculateWays", f.benchCalculateWays},
+		{"CalculateCascadeWin", f.benchCalculateCascadeWin},
+		{"ExecuteCascades", f.benchExecuteCascades},
+		{"ExecuteSpin", f.benchExecuteSpin},
+		{"ExecuteSpinProvablyFair", f.benchExecuteSpinProvablyFair},
+		{"ExecuteFreeSpin", f.benchExecuteFreeSpin},
+	}
+}
+
+func (f *Fixture) benchGenerateGrid(b *testing.B) {
+	seeded := rng.NewFastRNGWithSeed(Seed)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := f.Base.GenerateGrid(seeded); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// benchGenerateGridUncompiled draws from the string strips, the path of sets that fail to compile
+func (f *Fixture) benchGenerateGridUncompiled(b *testing.B) {
+	seeded := rng.NewFastRNGWithSeed(Seed)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := reels.GenerateGrid(f.Base.Strips, seeded); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func (f *Fixture) benchCalculateWays(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		wins.CalculateWays(f.Grid)
+	}
+}
+
+func (f *Fixture) benchCalculateCascadeWin(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		wins.CalculateCascadeWinWithFeatures(f.Grid, BetAmount, 1, false, f.Rules.Multipliers, f.Rules.Wilds)
+	}
+}
+
+func (f *Fixture) benchExecuteCascades(b *testing.B) {
+	seeded := rng.NewFastRNGWithSeed(Seed)
+	positions := make([]int, len(f.Positions))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(positions, f.Positions)
+		_, _, err := cascade.ExecuteCascadesWithFeatures(f.Grid, f.Base.Strips, positions, BetAmount, false, seeded, f.Rules.Multipliers, f.Rules.Wilds)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func (f *Fixture) benchExecuteSpin(b *testing.B) {
+	ctx := context.Background()
+	seeded := rng.NewFastRNGWithSeed(Seed)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := f.Engine.ExecuteBaseSpinWithRNG(ctx, PlayerID, BetAmount, string(reelstrip.BaseGame), seeded); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// benchExecuteSpinProvablyFair derives each spin's RNG from the hash chain as the spin service does
+func (f *Fixture) benchExecuteSpinProvablyFair(b *testing.B) {
+	ctx := context.Background()
+	serverSeed := fmt.Sprintf("%064x", Seed)
+	prevSpinHash := fmt.Sprintf("%064x", Seed+1)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		spinRNG, err := rng.NewHKDFStreamRNG(serverSeed, "benchmarks", int64(i+1), prevSpinHash)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := f.Engine.ExecuteBaseSpinWithRNG(ctx, PlayerID, BetAmount, string(reelstrip.BaseGame), spinRNG); err != nil {
+			b.Fatal(err)
+		}
+		prevSpinHash = spinRNG.GetSpinHash()
+	}
+}
+
+func (f *Fixture) benchExecuteFreeSpin(b *testing.B) {
+	ctx := context.Background()
+	seeded := rng.NewFastRNGWithSeed(Seed)
+	session := freespins.NewSession(uuid.Nil, 3, BetAmount, nil)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := f.Engine.ExecuteFreeSpinWithRNG(ctx, PlayerID, session, 1, seeded); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
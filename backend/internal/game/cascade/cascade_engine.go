@@ -5,6 +5,7 @@ import (
 	"github.com/slotmachine/backend/internal/game/reels"
 	"github.com/slotmachine/backend/internal/game/rng"
 	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/game/wilds"
 	"github.com/slotmachine/backend/internal/game/wins"
 )
 
@@ -18,9 +19,10 @@ type CascadeResult struct {
 	TotalCascadeWin float64                 `json:"total_cascade_win"`
 	Multiplier      int                     `json:"multiplier"`
 	WinningSymbols  []symbols.Symbol        `json:"winning_symbols"`
+	ExpandedReels   []int                   `json:"expanded_reels,omitempty"` // Reels covered by expanding wilds before this cascade's wins
 }
 
-// ExecuteCascades executes all cascades for a spin
+// ExecuteCascades executes all cascades for a spin using the default multiplier ladder
 // Returns all cascade results and the final grid
 func ExecuteCascades(
 	initialGrid reels.Grid,
@@ -29,21 +31,62 @@ func ExecuteCascades(
 	betAmount float64,
 	isFreeSpin bool,
 	rngInstance rng.RNG,
+) ([]CascadeResult, reels.Grid, error) {
+	return ExecuteCascadesWithLadder(initialGrid, reelStrips, reelPositions, betAmount, isFreeSpin, rngInstance, multiplier.DefaultLadder)
+}
+
+// ExecuteCascadesWithLadder executes all cascades for a spin using the given multiplier ladder
+func ExecuteCascadesWithLadder(
+	initialGrid reels.Grid,
+	reelStrips []reels.ReelStrip,
+	reelPositions []int,
+	betAmount float64,
+	isFreeSpin bool,
+	rngInstance rng.RNG,
+	ladder multiplier.Ladder,
+) ([]CascadeResult, reels.Grid, error) {
+	return ExecuteCascadesWithFeatures(initialGrid, reelStrips, reelPositions, betAmount, isFreeSpin, rngInstance, ladder, wilds.Features{})
+}
+
+// ExecuteCascadesWithFeatures executes all cascades for a spin using the given multiplier ladder
+// and wild features. Wild features never draw from the RNG, so outcomes stay replayable from
+// the reel positions alone.
+func ExecuteCascadesWithFeatures(
+	initialGrid reels.Grid,
+	reelStrips []reels.ReelStrip,
+	reelPositions []int,
+	betAmount float64,
+	isFreeSpin bool,
+	rngInstance rng.RNG,
+	ladder multiplier.Ladder,
+	features wilds.Features,
 ) ([]CascadeResult, reels.Grid, error) {
 	cascadeResults := make([]CascadeResult, 0)
 	currentGrid := initialGrid.Clone()
 	cascadeNumber := 0
 
+	// Removal and gravity work on a pooled grid; only the refilled grid is kept in a result
+	scratch := reels.AcquireScratchGrid()
+	defer scratch.Release()
+
 	// Execute first cascade (initial grid evaluation)
 	for {
 		cascadeNumber++
 
+		// Expanding wilds cover their reel before wins are evaluated
+		evalGrid := currentGrid
+		var expandedReels []int
+		if features.ExpandingWilds {
+			evalGrid, expandedReels = wilds.Expand(currentGrid)
+		}
+
 		// Calculate win for this cascade
-		winDetails, symbolWins, totalWin := wins.CalculateCascadeWin(currentGrid, betAmount, cascadeNumber, isFreeSpin)
+		winDetails, symbolWins, totalWin := wins.CalculateCascadeWinWithFeatures(evalGrid, betAmount, cascadeNumber, isFreeSpin, ladder, features)
 		if len(symbolWins) == 0 {
-			// No wins, cascade sequence ends
+			// No wins, cascade sequence ends (an expansion that paid nothing is not kept)
 			break
 		}
+		currentGrid = evalGrid
 
 		// Get winning symbols for position tracking
 		winningSymbols := make([]symbols.Symbol, 0)
@@ -51,14 +94,19 @@ func ExecuteCascades(
 			winningSymbols = append(winningSymbols, w.Symbol)
 		}
 
+		work := scratch.Grid()
+		if !scratch.CopyFrom(currentGrid) {
+			work = currentGrid.Clone()
+		}
+
 		// Remove winning symbols
-		currentGrid = removeWinningSymbols(currentGrid, symbolWins)
+		clearWinningSymbols(work, currentGrid, symbolWins)
 
 		// Drop symbols down (gravity)
-		currentGrid = dropSymbols(currentGrid)
+		applyGravity(work)
 
 		// Fill empty positions from reel strips AND get buffer symbols
-		currentGrid, reelPositions = fillEmptyPositions(currentGrid, reelStrips, reelPositions)
+		currentGrid, reelPositions = fillEmptyPositions(work, reelStrips, reelPositions)
 
 		// Store cascade result with extended grid
 		cascadeResult := CascadeResult{
@@ -66,8 +114,9 @@ func ExecuteCascades(
 			GridAfter:       currentGrid, // Variable-length columns for smooth animation
 			Wins:            winDetails,
 			TotalCascadeWin: totalWin,
-			Multiplier:      multiplier.GetMultiplier(cascadeNumber, isFreeSpin),
+			Multiplier:      ladder.Get(cascadeNumber, isFreeSpin),
 			WinningSymbols:  winningSymbols,
+			ExpandedReels:   expandedReels,
 		}
 
 		cascadeResults = append(cascadeResults, cascadeResult)
@@ -81,7 +130,13 @@ func ExecuteCascades(
 // removeWinningSymbols removes all winning symbols from the grid
 func removeWinningSymbols(grid reels.Grid, symbolWins []wins.SymbolWin) reels.Grid {
 	newGrid := grid.Clone()
+	clearWinningSymbols(newGrid, grid, symbolWins)
+	return newGrid
+}
 
+// clearWinningSymbols empties the winning positions of grid in dst (a copy of grid);
+// gold variants become wilds instead
+func clearWinningSymbols(dst, grid reels.Grid, symbolWins []wins.SymbolWin) {
 	for _, win := range symbolWins {
 		// Get all winning positions for this symbol
 		positions := wins.GetWinningPositions(grid, win.Symbol, win.Count)
@@ -90,28 +145,33 @@ func removeWinningSymbols(grid reels.Grid, symbolWins []wins.SymbolWin) reels.Gr
 		for _, pos := range positions {
 			sym := grid.GetSymbol(pos.Reel, pos.Row)
 			if symbols.IsGoldVariant(sym) {
-				newGrid.SetSymbol(pos.Reel, pos.Row, string(symbols.SymbolWild))
+				dst.SetSymbol(pos.Reel, pos.Row, string(symbols.SymbolWild))
 			} else {
-				newGrid.SetSymbol(pos.Reel, pos.Row, EmptySymbol)
+				dst.SetSymbol(pos.Reel, pos.Row, EmptySymbol)
 			}
 		}
 	}
-
-	return newGrid
 }
 
 // dropSymbols applies gravity - symbols drop down to fill empty spaces
 func dropSymbols(grid reels.Grid) reels.Grid {
 	newGrid := grid.Clone()
+	applyGravity(newGrid)
+	return newGrid
+}
 
+// applyGravity drops symbols down in place to fill empty spaces
+func applyGravity(grid reels.Grid) {
 	// Process each reel independently
 	for reelIdx := 0; reelIdx < reels.ReelCount; reelIdx++ {
 		// Collect non-empty symbols from bottom to top
-		nonEmptySymbols := make([]string, 0)
+		var nonEmptySymbols [reels.TotalRows]string
+		count := 0
 		for row := reels.TotalRows - 1; row >= 0; row-- {
-			symbol := newGrid.GetSymbol(reelIdx, row)
+			symbol := grid.GetSymbol(reelIdx, row)
 			if symbol != EmptySymbol {
-				nonEmptySymbols = append(nonEmptySymbols, symbol)
+				nonEmptySymbols[count] = symbol
+				count++
 			}
 		}
 
@@ -119,15 +179,13 @@ func dropSymbols(grid reels.Grid) reels.Grid {
 		// Fill from bottom up with non-empty symbols
 		for row := reels.TotalRows - 1; row >= 0; row-- {
 			bottomIndex := reels.TotalRows - 1 - row
-			if bottomIndex < len(nonEmptySymbols) {
-				newGrid.SetSymbol(reelIdx, row, nonEmptySymbols[bottomIndex])
+			if bottomIndex < count {
+				grid.SetSymbol(reelIdx, row, nonEmptySymbols[bottomIndex])
 			} else {
-				newGrid.SetSymbol(reelIdx, row, EmptySymbol)
+				grid.SetSymbol(reelIdx, row, EmptySymbol)
 			}
 		}
 	}
-
-	return newGrid
 }
 
 // fillEmptyPositions fills empty positions from reel strips
@@ -7,6 +7,7 @@ import (
 	"github.com/slotmachine/backend/internal/game/reels"
 	"github.com/slotmachine/backend/internal/game/rng"
 	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/game/wilds"
 	"github.com/slotmachine/backend/internal/game/wins"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -756,6 +757,51 @@ func TestExecuteCascades(t *testing.T) {
 	})
 }
 
+func TestExecuteCascadesWithFeatures(t *testing.T) {
+	// "fa" on reels 1 and 3, completed by a wild on reel 2
+	initialGrid := reels.Grid{
+		{"cai", "fu", "shu", "zhong", "liangtong", "fa", "zhong", "liangtong", "cai", "fu"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "wild", "bai", "bai", "bai", "zhong"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "fa", "bai", "bai", "bai", "fu"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "bai", "bai", "bai", "bai", "shu"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "bai", "bai", "bai", "bai", "zhong"},
+	}
+
+	reelStrips := make([]reels.ReelStrip, 5)
+	symbolCycle := []string{"cai", "fu", "shu", "zhong", "liangtong", "bai", "wusuo", "wutong"}
+	for i := 0; i < 5; i++ {
+		strip := make(reels.ReelStrip, 100)
+		for j := 0; j < 100; j++ {
+			strip[j] = symbolCycle[j%len(symbolCycle)]
+		}
+		reelStrips[i] = strip
+	}
+
+	t.Run("should expand wilds before evaluating wins", func(t *testing.T) {
+		cascadeResults, _, err := ExecuteCascadesWithFeatures(
+			initialGrid, reelStrips, []int{0, 0, 0, 0, 0}, 20.0, false, rng.NewCryptoRNG(),
+			multiplier.DefaultLadder, wilds.Features{ExpandingWilds: true},
+		)
+
+		require.NoError(t, err)
+		require.NotEmpty(t, cascadeResults)
+		assert.Equal(t, []int{1}, cascadeResults[0].ExpandedReels)
+		require.NotEmpty(t, cascadeResults[0].Wins)
+		assert.Equal(t, 4, cascadeResults[0].Wins[0].Ways, "the expanded reel adds a way per row")
+	})
+
+	t.Run("should not expand without the feature", func(t *testing.T) {
+		cascadeResults, _, err := ExecuteCascadesWithFeatures(
+			initialGrid, reelStrips, []int{0, 0, 0, 0, 0}, 20.0, false, rng.NewCryptoRNG(),
+			multiplier.DefaultLadder, wilds.Features{},
+		)
+
+		require.NoError(t, err)
+		require.NotEmpty(t, cascadeResults)
+		assert.Empty(t, cascadeResults[0].ExpandedReels)
+	})
+}
+
 // ============================================================================
 // GetTotalWinFromCascades TESTS
 // ============================================================================
@@ -889,3 +935,45 @@ func BenchmarkExecuteCascades(b *testing.B) {
 		_, _, _ = ExecuteCascades(initialGrid, reelStrips, reelPositions, betAmount, isFreeSpin, cryptoRNG)
 	}
 }
+
+// BenchmarkCascadeStep compares one remove/drop/refill step on a pooled scratch grid
+// against the cloning helpers, across goroutines, to show the allocations saved per cascade
+func BenchmarkCascadeStep(b *testing.B) {
+	grid := reels.Grid{
+		{"cai", "fu", "shu", "zhong", "liangtong", "fa", "fa", "fa", "cai", "fu"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "fa", "fa", "fa", "cai", "fu"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "fa_gold", "fa", "fa", "cai", "fu"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "zhong", "liangtong", "cai", "fu", "shu"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "zhong", "liangtong", "cai", "fu", "shu"},
+	}
+	symbolWins := wins.CalculateWays(grid)
+	reelStrips := make([]reels.ReelStrip, reels.ReelCount)
+	for i := range reelStrips {
+		reelStrips[i] = reels.ReelStrip{"liangtong", "wusuo", "bai", "zhong"}
+	}
+	reelPositions := []int{0, 0, 0, 0, 0}
+
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				scratch := reels.AcquireScratchGrid()
+				scratch.CopyFrom(grid)
+				clearWinningSymbols(scratch.Grid(), grid, symbolWins)
+				applyGravity(scratch.Grid())
+				_, _ = fillEmptyPositions(scratch.Grid(), reelStrips, reelPositions)
+				scratch.Release()
+			}
+		})
+	})
+
+	b.Run("cloned", func(b *testing.B) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				next := dropSymbols(removeWinningSymbols(grid, symbolWins))
+				_, _ = fillEmptyPositions(next, reelStrips, reelPositions)
+			}
+		})
+	})
+}
@@ -0,0 +1,151 @@
+package cascade
+
+import (
+	"testing"
+
+	"github.com/slotmachine/backend/internal/game/proptest"
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/game/wins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+// ============================================================================
+// PROPERTY TESTS
+// ============================================================================
+
+// requireFullGrid fails unless every cell of a ReelCount × TotalRows grid holds a symbol
+func requireFullGrid(t *rapid.T, grid reels.Grid) {
+	require.Len(t, grid, reels.ReelCount)
+	for reelIdx, column := range grid {
+		require.Len(t, column, reels.TotalRows, "reel %d", reelIdx)
+		for row, sym := range column {
+			require.NotEqual(t, EmptySymbol, sym, "reel %d row %d is empty", reelIdx, row)
+		}
+	}
+}
+
+// positionsInRange draws one position per reel within its strip
+func positionsInRange(t *rapid.T, strips []reels.ReelStrip) []int {
+	positions := make([]int, reels.ReelCount)
+	for reelIdx := range positions {
+		positions[reelIdx] = rapid.IntRange(0, len(strips[reelIdx])-1).Draw(t, "position")
+	}
+	return positions
+}
+
+func TestProperty_GravityIsIdempotent(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		once := dropSymbols(proptest.HoledGrid().Draw(t, "grid"))
+		assert.Equal(t, once, dropSymbols(once))
+	})
+}
+
+func TestProperty_GravityKeepsEachReelInOrder(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		grid := proptest.HoledGrid().Draw(t, "grid")
+		dropped := dropSymbols(grid)
+
+		for reelIdx := range grid {
+			var before, after []string
+			for _, sym := range grid[reelIdx] {
+				if sym != EmptySymbol {
+					before = append(before, sym)
+				}
+			}
+			empties := reels.TotalRows - len(before)
+			for row, sym := range dropped[reelIdx] {
+				if row < empties {
+					assert.Equal(t, EmptySymbol, sym, "reel %d: empty cells rise to the top", reelIdx)
+				} else {
+					after = append(after, sym)
+				}
+			}
+			assert.Equal(t, before, after, "reel %d: symbols fall without reordering", reelIdx)
+		}
+	})
+}
+
+func TestProperty_FillCompletesGridFromStrips(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		strips := proptest.Strips().Draw(t, "strips")
+		positions := positionsInRange(t, strips)
+		dropped := dropSymbols(proptest.HoledGrid().Draw(t, "grid"))
+
+		filled, newPositions := fillEmptyPositions(dropped, strips, positions)
+		requireFullGrid(t, filled)
+
+		for reelIdx := range dropped {
+			empties := 0
+			for _, sym := range dropped[reelIdx] {
+				if sym == EmptySymbol {
+					empties++
+				}
+			}
+			assert.GreaterOrEqual(t, newPositions[reelIdx], 0)
+			assert.Less(t, newPositions[reelIdx], len(strips[reelIdx]))
+			assert.Equal(t, strips[reelIdx].GetSymbolsFromPosition(newPositions[reelIdx], empties), filled[reelIdx][:empties],
+				"reel %d: empty cells fill from the strip above the reel position", reelIdx)
+			assert.Equal(t, dropped[reelIdx][empties:], filled[reelIdx][empties:], "reel %d: settled symbols stay put", reelIdx)
+		}
+
+		// A full grid has nothing left to fill
+		refilled, refilledPositions := fillEmptyPositions(filled, strips, newPositions)
+		assert.Equal(t, filled, refilled)
+		assert.Equal(t, newPositions, refilledPositions)
+	})
+}
+
+func TestProperty_CascadeSequence(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		strips := proptest.Strips().Draw(t, "strips")
+		seed := rapid.Int64().Draw(t, "rng_seed")
+		bet := proptest.Bet().Draw(t, "bet")
+		isFreeSpin := rapid.Bool().Draw(t, "free_spin")
+
+		grid, positions, err := reels.GenerateGrid(strips, rng.NewFastRNGWithSeed(seed))
+		require.NoError(t, err)
+		requireFullGrid(t, grid)
+
+		results, final, err := ExecuteCascades(grid, strips, positions, bet, isFreeSpin, rng.NewFastRNGWithSeed(seed))
+		require.NoError(t, err)
+		replay, replayFinal, err := ExecuteCascades(grid, strips, positions, bet, isFreeSpin, rng.NewFastRNGWithSeed(seed))
+		require.NoError(t, err)
+
+		prev := grid
+		for i, result := range results {
+			assert.Equal(t, i+1, result.CascadeNumber)
+			assert.GreaterOrEqual(t, result.TotalCascadeWin, 0.0, "cascade %d", result.CascadeNumber)
+			sum := 0.0
+			for _, win := range result.Wins {
+				assert.GreaterOrEqual(t, win.WinAmount, 0.0)
+				sum += win.WinAmount
+			}
+			assert.InDelta(t, result.TotalCascadeWin, sum, 1e-9)
+
+			// Removed symbols are replaced one for one from the strips, above the ones that fell
+			requireFullGrid(t, result.GridAfter)
+			_, symbolWins, _ := wins.CalculateCascadeWin(prev, bet, result.CascadeNumber, isFreeSpin)
+			require.NotEmpty(t, symbolWins, "cascade %d follows a grid with wins", result.CascadeNumber)
+			settled := dropSymbols(removeWinningSymbols(prev, symbolWins))
+			assert.NotEqual(t, prev, settled, "cascade %d: a win removes or transforms symbols", result.CascadeNumber)
+			var refilled reels.Grid
+			refilled, positions = fillEmptyPositions(settled, strips, positions)
+			assert.Equal(t, refilled, result.GridAfter, "cascade %d", result.CascadeNumber)
+			prev = result.GridAfter
+		}
+
+		assert.Equal(t, prev, final, "the final grid is the last cascade's")
+		assert.False(t, wins.HasAnyWins(final), "cascades stop only once the grid has no wins")
+
+		total := GetTotalWinFromCascades(results, bet)
+		assert.GreaterOrEqual(t, total, 0.0)
+		assert.LessOrEqual(t, total, wins.GetMaxWinForBet(bet))
+
+		// The sequence depends on nothing but its inputs
+		assert.Equal(t, results, replay)
+		assert.Equal(t, final, replayFinal)
+	})
+}
@@ -0,0 +1,19 @@
+package engine
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/rng"
+)
+
+// Candidate is an engine implementation that plays spins from a given RNG
+// Shadow mode runs a candidate next to the production engine on the same draws to compare outcomes.
+type Candidate interface {
+	ExecuteBaseSpinWithRNG(ctx context.Context, playerID uuid.UUID, betAmount float64, gameMode string, customRNG rng.RNG) (*SpinResult, error)
+	ExecuteFreeSpinWithRNG(ctx context.Context, playerID uuid.UUID, session *freespins.Session, spinNumber int, customRNG rng.RNG) (*FreeSpinResult, error)
+}
+
+// Ensure GameEngine implements Candidate
+var _ Candidate = (*GameEngine)(nil)
@@ -3,16 +3,23 @@ package engine
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
 	"github.com/slotmachine/backend/domain/reelstrip"
 	"github.com/slotmachine/backend/internal/game/cascade"
 	"github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/multiplier"
 	"github.com/slotmachine/backend/internal/game/reels"
 	"github.com/slotmachine/backend/internal/game/rng"
 	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/game/wilds"
 	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/featureflags"
 )
 
 // GameEngine is an enhanced game engine that uses pre-generated reel strips from database
@@ -23,6 +30,31 @@ type GameEngine struct {
 	useDBStrips        bool // Flag to enable/disable DB strips (for gradual rollout)
 	fallbackToGenerate bool // If true, falls back to generation if DB strips not available
 	cache              *cache.Cache
+	rules              RulesResolver // Optional: per-game multiplier ladder and free spins trigger rules
+	compiled           sync.Map      // strip checksums -> *reels.CompiledStrips
+	clock              clock.Clock   // Timestamps spins
+}
+
+// GameRules are the configurable game math rules applied to a spin
+type GameRules struct {
+	Multipliers multiplier.Ladder      `json:"multipliers"`
+	FreeSpins   freespins.TriggerRules `json:"free_spins"`
+	Wilds       wilds.Features         `json:"wilds"`
+	WinTiers    game.WinTiers          `json:"win_tiers"` // Presentation only, never changes a payout
+	Timing      game.TimingProfiles    `json:"timing"`    // Presentation only, never changes a payout
+}
+
+// DefaultGameRules are the built-in rules used when a game has none configured
+var DefaultGameRules = GameRules{
+	Multipliers: multiplier.DefaultLadder,
+	FreeSpins:   freespins.DefaultTriggerRules,
+	WinTiers:    game.DefaultWinTiers,
+	Timing:      game.DefaultTimingProfiles,
+}
+
+// RulesResolver resolves the game rules that apply to a player
+type RulesResolver interface {
+	RulesForPlayer(ctx context.Context, playerID uuid.UUID) GameRules
 }
 
 // GridPosition represents a position on the grid (reel, row)
@@ -42,22 +74,36 @@ type SpinResult struct {
 	FreeSpinsAwarded   int                     `json:"free_spins_awarded,omitempty"`
 	ReelPositions      []int                   `json:"reel_positions"`       // For provably fair
 	ReelStripConfigID  *uuid.UUID              `json:"reel_strip_config_id"` // For provably fair verification
+	StripChecksums     []string                `json:"-"`                    // Checksums of the strips drawn from, for certification records
+	Multipliers        []int                   `json:"multipliers"`          // Ladder applied to the cascades
+	MathVersion        string                  `json:"math_version"`         // Engine build, paytable and ladder the spin was played with
+	WildFeatures       wilds.Features          `json:"wild_features"`
+	WinTiers           game.WinTiers           `json:"-"` // Tiers to classify the settled win with
+	Timing             game.TimingProfiles     `json:"-"` // Animation timing to plan the presentation with
 	Timestamp          time.Time               `json:"timestamp"`
 }
 
 // FreeSpinResult represents the result of a free spin
 type FreeSpinResult struct {
-	SpinID          uuid.UUID               `json:"spin_id"`
-	Grid            reels.Grid              `json:"grid"`
-	Cascades        []cascade.CascadeResult `json:"cascades"`
-	TotalWin        float64                 `json:"total_win"`
-	ScatterCount    int                     `json:"scatter_count"`
-	Retriggered     bool                    `json:"retriggered"`
-	AdditionalSpins int                     `json:"additional_spins,omitempty"`
-	RemainingSpins  int                     `json:"remaining_spins"`
-	SpinNumber      int                     `json:"spin_number"`
-	ReelPositions   []int                   `json:"reel_positions"`
-	Timestamp       time.Time               `json:"timestamp"`
+	SpinID            uuid.UUID               `json:"spin_id"`
+	Grid              reels.Grid              `json:"grid"`
+	Cascades          []cascade.CascadeResult `json:"cascades"`
+	TotalWin          float64                 `json:"total_win"`
+	ScatterCount      int                     `json:"scatter_count"`
+	Retriggered       bool                    `json:"retriggered"`
+	AdditionalSpins   int                     `json:"additional_spins,omitempty"`
+	RemainingSpins    int                     `json:"remaining_spins"`
+	SpinNumber        int                     `json:"spin_number"`
+	ReelPositions     []int                   `json:"reel_positions"`
+	ReelStripConfigID *uuid.UUID              `json:"-"` // Config drawn from, nil for generated strips
+	StripChecksums    []string                `json:"-"` // Checksums of the strips drawn from, for certification records
+	Multipliers       []int                   `json:"multipliers"`
+	MathVersion       string                  `json:"math_version"` // Engine build, paytable and ladder the spin was played with
+	WildFeatures      wilds.Features          `json:"wild_features"`
+	StickyWilds       []wilds.Position        `json:"sticky_wilds,omitempty"` // Sticky wilds held for the next free spin
+	WinTiers          game.WinTiers           `json:"-"`                      // Tiers to classify the settled win with
+	Timing            game.TimingProfiles     `json:"-"`                      // Animation timing to plan the presentation with
+	Timestamp         time.Time               `json:"timestamp"`
 }
 
 // NewGameEngine creates a new game engine with database-backed reel strips
@@ -68,7 +114,38 @@ func NewGameEngine(reelStripService reelstrip.Service, cache *cache.Cache, useDB
 		useDBStrips:        useDBStrips,
 		cache:              cache,
 		fallbackToGenerate: true, // Always allow fallback for safety
+		clock:              clock.New(),
+	}
+}
+
+// SetClock replaces the clock spins are timestamped with
+func (e *GameEngine) SetClock(c clock.Clock) {
+	e.clock = c
+}
+
+// SetRulesResolver sets the resolver used to pick the game rules per player
+func (e *GameEngine) SetRulesResolver(resolver RulesResolver) {
+	e.rules = resolver
+}
+
+// RulesForPlayer returns the game rules the player's spins are played with, or the default rules
+func (e *GameEngine) RulesForPlayer(ctx context.Context, playerID uuid.UUID) GameRules {
+	rules := DefaultGameRules
+	if e.rules != nil {
+		rules = e.rules.RulesForPlayer(ctx, playerID)
+	}
+	// Players outside the wild features rollout play without them
+	if featureflags.FromContext(ctx).Disabled(featureflags.WildFeatures) {
+		rules.Wilds = wilds.Features{}
+	}
+	// Rules cached before win tiers and timing existed carry none
+	if rules.WinTiers == nil {
+		rules.WinTiers = game.DefaultWinTiers
 	}
+	if rules.Timing == (game.TimingProfiles{}) {
+		rules.Timing = game.DefaultTimingProfiles
+	}
+	return rules
 }
 
 // GenerateInitialGrid generates a demo grid for initial display
@@ -234,8 +311,27 @@ func (e *GameEngine) generateBonusSpinTriggerGrid(reelStrips []reels.ReelStrip)
 
 // ReelStripsResult contains reel strips and their config ID for provably fair verification
 type ReelStripsResult struct {
-	Strips   []reels.ReelStrip
-	ConfigID *uuid.UUID // nil if using generated strips (not from DB config)
+	Strips    []reels.ReelStrip
+	ConfigID  *uuid.UUID            // nil if using generated strips (not from DB config)
+	Compiled  *reels.CompiledStrips // nil if using generated strips or the set failed to compile
+	Checksums []string              // Per-reel strip checksums, as stored or computed for generated strips
+}
+
+// generatedResult wraps strips generated on the fly, which have no config or stored checksums
+func generatedResult(strips []reels.ReelStrip) *ReelStripsResult {
+	checksums := make([]string, len(strips))
+	for i, strip := range strips {
+		checksums[i] = reelstrip.ComputeChecksum(strip)
+	}
+	return &ReelStripsResult{Strips: strips, Checksums: checksums}
+}
+
+// GenerateGrid draws the initial grid, from the compiled strips when available
+func (r *ReelStripsResult) GenerateGrid(rngInstance rng.RNG) (reels.Grid, []int, error) {
+	if r.Compiled != nil {
+		return r.Compiled.GenerateGrid(rngInstance)
+	}
+	return reels.GenerateGrid(r.Strips, rngInstance)
 }
 
 // getReelStripsForPlayer retrieves reel strips for a specific player
@@ -254,7 +350,10 @@ func (e *GameEngine) GetReelStripsWithConfigID(ctx context.Context, playerID uui
 	// If DB strips are disabled, generate on-the-fly
 	if !e.useDBStrips || e.reelStripService == nil {
 		strips, err := reels.GenerateAllReelStrips(isFreeSpin, e.cryptoRNG)
-		return &ReelStripsResult{Strips: strips, ConfigID: nil}, err
+		if err != nil {
+			return nil, err
+		}
+		return generatedResult(strips), nil
 	}
 
 	gameMode := string(reelstrip.BaseGame)
@@ -266,18 +365,14 @@ func (e *GameEngine) GetReelStripsWithConfigID(ctx context.Context, playerID uui
 	if playerID == uuid.Nil {
 		configSet, err := e.reelStripService.GetDefaultReelSet(ctx, gameMode)
 		if err == nil && configSet != nil && configSet.IsComplete() {
-			strips := e.convertConfigSetToReelStrips(configSet)
-			configID := configSet.Config.ID
-			return &ReelStripsResult{Strips: strips, ConfigID: &configID}, nil
+			return e.configSetResult(configSet), nil
 		}
 		// Fall through to fallback
 	} else {
 		// Get player-specific reel strip configuration
 		configSet, err := e.reelStripService.GetReelSetForPlayer(ctx, playerID, gameMode)
 		if err == nil && configSet != nil && configSet.IsComplete() {
-			strips := e.convertConfigSetToReelStrips(configSet)
-			configID := configSet.Config.ID
-			return &ReelStripsResult{Strips: strips, ConfigID: &configID}, nil
+			return e.configSetResult(configSet), nil
 		}
 		// Fall through to fallback
 	}
@@ -285,12 +380,58 @@ func (e *GameEngine) GetReelStripsWithConfigID(ctx context.Context, playerID uui
 	// Fallback: Generate strips on-the-fly if DB lookup fails
 	if e.fallbackToGenerate {
 		strips, err := reels.GenerateAllReelStrips(isFreeSpin, e.cryptoRNG)
-		return &ReelStripsResult{Strips: strips, ConfigID: nil}, err
+		if err != nil {
+			return nil, err
+		}
+		return generatedResult(strips), nil
 	}
 
 	return nil, fmt.Errorf("failed to get strips for player and fallback is disabled")
 }
 
+// GetReelStripsForConfig retrieves the reel strips of a specific configuration
+func (e *GameEngine) GetReelStripsForConfig(ctx context.Context, configID uuid.UUID) (*ReelStripsResult, *reelstrip.ReelStripConfig, error) {
+	if e.reelStripService == nil {
+		return nil, nil, fmt.Errorf("reel strip service not configured")
+	}
+	configSet, err := e.reelStripService.GetReelSetByConfig(ctx, configID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if configSet == nil || !configSet.IsComplete() {
+		return nil, nil, reelstrip.ErrIncompleteSet
+	}
+	return e.configSetResult(configSet), configSet.Config, nil
+}
+
+// configSetResult wraps a config set's strips with their compiled form
+func (e *GameEngine) configSetResult(configSet *reelstrip.ReelStripConfigSet) *ReelStripsResult {
+	configID := configSet.Config.ID
+	strips := e.convertConfigSetToReelStrips(configSet)
+	checksums := make([]string, len(configSet.Strips))
+	for i, strip := range configSet.Strips {
+		checksums[i] = strip.Checksum
+	}
+	return &ReelStripsResult{Strips: strips, ConfigID: &configID, Compiled: e.compileStrips(checksums, strips), Checksums: checksums}
+}
+
+// compileStrips returns the set's compiled strips, compiling them on first use
+// Strips are immutable by checksum, so the compiled form is cached for the process lifetime.
+// A set that fails to compile (unknown symbols) returns nil and spins on the string strips.
+func (e *GameEngine) compileStrips(checksums []string, strips []reels.ReelStrip) *reels.CompiledStrips {
+	key := strings.Join(checksums, ":")
+
+	if cached, ok := e.compiled.Load(key); ok {
+		return cached.(*reels.CompiledStrips)
+	}
+	compiled, err := reels.CompileStrips(strips)
+	if err != nil {
+		return nil
+	}
+	actual, _ := e.compiled.LoadOrStore(key, compiled)
+	return actual.(*reels.CompiledStrips)
+}
+
 // convertConfigSetToReelStrips converts domain ReelStripConfigSet to game engine ReelStrip format
 func (e *GameEngine) convertConfigSetToReelStrips(configSet *reelstrip.ReelStripConfigSet) []reels.ReelStrip {
 	return []reels.ReelStrip{
@@ -309,18 +450,27 @@ func (e *GameEngine) GetReelStripsForFreeSpinSession(
 	playerID uuid.UUID,
 	session *freespins.Session,
 ) ([]reels.ReelStrip, error) {
+	result, err := e.freeSpinSessionStrips(ctx, playerID, session)
+	if err != nil {
+		return nil, err
+	}
+	return result.Strips, nil
+}
+
+// freeSpinSessionStrips resolves a free spin session's strips along with their compiled form
+func (e *GameEngine) freeSpinSessionStrips(ctx context.Context, playerID uuid.UUID, session *freespins.Session) (*ReelStripsResult, error) {
 	// First, try to get reel strips from session's ReelStripConfigID if set
 	if session.ReelStripConfigID != nil && e.reelStripService != nil {
 		configSet, err := e.reelStripService.GetReelSetByConfig(ctx, *session.ReelStripConfigID)
 		if err == nil && configSet != nil && configSet.IsComplete() {
-			return e.convertConfigSetToReelStrips(configSet), nil
+			return e.configSetResult(configSet), nil
 		}
 		// Log warning but continue to fallback
 		// Failed to get config from ReelStripConfigID, will use default logic
 	}
 
 	// Fallback to existing logic: get reel strips for player (free spins mode)
-	return e.GetReelStripsForPlayer(ctx, playerID, true)
+	return e.GetReelStripsWithConfigID(ctx, playerID, true)
 }
 
 // ValidateBetAmount validates that bet amount is within allowed range
@@ -367,7 +517,7 @@ func (e *GameEngine) ExecuteBaseSpinWithRNG(
 		initialGrid, reelPositions, err = e.generateBonusSpinTriggerGridWithRNG(reelStrips, customRNG)
 	} else {
 		// Normal spin with custom RNG
-		initialGrid, reelPositions, err = reels.GenerateGrid(reelStrips, customRNG)
+		initialGrid, reelPositions, err = reelStripsResult.GenerateGrid(customRNG)
 	}
 
 	if err != nil {
@@ -375,13 +525,16 @@ func (e *GameEngine) ExecuteBaseSpinWithRNG(
 	}
 
 	// Execute cascades with custom RNG
-	cascadeResults, finalGrid, err := cascade.ExecuteCascades(
+	rules := e.RulesForPlayer(ctx, playerID)
+	cascadeResults, finalGrid, err := cascade.ExecuteCascadesWithFeatures(
 		initialGrid,
 		reelStrips,
 		reelPositions,
 		betAmount,
 		isFreeSpin,
 		customRNG,
+		rules.Multipliers,
+		rules.Wilds,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute cascades: %w", err)
@@ -391,7 +544,7 @@ func (e *GameEngine) ExecuteBaseSpinWithRNG(
 	totalWin := cascade.GetTotalWinFromCascades(cascadeResults, betAmount)
 
 	// Check for free spins trigger
-	triggerResult := freespins.CheckTrigger(finalGrid)
+	triggerResult := rules.FreeSpins.Check(finalGrid)
 
 	result := &SpinResult{
 		SpinID:             spinID,
@@ -403,7 +556,13 @@ func (e *GameEngine) ExecuteBaseSpinWithRNG(
 		FreeSpinsAwarded:   triggerResult.SpinsAwarded,
 		ReelPositions:      reelPositions,
 		ReelStripConfigID:  reelStripsResult.ConfigID,
-		Timestamp:          time.Now().UTC(),
+		StripChecksums:     reelStripsResult.Checksums,
+		Multipliers:        rules.Multipliers.Steps(isFreeSpin),
+		MathVersion:        MathVersion(rules.Multipliers),
+		WildFeatures:       rules.Wilds,
+		WinTiers:           rules.WinTiers,
+		Timing:             rules.Timing,
+		Timestamp:          e.clock.Now().UTC(),
 	}
 
 	return result, nil
@@ -550,25 +709,34 @@ func (e *GameEngine) ExecuteFreeSpinWithRNG(
 
 	// Get reel strips for this free spin session
 	// Priority: session.ReelStripConfigID > player assignment > default config > fallback
-	reelStrips, err := e.GetReelStripsForFreeSpinSession(ctx, playerID, session)
+	reelStripsResult, err := e.freeSpinSessionStrips(ctx, playerID, session)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reel strips: %w", err)
 	}
+	reelStrips := reelStripsResult.Strips
 
 	// Generate initial grid with custom RNG
-	initialGrid, reelPositions, err := reels.GenerateGrid(reelStrips, customRNG)
+	initialGrid, reelPositions, err := reelStripsResult.GenerateGrid(customRNG)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate grid: %w", err)
 	}
 
+	// Sticky wilds from earlier free spins hold their positions
+	rules := e.RulesForPlayer(ctx, playerID)
+	if rules.Wilds.StickyWilds {
+		initialGrid = wilds.ApplySticky(initialGrid, session.StickyWilds)
+	}
+
 	// Execute cascades with custom RNG
-	cascadeResults, finalGrid, err := cascade.ExecuteCascades(
+	cascadeResults, finalGrid, err := cascade.ExecuteCascadesWithFeatures(
 		initialGrid,
 		reelStrips,
 		reelPositions,
 		betAmount,
 		true, // isFreeSpin
 		customRNG,
+		rules.Multipliers,
+		rules.Wilds,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute cascades: %w", err)
@@ -578,35 +746,64 @@ func (e *GameEngine) ExecuteFreeSpinWithRNG(
 	totalWin := cascade.GetTotalWinFromCascades(cascadeResults, betAmount)
 
 	// Check for retrigger
-	retriggerResult := freespins.CheckRetrigger(finalGrid, session.RemainingSpins-1)
+	retriggerResult := rules.FreeSpins.CheckRetrigger(finalGrid, session.RemainingSpins-1)
 
 	result := &FreeSpinResult{
-		SpinID:          spinID,
-		Grid:            initialGrid,
-		Cascades:        cascadeResults,
-		TotalWin:        totalWin,
-		ScatterCount:    retriggerResult.ScatterCount,
-		Retriggered:     retriggerResult.Retriggered,
-		AdditionalSpins: retriggerResult.AdditionalSpins,
-		RemainingSpins:  retriggerResult.NewTotalRemaining,
-		SpinNumber:      spinNumber,
-		ReelPositions:   reelPositions,
-		Timestamp:       time.Now().UTC(),
+		SpinID:            spinID,
+		Grid:              initialGrid,
+		Cascades:          cascadeResults,
+		TotalWin:          totalWin,
+		ScatterCount:      retriggerResult.ScatterCount,
+		Retriggered:       retriggerResult.Retriggered,
+		AdditionalSpins:   retriggerResult.AdditionalSpins,
+		RemainingSpins:    retriggerResult.NewTotalRemaining,
+		SpinNumber:        spinNumber,
+		ReelPositions:     reelPositions,
+		ReelStripConfigID: reelStripsResult.ConfigID,
+		StripChecksums:    reelStripsResult.Checksums,
+		Multipliers:       rules.Multipliers.Steps(true),
+		MathVersion:       MathVersion(rules.Multipliers),
+		WildFeatures:      rules.Wilds,
+		WinTiers:          rules.WinTiers,
+		Timing:            rules.Timing,
+		Timestamp:         e.clock.Now().UTC(),
+	}
+	if rules.Wilds.StickyWilds {
+		result.StickyWilds = wilds.CollectSticky(initialGrid, session.StickyWilds)
 	}
 
 	return result, nil
 }
 
+// getTrialReelStrips returns the strips for a trial spin
+// A demo config is used when one is given; only configs flagged as demo are accepted.
+// Otherwise (or if the demo config cannot be loaded) HUGE RTP strips are generated.
+func (e *GameEngine) getTrialReelStrips(ctx context.Context, isFreeSpin bool, demoConfigID *uuid.UUID) ([]reels.ReelStrip, *uuid.UUID, error) {
+	if demoConfigID != nil && e.reelStripService != nil {
+		configSet, err := e.reelStripService.GetDemoReelSet(ctx, *demoConfigID)
+		if err == nil && configSet.IsComplete() {
+			configID := configSet.Config.ID
+			return e.convertConfigSetToReelStrips(configSet), &configID, nil
+		}
+		// Fall through to generated trial strips
+	}
+
+	strips, err := reels.GenerateTrialReelStrips(isFreeSpin, e.cryptoRNG)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate trial reel strips: %w", err)
+	}
+	return strips, nil, nil
+}
+
 // ExecuteTrialSpin executes a spin for trial mode using HUGE RTP weights
-// Trial spins use generated strips with higher winning rates, not DB-backed strips
-func (e *GameEngine) ExecuteTrialSpin(ctx context.Context, betAmount float64, gameMode string) (*SpinResult, error) {
+// Trial spins use generated strips with higher winning rates, or the game's demo-only config
+func (e *GameEngine) ExecuteTrialSpin(ctx context.Context, betAmount float64, gameMode string, demoConfigID *uuid.UUID) (*SpinResult, error) {
 	spinID := uuid.New()
 	isFreeSpin := false
 
-	// Generate trial reel strips with HUGE RTP
-	reelStrips, err := reels.GenerateTrialReelStrips(isFreeSpin, e.cryptoRNG)
+	reelStrips, configID, err := e.getTrialReelStrips(ctx, isFreeSpin, demoConfigID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate trial reel strips: %w", err)
+		return nil, err
 	}
 
 	var initialGrid reels.Grid
@@ -652,26 +849,32 @@ func (e *GameEngine) ExecuteTrialSpin(ctx context.Context, betAmount float64, ga
 		FreeSpinsTriggered: triggerResult.Triggered,
 		FreeSpinsAwarded:   triggerResult.SpinsAwarded,
 		ReelPositions:      reelPositions,
-		ReelStripConfigID:  nil, // Trial uses generated strips, not DB config
-		Timestamp:          time.Now().UTC(),
+		ReelStripConfigID:  configID, // Demo config, or nil for generated trial strips
+		Multipliers:        multiplier.DefaultLadder.Steps(isFreeSpin),
+		MathVersion:        MathVersion(multiplier.DefaultLadder),
+		WinTiers:           game.DefaultWinTiers,
+		Timing:             game.DefaultTimingProfiles,
+		Timestamp:          e.clock.Now().UTC(),
 	}
 
 	return result, nil
 }
 
 // ExecuteTrialFreeSpin executes a free spin for trial mode using HUGE RTP weights
+// or the game's demo-only free spins config
 func (e *GameEngine) ExecuteTrialFreeSpin(
+	ctx context.Context,
 	betAmount float64,
 	remainingSpins int,
 	spinNumber int,
+	demoConfigID *uuid.UUID,
 ) (*FreeSpinResult, error) {
 	spinID := uuid.New()
 	isFreeSpin := true
 
-	// Generate trial free spin strips with HUGE RTP
-	reelStrips, err := reels.GenerateTrialReelStrips(isFreeSpin, e.cryptoRNG)
+	reelStrips, _, err := e.getTrialReelStrips(ctx, isFreeSpin, demoConfigID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate trial reel strips: %w", err)
+		return nil, err
 	}
 
 	// Generate initial grid
@@ -710,7 +913,11 @@ func (e *GameEngine) ExecuteTrialFreeSpin(
 		RemainingSpins:  retriggerResult.NewTotalRemaining,
 		SpinNumber:      spinNumber,
 		ReelPositions:   reelPositions,
-		Timestamp:       time.Now().UTC(),
+		Multipliers:     multiplier.DefaultLadder.Steps(isFreeSpin),
+		MathVersion:     MathVersion(multiplier.DefaultLadder),
+		WinTiers:        game.DefaultWinTiers,
+		Timing:          game.DefaultTimingProfiles,
+		Timestamp:       e.clock.Now().UTC(),
 	}
 
 	return result, nil
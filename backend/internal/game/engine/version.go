@@ -0,0 +1,48 @@
+package engine
+
+import (
+	"runtime/debug"
+	"sync"
+
+	"github.com/slotmachine/backend/internal/game/multiplier"
+	"github.com/slotmachine/backend/internal/game/symbols"
+)
+
+// Version identifies the engine's outcome generation in certification records
+// Bump it with every change to how grids, cascades, wins or triggers are computed.
+const Version = "1.0.0"
+
+// BuildRevision is the git commit the server was built from, set at build time with
+// -ldflags "-X github.com/slotmachine/backend/internal/game/engine.BuildRevision=$(git rev-parse HEAD)"
+// When unset, the VCS revision Go stamps into the binary is used.
+var BuildRevision string
+
+// revisionLength is how many hex digits of the commit math versions keep
+const revisionLength = 12
+
+// buildRevision resolves the commit once: BuildRevision, the binary's VCS stamp, or "unknown"
+var buildRevision = sync.OnceValue(func() string {
+	revision := BuildRevision
+	if revision == "" {
+		if info, ok := debug.ReadBuildInfo(); ok {
+			for _, setting := range info.Settings {
+				if setting.Key == "vcs.revision" {
+					revision = setting.Value
+				}
+			}
+		}
+	}
+	if revision == "" {
+		return "unknown"
+	}
+	if len(revision) > revisionLength {
+		revision = revision[:revisionLength]
+	}
+	return revision
+})
+
+// MathVersion identifies the exact math a spin was played with, so it can be replayed after engine updates
+// Format: <git commit>-<paytable hash>-<multiplier ladder hash>
+func MathVersion(ladder multiplier.Ladder) string {
+	return buildRevision() + "-" + symbols.PaytableVersion() + "-" + ladder.Version()
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/google/wire"
 	"github.com/slotmachine/backend/domain/reelstrip"
 	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/clock"
 )
 
 // ProviderSet is the Wire provider set for game engine
@@ -13,8 +14,10 @@ var ProviderSet = wire.NewSet(
 
 // ProvideGameEngine creates a new game engine with DB support
 // This is the recommended implementation that uses reel strips from database
-func ProvideGameEngine(cache *cache.Cache, reelStripService reelstrip.Service) *GameEngine {
+func ProvideGameEngine(cache *cache.Cache, reelStripService reelstrip.Service, clk clock.Clock) *GameEngine {
 	// Enable DB strips by default (set to true)
 	useDBStrips := true
-	return NewGameEngine(reelStripService, cache, useDBStrips)
+	e := NewGameEngine(reelStripService, cache, useDBStrips)
+	e.SetClock(clk)
+	return e
 }
@@ -507,6 +507,50 @@ func TestIsRetriggerPossible(t *testing.T) {
 	})
 }
 
+func TestTriggerRules(t *testing.T) {
+	// 2 scatters in visible rows
+	grid := reels.Grid{
+		{"cai", "fu", "shu", "zhong", "liangtong", "bonus", "fa", "bai", "wusuo", "wutong"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "bonus", "fa", "bai", "wusuo", "wutong"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "fa", "bai", "wusuo", "wutong", "zhong"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "fa", "bai", "wusuo", "wutong", "zhong"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "fa", "bai", "wusuo", "wutong", "zhong"},
+	}
+
+	t.Run("default rules should match the symbols package", func(t *testing.T) {
+		for count := 0; count <= 10; count++ {
+			assert.Equal(t, symbols.GetFreeSpinsAward(count), DefaultTriggerRules.Award(count))
+		}
+	})
+
+	t.Run("should trigger with custom minimum and awards", func(t *testing.T) {
+		rules := TriggerRules{MinScatters: 2, BaseAward: 8, ExtraPerScatter: 3, Retrigger: true}
+
+		result := rules.Check(grid)
+		assert.True(t, result.Triggered)
+		assert.Equal(t, 8, result.SpinsAwarded)
+		assert.Equal(t, 11, rules.Award(3))
+		assert.False(t, DefaultTriggerRules.Check(grid).Triggered)
+	})
+
+	t.Run("should not retrigger when disabled", func(t *testing.T) {
+		rules := TriggerRules{MinScatters: 2, BaseAward: 8, ExtraPerScatter: 3, Retrigger: false}
+
+		result := rules.CheckRetrigger(grid, 4)
+		assert.False(t, result.Retriggered)
+		assert.Equal(t, 2, result.ScatterCount)
+		assert.Equal(t, 4, result.NewTotalRemaining)
+	})
+
+	t.Run("should create sessions with the rules award", func(t *testing.T) {
+		rules := TriggerRules{MinScatters: 3, BaseAward: 10, ExtraPerScatter: 5, Retrigger: true}
+
+		session := NewSessionWithRules(uuid.New(), 4, 1.0, nil, rules)
+		assert.Equal(t, 15, session.TotalSpinsAwarded)
+		assert.Equal(t, 15, session.RemainingSpins)
+	})
+}
+
 func TestGetRetriggerMessage(t *testing.T) {
 	t.Run("should generate message", func(t *testing.T) {
 		msg := GetRetriggerMessage(3, 12)
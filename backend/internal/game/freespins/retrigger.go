@@ -13,29 +13,10 @@ type RetriggerResult struct {
 	NewTotalRemaining int  `json:"new_total_remaining"`
 }
 
-// CheckRetrigger checks if free spins are retriggered during a free spin
+// CheckRetrigger checks if free spins are retriggered under the default rules
 // Retrigger adds additional spins to the current session
 func CheckRetrigger(grid reels.Grid, currentRemainingSpins int) RetriggerResult {
-	scatterCount := countScatters(grid)
-
-	if scatterCount >= symbols.MinScattersForFreeSpin() {
-		additionalSpins := symbols.GetFreeSpinsAward(scatterCount)
-		newTotalRemaining := currentRemainingSpins + additionalSpins
-
-		return RetriggerResult{
-			Retriggered:       true,
-			ScatterCount:      scatterCount,
-			AdditionalSpins:   additionalSpins,
-			NewTotalRemaining: newTotalRemaining,
-		}
-	}
-
-	return RetriggerResult{
-		Retriggered:       false,
-		ScatterCount:      scatterCount,
-		AdditionalSpins:   0,
-		NewTotalRemaining: currentRemainingSpins,
-	}
+	return DefaultTriggerRules.CheckRetrigger(grid, currentRemainingSpins)
 }
 
 // IsRetriggerPossible checks if retrigger is possible based on scatter count
@@ -0,0 +1,58 @@
+package freespins
+
+import (
+	"github.com/slotmachine/backend/internal/game/reels"
+)
+
+// TriggerRules defines how scatters trigger and retrigger free spins
+type TriggerRules struct {
+	MinScatters     int  `json:"min_scatters"`      // Scatters needed to trigger
+	BaseAward       int  `json:"base_award"`        // Spins awarded for MinScatters
+	ExtraPerScatter int  `json:"extra_per_scatter"` // Additional spins per scatter beyond MinScatters
+	Retrigger       bool `json:"retrigger"`         // Whether scatters during free spins award more spins
+}
+
+// DefaultTriggerRules are the built-in rules: 3+ scatters award 12 spins, +2 per extra scatter
+var DefaultTriggerRules = TriggerRules{
+	MinScatters:     3,
+	BaseAward:       12,
+	ExtraPerScatter: 2,
+	Retrigger:       true,
+}
+
+// Award returns the spins awarded for a scatter count, 0 below MinScatters
+func (r TriggerRules) Award(scatterCount int) int {
+	if scatterCount < r.MinScatters {
+		return 0
+	}
+	return r.BaseAward + r.ExtraPerScatter*(scatterCount-r.MinScatters)
+}
+
+// Check checks if free spins are triggered
+func (r TriggerRules) Check(grid reels.Grid) TriggerResult {
+	scatterCount := countScatters(grid)
+	spinsAwarded := r.Award(scatterCount)
+
+	return TriggerResult{
+		Triggered:    spinsAwarded > 0,
+		ScatterCount: scatterCount,
+		SpinsAwarded: spinsAwarded,
+	}
+}
+
+// CheckRetrigger checks if free spins are retriggered during a free spin
+func (r TriggerRules) CheckRetrigger(grid reels.Grid, currentRemainingSpins int) RetriggerResult {
+	scatterCount := countScatters(grid)
+
+	additionalSpins := 0
+	if r.Retrigger {
+		additionalSpins = r.Award(scatterCount)
+	}
+
+	return RetriggerResult{
+		Retriggered:       additionalSpins > 0,
+		ScatterCount:      scatterCount,
+		AdditionalSpins:   additionalSpins,
+		NewTotalRemaining: currentRemainingSpins + additionalSpins,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/internal/game/wilds"
 )
 
 // Session represents a free spins session (lightweight version for game engine)
@@ -11,6 +12,7 @@ type Session struct {
 	ID                uuid.UUID
 	PlayerID          uuid.UUID
 	ReelStripConfigID *uuid.UUID
+	StickyWilds       []wilds.Position // Wilds held in place for the rest of the session
 	TotalSpinsAwarded int
 	SpinsCompleted    int
 	RemainingSpins    int
@@ -20,9 +22,14 @@ type Session struct {
 	CreatedAt         time.Time
 }
 
-// NewSession creates a new free spins session
+// NewSession creates a new free spins session under the default trigger rules
 func NewSession(playerID uuid.UUID, scatterCount int, betAmount float64, reelStripConfigID *uuid.UUID) *Session {
-	spinsAwarded := CalculateFreeSpinsAward(scatterCount)
+	return NewSessionWithRules(playerID, scatterCount, betAmount, reelStripConfigID, DefaultTriggerRules)
+}
+
+// NewSessionWithRules creates a new free spins session awarding spins under the given rules
+func NewSessionWithRules(playerID uuid.UUID, scatterCount int, betAmount float64, reelStripConfigID *uuid.UUID, rules TriggerRules) *Session {
+	spinsAwarded := rules.Award(scatterCount)
 
 	return &Session{
 		ID:                uuid.New(),
@@ -12,25 +12,10 @@ type TriggerResult struct {
 	SpinsAwarded int  `json:"spins_awarded"`
 }
 
-// CheckTrigger checks if free spins are triggered
+// CheckTrigger checks if free spins are triggered under the default rules
 // Free spins trigger when 3+ bonus (scatter) symbols appear
 func CheckTrigger(grid reels.Grid) TriggerResult {
-	scatterCount := countScatters(grid)
-
-	if scatterCount >= symbols.MinScattersForFreeSpin() {
-		spinsAwarded := symbols.GetFreeSpinsAward(scatterCount)
-		return TriggerResult{
-			Triggered:    true,
-			ScatterCount: scatterCount,
-			SpinsAwarded: spinsAwarded,
-		}
-	}
-
-	return TriggerResult{
-		Triggered:    false,
-		ScatterCount: scatterCount,
-		SpinsAwarded: 0,
-	}
+	return DefaultTriggerRules.Check(grid)
 }
 
 // countScatters counts the number of bonus (scatter) symbols in the visible grid
@@ -0,0 +1,105 @@
+package golden
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/slotmachine/backend/internal/game/engine"
+	"github.com/slotmachine/backend/internal/game/multiplier"
+	"github.com/slotmachine/backend/internal/game/symbols"
+)
+
+// Drift is a case whose replayed outcome differs from its golden
+// Want is nil for a case with no golden yet, Got is nil for a golden whose case left the corpus.
+type Drift struct {
+	Case   string
+	Want   *Outcome
+	Got    *Outcome
+	Fields []string // Outcome fields that differ
+}
+
+// String describes the drift in one line
+func (d Drift) String() string {
+	switch {
+	case d.Want == nil:
+		return fmt.Sprintf("%s: new case, total win %.2f", d.Case, d.Got.TotalWin)
+	case d.Got == nil:
+		return fmt.Sprintf("%s: removed from the corpus", d.Case)
+	default:
+		return fmt.Sprintf("%s: %s differ, total win %.2f -> %.2f", d.Case, strings.Join(d.Fields, ", "), d.Want.TotalWin, d.Got.TotalWin)
+	}
+}
+
+// Compare lists every case whose replayed outcome drifted from its golden, in corpus order
+// Goldens without a case follow, in golden order.
+func Compare(want, got []Outcome) []Drift {
+	goldens := make(map[string]*Outcome, len(want))
+	for i := range want {
+		goldens[want[i].Case] = &want[i]
+	}
+
+	var drifts []Drift
+	replayed := make(map[string]bool, len(got))
+	for i := range got {
+		g := &got[i]
+		replayed[g.Case] = true
+		w, ok := goldens[g.Case]
+		if !ok {
+			drifts = append(drifts, Drift{Case: g.Case, Got: g})
+			continue
+		}
+		if fields := diffFields(w, g); len(fields) > 0 {
+			drifts = append(drifts, Drift{Case: g.Case, Want: w, Got: g, Fields: fields})
+		}
+	}
+	for i := range want {
+		if !replayed[want[i].Case] {
+			drifts = append(drifts, Drift{Case: want[i].Case, Want: &want[i]})
+		}
+	}
+	return drifts
+}
+
+// diffFields names the outcome fields that differ, by their JSON names
+func diffFields(want, got *Outcome) []string {
+	var fields []string
+	w, g := reflect.ValueOf(*want), reflect.ValueOf(*got)
+	t := w.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if !reflect.DeepEqual(w.Field(i).Interface(), g.Field(i).Interface()) {
+			fields = append(fields, strings.Split(t.Field(i).Tag.Get("json"), ",")[0])
+		}
+	}
+	return fields
+}
+
+// AppendChangelog records a re-bless in the suite's changelog: why, under which math, and which cases moved
+func AppendChangelog(dir, reason string, drifts []Drift, now time.Time) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n## %s\n\n", now.UTC().Format("2006-01-02"))
+	fmt.Fprintf(&b, "%s\n\n", strings.TrimSpace(reason))
+	fmt.Fprintf(&b, "Engine %s, paytable %s, ladder %s\n\n", engine.Version, symbols.PaytableVersion(), multiplier.DefaultLadder.Version())
+
+	lines := make([]string, len(drifts))
+	for i, d := range drifts {
+		lines[i] = "- " + d.String()
+	}
+	sort.Strings(lines)
+	b.WriteString(strings.Join(lines, "\n"))
+	b.WriteString("\n")
+
+	f, err := os.OpenFile(filepath.Join(dir, ChangelogFile), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(b.String()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
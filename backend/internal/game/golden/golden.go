@@ -0,0 +1,111 @@
+// Package golden replays a corpus of recorded spins through the engine and compares them to stored golden outcomes
+// Every case is a provably fair seed, nonce and reel strip config, so a replay draws exactly
+// what production draws for that spin. Any difference from the golden outcome is math drift:
+// either a regression, or an intended change that is re-blessed with cmd/golden, which records
+// the reason and the affected cases in the changelog next to the goldens.
+package golden
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/slotmachine/backend/internal/game/cascade"
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/slotmachine/backend/internal/game/wilds"
+)
+
+// Files of a golden suite, read from and written to its directory
+const (
+	CorpusFile    = "corpus.json"
+	GoldenFile    = "golden.json"
+	ChangelogFile = "CHANGELOG.md"
+)
+
+// DefaultDir is the suite's directory relative to the backend module root
+const DefaultDir = "internal/game/golden/testdata"
+
+// Config is a reel strip config the corpus plays on
+// Its strips are stored with the corpus, so goldens depend on neither the database nor strip generation.
+type Config struct {
+	Name   string     `json:"name"`
+	Strips [][]string `json:"strips"`
+}
+
+// Case is one recorded spin
+type Case struct {
+	Name         string  `json:"name"`
+	Config       string  `json:"config"` // Name of the config the spin draws from
+	ServerSeed   string  `json:"server_seed"`
+	ClientSeed   string  `json:"client_seed"`
+	Nonce        int64   `json:"nonce"`
+	PrevSpinHash string  `json:"prev_spin_hash"`
+	Bet          float64 `json:"bet"`
+	GameMode     string  `json:"game_mode,omitempty"` // Base spins only: empty or bonus_spin_trigger
+	// Free spin cases are played as the first spin of a session with RemainingSpins left,
+	// holding the sticky wilds earlier spins of the session left behind
+	FreeSpin       bool             `json:"free_spin,omitempty"`
+	RemainingSpins int              `json:"remaining_spins,omitempty"`
+	StickyWilds    []wilds.Position `json:"sticky_wilds,omitempty"`
+	Wilds          wilds.Features   `json:"wilds"`
+}
+
+// Corpus is the set of recorded spins and the configs they play on
+type Corpus struct {
+	Configs []Config `json:"configs"`
+	Cases   []Case   `json:"cases"`
+}
+
+// Outcome is everything a spin's math decides, without the IDs and timestamps of a live spin
+type Outcome struct {
+	Case               string                  `json:"case"`
+	ReelPositions      []int                   `json:"reel_positions"`
+	Grid               reels.Grid              `json:"grid"`
+	Cascades           []cascade.CascadeResult `json:"cascades"`
+	TotalWin           float64                 `json:"total_win"`
+	ScatterCount       int                     `json:"scatter_count"`
+	FreeSpinsTriggered bool                    `json:"free_spins_triggered"`
+	FreeSpinsAwarded   int                     `json:"free_spins_awarded"`
+	Retriggered        bool                    `json:"retriggered"`
+	AdditionalSpins    int                     `json:"additional_spins"`
+	StickyWilds        []wilds.Position        `json:"sticky_wilds,omitempty"`
+}
+
+// ReadCorpus reads the corpus of a suite directory
+func ReadCorpus(dir string) (*Corpus, error) {
+	var corpus Corpus
+	if err := readJSON(filepath.Join(dir, CorpusFile), &corpus); err != nil {
+		return nil, err
+	}
+	return &corpus, nil
+}
+
+// ReadGoldens reads the golden outcomes of a suite directory
+func ReadGoldens(dir string) ([]Outcome, error) {
+	var outcomes []Outcome
+	if err := readJSON(filepath.Join(dir, GoldenFile), &outcomes); err != nil {
+		return nil, err
+	}
+	return outcomes, nil
+}
+
+// WriteGoldens writes the golden outcomes of a suite directory
+func WriteGoldens(dir string, outcomes []Outcome) error {
+	data, err := json.MarshalIndent(outcomes, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, GoldenFile), append(data, '\n'), 0o644)
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	return nil
+}
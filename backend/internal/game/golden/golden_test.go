@@ -0,0 +1,108 @@
+package golden
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoldenOutcomes(t *testing.T) {
+	corpus, err := ReadCorpus("testdata")
+	require.NoError(t, err)
+	want, err := ReadGoldens("testdata")
+	require.NoError(t, err)
+
+	got, err := Replay(context.Background(), corpus)
+	require.NoError(t, err)
+
+	for _, d := range Compare(want, got) {
+		t.Errorf("math drift: %s", d)
+	}
+	if t.Failed() {
+		t.Log(`If the change is intended, re-bless from the backend directory: go run ./cmd/golden -bless -reason "..."`)
+	}
+}
+
+func TestReplay_Deterministic(t *testing.T) {
+	corpus, err := ReadCorpus("testdata")
+	require.NoError(t, err)
+
+	first, err := Replay(context.Background(), corpus)
+	require.NoError(t, err)
+	second, err := Replay(context.Background(), corpus)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+}
+
+func TestReplay_RejectsBadCorpus(t *testing.T) {
+	corpus, err := ReadCorpus("testdata")
+	require.NoError(t, err)
+
+	t.Run("unknown config", func(t *testing.T) {
+		bad := &Corpus{Configs: corpus.Configs, Cases: []Case{corpus.Cases[0]}}
+		bad.Cases[0].Config = "missing"
+		_, err := Replay(context.Background(), bad)
+		assert.ErrorContains(t, err, "unknown config")
+	})
+
+	t.Run("duplicate case", func(t *testing.T) {
+		bad := &Corpus{Configs: corpus.Configs, Cases: []Case{corpus.Cases[0], corpus.Cases[0]}}
+		_, err := Replay(context.Background(), bad)
+		assert.ErrorContains(t, err, "appears twice")
+	})
+
+	t.Run("short config", func(t *testing.T) {
+		bad := &Corpus{Configs: []Config{{Name: "short", Strips: corpus.Configs[0].Strips[:3]}}}
+		_, err := Replay(context.Background(), bad)
+		assert.ErrorContains(t, err, "expected 5 strips")
+	})
+}
+
+func TestCompare(t *testing.T) {
+	want := []Outcome{
+		{Case: "same", TotalWin: 1},
+		{Case: "changed", TotalWin: 2, ReelPositions: []int{1, 2, 3, 4, 5}},
+		{Case: "removed", TotalWin: 3},
+	}
+	got := []Outcome{
+		{Case: "same", TotalWin: 1},
+		{Case: "changed", TotalWin: 2.5, ReelPositions: []int{1, 2, 3, 4, 6}},
+		{Case: "added", TotalWin: 4},
+	}
+
+	drifts := Compare(want, got)
+	require.Len(t, drifts, 3)
+	assert.Equal(t, "changed", drifts[0].Case)
+	assert.Equal(t, []string{"reel_positions", "total_win"}, drifts[0].Fields)
+	assert.Equal(t, "changed: reel_positions, total_win differ, total win 2.00 -> 2.50", drifts[0].String())
+	assert.Equal(t, "added: new case, total win 4.00", drifts[1].String())
+	assert.Equal(t, "removed: removed from the corpus", drifts[2].String())
+
+	assert.Empty(t, Compare(want, want))
+}
+
+func TestAppendChangelog(t *testing.T) {
+	dir := t.TempDir()
+	drifts := []Drift{
+		{Case: "b", Got: &Outcome{Case: "b", TotalWin: 1}},
+		{Case: "a", Want: &Outcome{Case: "a"}},
+	}
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, AppendChangelog(dir, "First", drifts, now))
+	require.NoError(t, AppendChangelog(dir, "Second", drifts[:1], now.AddDate(0, 0, 1)))
+
+	data, err := os.ReadFile(filepath.Join(dir, ChangelogFile))
+	require.NoError(t, err)
+	log := string(data)
+	assert.Contains(t, log, "## 2026-03-01\n\nFirst\n")
+	assert.Contains(t, log, "- a: removed from the corpus\n- b: new case, total win 1.00\n")
+	assert.Contains(t, log, "## 2026-03-02\n\nSecond\n")
+	assert.Less(t, strings.Index(log, "First"), strings.Index(log, "Second"), "entries are appended")
+}
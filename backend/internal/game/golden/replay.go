@@ -0,0 +1,181 @@
+package golden
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/game/engine"
+	"github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/slotmachine/backend/internal/game/rng"
+)
+
+// playerID is the player every case is replayed as
+var playerID = uuid.MustParse("00000000-0000-0000-0000-0000000060a1")
+
+// Replay plays every case of the corpus through the engine, in corpus order
+func Replay(ctx context.Context, corpus *Corpus) ([]Outcome, error) {
+	configs := make(map[string]*reelstrip.ReelStripConfigSet, len(corpus.Configs))
+	for _, c := range corpus.Configs {
+		set, err := configSet(c)
+		if err != nil {
+			return nil, err
+		}
+		configs[c.Name] = set
+	}
+
+	outcomes := make([]Outcome, 0, len(corpus.Cases))
+	seen := make(map[string]bool, len(corpus.Cases))
+	for _, c := range corpus.Cases {
+		if seen[c.Name] {
+			return nil, fmt.Errorf("case %q appears twice", c.Name)
+		}
+		seen[c.Name] = true
+
+		set, ok := configs[c.Config]
+		if !ok {
+			return nil, fmt.Errorf("case %q: unknown config %q", c.Name, c.Config)
+		}
+		outcome, err := replayCase(ctx, c, set)
+		if err != nil {
+			return nil, fmt.Errorf("case %q: %w", c.Name, err)
+		}
+		outcomes = append(outcomes, *outcome)
+	}
+	return outcomes, nil
+}
+
+// replayCase plays one case with the RNG production derives from its seed
+func replayCase(ctx context.Context, c Case, set *reelstrip.ReelStripConfigSet) (*Outcome, error) {
+	spinRNG, err := rng.NewHKDFProvider().ForSpin(ctx, rng.SpinSeed{
+		ServerSeed:   c.ServerSeed,
+		ClientSeed:   c.ClientSeed,
+		Nonce:        c.Nonce,
+		PrevSpinHash: c.PrevSpinHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	e := engine.NewGameEngine(&stripService{set: set}, nil, true)
+	rules := engine.DefaultGameRules
+	rules.Wilds = c.Wilds
+	e.SetRulesResolver(fixedRules(rules))
+
+	if c.FreeSpin {
+		session := &freespins.Session{
+			ID:                uuid.NewSHA1(uuid.NameSpaceOID, []byte("golden/"+c.Name)),
+			PlayerID:          playerID,
+			ReelStripConfigID: &set.Config.ID,
+			TotalSpinsAwarded: c.RemainingSpins,
+			RemainingSpins:    c.RemainingSpins,
+			LockedBetAmount:   c.Bet,
+			StickyWilds:       c.StickyWilds,
+			IsActive:          true,
+		}
+		result, err := e.ExecuteFreeSpinWithRNG(ctx, playerID, session, 1, spinRNG)
+		if err != nil {
+			return nil, err
+		}
+		if err := drewFrom(set, result.ReelStripConfigID); err != nil {
+			return nil, err
+		}
+		return &Outcome{
+			Case:            c.Name,
+			ReelPositions:   result.ReelPositions,
+			Grid:            result.Grid,
+			Cascades:        result.Cascades,
+			TotalWin:        result.TotalWin,
+			ScatterCount:    result.ScatterCount,
+			Retriggered:     result.Retriggered,
+			AdditionalSpins: result.AdditionalSpins,
+			StickyWilds:     result.StickyWilds,
+		}, nil
+	}
+
+	result, err := e.ExecuteBaseSpinWithRNG(ctx, playerID, c.Bet, c.GameMode, spinRNG)
+	if err != nil {
+		return nil, err
+	}
+	if err := drewFrom(set, result.ReelStripConfigID); err != nil {
+		return nil, err
+	}
+	return &Outcome{
+		Case:               c.Name,
+		ReelPositions:      result.ReelPositions,
+		Grid:               result.Grid,
+		Cascades:           result.Cascades,
+		TotalWin:           result.TotalWin,
+		ScatterCount:       result.ScatterCount,
+		FreeSpinsTriggered: result.FreeSpinsTriggered,
+		FreeSpinsAwarded:   result.FreeSpinsAwarded,
+	}, nil
+}
+
+// drewFrom checks a spin drew from the case's config rather than from strips the engine generated
+// The engine falls back to random strips when a config set cannot be used, which no golden could match.
+func drewFrom(set *reelstrip.ReelStripConfigSet, configID *uuid.UUID) error {
+	if configID == nil || *configID != set.Config.ID {
+		return fmt.Errorf("spin did not draw from config %q", set.Config.Name)
+	}
+	return nil
+}
+
+// configSet turns a corpus config into the active config set the engine draws from
+func configSet(c Config) (*reelstrip.ReelStripConfigSet, error) {
+	if len(c.Strips) != reels.ReelCount {
+		return nil, fmt.Errorf("config %q: expected %d strips, got %d", c.Name, reels.ReelCount, len(c.Strips))
+	}
+	set := &reelstrip.ReelStripConfigSet{
+		Config: &reelstrip.ReelStripConfig{
+			ID:       uuid.NewSHA1(uuid.NameSpaceOID, []byte("golden/"+c.Name)),
+			Name:     c.Name,
+			IsActive: true,
+		},
+	}
+	for reel, strip := range c.Strips {
+		set.Strips[reel] = &reelstrip.ReelStrip{
+			ID:          uuid.NewSHA1(set.Config.ID, []byte{byte(reel)}),
+			ReelNumber:  reel,
+			StripData:   strip,
+			Checksum:    reelstrip.ComputeChecksum(strip),
+			StripLength: len(strip),
+			IsActive:    true,
+		}
+	}
+	return set, nil
+}
+
+// fixedRules plays every player under the same rules
+type fixedRules engine.GameRules
+
+// RulesForPlayer implements engine.RulesResolver
+func (r fixedRules) RulesForPlayer(ctx context.Context, playerID uuid.UUID) engine.GameRules {
+	return engine.GameRules(r)
+}
+
+// stripService serves one config set for every game mode, as the reel strip service would from its cache
+type stripService struct {
+	reelstrip.Service
+	set *reelstrip.ReelStripConfigSet
+}
+
+// GetReelSetForPlayer implements reelstrip.Service
+func (s *stripService) GetReelSetForPlayer(ctx context.Context, playerID uuid.UUID, gameMode string) (*reelstrip.ReelStripConfigSet, error) {
+	return s.set, nil
+}
+
+// GetDefaultReelSet implements reelstrip.Service
+func (s *stripService) GetDefaultReelSet(ctx context.Context, gameMode string) (*reelstrip.ReelStripConfigSet, error) {
+	return s.set, nil
+}
+
+// GetReelSetByConfig implements reelstrip.Service
+func (s *stripService) GetReelSetByConfig(ctx context.Context, configID uuid.UUID) (*reelstrip.ReelStripConfigSet, error) {
+	if s.set.Config.ID != configID {
+		return nil, reelstrip.ErrConfigNotFound
+	}
+	return s.set, nil
+}
@@ -1,36 +1,59 @@
 package multiplier
 
-// GetMultiplier returns the multiplier for a given cascade number
-// Base game: 1x, 2x, 3x, 5x... (increments by 1)
-// Free spins: 2x, 4x, 6x, 10x... (increments by 2, starts at 2x)
-func GetMultiplier(cascadeNumber int, isFreeSpin bool) int {
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// Ladder is a cascade multiplier progression
+// Step N applies to cascade N; the last step applies to every further cascade.
+type Ladder struct {
+	BaseGame  []int `json:"base_game"`
+	FreeSpins []int `json:"free_spins"`
+}
+
+// DefaultLadder is the standard progression
+// Base game: 1x, 2x, 3x, 5x
+// Free spins: 2x, 4x, 6x, 10x (doubled)
+var DefaultLadder = Ladder{
+	BaseGame:  []int{1, 2, 3, 5},
+	FreeSpins: []int{2, 4, 6, 10},
+}
+
+// Get returns the multiplier for a cascade number
+// An empty progression falls back to DefaultLadder.
+func (l Ladder) Get(cascadeNumber int, isFreeSpin bool) int {
+	steps := l.Steps(isFreeSpin)
 	if cascadeNumber < 1 {
 		cascadeNumber = 1
 	}
+	if cascadeNumber > len(steps) {
+		return steps[len(steps)-1]
+	}
+	return steps[cascadeNumber-1]
+}
 
+// Steps returns the progression for a mode, falling back to DefaultLadder
+func (l Ladder) Steps(isFreeSpin bool) []int {
+	steps := l.BaseGame
 	if isFreeSpin {
-		switch cascadeNumber {
-		case 1:
-			return 2
-		case 2:
-			return 4
-		case 3:
-			return 6
-		default:
-			return 10 // 4+
+		steps = l.FreeSpins
+	}
+	if len(steps) == 0 {
+		if isFreeSpin {
+			return DefaultLadder.FreeSpins
 		}
+		return DefaultLadder.BaseGame
 	}
+	return steps
+}
 
-	switch cascadeNumber {
-	case 1:
-		return 1
-	case 2:
-		return 2
-	case 3:
-		return 3
-	default:
-		return 5 // 4+
-	}
+// GetMultiplier returns the default multiplier for a given cascade number
+// Base game: 1x, 2x, 3x, 5x... (increments by 1)
+// Free spins: 2x, 4x, 6x, 10x... (increments by 2, starts at 2x)
+func GetMultiplier(cascadeNumber int, isFreeSpin bool) int {
+	return DefaultLadder.Get(cascadeNumber, isFreeSpin)
 }
 
 // GetBaseMultiplier returns the starting multiplier for a mode
@@ -51,9 +74,21 @@ func GetIncrement(isFreeSpin bool) int {
 
 // CalculateMultiplierProgression returns the full multiplier progression for N cascades
 func CalculateMultiplierProgression(numCascades int, isFreeSpin bool) []int {
+	return DefaultLadder.Progression(numCascades, isFreeSpin)
+}
+
+// Progression returns the ladder's multipliers for N cascades
+func (l Ladder) Progression(numCascades int, isFreeSpin bool) []int {
 	progression := make([]int, numCascades)
 	for i := 0; i < numCascades; i++ {
-		progression[i] = GetMultiplier(i+1, isFreeSpin)
+		progression[i] = l.Get(i+1, isFreeSpin)
 	}
 	return progression
 }
+
+// Version identifies the ladder's effective progressions in spin math versions
+// It is the first 16 hex digits of the SHA256 of both progressions, after the DefaultLadder fallback.
+func (l Ladder) Version() string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%v|%v", l.Steps(false), l.Steps(true)))
+	return hex.EncodeToString(sum[:8])
+}
@@ -306,3 +306,31 @@ func BenchmarkCalculateMultiplierProgression(b *testing.B) {
 		_ = CalculateMultiplierProgression(10, false)
 	}
 }
+
+func TestLadder(t *testing.T) {
+	ladder := Ladder{BaseGame: []int{1, 3, 6}, FreeSpins: []int{3, 6, 9, 12, 15}}
+
+	t.Run("should apply custom steps and cap at the last step", func(t *testing.T) {
+		assert.Equal(t, []int{1, 3, 6, 6}, ladder.Progression(4, false))
+		assert.Equal(t, []int{3, 6, 9, 12, 15, 15}, ladder.Progression(6, true))
+	})
+
+	t.Run("should fall back to the default progression when empty", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3, 5}, Ladder{}.Progression(4, false))
+		assert.Equal(t, []int{2, 4, 6, 10}, Ladder{BaseGame: []int{2}}.Progression(4, true))
+	})
+
+	t.Run("should treat cascade numbers below 1 as the first cascade", func(t *testing.T) {
+		assert.Equal(t, 1, ladder.Get(0, false))
+	})
+}
+
+func TestLadder_Version(t *testing.T) {
+	assert.Len(t, DefaultLadder.Version(), 16)
+	assert.Equal(t, DefaultLadder.Version(), Ladder{}.Version(), "an empty ladder plays the default progressions")
+	assert.NotEqual(t, DefaultLadder.Version(), Ladder{BaseGame: []int{1, 2, 3, 6}}.Version())
+	assert.NotEqual(t,
+		Ladder{BaseGame: []int{1, 2}, FreeSpins: []int{3}}.Version(),
+		Ladder{BaseGame: []int{1}, FreeSpins: []int{2, 3}}.Version(),
+		"steps are attributed to their mode")
+}
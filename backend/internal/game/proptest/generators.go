@@ -0,0 +1,103 @@
+// Package proptest draws random reel strips, grids and spin inputs for property-based tests of the game math
+// The generators are built on rapid, which shrinks a failing case to a minimal one, so invariants
+// can be checked against far more shapes than hand-written grids cover.
+package proptest
+
+import (
+	"math/rand"
+
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/slotmachine/backend/internal/game/symbols"
+	"pgregory.net/rapid"
+)
+
+// Bets are the stakes spins are drawn at
+var Bets = []float64{0.1, 0.2, 0.5, 1, 2, 5, 10, 100}
+
+// goldReels are the reels gold variants appear on (reels 2, 3 and 4)
+var goldReels = map[int]bool{1: true, 2: true, 3: true}
+
+// Strip draws a shuffled reel strip for a reel
+// Every paying symbol appears at least twice, so a strip never degenerates into one repeated
+// symbol that cascades forever; wilds, scatters and, on reels 2-4, gold variants are mixed in.
+// The shuffle is seeded from a drawn value, keeping strips irregular even while rapid shrinks.
+func Strip(reelIdx int) *rapid.Generator[reels.ReelStrip] {
+	return rapid.Custom(func(t *rapid.T) reels.ReelStrip {
+		var strip reels.ReelStrip
+		add := func(sym string, count int) {
+			for i := 0; i < count; i++ {
+				strip = append(strip, sym)
+			}
+		}
+
+		for _, sym := range symbols.PayingSymbols() {
+			add(string(sym), rapid.IntRange(2, 8).Draw(t, "count_"+string(sym)))
+			if goldReels[reelIdx] {
+				add(string(sym)+"_gold", rapid.IntRange(0, 1).Draw(t, "gold_"+string(sym)))
+			}
+		}
+		add(string(symbols.SymbolWild), rapid.IntRange(0, 2).Draw(t, "wilds"))
+		add(string(symbols.SymbolBonus), rapid.IntRange(0, 2).Draw(t, "bonus"))
+
+		shuffle := rand.New(rand.NewSource(rapid.Int64().Draw(t, "shuffle_seed")))
+		shuffle.Shuffle(len(strip), func(i, j int) { strip[i], strip[j] = strip[j], strip[i] })
+		return strip
+	})
+}
+
+// Strips draws a full set of reel strips, one per reel
+func Strips() *rapid.Generator[[]reels.ReelStrip] {
+	return rapid.Custom(func(t *rapid.T) []reels.ReelStrip {
+		strips := make([]reels.ReelStrip, reels.ReelCount)
+		for reelIdx := range strips {
+			strips[reelIdx] = Strip(reelIdx).Draw(t, "strip")
+		}
+		return strips
+	})
+}
+
+// Bet draws a stake from Bets
+func Bet() *rapid.Generator[float64] {
+	return rapid.SampledFrom(Bets)
+}
+
+// Grid draws a full grid: every cell holds a symbol, gold variants only on reels 2-4
+// Cells are drawn independently, so grids need not come from any strip.
+func Grid() *rapid.Generator[reels.Grid] {
+	return grid(0)
+}
+
+// HoledGrid draws a grid with cells emptied at random, as winning symbols leave it
+func HoledGrid() *rapid.Generator[reels.Grid] {
+	return grid(3)
+}
+
+// grid draws a grid whose cells may each be left empty when holeOdds is set, one draw in holeOdds
+func grid(holeOdds int) *rapid.Generator[reels.Grid] {
+	return rapid.Custom(func(t *rapid.T) reels.Grid {
+		g := make(reels.Grid, reels.ReelCount)
+		for reelIdx := range g {
+			cell := rapid.SampledFrom(cellSymbols(reelIdx))
+			g[reelIdx] = make([]string, reels.TotalRows)
+			for row := range g[reelIdx] {
+				if holeOdds > 0 && rapid.IntRange(1, holeOdds).Draw(t, "hole") == 1 {
+					continue
+				}
+				g[reelIdx][row] = cell.Draw(t, "cell")
+			}
+		}
+		return g
+	})
+}
+
+// cellSymbols lists the symbols a cell on a reel can hold
+func cellSymbols(reelIdx int) []string {
+	var out []string
+	for _, sym := range symbols.PayingSymbols() {
+		out = append(out, string(sym))
+		if goldReels[reelIdx] {
+			out = append(out, string(sym)+"_gold")
+		}
+	}
+	return append(out, string(symbols.SymbolWild), string(symbols.SymbolBonus))
+}
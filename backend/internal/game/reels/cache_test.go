@@ -42,6 +42,14 @@ func (m *MockReelStripService) GetDefaultReelSet(ctx context.Context, gameMode s
 	return args.Get(0).(*reelstrip.ReelStripConfigSet), args.Error(1)
 }
 
+func (m *MockReelStripService) GetDemoReelSet(ctx context.Context, configID uuid.UUID) (*reelstrip.ReelStripConfigSet, error) {
+	return nil, nil
+}
+
+func (m *MockReelStripService) CreateDemoConfig(ctx context.Context, name, gameMode, description string, reelStripIDs [5]uuid.UUID, targetRTP float64) (*reelstrip.ReelStripConfig, error) {
+	return nil, nil
+}
+
 func (m *MockReelStripService) CreateConfig(ctx context.Context, name, gameMode, description string, reelStripIDs [5]uuid.UUID, targetRTP float64, extraInfoJSON []byte) (*reelstrip.ReelStripConfig, error) {
 	return nil, nil
 }
@@ -66,6 +74,18 @@ func (m *MockReelStripService) DeactivateConfig(ctx context.Context, configID uu
 	return nil
 }
 
+func (m *MockReelStripService) UpdateConfig(ctx context.Context, configID uuid.UUID, update *reelstrip.ConfigUpdate) (*reelstrip.ReelStripConfig, error) {
+	return nil, nil
+}
+
+func (m *MockReelStripService) DeleteConfig(ctx context.Context, configID uuid.UUID) error {
+	return nil
+}
+
+func (m *MockReelStripService) RestoreConfig(ctx context.Context, configID uuid.UUID) error {
+	return nil
+}
+
 func (m *MockReelStripService) AssignConfigToPlayer(ctx context.Context, playerID, configID uuid.UUID, gameMode, reason, assignedBy string, expiresAt *time.Time) error {
 	return nil
 }
@@ -78,6 +98,22 @@ func (m *MockReelStripService) RemovePlayerAssignment(ctx context.Context, playe
 	return nil
 }
 
+func (m *MockReelStripService) SetOperatorDefault(ctx context.Context, def *reelstrip.OperatorReelStripDefault) error {
+	return nil
+}
+
+func (m *MockReelStripService) GetOperatorDefault(ctx context.Context, operatorID string) (*reelstrip.OperatorReelStripDefault, error) {
+	return nil, nil
+}
+
+func (m *MockReelStripService) ListOperatorDefaults(ctx context.Context) ([]*reelstrip.OperatorReelStripDefault, error) {
+	return nil, nil
+}
+
+func (m *MockReelStripService) RemoveOperatorDefault(ctx context.Context, operatorID string) error {
+	return nil
+}
+
 func (m *MockReelStripService) GenerateAndSaveStrips(ctx context.Context, gameMode string, count int, version int) error {
 	return nil
 }
@@ -86,6 +122,10 @@ func (m *MockReelStripService) GenerateAndSaveStripSet(ctx context.Context, game
 	return [5]uuid.UUID{}, nil
 }
 
+func (m *MockReelStripService) CreateStripSet(ctx context.Context, gameMode string, strips [][]string) ([5]uuid.UUID, error) {
+	return [5]uuid.UUID{}, nil
+}
+
 func (m *MockReelStripService) GetStripByID(ctx context.Context, id uuid.UUID) (*reelstrip.ReelStrip, error) {
 	return nil, nil
 }
@@ -0,0 +1,84 @@
+package reels
+
+import (
+	"fmt"
+
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/game/symbols"
+)
+
+// IDGrid is a grid as symbol IDs, stored [reel][row] like Grid
+// It is a fixed-size value, so evaluating wins on it does not allocate.
+type IDGrid [ReelCount][TotalRows]symbols.ID
+
+// IDs compiles the grid to symbol IDs. Cells beyond the grid's bounds are IDNone
+func (g Grid) IDs() IDGrid {
+	var ids IDGrid
+	for reel := 0; reel < ReelCount && reel < len(g); reel++ {
+		for row := 0; row < TotalRows && row < len(g[reel]); row++ {
+			ids[reel][row] = symbols.ParseID(g[reel][row])
+		}
+	}
+	return ids
+}
+
+// CompiledStrips are a set of reel strips with their symbols resolved to IDs
+// Strips are immutable, so a set is compiled once per config and shared by every spin on it.
+type CompiledStrips struct {
+	strips []ReelStrip
+	ids    [ReelCount][]symbols.ID
+}
+
+// CompileStrips compiles a full set of reel strips, rejecting empty strips and unknown symbols
+func CompileStrips(strips []ReelStrip) (*CompiledStrips, error) {
+	if len(strips) != ReelCount {
+		return nil, fmt.Errorf("expected %d reel strips, got %d", ReelCount, len(strips))
+	}
+
+	c := &CompiledStrips{strips: strips}
+	for reelIdx, strip := range strips {
+		if len(strip) == 0 {
+			return nil, fmt.Errorf("reel %d strip is empty", reelIdx)
+		}
+		ids := make([]symbols.ID, len(strip))
+		for pos, name := range strip {
+			id := symbols.ParseID(name)
+			if id == symbols.IDNone || id == symbols.IDUnknown {
+				return nil, fmt.Errorf("reel %d position %d: unknown symbol %q", reelIdx, pos, name)
+			}
+			ids[pos] = id
+		}
+		c.ids[reelIdx] = ids
+	}
+	return c, nil
+}
+
+// Strips returns the source strips
+func (c *CompiledStrips) Strips() []ReelStrip {
+	return c.strips
+}
+
+// GenerateGrid draws reel positions exactly like GenerateGrid (one rng.Int per reel, in order),
+// so provably fair replays match, but fills the grid from one backing array of interned names
+func (c *CompiledStrips) GenerateGrid(rngInstance rng.RNG) (Grid, []int, error) {
+	cells := make([]string, ReelCount*TotalRows)
+	grid := make(Grid, ReelCount)
+	reelPositions := make([]int, ReelCount)
+
+	for reelIdx := 0; reelIdx < ReelCount; reelIdx++ {
+		ids := c.ids[reelIdx]
+		position, err := rngInstance.Int(len(ids))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate random position for reel %d: %w", reelIdx, err)
+		}
+		reelPositions[reelIdx] = position
+
+		column := cells[reelIdx*TotalRows : (reelIdx+1)*TotalRows : (reelIdx+1)*TotalRows]
+		for row := range column {
+			column[row] = ids[(position+row)%len(ids)].String()
+		}
+		grid[reelIdx] = column
+	}
+
+	return grid, reelPositions, nil
+}
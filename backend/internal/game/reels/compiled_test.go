@@ -0,0 +1,58 @@
+package reels
+
+import (
+	"testing"
+
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompiledStrips_GenerateGridMatchesGenerateGrid(t *testing.T) {
+	strips, err := GenerateAllReelStrips(false, rng.NewCryptoRNG())
+	require.NoError(t, err)
+
+	compiled, err := CompileStrips(strips)
+	require.NoError(t, err)
+
+	// Same seed, same draws: the compiled path must be a drop-in replacement
+	for i := int64(0); i < 50; i++ {
+		want, wantPos, err := GenerateGrid(strips, rng.NewFastRNGWithSeed(i))
+		require.NoError(t, err)
+		got, gotPos, err := compiled.GenerateGrid(rng.NewFastRNGWithSeed(i))
+		require.NoError(t, err)
+
+		assert.Equal(t, want, got)
+		assert.Equal(t, wantPos, gotPos)
+	}
+}
+
+func TestCompileStrips_RejectsInvalidStrips(t *testing.T) {
+	valid := ReelStrip{"fa", "zhong", "wild"}
+
+	_, err := CompileStrips([]ReelStrip{valid, valid, valid, valid})
+	assert.Error(t, err, "wrong reel count")
+
+	_, err = CompileStrips([]ReelStrip{valid, valid, {"fa", "cai"}, valid, valid})
+	assert.ErrorContains(t, err, `unknown symbol "cai"`)
+
+	_, err = CompileStrips([]ReelStrip{valid, valid, {}, valid, valid})
+	assert.Error(t, err, "empty strip")
+}
+
+func TestGrid_IDs(t *testing.T) {
+	grid := Grid{
+		{"fa", "fa_gold", "wild", "bonus", "", "cai", "gold", "bai", "bai", "bai"},
+	}
+
+	ids := grid.IDs()
+	assert.Equal(t, symbols.IDFa, ids[0][0])
+	assert.Equal(t, symbols.IDFaGold, ids[0][1])
+	assert.Equal(t, symbols.IDWild, ids[0][2])
+	assert.Equal(t, symbols.IDBonus, ids[0][3])
+	assert.Equal(t, symbols.IDNone, ids[0][4])
+	assert.Equal(t, symbols.IDUnknown, ids[0][5])
+	assert.Equal(t, symbols.IDGold, ids[0][6])
+	assert.Equal(t, symbols.IDNone, ids[1][0], "missing reels stay empty")
+}
@@ -0,0 +1,58 @@
+package reels
+
+import "sync"
+
+// pooledGrid is a full-size grid whose columns share one backing array
+type pooledGrid struct {
+	grid  Grid
+	cells [ReelCount * TotalRows]string
+}
+
+var gridPool = sync.Pool{
+	New: func() any {
+		p := &pooledGrid{grid: make(Grid, ReelCount)}
+		for reel := range p.grid {
+			p.grid[reel] = p.cells[reel*TotalRows : (reel+1)*TotalRows : (reel+1)*TotalRows]
+		}
+		return p
+	},
+}
+
+// ScratchGrid is a pooled grid for intermediate results that never leave the spin path
+// Anything stored in a spin result must be a regular Grid (e.g. via Clone), since a
+// released scratch grid is reused by the next spin.
+type ScratchGrid struct {
+	p *pooledGrid
+}
+
+// AcquireScratchGrid takes a full-size scratch grid from the pool; its cells are unspecified
+func AcquireScratchGrid() ScratchGrid {
+	return ScratchGrid{p: gridPool.Get().(*pooledGrid)}
+}
+
+// Grid returns the scratch grid's cells
+func (s ScratchGrid) Grid() Grid {
+	return s.p.grid
+}
+
+// CopyFrom overwrites the scratch grid with src, reporting false (and leaving it unspecified)
+// when src is not a full ReelCount × TotalRows grid
+func (s ScratchGrid) CopyFrom(src Grid) bool {
+	if len(src) != ReelCount {
+		return false
+	}
+	for reel := range src {
+		if len(src[reel]) != TotalRows {
+			return false
+		}
+		copy(s.p.grid[reel], src[reel])
+	}
+	return true
+}
+
+// Release returns the scratch grid to the pool; it must not be used afterwards
+func (s ScratchGrid) Release() {
+	// Drop symbol references so pooled grids don't pin strings
+	clear(s.p.cells[:])
+	gridPool.Put(s.p)
+}
@@ -0,0 +1,31 @@
+package reels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScratchGrid_CopyFrom(t *testing.T) {
+	scratch := AcquireScratchGrid()
+	defer scratch.Release()
+
+	src := make(Grid, ReelCount)
+	for reel := range src {
+		src[reel] = make([]string, TotalRows)
+		for row := range src[reel] {
+			src[reel][row] = "fa"
+		}
+	}
+
+	assert.True(t, scratch.CopyFrom(src))
+	assert.Equal(t, src, scratch.Grid())
+
+	scratch.Grid().SetSymbol(0, 0, "bai")
+	assert.Equal(t, "fa", src.GetSymbol(0, 0), "scratch must not alias its source")
+
+	assert.False(t, scratch.CopyFrom(src[:ReelCount-1]), "too few reels")
+	short := src.Clone()
+	short[2] = short[2][:VisibleRows]
+	assert.False(t, scratch.CopyFrom(short), "short column")
+}
@@ -0,0 +1,39 @@
+package reels_test
+
+import (
+	"testing"
+
+	"github.com/slotmachine/backend/internal/game/proptest"
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestProperty_GenerateGridReadsStripsAtItsPositions(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		strips := proptest.Strips().Draw(t, "strips")
+		seed := rapid.Int64().Draw(t, "rng_seed")
+
+		grid, positions, err := reels.GenerateGrid(strips, rng.NewFastRNGWithSeed(seed))
+		require.NoError(t, err)
+		require.Len(t, grid, reels.ReelCount)
+		require.Len(t, positions, reels.ReelCount)
+
+		for reelIdx, column := range grid {
+			assert.GreaterOrEqual(t, positions[reelIdx], 0)
+			assert.Less(t, positions[reelIdx], len(strips[reelIdx]))
+			assert.Equal(t, strips[reelIdx].GetSymbolsFromPosition(positions[reelIdx], reels.TotalRows), []string(column),
+				"reel %d shows TotalRows consecutive strip symbols, wrapping at the end", reelIdx)
+		}
+
+		// The compiled path draws the same positions and grid
+		compiled, err := reels.CompileStrips(strips)
+		require.NoError(t, err)
+		compiledGrid, compiledPositions, err := compiled.GenerateGrid(rng.NewFastRNGWithSeed(seed))
+		require.NoError(t, err)
+		assert.Equal(t, positions, compiledPositions)
+		assert.Equal(t, grid, compiledGrid)
+	})
+}
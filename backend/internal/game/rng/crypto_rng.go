@@ -3,6 +3,7 @@ package rng
 import (
 	"crypto/rand"
 	"fmt"
+	"io"
 	"math/big"
 )
 
@@ -20,13 +21,29 @@ type RNG interface {
 
 // CryptoRNG provides cryptographically secure random number generation
 // CRITICAL: Uses crypto/rand ONLY - NEVER math/rand for gaming compliance
-type CryptoRNG struct{}
+type CryptoRNG struct {
+	source io.Reader // Entropy source; crypto/rand when nil
+}
 
 // NewCryptoRNG creates a new cryptographically secure RNG
 func NewCryptoRNG() *CryptoRNG {
 	return &CryptoRNG{}
 }
 
+// NewEntropyRNG creates an RNG drawing from an external entropy source, such as a hardware RNG
+// The source must be cryptographically secure; read errors are returned, never replaced.
+func NewEntropyRNG(source io.Reader) *CryptoRNG {
+	return &CryptoRNG{source: source}
+}
+
+// reader returns the entropy source
+func (r *CryptoRNG) reader() io.Reader {
+	if r.source == nil {
+		return rand.Reader
+	}
+	return r.source
+}
+
 // Int generates a random integer in range [0, max)
 // Uses crypto/rand for cryptographic security
 func (r *CryptoRNG) Int(max int) (int, error) {
@@ -35,7 +52,7 @@ func (r *CryptoRNG) Int(max int) (int, error) {
 	}
 
 	// crypto/rand.Int returns a uniform random value in [0, max)
-	nBig, err := rand.Int(rand.Reader, big.NewInt(int64(max)))
+	nBig, err := rand.Int(r.reader(), big.NewInt(int64(max)))
 	if err != nil {
 		return 0, fmt.Errorf("crypto RNG failed: %w", err)
 	}
@@ -77,7 +94,7 @@ func (r *CryptoRNG) Float64() (float64, error) {
 
 // Bytes fills the provided byte slice with random bytes
 func (r *CryptoRNG) Bytes(b []byte) error {
-	_, err := rand.Read(b)
+	_, err := io.ReadFull(r.reader(), b)
 	if err != nil {
 		return fmt.Errorf("crypto RNG read failed: %w", err)
 	}
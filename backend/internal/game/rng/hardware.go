@@ -0,0 +1,126 @@
+package rng
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// ErrEntropyUnavailable is returned when the hardware entropy service cannot supply bytes
+// Spins fail rather than fall back to another source, so every spin is drawn from the configured one.
+var ErrEntropyUnavailable = errors.New("hardware entropy unavailable")
+
+// HardwareProvider draws spins from an external hardware/QRNG entropy service
+// Entropy is fetched as GET {url}?bytes=N, answered with N raw bytes, and buffered so most
+// spins are served without a request. Each byte is handed out once.
+type HardwareProvider struct {
+	url       string
+	apiKey    string
+	blockSize int
+	client    *http.Client
+
+	mu   sync.Mutex
+	pool []byte
+}
+
+// NewHardwareProvider creates an entropy service client fetching blockSize bytes at a time
+func NewHardwareProvider(serviceURL, apiKey string, blockSize int, client *http.Client) *HardwareProvider {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if blockSize <= 0 {
+		blockSize = 4096
+	}
+	return &HardwareProvider{
+		url:       serviceURL,
+		apiKey:    apiKey,
+		blockSize: blockSize,
+		client:    client,
+	}
+}
+
+// Name identifies the provider
+func (p *HardwareProvider) Name() string {
+	return ProviderHardware
+}
+
+// ProvablyFair is false: outcomes do not depend on the seed
+func (p *HardwareProvider) ProvablyFair() bool {
+	return false
+}
+
+// ForSpin returns an RNG reading the buffered entropy, refilling it within ctx
+func (p *HardwareProvider) ForSpin(ctx context.Context, seed SpinSeed) (RNG, error) {
+	return NewEntropyRNG(&hardwareReader{ctx: ctx, provider: p}), nil
+}
+
+// hardwareReader reads a provider's entropy within one spin's context
+type hardwareReader struct {
+	ctx      context.Context
+	provider *HardwareProvider
+}
+
+func (r *hardwareReader) Read(b []byte) (int, error) {
+	return r.provider.read(r.ctx, b)
+}
+
+// read fills b from the pool, fetching blocks as it runs dry
+func (p *HardwareProvider) read(ctx context.Context, b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	n := 0
+	for n < len(b) {
+		if len(p.pool) == 0 {
+			block, err := p.fetch(ctx)
+			if err != nil {
+				return n, err
+			}
+			p.pool = block
+		}
+		copied := copy(b[n:], p.pool)
+		p.pool = p.pool[copied:]
+		n += copied
+	}
+	return n, nil
+}
+
+// fetch requests one block of entropy
+func (p *HardwareProvider) fetch(ctx context.Context) ([]byte, error) {
+	u, err := url.Parse(p.url)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid service URL: %v", ErrEntropyUnavailable, err)
+	}
+	query := u.Query()
+	query.Set("bytes", strconv.Itoa(p.blockSize))
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrEntropyUnavailable, err)
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrEntropyUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%w: unexpected status code %d", ErrEntropyUnavailable, resp.StatusCode)
+	}
+	block := make([]byte, p.blockSize)
+	if _, err := io.ReadFull(resp.Body, block); err != nil {
+		return nil, fmt.Errorf("%w: short response: %v", ErrEntropyUnavailable, err)
+	}
+	return block, nil
+}
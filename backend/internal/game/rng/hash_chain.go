@@ -77,10 +77,11 @@ func (h *HashChainGenerator) GenerateInitialPrevSpinHash(serverSeedHash, thetaCo
 
 // VerifyHashChain verifies the entire hash chain for a session
 // Each spin has its own client_seed provided per-spin
-// First spin's prevSpinHash should be serverSeedHash
+// First spin's prevSpinHash should be GenerateInitialPrevSpinHash(serverSeedHash, thetaCommitment),
+// which is serverSeedHash for sessions without a theta_commitment
 // Returns true if all hashes are valid
 func (h *HashChainGenerator) VerifyHashChain(
-	serverSeed, serverSeedHash string,
+	serverSeed, serverSeedHash, thetaCommitment string,
 	spins []provablyfair.SpinVerification,
 ) (bool, error) {
 	// Verify server seed hash matches
@@ -90,8 +91,7 @@ func (h *HashChainGenerator) VerifyHashChain(
 	}
 
 	// Verify each spin in the chain
-	// First spin's prevSpinHash should be serverSeedHash
-	prevHash := serverSeedHash
+	prevHash := h.GenerateInitialPrevSpinHash(serverSeedHash, thetaCommitment)
 	for i, spin := range spins {
 		// Each spin has its own client_seed
 		expectedHash := h.GenerateSpinHash(prevHash, serverSeed, spin.ClientSeed, spin.Nonce)
@@ -175,32 +175,45 @@ func (r *HKDFRNG) Int(domain string, max int) (int, error) {
 		return 0, fmt.Errorf("max must be positive, got %d", max)
 	}
 
-	// Calculate rejection threshold to eliminate modulo bias
-	umax := uint64(max)
-	threshold := -umax % umax
-
 	// Use counter suffix for rejection sampling with deterministic HKDF
 	for attempt := 0; attempt < 100; attempt++ {
-		// Domain includes attempt counter for unique derivation on retry
-		info := []byte(fmt.Sprintf("%s:%d", domain, attempt))
-		hkdfReader := hkdf.New(sha256.New, r.masterKey, nil, info)
-
-		key := make([]byte, 8)
-		if _, err := io.ReadFull(hkdfReader, key); err != nil {
-			return 0, fmt.Errorf("HKDF expand for domain '%s' failed: %w", domain, err)
+		value, err := r.attemptValue(domain, attempt)
+		if err != nil {
+			return 0, err
 		}
 
-		value := binary.BigEndian.Uint64(key)
-
 		// Reject values in the biased zone
-		if value >= threshold {
-			return int(value % umax), nil
+		if n, ok := unbiased(value, max); ok {
+			return n, nil
 		}
 	}
 
 	return 0, fmt.Errorf("rejection sampling failed after 100 attempts for domain '%s'", domain)
 }
 
+// attemptValue derives the raw value of one rejection sampling attempt for a domain
+func (r *HKDFRNG) attemptValue(domain string, attempt int) (uint64, error) {
+	// Domain includes attempt counter for unique derivation on retry
+	info := []byte(fmt.Sprintf("%s:%d", domain, attempt))
+	hkdfReader := hkdf.New(sha256.New, r.masterKey, nil, info)
+
+	key := make([]byte, 8)
+	if _, err := io.ReadFull(hkdfReader, key); err != nil {
+		return 0, fmt.Errorf("HKDF expand for domain '%s' failed: %w", domain, err)
+	}
+	return binary.BigEndian.Uint64(key), nil
+}
+
+// unbiased maps value into [0, max), rejecting values in the zone that would bias the modulo
+func unbiased(value uint64, max int) (int, bool) {
+	umax := uint64(max)
+	threshold := -umax % umax
+	if value < threshold {
+		return 0, false
+	}
+	return int(value % umax), true
+}
+
 // Float64 derives a random float64 in range [0.0, 1.0) for a specific domain
 func (r *HKDFRNG) Float64(domain string) (float64, error) {
 	key, err := r.DeriveKey(domain, 8)
@@ -276,6 +289,7 @@ func VerifyAllReelPositions(serverSeed, clientSeed string, nonce int64, prevSpin
 type HKDFStreamRNG struct {
 	hkdf    *HKDFRNG
 	counter int
+	draws   []uint64 // First attempt values of the leading Int draws, derived ahead by Prederive
 }
 
 // NewHKDFStreamRNG creates a new HKDF-based RNG that implements the RNG interface
@@ -311,10 +325,33 @@ func (r *HKDFStreamRNG) Int(max int) (int, error) {
 		return 0, fmt.Errorf("max must be positive, got %d", max)
 	}
 
+	index := r.counter
 	domain := r.nextDomain()
+	if index < len(r.draws) {
+		if n, ok := unbiased(r.draws[index], max); ok {
+			return n, nil
+		}
+		// Rejected: the remaining attempts are derived as usual, so the result is unchanged
+	}
 	return r.hkdf.Int(domain, max)
 }
 
+// Prederive derives the first attempt of the next n Int draws ahead of the spin
+// Reel positions are the leading draws of a spin, so once they are derived drawing them is
+// only a modulo. The outcome is identical to deriving each draw when it is made.
+func (r *HKDFStreamRNG) Prederive(n int) error {
+	draws := make([]uint64, r.counter+n)
+	for i := r.counter; i < len(draws); i++ {
+		value, err := r.hkdf.attemptValue(fmt.Sprintf("stream:%d", i), 0)
+		if err != nil {
+			return err
+		}
+		draws[i] = value
+	}
+	r.draws = draws
+	return nil
+}
+
 // IntRange generates a random integer in range [min, max]
 func (r *HKDFStreamRNG) IntRange(min, max int) (int, error) {
 	if min > max {
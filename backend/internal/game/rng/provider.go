@@ -0,0 +1,138 @@
+package rng
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// Provider names, recorded on every spin as its rng_provider
+const (
+	ProviderHKDF          = "hkdf"
+	ProviderCrypto        = "crypto"
+	ProviderDeterministic = "deterministic"
+	ProviderHardware      = "hardware"
+)
+
+// SpinSeed is the provably fair state of the spin an RNG is requested for
+type SpinSeed struct {
+	ServerSeed   string
+	ClientSeed   string
+	Nonce        int64
+	PrevSpinHash string // Previous spin hash (server_seed_hash for first spin)
+}
+
+// Provider supplies the RNG each spin is drawn with
+// Only providers deriving outcomes from the seed are provably fair: with any other provider
+// the hash chain is still recorded, but players cannot reproduce reel positions from it.
+type Provider interface {
+	// Name identifies the provider on spin records
+	Name() string
+	// ProvablyFair reports whether outcomes can be reproduced from the spin seed
+	ProvablyFair() bool
+	// ForSpin returns the RNG for one spin
+	ForSpin(ctx context.Context, seed SpinSeed) (RNG, error)
+}
+
+// Prederiver is implemented by providers that can derive a spin's draws before the spin is played
+// Only seed-derived outcomes can be prepared ahead: the spin must then be played with exactly that seed.
+type Prederiver interface {
+	// Prederive returns the RNG for the spin with its first draws already derived
+	Prederive(ctx context.Context, seed SpinSeed, draws int) (RNG, error)
+}
+
+// HKDFProvider derives each spin's RNG from its seed with HKDFStreamRNG
+type HKDFProvider struct{}
+
+// NewHKDFProvider creates the provably fair provider
+func NewHKDFProvider() *HKDFProvider {
+	return &HKDFProvider{}
+}
+
+// Name identifies the provider
+func (p *HKDFProvider) Name() string {
+	return ProviderHKDF
+}
+
+// ProvablyFair is true: reel positions are verifiable from the revealed server seed
+func (p *HKDFProvider) ProvablyFair() bool {
+	return true
+}
+
+// ForSpin derives the spin's HKDF stream RNG
+func (p *HKDFProvider) ForSpin(ctx context.Context, seed SpinSeed) (RNG, error) {
+	return NewHKDFStreamRNG(seed.ServerSeed, seed.ClientSeed, seed.Nonce, seed.PrevSpinHash)
+}
+
+// Prederive derives the spin's HKDF stream RNG and its first draws
+func (p *HKDFProvider) Prederive(ctx context.Context, seed SpinSeed, draws int) (RNG, error) {
+	streamRNG, err := NewHKDFStreamRNG(seed.ServerSeed, seed.ClientSeed, seed.Nonce, seed.PrevSpinHash)
+	if err != nil {
+		return nil, err
+	}
+	if err := streamRNG.Prederive(draws); err != nil {
+		return nil, err
+	}
+	return streamRNG, nil
+}
+
+// CryptoProvider draws every spin from crypto/rand, ignoring the seed
+type CryptoProvider struct{}
+
+// NewCryptoProvider creates the crypto/rand provider
+func NewCryptoProvider() *CryptoProvider {
+	return &CryptoProvider{}
+}
+
+// Name identifies the provider
+func (p *CryptoProvider) Name() string {
+	return ProviderCrypto
+}
+
+// ProvablyFair is false: outcomes do not depend on the seed
+func (p *CryptoProvider) ProvablyFair() bool {
+	return false
+}
+
+// ForSpin returns a crypto/rand RNG
+func (p *CryptoProvider) ForSpin(ctx context.Context, seed SpinSeed) (RNG, error) {
+	return NewCryptoRNG(), nil
+}
+
+// DeterministicProvider seeds a FastRNG from the spin seed so test runs replay exactly
+// WARNING: NOT cryptographically secure - ONLY for tests and staging, refused in production
+type DeterministicProvider struct {
+	seed int64
+}
+
+// NewDeterministicProvider creates a deterministic provider; seed varies the outcomes between runs
+func NewDeterministicProvider(seed int64) *DeterministicProvider {
+	return &DeterministicProvider{seed: seed}
+}
+
+// Name identifies the provider
+func (p *DeterministicProvider) Name() string {
+	return ProviderDeterministic
+}
+
+// ProvablyFair is false: outcomes are reproducible, but only with the provider seed
+func (p *DeterministicProvider) ProvablyFair() bool {
+	return false
+}
+
+// ForSpin returns a FastRNG seeded with SHA256(seed || prevSpinHash || serverSeed || clientSeed || nonce)
+func (p *DeterministicProvider) ForSpin(ctx context.Context, seed SpinSeed) (RNG, error) {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d%s%s%s%d", p.seed, seed.PrevSpinHash, seed.ServerSeed, seed.ClientSeed, seed.Nonce)))
+	return NewFastRNGWithSeed(int64(binary.BigEndian.Uint64(sum[:8]))), nil
+}
+
+// Ensure the providers implement Provider
+var (
+	_ Provider = (*HKDFProvider)(nil)
+	_ Provider = (*CryptoProvider)(nil)
+	_ Provider = (*DeterministicProvider)(nil)
+	_ Provider = (*HardwareProvider)(nil)
+
+	_ Prederiver = (*HKDFProvider)(nil)
+)
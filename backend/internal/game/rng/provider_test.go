@@ -0,0 +1,179 @@
+package rng
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSpinSeed = SpinSeed{
+	ServerSeed:   "a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2",
+	ClientSeed:   "deadbeefdeadbeefdeadbeefdeadbeef",
+	Nonce:        1,
+	PrevSpinHash: testPrevSpinHash,
+}
+
+// draws returns n draws in [0, 1000) from r
+func draws(t *testing.T, r RNG, n int) []int {
+	t.Helper()
+	out := make([]int, n)
+	for i := range out {
+		v, err := r.Int(1000)
+		require.NoError(t, err)
+		out[i] = v
+	}
+	return out
+}
+
+func TestHKDFProvider_MatchesHKDFStreamRNG(t *testing.T) {
+	p := NewHKDFProvider()
+	assert.Equal(t, ProviderHKDF, p.Name())
+	assert.True(t, p.ProvablyFair())
+
+	fromProvider, err := p.ForSpin(context.Background(), testSpinSeed)
+	require.NoError(t, err)
+	direct, err := NewHKDFStreamRNG(testSpinSeed.ServerSeed, testSpinSeed.ClientSeed, testSpinSeed.Nonce, testSpinSeed.PrevSpinHash)
+	require.NoError(t, err)
+
+	assert.Equal(t, draws(t, direct, 20), draws(t, fromProvider, 20))
+}
+
+func TestHKDFProvider_PrederiveMatchesForSpin(t *testing.T) {
+	p := NewHKDFProvider()
+	fresh, err := p.ForSpin(context.Background(), testSpinSeed)
+	require.NoError(t, err)
+	prederived, err := p.Prederive(context.Background(), testSpinSeed, 5)
+	require.NoError(t, err)
+
+	assert.Equal(t, draws(t, fresh, 20), draws(t, prederived, 20), "prederived draws and later draws are unchanged")
+
+	// A quarter of raw values are rejected for this bound, so some prederived draws fall back to later attempts
+	const wide = 3 << 61
+	fresh, err = p.ForSpin(context.Background(), testSpinSeed)
+	require.NoError(t, err)
+	prederived, err = p.Prederive(context.Background(), testSpinSeed, 16)
+	require.NoError(t, err)
+	for i := 0; i < 16; i++ {
+		want, err := fresh.Int(wide)
+		require.NoError(t, err)
+		got, err := prederived.Int(wide)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "draw %d", i)
+	}
+}
+
+func TestDeterministicProvider(t *testing.T) {
+	p := NewDeterministicProvider(42)
+	assert.False(t, p.ProvablyFair())
+
+	first, err := p.ForSpin(context.Background(), testSpinSeed)
+	require.NoError(t, err)
+	replay, err := p.ForSpin(context.Background(), testSpinSeed)
+	require.NoError(t, err)
+	assert.Equal(t, draws(t, first, 20), draws(t, replay, 20), "same seed replays the same spin")
+
+	next := testSpinSeed
+	next.Nonce++
+	nextSpin, err := p.ForSpin(context.Background(), next)
+	require.NoError(t, err)
+	otherRun, err := NewDeterministicProvider(43).ForSpin(context.Background(), testSpinSeed)
+	require.NoError(t, err)
+
+	reference := draws(t, replay, 20)
+	assert.NotEqual(t, reference, draws(t, nextSpin, 20))
+	assert.NotEqual(t, reference, draws(t, otherRun, 20))
+}
+
+func TestHardwareProvider(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("Authorization") != "Bearer key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		n, err := strconv.Atoi(r.URL.Query().Get("bytes"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		block := make([]byte, n)
+		for i := range block {
+			block[i] = byte(i * 37)
+		}
+		_, _ = w.Write(block)
+	}))
+	defer srv.Close()
+
+	p := NewHardwareProvider(srv.URL, "key", 64, nil)
+	assert.Equal(t, ProviderHardware, p.Name())
+	assert.False(t, p.ProvablyFair())
+
+	spinRNG, err := p.ForSpin(context.Background(), testSpinSeed)
+	require.NoError(t, err)
+	b := make([]byte, 100)
+	require.NoError(t, spinRNG.Bytes(b))
+	assert.Equal(t, byte(0), b[0])
+	assert.Equal(t, byte(37), b[1])
+	assert.Equal(t, byte(0), b[64], "second block starts over")
+	assert.Equal(t, int32(2), requests.Load())
+
+	// The rest of the second block is served without a request
+	v, err := spinRNG.Int(6)
+	require.NoError(t, err)
+	assert.Less(t, v, 6)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestHardwareProvider_Unavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("bytes") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	spinRNG, err := NewHardwareProvider(srv.URL, "", 64, nil).ForSpin(context.Background(), testSpinSeed)
+	require.NoError(t, err)
+	_, err = spinRNG.Int(6)
+	assert.ErrorIs(t, err, ErrEntropyUnavailable)
+
+	short := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte{1, 2, 3})
+	}))
+	defer short.Close()
+
+	spinRNG, err = NewHardwareProvider(short.URL, "", 64, nil).ForSpin(context.Background(), testSpinSeed)
+	require.NoError(t, err)
+	assert.ErrorIs(t, spinRNG.Bytes(make([]byte, 8)), ErrEntropyUnavailable)
+}
+
+func TestProvideProvider(t *testing.T) {
+	for _, name := range []string{"", ProviderHKDF, ProviderCrypto, ProviderDeterministic} {
+		p, err := ProvideProvider(&config.Config{RNG: config.RNGConfig{Provider: name}})
+		require.NoError(t, err, name)
+		if name == "" {
+			name = ProviderHKDF
+		}
+		assert.Equal(t, name, p.Name())
+	}
+
+	_, err := ProvideProvider(&config.Config{RNG: config.RNGConfig{Provider: ProviderHardware}})
+	assert.Error(t, err, "hardware needs a service URL")
+
+	p, err := ProvideProvider(&config.Config{RNG: config.RNGConfig{Provider: ProviderHardware, HardwareURL: "http://qrng.local/entropy"}})
+	require.NoError(t, err)
+	assert.Equal(t, ProviderHardware, p.Name())
+
+	_, err = ProvideProvider(&config.Config{RNG: config.RNGConfig{Provider: "lavalamp"}})
+	assert.Error(t, err)
+}
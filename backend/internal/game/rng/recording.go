@@ -0,0 +1,100 @@
+package rng
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/slotmachine/backend/domain/certification"
+)
+
+// RecordingRNG passes every call through to an RNG and records the values it returns
+// Outcomes are exactly those of the wrapped RNG. Not safe for concurrent use: record one spin at a time.
+type RecordingRNG struct {
+	rng   RNG
+	draws []certification.RNGDraw
+}
+
+// NewRecordingRNG wraps an RNG to record its draws
+func NewRecordingRNG(r RNG) *RecordingRNG {
+	return &RecordingRNG{rng: r}
+}
+
+// Draws returns the calls recorded so far, in order
+func (r *RecordingRNG) Draws() []certification.RNGDraw {
+	return r.draws
+}
+
+func (r *RecordingRNG) record(call string, result string, args ...int) {
+	r.draws = append(r.draws, certification.RNGDraw{Call: call, Args: args, Result: result})
+}
+
+// Int records a draw in [0, max)
+func (r *RecordingRNG) Int(max int) (int, error) {
+	n, err := r.rng.Int(max)
+	if err == nil {
+		r.record("Int", strconv.Itoa(n), max)
+	}
+	return n, err
+}
+
+// IntRange records a draw in [min, max]
+func (r *RecordingRNG) IntRange(min, max int) (int, error) {
+	n, err := r.rng.IntRange(min, max)
+	if err == nil {
+		r.record("IntRange", strconv.Itoa(n), min, max)
+	}
+	return n, err
+}
+
+// Intn records a draw in [0, max)
+func (r *RecordingRNG) Intn(max int) (int, error) {
+	n, err := r.rng.Intn(max)
+	if err == nil {
+		r.record("Intn", strconv.Itoa(n), max)
+	}
+	return n, err
+}
+
+// Float64 records a draw in [0.0, 1.0)
+func (r *RecordingRNG) Float64() (float64, error) {
+	f, err := r.rng.Float64()
+	if err == nil {
+		r.record("Float64", strconv.FormatFloat(f, 'g', -1, 64))
+	}
+	return f, err
+}
+
+// Bytes records the bytes read
+func (r *RecordingRNG) Bytes(b []byte) error {
+	err := r.rng.Bytes(b)
+	if err == nil {
+		r.record("Bytes", hex.EncodeToString(b), len(b))
+	}
+	return err
+}
+
+// Shuffle records the length shuffled and the swaps made, as "i:j" pairs separated by commas
+func (r *RecordingRNG) Shuffle(n int, swap func(i, j int)) error {
+	var swaps []string
+	err := r.rng.Shuffle(n, func(i, j int) {
+		swaps = append(swaps, strconv.Itoa(i)+":"+strconv.Itoa(j))
+		swap(i, j)
+	})
+	if err == nil {
+		r.record("Shuffle", strings.Join(swaps, ","), n)
+	}
+	return err
+}
+
+// WeightedChoice records the index chosen for the weights
+func (r *RecordingRNG) WeightedChoice(weights []int) (int, error) {
+	i, err := r.rng.WeightedChoice(weights)
+	if err == nil {
+		r.record("WeightedChoice", strconv.Itoa(i), append([]int(nil), weights...)...)
+	}
+	return i, err
+}
+
+// Ensure RecordingRNG implements RNG
+var _ RNG = (*RecordingRNG)(nil)
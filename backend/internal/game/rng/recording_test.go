@@ -0,0 +1,42 @@
+package rng
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/slotmachine/backend/domain/certification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingRNG(t *testing.T) {
+	direct, err := NewDeterministicProvider(7).ForSpin(context.Background(), testSpinSeed)
+	require.NoError(t, err)
+	wrapped, err := NewDeterministicProvider(7).ForSpin(context.Background(), testSpinSeed)
+	require.NoError(t, err)
+	recording := NewRecordingRNG(wrapped)
+
+	want, err := direct.Int(100)
+	require.NoError(t, err)
+	got, err := recording.Int(100)
+	require.NoError(t, err)
+	assert.Equal(t, want, got, "outcomes are those of the wrapped RNG")
+
+	weights := []int{1, 2, 3}
+	want, err = direct.WeightedChoice(weights)
+	require.NoError(t, err)
+	choice, err := recording.WeightedChoice(weights)
+	require.NoError(t, err)
+	assert.Equal(t, want, choice)
+	weights[0] = 99
+
+	require.NoError(t, recording.Bytes(make([]byte, 2)))
+
+	drawn := recording.Draws()
+	require.Len(t, drawn, 3)
+	assert.Equal(t, certification.RNGDraw{Call: "Int", Args: []int{100}, Result: strconv.Itoa(got)}, drawn[0])
+	assert.Equal(t, certification.RNGDraw{Call: "WeightedChoice", Args: []int{1, 2, 3}, Result: strconv.Itoa(choice)}, drawn[1], "weights are copied")
+	assert.Equal(t, "Bytes", drawn[2].Call)
+	assert.Len(t, drawn[2].Result, 4, "bytes are hex encoded")
+}
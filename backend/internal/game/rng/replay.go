@@ -0,0 +1,130 @@
+package rng
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/slotmachine/backend/domain/certification"
+)
+
+// ErrReplayDiverged is returned when a replayed spin asks for a draw other than the one recorded next
+var ErrReplayDiverged = errors.New("rng replay diverged from the recorded draws")
+
+// ReplayRNG serves the draws a RecordingRNG recorded, in order
+// Each call must match the recorded call and arguments, so a replay only succeeds when the
+// spin consumes randomness exactly as the recorded one did. Not safe for concurrent use.
+type ReplayRNG struct {
+	draws []certification.RNGDraw
+	next  int
+}
+
+// NewReplayRNG creates an RNG replaying recorded draws
+func NewReplayRNG(draws []certification.RNGDraw) *ReplayRNG {
+	return &ReplayRNG{draws: draws}
+}
+
+// Remaining returns how many recorded draws have not been replayed
+func (r *ReplayRNG) Remaining() int {
+	return len(r.draws) - r.next
+}
+
+// take returns the result of the next draw if it matches the call
+func (r *ReplayRNG) take(call string, args ...int) (string, error) {
+	if r.next >= len(r.draws) {
+		return "", fmt.Errorf("%w: %s%v requested after all %d draws", ErrReplayDiverged, call, args, len(r.draws))
+	}
+	draw := r.draws[r.next]
+	if draw.Call != call || !slices.Equal(draw.Args, args) {
+		return "", fmt.Errorf("%w: draw %d is %s%v, %s%v requested", ErrReplayDiverged, r.next, draw.Call, draw.Args, call, args)
+	}
+	r.next++
+	return draw.Result, nil
+}
+
+// takeInt returns the next draw as an int
+func (r *ReplayRNG) takeInt(call string, args ...int) (int, error) {
+	result, err := r.take(call, args...)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(result)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid %s result %q", ErrReplayDiverged, call, result)
+	}
+	return n, nil
+}
+
+// Int replays a draw in [0, max)
+func (r *ReplayRNG) Int(max int) (int, error) {
+	return r.takeInt("Int", max)
+}
+
+// IntRange replays a draw in [min, max]
+func (r *ReplayRNG) IntRange(min, max int) (int, error) {
+	return r.takeInt("IntRange", min, max)
+}
+
+// Intn replays a draw in [0, max)
+func (r *ReplayRNG) Intn(max int) (int, error) {
+	return r.takeInt("Intn", max)
+}
+
+// Float64 replays a draw in [0.0, 1.0)
+func (r *ReplayRNG) Float64() (float64, error) {
+	result, err := r.take("Float64")
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(result, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid Float64 result %q", ErrReplayDiverged, result)
+	}
+	return f, nil
+}
+
+// Bytes replays the bytes read
+func (r *ReplayRNG) Bytes(b []byte) error {
+	result, err := r.take("Bytes", len(b))
+	if err != nil {
+		return err
+	}
+	decoded, err := hex.DecodeString(result)
+	if err != nil || len(decoded) != len(b) {
+		return fmt.Errorf("%w: invalid Bytes result %q", ErrReplayDiverged, result)
+	}
+	copy(b, decoded)
+	return nil
+}
+
+// Shuffle replays the recorded swaps
+func (r *ReplayRNG) Shuffle(n int, swap func(i, j int)) error {
+	result, err := r.take("Shuffle", n)
+	if err != nil {
+		return err
+	}
+	if result == "" {
+		return nil
+	}
+	for _, pair := range strings.Split(result, ",") {
+		a, b, ok := strings.Cut(pair, ":")
+		i, errI := strconv.Atoi(a)
+		j, errJ := strconv.Atoi(b)
+		if !ok || errI != nil || errJ != nil {
+			return fmt.Errorf("%w: invalid Shuffle swap %q", ErrReplayDiverged, pair)
+		}
+		swap(i, j)
+	}
+	return nil
+}
+
+// WeightedChoice replays the index chosen for the weights
+func (r *ReplayRNG) WeightedChoice(weights []int) (int, error) {
+	return r.takeInt("WeightedChoice", weights...)
+}
+
+// Ensure ReplayRNG implements RNG
+var _ RNG = (*ReplayRNG)(nil)
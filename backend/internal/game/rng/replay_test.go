@@ -0,0 +1,56 @@
+package rng
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// playDraws makes one of each kind of call on r and returns what it got
+func playDraws(t *testing.T, r RNG) []any {
+	t.Helper()
+	n, err := r.Int(100)
+	require.NoError(t, err)
+	ranged, err := r.IntRange(5, 9)
+	require.NoError(t, err)
+	f, err := r.Float64()
+	require.NoError(t, err)
+	b := make([]byte, 4)
+	require.NoError(t, r.Bytes(b))
+	order := []int{0, 1, 2, 3, 4, 5}
+	require.NoError(t, r.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] }))
+	choice, err := r.WeightedChoice([]int{1, 2, 3})
+	require.NoError(t, err)
+	return []any{n, ranged, f, b, order, choice}
+}
+
+func TestReplayRNG(t *testing.T) {
+	source, err := NewDeterministicProvider(11).ForSpin(context.Background(), testSpinSeed)
+	require.NoError(t, err)
+	recording := NewRecordingRNG(source)
+	played := playDraws(t, recording)
+
+	t.Run("should serve the recorded draws", func(t *testing.T) {
+		replay := NewReplayRNG(recording.Draws())
+		assert.Equal(t, played, playDraws(t, replay))
+		assert.Zero(t, replay.Remaining())
+	})
+
+	t.Run("should fail on a different call", func(t *testing.T) {
+		replay := NewReplayRNG(recording.Draws())
+		_, err := replay.Int(50)
+		assert.ErrorIs(t, err, ErrReplayDiverged, "same call, different arguments")
+		_, err = replay.Float64()
+		assert.ErrorIs(t, err, ErrReplayDiverged)
+	})
+
+	t.Run("should fail past the last draw", func(t *testing.T) {
+		replay := NewReplayRNG(recording.Draws()[:1])
+		_, err := replay.Int(100)
+		require.NoError(t, err)
+		_, err = replay.Int(100)
+		assert.ErrorIs(t, err, ErrReplayDiverged)
+	})
+}
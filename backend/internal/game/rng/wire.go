@@ -0,0 +1,38 @@
+package rng
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/slotmachine/backend/internal/config"
+)
+
+// ProviderSet is the Wire provider set for the spin RNG
+var ProviderSet = wire.NewSet(
+	ProvideProvider,
+)
+
+// ProvideProvider builds the configured spin RNG provider
+func ProvideProvider(cfg *config.Config) (Provider, error) {
+	switch cfg.RNG.Provider {
+	case "", ProviderHKDF:
+		return NewHKDFProvider(), nil
+	case ProviderCrypto:
+		return NewCryptoProvider(), nil
+	case ProviderDeterministic:
+		return NewDeterministicProvider(cfg.RNG.DeterministicSeed), nil
+	case ProviderHardware:
+		if cfg.RNG.HardwareURL == "" {
+			return nil, fmt.Errorf("RNG_HARDWARE_URL must be set for the hardware RNG provider")
+		}
+		timeout := time.Duration(cfg.RNG.HardwareTimeoutSeconds) * time.Second
+		if timeout <= 0 {
+			timeout = 5 * time.Second
+		}
+		return NewHardwareProvider(cfg.RNG.HardwareURL, cfg.RNG.HardwareAPIKey, cfg.RNG.HardwareBlockBytes, &http.Client{Timeout: timeout}), nil
+	default:
+		return nil, fmt.Errorf("unknown RNG provider %q", cfg.RNG.Provider)
+	}
+}
@@ -0,0 +1,130 @@
+package rules
+
+import (
+	"sort"
+
+	"github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/multiplier"
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/game/wilds"
+	"github.com/slotmachine/backend/internal/game/wins"
+)
+
+// Rules is the game's rules and paytable as applied by the engine
+// Built from the game packages so a rules screen cannot drift from server math.
+type Rules struct {
+	Reels            int           `json:"reels"`
+	Rows             int           `json:"rows"` // Rows evaluated for wins on each reel
+	Ways             int           `json:"ways"` // Maximum ways to win (rows ^ reels)
+	MinMatch         int           `json:"min_match"`
+	MaxWinMultiplier int           `json:"max_win_multiplier"` // Max total win as a multiple of bet
+	Paytable         []SymbolPay   `json:"paytable"`
+	Wild             WildRules     `json:"wild"`
+	Multipliers      Multipliers   `json:"multipliers"`
+	FreeSpins        FreeSpinRules `json:"free_spins"`
+}
+
+// SymbolPay lists a symbol's payouts (bet multiples) by matched reel count
+type SymbolPay struct {
+	Symbol    symbols.Symbol  `json:"symbol"`
+	HighValue bool            `json:"high_value"`
+	Payouts   map[int]float64 `json:"payouts"`
+}
+
+// WildRules describes wild and gold symbol behaviour
+type WildRules struct {
+	Symbol      symbols.Symbol   `json:"symbol"`
+	Substitutes []symbols.Symbol `json:"substitutes"`
+	GoldToWild  bool             `json:"gold_to_wild"` // Winning gold variants turn into wilds instead of clearing
+	Sticky      bool             `json:"sticky"`       // Wilds stay in place for the rest of a free spins session
+	Expanding   bool             `json:"expanding"`    // Wilds in a winning cascade fill their reel
+	Multiplier  int              `json:"multiplier"`   // Multiplier on each way a wild completes
+}
+
+// Multipliers holds the cascade multiplier ladders
+// The last step applies to every further cascade.
+type Multipliers struct {
+	BaseGame  []int `json:"base_game"`
+	FreeSpins []int `json:"free_spins"`
+}
+
+// FreeSpinRules describes how free spins are triggered and awarded
+type FreeSpinRules struct {
+	ScatterSymbol   symbols.Symbol `json:"scatter_symbol"`
+	MinScatters     int            `json:"min_scatters"`
+	Awards          map[int]int    `json:"awards"`            // Spins awarded by scatter count
+	ExtraPerScatter int            `json:"extra_per_scatter"` // Additional spins per scatter beyond the table
+	Retrigger       bool           `json:"retrigger"`
+}
+
+// awardRows is how many scatter counts the free spins award table lists
+const awardRows = 3
+
+// Build serializes the engine's current rules with the given multiplier ladder, trigger rules and wild features
+func Build(ladder multiplier.Ladder, trigger freespins.TriggerRules, features wilds.Features) *Rules {
+	rows := reels.WinCheckEndRow - reels.WinCheckStartRow + 1
+
+	ways := 1
+	for i := 0; i < reels.ReelCount; i++ {
+		ways *= rows
+	}
+
+	paying := symbols.PayingSymbols()
+	paytable := make([]SymbolPay, 0, len(paying))
+	for _, sym := range paying {
+		payouts := make(map[int]float64, len(symbols.Paytable[sym]))
+		for count, pay := range symbols.Paytable[sym] {
+			payouts[count] = pay
+		}
+		paytable = append(paytable, SymbolPay{
+			Symbol:    sym,
+			HighValue: symbols.IsHighValueSymbol(sym),
+			Payouts:   payouts,
+		})
+	}
+	// Highest paying first, as shown on a paytable
+	sort.SliceStable(paytable, func(i, j int) bool {
+		return symbols.GetPayout(paytable[i].Symbol, reels.ReelCount) > symbols.GetPayout(paytable[j].Symbol, reels.ReelCount)
+	})
+
+	substitutes := make([]symbols.Symbol, 0, len(paying))
+	for _, sym := range paying {
+		if symbols.CanBeSubstituted(sym) {
+			substitutes = append(substitutes, sym)
+		}
+	}
+
+	awards := make(map[int]int, awardRows)
+	for count := trigger.MinScatters; count < trigger.MinScatters+awardRows; count++ {
+		awards[count] = trigger.Award(count)
+	}
+
+	return &Rules{
+		Reels:            reels.ReelCount,
+		Rows:             rows,
+		Ways:             ways,
+		MinMatch:         symbols.MinSymbolsForPayout(),
+		MaxWinMultiplier: wins.MaxWinMultiplier,
+		Paytable:         paytable,
+		Wild: WildRules{
+			Symbol:      symbols.SymbolWild,
+			Substitutes: substitutes,
+			GoldToWild:  true,
+			Sticky:      features.StickyWilds,
+			Expanding:   features.ExpandingWilds,
+			Multiplier:  features.Multiplier(),
+		},
+		Multipliers: Multipliers{
+			BaseGame:  ladder.Steps(false),
+			FreeSpins: ladder.Steps(true),
+		},
+		FreeSpins: FreeSpinRules{
+			ScatterSymbol:   symbols.SymbolBonus,
+			MinScatters:     trigger.MinScatters,
+			Awards:          awards,
+			ExtraPerScatter: trigger.ExtraPerScatter,
+			Retrigger:       trigger.Retrigger,
+		},
+	}
+}
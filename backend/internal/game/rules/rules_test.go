@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/multiplier"
+	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/game/wilds"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	r := Build(multiplier.DefaultLadder, freespins.DefaultTriggerRules, wilds.Features{})
+
+	t.Run("should report 1024 ways on 5 reels of 4 rows", func(t *testing.T) {
+		assert.Equal(t, 5, r.Reels)
+		assert.Equal(t, 4, r.Rows)
+		assert.Equal(t, 1024, r.Ways)
+	})
+
+	t.Run("should include every paying symbol, highest first", func(t *testing.T) {
+		require.Len(t, r.Paytable, len(symbols.PayingSymbols()))
+		assert.Equal(t, symbols.SymbolFa, r.Paytable[0].Symbol)
+		assert.Equal(t, symbols.SymbolLiangtong, r.Paytable[len(r.Paytable)-1].Symbol)
+		for _, pay := range r.Paytable {
+			for count, payout := range pay.Payouts {
+				assert.Equal(t, symbols.GetPayout(pay.Symbol, count), payout)
+			}
+		}
+	})
+
+	t.Run("should serialize multiplier ladders", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3, 5}, r.Multipliers.BaseGame)
+		assert.Equal(t, []int{2, 4, 6, 10}, r.Multipliers.FreeSpins)
+
+		custom := Build(multiplier.Ladder{BaseGame: []int{1, 3, 9}, FreeSpins: []int{3, 9, 27}}, freespins.DefaultTriggerRules, wilds.Features{})
+		assert.Equal(t, []int{1, 3, 9}, custom.Multipliers.BaseGame)
+		assert.Equal(t, []int{3, 9, 27}, custom.Multipliers.FreeSpins)
+	})
+
+	t.Run("should serialize free spin awards", func(t *testing.T) {
+		assert.Equal(t, 3, r.FreeSpins.MinScatters)
+		assert.Equal(t, map[int]int{3: 12, 4: 14, 5: 16}, r.FreeSpins.Awards)
+		assert.Equal(t, 2, r.FreeSpins.ExtraPerScatter)
+	})
+
+	t.Run("should serialize custom trigger rules", func(t *testing.T) {
+		custom := Build(multiplier.DefaultLadder, freespins.TriggerRules{MinScatters: 4, BaseAward: 8, ExtraPerScatter: 4}, wilds.Features{})
+		assert.Equal(t, 4, custom.FreeSpins.MinScatters)
+		assert.Equal(t, map[int]int{4: 8, 5: 12, 6: 16}, custom.FreeSpins.Awards)
+		assert.False(t, custom.FreeSpins.Retrigger)
+	})
+
+	t.Run("should serialize wild features", func(t *testing.T) {
+		assert.False(t, r.Wild.Sticky)
+		assert.Equal(t, 1, r.Wild.Multiplier)
+
+		custom := Build(multiplier.DefaultLadder, freespins.DefaultTriggerRules, wilds.Features{StickyWilds: true, ExpandingWilds: true, WildMultiplier: 2})
+		assert.True(t, custom.Wild.Sticky)
+		assert.True(t, custom.Wild.Expanding)
+		assert.Equal(t, 2, custom.Wild.Multiplier)
+	})
+
+	t.Run("should not share maps with the engine paytable", func(t *testing.T) {
+		r.Paytable[0].Payouts[5] = 0
+		assert.NotZero(t, symbols.GetPayout(r.Paytable[0].Symbol, 5))
+	})
+}
@@ -0,0 +1,97 @@
+package simstats
+
+import (
+	"fmt"
+	"math"
+)
+
+// Comparison is the difference between two finalized runs, B relative to A
+type Comparison struct {
+	RTPDiff          float64 `json:"rtp_diff"`           // Percentage points
+	HitFrequencyDiff float64 `json:"hit_frequency_diff"` // Percentage points
+	VolatilityDiff   float64 `json:"volatility_diff"`    // Bets
+	// JSDivergence is the Jensen-Shannon divergence of the return distributions:
+	// 0 for identical distributions, 1 for distributions with no bucket in common
+	JSDivergence float64 `json:"js_divergence"`
+	// KSDistance is the largest gap between the cumulative return distributions
+	KSDistance float64 `json:"ks_distance"`
+}
+
+// Compare compares the finalized stats of two runs
+func Compare(a, b Stats) Comparison {
+	pa, pb := a.returnShares(), b.returnShares()
+
+	js, cdfA, cdfB, ks := 0.0, 0.0, 0.0, 0.0
+	for i := range pa {
+		m := (pa[i] + pb[i]) / 2
+		js += (klTerm(pa[i], m) + klTerm(pb[i], m)) / 2
+		cdfA += pa[i]
+		cdfB += pb[i]
+		ks = math.Max(ks, math.Abs(cdfA-cdfB))
+	}
+
+	return Comparison{
+		RTPDiff:          b.RTP - a.RTP,
+		HitFrequencyDiff: b.HitFrequency - a.HitFrequency,
+		VolatilityDiff:   b.Volatility - a.Volatility,
+		JSDivergence:     js,
+		KSDistance:       ks,
+	}
+}
+
+// returnShares is the return distribution as fractions of the recorded spins
+func (s *Stats) returnShares() [len(ReturnBuckets)]float64 {
+	var shares [len(ReturnBuckets)]float64
+	if n := s.ReturnSpins(); n > 0 {
+		for i, count := range s.ReturnDistribution {
+			shares[i] = float64(count) / float64(n)
+		}
+	}
+	return shares
+}
+
+// klTerm is one bucket's term of the base-2 Kullback-Leibler divergence of p from m
+func klTerm(p, m float64) float64 {
+	if p == 0 {
+		return 0
+	}
+	return p * math.Log2(p/m)
+}
+
+// PrintComparison writes two runs side by side with their differences to stdout
+func PrintComparison(labelA, labelB string, a, b Stats, betAmount float64) {
+	c := Compare(a, b)
+
+	fmt.Println()
+	fmt.Println("╔════════════════════════════════════════════════════════════╗")
+	fmt.Println("║                   COMPARISON RESULTS                       ║")
+	fmt.Println("╚════════════════════════════════════════════════════════════╝")
+	fmt.Println()
+	fmt.Printf("A: %s\n", labelA)
+	fmt.Printf("B: %s\n", labelB)
+	fmt.Println()
+
+	fmt.Printf("%-22s %14s %14s %14s\n", "", "A", "B", "B - A")
+	row := func(name, format string, va, vb, diff float64) {
+		fmt.Printf("%-22s %14s %14s %14s\n", name,
+			fmt.Sprintf(format, va), fmt.Sprintf(format, vb), fmt.Sprintf("%+"+format[1:], diff))
+	}
+	row("RTP (%)", "%.4f", a.RTP, b.RTP, c.RTPDiff)
+	row("Base Game RTP (%)", "%.4f", a.BaseRTP, b.BaseRTP, b.BaseRTP-a.BaseRTP)
+	row("Free Spins RTP (%)", "%.4f", a.FreeRTP, b.FreeRTP, b.FreeRTP-a.FreeRTP)
+	row("Hit Frequency (%)", "%.2f", a.HitFrequency, b.HitFrequency, c.HitFrequencyDiff)
+	row("Trigger Rate (%)", "%.4f", a.FreeSpinsTriggeredRate, b.FreeSpinsTriggeredRate, b.FreeSpinsTriggeredRate-a.FreeSpinsTriggeredRate)
+	row("Volatility (SD, bets)", "%.3f", a.Volatility, b.Volatility, c.VolatilityDiff)
+	row("Max Win (bets)", "%.1f", a.MaxWin/betAmount, b.MaxWin/betAmount, (b.MaxWin-a.MaxWin)/betAmount)
+	fmt.Println()
+
+	fmt.Println("═══ RETURN DISTRIBUTION (% of spins) ═══")
+	pa, pb := a.returnShares(), b.returnShares()
+	for i, label := range ReturnBucketLabels {
+		row(label, "%.4f", pa[i]*100, pb[i]*100, (pb[i]-pa[i])*100)
+	}
+	fmt.Println()
+	fmt.Printf("Jensen-Shannon Divergence: %.6f\n", c.JSDivergence)
+	fmt.Printf("KS Distance:               %.6f\n", c.KSDistance)
+	fmt.Println()
+}
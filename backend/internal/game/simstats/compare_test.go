@@ -0,0 +1,49 @@
+package simstats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func statsWithReturns(returns ...float64) Stats {
+	var s Stats
+	for i, r := range returns {
+		s.RecordSpin(i+1, 1, r, 0)
+		s.RecordReturn(1, r)
+	}
+	s.Finalize()
+	return s
+}
+
+func TestCompare_Identical(t *testing.T) {
+	a := statsWithReturns(0, 1.5, 3, 30)
+	c := Compare(a, a)
+
+	assert.Zero(t, c.RTPDiff)
+	assert.Zero(t, c.VolatilityDiff)
+	assert.InDelta(t, 0, c.JSDivergence, 1e-12)
+	assert.InDelta(t, 0, c.KSDistance, 1e-12)
+}
+
+func TestCompare_Disjoint(t *testing.T) {
+	a := statsWithReturns(0, 0)
+	b := statsWithReturns(3, 3)
+	c := Compare(a, b)
+
+	assert.InDelta(t, 300, c.RTPDiff, 1e-9)
+	assert.InDelta(t, 100, c.HitFrequencyDiff, 1e-9)
+	assert.InDelta(t, 1, c.JSDivergence, 1e-12, "no bucket in common")
+	assert.InDelta(t, 1, c.KSDistance, 1e-12)
+}
+
+func TestCompare_PartialOverlap(t *testing.T) {
+	a := statsWithReturns(0, 0, 1.5, 1.5)
+	b := statsWithReturns(0, 1.5, 1.5, 1.5)
+	c := Compare(a, b)
+
+	assert.InDelta(t, 25, c.HitFrequencyDiff, 1e-9)
+	assert.InDelta(t, 0.25, c.KSDistance, 1e-12)
+	assert.Greater(t, c.JSDivergence, 0.0)
+	assert.Less(t, c.JSDivergence, 1.0)
+}
@@ -0,0 +1,255 @@
+// Package simstats accumulates and reports spin statistics for the RTP simulator and load tester
+package simstats
+
+import (
+	"fmt"
+	"math"
+)
+
+// Stats holds the statistics from a simulation or load test run
+type Stats struct {
+	TotalSpins             int     `json:"total_spins"`
+	TotalWagered           float64 `json:"total_wagered"`
+	TotalWon               float64 `json:"total_won"`
+	RTP                    float64 `json:"rtp"`
+	BaseGameWins           int     `json:"base_game_wins"`
+	BaseGameTotalWon       float64 `json:"base_game_total_won"`
+	BaseRTP                float64 `json:"base_rtp"`
+	FreeSpinsTriggered     int     `json:"free_spins_triggered"`
+	FreeSpinsTriggeredRate float64 `json:"free_spins_triggered_rate"`
+	FreeSpinsTotalWon      float64 `json:"free_spins_total_won"`
+	FreeRTP                float64 `json:"free_rtp"`
+	FreeSpinsRetriggered   int     `json:"free_spins_retriggered"`
+	MaxWin                 float64 `json:"max_win"`
+	MaxWinSpin             int     `json:"max_win_spin"`
+
+	// Hit frequency
+	NoWinSpins int `json:"no_win_spins"`
+	SmallWins  int `json:"small_wins"`  // < 5x bet
+	MediumWins int `json:"medium_wins"` // 5x - 20x bet
+	BigWins    int `json:"big_wins"`    // 20x - 100x bet
+	MegaWins   int `json:"mega_wins"`   // > 100x bet
+
+	// Cascade statistics
+	TotalCascades     int     `json:"total_cascades"`
+	MaxCascades       int     `json:"max_cascades"`
+	AvgCascadesPerWin float64 `json:"avg_cascades_per_win"`
+
+	// Free spins statistics
+	TotalFreeSpins      int     `json:"total_free_spins"`
+	AvgFreeSpinsAwarded float64 `json:"avg_free_spins_awarded"`
+
+	// Return per paid spin (base win plus any free spins it triggered), in bets
+	HitFrequency       float64                 `json:"hit_frequency"`      // % of paid spins returning anything
+	Volatility         float64                 `json:"volatility"`         // Standard deviation of the return
+	SumSquaredReturn   float64                 `json:"sum_squared_return"` // Σ return², for Volatility
+	ReturnDistribution [len(ReturnBuckets)]int `json:"return_distribution"`
+}
+
+// ReturnBuckets are the upper bounds (exclusive, in bets) of the ReturnDistribution buckets;
+// the first bucket holds the spins returning nothing
+var ReturnBuckets = [...]float64{0, 1, 2, 5, 20, 100, math.Inf(1)}
+
+// ReturnBucketLabels name the ReturnDistribution buckets
+var ReturnBucketLabels = [len(ReturnBuckets)]string{"0x", "<1x", "1-2x", "2-5x", "5-20x", "20-100x", "100x+"}
+
+// RecordReturn adds the total return of a paid spin once its free spins, if any, are played
+func (s *Stats) RecordReturn(betAmount, totalReturn float64) {
+	x := totalReturn / betAmount
+	s.SumSquaredReturn += x * x
+	if x <= 0 {
+		s.ReturnDistribution[0]++
+		return
+	}
+	for i := 1; i < len(ReturnBuckets); i++ {
+		if x < ReturnBuckets[i] {
+			s.ReturnDistribution[i]++
+			return
+		}
+	}
+}
+
+// RecordSpin adds a paid base spin; spinNumber is 1-based and only used to locate the max win
+// Free spin winnings are added by the caller to TotalWon and FreeSpinsTotalWon.
+func (s *Stats) RecordSpin(spinNumber int, betAmount, win float64, cascades int) {
+	s.TotalSpins++
+	s.TotalWagered += betAmount
+	s.TotalWon += win
+
+	// Track max win
+	if win > s.MaxWin {
+		s.MaxWin = win
+		s.MaxWinSpin = spinNumber
+	}
+
+	// Categorize win size
+	winMultiplier := win / betAmount
+	if win == 0 {
+		s.NoWinSpins++
+	} else if winMultiplier < 5 {
+		s.SmallWins++
+	} else if winMultiplier < 20 {
+		s.MediumWins++
+	} else if winMultiplier < 100 {
+		s.BigWins++
+	} else {
+		s.MegaWins++
+	}
+
+	// Track cascades
+	s.TotalCascades += cascades
+	if cascades > s.MaxCascades {
+		s.MaxCascades = cascades
+	}
+	if win > 0 {
+		s.BaseGameWins++
+		s.BaseGameTotalWon += win
+	}
+}
+
+// Merge adds the counts from other, e.g. per-worker stats; call Finalize afterwards
+func (s *Stats) Merge(other Stats) {
+	if other.MaxWin > s.MaxWin {
+		s.MaxWin = other.MaxWin
+		s.MaxWinSpin = s.TotalSpins + other.MaxWinSpin
+	}
+	if other.MaxCascades > s.MaxCascades {
+		s.MaxCascades = other.MaxCascades
+	}
+	s.TotalSpins += other.TotalSpins
+	s.TotalWagered += other.TotalWagered
+	s.TotalWon += other.TotalWon
+	s.BaseGameWins += other.BaseGameWins
+	s.BaseGameTotalWon += other.BaseGameTotalWon
+	s.FreeSpinsTriggered += other.FreeSpinsTriggered
+	s.FreeSpinsTotalWon += other.FreeSpinsTotalWon
+	s.FreeSpinsRetriggered += other.FreeSpinsRetriggered
+	s.NoWinSpins += other.NoWinSpins
+	s.SmallWins += other.SmallWins
+	s.MediumWins += other.MediumWins
+	s.BigWins += other.BigWins
+	s.MegaWins += other.MegaWins
+	s.TotalCascades += other.TotalCascades
+	s.TotalFreeSpins += other.TotalFreeSpins
+	s.SumSquaredReturn += other.SumSquaredReturn
+	for i, n := range other.ReturnDistribution {
+		s.ReturnDistribution[i] += n
+	}
+}
+
+// Finalize computes the derived ratios from the counts
+func (s *Stats) Finalize() {
+	if s.TotalWagered > 0 {
+		s.RTP = (s.TotalWon / s.TotalWagered) * 100
+		s.BaseRTP = (s.BaseGameTotalWon / s.TotalWagered) * 100
+		s.FreeRTP = (s.FreeSpinsTotalWon / s.TotalWagered) * 100
+	}
+
+	if recorded := s.ReturnSpins(); recorded > 0 {
+		s.HitFrequency = float64(recorded-s.ReturnDistribution[0]) / float64(recorded) * 100
+		mean := s.RTP / 100
+		s.Volatility = math.Sqrt(math.Max(s.SumSquaredReturn/float64(recorded)-mean*mean, 0))
+	}
+
+	if s.BaseGameWins > 0 {
+		s.AvgCascadesPerWin = float64(s.TotalCascades) / float64(s.BaseGameWins)
+	}
+
+	if s.FreeSpinsTriggered > 0 {
+		s.AvgFreeSpinsAwarded = float64(s.TotalFreeSpins) / float64(s.FreeSpinsTriggered)
+		s.FreeSpinsTriggeredRate = float64(s.FreeSpinsTriggered) / float64(s.TotalSpins) * 100
+	}
+}
+
+// ReturnSpins is the number of paid spins whose return was recorded
+func (s *Stats) ReturnSpins() int {
+	n := 0
+	for _, count := range s.ReturnDistribution {
+		n += count
+	}
+	return n
+}
+
+// Print writes the results report to stdout
+func Print(stats Stats, betAmount float64, targetRTP float64) {
+	fmt.Println()
+	fmt.Println("╔════════════════════════════════════════════════════════════╗")
+	fmt.Println("║                    SIMULATION RESULTS                      ║")
+	fmt.Println("╚════════════════════════════════════════════════════════════╝")
+	fmt.Println()
+
+	// Overall RTP
+	fmt.Println("═══ OVERALL STATISTICS ═══")
+	fmt.Printf("Total Spins:           %d\n", stats.TotalSpins)
+	fmt.Printf("Total Wagered:         %.2f\n", stats.TotalWagered)
+	fmt.Printf("Total Won:             %.2f\n", stats.TotalWon)
+	fmt.Printf("RTP:                   %.4f%% ", stats.RTP)
+
+	// Color-code RTP result
+	diff := stats.RTP - targetRTP
+	if diff > -0.3 && diff < 0.3 {
+		fmt.Printf("✓ (target: %.2f%%)\n", targetRTP)
+	} else if diff > -1.0 && diff < 1.0 {
+		fmt.Printf("⚠ (target: %.2f%%, diff: %+.2f%%)\n", targetRTP, diff)
+	} else {
+		fmt.Printf("✗ (target: %.2f%%, diff: %+.2f%%)\n", targetRTP, diff)
+	}
+	fmt.Println()
+
+	// Hit frequency
+	fmt.Println("═══ HIT FREQUENCY ═══")
+	totalWinSpins := stats.SmallWins + stats.MediumWins + stats.BigWins + stats.MegaWins
+	hitFrequency := float64(totalWinSpins) / float64(stats.TotalSpins) * 100
+
+	fmt.Printf("Winning Spins:         %d (%.2f%%)\n", totalWinSpins, hitFrequency)
+	fmt.Printf("No Win:                %d (%.2f%%)\n", stats.NoWinSpins,
+		float64(stats.NoWinSpins)/float64(stats.TotalSpins)*100)
+	fmt.Printf("Small Wins (<5x):      %d (%.2f%%)\n", stats.SmallWins,
+		float64(stats.SmallWins)/float64(stats.TotalSpins)*100)
+	fmt.Printf("Medium Wins (5-20x):   %d (%.2f%%)\n", stats.MediumWins,
+		float64(stats.MediumWins)/float64(stats.TotalSpins)*100)
+	fmt.Printf("Big Wins (20-100x):    %d (%.2f%%)\n", stats.BigWins,
+		float64(stats.BigWins)/float64(stats.TotalSpins)*100)
+	fmt.Printf("Mega Wins (>100x):     %d (%.2f%%)\n", stats.MegaWins,
+		float64(stats.MegaWins)/float64(stats.TotalSpins)*100)
+	fmt.Println()
+
+	// Base game statistics
+	fmt.Println("═══ BASE GAME ═══")
+	fmt.Printf("Base Game Wins:        %d (%.2f%%)\n", stats.BaseGameWins,
+		float64(stats.BaseGameWins)/float64(stats.TotalSpins)*100)
+	fmt.Printf("Base Game RTP:         %.4f%%\n", stats.BaseRTP)
+	fmt.Printf("Avg Cascades/Win:      %.2f\n", stats.AvgCascadesPerWin)
+	fmt.Printf("Max Cascades:          %d\n", stats.MaxCascades)
+	fmt.Println()
+
+	// Free spins statistics
+	fmt.Println("═══ FREE SPINS ═══")
+	fmt.Printf("Triggered:             %d times (%.4f%%)\n", stats.FreeSpinsTriggered, stats.FreeSpinsTriggeredRate)
+	fmt.Printf("Avg Spins Awarded:     %.2f\n", stats.AvgFreeSpinsAwarded)
+	fmt.Printf("Free Spins RTP:        %.4f%%\n", stats.FreeRTP)
+	fmt.Printf("Avg Trigger Frequency: 1 in %.0f spins\n", float64(stats.TotalSpins)/float64(stats.FreeSpinsTriggered))
+	fmt.Println()
+
+	// Max win
+	fmt.Println("═══ MAX WIN ═══")
+	maxWinMultiplier := stats.MaxWin / betAmount
+	fmt.Printf("Max Win:               %.2f (%.1fx bet)\n", stats.MaxWin, maxWinMultiplier)
+	fmt.Printf("Occurred at Spin:      %d\n", stats.MaxWinSpin)
+	fmt.Println()
+
+	// Volatility indicator
+	fmt.Println("═══ VOLATILITY INDICATORS ═══")
+	avgWin := stats.TotalWon / float64(totalWinSpins)
+	fmt.Printf("Average Win:           %.2f (%.2fx bet)\n", avgWin, avgWin/betAmount)
+	fmt.Printf("Max/Avg Win Ratio:     %.1fx\n", stats.MaxWin/avgWin)
+
+	volatility := "MEDIUM"
+	if maxWinMultiplier > 500 {
+		volatility = "HIGH"
+	} else if maxWinMultiplier < 100 {
+		volatility = "LOW"
+	}
+	fmt.Printf("Volatility:            %s\n", volatility)
+	fmt.Println()
+}
@@ -0,0 +1,65 @@
+package simstats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats_RecordAndFinalize(t *testing.T) {
+	var s Stats
+	s.RecordSpin(1, 1, 0, 0)
+	s.RecordSpin(2, 1, 3, 1)
+	s.RecordSpin(3, 1, 150, 4)
+	s.FreeSpinsTriggered++
+	s.FreeSpinsTotalWon += 7
+	s.TotalWon += 7
+	s.TotalFreeSpins += 10
+	s.Finalize()
+
+	assert.Equal(t, 3, s.TotalSpins)
+	assert.Equal(t, 1, s.NoWinSpins)
+	assert.Equal(t, 1, s.SmallWins)
+	assert.Equal(t, 1, s.MegaWins)
+	assert.Equal(t, 150.0, s.MaxWin)
+	assert.Equal(t, 3, s.MaxWinSpin)
+	assert.Equal(t, 4, s.MaxCascades)
+	assert.Equal(t, 2.5, s.AvgCascadesPerWin)
+	assert.InDelta(t, 160.0/3*100, s.RTP, 1e-9)
+	assert.InDelta(t, 7.0/3*100, s.FreeRTP, 1e-9)
+	assert.Equal(t, 10.0, s.AvgFreeSpinsAwarded)
+}
+
+func TestStats_Merge(t *testing.T) {
+	var a, b Stats
+	a.RecordSpin(1, 1, 2, 1)
+	a.RecordSpin(2, 1, 0, 0)
+	b.RecordSpin(1, 1, 40, 3)
+
+	var total Stats
+	total.Merge(a)
+	total.Merge(b)
+	total.Finalize()
+
+	assert.Equal(t, 3, total.TotalSpins)
+	assert.Equal(t, 42.0, total.TotalWon)
+	assert.Equal(t, 40.0, total.MaxWin)
+	assert.Equal(t, 3, total.MaxWinSpin, "max win spin is offset by the spins merged before it")
+	assert.Equal(t, 3, total.MaxCascades)
+	assert.Equal(t, 1, total.BigWins)
+}
+
+func TestStats_ReturnDistributionAndVolatility(t *testing.T) {
+	var s Stats
+	for _, win := range []float64{0, 0, 0.5, 4} {
+		s.RecordSpin(1, 1, win, 0)
+		s.RecordReturn(1, win)
+	}
+	s.Finalize()
+
+	assert.Equal(t, [len(ReturnBuckets)]int{2, 1, 0, 1, 0, 0, 0}, s.ReturnDistribution)
+	assert.Equal(t, 50.0, s.HitFrequency)
+	// Mean 1.125, E[x²] = (0.25 + 16) / 4
+	assert.InDelta(t, math.Sqrt(16.25/4-1.125*1.125), s.Volatility, 1e-9)
+}
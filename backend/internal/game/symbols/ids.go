@@ -0,0 +1,115 @@
+package symbols
+
+// ID is a compact symbol identifier for the spin hot path
+// Grids and strips are compiled to IDs once so win evaluation compares bytes instead of strings.
+type ID uint8
+
+// Symbol IDs. IDNone is an empty cell (removed by a cascade); IDUnknown is any unrecognised name
+const (
+	IDNone ID = iota
+	IDUnknown
+	IDWild
+	IDBonus
+	IDGold
+	IDFa
+	IDZhong
+	IDBai
+	IDBawan
+	IDWusuo
+	IDWutong
+	IDLiangsuo
+	IDLiangtong
+	IDFaGold
+	IDZhongGold
+	IDBaiGold
+	IDBawanGold
+	IDWusuoGold
+	IDWutongGold
+	IDLiangsuoGold
+	IDLiangtongGold
+
+	idCount
+)
+
+// goldOffset is the distance from a paying symbol's ID to its gold variant
+const goldOffset = IDFaGold - IDFa
+
+var idNames = [idCount]string{
+	IDNone:          "",
+	IDUnknown:       "",
+	IDWild:          string(SymbolWild),
+	IDBonus:         string(SymbolBonus),
+	IDGold:          string(SymbolGold),
+	IDFa:            string(SymbolFa),
+	IDZhong:         string(SymbolZhong),
+	IDBai:           string(SymbolBai),
+	IDBawan:         string(SymbolBawan),
+	IDWusuo:         string(SymbolWusuo),
+	IDWutong:        string(SymbolWutong),
+	IDLiangsuo:      string(SymbolLiangsuo),
+	IDLiangtong:     string(SymbolLiangtong),
+	IDFaGold:        string(SymbolFa) + "_gold",
+	IDZhongGold:     string(SymbolZhong) + "_gold",
+	IDBaiGold:       string(SymbolBai) + "_gold",
+	IDBawanGold:     string(SymbolBawan) + "_gold",
+	IDWusuoGold:     string(SymbolWusuo) + "_gold",
+	IDWutongGold:    string(SymbolWutong) + "_gold",
+	IDLiangsuoGold:  string(SymbolLiangsuo) + "_gold",
+	IDLiangtongGold: string(SymbolLiangtong) + "_gold",
+}
+
+var idsByName = func() map[string]ID {
+	m := make(map[string]ID, idCount)
+	for id := IDWild; id < idCount; id++ {
+		m[idNames[id]] = id
+	}
+	return m
+}()
+
+// ParseID returns the ID of a symbol name; "" is IDNone and unrecognised names are IDUnknown
+func ParseID(name string) ID {
+	if name == "" {
+		return IDNone
+	}
+	if id, ok := idsByName[name]; ok {
+		return id
+	}
+	return IDUnknown
+}
+
+// String returns the symbol name ("" for IDNone and IDUnknown)
+func (id ID) String() string {
+	if id >= idCount {
+		return ""
+	}
+	return idNames[id]
+}
+
+// Base returns the ID with any gold variant removed
+func (id ID) Base() ID {
+	if id.IsGold() {
+		return id - goldOffset
+	}
+	return id
+}
+
+// Symbol returns the base symbol
+func (id ID) Symbol() Symbol {
+	return Symbol(id.Base().String())
+}
+
+// IsGold reports whether the ID is the gold variant of a paying symbol
+func (id ID) IsGold() bool {
+	return id >= IDFaGold && id < idCount
+}
+
+// IsPaying reports whether the ID's base symbol awards payouts
+func (id ID) IsPaying() bool {
+	base := id.Base()
+	return base >= IDFa && base <= IDLiangtong
+}
+
+// SymbolID returns the ID of a base symbol
+func SymbolID(sym Symbol) ID {
+	return ParseID(string(sym))
+}
@@ -0,0 +1,32 @@
+package symbols
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestID_RoundTrip(t *testing.T) {
+	for _, sym := range AllSymbols() {
+		id := SymbolID(sym)
+		assert.Equal(t, string(sym), id.String())
+		assert.Equal(t, sym, id.Symbol())
+		assert.Equal(t, IsPayingSymbol(sym), id.IsPaying(), "paying %s", sym)
+
+		if HasGoldVariant(sym) {
+			gold := ParseID(string(sym) + "_gold")
+			assert.True(t, gold.IsGold())
+			assert.Equal(t, id, gold.Base())
+			assert.Equal(t, sym, gold.Symbol())
+			assert.True(t, gold.IsPaying())
+		}
+	}
+}
+
+func TestParseID_Unrecognised(t *testing.T) {
+	assert.Equal(t, IDNone, ParseID(""))
+	assert.Equal(t, IDUnknown, ParseID("cai"))
+	assert.Equal(t, IDUnknown, ParseID("gold_gold"))
+	assert.False(t, IDUnknown.IsPaying())
+	assert.Equal(t, "", IDUnknown.String())
+}
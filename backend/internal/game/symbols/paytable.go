@@ -1,5 +1,12 @@
 package symbols
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+)
+
 // SymbolPayout represents payouts for different symbol counts
 type SymbolPayout struct {
 	Symbol  Symbol
@@ -53,6 +60,14 @@ var Paytable = map[Symbol]map[int]float64{
 	},
 }
 
+// PaytableVersion identifies the paytable in certification records and spin math versions
+// It is the first 16 hex digits of the SHA256 of the paytable's JSON, so any payout change yields a new version.
+var PaytableVersion = sync.OnceValue(func() string {
+	data, _ := json.Marshal(Paytable) // Map keys marshal sorted
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+})
+
 // GetPayout returns the payout multiplier for a symbol and count
 func GetPayout(sym Symbol, count int) float64 {
 	if payouts, ok := Paytable[sym]; ok {
@@ -2,7 +2,6 @@ package tuningbasespin
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"math"
 	"os"
@@ -10,20 +9,13 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/google/uuid"
-	"github.com/slotmachine/backend/cmd/rtp-tuning/tuning"
-	"github.com/slotmachine/backend/domain/reelstrip"
-	"github.com/slotmachine/backend/internal/config"
-	"github.com/slotmachine/backend/internal/db"
 	"github.com/slotmachine/backend/internal/game/cascade"
 	"github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/multiplier"
 	"github.com/slotmachine/backend/internal/game/reels"
 	"github.com/slotmachine/backend/internal/game/rng"
 	"github.com/slotmachine/backend/internal/game/symbols"
-	"github.com/slotmachine/backend/internal/infra/repository"
-	"github.com/slotmachine/backend/internal/pkg/cache"
-	"github.com/slotmachine/backend/internal/pkg/logger"
-	"github.com/slotmachine/backend/internal/service"
+	"github.com/slotmachine/backend/internal/game/tuning"
 )
 
 // ProgressTracker tracks progress across all workers using atomic operations
@@ -73,9 +65,67 @@ func (p *ProgressTracker) Increment(count int, prefix string) {
 	}
 }
 
+// GeneratorSeed seeds the reel generator so tuning runs are reproducible
+const GeneratorSeed = 42
+
+// Weights returns the symbol weights the reel strips are generated from
+func Weights() *tuning.ReelWeightsSet {
+	return tuning.ConvertToReelWeightsSet(symbols.BaseGameWeights)
+}
+
+// Simulate plays cfg.TotalSpin base game spins on strips and returns the statistics with derived rates
+func Simulate(strips []reels.ReelStrip, cfg *tuning.TuningConfig, progressInterval int) tuning.SimulationStats {
+	stats := runParallelSpinSimulation(strips, cfg, cfg.ParallelCfg, progressInterval)
+	stats.ComputeRates()
+	return stats
+}
+
+// CountTriggers plays spins base spins on strips and returns the free spins triggers by scatter count
+func CountTriggers(strips []reels.ReelStrip, cfg *tuning.TuningConfig, spins int) map[int]int {
+	numWorkers := cfg.ParallelCfg.NumWorkers
+	results := make([]map[int]int, numWorkers)
+	var wg sync.WaitGroup
+
+	for w := 0; w < numWorkers; w++ {
+		wg.Add(1)
+		workerSpins := spins / numWorkers
+		if w < spins%numWorkers {
+			workerSpins++
+		}
+
+		go func(workerID int, spins int) {
+			defer wg.Done()
+
+			workerRNG := rng.NewFastRNG()
+			for i := 0; i < workerID*1000; i++ {
+				workerRNG.Intn(100)
+			}
+
+			stats := tuning.SimulationStats{}
+			triggers := make(map[int]int)
+			for i := 0; i < spins; i++ {
+				if trigger := executeBaseSpin(&stats, strips, workerRNG, cfg.BetAmount, cfg.Ladder); trigger.Triggered {
+					triggers[trigger.ScatterCount]++
+				}
+			}
+			results[workerID] = triggers
+		}(w, workerSpins)
+	}
+
+	wg.Wait()
+
+	merged := make(map[int]int)
+	for _, triggers := range results {
+		for scatterCount, n := range triggers {
+			merged[scatterCount] += n
+		}
+	}
+	return merged
+}
+
 func ExecuteTuning() {
 	tuningCfg := NewConfig()
-	pgGenerator := tuning.NewPGReelGenerator(42, DefaultTopologies())
+	pgGenerator := tuning.NewPGReelGenerator(GeneratorSeed, DefaultTopologies())
 	symbolTargets := tuning.DefaultSymbolContributionTargets()
 
 	fmt.Println("Using PG-style reel generator with:")
@@ -116,84 +166,20 @@ func ExecuteTuning() {
 
 	reelStrips, stats := executeTuning(&tuningCfg, pgGenerator, 100000)
 	if tuningCfg.SaveToDB {
-		fmt.Println("✓ Saved to database")
-		// Load configuration
-		cfg, err := config.Load()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
-			os.Exit(1)
-		}
-		// Initialize logger
-		log := logger.ProvideLogger(cfg)
-		// Initialize database
-		database, err := db.ProvideDatabase(cfg, log)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Failed to connect to database: %v\n", err)
+		if _, err := tuning.SaveToDB(context.Background(), tuningCfg.GameMode, "", reelStrips, stats, nil); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to save to database: %v\n", err)
 			os.Exit(1)
 		}
-
-		cacheClient := cache.ProvideCache(cfg, log)
-
-		// Initialize repositories and services
-		reelStripRepo := repository.NewReelStripGormRepository(database, cacheClient)
-		reelStripService := service.NewReelStripService(reelStripRepo, log)
-
-		var stripIDs [5]uuid.UUID
-		var allStrips []*reelstrip.ReelStrip
-		for reelNum := 0; reelNum < 5; reelNum++ {
-			stripData := reelStrips[reelNum]
-
-			// Convert reels.ReelStrip ([]string) to regular []string
-			stripSlice := []string(stripData)
-
-			// Calculate checksum
-			checksum := tuning.CalculateChecksum(stripSlice)
-
-			strip := &reelstrip.ReelStrip{
-				ID:          uuid.New(),
-				GameMode:    tuningCfg.GameMode,
-				ReelNumber:  reelNum,
-				StripData:   stripSlice,
-				Checksum:    checksum,
-				StripLength: len(stripSlice),
-				IsActive:    true,
-			}
-
-			allStrips = append(allStrips, strip)
-			stripIDs[reelNum] = strip.ID
-		}
-
-		// Save all strips in batch
-		if err := reelStripRepo.CreateBatch(context.Background(), allStrips); err != nil {
-			fmt.Printf("failed to save reel strips: %s", err.Error())
-		}
-
-		timestamp := time.Now().Format("20060102-150405")
-		configName := fmt.Sprintf("%s-%0.2f-%s", tuningCfg.GameMode, stats.RTP, timestamp)
-		extraInfo := map[string]any{
-			"stats":    stats,
-			"paytable": symbols.Paytable,
-		}
-
-		extraInfoJSON, _ := json.Marshal(extraInfo)
-		reelStripService.CreateConfig(
-			context.Background(),
-			configName,
-			tuningCfg.GameMode,
-			fmt.Sprintf("GameMode: %s\nTuning with %d spins\nRTP = %0.3f%%\nFreeSpinTriggerRate = %.3f%%\n", tuningCfg.GameMode, stats.TotalSpins, stats.RTP, stats.FreeSpinsTriggeredRate),
-			stripIDs,
-			stats.RTP,
-			extraInfoJSON,
-		)
+		fmt.Println("✓ Saved to database")
 	}
 	tuning.PrintResults(stats, tuningCfg.BetAmount, tuningCfg.TargetRTP)
 }
 
 func executeTuning(tuningCfg *tuning.TuningConfig, pgGenerator *tuning.PGReelGenerator, progressInterval int) ([]reels.ReelStrip, tuning.SimulationStats) {
 	var reelStrips []reels.ReelStrip
-	weightsSet := tuning.ConvertToReelWeightsSet(symbols.BaseGameWeights)
+	weightsSet := Weights()
 	for iter := 1; iter <= tuningCfg.MaxIter; iter++ {
-		weightsSet = tuning.ConvertToReelWeightsSet(symbols.BaseGameWeights)
+		weightsSet = Weights()
 
 		// Generate reel strips using PG generator
 		pgStrips, genErr := pgGenerator.GenerateAllReelStrips(weightsSet)
@@ -204,43 +190,8 @@ func executeTuning(tuningCfg *tuning.TuningConfig, pgGenerator *tuning.PGReelGen
 
 		// Convert [][]string to []reels.ReelStrip
 		reelStrips = tuning.ConvertToReelStrips(pgStrips)
-		stats := runParallelSpinSimulation(
-			reelStrips,
-			tuningCfg,
-			tuningCfg.ParallelCfg,
-			progressInterval,
-		)
-
-		if stats.TotalWagered > 0 {
-			stats.RTP = (stats.TotalWon / stats.TotalWagered) * 100
-		}
-
-		if stats.TotalCascades > 0 {
-			stats.AvgCascadesPerWin = float64(stats.TotalCascades) / float64(stats.TotalSpins)
-		}
-
-		if stats.FreeSpinsTriggered > 0 {
-			stats.AvgFreeSpinsAwarded /= float64(stats.FreeSpinsTriggered)
-			stats.FreeSpinsTriggeredRate = float64(stats.FreeSpinsTriggered) / float64(stats.TotalSpins) * 100
-		}
-
-		var highSymbolWinsRate, lowSymbolWinsRate float64
-		if stats.HighSymbolWins+stats.LowSymbolWins > 0 {
-			highSymbolWinsRate = float64(stats.HighSymbolWins) / float64(stats.HighSymbolWins+stats.LowSymbolWins) * 100
-			lowSymbolWinsRate = float64(stats.LowSymbolWins) / float64(stats.HighSymbolWins+stats.LowSymbolWins) * 100
-		}
-
-		if stats.TotalWon > 0 {
-			// Low symbols contribution
-			lowWin := stats.LiangtongWinAmount + stats.LiangsuoWinAmount + stats.WusuoWinAmount + stats.WutongWinAmount
-			// Mid symbols contribution
-			midWin := stats.BawanWinAmount + stats.BaiWinAmount
-			// High symbols contribution
-			highWin := stats.ZhongWinAmount + stats.FaWinAmount
-			stats.LowSymbolRTPPct = (lowWin / stats.TotalWon) * 100
-			stats.MidSymbolRTPPct = (midWin / stats.TotalWon) * 100
-			stats.HighSymbolRTPPct = (highWin / stats.TotalWon) * 100
-		}
+		stats := Simulate(reelStrips, tuningCfg, progressInterval)
+		highSymbolWinsRate, lowSymbolWinsRate := stats.SymbolWinRates()
 		for _, s := range pgGenerator.GetAllReelDensities() {
 			fmt.Printf("      R%d %-9s: Low=%.2f Mid=%.2f High=%.2f\n",
 				s.ReelIndex, tuning.RoleName(s.Role), s.LowAvg, s.MidAvg, s.HighAvg)
@@ -499,7 +450,7 @@ func runParallelSpinSimulation(
 			batchSize := 1000
 
 			for i := 0; i < spins; i++ {
-				executeBaseSpin(&stats, reelStips, workerRNG, tuningCfg.BetAmount)
+				executeBaseSpin(&stats, reelStips, workerRNG, tuningCfg.BetAmount, tuningCfg.Ladder)
 				stats.TotalSpins++
 				stats.TotalWagered += tuningCfg.BetAmount
 
@@ -528,7 +479,8 @@ func runParallelSpinSimulation(
 	return merged
 }
 
-func executeBaseSpin(stats *tuning.SimulationStats, reelStrips []reels.ReelStrip, rngInstance rng.RNG, betAmount float64) {
+// executeBaseSpin plays one base spin into stats and returns its free spins trigger
+func executeBaseSpin(stats *tuning.SimulationStats, reelStrips []reels.ReelStrip, rngInstance rng.RNG, betAmount float64, ladder multiplier.Ladder) freespins.TriggerResult {
 	isFreeSpin := false
 
 	// Generate initial grid
@@ -539,17 +491,18 @@ func executeBaseSpin(stats *tuning.SimulationStats, reelStrips []reels.ReelStrip
 	}
 
 	// Execute cascades
-	cascadeResults, finalGrid, err := cascade.ExecuteCascades(
+	cascadeResults, finalGrid, err := cascade.ExecuteCascadesWithLadder(
 		initialGrid,
 		reelStrips,
 		reelPositions,
 		betAmount,
 		isFreeSpin,
 		rngInstance,
+		ladder,
 	)
 	if err != nil {
 		fmt.Printf("failed to execute cascades: %s", err.Error())
-		return
+		return freespins.TriggerResult{}
 	}
 
 	// Calculate total win
@@ -679,4 +632,6 @@ func executeBaseSpin(stats *tuning.SimulationStats, reelStrips []reels.ReelStrip
 		stats.FreeSpinsTriggered++
 		stats.AvgFreeSpinsAwarded += float64(triggerResult.SpinsAwarded)
 	}
+
+	return triggerResult
 }
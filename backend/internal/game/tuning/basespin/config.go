@@ -1,6 +1,6 @@
 package tuningbasespin
 
-import "github.com/slotmachine/backend/cmd/rtp-tuning/tuning"
+import "github.com/slotmachine/backend/internal/game/tuning"
 
 func NewConfig() tuning.TuningConfig {
 	return tuning.TuningConfig{
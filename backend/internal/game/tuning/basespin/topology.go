@@ -1,6 +1,6 @@
 package tuningbasespin
 
-import "github.com/slotmachine/backend/cmd/rtp-tuning/tuning"
+import "github.com/slotmachine/backend/internal/game/tuning"
 
 func DefaultTopologies() [5]tuning.ReelTopology {
 	return [5]tuning.ReelTopology{
@@ -1,6 +1,10 @@
 package tuning
 
-import "runtime"
+import (
+	"runtime"
+
+	"github.com/slotmachine/backend/internal/game/multiplier"
+)
 
 type SimulationStats struct {
 	TotalSpins             int     `json:"total_spins"`
@@ -91,6 +95,10 @@ type TuningConfig struct {
 	TopologyLearningRate float64
 	SaveToDB             bool
 	GameMode             string
+
+	// Ladder is the cascade multiplier progression the simulation assumes;
+	// empty steps fall back to multiplier.DefaultLadder
+	Ladder multiplier.Ladder
 }
 
 // ParallelConfig holds configuration for parallel simulation
@@ -194,3 +202,41 @@ func MergeStats(results []WorkerResult) SimulationStats {
 
 	return merged
 }
+
+// ComputeRates derives RTP, trigger rate and the symbol RTP contribution from the raw counters
+// Call it once, after merging worker results.
+func (s *SimulationStats) ComputeRates() {
+	if s.TotalWagered > 0 {
+		s.RTP = (s.TotalWon / s.TotalWagered) * 100
+	}
+
+	if s.TotalCascades > 0 {
+		s.AvgCascadesPerWin = float64(s.TotalCascades) / float64(s.TotalSpins)
+	}
+
+	if s.FreeSpinsTriggered > 0 {
+		s.AvgFreeSpinsAwarded /= float64(s.FreeSpinsTriggered)
+		s.FreeSpinsTriggeredRate = float64(s.FreeSpinsTriggered) / float64(s.TotalSpins) * 100
+	}
+
+	if s.TotalWon > 0 {
+		// Low symbols contribution
+		lowWin := s.LiangtongWinAmount + s.LiangsuoWinAmount + s.WusuoWinAmount + s.WutongWinAmount
+		// Mid symbols contribution
+		midWin := s.BawanWinAmount + s.BaiWinAmount
+		// High symbols contribution
+		highWin := s.ZhongWinAmount + s.FaWinAmount
+		s.LowSymbolRTPPct = (lowWin / s.TotalWon) * 100
+		s.MidSymbolRTPPct = (midWin / s.TotalWon) * 100
+		s.HighSymbolRTPPct = (highWin / s.TotalWon) * 100
+	}
+}
+
+// SymbolWinRates returns the share of winning spins paid by high and by low symbols
+func (s *SimulationStats) SymbolWinRates() (high, low float64) {
+	if s.HighSymbolWins+s.LowSymbolWins > 0 {
+		high = float64(s.HighSymbolWins) / float64(s.HighSymbolWins+s.LowSymbolWins) * 100
+		low = float64(s.LowSymbolWins) / float64(s.HighSymbolWins+s.LowSymbolWins) * 100
+	}
+	return high, low
+}
@@ -0,0 +1,171 @@
+package tuning
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/slotmachine/backend/internal/game/freespins"
+)
+
+// FeatureSampler plays the two halves of the game the free spins feature value is built from
+type FeatureSampler struct {
+	// CountTriggers plays base spins and returns the number of triggers by scatter count
+	CountTriggers func(spins int) map[int]int
+	// PlaySessions plays sessions entered with scatterCount scatters and returns each session's win in bets
+	PlaySessions func(scatterCount, sessions int) []float64
+}
+
+// FeatureStratum is the feature entered with one scatter count
+type FeatureStratum struct {
+	ScatterCount int     `json:"scatter_count"`
+	SpinsAwarded int     `json:"spins_awarded"`
+	Triggers     int     `json:"triggers"`
+	TriggerRate  float64 `json:"trigger_rate"` // Triggers per base spin
+	Sessions     int     `json:"sessions"`
+	MeanWin      float64 `json:"mean_win"` // Average session win in bets
+	StdDev       float64 `json:"std_dev"`  // Session win standard deviation in bets
+	RTP          float64 `json:"rtp"`      // Contribution to the feature RTP (%)
+}
+
+// FeatureEstimate is the free spins feature's contribution to the base game RTP
+// RTP = Σ TriggerRate × MeanWin over the strata, with a normal confidence interval that
+// accounts for the uncertainty of both the trigger rates and the session means.
+type FeatureEstimate struct {
+	BaseSpins     int              `json:"base_spins"`
+	Triggers      int              `json:"triggers"`
+	TriggerRate   float64          `json:"trigger_rate"`    // Triggers per base spin
+	AvgFeatureWin float64          `json:"avg_feature_win"` // Average feature win in bets
+	RTP           float64          `json:"rtp"`             // Feature RTP (%)
+	StdErr        float64          `json:"std_err"`         // Standard error of RTP (%)
+	TriggerStdErr float64          `json:"trigger_std_err"` // Part of StdErr from the trigger rates
+	PayoutStdErr  float64          `json:"payout_std_err"`  // Part of StdErr from the session means
+	Confidence    float64          `json:"confidence"`
+	CILow         float64          `json:"ci_low"`
+	CIHigh        float64          `json:"ci_high"`
+	Strata        []FeatureStratum `json:"strata"`
+}
+
+// EstimateFeatureValue estimates the feature RTP from baseSpins base spins and sessions feature sessions
+// Sessions are stratified by scatter count: a pilot run per stratum, then the rest by Neyman
+// allocation (proportional to trigger rate × session standard deviation), so the rare high
+// scatter strata are sampled as much as they matter rather than as often as they occur.
+func EstimateFeatureValue(sampler FeatureSampler, baseSpins, sessions int, confidence float64) (FeatureEstimate, error) {
+	estimate := FeatureEstimate{BaseSpins: baseSpins, Confidence: confidence}
+	if baseSpins <= 0 || sessions <= 0 {
+		return estimate, fmt.Errorf("base spins and sessions must be positive")
+	}
+	if confidence <= 0 || confidence >= 1 {
+		return estimate, fmt.Errorf("confidence must be between 0 and 1")
+	}
+
+	triggers := sampler.CountTriggers(baseSpins)
+	scatterCounts := make([]int, 0, len(triggers))
+	for scatterCount, n := range triggers {
+		if n > 0 {
+			scatterCounts = append(scatterCounts, scatterCount)
+		}
+	}
+	if len(scatterCounts) == 0 {
+		return estimate, fmt.Errorf("no free spins triggers in %d base spins", baseSpins)
+	}
+	sort.Ints(scatterCounts)
+
+	wins := make([][]float64, len(scatterCounts))
+	pilot := max(sessions/5/len(scatterCounts), 30)
+	for i, scatterCount := range scatterCounts {
+		wins[i] = sampler.PlaySessions(scatterCount, pilot)
+	}
+
+	// Neyman allocation of the remaining sessions from the pilot estimates
+	if remaining := sessions - pilot*len(scatterCounts); remaining > 0 {
+		weights := make([]float64, len(scatterCounts))
+		total := 0.0
+		for i, scatterCount := range scatterCounts {
+			_, stdDev := meanStdDev(wins[i])
+			weights[i] = float64(triggers[scatterCount]) * stdDev
+			total += weights[i]
+		}
+		for i, scatterCount := range scatterCounts {
+			share := float64(triggers[scatterCount]) / float64(baseSpins)
+			if total > 0 {
+				share = weights[i] / total
+			}
+			if n := int(float64(remaining) * share); n > 0 {
+				wins[i] = append(wins[i], sampler.PlaySessions(scatterCount, n)...)
+			}
+		}
+	}
+
+	payoutVar, secondMoment := 0.0, 0.0
+	for i, scatterCount := range scatterCounts {
+		mean, stdDev := meanStdDev(wins[i])
+		rate := float64(triggers[scatterCount]) / float64(baseSpins)
+		estimate.Strata = append(estimate.Strata, FeatureStratum{
+			ScatterCount: scatterCount,
+			SpinsAwarded: freespins.CalculateFreeSpinsAward(scatterCount),
+			Triggers:     triggers[scatterCount],
+			TriggerRate:  rate,
+			Sessions:     len(wins[i]),
+			MeanWin:      mean,
+			StdDev:       stdDev,
+			RTP:          rate * mean * 100,
+		})
+		estimate.Triggers += triggers[scatterCount]
+		estimate.RTP += rate * mean * 100
+		payoutVar += rate * rate * stdDev * stdDev / float64(len(wins[i]))
+		secondMoment += rate * mean * mean
+	}
+
+	// The trigger counts are one multinomial sample of the base spins
+	value := estimate.RTP / 100
+	triggerVar := math.Max(secondMoment-value*value, 0) / float64(baseSpins)
+
+	estimate.TriggerRate = float64(estimate.Triggers) / float64(baseSpins)
+	estimate.AvgFeatureWin = value / estimate.TriggerRate
+	estimate.TriggerStdErr = math.Sqrt(triggerVar) * 100
+	estimate.PayoutStdErr = math.Sqrt(payoutVar) * 100
+	estimate.StdErr = math.Sqrt(triggerVar+payoutVar) * 100
+	z := math.Sqrt2 * math.Erfinv(confidence)
+	estimate.CILow = estimate.RTP - z*estimate.StdErr
+	estimate.CIHigh = estimate.RTP + z*estimate.StdErr
+	return estimate, nil
+}
+
+// meanStdDev returns the mean and sample standard deviation of xs
+func meanStdDev(xs []float64) (mean, stdDev float64) {
+	if len(xs) == 0 {
+		return 0, 0
+	}
+	for _, x := range xs {
+		mean += x
+	}
+	mean /= float64(len(xs))
+	if len(xs) < 2 {
+		return mean, 0
+	}
+	sumSq := 0.0
+	for _, x := range xs {
+		sumSq += (x - mean) * (x - mean)
+	}
+	return mean, math.Sqrt(sumSq / float64(len(xs)-1))
+}
+
+// PrintFeatureEstimate writes the feature value estimate to stdout
+func PrintFeatureEstimate(e FeatureEstimate) {
+	fmt.Println()
+	fmt.Println("═══ FREE SPINS FEATURE VALUE ═══")
+	fmt.Printf("Base Spins:            %d\n", e.BaseSpins)
+	fmt.Printf("Triggers:              %d (1 in %.0f)\n", e.Triggers, 1/e.TriggerRate)
+	fmt.Printf("Avg Feature Win:       %.2fx bet\n", e.AvgFeatureWin)
+	fmt.Printf("Feature RTP:           %.4f%% ± %.4f%% (%.0f%% CI %.4f%% – %.4f%%)\n",
+		e.RTP, e.CIHigh-e.RTP, e.Confidence*100, e.CILow, e.CIHigh)
+	fmt.Printf("Std Error:             %.4f%% (trigger rate %.4f%%, session payout %.4f%%)\n",
+		e.StdErr, e.TriggerStdErr, e.PayoutStdErr)
+	fmt.Println()
+	fmt.Println("Scatters  Spins  Trigger 1 in   Sessions  Mean Win  Std Dev    RTP")
+	for _, s := range e.Strata {
+		fmt.Printf("%8d  %5d  %12.0f  %8d  %7.2fx  %6.2fx  %6.3f%%\n",
+			s.ScatterCount, s.SpinsAwarded, 1/s.TriggerRate, s.Sessions, s.MeanWin, s.StdDev, s.RTP)
+	}
+}
@@ -2,7 +2,6 @@ package tuningfreespin
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"math"
 	"os"
@@ -11,20 +10,14 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/slotmachine/backend/cmd/rtp-tuning/tuning"
-	"github.com/slotmachine/backend/domain/reelstrip"
-	"github.com/slotmachine/backend/internal/config"
-	"github.com/slotmachine/backend/internal/db"
 	"github.com/slotmachine/backend/internal/game/cascade"
 	"github.com/slotmachine/backend/internal/game/freespins"
 	freespinsEngine "github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/multiplier"
 	"github.com/slotmachine/backend/internal/game/reels"
 	"github.com/slotmachine/backend/internal/game/rng"
 	"github.com/slotmachine/backend/internal/game/symbols"
-	"github.com/slotmachine/backend/internal/infra/repository"
-	"github.com/slotmachine/backend/internal/pkg/cache"
-	"github.com/slotmachine/backend/internal/pkg/logger"
-	"github.com/slotmachine/backend/internal/service"
+	"github.com/slotmachine/backend/internal/game/tuning"
 )
 
 // ProgressTracker tracks progress across all workers using atomic operations
@@ -74,9 +67,62 @@ func (p *ProgressTracker) Increment(count int, prefix string) {
 	}
 }
 
+// GeneratorSeed seeds the reel generator so tuning runs are reproducible
+const GeneratorSeed = 60
+
+// Weights returns the symbol weights the reel strips are generated from
+func Weights() *tuning.ReelWeightsSet {
+	return tuning.ConvertToReelWeightsSet(symbols.FreeSpinsWeights)
+}
+
+// Simulate plays cfg.TotalSpin bought free spins sessions on strips and returns the statistics with derived rates
+func Simulate(strips []reels.ReelStrip, cfg *tuning.TuningConfig, progressInterval int) tuning.SimulationStats {
+	stats := runParallelSpinSimulation(strips, cfg, cfg.ParallelCfg, progressInterval)
+	stats.ComputeRates()
+	return stats
+}
+
+// PlaySessions plays sessions free spins sessions entered with scatterCount scatters on strips
+// and returns each session's win in bets
+func PlaySessions(strips []reels.ReelStrip, cfg *tuning.TuningConfig, scatterCount, sessions int) []float64 {
+	numWorkers := cfg.ParallelCfg.NumWorkers
+	wins := make([]float64, sessions)
+	var wg sync.WaitGroup
+
+	for w := 0; w < numWorkers; w++ {
+		wg.Add(1)
+
+		go func(workerID int) {
+			defer wg.Done()
+
+			workerRNG := rng.NewFastRNG()
+			for i := 0; i < workerID*1000; i++ {
+				workerRNG.Intn(100)
+			}
+
+			stats := tuning.SimulationStats{}
+			for i := workerID; i < sessions; i += numWorkers {
+				wins[i] = executeFreeSpins(&stats, strips, workerRNG, scatterCount, cfg.BetAmount, cfg.Ladder) / cfg.BetAmount
+			}
+		}(w)
+	}
+
+	wg.Wait()
+	return wins
+}
+
+// ExecuteTuning tunes the bonus spin trigger strips and saves them
 func ExecuteTuning() {
-	tuningCfg := NewConfig()
-	pgGenerator := tuning.NewPGReelGenerator(60, DefaultTopologies())
+	executeModeTuning(NewConfig(), DefaultTopologies())
+}
+
+// ExecuteFreeSpinsTuning tunes the strips played inside free spins sessions and saves them
+func ExecuteFreeSpinsTuning() {
+	executeModeTuning(NewFreeSpinsConfig(), FreeSpinsTopologies())
+}
+
+func executeModeTuning(tuningCfg tuning.TuningConfig, topologies [5]tuning.ReelTopology) {
+	pgGenerator := tuning.NewPGReelGenerator(GeneratorSeed, topologies)
 	symbolTargets := tuning.DefaultSymbolContributionTargets()
 
 	fmt.Println("Using PG-style reel generator with:")
@@ -117,89 +163,20 @@ func ExecuteTuning() {
 
 	reelStrips, stats := executeTuning(&tuningCfg, pgGenerator, 100000)
 	if tuningCfg.SaveToDB {
-		fmt.Println("✓ Saved to database")
-		// Load configuration
-		cfg, err := config.Load()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
-			os.Exit(1)
-		}
-		// Initialize logger
-		log := logger.ProvideLogger(cfg)
-		// Initialize database
-		database, err := db.ProvideDatabase(cfg, log)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Failed to connect to database: %v\n", err)
+		if _, err := tuning.SaveToDB(context.Background(), tuningCfg.GameMode, "", reelStrips, stats, nil); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to save to database: %v\n", err)
 			os.Exit(1)
 		}
-
-		cacheClient := cache.ProvideCache(cfg, log)
-
-		// Initialize repositories and services
-		reelStripRepo := repository.NewReelStripGormRepository(database, cacheClient)
-		reelStripService := service.NewReelStripService(reelStripRepo, log)
-
-		var stripIDs [5]uuid.UUID
-		var allStrips []*reelstrip.ReelStrip
-		for reelNum := 0; reelNum < 5; reelNum++ {
-			stripData := reelStrips[reelNum]
-
-			// Convert reels.ReelStrip ([]string) to regular []string
-			stripSlice := []string(stripData)
-
-			// Calculate checksum
-			checksum := tuning.CalculateChecksum(stripSlice)
-
-			strip := &reelstrip.ReelStrip{
-				ID:          uuid.New(),
-				GameMode:    tuningCfg.GameMode,
-				ReelNumber:  reelNum,
-				StripData:   stripSlice,
-				Checksum:    checksum,
-				StripLength: len(stripSlice),
-				IsActive:    true,
-			}
-
-			allStrips = append(allStrips, strip)
-			stripIDs[reelNum] = strip.ID
-		}
-
-		// Save all strips in batch
-		if err := reelStripRepo.CreateBatch(context.Background(), allStrips); err != nil {
-			fmt.Printf("failed to save reel strips: %s", err.Error())
-		}
-
-		timestamp := time.Now().Format("20060102-150405")
-		configName := fmt.Sprintf("%s-%0.2f-%s", tuningCfg.GameMode, stats.RTP, timestamp)
-		extraInfo := map[string]any{
-			"stats":    stats,
-			"paytable": symbols.Paytable,
-		}
-
-		extraInfoJSON, _ := json.Marshal(extraInfo)
-		_, err = reelStripService.CreateConfig(
-			context.Background(),
-			configName,
-			tuningCfg.GameMode,
-			fmt.Sprintf("GameMode: %s\nTuning with %d spins\nRTP = %0.3f%%\nFreeSpinTriggerRate = %.3f%%\n", tuningCfg.GameMode, stats.TotalSpins, stats.RTP, stats.FreeSpinsTriggeredRate),
-			stripIDs,
-			stats.RTP,
-			extraInfoJSON,
-		)
-
-		if err != nil {
-			fmt.Printf("failed to create config: %s", err.Error())
-		}
+		fmt.Println("✓ Saved to database")
 	}
-
 	tuning.PrintResults(stats, tuningCfg.BetAmount, tuningCfg.TargetRTP)
 }
 
 func executeTuning(tuningCfg *tuning.TuningConfig, pgGenerator *tuning.PGReelGenerator, progressInterval int) ([]reels.ReelStrip, tuning.SimulationStats) {
 	var reelStrips []reels.ReelStrip
-	weightsSet := tuning.ConvertToReelWeightsSet(symbols.FreeSpinsWeights)
+	weightsSet := Weights()
 	for iter := 1; iter <= tuningCfg.MaxIter; iter++ {
-		weightsSet = tuning.ConvertToReelWeightsSet(symbols.FreeSpinsWeights)
+		weightsSet = Weights()
 
 		// Generate reel strips using PG generator
 		pgStrips, genErr := pgGenerator.GenerateAllReelStrips(weightsSet)
@@ -210,43 +187,8 @@ func executeTuning(tuningCfg *tuning.TuningConfig, pgGenerator *tuning.PGReelGen
 
 		// Convert [][]string to []reels.ReelStrip
 		reelStrips = tuning.ConvertToReelStrips(pgStrips)
-		stats := runParallelSpinSimulation(
-			reelStrips,
-			tuningCfg,
-			tuningCfg.ParallelCfg,
-			progressInterval,
-		)
-
-		if stats.TotalWagered > 0 {
-			stats.RTP = (stats.TotalWon / stats.TotalWagered) * 100
-		}
-
-		if stats.TotalCascades > 0 {
-			stats.AvgCascadesPerWin = float64(stats.TotalCascades) / float64(stats.TotalSpins)
-		}
-
-		if stats.FreeSpinsTriggered > 0 {
-			stats.AvgFreeSpinsAwarded /= float64(stats.FreeSpinsTriggered)
-			stats.FreeSpinsTriggeredRate = float64(stats.FreeSpinsTriggered) / float64(stats.TotalSpins) * 100
-		}
-
-		var highSymbolWinsRate, lowSymbolWinsRate float64
-		if stats.HighSymbolWins+stats.LowSymbolWins > 0 {
-			highSymbolWinsRate = float64(stats.HighSymbolWins) / float64(stats.HighSymbolWins+stats.LowSymbolWins) * 100
-			lowSymbolWinsRate = float64(stats.LowSymbolWins) / float64(stats.HighSymbolWins+stats.LowSymbolWins) * 100
-		}
-
-		if stats.TotalWon > 0 {
-			// Low symbols contribution
-			lowWin := stats.LiangtongWinAmount + stats.LiangsuoWinAmount + stats.WusuoWinAmount + stats.WutongWinAmount
-			// Mid symbols contribution
-			midWin := stats.BawanWinAmount + stats.BaiWinAmount
-			// High symbols contribution
-			highWin := stats.ZhongWinAmount + stats.FaWinAmount
-			stats.LowSymbolRTPPct = (lowWin / stats.TotalWon) * 100
-			stats.MidSymbolRTPPct = (midWin / stats.TotalWon) * 100
-			stats.HighSymbolRTPPct = (highWin / stats.TotalWon) * 100
-		}
+		stats := Simulate(reelStrips, tuningCfg, progressInterval)
+		highSymbolWinsRate, lowSymbolWinsRate := stats.SymbolWinRates()
 		for _, s := range pgGenerator.GetAllReelDensities() {
 			fmt.Printf("      R%d %-9s: Low=%.2f Mid=%.2f High=%.2f\n",
 				s.ReelIndex, tuning.RoleName(s.Role), s.LowAvg, s.MidAvg, s.HighAvg)
@@ -514,7 +456,7 @@ func runParallelSpinSimulation(
 					scatterCount = 4
 				}
 
-				executeFreeSpins(&stats, reelStips, workerRNG, scatterCount, tuningCfg.BetAmount)
+				executeFreeSpins(&stats, reelStips, workerRNG, scatterCount, tuningCfg.BetAmount, tuningCfg.Ladder)
 				stats.TotalWagered += tuningCfg.BuyCost
 
 				if (i+1)%batchSize == 0 {
@@ -543,7 +485,7 @@ func runParallelSpinSimulation(
 }
 
 // executeFreeSpins executes all free spins in a session and returns total win
-func executeFreeSpins(stats *tuning.SimulationStats, reelStrips []reels.ReelStrip, rngInstance rng.RNG, scatterCount int, betAmount float64) {
+func executeFreeSpins(stats *tuning.SimulationStats, reelStrips []reels.ReelStrip, rngInstance rng.RNG, scatterCount int, betAmount float64, ladder multiplier.Ladder) float64 {
 	isFreeSpin := true
 	// Create a free spins session
 	session := freespinsEngine.NewSession(uuid.Nil, scatterCount, betAmount, nil)
@@ -560,13 +502,14 @@ func executeFreeSpins(stats *tuning.SimulationStats, reelStrips []reels.ReelStri
 		}
 
 		// Execute cascades with free spin multipliers
-		cascadeResults, finalGrid, err := cascade.ExecuteCascades(
+		cascadeResults, finalGrid, err := cascade.ExecuteCascadesWithLadder(
 			initialGrid,
 			reelStrips,
 			reelPositions,
 			betAmount,
 			isFreeSpin,
 			rngInstance,
+			ladder,
 		)
 		if err != nil {
 			fmt.Printf("failed to execute cascades: %s", err.Error())
@@ -706,4 +649,6 @@ func executeFreeSpins(stats *tuning.SimulationStats, reelStrips []reels.ReelStri
 
 		spinNumber++
 	}
+
+	return totalWin
 }
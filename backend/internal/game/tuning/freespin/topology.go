@@ -1,6 +1,6 @@
 package tuningfreespin
 
-import "github.com/slotmachine/backend/cmd/rtp-tuning/tuning"
+import "github.com/slotmachine/backend/internal/game/tuning"
 
 func DefaultTopologies() [5]tuning.ReelTopology {
 	return [5]tuning.ReelTopology{
@@ -189,3 +189,25 @@ func DefaultTopologies() [5]tuning.ReelTopology {
 		},
 	}
 }
+
+const (
+	// freeSpinsScatterScale thins out scatters: inside a session they only retrigger
+	freeSpinsScatterScale = 0.5
+	// freeSpinsGoldScale raises the gold ratio of every reel that has gold symbols
+	freeSpinsGoldScale = 1.5
+)
+
+// FreeSpinsTopologies returns the topologies of the strips played inside free spins sessions
+// They are the bonus trigger topologies with fewer scatters and more gold symbols.
+func FreeSpinsTopologies() [5]tuning.ReelTopology {
+	topologies := DefaultTopologies()
+	for i := range topologies {
+		if d, ok := topologies[i].SymbolDensity["bonus"]; ok {
+			topologies[i].SymbolDensity["bonus"] = d * freeSpinsScatterScale
+		}
+		if gold := topologies[i].GoldConfig; gold != nil {
+			gold.GoldRatio *= freeSpinsGoldScale
+		}
+	}
+	return topologies
+}
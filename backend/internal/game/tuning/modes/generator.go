@@ -0,0 +1,87 @@
+package tuningmodes
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/game/tuning"
+)
+
+// Generator builds strips from symbol weights with the tuning reel generator
+// Strips generated from an admin's weights follow the same topologies as tuned ones.
+type Generator struct{}
+
+// NewGenerator creates a reel strip generator backed by the tuning reel generator
+func NewGenerator() reelstrip.Generator {
+	return Generator{}
+}
+
+// DefaultInput returns the mode's tuned weights and generator seed
+func (Generator) DefaultInput(gameMode string) (reelstrip.GeneratorInput, error) {
+	m, ok := ForGameMode(gameMode)
+	if !ok {
+		return reelstrip.GeneratorInput{}, reelstrip.ErrInvalidGameMode
+	}
+
+	input := reelstrip.GeneratorInput{Seed: m.Seed}
+	weights := m.Weights()
+	for reel := range input.Weights {
+		input.Weights[reel] = reelstrip.SymbolWeights(maps.Clone(weights.GetReel(reel)))
+	}
+	return input, nil
+}
+
+// Generate builds one strip per reel from input with the mode's topologies
+func (Generator) Generate(gameMode string, input reelstrip.GeneratorInput) ([][]string, error) {
+	m, ok := ForGameMode(gameMode)
+	if !ok {
+		return nil, reelstrip.ErrInvalidGameMode
+	}
+	if err := input.Weights.Validate(); err != nil {
+		return nil, err
+	}
+
+	weights := &tuning.ReelWeightsSet{}
+	for reel, reelWeights := range input.Weights {
+		for sym := range reelWeights {
+			if !isKnownSymbol(sym) {
+				return nil, fmt.Errorf("%w: reel %d: unknown symbol %q", reelstrip.ErrInvalidWeights, reel, sym)
+			}
+		}
+		setReel(weights, reel, tuning.SymbolWeights(reelWeights))
+	}
+
+	return tuning.NewPGReelGenerator(input.Seed, m.Topologies()).GenerateAllReelStrips(weights)
+}
+
+// isKnownSymbol reports whether sym is a symbol of the game or a gold variant of one that has them
+func isKnownSymbol(sym string) bool {
+	base := symbols.GetBaseSymbol(sym)
+	if symbols.IsGoldVariant(sym) && !symbols.HasGoldVariant(base) {
+		return false
+	}
+	for _, known := range symbols.AllSymbols() {
+		if base == known {
+			return true
+		}
+	}
+	return false
+}
+
+// setReel sets the weights of reel (0-indexed), the counterpart of ReelWeightsSet.GetReel
+func setReel(set *tuning.ReelWeightsSet, reel int, weights tuning.SymbolWeights) {
+	switch reel {
+	case 0:
+		set.Reel1 = weights
+	case 1:
+		set.Reel2 = weights
+	case 2:
+		set.Reel3 = weights
+	case 3:
+		set.Reel4 = weights
+	case 4:
+		set.Reel5 = weights
+	}
+}
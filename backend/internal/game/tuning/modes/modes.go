@@ -0,0 +1,84 @@
+package tuningmodes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/slotmachine/backend/internal/game/tuning"
+	tuningbasespin "github.com/slotmachine/backend/internal/game/tuning/basespin"
+	tuningfreespin "github.com/slotmachine/backend/internal/game/tuning/freespin"
+)
+
+// Mode is a tunable game mode: its defaults, strip generator inputs and simulator
+type Mode struct {
+	NewConfig  func() tuning.TuningConfig
+	Topologies func() [5]tuning.ReelTopology
+	Seed       int64
+	Weights    func() *tuning.ReelWeightsSet
+	Simulate   func([]reels.ReelStrip, *tuning.TuningConfig, int) tuning.SimulationStats
+	Tune       func()
+}
+
+// GameMode returns the reel strip game mode the mode's strips are stored under
+func (m Mode) GameMode() string {
+	return m.NewConfig().GameMode
+}
+
+// modes maps the CLI name of each mode to it
+var modes = map[string]Mode{
+	"base": {
+		NewConfig:  tuningbasespin.NewConfig,
+		Topologies: tuningbasespin.DefaultTopologies,
+		Seed:       tuningbasespin.GeneratorSeed,
+		Weights:    tuningbasespin.Weights,
+		Simulate:   tuningbasespin.Simulate,
+		Tune:       tuningbasespin.ExecuteTuning,
+	},
+	"free": {
+		NewConfig:  tuningfreespin.NewFreeSpinsConfig,
+		Topologies: tuningfreespin.FreeSpinsTopologies,
+		Seed:       tuningfreespin.GeneratorSeed,
+		Weights:    tuningfreespin.Weights,
+		Simulate:   tuningfreespin.Simulate,
+		Tune:       tuningfreespin.ExecuteFreeSpinsTuning,
+	},
+	"bonus": {
+		NewConfig:  tuningfreespin.NewConfig,
+		Topologies: tuningfreespin.DefaultTopologies,
+		Seed:       tuningfreespin.GeneratorSeed,
+		Weights:    tuningfreespin.Weights,
+		Simulate:   tuningfreespin.Simulate,
+		Tune:       tuningfreespin.ExecuteTuning,
+	},
+}
+
+// Lookup returns the mode with the given CLI name
+func Lookup(name string) (Mode, error) {
+	m, ok := modes[name]
+	if !ok {
+		return Mode{}, fmt.Errorf("unknown mode %q (want %s)", name, Names())
+	}
+	return m, nil
+}
+
+// ForGameMode returns the mode whose strips are stored under gameMode
+func ForGameMode(gameMode string) (Mode, bool) {
+	for _, m := range modes {
+		if m.GameMode() == gameMode {
+			return m, true
+		}
+	}
+	return Mode{}, false
+}
+
+// Names lists the CLI names of the modes
+func Names() string {
+	names := make([]string, 0, len(modes))
+	for name := range modes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,72 @@
+package tuningmodes
+
+import (
+	"context"
+	"math"
+
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/game/tuning"
+)
+
+// Simulator analyzes strips and estimates their RTP with the tuning simulators
+// Draft strips are measured exactly as the tuning pipeline measures generated ones.
+type Simulator struct{}
+
+// NewSimulator creates a reel strip simulator backed by the tuning simulators
+func NewSimulator() reelstrip.Simulator {
+	return Simulator{}
+}
+
+// AnalyzeStrip returns the symbol counts, spacing and clusters of strip
+func (Simulator) AnalyzeStrip(strip []string) reelstrip.StripAnalysis {
+	analysis := new(tuning.PGReelGenerator).AnalyzeStrip(strip)
+	return reelstrip.StripAnalysis{
+		Length:         analysis.Length,
+		SymbolCounts:   analysis.SymbolCounts,
+		AvgSpacing:     analysis.AvgSpacing,
+		MaxClusterSize: analysis.MaxClusterSize,
+	}
+}
+
+// previewChunkSpins is how many spins are simulated between checks for cancellation
+const previewChunkSpins = 50_000
+
+// EstimateRTP plays spins spins of gameMode on strips with the mode's tuning configuration
+// Spins are played in chunks, so a cancelled preview stops within one chunk.
+func (Simulator) EstimateRTP(ctx context.Context, gameMode string, strips [][]string, spins int) (*reelstrip.RTPEstimate, error) {
+	m, ok := ForGameMode(gameMode)
+	if !ok {
+		return nil, reelstrip.ErrInvalidGameMode
+	}
+	if len(strips) != 5 {
+		return nil, reelstrip.ErrIncompleteSet
+	}
+
+	reelStrips := tuning.ConvertToReelStrips(strips)
+	cfg := m.NewConfig()
+	var chunks []tuning.WorkerResult
+	for played := 0; played < spins; {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		chunk := min(previewChunkSpins, spins-played)
+		cfg.TotalSpin = chunk
+		// Progress is reported every interval spins; a preview is too short to need it
+		chunks = append(chunks, tuning.WorkerResult{Stats: m.Simulate(reelStrips, &cfg, math.MaxInt)})
+		played += chunk
+	}
+	stats := tuning.MergeStats(chunks)
+
+	estimate := &reelstrip.RTPEstimate{
+		Spins:  stats.TotalSpins,
+		MaxWin: stats.MaxWin / cfg.BetAmount,
+	}
+	if stats.TotalWagered > 0 {
+		estimate.RTP = stats.TotalWon / stats.TotalWagered * 100
+	}
+	if stats.TotalSpins > 0 {
+		estimate.HitRate = float64(stats.TotalWinSpins) / float64(stats.TotalSpins) * 100
+		estimate.FreeSpinsTriggerRate = float64(stats.FreeSpinsTriggered) / float64(stats.TotalSpins) * 100
+	}
+	return estimate, nil
+}
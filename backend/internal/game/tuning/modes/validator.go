@@ -0,0 +1,24 @@
+package tuningmodes
+
+import (
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/game/tuning"
+)
+
+// Validator checks strips against the topologies of the mode they are for
+// Hand-edited strips are held to the same spacing, cluster and gold rules as generated ones.
+type Validator struct{}
+
+// NewValidator creates a reel strip validator backed by the tuning topologies
+func NewValidator() reelstrip.Validator {
+	return Validator{}
+}
+
+// Validate returns every rule violation in strips
+func (Validator) Validate(gameMode string, strips [][]string) ([]reelstrip.Violation, error) {
+	m, ok := ForGameMode(gameMode)
+	if !ok {
+		return nil, reelstrip.ErrInvalidGameMode
+	}
+	return tuning.NewPGReelGenerator(m.Seed, m.Topologies()).ValidateStrips(strips)
+}
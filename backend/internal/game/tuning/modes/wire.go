@@ -0,0 +1,10 @@
+package tuningmodes
+
+import "github.com/google/wire"
+
+// ProviderSet is the Wire provider set for the reel strip validator, simulator and generator
+var ProviderSet = wire.NewSet(
+	NewValidator,
+	NewSimulator,
+	NewGenerator,
+)
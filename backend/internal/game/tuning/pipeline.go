@@ -0,0 +1,218 @@
+package tuning
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/db"
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/service"
+)
+
+// StripSet is a generated set of reel strips with the topologies they were generated from
+// It is the file the rtp-tuning subcommands pass to each other.
+type StripSet struct {
+	GameMode    string           `json:"game_mode"`
+	Seed        int64            `json:"seed"`
+	Topologies  [5]ReelTopology  `json:"topologies"`
+	Strips      [][]string       `json:"strips"`
+	Stats       *SimulationStats `json:"stats,omitempty"`  // Set once the strips have been simulated
+	Report      *TuningReport    `json:"report,omitempty"` // Set when the strips came out of AutoTune
+	GeneratedAt time.Time        `json:"generated_at"`
+}
+
+// NewStripSet captures the generator's current topologies with the strips it generated
+func NewStripSet(gameMode string, seed int64, gen *PGReelGenerator, strips [][]string) *StripSet {
+	set := &StripSet{
+		GameMode:    gameMode,
+		Seed:        seed,
+		Strips:      strips,
+		GeneratedAt: time.Now().UTC(),
+	}
+	for i := 0; i < 5; i++ {
+		set.Topologies[i] = gen.GetTopology(i)
+	}
+	return set
+}
+
+// ReadStripSet reads a strip set written by WriteFile
+func ReadStripSet(path string) (*StripSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var set StripSet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse strip set %s: %w", path, err)
+	}
+	if len(set.Strips) != 5 {
+		return nil, fmt.Errorf("strip set %s has %d strips, want 5", path, len(set.Strips))
+	}
+	return &set, nil
+}
+
+// WriteFile writes the strip set as indented JSON
+func (s *StripSet) WriteFile(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// ReelStrips returns the strips in the form the game engine plays them
+func (s *StripSet) ReelStrips() []reels.ReelStrip {
+	return ConvertToReelStrips(s.Strips)
+}
+
+// ReadTopologies reads the five reel topologies from a JSON file
+func ReadTopologies(path string) ([5]ReelTopology, error) {
+	var topologies [5]ReelTopology
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return topologies, err
+	}
+	if err := json.Unmarshal(data, &topologies); err != nil {
+		return topologies, fmt.Errorf("failed to parse topologies %s: %w", path, err)
+	}
+	return topologies, nil
+}
+
+// AutoTuneResult is the outcome of AutoTune
+type AutoTuneResult struct {
+	Strips     [][]string
+	Topologies [5]ReelTopology // The topologies Strips were generated from
+	Stats      SimulationStats
+	Iterations int
+	Converged  bool
+	Report     *TuningReport // GameMode, Seed, SpinsPerIter and the RTP target are left to the caller
+}
+
+// AutoTune generates strips, simulates them and corrects the topology densities with
+// AdjustTopologyDensities until the symbol RTP contribution is within tolerance of
+// targets, or maxIter iterations have run. The result holds the last strips simulated
+// and a report with a snapshot of every iteration.
+func (g *PGReelGenerator) AutoTune(
+	weights *ReelWeightsSet,
+	simulate func([]reels.ReelStrip) SimulationStats,
+	targets SymbolContributionTargets,
+	learningRate float64,
+	maxIter int,
+) (*AutoTuneResult, error) {
+	result := &AutoTuneResult{
+		Report: &TuningReport{
+			Targets:      targets,
+			LearningRate: learningRate,
+			MaxIter:      maxIter,
+			StartedAt:    time.Now().UTC(),
+		},
+	}
+	defer func() { result.Report.FinishedAt = time.Now().UTC() }()
+
+	for iter := 1; iter <= maxIter; iter++ {
+		strips, err := g.GenerateAllReelStrips(weights)
+		if err != nil {
+			return nil, err
+		}
+		stats := simulate(ConvertToReelStrips(strips))
+		result.Strips, result.Stats, result.Iterations = strips, stats, iter
+
+		fmt.Printf("Iter %d: RTP=%.3f%% Low=%.2f%% Mid=%.2f%% High=%.2f%% (target %.0f/%.0f/%.0f)\n",
+			iter, stats.RTP, stats.LowSymbolRTPPct, stats.MidSymbolRTPPct, stats.HighSymbolRTPPct,
+			targets.LowPct, targets.MidPct, targets.HighPct)
+
+		// Snapshot before adjusting: the densities are updated in place
+		report := newIterationReport(iter, g, strips, stats)
+		adjusted := g.AdjustTopologyDensities(stats.LowSymbolRTPPct, stats.MidSymbolRTPPct, stats.HighSymbolRTPPct, targets, learningRate)
+		report.WithinTolerance = !adjusted
+		result.Report.Iterations = append(result.Report.Iterations, report)
+		result.Topologies = report.Topologies
+
+		if !adjusted {
+			result.Converged = true
+			result.Report.Converged = true
+			return result, nil
+		}
+	}
+	return result, nil
+}
+
+// SaveToDB stores strips as new reel strips and a reel strip config of gameMode
+// An empty name is replaced by <game mode>-<RTP>-<timestamp>. The optional tuning
+// report is kept in the config's options next to the statistics.
+func SaveToDB(ctx context.Context, gameMode, name string, strips []reels.ReelStrip, stats SimulationStats, report *TuningReport) (*reelstrip.ReelStripConfig, error) {
+	if len(strips) != 5 {
+		return nil, fmt.Errorf("need 5 reel strips, got %d", len(strips))
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	log := logger.ProvideLogger(cfg)
+	database, err := db.ProvideDatabase(cfg, log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	cacheClient := cache.ProvideCache(cfg, log)
+
+	reelStripRepo := repository.NewReelStripGormRepository(database, cacheClient)
+	reelStripService := service.NewReelStripService(reelStripRepo, log)
+
+	var stripIDs [5]uuid.UUID
+	allStrips := make([]*reelstrip.ReelStrip, 0, len(strips))
+	for reelNum, stripData := range strips {
+		stripSlice := []string(stripData)
+		strip := &reelstrip.ReelStrip{
+			ID:          uuid.New(),
+			GameMode:    gameMode,
+			ReelNumber:  reelNum,
+			StripData:   stripSlice,
+			Checksum:    CalculateChecksum(stripSlice),
+			StripLength: len(stripSlice),
+			IsActive:    true,
+		}
+		allStrips = append(allStrips, strip)
+		stripIDs[reelNum] = strip.ID
+	}
+
+	if err := reelStripRepo.CreateBatch(ctx, allStrips); err != nil {
+		return nil, fmt.Errorf("failed to save reel strips: %w", err)
+	}
+
+	if name == "" {
+		name = fmt.Sprintf("%s-%0.2f-%s", gameMode, stats.RTP, time.Now().Format("20060102-150405"))
+	}
+	extraInfo := map[string]any{
+		"stats":    stats,
+		"paytable": symbols.Paytable,
+	}
+	if report != nil {
+		extraInfo["tuning_report"] = report
+		extraInfo["tuning_summary"] = report.Markdown()
+	}
+	extraInfoJSON, err := json.Marshal(extraInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return reelStripService.CreateConfig(
+		ctx,
+		name,
+		gameMode,
+		fmt.Sprintf("GameMode: %s\nTuning with %d spins\nRTP = %0.3f%%\nFreeSpinTriggerRate = %.3f%%\n", gameMode, stats.TotalSpins, stats.RTP, stats.FreeSpinsTriggeredRate),
+		stripIDs,
+		stats.RTP,
+		extraInfoJSON,
+	)
+}
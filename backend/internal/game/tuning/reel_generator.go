@@ -29,54 +29,54 @@ type ReelTopology struct {
 	// SymbolDensity maps symbol -> relative density multiplier for this reel
 	// hệ số nhân mật độ tương đối cho cuộn này
 	// 1.0 = normal, >1.0 = more frequent, <1.0 = less frequent
-	SymbolDensity map[string]float64
+	SymbolDensity map[string]float64 `json:"symbol_density"`
 
 	// MinSpacing maps symbol -> minimum positions between same symbols
 	// vị trí tối thiểu giữa các ký hiệu giống nhau
-	MinSpacing map[string]int
+	MinSpacing map[string]int `json:"min_spacing"`
 
 	// MaxClusterSize maps symbol -> max consecutive symbols allowed
 	// số lượng ký hiệu liên tiếp tối đa được cho phép
-	MaxClusterSize map[string]int
+	MaxClusterSize map[string]int `json:"max_cluster_size"`
 
 	// ForbiddenPairs lists symbol pairs that should not be adjacent
 	//  liệt kê các cặp ký hiệu không được liền kề
-	ForbiddenPairs [][2]string
+	ForbiddenPairs [][2]string `json:"forbidden_pairs"`
 
-	ClusterForbiddenPairs []ClusterForbidden
+	ClusterForbiddenPairs []ClusterForbidden `json:"cluster_forbidden_pairs"`
 
 	// Role defines the role of this reel
-	Role ReelRole
+	Role ReelRole `json:"role"`
 
 	// GoldConfig defines gold symbol replacement rules for this reel
 	// Gold symbols are created by replacing base symbols after strip generation
-	GoldConfig *GoldTopologyConfig
+	GoldConfig *GoldTopologyConfig `json:"gold_config"`
 }
 
 // GoldTopologyConfig defines gold symbol configuration for a reel
 type GoldTopologyConfig struct {
 	// Enabled controls whether gold symbols are generated on this reel
-	Enabled bool
+	Enabled bool `json:"enabled"`
 
 	// GoldRatio is the target percentage of paying symbols to convert to gold (0.0-1.0)
 	// e.g., 0.12 means 12% of paying symbols will be gold
-	GoldRatio float64
+	GoldRatio float64 `json:"gold_ratio"`
 
 	// MinGoldSpacing is the minimum positions between gold symbols
-	MinGoldSpacing int
+	MinGoldSpacing int `json:"min_gold_spacing"`
 
 	// MaxGoldCluster is the maximum consecutive gold symbols allowed
-	MaxGoldCluster int
+	MaxGoldCluster int `json:"max_gold_cluster"`
 
 	// SymbolGoldRatio allows per-symbol gold ratio override (optional)
 	// If not set, uses GoldRatio for all symbols
-	SymbolGoldRatio map[string]float64
+	SymbolGoldRatio map[string]float64 `json:"symbol_gold_ratio,omitempty"`
 }
 
 type ClusterForbidden struct {
-	ClusterSymbols map[string]struct{}
-	GroupSize      int
-	AllowedSymbols []string
+	ClusterSymbols map[string]struct{} `json:"cluster_symbols"`
+	GroupSize      int                 `json:"group_size"`
+	AllowedSymbols []string            `json:"allowed_symbols"`
 }
 
 // ReelStripConfig holds configuration for reel strip generation
@@ -928,9 +928,9 @@ func (g *PGReelGenerator) GetTopology(reelIndex int) ReelTopology {
 
 // SymbolContributionTargets defines target percentages for symbol RTP contribution
 type SymbolContributionTargets struct {
-	LowPct  float64 // Target: 60-70%
-	MidPct  float64 // Target: 25-30%
-	HighPct float64 // Target: <10%
+	LowPct  float64 `json:"low_pct"`  // Target: 60-70%
+	MidPct  float64 `json:"mid_pct"`  // Target: 25-30%
+	HighPct float64 `json:"high_pct"` // Target: <10%
 }
 
 // DefaultSymbolContributionTargets returns PG-style targets
@@ -1118,11 +1118,11 @@ func (g *PGReelGenerator) GetCurrentDensities() map[string]float64 {
 
 // ReelDensitySummary holds density summary for a single reel
 type ReelDensitySummary struct {
-	ReelIndex int
-	Role      ReelRole
-	LowAvg    float64 // Average density for low symbols
-	MidAvg    float64 // Average density for mid symbols
-	HighAvg   float64 // Average density for high symbols
+	ReelIndex int      `json:"reel_index"`
+	Role      ReelRole `json:"role"`
+	LowAvg    float64  `json:"low_avg"`  // Average density for low symbols
+	MidAvg    float64  `json:"mid_avg"`  // Average density for mid symbols
+	HighAvg   float64  `json:"high_avg"` // Average density for high symbols
 }
 
 // GetAllReelDensities returns density summary for all 5 reels
@@ -1254,10 +1254,10 @@ func (g *PGReelGenerator) AnalyzeStrip(strip []string) StripAnalysis {
 
 // StripAnalysis holds analysis results for a reel strip
 type StripAnalysis struct {
-	Length         int
-	SymbolCounts   map[string]int
-	AvgSpacing     map[string]float64
-	MaxClusterSize map[string]int
+	Length         int                `json:"length"`
+	SymbolCounts   map[string]int     `json:"symbol_counts"`
+	AvgSpacing     map[string]float64 `json:"avg_spacing"`
+	MaxClusterSize map[string]int     `json:"max_cluster_size"`
 }
 
 // GetAdjustedWeights returns weights adjusted by topology density for all reels
@@ -0,0 +1,187 @@
+package tuning
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/slotmachine/backend/internal/game/multiplier"
+)
+
+// ContributionSplit is the share of RTP paid by each symbol tier, in percent
+type ContributionSplit struct {
+	LowPct  float64 `json:"low_pct"`
+	MidPct  float64 `json:"mid_pct"`
+	HighPct float64 `json:"high_pct"`
+}
+
+// IterationReport records one generate/simulate/adjust step of AutoTune
+// Topologies and Densities are the ones the strips were generated from, before the
+// step's adjustment.
+type IterationReport struct {
+	Iteration        int                  `json:"iteration"`
+	RTP              float64              `json:"rtp"`
+	HitRate          float64              `json:"hit_rate"`
+	BonusTriggerRate float64              `json:"bonus_trigger_rate"`
+	Contribution     ContributionSplit    `json:"contribution"`
+	WithinTolerance  bool                 `json:"within_tolerance"`
+	Densities        []ReelDensitySummary `json:"densities"`
+	Topologies       [5]ReelTopology      `json:"topologies"`
+	StripAnalyses    []StripAnalysis      `json:"strip_analyses"`
+	Stats            SimulationStats      `json:"stats"`
+}
+
+// TuningReport is the convergence record of an AutoTune run
+type TuningReport struct {
+	GameMode           string                    `json:"game_mode"`
+	Seed               int64                     `json:"seed"`
+	SpinsPerIter       int                       `json:"spins_per_iteration"`
+	Targets            SymbolContributionTargets `json:"targets"`
+	LearningRate       float64                   `json:"learning_rate"`
+	TargetRTP          float64                   `json:"target_rtp"`
+	TargetRTPTolerance float64                   `json:"target_rtp_tolerance"`
+	Ladder             multiplier.Ladder         `json:"multiplier_ladder"`
+	MaxIter            int                       `json:"max_iter"`
+	Converged          bool                      `json:"converged"`
+	StartedAt          time.Time                 `json:"started_at"`
+	FinishedAt         time.Time                 `json:"finished_at"`
+	Iterations         []IterationReport         `json:"iterations"`
+}
+
+// newIterationReport snapshots the generator and the simulation of one iteration
+func newIterationReport(iter int, g *PGReelGenerator, strips [][]string, stats SimulationStats) IterationReport {
+	report := IterationReport{
+		Iteration:        iter,
+		RTP:              stats.RTP,
+		BonusTriggerRate: stats.FreeSpinsTriggeredRate,
+		Contribution: ContributionSplit{
+			LowPct:  stats.LowSymbolRTPPct,
+			MidPct:  stats.MidSymbolRTPPct,
+			HighPct: stats.HighSymbolRTPPct,
+		},
+		Densities:     g.GetAllReelDensities(),
+		StripAnalyses: make([]StripAnalysis, len(strips)),
+		Stats:         stats,
+	}
+	if stats.TotalSpins > 0 {
+		report.HitRate = float64(stats.TotalWinSpins) / float64(stats.TotalSpins) * 100
+	}
+	for i := 0; i < 5; i++ {
+		report.Topologies[i] = cloneTopology(g.GetTopology(i))
+	}
+	for i, strip := range strips {
+		report.StripAnalyses[i] = g.AnalyzeStrip(strip)
+	}
+	return report
+}
+
+// cloneTopology copies the density map, which AdjustTopologyDensities mutates in place
+func cloneTopology(t ReelTopology) ReelTopology {
+	density := make(map[string]float64, len(t.SymbolDensity))
+	for sym, d := range t.SymbolDensity {
+		density[sym] = d
+	}
+	t.SymbolDensity = density
+	return t
+}
+
+// Final returns the last iteration, or nil if none ran
+func (r *TuningReport) Final() *IterationReport {
+	if len(r.Iterations) == 0 {
+		return nil
+	}
+	return &r.Iterations[len(r.Iterations)-1]
+}
+
+// RTPWithinTarget reports whether the final iteration's RTP is within tolerance of the mode's target
+func (r *TuningReport) RTPWithinTarget() bool {
+	final := r.Final()
+	return final != nil && math.Abs(final.RTP-r.TargetRTP) <= r.TargetRTPTolerance
+}
+
+// WriteDir writes iteration-NNN.json for every iteration, report.json and summary.md into dir
+func (r *TuningReport) WriteDir(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, iter := range r.Iterations {
+		if err := writeJSON(filepath.Join(dir, fmt.Sprintf("iteration-%03d.json", iter.Iteration)), iter); err != nil {
+			return err
+		}
+	}
+	if err := writeJSON(filepath.Join(dir, "report.json"), r); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "summary.md"), []byte(r.Markdown()), 0o644)
+}
+
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Markdown renders the run's outcome, its convergence path and the final densities
+func (r *TuningReport) Markdown() string {
+	var b strings.Builder
+
+	outcome := "converged"
+	if !r.Converged {
+		outcome = "did not converge"
+	}
+	rtpOutcome := "met"
+	if !r.RTPWithinTarget() {
+		rtpOutcome = "missed"
+	}
+	fmt.Fprintf(&b, "# Tuning report: %s\n\n", r.GameMode)
+	fmt.Fprintf(&b, "- Outcome: **%s** after %d of %d iterations\n", outcome, len(r.Iterations), r.MaxIter)
+	fmt.Fprintf(&b, "- Seed: %d\n", r.Seed)
+	fmt.Fprintf(&b, "- Spins per iteration: %d\n", r.SpinsPerIter)
+	fmt.Fprintf(&b, "- Targets: Low %.1f%% / Mid %.1f%% / High %.1f%%\n", r.Targets.LowPct, r.Targets.MidPct, r.Targets.HighPct)
+	fmt.Fprintf(&b, "- Learning rate: %.3f\n", r.LearningRate)
+	fmt.Fprintf(&b, "- RTP target: %.2f%% ± %.2f%% (%s)\n", r.TargetRTP, r.TargetRTPTolerance, rtpOutcome)
+	fmt.Fprintf(&b, "- Multiplier ladder: base %v, free spins %v\n", r.Ladder.Steps(false), r.Ladder.Steps(true))
+	fmt.Fprintf(&b, "- Started: %s, finished: %s (%s)\n\n",
+		r.StartedAt.Format(time.RFC3339), r.FinishedAt.Format(time.RFC3339), r.FinishedAt.Sub(r.StartedAt).Round(time.Second))
+
+	b.WriteString("## Convergence\n\n")
+	b.WriteString("| Iter | RTP % | Hit rate % | Bonus trigger % | Low % | Mid % | High % |\n")
+	b.WriteString("|---:|---:|---:|---:|---:|---:|---:|\n")
+	for _, iter := range r.Iterations {
+		fmt.Fprintf(&b, "| %d | %.3f | %.2f | %.3f | %.2f | %.2f | %.2f |\n",
+			iter.Iteration, iter.RTP, iter.HitRate, iter.BonusTriggerRate,
+			iter.Contribution.LowPct, iter.Contribution.MidPct, iter.Contribution.HighPct)
+	}
+
+	final := r.Final()
+	if final == nil {
+		return b.String()
+	}
+
+	b.WriteString("\n## Final densities\n\n")
+	b.WriteString("| Reel | Role | Low | Mid | High |\n")
+	b.WriteString("|---:|---|---:|---:|---:|\n")
+	for _, d := range final.Densities {
+		fmt.Fprintf(&b, "| %d | %s | %.3f | %.3f | %.3f |\n", d.ReelIndex, RoleName(d.Role), d.LowAvg, d.MidAvg, d.HighAvg)
+	}
+
+	b.WriteString("\n## Final strips\n\n")
+	b.WriteString("| Reel | Length | Symbols | Largest cluster |\n")
+	b.WriteString("|---:|---:|---:|---:|\n")
+	for i, a := range final.StripAnalyses {
+		largest := 0
+		for _, size := range a.MaxClusterSize {
+			if size > largest {
+				largest = size
+			}
+		}
+		fmt.Fprintf(&b, "| %d | %d | %d | %d |\n", i+1, a.Length, len(a.SymbolCounts), largest)
+	}
+	return b.String()
+}
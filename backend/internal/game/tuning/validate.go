@@ -0,0 +1,182 @@
+package tuning
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/game/symbols"
+)
+
+// ValidateStrips checks a full set of strips, one per reel, against the generator's topologies
+func (g *PGReelGenerator) ValidateStrips(strips [][]string) ([]reelstrip.Violation, error) {
+	if len(strips) != 5 {
+		return nil, reelstrip.ErrIncompleteSet
+	}
+	violations := make([]reelstrip.Violation, 0)
+	for reelIndex, strip := range strips {
+		violations = append(violations, g.ValidateStrip(reelIndex, strip)...)
+	}
+	return violations, nil
+}
+
+// ValidateStrip checks a strip against the rules the generator follows for reelIndex
+// Placement rules are those of canPlace, checked left to right with a symbol and its
+// _gold variant treated as one family; gold rules are those of ApplyGoldSymbols.
+func (g *PGReelGenerator) ValidateStrip(reelIndex int, strip []string) []reelstrip.Violation {
+	topology := g.topologies[reelIndex]
+	violations := make([]reelstrip.Violation, 0)
+	violate := func(pos int, rule, format string, args ...any) {
+		v := reelstrip.Violation{Reel: reelIndex, Position: pos, Rule: rule, Message: fmt.Sprintf(format, args...)}
+		if pos >= 0 {
+			v.Symbol = strip[pos]
+		}
+		violations = append(violations, v)
+	}
+
+	lastPos := make(map[string]int)
+	run := 0
+	for pos, sym := range strip {
+		family := strings.Split(sym, "_")[0]
+		if !isKnownSymbol(sym) {
+			violate(pos, reelstrip.RuleUnknownSymbol, "unknown symbol %q", sym)
+		}
+
+		if minSpace, ok := topology.MinSpacing[family]; ok {
+			if lp, seen := lastPos[family]; seen && pos-lp < minSpace {
+				violate(pos, reelstrip.RuleMinSpacing, "%s is %d positions after the previous one, minimum is %d", family, pos-lp, minSpace)
+			}
+		}
+		lastPos[family] = pos
+
+		if pos > 0 && strings.Split(strip[pos-1], "_")[0] == family {
+			run++
+		} else {
+			run = 1
+		}
+		if maxCluster, ok := topology.MaxClusterSize[family]; ok && run == maxCluster+1 {
+			violate(pos, reelstrip.RuleMaxCluster, "more than %d consecutive %s", maxCluster, family)
+		}
+
+		if pos > 0 {
+			prev := strings.Split(strip[pos-1], "_")[0]
+			for _, pair := range topology.ForbiddenPairs {
+				first, second := strings.Split(pair[0], "_")[0], strings.Split(pair[1], "_")[0]
+				if (first == prev && second == family) || (first == family && second == prev) {
+					violate(pos, reelstrip.RuleForbiddenPair, "%s next to %s", family, prev)
+				}
+			}
+		}
+
+		for _, cluster := range topology.ClusterForbiddenPairs {
+			if pos < cluster.GroupSize || !endsWithCluster(strip[:pos], cluster) {
+				continue
+			}
+			allowed := false
+			for _, allowedSym := range cluster.AllowedSymbols {
+				if family == allowedSym {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				violate(pos, reelstrip.RuleClusterForbidden, "%s after %d clustered symbols, allowed: %s",
+					family, cluster.GroupSize, strings.Join(cluster.AllowedSymbols, ", "))
+			}
+		}
+	}
+
+	g.validateGold(reelIndex, strip, violate)
+	return violations
+}
+
+// validateGold reports the gold symbols ApplyGoldSymbols would not have placed
+func (g *PGReelGenerator) validateGold(reelIndex int, strip []string, violate func(pos int, rule, format string, args ...any)) {
+	goldConfig := g.topologies[reelIndex].GoldConfig
+	enabled := goldConfig != nil && goldConfig.Enabled && goldConfig.GoldRatio > 0
+
+	paying := make(map[string]bool)
+	for _, sym := range append(append(append([]string{}, g.lowSymbols...), g.midSymbols...), g.highSymbols...) {
+		paying[sym] = true
+	}
+
+	goldPositions := make([]int, 0)
+	payingCount := 0
+	run := 0
+	for pos, sym := range strip {
+		if paying[strings.Split(sym, "_")[0]] {
+			payingCount++
+		}
+		if !strings.HasSuffix(sym, "_gold") {
+			run = 0
+			continue
+		}
+		goldPositions = append(goldPositions, pos)
+		run++
+		if !enabled {
+			violate(pos, reelstrip.RuleGoldNotAllowed, "reel %d has no gold symbols", reelIndex+1)
+			continue
+		}
+		if goldConfig.MaxGoldCluster > 0 && run == goldConfig.MaxGoldCluster+1 {
+			violate(pos, reelstrip.RuleGoldCluster, "more than %d consecutive gold symbols", goldConfig.MaxGoldCluster)
+		}
+	}
+	if !enabled || len(goldPositions) == 0 {
+		return
+	}
+
+	// Gold spacing is circular: the strip wraps around
+	if goldConfig.MinGoldSpacing > 0 && len(goldPositions) > 1 {
+		sort.Ints(goldPositions)
+		for i, pos := range goldPositions {
+			if i == 0 && len(goldPositions) == 2 {
+				continue // The wrap-around pair is the same pair
+			}
+			prev := goldPositions[(i+len(goldPositions)-1)%len(goldPositions)]
+			dist := pos - prev
+			if dist < 0 {
+				dist += len(strip)
+			}
+			if wrap := len(strip) - dist; wrap < dist {
+				dist = wrap
+			}
+			if dist < goldConfig.MinGoldSpacing {
+				violate(pos, reelstrip.RuleGoldSpacing, "gold symbol %d positions from the previous one, minimum is %d", dist, goldConfig.MinGoldSpacing)
+			}
+		}
+	}
+
+	maxGold := int(float64(payingCount) * goldConfig.GoldRatio)
+	if maxGold == 0 {
+		maxGold = 1
+	}
+	if len(goldPositions) > maxGold {
+		violate(-1, reelstrip.RuleGoldRatio, "%d gold symbols, at most %d (%.1f%% of %d paying symbols)",
+			len(goldPositions), maxGold, goldConfig.GoldRatio*100, payingCount)
+	}
+}
+
+// endsWithCluster reports whether the last GroupSize symbols of strip all belong to the cluster
+func endsWithCluster(strip []string, cluster ClusterForbidden) bool {
+	for i := 1; i <= cluster.GroupSize; i++ {
+		if _, ok := cluster.ClusterSymbols[strings.Split(strip[len(strip)-i], "_")[0]]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// isKnownSymbol reports whether the game can render sym
+func isKnownSymbol(sym string) bool {
+	base := symbols.GetBaseSymbol(sym)
+	if symbols.IsGoldVariant(sym) && !symbols.HasGoldVariant(base) {
+		return false
+	}
+	for _, known := range symbols.AllSymbols() {
+		if base == known {
+			return true
+		}
+	}
+	return false
+}
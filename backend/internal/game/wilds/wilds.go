@@ -0,0 +1,111 @@
+package wilds
+
+import (
+	"sort"
+
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/slotmachine/backend/internal/game/symbols"
+)
+
+// Features are the optional wild behaviors a game can enable
+// The zero value keeps the base behavior: wilds only substitute.
+type Features struct {
+	StickyWilds    bool `json:"sticky_wilds"`    // Free spins: wilds that land stay in place for the rest of the session
+	ExpandingWilds bool `json:"expanding_wilds"` // A wild in the visible rows expands to cover its whole reel
+	WildMultiplier int  `json:"wild_multiplier"` // Each wild in a winning way multiplies it (0 or 1 = off)
+}
+
+// Multiplier returns the per-wild way multiplier, 1 when disabled
+func (f Features) Multiplier() int {
+	if f.WildMultiplier < 2 {
+		return 1
+	}
+	return f.WildMultiplier
+}
+
+// Position is a wild's grid position
+type Position struct {
+	Reel int `json:"reel"`
+	Row  int `json:"row"`
+}
+
+// IsWild reports whether a grid symbol is a wild
+func IsWild(symbolStr string) bool {
+	return symbols.GetBaseSymbol(symbolStr) == symbols.SymbolWild
+}
+
+// Expand covers the visible rows of every reel holding a wild with wilds
+// Returns the expanded grid and the reels that changed; the input grid is not modified.
+func Expand(grid reels.Grid) (reels.Grid, []int) {
+	expanded := grid
+	var expandedReels []int
+
+	for reel := 0; reel < reels.ReelCount; reel++ {
+		hasWild, allWild := false, true
+		for row := reels.WinCheckStartRow; row <= reels.WinCheckEndRow; row++ {
+			if IsWild(grid.GetSymbol(reel, row)) {
+				hasWild = true
+			} else {
+				allWild = false
+			}
+		}
+		if !hasWild || allWild {
+			continue
+		}
+
+		if expandedReels == nil {
+			expanded = grid.Clone()
+		}
+		for row := reels.WinCheckStartRow; row <= reels.WinCheckEndRow; row++ {
+			expanded.SetSymbol(reel, row, string(symbols.SymbolWild))
+		}
+		expandedReels = append(expandedReels, reel)
+	}
+
+	return expanded, expandedReels
+}
+
+// ApplySticky places wilds at the sticky positions
+// The input grid is not modified.
+func ApplySticky(grid reels.Grid, positions []Position) reels.Grid {
+	if len(positions) == 0 {
+		return grid
+	}
+
+	sticky := grid.Clone()
+	for _, pos := range positions {
+		sticky.SetSymbol(pos.Reel, pos.Row, string(symbols.SymbolWild))
+	}
+	return sticky
+}
+
+// CollectSticky adds the wilds landed in the visible rows to the existing sticky positions
+// The result is sorted by reel then row so it is stable across replays.
+func CollectSticky(grid reels.Grid, existing []Position) []Position {
+	seen := make(map[Position]bool, len(existing))
+	positions := make([]Position, 0, len(existing))
+	for _, pos := range existing {
+		if !seen[pos] {
+			seen[pos] = true
+			positions = append(positions, pos)
+		}
+	}
+
+	for reel := 0; reel < reels.ReelCount; reel++ {
+		for row := reels.WinCheckStartRow; row <= reels.WinCheckEndRow; row++ {
+			pos := Position{Reel: reel, Row: row}
+			if IsWild(grid.GetSymbol(reel, row)) && !seen[pos] {
+				seen[pos] = true
+				positions = append(positions, pos)
+			}
+		}
+	}
+
+	sort.Slice(positions, func(i, j int) bool {
+		if positions[i].Reel != positions[j].Reel {
+			return positions[i].Reel < positions[j].Reel
+		}
+		return positions[i].Row < positions[j].Row
+	})
+	return positions
+}
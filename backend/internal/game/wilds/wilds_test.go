@@ -0,0 +1,80 @@
+package wilds
+
+import (
+	"testing"
+
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/stretchr/testify/assert"
+)
+
+func testGrid() reels.Grid {
+	return reels.Grid{
+		{"cai", "fu", "shu", "zhong", "liangtong", "fa", "zhong", "liangtong", "cai", "fu"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "wild", "bai", "bai", "bai", "zhong"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "fa", "bai", "bai", "bai", "fu"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "wild", "wild", "wild", "wild", "shu"},
+		{"wild", "fu", "shu", "zhong", "liangtong", "bai", "bai", "bai", "bai", "wild"},
+	}
+}
+
+func TestFeatures_Multiplier(t *testing.T) {
+	assert.Equal(t, 1, Features{}.Multiplier())
+	assert.Equal(t, 1, Features{WildMultiplier: 1}.Multiplier())
+	assert.Equal(t, 3, Features{WildMultiplier: 3}.Multiplier())
+}
+
+func TestExpand(t *testing.T) {
+	grid := testGrid()
+
+	expanded, expandedReels := Expand(grid)
+
+	t.Run("should expand reels with a visible wild", func(t *testing.T) {
+		assert.Equal(t, []int{1}, expandedReels)
+		for row := reels.WinCheckStartRow; row <= reels.WinCheckEndRow; row++ {
+			assert.Equal(t, "wild", expanded.GetSymbol(1, row))
+		}
+		assert.Equal(t, "zhong", expanded.GetSymbol(1, 9), "rows outside the visible area are untouched")
+	})
+
+	t.Run("should skip full wild reels and wilds outside the visible rows", func(t *testing.T) {
+		assert.NotContains(t, expandedReels, 3)
+		assert.NotContains(t, expandedReels, 4)
+	})
+
+	t.Run("should not modify the input grid", func(t *testing.T) {
+		assert.Equal(t, "bai", grid.GetSymbol(1, 6))
+	})
+
+	t.Run("should return no reels when nothing expands", func(t *testing.T) {
+		plain := testGrid()
+		plain.SetSymbol(1, 5, "bai")
+		_, none := Expand(plain)
+		assert.Empty(t, none)
+	})
+}
+
+func TestSticky(t *testing.T) {
+	t.Run("should apply sticky positions without modifying the input", func(t *testing.T) {
+		grid := testGrid()
+
+		sticky := ApplySticky(grid, []Position{{Reel: 0, Row: 6}})
+
+		assert.Equal(t, "wild", sticky.GetSymbol(0, 6))
+		assert.Equal(t, "zhong", grid.GetSymbol(0, 6))
+	})
+
+	t.Run("should collect landed wilds in reel and row order", func(t *testing.T) {
+		grid := testGrid()
+
+		positions := CollectSticky(grid, []Position{{Reel: 2, Row: 7}, {Reel: 1, Row: 5}})
+
+		assert.Equal(t, []Position{
+			{Reel: 1, Row: 5},
+			{Reel: 2, Row: 7},
+			{Reel: 3, Row: 5},
+			{Reel: 3, Row: 6},
+			{Reel: 3, Row: 7},
+			{Reel: 3, Row: 8},
+		}, positions)
+	})
+}
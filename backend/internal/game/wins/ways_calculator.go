@@ -3,7 +3,6 @@ package wins
 import (
 	"github.com/slotmachine/backend/internal/game/reels"
 	"github.com/slotmachine/backend/internal/game/symbols"
-	"github.com/slotmachine/backend/internal/pkg/util"
 )
 
 // Position represents a grid position [reel, row]
@@ -24,21 +23,27 @@ type SymbolWin struct {
 // CalculateWays calculates all winning combinations in a grid
 // Returns a slice of SymbolWin for each winning symbol
 func CalculateWays(grid reels.Grid) []SymbolWin {
+	ids := grid.IDs()
+	return calculateWays(&ids)
+}
+
+// calculateWays evaluates way wins on a compiled grid, in order of first appearance on reel 0
+func calculateWays(ids *reels.IDGrid) []SymbolWin {
 	wins := make([]SymbolWin, 0)
 
 	// Symbol must appear in reel 0 to check win
 	// Only check middle 4 fully visible rows (5-8)
-	needCheckWinSyms := util.UniqueSlice(grid[0][reels.WinCheckStartRow : reels.WinCheckEndRow+1])
-	// Check each paying symbol (skip bonus/wild/gold - they don't create way wins)
-	for _, sym := range needCheckWinSyms {
-		baseSymbol := symbols.GetBaseSymbol(sym)
+	var seen uint64
+	for row := reels.WinCheckStartRow; row <= reels.WinCheckEndRow; row++ {
+		base := ids[0][row].Base()
 
-		// Only process paying symbols
-		if !symbols.IsPayingSymbol(baseSymbol) {
+		// Only process paying symbols (skip bonus/wild/gold - they don't create way wins)
+		if !base.IsPaying() || seen&(1<<base) != 0 {
 			continue
 		}
+		seen |= 1 << base
 
-		win := calculateWaysForSymbol(grid, baseSymbol)
+		win := calculateWaysForSymbol(ids, base)
 		if win.Ways > 0 && win.Count >= symbols.MinSymbolsForPayout() {
 			wins = append(wins, win)
 		}
@@ -47,76 +52,60 @@ func CalculateWays(grid reels.Grid) []SymbolWin {
 	return wins
 }
 
-// calculateWaysForSymbol calculates ways for a specific symbol
-func calculateWaysForSymbol(grid reels.Grid, targetSymbol symbols.Symbol) SymbolWin {
-	// Count matching positions on each reel starting from reel 0
-	matchingPositions := make([][]int, reels.ReelCount)
-
+// calculateWaysForSymbol calculates ways for a specific paying symbol
+func calculateWaysForSymbol(ids *reels.IDGrid, target symbols.ID) SymbolWin {
+	// Count matching positions on each consecutive reel starting from reel 0
+	var matches [reels.ReelCount]int
+	count, total := 0, 0
 	for reelIdx := 0; reelIdx < reels.ReelCount; reelIdx++ {
-		matchingPositions[reelIdx] = getMatchingPositions(grid, reelIdx, targetSymbol)
-
-		if len(matchingPositions[reelIdx]) == 0 {
-			// Calculate win for previous consecutive reels
-			if reelIdx >= symbols.MinSymbolsForPayout() {
-				ways := calculateWaysProduct(matchingPositions[:reelIdx])
-				positions := collectPositions(matchingPositions[:reelIdx])
-				return SymbolWin{
-					Symbol:    targetSymbol,
-					Count:     reelIdx,
-					Ways:      ways,
-					Positions: positions,
-				}
+		for row := reels.WinCheckStartRow; row <= reels.WinCheckEndRow; row++ {
+			if matchesSymbol(ids[reelIdx][row], target) {
+				matches[reelIdx]++
 			}
-			// Not enough consecutive reels for a win
-			return SymbolWin{Symbol: targetSymbol, Count: 0, Ways: 0, Positions: nil}
 		}
+		if matches[reelIdx] == 0 {
+			break
+		}
+		count++
+		total += matches[reelIdx]
+	}
+
+	if count < symbols.MinSymbolsForPayout() {
+		// Not enough consecutive reels for a win
+		return SymbolWin{Symbol: target.Symbol(), Count: 0, Ways: 0, Positions: nil}
+	}
+
+	// Ways = matches_reel1 × matches_reel2 × matches_reel3 × ...
+	ways := 1
+	for _, n := range matches[:count] {
+		ways *= n
 	}
 
-	// All 5 reels have matches - 5-of-a-kind
-	ways := calculateWaysProduct(matchingPositions)
-	positions := collectPositions(matchingPositions)
 	return SymbolWin{
-		Symbol:    targetSymbol,
-		Count:     reels.ReelCount,
+		Symbol:    target.Symbol(),
+		Count:     count,
 		Ways:      ways,
-		Positions: positions,
+		Positions: matchingPositions(ids, target, count, total),
 	}
 }
 
-// getMatchingPositions returns row indices where the symbol matches (including wild substitution)
-func getMatchingPositions(grid reels.Grid, reelIdx int, targetSymbol symbols.Symbol) []int {
-	positions := make([]int, 0)
-
-	// Only check middle 4 fully visible rows (5-8) for winning positions
-	for row := reels.WinCheckStartRow; row <= reels.WinCheckEndRow; row++ {
-		symbolStr := grid.GetSymbol(reelIdx, row)
-		baseSymbol := symbols.GetBaseSymbol(symbolStr)
-
-		// Check if symbol matches or wild substitutes
-		if baseSymbol == targetSymbol {
-			positions = append(positions, row)
-		} else if baseSymbol == symbols.SymbolWild && symbols.CanBeSubstituted(targetSymbol) {
-			// Wild substitutes for paying symbols
-			positions = append(positions, row)
+// matchingPositions returns every [reel, row] on the first count reels where target matches
+func matchingPositions(ids *reels.IDGrid, target symbols.ID, count, capacity int) []Position {
+	positions := make([]Position, 0, capacity)
+	for reelIdx := 0; reelIdx < count && reelIdx < reels.ReelCount; reelIdx++ {
+		for row := reels.WinCheckStartRow; row <= reels.WinCheckEndRow; row++ {
+			if matchesSymbol(ids[reelIdx][row], target) {
+				positions = append(positions, Position{Reel: reelIdx, Row: row})
+			}
 		}
 	}
-
 	return positions
 }
 
-// calculateWaysProduct calculates the product of matching positions
-// Ways = matches_reel1 × matches_reel2 × matches_reel3 × ...
-func calculateWaysProduct(matchingPositions [][]int) int {
-	if len(matchingPositions) == 0 {
-		return 0
-	}
-
-	ways := 1
-	for _, positions := range matchingPositions {
-		ways *= len(positions)
-	}
-
-	return ways
+// matchesSymbol reports whether a cell pays for a paying target symbol, directly or as a wild
+func matchesSymbol(id, target symbols.ID) bool {
+	base := id.Base()
+	return base == target || base == symbols.IDWild
 }
 
 // GetWinningSymbols returns all symbols that have wins in the grid
@@ -138,21 +127,3 @@ func HasAnyWins(grid reels.Grid) bool {
 	wins := CalculateWays(grid)
 	return len(wins) > 0
 }
-
-// collectPositions converts matchingPositions ([][]int) to []Position
-// matchingPositions[reelIdx] = [row1, row2, ...] for each reel
-// Returns all [reel, row] positions across all reels
-func collectPositions(matchingPositions [][]int) []Position {
-	positions := make([]Position, 0)
-
-	for reelIdx, rows := range matchingPositions {
-		for _, row := range rows {
-			positions = append(positions, Position{
-				Reel: reelIdx,
-				Row:  row,
-			})
-		}
-	}
-
-	return positions
-}
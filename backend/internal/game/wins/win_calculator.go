@@ -4,29 +4,43 @@ import (
 	"github.com/slotmachine/backend/internal/game/multiplier"
 	"github.com/slotmachine/backend/internal/game/reels"
 	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/game/wilds"
 )
 
 // CascadeWinDetail represents win details for a single cascade
 type CascadeWinDetail struct {
-	Symbol    symbols.Symbol `json:"symbol"`
-	Count     int            `json:"count"`      // 3, 4, or 5
-	Ways      int            `json:"ways"`       // Number of ways
-	Payout    float64        `json:"payout"`     // Base payout multiplier from paytable
-	WinAmount float64        `json:"win_amount"` // Actual win amount for this symbol
-	Positions []Position     `json:"positions"`  // Grid positions that form this win
+	Symbol        symbols.Symbol `json:"symbol"`
+	Count         int            `json:"count"`                    // 3, 4, or 5
+	Ways          int            `json:"ways"`                     // Number of ways
+	EffectiveWays int            `json:"effective_ways,omitempty"` // Ways weighted by wild multipliers, set when they raised the win
+	Payout        float64        `json:"payout"`                   // Base payout multiplier from paytable
+	WinAmount     float64        `json:"win_amount"`               // Actual win amount for this symbol
+	Positions     []Position     `json:"positions"`                // Grid positions that form this win
 }
 
-// CalculateCascadeWin calculates the total win for a single cascade
+// CalculateCascadeWin calculates the total win for a single cascade using the default multiplier ladder
 // Returns individual symbol wins and total cascade win
 func CalculateCascadeWin(grid reels.Grid, betAmount float64, cascadeNumber int, isFreeSpin bool) ([]CascadeWinDetail, []SymbolWin, float64) {
+	return CalculateCascadeWinWithLadder(grid, betAmount, cascadeNumber, isFreeSpin, multiplier.DefaultLadder)
+}
+
+// CalculateCascadeWinWithLadder calculates the total win for a single cascade using the given multiplier ladder
+func CalculateCascadeWinWithLadder(grid reels.Grid, betAmount float64, cascadeNumber int, isFreeSpin bool, ladder multiplier.Ladder) ([]CascadeWinDetail, []SymbolWin, float64) {
+	return CalculateCascadeWinWithFeatures(grid, betAmount, cascadeNumber, isFreeSpin, ladder, wilds.Features{})
+}
+
+// CalculateCascadeWinWithFeatures calculates the total win for a single cascade using the given
+// multiplier ladder and wild features
+func CalculateCascadeWinWithFeatures(grid reels.Grid, betAmount float64, cascadeNumber int, isFreeSpin bool, ladder multiplier.Ladder, features wilds.Features) ([]CascadeWinDetail, []SymbolWin, float64) {
 	// Get multiplier for this cascade
-	cascadeMultiplier := multiplier.GetMultiplier(cascadeNumber, isFreeSpin)
+	cascadeMultiplier := ladder.Get(cascadeNumber, isFreeSpin)
 
-	// Calculate ways for all symbols
-	symbolWins := CalculateWays(grid)
+	// Calculate ways for all symbols on the grid compiled once for this cascade
+	ids := grid.IDs()
+	symbolWins := calculateWays(&ids)
 
 	// Calculate win for each symbol
-	winDetails := make([]CascadeWinDetail, 0)
+	winDetails := make([]CascadeWinDetail, 0, len(symbolWins))
 	totalCascadeWin := 0.0
 
 	for _, win := range symbolWins {
@@ -36,30 +50,42 @@ func CalculateCascadeWin(grid reels.Grid, betAmount float64, cascadeNumber int,
 			continue
 		}
 
+		// Wild multipliers weight each way by the multipliers of the wilds in it
+		ways := win.Ways
+		effectiveWays := 0
+		if features.Multiplier() > 1 {
+			effectiveWays = weightedWays(&ids, win, features.Multiplier())
+			if effectiveWays > ways {
+				ways = effectiveWays
+			} else {
+				effectiveWays = 0
+			}
+		}
+
 		// Win calculation formula (spec 04-rtp-mathematics.md):
 		// Win = Symbol_Payout × Ways_Count × Cascade_Multiplier × Bet_Per_Way
 		// where Bet_Per_Way = Total_Bet / 20
 		betPerWay := betAmount / 20.0
-		winAmount := payoutMultiplier * float64(win.Ways) * float64(cascadeMultiplier) * betPerWay
+		winAmount := payoutMultiplier * float64(ways) * float64(cascadeMultiplier) * betPerWay
 
 		// Create positions with gold transformation flag set
 		positions := make([]Position, len(win.Positions))
 		for j, pos := range win.Positions {
-			symbolStr := grid.GetSymbol(pos.Reel, pos.Row)
 			positions[j] = Position{
 				Reel:         pos.Reel,
 				Row:          pos.Row,
-				IsGoldToWild: symbols.IsGoldVariant(symbolStr),
+				IsGoldToWild: ids[pos.Reel][pos.Row].IsGold(),
 			}
 		}
 
 		winDetail := CascadeWinDetail{
-			Symbol:    win.Symbol,
-			Count:     win.Count,
-			Ways:      win.Ways,
-			Payout:    payoutMultiplier,
-			WinAmount: winAmount,
-			Positions: positions, // Include positions with gold flag for frontend
+			Symbol:        win.Symbol,
+			Count:         win.Count,
+			Ways:          win.Ways,
+			EffectiveWays: effectiveWays,
+			Payout:        payoutMultiplier,
+			WinAmount:     winAmount,
+			Positions:     positions, // Include positions with gold flag for frontend
 		}
 
 		winDetails = append(winDetails, winDetail)
@@ -69,6 +95,27 @@ func CalculateCascadeWin(grid reels.Grid, betAmount float64, cascadeNumber int,
 	return winDetails, symbolWins, totalCascadeWin
 }
 
+// weightedWays sums every way of a win weighted by its wilds
+// Each wild counts wildMultiplier times, so a way through two wilds pays wildMultiplier² times.
+// Summed over all ways this is the product, per reel, of (matching symbols + wildMultiplier × wilds).
+func weightedWays(ids *reels.IDGrid, win SymbolWin, wildMultiplier int) int {
+	target := symbols.SymbolID(win.Symbol)
+	ways := 1
+	for reelIdx := 0; reelIdx < win.Count; reelIdx++ {
+		weight := 0
+		for row := reels.WinCheckStartRow; row <= reels.WinCheckEndRow; row++ {
+			switch base := ids[reelIdx][row].Base(); {
+			case base == symbols.IDWild:
+				weight += wildMultiplier
+			case base == target:
+				weight++
+			}
+		}
+		ways *= weight
+	}
+	return ways
+}
+
 // CalculateTotalSpinWin calculates the total win across all cascades
 // and applies the max win cap
 func CalculateTotalSpinWin(cascadeWins []float64, betAmount float64) float64 {
@@ -84,23 +131,22 @@ func CalculateTotalSpinWin(cascadeWins []float64, betAmount float64) float64 {
 // GetWinningPositions returns positions of winning symbols
 // Used for highlighting/animation
 func GetWinningPositions(grid reels.Grid, targetSymbol symbols.Symbol, count int) []Position {
-	positions := make([]Position, 0)
-
-	// Get matching positions for each reel up to 'count' reels
-	// Only check middle 4 fully visible rows (5-8)
-	for reelIdx := 0; reelIdx < count && reelIdx < reels.ReelCount; reelIdx++ {
-		for row := reels.WinCheckStartRow; row <= reels.WinCheckEndRow; row++ {
-			symbolStr := grid.GetSymbol(reelIdx, row)
-			baseSymbol := symbols.GetBaseSymbol(symbolStr)
-
-			if baseSymbol == targetSymbol {
-				positions = append(positions, Position{Reel: reelIdx, Row: row})
-			} else if baseSymbol == symbols.SymbolWild && symbols.CanBeSubstituted(targetSymbol) {
-				// Include wild substitutions
-				positions = append(positions, Position{Reel: reelIdx, Row: row})
+	target := symbols.SymbolID(targetSymbol)
+	ids := grid.IDs()
+
+	// Only paying symbols are substituted by wilds
+	if !target.IsPaying() {
+		positions := make([]Position, 0)
+		for reelIdx := 0; reelIdx < count && reelIdx < reels.ReelCount; reelIdx++ {
+			for row := reels.WinCheckStartRow; row <= reels.WinCheckEndRow; row++ {
+				if ids[reelIdx][row].Base() == target {
+					positions = append(positions, Position{Reel: reelIdx, Row: row})
+				}
 			}
 		}
+		return positions
 	}
 
-	return positions
+	// Only check middle 4 fully visible rows (5-8)
+	return matchingPositions(&ids, target, count, 0)
 }
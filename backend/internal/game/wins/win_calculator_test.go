@@ -3,8 +3,10 @@ package wins
 import (
 	"testing"
 
+	"github.com/slotmachine/backend/internal/game/multiplier"
 	"github.com/slotmachine/backend/internal/game/reels"
 	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/game/wilds"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -376,3 +378,31 @@ func BenchmarkGetWinningPositions(b *testing.B) {
 		_ = GetWinningPositions(grid, symbols.SymbolFa, 3)
 	}
 }
+
+func TestCalculateCascadeWinWithFeatures(t *testing.T) {
+	// "fa" 3-of-a-kind through a wild on reel 2 (1 way)
+	grid := reels.Grid{
+		{"cai", "fu", "shu", "zhong", "liangtong", "fa", "zhong", "liangtong", "cai", "fu"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "wild", "bai", "bai", "bai", "zhong"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "fa", "bai", "bai", "bai", "fu"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "bai", "bai", "bai", "bai", "shu"},
+		{"cai", "fu", "shu", "zhong", "liangtong", "bai", "bai", "bai", "bai", "zhong"},
+	}
+
+	t.Run("should multiply ways through wilds", func(t *testing.T) {
+		winDetails, _, totalWin := CalculateCascadeWinWithFeatures(grid, 20.0, 1, false, multiplier.DefaultLadder, wilds.Features{WildMultiplier: 3})
+
+		require.Len(t, winDetails, 1)
+		assert.Equal(t, 1, winDetails[0].Ways)
+		assert.Equal(t, 3, winDetails[0].EffectiveWays)
+		assert.Equal(t, 30.0, totalWin) // 10 × 3 × 1 × (20/20)
+	})
+
+	t.Run("should match the base win without wild features", func(t *testing.T) {
+		winDetails, _, totalWin := CalculateCascadeWinWithFeatures(grid, 20.0, 1, false, multiplier.DefaultLadder, wilds.Features{})
+
+		require.Len(t, winDetails, 1)
+		assert.Zero(t, winDetails[0].EffectiveWays)
+		assert.Equal(t, 10.0, totalWin)
+	})
+}
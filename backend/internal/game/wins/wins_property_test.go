@@ -0,0 +1,65 @@
+package wins
+
+import (
+	"testing"
+
+	"github.com/slotmachine/backend/internal/game/proptest"
+	"github.com/slotmachine/backend/internal/game/reels"
+	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+)
+
+func TestProperty_CascadeWinsAreNonNegativeAndConsistent(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		grid := proptest.Grid().Draw(t, "grid")
+		bet := proptest.Bet().Draw(t, "bet")
+		cascadeNumber := rapid.IntRange(1, 10).Draw(t, "cascade")
+		isFreeSpin := rapid.Bool().Draw(t, "free_spin")
+
+		details, symbolWins, total := CalculateCascadeWin(grid, bet, cascadeNumber, isFreeSpin)
+		assert.GreaterOrEqual(t, total, 0.0)
+		assert.Equal(t, len(symbolWins) > 0, HasAnyWins(grid))
+
+		sum := 0.0
+		for _, win := range details {
+			assert.GreaterOrEqual(t, win.WinAmount, 0.0)
+			assert.GreaterOrEqual(t, win.Count, 3)
+			assert.LessOrEqual(t, win.Count, reels.ReelCount)
+			assert.Positive(t, win.Ways)
+			for _, pos := range win.Positions {
+				assert.Less(t, pos.Reel, win.Count, "%s win reaches past its reels", win.Symbol)
+				assert.GreaterOrEqual(t, pos.Row, reels.WinCheckStartRow)
+				assert.LessOrEqual(t, pos.Row, reels.WinCheckEndRow)
+				base := symbols.GetBaseSymbol(grid.GetSymbol(pos.Reel, pos.Row))
+				assert.Contains(t, []symbols.Symbol{win.Symbol, symbols.SymbolWild}, base)
+			}
+			sum += win.WinAmount
+		}
+		assert.InDelta(t, total, sum, 1e-9)
+
+		// Wins scale with the bet
+		_, _, doubled := CalculateCascadeWin(grid, bet*2, cascadeNumber, isFreeSpin)
+		assert.InDelta(t, total*2, doubled, 1e-9)
+	})
+}
+
+func TestProperty_MaxWinCapIsRespected(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		bet := proptest.Bet().Draw(t, "bet")
+		cascadeWins := rapid.SliceOfN(rapid.Float64Range(0, bet*MaxWinMultiplier), 0, 20).Draw(t, "cascade_wins")
+
+		sum := 0.0
+		for _, w := range cascadeWins {
+			sum += w
+		}
+		total := CalculateTotalSpinWin(cascadeWins, bet)
+
+		assert.LessOrEqual(t, total, GetMaxWinForBet(bet))
+		assert.Equal(t, IsWinCapped(sum, bet), total < sum)
+		if !IsWinCapped(sum, bet) {
+			assert.Equal(t, sum, total, "wins under the cap are paid in full")
+		}
+		assert.Equal(t, total, ApplyMaxWinCap(total, bet), "capping is idempotent")
+	})
+}
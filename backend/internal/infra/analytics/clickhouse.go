@@ -0,0 +1,231 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/analytics"
+	"github.com/slotmachine/backend/domain/reelstrip"
+)
+
+// clickHouseTime is the DateTime64(3) layout ClickHouse parses and prints
+const clickHouseTime = "2006-01-02 15:04:05.000"
+
+// spinEventsSchema creates the table spin events are mirrored into
+// Spins are sorted by config so per-config aggregates read one range. The deduplication window
+// drops a retried batch ClickHouse already inserted.
+const spinEventsSchema = `CREATE TABLE IF NOT EXISTS spin_events (
+	spin_id UUID,
+	player_id UUID,
+	session_id UUID,
+	reel_strip_config_id UUID,
+	game_mode LowCardinality(String),
+	bet_amount Float64,
+	stake Float64,
+	total_win Float64,
+	is_free_spin Bool,
+	free_spins_triggered Bool,
+	scatter_count UInt8,
+	created_at DateTime64(3, 'UTC')
+) ENGINE = MergeTree
+PARTITION BY toYYYYMM(created_at)
+ORDER BY (reel_strip_config_id, created_at, spin_id)
+SETTINGS non_replicated_deduplication_window = 1000`
+
+// clickHouseRow is a spin event in ClickHouse's JSONEachRow format
+type clickHouseRow struct {
+	*analytics.SpinEvent
+	CreatedAt string `json:"created_at"`
+}
+
+// ClickHouse mirrors spin events into ClickHouse and runs the analytics queries there
+// It talks to the HTTP interface, so it needs no native driver.
+type ClickHouse struct {
+	baseURL  string
+	database string
+	user     string
+	password string
+	client   *http.Client
+}
+
+// NewClickHouse creates a ClickHouse client for a base URL such as http://clickhouse:8123
+func NewClickHouse(baseURL, database, user, password string, client *http.Client) *ClickHouse {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &ClickHouse{
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		database: database,
+		user:     user,
+		password: password,
+		client:   client,
+	}
+}
+
+// Ensure ClickHouse implements the analytics sink and store
+var (
+	_ analytics.Sink  = (*ClickHouse)(nil)
+	_ analytics.Store = (*ClickHouse)(nil)
+)
+
+// Name identifies the sink
+func (c *ClickHouse) Name() string {
+	return "clickhouse"
+}
+
+// EnsureSchema creates the spin events table if it does not exist
+func (c *ClickHouse) EnsureSchema(ctx context.Context) error {
+	_, err := c.exec(ctx, spinEventsSchema, nil, nil)
+	return err
+}
+
+// Write inserts events in one block; a retry of the same batch is deduplicated by ClickHouse
+func (c *ClickHouse) Write(ctx context.Context, events []*analytics.SpinEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for _, e := range events {
+		if err := enc.Encode(clickHouseRow{SpinEvent: e, CreatedAt: e.CreatedAt.UTC().Format(clickHouseTime)}); err != nil {
+			return fmt.Errorf("failed to encode spin event: %w", err)
+		}
+	}
+
+	_, err := c.exec(ctx, "INSERT INTO spin_events FORMAT JSONEachRow", nil, &body)
+	return err
+}
+
+// ConfigPerformance sums the stakes and wins of spins played on a config since a time
+func (c *ClickHouse) ConfigPerformance(ctx context.Context, configID uuid.UUID, since time.Time) (*reelstrip.CanaryPerformance, error) {
+	var rows []struct {
+		Spins   int64   `json:"spins"`
+		Wagered float64 `json:"wagered"`
+		Won     float64 `json:"won"`
+	}
+	err := c.query(ctx, `SELECT count() AS spins, sum(stake) AS wagered, sum(total_win) AS won
+		FROM spin_events
+		WHERE reel_strip_config_id = {config_id:UUID} AND created_at >= {since:DateTime64(3, 'UTC')}`,
+		url.Values{
+			"param_config_id": {configID.String()},
+			"param_since":     {since.UTC().Format(clickHouseTime)},
+		}, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum config performance: %w", err)
+	}
+
+	perf := &reelstrip.CanaryPerformance{}
+	if len(rows) > 0 {
+		perf.Spins, perf.Wagered, perf.Won = rows[0].Spins, rows[0].Wagered, rows[0].Won
+	}
+	return perf, nil
+}
+
+// ConfigSample sums the stakes, wins and per-spin returns of paid spins played on a config in [since, until)
+func (c *ClickHouse) ConfigSample(ctx context.Context, configID uuid.UUID, since, until time.Time) (*reelstrip.RTPSample, error) {
+	var rows []struct {
+		Spins             int64   `json:"spins"`
+		Wagered           float64 `json:"wagered"`
+		Won               float64 `json:"won"`
+		SumReturns        float64 `json:"sum_returns"`
+		SumSquaredReturns float64 `json:"sum_squared_returns"`
+	}
+	err := c.query(ctx, `SELECT count() AS spins, sum(stake) AS wagered, sum(total_win) AS won,
+			sum(total_win / stake) AS sum_returns, sum((total_win / stake) * (total_win / stake)) AS sum_squared_returns
+		FROM spin_events
+		WHERE reel_strip_config_id = {config_id:UUID}
+			AND created_at >= {since:DateTime64(3, 'UTC')} AND created_at < {until:DateTime64(3, 'UTC')}
+			AND stake > 0`,
+		url.Values{
+			"param_config_id": {configID.String()},
+			"param_since":     {since.UTC().Format(clickHouseTime)},
+			"param_until":     {until.UTC().Format(clickHouseTime)},
+		}, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sample config RTP: %w", err)
+	}
+
+	sample := &reelstrip.RTPSample{}
+	if len(rows) > 0 {
+		r := rows[0]
+		sample.Spins, sample.Wagered, sample.Won = r.Spins, r.Wagered, r.Won
+		sample.SumReturns, sample.SumSquaredReturns = r.SumReturns, r.SumSquaredReturns
+	}
+	return sample, nil
+}
+
+// Ping checks that ClickHouse answers queries
+func (c *ClickHouse) Ping(ctx context.Context) error {
+	_, err := c.exec(ctx, "SELECT 1", nil, nil)
+	return err
+}
+
+// query runs a SELECT and decodes the rows of its JSON output into rows
+func (c *ClickHouse) query(ctx context.Context, sql string, params url.Values, rows any) error {
+	if params == nil {
+		params = url.Values{}
+	}
+	// Counts are 64-bit integers, which ClickHouse quotes in JSON by default
+	params.Set("output_format_json_quote_64bit_integers", "0")
+
+	body, err := c.exec(ctx, sql+" FORMAT JSON", params, nil)
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("failed to decode ClickHouse response: %w", err)
+	}
+	return json.Unmarshal(result.Data, rows)
+}
+
+// exec sends sql to the HTTP interface; data, if any, follows the statement in the body
+func (c *ClickHouse) exec(ctx context.Context, sql string, params url.Values, data io.Reader) ([]byte, error) {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("database", c.database)
+
+	body := io.Reader(strings.NewReader(sql))
+	if data != nil {
+		// The statement travels in the query string so the body is the rows alone
+		params.Set("query", sql)
+		body = data
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/?"+params.Encode(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build ClickHouse request: %w", err)
+	}
+	if c.user != "" {
+		req.Header.Set("X-ClickHouse-User", c.user)
+		req.Header.Set("X-ClickHouse-Key", c.password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ClickHouse request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	out, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ClickHouse response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ClickHouse returned %d: %s", resp.StatusCode, strings.TrimSpace(string(out)))
+	}
+	return out, nil
+}
@@ -0,0 +1,89 @@
+package analytics
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/analytics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClickHouse(t *testing.T) {
+	ctx := context.Background()
+	configID := uuid.New()
+	createdAt := time.Date(2026, 3, 1, 12, 30, 0, 250_000_000, time.UTC)
+
+	var inserted []map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("database") != "slots" || r.Header.Get("X-ClickHouse-User") != "writer" || r.Header.Get("X-ClickHouse-Key") != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		q := r.URL.Query()
+		switch {
+		case q.Get("query") == "INSERT INTO spin_events FORMAT JSONEachRow":
+			scanner := bufio.NewScanner(r.Body)
+			for scanner.Scan() {
+				var row map[string]any
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &row))
+				inserted = append(inserted, row)
+			}
+		case q.Get("param_config_id") == configID.String() && q.Get("param_since") == "2026-03-01 00:00:00.000":
+			if q.Get("param_until") != "" {
+				_, _ = w.Write([]byte(`{"meta":[],"data":[{"spins":4,"wagered":4,"won":3,"sum_returns":3,"sum_squared_returns":5}],"rows":1}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"meta":[],"data":[{"spins":2,"wagered":20,"won":35}],"rows":1}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("Code: 62. DB::Exception: Syntax error"))
+		}
+	}))
+	defer srv.Close()
+
+	ch := NewClickHouse(srv.URL+"/", "slots", "writer", "secret", nil)
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should insert events as JSON rows with ClickHouse timestamps", func(t *testing.T) {
+		events := []*analytics.SpinEvent{
+			{SpinID: uuid.New(), ReelStripConfigID: configID, GameMode: "bonus_spin_trigger", BetAmount: 1, Stake: 100, TotalWin: 30, CreatedAt: createdAt},
+			{SpinID: uuid.New(), ReelStripConfigID: configID, BetAmount: 1, Stake: 1, IsFreeSpin: true, CreatedAt: createdAt},
+		}
+		require.NoError(t, ch.Write(ctx, events))
+
+		require.Len(t, inserted, 2)
+		assert.Equal(t, events[0].SpinID.String(), inserted[0]["spin_id"])
+		assert.Equal(t, configID.String(), inserted[0]["reel_strip_config_id"])
+		assert.Equal(t, "2026-03-01 12:30:00.250", inserted[0]["created_at"])
+		assert.Equal(t, float64(100), inserted[0]["stake"])
+		assert.Equal(t, true, inserted[1]["is_free_spin"])
+	})
+
+	t.Run("should read performance and samples from the JSON output", func(t *testing.T) {
+		perf, err := ch.ConfigPerformance(ctx, configID, since)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), perf.Spins)
+		assert.Equal(t, 20.0, perf.Wagered)
+		assert.Equal(t, 35.0, perf.Won)
+
+		sample, err := ch.ConfigSample(ctx, configID, since, since.Add(24*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), sample.Spins)
+		assert.Equal(t, 3.0, sample.SumReturns)
+		assert.Equal(t, 5.0, sample.SumSquaredReturns)
+	})
+
+	t.Run("should surface ClickHouse errors", func(t *testing.T) {
+		_, err := ch.ConfigPerformance(ctx, uuid.New(), since)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Syntax error")
+	})
+}
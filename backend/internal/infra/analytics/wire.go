@@ -0,0 +1,55 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/slotmachine/backend/domain/analytics"
+	"github.com/slotmachine/backend/internal/config"
+)
+
+// ProviderSet is the Wire provider set for the analytics store
+var ProviderSet = wire.NewSet(
+	ProvideClickHouse,
+	ProvideSink,
+	ProvideStore,
+)
+
+// ProvideClickHouse builds the configured ClickHouse client and creates its table, or nil when no sink is set
+func ProvideClickHouse(cfg *config.Config) (*ClickHouse, error) {
+	switch cfg.Analytics.Sink {
+	case "":
+		return nil, nil
+	case "clickhouse":
+		timeout := time.Duration(cfg.Analytics.TimeoutSeconds) * time.Second
+		ch := NewClickHouse(cfg.Analytics.ClickHouseURL, cfg.Analytics.ClickHouseDatabase, cfg.Analytics.ClickHouseUser, cfg.Analytics.ClickHousePassword, &http.Client{Timeout: timeout})
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		if err := ch.EnsureSchema(ctx); err != nil {
+			return nil, fmt.Errorf("failed to create ClickHouse spin events table: %w", err)
+		}
+		return ch, nil
+	default:
+		return nil, fmt.Errorf("unknown analytics sink %q", cfg.Analytics.Sink)
+	}
+}
+
+// ProvideSink provides the sink spin events are mirrored into, or nil when mirroring is disabled
+func ProvideSink(ch *ClickHouse) analytics.Sink {
+	if ch == nil {
+		return nil
+	}
+	return ch
+}
+
+// ProvideStore provides the store analytics queries run on, or nil to keep them on PostgreSQL
+func ProvideStore(cfg *config.Config, ch *ClickHouse) analytics.Store {
+	if ch == nil || !cfg.Analytics.ServeQueries {
+		return nil
+	}
+	return ch
+}
@@ -0,0 +1,84 @@
+package anchor
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/slotmachine/backend/domain/transparency"
+)
+
+// DefaultCalendarURL is the public OpenTimestamps calendar used when none is configured
+const DefaultCalendarURL = "https://alice.btc.calendar.opentimestamps.org"
+
+// maxTimestampBytes bounds the calendar response; pending timestamps are a few hundred bytes
+const maxTimestampBytes = 64 << 10
+
+// OpenTimestamps anchors checkpoint hashes in Bitcoin through an OpenTimestamps calendar
+// The returned reference is the base64 pending timestamp; `ots upgrade` completes it once
+// the calendar's Bitcoin transaction confirms.
+type OpenTimestamps struct {
+	calendarURL string
+	client      *http.Client
+}
+
+// NewOpenTimestamps creates an OpenTimestamps anchor for a calendar URL
+func NewOpenTimestamps(calendarURL string, client *http.Client) *OpenTimestamps {
+	if calendarURL == "" {
+		calendarURL = DefaultCalendarURL
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &OpenTimestamps{
+		calendarURL: strings.TrimRight(calendarURL, "/"),
+		client:      client,
+	}
+}
+
+// Ensure OpenTimestamps implements transparency.Anchor
+var _ transparency.Anchor = (*OpenTimestamps)(nil)
+
+// Name identifies the anchor
+func (o *OpenTimestamps) Name() string {
+	return "opentimestamps"
+}
+
+// Anchor posts the raw checkpoint hash to {calendarURL}/digest
+func (o *OpenTimestamps) Anchor(ctx context.Context, cp *transparency.Checkpoint) (string, error) {
+	digest, err := hex.DecodeString(cp.Hash)
+	if err != nil {
+		return "", fmt.Errorf("invalid checkpoint hash: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.calendarURL+"/digest", bytes.NewReader(digest))
+	if err != nil {
+		return "", fmt.Errorf("failed to build timestamp request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.opentimestamps.v1")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to submit timestamp: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("timestamp calendar returned status code %d", resp.StatusCode)
+	}
+
+	timestamp, err := io.ReadAll(io.LimitReader(resp.Body, maxTimestampBytes))
+	if err != nil {
+		return "", fmt.Errorf("failed to read timestamp: %w", err)
+	}
+	if len(timestamp) == 0 {
+		return "", fmt.Errorf("timestamp calendar returned an empty timestamp")
+	}
+	return base64.StdEncoding.EncodeToString(timestamp), nil
+}
@@ -0,0 +1,41 @@
+package anchor
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/slotmachine/backend/domain/transparency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenTimestamps_Anchor(t *testing.T) {
+	cp := &transparency.Checkpoint{Hash: transparency.GenesisHash}
+
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/digest", r.URL.Path)
+		received, _ = io.ReadAll(r.Body)
+		_, _ = w.Write([]byte("pending-timestamp"))
+	}))
+	defer server.Close()
+
+	ref, err := NewOpenTimestamps(server.URL, server.Client()).Anchor(context.Background(), cp)
+	require.NoError(t, err)
+	assert.Len(t, received, 32)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("pending-timestamp")), ref)
+}
+
+func TestOpenTimestamps_CalendarError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := NewOpenTimestamps(server.URL, server.Client()).Anchor(context.Background(), &transparency.Checkpoint{Hash: transparency.GenesisHash})
+	assert.Error(t, err)
+}
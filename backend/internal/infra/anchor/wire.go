@@ -0,0 +1,29 @@
+package anchor
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/slotmachine/backend/domain/transparency"
+	"github.com/slotmachine/backend/internal/config"
+)
+
+// ProviderSet is the Wire provider set for transparency anchors
+var ProviderSet = wire.NewSet(
+	ProvideAnchor,
+)
+
+// ProvideAnchor builds the configured transparency anchor, or nil when anchoring is disabled
+func ProvideAnchor(cfg *config.Config) (transparency.Anchor, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	switch cfg.Transparency.Anchor {
+	case "":
+		return nil, nil
+	case "opentimestamps":
+		return NewOpenTimestamps(cfg.Transparency.AnchorURL, client), nil
+	default:
+		return nil, fmt.Errorf("unknown transparency anchor %q", cfg.Transparency.Anchor)
+	}
+}
@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/balance"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// BalanceEventsChannel is the pub/sub channel balance events are shared on between instances
+const BalanceEventsChannel = "balance:events"
+
+// balanceSubscriberBuffer is how many events a slow subscriber may lag before events are dropped
+const balanceSubscriberBuffer = 16
+
+// BalanceEventStore implements balance.Store with one Redis pub/sub subscription per instance
+// fanned out to the instance's connected players, or in memory when Redis is unavailable
+type BalanceEventStore struct {
+	redis  *RedisClient
+	logger *logger.Logger
+
+	mu        sync.Mutex
+	listening bool
+	subs      map[uuid.UUID]map[chan *balance.Event]struct{}
+}
+
+// NewBalanceEventStore creates a new balance event store
+func NewBalanceEventStore(redis *RedisClient, log *logger.Logger) *BalanceEventStore {
+	return &BalanceEventStore{
+		redis:  redis,
+		logger: log,
+		subs:   make(map[uuid.UUID]map[chan *balance.Event]struct{}),
+	}
+}
+
+// Ensure BalanceEventStore implements balance.Store
+var _ balance.Store = (*BalanceEventStore)(nil)
+
+func (s *BalanceEventStore) useRedis() bool {
+	return s.redis != nil && s.redis.GetClient() != nil
+}
+
+// Publish sends the event to the player's subscribers on every instance
+func (s *BalanceEventStore) Publish(ctx context.Context, event *balance.Event) error {
+	if !s.useRedis() {
+		s.deliver(event)
+		return nil
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal balance event: %w", err)
+	}
+	if err := s.redis.GetClient().Publish(ctx, BalanceEventsChannel, data).Err(); err != nil {
+		return fmt.Errorf("failed to publish balance event: %w", err)
+	}
+	return nil
+}
+
+// Subscribe streams the player's events until ctx is cancelled
+// Events are dropped for subscribers that fall more than a buffer behind.
+func (s *BalanceEventStore) Subscribe(ctx context.Context, playerID uuid.UUID) (<-chan *balance.Event, error) {
+	if s.useRedis() {
+		if err := s.listen(); err != nil {
+			return nil, err
+		}
+	}
+
+	out := make(chan *balance.Event, balanceSubscriberBuffer)
+	s.mu.Lock()
+	if s.subs[playerID] == nil {
+		s.subs[playerID] = make(map[chan *balance.Event]struct{})
+	}
+	s.subs[playerID][out] = struct{}{}
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		delete(s.subs[playerID], out)
+		if len(s.subs[playerID]) == 0 {
+			delete(s.subs, playerID)
+		}
+		close(out)
+		s.mu.Unlock()
+	}()
+	return out, nil
+}
+
+// listen subscribes the instance to the shared channel on first use
+func (s *BalanceEventStore) listen() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listening {
+		return nil
+	}
+
+	pubsub := s.redis.GetClient().Subscribe(context.Background(), BalanceEventsChannel)
+	if _, err := pubsub.Receive(context.Background()); err != nil {
+		_ = pubsub.Close()
+		return fmt.Errorf("failed to subscribe to balance events: %w", err)
+	}
+	s.listening = true
+
+	go func() {
+		defer func() {
+			_ = pubsub.Close()
+			s.mu.Lock()
+			s.listening = false
+			s.mu.Unlock()
+		}()
+		for msg := range pubsub.Channel() {
+			var e balance.Event
+			if err := json.Unmarshal([]byte(msg.Payload), &e); err != nil {
+				s.logger.Warn().Err(err).Msg("Skipping malformed balance event")
+				continue
+			}
+			s.deliver(&e)
+		}
+	}()
+	return nil
+}
+
+// deliver sends the event to this instance's subscribers of the player
+func (s *BalanceEventStore) deliver(event *balance.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subs[event.PlayerID] {
+		select {
+		case sub <- event:
+		default:
+		}
+	}
+}
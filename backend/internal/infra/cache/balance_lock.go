@@ -0,0 +1,163 @@
+package cache
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// BalanceLockKeyPrefix is the Redis key prefix of per-player balance locks
+const BalanceLockKeyPrefix = "balance_lock:" // balance_lock:{player_id} -> holder token
+
+const (
+	// balanceLockPollMin and balanceLockPollMax bound the jittered wait between attempts on a held Redis lock
+	balanceLockPollMin = 5 * time.Millisecond
+	balanceLockPollMax = 50 * time.Millisecond
+	// balanceLockReleaseTimeout bounds the release, which runs even if the request was cancelled
+	balanceLockReleaseTimeout = 2 * time.Second
+)
+
+// releaseBalanceLock deletes the lock only while it still holds the caller's token,
+// so a holder whose lock expired cannot release its successor's
+var releaseBalanceLock = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// balanceQueue is the in-process line for one player's lock
+type balanceQueue struct {
+	slot    chan struct{}
+	waiters int
+}
+
+// BalanceLock implements player.Locker with a Redis lock per player
+// Requests on one instance first queue in process, so only the head of each line polls Redis.
+// Without Redis the in-process queue alone serializes the player's updates on this instance.
+type BalanceLock struct {
+	redis  *RedisClient
+	ttl    time.Duration
+	wait   time.Duration
+	logger *logger.Logger
+
+	mu     sync.Mutex
+	queues map[uuid.UUID]*balanceQueue
+}
+
+// NewBalanceLock creates a balance lock held for at most ttl and waited on for at most wait
+func NewBalanceLock(redis *RedisClient, ttl, wait time.Duration, log *logger.Logger) *BalanceLock {
+	return &BalanceLock{
+		redis:  redis,
+		ttl:    ttl,
+		wait:   wait,
+		logger: log,
+		queues: make(map[uuid.UUID]*balanceQueue),
+	}
+}
+
+// Ensure BalanceLock implements player.Locker
+var _ player.Locker = (*BalanceLock)(nil)
+
+func (l *BalanceLock) useRedis() bool {
+	return l.redis != nil && l.redis.GetClient() != nil
+}
+
+// Lock waits for the player's lock, in arrival order on this instance
+func (l *BalanceLock) Lock(ctx context.Context, playerID uuid.UUID) (func(), error) {
+	waitCtx, cancel := context.WithTimeout(ctx, l.wait)
+	defer cancel()
+
+	leave, err := l.queue(waitCtx, playerID)
+	if err != nil {
+		return nil, l.waitError(ctx)
+	}
+	if !l.useRedis() {
+		return leave, nil
+	}
+
+	key := BalanceLockKeyPrefix + playerID.String()
+	token := uuid.NewString()
+	for {
+		ok, err := l.redis.GetClient().SetNX(waitCtx, key, token, l.ttl).Result()
+		if err != nil && waitCtx.Err() == nil {
+			// The optimistic lock still guards the balance; carry on with this instance's queue alone
+			l.logger.Warn().Err(err).Str("player_id", playerID.String()).Msg("Failed to take Redis balance lock")
+			return leave, nil
+		}
+		if ok {
+			return func() {
+				l.release(ctx, key, token)
+				leave()
+			}, nil
+		}
+
+		timer := time.NewTimer(balanceLockPollMin + rand.N(balanceLockPollMax-balanceLockPollMin))
+		select {
+		case <-timer.C:
+		case <-waitCtx.Done():
+			timer.Stop()
+			leave()
+			return nil, l.waitError(ctx)
+		}
+	}
+}
+
+// queue waits for the player's turn on this instance and returns the function giving it up
+func (l *BalanceLock) queue(ctx context.Context, playerID uuid.UUID) (func(), error) {
+	l.mu.Lock()
+	q, ok := l.queues[playerID]
+	if !ok {
+		q = &balanceQueue{slot: make(chan struct{}, 1)}
+		l.queues[playerID] = q
+	}
+	q.waiters++
+	l.mu.Unlock()
+
+	done := func() {
+		l.mu.Lock()
+		q.waiters--
+		if q.waiters == 0 {
+			delete(l.queues, playerID)
+		}
+		l.mu.Unlock()
+	}
+
+	select {
+	case q.slot <- struct{}{}:
+		var once sync.Once
+		return func() {
+			once.Do(func() {
+				<-q.slot
+				done()
+			})
+		}, nil
+	case <-ctx.Done():
+		done()
+		return nil, ctx.Err()
+	}
+}
+
+// release deletes the Redis lock if this holder still owns it
+func (l *BalanceLock) release(ctx context.Context, key, token string) {
+	releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), balanceLockReleaseTimeout)
+	defer cancel()
+	if err := releaseBalanceLock.Run(releaseCtx, l.redis.GetClient(), []string{key}, token).Err(); err != nil {
+		// The lock expires on its own after its TTL
+		l.logger.Warn().Err(err).Str("key", key).Msg("Failed to release Redis balance lock")
+	}
+}
+
+// waitError reports a wait that ran out as ErrBalanceBusy, and a cancelled request as itself
+func (l *BalanceLock) waitError(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return player.ErrBalanceBusy
+}
@@ -0,0 +1,281 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/slotmachine/backend/domain/dashboard"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// Dashboard Redis keys
+const (
+	DashboardSpinsKey          = "dashboard:spins:"          // + unix minute: spins settled in the minute
+	DashboardRequestsKey       = "dashboard:requests:"       // + unix minute: spin requests in the minute
+	DashboardErrorsKey         = "dashboard:errors:"         // + unix minute: spin requests that failed in the minute
+	DashboardDayKey            = "dashboard:day:"            // + UTC date: hash of spins, wagered and won
+	DashboardBigWinKey         = "dashboard:big_win:"        // + UTC date: sorted set holding the biggest win
+	DashboardActiveSessionsKey = "dashboard:active_sessions" // Sorted set of session IDs scored by their last spin
+)
+
+// dashboardMinuteTTL keeps minute counters long enough for any rate window
+const dashboardMinuteTTL = 2 * time.Hour
+
+// dashboardDayTTL keeps a day's totals until the day after it ended
+const dashboardDayTTL = 48 * time.Hour
+
+// DashboardStore implements dashboard.Store with Redis counters
+// with an in-memory fallback, counting this instance only, when Redis is unavailable
+type DashboardStore struct {
+	redis  *RedisClient
+	logger *logger.Logger
+
+	mu       sync.Mutex
+	minutes  map[int64]*dashboardMinute
+	days     map[string]*dashboardDay
+	sessions map[uuid.UUID]time.Time
+}
+
+type dashboardMinute struct {
+	spins, requests, errors int64
+}
+
+type dashboardDay struct {
+	spins        int64
+	wagered, won float64
+	biggest      *dashboard.BigWin
+}
+
+// NewDashboardStore creates a new dashboard store
+func NewDashboardStore(redis *RedisClient, log *logger.Logger) *DashboardStore {
+	return &DashboardStore{
+		redis:    redis,
+		logger:   log,
+		minutes:  make(map[int64]*dashboardMinute),
+		days:     make(map[string]*dashboardDay),
+		sessions: make(map[uuid.UUID]time.Time),
+	}
+}
+
+// Ensure DashboardStore implements dashboard.Store
+var _ dashboard.Store = (*DashboardStore)(nil)
+
+func (s *DashboardStore) useRedis() bool {
+	return s.redis != nil && s.redis.GetClient() != nil
+}
+
+func dashboardMinuteOf(t time.Time) int64 {
+	return t.Unix() / 60
+}
+
+func dashboardDayOf(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
+
+// RecordSpin counts the spin in its minute and day, keeps the day's biggest win and marks its session active
+func (s *DashboardStore) RecordSpin(ctx context.Context, sample *dashboard.Sample, activeWindow time.Duration) error {
+	if !s.useRedis() {
+		s.recordSpinLocal(sample, activeWindow)
+		return nil
+	}
+
+	bigWin, err := json.Marshal(sample.BigWin)
+	if err != nil {
+		return fmt.Errorf("failed to marshal dashboard big win: %w", err)
+	}
+
+	minuteKey := DashboardSpinsKey + strconv.FormatInt(dashboardMinuteOf(sample.At), 10)
+	dayKey := DashboardDayKey + dashboardDayOf(sample.At)
+	bigWinKey := DashboardBigWinKey + dashboardDayOf(sample.At)
+
+	pipe := s.redis.GetClient().Pipeline()
+	pipe.Incr(ctx, minuteKey)
+	pipe.Expire(ctx, minuteKey, dashboardMinuteTTL)
+	pipe.HIncrBy(ctx, dayKey, "spins", 1)
+	pipe.HIncrByFloat(ctx, dayKey, "wagered", sample.Stake)
+	pipe.HIncrByFloat(ctx, dayKey, "won", sample.Win)
+	pipe.Expire(ctx, dayKey, dashboardDayTTL)
+	if sample.Win > 0 {
+		// Only the top entry is kept, so the set holds the biggest win so far
+		pipe.ZAdd(ctx, bigWinKey, redis.Z{Score: sample.Win, Member: bigWin})
+		pipe.ZRemRangeByRank(ctx, bigWinKey, 0, -2)
+		pipe.Expire(ctx, bigWinKey, dashboardDayTTL)
+	}
+	pipe.ZAdd(ctx, DashboardActiveSessionsKey, redis.Z{Score: float64(sample.At.Unix()), Member: sample.SessionID.String()})
+	pipe.ZRemRangeByScore(ctx, DashboardActiveSessionsKey, "-inf", "("+strconv.FormatInt(sample.At.Add(-activeWindow).Unix(), 10))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record dashboard spin: %w", err)
+	}
+	return nil
+}
+
+// RecordRequest counts a spin request, and a failure if it failed, in its minute
+func (s *DashboardStore) RecordRequest(ctx context.Context, at time.Time, failed bool) error {
+	minute := strconv.FormatInt(dashboardMinuteOf(at), 10)
+	if !s.useRedis() {
+		s.recordRequestLocal(at, failed)
+		return nil
+	}
+
+	pipe := s.redis.GetClient().Pipeline()
+	pipe.Incr(ctx, DashboardRequestsKey+minute)
+	pipe.Expire(ctx, DashboardRequestsKey+minute, dashboardMinuteTTL)
+	if failed {
+		pipe.Incr(ctx, DashboardErrorsKey+minute)
+		pipe.Expire(ctx, DashboardErrorsKey+minute, dashboardMinuteTTL)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record dashboard spin request: %w", err)
+	}
+	return nil
+}
+
+// Counters reads the counters of the whole minutes in [now-window, now) and of now's UTC day
+func (s *DashboardStore) Counters(ctx context.Context, now time.Time, window, activeWindow time.Duration) (*dashboard.Counters, error) {
+	if !s.useRedis() {
+		return s.countersLocal(now, window, activeWindow), nil
+	}
+
+	last := dashboardMinuteOf(now)
+	first := last - int64(window/time.Minute)
+	var spinKeys, requestKeys, errorKeys []string
+	for m := first; m < last; m++ {
+		minute := strconv.FormatInt(m, 10)
+		spinKeys = append(spinKeys, DashboardSpinsKey+minute)
+		requestKeys = append(requestKeys, DashboardRequestsKey+minute)
+		errorKeys = append(errorKeys, DashboardErrorsKey+minute)
+	}
+
+	client := s.redis.GetClient()
+	pipe := client.Pipeline()
+	spins := pipe.MGet(ctx, spinKeys...)
+	requests := pipe.MGet(ctx, requestKeys...)
+	errs := pipe.MGet(ctx, errorKeys...)
+	day := pipe.HGetAll(ctx, DashboardDayKey+dashboardDayOf(now))
+	bigWin := pipe.ZRevRange(ctx, DashboardBigWinKey+dashboardDayOf(now), 0, 0)
+	active := pipe.ZCount(ctx, DashboardActiveSessionsKey, strconv.FormatInt(now.Add(-activeWindow).Unix(), 10), "+inf")
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("failed to read dashboard counters: %w", err)
+	}
+
+	counters := &dashboard.Counters{
+		ActiveSessions: active.Val(),
+		WindowSpins:    sumCounts(spins.Val()),
+		WindowRequests: sumCounts(requests.Val()),
+		WindowErrors:   sumCounts(errs.Val()),
+	}
+	totals := day.Val()
+	counters.DaySpins, _ = strconv.ParseInt(totals["spins"], 10, 64)
+	counters.DayWagered, _ = strconv.ParseFloat(totals["wagered"], 64)
+	counters.DayWon, _ = strconv.ParseFloat(totals["won"], 64)
+	if top := bigWin.Val(); len(top) > 0 {
+		var win dashboard.BigWin
+		if err := json.Unmarshal([]byte(top[0]), &win); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal dashboard big win: %w", err)
+		}
+		counters.BiggestWin = &win
+	}
+	return counters, nil
+}
+
+// sumCounts adds up MGET results, skipping minutes without a counter
+func sumCounts(values []interface{}) int64 {
+	var total int64
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			n, _ := strconv.ParseInt(s, 10, 64)
+			total += n
+		}
+	}
+	return total
+}
+
+func (s *DashboardStore) minuteLocal(at time.Time) *dashboardMinute {
+	m := dashboardMinuteOf(at)
+	counter, ok := s.minutes[m]
+	if !ok {
+		counter = &dashboardMinute{}
+		s.minutes[m] = counter
+		// Drop minutes past any rate window as new ones start
+		for old := range s.minutes {
+			if old <= m-int64(dashboardMinuteTTL/time.Minute) {
+				delete(s.minutes, old)
+			}
+		}
+	}
+	return counter
+}
+
+func (s *DashboardStore) recordSpinLocal(sample *dashboard.Sample, activeWindow time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.minuteLocal(sample.At).spins++
+
+	key := dashboardDayOf(sample.At)
+	day, ok := s.days[key]
+	if !ok {
+		day = &dashboardDay{}
+		s.days[key] = day
+		for old := range s.days {
+			if old < dashboardDayOf(sample.At.Add(-dashboardDayTTL)) {
+				delete(s.days, old)
+			}
+		}
+	}
+	day.spins++
+	day.wagered += sample.Stake
+	day.won += sample.Win
+	if sample.Win > 0 && (day.biggest == nil || sample.Win > day.biggest.TotalWin) {
+		day.biggest = sample.BigWin
+	}
+
+	s.sessions[sample.SessionID] = sample.At
+	for id, last := range s.sessions {
+		if last.Before(sample.At.Add(-activeWindow)) {
+			delete(s.sessions, id)
+		}
+	}
+}
+
+func (s *DashboardStore) recordRequestLocal(at time.Time, failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counter := s.minuteLocal(at)
+	counter.requests++
+	if failed {
+		counter.errors++
+	}
+}
+
+func (s *DashboardStore) countersLocal(now time.Time, window, activeWindow time.Duration) *dashboard.Counters {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counters := &dashboard.Counters{}
+	last := dashboardMinuteOf(now)
+	for m := last - int64(window/time.Minute); m < last; m++ {
+		if counter, ok := s.minutes[m]; ok {
+			counters.WindowSpins += counter.spins
+			counters.WindowRequests += counter.requests
+			counters.WindowErrors += counter.errors
+		}
+	}
+	if day, ok := s.days[dashboardDayOf(now)]; ok {
+		counters.DaySpins, counters.DayWagered, counters.DayWon = day.spins, day.wagered, day.won
+		counters.BiggestWin = day.biggest
+	}
+	for _, lastSpin := range s.sessions {
+		if !lastSpin.Before(now.Add(-activeWindow)) {
+			counters.ActiveSessions++
+		}
+	}
+	return counters
+}
@@ -0,0 +1,148 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/notification"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// NotificationEventsChannel is the pub/sub channel notification events are shared on between instances
+const NotificationEventsChannel = "notification:events"
+
+// notificationSubscriberBuffer is how many events a slow subscriber may lag before events are dropped
+const notificationSubscriberBuffer = 16
+
+// NotificationEventStore implements notification.Store with one Redis pub/sub subscription per
+// instance fanned out to the instance's connected players, or in memory when Redis is unavailable
+type NotificationEventStore struct {
+	redis  *RedisClient
+	logger *logger.Logger
+
+	mu        sync.Mutex
+	listening bool
+	subs      map[uuid.UUID]map[chan *notification.Event]struct{}
+}
+
+// NewNotificationEventStore creates a new notification event store
+func NewNotificationEventStore(redis *RedisClient, log *logger.Logger) *NotificationEventStore {
+	return &NotificationEventStore{
+		redis:  redis,
+		logger: log,
+		subs:   make(map[uuid.UUID]map[chan *notification.Event]struct{}),
+	}
+}
+
+// Ensure NotificationEventStore implements notification.Store
+var _ notification.Store = (*NotificationEventStore)(nil)
+
+func (s *NotificationEventStore) useRedis() bool {
+	return s.redis != nil && s.redis.GetClient() != nil
+}
+
+// Publish sends the event to the player's subscribers, or every subscriber for a broadcast, on every instance
+func (s *NotificationEventStore) Publish(ctx context.Context, event *notification.Event) error {
+	if !s.useRedis() {
+		s.deliver(event)
+		return nil
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification event: %w", err)
+	}
+	if err := s.redis.GetClient().Publish(ctx, NotificationEventsChannel, data).Err(); err != nil {
+		return fmt.Errorf("failed to publish notification event: %w", err)
+	}
+	return nil
+}
+
+// Subscribe streams the player's events until ctx is cancelled
+// Events are dropped for subscribers that fall more than a buffer behind.
+func (s *NotificationEventStore) Subscribe(ctx context.Context, playerID uuid.UUID) (<-chan *notification.Event, error) {
+	if s.useRedis() {
+		if err := s.listen(); err != nil {
+			return nil, err
+		}
+	}
+
+	out := make(chan *notification.Event, notificationSubscriberBuffer)
+	s.mu.Lock()
+	if s.subs[playerID] == nil {
+		s.subs[playerID] = make(map[chan *notification.Event]struct{})
+	}
+	s.subs[playerID][out] = struct{}{}
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		delete(s.subs[playerID], out)
+		if len(s.subs[playerID]) == 0 {
+			delete(s.subs, playerID)
+		}
+		close(out)
+		s.mu.Unlock()
+	}()
+	return out, nil
+}
+
+// listen subscribes the instance to the shared channel on first use
+func (s *NotificationEventStore) listen() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listening {
+		return nil
+	}
+
+	pubsub := s.redis.GetClient().Subscribe(context.Background(), NotificationEventsChannel)
+	if _, err := pubsub.Receive(context.Background()); err != nil {
+		_ = pubsub.Close()
+		return fmt.Errorf("failed to subscribe to notification events: %w", err)
+	}
+	s.listening = true
+
+	go func() {
+		defer func() {
+			_ = pubsub.Close()
+			s.mu.Lock()
+			s.listening = false
+			s.mu.Unlock()
+		}()
+		for msg := range pubsub.Channel() {
+			var e notification.Event
+			if err := json.Unmarshal([]byte(msg.Payload), &e); err != nil || e.Notification == nil {
+				s.logger.Warn().Err(err).Msg("Skipping malformed notification event")
+				continue
+			}
+			s.deliver(&e)
+		}
+	}()
+	return nil
+}
+
+// deliver sends the event to this instance's subscribers of the player, or to all of them for a broadcast
+func (s *NotificationEventStore) deliver(event *notification.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if event.Notification.Broadcast() {
+		for _, subs := range s.subs {
+			sendNotificationEvent(subs, event)
+		}
+		return
+	}
+	sendNotificationEvent(s.subs[*event.Notification.PlayerID], event)
+}
+
+func sendNotificationEvent(subs map[chan *notification.Event]struct{}, event *notification.Event) {
+	for sub := range subs {
+		select {
+		case sub <- event:
+		default:
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Processing status values
+const (
+	ProcessingStatusProcessing = "processing"
+	ProcessingStatusCompleted  = "completed"
+	ProcessingStatusFailed     = "failed"
+)
+
+// Redis key prefix for processing status
+const (
+	ProcessingStatusKeyPrefix = "chunked_upload_status:"
+	ProcessingStatusTTL       = 1 * time.Hour // TTL for processing status in Redis
+)
+
+// ProcessingStatus tracks the status of background file processing
+type ProcessingStatus struct {
+	UploadID    string          `json:"upload_id"`
+	Status      string          `json:"status"`   // "processing", "completed", "failed"
+	Progress    int             `json:"progress"` // 0-100
+	Message     string          `json:"message,omitempty"`
+	Error       string          `json:"error,omitempty"`
+	Result      json.RawMessage `json:"result,omitempty"`
+	StartedAt   time.Time       `json:"started_at"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty"`
+}
+
+// ProcessingStatusStore persists background processing status in Redis
+// with an in-memory fallback when Redis is unavailable
+type ProcessingStatusStore struct {
+	redis        *RedisClient
+	processing   map[string]*ProcessingStatus
+	processingMu sync.RWMutex
+}
+
+// NewProcessingStatusStore creates a new processing status store
+func NewProcessingStatusStore(redis *RedisClient) *ProcessingStatusStore {
+	store := &ProcessingStatusStore{
+		redis:      redis,
+		processing: make(map[string]*ProcessingStatus),
+	}
+
+	go store.cleanupCompleted()
+
+	return store
+}
+
+// cleanupCompleted periodically removes completed processing statuses from in-memory fallback
+func (s *ProcessingStatusStore) cleanupCompleted() {
+	ticker := time.NewTicker(10 * time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.processingMu.Lock()
+		now := time.Now()
+		for id, status := range s.processing {
+			// Remove completed/failed statuses older than 30 minutes
+			if status.CompletedAt != nil && now.Sub(*status.CompletedAt) > 30*time.Minute {
+				delete(s.processing, id)
+			}
+		}
+		s.processingMu.Unlock()
+	}
+}
+
+// Save saves processing status to Redis (with in-memory fallback)
+func (s *ProcessingStatusStore) Save(ctx context.Context, status *ProcessingStatus) error {
+	// Try Redis first
+	if s.redis != nil {
+		data, err := json.Marshal(status)
+		if err != nil {
+			return fmt.Errorf("failed to marshal status: %w", err)
+		}
+		key := ProcessingStatusKeyPrefix + status.UploadID
+		if err := s.redis.Set(ctx, key, string(data), ProcessingStatusTTL); err == nil {
+			return nil
+		}
+		// Fall through to in-memory if Redis fails
+	}
+
+	// Fallback to in-memory storage
+	s.processingMu.Lock()
+	// Deep copy the status to avoid race conditions
+	statusCopy := *status
+	s.processing[status.UploadID] = &statusCopy
+	s.processingMu.Unlock()
+	return nil
+}
+
+// Get retrieves processing status from Redis (with in-memory fallback)
+// Returns nil, nil when no status exists for the ID
+func (s *ProcessingStatusStore) Get(ctx context.Context, uploadID string) (*ProcessingStatus, error) {
+	// Try Redis first
+	if s.redis != nil {
+		key := ProcessingStatusKeyPrefix + uploadID
+		data, err := s.redis.Get(ctx, key)
+		if err == nil && data != "" {
+			var status ProcessingStatus
+			if err := json.Unmarshal([]byte(data), &status); err == nil {
+				return &status, nil
+			}
+		}
+		// Fall through to in-memory if Redis fails or not found
+	}
+
+	// Fallback to in-memory storage
+	s.processingMu.RLock()
+	status, exists := s.processing[uploadID]
+	s.processingMu.RUnlock()
+
+	if !exists {
+		return nil, nil
+	}
+
+	// Return a copy to avoid race conditions
+	statusCopy := *status
+	return &statusCopy, nil
+}
+
+// Update applies updateFn to the stored status and saves it back
+func (s *ProcessingStatusStore) Update(ctx context.Context, uploadID string, updateFn func(*ProcessingStatus)) error {
+	status, err := s.Get(ctx, uploadID)
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		status = &ProcessingStatus{
+			UploadID:  uploadID,
+			Status:    ProcessingStatusProcessing,
+			StartedAt: time.Now(),
+		}
+	}
+
+	updateFn(status)
+
+	return s.Save(ctx, status)
+}
@@ -306,6 +306,7 @@ type TrialSessionData struct {
 	TotalWon       float64 `json:"total_won"`
 	CreatedAt      int64   `json:"created_at"`
 	LastActivityAt int64   `json:"last_activity_at"`
+	LastResetAt    int64   `json:"last_reset_at,omitempty"`
 	ExpiresAt      int64   `json:"expires_at"`
 }
 
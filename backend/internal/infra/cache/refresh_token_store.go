@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/slotmachine/backend/domain/session"
+)
+
+// Refresh token Redis key prefixes
+const (
+	RefreshTokenKeyPrefix      = "refresh_token:"          // refresh_token:{token_hash} -> record
+	RefreshTokenUsedKeyPrefix  = "refresh_token_used:"     // refresh_token_used:{token_hash} -> rotation marker
+	RefreshSessionKeyPrefix    = "refresh_session:"        // refresh_session:{session_id} -> family_id
+	RefreshRevokedFamilyPrefix = "refresh_revoked:"        // refresh_revoked:{family_id} -> revocation marker
+	RefreshRevokedPlayerPrefix = "refresh_revoked_player:" // refresh_revoked_player:{player_id} -> unix millis
+)
+
+// RefreshTokenStore implements session.RefreshTokenStore using Redis
+type RefreshTokenStore struct {
+	client *RedisClient
+}
+
+// NewRefreshTokenStore creates a new Redis refresh token store
+func NewRefreshTokenStore(client *RedisClient) *RefreshTokenStore {
+	return &RefreshTokenStore{client: client}
+}
+
+// Ensure RefreshTokenStore implements session.RefreshTokenStore
+var _ session.RefreshTokenStore = (*RefreshTokenStore)(nil)
+
+// Save stores a token record under its hash until the record expires, indexed by its login session
+func (s *RefreshTokenStore) Save(ctx context.Context, tokenHash string, token *session.RefreshToken) error {
+	ttl := time.Until(token.ExpiresAt)
+	if ttl <= 0 {
+		return session.ErrRefreshTokenInvalid
+	}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to marshal refresh token: %w", err)
+	}
+
+	pipe := s.client.GetClient().Pipeline()
+	pipe.Set(ctx, RefreshTokenKeyPrefix+tokenHash, data, ttl)
+	pipe.Set(ctx, RefreshSessionKeyPrefix+token.SessionID.String(), token.FamilyID, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to store refresh token: %w", err)
+	}
+	return nil
+}
+
+// Get returns the record of a token hash
+func (s *RefreshTokenStore) Get(ctx context.Context, tokenHash string) (*session.RefreshToken, error) {
+	val, err := s.client.GetClient().Get(ctx, RefreshTokenKeyPrefix+tokenHash).Result()
+	if err == redis.Nil {
+		return nil, session.ErrRefreshTokenInvalid
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	var token session.RefreshToken
+	if err := json.Unmarshal([]byte(val), &token); err != nil {
+		return nil, fmt.Errorf("failed to parse refresh token: %w", err)
+	}
+	return &token, nil
+}
+
+// MarkUsed sets the rotation marker with SETNX so only one refresh can rotate a token
+func (s *RefreshTokenStore) MarkUsed(ctx context.Context, tokenHash string, expiresAt time.Time) (bool, error) {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		ttl = time.Second
+	}
+	ok, err := s.client.GetClient().SetNX(ctx, RefreshTokenUsedKeyPrefix+tokenHash, 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to mark refresh token used: %w", err)
+	}
+	return ok, nil
+}
+
+// FamilyForSession returns the family of the token issued with a login session
+func (s *RefreshTokenStore) FamilyForSession(ctx context.Context, sessionID uuid.UUID) (string, error) {
+	family, err := s.client.GetClient().Get(ctx, RefreshSessionKeyPrefix+sessionID.String()).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get refresh token family: %w", err)
+	}
+	return family, nil
+}
+
+// RevokeFamily adds a token family to the revocation list until expiresAt
+func (s *RefreshTokenStore) RevokeFamily(ctx context.Context, familyID string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	if err := s.client.GetClient().Set(ctx, RefreshRevokedFamilyPrefix+familyID, 1, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+	return nil
+}
+
+// IsFamilyRevoked reports whether a token family is on the revocation list
+func (s *RefreshTokenStore) IsFamilyRevoked(ctx context.Context, familyID string) (bool, error) {
+	n, err := s.client.GetClient().Exists(ctx, RefreshRevokedFamilyPrefix+familyID).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check refresh token revocation: %w", err)
+	}
+	return n > 0, nil
+}
+
+// RevokePlayer records the revocation time for ttl, the longest a token issued before it can live
+func (s *RefreshTokenStore) RevokePlayer(ctx context.Context, playerID uuid.UUID, at time.Time, ttl time.Duration) error {
+	if err := s.client.GetClient().Set(ctx, RefreshRevokedPlayerPrefix+playerID.String(), at.UnixMilli(), ttl).Err(); err != nil {
+		return fmt.Errorf("failed to revoke player refresh tokens: %w", err)
+	}
+	return nil
+}
+
+// PlayerRevokedAt returns the latest RevokePlayer time, or the zero time
+func (s *RefreshTokenStore) PlayerRevokedAt(ctx context.Context, playerID uuid.UUID) (time.Time, error) {
+	val, err := s.client.GetClient().Get(ctx, RefreshRevokedPlayerPrefix+playerID.String()).Result()
+	if err == redis.Nil {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get player refresh token revocation: %w", err)
+	}
+	millis, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse player refresh token revocation: %w", err)
+	}
+	return time.UnixMilli(millis), nil
+}
@@ -0,0 +1,166 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/slotmachine/backend/domain/spinfeed"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// Spin feed Redis keys
+const (
+	SpinFeedKey     = "spin_feed"        // Capped list of recent entries, newest first
+	SpinFeedChannel = "spin_feed:events" // Pub/sub channel for new entries
+)
+
+// spinFeedSubscriberBuffer is how many entries a slow subscriber may lag before entries are dropped
+const spinFeedSubscriberBuffer = 64
+
+// SpinFeedStore implements spinfeed.Store with a Redis capped list and pub/sub
+// with an in-memory fallback when Redis is unavailable
+type SpinFeedStore struct {
+	redis  *RedisClient
+	logger *logger.Logger
+
+	mu      sync.Mutex
+	entries []*spinfeed.Entry
+	subs    map[chan *spinfeed.Entry]struct{}
+}
+
+// NewSpinFeedStore creates a new spin feed store
+func NewSpinFeedStore(redis *RedisClient, log *logger.Logger) *SpinFeedStore {
+	return &SpinFeedStore{
+		redis:  redis,
+		logger: log,
+		subs:   make(map[chan *spinfeed.Entry]struct{}),
+	}
+}
+
+// Ensure SpinFeedStore implements spinfeed.Store
+var _ spinfeed.Store = (*SpinFeedStore)(nil)
+
+func (s *SpinFeedStore) useRedis() bool {
+	return s.redis != nil && s.redis.GetClient() != nil
+}
+
+// Push adds the entry to the front of the feed, trims the feed to size and notifies subscribers
+func (s *SpinFeedStore) Push(ctx context.Context, entry *spinfeed.Entry, size int) error {
+	if !s.useRedis() {
+		s.pushLocal(entry, size)
+		return nil
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal spin feed entry: %w", err)
+	}
+
+	pipe := s.redis.GetClient().Pipeline()
+	pipe.LPush(ctx, SpinFeedKey, data)
+	pipe.LTrim(ctx, SpinFeedKey, 0, int64(size-1))
+	pipe.Publish(ctx, SpinFeedChannel, data)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to push spin feed entry: %w", err)
+	}
+	return nil
+}
+
+// Recent returns up to n entries, newest first
+func (s *SpinFeedStore) Recent(ctx context.Context, n int) ([]*spinfeed.Entry, error) {
+	if !s.useRedis() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		n = min(n, len(s.entries))
+		return append([]*spinfeed.Entry(nil), s.entries[:n]...), nil
+	}
+
+	values, err := s.redis.GetClient().LRange(ctx, SpinFeedKey, 0, int64(n-1)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spin feed: %w", err)
+	}
+
+	entries := make([]*spinfeed.Entry, 0, len(values))
+	for _, v := range values {
+		var e spinfeed.Entry
+		if err := json.Unmarshal([]byte(v), &e); err != nil {
+			s.logger.Warn().Err(err).Msg("Skipping malformed spin feed entry")
+			continue
+		}
+		entries = append(entries, &e)
+	}
+	return entries, nil
+}
+
+// Subscribe streams new entries until ctx is cancelled
+// Entries are dropped for subscribers that fall more than a buffer behind.
+func (s *SpinFeedStore) Subscribe(ctx context.Context) (<-chan *spinfeed.Entry, error) {
+	out := make(chan *spinfeed.Entry, spinFeedSubscriberBuffer)
+
+	if !s.useRedis() {
+		s.mu.Lock()
+		s.subs[out] = struct{}{}
+		s.mu.Unlock()
+
+		go func() {
+			<-ctx.Done()
+			s.mu.Lock()
+			delete(s.subs, out)
+			close(out)
+			s.mu.Unlock()
+		}()
+		return out, nil
+	}
+
+	pubsub := s.redis.GetClient().Subscribe(ctx, SpinFeedChannel)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("failed to subscribe to spin feed: %w", err)
+	}
+
+	go func() {
+		defer close(out)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				var e spinfeed.Entry
+				if err := json.Unmarshal([]byte(msg.Payload), &e); err != nil {
+					s.logger.Warn().Err(err).Msg("Skipping malformed spin feed event")
+					continue
+				}
+				select {
+				case out <- &e:
+				default:
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// pushLocal updates the in-memory feed used when Redis is unavailable
+func (s *SpinFeedStore) pushLocal(entry *spinfeed.Entry, size int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = append([]*spinfeed.Entry{entry}, s.entries...)
+	if len(s.entries) > size {
+		s.entries = s.entries[:size]
+	}
+	for sub := range s.subs {
+		select {
+		case sub <- entry:
+		default:
+		}
+	}
+}
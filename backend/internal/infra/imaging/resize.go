@@ -0,0 +1,109 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+)
+
+// Resize scales src to the given width, preserving aspect ratio.
+// Uses area averaging, which gives good quality for downscaling.
+// If width is <= 0 or not smaller than the source width, src is returned unchanged.
+func Resize(src image.Image, width int) image.Image {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	if width <= 0 || width >= srcW || srcH == 0 {
+		return src
+	}
+
+	height := ScaledHeight(srcW, srcH, width)
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+
+	// Each destination pixel covers a (xRatio x yRatio) box of source pixels
+	xRatio := float64(srcW) / float64(width)
+	yRatio := float64(srcH) / float64(height)
+
+	for dy := 0; dy < height; dy++ {
+		y0 := float64(dy) * yRatio
+		y1 := y0 + yRatio
+		for dx := 0; dx < width; dx++ {
+			x0 := float64(dx) * xRatio
+			x1 := x0 + xRatio
+
+			var r, g, b, a, total float64
+			for sy := int(y0); sy < int(ceil(y1)) && sy < srcH; sy++ {
+				wy := overlap(float64(sy), y0, y1)
+				for sx := int(x0); sx < int(ceil(x1)) && sx < srcW; sx++ {
+					w := wy * overlap(float64(sx), x0, x1)
+					if w <= 0 {
+						continue
+					}
+					// RGBA returns alpha-premultiplied 16-bit values
+					cr, cg, cb, ca := src.At(bounds.Min.X+sx, bounds.Min.Y+sy).RGBA()
+					r += float64(cr) * w
+					g += float64(cg) * w
+					b += float64(cb) * w
+					a += float64(ca) * w
+					total += w
+				}
+			}
+
+			if total == 0 || a == 0 {
+				dst.SetNRGBA(dx, dy, color.NRGBA{})
+				continue
+			}
+
+			// Un-premultiply and scale down to 8-bit
+			dst.SetNRGBA(dx, dy, color.NRGBA{
+				R: clamp8(r / a * 255),
+				G: clamp8(g / a * 255),
+				B: clamp8(b / a * 255),
+				A: clamp8(a / total / 257),
+			})
+		}
+	}
+
+	return dst
+}
+
+// ScaledHeight returns the height matching width while preserving the srcW:srcH aspect ratio
+func ScaledHeight(srcW, srcH, width int) int {
+	height := (srcH*width + srcW/2) / srcW
+	if height < 1 {
+		height = 1
+	}
+	return height
+}
+
+// overlap returns how much of the unit pixel starting at p falls inside [lo, hi)
+func overlap(p, lo, hi float64) float64 {
+	start := p
+	if lo > start {
+		start = lo
+	}
+	end := p + 1
+	if hi < end {
+		end = hi
+	}
+	if end <= start {
+		return 0
+	}
+	return end - start
+}
+
+func ceil(v float64) float64 {
+	i := float64(int(v))
+	if v > i {
+		return i + 1
+	}
+	return i
+}
+
+func clamp8(v float64) uint8 {
+	if v <= 0 {
+		return 0
+	}
+	if v >= 255 {
+		return 255
+	}
+	return uint8(v + 0.5)
+}
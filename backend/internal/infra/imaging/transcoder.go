@@ -0,0 +1,186 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // Register GIF decoder
+	_ "image/jpeg" // Register JPEG decoder
+	"image/png"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/slotmachine/backend/internal/config"
+)
+
+// Format is an output image format for optimized variants
+type Format string
+
+const (
+	FormatWebP Format = "webp"
+	FormatAVIF Format = "avif"
+)
+
+// VariantsDir is the folder (relative to the source image folder) where variants are stored
+const VariantsDir = "_variants"
+
+var (
+	// ErrEncoderUnavailable is returned when the encoder binary for a format is not installed
+	ErrEncoderUnavailable = errors.New("image encoder unavailable")
+
+	// ErrUnsupportedFormat is returned for unknown output formats
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+)
+
+// Transcoder decodes source images, resizes them and encodes WebP/AVIF variants.
+// Encoding is delegated to the cwebp and avifenc binaries since the standard
+// library has no encoders for these formats.
+type Transcoder struct {
+	encoders map[Format]string
+	quality  int
+}
+
+// NewTranscoder creates a new transcoder from imaging config
+func NewTranscoder(cfg *config.ImagingConfig) *Transcoder {
+	quality := cfg.Quality
+	if quality <= 0 || quality > 100 {
+		quality = 80
+	}
+	return &Transcoder{
+		encoders: map[Format]string{
+			FormatWebP: cfg.CWebPPath,
+			FormatAVIF: cfg.AvifEncPath,
+		},
+		quality: quality,
+	}
+}
+
+// Available reports whether the encoder for the format is installed
+func (t *Transcoder) Available(format Format) bool {
+	bin, ok := t.encoders[format]
+	if !ok || bin == "" {
+		return false
+	}
+	_, err := exec.LookPath(bin)
+	return err == nil
+}
+
+// Decode decodes a PNG, JPEG or GIF image
+func (t *Transcoder) Decode(r io.Reader) (image.Image, error) {
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	return img, nil
+}
+
+// Encode encodes img to the given format using the external encoder
+func (t *Transcoder) Encode(ctx context.Context, img image.Image, format Format) ([]byte, error) {
+	bin, ok := t.encoders[format]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+	if !t.Available(format) {
+		return nil, fmt.Errorf("%w: %s", ErrEncoderUnavailable, format)
+	}
+
+	tempDir, err := os.MkdirTemp("", "imaging-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	// Encoders read from disk - write a lossless PNG intermediate
+	inputPath := filepath.Join(tempDir, "input.png")
+	inputFile, err := os.Create(inputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create intermediate file: %w", err)
+	}
+	if err := png.Encode(inputFile, img); err != nil {
+		inputFile.Close()
+		return nil, fmt.Errorf("failed to write intermediate file: %w", err)
+	}
+	inputFile.Close()
+
+	outputPath := filepath.Join(tempDir, "output."+string(format))
+	quality := strconv.Itoa(t.quality)
+
+	var cmd *exec.Cmd
+	switch format {
+	case FormatWebP:
+		cmd = exec.CommandContext(ctx, bin, "-quiet", "-q", quality, inputPath, "-o", outputPath)
+	case FormatAVIF:
+		cmd = exec.CommandContext(ctx, bin, "-q", quality, inputPath, outputPath)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s encoder failed: %w: %s", format, err, strings.TrimSpace(stderr.String()))
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read encoded file: %w", err)
+	}
+	return data, nil
+}
+
+// IsTranscodable reports whether a stored file is a raster source image worth optimizing
+// WebP/AVIF sources are already optimized and SVGs are vector graphics, so both are skipped
+func IsTranscodable(fileName string) bool {
+	switch strings.ToLower(path.Ext(fileName)) {
+	case ".png", ".jpg", ".jpeg":
+		return true
+	default:
+		return false
+	}
+}
+
+// VariantPath builds the storage path for a variant of the image at srcPath
+// Format: {dir}/_variants/{name}@{width}w.{format}
+func VariantPath(srcPath string, width int, format Format) string {
+	dir, file := path.Split(srcPath)
+	name := strings.TrimSuffix(file, path.Ext(file))
+	return path.Join(dir, VariantsDir, fmt.Sprintf("%s@%dw.%s", name, width, format))
+}
+
+// ParseWidths parses a comma-separated list of widths, ignoring invalid entries
+// Returned widths are unique and sorted ascending
+func ParseWidths(s string) []int {
+	seen := make(map[int]bool)
+	var widths []int
+	for _, part := range strings.Split(s, ",") {
+		w, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || w <= 0 || seen[w] {
+			continue
+		}
+		seen[w] = true
+		widths = append(widths, w)
+	}
+	sort.Ints(widths)
+	return widths
+}
+
+// ParseFormats parses a comma-separated list of output formats, ignoring unknown entries
+func ParseFormats(s string) []Format {
+	seen := make(map[Format]bool)
+	var formats []Format
+	for _, part := range strings.Split(s, ",") {
+		f := Format(strings.ToLower(strings.TrimSpace(part)))
+		if (f != FormatWebP && f != FormatAVIF) || seen[f] {
+			continue
+		}
+		seen[f] = true
+		formats = append(formats, f)
+	}
+	return formats
+}
@@ -0,0 +1,66 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResize(t *testing.T) {
+	t.Run("should downscale preserving aspect ratio", func(t *testing.T) {
+		src := image.NewNRGBA(image.Rect(0, 0, 400, 200))
+		dst := Resize(src, 100)
+
+		assert.Equal(t, 100, dst.Bounds().Dx())
+		assert.Equal(t, 50, dst.Bounds().Dy())
+	})
+
+	t.Run("should not upscale", func(t *testing.T) {
+		src := image.NewNRGBA(image.Rect(0, 0, 100, 100))
+		dst := Resize(src, 200)
+
+		assert.Same(t, src, dst)
+	})
+
+	t.Run("should average solid color without drift", func(t *testing.T) {
+		src := image.NewNRGBA(image.Rect(0, 0, 30, 30))
+		fill := color.NRGBA{R: 200, G: 100, B: 50, A: 255}
+		for y := 0; y < 30; y++ {
+			for x := 0; x < 30; x++ {
+				src.SetNRGBA(x, y, fill)
+			}
+		}
+
+		dst := Resize(src, 7).(*image.NRGBA)
+
+		assert.Equal(t, fill, dst.NRGBAAt(3, 3))
+	})
+
+	t.Run("should keep fully transparent pixels transparent", func(t *testing.T) {
+		src := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+		dst := Resize(src, 5).(*image.NRGBA)
+
+		assert.Equal(t, uint8(0), dst.NRGBAAt(0, 0).A)
+	})
+}
+
+func TestVariantPath(t *testing.T) {
+	assert.Equal(t, "backgrounds/_variants/main@960w.webp", VariantPath("backgrounds/main.png", 960, FormatWebP))
+	assert.Equal(t, "_variants/logo@480w.avif", VariantPath("logo.jpg", 480, FormatAVIF))
+}
+
+func TestIsTranscodable(t *testing.T) {
+	assert.True(t, IsTranscodable("tiles/a.PNG"))
+	assert.True(t, IsTranscodable("bg.jpeg"))
+	assert.False(t, IsTranscodable("bg.webp"))
+	assert.False(t, IsTranscodable("icon.svg"))
+	assert.False(t, IsTranscodable("sheet.json"))
+}
+
+func TestParseWidthsAndFormats(t *testing.T) {
+	assert.Equal(t, []int{480, 960, 1920}, ParseWidths("1920, 480,bad,960,-1,480"))
+	assert.Equal(t, []Format{FormatWebP, FormatAVIF}, ParseFormats("WEBP,avif,png,webp"))
+	assert.Empty(t, ParseFormats(""))
+}
@@ -0,0 +1,116 @@
+package kycprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/slotmachine/backend/domain/kyc"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/infra/notifier"
+)
+
+// StartRequest is the JSON body posted to the provider to start a verification
+type StartRequest struct {
+	PlayerID string `json:"player_id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// StatusResponse is the provider's JSON report on a verification
+type StatusResponse struct {
+	Status kyc.Status `json:"status"`
+}
+
+// HTTPProvider verifies players through a generic HTTP verification API
+// Requests are signed like outgoing webhooks: X-Signature carries the hex HMAC-SHA256 of the body
+// (of the path for GET requests, which have none).
+type HTTPProvider struct {
+	baseURL string
+	secret  string
+	client  *http.Client
+}
+
+// NewHTTPProvider creates a provider client for a base URL
+func NewHTTPProvider(baseURL, secret string, client *http.Client) *HTTPProvider {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPProvider{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		secret:  secret,
+		client:  client,
+	}
+}
+
+// Ensure HTTPProvider implements kyc.Provider
+var _ kyc.Provider = (*HTTPProvider)(nil)
+
+// Name identifies the provider
+func (p *HTTPProvider) Name() string {
+	return "http"
+}
+
+// Start posts the player to {baseURL}/verifications and returns the reference and hosted URL
+func (p *HTTPProvider) Start(ctx context.Context, pl *player.Player) (*kyc.Session, error) {
+	body, err := json.Marshal(StartRequest{PlayerID: pl.ID.String(), Username: pl.Username, Email: pl.Email})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode verification request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/verifications", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build verification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(notifier.SignatureHeader, notifier.Sign(p.secret, body))
+
+	var session kyc.Session
+	if err := p.do(req, &session); err != nil {
+		return nil, err
+	}
+	if session.Reference == "" {
+		return nil, fmt.Errorf("%w: response has no reference", kyc.ErrProviderUnavailable)
+	}
+	return &session, nil
+}
+
+// Status gets {baseURL}/verifications/{reference}
+func (p *HTTPProvider) Status(ctx context.Context, reference string) (kyc.Status, error) {
+	path := "/verifications/" + url.PathEscape(reference)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+path, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build verification request: %w", err)
+	}
+	req.Header.Set(notifier.SignatureHeader, notifier.Sign(p.secret, []byte(path)))
+
+	var resp StatusResponse
+	if err := p.do(req, &resp); err != nil {
+		return "", err
+	}
+	if !resp.Status.Valid() || resp.Status == kyc.StatusNone {
+		return "", fmt.Errorf("%w: unknown status %q", kyc.ErrProviderUnavailable, resp.Status)
+	}
+	return resp.Status, nil
+}
+
+// do sends req and decodes a 2xx JSON response into out
+func (p *HTTPProvider) do(req *http.Request, out interface{}) error {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", kyc.ErrProviderUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: unexpected status code %d", kyc.ErrProviderUnavailable, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("%w: invalid response: %v", kyc.ErrProviderUnavailable, err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package kycprovider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/kyc"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/infra/notifier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPProvider(t *testing.T) {
+	playerID := uuid.New()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/verifications" && r.Header.Get(notifier.SignatureHeader) == notifier.Sign("secret", body):
+			var req StartRequest
+			_ = json.Unmarshal(body, &req)
+			if req.PlayerID != playerID.String() {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(kyc.Session{Reference: "ref-1", URL: "https://kyc.example/ref-1"})
+		case r.Method == http.MethodGet && r.URL.Path == "/verifications/ref-1" && r.Header.Get(notifier.SignatureHeader) == notifier.Sign("secret", []byte(r.URL.Path)):
+			_ = json.NewEncoder(w).Encode(StatusResponse{Status: kyc.StatusVerified})
+		case r.Method == http.MethodGet && r.URL.Path == "/verifications/ref-bogus":
+			_ = json.NewEncoder(w).Encode(StatusResponse{Status: "approved"})
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer srv.Close()
+
+	p := NewHTTPProvider(srv.URL+"/", "secret", nil)
+
+	session, err := p.Start(context.Background(), &player.Player{ID: playerID, Username: "alice", Email: "alice@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "ref-1", session.Reference)
+	assert.Equal(t, "https://kyc.example/ref-1", session.URL)
+
+	status, err := p.Status(context.Background(), "ref-1")
+	require.NoError(t, err)
+	assert.Equal(t, kyc.StatusVerified, status)
+
+	_, err = p.Status(context.Background(), "ref-bogus")
+	assert.ErrorIs(t, err, kyc.ErrProviderUnavailable)
+
+	_, err = p.Status(context.Background(), "ref-missing")
+	assert.ErrorIs(t, err, kyc.ErrProviderUnavailable)
+}
+
+func TestProvideProvider(t *testing.T) {
+	p, err := ProvideProvider(&config.Config{})
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	p, err = ProvideProvider(&config.Config{KYC: config.KYCConfig{Provider: "http", ProviderURL: "http://kyc"}})
+	require.NoError(t, err)
+	assert.NotNil(t, p)
+
+	_, err = ProvideProvider(&config.Config{KYC: config.KYCConfig{Provider: "http"}})
+	assert.Error(t, err)
+
+	_, err = ProvideProvider(&config.Config{KYC: config.KYCConfig{Provider: "acme"}})
+	assert.Error(t, err)
+}
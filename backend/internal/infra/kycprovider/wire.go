@@ -0,0 +1,35 @@
+package kycprovider
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/slotmachine/backend/domain/kyc"
+	"github.com/slotmachine/backend/internal/config"
+)
+
+// ProviderSet is the Wire provider set for KYC providers
+var ProviderSet = wire.NewSet(
+	ProvideProvider,
+)
+
+// ProvideProvider builds the configured KYC provider, or nil when verification is decided by admins only
+func ProvideProvider(cfg *config.Config) (kyc.Provider, error) {
+	timeout := time.Duration(cfg.KYC.ProviderTimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	switch cfg.KYC.Provider {
+	case "":
+		return nil, nil
+	case "http":
+		if cfg.KYC.ProviderURL == "" {
+			return nil, fmt.Errorf("KYC_PROVIDER_URL must be set for the http KYC provider")
+		}
+		return NewHTTPProvider(cfg.KYC.ProviderURL, cfg.KYC.ProviderSecret, &http.Client{Timeout: timeout}), nil
+	default:
+		return nil, fmt.Errorf("unknown KYC provider %q", cfg.KYC.Provider)
+	}
+}
@@ -0,0 +1,153 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/slotmachine/backend/domain/approval"
+	"github.com/slotmachine/backend/domain/bigwin"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/shadow"
+)
+
+// Multi fans a big win out to several notifiers
+// Every notifier is attempted; the joined error reports the ones that failed.
+type Multi []bigwin.Notifier
+
+// Name lists the wrapped notifiers
+func (m Multi) Name() string {
+	names := make([]string, len(m))
+	for i, n := range m {
+		names[i] = n.Name()
+	}
+	return strings.Join(names, ",")
+}
+
+// Notify delivers the big win to every notifier
+func (m Multi) Notify(ctx context.Context, win *bigwin.BigWin) error {
+	var errs []error
+	for _, n := range m {
+		if err := n.Notify(ctx, win); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", n.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ChainAlertMulti fans a failed chain audit out to several notifiers
+type ChainAlertMulti []provablyfair.ChainAlertNotifier
+
+// Name lists the wrapped notifiers
+func (m ChainAlertMulti) Name() string {
+	names := make([]string, len(m))
+	for i, n := range m {
+		names[i] = n.Name()
+	}
+	return strings.Join(names, ",")
+}
+
+// NotifyChainFailure delivers the failed audit to every notifier
+func (m ChainAlertMulti) NotifyChainFailure(ctx context.Context, audit *provablyfair.ChainAudit) error {
+	var errs []error
+	for _, n := range m {
+		if err := n.NotifyChainFailure(ctx, audit); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", n.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DivergenceMulti fans a shadow engine divergence out to several notifiers
+type DivergenceMulti []shadow.AlertNotifier
+
+// Name lists the wrapped notifiers
+func (m DivergenceMulti) Name() string {
+	names := make([]string, len(m))
+	for i, n := range m {
+		names[i] = n.Name()
+	}
+	return strings.Join(names, ",")
+}
+
+// NotifyDivergence delivers the divergence to every notifier
+func (m DivergenceMulti) NotifyDivergence(ctx context.Context, divergence *shadow.Divergence) error {
+	var errs []error
+	for _, n := range m {
+		if err := n.NotifyDivergence(ctx, divergence); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", n.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ApprovalMulti fans a change request out to several notifiers
+type ApprovalMulti []approval.Notifier
+
+// Name lists the wrapped notifiers
+func (m ApprovalMulti) Name() string {
+	names := make([]string, len(m))
+	for i, n := range m {
+		names[i] = n.Name()
+	}
+	return strings.Join(names, ",")
+}
+
+// NotifyChangeRequest delivers the change request to every notifier
+func (m ApprovalMulti) NotifyChangeRequest(ctx context.Context, cr *approval.ChangeRequest) error {
+	var errs []error
+	for _, n := range m {
+		if err := n.NotifyChangeRequest(ctx, cr); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", n.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CanaryMulti fans a finished canary out to several notifiers
+type CanaryMulti []reelstrip.CanaryNotifier
+
+// Name lists the wrapped notifiers
+func (m CanaryMulti) Name() string {
+	names := make([]string, len(m))
+	for i, n := range m {
+		names[i] = n.Name()
+	}
+	return strings.Join(names, ",")
+}
+
+// NotifyCanary delivers the canary to every notifier
+func (m CanaryMulti) NotifyCanary(ctx context.Context, canary *reelstrip.Canary) error {
+	var errs []error
+	for _, n := range m {
+		if err := n.NotifyCanary(ctx, canary); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", n.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// RTPAlertMulti fans an RTP alert out to several notifiers
+type RTPAlertMulti []reelstrip.RTPAlertNotifier
+
+// Name lists the wrapped notifiers
+func (m RTPAlertMulti) Name() string {
+	names := make([]string, len(m))
+	for i, n := range m {
+		names[i] = n.Name()
+	}
+	return strings.Join(names, ",")
+}
+
+// NotifyRTPAlert delivers the RTP alert to every notifier
+func (m RTPAlertMulti) NotifyRTPAlert(ctx context.Context, alert *reelstrip.RTPAlert) error {
+	var errs []error
+	for _, n := range m {
+		if err := n.NotifyRTPAlert(ctx, alert); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", n.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,78 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/bigwin"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookNotifier_SignsPayload(t *testing.T) {
+	var body []byte
+	var signature string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		signature = r.Header.Get(SignatureHeader)
+	}))
+	defer srv.Close()
+
+	win := &bigwin.BigWin{ID: uuid.New(), SpinID: uuid.New(), Multiplier: 120, Proof: &bigwin.Proof{SpinHash: "h"}}
+	require.NoError(t, NewWebhookNotifier(srv.URL, "secret", nil).Notify(context.Background(), win))
+
+	assert.Equal(t, Sign("secret", body), signature)
+	var event WebhookEvent
+	require.NoError(t, json.Unmarshal(body, &event))
+	assert.Equal(t, "big_win", event.Event)
+	assert.Equal(t, win.SpinID, event.BigWin.SpinID)
+	assert.Equal(t, "h", event.BigWin.Proof.SpinHash)
+}
+
+func TestWebhookNotifier_FailsOnErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	err := NewWebhookNotifier(srv.URL, "", nil).Notify(context.Background(), &bigwin.BigWin{})
+	assert.Error(t, err)
+}
+
+type stubNotifier struct {
+	name  string
+	err   error
+	calls int
+}
+
+func (s *stubNotifier) Name() string { return s.name }
+
+func (s *stubNotifier) Notify(context.Context, *bigwin.BigWin) error {
+	s.calls++
+	return s.err
+}
+
+func TestMulti_NotifiesAll(t *testing.T) {
+	failing := &stubNotifier{name: "a", err: errors.New("down")}
+	ok := &stubNotifier{name: "b"}
+
+	err := Multi{failing, ok}.Notify(context.Background(), &bigwin.BigWin{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "a: down")
+	assert.Equal(t, 1, ok.calls, "a failing notifier should not stop the others")
+}
+
+func TestProvideBigWinNotifier(t *testing.T) {
+	assert.Nil(t, ProvideBigWinNotifier(&config.Config{}))
+
+	n := ProvideBigWinNotifier(&config.Config{BigWin: config.BigWinConfig{WebhookURL: "http://a", SlackWebhookURL: "http://b"}})
+	require.NotNil(t, n)
+	assert.Equal(t, "webhook,slack", n.Name())
+}
@@ -0,0 +1,136 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/slotmachine/backend/domain/approval"
+	"github.com/slotmachine/backend/domain/bigwin"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/shadow"
+)
+
+// SlackNotifier posts big wins to a Slack incoming webhook
+type SlackNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewSlackNotifier creates a Slack notifier for an incoming webhook URL
+func NewSlackNotifier(url string, client *http.Client) *SlackNotifier {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &SlackNotifier{url: url, client: client}
+}
+
+// Name identifies the notifier in logs
+func (n *SlackNotifier) Name() string {
+	return "slack"
+}
+
+// Notify posts a short message describing the big win
+func (n *SlackNotifier) Notify(ctx context.Context, win *bigwin.BigWin) error {
+	body, err := json.Marshal(map[string]string{"text": slackText(win)})
+	if err != nil {
+		return fmt.Errorf("failed to encode slack message: %w", err)
+	}
+	return post(ctx, n.client, n.url, body, nil)
+}
+
+// NotifyChainFailure posts a short message describing the failed PF chain audit
+func (n *SlackNotifier) NotifyChainFailure(ctx context.Context, audit *provablyfair.ChainAudit) error {
+	text := fmt.Sprintf(":rotating_light: PF hash chain audit failed for session `%s` (%d spins): %s",
+		audit.PFSessionID, audit.SpinCount, audit.Error)
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return fmt.Errorf("failed to encode slack message: %w", err)
+	}
+	return post(ctx, n.client, n.url, body, nil)
+}
+
+// NotifyDivergence posts a short message describing the shadow engine divergence
+func (n *SlackNotifier) NotifyDivergence(ctx context.Context, divergence *shadow.Divergence) error {
+	detail := "differs in " + strings.Join(divergence.Fields, ", ")
+	if divergence.Error != "" {
+		detail = "failed: " + divergence.Error
+	}
+	text := fmt.Sprintf(":warning: Shadow engine replay of spin `%s` %s", divergence.SpinID, detail)
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return fmt.Errorf("failed to encode slack message: %w", err)
+	}
+	return post(ctx, n.client, n.url, body, nil)
+}
+
+// NotifyChangeRequest posts a short message describing the change request and who acted on it
+func (n *SlackNotifier) NotifyChangeRequest(ctx context.Context, cr *approval.ChangeRequest) error {
+	var text string
+	switch cr.Status {
+	case approval.StatusPending:
+		text = fmt.Sprintf(":raised_hand: %s proposed a change awaiting approval: %s\nChange request `%s` · expires %s",
+			cr.ProposedByName, cr.Summary, cr.ID, cr.ExpiresAt.UTC().Format(time.RFC3339))
+	case approval.StatusExpired:
+		text = fmt.Sprintf(":hourglass: Change proposed by %s expired without review: %s\nChange request `%s`",
+			cr.ProposedByName, cr.Summary, cr.ID)
+	case approval.StatusFailed:
+		text = fmt.Sprintf(":x: Change approved by %s failed to apply: %s\n%s\nChange request `%s`",
+			cr.ReviewedByName, cr.Summary, cr.Error, cr.ID)
+	default:
+		text = fmt.Sprintf(":white_check_mark: %s %s a change proposed by %s: %s\nChange request `%s`",
+			cr.ReviewedByName, cr.Status, cr.ProposedByName, cr.Summary, cr.ID)
+	}
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return fmt.Errorf("failed to encode slack message: %w", err)
+	}
+	return post(ctx, n.client, n.url, body, nil)
+}
+
+// NotifyCanary posts a short message describing how the canary finished
+func (n *SlackNotifier) NotifyCanary(ctx context.Context, canary *reelstrip.Canary) error {
+	icon := ":white_check_mark:"
+	switch canary.Status {
+	case reelstrip.CanaryReverted:
+		icon = ":rotating_light:"
+	case reelstrip.CanaryAborted:
+		icon = ":no_entry_sign:"
+	}
+	text := fmt.Sprintf("%s Reel strip canary of config `%s` (%s, %d%% of players) %s: %s\nCanary `%s`",
+		icon, canary.ConfigID, canary.GameMode, canary.Percent, canary.Status, canary.Reason, canary.ID)
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return fmt.Errorf("failed to encode slack message: %w", err)
+	}
+	return post(ctx, n.client, n.url, body, nil)
+}
+
+// NotifyRTPAlert posts a short message comparing the config's realized RTP with its target
+func (n *SlackNotifier) NotifyRTPAlert(ctx context.Context, alert *reelstrip.RTPAlert) error {
+	text := fmt.Sprintf(":rotating_light: Realized RTP of config `%s` (%s) is %.2f%% against a %.2f%% target, outside the expected %.2f%%-%.2f%% (z = %.1f)\n%d spins, %.2f wagered, %.2f won since %s",
+		alert.ConfigName, alert.GameMode, alert.RealizedRTP, alert.TargetRTP, alert.LowerBound, alert.UpperBound, alert.ZScore,
+		alert.Spins, alert.Wagered, alert.Won, alert.WindowStart.Format(time.RFC3339))
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return fmt.Errorf("failed to encode slack message: %w", err)
+	}
+	return post(ctx, n.client, n.url, body, nil)
+}
+
+func slackText(win *bigwin.BigWin) string {
+	kind := "spin"
+	if win.IsFreeSpin {
+		kind = "free spin"
+	}
+	text := fmt.Sprintf(":tada: Big win %.2fx on a %s: won %.2f from a %.2f bet\nPlayer `%s` · Spin `%s`",
+		win.Multiplier, kind, win.WinAmount, win.BetAmount, win.PlayerID, win.SpinID)
+	if win.Proof != nil {
+		text += fmt.Sprintf("\nPF spin #%d · hash `%s`", win.Proof.SpinIndex, win.Proof.SpinHash)
+	}
+	return text
+}
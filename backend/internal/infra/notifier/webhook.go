@@ -0,0 +1,135 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/slotmachine/backend/domain/approval"
+	"github.com/slotmachine/backend/domain/bigwin"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/shadow"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the request body when a secret is configured
+const SignatureHeader = "X-Signature"
+
+// WebhookEvent is the JSON body posted to webhook endpoints
+type WebhookEvent struct {
+	Event         string                   `json:"event"`
+	BigWin        *bigwin.BigWin           `json:"big_win,omitempty"`
+	ChainAudit    *provablyfair.ChainAudit `json:"chain_audit,omitempty"`
+	Divergence    *shadow.Divergence       `json:"divergence,omitempty"`
+	ChangeRequest *approval.ChangeRequest  `json:"change_request,omitempty"`
+	Canary        *reelstrip.Canary        `json:"canary,omitempty"`
+	RTPAlert      *reelstrip.RTPAlert      `json:"rtp_alert,omitempty"`
+	Timestamp     time.Time                `json:"timestamp"`
+}
+
+// WebhookNotifier posts big wins as JSON to an HTTP endpoint
+type WebhookNotifier struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a webhook notifier; secret may be empty to disable signing
+func NewWebhookNotifier(url, secret string, client *http.Client) *WebhookNotifier {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &WebhookNotifier{url: url, secret: secret, client: client}
+}
+
+// Name identifies the notifier in logs
+func (n *WebhookNotifier) Name() string {
+	return "webhook"
+}
+
+// Notify posts the big win event to the webhook
+func (n *WebhookNotifier) Notify(ctx context.Context, win *bigwin.BigWin) error {
+	return n.send(ctx, WebhookEvent{Event: "big_win", BigWin: win, Timestamp: time.Now().UTC()})
+}
+
+// NotifyChainFailure posts the failed PF chain audit to the webhook
+func (n *WebhookNotifier) NotifyChainFailure(ctx context.Context, audit *provablyfair.ChainAudit) error {
+	return n.send(ctx, WebhookEvent{Event: "pf_chain_audit_failed", ChainAudit: audit, Timestamp: time.Now().UTC()})
+}
+
+// NotifyDivergence posts the shadow engine divergence to the webhook
+func (n *WebhookNotifier) NotifyDivergence(ctx context.Context, divergence *shadow.Divergence) error {
+	return n.send(ctx, WebhookEvent{Event: "shadow_engine_divergence", Divergence: divergence, Timestamp: time.Now().UTC()})
+}
+
+// NotifyChangeRequest posts the change request to the webhook, as an event named for its status
+func (n *WebhookNotifier) NotifyChangeRequest(ctx context.Context, cr *approval.ChangeRequest) error {
+	return n.send(ctx, WebhookEvent{Event: changeRequestEvent(cr), ChangeRequest: cr, Timestamp: time.Now().UTC()})
+}
+
+// changeRequestEvent names the event of a change request's status, e.g. change_request_approved
+func changeRequestEvent(cr *approval.ChangeRequest) string {
+	if cr.Status == approval.StatusPending {
+		return "change_request_proposed"
+	}
+	return "change_request_" + string(cr.Status)
+}
+
+// NotifyCanary posts the finished canary to the webhook, as an event named for its status
+func (n *WebhookNotifier) NotifyCanary(ctx context.Context, canary *reelstrip.Canary) error {
+	return n.send(ctx, WebhookEvent{Event: "reel_strip_canary_" + string(canary.Status), Canary: canary, Timestamp: time.Now().UTC()})
+}
+
+// NotifyRTPAlert posts the RTP alert to the webhook
+func (n *WebhookNotifier) NotifyRTPAlert(ctx context.Context, alert *reelstrip.RTPAlert) error {
+	return n.send(ctx, WebhookEvent{Event: "rtp_alert", RTPAlert: alert, Timestamp: time.Now().UTC()})
+}
+
+func (n *WebhookNotifier) send(ctx context.Context, event WebhookEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+
+	headers := map[string]string{}
+	if n.secret != "" {
+		headers[SignatureHeader] = Sign(n.secret, body)
+	}
+	return post(ctx, n.client, n.url, body, headers)
+}
+
+// Sign returns the hex HMAC-SHA256 of body, for receivers to verify webhook payloads
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// post sends a JSON body and treats any non-2xx response as a failure
+func post(ctx context.Context, client *http.Client, url string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
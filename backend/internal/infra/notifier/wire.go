@@ -0,0 +1,158 @@
+package notifier
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/slotmachine/backend/domain/approval"
+	"github.com/slotmachine/backend/domain/bigwin"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/shadow"
+	"github.com/slotmachine/backend/internal/config"
+)
+
+// ProviderSet is the Wire provider set for notifiers
+var ProviderSet = wire.NewSet(
+	ProvideBigWinNotifier,
+	ProvideChainAlertNotifier,
+	ProvideDivergenceNotifier,
+	ProvideApprovalNotifier,
+	ProvideCanaryNotifier,
+	ProvideRTPAlertNotifier,
+)
+
+// ProvideBigWinNotifier builds the big win notifier from config
+// It returns nil when no notifier is configured. Other transports (e.g. a message bus)
+// plug in by implementing bigwin.Notifier and joining the Multi here.
+func ProvideBigWinNotifier(cfg *config.Config) bigwin.Notifier {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	var notifiers Multi
+	if cfg.BigWin.WebhookURL != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(cfg.BigWin.WebhookURL, cfg.BigWin.WebhookSecret, client))
+	}
+	if cfg.BigWin.SlackWebhookURL != "" {
+		notifiers = append(notifiers, NewSlackNotifier(cfg.BigWin.SlackWebhookURL, client))
+	}
+
+	switch len(notifiers) {
+	case 0:
+		return nil
+	case 1:
+		return notifiers[0]
+	default:
+		return notifiers
+	}
+}
+
+// ProvideChainAlertNotifier builds the PF chain audit alert notifier from config, or nil when none is configured
+func ProvideChainAlertNotifier(cfg *config.Config) provablyfair.ChainAlertNotifier {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	var notifiers ChainAlertMulti
+	if cfg.ProvablyFair.AuditAlertWebhookURL != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(cfg.ProvablyFair.AuditAlertWebhookURL, cfg.ProvablyFair.AuditAlertWebhookSecret, client))
+	}
+	if cfg.ProvablyFair.AuditAlertSlackWebhookURL != "" {
+		notifiers = append(notifiers, NewSlackNotifier(cfg.ProvablyFair.AuditAlertSlackWebhookURL, client))
+	}
+
+	switch len(notifiers) {
+	case 0:
+		return nil
+	case 1:
+		return notifiers[0]
+	default:
+		return notifiers
+	}
+}
+
+// ProvideDivergenceNotifier builds the shadow engine divergence notifier from config, or nil when none is configured
+func ProvideDivergenceNotifier(cfg *config.Config) shadow.AlertNotifier {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	var notifiers DivergenceMulti
+	if cfg.Shadow.AlertWebhookURL != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(cfg.Shadow.AlertWebhookURL, cfg.Shadow.AlertWebhookSecret, client))
+	}
+	if cfg.Shadow.AlertSlackWebhookURL != "" {
+		notifiers = append(notifiers, NewSlackNotifier(cfg.Shadow.AlertSlackWebhookURL, client))
+	}
+
+	switch len(notifiers) {
+	case 0:
+		return nil
+	case 1:
+		return notifiers[0]
+	default:
+		return notifiers
+	}
+}
+
+// ProvideApprovalNotifier builds the change request notifier from config, or nil when none is configured
+func ProvideApprovalNotifier(cfg *config.Config) approval.Notifier {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	var notifiers ApprovalMulti
+	if cfg.Approval.WebhookURL != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(cfg.Approval.WebhookURL, cfg.Approval.WebhookSecret, client))
+	}
+	if cfg.Approval.SlackWebhookURL != "" {
+		notifiers = append(notifiers, NewSlackNotifier(cfg.Approval.SlackWebhookURL, client))
+	}
+
+	switch len(notifiers) {
+	case 0:
+		return nil
+	case 1:
+		return notifiers[0]
+	default:
+		return notifiers
+	}
+}
+
+// ProvideCanaryNotifier builds the reel strip canary notifier from config, or nil when none is configured
+func ProvideCanaryNotifier(cfg *config.Config) reelstrip.CanaryNotifier {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	var notifiers CanaryMulti
+	if cfg.Canary.AlertWebhookURL != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(cfg.Canary.AlertWebhookURL, cfg.Canary.AlertWebhookSecret, client))
+	}
+	if cfg.Canary.AlertSlackWebhookURL != "" {
+		notifiers = append(notifiers, NewSlackNotifier(cfg.Canary.AlertSlackWebhookURL, client))
+	}
+
+	switch len(notifiers) {
+	case 0:
+		return nil
+	case 1:
+		return notifiers[0]
+	default:
+		return notifiers
+	}
+}
+
+// ProvideRTPAlertNotifier builds the realized RTP alert notifier from config, or nil when none is configured
+func ProvideRTPAlertNotifier(cfg *config.Config) reelstrip.RTPAlertNotifier {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	var notifiers RTPAlertMulti
+	if cfg.RTPMonitor.AlertWebhookURL != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(cfg.RTPMonitor.AlertWebhookURL, cfg.RTPMonitor.AlertWebhookSecret, client))
+	}
+	if cfg.RTPMonitor.AlertSlackWebhookURL != "" {
+		notifiers = append(notifiers, NewSlackNotifier(cfg.RTPMonitor.AlertSlackWebhookURL, client))
+	}
+
+	switch len(notifiers) {
+	case 0:
+		return nil
+	case 1:
+		return notifiers[0]
+	default:
+		return notifiers
+	}
+}
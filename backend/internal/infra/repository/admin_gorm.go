@@ -12,13 +12,15 @@ import (
 
 // AdminGormRepository implements admin.Repository using GORM
 type AdminGormRepository struct {
-	db *gorm.DB
+	db    *gorm.DB
+	reads ReadSource
 }
 
 // NewAdminGormRepository creates a new GORM admin repository
 func NewAdminGormRepository(db *gorm.DB) admin.Repository {
 	return &AdminGormRepository{
-		db: db,
+		db:    db,
+		reads: primaryReads{db},
 	}
 }
 
@@ -107,7 +109,7 @@ func (r *AdminGormRepository) List(ctx context.Context, filters admin.ListFilter
 	var admins []*admin.Admin
 	var total int64
 
-	query := r.db.WithContext(ctx).
+	query := GetReadDBOrTx(ctx, r.reads).
 		Model(&admin.Admin{}).
 		Where("deleted_at IS NULL")
 
@@ -209,3 +211,166 @@ func (r *AdminGormRepository) ResetFailedAttempts(ctx context.Context, id uuid.U
 	}
 	return nil
 }
+
+// SetTOTPSecret stores a pending (not yet enabled) encrypted TOTP secret
+func (r *AdminGormRepository) SetTOTPSecret(ctx context.Context, id uuid.UUID, encryptedSecret string) error {
+	result := r.db.WithContext(ctx).
+		Model(&admin.Admin{}).
+		Where("id = ? AND two_factor_enabled = ?", id, false).
+		Update("totp_secret", encryptedSecret)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to set TOTP secret: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return admin.ErrTwoFactorAlreadyEnabled
+	}
+	return nil
+}
+
+// EnableTwoFactor turns 2FA on with the confirming code's step and replaces the recovery codes
+func (r *AdminGormRepository) EnableTwoFactor(ctx context.Context, id uuid.UUID, step int64, recoveryCodeHashes []string) error {
+	now := time.Now()
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&admin.Admin{}).
+			Where("id = ? AND two_factor_enabled = ?", id, false).
+			Updates(map[string]interface{}{
+				"two_factor_enabled":     true,
+				"two_factor_enabled_at":  now,
+				"two_factor_last_step":   step,
+				"two_factor_verified_at": now,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to enable two-factor: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return admin.ErrTwoFactorAlreadyEnabled
+		}
+		return replaceRecoveryCodes(tx, id, recoveryCodeHashes)
+	})
+}
+
+// DisableTwoFactor turns 2FA off, clearing the secret and recovery codes
+func (r *AdminGormRepository) DisableTwoFactor(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&admin.Admin{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"totp_secret":            nil,
+				"two_factor_enabled":     false,
+				"two_factor_enabled_at":  nil,
+				"two_factor_last_step":   0,
+				"two_factor_verified_at": nil,
+			}).Error; err != nil {
+			return fmt.Errorf("failed to disable two-factor: %w", err)
+		}
+		return replaceRecoveryCodes(tx, id, nil)
+	})
+}
+
+// AcceptTOTPStep records a verified TOTP step if it is newer than the last one accepted
+func (r *AdminGormRepository) AcceptTOTPStep(ctx context.Context, id uuid.UUID, step int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&admin.Admin{}).
+		Where("id = ? AND two_factor_last_step < ?", id, step).
+		Updates(map[string]interface{}{
+			"two_factor_last_step":   step,
+			"two_factor_verified_at": time.Now(),
+		})
+
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to accept TOTP step: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// UseRecoveryCode spends an unused recovery code and records the verification
+func (r *AdminGormRepository) UseRecoveryCode(ctx context.Context, id uuid.UUID, codeHash string) (bool, error) {
+	used := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&admin.RecoveryCode{}).
+			Where("admin_id = ? AND code_hash = ? AND used_at IS NULL", id, codeHash).
+			Update("used_at", now)
+		if result.Error != nil {
+			return fmt.Errorf("failed to use recovery code: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		used = true
+
+		if err := tx.Model(&admin.Admin{}).
+			Where("id = ?", id).
+			Update("two_factor_verified_at", now).Error; err != nil {
+			return fmt.Errorf("failed to record verification: %w", err)
+		}
+		return nil
+	})
+	return used, err
+}
+
+// ReplaceRecoveryCodes discards an admin's recovery codes and stores new ones
+func (r *AdminGormRepository) ReplaceRecoveryCodes(ctx context.Context, id uuid.UUID, codeHashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, id, codeHashes)
+	})
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, id uuid.UUID, codeHashes []string) error {
+	if err := tx.Where("admin_id = ?", id).Delete(&admin.RecoveryCode{}).Error; err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	codes := make([]admin.RecoveryCode, len(codeHashes))
+	for i, hash := range codeHashes {
+		codes[i] = admin.RecoveryCode{ID: uuid.New(), AdminID: id, CodeHash: hash}
+	}
+	if err := tx.Create(&codes).Error; err != nil {
+		return fmt.Errorf("failed to create recovery codes: %w", err)
+	}
+	return nil
+}
+
+// CountUnusedRecoveryCodes counts an admin's remaining recovery codes
+func (r *AdminGormRepository) CountUnusedRecoveryCodes(ctx context.Context, id uuid.UUID) (int64, error) {
+	var count int64
+	if err := GetReadDBOrTx(ctx, r.reads).
+		Model(&admin.RecoveryCode{}).
+		Where("admin_id = ? AND used_at IS NULL", id).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count recovery codes: %w", err)
+	}
+	return count, nil
+}
+
+// CreateTwoFactorEvent records a two-factor audit event
+func (r *AdminGormRepository) CreateTwoFactorEvent(ctx context.Context, event *admin.TwoFactorEvent) error {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	if err := r.db.WithContext(ctx).Create(event).Error; err != nil {
+		return fmt.Errorf("failed to create two-factor event: %w", err)
+	}
+	return nil
+}
+
+// ListTwoFactorEvents returns an admin's most recent two-factor events, newest first
+func (r *AdminGormRepository) ListTwoFactorEvents(ctx context.Context, id uuid.UUID, limit int) ([]*admin.TwoFactorEvent, error) {
+	if limit < 1 || limit > 200 {
+		limit = 50
+	}
+
+	var events []*admin.TwoFactorEvent
+	if err := GetReadDBOrTx(ctx, r.reads).
+		Where("admin_id = ?", id).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to list two-factor events: %w", err)
+	}
+	return events, nil
+}
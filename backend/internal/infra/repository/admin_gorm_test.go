@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupAdminTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	require.NoError(t, db.Exec(`CREATE TABLE admins (
+		id TEXT PRIMARY KEY,
+		username TEXT NOT NULL UNIQUE,
+		email TEXT NOT NULL UNIQUE,
+		password_hash TEXT NOT NULL,
+		full_name TEXT,
+		role TEXT NOT NULL DEFAULT 'operator',
+		status TEXT NOT NULL DEFAULT 'active',
+		permissions BLOB,
+		last_login_at DATETIME,
+		last_login_ip TEXT,
+		failed_login_attempts INTEGER DEFAULT 0,
+		locked_until DATETIME,
+		totp_secret TEXT,
+		two_factor_enabled BOOLEAN NOT NULL DEFAULT 0,
+		two_factor_enabled_at DATETIME,
+		two_factor_last_step INTEGER NOT NULL DEFAULT 0,
+		two_factor_verified_at DATETIME,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		created_by TEXT,
+		updated_by TEXT,
+		deleted_at DATETIME
+	)`).Error)
+	require.NoError(t, db.Exec(`CREATE TABLE admin_recovery_codes (
+		id TEXT PRIMARY KEY,
+		admin_id TEXT NOT NULL,
+		code_hash TEXT NOT NULL,
+		used_at DATETIME,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	)`).Error)
+	require.NoError(t, db.Exec(`CREATE TABLE admin_two_factor_events (
+		id TEXT PRIMARY KEY,
+		admin_id TEXT NOT NULL,
+		event TEXT NOT NULL,
+		ip_address TEXT,
+		detail TEXT,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	)`).Error)
+	return db
+}
+
+func createTestAdmin(t *testing.T, repo admin.Repository) *admin.Admin {
+	adm := &admin.Admin{
+		ID:           uuid.New(),
+		Username:     "ops-" + uuid.NewString()[:8],
+		PasswordHash: "hash",
+		Role:         admin.RoleAdmin,
+		Status:       admin.StatusActive,
+		Permissions:  admin.StringArray{"reelstrips"},
+	}
+	adm.Email = adm.Username + "@example.com"
+	require.NoError(t, repo.Create(context.Background(), adm))
+	return adm
+}
+
+func TestAdminGormRepository_TwoFactor(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAdminGormRepository(setupAdminTestDB(t))
+	adm := createTestAdmin(t, repo)
+
+	require.NoError(t, repo.SetTOTPSecret(ctx, adm.ID, "encrypted"))
+	require.NoError(t, repo.EnableTwoFactor(ctx, adm.ID, 100, []string{"h1", "h2"}))
+	assert.ErrorIs(t, repo.SetTOTPSecret(ctx, adm.ID, "other"), admin.ErrTwoFactorAlreadyEnabled)
+	assert.ErrorIs(t, repo.EnableTwoFactor(ctx, adm.ID, 101, nil), admin.ErrTwoFactorAlreadyEnabled)
+
+	got, err := repo.GetByID(ctx, adm.ID)
+	require.NoError(t, err)
+	assert.True(t, got.TwoFactorEnabled)
+	assert.Equal(t, "encrypted", got.TOTPSecret)
+	assert.Equal(t, int64(100), got.TwoFactorLastStep)
+	assert.NotNil(t, got.TwoFactorVerifiedAt)
+
+	t.Run("TOTP steps can't be replayed", func(t *testing.T) {
+		ok, err := repo.AcceptTOTPStep(ctx, adm.ID, 100)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		ok, err = repo.AcceptTOTPStep(ctx, adm.ID, 101)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("recovery codes are single use", func(t *testing.T) {
+		ok, err := repo.UseRecoveryCode(ctx, adm.ID, "h1")
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = repo.UseRecoveryCode(ctx, adm.ID, "h1")
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		remaining, err := repo.CountUnusedRecoveryCodes(ctx, adm.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), remaining)
+
+		require.NoError(t, repo.ReplaceRecoveryCodes(ctx, adm.ID, []string{"h3", "h4", "h5"}))
+		remaining, err = repo.CountUnusedRecoveryCodes(ctx, adm.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), remaining)
+	})
+
+	t.Run("disable clears the secret and codes", func(t *testing.T) {
+		require.NoError(t, repo.DisableTwoFactor(ctx, adm.ID))
+
+		got, err := repo.GetByID(ctx, adm.ID)
+		require.NoError(t, err)
+		assert.False(t, got.TwoFactorEnabled)
+		assert.Empty(t, got.TOTPSecret)
+		assert.Nil(t, got.TwoFactorVerifiedAt)
+
+		remaining, err := repo.CountUnusedRecoveryCodes(ctx, adm.ID)
+		require.NoError(t, err)
+		assert.Zero(t, remaining)
+	})
+}
+
+func TestAdminGormRepository_TwoFactorEvents(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAdminGormRepository(setupAdminTestDB(t))
+	adm := createTestAdmin(t, repo)
+
+	for _, event := range []admin.TwoFactorEventType{admin.TwoFactorEnrollmentStarted, admin.TwoFactorEnabled, admin.TwoFactorVerified} {
+		require.NoError(t, repo.CreateTwoFactorEvent(ctx, &admin.TwoFactorEvent{AdminID: adm.ID, Event: event, IPAddress: "10.0.0.1"}))
+	}
+
+	events, err := repo.ListTwoFactorEvents(ctx, adm.ID, 2)
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	events, err = repo.ListTwoFactorEvents(ctx, uuid.New(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/analytics"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"gorm.io/gorm"
+)
+
+// ProvideReelStripCanaryRepository provides the canary repository with performance sums on the
+// analytics store when one serves queries
+func ProvideReelStripCanaryRepository(db *gorm.DB, cache *cache.Cache, store analytics.Store) reelstrip.CanaryRepository {
+	repo := NewReelStripCanaryGormRepository(db, cache)
+	if store == nil {
+		return repo
+	}
+	return &analyticsCanaryRepository{CanaryRepository: repo, store: store}
+}
+
+// ProvideRTPAlertRepository provides the RTP alert repository with samples on the analytics store
+// when one serves queries
+func ProvideRTPAlertRepository(db *gorm.DB, store analytics.Store) reelstrip.RTPAlertRepository {
+	repo := NewRTPAlertGormRepository(db)
+	if store == nil {
+		return repo
+	}
+	return &analyticsRTPAlertRepository{RTPAlertRepository: repo, store: store}
+}
+
+// analyticsCanaryRepository sums canary performance on the analytics store instead of spin_logs
+type analyticsCanaryRepository struct {
+	reelstrip.CanaryRepository
+	store analytics.Store
+}
+
+// Performance sums the stakes and wins of spins played on the config since a time
+func (r *analyticsCanaryRepository) Performance(ctx context.Context, configID uuid.UUID, since time.Time) (*reelstrip.CanaryPerformance, error) {
+	return r.store.ConfigPerformance(ctx, configID, since)
+}
+
+// analyticsRTPAlertRepository samples config RTP on the analytics store instead of spin_logs
+type analyticsRTPAlertRepository struct {
+	reelstrip.RTPAlertRepository
+	store analytics.Store
+}
+
+// Sample sums the paid spins played on the config in [since, until)
+func (r *analyticsRTPAlertRepository) Sample(ctx context.Context, configID uuid.UUID, since, until time.Time) (*reelstrip.RTPSample, error) {
+	return r.store.ConfigSample(ctx, configID, since, until)
+}
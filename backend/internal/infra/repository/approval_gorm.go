@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/approval"
+	"gorm.io/gorm"
+)
+
+// ApprovalGormRepository implements approval.Repository using GORM
+type ApprovalGormRepository struct {
+	db *gorm.DB
+}
+
+// NewApprovalGormRepository creates a new GORM change request repository
+func NewApprovalGormRepository(db *gorm.DB) approval.Repository {
+	return &ApprovalGormRepository{db: db}
+}
+
+// Create inserts a new change request
+func (r *ApprovalGormRepository) Create(ctx context.Context, cr *approval.ChangeRequest) error {
+	if err := GetDBOrTx(ctx, r.db).WithContext(ctx).Create(cr).Error; err != nil {
+		return fmt.Errorf("failed to create change request: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a change request by ID
+func (r *ApprovalGormRepository) GetByID(ctx context.Context, id uuid.UUID) (*approval.ChangeRequest, error) {
+	var cr approval.ChangeRequest
+	if err := GetDBOrTx(ctx, r.db).WithContext(ctx).Where("id = ?", id).First(&cr).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, approval.ErrChangeRequestNotFound
+		}
+		return nil, fmt.Errorf("failed to get change request: %w", err)
+	}
+	return &cr, nil
+}
+
+// List lists change requests newest first with the total count for pagination
+func (r *ApprovalGormRepository) List(ctx context.Context, filters approval.ListFilters) ([]*approval.ChangeRequest, int64, error) {
+	query := r.db.WithContext(ctx).Model(&approval.ChangeRequest{})
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.Action != "" {
+		query = query.Where("action = ?", filters.Action)
+	}
+	if filters.ProposedBy != nil {
+		query = query.Where("proposed_by = ?", *filters.ProposedBy)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count change requests: %w", err)
+	}
+
+	var requests []*approval.ChangeRequest
+	offset := (filters.Page - 1) * filters.Limit
+	if err := query.Order("created_at DESC").Offset(offset).Limit(filters.Limit).Find(&requests).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list change requests: %w", err)
+	}
+	return requests, total, nil
+}
+
+// Update saves a change request
+func (r *ApprovalGormRepository) Update(ctx context.Context, cr *approval.ChangeRequest) error {
+	result := GetDBOrTx(ctx, r.db).WithContext(ctx).Model(cr).Select("*").Omit("created_at").Updates(cr)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update change request: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return approval.ErrChangeRequestNotFound
+	}
+	return nil
+}
+
+// Review saves a change request only while it is still pending in the database
+func (r *ApprovalGormRepository) Review(ctx context.Context, cr *approval.ChangeRequest) error {
+	result := GetDBOrTx(ctx, r.db).WithContext(ctx).Model(cr).
+		Where("status = ?", approval.StatusPending).
+		Select("*").Omit("created_at").Updates(cr)
+	if result.Error != nil {
+		return fmt.Errorf("failed to review change request: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return approval.ErrNotPending
+	}
+	return nil
+}
+
+// ListStale lists pending change requests that expired before now, oldest first
+func (r *ApprovalGormRepository) ListStale(ctx context.Context, now time.Time, limit int) ([]*approval.ChangeRequest, error) {
+	var requests []*approval.ChangeRequest
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND expires_at <= ?", approval.StatusPending, now).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&requests).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stale change requests: %w", err)
+	}
+	return requests, nil
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/approval"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupApprovalTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	require.NoError(t, db.Exec(`CREATE TABLE change_requests (
+		id TEXT PRIMARY KEY,
+		action TEXT NOT NULL,
+		params TEXT NOT NULL,
+		summary TEXT NOT NULL,
+		status TEXT NOT NULL DEFAULT 'pending',
+		proposed_by TEXT NOT NULL,
+		proposed_by_name TEXT NOT NULL,
+		reviewed_by TEXT,
+		reviewed_by_name TEXT,
+		review_note TEXT,
+		reviewed_at DATETIME,
+		error TEXT,
+		applied_at DATETIME,
+		expires_at DATETIME NOT NULL,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	)`).Error)
+	return db
+}
+
+func TestApprovalGormRepository(t *testing.T) {
+	ctx := context.Background()
+	db := setupApprovalTestDB(t)
+	repo := NewApprovalGormRepository(db)
+
+	now := time.Now().UTC()
+	playerID := uuid.New()
+	newRequest := func(expiresAt time.Time) *approval.ChangeRequest {
+		return &approval.ChangeRequest{
+			ID:             uuid.New(),
+			Action:         approval.ActionAdjustBalance,
+			Params:         &approval.Params{PlayerID: &playerID, Amount: -10, Reason: "Chargeback"},
+			Summary:        "Adjust balance",
+			Status:         approval.StatusPending,
+			ProposedBy:     uuid.New(),
+			ProposedByName: "alice",
+			ExpiresAt:      expiresAt,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+	}
+
+	t.Run("should round-trip the params", func(t *testing.T) {
+		cr := newRequest(now.Add(time.Hour))
+		require.NoError(t, repo.Create(ctx, cr))
+
+		stored, err := repo.GetByID(ctx, cr.ID)
+		require.NoError(t, err)
+		assert.Equal(t, playerID, *stored.Params.PlayerID)
+		assert.Equal(t, -10.0, stored.Params.Amount)
+
+		_, err = repo.GetByID(ctx, uuid.New())
+		assert.ErrorIs(t, err, approval.ErrChangeRequestNotFound)
+	})
+
+	t.Run("should review a change request only once", func(t *testing.T) {
+		cr := newRequest(now.Add(time.Hour))
+		require.NoError(t, repo.Create(ctx, cr))
+
+		first, second := *cr, *cr
+		reviewer := uuid.New()
+		first.Status, first.ReviewedBy = approval.StatusApproved, &reviewer
+		second.Status = approval.StatusRejected
+
+		require.NoError(t, repo.Review(ctx, &first))
+		assert.ErrorIs(t, repo.Review(ctx, &second), approval.ErrNotPending)
+
+		stored, err := repo.GetByID(ctx, cr.ID)
+		require.NoError(t, err)
+		assert.Equal(t, approval.StatusApproved, stored.Status)
+		assert.Equal(t, reviewer, *stored.ReviewedBy)
+	})
+
+	t.Run("should list stale pending change requests oldest first", func(t *testing.T) {
+		older := newRequest(now.Add(-2 * time.Hour))
+		newer := newRequest(now.Add(-time.Hour))
+		for _, cr := range []*approval.ChangeRequest{newer, older} {
+			require.NoError(t, repo.Create(ctx, cr))
+		}
+
+		stale, err := repo.ListStale(ctx, now, 10)
+		require.NoError(t, err)
+		require.Len(t, stale, 2)
+		assert.Equal(t, older.ID, stale[0].ID)
+		assert.Equal(t, newer.ID, stale[1].ID)
+
+		stale, err = repo.ListStale(ctx, now, 1)
+		require.NoError(t, err)
+		assert.Len(t, stale, 1)
+	})
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/archive"
+	"gorm.io/gorm"
+)
+
+// ArchiveGormRepository implements archive.Repository using GORM
+type ArchiveGormRepository struct {
+	db *gorm.DB
+}
+
+// NewArchiveGormRepository creates a new GORM archive repository
+func NewArchiveGormRepository(db *gorm.DB) archive.Repository {
+	return &ArchiveGormRepository{db: db}
+}
+
+// Create inserts a new archive record
+func (r *ArchiveGormRepository) Create(ctx context.Context, a *archive.Archive) error {
+	if err := r.db.WithContext(ctx).Create(a).Error; err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves an archive record by ID
+func (r *ArchiveGormRepository) GetByID(ctx context.Context, id uuid.UUID) (*archive.Archive, error) {
+	var a archive.Archive
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&a).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, archive.ErrArchiveNotFound
+		}
+		return nil, fmt.Errorf("failed to get archive: %w", err)
+	}
+	return &a, nil
+}
+
+// GetByPartition retrieves the archive record of a partition
+func (r *ArchiveGormRepository) GetByPartition(ctx context.Context, partitionName string) (*archive.Archive, error) {
+	var a archive.Archive
+	if err := r.db.WithContext(ctx).Where("partition_name = ?", partitionName).First(&a).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, archive.ErrArchiveNotFound
+		}
+		return nil, fmt.Errorf("failed to get archive by partition: %w", err)
+	}
+	return &a, nil
+}
+
+// List lists archive records newest range first with the total count for pagination
+func (r *ArchiveGormRepository) List(ctx context.Context, filters archive.ListFilters) ([]*archive.Archive, int64, error) {
+	query := r.db.WithContext(ctx).Model(&archive.Archive{})
+	if filters.SourceTable != "" {
+		query = query.Where("source_table = ?", filters.SourceTable)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count archives: %w", err)
+	}
+
+	var archives []*archive.Archive
+	offset := (filters.Page - 1) * filters.Limit
+	if err := query.Order("range_end DESC").Offset(offset).Limit(filters.Limit).Find(&archives).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list archives: %w", err)
+	}
+	return archives, total, nil
+}
+
+// MarkRestored records that an archive's rows were restored into the hot DB
+func (r *ArchiveGormRepository) MarkRestored(ctx context.Context, id uuid.UUID, at time.Time, by string) error {
+	result := r.db.WithContext(ctx).Model(&archive.Archive{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"restored_at": at, "restored_by": by})
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark archive restored: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return archive.ErrArchiveNotFound
+	}
+	return nil
+}
+
+// ClearRestored records that a restored archive's rows were dropped again
+func (r *ArchiveGormRepository) ClearRestored(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Model(&archive.Archive{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"restored_at": nil, "restored_by": ""})
+	if result.Error != nil {
+		return fmt.Errorf("failed to clear archive restore: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return archive.ErrArchiveNotFound
+	}
+	return nil
+}
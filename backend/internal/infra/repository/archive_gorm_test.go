@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/archive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupArchiveTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	require.NoError(t, db.Exec(`CREATE TABLE spin_archives (
+		id TEXT PRIMARY KEY,
+		source_table TEXT NOT NULL,
+		partition_name TEXT NOT NULL UNIQUE,
+		range_start DATETIME,
+		range_end DATETIME NOT NULL,
+		object_name TEXT NOT NULL,
+		row_count INTEGER NOT NULL,
+		size_bytes INTEGER NOT NULL,
+		checksum TEXT NOT NULL,
+		archived_at DATETIME NOT NULL,
+		restored_at DATETIME,
+		restored_by TEXT
+	)`).Error)
+	return db
+}
+
+func newTestArchive(table string, month time.Time) *archive.Archive {
+	start := month
+	name := partitionName(table, month)
+	return &archive.Archive{
+		ID:            uuid.New(),
+		SourceTable:   table,
+		PartitionName: name,
+		RangeStart:    &start,
+		RangeEnd:      month.AddDate(0, 1, 0),
+		ObjectName:    name + ".jsonl.gz",
+		RowCount:      10,
+		SizeBytes:     512,
+		Checksum:      "abc",
+		ArchivedAt:    time.Now().UTC(),
+	}
+}
+
+func TestArchiveGormRepository(t *testing.T) {
+	ctx := context.Background()
+	repo := NewArchiveGormRepository(setupArchiveTestDB(t))
+
+	jan := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	older := newTestArchive("spins", jan)
+	newer := newTestArchive("spins", jan.AddDate(0, 1, 0))
+	logs := newTestArchive("spin_logs", jan)
+	for _, a := range []*archive.Archive{older, newer, logs} {
+		require.NoError(t, repo.Create(ctx, a))
+	}
+
+	t.Run("should get by partition", func(t *testing.T) {
+		got, err := repo.GetByPartition(ctx, "spins_p2025_01")
+		require.NoError(t, err)
+		assert.Equal(t, older.ID, got.ID)
+
+		_, err = repo.GetByPartition(ctx, "spins_p2024_01")
+		assert.ErrorIs(t, err, archive.ErrArchiveNotFound)
+	})
+
+	t.Run("should list newest range first filtered by table", func(t *testing.T) {
+		list, total, err := repo.List(ctx, archive.ListFilters{SourceTable: "spins", Page: 1, Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, list, 2)
+		assert.Equal(t, newer.ID, list[0].ID)
+	})
+
+	t.Run("should mark and clear restores", func(t *testing.T) {
+		require.NoError(t, repo.MarkRestored(ctx, older.ID, time.Now().UTC(), "auditor"))
+		got, err := repo.GetByID(ctx, older.ID)
+		require.NoError(t, err)
+		require.NotNil(t, got.RestoredAt)
+		assert.Equal(t, "auditor", got.RestoredBy)
+
+		require.NoError(t, repo.ClearRestored(ctx, older.ID))
+		got, err = repo.GetByID(ctx, older.ID)
+		require.NoError(t, err)
+		assert.Nil(t, got.RestoredAt)
+
+		assert.ErrorIs(t, repo.MarkRestored(ctx, uuid.New(), time.Now(), "auditor"), archive.ErrArchiveNotFound)
+	})
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/autoplay"
+	"gorm.io/gorm"
+)
+
+// AutoplayGormRepository implements autoplay.Repository using GORM
+type AutoplayGormRepository struct {
+	db *gorm.DB
+}
+
+// NewAutoplayGormRepository creates a new GORM autoplay repository
+func NewAutoplayGormRepository(db *gorm.DB) autoplay.Repository {
+	return &AutoplayGormRepository{db: db}
+}
+
+// Create inserts a new autoplay contract
+func (r *AutoplayGormRepository) Create(ctx context.Context, c *autoplay.Contract) error {
+	if err := GetDBOrTx(ctx, r.db).Create(c).Error; err != nil {
+		return fmt.Errorf("failed to create autoplay contract: %w", err)
+	}
+	return nil
+}
+
+// GetActiveBySession returns the session's running contract
+func (r *AutoplayGormRepository) GetActiveBySession(ctx context.Context, sessionID uuid.UUID) (*autoplay.Contract, error) {
+	var c autoplay.Contract
+	err := GetDBOrTx(ctx, r.db).
+		Where("session_id = ? AND status = ?", sessionID, autoplay.StatusActive).
+		Order("created_at DESC").
+		First(&c).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, autoplay.ErrAutoplayNotFound
+		}
+		return nil, fmt.Errorf("failed to get autoplay contract: %w", err)
+	}
+	return &c, nil
+}
+
+// Update saves an autoplay contract
+func (r *AutoplayGormRepository) Update(ctx context.Context, c *autoplay.Contract) error {
+	result := GetDBOrTx(ctx, r.db).Model(c).Select("*").Omit("created_at").Updates(c)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update autoplay contract: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return autoplay.ErrAutoplayNotFound
+	}
+	return nil
+}
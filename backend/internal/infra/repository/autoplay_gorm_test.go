@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/autoplay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupAutoplayTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	err = db.Exec(`
+		CREATE TABLE autoplay_contracts (
+			id TEXT PRIMARY KEY,
+			player_id TEXT NOT NULL,
+			session_id TEXT NOT NULL,
+			total_spins INTEGER NOT NULL,
+			single_win_limit REAL,
+			loss_limit REAL,
+			spins_played INTEGER NOT NULL DEFAULT 0,
+			total_wagered REAL NOT NULL DEFAULT 0,
+			total_won REAL NOT NULL DEFAULT 0,
+			status TEXT NOT NULL,
+			stop_reason TEXT,
+			stopped_at DATETIME,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create autoplay_contracts table")
+
+	return db
+}
+
+func TestAutoplayGormRepository(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAutoplayGormRepository(setupAutoplayTestDB(t))
+
+	lossLimit := 50.0
+	contract := &autoplay.Contract{
+		ID:         uuid.New(),
+		PlayerID:   uuid.New(),
+		SessionID:  uuid.New(),
+		TotalSpins: 10,
+		LossLimit:  &lossLimit,
+		Status:     autoplay.StatusActive,
+	}
+	require.NoError(t, repo.Create(ctx, contract))
+
+	t.Run("should get the session's running contract", func(t *testing.T) {
+		found, err := repo.GetActiveBySession(ctx, contract.SessionID)
+		require.NoError(t, err)
+		assert.Equal(t, contract.ID, found.ID)
+		assert.Equal(t, &lossLimit, found.LossLimit)
+		assert.Nil(t, found.SingleWinLimit)
+
+		_, err = repo.GetActiveBySession(ctx, uuid.New())
+		assert.ErrorIs(t, err, autoplay.ErrAutoplayNotFound)
+	})
+
+	t.Run("should save spins and stop the contract", func(t *testing.T) {
+		contract.RecordSpin(30, 0, time.Now())
+		require.NoError(t, repo.Update(ctx, contract))
+		found, err := repo.GetActiveBySession(ctx, contract.SessionID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, found.SpinsPlayed)
+		assert.Equal(t, 30.0, found.TotalWagered)
+
+		contract.RecordSpin(30, 5, time.Now())
+		require.NoError(t, repo.Update(ctx, contract))
+		_, err = repo.GetActiveBySession(ctx, contract.SessionID)
+		assert.ErrorIs(t, err, autoplay.ErrAutoplayNotFound, "stopped contracts are not running")
+	})
+
+	t.Run("should reject unknown contract", func(t *testing.T) {
+		err := repo.Update(ctx, &autoplay.Contract{ID: uuid.New(), Status: autoplay.StatusStopped})
+		assert.ErrorIs(t, err, autoplay.ErrAutoplayNotFound)
+	})
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/slotmachine/backend/domain/balance"
+	"github.com/slotmachine/backend/domain/player"
+	"gorm.io/gorm"
+)
+
+// BalanceGormRepository implements balance.Repository using GORM
+type BalanceGormRepository struct {
+	db *gorm.DB
+}
+
+// NewBalanceGormRepository creates a new GORM balance repository
+func NewBalanceGormRepository(db *gorm.DB) balance.Repository {
+	return &BalanceGormRepository{db: db}
+}
+
+// Credit adds the amount to the player's balance and records the transaction atomically
+// The player's lock version is bumped so spins that read the balance before the credit retry.
+// A debit only matches while the balance covers it, so it never leaves the balance negative.
+func (r *BalanceGormRepository) Credit(ctx context.Context, t *balance.Transaction) error {
+	return GetDBOrTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if t.Reference != nil {
+			var count int64
+			err := tx.Model(&balance.Transaction{}).
+				Where("player_id = ? AND reference = ?", t.PlayerID, *t.Reference).
+				Count(&count).Error
+			if err != nil {
+				return fmt.Errorf("failed to check credit reference: %w", err)
+			}
+			if count > 0 {
+				return balance.ErrDuplicateCredit
+			}
+		}
+
+		query := tx.Model(&player.Player{}).Where("id = ?", t.PlayerID)
+		if t.Amount < 0 {
+			query = query.Where("balance + ? >= 0", t.Amount)
+		}
+		result := query.
+			Updates(map[string]any{
+				"balance":      gorm.Expr("balance + ?", t.Amount),
+				"lock_version": gorm.Expr("lock_version + 1"),
+				"updated_at":   time.Now().UTC(),
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to credit balance: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			var count int64
+			if err := tx.Model(&player.Player{}).Where("id = ?", t.PlayerID).Count(&count).Error; err != nil {
+				return fmt.Errorf("failed to check player: %w", err)
+			}
+			if count > 0 {
+				return player.ErrInsufficientBalance
+			}
+			return player.ErrPlayerNotFound
+		}
+
+		var after float64
+		if err := tx.Model(&player.Player{}).Where("id = ?", t.PlayerID).Select("balance").Scan(&after).Error; err != nil {
+			return fmt.Errorf("failed to read credited balance: %w", err)
+		}
+		t.BalanceAfter = after
+		t.BalanceBefore = after - t.Amount
+
+		if err := tx.Create(t).Error; err != nil {
+			return fmt.Errorf("failed to record credit: %w", err)
+		}
+		return nil
+	})
+}
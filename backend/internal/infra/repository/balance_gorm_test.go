@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/balance"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupBalanceTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	for _, stmt := range []string{
+		`CREATE TABLE players (
+			id TEXT PRIMARY KEY,
+			balance REAL NOT NULL DEFAULT 0,
+			lock_version INTEGER NOT NULL DEFAULT 0,
+			updated_at DATETIME
+		)`,
+		`CREATE TABLE transactions (
+			id TEXT PRIMARY KEY,
+			player_id TEXT NOT NULL,
+			type TEXT NOT NULL,
+			amount REAL NOT NULL,
+			balance_before REAL NOT NULL,
+			balance_after REAL NOT NULL,
+			description TEXT,
+			reference TEXT,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (player_id, reference)
+		)`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	return db
+}
+
+func TestBalanceGormRepository_Credit(t *testing.T) {
+	ctx := context.Background()
+	db := setupBalanceTestDB(t)
+	repo := NewBalanceGormRepository(db)
+
+	playerID := uuid.New()
+	require.NoError(t, db.Exec(`INSERT INTO players (id, balance, lock_version) VALUES (?, 100, 3)`, playerID).Error)
+
+	newCredit := func(amount float64, reference string) *balance.Transaction {
+		return &balance.Transaction{ID: uuid.New(), PlayerID: playerID, Type: "deposit", Amount: amount, Reference: &reference}
+	}
+
+	t.Run("should add to the balance and record the transaction", func(t *testing.T) {
+		credit := newCredit(25.5, "dep-1")
+		require.NoError(t, repo.Credit(ctx, credit))
+		assert.Equal(t, 100.0, credit.BalanceBefore)
+		assert.Equal(t, 125.5, credit.BalanceAfter)
+
+		var p player.Player
+		require.NoError(t, db.Select("balance", "lock_version").Where("id = ?", playerID).First(&p).Error)
+		assert.Equal(t, 125.5, p.Balance)
+		assert.Equal(t, 4, p.LockVersion, "concurrent spins must see the balance changed")
+
+		var stored balance.Transaction
+		require.NoError(t, db.Where("id = ?", credit.ID).First(&stored).Error)
+		assert.Equal(t, 125.5, stored.BalanceAfter)
+	})
+
+	t.Run("should not apply a reference twice", func(t *testing.T) {
+		err := repo.Credit(ctx, newCredit(10, "dep-1"))
+		assert.ErrorIs(t, err, balance.ErrDuplicateCredit)
+
+		var p player.Player
+		require.NoError(t, db.Select("balance").Where("id = ?", playerID).First(&p).Error)
+		assert.Equal(t, 125.5, p.Balance)
+	})
+
+	t.Run("should return not found for unknown players", func(t *testing.T) {
+		credit := newCredit(10, "dep-2")
+		credit.PlayerID = uuid.New()
+		assert.ErrorIs(t, repo.Credit(ctx, credit), player.ErrPlayerNotFound)
+	})
+
+	t.Run("should debit a negative amount the balance covers", func(t *testing.T) {
+		debit := newCredit(-25.5, "adj-1")
+		require.NoError(t, repo.Credit(ctx, debit))
+		assert.Equal(t, 125.5, debit.BalanceBefore)
+		assert.Equal(t, 100.0, debit.BalanceAfter)
+	})
+
+	t.Run("should refuse a debit that would overdraw the balance", func(t *testing.T) {
+		err := repo.Credit(ctx, newCredit(-100.01, "adj-2"))
+		assert.ErrorIs(t, err, player.ErrInsufficientBalance)
+
+		var p player.Player
+		require.NoError(t, db.Select("balance").Where("id = ?", playerID).First(&p).Error)
+		assert.Equal(t, 100.0, p.Balance)
+
+		var count int64
+		require.NoError(t, db.Model(&balance.Transaction{}).Where("reference = ?", "adj-2").Count(&count).Error)
+		assert.Zero(t, count)
+	})
+}
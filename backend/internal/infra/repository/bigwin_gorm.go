@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/bigwin"
+	"gorm.io/gorm"
+)
+
+// BigWinGormRepository implements bigwin.Repository using GORM
+type BigWinGormRepository struct {
+	db    *gorm.DB
+	reads ReadSource
+}
+
+// NewBigWinGormRepository creates a new GORM big win repository
+func NewBigWinGormRepository(db *gorm.DB) bigwin.Repository {
+	return &BigWinGormRepository{db: db, reads: primaryReads{db}}
+}
+
+// Create inserts a new big win
+func (r *BigWinGormRepository) Create(ctx context.Context, win *bigwin.BigWin) error {
+	if err := r.db.WithContext(ctx).Create(win).Error; err != nil {
+		return fmt.Errorf("failed to create big win: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a big win by ID
+func (r *BigWinGormRepository) GetByID(ctx context.Context, id uuid.UUID) (*bigwin.BigWin, error) {
+	var win bigwin.BigWin
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&win).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, bigwin.ErrBigWinNotFound
+		}
+		return nil, fmt.Errorf("failed to get big win: %w", err)
+	}
+	return &win, nil
+}
+
+// List lists big wins newest first with the total count for pagination
+func (r *BigWinGormRepository) List(ctx context.Context, filters bigwin.ListFilters) ([]*bigwin.BigWin, int64, error) {
+	query := GetReadDBOrTx(ctx, r.reads).Model(&bigwin.BigWin{})
+	if filters.PlayerID != nil {
+		query = query.Where("player_id = ?", *filters.PlayerID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count big wins: %w", err)
+	}
+
+	var wins []*bigwin.BigWin
+	offset := (filters.Page - 1) * filters.Limit
+	if err := query.Order("created_at DESC").Offset(offset).Limit(filters.Limit).Find(&wins).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list big wins: %w", err)
+	}
+	return wins, total, nil
+}
+
+// MarkNotified records when notifiers were run for a big win
+func (r *BigWinGormRepository) MarkNotified(ctx context.Context, id uuid.UUID, at time.Time) error {
+	result := r.db.WithContext(ctx).Model(&bigwin.BigWin{}).Where("id = ?", id).Update("notified_at", at)
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark big win notified: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return bigwin.ErrBigWinNotFound
+	}
+	return nil
+}
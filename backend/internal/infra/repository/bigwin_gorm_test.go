@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/bigwin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupBigWinTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	require.NoError(t, db.Exec(`CREATE TABLE big_wins (
+		id TEXT PRIMARY KEY,
+		spin_id TEXT UNIQUE NOT NULL,
+		player_id TEXT NOT NULL,
+		session_id TEXT NOT NULL,
+		bet_amount REAL NOT NULL,
+		win_amount REAL NOT NULL,
+		multiplier REAL NOT NULL,
+		is_free_spin INTEGER NOT NULL DEFAULT 0,
+		proof TEXT,
+		notified_at DATETIME,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	)`).Error)
+	return db
+}
+
+func newTestBigWin(playerID uuid.UUID, createdAt time.Time) *bigwin.BigWin {
+	return &bigwin.BigWin{
+		ID:         uuid.New(),
+		SpinID:     uuid.New(),
+		PlayerID:   playerID,
+		SessionID:  uuid.New(),
+		BetAmount:  1,
+		WinAmount:  150,
+		Multiplier: 150,
+		CreatedAt:  createdAt,
+	}
+}
+
+func TestBigWinGormRepository_CreateAndGet(t *testing.T) {
+	ctx := context.Background()
+	repo := NewBigWinGormRepository(setupBigWinTestDB(t))
+
+	win := newTestBigWin(uuid.New(), time.Now().UTC())
+	win.Proof = &bigwin.Proof{PFSessionID: uuid.New(), ServerSeedHash: "abc", SpinIndex: 3, Nonce: 4, SpinHash: "def", PrevSpinHash: "ghi"}
+	require.NoError(t, repo.Create(ctx, win))
+
+	t.Run("should round-trip the proof", func(t *testing.T) {
+		got, err := repo.GetByID(ctx, win.ID)
+		require.NoError(t, err)
+		require.NotNil(t, got.Proof)
+		assert.Equal(t, *win.Proof, *got.Proof)
+		assert.Nil(t, got.NotifiedAt)
+	})
+
+	t.Run("should reject a second big win for the same spin", func(t *testing.T) {
+		dup := newTestBigWin(win.PlayerID, time.Now().UTC())
+		dup.SpinID = win.SpinID
+		assert.Error(t, repo.Create(ctx, dup))
+	})
+
+	t.Run("should store big wins without a proof", func(t *testing.T) {
+		noProof := newTestBigWin(uuid.New(), time.Now().UTC())
+		require.NoError(t, repo.Create(ctx, noProof))
+		got, err := repo.GetByID(ctx, noProof.ID)
+		require.NoError(t, err)
+		assert.Nil(t, got.Proof)
+	})
+
+	t.Run("should return not found for unknown big win", func(t *testing.T) {
+		_, err := repo.GetByID(ctx, uuid.New())
+		assert.ErrorIs(t, err, bigwin.ErrBigWinNotFound)
+	})
+}
+
+func TestBigWinGormRepository_List(t *testing.T) {
+	ctx := context.Background()
+	repo := NewBigWinGormRepository(setupBigWinTestDB(t))
+	playerID := uuid.New()
+	now := time.Now().UTC()
+
+	older := newTestBigWin(playerID, now.Add(-2*time.Hour))
+	newer := newTestBigWin(playerID, now.Add(-time.Hour))
+	other := newTestBigWin(uuid.New(), now)
+	for _, w := range []*bigwin.BigWin{older, newer, other} {
+		require.NoError(t, repo.Create(ctx, w))
+	}
+
+	wins, total, err := repo.List(ctx, bigwin.ListFilters{PlayerID: &playerID, Page: 1, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, wins, 1)
+	assert.Equal(t, newer.ID, wins[0].ID, "newest first")
+
+	wins, total, err = repo.List(ctx, bigwin.ListFilters{Page: 1, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Len(t, wins, 3)
+}
+
+func TestBigWinGormRepository_MarkNotified(t *testing.T) {
+	ctx := context.Background()
+	repo := NewBigWinGormRepository(setupBigWinTestDB(t))
+
+	win := newTestBigWin(uuid.New(), time.Now().UTC())
+	require.NoError(t, repo.Create(ctx, win))
+
+	at := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, repo.MarkNotified(ctx, win.ID, at))
+
+	got, err := repo.GetByID(ctx, win.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.NotifiedAt)
+	assert.True(t, at.Equal(*got.NotifiedAt))
+
+	assert.ErrorIs(t, repo.MarkNotified(ctx, uuid.New(), at), bigwin.ErrBigWinNotFound)
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/certification"
+	"gorm.io/gorm"
+)
+
+// CertificationGormRepository implements certification.Repository using GORM
+type CertificationGormRepository struct {
+	db *gorm.DB
+}
+
+// NewCertificationGormRepository creates a new GORM certification event repository
+func NewCertificationGormRepository(db *gorm.DB) certification.Repository {
+	return &CertificationGormRepository{db: db}
+}
+
+// Create appends an event, within the spin's transaction when there is one
+func (r *CertificationGormRepository) Create(ctx context.Context, event *certification.Event) error {
+	if err := GetDBOrTx(ctx, r.db).Create(event).Error; err != nil {
+		return fmt.Errorf("failed to create certification event: %w", err)
+	}
+	return nil
+}
+
+// ListAfter lists events of a time range after a (created_at, id) cursor
+func (r *CertificationGormRepository) ListAfter(ctx context.Context, from, to, afterCreatedAt time.Time, afterID uuid.UUID, limit int) ([]*certification.Event, error) {
+	query := r.db.WithContext(ctx).Where("created_at >= ? AND created_at < ?", from, to)
+	if !afterCreatedAt.IsZero() {
+		query = query.Where("created_at > ? OR (created_at = ? AND id > ?)", afterCreatedAt, afterCreatedAt, afterID)
+	}
+
+	var events []*certification.Event
+	if err := query.Order("created_at ASC, id ASC").Limit(limit).Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to list certification events: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/certification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupCertificationTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	err = db.Exec(`
+		CREATE TABLE certification_events (
+			id TEXT PRIMARY KEY,
+			spin_id TEXT NOT NULL UNIQUE,
+			session_id TEXT NOT NULL,
+			player_id TEXT NOT NULL,
+			is_free_spin INTEGER NOT NULL,
+			free_spins_session_id TEXT,
+			game_mode TEXT,
+			bet_amount REAL NOT NULL,
+			engine_version TEXT NOT NULL,
+			paytable_version TEXT NOT NULL,
+			rng_provider TEXT NOT NULL,
+			rng_draws TEXT NOT NULL,
+			nonce INTEGER NOT NULL,
+			spin_hash TEXT NOT NULL,
+			reel_strip_config_id TEXT,
+			strip_checksums TEXT NOT NULL,
+			multipliers TEXT,
+			reel_positions TEXT NOT NULL,
+			grid TEXT NOT NULL,
+			cascades TEXT,
+			total_win REAL NOT NULL,
+			scatter_count INTEGER NOT NULL,
+			free_spins_triggered INTEGER NOT NULL,
+			balance_before REAL NOT NULL,
+			balance_after REAL NOT NULL,
+			created_at DATETIME NOT NULL
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create certification_events table")
+	return db
+}
+
+func createTestCertificationEvent(createdAt time.Time) *certification.Event {
+	return &certification.Event{
+		ID:              uuid.New(),
+		SpinID:          uuid.New(),
+		SessionID:       uuid.New(),
+		PlayerID:        uuid.New(),
+		BetAmount:       1,
+		EngineVersion:   "1.0.0",
+		PaytableVersion: "0123456789abcdef",
+		RNGProvider:     "hkdf",
+		RNGDraws:        []certification.RNGDraw{{Call: "Int", Args: []int{100}, Result: "42"}},
+		Nonce:           1,
+		SpinHash:        "hash",
+		StripChecksums:  []string{"a", "b", "c", "d", "e"},
+		ReelPositions:   []int{1, 2, 3, 4, 5},
+		CreatedAt:       createdAt,
+	}
+}
+
+func TestCertificationGormRepository_ListAfter(t *testing.T) {
+	ctx := context.Background()
+	db := setupCertificationTestDB(t)
+	repo := NewCertificationGormRepository(db)
+
+	day := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	var created []*certification.Event
+	for i := 0; i < 3; i++ {
+		event := createTestCertificationEvent(day.Add(time.Duration(i) * time.Hour))
+		require.NoError(t, repo.Create(ctx, event))
+		created = append(created, event)
+	}
+	require.NoError(t, repo.Create(ctx, createTestCertificationEvent(day.AddDate(0, 0, 1))))
+
+	t.Run("should list the range oldest first with draws intact", func(t *testing.T) {
+		events, err := repo.ListAfter(ctx, day, day.AddDate(0, 0, 1), time.Time{}, uuid.Nil, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		assert.Equal(t, created[0].ID, events[0].ID)
+		assert.Equal(t, created[2].ID, events[2].ID)
+		assert.Equal(t, created[0].RNGDraws, events[0].RNGDraws)
+		assert.Equal(t, created[0].StripChecksums, events[0].StripChecksums)
+	})
+
+	t.Run("should resume after the cursor", func(t *testing.T) {
+		events, err := repo.ListAfter(ctx, day, day.AddDate(0, 0, 1), created[0].CreatedAt, created[0].ID, 1)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, created[1].ID, events[0].ID)
+	})
+}
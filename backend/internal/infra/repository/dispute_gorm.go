@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/dispute"
+	"gorm.io/gorm"
+)
+
+// DisputeGormRepository implements dispute.Repository using GORM
+type DisputeGormRepository struct {
+	db *gorm.DB
+}
+
+// NewDisputeGormRepository creates a new GORM dispute repository
+func NewDisputeGormRepository(db *gorm.DB) dispute.Repository {
+	return &DisputeGormRepository{db: db}
+}
+
+// Create inserts a new dispute
+func (r *DisputeGormRepository) Create(ctx context.Context, d *dispute.Dispute) error {
+	if err := GetDBOrTx(ctx, r.db).Create(d).Error; err != nil {
+		return fmt.Errorf("failed to create dispute: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a dispute by ID
+func (r *DisputeGormRepository) GetByID(ctx context.Context, id uuid.UUID) (*dispute.Dispute, error) {
+	var d dispute.Dispute
+	if err := GetDBOrTx(ctx, r.db).Where("id = ?", id).First(&d).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, dispute.ErrDisputeNotFound
+		}
+		return nil, fmt.Errorf("failed to get dispute: %w", err)
+	}
+	return &d, nil
+}
+
+// GetOpenBySpin returns the spin's dispute that is not closed, if any
+func (r *DisputeGormRepository) GetOpenBySpin(ctx context.Context, spinID uuid.UUID) (*dispute.Dispute, error) {
+	var d dispute.Dispute
+	err := GetDBOrTx(ctx, r.db).
+		Where("spin_id = ? AND status IN ?", spinID, []dispute.Status{dispute.StatusOpen, dispute.StatusInvestigating}).
+		First(&d).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, dispute.ErrDisputeNotFound
+		}
+		return nil, fmt.Errorf("failed to get dispute by spin: %w", err)
+	}
+	return &d, nil
+}
+
+// Update saves a dispute
+func (r *DisputeGormRepository) Update(ctx context.Context, d *dispute.Dispute) error {
+	result := GetDBOrTx(ctx, r.db).Model(d).Select("*").Omit("created_at").Updates(d)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update dispute: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return dispute.ErrDisputeNotFound
+	}
+	return nil
+}
+
+// List lists disputes newest first with the total count for pagination
+func (r *DisputeGormRepository) List(ctx context.Context, filters dispute.ListFilters) ([]*dispute.Dispute, int64, error) {
+	query := r.db.WithContext(ctx).Model(&dispute.Dispute{})
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.PlayerID != nil {
+		query = query.Where("player_id = ?", *filters.PlayerID)
+	}
+	if filters.SpinID != nil {
+		query = query.Where("spin_id = ?", *filters.SpinID)
+	}
+	if filters.AssignedTo != nil {
+		query = query.Where("assigned_to = ?", *filters.AssignedTo)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count disputes: %w", err)
+	}
+
+	var disputes []*dispute.Dispute
+	offset := (filters.Page - 1) * filters.Limit
+	if err := query.Order("created_at DESC").Offset(offset).Limit(filters.Limit).Find(&disputes).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list disputes: %w", err)
+	}
+	return disputes, total, nil
+}
+
+// AddEvent appends to a dispute's history
+func (r *DisputeGormRepository) AddEvent(ctx context.Context, event *dispute.Event) error {
+	if err := GetDBOrTx(ctx, r.db).Create(event).Error; err != nil {
+		return fmt.Errorf("failed to add dispute event: %w", err)
+	}
+	return nil
+}
+
+// ListEvents lists a dispute's history oldest first
+func (r *DisputeGormRepository) ListEvents(ctx context.Context, disputeID uuid.UUID) ([]*dispute.Event, error) {
+	var events []*dispute.Event
+	if err := r.db.WithContext(ctx).Where("dispute_id = ?", disputeID).
+		Order("created_at ASC").Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to list dispute events: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/slotmachine/backend/domain/exposure"
+	"gorm.io/gorm"
+)
+
+// ExposureGormRepository implements exposure.Repository using GORM
+type ExposureGormRepository struct {
+	db *gorm.DB
+}
+
+// NewExposureGormRepository creates a new GORM exposure repository
+func NewExposureGormRepository(db *gorm.DB) exposure.Repository {
+	return &ExposureGormRepository{db: db}
+}
+
+// OpenFreeSpins sums the active free spins sessions with spins left
+func (r *ExposureGormRepository) OpenFreeSpins(ctx context.Context) (*exposure.FreeSpinsTotals, error) {
+	var totals exposure.FreeSpinsTotals
+	err := r.db.WithContext(ctx).
+		Table("free_spins_sessions").
+		Where("is_active = ? AND remaining_spins > 0", true).
+		Select("COUNT(*) AS sessions, COALESCE(SUM(remaining_spins), 0) AS remaining_spins, COALESCE(SUM(remaining_spins * locked_bet_amount), 0) AS remaining_stake").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum open free spins: %w", err)
+	}
+	return &totals, nil
+}
+
+// PendingTriggers sums, by scatter count, triggering spins whose free spins session was never created
+func (r *ExposureGormRepository) PendingTriggers(ctx context.Context, since time.Time) ([]exposure.TriggerTotals, error) {
+	var totals []exposure.TriggerTotals
+	err := r.db.WithContext(ctx).
+		Table("spins").
+		Where("free_spins_triggered = ? AND is_free_spin = ? AND free_spins_session_id IS NULL", true, false).
+		Where("created_at >= ?", since).
+		Select("scatter_count, COUNT(*) AS triggers, COALESCE(SUM(bet_amount), 0) AS stake").
+		Group("scatter_count").
+		Order("scatter_count").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum pending free spins triggers: %w", err)
+	}
+	return totals, nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupExposureTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	for _, stmt := range []string{
+		`CREATE TABLE free_spins_sessions (id TEXT PRIMARY KEY, remaining_spins INTEGER NOT NULL, locked_bet_amount REAL NOT NULL, is_active BOOLEAN NOT NULL)`,
+		`CREATE TABLE spins (
+			id TEXT PRIMARY KEY,
+			bet_amount REAL NOT NULL,
+			scatter_count INTEGER NOT NULL DEFAULT 0,
+			is_free_spin BOOLEAN NOT NULL DEFAULT 0,
+			free_spins_triggered BOOLEAN NOT NULL DEFAULT 0,
+			free_spins_session_id TEXT,
+			created_at DATETIME NOT NULL
+		)`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	return db
+}
+
+func TestExposureGormRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	t.Run("should sum the active free spins sessions with spins left", func(t *testing.T) {
+		db := setupExposureTestDB(t)
+		repo := NewExposureGormRepository(db)
+
+		insert := func(remaining int, bet float64, active bool) {
+			require.NoError(t, db.Exec(`INSERT INTO free_spins_sessions (id, remaining_spins, locked_bet_amount, is_active) VALUES (?, ?, ?, ?)`,
+				uuid.New().String(), remaining, bet, active).Error)
+		}
+		insert(10, 1, true)
+		insert(4, 2.5, true)
+		insert(0, 5, true)   // Last spin in progress
+		insert(12, 5, false) // Completed
+
+		totals, err := repo.OpenFreeSpins(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), totals.Sessions)
+		assert.Equal(t, int64(14), totals.RemainingSpins)
+		assert.InDelta(t, 20, totals.RemainingStake, 0.001)
+	})
+
+	t.Run("should sum the pending triggers by scatter count", func(t *testing.T) {
+		db := setupExposureTestDB(t)
+		repo := NewExposureGormRepository(db)
+
+		insert := func(bet float64, scatters int, triggered, freeSpin bool, sessionID *string, at time.Time) {
+			require.NoError(t, db.Exec(`INSERT INTO spins (id, bet_amount, scatter_count, is_free_spin, free_spins_triggered, free_spins_session_id, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				uuid.New().String(), bet, scatters, freeSpin, triggered, sessionID, at).Error)
+		}
+		resolved := uuid.New().String()
+		insert(1, 3, true, false, nil, now)
+		insert(2, 3, true, false, nil, now)
+		insert(1, 4, true, false, nil, now)
+		insert(1, 3, true, false, &resolved, now)             // Free spins awarded
+		insert(1, 3, true, true, nil, now)                    // Retrigger within free spins
+		insert(1, 2, false, false, nil, now)                  // No trigger
+		insert(1, 3, true, false, nil, now.Add(-2*time.Hour)) // Before the lookback
+
+		totals, err := repo.PendingTriggers(ctx, now.Add(-time.Hour))
+		require.NoError(t, err)
+		require.Len(t, totals, 2)
+		assert.Equal(t, 3, totals[0].ScatterCount)
+		assert.Equal(t, int64(2), totals[0].Triggers)
+		assert.InDelta(t, 3, totals[0].Stake, 0.001)
+		assert.Equal(t, 4, totals[1].ScatterCount)
+		assert.Equal(t, int64(1), totals[1].Triggers)
+	})
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/slotmachine/backend/domain/financialreport"
+	"gorm.io/gorm"
+)
+
+// reportDayLayout formats report days as SQL dates
+const reportDayLayout = "2006-01-02"
+
+// FinancialReportGormRepository implements financialreport.Repository using GORM
+type FinancialReportGormRepository struct {
+	db *gorm.DB
+}
+
+// NewFinancialReportGormRepository creates a new GORM financial report repository
+func NewFinancialReportGormRepository(db *gorm.DB) financialreport.Repository {
+	return &FinancialReportGormRepository{db: db}
+}
+
+// SpinTotals sums spins in [from, to) by the player's game and operator
+func (r *FinancialReportGormRepository) SpinTotals(ctx context.Context, from, to time.Time) ([]financialreport.SpinTotals, error) {
+	var totals []financialreport.SpinTotals
+	err := r.db.WithContext(ctx).
+		Table("spins AS s").
+		Joins("JOIN players AS p ON p.id = s.player_id").
+		Joins("LEFT JOIN operator_player_links AS l ON l.player_id = s.player_id").
+		Where("s.created_at >= ? AND s.created_at < ?", from, to).
+		Select("p.game_id, COALESCE(l.operator_id, '') AS operator_id, " +
+			"COUNT(*) AS spins, " +
+			"COALESCE(SUM(CASE WHEN s.is_free_spin THEN 0 ELSE 1 END), 0) AS paid_spins, " +
+			"COALESCE(SUM(CASE WHEN s.is_free_spin THEN 0 ELSE COALESCE(s.game_mode_cost, s.bet_amount) END), 0) AS wagered, " +
+			"COALESCE(SUM(s.total_win), 0) AS won, " +
+			"COALESCE(SUM(CASE WHEN s.is_free_spin THEN s.total_win ELSE 0 END), 0) AS free_spins_won").
+		Group("p.game_id, COALESCE(l.operator_id, '')").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum spins for financial report: %w", err)
+	}
+	return totals, nil
+}
+
+// BonusTotals sums bonus credits in [from, to) by the player's game and operator
+func (r *FinancialReportGormRepository) BonusTotals(ctx context.Context, from, to time.Time) ([]financialreport.BonusTotals, error) {
+	var totals []financialreport.BonusTotals
+	err := r.db.WithContext(ctx).
+		Table("transactions AS t").
+		Joins("JOIN players AS p ON p.id = t.player_id").
+		Joins("LEFT JOIN operator_player_links AS l ON l.player_id = t.player_id").
+		Where("t.type = ? AND t.created_at >= ? AND t.created_at < ?", "bonus", from, to).
+		Select("p.game_id, COALESCE(l.operator_id, '') AS operator_id, COALESCE(SUM(t.amount), 0) AS amount").
+		Group("p.game_id, COALESCE(l.operator_id, '')").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum bonus credits for financial report: %w", err)
+	}
+	return totals, nil
+}
+
+// ReplaceDay swaps the day's report rows for rows in one transaction
+func (r *FinancialReportGormRepository) ReplaceDay(ctx context.Context, day time.Time, rows []*financialreport.DailySummary) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("report_date >= ? AND report_date < ?", day.Format(reportDayLayout), day.AddDate(0, 0, 1).Format(reportDayLayout)).
+			Delete(&financialreport.DailySummary{}).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.Create(rows).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to replace financial report day: %w", err)
+	}
+	return nil
+}
+
+// LatestDay returns the most recent reported day, or nil if none is
+func (r *FinancialReportGormRepository) LatestDay(ctx context.Context) (*time.Time, error) {
+	var row financialreport.DailySummary
+	if err := r.db.WithContext(ctx).
+		Select("report_date").
+		Order("report_date DESC").
+		First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest financial report day: %w", err)
+	}
+	day := row.ReportDate.UTC()
+	return &day, nil
+}
+
+// List returns report rows ordered by day, game and operator
+func (r *FinancialReportGormRepository) List(ctx context.Context, filters financialreport.ListFilters) ([]*financialreport.DailySummary, error) {
+	query := r.db.WithContext(ctx).
+		Where("report_date >= ? AND report_date < ?", filters.From.Format(reportDayLayout), filters.To.AddDate(0, 0, 1).Format(reportDayLayout))
+	if filters.GameID != nil {
+		query = query.Where("game_id = ?", *filters.GameID)
+	}
+	if filters.OperatorID != nil {
+		query = query.Where("operator_id = ?", *filters.OperatorID)
+	}
+
+	var rows []*financialreport.DailySummary
+	if err := query.Order("report_date, game_id, operator_id").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list financial reports: %w", err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/financialreport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupFinancialReportTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	for _, stmt := range []string{
+		`CREATE TABLE players (id TEXT PRIMARY KEY, game_id TEXT)`,
+		`CREATE TABLE operator_player_links (id TEXT PRIMARY KEY, operator_id TEXT NOT NULL, player_id TEXT NOT NULL)`,
+		`CREATE TABLE spins (
+			id TEXT PRIMARY KEY,
+			player_id TEXT NOT NULL,
+			bet_amount REAL NOT NULL,
+			game_mode_cost REAL,
+			total_win REAL NOT NULL DEFAULT 0,
+			is_free_spin BOOLEAN NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE transactions (id TEXT PRIMARY KEY, player_id TEXT NOT NULL, type TEXT NOT NULL, amount REAL NOT NULL, created_at DATETIME NOT NULL)`,
+		`CREATE TABLE daily_financial_summaries (
+			id TEXT PRIMARY KEY,
+			report_date DATE NOT NULL,
+			game_id TEXT,
+			operator_id TEXT NOT NULL DEFAULT '',
+			spins INTEGER NOT NULL,
+			paid_spins INTEGER NOT NULL,
+			total_wagered REAL NOT NULL,
+			total_won REAL NOT NULL,
+			ggr REAL NOT NULL,
+			free_spin_cost REAL NOT NULL,
+			bonus_cost REAL NOT NULL,
+			jackpot_contributions REAL NOT NULL DEFAULT 0,
+			generated_at DATETIME NOT NULL
+		)`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	return db
+}
+
+func TestFinancialReportGormRepository(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	gameID := uuid.New()
+
+	t.Run("should sum spins and bonus credits by game and operator", func(t *testing.T) {
+		db := setupFinancialReportTestDB(t)
+		repo := NewFinancialReportGormRepository(db)
+
+		direct, linked := uuid.New().String(), uuid.New().String()
+		require.NoError(t, db.Exec(`INSERT INTO players (id, game_id) VALUES (?, ?), (?, NULL)`, direct, gameID.String(), linked).Error)
+		require.NoError(t, db.Exec(`INSERT INTO operator_player_links (id, operator_id, player_id) VALUES (?, ?, ?)`, uuid.New().String(), "acme", linked).Error)
+
+		spin := func(playerID string, bet float64, cost *float64, win float64, freeSpin bool, at time.Time) {
+			require.NoError(t, db.Exec(`INSERT INTO spins (id, player_id, bet_amount, game_mode_cost, total_win, is_free_spin, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				uuid.New().String(), playerID, bet, cost, win, freeSpin, at).Error)
+		}
+		bonusBuy := 100.0
+		spin(direct, 1, nil, 0.5, false, day.Add(time.Hour))
+		spin(direct, 1, &bonusBuy, 20, false, day.Add(2*time.Hour))
+		spin(direct, 1, nil, 7, true, day.Add(3*time.Hour))
+		spin(linked, 2, nil, 0, false, day.Add(4*time.Hour))
+		spin(direct, 50, nil, 0, false, day.Add(-time.Second)) // Day before
+		spin(direct, 50, nil, 0, false, day.AddDate(0, 0, 1))  // Day after
+
+		credit := func(playerID, kind string, amount float64, at time.Time) {
+			require.NoError(t, db.Exec(`INSERT INTO transactions (id, player_id, type, amount, created_at) VALUES (?, ?, ?, ?, ?)`,
+				uuid.New().String(), playerID, kind, amount, at).Error)
+		}
+		credit(linked, "bonus", 10, day.Add(time.Hour))
+		credit(linked, "bonus", 5, day.Add(2*time.Hour))
+		credit(linked, "deposit", 1000, day.Add(time.Hour))
+
+		spins, err := repo.SpinTotals(ctx, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		require.Len(t, spins, 2)
+		byOperator := map[string]financialreport.SpinTotals{}
+		for _, s := range spins {
+			byOperator[s.OperatorID] = s
+		}
+
+		d := byOperator[""]
+		require.NotNil(t, d.GameID)
+		assert.Equal(t, gameID, *d.GameID)
+		assert.Equal(t, int64(3), d.Spins)
+		assert.Equal(t, int64(2), d.PaidSpins)
+		assert.InDelta(t, 101, d.Wagered, 0.001, "a bonus buy counts its price and a free spin nothing")
+		assert.InDelta(t, 27.5, d.Won, 0.001)
+		assert.InDelta(t, 7, d.FreeSpinsWon, 0.001)
+
+		l := byOperator["acme"]
+		assert.Nil(t, l.GameID)
+		assert.Equal(t, int64(1), l.Spins)
+		assert.InDelta(t, 2, l.Wagered, 0.001)
+
+		bonuses, err := repo.BonusTotals(ctx, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		require.Len(t, bonuses, 1)
+		assert.Equal(t, "acme", bonuses[0].OperatorID)
+		assert.InDelta(t, 15, bonuses[0].Amount, 0.001)
+	})
+
+	t.Run("should replace a day's rows and list them", func(t *testing.T) {
+		db := setupFinancialReportTestDB(t)
+		repo := NewFinancialReportGormRepository(db)
+
+		latest, err := repo.LatestDay(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, latest)
+
+		row := func(d time.Time, operatorID string, wagered float64) *financialreport.DailySummary {
+			return &financialreport.DailySummary{ID: uuid.New(), ReportDate: d, GameID: &gameID, OperatorID: operatorID, TotalWagered: wagered, GeneratedAt: time.Now()}
+		}
+		next := day.AddDate(0, 0, 1)
+		require.NoError(t, repo.ReplaceDay(ctx, day, []*financialreport.DailySummary{row(day, "", 1), row(day, "acme", 2)}))
+		require.NoError(t, repo.ReplaceDay(ctx, next, []*financialreport.DailySummary{row(next, "", 3)}))
+		require.NoError(t, repo.ReplaceDay(ctx, day, []*financialreport.DailySummary{row(day, "", 4)}))
+
+		latest, err = repo.LatestDay(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, latest)
+		assert.True(t, next.Equal(*latest))
+
+		rows, err := repo.List(ctx, financialreport.ListFilters{From: day, To: next})
+		require.NoError(t, err)
+		require.Len(t, rows, 2, "regenerating a day replaces its rows")
+		assert.InDelta(t, 4, rows[0].TotalWagered, 0.001)
+		assert.InDelta(t, 3, rows[1].TotalWagered, 0.001)
+
+		acme := "acme"
+		rows, err = repo.List(ctx, financialreport.ListFilters{From: day, To: day, OperatorID: &acme})
+		require.NoError(t, err)
+		assert.Empty(t, rows)
+	})
+}
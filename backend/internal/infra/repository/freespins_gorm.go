@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/slotmachine/backend/domain/freespins"
+	"github.com/slotmachine/backend/domain/game"
 	"gorm.io/gorm"
 )
 
@@ -24,7 +25,7 @@ func NewFreeSpinsGormRepository(db *gorm.DB) freespins.Repository {
 
 // Create creates a new free spins session
 func (r *FreeSpinsGormRepository) Create(ctx context.Context, session *freespins.FreeSpinsSession) error {
-	if err := r.db.WithContext(ctx).Create(session).Error; err != nil {
+	if err := GetDBOrTx(ctx, r.db).Create(session).Error; err != nil {
 		return fmt.Errorf("failed to create free spins session: %w", err)
 	}
 	return nil
@@ -57,7 +58,7 @@ func (r *FreeSpinsGormRepository) GetByID(ctx context.Context, id uuid.UUID) (*f
 // GetActiveByPlayer retrieves the active free spins session for a player
 func (r *FreeSpinsGormRepository) GetActiveByPlayer(ctx context.Context, playerID uuid.UUID) (*freespins.FreeSpinsSession, error) {
 	var session freespins.FreeSpinsSession
-	err := r.db.WithContext(ctx).
+	err := GetDBOrTx(ctx, r.db).
 		Where("player_id = ? AND is_active = ?", playerID, true).
 		Order("created_at DESC").
 		First(&session).Error
@@ -73,7 +74,7 @@ func (r *FreeSpinsGormRepository) GetActiveByPlayer(ctx context.Context, playerI
 
 // Update updates a free spins session
 func (r *FreeSpinsGormRepository) Update(ctx context.Context, session *freespins.FreeSpinsSession) error {
-	result := r.db.WithContext(ctx).Save(session)
+	result := GetDBOrTx(ctx, r.db).Save(session)
 	if result.Error != nil {
 		return fmt.Errorf("failed to update free spins session: %w", result.Error)
 	}
@@ -85,7 +86,7 @@ func (r *FreeSpinsGormRepository) Update(ctx context.Context, session *freespins
 
 // UpdateSpins updates spins completed and remaining
 func (r *FreeSpinsGormRepository) UpdateSpins(ctx context.Context, id uuid.UUID, spinsCompleted, remainingSpins int) error {
-	result := r.db.WithContext(ctx).
+	result := GetDBOrTx(ctx, r.db).
 		Model(&freespins.FreeSpinsSession{}).
 		Where("id = ?", id).
 		Updates(map[string]interface{}{
@@ -102,9 +103,28 @@ func (r *FreeSpinsGormRepository) UpdateSpins(ctx context.Context, id uuid.UUID,
 	return nil
 }
 
+// SetStickyWilds replaces the wilds held in place for the next spin
+func (r *FreeSpinsGormRepository) SetStickyWilds(ctx context.Context, id uuid.UUID, positions game.GridPositions) error {
+	result := GetDBOrTx(ctx, r.db).
+		Model(&freespins.FreeSpinsSession{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"sticky_wilds": positions,
+			"updated_at":   time.Now().UTC(),
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update sticky wilds: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return freespins.ErrFreeSpinsNotFound
+	}
+	return nil
+}
+
 // AddTotalWon updates total won amount
 func (r *FreeSpinsGormRepository) AddTotalWon(ctx context.Context, id uuid.UUID, amount float64) error {
-	result := r.db.WithContext(ctx).
+	result := GetDBOrTx(ctx, r.db).
 		Model(&freespins.FreeSpinsSession{}).
 		Where("id = ?", id).
 		Updates(map[string]any{
@@ -125,7 +145,7 @@ func (r *FreeSpinsGormRepository) AddTotalWon(ctx context.Context, id uuid.UUID,
 func (r *FreeSpinsGormRepository) CompleteSession(ctx context.Context, id uuid.UUID) error {
 	now := time.Now().UTC()
 
-	result := r.db.WithContext(ctx).
+	result := GetDBOrTx(ctx, r.db).
 		Model(&freespins.FreeSpinsSession{}).
 		Where("id = ?", id).
 		Updates(map[string]interface{}{
@@ -145,7 +165,7 @@ func (r *FreeSpinsGormRepository) CompleteSession(ctx context.Context, id uuid.U
 }
 
 func (r *FreeSpinsGormRepository) ExecuteSpinWithLock(ctx context.Context, id uuid.UUID, additionalSpins int, lockVersion int) error {
-	result := r.db.WithContext(ctx).
+	result := GetDBOrTx(ctx, r.db).
 		Model(&freespins.FreeSpinsSession{}).
 		Where("id = ? and lock_version = ?", id, lockVersion).
 		Updates(map[string]interface{}{
@@ -166,7 +186,7 @@ func (r *FreeSpinsGormRepository) ExecuteSpinWithLock(ctx context.Context, id uu
 }
 
 func (r *FreeSpinsGormRepository) RollbackSpin(ctx context.Context, id uuid.UUID, additionalSpins int) error {
-	result := r.db.WithContext(ctx).
+	result := GetDBOrTx(ctx, r.db).
 		Model(&freespins.FreeSpinsSession{}).
 		Where("id = ?", id).
 		Updates(map[string]interface{}{
@@ -187,7 +207,7 @@ func (r *FreeSpinsGormRepository) RollbackSpin(ctx context.Context, id uuid.UUID
 
 // AddSpins adds additional spins (for retrigger)
 func (r *FreeSpinsGormRepository) AddSpins(ctx context.Context, id uuid.UUID, additionalSpins int) error {
-	result := r.db.WithContext(ctx).
+	result := GetDBOrTx(ctx, r.db).
 		Model(&freespins.FreeSpinsSession{}).
 		Where("id = ?", id).
 		Updates(map[string]interface{}{
@@ -39,6 +39,7 @@ func setupFreeSpinsTestDB(t *testing.T) *gorm.DB {
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			lock_version INTEGER DEFAULT 0,
+			sticky_wilds TEXT,
 			completed_at DATETIME
 		)
 	`).Error
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/freespins"
+	"gorm.io/gorm"
+)
+
+// FreeSpinsSettlementGormRepository implements freespins.SettlementRepository using GORM
+type FreeSpinsSettlementGormRepository struct {
+	db *gorm.DB
+}
+
+// NewFreeSpinsSettlementGormRepository creates a new GORM free spins settlement repository
+func NewFreeSpinsSettlementGormRepository(db *gorm.DB) freespins.SettlementRepository {
+	return &FreeSpinsSettlementGormRepository{db: db}
+}
+
+// Create records a settlement
+func (r *FreeSpinsSettlementGormRepository) Create(ctx context.Context, settlement *freespins.Settlement) error {
+	if err := GetDBOrTx(ctx, r.db).Create(settlement).Error; err != nil {
+		return fmt.Errorf("failed to create free spins settlement: %w", err)
+	}
+	return nil
+}
+
+// ListBySession returns the settlements made when a game session was force-ended, oldest first
+func (r *FreeSpinsSettlementGormRepository) ListBySession(ctx context.Context, sessionID uuid.UUID) ([]*freespins.Settlement, error) {
+	var settlements []*freespins.Settlement
+	err := r.db.WithContext(ctx).
+		Where("session_id = ?", sessionID).
+		Order("created_at ASC").
+		Find(&settlements).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list free spins settlements: %w", err)
+	}
+	return settlements, nil
+}
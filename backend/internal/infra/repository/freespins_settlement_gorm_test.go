@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/freespins"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupFreeSpinsSettlementTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	err = db.Exec(`
+		CREATE TABLE free_spins_settlements (
+			id TEXT PRIMARY KEY,
+			free_spins_session_id TEXT NOT NULL,
+			session_id TEXT NOT NULL,
+			player_id TEXT NOT NULL,
+			reason TEXT NOT NULL,
+			policy TEXT NOT NULL,
+			remaining_spins INTEGER NOT NULL,
+			spins_played INTEGER NOT NULL DEFAULT 0,
+			spins_valued INTEGER NOT NULL DEFAULT 0,
+			amount_credited REAL NOT NULL DEFAULT 0,
+			note TEXT NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create free_spins_settlements table")
+	return db
+}
+
+func TestFreeSpinsSettlementGormRepository(t *testing.T) {
+	ctx := context.Background()
+	db := setupFreeSpinsSettlementTestDB(t)
+	repo := NewFreeSpinsSettlementGormRepository(db)
+
+	sessionID := uuid.New()
+	now := time.Now().UTC()
+
+	first := &freespins.Settlement{
+		ID:                 uuid.New(),
+		FreeSpinsSessionID: uuid.New(),
+		SessionID:          sessionID,
+		PlayerID:           uuid.New(),
+		Reason:             session.EndReasonTimeout,
+		Policy:             freespins.SettlementPolicyAverage,
+		RemainingSpins:     4,
+		SpinsValued:        4,
+		AmountCredited:     6.0,
+		Note:               "autoplay failed",
+		CreatedAt:          now.Add(-time.Minute),
+	}
+	second := &freespins.Settlement{
+		ID:                 uuid.New(),
+		FreeSpinsSessionID: uuid.New(),
+		SessionID:          sessionID,
+		PlayerID:           first.PlayerID,
+		Reason:             session.EndReasonAdmin,
+		Policy:             freespins.SettlementPolicyAutoplay,
+		RemainingSpins:     2,
+		SpinsPlayed:        2,
+		AmountCredited:     1.5,
+		CreatedAt:          now,
+	}
+	other := &freespins.Settlement{
+		ID:                 uuid.New(),
+		FreeSpinsSessionID: uuid.New(),
+		SessionID:          uuid.New(),
+		PlayerID:           uuid.New(),
+		Reason:             session.EndReasonTimeout,
+		Policy:             freespins.SettlementPolicyAverage,
+		CreatedAt:          now,
+	}
+	for _, s := range []*freespins.Settlement{second, first, other} {
+		require.NoError(t, repo.Create(ctx, s))
+	}
+
+	settlements, err := repo.ListBySession(ctx, sessionID)
+	require.NoError(t, err)
+	require.Len(t, settlements, 2)
+	assert.Equal(t, first.ID, settlements[0].ID)
+	assert.Equal(t, session.EndReasonTimeout, settlements[0].Reason)
+	assert.Equal(t, freespins.SettlementPolicyAverage, settlements[0].Policy)
+	assert.Equal(t, 6.0, settlements[0].AmountCredited)
+	assert.Equal(t, "autoplay failed", settlements[0].Note)
+	assert.Equal(t, second.ID, settlements[1].ID)
+	assert.Equal(t, 2, settlements[1].SpinsPlayed)
+
+	empty, err := repo.ListBySession(ctx, uuid.New())
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/slotmachine/backend/domain/game"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // GameGormRepository implements game.Repository using GORM
@@ -24,10 +25,10 @@ func NewGameGormRepository(db *gorm.DB) game.Repository {
 
 // ============== Game Methods ==============
 
-// GetGameByID retrieves a game by ID (includes inactive for admin)
+// GetGameByID retrieves a game by ID (includes inactive for admin, not deleted)
 func (r *GameGormRepository) GetGameByID(ctx context.Context, id uuid.UUID) (*game.Game, error) {
 	var g game.Game
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&g).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&g).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, game.ErrGameNotFound
 		}
@@ -44,7 +45,7 @@ func (r *GameGormRepository) GetGamesByIDs(ctx context.Context, ids []uuid.UUID)
 	}
 
 	var games []*game.Game
-	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&games).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id IN ? AND deleted_at IS NULL", ids).Find(&games).Error; err != nil {
 		return nil, fmt.Errorf("failed to get games by IDs: %w", err)
 	}
 
@@ -62,11 +63,12 @@ func (r *GameGormRepository) ListGames(ctx context.Context, page, pageSize int)
 
 	offset := (page - 1) * pageSize
 
-	if err := r.db.WithContext(ctx).Model(&game.Game{}).Count(&total).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&game.Game{}).Where("deleted_at IS NULL").Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count games: %w", err)
 	}
 
 	if err := r.db.WithContext(ctx).
+		Where("deleted_at IS NULL").
 		Order("created_at DESC").
 		Offset(offset).
 		Limit(pageSize).
@@ -90,7 +92,7 @@ func (r *GameGormRepository) CreateGame(ctx context.Context, g *game.Game) error
 // UpdateGame updates a game
 func (r *GameGormRepository) UpdateGame(ctx context.Context, id uuid.UUID, update *game.GameUpdate) (*game.Game, error) {
 	var g game.Game
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&g).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&g).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, game.ErrGameNotFound
 		}
@@ -129,9 +131,17 @@ func (r *GameGormRepository) UpdateGame(ctx context.Context, id uuid.UUID, updat
 	return &g, nil
 }
 
-// DeleteGame deletes a game
+// DeleteGame soft deletes a game, deactivating it
 func (r *GameGormRepository) DeleteGame(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&game.Game{})
+	now := time.Now()
+	result := r.db.WithContext(ctx).
+		Model(&game.Game{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"is_active":  false,
+			"deleted_at": now,
+			"updated_at": now,
+		})
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete game: %w", result.Error)
 	}
@@ -141,12 +151,41 @@ func (r *GameGormRepository) DeleteGame(ctx context.Context, id uuid.UUID) error
 	return nil
 }
 
+// RestoreGame undeletes a soft-deleted game; it stays inactive until activated
+func (r *GameGormRepository) RestoreGame(ctx context.Context, id uuid.UUID) (*game.Game, error) {
+	var g game.Game
+	if err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NOT NULL", id).First(&g).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, game.ErrGameNotFound
+		}
+		return nil, fmt.Errorf("failed to get deleted game: %w", err)
+	}
+
+	var taken int64
+	if err := r.db.WithContext(ctx).Model(&game.Game{}).
+		Where("name = ? AND deleted_at IS NULL", g.Name).
+		Count(&taken).Error; err != nil {
+		return nil, fmt.Errorf("failed to check game name: %w", err)
+	}
+	if taken > 0 {
+		return nil, game.ErrNameTaken
+	}
+
+	if err := r.db.WithContext(ctx).Model(&g).Updates(map[string]interface{}{
+		"deleted_at": nil,
+		"updated_at": time.Now(),
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to restore game: %w", err)
+	}
+	return r.GetGameByID(ctx, id)
+}
+
 // ============== Asset Methods ==============
 
-// GetAssetByID retrieves an asset by ID (includes inactive for admin)
+// GetAssetByID retrieves an asset by ID (includes inactive for admin, not deleted)
 func (r *GameGormRepository) GetAssetByID(ctx context.Context, id uuid.UUID) (*game.Asset, error) {
 	var a game.Asset
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&a).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&a).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, game.ErrAssetNotFound
 		}
@@ -155,6 +194,18 @@ func (r *GameGormRepository) GetAssetByID(ctx context.Context, id uuid.UUID) (*g
 	return &a, nil
 }
 
+// GetAssetByObjectName retrieves an asset by its storage folder name
+func (r *GameGormRepository) GetAssetByObjectName(ctx context.Context, objectName string) (*game.Asset, error) {
+	var a game.Asset
+	if err := r.db.WithContext(ctx).Where("object_name = ? AND deleted_at IS NULL", objectName).First(&a).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, game.ErrAssetNotFound
+		}
+		return nil, fmt.Errorf("failed to get asset by object name: %w", err)
+	}
+	return &a, nil
+}
+
 // ListAssets lists all assets with pagination
 func (r *GameGormRepository) ListAssets(ctx context.Context, page, pageSize int) ([]*game.Asset, int64, error) {
 	var assets []*game.Asset
@@ -162,11 +213,12 @@ func (r *GameGormRepository) ListAssets(ctx context.Context, page, pageSize int)
 
 	offset := (page - 1) * pageSize
 
-	if err := r.db.WithContext(ctx).Model(&game.Asset{}).Count(&total).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&game.Asset{}).Where("deleted_at IS NULL").Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count assets: %w", err)
 	}
 
 	if err := r.db.WithContext(ctx).
+		Where("deleted_at IS NULL").
 		Order("created_at DESC").
 		Offset(offset).
 		Limit(pageSize).
@@ -190,7 +242,7 @@ func (r *GameGormRepository) CreateAsset(ctx context.Context, a *game.Asset) err
 // UpdateAsset updates an asset
 func (r *GameGormRepository) UpdateAsset(ctx context.Context, id uuid.UUID, update *game.AssetUpdate) (*game.Asset, error) {
 	var a game.Asset
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&a).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&a).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, game.ErrAssetNotFound
 		}
@@ -225,6 +277,9 @@ func (r *GameGormRepository) UpdateAsset(ctx context.Context, id uuid.UUID, upda
 	if update.Videos != nil {
 		updates["videos"] = update.Videos
 	}
+	if update.IsPrivate != nil {
+		updates["is_private"] = *update.IsPrivate
+	}
 	if update.IsActive != nil {
 		updates["is_active"] = *update.IsActive
 	}
@@ -241,9 +296,28 @@ func (r *GameGormRepository) UpdateAsset(ctx context.Context, id uuid.UUID, upda
 	return &a, nil
 }
 
-// DeleteAsset deletes an asset
+// DeleteAsset soft deletes an asset no game config uses, deactivating it
+// Its files and file records are kept so a restored asset is served again as it was.
 func (r *GameGormRepository) DeleteAsset(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&game.Asset{})
+	var inUse int64
+	if err := r.db.WithContext(ctx).Model(&game.GameConfig{}).
+		Where("asset_id = ? AND deleted_at IS NULL", id).
+		Count(&inUse).Error; err != nil {
+		return fmt.Errorf("failed to check asset usage: %w", err)
+	}
+	if inUse > 0 {
+		return game.ErrAssetInUse
+	}
+
+	now := time.Now()
+	result := r.db.WithContext(ctx).
+		Model(&game.Asset{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"is_active":  false,
+			"deleted_at": now,
+			"updated_at": now,
+		})
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete asset: %w", result.Error)
 	}
@@ -253,13 +327,90 @@ func (r *GameGormRepository) DeleteAsset(ctx context.Context, id uuid.UUID) erro
 	return nil
 }
 
+// RestoreAsset undeletes a soft-deleted asset; it stays inactive until activated
+func (r *GameGormRepository) RestoreAsset(ctx context.Context, id uuid.UUID) (*game.Asset, error) {
+	var a game.Asset
+	if err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NOT NULL", id).First(&a).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, game.ErrAssetNotFound
+		}
+		return nil, fmt.Errorf("failed to get deleted asset: %w", err)
+	}
+
+	var taken int64
+	if err := r.db.WithContext(ctx).Model(&game.Asset{}).
+		Where("(name = ? OR object_name = ?) AND deleted_at IS NULL", a.Name, a.ObjectName).
+		Count(&taken).Error; err != nil {
+		return nil, fmt.Errorf("failed to check asset name: %w", err)
+	}
+	if taken > 0 {
+		return nil, game.ErrNameTaken
+	}
+
+	if err := r.db.WithContext(ctx).Model(&a).Updates(map[string]interface{}{
+		"deleted_at": nil,
+		"updated_at": time.Now(),
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to restore asset: %w", err)
+	}
+	return r.GetAssetByID(ctx, id)
+}
+
+// ============== AssetFile Methods ==============
+
+// UpsertAssetFile creates or replaces the hash record for an asset file
+func (r *GameGormRepository) UpsertAssetFile(ctx context.Context, f *game.AssetFile) error {
+	now := time.Now()
+	f.CreatedAt = now
+	f.UpdatedAt = now
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "asset_id"}, {Name: "path"}},
+		DoUpdates: clause.AssignmentColumns([]string{"sha256", "size", "content_type", "updated_at"}),
+	}).Create(f).Error; err != nil {
+		return fmt.Errorf("failed to upsert asset file: %w", err)
+	}
+	return nil
+}
+
+// ListAssetFiles lists all file records for an asset ordered by path
+func (r *GameGormRepository) ListAssetFiles(ctx context.Context, assetID uuid.UUID) ([]*game.AssetFile, error) {
+	var files []*game.AssetFile
+	if err := r.db.WithContext(ctx).
+		Where("asset_id = ?", assetID).
+		Order("path ASC").
+		Find(&files).Error; err != nil {
+		return nil, fmt.Errorf("failed to list asset files: %w", err)
+	}
+	return files, nil
+}
+
+// DeleteAssetFile removes the record for a single asset file (no-op if absent)
+func (r *GameGormRepository) DeleteAssetFile(ctx context.Context, assetID uuid.UUID, path string) error {
+	if err := r.db.WithContext(ctx).
+		Where("asset_id = ? AND path = ?", assetID, path).
+		Delete(&game.AssetFile{}).Error; err != nil {
+		return fmt.Errorf("failed to delete asset file: %w", err)
+	}
+	return nil
+}
+
+// DeleteAssetFiles removes all file records for an asset
+func (r *GameGormRepository) DeleteAssetFiles(ctx context.Context, assetID uuid.UUID) error {
+	if err := r.db.WithContext(ctx).
+		Where("asset_id = ?", assetID).
+		Delete(&game.AssetFile{}).Error; err != nil {
+		return fmt.Errorf("failed to delete asset files: %w", err)
+	}
+	return nil
+}
+
 // ============== GameConfig Methods ==============
 
 // GetActiveAssetForGame retrieves the active asset configuration for a game
 func (r *GameGormRepository) GetActiveAssetForGame(ctx context.Context, gameID uuid.UUID) (*game.Asset, error) {
 	// First check if the game exists
 	var g game.Game
-	if err := r.db.WithContext(ctx).Where("id = ? AND is_active = true", gameID).First(&g).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ? AND is_active = true AND deleted_at IS NULL", gameID).First(&g).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, game.ErrGameNotFound
 		}
@@ -270,7 +421,7 @@ func (r *GameGormRepository) GetActiveAssetForGame(ctx context.Context, gameID u
 	var config game.GameConfig
 	if err := r.db.WithContext(ctx).
 		Preload("Asset").
-		Where("game_id = ? AND is_active = true", gameID).
+		Where("game_id = ? AND is_active = true AND deleted_at IS NULL", gameID).
 		First(&config).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, game.ErrNoActiveConfig
@@ -278,20 +429,124 @@ func (r *GameGormRepository) GetActiveAssetForGame(ctx context.Context, gameID u
 		return nil, fmt.Errorf("failed to get game config: %w", err)
 	}
 
-	if config.Asset == nil || !config.Asset.IsActive {
+	if config.Asset == nil || !config.Asset.IsActive || config.Asset.DeletedAt != nil {
 		return nil, game.ErrNoActiveConfig
 	}
 
 	return config.Asset, nil
 }
 
+// GetActiveGameConfig retrieves the active config for a game
+func (r *GameGormRepository) GetActiveGameConfig(ctx context.Context, gameID uuid.UUID) (*game.GameConfig, error) {
+	var config game.GameConfig
+	if err := r.db.WithContext(ctx).
+		Where("game_id = ? AND is_active = true AND deleted_at IS NULL", gameID).
+		First(&config).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, game.ErrNoActiveConfig
+		}
+		return nil, fmt.Errorf("failed to get active game config: %w", err)
+	}
+	return &config, nil
+}
+
+// UpdateGameConfigLadder sets a game config's multiplier ladder (nil restores the engine default)
+func (r *GameGormRepository) UpdateGameConfigLadder(ctx context.Context, id uuid.UUID, ladder *game.MultiplierLadder) (*game.GameConfig, error) {
+	result := r.db.WithContext(ctx).
+		Model(&game.GameConfig{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"multiplier_ladder": ladder,
+			"updated_at":        time.Now(),
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to update multiplier ladder: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, game.ErrGameConfigNotFound
+	}
+	return r.GetGameConfigByID(ctx, id)
+}
+
+// UpdateGameConfigTriggerRules sets a game config's free spins trigger rules (nil restores the engine default)
+func (r *GameGormRepository) UpdateGameConfigTriggerRules(ctx context.Context, id uuid.UUID, rules *game.TriggerRules) (*game.GameConfig, error) {
+	result := r.db.WithContext(ctx).
+		Model(&game.GameConfig{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"trigger_rules": rules,
+			"updated_at":    time.Now(),
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to update trigger rules: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, game.ErrGameConfigNotFound
+	}
+	return r.GetGameConfigByID(ctx, id)
+}
+
+// UpdateGameConfigWildFeatures sets a game config's wild features (nil disables them)
+func (r *GameGormRepository) UpdateGameConfigWildFeatures(ctx context.Context, id uuid.UUID, features *game.WildFeatures) (*game.GameConfig, error) {
+	result := r.db.WithContext(ctx).
+		Model(&game.GameConfig{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"wild_features": features,
+			"updated_at":    time.Now(),
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to update wild features: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, game.ErrGameConfigNotFound
+	}
+	return r.GetGameConfigByID(ctx, id)
+}
+
+// UpdateGameConfigWinTiers sets a game config's win presentation tiers (nil resets them to the default)
+func (r *GameGormRepository) UpdateGameConfigWinTiers(ctx context.Context, id uuid.UUID, tiers *game.WinTiers) (*game.GameConfig, error) {
+	result := r.db.WithContext(ctx).
+		Model(&game.GameConfig{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"win_tiers":  tiers,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to update win tiers: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, game.ErrGameConfigNotFound
+	}
+	return r.GetGameConfigByID(ctx, id)
+}
+
+// UpdateGameConfigTimingProfiles sets a game config's animation timing (nil resets it to the default)
+func (r *GameGormRepository) UpdateGameConfigTimingProfiles(ctx context.Context, id uuid.UUID, profiles *game.TimingProfiles) (*game.GameConfig, error) {
+	result := r.db.WithContext(ctx).
+		Model(&game.GameConfig{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"timing_profiles": profiles,
+			"updated_at":      time.Now(),
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to update timing profiles: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, game.ErrGameConfigNotFound
+	}
+	return r.GetGameConfigByID(ctx, id)
+}
+
 // GetGameConfigByID retrieves a game config by ID
 func (r *GameGormRepository) GetGameConfigByID(ctx context.Context, id uuid.UUID) (*game.GameConfig, error) {
 	var config game.GameConfig
 	if err := r.db.WithContext(ctx).
 		Preload("Game").
 		Preload("Asset").
-		Where("id = ?", id).
+		Where("id = ? AND deleted_at IS NULL", id).
 		First(&config).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, game.ErrGameConfigNotFound
@@ -308,13 +563,14 @@ func (r *GameGormRepository) ListGameConfigs(ctx context.Context, page, pageSize
 
 	offset := (page - 1) * pageSize
 
-	if err := r.db.WithContext(ctx).Model(&game.GameConfig{}).Count(&total).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&game.GameConfig{}).Where("deleted_at IS NULL").Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count game configs: %w", err)
 	}
 
 	if err := r.db.WithContext(ctx).
 		Preload("Game").
 		Preload("Asset").
+		Where("deleted_at IS NULL").
 		Order("created_at DESC").
 		Offset(offset).
 		Limit(pageSize).
@@ -335,9 +591,17 @@ func (r *GameGormRepository) CreateGameConfig(ctx context.Context, c *game.GameC
 	return nil
 }
 
-// DeleteGameConfig deletes a game config
+// DeleteGameConfig soft deletes a game config, deactivating it
 func (r *GameGormRepository) DeleteGameConfig(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&game.GameConfig{})
+	now := time.Now()
+	result := r.db.WithContext(ctx).
+		Model(&game.GameConfig{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"is_active":  false,
+			"deleted_at": now,
+			"updated_at": now,
+		})
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete game config: %w", result.Error)
 	}
@@ -347,10 +611,28 @@ func (r *GameGormRepository) DeleteGameConfig(ctx context.Context, id uuid.UUID)
 	return nil
 }
 
+// RestoreGameConfig undeletes a soft-deleted game config; it stays inactive until activated
+func (r *GameGormRepository) RestoreGameConfig(ctx context.Context, id uuid.UUID) (*game.GameConfig, error) {
+	result := r.db.WithContext(ctx).
+		Model(&game.GameConfig{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to restore game config: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, game.ErrGameConfigNotFound
+	}
+	return r.GetGameConfigByID(ctx, id)
+}
+
 // ActivateGameConfig activates a game config (and deactivates others for the same game)
 func (r *GameGormRepository) ActivateGameConfig(ctx context.Context, id uuid.UUID) (*game.GameConfig, error) {
 	var config game.GameConfig
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&config).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&config).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, game.ErrGameConfigNotFound
 		}
@@ -390,7 +672,7 @@ func (r *GameGormRepository) ActivateGameConfig(ctx context.Context, id uuid.UUI
 // DeactivateGameConfig deactivates a game config
 func (r *GameGormRepository) DeactivateGameConfig(ctx context.Context, id uuid.UUID) (*game.GameConfig, error) {
 	var config game.GameConfig
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&config).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&config).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, game.ErrGameConfigNotFound
 		}
@@ -0,0 +1,341 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupGameTestDB creates an in-memory SQLite database for testing asset files
+func setupGameTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	err = db.Exec(`
+		CREATE TABLE asset_files (
+			id TEXT PRIMARY KEY,
+			asset_id TEXT NOT NULL,
+			path TEXT NOT NULL,
+			sha256 TEXT NOT NULL,
+			size INTEGER NOT NULL,
+			content_type TEXT NOT NULL DEFAULT '',
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create asset_files table")
+
+	err = db.Exec("CREATE UNIQUE INDEX idx_asset_files_asset_path ON asset_files(asset_id, path)").Error
+	require.NoError(t, err, "Failed to create asset_id/path index")
+
+	return db
+}
+
+func TestGameGormRepository_AssetFiles(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should overwrite hash on re-upload of the same path", func(t *testing.T) {
+		repo := NewGameGormRepository(setupGameTestDB(t))
+		assetID := uuid.New()
+
+		require.NoError(t, repo.UpsertAssetFile(ctx, &game.AssetFile{ID: uuid.New(), AssetID: assetID, Path: "bg.png", SHA256: "aa", Size: 10}))
+		require.NoError(t, repo.UpsertAssetFile(ctx, &game.AssetFile{ID: uuid.New(), AssetID: assetID, Path: "bg.png", SHA256: "bb", Size: 20}))
+
+		files, err := repo.ListAssetFiles(ctx, assetID)
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Equal(t, "bb", files[0].SHA256)
+		assert.Equal(t, int64(20), files[0].Size)
+	})
+
+	t.Run("should list files of one asset ordered by path", func(t *testing.T) {
+		repo := NewGameGormRepository(setupGameTestDB(t))
+		assetID := uuid.New()
+		otherID := uuid.New()
+
+		for _, p := range []string{"tiles/b.png", "audio/a.mp3", "bg.png"} {
+			require.NoError(t, repo.UpsertAssetFile(ctx, &game.AssetFile{ID: uuid.New(), AssetID: assetID, Path: p, SHA256: "x", Size: 1}))
+		}
+		require.NoError(t, repo.UpsertAssetFile(ctx, &game.AssetFile{ID: uuid.New(), AssetID: otherID, Path: "bg.png", SHA256: "y", Size: 1}))
+
+		files, err := repo.ListAssetFiles(ctx, assetID)
+		require.NoError(t, err)
+		require.Len(t, files, 3)
+		assert.Equal(t, "audio/a.mp3", files[0].Path)
+		assert.Equal(t, "bg.png", files[1].Path)
+		assert.Equal(t, "tiles/b.png", files[2].Path)
+	})
+
+	t.Run("should delete single file and all files of an asset", func(t *testing.T) {
+		repo := NewGameGormRepository(setupGameTestDB(t))
+		assetID := uuid.New()
+
+		for _, p := range []string{"a.png", "b.png", "c.png"} {
+			require.NoError(t, repo.UpsertAssetFile(ctx, &game.AssetFile{ID: uuid.New(), AssetID: assetID, Path: p, SHA256: "x", Size: 1}))
+		}
+
+		require.NoError(t, repo.DeleteAssetFile(ctx, assetID, "b.png"))
+		files, err := repo.ListAssetFiles(ctx, assetID)
+		require.NoError(t, err)
+		assert.Len(t, files, 2)
+
+		require.NoError(t, repo.DeleteAssetFiles(ctx, assetID))
+		files, err = repo.ListAssetFiles(ctx, assetID)
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+}
+
+func TestManifestHash(t *testing.T) {
+	a := &game.AssetFile{Path: "a.png", SHA256: "11"}
+	b := &game.AssetFile{Path: "b.png", SHA256: "22"}
+
+	t.Run("should not depend on input order", func(t *testing.T) {
+		assert.Equal(t, game.ManifestHash([]*game.AssetFile{a, b}), game.ManifestHash([]*game.AssetFile{b, a}))
+	})
+
+	t.Run("should change when a file hash changes", func(t *testing.T) {
+		changed := &game.AssetFile{Path: "b.png", SHA256: "33"}
+		assert.NotEqual(t, game.ManifestHash([]*game.AssetFile{a, b}), game.ManifestHash([]*game.AssetFile{a, changed}))
+	})
+}
+
+// gameConfigsTableDDL creates the game_configs table with every rules column
+const gameConfigsTableDDL = `CREATE TABLE game_configs (
+	id TEXT PRIMARY KEY,
+	game_id TEXT NOT NULL,
+	asset_id TEXT NOT NULL,
+	is_active INTEGER DEFAULT 1,
+	multiplier_ladder TEXT,
+	trigger_rules TEXT,
+	wild_features TEXT,
+	win_tiers TEXT,
+	timing_profiles TEXT,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	deleted_at DATETIME
+)`
+
+func TestGameGormRepository_GameRules(t *testing.T) {
+	ctx := context.Background()
+	db := setupGameTestDB(t)
+	for _, stmt := range []string{
+		`CREATE TABLE games (id TEXT PRIMARY KEY, name TEXT, is_active INTEGER DEFAULT 1, deleted_at DATETIME)`,
+		`CREATE TABLE assets (id TEXT PRIMARY KEY, name TEXT, is_active INTEGER DEFAULT 1, deleted_at DATETIME)`,
+		gameConfigsTableDDL,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	repo := NewGameGormRepository(db)
+
+	gameID := uuid.New()
+	config := &game.GameConfig{ID: uuid.New(), GameID: gameID, AssetID: uuid.New(), IsActive: true}
+	require.NoError(t, repo.CreateGameConfig(ctx, config))
+
+	t.Run("should store and load the ladder", func(t *testing.T) {
+		ladder := &game.MultiplierLadder{BaseGame: []int{1, 2, 4}, FreeSpins: []int{2, 4, 8}}
+		updated, err := repo.UpdateGameConfigLadder(ctx, config.ID, ladder)
+		require.NoError(t, err)
+		require.NotNil(t, updated.MultiplierLadder)
+		assert.Equal(t, []int{1, 2, 4}, updated.MultiplierLadder.BaseGame)
+
+		active, err := repo.GetActiveGameConfig(ctx, gameID)
+		require.NoError(t, err)
+		require.NotNil(t, active.MultiplierLadder)
+		assert.Equal(t, []int{2, 4, 8}, active.MultiplierLadder.FreeSpins)
+	})
+
+	t.Run("should clear the ladder", func(t *testing.T) {
+		updated, err := repo.UpdateGameConfigLadder(ctx, config.ID, nil)
+		require.NoError(t, err)
+		assert.Nil(t, updated.MultiplierLadder)
+	})
+
+	t.Run("should return not found for unknown config", func(t *testing.T) {
+		_, err := repo.UpdateGameConfigLadder(ctx, uuid.New(), nil)
+		assert.ErrorIs(t, err, game.ErrGameConfigNotFound)
+	})
+
+	t.Run("should store and clear trigger rules", func(t *testing.T) {
+		rules := &game.TriggerRules{MinScatters: 4, BaseAward: 10, ExtraPerScatter: 3, Retrigger: false}
+		updated, err := repo.UpdateGameConfigTriggerRules(ctx, config.ID, rules)
+		require.NoError(t, err)
+		assert.Equal(t, rules, updated.TriggerRules)
+
+		active, err := repo.GetActiveGameConfig(ctx, gameID)
+		require.NoError(t, err)
+		assert.Equal(t, rules, active.TriggerRules)
+
+		updated, err = repo.UpdateGameConfigTriggerRules(ctx, config.ID, nil)
+		require.NoError(t, err)
+		assert.Nil(t, updated.TriggerRules)
+	})
+
+	t.Run("should store and clear wild features", func(t *testing.T) {
+		features := &game.WildFeatures{StickyWilds: true, WildMultiplier: 2}
+		updated, err := repo.UpdateGameConfigWildFeatures(ctx, config.ID, features)
+		require.NoError(t, err)
+		assert.Equal(t, features, updated.WildFeatures)
+
+		updated, err = repo.UpdateGameConfigWildFeatures(ctx, config.ID, nil)
+		require.NoError(t, err)
+		assert.Nil(t, updated.WildFeatures)
+	})
+
+	t.Run("should store and reset win tiers", func(t *testing.T) {
+		tiers := &game.WinTiers{
+			{Name: "nice", MinMultiplier: 0, Audio: "coins"},
+			{Name: "huge", MinMultiplier: 50, Video: "huge_win", Audio: "fanfare"},
+		}
+		updated, err := repo.UpdateGameConfigWinTiers(ctx, config.ID, tiers)
+		require.NoError(t, err)
+		assert.Equal(t, tiers, updated.WinTiers)
+
+		updated, err = repo.UpdateGameConfigWinTiers(ctx, config.ID, nil)
+		require.NoError(t, err)
+		assert.Nil(t, updated.WinTiers)
+	})
+
+	t.Run("should store and reset timing profiles", func(t *testing.T) {
+		profiles := &game.TimingProfiles{
+			Normal:    game.TimingProfile{ReelSpinMs: 1000, CascadeMs: 800, ExpandMs: 400},
+			Turbo:     game.TimingProfile{ReelSpinMs: 300, CascadeMs: 300, ExpandMs: 100},
+			MinSpinMs: 2500,
+		}
+		updated, err := repo.UpdateGameConfigTimingProfiles(ctx, config.ID, profiles)
+		require.NoError(t, err)
+		assert.Equal(t, profiles, updated.TimingProfiles)
+
+		updated, err = repo.UpdateGameConfigTimingProfiles(ctx, config.ID, nil)
+		require.NoError(t, err)
+		assert.Nil(t, updated.TimingProfiles)
+	})
+
+	t.Run("should report games without an active config", func(t *testing.T) {
+		_, err := repo.GetActiveGameConfig(ctx, uuid.New())
+		assert.ErrorIs(t, err, game.ErrNoActiveConfig)
+	})
+}
+
+func TestGameGormRepository_SoftDelete(t *testing.T) {
+	ctx := context.Background()
+	db := setupGameTestDB(t)
+	for _, stmt := range []string{
+		`CREATE TABLE games (
+			id TEXT PRIMARY KEY,
+			name TEXT NOT NULL,
+			description TEXT,
+			dev_url TEXT,
+			prod_url TEXT,
+			is_active INTEGER DEFAULT 1,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			deleted_at DATETIME
+		)`,
+		`CREATE UNIQUE INDEX idx_games_name_live ON games (name) WHERE deleted_at IS NULL`,
+		`CREATE TABLE assets (
+			id TEXT PRIMARY KEY,
+			name TEXT NOT NULL,
+			description TEXT,
+			object_name TEXT NOT NULL,
+			base_url TEXT NOT NULL,
+			spritesheet_json TEXT NOT NULL,
+			images TEXT NOT NULL,
+			audios TEXT DEFAULT '{}',
+			videos TEXT DEFAULT '{}',
+			is_private INTEGER DEFAULT 0,
+			is_active INTEGER DEFAULT 1,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			deleted_at DATETIME
+		)`,
+		gameConfigsTableDDL,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	repo := NewGameGormRepository(db)
+
+	newAsset := func(name string) *game.Asset {
+		return &game.Asset{
+			ID: uuid.New(), Name: name, ObjectName: name, BaseURL: "http://assets/" + name,
+			SpritesheetJSON: []byte(`{}`), Images: []byte(`{}`), Audios: []byte(`{}`), Videos: []byte(`{}`), IsActive: true,
+		}
+	}
+
+	t.Run("deleted game is hidden and restored inactive", func(t *testing.T) {
+		g := &game.Game{ID: uuid.New(), Name: "Fortune", IsActive: true}
+		require.NoError(t, repo.CreateGame(ctx, g))
+
+		require.NoError(t, repo.DeleteGame(ctx, g.ID))
+		_, err := repo.GetGameByID(ctx, g.ID)
+		assert.ErrorIs(t, err, game.ErrGameNotFound)
+		games, total, err := repo.ListGames(ctx, 1, 10)
+		require.NoError(t, err)
+		assert.Empty(t, games)
+		assert.Zero(t, total)
+		assert.ErrorIs(t, repo.DeleteGame(ctx, g.ID), game.ErrGameNotFound)
+
+		restored, err := repo.RestoreGame(ctx, g.ID)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+		assert.False(t, restored.IsActive)
+
+		_, err = repo.RestoreGame(ctx, g.ID)
+		assert.ErrorIs(t, err, game.ErrGameNotFound)
+	})
+
+	t.Run("deleted game name can be reused but then not restored", func(t *testing.T) {
+		g := &game.Game{ID: uuid.New(), Name: "Jade", IsActive: true}
+		require.NoError(t, repo.CreateGame(ctx, g))
+		require.NoError(t, repo.DeleteGame(ctx, g.ID))
+		require.NoError(t, repo.CreateGame(ctx, &game.Game{ID: uuid.New(), Name: "Jade", IsActive: true}))
+
+		_, err := repo.RestoreGame(ctx, g.ID)
+		assert.ErrorIs(t, err, game.ErrNameTaken)
+	})
+
+	t.Run("asset used by a game config cannot be deleted", func(t *testing.T) {
+		a := newAsset("bamboo")
+		require.NoError(t, repo.CreateAsset(ctx, a))
+		config := &game.GameConfig{ID: uuid.New(), GameID: uuid.New(), AssetID: a.ID, IsActive: true}
+		require.NoError(t, repo.CreateGameConfig(ctx, config))
+
+		assert.ErrorIs(t, repo.DeleteAsset(ctx, a.ID), game.ErrAssetInUse)
+
+		require.NoError(t, repo.DeleteGameConfig(ctx, config.ID))
+		require.NoError(t, repo.DeleteAsset(ctx, a.ID))
+		_, err := repo.GetAssetByObjectName(ctx, "bamboo")
+		assert.ErrorIs(t, err, game.ErrAssetNotFound)
+
+		restored, err := repo.RestoreAsset(ctx, a.ID)
+		require.NoError(t, err)
+		assert.False(t, restored.IsActive)
+	})
+
+	t.Run("deleted game config is no longer the active config", func(t *testing.T) {
+		gameID := uuid.New()
+		config := &game.GameConfig{ID: uuid.New(), GameID: gameID, AssetID: uuid.New(), IsActive: true}
+		require.NoError(t, repo.CreateGameConfig(ctx, config))
+
+		require.NoError(t, repo.DeleteGameConfig(ctx, config.ID))
+		_, err := repo.GetActiveGameConfig(ctx, gameID)
+		assert.ErrorIs(t, err, game.ErrNoActiveConfig)
+		_, err = repo.ActivateGameConfig(ctx, config.ID)
+		assert.ErrorIs(t, err, game.ErrGameConfigNotFound)
+
+		restored, err := repo.RestoreGameConfig(ctx, config.ID)
+		require.NoError(t, err)
+		assert.False(t, restored.IsActive)
+		_, err = repo.GetActiveGameConfig(ctx, gameID)
+		assert.ErrorIs(t, err, game.ErrNoActiveConfig)
+	})
+}
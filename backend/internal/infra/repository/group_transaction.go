@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/spin"
+	"gorm.io/gorm"
+)
+
+// groupRowsKey is the context key for the rows a unit of a group transaction defers
+type groupRowsKey struct{}
+
+// groupRows collects the append-only rows a unit inserts, written for the whole group at once
+type groupRows struct {
+	spins    []*spin.Spin
+	spinLogs []*provablyfair.SpinLog
+}
+
+// GroupUnit is one caller's transaction inside a group transaction
+type GroupUnit struct {
+	Ctx context.Context
+	Fn  func(ctx context.Context) error
+	Err error // Set by WithGroupTransaction; nil once the group committed the unit
+
+	rows  groupRows
+	hooks afterCommitHooks
+}
+
+// RunAfterCommit runs the hooks the unit registered with AfterCommit; call it once Err is nil
+func (u *GroupUnit) RunAfterCommit() {
+	hookCtx := context.WithoutCancel(u.Ctx)
+	for _, hook := range u.hooks.fns {
+		hook(hookCtx)
+	}
+}
+
+// WithGroupTransaction commits several units in one database transaction
+// Each unit runs in its own savepoint, so a unit that fails is rolled back alone and gets its
+// error in Err. Spins and spin logs the committed units create are inserted with one statement
+// per table, in unit order, just before the commit. If that insert or the commit fails, every
+// unit is rolled back and fails with the error: no unit is ever reported committed unless its
+// rows are.
+//
+// A unit whose context is done is skipped, but one that started runs without its caller's
+// cancellation: cancelling a query cancels it on the connection the whole group shares. On
+// PostgreSQL, unitTimeout bounds each statement and lock wait of a unit instead, failing the unit
+// alone; 0 leaves them unbounded. Locks a unit takes are held until the group commits, so callers
+// order units by what they lock (e.g. the player) to keep concurrent groups from deadlocking.
+func (m *TxManager) WithGroupTransaction(ctx context.Context, units []*GroupUnit, unitTimeout time.Duration) {
+	for _, u := range units {
+		u.Err = nil
+	}
+	bounded := unitTimeout > 0 && m.db.Dialector.Name() == "postgres"
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var committed []*GroupUnit
+		for _, u := range units {
+			if err := u.Ctx.Err(); err != nil {
+				u.Err = err
+				continue
+			}
+			u.rows = groupRows{}
+			u.hooks = afterCommitHooks{}
+			u.Err = tx.Transaction(func(sp *gorm.DB) error {
+				// SET LOCAL inside the savepoint is undone if the unit rolls back
+				if bounded {
+					if err := setUnitTimeout(sp, fmt.Sprint(unitTimeout.Milliseconds())); err != nil {
+						return err
+					}
+				}
+				txCtx := context.WithValue(context.WithoutCancel(u.Ctx), txKey{}, sp)
+				txCtx = context.WithValue(txCtx, afterCommitKey{}, &u.hooks)
+				txCtx = context.WithValue(txCtx, groupRowsKey{}, &u.rows)
+				return u.Fn(txCtx)
+			})
+			if u.Err == nil {
+				committed = append(committed, u)
+			}
+		}
+		// The group's insert is bounded by ctx, not by the last unit's timeouts
+		if bounded {
+			if err := setUnitTimeout(tx, "DEFAULT"); err != nil {
+				return err
+			}
+		}
+		return insertGroupRows(tx, committed)
+	})
+	if err != nil {
+		for _, u := range units {
+			if u.Err == nil {
+				u.Err = fmt.Errorf("failed to commit group transaction: %w", err)
+			}
+		}
+	}
+}
+
+// setUnitTimeout sets the timeout of the statements and lock waits that follow in tx to value,
+// in milliseconds or DEFAULT
+// SET takes no bind parameters, so value is formatted into the statement and must never come from input.
+func setUnitTimeout(tx *gorm.DB, value string) error {
+	if err := tx.Exec("SET LOCAL lock_timeout = " + value).Error; err != nil {
+		return fmt.Errorf("failed to set lock timeout: %w", err)
+	}
+	if err := tx.Exec("SET LOCAL statement_timeout = " + value).Error; err != nil {
+		return fmt.Errorf("failed to set statement timeout: %w", err)
+	}
+	return nil
+}
+
+// insertGroupRows writes the rows the committed units deferred
+func insertGroupRows(tx *gorm.DB, units []*GroupUnit) error {
+	var spins []*spin.Spin
+	var spinLogs []*provablyfair.SpinLog
+	for _, u := range units {
+		spins = append(spins, u.rows.spins...)
+		spinLogs = append(spinLogs, u.rows.spinLogs...)
+	}
+
+	if len(spins) > 0 {
+		if err := tx.Create(&spins).Error; err != nil {
+			return fmt.Errorf("failed to create spins: %w", err)
+		}
+	}
+	if len(spinLogs) > 0 {
+		if err := tx.Create(&spinLogs).Error; err != nil {
+			return fmt.Errorf("failed to create spin logs: %w", err)
+		}
+	}
+	return nil
+}
+
+// deferSpin hands s to the group transaction in ctx and reports whether it did
+// Outside a group transaction the caller inserts s itself.
+func deferSpin(ctx context.Context, s *spin.Spin) bool {
+	rows, ok := ctx.Value(groupRowsKey{}).(*groupRows)
+	if !ok {
+		return false
+	}
+	rows.spins = append(rows.spins, s)
+	return true
+}
+
+// deferSpinLog hands log to the group transaction in ctx and reports whether it did
+func deferSpinLog(ctx context.Context, log *provablyfair.SpinLog) bool {
+	rows, ok := ctx.Value(groupRowsKey{}).(*groupRows)
+	if !ok {
+		return false
+	}
+	rows.spinLogs = append(rows.spinLogs, log)
+	return true
+}
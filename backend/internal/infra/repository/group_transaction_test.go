@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxManager_WithGroupTransaction(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	sessionID := uuid.New()
+
+	countSpins := func(t *testing.T, repo spin.Repository) int64 {
+		count, err := repo.Count(ctx, playerID)
+		require.NoError(t, err)
+		return count
+	}
+
+	t.Run("should commit units together and roll a failed unit back alone", func(t *testing.T) {
+		db := setupSpinTestDB(t)
+		repo := NewSpinGormRepository(db)
+		settled := createTestSpin(playerID, sessionID)
+		hookRan := false
+
+		units := []*GroupUnit{
+			{Ctx: ctx, Fn: func(txCtx context.Context) error {
+				AfterCommit(txCtx, func(context.Context) { hookRan = true })
+				return repo.Create(txCtx, settled)
+			}},
+			{Ctx: ctx, Fn: func(txCtx context.Context) error {
+				require.NoError(t, repo.Create(txCtx, createTestSpin(playerID, sessionID)))
+				return errors.New("boom")
+			}},
+		}
+		NewTxManager(db).WithGroupTransaction(ctx, units, 0)
+
+		require.NoError(t, units[0].Err)
+		assert.EqualError(t, units[1].Err, "boom")
+		assert.Equal(t, int64(1), countSpins(t, repo), "only the committed unit's spin is written")
+		_, err := repo.GetByID(ctx, settled.ID)
+		require.NoError(t, err)
+
+		assert.False(t, hookRan, "hooks wait for the caller")
+		units[0].RunAfterCommit()
+		assert.True(t, hookRan)
+	})
+
+	t.Run("should fail every unit when the group's rows can't be written", func(t *testing.T) {
+		db := setupSpinTestDB(t)
+		repo := NewSpinGormRepository(db)
+		duplicate := createTestSpin(playerID, sessionID)
+
+		units := make([]*GroupUnit, 2)
+		for i := range units {
+			units[i] = &GroupUnit{Ctx: ctx, Fn: func(txCtx context.Context) error {
+				copied := *duplicate
+				return repo.Create(txCtx, &copied)
+			}}
+		}
+		NewTxManager(db).WithGroupTransaction(ctx, units, 0)
+
+		assert.Error(t, units[0].Err)
+		assert.Error(t, units[1].Err)
+		assert.Equal(t, int64(0), countSpins(t, repo))
+	})
+
+	t.Run("should skip units whose context is done", func(t *testing.T) {
+		db := setupSpinTestDB(t)
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		ran := false
+
+		units := []*GroupUnit{{Ctx: cancelled, Fn: func(context.Context) error {
+			ran = true
+			return nil
+		}}}
+		NewTxManager(db).WithGroupTransaction(ctx, units, 0)
+
+		assert.ErrorIs(t, units[0].Err, context.Canceled)
+		assert.False(t, ran)
+	})
+
+	t.Run("should commit a unit whose caller cancels while it runs", func(t *testing.T) {
+		db := setupSpinTestDB(t)
+		repo := NewSpinGormRepository(db)
+		callerCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		units := []*GroupUnit{{Ctx: callerCtx, Fn: func(txCtx context.Context) error {
+			cancel()
+			require.NoError(t, txCtx.Err(), "the unit's statements would be cancelled on the group's connection")
+			return repo.Create(txCtx, createTestSpin(playerID, sessionID))
+		}}}
+		NewTxManager(db).WithGroupTransaction(ctx, units, time.Second)
+
+		require.NoError(t, units[0].Err)
+		assert.Equal(t, int64(1), countSpins(t, repo))
+	})
+}
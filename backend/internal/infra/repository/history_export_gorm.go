@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/historyexport"
+	"gorm.io/gorm"
+)
+
+// HistoryExportGormRepository implements historyexport.Repository using GORM
+type HistoryExportGormRepository struct {
+	db *gorm.DB
+}
+
+// NewHistoryExportGormRepository creates a new GORM history export repository
+func NewHistoryExportGormRepository(db *gorm.DB) historyexport.Repository {
+	return &HistoryExportGormRepository{db: db}
+}
+
+// Create inserts a new history export
+func (r *HistoryExportGormRepository) Create(ctx context.Context, e *historyexport.Export) error {
+	if err := GetDBOrTx(ctx, r.db).Create(e).Error; err != nil {
+		return fmt.Errorf("failed to create history export: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a history export by ID
+func (r *HistoryExportGormRepository) GetByID(ctx context.Context, id uuid.UUID) (*historyexport.Export, error) {
+	var e historyexport.Export
+	if err := GetDBOrTx(ctx, r.db).Where("id = ?", id).First(&e).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, historyexport.ErrExportNotFound
+		}
+		return nil, fmt.Errorf("failed to get history export: %w", err)
+	}
+	return &e, nil
+}
+
+// ListByPlayer lists a player's exports newest first
+func (r *HistoryExportGormRepository) ListByPlayer(ctx context.Context, playerID uuid.UUID, limit int) ([]*historyexport.Export, error) {
+	var exports []*historyexport.Export
+	if err := r.db.WithContext(ctx).Where("player_id = ?", playerID).
+		Order("created_at DESC").Limit(limit).Find(&exports).Error; err != nil {
+		return nil, fmt.Errorf("failed to list history exports: %w", err)
+	}
+	return exports, nil
+}
+
+// CountUnfinished counts a player's pending and processing exports
+func (r *HistoryExportGormRepository) CountUnfinished(ctx context.Context, playerID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&historyexport.Export{}).
+		Where("player_id = ? AND status IN ?", playerID, []historyexport.Status{historyexport.StatusPending, historyexport.StatusProcessing}).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unfinished history exports: %w", err)
+	}
+	return count, nil
+}
+
+// ListPending lists pending exports oldest first
+func (r *HistoryExportGormRepository) ListPending(ctx context.Context, limit int) ([]*historyexport.Export, error) {
+	var exports []*historyexport.Export
+	if err := r.db.WithContext(ctx).Where("status = ?", historyexport.StatusPending).
+		Order("created_at ASC").Limit(limit).Find(&exports).Error; err != nil {
+		return nil, fmt.Errorf("failed to list pending history exports: %w", err)
+	}
+	return exports, nil
+}
+
+// Claim moves a pending export to processing; false when another worker claimed it first
+func (r *HistoryExportGormRepository) Claim(ctx context.Context, id uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&historyexport.Export{}).
+		Where("id = ? AND status = ?", id, historyexport.StatusPending).
+		Update("status", historyexport.StatusProcessing)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to claim history export: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Update saves a history export
+func (r *HistoryExportGormRepository) Update(ctx context.Context, e *historyexport.Export) error {
+	result := GetDBOrTx(ctx, r.db).Model(e).Select("*").Omit("created_at").Updates(e)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update history export: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return historyexport.ErrExportNotFound
+	}
+	return nil
+}
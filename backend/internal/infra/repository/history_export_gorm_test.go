@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/historyexport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupHistoryExportTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	err = db.Exec(`
+		CREATE TABLE history_exports (
+			id TEXT PRIMARY KEY,
+			player_id TEXT NOT NULL,
+			format TEXT NOT NULL,
+			range_start DATETIME NOT NULL,
+			range_end DATETIME NOT NULL,
+			status TEXT NOT NULL,
+			object_name TEXT,
+			spin_count INTEGER NOT NULL DEFAULT 0,
+			size_bytes INTEGER NOT NULL DEFAULT 0,
+			error TEXT,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			completed_at DATETIME
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create history_exports table")
+
+	return db
+}
+
+func TestHistoryExportGormRepository(t *testing.T) {
+	ctx := context.Background()
+	repo := NewHistoryExportGormRepository(setupHistoryExportTestDB(t))
+
+	playerID := uuid.New()
+	from := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	newExport := func(createdAt time.Time) *historyexport.Export {
+		e := &historyexport.Export{
+			ID:         uuid.New(),
+			PlayerID:   playerID,
+			Format:     historyexport.FormatCSV,
+			RangeStart: from,
+			RangeEnd:   from.AddDate(0, 1, 0),
+			Status:     historyexport.StatusPending,
+			CreatedAt:  createdAt,
+		}
+		require.NoError(t, repo.Create(ctx, e))
+		return e
+	}
+	first := newExport(from)
+	second := newExport(from.Add(time.Hour))
+
+	t.Run("should list pending exports oldest first", func(t *testing.T) {
+		pending, err := repo.ListPending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		assert.Equal(t, first.ID, pending[0].ID)
+
+		count, err := repo.CountUnfinished(ctx, playerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("should claim an export once", func(t *testing.T) {
+		claimed, err := repo.Claim(ctx, first.ID)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = repo.Claim(ctx, first.ID)
+		require.NoError(t, err)
+		assert.False(t, claimed)
+	})
+
+	t.Run("should save a completed export", func(t *testing.T) {
+		completedAt := from.Add(2 * time.Hour)
+		first.Status = historyexport.StatusCompleted
+		first.ObjectName = first.ID.String() + ".csv"
+		first.SpinCount = 42
+		first.CompletedAt = &completedAt
+		require.NoError(t, repo.Update(ctx, first))
+
+		found, err := repo.GetByID(ctx, first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, historyexport.StatusCompleted, found.Status)
+		assert.Equal(t, 42, found.SpinCount)
+		assert.Equal(t, first.ObjectName, found.ObjectName)
+
+		count, err := repo.CountUnfinished(ctx, playerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("should list a player's exports newest first", func(t *testing.T) {
+		exports, err := repo.ListByPlayer(ctx, playerID, 10)
+		require.NoError(t, err)
+		require.Len(t, exports, 2)
+		assert.Equal(t, second.ID, exports[0].ID)
+
+		_, err = repo.GetByID(ctx, uuid.New())
+		assert.ErrorIs(t, err, historyexport.ErrExportNotFound)
+	})
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/impersonation"
+	"gorm.io/gorm"
+)
+
+// ImpersonationGormRepository implements impersonation.Repository using GORM
+type ImpersonationGormRepository struct {
+	db *gorm.DB
+}
+
+// NewImpersonationGormRepository creates a new GORM impersonation repository
+func NewImpersonationGormRepository(db *gorm.DB) impersonation.Repository {
+	return &ImpersonationGormRepository{db: db}
+}
+
+// CreateGrant stores a new grant
+func (r *ImpersonationGormRepository) CreateGrant(ctx context.Context, grant *impersonation.Grant) error {
+	if err := r.db.WithContext(ctx).Create(grant).Error; err != nil {
+		return fmt.Errorf("failed to create impersonation grant: %w", err)
+	}
+	return nil
+}
+
+// GetGrant retrieves a grant by ID
+func (r *ImpersonationGormRepository) GetGrant(ctx context.Context, id uuid.UUID) (*impersonation.Grant, error) {
+	var grant impersonation.Grant
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&grant).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, impersonation.ErrGrantNotFound
+		}
+		return nil, fmt.Errorf("failed to get impersonation grant: %w", err)
+	}
+	return &grant, nil
+}
+
+// GetGrantByTokenHash retrieves the grant a token was issued for
+func (r *ImpersonationGormRepository) GetGrantByTokenHash(ctx context.Context, tokenHash string) (*impersonation.Grant, error) {
+	var grant impersonation.Grant
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&grant).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, impersonation.ErrGrantNotFound
+		}
+		return nil, fmt.Errorf("failed to get impersonation grant: %w", err)
+	}
+	return &grant, nil
+}
+
+// RevokeGrant marks an unrevoked grant revoked; revoking twice keeps the first revocation
+func (r *ImpersonationGormRepository) RevokeGrant(ctx context.Context, id, revokedBy uuid.UUID, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&impersonation.Grant{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]any{
+			"revoked_at": at,
+			"revoked_by": revokedBy,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke impersonation grant: %w", result.Error)
+	}
+	return nil
+}
+
+// ListGrants returns grants matching the filters, newest first
+func (r *ImpersonationGormRepository) ListGrants(ctx context.Context, filters impersonation.ListFilters) ([]*impersonation.Grant, error) {
+	query := r.db.WithContext(ctx).Model(&impersonation.Grant{})
+	if filters.AdminID != nil {
+		query = query.Where("admin_id = ?", *filters.AdminID)
+	}
+	if filters.PlayerID != nil {
+		query = query.Where("player_id = ?", *filters.PlayerID)
+	}
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+
+	var grants []*impersonation.Grant
+	if err := query.Order("created_at DESC").Find(&grants).Error; err != nil {
+		return nil, fmt.Errorf("failed to list impersonation grants: %w", err)
+	}
+	return grants, nil
+}
+
+// RecordAccess stores the audit record of a request made with a grant
+func (r *ImpersonationGormRepository) RecordAccess(ctx context.Context, access *impersonation.Access) error {
+	if err := r.db.WithContext(ctx).Create(access).Error; err != nil {
+		return fmt.Errorf("failed to record impersonation access: %w", err)
+	}
+	return nil
+}
+
+// ListAccesses returns the requests made with a grant, oldest first
+func (r *ImpersonationGormRepository) ListAccesses(ctx context.Context, grantID uuid.UUID) ([]*impersonation.Access, error) {
+	var accesses []*impersonation.Access
+	err := r.db.WithContext(ctx).
+		Where("grant_id = ?", grantID).
+		Order("created_at ASC").
+		Find(&accesses).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list impersonation accesses: %w", err)
+	}
+	return accesses, nil
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/impersonation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupImpersonationTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	for _, stmt := range []string{
+		`CREATE TABLE impersonation_grants (
+			id TEXT PRIMARY KEY,
+			admin_id TEXT NOT NULL,
+			admin_username TEXT NOT NULL,
+			player_id TEXT NOT NULL,
+			reason TEXT NOT NULL,
+			token_hash TEXT NOT NULL UNIQUE,
+			ip_address TEXT,
+			expires_at DATETIME NOT NULL,
+			revoked_at DATETIME,
+			revoked_by TEXT,
+			created_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE impersonation_accesses (
+			id TEXT PRIMARY KEY,
+			grant_id TEXT NOT NULL,
+			admin_id TEXT NOT NULL,
+			player_id TEXT NOT NULL,
+			method TEXT NOT NULL,
+			path TEXT NOT NULL,
+			status INTEGER NOT NULL,
+			ip_address TEXT,
+			created_at DATETIME NOT NULL
+		)`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	return db
+}
+
+func TestImpersonationGormRepository(t *testing.T) {
+	ctx := context.Background()
+	db := setupImpersonationTestDB(t)
+	repo := NewImpersonationGormRepository(db)
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	adminID := uuid.New()
+	playerID := uuid.New()
+
+	older := &impersonation.Grant{
+		ID: uuid.New(), AdminID: adminID, AdminUsername: "support", PlayerID: playerID,
+		Reason: "ticket 1", TokenHash: "hash-1", ExpiresAt: now.Add(time.Hour), CreatedAt: now.Add(-time.Hour),
+	}
+	newer := &impersonation.Grant{
+		ID: uuid.New(), AdminID: adminID, AdminUsername: "support", PlayerID: playerID,
+		Reason: "ticket 2", TokenHash: "hash-2", ExpiresAt: now.Add(time.Hour), CreatedAt: now,
+	}
+	other := &impersonation.Grant{
+		ID: uuid.New(), AdminID: uuid.New(), AdminUsername: "other", PlayerID: uuid.New(),
+		Reason: "ticket 3", TokenHash: "hash-3", ExpiresAt: now.Add(time.Hour), CreatedAt: now,
+	}
+	for _, g := range []*impersonation.Grant{older, newer, other} {
+		require.NoError(t, repo.CreateGrant(ctx, g))
+	}
+
+	t.Run("should find a grant by token hash", func(t *testing.T) {
+		grant, err := repo.GetGrantByTokenHash(ctx, "hash-2")
+		require.NoError(t, err)
+		assert.Equal(t, newer.ID, grant.ID)
+
+		_, err = repo.GetGrantByTokenHash(ctx, "unknown")
+		assert.ErrorIs(t, err, impersonation.ErrGrantNotFound)
+	})
+
+	t.Run("should list grants for a player newest first", func(t *testing.T) {
+		grants, err := repo.ListGrants(ctx, impersonation.ListFilters{PlayerID: &playerID})
+		require.NoError(t, err)
+		require.Len(t, grants, 2)
+		assert.Equal(t, newer.ID, grants[0].ID)
+		assert.Equal(t, older.ID, grants[1].ID)
+
+		all, err := repo.ListGrants(ctx, impersonation.ListFilters{Limit: 10})
+		require.NoError(t, err)
+		assert.Len(t, all, 3)
+	})
+
+	t.Run("should keep the first revocation", func(t *testing.T) {
+		revoker := uuid.New()
+		require.NoError(t, repo.RevokeGrant(ctx, older.ID, revoker, now))
+		require.NoError(t, repo.RevokeGrant(ctx, older.ID, uuid.New(), now.Add(time.Minute)))
+
+		grant, err := repo.GetGrant(ctx, older.ID)
+		require.NoError(t, err)
+		require.NotNil(t, grant.RevokedAt)
+		assert.True(t, now.Equal(*grant.RevokedAt))
+		require.NotNil(t, grant.RevokedBy)
+		assert.Equal(t, revoker, *grant.RevokedBy)
+	})
+
+	t.Run("should list accesses oldest first", func(t *testing.T) {
+		for i, method := range []string{"POST", "GET"} {
+			require.NoError(t, repo.RecordAccess(ctx, &impersonation.Access{
+				ID: uuid.New(), GrantID: newer.ID, AdminID: adminID, PlayerID: playerID,
+				Method: method, Path: "/v1/player/balance", Status: 200,
+				CreatedAt: now.Add(time.Duration(-i) * time.Minute),
+			}))
+		}
+
+		accesses, err := repo.ListAccesses(ctx, newer.ID)
+		require.NoError(t, err)
+		require.Len(t, accesses, 2)
+		assert.Equal(t, "GET", accesses[0].Method)
+		assert.Equal(t, "POST", accesses[1].Method)
+	})
+}
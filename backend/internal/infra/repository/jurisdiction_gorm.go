@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/jurisdiction"
+	"github.com/slotmachine/backend/domain/player"
+	"gorm.io/gorm"
+)
+
+// JurisdictionGormRepository implements jurisdiction.Repository using GORM
+type JurisdictionGormRepository struct {
+	db *gorm.DB
+}
+
+// NewJurisdictionGormRepository creates a new GORM jurisdiction repository
+func NewJurisdictionGormRepository(db *gorm.DB) jurisdiction.Repository {
+	return &JurisdictionGormRepository{db: db}
+}
+
+// Create inserts a new jurisdiction
+func (r *JurisdictionGormRepository) Create(ctx context.Context, j *jurisdiction.Jurisdiction) error {
+	if err := r.db.WithContext(ctx).Create(j).Error; err != nil {
+		return fmt.Errorf("failed to create jurisdiction: %w", err)
+	}
+	return nil
+}
+
+// GetByCode retrieves a jurisdiction by code
+func (r *JurisdictionGormRepository) GetByCode(ctx context.Context, code string) (*jurisdiction.Jurisdiction, error) {
+	var j jurisdiction.Jurisdiction
+	if err := r.db.WithContext(ctx).Where("code = ?", code).First(&j).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, jurisdiction.ErrJurisdictionNotFound
+		}
+		return nil, fmt.Errorf("failed to get jurisdiction: %w", err)
+	}
+	return &j, nil
+}
+
+// List lists all jurisdictions ordered by code
+func (r *JurisdictionGormRepository) List(ctx context.Context) ([]*jurisdiction.Jurisdiction, error) {
+	var list []*jurisdiction.Jurisdiction
+	if err := r.db.WithContext(ctx).Order("code ASC").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("failed to list jurisdictions: %w", err)
+	}
+	return list, nil
+}
+
+// Update replaces the rules of an existing jurisdiction
+func (r *JurisdictionGormRepository) Update(ctx context.Context, j *jurisdiction.Jurisdiction) error {
+	j.UpdatedAt = time.Now()
+	result := r.db.WithContext(ctx).
+		Model(&jurisdiction.Jurisdiction{}).
+		Where("code = ?", j.Code).
+		Select("*").Omit("code", "created_at").
+		Updates(j)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update jurisdiction: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return jurisdiction.ErrJurisdictionNotFound
+	}
+	return nil
+}
+
+// Delete deletes a jurisdiction and detaches its players
+func (r *JurisdictionGormRepository) Delete(ctx context.Context, code string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&player.Player{}).
+			Where("jurisdiction_code = ?", code).
+			Update("jurisdiction_code", nil).Error; err != nil {
+			return fmt.Errorf("failed to detach players from jurisdiction: %w", err)
+		}
+
+		result := tx.Where("code = ?", code).Delete(&jurisdiction.Jurisdiction{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete jurisdiction: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return jurisdiction.ErrJurisdictionNotFound
+		}
+		return nil
+	})
+}
+
+// SetPlayerJurisdiction attaches a player to a jurisdiction (nil detaches)
+func (r *JurisdictionGormRepository) SetPlayerJurisdiction(ctx context.Context, playerID uuid.UUID, code *string) error {
+	result := r.db.WithContext(ctx).
+		Model(&player.Player{}).
+		Where("id = ?", playerID).
+		Update("jurisdiction_code", code)
+	if result.Error != nil {
+		return fmt.Errorf("failed to set player jurisdiction: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return player.ErrPlayerNotFound
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/jurisdiction"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupJurisdictionTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	for _, stmt := range []string{
+		`CREATE TABLE jurisdictions (
+			code TEXT PRIMARY KEY,
+			name TEXT NOT NULL,
+			max_bet REAL NOT NULL DEFAULT 0,
+			max_win REAL NOT NULL DEFAULT 0,
+			reality_check_minutes INTEGER NOT NULL DEFAULT 0,
+			autoplay_allowed INTEGER NOT NULL DEFAULT 1,
+			rtp_disclosure INTEGER NOT NULL DEFAULT 0,
+			disclosed_rtp REAL NOT NULL DEFAULT 0,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE players (
+			id TEXT PRIMARY KEY,
+			jurisdiction_code TEXT,
+			updated_at DATETIME
+		)`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	return db
+}
+
+func TestJurisdictionGormRepository_CRUD(t *testing.T) {
+	ctx := context.Background()
+	repo := NewJurisdictionGormRepository(setupJurisdictionTestDB(t))
+
+	ukgc := &jurisdiction.Jurisdiction{Code: "UKGC", Name: "UK Gambling Commission", MaxBet: 5, RealityCheckMinutes: 60, RTPDisclosure: true, DisclosedRTP: 96.5}
+	mga := &jurisdiction.Jurisdiction{Code: "MGA", Name: "Malta Gaming Authority", AutoplayAllowed: true}
+	require.NoError(t, repo.Create(ctx, ukgc))
+	require.NoError(t, repo.Create(ctx, mga))
+
+	t.Run("should get by code", func(t *testing.T) {
+		got, err := repo.GetByCode(ctx, "UKGC")
+		require.NoError(t, err)
+		assert.Equal(t, 5.0, got.MaxBet)
+		assert.False(t, got.AutoplayAllowed)
+		assert.True(t, got.RTPDisclosure)
+	})
+
+	t.Run("should return not found for unknown code", func(t *testing.T) {
+		_, err := repo.GetByCode(ctx, "XX")
+		assert.ErrorIs(t, err, jurisdiction.ErrJurisdictionNotFound)
+	})
+
+	t.Run("should list by code", func(t *testing.T) {
+		list, err := repo.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, "MGA", list[0].Code)
+		assert.Equal(t, "UKGC", list[1].Code)
+	})
+
+	t.Run("should update including zero values", func(t *testing.T) {
+		update := *mga
+		update.AutoplayAllowed = false
+		update.MaxWin = 250000
+		require.NoError(t, repo.Update(ctx, &update))
+
+		got, err := repo.GetByCode(ctx, "MGA")
+		require.NoError(t, err)
+		assert.False(t, got.AutoplayAllowed)
+		assert.Equal(t, 250000.0, got.MaxWin)
+	})
+
+	t.Run("should return not found when updating unknown code", func(t *testing.T) {
+		err := repo.Update(ctx, &jurisdiction.Jurisdiction{Code: "XX", Name: "Unknown"})
+		assert.ErrorIs(t, err, jurisdiction.ErrJurisdictionNotFound)
+	})
+}
+
+func TestJurisdictionGormRepository_PlayerAssignment(t *testing.T) {
+	ctx := context.Background()
+	db := setupJurisdictionTestDB(t)
+	repo := NewJurisdictionGormRepository(db)
+
+	require.NoError(t, repo.Create(ctx, &jurisdiction.Jurisdiction{Code: "UKGC", Name: "UK Gambling Commission"}))
+	playerID := uuid.New()
+	require.NoError(t, db.Exec("INSERT INTO players (id) VALUES (?)", playerID).Error)
+
+	code := "UKGC"
+	require.NoError(t, repo.SetPlayerJurisdiction(ctx, playerID, &code))
+
+	var assigned *string
+	require.NoError(t, db.Table("players").Select("jurisdiction_code").Where("id = ?", playerID).Scan(&assigned).Error)
+	require.NotNil(t, assigned)
+	assert.Equal(t, "UKGC", *assigned)
+
+	t.Run("should reject unknown player", func(t *testing.T) {
+		err := repo.SetPlayerJurisdiction(ctx, uuid.New(), &code)
+		assert.ErrorIs(t, err, player.ErrPlayerNotFound)
+	})
+
+	t.Run("should detach players on delete", func(t *testing.T) {
+		require.NoError(t, repo.Delete(ctx, "UKGC"))
+
+		var after *string
+		require.NoError(t, db.Table("players").Select("jurisdiction_code").Where("id = ?", playerID).Scan(&after).Error)
+		assert.Nil(t, after)
+
+		assert.ErrorIs(t, repo.Delete(ctx, "UKGC"), jurisdiction.ErrJurisdictionNotFound)
+	})
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/kyc"
+	"github.com/slotmachine/backend/domain/player"
+	"gorm.io/gorm"
+)
+
+// KYCGormRepository implements kyc.Repository using GORM
+type KYCGormRepository struct {
+	db *gorm.DB
+}
+
+// NewKYCGormRepository creates a new GORM KYC repository
+func NewKYCGormRepository(db *gorm.DB) kyc.Repository {
+	return &KYCGormRepository{db: db}
+}
+
+// UpdatePlayerKYC overwrites a player's KYC fields
+func (r *KYCGormRepository) UpdatePlayerKYC(ctx context.Context, playerID uuid.UUID, update kyc.Update) error {
+	result := GetDBOrTx(ctx, r.db).
+		Model(&player.Player{}).
+		Where("id = ?", playerID).
+		Updates(map[string]interface{}{
+			"kyc_status":      string(update.Status),
+			"kyc_provider":    update.Provider,
+			"kyc_reference":   update.Reference,
+			"kyc_verified_at": update.VerifiedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update player KYC: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return player.ErrPlayerNotFound
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/kyc"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKYCGormRepository_UpdatePlayerKYC(t *testing.T) {
+	ctx := context.Background()
+	db := setupPlayerTestDB(t)
+	players := NewPlayerGormRepository(db)
+	repo := NewKYCGormRepository(db)
+
+	p := createTestPlayer()
+	require.NoError(t, players.Create(ctx, p))
+
+	created, err := players.GetByID(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Equal(t, string(kyc.StatusNone), created.KYCStatus)
+
+	provider, reference := "http", "ref-1"
+	verifiedAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.UpdatePlayerKYC(ctx, p.ID, kyc.Update{
+		Status: kyc.StatusVerified, Provider: &provider, Reference: &reference, VerifiedAt: &verifiedAt,
+	}))
+
+	updated, err := players.GetByID(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Equal(t, string(kyc.StatusVerified), updated.KYCStatus)
+	assert.Equal(t, &reference, updated.KYCReference)
+	require.NotNil(t, updated.KYCVerifiedAt)
+	assert.True(t, verifiedAt.Equal(*updated.KYCVerifiedAt))
+
+	t.Run("should reject unknown player", func(t *testing.T) {
+		err := repo.UpdatePlayerKYC(ctx, uuid.New(), kyc.Update{Status: kyc.StatusPending})
+		assert.ErrorIs(t, err, player.ErrPlayerNotFound)
+	})
+}
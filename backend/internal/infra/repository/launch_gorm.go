@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/launch"
+	"gorm.io/gorm"
+)
+
+// LaunchGormRepository implements launch.Repository using GORM
+type LaunchGormRepository struct {
+	db *gorm.DB
+}
+
+// NewLaunchGormRepository creates a new GORM operator player link repository
+func NewLaunchGormRepository(db *gorm.DB) launch.Repository {
+	return &LaunchGormRepository{db: db}
+}
+
+// GetLink finds the local player linked to an operator's player
+func (r *LaunchGormRepository) GetLink(ctx context.Context, operatorID, externalPlayerID string) (*launch.PlayerLink, error) {
+	var link launch.PlayerLink
+	err := r.db.WithContext(ctx).
+		Where("operator_id = ? AND external_player_id = ?", operatorID, externalPlayerID).
+		First(&link).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, launch.ErrLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to get operator player link: %w", err)
+	}
+	return &link, nil
+}
+
+// GetLinkByPlayer finds the operator link of a local player
+func (r *LaunchGormRepository) GetLinkByPlayer(ctx context.Context, playerID uuid.UUID) (*launch.PlayerLink, error) {
+	var link launch.PlayerLink
+	err := r.db.WithContext(ctx).Where("player_id = ?", playerID).First(&link).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, launch.ErrLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to get operator player link: %w", err)
+	}
+	return &link, nil
+}
+
+// CreateLink inserts a new operator player link
+func (r *LaunchGormRepository) CreateLink(ctx context.Context, link *launch.PlayerLink) error {
+	if err := r.db.WithContext(ctx).Create(link).Error; err != nil {
+		return fmt.Errorf("failed to create operator player link: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/launch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupLaunchTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	require.NoError(t, db.Exec(`CREATE TABLE operator_player_links (
+		id TEXT PRIMARY KEY,
+		operator_id TEXT NOT NULL,
+		external_player_id TEXT NOT NULL,
+		player_id TEXT NOT NULL,
+		created_at DATETIME NOT NULL,
+		UNIQUE (operator_id, external_player_id)
+	)`).Error)
+	return db
+}
+
+func TestLaunchGormRepository(t *testing.T) {
+	ctx := context.Background()
+	repo := NewLaunchGormRepository(setupLaunchTestDB(t))
+
+	_, err := repo.GetLink(ctx, "op", "ext-1")
+	assert.ErrorIs(t, err, launch.ErrLinkNotFound)
+
+	link := &launch.PlayerLink{ID: uuid.New(), OperatorID: "op", ExternalPlayerID: "ext-1", PlayerID: uuid.New(), CreatedAt: time.Now().UTC()}
+	require.NoError(t, repo.CreateLink(ctx, link))
+
+	got, err := repo.GetLink(ctx, "op", "ext-1")
+	require.NoError(t, err)
+	assert.Equal(t, link.PlayerID, got.PlayerID)
+
+	byPlayer, err := repo.GetLinkByPlayer(ctx, link.PlayerID)
+	require.NoError(t, err)
+	assert.Equal(t, "op", byPlayer.OperatorID)
+	_, err = repo.GetLinkByPlayer(ctx, uuid.New())
+	assert.ErrorIs(t, err, launch.ErrLinkNotFound)
+
+	_, err = repo.GetLink(ctx, "other", "ext-1")
+	assert.ErrorIs(t, err, launch.ErrLinkNotFound, "links are scoped to their operator")
+
+	dup := &launch.PlayerLink{ID: uuid.New(), OperatorID: "op", ExternalPlayerID: "ext-1", PlayerID: uuid.New(), CreatedAt: time.Now().UTC()}
+	assert.Error(t, repo.CreateLink(ctx, dup), "an operator player links to one account")
+}
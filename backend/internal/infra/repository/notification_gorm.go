@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/notification"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// visibleNotifications matches the notifications a player can see: their own and broadcasts
+// sent since they registered, until they expire. Its parameters are the player ID twice and now.
+const visibleNotifications = `(n.player_id = ? OR (n.player_id IS NULL AND n.created_at >= (SELECT p.created_at FROM players p WHERE p.id = ?)))
+	AND (n.expires_at IS NULL OR n.expires_at > ?)`
+
+// NotificationGormRepository implements notification.Repository using GORM
+type NotificationGormRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationGormRepository creates a new GORM notification repository
+func NewNotificationGormRepository(db *gorm.DB) notification.Repository {
+	return &NotificationGormRepository{db: db}
+}
+
+// Create inserts a new notification
+func (r *NotificationGormRepository) Create(ctx context.Context, n *notification.Notification) error {
+	if err := r.db.WithContext(ctx).Create(n).Error; err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	return nil
+}
+
+// Unread lists the player's unexpired notifications without a read receipt, oldest first
+func (r *NotificationGormRepository) Unread(ctx context.Context, playerID uuid.UUID, now time.Time, limit int) ([]*notification.Notification, error) {
+	var notifications []*notification.Notification
+	err := r.db.WithContext(ctx).
+		Table("notifications n").
+		Select("n.*").
+		Where(visibleNotifications, playerID, playerID, now).
+		Where("NOT EXISTS (SELECT 1 FROM notification_reads nr WHERE nr.notification_id = n.id AND nr.player_id = ?)", playerID).
+		Order("n.created_at ASC").
+		Limit(limit).
+		Find(&notifications).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unread notifications: %w", err)
+	}
+	return notifications, nil
+}
+
+// MarkRead records the player's receipt for a notification they can see
+func (r *NotificationGormRepository) MarkRead(ctx context.Context, playerID, notificationID uuid.UUID, at time.Time) error {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Table("notifications n").
+		Where("n.id = ?", notificationID).
+		Where(visibleNotifications, playerID, playerID, at).
+		Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("failed to get notification: %w", err)
+	}
+	if count == 0 {
+		return notification.ErrNotificationNotFound
+	}
+
+	receipt := &notification.Read{NotificationID: notificationID, PlayerID: playerID, ReadAt: at}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(receipt).Error; err != nil {
+		return fmt.Errorf("failed to mark notification read: %w", err)
+	}
+	return nil
+}
+
+// MarkAllRead records receipts for all the player's unread notifications in one statement
+func (r *NotificationGormRepository) MarkAllRead(ctx context.Context, playerID uuid.UUID, at time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(`INSERT INTO notification_reads (notification_id, player_id, read_at)
+		SELECT n.id, ?, ? FROM notifications n
+		WHERE `+visibleNotifications+`
+			AND NOT EXISTS (SELECT 1 FROM notification_reads nr WHERE nr.notification_id = n.id AND nr.player_id = ?)
+		ON CONFLICT DO NOTHING`,
+		playerID, at, playerID, playerID, at, playerID)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
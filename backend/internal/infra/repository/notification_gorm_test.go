@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupNotificationTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	for _, stmt := range []string{
+		`CREATE TABLE players (id TEXT PRIMARY KEY, created_at DATETIME NOT NULL)`,
+		`CREATE TABLE notifications (
+			id TEXT PRIMARY KEY,
+			player_id TEXT,
+			type TEXT NOT NULL,
+			title TEXT NOT NULL,
+			body TEXT NOT NULL,
+			data TEXT,
+			expires_at DATETIME,
+			created_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE notification_reads (
+			notification_id TEXT NOT NULL,
+			player_id TEXT NOT NULL,
+			read_at DATETIME NOT NULL,
+			PRIMARY KEY (notification_id, player_id)
+		)`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	return db
+}
+
+func TestNotificationGormRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	registered := now.Add(-24 * time.Hour)
+
+	setup := func(t *testing.T) (notification.Repository, uuid.UUID) {
+		db := setupNotificationTestDB(t)
+		playerID := uuid.New()
+		require.NoError(t, db.Exec(`INSERT INTO players (id, created_at) VALUES (?, ?)`, playerID, registered).Error)
+		return NewNotificationGormRepository(db), playerID
+	}
+	create := func(t *testing.T, repo notification.Repository, playerID *uuid.UUID, createdAt time.Time, expiresAt *time.Time) *notification.Notification {
+		n := &notification.Notification{
+			ID:        uuid.New(),
+			PlayerID:  playerID,
+			Type:      notification.TypeMaintenance,
+			Title:     "Title",
+			Body:      "Body",
+			Data:      map[string]any{"amount": 10.0},
+			ExpiresAt: expiresAt,
+			CreatedAt: createdAt,
+		}
+		require.NoError(t, repo.Create(ctx, n))
+		return n
+	}
+
+	t.Run("should list the player's and broadcast notifications still visible, oldest first", func(t *testing.T) {
+		repo, playerID := setup(t)
+		other := uuid.New()
+		expired := now.Add(-time.Minute)
+		later := now.Add(time.Hour)
+
+		own := create(t, repo, &playerID, now.Add(-2*time.Hour), nil)
+		broadcast := create(t, repo, nil, now.Add(-time.Hour), &later)
+		create(t, repo, &other, now.Add(-time.Hour), nil)
+		create(t, repo, nil, registered.Add(-time.Hour), nil)
+		create(t, repo, &playerID, now.Add(-time.Hour), &expired)
+
+		unread, err := repo.Unread(ctx, playerID, now, 10)
+		require.NoError(t, err)
+		require.Len(t, unread, 2, "other players', pre-registration and expired notifications are hidden")
+		assert.Equal(t, own.ID, unread[0].ID)
+		assert.Equal(t, broadcast.ID, unread[1].ID)
+		assert.Equal(t, 10.0, unread[0].Data["amount"])
+
+		unread, err = repo.Unread(ctx, playerID, now, 1)
+		require.NoError(t, err)
+		assert.Len(t, unread, 1)
+	})
+
+	t.Run("should keep read notifications out of the unread list", func(t *testing.T) {
+		repo, playerID := setup(t)
+		own := create(t, repo, &playerID, now.Add(-2*time.Hour), nil)
+		broadcast := create(t, repo, nil, now.Add(-time.Hour), nil)
+
+		require.NoError(t, repo.MarkRead(ctx, playerID, broadcast.ID, now))
+		require.NoError(t, repo.MarkRead(ctx, playerID, broadcast.ID, now), "reading twice is harmless")
+
+		unread, err := repo.Unread(ctx, playerID, now, 10)
+		require.NoError(t, err)
+		require.Len(t, unread, 1)
+		assert.Equal(t, own.ID, unread[0].ID)
+
+		otherPlayer := uuid.New()
+		assert.ErrorIs(t, repo.MarkRead(ctx, otherPlayer, own.ID, now), notification.ErrNotificationNotFound)
+		assert.ErrorIs(t, repo.MarkRead(ctx, playerID, uuid.New(), now), notification.ErrNotificationNotFound)
+	})
+
+	t.Run("should mark everything unread as read", func(t *testing.T) {
+		repo, playerID := setup(t)
+		create(t, repo, &playerID, now.Add(-2*time.Hour), nil)
+		read := create(t, repo, nil, now.Add(-time.Hour), nil)
+		require.NoError(t, repo.MarkRead(ctx, playerID, read.ID, now))
+
+		marked, err := repo.MarkAllRead(ctx, playerID, now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), marked)
+
+		unread, err := repo.Unread(ctx, playerID, now, 10)
+		require.NoError(t, err)
+		assert.Empty(t, unread)
+	})
+}
@@ -0,0 +1,312 @@
+package repository
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PartitionedTables are the tables range-partitioned by month on created_at
+var PartitionedTables = []string{"spins", "spin_logs"}
+
+// partitionMonthLayout is the month suffix of a partition name, e.g. spins_p2026_01
+const partitionMonthLayout = "2006_01"
+
+// Partition is one monthly partition of a partitioned table
+type Partition struct {
+	Name  string
+	Month time.Time // First instant of the month (UTC)
+}
+
+// PartitionTable is a partition's table, attached or detached, with its range
+type PartitionTable struct {
+	Name       string
+	RangeStart *time.Time // nil for the unbounded legacy partition
+	RangeEnd   time.Time  // Exclusive
+}
+
+// restoreBatchSize bounds how many rows one restore INSERT carries
+const restoreBatchSize = 500
+
+// maxExportLineBytes bounds one exported row when reading an archive back
+const maxExportLineBytes = 16 << 20
+
+// PartitionManager creates and detaches monthly partitions (PostgreSQL only)
+type PartitionManager struct {
+	db *gorm.DB
+}
+
+// NewPartitionManager creates a new partition manager
+func NewPartitionManager(db *gorm.DB) *PartitionManager {
+	return &PartitionManager{db: db}
+}
+
+// Supported reports whether the database supports declarative partitioning
+func (m *PartitionManager) Supported() bool {
+	return m.db.Dialector.Name() == "postgres"
+}
+
+// ListPartitions returns the table's monthly partitions, oldest first.
+// The legacy and default partitions are not monthly and are left out
+func (m *PartitionManager) ListPartitions(ctx context.Context, table string) ([]Partition, error) {
+	var names []string
+	err := m.db.WithContext(ctx).Raw(`
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		JOIN pg_class p ON p.oid = i.inhparent
+		WHERE p.relname = ?`, table).
+		Scan(&names).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partitions of %s: %w", table, err)
+	}
+
+	partitions := make([]Partition, 0, len(names))
+	for _, name := range names {
+		if month, ok := parsePartitionMonth(table, name); ok {
+			partitions = append(partitions, Partition{Name: name, Month: month})
+		}
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i].Month.Before(partitions[j].Month) })
+	return partitions, nil
+}
+
+// EnsurePartitions creates any missing monthly partitions from the month of from
+// through months ahead of it, returning the names it created
+func (m *PartitionManager) EnsurePartitions(ctx context.Context, table string, from time.Time, months int) ([]string, error) {
+	existing, err := m.ListPartitions(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	have := make(map[string]bool, len(existing))
+	for _, p := range existing {
+		have[p.Name] = true
+	}
+
+	// Months inside the legacy partition would overlap it, so creation starts after it
+	legacyBound, hasLegacy, err := m.legacyUpperBound(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	var created []string
+	start := monthStart(from)
+	for i := 0; i <= months; i++ {
+		month := start.AddDate(0, i, 0)
+		name := partitionName(table, month)
+		if have[name] || (hasLegacy && month.Before(legacyBound)) {
+			continue
+		}
+
+		stmt := fmt.Sprintf(
+			`CREATE TABLE IF NOT EXISTS %q PARTITION OF %q FOR VALUES FROM ('%s') TO ('%s')`,
+			name, table, month.Format(time.RFC3339), month.AddDate(0, 1, 0).Format(time.RFC3339),
+		)
+		if err := m.db.WithContext(ctx).Exec(stmt).Error; err != nil {
+			return created, fmt.Errorf("failed to create partition %s: %w", name, err)
+		}
+		created = append(created, name)
+	}
+	return created, nil
+}
+
+// DetachPartitionsBefore detaches monthly partitions whose whole range is before cutoff,
+// returning the names it detached. Detached tables are kept for archival
+func (m *PartitionManager) DetachPartitionsBefore(ctx context.Context, table string, cutoff time.Time) ([]string, error) {
+	partitions, err := m.ListPartitions(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	var detached []string
+	for _, p := range partitions {
+		if p.Month.AddDate(0, 1, 0).After(cutoff) {
+			break
+		}
+		stmt := fmt.Sprintf(`ALTER TABLE %q DETACH PARTITION %q`, table, p.Name)
+		if err := m.db.WithContext(ctx).Exec(stmt).Error; err != nil {
+			return detached, fmt.Errorf("failed to detach partition %s: %w", p.Name, err)
+		}
+		detached = append(detached, p.Name)
+	}
+	return detached, nil
+}
+
+// ExpiredPartitions returns the table's partitions, attached or detached, whose range
+// ends at or before cutoff, oldest first. A detached legacy partition has no known range
+// and is left out
+func (m *PartitionManager) ExpiredPartitions(ctx context.Context, table string, cutoff time.Time) ([]PartitionTable, error) {
+	var names []string
+	err := m.db.WithContext(ctx).Raw(`
+		SELECT relname FROM pg_class
+		WHERE relkind = 'r' AND relnamespace = current_schema()::regnamespace AND relname LIKE ?`,
+		strings.ReplaceAll(table, "_", `\_`)+`\_p%`).
+		Scan(&names).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partition tables of %s: %w", table, err)
+	}
+
+	var expired []PartitionTable
+	legacyBound, hasLegacy, err := m.legacyUpperBound(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	if hasLegacy && !legacyBound.After(cutoff) {
+		expired = append(expired, PartitionTable{Name: table + "_legacy", RangeEnd: legacyBound})
+	}
+
+	var monthly []PartitionTable
+	for _, name := range names {
+		month, ok := parsePartitionMonth(table, name)
+		if !ok || month.AddDate(0, 1, 0).After(cutoff) {
+			continue
+		}
+		start := month
+		monthly = append(monthly, PartitionTable{Name: name, RangeStart: &start, RangeEnd: month.AddDate(0, 1, 0)})
+	}
+	sort.Slice(monthly, func(i, j int) bool { return monthly[i].RangeEnd.Before(monthly[j].RangeEnd) })
+	return append(expired, monthly...), nil
+}
+
+// ExportPartition writes every row of a partition table to w as one JSON object per line,
+// returning the number of rows written
+func (m *PartitionManager) ExportPartition(ctx context.Context, name string, w io.Writer) (int64, error) {
+	rows, err := m.db.WithContext(ctx).Raw(fmt.Sprintf(`SELECT row_to_json(t)::text FROM %q t`, name)).Rows()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read partition %s: %w", name, err)
+	}
+	defer rows.Close()
+
+	var count int64
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			return count, fmt.Errorf("failed to scan row of %s: %w", name, err)
+		}
+		if _, err := io.WriteString(w, line+"\n"); err != nil {
+			return count, fmt.Errorf("failed to write row of %s: %w", name, err)
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return count, fmt.Errorf("failed to read partition %s: %w", name, err)
+	}
+	return count, nil
+}
+
+// DropPartition drops a partition table, attached or detached
+func (m *PartitionManager) DropPartition(ctx context.Context, name string) error {
+	if err := m.db.WithContext(ctx).Exec(fmt.Sprintf(`DROP TABLE IF EXISTS %q`, name)).Error; err != nil {
+		return fmt.Errorf("failed to drop partition %s: %w", name, err)
+	}
+	return nil
+}
+
+// RestorePartition recreates a dropped partition of table and loads rows exported by
+// ExportPartition from r, all in one transaction. verify runs once every row is loaded;
+// an error from it rolls the restore back
+func (m *PartitionManager) RestorePartition(ctx context.Context, table string, p PartitionTable, r io.Reader, verify func() error) (int64, error) {
+	from := "MINVALUE"
+	if p.RangeStart != nil {
+		from = "'" + p.RangeStart.UTC().Format(time.RFC3339) + "'"
+	}
+
+	var count int64
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		create := fmt.Sprintf(`CREATE TABLE %q PARTITION OF %q FOR VALUES FROM (%s) TO ('%s')`,
+			p.Name, table, from, p.RangeEnd.UTC().Format(time.RFC3339))
+		if err := tx.Exec(create).Error; err != nil {
+			return fmt.Errorf("failed to recreate partition %s: %w", p.Name, err)
+		}
+
+		insert := fmt.Sprintf(`INSERT INTO %q SELECT * FROM json_populate_recordset(NULL::%q, ?::json)`, p.Name, table)
+		batch := make([]string, 0, restoreBatchSize)
+		flush := func() error {
+			if len(batch) == 0 {
+				return nil
+			}
+			if err := tx.Exec(insert, "["+strings.Join(batch, ",")+"]").Error; err != nil {
+				return fmt.Errorf("failed to restore rows into %s: %w", p.Name, err)
+			}
+			count += int64(len(batch))
+			batch = batch[:0]
+			return nil
+		}
+
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), maxExportLineBytes)
+		for scanner.Scan() {
+			if line := scanner.Text(); line != "" {
+				batch = append(batch, line)
+			}
+			if len(batch) == restoreBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return fmt.Errorf("failed to read archive of %s: %w", p.Name, err)
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		return verify()
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// legacyUpperBound returns the exclusive upper bound of the table's legacy partition, if attached
+func (m *PartitionManager) legacyUpperBound(ctx context.Context, table string) (time.Time, bool, error) {
+	var bound *string
+	err := m.db.WithContext(ctx).Raw(`
+		SELECT (regexp_match(pg_get_expr(c.relpartbound, c.oid), 'TO \(''([^'']+)''\)'))[1]
+		FROM pg_class c
+		WHERE c.relname = ? AND c.relispartition`, table+"_legacy").
+		Scan(&bound).Error
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to read legacy partition bound of %s: %w", table, err)
+	}
+	if bound == nil {
+		return time.Time{}, false, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04:05-07", "2006-01-02 15:04:05-07:00"} {
+		if t, err := time.Parse(layout, *bound); err == nil {
+			return t.UTC(), true, nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("unrecognised legacy partition bound of %s: %q", table, *bound)
+}
+
+// partitionName returns the name of table's partition for month
+func partitionName(table string, month time.Time) string {
+	return table + "_p" + month.UTC().Format(partitionMonthLayout)
+}
+
+// parsePartitionMonth extracts the month from a monthly partition name of table
+func parsePartitionMonth(table, name string) (time.Time, bool) {
+	prefix := table + "_p"
+	if len(name) != len(prefix)+len(partitionMonthLayout) || name[:len(prefix)] != prefix {
+		return time.Time{}, false
+	}
+	month, err := time.Parse(partitionMonthLayout, name[len(prefix):])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return month, true
+}
+
+// monthStart returns the first instant of t's month in UTC
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestPartitionName(t *testing.T) {
+	month := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "spins_p2026_03", partitionName("spins", month))
+	assert.Equal(t, "spin_logs_p2026_03", partitionName("spin_logs", month))
+}
+
+func TestParsePartitionMonth(t *testing.T) {
+	t.Run("should parse monthly partitions of the table", func(t *testing.T) {
+		month, ok := parsePartitionMonth("spins", "spins_p2026_03")
+		assert.True(t, ok)
+		assert.Equal(t, time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC), month)
+	})
+
+	t.Run("should ignore legacy, default and other tables' partitions", func(t *testing.T) {
+		for _, name := range []string{"spins_legacy", "spins_default", "spin_logs_p2026_03", "spins_p2026_13"} {
+			_, ok := parsePartitionMonth("spins", name)
+			assert.False(t, ok, name)
+		}
+	})
+}
+
+func TestMonthStart(t *testing.T) {
+	at := time.Date(2026, time.March, 31, 23, 30, 0, 0, time.FixedZone("UTC-2", -2*3600))
+
+	assert.Equal(t, time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC), monthStart(at))
+}
+
+func TestPartitionManager_Supported(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	assert.NoError(t, err)
+
+	assert.False(t, NewPartitionManager(db).Supported())
+}
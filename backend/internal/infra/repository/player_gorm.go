@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,19 +14,21 @@ import (
 
 // PlayerGormRepository implements player.Repository using GORM
 type PlayerGormRepository struct {
-	db *gorm.DB
+	db    *gorm.DB
+	reads ReadSource
 }
 
 // NewPlayerGormRepository creates a new GORM player repository
 func NewPlayerGormRepository(db *gorm.DB) player.Repository {
 	return &PlayerGormRepository{
-		db: db,
+		db:    db,
+		reads: primaryReads{db},
 	}
 }
 
 // Create creates a new player
 func (r *PlayerGormRepository) Create(ctx context.Context, p *player.Player) error {
-	if err := r.db.WithContext(ctx).Create(p).Error; err != nil {
+	if err := GetDBOrTx(ctx, r.db).Create(p).Error; err != nil {
 		return fmt.Errorf("failed to create player: %w", err)
 	}
 	return nil
@@ -204,6 +208,29 @@ func (r *PlayerGormRepository) UpdateBalanceWithTx(ctx context.Context, id uuid.
 	return nil
 }
 
+// LockBalanceWithTx takes a PostgreSQL transaction-level advisory lock keyed on the player
+// It is released when the transaction commits or rolls back, so a crashed replica never leaves it held.
+// Other databases already allow one writer at a time, so there it only checks for the transaction.
+func (r *PlayerGormRepository) LockBalanceWithTx(ctx context.Context, id uuid.UUID) error {
+	tx := GetTxFromContext(ctx)
+	if tx == nil {
+		return errors.New("balance lock requires a transaction")
+	}
+	if tx.Dialector.Name() != "postgres" {
+		return nil
+	}
+
+	if err := tx.WithContext(ctx).Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", balanceLockKey(id)).Error; err != nil {
+		return fmt.Errorf("failed to lock balance: %w", err)
+	}
+	return nil
+}
+
+// balanceLockKey namespaces the player's advisory lock so it cannot collide with other advisory locks
+func balanceLockKey(id uuid.UUID) string {
+	return "balance:" + id.String()
+}
+
 // UpdateBalanceWithLockAndTx updates a player's balance with optimistic locking using transaction from context
 func (r *PlayerGormRepository) UpdateBalanceWithLockAndTx(ctx context.Context, id uuid.UUID, amount float64, lockVersion int) error {
 	db := GetDBOrTx(ctx, r.db)
@@ -227,7 +254,7 @@ func (r *PlayerGormRepository) UpdateBalanceWithLockAndTx(ctx context.Context, i
 
 // UpdateStatistics updates player statistics
 func (r *PlayerGormRepository) UpdateStatistics(ctx context.Context, id uuid.UUID, spins int, wagered, won float64) error {
-	result := r.db.WithContext(ctx).
+	result := GetDBOrTx(ctx, r.db).
 		Model(&player.Player{}).
 		Where("id = ?", id).
 		Updates(map[string]interface{}{
@@ -279,7 +306,7 @@ func (r *PlayerGormRepository) List(ctx context.Context, filters player.ListFilt
 	var players []*player.Player
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&player.Player{})
+	query := GetReadDBOrTx(ctx, r.reads).Model(&player.Player{})
 
 	// Apply filters
 	if filters.Username != "" {
@@ -329,3 +356,93 @@ func (r *PlayerGormRepository) List(ctx context.Context, filters player.ListFilt
 
 	return players, total, nil
 }
+
+// Search retrieves one keyset page of players matching the filters
+// Partial matches compare lowercased columns so Postgres can serve them from the
+// trigram indexes on lower(username) and lower(email).
+func (r *PlayerGormRepository) Search(ctx context.Context, filters player.SearchFilters) ([]*player.Player, error) {
+	query := GetReadDBOrTx(ctx, r.reads).Model(&player.Player{})
+
+	if filters.Username != "" {
+		query = query.Where(`LOWER(username) LIKE ? ESCAPE '\'`, containsPattern(filters.Username))
+	}
+	if filters.Email != "" {
+		query = query.Where(`LOWER(email) LIKE ? ESCAPE '\'`, containsPattern(filters.Email))
+	}
+	if filters.GameID != nil {
+		query = query.Where("game_id = ?", *filters.GameID)
+	}
+	if filters.IsActive != nil {
+		query = query.Where("is_active = ?", *filters.IsActive)
+	}
+	if filters.MinBalance != nil {
+		query = query.Where("balance >= ?", *filters.MinBalance)
+	}
+	if filters.MaxBalance != nil {
+		query = query.Where("balance <= ?", *filters.MaxBalance)
+	}
+	if filters.RegisteredFrom != nil {
+		query = query.Where("created_at >= ?", *filters.RegisteredFrom)
+	}
+	if filters.RegisteredTo != nil {
+		query = query.Where("created_at < ?", *filters.RegisteredTo)
+	}
+	if filters.LastLoginFrom != nil {
+		query = query.Where("last_login_at >= ?", *filters.LastLoginFrom)
+	}
+	if filters.LastLoginTo != nil {
+		query = query.Where("last_login_at < ?", *filters.LastLoginTo)
+	}
+	if len(filters.Tags) > 0 {
+		query = query.Where(
+			"id IN (SELECT player_id FROM player_tags WHERE tag IN ? GROUP BY player_id HAVING COUNT(DISTINCT tag) = ?)",
+			filters.Tags, len(filters.Tags),
+		)
+	}
+	if filters.AssignedConfigID != nil {
+		query = query.Where(
+			`EXISTS (SELECT 1 FROM player_reel_strip_assignments a WHERE a.player_id = players.id AND a.is_active = ?
+				AND (a.expires_at IS NULL OR a.expires_at > ?)
+				AND (a.base_game_config_id = ? OR a.free_spins_config_id = ?))`,
+			true, time.Now(), *filters.AssignedConfigID, *filters.AssignedConfigID,
+		)
+	}
+
+	sortBy := filters.Sort
+	if !sortBy.Valid() {
+		sortBy = player.SearchSortCreatedAt
+	}
+	op, order := ">", "ASC"
+	if filters.SortDesc {
+		op, order = "<", "DESC"
+	}
+	if filters.After != nil {
+		value, err := filters.After.SortValue()
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(
+			fmt.Sprintf("%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?)", sortBy, op),
+			value, value, filters.After.ID,
+		)
+	}
+	query = query.Order(fmt.Sprintf("%s %s, id %s", sortBy, order, order))
+
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	} else {
+		query = query.Limit(20) // Default limit
+	}
+
+	var players []*player.Player
+	if err := query.Find(&players).Error; err != nil {
+		return nil, fmt.Errorf("failed to search players: %w", err)
+	}
+	return players, nil
+}
+
+// containsPattern builds a LIKE pattern matching s anywhere, case-insensitively
+func containsPattern(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(strings.ToLower(s))
+	return "%" + s + "%"
+}
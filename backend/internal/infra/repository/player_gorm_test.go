@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/slotmachine/backend/domain/player"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -36,10 +39,16 @@ func setupPlayerTestDB(t *testing.T) *gorm.DB {
 			is_active INTEGER DEFAULT 1,
 			is_verified INTEGER DEFAULT 0,
 			game_id TEXT,
+			jurisdiction_code TEXT,
+			kyc_status TEXT DEFAULT 'none' NOT NULL,
+			kyc_provider TEXT,
+			kyc_reference TEXT,
+			kyc_verified_at DATETIME,
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			lock_version INTEGER DEFAULT 0,
-			last_login_at DATETIME
+			last_login_at DATETIME,
+			erased_at DATETIME
 		)
 	`).Error
 	require.NoError(t, err, "Failed to create players table")
@@ -411,6 +420,83 @@ func TestPlayerGormRepository_UpdateBalance(t *testing.T) {
 	})
 }
 
+// ============================================================================
+// LockBalanceWithTx TESTS
+// ============================================================================
+
+func TestPlayerGormRepository_LockBalanceWithTx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should require a transaction", func(t *testing.T) {
+		repo := NewPlayerGormRepository(setupPlayerTestDB(t))
+
+		assert.Error(t, repo.LockBalanceWithTx(ctx, uuid.New()))
+	})
+
+	t.Run("should succeed inside a transaction", func(t *testing.T) {
+		db := setupPlayerTestDB(t)
+		repo := NewPlayerGormRepository(db)
+
+		err := NewTxManager(db).WithTransaction(ctx, func(txCtx context.Context) error {
+			return repo.LockBalanceWithTx(txCtx, uuid.New())
+		})
+		assert.NoError(t, err)
+	})
+
+	// Two connection pools stand in for two replicas; needs a PostgreSQL database
+	t.Run("should serialize settlements for a player across replicas", func(t *testing.T) {
+		dsn := os.Getenv("TEST_POSTGRES_DSN")
+		if dsn == "" {
+			t.Skip("TEST_POSTGRES_DSN not set")
+		}
+		var replicas []*gorm.DB
+		for i := 0; i < 2; i++ {
+			db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+			require.NoError(t, err)
+			replicas = append(replicas, db)
+		}
+
+		// settle runs concurrent critical sections per player and reports the most that overlapped for any one
+		settle := func(playerIDs ...uuid.UUID) int32 {
+			var mu sync.Mutex
+			inFlight := make(map[uuid.UUID]int32)
+			var maxOverlap int32
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				playerID := playerIDs[i%len(playerIDs)]
+				db := replicas[i%len(replicas)]
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					repo := NewPlayerGormRepository(db)
+					err := NewTxManager(db).WithTransaction(ctx, func(txCtx context.Context) error {
+						if err := repo.LockBalanceWithTx(txCtx, playerID); err != nil {
+							return err
+						}
+						mu.Lock()
+						inFlight[playerID]++
+						maxOverlap = max(maxOverlap, inFlight[playerID])
+						mu.Unlock()
+
+						time.Sleep(20 * time.Millisecond)
+
+						mu.Lock()
+						inFlight[playerID]--
+						mu.Unlock()
+						return nil
+					})
+					assert.NoError(t, err)
+				}()
+			}
+			wg.Wait()
+			return maxOverlap
+		}
+
+		assert.Equal(t, int32(1), settle(uuid.New()), "one player's settlements must not overlap")
+		assert.Equal(t, int32(1), settle(uuid.New(), uuid.New()), "each player's settlements must not overlap")
+	})
+}
+
 // ============================================================================
 // UpdateStatistics TESTS
 // ============================================================================
@@ -576,3 +662,98 @@ func TestPlayerGormRepository_Delete(t *testing.T) {
 	})
 }
 
+func TestPlayerGormRepository_Search(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	configID := uuid.New()
+
+	db := setupPlayerTestDB(t)
+	require.NoError(t, db.Exec(`CREATE TABLE player_tags (player_id TEXT NOT NULL, tag TEXT NOT NULL, created_at DATETIME, PRIMARY KEY (player_id, tag))`).Error)
+	require.NoError(t, db.Exec(`
+		CREATE TABLE player_reel_strip_assignments (
+			id TEXT PRIMARY KEY,
+			player_id TEXT NOT NULL,
+			base_game_config_id TEXT,
+			free_spins_config_id TEXT,
+			is_active INTEGER DEFAULT 1,
+			expires_at DATETIME
+		)
+	`).Error)
+	repo := NewPlayerGormRepository(db)
+
+	players := make([]*player.Player, 5)
+	for i, name := range []string{"Alice", "alina", "bob", "carol_x", "carolx"} {
+		p := createTestPlayer()
+		p.ID = uuid.New()
+		p.Username = name
+		p.Email = name + "@example.com"
+		p.Balance = float64(100 * (i + 1))
+		p.CreatedAt = base.Add(time.Duration(i) * 24 * time.Hour)
+		require.NoError(t, repo.Create(ctx, p))
+		players[i] = p
+	}
+	lastLogin := base.Add(10 * 24 * time.Hour)
+	require.NoError(t, db.Exec("UPDATE players SET last_login_at = ? WHERE id = ?", lastLogin, players[2].ID).Error)
+	for _, tag := range []struct {
+		player *player.Player
+		tag    string
+	}{{players[0], "vip"}, {players[0], "whale"}, {players[1], "vip"}} {
+		require.NoError(t, db.Exec("INSERT INTO player_tags (player_id, tag) VALUES (?, ?)", tag.player.ID, tag.tag).Error)
+	}
+	require.NoError(t, db.Exec("INSERT INTO player_reel_strip_assignments (id, player_id, free_spins_config_id, is_active) VALUES (?, ?, ?, 1)",
+		uuid.New(), players[3].ID, configID).Error)
+	require.NoError(t, db.Exec("INSERT INTO player_reel_strip_assignments (id, player_id, base_game_config_id, is_active) VALUES (?, ?, ?, 0)",
+		uuid.New(), players[4].ID, configID).Error)
+
+	usernames := func(ps []*player.Player) []string {
+		names := make([]string, len(ps))
+		for i, p := range ps {
+			names[i] = p.Username
+		}
+		return names
+	}
+	ptr := func(f float64) *float64 { return &f }
+	at := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		name    string
+		filters player.SearchFilters
+		want    []string
+	}{
+		{"partial username, case-insensitive", player.SearchFilters{Username: "ALI"}, []string{"Alice", "alina"}},
+		{"partial email", player.SearchFilters{Email: "bob@"}, []string{"bob"}},
+		{"LIKE wildcards are literal", player.SearchFilters{Username: "_x"}, []string{"carol_x"}},
+		{"balance range", player.SearchFilters{MinBalance: ptr(200), MaxBalance: ptr(400)}, []string{"alina", "bob", "carol_x"}},
+		{"registration window", player.SearchFilters{RegisteredFrom: at(base.Add(24 * time.Hour)), RegisteredTo: at(base.Add(3 * 24 * time.Hour))}, []string{"alina", "bob"}},
+		{"last login window", player.SearchFilters{LastLoginFrom: at(base)}, []string{"bob"}},
+		{"all tags", player.SearchFilters{Tags: []string{"vip", "whale"}}, []string{"Alice"}},
+		{"any tagged vip", player.SearchFilters{Tags: []string{"vip"}}, []string{"Alice", "alina"}},
+		{"active assignment to config", player.SearchFilters{AssignedConfigID: &configID}, []string{"carol_x"}},
+		{"sort by balance descending", player.SearchFilters{Sort: player.SearchSortBalance, SortDesc: true, Limit: 2}, []string{"carolx", "carol_x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := repo.Search(ctx, tt.filters)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, usernames(result))
+		})
+	}
+
+	t.Run("should page through results with a keyset cursor", func(t *testing.T) {
+		filters := player.SearchFilters{Sort: player.SearchSortCreatedAt, SortDesc: true, Limit: 2}
+		var seen []string
+		for page := 0; page < 5; page++ {
+			result, err := repo.Search(ctx, filters)
+			require.NoError(t, err)
+			seen = append(seen, usernames(result)...)
+			if len(result) < filters.Limit {
+				break
+			}
+			filters.After = player.NewSearchCursor(result[len(result)-1], filters.Sort, filters.SortDesc)
+		}
+
+		assert.Equal(t, []string{"carolx", "carol_x", "bob", "alina", "Alice"}, seen)
+	})
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/playernote"
+	"gorm.io/gorm"
+)
+
+// PlayerNoteGormRepository implements playernote.Repository using GORM
+type PlayerNoteGormRepository struct {
+	db *gorm.DB
+}
+
+// NewPlayerNoteGormRepository creates a new GORM player note repository
+func NewPlayerNoteGormRepository(db *gorm.DB) playernote.Repository {
+	return &PlayerNoteGormRepository{db: db}
+}
+
+// Create stores a new note
+func (r *PlayerNoteGormRepository) Create(ctx context.Context, note *playernote.Note) error {
+	if err := r.db.WithContext(ctx).Create(note).Error; err != nil {
+		return fmt.Errorf("failed to create player note: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns a note that has not been deleted
+func (r *PlayerNoteGormRepository) GetByID(ctx context.Context, id uuid.UUID) (*playernote.Note, error) {
+	var note playernote.Note
+	if err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&note).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, playernote.ErrNoteNotFound
+		}
+		return nil, fmt.Errorf("failed to get player note: %w", err)
+	}
+	return &note, nil
+}
+
+// UpdateBody replaces a note's body and records who edited it
+func (r *PlayerNoteGormRepository) UpdateBody(ctx context.Context, id uuid.UUID, body, editedBy string, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&playernote.Note{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]any{
+			"body":      body,
+			"edited_by": editedBy,
+			"edited_at": at,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update player note: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return playernote.ErrNoteNotFound
+	}
+	return nil
+}
+
+// Delete hides a note from listings and records who deleted it
+func (r *PlayerNoteGormRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy string, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&playernote.Note{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]any{
+			"deleted_by": deletedBy,
+			"deleted_at": at,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete player note: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return playernote.ErrNoteNotFound
+	}
+	return nil
+}
+
+// ListByPlayer returns a player's notes that have not been deleted, newest first
+func (r *PlayerNoteGormRepository) ListByPlayer(ctx context.Context, playerID uuid.UUID, kind *playernote.Kind) ([]*playernote.Note, error) {
+	query := r.db.WithContext(ctx).Where("player_id = ? AND deleted_at IS NULL", playerID)
+	if kind != nil {
+		query = query.Where("kind = ?", *kind)
+	}
+
+	var notes []*playernote.Note
+	if err := query.Order("created_at DESC").Order("id DESC").Find(&notes).Error; err != nil {
+		return nil, fmt.Errorf("failed to list player notes: %w", err)
+	}
+	return notes, nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/playernote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupPlayerNoteTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	require.NoError(t, db.Exec(`CREATE TABLE player_notes (
+		id TEXT PRIMARY KEY,
+		player_id TEXT NOT NULL,
+		kind TEXT NOT NULL,
+		body TEXT NOT NULL,
+		author_id TEXT NOT NULL,
+		author_username TEXT NOT NULL,
+		created_at DATETIME NOT NULL,
+		edited_by TEXT,
+		edited_at DATETIME,
+		deleted_by TEXT,
+		deleted_at DATETIME
+	)`).Error)
+	return db
+}
+
+func TestPlayerNoteGormRepository(t *testing.T) {
+	ctx := context.Background()
+	db := setupPlayerNoteTestDB(t)
+	repo := NewPlayerNoteGormRepository(db)
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	playerID := uuid.New()
+	create := func(playerID uuid.UUID, kind playernote.Kind, body string, at time.Time) *playernote.Note {
+		note := &playernote.Note{
+			ID: uuid.New(), PlayerID: playerID, Kind: kind, Body: body,
+			AuthorID: uuid.New(), AuthorUsername: "support", CreatedAt: at,
+		}
+		require.NoError(t, repo.Create(ctx, note))
+		return note
+	}
+
+	note := create(playerID, playernote.KindNote, "called about a missing win", now)
+	flag := create(playerID, playernote.KindFlag, "charge-back risk", now.Add(time.Minute))
+	create(uuid.New(), playernote.KindNote, "another player's note", now)
+
+	t.Run("lists a player's notes newest first, optionally by kind", func(t *testing.T) {
+		notes, err := repo.ListByPlayer(ctx, playerID, nil)
+		require.NoError(t, err)
+		require.Len(t, notes, 2)
+		assert.Equal(t, flag.ID, notes[0].ID)
+		assert.Equal(t, note.ID, notes[1].ID)
+
+		kind := playernote.KindFlag
+		flags, err := repo.ListByPlayer(ctx, playerID, &kind)
+		require.NoError(t, err)
+		require.Len(t, flags, 1)
+		assert.Equal(t, "charge-back risk", flags[0].Body)
+	})
+
+	t.Run("edits record the editor", func(t *testing.T) {
+		require.NoError(t, repo.UpdateBody(ctx, note.ID, "called about a missing win, resolved", "lead", now.Add(time.Hour)))
+
+		got, err := repo.GetByID(ctx, note.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "called about a missing win, resolved", got.Body)
+		assert.Equal(t, "lead", got.EditedBy)
+		require.NotNil(t, got.EditedAt)
+		assert.True(t, got.EditedAt.Equal(now.Add(time.Hour)))
+	})
+
+	t.Run("deleted notes are kept but no longer found", func(t *testing.T) {
+		require.NoError(t, repo.Delete(ctx, flag.ID, "lead", now.Add(2*time.Hour)))
+
+		_, err := repo.GetByID(ctx, flag.ID)
+		assert.ErrorIs(t, err, playernote.ErrNoteNotFound)
+		assert.ErrorIs(t, repo.UpdateBody(ctx, flag.ID, "x", "lead", now), playernote.ErrNoteNotFound)
+		assert.ErrorIs(t, repo.Delete(ctx, flag.ID, "lead", now), playernote.ErrNoteNotFound)
+
+		notes, err := repo.ListByPlayer(ctx, playerID, nil)
+		require.NoError(t, err)
+		require.Len(t, notes, 1)
+
+		var deletedBy string
+		require.NoError(t, db.Raw("SELECT deleted_by FROM player_notes WHERE id = ?", flag.ID).Scan(&deletedBy).Error)
+		assert.Equal(t, "lead", deletedBy)
+	})
+}
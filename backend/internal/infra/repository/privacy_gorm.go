@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/privacy"
+	"gorm.io/gorm"
+)
+
+// exportSource reads one section of a player's data archive
+// The query takes the player ID as its only argument; omit lists secret columns
+// that are dropped from the records.
+type exportSource struct {
+	name  string
+	query string
+	omit  []string
+}
+
+var exportSources = []exportSource{
+	{name: "player", query: "SELECT * FROM players WHERE id = ?", omit: []string{"password_hash"}},
+	{name: "login_sessions", query: "SELECT * FROM player_sessions WHERE player_id = ? ORDER BY created_at", omit: []string{"session_token"}},
+	{name: "operator_links", query: "SELECT * FROM operator_player_links WHERE player_id = ? ORDER BY created_at"},
+	{name: "tags", query: "SELECT * FROM player_tags WHERE player_id = ? ORDER BY created_at"},
+	{name: "game_sessions", query: "SELECT * FROM game_sessions WHERE player_id = ? ORDER BY created_at"},
+	{name: "session_bet_changes", query: "SELECT * FROM session_bet_changes WHERE player_id = ? ORDER BY created_at"},
+	{name: "autoplay_contracts", query: "SELECT * FROM autoplay_contracts WHERE player_id = ? ORDER BY created_at"},
+	{name: "spins", query: "SELECT * FROM spins WHERE player_id = ? ORDER BY created_at"},
+	{name: "free_spins_sessions", query: "SELECT * FROM free_spins_sessions WHERE player_id = ? ORDER BY created_at"},
+	{name: "transactions", query: "SELECT * FROM transactions WHERE player_id = ? ORDER BY created_at"},
+	{name: "pf_sessions", query: "SELECT * FROM pf_sessions WHERE player_id = ? ORDER BY created_at", omit: []string{"encrypted_server_seed"}},
+	{name: "pf_spin_logs", query: `SELECT l.* FROM spin_logs l JOIN pf_sessions s ON s.id = l.pf_session_id
+		WHERE s.player_id = ? ORDER BY l.created_at, l.spin_index`},
+	{name: "pf_reveals", query: `SELECT a.* FROM session_audits a JOIN pf_sessions s ON s.id = a.pf_session_id
+		WHERE s.player_id = ? ORDER BY a.revealed_at`},
+	{name: "reel_strip_assignments", query: "SELECT * FROM player_reel_strip_assignments WHERE player_id = ? ORDER BY assigned_at"},
+	{name: "vip_points", query: "SELECT * FROM player_vip_points WHERE player_id = ?"},
+	{name: "daily_stats", query: "SELECT * FROM player_daily_stats WHERE player_id = ? ORDER BY day"},
+	{name: "lifetime_stats", query: "SELECT * FROM player_lifetime_stats WHERE player_id = ?"},
+	{name: "big_wins", query: "SELECT * FROM big_wins WHERE player_id = ? ORDER BY created_at"},
+	{name: "trial_conversions", query: "SELECT * FROM trial_conversions WHERE player_id = ?"},
+	{name: "history_exports", query: "SELECT * FROM history_exports WHERE player_id = ? ORDER BY created_at"},
+	{name: "disputes", query: "SELECT * FROM disputes WHERE player_id = ? ORDER BY created_at"},
+	{name: "dispute_events", query: `SELECT e.* FROM dispute_events e JOIN disputes d ON d.id = e.dispute_id
+		WHERE d.player_id = ? ORDER BY e.created_at`},
+	{name: "audit_logs", query: "SELECT * FROM audit_logs WHERE player_id = ? ORDER BY created_at"},
+}
+
+// PrivacyGormRepository implements privacy.Repository using GORM
+type PrivacyGormRepository struct {
+	db *gorm.DB
+}
+
+// NewPrivacyGormRepository creates a new GORM privacy repository
+func NewPrivacyGormRepository(db *gorm.DB) privacy.Repository {
+	return &PrivacyGormRepository{db: db}
+}
+
+// Export reads every record of a player, one section per source table
+func (r *PrivacyGormRepository) Export(ctx context.Context, playerID uuid.UUID) ([]privacy.Section, error) {
+	db := GetDBOrTx(ctx, r.db).WithContext(ctx)
+
+	sections := make([]privacy.Section, 0, len(exportSources))
+	for _, source := range exportSources {
+		var rows []map[string]interface{}
+		if err := db.Raw(source.query, playerID).Scan(&rows).Error; err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", source.name, err)
+		}
+
+		records := make([]privacy.Record, len(rows))
+		for i, row := range rows {
+			for _, column := range source.omit {
+				delete(row, column)
+			}
+			records[i] = exportRecord(row)
+		}
+		sections = append(sections, privacy.Section{Name: source.name, Records: records})
+	}
+
+	if len(sections[0].Records) == 0 {
+		return nil, player.ErrPlayerNotFound
+	}
+	return sections, nil
+}
+
+// exportRecord converts raw column values into values that encode as readable JSON
+// Drivers return JSONB and text columns as bytes; JSON documents are embedded as is.
+func exportRecord(row map[string]interface{}) privacy.Record {
+	for column, value := range row {
+		if b, ok := value.([]byte); ok {
+			if json.Valid(b) {
+				row[column] = json.RawMessage(b)
+			} else {
+				row[column] = string(b)
+			}
+		}
+	}
+	return privacy.Record(row)
+}
+
+// Erase replaces a player's identity with pseudonym and removes its personal data
+// Login sessions, operator links and tags are deleted; the request metadata of
+// audit logs is cleared. The tables in privacy.RetainedTables are not touched.
+func (r *PrivacyGormRepository) Erase(ctx context.Context, playerID uuid.UUID, pseudonym string, erasedAt time.Time) (*privacy.Erasure, error) {
+	erasure := &privacy.Erasure{
+		PlayerID:  playerID,
+		Pseudonym: pseudonym,
+		ErasedAt:  erasedAt,
+		Deleted:   map[string]int64{},
+		Scrubbed:  map[string]int64{},
+	}
+
+	err := GetDBOrTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&player.Player{}).
+			Where("id = ? AND erased_at IS NULL", playerID).
+			Updates(map[string]interface{}{
+				"username":      pseudonym,
+				"email":         pseudonym + "@erased.invalid",
+				"password_hash": "",
+				"is_active":     false,
+				"kyc_reference": nil,
+				"last_login_at": nil,
+				"erased_at":     erasedAt,
+				"updated_at":    erasedAt,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to pseudonymize player: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			var count int64
+			if err := tx.Model(&player.Player{}).Where("id = ?", playerID).Count(&count).Error; err != nil {
+				return fmt.Errorf("failed to get player: %w", err)
+			}
+			if count == 0 {
+				return player.ErrPlayerNotFound
+			}
+			return privacy.ErrAlreadyErased
+		}
+		erasure.Scrubbed["players"] = result.RowsAffected
+
+		for _, table := range []string{"player_sessions", "operator_player_links", "player_tags"} {
+			result := tx.Exec("DELETE FROM "+table+" WHERE player_id = ?", playerID)
+			if result.Error != nil {
+				return fmt.Errorf("failed to erase %s: %w", table, result.Error)
+			}
+			erasure.Deleted[table] = result.RowsAffected
+		}
+
+		result = tx.Exec(`UPDATE audit_logs SET ip_address = NULL, user_agent = NULL
+			WHERE player_id = ? AND (ip_address IS NOT NULL OR user_agent IS NOT NULL)`, playerID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to erase audit_logs: %w", result.Error)
+		}
+		erasure.Scrubbed["audit_logs"] = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return erasure, nil
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/privacy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupPrivacyTestDB creates the players table and minimal versions of the tables a player's data is read from
+func setupPrivacyTestDB(t *testing.T) *gorm.DB {
+	db := setupPlayerTestDB(t)
+	for _, stmt := range []string{
+		`CREATE TABLE player_sessions (id TEXT PRIMARY KEY, player_id TEXT, session_token TEXT, ip_address TEXT, user_agent TEXT, created_at DATETIME)`,
+		`CREATE TABLE operator_player_links (id TEXT PRIMARY KEY, operator_id TEXT, external_player_id TEXT, player_id TEXT, created_at DATETIME)`,
+		`CREATE TABLE player_tags (player_id TEXT, tag TEXT, created_at DATETIME)`,
+		`CREATE TABLE game_sessions (id TEXT PRIMARY KEY, player_id TEXT, created_at DATETIME)`,
+		`CREATE TABLE session_bet_changes (id TEXT PRIMARY KEY, player_id TEXT, created_at DATETIME)`,
+		`CREATE TABLE autoplay_contracts (id TEXT PRIMARY KEY, player_id TEXT, created_at DATETIME)`,
+		`CREATE TABLE history_exports (id TEXT PRIMARY KEY, player_id TEXT, created_at DATETIME)`,
+		`CREATE TABLE spins (id TEXT PRIMARY KEY, player_id TEXT, created_at DATETIME)`,
+		`CREATE TABLE free_spins_sessions (id TEXT PRIMARY KEY, player_id TEXT, created_at DATETIME)`,
+		`CREATE TABLE transactions (id TEXT PRIMARY KEY, player_id TEXT, created_at DATETIME)`,
+		`CREATE TABLE pf_sessions (id TEXT PRIMARY KEY, player_id TEXT, encrypted_server_seed TEXT, created_at DATETIME)`,
+		`CREATE TABLE spin_logs (id TEXT PRIMARY KEY, pf_session_id TEXT, spin_index INTEGER, reel_positions TEXT, created_at DATETIME)`,
+		`CREATE TABLE session_audits (id TEXT PRIMARY KEY, pf_session_id TEXT, revealed_at DATETIME)`,
+		`CREATE TABLE player_reel_strip_assignments (id TEXT PRIMARY KEY, player_id TEXT, assigned_at DATETIME)`,
+		`CREATE TABLE player_vip_points (player_id TEXT PRIMARY KEY)`,
+		`CREATE TABLE player_daily_stats (player_id TEXT, day DATE)`,
+		`CREATE TABLE player_lifetime_stats (player_id TEXT PRIMARY KEY)`,
+		`CREATE TABLE big_wins (id TEXT PRIMARY KEY, player_id TEXT, created_at DATETIME)`,
+		`CREATE TABLE trial_conversions (id TEXT PRIMARY KEY, player_id TEXT)`,
+		`CREATE TABLE disputes (id TEXT PRIMARY KEY, player_id TEXT, created_at DATETIME)`,
+		`CREATE TABLE dispute_events (id TEXT PRIMARY KEY, dispute_id TEXT, created_at DATETIME)`,
+		`CREATE TABLE audit_logs (id TEXT PRIMARY KEY, player_id TEXT, ip_address TEXT, user_agent TEXT, created_at DATETIME)`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	return db
+}
+
+func TestPrivacyGormRepository(t *testing.T) {
+	ctx := context.Background()
+	db := setupPrivacyTestDB(t)
+	repo := NewPrivacyGormRepository(db)
+
+	p := createTestPlayer()
+	require.NoError(t, NewPlayerGormRepository(db).Create(ctx, p))
+	pfSessionID := uuid.New().String()
+	now := time.Now().UTC()
+	for _, stmt := range []struct {
+		query string
+		args  []interface{}
+	}{
+		{"INSERT INTO player_sessions VALUES (?, ?, 'secret-token', '10.0.0.1', 'Mozilla', ?)", []interface{}{uuid.New().String(), p.ID, now}},
+		{"INSERT INTO operator_player_links VALUES (?, 'op', 'ext-1', ?, ?)", []interface{}{uuid.New().String(), p.ID, now}},
+		{"INSERT INTO player_tags VALUES (?, 'vip', ?)", []interface{}{p.ID, now}},
+		{"INSERT INTO pf_sessions VALUES (?, ?, 'encrypted', ?)", []interface{}{pfSessionID, p.ID, now}},
+		{"INSERT INTO spin_logs VALUES (?, ?, 1, '[1,2,3]', ?)", []interface{}{uuid.New().String(), pfSessionID, now}},
+		{"INSERT INTO audit_logs VALUES (?, ?, '10.0.0.1', 'Mozilla', ?)", []interface{}{uuid.New().String(), p.ID, now}},
+	} {
+		require.NoError(t, db.Exec(stmt.query, stmt.args...).Error)
+	}
+
+	t.Run("should export every section without secrets", func(t *testing.T) {
+		sections, err := repo.Export(ctx, p.ID)
+		require.NoError(t, err)
+
+		byName := map[string]privacy.Section{}
+		for _, s := range sections {
+			byName[s.Name] = s
+		}
+		require.Len(t, byName["player"].Records, 1)
+		assert.Equal(t, "testuser", byName["player"].Records[0]["username"])
+		assert.NotContains(t, byName["player"].Records[0], "password_hash")
+		require.Len(t, byName["login_sessions"].Records, 1)
+		assert.NotContains(t, byName["login_sessions"].Records[0], "session_token")
+		assert.NotContains(t, byName["pf_sessions"].Records[0], "encrypted_server_seed")
+		assert.Len(t, byName["pf_spin_logs"].Records, 1)
+		assert.Empty(t, byName["spins"].Records)
+
+		var buf bytes.Buffer
+		archive := &privacy.Archive{PlayerID: p.ID, GeneratedAt: now, Sections: sections}
+		require.NoError(t, archive.WriteZip(&buf))
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+		assert.Len(t, zr.File, len(sections)+1)
+		assert.Equal(t, "manifest.json", zr.File[0].Name)
+	})
+
+	t.Run("should return not found for an unknown player", func(t *testing.T) {
+		_, err := repo.Export(ctx, uuid.New())
+		assert.ErrorIs(t, err, player.ErrPlayerNotFound)
+
+		_, err = repo.Erase(ctx, uuid.New(), "erased-x", now)
+		assert.ErrorIs(t, err, player.ErrPlayerNotFound)
+	})
+
+	t.Run("should pseudonymize the player and keep provably fair records", func(t *testing.T) {
+		pseudonym := privacy.Pseudonym(p.ID)
+		erasure, err := repo.Erase(ctx, p.ID, pseudonym, now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), erasure.Deleted["player_sessions"])
+		assert.Equal(t, int64(1), erasure.Deleted["operator_player_links"])
+		assert.Equal(t, int64(1), erasure.Deleted["player_tags"])
+		assert.Equal(t, int64(1), erasure.Scrubbed["audit_logs"])
+
+		erased, err := NewPlayerGormRepository(db).GetByID(ctx, p.ID)
+		require.NoError(t, err)
+		assert.Equal(t, pseudonym, erased.Username)
+		assert.Equal(t, pseudonym+"@erased.invalid", erased.Email)
+		assert.Empty(t, erased.PasswordHash)
+		assert.False(t, erased.IsActive)
+		assert.NotNil(t, erased.ErasedAt)
+
+		var spinLogs, scrubbed int64
+		require.NoError(t, db.Table("spin_logs").Where("pf_session_id = ?", pfSessionID).Count(&spinLogs).Error)
+		assert.Equal(t, int64(1), spinLogs)
+		require.NoError(t, db.Table("audit_logs").Where("player_id = ? AND ip_address IS NULL AND user_agent IS NULL", p.ID).Count(&scrubbed).Error)
+		assert.Equal(t, int64(1), scrubbed)
+
+		_, err = repo.Erase(ctx, p.ID, pseudonym, now)
+		assert.ErrorIs(t, err, privacy.ErrAlreadyErased)
+	})
+}
@@ -82,7 +82,7 @@ func (r *ProvablyFairGormRepository) GetActiveSessionByGameSession(ctx context.C
 
 // UpdateSession updates a PF session
 func (r *ProvablyFairGormRepository) UpdateSession(ctx context.Context, session *provablyfair.PFSession) error {
-	result := r.db.WithContext(ctx).Save(session)
+	result := GetDBOrTx(ctx, r.db).Save(session)
 	if result.Error != nil {
 		return fmt.Errorf("failed to update PF session: %w", result.Error)
 	}
@@ -92,6 +92,39 @@ func (r *ProvablyFairGormRepository) UpdateSession(ctx context.Context, session
 	return nil
 }
 
+// UpdateSessionProgress sets last_nonce and last_spin_hash in a single statement.
+// A write carrying a nonce at or below the stored one is a no-op, so late flushes never move it back
+func (r *ProvablyFairGormRepository) UpdateSessionProgress(ctx context.Context, id uuid.UUID, nonce int64, lastSpinHash string) error {
+	err := GetDBOrTx(ctx, r.db).
+		Model(&provablyfair.PFSession{}).
+		Where("id = ? AND last_nonce < ?", id, nonce).
+		Updates(map[string]any{
+			"last_nonce":     nonce,
+			"last_spin_hash": lastSpinHash,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to update PF session progress: %w", err)
+	}
+	return nil
+}
+
+// ClaimClientNonce sets last_client_nonce unless the session already claimed nonce or a later one.
+// The update holds the session row until the transaction ends, so a concurrent claim of the
+// same nonce waits and then matches no row
+func (r *ProvablyFairGormRepository) ClaimClientNonce(ctx context.Context, id uuid.UUID, nonce int64) error {
+	result := GetDBOrTx(ctx, r.db).
+		Model(&provablyfair.PFSession{}).
+		Where("id = ? AND last_client_nonce < ?", id, nonce).
+		Update("last_client_nonce", nonce)
+	if result.Error != nil {
+		return fmt.Errorf("failed to claim client nonce: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: nonce %d was already played", provablyfair.ErrClientNonceMismatch, nonce)
+	}
+	return nil
+}
+
 // EndSession marks a PF session as ended
 func (r *ProvablyFairGormRepository) EndSession(ctx context.Context, id uuid.UUID) error {
 	now := time.Now().UTC()
@@ -112,11 +145,33 @@ func (r *ProvablyFairGormRepository) EndSession(ctx context.Context, id uuid.UUI
 	return nil
 }
 
+// ListOrphanedSessions returns active PF sessions left behind by ended, missing or idle game sessions, oldest first
+func (r *ProvablyFairGormRepository) ListOrphanedSessions(ctx context.Context, idleSince time.Time, limit int) ([]provablyfair.PFSession, error) {
+	var sessions []provablyfair.PFSession
+	err := r.db.WithContext(ctx).
+		Table("pf_sessions AS ps").
+		Select("ps.*").
+		Joins("LEFT JOIN game_sessions gs ON gs.id = ps.game_session_id").
+		Where("ps.status = ?", provablyfair.SessionStatusActive).
+		Where(`gs.id IS NULL OR gs.ended_at IS NOT NULL OR
+			COALESCE((SELECT MAX(sl.created_at) FROM spin_logs sl WHERE sl.pf_session_id = ps.id), ps.created_at) < ?`, idleSince).
+		Order("ps.created_at ASC").
+		Limit(limit).
+		Find(&sessions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list orphaned PF sessions: %w", err)
+	}
+	return sessions, nil
+}
+
 // ==================== SpinLog Operations ====================
 
 // CreateSpinLog creates a new spin log entry (append-only)
 func (r *ProvablyFairGormRepository) CreateSpinLog(ctx context.Context, log *provablyfair.SpinLog) error {
-	if err := r.db.WithContext(ctx).Create(log).Error; err != nil {
+	if deferSpinLog(ctx, log) {
+		return nil
+	}
+	if err := GetDBOrTx(ctx, r.db).Create(log).Error; err != nil {
 		return fmt.Errorf("failed to create spin log: %w", err)
 	}
 	return nil
@@ -169,6 +224,22 @@ func (r *ProvablyFairGormRepository) GetLastSpinLog(ctx context.Context, pfSessi
 	return &log, nil
 }
 
+// GetSpinLogBySpinID retrieves the spin log recorded for a game spin
+func (r *ProvablyFairGormRepository) GetSpinLogBySpinID(ctx context.Context, spinID uuid.UUID) (*provablyfair.SpinLog, error) {
+	var log provablyfair.SpinLog
+	err := r.db.WithContext(ctx).
+		Where("spin_id = ?", spinID).
+		First(&log).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, provablyfair.ErrSpinNotFound
+		}
+		return nil, fmt.Errorf("failed to get spin log by spin ID: %w", err)
+	}
+	return &log, nil
+}
+
 // ==================== SessionAudit Operations ====================
 
 // CreateSessionAudit creates a new session audit entry (reveals server seed)
@@ -194,3 +265,47 @@ func (r *ProvablyFairGormRepository) GetSessionAudit(ctx context.Context, pfSess
 	}
 	return &audit, nil
 }
+
+// ==================== ChainAudit Operations ====================
+
+// ListUnauditedSessions returns sessions ended since endedSince that have no chain audit yet, oldest first
+func (r *ProvablyFairGormRepository) ListUnauditedSessions(ctx context.Context, endedSince time.Time, limit int) ([]provablyfair.PFSession, error) {
+	var sessions []provablyfair.PFSession
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND ended_at >= ?", provablyfair.SessionStatusEnded, endedSince).
+		Where("NOT EXISTS (SELECT 1 FROM pf_chain_audits ca WHERE ca.pf_session_id = pf_sessions.id)").
+		Order("ended_at ASC").
+		Limit(limit).
+		Find(&sessions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unaudited PF sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// CreateChainAudit records a chain audit result
+func (r *ProvablyFairGormRepository) CreateChainAudit(ctx context.Context, audit *provablyfair.ChainAudit) error {
+	if err := r.db.WithContext(ctx).Create(audit).Error; err != nil {
+		return fmt.Errorf("failed to create chain audit: %w", err)
+	}
+	return nil
+}
+
+// ListChainAudits lists chain audits newest first with the total count for pagination
+func (r *ProvablyFairGormRepository) ListChainAudits(ctx context.Context, failedOnly bool, limit, offset int) ([]provablyfair.ChainAudit, int64, error) {
+	query := r.db.WithContext(ctx).Model(&provablyfair.ChainAudit{})
+	if failedOnly {
+		query = query.Where("valid = ?", false)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count chain audits: %w", err)
+	}
+
+	var audits []provablyfair.ChainAudit
+	if err := query.Order("checked_at DESC").Offset(offset).Limit(limit).Find(&audits).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list chain audits: %w", err)
+	}
+	return audits, total, nil
+}
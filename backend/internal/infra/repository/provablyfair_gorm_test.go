@@ -0,0 +1,225 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupProvablyFairTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	for _, stmt := range []string{
+		`CREATE TABLE game_sessions (
+			id TEXT PRIMARY KEY,
+			created_at DATETIME,
+			ended_at DATETIME
+		)`,
+		`CREATE TABLE pf_sessions (
+			id TEXT PRIMARY KEY,
+			player_id TEXT NOT NULL,
+			game_session_id TEXT NOT NULL,
+			server_seed_hash TEXT NOT NULL,
+			encrypted_server_seed TEXT NOT NULL,
+			nonce_start INTEGER NOT NULL DEFAULT 1,
+			last_nonce INTEGER NOT NULL DEFAULT 0,
+			last_client_nonce INTEGER NOT NULL DEFAULT 0,
+			last_spin_hash TEXT,
+			status TEXT NOT NULL DEFAULT 'active',
+			created_at DATETIME NOT NULL,
+			ended_at DATETIME,
+			theta_commitment TEXT,
+			theta_seed TEXT,
+			theta_verified INTEGER NOT NULL DEFAULT 0
+		)`,
+		`CREATE TABLE spin_logs (
+			id TEXT PRIMARY KEY,
+			pf_session_id TEXT NOT NULL,
+			created_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE pf_chain_audits (
+			id TEXT PRIMARY KEY,
+			pf_session_id TEXT NOT NULL UNIQUE,
+			player_id TEXT NOT NULL,
+			spin_count INTEGER NOT NULL,
+			valid INTEGER NOT NULL,
+			error TEXT,
+			checked_at DATETIME NOT NULL
+		)`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	return db
+}
+
+func TestProvablyFairGormRepository_ListOrphanedSessions(t *testing.T) {
+	ctx := context.Background()
+	db := setupProvablyFairTestDB(t)
+	repo := NewProvablyFairGormRepository(db)
+
+	now := time.Now().UTC()
+	idleSince := now.Add(-time.Hour)
+
+	// newSession creates an active PF session, optionally with its game session and a spin
+	newSession := func(createdAt time.Time, gameEndedAt *time.Time, hasGameSession bool, lastSpinAt *time.Time) uuid.UUID {
+		sess := &provablyfair.PFSession{
+			ID:                  uuid.New(),
+			PlayerID:            uuid.New(),
+			GameSessionID:       uuid.New(),
+			ServerSeedHash:      "hash",
+			EncryptedServerSeed: "seed",
+			Status:              provablyfair.SessionStatusActive,
+			CreatedAt:           createdAt,
+		}
+		require.NoError(t, repo.CreateSession(ctx, sess))
+		if hasGameSession {
+			require.NoError(t, db.Exec(`INSERT INTO game_sessions (id, created_at, ended_at) VALUES (?, ?, ?)`,
+				sess.GameSessionID, createdAt, gameEndedAt).Error)
+		}
+		if lastSpinAt != nil {
+			require.NoError(t, db.Exec(`INSERT INTO spin_logs (id, pf_session_id, created_at) VALUES (?, ?, ?)`,
+				uuid.New(), sess.ID, *lastSpinAt).Error)
+		}
+		return sess.ID
+	}
+
+	old := now.Add(-3 * time.Hour)
+	recent := now.Add(-5 * time.Minute)
+
+	live := newSession(recent, nil, true, nil)
+	liveWithOldStart := newSession(old, nil, true, &recent)
+	gameEnded := newSession(recent, &recent, true, nil)
+	gameMissing := newSession(recent, nil, false, nil)
+	idle := newSession(old, nil, true, &old)
+	idleNoSpins := newSession(old.Add(time.Minute), nil, true, nil)
+
+	endedID := newSession(old, &old, true, nil)
+	require.NoError(t, repo.EndSession(ctx, endedID))
+
+	orphans, err := repo.ListOrphanedSessions(ctx, idleSince, 10)
+	require.NoError(t, err)
+
+	ids := make([]uuid.UUID, len(orphans))
+	for i, o := range orphans {
+		ids[i] = o.ID
+	}
+	assert.ElementsMatch(t, []uuid.UUID{gameEnded, gameMissing, idle, idleNoSpins}, ids)
+	assert.NotContains(t, ids, live)
+	assert.NotContains(t, ids, liveWithOldStart)
+	assert.Equal(t, idle, ids[0], "oldest first")
+
+	limited, err := repo.ListOrphanedSessions(ctx, idleSince, 2)
+	require.NoError(t, err)
+	assert.Len(t, limited, 2)
+}
+
+func TestProvablyFairGormRepository_UpdateSessionProgress(t *testing.T) {
+	ctx := context.Background()
+	db := setupProvablyFairTestDB(t)
+	repo := NewProvablyFairGormRepository(db)
+
+	sess := &provablyfair.PFSession{
+		ID:                  uuid.New(),
+		PlayerID:            uuid.New(),
+		GameSessionID:       uuid.New(),
+		ServerSeedHash:      "hash",
+		EncryptedServerSeed: "seed",
+		Status:              provablyfair.SessionStatusActive,
+		CreatedAt:           time.Now().UTC(),
+	}
+	require.NoError(t, repo.CreateSession(ctx, sess))
+
+	require.NoError(t, repo.UpdateSessionProgress(ctx, sess.ID, 5, "hash-5"))
+	got, err := repo.GetSessionByID(ctx, sess.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), got.LastNonce)
+	assert.Equal(t, "hash-5", got.LastSpinHash)
+
+	// A stale flush must not move progress back
+	require.NoError(t, repo.UpdateSessionProgress(ctx, sess.ID, 3, "hash-3"))
+	got, err = repo.GetSessionByID(ctx, sess.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), got.LastNonce)
+	assert.Equal(t, "hash-5", got.LastSpinHash)
+}
+
+func TestProvablyFairGormRepository_ClaimClientNonce(t *testing.T) {
+	ctx := context.Background()
+	db := setupProvablyFairTestDB(t)
+	repo := NewProvablyFairGormRepository(db)
+
+	sess := &provablyfair.PFSession{
+		ID:                  uuid.New(),
+		PlayerID:            uuid.New(),
+		GameSessionID:       uuid.New(),
+		ServerSeedHash:      "hash",
+		EncryptedServerSeed: "seed",
+		Status:              provablyfair.SessionStatusActive,
+		CreatedAt:           time.Now().UTC(),
+	}
+	require.NoError(t, repo.CreateSession(ctx, sess))
+
+	require.NoError(t, repo.ClaimClientNonce(ctx, sess.ID, 1))
+	require.NoError(t, repo.ClaimClientNonce(ctx, sess.ID, 3), "free spins without a nonce leave gaps")
+	assert.ErrorIs(t, repo.ClaimClientNonce(ctx, sess.ID, 3), provablyfair.ErrClientNonceMismatch, "a nonce is claimed once")
+	assert.ErrorIs(t, repo.ClaimClientNonce(ctx, sess.ID, 2), provablyfair.ErrClientNonceMismatch)
+
+	got, err := repo.GetSessionByID(ctx, sess.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), got.LastClientNonce)
+}
+
+func TestProvablyFairGormRepository_ChainAudits(t *testing.T) {
+	ctx := context.Background()
+	db := setupProvablyFairTestDB(t)
+	repo := NewProvablyFairGormRepository(db)
+
+	now := time.Now().UTC()
+	newEnded := func(endedAt time.Time) uuid.UUID {
+		sess := &provablyfair.PFSession{
+			ID:                  uuid.New(),
+			PlayerID:            uuid.New(),
+			GameSessionID:       uuid.New(),
+			ServerSeedHash:      "hash",
+			EncryptedServerSeed: "seed",
+			Status:              provablyfair.SessionStatusEnded,
+			CreatedAt:           endedAt.Add(-time.Hour),
+			EndedAt:             &endedAt,
+		}
+		require.NoError(t, repo.CreateSession(ctx, sess))
+		return sess.ID
+	}
+
+	older := newEnded(now.Add(-2 * time.Hour))
+	newer := newEnded(now.Add(-time.Hour))
+	newEnded(now.Add(-48 * time.Hour))
+
+	pending, err := repo.ListUnauditedSessions(ctx, now.Add(-24*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, older, pending[0].ID, "oldest first")
+
+	require.NoError(t, repo.CreateChainAudit(ctx, &provablyfair.ChainAudit{ID: uuid.New(), PFSessionID: older, PlayerID: uuid.New(), Valid: true, CheckedAt: now}))
+	require.NoError(t, repo.CreateChainAudit(ctx, &provablyfair.ChainAudit{ID: uuid.New(), PFSessionID: newer, PlayerID: uuid.New(), Error: "spin 1 hash mismatch", CheckedAt: now}))
+
+	pending, err = repo.ListUnauditedSessions(ctx, now.Add(-24*time.Hour), 10)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	failed, total, err := repo.ListChainAudits(ctx, true, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, failed, 1)
+	assert.Equal(t, newer, failed[0].PFSessionID)
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"gorm.io/gorm"
+)
+
+// ReelStripCanaryGormRepository implements reelstrip.CanaryRepository using GORM
+type ReelStripCanaryGormRepository struct {
+	db    *gorm.DB
+	cache *cache.Cache
+}
+
+// NewReelStripCanaryGormRepository creates a new GORM reel strip canary repository
+func NewReelStripCanaryGormRepository(db *gorm.DB, cache *cache.Cache) reelstrip.CanaryRepository {
+	return &ReelStripCanaryGormRepository{
+		db:    db,
+		cache: cache,
+	}
+}
+
+// Create inserts a new canary unless one already runs for its game mode
+func (r *ReelStripCanaryGormRepository) Create(ctx context.Context, canary *reelstrip.Canary) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var running int64
+		if err := tx.Model(&reelstrip.Canary{}).
+			Where("game_mode = ? AND status = ?", canary.GameMode, reelstrip.CanaryRunning).
+			Count(&running).Error; err != nil {
+			return fmt.Errorf("failed to check running canaries: %w", err)
+		}
+		if running > 0 {
+			return reelstrip.ErrCanaryRunning
+		}
+
+		if err := tx.Create(canary).Error; err != nil {
+			return fmt.Errorf("failed to create canary: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetByID retrieves a canary by ID
+func (r *ReelStripCanaryGormRepository) GetByID(ctx context.Context, id uuid.UUID) (*reelstrip.Canary, error) {
+	var canary reelstrip.Canary
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&canary).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, reelstrip.ErrCanaryNotFound
+		}
+		return nil, fmt.Errorf("failed to get canary: %w", err)
+	}
+	return &canary, nil
+}
+
+// GetRunning retrieves the canary running for a game mode
+// Misses are cached as an empty canary, so game modes without one cost no query per spin.
+func (r *ReelStripCanaryGormRepository) GetRunning(ctx context.Context, gameMode string) (*reelstrip.Canary, error) {
+	res, err := r.cache.GetWithSingleflight(ctx, r.cache.ReelStripCanaryKey(gameMode), &reelstrip.Canary{}, func() (any, error) {
+		var canary reelstrip.Canary
+		if err := r.db.WithContext(ctx).
+			Where("game_mode = ? AND status = ?", gameMode, reelstrip.CanaryRunning).
+			First(&canary).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &reelstrip.Canary{}, nil
+			}
+			return nil, fmt.Errorf("failed to get running canary: %w", err)
+		}
+		return &canary, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	canary := res.(*reelstrip.Canary)
+	if canary.ID == uuid.Nil {
+		return nil, reelstrip.ErrCanaryNotFound
+	}
+	return canary, nil
+}
+
+// ListRunning lists every running canary, oldest first
+func (r *ReelStripCanaryGormRepository) ListRunning(ctx context.Context) ([]*reelstrip.Canary, error) {
+	var canaries []*reelstrip.Canary
+	if err := r.db.WithContext(ctx).
+		Where("status = ?", reelstrip.CanaryRunning).
+		Order("started_at ASC").
+		Find(&canaries).Error; err != nil {
+		return nil, fmt.Errorf("failed to list running canaries: %w", err)
+	}
+	return canaries, nil
+}
+
+// List lists canaries newest first with the total count for pagination
+func (r *ReelStripCanaryGormRepository) List(ctx context.Context, filters reelstrip.CanaryListFilters) ([]*reelstrip.Canary, int64, error) {
+	query := r.db.WithContext(ctx).Model(&reelstrip.Canary{})
+	if filters.GameMode != "" {
+		query = query.Where("game_mode = ?", filters.GameMode)
+	}
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count canaries: %w", err)
+	}
+
+	var canaries []*reelstrip.Canary
+	offset := (filters.Page - 1) * filters.Limit
+	if err := query.Order("created_at DESC").Offset(offset).Limit(filters.Limit).Find(&canaries).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list canaries: %w", err)
+	}
+	return canaries, total, nil
+}
+
+// UpdateProgress saves the realized performance of a running canary
+func (r *ReelStripCanaryGormRepository) UpdateProgress(ctx context.Context, canary *reelstrip.Canary) error {
+	result := r.db.WithContext(ctx).Model(canary).
+		Where("status = ?", reelstrip.CanaryRunning).
+		Select("spins", "wagered", "won", "realized_rtp", "checked_at", "updated_at").
+		Updates(canary)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update canary progress: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return reelstrip.ErrCanaryNotRunning
+	}
+	return nil
+}
+
+// Finish saves the outcome of a canary only while it is still running in the database
+func (r *ReelStripCanaryGormRepository) Finish(ctx context.Context, canary *reelstrip.Canary) error {
+	result := r.db.WithContext(ctx).Model(canary).
+		Where("status = ?", reelstrip.CanaryRunning).
+		Select("*").Omit("created_at").Updates(canary)
+	if result.Error != nil {
+		return fmt.Errorf("failed to finish canary: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return reelstrip.ErrCanaryNotRunning
+	}
+	return nil
+}
+
+// Performance sums the stakes and wins of spins played on a config since a time
+// Spins reference their config through the provably fair spin log.
+func (r *ReelStripCanaryGormRepository) Performance(ctx context.Context, configID uuid.UUID, since time.Time) (*reelstrip.CanaryPerformance, error) {
+	var perf reelstrip.CanaryPerformance
+	err := r.db.WithContext(ctx).
+		Table("spin_logs AS l").
+		Joins("JOIN spins AS s ON s.id = l.spin_id").
+		Where("l.reel_strip_config_id = ? AND l.created_at >= ?", configID, since).
+		Select("COUNT(*) AS spins, COALESCE(SUM(COALESCE(s.game_mode_cost, s.bet_amount)), 0) AS wagered, COALESCE(SUM(s.total_win), 0) AS won").
+		Scan(&perf).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum canary performance: %w", err)
+	}
+	return &perf, nil
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupCanaryTestDB(t *testing.T) (*gorm.DB, *cache.Cache) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	for _, stmt := range []string{
+		`CREATE TABLE reel_strip_canaries (
+			id TEXT PRIMARY KEY,
+			game_mode TEXT NOT NULL,
+			config_id TEXT NOT NULL,
+			baseline_config_id TEXT NOT NULL,
+			percent INTEGER NOT NULL,
+			max_rtp_deviation REAL NOT NULL,
+			min_spins INTEGER NOT NULL,
+			status TEXT NOT NULL DEFAULT 'running',
+			spins INTEGER NOT NULL DEFAULT 0,
+			wagered REAL NOT NULL DEFAULT 0,
+			won REAL NOT NULL DEFAULT 0,
+			realized_rtp REAL,
+			checked_at DATETIME,
+			reason TEXT,
+			started_by TEXT NOT NULL,
+			started_at DATETIME NOT NULL,
+			ends_at DATETIME NOT NULL,
+			finished_at DATETIME,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE spins (id TEXT PRIMARY KEY, bet_amount REAL NOT NULL, game_mode_cost REAL, total_win REAL NOT NULL DEFAULT 0)`,
+		`CREATE TABLE spin_logs (id TEXT PRIMARY KEY, spin_id TEXT NOT NULL, reel_strip_config_id TEXT, created_at DATETIME NOT NULL)`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+
+	c := cache.NewCache(cache.NewCacheParams{
+		Channel: "test:canary",
+		Config:  &config.Config{App: config.AppConfig{Name: "test", Env: "test"}},
+	})
+	return db, c
+}
+
+func TestReelStripCanaryGormRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	newCanary := func(gameMode string) *reelstrip.Canary {
+		return &reelstrip.Canary{
+			ID:               uuid.New(),
+			GameMode:         gameMode,
+			ConfigID:         uuid.New(),
+			BaselineConfigID: uuid.New(),
+			Percent:          10,
+			MaxRTPDeviation:  2,
+			MinSpins:         100,
+			Status:           reelstrip.CanaryRunning,
+			StartedBy:        "alice",
+			StartedAt:        now,
+			EndsAt:           now.Add(time.Hour),
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		}
+	}
+
+	t.Run("should run one canary per game mode", func(t *testing.T) {
+		db, c := setupCanaryTestDB(t)
+		repo := NewReelStripCanaryGormRepository(db, c)
+
+		_, err := repo.GetRunning(ctx, "base_game")
+		assert.ErrorIs(t, err, reelstrip.ErrCanaryNotFound)
+
+		first := newCanary("base_game")
+		require.NoError(t, repo.Create(ctx, first))
+		assert.ErrorIs(t, repo.Create(ctx, newCanary("base_game")), reelstrip.ErrCanaryRunning)
+		require.NoError(t, repo.Create(ctx, newCanary("free_spins")))
+
+		// The miss stays cached until the key is expired
+		_, err = repo.GetRunning(ctx, "base_game")
+		assert.ErrorIs(t, err, reelstrip.ErrCanaryNotFound)
+		require.NoError(t, c.Expire(ctx, c.ReelStripCanaryKey("base_game")))
+
+		running, err := repo.GetRunning(ctx, "base_game")
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, running.ID)
+
+		all, err := repo.ListRunning(ctx)
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+	})
+
+	t.Run("should finish a canary only once", func(t *testing.T) {
+		db, c := setupCanaryTestDB(t)
+		repo := NewReelStripCanaryGormRepository(db, c)
+
+		canary := newCanary("base_game")
+		require.NoError(t, repo.Create(ctx, canary))
+
+		rtp := 95.5
+		canary.Spins, canary.RealizedRTP = 42, &rtp
+		require.NoError(t, repo.UpdateProgress(ctx, canary))
+
+		reverted, aborted := *canary, *canary
+		reverted.Status, reverted.Reason = reelstrip.CanaryReverted, "drifted"
+		aborted.Status = reelstrip.CanaryAborted
+		require.NoError(t, repo.Finish(ctx, &reverted))
+		assert.ErrorIs(t, repo.Finish(ctx, &aborted), reelstrip.ErrCanaryNotRunning)
+		assert.ErrorIs(t, repo.UpdateProgress(ctx, canary), reelstrip.ErrCanaryNotRunning)
+
+		stored, err := repo.GetByID(ctx, canary.ID)
+		require.NoError(t, err)
+		assert.Equal(t, reelstrip.CanaryReverted, stored.Status)
+		assert.Equal(t, int64(42), stored.Spins)
+		assert.InDelta(t, 95.5, *stored.RealizedRTP, 0.001)
+
+		// A new canary may start once the previous one finished
+		require.NoError(t, repo.Create(ctx, newCanary("base_game")))
+	})
+
+	t.Run("should sum the stakes and wins of spins on the config since the start", func(t *testing.T) {
+		db, c := setupCanaryTestDB(t)
+		repo := NewReelStripCanaryGormRepository(db, c)
+
+		configID, otherID := uuid.New(), uuid.New()
+		insert := func(config uuid.UUID, bet float64, cost *float64, win float64, at time.Time) {
+			spinID := uuid.New().String()
+			require.NoError(t, db.Exec(`INSERT INTO spins (id, bet_amount, game_mode_cost, total_win) VALUES (?, ?, ?, ?)`, spinID, bet, cost, win).Error)
+			require.NoError(t, db.Exec(`INSERT INTO spin_logs (id, spin_id, reel_strip_config_id, created_at) VALUES (?, ?, ?, ?)`,
+				uuid.New().String(), spinID, config.String(), at).Error)
+		}
+		cost := 100.0
+		insert(configID, 1, nil, 0.5, now)
+		insert(configID, 1, &cost, 150, now.Add(time.Minute))
+		insert(configID, 1, nil, 10, now.Add(-time.Hour)) // Before the canary started
+		insert(otherID, 1, nil, 10, now)
+
+		perf, err := repo.Performance(ctx, configID, now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), perf.Spins)
+		assert.InDelta(t, 101, perf.Wagered, 0.001)
+		assert.InDelta(t, 150.5, perf.Won, 0.001)
+
+		rtp, ok := perf.RTP()
+		require.True(t, ok)
+		assert.InDelta(t, 150.5/101*100, rtp, 0.001)
+	})
+}
@@ -9,8 +9,13 @@ import (
 	"github.com/slotmachine/backend/domain/reelstrip"
 	"github.com/slotmachine/backend/internal/pkg/cache"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// reelStripSetCacheTTL is how long a resolved config set stays in process memory.
+// Strips are immutable (checksummed), so only admin config changes invalidate a set, via Expire
+var reelStripSetCacheTTL = 24 * time.Hour
+
 // ReelStripGormRepository implements reelstrip.Repository using GORM
 type ReelStripGormRepository struct {
 	cache *cache.Cache
@@ -182,12 +187,12 @@ func (r *ReelStripGormRepository) CreateConfig(ctx context.Context, config *reel
 	return nil
 }
 
-// GetConfigByID retrieves a reel strip configuration by ID
+// GetConfigByID retrieves a reel strip configuration by ID (not deleted)
 func (r *ReelStripGormRepository) GetConfigByID(ctx context.Context, id uuid.UUID) (*reelstrip.ReelStripConfig, error) {
 	var config reelstrip.ReelStripConfig
 	cacheId := r.cache.ReelStripConfigById(id)
 	res, err := r.cache.GetWithSingleflight(ctx, cacheId, &reelstrip.ReelStripConfig{}, func() (any, error) {
-		if err := r.db.WithContext(ctx).Where("id = ?", id).First(&config).Error; err != nil {
+		if err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&config).Error; err != nil {
 			if err == gorm.ErrRecordNotFound {
 				return nil, reelstrip.ErrConfigNotFound
 			}
@@ -202,10 +207,10 @@ func (r *ReelStripGormRepository) GetConfigByID(ctx context.Context, id uuid.UUI
 	return res.(*reelstrip.ReelStripConfig), nil
 }
 
-// GetConfigByName retrieves a reel strip configuration by name
+// GetConfigByName retrieves a reel strip configuration by name (not deleted)
 func (r *ReelStripGormRepository) GetConfigByName(ctx context.Context, name string) (*reelstrip.ReelStripConfig, error) {
 	var config reelstrip.ReelStripConfig
-	if err := r.db.WithContext(ctx).Where("name = ?", name).First(&config).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("name = ? AND deleted_at IS NULL", name).First(&config).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, reelstrip.ErrConfigNotFound
 		}
@@ -220,7 +225,7 @@ func (r *ReelStripGormRepository) GetDefaultConfig(ctx context.Context, gameMode
 	key := r.cache.DefaultReelStripConfig(gameMode)
 	res, err := r.cache.GetWithSingleflight(ctx, key, &reelstrip.ReelStripConfig{}, func() (any, error) {
 		if err := r.db.WithContext(ctx).
-			Where("(game_mode = ? OR game_mode = ?) AND is_default = ? AND is_active = ?", gameMode, string(reelstrip.Both), true, true).
+			Where("(game_mode = ? OR game_mode = ?) AND is_default = ? AND is_active = ? AND is_demo = ? AND deleted_at IS NULL", gameMode, string(reelstrip.Both), true, true, false).
 			Order(gorm.Expr("CASE game_mode WHEN ? THEN 0 WHEN ? THEN 1 END", gameMode, string(reelstrip.Both))).
 			First(&config).Error; err != nil {
 			if err == gorm.ErrRecordNotFound {
@@ -245,6 +250,11 @@ func (r *ReelStripGormRepository) ListConfigs(ctx context.Context, filters *reel
 	query := r.db.WithContext(ctx).Model(&reelstrip.ReelStripConfig{})
 
 	// Apply filters
+	if filters.Deleted {
+		query = query.Where("deleted_at IS NOT NULL")
+	} else {
+		query = query.Where("deleted_at IS NULL")
+	}
 	if filters.GameMode != nil && *filters.GameMode != "" {
 		query = query.Where("game_mode = ?", *filters.GameMode)
 	}
@@ -254,6 +264,9 @@ func (r *ReelStripGormRepository) ListConfigs(ctx context.Context, filters *reel
 	if filters.IsDefault != nil {
 		query = query.Where("is_default = ?", *filters.IsDefault)
 	}
+	if filters.IsDemo != nil {
+		query = query.Where("is_demo = ?", *filters.IsDemo)
+	}
 	if filters.Name != nil && *filters.Name != "" {
 		query = query.Where("name ILIKE ?", "%"+*filters.Name+"%")
 	}
@@ -298,18 +311,108 @@ func (r *ReelStripGormRepository) UpdateConfig(ctx context.Context, config *reel
 	return nil
 }
 
-// DeleteConfig soft deletes a configuration by marking it as inactive
+// DeleteConfig soft deletes a configuration, marking it as inactive
+// A default config must be replaced as the default, and a config still referenced by player
+// assignments, operator defaults, running canaries, segment targets or demo settings must be
+// released, before it can be deleted; otherwise those players' spins would stop resolving.
 func (r *ReelStripGormRepository) DeleteConfig(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).
-		Model(&reelstrip.ReelStripConfig{}).
-		Where("id = ?", id).
-		Update("is_active", false)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var config reelstrip.ReelStripConfig
+		if err := tx.Where("id = ? AND deleted_at IS NULL", id).First(&config).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return reelstrip.ErrConfigNotFound
+			}
+			return fmt.Errorf("failed to get config: %w", err)
+		}
+		if config.IsDefault {
+			return reelstrip.ErrConfigIsDefault
+		}
 
-	if result.Error != nil {
-		return fmt.Errorf("failed to delete config: %w", result.Error)
+		inUse, err := configInUse(tx, id)
+		if err != nil {
+			return err
+		}
+		if inUse {
+			return reelstrip.ErrConfigInUse
+		}
+
+		now := time.Now().UTC()
+		result := tx.
+			Model(&reelstrip.ReelStripConfig{}).
+			Where("id = ? AND deleted_at IS NULL", id).
+			Updates(map[string]interface{}{
+				"is_active":  false,
+				"deleted_at": now,
+				"updated_at": now,
+			})
+
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete config: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return reelstrip.ErrConfigNotFound
+		}
+		return nil
+	})
+}
+
+// configInUse reports whether anything that resolves configs for play still points at id
+func configInUse(tx *gorm.DB, id uuid.UUID) (bool, error) {
+	references := []struct {
+		what  string
+		query *gorm.DB
+	}{
+		{"player assignments", tx.Model(&reelstrip.PlayerReelStripAssignment{}).
+			Where("is_active = ? AND (base_game_config_id = ? OR free_spins_config_id = ?)", true, id, id)},
+		{"operator defaults", tx.Model(&reelstrip.OperatorReelStripDefault{}).
+			Where("base_game_config_id = ? OR free_spins_config_id = ?", id, id)},
+		{"canaries", tx.Model(&reelstrip.Canary{}).
+			Where("status = ? AND (config_id = ? OR baseline_config_id = ?)", reelstrip.CanaryRunning, id, id)},
+		{"segment targets", tx.Table("segment_targets").
+			Where("kind = ? AND is_active = ? AND CAST(settings AS TEXT) LIKE ?", "reel_strip", true, "%"+id.String()+"%")},
+		{"trial settings", tx.Table("trial_game_settings").
+			Where("base_game_config_id = ? OR free_spins_config_id = ?", id, id)},
+	}
+	for _, ref := range references {
+		var count int64
+		if err := ref.query.Count(&count).Error; err != nil {
+			return false, fmt.Errorf("failed to check config %s: %w", ref.what, err)
+		}
+		if count > 0 {
+			return true, nil
+		}
 	}
-	if result.RowsAffected == 0 {
-		return reelstrip.ErrConfigNotFound
+	return false, nil
+}
+
+// RestoreConfig undeletes a soft-deleted configuration; it stays inactive until activated
+func (r *ReelStripGormRepository) RestoreConfig(ctx context.Context, id uuid.UUID) error {
+	var config reelstrip.ReelStripConfig
+	if err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NOT NULL", id).First(&config).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return reelstrip.ErrConfigNotFound
+		}
+		return fmt.Errorf("failed to get deleted config: %w", err)
+	}
+
+	var taken int64
+	if err := r.db.WithContext(ctx).Model(&reelstrip.ReelStripConfig{}).
+		Where("name = ? AND deleted_at IS NULL", config.Name).
+		Count(&taken).Error; err != nil {
+		return fmt.Errorf("failed to check config name: %w", err)
+	}
+	if taken > 0 {
+		return reelstrip.ErrConfigNameTaken
+	}
+
+	if err := r.db.WithContext(ctx).
+		Model(&reelstrip.ReelStripConfig{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"updated_at": time.Now().UTC(),
+		}).Error; err != nil {
+		return fmt.Errorf("failed to restore config: %w", err)
 	}
 	return nil
 }
@@ -325,9 +428,9 @@ func (r *ReelStripGormRepository) SetDefaultConfig(ctx context.Context, id uuid.
 			return fmt.Errorf("failed to unset existing default: %w", err)
 		}
 
-		// Set the new default
+		// Set the new default (demo configs can never become a real-money default)
 		result := tx.Model(&reelstrip.ReelStripConfig{}).
-			Where("id = ? AND game_mode = ?", id, gameMode).
+			Where("id = ? AND game_mode = ? AND is_demo = ? AND deleted_at IS NULL", id, gameMode, false).
 			Update("is_default", true)
 
 		if result.Error != nil {
@@ -342,13 +445,17 @@ func (r *ReelStripGormRepository) SetDefaultConfig(ctx context.Context, id uuid.
 }
 
 // GetSetByConfigID retrieves a complete reel strip set by configuration ID
+// Soft-deleted configs are included so spins played on them can still be verified.
 func (r *ReelStripGormRepository) GetSetByConfigID(ctx context.Context, configID uuid.UUID) (*reelstrip.ReelStripConfigSet, error) {
 	// Get the configuration
 	cacheKey := r.cache.ReelStripConfigSetKey(configID)
 	res, err := r.cache.GetWithSingleflight(ctx, cacheKey, &reelstrip.ReelStripConfigSet{}, func() (any, error) {
-		config, err := r.GetConfigByID(ctx, configID)
-		if err != nil {
-			return nil, err
+		var config reelstrip.ReelStripConfig
+		if err := r.db.WithContext(ctx).Where("id = ?", configID).First(&config).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, reelstrip.ErrConfigNotFound
+			}
+			return nil, fmt.Errorf("failed to get config by ID: %w", err)
 		}
 
 		// Collect all reel strip IDs
@@ -374,7 +481,7 @@ func (r *ReelStripGormRepository) GetSetByConfigID(ctx context.Context, configID
 
 		// Build the config set in correct order
 		configSet := &reelstrip.ReelStripConfigSet{
-			Config: config,
+			Config: &config,
 		}
 
 		configSet.Strips[0] = stripMap[config.Reel0StripID]
@@ -389,7 +496,7 @@ func (r *ReelStripGormRepository) GetSetByConfigID(ctx context.Context, configID
 		}
 
 		return configSet, nil
-	})
+	}, &reelStripSetCacheTTL)
 
 	if err != nil {
 		return nil, err
@@ -481,3 +588,63 @@ func (r *ReelStripGormRepository) DeleteAssignment(ctx context.Context, id uuid.
 	}
 	return nil
 }
+
+// ===== OperatorReelStripDefault Methods =====
+
+// GetOperatorDefault retrieves an operator's default configs
+// Misses are cached as an empty default, so operators without one cost no query per spin.
+func (r *ReelStripGormRepository) GetOperatorDefault(ctx context.Context, operatorID string) (*reelstrip.OperatorReelStripDefault, error) {
+	res, err := r.cache.GetWithSingleflight(ctx, r.cache.OperatorDefaultKey(operatorID), &reelstrip.OperatorReelStripDefault{}, func() (any, error) {
+		var def reelstrip.OperatorReelStripDefault
+		if err := r.db.WithContext(ctx).Where("operator_id = ?", operatorID).First(&def).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return &reelstrip.OperatorReelStripDefault{}, nil
+			}
+			return nil, fmt.Errorf("failed to get operator default: %w", err)
+		}
+		return &def, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	def := res.(*reelstrip.OperatorReelStripDefault)
+	if def.OperatorID == "" {
+		return nil, reelstrip.ErrOperatorDefaultNotFound
+	}
+	return def, nil
+}
+
+// ListOperatorDefaults retrieves every operator default, ordered by operator
+func (r *ReelStripGormRepository) ListOperatorDefaults(ctx context.Context) ([]*reelstrip.OperatorReelStripDefault, error) {
+	var defaults []*reelstrip.OperatorReelStripDefault
+	if err := r.db.WithContext(ctx).Order("operator_id").Find(&defaults).Error; err != nil {
+		return nil, fmt.Errorf("failed to list operator defaults: %w", err)
+	}
+	return defaults, nil
+}
+
+// UpsertOperatorDefault creates or replaces an operator's default configs, keeping its created_at
+func (r *ReelStripGormRepository) UpsertOperatorDefault(ctx context.Context, def *reelstrip.OperatorReelStripDefault) error {
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "operator_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"target_rtp", "base_game_config_id", "free_spins_config_id", "updated_by", "updated_at"}),
+		}).
+		Create(def).Error; err != nil {
+		return fmt.Errorf("failed to save operator default: %w", err)
+	}
+	return nil
+}
+
+// DeleteOperatorDefault removes an operator's default configs
+func (r *ReelStripGormRepository) DeleteOperatorDefault(ctx context.Context, operatorID string) error {
+	result := r.db.WithContext(ctx).Delete(&reelstrip.OperatorReelStripDefault{}, "operator_id = ?", operatorID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete operator default: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return reelstrip.ErrOperatorDefaultNotFound
+	}
+	return nil
+}
@@ -42,7 +42,7 @@ func setupReelStripTestDB(t *testing.T) (*gorm.DB, *cache.Cache) {
 	err = db.Exec(`
 		CREATE TABLE reel_strip_configs (
 			id TEXT PRIMARY KEY,
-			name TEXT NOT NULL UNIQUE,
+			name TEXT NOT NULL,
 			game_mode TEXT NOT NULL,
 			description TEXT,
 			reel0_strip_id TEXT NOT NULL,
@@ -54,16 +54,21 @@ func setupReelStripTestDB(t *testing.T) (*gorm.DB, *cache.Cache) {
 			options TEXT,
 			is_active INTEGER DEFAULT 1,
 			is_default INTEGER DEFAULT 0,
+			is_demo INTEGER NOT NULL DEFAULT 0,
 			activated_at DATETIME,
 			deactivated_at DATETIME,
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			created_by TEXT,
-			notes TEXT
+			notes TEXT,
+			deleted_at DATETIME
 		)
 	`).Error
 	require.NoError(t, err, "Failed to create reel_strip_configs table")
 
+	err = db.Exec("CREATE UNIQUE INDEX idx_reel_strip_configs_name_live ON reel_strip_configs(name) WHERE deleted_at IS NULL").Error
+	require.NoError(t, err, "Failed to create live config name index")
+
 	// Create player_reel_strip_assignments table
 	err = db.Exec(`
 		CREATE TABLE player_reel_strip_assignments (
@@ -80,6 +85,52 @@ func setupReelStripTestDB(t *testing.T) (*gorm.DB, *cache.Cache) {
 	`).Error
 	require.NoError(t, err, "Failed to create player_reel_strip_assignments table")
 
+	// Create operator_reel_strip_defaults table
+	err = db.Exec(`
+		CREATE TABLE operator_reel_strip_defaults (
+			operator_id TEXT PRIMARY KEY,
+			target_rtp REAL NOT NULL,
+			base_game_config_id TEXT,
+			free_spins_config_id TEXT,
+			updated_by TEXT,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create operator_reel_strip_defaults table")
+
+	// Tables that reference configs and keep them from being deleted
+	err = db.Exec(`
+		CREATE TABLE reel_strip_canaries (
+			id TEXT PRIMARY KEY,
+			game_mode TEXT NOT NULL,
+			config_id TEXT NOT NULL,
+			baseline_config_id TEXT NOT NULL,
+			status TEXT NOT NULL
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create reel_strip_canaries table")
+
+	err = db.Exec(`
+		CREATE TABLE segment_targets (
+			id TEXT PRIMARY KEY,
+			segment_id TEXT NOT NULL,
+			kind TEXT NOT NULL,
+			settings TEXT NOT NULL,
+			is_active INTEGER DEFAULT 1
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create segment_targets table")
+
+	err = db.Exec(`
+		CREATE TABLE trial_game_settings (
+			game_id TEXT PRIMARY KEY,
+			base_game_config_id TEXT,
+			free_spins_config_id TEXT
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create trial_game_settings table")
+
 	// Create cache instance with minimal config for testing
 	c := cache.NewCache(cache.NewCacheParams{
 		Channel: "test",
@@ -438,6 +489,39 @@ func TestReelStripGormRepository_SetDefaultConfig(t *testing.T) {
 		require.NoError(t, err)
 		assert.False(t, retrieved1.IsDefault)
 	})
+
+	t.Run("should refuse demo config as default", func(t *testing.T) {
+		db, c := setupReelStripTestDB(t)
+		repo := NewReelStripGormRepository(db, c)
+
+		stripIDs := [5]uuid.UUID{}
+		for i := 0; i < 5; i++ {
+			strip := createTestReelStrip("base_game", i)
+			require.NoError(t, repo.Create(ctx, strip))
+			stripIDs[i] = strip.ID
+		}
+
+		demo := &reelstrip.ReelStripConfig{
+			ID:           uuid.New(),
+			Name:         "demo",
+			GameMode:     "base_game",
+			Reel0StripID: stripIDs[0],
+			Reel1StripID: stripIDs[1],
+			Reel2StripID: stripIDs[2],
+			Reel3StripID: stripIDs[3],
+			Reel4StripID: stripIDs[4],
+			IsActive:     true,
+			IsDemo:       true,
+		}
+		require.NoError(t, repo.CreateConfig(ctx, demo))
+
+		err := repo.SetDefaultConfig(ctx, demo.ID, "base_game")
+		assert.ErrorIs(t, err, reelstrip.ErrConfigNotFound)
+
+		retrieved, err := repo.GetConfigByID(ctx, demo.ID)
+		require.NoError(t, err)
+		assert.False(t, retrieved.IsDefault)
+	})
 }
 
 func TestReelStripGormRepository_GetDefaultConfig(t *testing.T) {
@@ -488,6 +572,25 @@ func TestReelStripGormRepository_GetDefaultConfig(t *testing.T) {
 		assert.Nil(t, retrieved)
 		assert.Equal(t, reelstrip.ErrNoDefaultConfig, err)
 	})
+
+	t.Run("should never return a demo config", func(t *testing.T) {
+		db, c := setupReelStripTestDB(t)
+		repo := NewReelStripGormRepository(db, c)
+
+		// Written directly to bypass SetDefaultConfig's guard
+		demo := &reelstrip.ReelStripConfig{
+			ID:        uuid.New(),
+			Name:      "demo_default",
+			GameMode:  "base_game",
+			IsDefault: true,
+			IsActive:  true,
+			IsDemo:    true,
+		}
+		require.NoError(t, db.Create(demo).Error)
+
+		_, err := repo.GetDefaultConfig(ctx, "base_game")
+		assert.Equal(t, reelstrip.ErrNoDefaultConfig, err)
+	})
 }
 
 func TestReelStripGormRepository_GetSetByConfigID(t *testing.T) {
@@ -535,6 +638,140 @@ func TestReelStripGormRepository_GetSetByConfigID(t *testing.T) {
 	})
 }
 
+func TestReelStripGormRepository_DeleteConfig(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (reelstrip.Repository, *reelstrip.ReelStripConfig) {
+		db, c := setupReelStripTestDB(t)
+		repo := NewReelStripGormRepository(db, c)
+
+		stripIDs := [5]uuid.UUID{}
+		for i := 0; i < 5; i++ {
+			strip := createTestReelStrip("base_game", i)
+			require.NoError(t, repo.Create(ctx, strip))
+			stripIDs[i] = strip.ID
+		}
+		config := &reelstrip.ReelStripConfig{
+			ID:           uuid.New(),
+			Name:         "retired",
+			GameMode:     "base_game",
+			Reel0StripID: stripIDs[0],
+			Reel1StripID: stripIDs[1],
+			Reel2StripID: stripIDs[2],
+			Reel3StripID: stripIDs[3],
+			Reel4StripID: stripIDs[4],
+			IsActive:     true,
+		}
+		require.NoError(t, repo.CreateConfig(ctx, config))
+		return repo, config
+	}
+
+	t.Run("should hide a deleted config but keep its set for verification", func(t *testing.T) {
+		repo, config := setup(t)
+
+		require.NoError(t, repo.DeleteConfig(ctx, config.ID))
+
+		_, err := repo.GetConfigByID(ctx, config.ID)
+		assert.ErrorIs(t, err, reelstrip.ErrConfigNotFound)
+		_, err = repo.GetConfigByName(ctx, config.Name)
+		assert.ErrorIs(t, err, reelstrip.ErrConfigNotFound)
+
+		live, total, err := repo.ListConfigs(ctx, &reelstrip.ConfigListFilters{})
+		require.NoError(t, err)
+		assert.Empty(t, live)
+		assert.Zero(t, total)
+		deleted, _, err := repo.ListConfigs(ctx, &reelstrip.ConfigListFilters{Deleted: true})
+		require.NoError(t, err)
+		require.Len(t, deleted, 1)
+		assert.False(t, deleted[0].IsActive)
+
+		set, err := repo.GetSetByConfigID(ctx, config.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, set.Config.DeletedAt)
+
+		assert.ErrorIs(t, repo.SetDefaultConfig(ctx, config.ID, "base_game"), reelstrip.ErrConfigNotFound)
+	})
+
+	t.Run("should refuse to delete a default config", func(t *testing.T) {
+		repo, config := setup(t)
+		require.NoError(t, repo.SetDefaultConfig(ctx, config.ID, "base_game"))
+
+		assert.ErrorIs(t, repo.DeleteConfig(ctx, config.ID), reelstrip.ErrConfigIsDefault)
+	})
+
+	t.Run("should refuse to delete a config a player is assigned and keep resolving it for their spins", func(t *testing.T) {
+		repo, config := setup(t)
+		playerID := uuid.New()
+		assignment := &reelstrip.PlayerReelStripAssignment{
+			ID:               uuid.New(),
+			PlayerID:         playerID,
+			BaseGameConfigID: &config.ID,
+			IsActive:         true,
+		}
+		require.NoError(t, repo.CreateAssignment(ctx, assignment))
+
+		assert.ErrorIs(t, repo.DeleteConfig(ctx, config.ID), reelstrip.ErrConfigInUse)
+
+		// The player's next spin resolves their assignment to the config's set
+		got, err := repo.GetPlayerAssignment(ctx, playerID)
+		require.NoError(t, err)
+		set, err := repo.GetSetByConfigID(ctx, *got.BaseGameConfigID)
+		require.NoError(t, err)
+		assert.Equal(t, config.ID, set.Config.ID)
+		assert.Nil(t, set.Config.DeletedAt)
+
+		require.NoError(t, repo.DeleteAssignment(ctx, assignment.ID))
+		assert.NoError(t, repo.DeleteConfig(ctx, config.ID))
+	})
+
+	t.Run("should refuse to delete a config used by an operator default, canary, segment target or demo settings", func(t *testing.T) {
+		repo, config := setup(t)
+		db := repo.(*ReelStripGormRepository).db
+
+		references := []struct {
+			name    string
+			insert  string
+			args    []any
+			release string
+		}{
+			{"operator default", `INSERT INTO operator_reel_strip_defaults (operator_id, target_rtp, free_spins_config_id) VALUES ('op-1', 96, ?)`,
+				[]any{config.ID.String()}, `DELETE FROM operator_reel_strip_defaults`},
+			{"canary", `INSERT INTO reel_strip_canaries (id, game_mode, config_id, baseline_config_id, status) VALUES (?, 'base_game', ?, ?, 'running')`,
+				[]any{uuid.NewString(), uuid.NewString(), config.ID.String()}, `UPDATE reel_strip_canaries SET status = 'promoted'`},
+			{"segment target", `INSERT INTO segment_targets (id, segment_id, kind, settings, is_active) VALUES (?, ?, 'reel_strip', ?, 1)`,
+				[]any{uuid.NewString(), uuid.NewString(), `{"base_game_config_id":"` + config.ID.String() + `"}`}, `UPDATE segment_targets SET is_active = 0`},
+			{"demo settings", `INSERT INTO trial_game_settings (game_id, base_game_config_id) VALUES (?, ?)`,
+				[]any{uuid.NewString(), config.ID.String()}, `DELETE FROM trial_game_settings`},
+		}
+		for _, ref := range references {
+			require.NoError(t, db.Exec(ref.insert, ref.args...).Error, ref.name)
+			assert.ErrorIs(t, repo.DeleteConfig(ctx, config.ID), reelstrip.ErrConfigInUse, ref.name)
+			require.NoError(t, db.Exec(ref.release).Error, ref.name)
+		}
+
+		assert.NoError(t, repo.DeleteConfig(ctx, config.ID))
+	})
+
+	t.Run("should restore a deleted config unless its name was reused", func(t *testing.T) {
+		repo, config := setup(t)
+		require.NoError(t, repo.DeleteConfig(ctx, config.ID))
+
+		require.NoError(t, repo.RestoreConfig(ctx, config.ID))
+		restored, err := repo.GetConfigByID(ctx, config.ID)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+		assert.False(t, restored.IsActive)
+		assert.ErrorIs(t, repo.RestoreConfig(ctx, config.ID), reelstrip.ErrConfigNotFound)
+
+		require.NoError(t, repo.DeleteConfig(ctx, config.ID))
+		reused := *config
+		reused.ID = uuid.New()
+		reused.DeletedAt = nil
+		require.NoError(t, repo.CreateConfig(ctx, &reused))
+		assert.ErrorIs(t, repo.RestoreConfig(ctx, config.ID), reelstrip.ErrConfigNameTaken)
+	})
+}
+
 // ============================================================================
 // PlayerReelStripAssignment TESTS
 // ============================================================================
@@ -631,3 +868,55 @@ func TestReelStripGormRepository_DeleteAssignment(t *testing.T) {
 		assert.Error(t, err) // Should return "record not found"
 	})
 }
+
+func TestReelStripGormRepository_OperatorDefaults(t *testing.T) {
+	ctx := context.Background()
+	db, c := setupReelStripTestDB(t)
+	repo := NewReelStripGormRepository(db, c)
+
+	_, err := repo.GetOperatorDefault(ctx, "brand-a")
+	assert.ErrorIs(t, err, reelstrip.ErrOperatorDefaultNotFound)
+
+	baseConfigID := uuid.New()
+	def := &reelstrip.OperatorReelStripDefault{OperatorID: "brand-a", TargetRTP: 94, BaseGameConfigID: &baseConfigID, UpdatedBy: "alice"}
+	require.NoError(t, repo.UpsertOperatorDefault(ctx, def))
+	created := def.CreatedAt
+
+	// The miss stays cached until the key is expired
+	_, err = repo.GetOperatorDefault(ctx, "brand-a")
+	assert.ErrorIs(t, err, reelstrip.ErrOperatorDefaultNotFound)
+	require.NoError(t, c.Expire(ctx, c.OperatorDefaultKey("brand-a")))
+
+	saved, err := repo.GetOperatorDefault(ctx, "brand-a")
+	require.NoError(t, err)
+	assert.Equal(t, 94.0, saved.TargetRTP)
+	assert.Equal(t, baseConfigID, *saved.BaseGameConfigID)
+	assert.Nil(t, saved.FreeSpinsConfigID)
+
+	t.Run("should replace an existing default and keep its creation time", func(t *testing.T) {
+		freeSpinsConfigID := uuid.New()
+		replacement := &reelstrip.OperatorReelStripDefault{OperatorID: "brand-a", TargetRTP: 92, FreeSpinsConfigID: &freeSpinsConfigID, UpdatedBy: "bob"}
+		require.NoError(t, repo.UpsertOperatorDefault(ctx, replacement))
+		require.NoError(t, c.Expire(ctx, c.OperatorDefaultKey("brand-a")))
+
+		saved, err := repo.GetOperatorDefault(ctx, "brand-a")
+		require.NoError(t, err)
+		assert.Equal(t, 92.0, saved.TargetRTP)
+		assert.Nil(t, saved.BaseGameConfigID)
+		assert.Equal(t, freeSpinsConfigID, *saved.FreeSpinsConfigID)
+		assert.Equal(t, "bob", saved.UpdatedBy)
+		assert.True(t, saved.CreatedAt.Equal(created))
+	})
+
+	t.Run("should list and delete defaults", func(t *testing.T) {
+		require.NoError(t, repo.UpsertOperatorDefault(ctx, &reelstrip.OperatorReelStripDefault{OperatorID: "brand-0", TargetRTP: 96, BaseGameConfigID: &baseConfigID}))
+
+		defaults, err := repo.ListOperatorDefaults(ctx)
+		require.NoError(t, err)
+		require.Len(t, defaults, 2)
+		assert.Equal(t, "brand-0", defaults[0].OperatorID)
+
+		require.NoError(t, repo.DeleteOperatorDefault(ctx, "brand-0"))
+		assert.ErrorIs(t, repo.DeleteOperatorDefault(ctx, "brand-0"), reelstrip.ErrOperatorDefaultNotFound)
+	})
+}
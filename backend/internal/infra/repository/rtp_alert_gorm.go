@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"gorm.io/gorm"
+)
+
+// RTPAlertGormRepository implements reelstrip.RTPAlertRepository using GORM
+type RTPAlertGormRepository struct {
+	db *gorm.DB
+}
+
+// NewRTPAlertGormRepository creates a new GORM RTP alert repository
+func NewRTPAlertGormRepository(db *gorm.DB) reelstrip.RTPAlertRepository {
+	return &RTPAlertGormRepository{db: db}
+}
+
+// Sample sums the stakes, wins and per-spin returns of spins played on a config in a window
+// Spins reference their config through the provably fair spin log; spins that cost nothing
+// have no return and are left out.
+func (r *RTPAlertGormRepository) Sample(ctx context.Context, configID uuid.UUID, since, until time.Time) (*reelstrip.RTPSample, error) {
+	const stake = "COALESCE(s.game_mode_cost, s.bet_amount)"
+
+	var sample reelstrip.RTPSample
+	err := r.db.WithContext(ctx).
+		Table("spin_logs AS l").
+		Joins("JOIN spins AS s ON s.id = l.spin_id").
+		Where("l.reel_strip_config_id = ? AND l.created_at >= ? AND l.created_at < ?", configID, since, until).
+		Where(stake + " > 0").
+		Select("COUNT(*) AS spins, " +
+			"COALESCE(SUM(" + stake + "), 0) AS wagered, " +
+			"COALESCE(SUM(s.total_win), 0) AS won, " +
+			"COALESCE(SUM(s.total_win / " + stake + "), 0) AS sum_returns, " +
+			"COALESCE(SUM((s.total_win / " + stake + ") * (s.total_win / " + stake + ")), 0) AS sum_squared_returns").
+		Scan(&sample).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to sample config RTP: %w", err)
+	}
+	return &sample, nil
+}
+
+// Create inserts a new RTP alert
+func (r *RTPAlertGormRepository) Create(ctx context.Context, alert *reelstrip.RTPAlert) error {
+	if err := r.db.WithContext(ctx).Create(alert).Error; err != nil {
+		return fmt.Errorf("failed to create RTP alert: %w", err)
+	}
+	return nil
+}
+
+// LastAlertedAt returns when the config was last alerted on, or nil if it never was
+func (r *RTPAlertGormRepository) LastAlertedAt(ctx context.Context, configID uuid.UUID) (*time.Time, error) {
+	var alert reelstrip.RTPAlert
+	if err := r.db.WithContext(ctx).
+		Select("created_at").
+		Where("config_id = ?", configID).
+		Order("created_at DESC").
+		First(&alert).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get last RTP alert: %w", err)
+	}
+	return &alert.CreatedAt, nil
+}
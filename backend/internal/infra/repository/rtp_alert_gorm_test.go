@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupRTPAlertTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	for _, stmt := range []string{
+		`CREATE TABLE rtp_alerts (
+			id TEXT PRIMARY KEY,
+			config_id TEXT NOT NULL,
+			config_name TEXT NOT NULL,
+			game_mode TEXT NOT NULL,
+			target_rtp REAL NOT NULL,
+			realized_rtp REAL NOT NULL,
+			lower_bound REAL NOT NULL,
+			upper_bound REAL NOT NULL,
+			z_score REAL NOT NULL,
+			spins INTEGER NOT NULL,
+			wagered REAL NOT NULL,
+			won REAL NOT NULL,
+			window_start DATETIME NOT NULL,
+			window_end DATETIME NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE spins (id TEXT PRIMARY KEY, bet_amount REAL NOT NULL, game_mode_cost REAL, total_win REAL NOT NULL DEFAULT 0)`,
+		`CREATE TABLE spin_logs (id TEXT PRIMARY KEY, spin_id TEXT NOT NULL, reel_strip_config_id TEXT, created_at DATETIME NOT NULL)`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	return db
+}
+
+func TestRTPAlertGormRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	t.Run("should sample the spins on the config within the window", func(t *testing.T) {
+		db := setupRTPAlertTestDB(t)
+		repo := NewRTPAlertGormRepository(db)
+
+		configID, otherID := uuid.New(), uuid.New()
+		insert := func(config uuid.UUID, bet float64, cost *float64, win float64, at time.Time) {
+			spinID := uuid.New().String()
+			require.NoError(t, db.Exec(`INSERT INTO spins (id, bet_amount, game_mode_cost, total_win) VALUES (?, ?, ?, ?)`, spinID, bet, cost, win).Error)
+			require.NoError(t, db.Exec(`INSERT INTO spin_logs (id, spin_id, reel_strip_config_id, created_at) VALUES (?, ?, ?, ?)`,
+				uuid.New().String(), spinID, config.String(), at).Error)
+		}
+		cost, free := 100.0, 0.0
+		insert(configID, 1, nil, 0.5, now.Add(-time.Hour))
+		insert(configID, 1, &cost, 150, now.Add(-time.Minute))
+		insert(configID, 1, &free, 5, now.Add(-time.Minute)) // Cost nothing
+		insert(configID, 1, nil, 10, now.Add(-3*time.Hour))  // Before the window
+		insert(configID, 1, nil, 10, now.Add(time.Minute))   // After the window
+		insert(otherID, 1, nil, 10, now.Add(-time.Minute))
+
+		sample, err := repo.Sample(ctx, configID, now.Add(-2*time.Hour), now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), sample.Spins)
+		assert.InDelta(t, 101, sample.Wagered, 0.001)
+		assert.InDelta(t, 150.5, sample.Won, 0.001)
+		assert.InDelta(t, 2.0, sample.SumReturns, 0.001)
+		assert.InDelta(t, 2.5, sample.SumSquaredReturns, 0.001)
+
+		// Returns of 0.5 and 1.5 have a sample variance of 0.5
+		assert.InDelta(t, math.Sqrt(0.5/2)*100, sample.StandardError(), 0.001)
+	})
+
+	t.Run("should return when the config was last alerted on", func(t *testing.T) {
+		db := setupRTPAlertTestDB(t)
+		repo := NewRTPAlertGormRepository(db)
+
+		configID := uuid.New()
+		last, err := repo.LastAlertedAt(ctx, configID)
+		require.NoError(t, err)
+		assert.Nil(t, last)
+
+		for _, at := range []time.Time{now.Add(-2 * time.Hour), now.Add(-time.Hour)} {
+			require.NoError(t, repo.Create(ctx, &reelstrip.RTPAlert{
+				ID:          uuid.New(),
+				ConfigID:    configID,
+				ConfigName:  "v2",
+				GameMode:    "base_game",
+				TargetRTP:   96,
+				RealizedRTP: 90,
+				Spins:       1000,
+				WindowStart: at.Add(-24 * time.Hour),
+				WindowEnd:   at,
+				CreatedAt:   at,
+			}))
+		}
+
+		last, err = repo.LastAlertedAt(ctx, configID)
+		require.NoError(t, err)
+		require.NotNil(t, last)
+		assert.WithinDuration(t, now.Add(-time.Hour), *last, time.Second)
+	})
+}
@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/segment"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SegmentGormRepository implements segment.Repository using GORM
+type SegmentGormRepository struct {
+	db *gorm.DB
+}
+
+// NewSegmentGormRepository creates a new GORM segment repository
+func NewSegmentGormRepository(db *gorm.DB) segment.Repository {
+	return &SegmentGormRepository{db: db}
+}
+
+// AddTag tags a player; adding an existing tag is a no-op
+func (r *SegmentGormRepository) AddTag(ctx context.Context, tag *segment.PlayerTag) error {
+	if tag.CreatedAt.IsZero() {
+		tag.CreatedAt = time.Now()
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(tag).Error; err != nil {
+		return fmt.Errorf("failed to add player tag: %w", err)
+	}
+	return nil
+}
+
+// RemoveTag removes a tag from a player
+func (r *SegmentGormRepository) RemoveTag(ctx context.Context, playerID uuid.UUID, tag string) error {
+	if err := r.db.WithContext(ctx).
+		Where("player_id = ? AND tag = ?", playerID, tag).
+		Delete(&segment.PlayerTag{}).Error; err != nil {
+		return fmt.Errorf("failed to remove player tag: %w", err)
+	}
+	return nil
+}
+
+// ListTags lists a player's tags ordered by tag
+func (r *SegmentGormRepository) ListTags(ctx context.Context, playerID uuid.UUID) ([]*segment.PlayerTag, error) {
+	var tags []*segment.PlayerTag
+	if err := r.db.WithContext(ctx).
+		Where("player_id = ?", playerID).
+		Order("tag ASC").
+		Find(&tags).Error; err != nil {
+		return nil, fmt.Errorf("failed to list player tags: %w", err)
+	}
+	return tags, nil
+}
+
+// CreateSegment inserts a new segment
+func (r *SegmentGormRepository) CreateSegment(ctx context.Context, s *segment.Segment) error {
+	if err := r.db.WithContext(ctx).Create(s).Error; err != nil {
+		return fmt.Errorf("failed to create segment: %w", err)
+	}
+	return nil
+}
+
+// GetSegmentByID retrieves a segment by ID
+func (r *SegmentGormRepository) GetSegmentByID(ctx context.Context, id uuid.UUID) (*segment.Segment, error) {
+	var s segment.Segment
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&s).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, segment.ErrSegmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get segment: %w", err)
+	}
+	return &s, nil
+}
+
+// GetSegmentByName retrieves a segment by name
+func (r *SegmentGormRepository) GetSegmentByName(ctx context.Context, name string) (*segment.Segment, error) {
+	var s segment.Segment
+	if err := r.db.WithContext(ctx).Where("name = ?", name).First(&s).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, segment.ErrSegmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get segment by name: %w", err)
+	}
+	return &s, nil
+}
+
+// GetSegmentsByIDs retrieves multiple segments by ID
+func (r *SegmentGormRepository) GetSegmentsByIDs(ctx context.Context, ids []uuid.UUID) ([]*segment.Segment, error) {
+	var segments []*segment.Segment
+	if len(ids) == 0 {
+		return segments, nil
+	}
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&segments).Error; err != nil {
+		return nil, fmt.Errorf("failed to get segments: %w", err)
+	}
+	return segments, nil
+}
+
+// ListSegments lists segments ordered by name, optionally filtered by active status
+func (r *SegmentGormRepository) ListSegments(ctx context.Context, isActive *bool) ([]*segment.Segment, error) {
+	var segments []*segment.Segment
+	query := r.db.WithContext(ctx).Model(&segment.Segment{})
+	if isActive != nil {
+		query = query.Where("is_active = ?", *isActive)
+	}
+	if err := query.Order("name ASC").Find(&segments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list segments: %w", err)
+	}
+	return segments, nil
+}
+
+// UpdateSegment updates a segment
+func (r *SegmentGormRepository) UpdateSegment(ctx context.Context, s *segment.Segment) error {
+	s.UpdatedAt = time.Now()
+	result := r.db.WithContext(ctx).Save(s)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update segment: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return segment.ErrSegmentNotFound
+	}
+	return nil
+}
+
+// DeleteSegment deletes a segment together with its targets
+func (r *SegmentGormRepository) DeleteSegment(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("segment_id = ?", id).Delete(&segment.Target{}).Error; err != nil {
+			return fmt.Errorf("failed to delete segment targets: %w", err)
+		}
+		result := tx.Where("id = ?", id).Delete(&segment.Segment{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete segment: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return segment.ErrSegmentNotFound
+		}
+		return nil
+	})
+}
+
+// CreateTarget inserts a new segment target
+func (r *SegmentGormRepository) CreateTarget(ctx context.Context, t *segment.Target) error {
+	if err := r.db.WithContext(ctx).Create(t).Error; err != nil {
+		return fmt.Errorf("failed to create segment target: %w", err)
+	}
+	return nil
+}
+
+// GetTargetByID retrieves a segment target by ID
+func (r *SegmentGormRepository) GetTargetByID(ctx context.Context, id uuid.UUID) (*segment.Target, error) {
+	var t segment.Target
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&t).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, segment.ErrTargetNotFound
+		}
+		return nil, fmt.Errorf("failed to get segment target: %w", err)
+	}
+	return &t, nil
+}
+
+// ListTargets lists segment targets ordered by priority (highest first)
+func (r *SegmentGormRepository) ListTargets(ctx context.Context, filters segment.TargetListFilters) ([]*segment.Target, error) {
+	var targets []*segment.Target
+	query := r.db.WithContext(ctx).Model(&segment.Target{})
+	if filters.SegmentID != nil {
+		query = query.Where("segment_id = ?", *filters.SegmentID)
+	}
+	if filters.Kind != nil {
+		query = query.Where("kind = ?", *filters.Kind)
+	}
+	if filters.IsActive != nil {
+		query = query.Where("is_active = ?", *filters.IsActive)
+	}
+	if err := query.Order("priority DESC, created_at ASC").Find(&targets).Error; err != nil {
+		return nil, fmt.Errorf("failed to list segment targets: %w", err)
+	}
+	return targets, nil
+}
+
+// UpdateTarget updates a segment target
+func (r *SegmentGormRepository) UpdateTarget(ctx context.Context, t *segment.Target) error {
+	t.UpdatedAt = time.Now()
+	result := r.db.WithContext(ctx).Save(t)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update segment target: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return segment.ErrTargetNotFound
+	}
+	return nil
+}
+
+// DeleteTarget deletes a segment target
+func (r *SegmentGormRepository) DeleteTarget(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&segment.Target{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete segment target: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return segment.ErrTargetNotFound
+	}
+	return nil
+}
+
+// GetActivityStats aggregates a player's spins created since the given time
+// Free spins do not debit the player, so they count towards won but not spins or wagered.
+func (r *SegmentGormRepository) GetActivityStats(ctx context.Context, playerID uuid.UUID, since time.Time) (*segment.ActivityStats, error) {
+	var stats segment.ActivityStats
+	if err := r.db.WithContext(ctx).
+		Table("spins").
+		Select(`COALESCE(SUM(CASE WHEN is_free_spin THEN 0 ELSE 1 END), 0) AS spins,
+			COALESCE(SUM(CASE WHEN is_free_spin THEN 0 ELSE bet_amount END), 0) AS wagered,
+			COALESCE(SUM(total_win), 0) AS won`).
+		Where("player_id = ? AND created_at >= ?", playerID, since).
+		Scan(&stats).Error; err != nil {
+		return nil, fmt.Errorf("failed to get player activity stats: %w", err)
+	}
+	return &stats, nil
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/segment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupSegmentTestDB creates the segment tables next to the spins table used for activity stats
+func setupSegmentTestDB(t *testing.T) *gorm.DB {
+	db := setupSpinTestDB(t)
+
+	for _, stmt := range []string{
+		`CREATE TABLE player_tags (
+			player_id TEXT NOT NULL,
+			tag TEXT NOT NULL,
+			created_by TEXT,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (player_id, tag)
+		)`,
+		`CREATE TABLE segments (
+			id TEXT PRIMARY KEY,
+			name TEXT UNIQUE NOT NULL,
+			description TEXT,
+			rules TEXT NOT NULL DEFAULT '[]',
+			is_active INTEGER DEFAULT 1,
+			created_by TEXT,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE segment_targets (
+			id TEXT PRIMARY KEY,
+			segment_id TEXT NOT NULL,
+			kind TEXT NOT NULL,
+			priority INTEGER NOT NULL DEFAULT 0,
+			settings TEXT NOT NULL DEFAULT '{}',
+			is_active INTEGER DEFAULT 1,
+			created_by TEXT,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	return db
+}
+
+func TestSegmentGormRepository_Tags(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSegmentGormRepository(setupSegmentTestDB(t))
+	playerID := uuid.New()
+
+	require.NoError(t, repo.AddTag(ctx, &segment.PlayerTag{PlayerID: playerID, Tag: "vip"}))
+	require.NoError(t, repo.AddTag(ctx, &segment.PlayerTag{PlayerID: playerID, Tag: "vip"}), "adding an existing tag should be a no-op")
+	require.NoError(t, repo.AddTag(ctx, &segment.PlayerTag{PlayerID: playerID, Tag: "affiliate"}))
+	require.NoError(t, repo.AddTag(ctx, &segment.PlayerTag{PlayerID: uuid.New(), Tag: "vip"}))
+
+	tags, err := repo.ListTags(ctx, playerID)
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	assert.Equal(t, "affiliate", tags[0].Tag)
+	assert.Equal(t, "vip", tags[1].Tag)
+
+	require.NoError(t, repo.RemoveTag(ctx, playerID, "vip"))
+	tags, err = repo.ListTags(ctx, playerID)
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "affiliate", tags[0].Tag)
+}
+
+func TestSegmentGormRepository_Segments(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSegmentGormRepository(setupSegmentTestDB(t))
+
+	seg := &segment.Segment{
+		ID:       uuid.New(),
+		Name:     "high-rollers",
+		Rules:    segment.Rules{{Field: segment.FieldWagered, Op: segment.OpGT, Value: 1000, WindowDays: 7}},
+		IsActive: true,
+	}
+	require.NoError(t, repo.CreateSegment(ctx, seg))
+
+	t.Run("should round-trip rules", func(t *testing.T) {
+		got, err := repo.GetSegmentByName(ctx, "high-rollers")
+		require.NoError(t, err)
+		assert.Equal(t, seg.Rules, got.Rules)
+	})
+
+	t.Run("should return not found for unknown segment", func(t *testing.T) {
+		_, err := repo.GetSegmentByID(ctx, uuid.New())
+		assert.ErrorIs(t, err, segment.ErrSegmentNotFound)
+	})
+
+	t.Run("should delete segment with its targets", func(t *testing.T) {
+		target := &segment.Target{ID: uuid.New(), SegmentID: seg.ID, Kind: segment.TargetRateLimit, Settings: json.RawMessage(`{"rps":5}`), IsActive: true}
+		require.NoError(t, repo.CreateTarget(ctx, target))
+
+		require.NoError(t, repo.DeleteSegment(ctx, seg.ID))
+
+		_, err := repo.GetTargetByID(ctx, target.ID)
+		assert.ErrorIs(t, err, segment.ErrTargetNotFound)
+		assert.ErrorIs(t, repo.DeleteSegment(ctx, seg.ID), segment.ErrSegmentNotFound)
+	})
+}
+
+func TestSegmentGormRepository_ListTargets(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSegmentGormRepository(setupSegmentTestDB(t))
+	segmentID := uuid.New()
+
+	low := &segment.Target{ID: uuid.New(), SegmentID: segmentID, Kind: segment.TargetBonus, Priority: 1, Settings: json.RawMessage(`{"extra_free_spins":2}`), IsActive: true}
+	high := &segment.Target{ID: uuid.New(), SegmentID: segmentID, Kind: segment.TargetBonus, Priority: 10, Settings: json.RawMessage(`{"extra_free_spins":5}`), IsActive: true}
+	inactive := &segment.Target{ID: uuid.New(), SegmentID: segmentID, Kind: segment.TargetBonus, Priority: 20, Settings: json.RawMessage(`{"extra_free_spins":9}`), IsActive: false}
+	other := &segment.Target{ID: uuid.New(), SegmentID: segmentID, Kind: segment.TargetRateLimit, Priority: 50, Settings: json.RawMessage(`{"rps":5}`), IsActive: true}
+	for _, target := range []*segment.Target{low, high, inactive, other} {
+		require.NoError(t, repo.CreateTarget(ctx, target))
+	}
+	kind := segment.TargetBonus
+	active := true
+	targets, err := repo.ListTargets(ctx, segment.TargetListFilters{Kind: &kind, IsActive: &active})
+	require.NoError(t, err)
+	require.Len(t, targets, 2)
+	assert.Equal(t, high.ID, targets[0].ID, "highest priority first")
+	assert.Equal(t, low.ID, targets[1].ID)
+}
+
+func TestSegmentGormRepository_GetActivityStats(t *testing.T) {
+	ctx := context.Background()
+	db := setupSegmentTestDB(t)
+	repo := NewSegmentGormRepository(db)
+	playerID := uuid.New()
+	sessionID := uuid.New()
+	now := time.Now().UTC()
+
+	recent := createTestSpin(playerID, sessionID)
+	recent.BetAmount = 10
+	recent.TotalWin = 5
+	recent.CreatedAt = now.Add(-time.Hour)
+
+	free := createTestSpin(playerID, sessionID)
+	free.BetAmount = 10
+	free.TotalWin = 20
+	free.IsFreeSpin = true
+	free.CreatedAt = now.Add(-time.Hour)
+
+	old := createTestSpin(playerID, sessionID)
+	old.BetAmount = 100
+	old.TotalWin = 100
+	old.CreatedAt = now.AddDate(0, 0, -30)
+
+	otherPlayer := createTestSpin(uuid.New(), sessionID)
+	otherPlayer.CreatedAt = now.Add(-time.Hour)
+
+	for _, s := range []any{recent, free, old, otherPlayer} {
+		require.NoError(t, db.Create(s).Error)
+	}
+
+	stats, err := repo.GetActivityStats(ctx, playerID, now.AddDate(0, 0, -7))
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Spins, "free spins should not count as spins")
+	assert.Equal(t, 10.0, stats.Wagered, "free spins should not count as wagered")
+	assert.Equal(t, 25.0, stats.Won, "free spin wins should count as won")
+}
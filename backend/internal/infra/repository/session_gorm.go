@@ -12,19 +12,21 @@ import (
 
 // SessionGormRepository implements session.Repository using GORM
 type SessionGormRepository struct {
-	db *gorm.DB
+	db    *gorm.DB
+	reads ReadSource
 }
 
 // NewSessionGormRepository creates a new GORM session repository
 func NewSessionGormRepository(db *gorm.DB) session.Repository {
 	return &SessionGormRepository{
-		db: db,
+		db:    db,
+		reads: primaryReads{db},
 	}
 }
 
 // Create creates a new game session
 func (r *SessionGormRepository) Create(ctx context.Context, s *session.GameSession) error {
-	if err := r.db.WithContext(ctx).Create(s).Error; err != nil {
+	if err := GetDBOrTx(ctx, r.db).Create(s).Error; err != nil {
 		return fmt.Errorf("failed to create session: %w", err)
 	}
 	return nil
@@ -33,7 +35,7 @@ func (r *SessionGormRepository) Create(ctx context.Context, s *session.GameSessi
 // GetByID retrieves a session by ID
 func (r *SessionGormRepository) GetByID(ctx context.Context, id uuid.UUID) (*session.GameSession, error) {
 	var s session.GameSession
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&s).Error; err != nil {
+	if err := GetDBOrTx(ctx, r.db).Where("id = ?", id).First(&s).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, session.ErrSessionNotFound
 		}
@@ -45,7 +47,7 @@ func (r *SessionGormRepository) GetByID(ctx context.Context, id uuid.UUID) (*ses
 // GetActiveSessionByPlayer retrieves the active session for a player
 func (r *SessionGormRepository) GetActiveSessionByPlayer(ctx context.Context, playerID uuid.UUID) (*session.GameSession, error) {
 	var s session.GameSession
-	err := r.db.WithContext(ctx).
+	err := GetDBOrTx(ctx, r.db).
 		Where("player_id = ? AND ended_at IS NULL", playerID).
 		Order("created_at DESC").
 		First(&s).Error
@@ -71,13 +73,40 @@ func (r *SessionGormRepository) Update(ctx context.Context, s *session.GameSessi
 	return nil
 }
 
+// MarkRealityCheck records when the player acknowledged a reality check
+func (r *SessionGormRepository) MarkRealityCheck(ctx context.Context, id uuid.UUID, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&session.GameSession{}).
+		Where("id = ?", id).
+		Update("reality_check_at", at)
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark reality check: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return session.ErrSessionNotFound
+	}
+	return nil
+}
+
+// MarkSpinAcked advances the session's spin acknowledgement mark
+func (r *SessionGormRepository) MarkSpinAcked(ctx context.Context, id uuid.UUID, spinCreatedAt time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&session.GameSession{}).
+		Where("id = ? AND (spin_acked_at IS NULL OR spin_acked_at < ?)", id, spinCreatedAt).
+		Update("spin_acked_at", spinCreatedAt)
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark spin acknowledged: %w", result.Error)
+	}
+	return nil
+}
+
 // EndSession marks a session as ended
 func (r *SessionGormRepository) EndSession(ctx context.Context, id uuid.UUID, endingBalance float64) error {
 	now := time.Now().UTC()
 
 	// First, get the session to calculate net change
 	var s session.GameSession
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&s).Error; err != nil {
+	if err := GetDBOrTx(ctx, r.db).Where("id = ?", id).First(&s).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return session.ErrSessionNotFound
 		}
@@ -88,7 +117,7 @@ func (r *SessionGormRepository) EndSession(ctx context.Context, id uuid.UUID, en
 	netChange := endingBalance - s.StartingBalance
 
 	// Update session
-	result := r.db.WithContext(ctx).
+	result := GetDBOrTx(ctx, r.db).
 		Model(&session.GameSession{}).
 		Where("id = ?", id).
 		Updates(map[string]interface{}{
@@ -108,7 +137,7 @@ func (r *SessionGormRepository) EndSession(ctx context.Context, id uuid.UUID, en
 
 // UpdateStatistics updates session statistics
 func (r *SessionGormRepository) UpdateStatistics(ctx context.Context, id uuid.UUID, spins int, wagered, won float64) error {
-	result := r.db.WithContext(ctx).
+	result := GetDBOrTx(ctx, r.db).
 		Model(&session.GameSession{}).
 		Where("id = ?", id).
 		Updates(map[string]interface{}{
@@ -126,10 +155,26 @@ func (r *SessionGormRepository) UpdateStatistics(ctx context.Context, id uuid.UU
 	return nil
 }
 
+// RecordBetChange records a bet change and makes its new bet the session's bet amount
+func (r *SessionGormRepository) RecordBetChange(ctx context.Context, change *session.BetChange) error {
+	db := GetDBOrTx(ctx, r.db)
+	result := db.Model(&session.GameSession{}).Where("id = ?", change.SessionID).Update("bet_amount", change.NewBet)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update session bet amount: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return session.ErrSessionNotFound
+	}
+	if err := db.Create(change).Error; err != nil {
+		return fmt.Errorf("failed to record bet change: %w", err)
+	}
+	return nil
+}
+
 // GetByPlayer retrieves all sessions for a player (paginated)
 func (r *SessionGormRepository) GetByPlayer(ctx context.Context, playerID uuid.UUID, limit, offset int) ([]*session.GameSession, error) {
 	var sessions []*session.GameSession
-	err := r.db.WithContext(ctx).
+	err := GetReadDBOrTx(ctx, r.reads).
 		Where("player_id = ?", playerID).
 		Order("created_at DESC").
 		Limit(limit).
@@ -142,6 +187,22 @@ func (r *SessionGormRepository) GetByPlayer(ctx context.Context, playerID uuid.U
 	return sessions, nil
 }
 
+// ListIdleSessions returns active sessions with no spin since idleSince, oldest first
+// Reads the primary so a sweep never ends a session whose latest spin has not replicated yet
+func (r *SessionGormRepository) ListIdleSessions(ctx context.Context, idleSince time.Time, limit int) ([]*session.GameSession, error) {
+	var sessions []*session.GameSession
+	err := GetDBOrTx(ctx, r.db).
+		Where("ended_at IS NULL").
+		Where(`COALESCE((SELECT MAX(s.created_at) FROM spins s WHERE s.session_id = game_sessions.id), game_sessions.created_at) < ?`, idleSince).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&sessions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list idle sessions: %w", err)
+	}
+	return sessions, nil
+}
+
 // PlayerSessionGormRepository implements session.PlayerSessionRepository using GORM
 type PlayerSessionGormRepository struct {
 	db *gorm.DB
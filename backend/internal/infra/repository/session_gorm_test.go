@@ -34,11 +34,26 @@ func setupSessionTestDB(t *testing.T) *gorm.DB {
 			total_won REAL DEFAULT 0.00,
 			net_change REAL DEFAULT 0.00,
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
-			ended_at DATETIME
+			ended_at DATETIME,
+			reality_check_at DATETIME,
+			spin_acked_at DATETIME
 		)
 	`).Error
 	require.NoError(t, err, "Failed to create game_sessions table")
 
+	err = db.Exec(`
+		CREATE TABLE session_bet_changes (
+			id TEXT PRIMARY KEY,
+			session_id TEXT NOT NULL,
+			player_id TEXT NOT NULL,
+			spin_id TEXT NOT NULL,
+			previous_bet REAL NOT NULL,
+			new_bet REAL NOT NULL,
+			created_at DATETIME NOT NULL
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create session_bet_changes table")
+
 	// Create index on player_id for better query performance
 	err = db.Exec("CREATE INDEX idx_sessions_player_id ON game_sessions(player_id)").Error
 	require.NoError(t, err, "Failed to create player_id index")
@@ -544,3 +559,146 @@ func TestSessionGormRepository_GetByPlayer(t *testing.T) {
 		assert.Equal(t, player1ID, sessions[0].PlayerID)
 	})
 }
+
+// ============================================================================
+// MarkRealityCheck TESTS
+// ============================================================================
+
+func TestSessionGormRepository_MarkRealityCheck(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should record reality check without touching statistics", func(t *testing.T) {
+		db := setupSessionTestDB(t)
+		repo := NewSessionGormRepository(db)
+
+		s := createTestSession(uuid.New())
+		require.NoError(t, repo.Create(ctx, s))
+		require.NoError(t, repo.UpdateStatistics(ctx, s.ID, 3, 30.0, 12.0))
+
+		at := time.Now().UTC().Truncate(time.Second)
+		require.NoError(t, repo.MarkRealityCheck(ctx, s.ID, at))
+
+		updated, err := repo.GetByID(ctx, s.ID)
+		require.NoError(t, err)
+		require.NotNil(t, updated.RealityCheckAt)
+		assert.True(t, at.Equal(*updated.RealityCheckAt))
+		assert.Equal(t, 3, updated.TotalSpins)
+	})
+
+	t.Run("should return not found for unknown session", func(t *testing.T) {
+		db := setupSessionTestDB(t)
+		repo := NewSessionGormRepository(db)
+
+		err := repo.MarkRealityCheck(ctx, uuid.New(), time.Now())
+		assert.ErrorIs(t, err, session.ErrSessionNotFound)
+	})
+}
+
+// ============================================================================
+// MarkSpinAcked TESTS
+// ============================================================================
+
+func TestSessionGormRepository_MarkSpinAcked(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should only move the mark forward", func(t *testing.T) {
+		db := setupSessionTestDB(t)
+		repo := NewSessionGormRepository(db)
+
+		s := createTestSession(uuid.New())
+		require.NoError(t, repo.Create(ctx, s))
+
+		newer := time.Now().UTC().Truncate(time.Second)
+		require.NoError(t, repo.MarkSpinAcked(ctx, s.ID, newer))
+		require.NoError(t, repo.MarkSpinAcked(ctx, s.ID, newer.Add(-time.Minute)))
+
+		updated, err := repo.GetByID(ctx, s.ID)
+		require.NoError(t, err)
+		require.NotNil(t, updated.SpinAckedAt)
+		assert.True(t, newer.Equal(*updated.SpinAckedAt))
+	})
+}
+
+// ============================================================================
+// RecordBetChange TESTS
+// ============================================================================
+
+func TestSessionGormRepository_RecordBetChange(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should record the change and update the session bet", func(t *testing.T) {
+		db := setupSessionTestDB(t)
+		repo := NewSessionGormRepository(db)
+
+		s := createTestSession(uuid.New())
+		require.NoError(t, repo.Create(ctx, s))
+
+		change := &session.BetChange{
+			ID:          uuid.New(),
+			SessionID:   s.ID,
+			PlayerID:    s.PlayerID,
+			SpinID:      uuid.New(),
+			PreviousBet: s.BetAmount,
+			NewBet:      50.0,
+			CreatedAt:   time.Now().UTC(),
+		}
+		require.NoError(t, repo.RecordBetChange(ctx, change))
+
+		updated, err := repo.GetByID(ctx, s.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 50.0, updated.BetAmount)
+
+		var saved session.BetChange
+		require.NoError(t, db.First(&saved, "id = ?", change.ID).Error)
+		assert.Equal(t, 100.0, saved.PreviousBet)
+		assert.Equal(t, change.SpinID, saved.SpinID)
+	})
+
+	t.Run("should return error for unknown session", func(t *testing.T) {
+		db := setupSessionTestDB(t)
+		repo := NewSessionGormRepository(db)
+
+		err := repo.RecordBetChange(ctx, &session.BetChange{ID: uuid.New(), SessionID: uuid.New(), NewBet: 50.0})
+		assert.ErrorIs(t, err, session.ErrSessionNotFound)
+	})
+}
+
+// ============================================================================
+// ListIdleSessions TESTS
+// ============================================================================
+
+func TestSessionGormRepository_ListIdleSessions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should list active sessions without recent spins", func(t *testing.T) {
+		db := setupSessionTestDB(t)
+		require.NoError(t, db.Exec(`CREATE TABLE spins (id TEXT PRIMARY KEY, session_id TEXT, created_at DATETIME)`).Error)
+		repo := NewSessionGormRepository(db)
+
+		now := time.Now().UTC()
+		old := now.Add(-2 * time.Hour)
+
+		idle := createTestSession(uuid.New())
+		idle.CreatedAt = old
+		require.NoError(t, repo.Create(ctx, idle))
+
+		recentSpin := createTestSession(uuid.New())
+		recentSpin.CreatedAt = old
+		require.NoError(t, repo.Create(ctx, recentSpin))
+		require.NoError(t, db.Exec(`INSERT INTO spins (id, session_id, created_at) VALUES (?, ?, ?)`,
+			uuid.New().String(), recentSpin.ID.String(), now).Error)
+
+		fresh := createTestSession(uuid.New())
+		require.NoError(t, repo.Create(ctx, fresh))
+
+		ended := createTestSession(uuid.New())
+		ended.CreatedAt = old
+		require.NoError(t, repo.Create(ctx, ended))
+		require.NoError(t, repo.EndSession(ctx, ended.ID, 100.0))
+
+		sessions, err := repo.ListIdleSessions(ctx, now.Add(-time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, idle.ID, sessions[0].ID)
+	})
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/slotmachine/backend/domain/signingkey"
+	"gorm.io/gorm"
+)
+
+// SigningKeyGormRepository implements signingkey.Repository using GORM
+type SigningKeyGormRepository struct {
+	db *gorm.DB
+}
+
+// NewSigningKeyGormRepository creates a new GORM signing key repository
+func NewSigningKeyGormRepository(db *gorm.DB) signingkey.Repository {
+	return &SigningKeyGormRepository{db: db}
+}
+
+// Create inserts a new signing key
+func (r *SigningKeyGormRepository) Create(ctx context.Context, key *signingkey.SigningKey) error {
+	if err := r.db.WithContext(ctx).Create(key).Error; err != nil {
+		return fmt.Errorf("failed to create signing key: %w", err)
+	}
+	return nil
+}
+
+// ListValid lists keys not expired at now, oldest activation first
+func (r *SigningKeyGormRepository) ListValid(ctx context.Context, now time.Time) ([]*signingkey.SigningKey, error) {
+	var keys []*signingkey.SigningKey
+	if err := r.db.WithContext(ctx).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Order("activates_at ASC").
+		Find(&keys).Error; err != nil {
+		return nil, fmt.Errorf("failed to list signing keys: %w", err)
+	}
+	return keys, nil
+}
+
+// RetireBefore retires every unretired key activated before at
+func (r *SigningKeyGormRepository) RetireBefore(ctx context.Context, at, expiresAt time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&signingkey.SigningKey{}).
+		Where("retired_at IS NULL AND activates_at < ?", at).
+		Updates(map[string]interface{}{"retired_at": at, "expires_at": expiresAt})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to retire signing keys: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// DeleteExpired removes keys expired at now
+func (r *SigningKeyGormRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("expires_at IS NOT NULL AND expires_at <= ?", now).
+		Delete(&signingkey.SigningKey{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete expired signing keys: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/signingkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupSigningKeyTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	require.NoError(t, db.Exec(`CREATE TABLE jwt_signing_keys (
+		id TEXT PRIMARY KEY,
+		kid TEXT NOT NULL UNIQUE,
+		algorithm TEXT NOT NULL,
+		private_key TEXT NOT NULL,
+		public_key TEXT NOT NULL,
+		created_at DATETIME NOT NULL,
+		activates_at DATETIME NOT NULL,
+		retired_at DATETIME,
+		expires_at DATETIME
+	)`).Error)
+	return db
+}
+
+func newTestSigningKey(kid string, activatesAt time.Time) *signingkey.SigningKey {
+	return &signingkey.SigningKey{
+		ID:          uuid.New(),
+		KID:         kid,
+		Algorithm:   signingkey.AlgorithmES256,
+		PrivateKey:  "encrypted",
+		PublicKey:   "public",
+		CreatedAt:   activatesAt,
+		ActivatesAt: activatesAt,
+	}
+}
+
+func TestSigningKeyGormRepository(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSigningKeyGormRepository(setupSigningKeyTestDB(t))
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	older := newTestSigningKey("older", now.Add(-48*time.Hour))
+	old := newTestSigningKey("old", now.Add(-24*time.Hour))
+	next := newTestSigningKey("next", now.Add(time.Minute))
+	for _, k := range []*signingkey.SigningKey{next, older, old} {
+		require.NoError(t, repo.Create(ctx, k))
+	}
+
+	t.Run("ListValid orders by activation", func(t *testing.T) {
+		keys, err := repo.ListValid(ctx, now)
+		require.NoError(t, err)
+		require.Len(t, keys, 3)
+		assert.Equal(t, []string{"older", "old", "next"}, []string{keys[0].KID, keys[1].KID, keys[2].KID})
+	})
+
+	t.Run("RetireBefore keeps existing expiry", func(t *testing.T) {
+		n, err := repo.RetireBefore(ctx, old.ActivatesAt, now.Add(-time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+
+		n, err = repo.RetireBefore(ctx, next.ActivatesAt, now.Add(24*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+
+		keys, err := repo.ListValid(ctx, now)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		assert.Equal(t, "old", keys[0].KID)
+		require.NotNil(t, keys[0].ExpiresAt)
+		assert.True(t, keys[0].ExpiresAt.Equal(now.Add(24*time.Hour)))
+		assert.True(t, keys[0].IsActive(now))
+		assert.False(t, keys[0].IsActive(next.ActivatesAt))
+		assert.Nil(t, keys[1].RetiredAt)
+	})
+
+	t.Run("DeleteExpired", func(t *testing.T) {
+		n, err := repo.DeleteExpired(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+
+		n, err = repo.DeleteExpired(ctx, now.Add(25*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+
+		keys, err := repo.ListValid(ctx, now.Add(25*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, "next", keys[0].KID)
+	})
+}
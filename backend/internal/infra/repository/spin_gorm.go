@@ -12,19 +12,24 @@ import (
 
 // SpinGormRepository implements spin.Repository using GORM
 type SpinGormRepository struct {
-	db *gorm.DB
+	db    *gorm.DB
+	reads ReadSource
 }
 
 // NewSpinGormRepository creates a new GORM spin repository
 func NewSpinGormRepository(db *gorm.DB) spin.Repository {
 	return &SpinGormRepository{
-		db: db,
+		db:    db,
+		reads: primaryReads{db},
 	}
 }
 
 // Create creates a new spin record
 func (r *SpinGormRepository) Create(ctx context.Context, s *spin.Spin) error {
-	if err := r.db.WithContext(ctx).Create(s).Error; err != nil {
+	if deferSpin(ctx, s) {
+		return nil
+	}
+	if err := GetDBOrTx(ctx, r.db).Create(s).Error; err != nil {
 		return fmt.Errorf("failed to create spin: %w", err)
 	}
 	return nil
@@ -63,7 +68,7 @@ func (r *SpinGormRepository) GetBySession(ctx context.Context, sessionID uuid.UU
 // GetByPlayer retrieves spins for a player (paginated)
 func (r *SpinGormRepository) GetByPlayer(ctx context.Context, playerID uuid.UUID, limit, offset int) ([]*spin.Spin, error) {
 	var spins []*spin.Spin
-	err := r.db.WithContext(ctx).
+	err := GetReadDBOrTx(ctx, r.reads).
 		Where("player_id = ?", playerID).
 		Order("created_at DESC").
 		Limit(limit).
@@ -84,7 +89,7 @@ func (r *SpinGormRepository) GetByPlayerInTimeRange(
 	limit, offset int,
 ) ([]*spin.Spin, error) {
 	var spins []*spin.Spin
-	err := r.db.WithContext(ctx).
+	err := GetReadDBOrTx(ctx, r.reads).
 		Where("player_id = ? AND created_at >= ? AND created_at <= ?", playerID, start, end).
 		Order("created_at DESC").
 		Limit(limit).
@@ -118,7 +123,7 @@ func (r *SpinGormRepository) GetByFreeSpinsSession(ctx context.Context, freeSpin
 // Count counts total spins for a player
 func (r *SpinGormRepository) Count(ctx context.Context, playerID uuid.UUID) (int64, error) {
 	var count int64
-	err := r.db.WithContext(ctx).
+	err := GetReadDBOrTx(ctx, r.reads).
 		Model(&spin.Spin{}).
 		Where("player_id = ?", playerID).
 		Count(&count).Error
@@ -136,7 +141,7 @@ func (r *SpinGormRepository) CountInTimeRange(
 	start, end time.Time,
 ) (int64, error) {
 	var count int64
-	err := r.db.WithContext(ctx).
+	err := GetReadDBOrTx(ctx, r.reads).
 		Model(&spin.Spin{}).
 		Where("player_id = ? AND created_at >= ? AND created_at <= ?", playerID, start, end).
 		Count(&count).Error
@@ -147,8 +152,43 @@ func (r *SpinGormRepository) CountInTimeRange(
 	return count, nil
 }
 
+// GetLatestBySession retrieves the session's newest spin created after since
+// Reads the primary: the spin was usually written moments ago and replicas may lag
+func (r *SpinGormRepository) GetLatestBySession(ctx context.Context, sessionID uuid.UUID, since *time.Time) (*spin.Spin, error) {
+	query := GetDBOrTx(ctx, r.db).Where("session_id = ?", sessionID)
+	if since != nil {
+		query = query.Where("created_at > ?", *since)
+	}
+
+	var s spin.Spin
+	if err := query.Order("created_at DESC").First(&s).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, spin.ErrSpinNotFound
+		}
+		return nil, fmt.Errorf("failed to get latest spin: %w", err)
+	}
+	return &s, nil
+}
+
+// ListUnresolvedTriggers lists triggering spins whose free spins session was never created
+// Always reads the primary: a replica could still show a spin the reconciler just resolved
+func (r *SpinGormRepository) ListUnresolvedTriggers(ctx context.Context, since, before time.Time, limit int) ([]*spin.Spin, error) {
+	var spins []*spin.Spin
+	err := GetDBOrTx(ctx, r.db).
+		Where("free_spins_triggered = ? AND is_free_spin = ? AND free_spins_session_id IS NULL", true, false).
+		Where("created_at >= ? AND created_at < ?", since, before).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&spins).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unresolved free spins triggers: %w", err)
+	}
+	return spins, nil
+}
+
 func (r *SpinGormRepository) UpdateFreeSpinsSessionId(ctx context.Context, id uuid.UUID, freeSpinsSessionID uuid.UUID) error {
-	return r.db.WithContext(ctx).Model(&spin.Spin{}).
+	return GetDBOrTx(ctx, r.db).Model(&spin.Spin{}).
 		Where("id = ?", id).
 		Update("free_spins_session_id", freeSpinsSessionID).Error
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/db"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/driver/sqlite"
@@ -40,6 +41,8 @@ func setupSpinTestDB(t *testing.T) *gorm.DB {
 			free_spins_triggered INTEGER DEFAULT 0,
 			game_mode TEXT DEFAULT NULL,
 			game_mode_cost REAL DEFAULT NULL,
+			rng_provider TEXT NOT NULL DEFAULT 'hkdf',
+			math_version TEXT NOT NULL DEFAULT '',
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
 		)
 	`).Error
@@ -611,3 +614,118 @@ func TestSpinGormRepository_CountInTimeRange(t *testing.T) {
 		assert.Equal(t, int64(2), count) // Both should be included
 	})
 }
+
+func TestSpinGormRepository_ReadReplicaRouting(t *testing.T) {
+	ctx := context.Background()
+	primary := setupSpinTestDB(t)
+	replica := setupSpinTestDB(t)
+	repo := ProvideSpinRepository(db.NewRouter(primary, replica))
+
+	playerID := uuid.New()
+	require.NoError(t, repo.Create(ctx, createTestSpin(playerID, uuid.New())))
+
+	t.Run("should write to the primary and read history from the replica", func(t *testing.T) {
+		count, err := repo.Count(ctx, playerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count, "replica has not received the write")
+
+		var primaryCount int64
+		require.NoError(t, primary.Model(&spin.Spin{}).Where("player_id = ?", playerID).Count(&primaryCount).Error)
+		assert.Equal(t, int64(1), primaryCount)
+	})
+
+	t.Run("should read from the transaction inside one", func(t *testing.T) {
+		err := NewTxManager(primary).WithTransaction(ctx, func(txCtx context.Context) error {
+			count, err := repo.Count(txCtx, playerID)
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), count)
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("should fall back to the primary without replicas", func(t *testing.T) {
+		count, err := ProvideSpinRepository(db.NewRouter(primary)).Count(ctx, playerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+}
+
+func TestSpinGormRepository_ListUnresolvedTriggers(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	t.Run("should list only triggering spins without a free spins session", func(t *testing.T) {
+		db := setupSpinTestDB(t)
+		repo := NewSpinGormRepository(db)
+		playerID := uuid.New()
+		sessionID := uuid.New()
+
+		unresolved := createTestSpin(playerID, sessionID)
+		unresolved.FreeSpinsTriggered = true
+		unresolved.CreatedAt = now.Add(-10 * time.Minute)
+		require.NoError(t, repo.Create(ctx, unresolved))
+
+		resolved := createTestSpin(playerID, sessionID)
+		resolved.FreeSpinsTriggered = true
+		resolved.CreatedAt = now.Add(-9 * time.Minute)
+		require.NoError(t, repo.Create(ctx, resolved))
+		require.NoError(t, repo.UpdateFreeSpinsSessionId(ctx, resolved.ID, uuid.New()))
+
+		plain := createTestSpin(playerID, sessionID)
+		plain.CreatedAt = now.Add(-8 * time.Minute)
+		require.NoError(t, repo.Create(ctx, plain))
+
+		spins, err := repo.ListUnresolvedTriggers(ctx, now.Add(-time.Hour), now, 10)
+
+		require.NoError(t, err)
+		require.Len(t, spins, 1)
+		assert.Equal(t, unresolved.ID, spins[0].ID)
+	})
+
+	t.Run("should leave spins outside the window alone", func(t *testing.T) {
+		db := setupSpinTestDB(t)
+		repo := NewSpinGormRepository(db)
+
+		recent := createTestSpin(uuid.New(), uuid.New())
+		recent.FreeSpinsTriggered = true
+		recent.CreatedAt = now.Add(-10 * time.Second)
+		require.NoError(t, repo.Create(ctx, recent))
+
+		spins, err := repo.ListUnresolvedTriggers(ctx, now.Add(-time.Hour), now.Add(-30*time.Second), 10)
+
+		require.NoError(t, err)
+		assert.Empty(t, spins)
+	})
+}
+
+func TestSpinGormRepository_GetLatestBySession(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	db := setupSpinTestDB(t)
+	repo := NewSpinGormRepository(db)
+	playerID := uuid.New()
+	sessionID := uuid.New()
+
+	older := createTestSpin(playerID, sessionID)
+	older.CreatedAt = now.Add(-2 * time.Minute)
+	require.NoError(t, repo.Create(ctx, older))
+
+	latest := createTestSpin(playerID, sessionID)
+	latest.CreatedAt = now.Add(-time.Minute)
+	require.NoError(t, repo.Create(ctx, latest))
+
+	require.NoError(t, repo.Create(ctx, createTestSpin(playerID, uuid.New())))
+
+	t.Run("should return the newest spin of the session", func(t *testing.T) {
+		s, err := repo.GetLatestBySession(ctx, sessionID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, latest.ID, s.ID)
+	})
+
+	t.Run("should return not found when nothing is newer than since", func(t *testing.T) {
+		_, err := repo.GetLatestBySession(ctx, sessionID, &latest.CreatedAt)
+		assert.ErrorIs(t, err, spin.ErrSpinNotFound)
+	})
+}
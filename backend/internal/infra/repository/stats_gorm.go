@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/stats"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StatsGormRepository implements stats.Repository using GORM
+type StatsGormRepository struct {
+	db    *gorm.DB
+	reads ReadSource
+}
+
+// NewStatsGormRepository creates a new GORM stats repository
+func NewStatsGormRepository(db *gorm.DB) stats.Repository {
+	return &StatsGormRepository{db: db, reads: primaryReads{db}}
+}
+
+// totalsAssignments increments the counters of table by the excluded (inserted) row
+func totalsAssignments(table string) map[string]interface{} {
+	return map[string]interface{}{
+		"spins":                gorm.Expr(table + ".spins + excluded.spins"),
+		"free_spins":           gorm.Expr(table + ".free_spins + excluded.free_spins"),
+		"free_spins_triggered": gorm.Expr(table + ".free_spins_triggered + excluded.free_spins_triggered"),
+		"wagered":              gorm.Expr(table + ".wagered + excluded.wagered"),
+		"won":                  gorm.Expr(table + ".won + excluded.won"),
+		"biggest_win":          gorm.Expr("CASE WHEN excluded.biggest_win > " + table + ".biggest_win THEN excluded.biggest_win ELSE " + table + ".biggest_win END"),
+		"updated_at":           gorm.Expr("excluded.updated_at"),
+	}
+}
+
+// Record upserts the daily and lifetime rows for the delta in one transaction
+// Inside a caller's transaction it runs as a nested savepoint
+func (r *StatsGormRepository) Record(ctx context.Context, delta *stats.Delta) error {
+	now := time.Now().UTC()
+	at := delta.At
+
+	return GetDBOrTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		daily := &stats.DailyStats{
+			PlayerID:  delta.PlayerID,
+			Day:       stats.Day(at),
+			Totals:    delta.Totals,
+			UpdatedAt: now,
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "player_id"}, {Name: "day"}},
+			DoUpdates: clause.Assignments(totalsAssignments("player_daily_stats")),
+		}).Create(daily).Error; err != nil {
+			return fmt.Errorf("failed to record daily stats: %w", err)
+		}
+
+		lifetimeUpdates := totalsAssignments("player_lifetime_stats")
+		lifetimeUpdates["first_spin_at"] = gorm.Expr("COALESCE(player_lifetime_stats.first_spin_at, excluded.first_spin_at)")
+		lifetimeUpdates["last_spin_at"] = gorm.Expr("CASE WHEN player_lifetime_stats.last_spin_at IS NULL OR excluded.last_spin_at > player_lifetime_stats.last_spin_at THEN excluded.last_spin_at ELSE player_lifetime_stats.last_spin_at END")
+		lifetime := &stats.LifetimeStats{
+			PlayerID:    delta.PlayerID,
+			Totals:      delta.Totals,
+			FirstSpinAt: &at,
+			LastSpinAt:  &at,
+			UpdatedAt:   now,
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "player_id"}},
+			DoUpdates: clause.Assignments(lifetimeUpdates),
+		}).Create(lifetime).Error; err != nil {
+			return fmt.Errorf("failed to record lifetime stats: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetLifetime returns the player's lifetime rollup
+func (r *StatsGormRepository) GetLifetime(ctx context.Context, playerID uuid.UUID) (*stats.LifetimeStats, error) {
+	var lifetime stats.LifetimeStats
+	if err := GetReadDBOrTx(ctx, r.reads).Where("player_id = ?", playerID).First(&lifetime).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &stats.LifetimeStats{PlayerID: playerID}, nil
+		}
+		return nil, fmt.Errorf("failed to get lifetime stats: %w", err)
+	}
+	return &lifetime, nil
+}
+
+// ListDaily returns the player's daily rollups in the range, oldest first
+func (r *StatsGormRepository) ListDaily(ctx context.Context, playerID uuid.UUID, from, to time.Time) ([]*stats.DailyStats, error) {
+	var days []*stats.DailyStats
+	if err := GetReadDBOrTx(ctx, r.reads).
+		Where("player_id = ? AND day >= ? AND day <= ?", playerID, stats.Day(from), stats.Day(to)).
+		Order("day ASC").
+		Find(&days).Error; err != nil {
+		return nil, fmt.Errorf("failed to list daily stats: %w", err)
+	}
+	return days, nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/stats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupStatsTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	for _, stmt := range []string{
+		`CREATE TABLE player_daily_stats (
+			player_id TEXT NOT NULL,
+			day DATE NOT NULL,
+			spins INTEGER NOT NULL DEFAULT 0,
+			free_spins INTEGER NOT NULL DEFAULT 0,
+			free_spins_triggered INTEGER NOT NULL DEFAULT 0,
+			wagered REAL NOT NULL DEFAULT 0,
+			won REAL NOT NULL DEFAULT 0,
+			biggest_win REAL NOT NULL DEFAULT 0,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (player_id, day)
+		)`,
+		`CREATE TABLE player_lifetime_stats (
+			player_id TEXT PRIMARY KEY,
+			spins INTEGER NOT NULL DEFAULT 0,
+			free_spins INTEGER NOT NULL DEFAULT 0,
+			free_spins_triggered INTEGER NOT NULL DEFAULT 0,
+			wagered REAL NOT NULL DEFAULT 0,
+			won REAL NOT NULL DEFAULT 0,
+			biggest_win REAL NOT NULL DEFAULT 0,
+			first_spin_at DATETIME,
+			last_spin_at DATETIME,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	return db
+}
+
+func TestStatsGormRepository_Record(t *testing.T) {
+	ctx := context.Background()
+	repo := NewStatsGormRepository(setupStatsTestDB(t))
+	playerID := uuid.New()
+	day1 := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	day2 := time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+
+	deltas := []*stats.Delta{
+		{PlayerID: playerID, At: day1, Totals: stats.Totals{Spins: 1, Wagered: 10, Won: 5, BiggestWin: 5}},
+		{PlayerID: playerID, At: day1.Add(time.Hour), Totals: stats.Totals{Spins: 1, FreeSpinsTriggered: 1, Wagered: 10, Won: 40, BiggestWin: 40}},
+		{PlayerID: playerID, At: day2, Totals: stats.Totals{FreeSpins: 1, Won: 25, BiggestWin: 25}},
+		{PlayerID: uuid.New(), At: day1, Totals: stats.Totals{Spins: 1, Wagered: 100, Won: 900, BiggestWin: 900}},
+	}
+	for _, d := range deltas {
+		require.NoError(t, repo.Record(ctx, d))
+	}
+
+	t.Run("should accumulate lifetime totals", func(t *testing.T) {
+		lifetime, err := repo.GetLifetime(ctx, playerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), lifetime.Spins)
+		assert.Equal(t, int64(1), lifetime.FreeSpins)
+		assert.Equal(t, int64(1), lifetime.FreeSpinsTriggered)
+		assert.Equal(t, 20.0, lifetime.Wagered)
+		assert.Equal(t, 70.0, lifetime.Won)
+		assert.Equal(t, 40.0, lifetime.BiggestWin, "biggest win keeps the maximum")
+		require.NotNil(t, lifetime.FirstSpinAt)
+		require.NotNil(t, lifetime.LastSpinAt)
+		assert.True(t, lifetime.FirstSpinAt.Equal(day1))
+		assert.True(t, lifetime.LastSpinAt.Equal(day2))
+	})
+
+	t.Run("should roll up per day", func(t *testing.T) {
+		days, err := repo.ListDaily(ctx, playerID, day1, day2)
+		require.NoError(t, err)
+		require.Len(t, days, 2)
+		assert.Equal(t, int64(2), days[0].Spins)
+		assert.Equal(t, 45.0, days[0].Won)
+		assert.Equal(t, 40.0, days[0].BiggestWin)
+		assert.Equal(t, int64(1), days[1].FreeSpins)
+
+		days, err = repo.ListDaily(ctx, playerID, day2, day2)
+		require.NoError(t, err)
+		assert.Len(t, days, 1)
+	})
+
+	t.Run("should return zero totals for players without spins", func(t *testing.T) {
+		lifetime, err := repo.GetLifetime(ctx, uuid.New())
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), lifetime.Spins)
+		assert.Nil(t, lifetime.FirstSpinAt)
+	})
+}
@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/freespins"
+	"github.com/slotmachine/backend/domain/playernote"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/domain/timeline"
+	"gorm.io/gorm"
+)
+
+// TimelineGormRepository implements timeline.Repository using GORM
+// Each source table is read with its own keyset query; the sources are then merged.
+// Every source returns at least limit events unless it is exhausted, so the first
+// limit events of the merge are exactly the timeline's next page.
+type TimelineGormRepository struct {
+	db    *gorm.DB
+	reads ReadSource
+}
+
+// NewTimelineGormRepository creates a new GORM timeline repository
+func NewTimelineGormRepository(db *gorm.DB) timeline.Repository {
+	return &TimelineGormRepository{db: db, reads: primaryReads{db}}
+}
+
+// timelineSource reads one source's events for a player, newest first
+type timelineSource func(r *TimelineGormRepository, ctx context.Context, playerID uuid.UUID, before *timeline.Cursor, limit int) ([]timeline.Event, error)
+
+var timelineSources = []timelineSource{
+	(*TimelineGormRepository).sessionStarts,
+	(*TimelineGormRepository).sessionEnds,
+	(*TimelineGormRepository).spins,
+	(*TimelineGormRepository).freeSpinsTriggers,
+	(*TimelineGormRepository).freeSpinsCompletions,
+	(*TimelineGormRepository).pfSessionStarts,
+	(*TimelineGormRepository).pfSessionEnds,
+	(*TimelineGormRepository).notes,
+}
+
+// ListEvents lists a player's events older than before (nil for the newest), newest first
+func (r *TimelineGormRepository) ListEvents(ctx context.Context, playerID uuid.UUID, before *timeline.Cursor, limit int) ([]timeline.Event, error) {
+	var events []timeline.Event
+	for _, source := range timelineSources {
+		sourceEvents, err := source(r, ctx, playerID, before, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list timeline events: %w", err)
+		}
+		events = append(events, sourceEvents...)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[j].Key().Before(events[i].Key())
+	})
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+// timelinePage restricts query to the limit rows of a source that come after before
+// kind is the lowest kind the rows yield; it decides whether the cursor's own row
+// still has events left.
+func timelinePage(query *gorm.DB, column string, kind timeline.Kind, before *timeline.Cursor, limit int) *gorm.DB {
+	if before != nil {
+		op := "<"
+		if kind < before.Kind {
+			op = "<="
+		}
+		query = query.Where(
+			fmt.Sprintf("%[1]s < ? OR (%[1]s = ? AND id %[2]s ?)", column, op),
+			before.At, before.At, before.ID,
+		)
+	}
+	return query.Order(column + " DESC").Order("id DESC").Limit(limit)
+}
+
+func (r *TimelineGormRepository) sessionStarts(ctx context.Context, playerID uuid.UUID, before *timeline.Cursor, limit int) ([]timeline.Event, error) {
+	var sessions []*session.GameSession
+	query := GetReadDBOrTx(ctx, r.reads).Where("player_id = ?", playerID)
+	if err := timelinePage(query, "created_at", timeline.KindSessionStarted, before, limit).Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]timeline.Event, len(sessions))
+	for i, s := range sessions {
+		id := s.ID
+		events[i] = timeline.Event{At: s.CreatedAt, Kind: timeline.KindSessionStarted, ID: s.ID, SessionID: &id, Details: map[string]interface{}{
+			"bet_amount":       s.BetAmount,
+			"starting_balance": s.StartingBalance,
+		}}
+	}
+	return events, nil
+}
+
+func (r *TimelineGormRepository) sessionEnds(ctx context.Context, playerID uuid.UUID, before *timeline.Cursor, limit int) ([]timeline.Event, error) {
+	var sessions []*session.GameSession
+	query := GetReadDBOrTx(ctx, r.reads).Where("player_id = ? AND ended_at IS NOT NULL", playerID)
+	if err := timelinePage(query, "ended_at", timeline.KindSessionEnded, before, limit).Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]timeline.Event, len(sessions))
+	for i, s := range sessions {
+		id := s.ID
+		events[i] = timeline.Event{At: *s.EndedAt, Kind: timeline.KindSessionEnded, ID: s.ID, SessionID: &id, Details: map[string]interface{}{
+			"ending_balance": s.EndingBalance,
+			"total_spins":    s.TotalSpins,
+			"total_wagered":  s.TotalWagered,
+			"total_won":      s.TotalWon,
+			"net_change":     s.NetChange,
+		}}
+	}
+	return events, nil
+}
+
+// timelineSpinRow is a spin without its grid and cascades, with the balance the
+// player's previous spin left
+type timelineSpinRow struct {
+	ID                 uuid.UUID
+	SessionID          uuid.UUID
+	BetAmount          float64
+	BalanceBefore      float64
+	BalanceAfter       float64
+	TotalWin           float64
+	IsFreeSpin         bool
+	FreeSpinsSessionID *uuid.UUID
+	GameMode           *string
+	CreatedAt          time.Time
+	PrevBalanceAfter   *float64
+}
+
+// spins yields a spin event per spin, preceded by a balance adjustment when the
+// spin started from a balance other than the one the previous spin left
+// The cursor's own spin may have no events left, so one extra row is read.
+func (r *TimelineGormRepository) spins(ctx context.Context, playerID uuid.UUID, before *timeline.Cursor, limit int) ([]timeline.Event, error) {
+	var rows []timelineSpinRow
+	query := GetReadDBOrTx(ctx, r.reads).Table("spins AS s").
+		Select(`s.id, s.session_id, s.bet_amount, s.balance_before, s.balance_after, s.total_win,
+			s.is_free_spin, s.free_spins_session_id, s.game_mode, s.created_at,
+			(SELECT p.balance_after FROM spins p WHERE p.player_id = s.player_id
+				AND (p.created_at < s.created_at OR (p.created_at = s.created_at AND p.id < s.id))
+				ORDER BY p.created_at DESC, p.id DESC LIMIT 1) AS prev_balance_after`).
+		Where("s.player_id = ?", playerID)
+	if err := timelinePage(query, "s.created_at", timeline.KindBalanceAdjusted, before, limit+1).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]timeline.Event, 0, len(rows))
+	for _, row := range rows {
+		sessionID := row.SessionID
+		spinEvent := timeline.Event{At: row.CreatedAt, Kind: timeline.KindSpin, ID: row.ID, SessionID: &sessionID, Details: map[string]interface{}{
+			"bet_amount":     row.BetAmount,
+			"total_win":      row.TotalWin,
+			"balance_before": row.BalanceBefore,
+			"balance_after":  row.BalanceAfter,
+			"is_free_spin":   row.IsFreeSpin,
+		}}
+		if row.FreeSpinsSessionID != nil {
+			spinEvent.Details["free_spins_session_id"] = row.FreeSpinsSessionID
+		}
+		if row.GameMode != nil {
+			spinEvent.Details["game_mode"] = *row.GameMode
+		}
+		if before == nil || spinEvent.Key().Before(*before) {
+			events = append(events, spinEvent)
+		}
+
+		if row.PrevBalanceAfter != nil && *row.PrevBalanceAfter != row.BalanceBefore {
+			events = append(events, timeline.Event{At: row.CreatedAt, Kind: timeline.KindBalanceAdjusted, ID: row.ID, SessionID: &sessionID, Details: map[string]interface{}{
+				"balance_from": *row.PrevBalanceAfter,
+				"balance_to":   row.BalanceBefore,
+				"amount":       math.Round((row.BalanceBefore-*row.PrevBalanceAfter)*100) / 100,
+			}})
+		}
+	}
+	return events, nil
+}
+
+func (r *TimelineGormRepository) freeSpinsTriggers(ctx context.Context, playerID uuid.UUID, before *timeline.Cursor, limit int) ([]timeline.Event, error) {
+	var sessions []*freespins.FreeSpinsSession
+	query := GetReadDBOrTx(ctx, r.reads).Where("player_id = ?", playerID)
+	if err := timelinePage(query, "created_at", timeline.KindFreeSpinsTriggered, before, limit).Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]timeline.Event, len(sessions))
+	for i, s := range sessions {
+		sessionID := s.SessionID
+		events[i] = timeline.Event{At: s.CreatedAt, Kind: timeline.KindFreeSpinsTriggered, ID: s.ID, SessionID: &sessionID, Details: map[string]interface{}{
+			"triggered_by_spin_id": s.TriggeredBySpinID,
+			"scatter_count":        s.ScatterCount,
+			"spins_awarded":        s.TotalSpinsAwarded,
+			"locked_bet_amount":    s.LockedBetAmount,
+		}}
+	}
+	return events, nil
+}
+
+func (r *TimelineGormRepository) freeSpinsCompletions(ctx context.Context, playerID uuid.UUID, before *timeline.Cursor, limit int) ([]timeline.Event, error) {
+	var sessions []*freespins.FreeSpinsSession
+	query := GetReadDBOrTx(ctx, r.reads).Where("player_id = ? AND completed_at IS NOT NULL", playerID)
+	if err := timelinePage(query, "completed_at", timeline.KindFreeSpinsCompleted, before, limit).Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]timeline.Event, len(sessions))
+	for i, s := range sessions {
+		sessionID := s.SessionID
+		events[i] = timeline.Event{At: *s.CompletedAt, Kind: timeline.KindFreeSpinsCompleted, ID: s.ID, SessionID: &sessionID, Details: map[string]interface{}{
+			"spins_completed": s.SpinsCompleted,
+			"total_won":       s.TotalWon,
+		}}
+	}
+	return events, nil
+}
+
+func (r *TimelineGormRepository) pfSessionStarts(ctx context.Context, playerID uuid.UUID, before *timeline.Cursor, limit int) ([]timeline.Event, error) {
+	var sessions []*provablyfair.PFSession
+	query := GetReadDBOrTx(ctx, r.reads).Where("player_id = ?", playerID)
+	if err := timelinePage(query, "created_at", timeline.KindPFSessionStarted, before, limit).Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]timeline.Event, len(sessions))
+	for i, s := range sessions {
+		gameSessionID := s.GameSessionID
+		events[i] = timeline.Event{At: s.CreatedAt, Kind: timeline.KindPFSessionStarted, ID: s.ID, SessionID: &gameSessionID, Details: map[string]interface{}{
+			"server_seed_hash": s.ServerSeedHash,
+			"theta_commitment": s.ThetaCommitment,
+		}}
+	}
+	return events, nil
+}
+
+func (r *TimelineGormRepository) pfSessionEnds(ctx context.Context, playerID uuid.UUID, before *timeline.Cursor, limit int) ([]timeline.Event, error) {
+	var sessions []*provablyfair.PFSession
+	query := GetReadDBOrTx(ctx, r.reads).Where("player_id = ? AND ended_at IS NOT NULL", playerID)
+	if err := timelinePage(query, "ended_at", timeline.KindPFSessionEnded, before, limit).Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]timeline.Event, len(sessions))
+	for i, s := range sessions {
+		gameSessionID := s.GameSessionID
+		events[i] = timeline.Event{At: *s.EndedAt, Kind: timeline.KindPFSessionEnded, ID: s.ID, SessionID: &gameSessionID, Details: map[string]interface{}{
+			"last_nonce":     s.LastNonce,
+			"last_spin_hash": s.LastSpinHash,
+		}}
+	}
+	return events, nil
+}
+
+// notes yields a note event per support note or flag that has not been deleted
+func (r *TimelineGormRepository) notes(ctx context.Context, playerID uuid.UUID, before *timeline.Cursor, limit int) ([]timeline.Event, error) {
+	var notes []*playernote.Note
+	query := GetReadDBOrTx(ctx, r.reads).Where("player_id = ? AND deleted_at IS NULL", playerID)
+	if err := timelinePage(query, "created_at", timeline.KindNoteAdded, before, limit).Find(&notes).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]timeline.Event, len(notes))
+	for i, n := range notes {
+		events[i] = timeline.Event{At: n.CreatedAt, Kind: timeline.KindNoteAdded, ID: n.ID, Details: map[string]interface{}{
+			"note_kind": n.Kind,
+			"body":      n.Body,
+			"author":    n.AuthorUsername,
+		}}
+		if n.EditedAt != nil {
+			events[i].Details["edited_by"] = n.EditedBy
+			events[i].Details["edited_at"] = *n.EditedAt
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/timeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupTimelineTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	for _, ddl := range []string{
+		`CREATE TABLE game_sessions (
+			id TEXT PRIMARY KEY,
+			player_id TEXT NOT NULL,
+			bet_amount REAL NOT NULL,
+			starting_balance REAL NOT NULL,
+			ending_balance REAL,
+			total_spins INTEGER DEFAULT 0,
+			total_wagered REAL DEFAULT 0,
+			total_won REAL DEFAULT 0,
+			net_change REAL DEFAULT 0,
+			created_at DATETIME,
+			ended_at DATETIME
+		)`,
+		`CREATE TABLE spins (
+			id TEXT PRIMARY KEY,
+			session_id TEXT NOT NULL,
+			player_id TEXT NOT NULL,
+			bet_amount REAL NOT NULL,
+			balance_before REAL NOT NULL,
+			balance_after REAL NOT NULL,
+			total_win REAL DEFAULT 0,
+			is_free_spin INTEGER DEFAULT 0,
+			free_spins_session_id TEXT,
+			game_mode TEXT,
+			created_at DATETIME
+		)`,
+		`CREATE TABLE free_spins_sessions (
+			id TEXT PRIMARY KEY,
+			player_id TEXT NOT NULL,
+			session_id TEXT NOT NULL,
+			triggered_by_spin_id TEXT,
+			scatter_count INTEGER NOT NULL,
+			total_spins_awarded INTEGER NOT NULL,
+			spins_completed INTEGER DEFAULT 0,
+			remaining_spins INTEGER NOT NULL,
+			locked_bet_amount REAL NOT NULL,
+			total_won REAL DEFAULT 0,
+			created_at DATETIME,
+			completed_at DATETIME
+		)`,
+		`CREATE TABLE pf_sessions (
+			id TEXT PRIMARY KEY,
+			player_id TEXT NOT NULL,
+			game_session_id TEXT NOT NULL,
+			server_seed_hash TEXT NOT NULL,
+			last_nonce INTEGER DEFAULT 0,
+			last_spin_hash TEXT,
+			theta_commitment TEXT,
+			created_at DATETIME,
+			ended_at DATETIME
+		)`,
+		`CREATE TABLE player_notes (
+			id TEXT PRIMARY KEY,
+			player_id TEXT NOT NULL,
+			kind TEXT NOT NULL,
+			body TEXT NOT NULL,
+			author_id TEXT NOT NULL,
+			author_username TEXT NOT NULL,
+			created_at DATETIME NOT NULL,
+			edited_by TEXT,
+			edited_at DATETIME,
+			deleted_by TEXT,
+			deleted_at DATETIME
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+	return db
+}
+
+func TestTimelineGormRepository_ListEvents(t *testing.T) {
+	ctx := context.Background()
+	db := setupTimelineTestDB(t)
+	repo := NewTimelineGormRepository(db)
+
+	playerID := uuid.New()
+	sessionID := uuid.New()
+	t0 := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) time.Time { return t0.Add(d) }
+
+	exec := func(sql string, args ...interface{}) {
+		require.NoError(t, db.Exec(sql, args...).Error)
+	}
+	exec("INSERT INTO game_sessions (id, player_id, bet_amount, starting_balance, ending_balance, created_at, ended_at) VALUES (?, ?, 1, 100, 153, ?, ?)",
+		sessionID, playerID, at(0), at(10*time.Minute))
+	exec("INSERT INTO pf_sessions (id, player_id, game_session_id, server_seed_hash, created_at, ended_at) VALUES (?, ?, ?, 'hash', ?, ?)",
+		uuid.New(), playerID, sessionID, at(time.Second), at(9*time.Minute))
+	spin := func(before, after float64, d time.Duration) uuid.UUID {
+		id := uuid.New()
+		exec("INSERT INTO spins (id, session_id, player_id, bet_amount, balance_before, balance_after, created_at) VALUES (?, ?, ?, 1, ?, ?, ?)",
+			id, sessionID, playerID, before, after, at(d))
+		return id
+	}
+	spin(100, 99, time.Minute)
+	trigger := spin(99, 104, 2*time.Minute)
+	adjusted := spin(154, 153, 5*time.Minute) // 50 credited outside of play since the last spin
+	exec("INSERT INTO free_spins_sessions (id, player_id, session_id, triggered_by_spin_id, scatter_count, total_spins_awarded, remaining_spins, locked_bet_amount, created_at, completed_at) VALUES (?, ?, ?, ?, 3, 10, 0, 1, ?, ?)",
+		uuid.New(), playerID, sessionID, trigger, at(150*time.Second), at(4*time.Minute))
+	exec("INSERT INTO player_notes (id, player_id, kind, body, author_id, author_username, created_at) VALUES (?, ?, 'flag', 'charge-back risk', ?, 'support', ?)",
+		uuid.New(), playerID, uuid.New(), at(20*time.Minute))
+	// Deleted notes are left out
+	exec("INSERT INTO player_notes (id, player_id, kind, body, author_id, author_username, created_at, deleted_by, deleted_at) VALUES (?, ?, 'note', 'typo', ?, 'support', ?, 'support', ?)",
+		uuid.New(), playerID, uuid.New(), at(21*time.Minute), at(22*time.Minute))
+
+	// Another player's activity never shows up
+	exec("INSERT INTO spins (id, session_id, player_id, bet_amount, balance_before, balance_after, created_at) VALUES (?, ?, ?, 1, 5, 4, ?)",
+		uuid.New(), uuid.New(), uuid.New(), at(3*time.Minute))
+
+	want := []timeline.Kind{
+		timeline.KindNoteAdded,
+		timeline.KindSessionEnded,
+		timeline.KindPFSessionEnded,
+		timeline.KindSpin,
+		timeline.KindBalanceAdjusted,
+		timeline.KindFreeSpinsCompleted,
+		timeline.KindFreeSpinsTriggered,
+		timeline.KindSpin,
+		timeline.KindSpin,
+		timeline.KindPFSessionStarted,
+		timeline.KindSessionStarted,
+	}
+	kinds := func(events []timeline.Event) []timeline.Kind {
+		out := make([]timeline.Kind, len(events))
+		for i, e := range events {
+			out[i] = e.Kind
+		}
+		return out
+	}
+
+	t.Run("should merge every source newest first", func(t *testing.T) {
+		events, err := repo.ListEvents(ctx, playerID, nil, 50)
+
+		require.NoError(t, err)
+		assert.Equal(t, want, kinds(events))
+		assert.Equal(t, "charge-back risk", events[0].Details["body"])
+		assert.Equal(t, "support", events[0].Details["author"])
+		assert.Nil(t, events[0].SessionID)
+		assert.Equal(t, adjusted, events[4].ID)
+		assert.Equal(t, 50.0, events[4].Details["amount"])
+		assert.Equal(t, &sessionID, events[4].SessionID)
+	})
+
+	for _, limit := range []int{1, 2, 3} {
+		t.Run("should page without gaps or repeats", func(t *testing.T) {
+			var all []timeline.Event
+			var before *timeline.Cursor
+			for {
+				events, err := repo.ListEvents(ctx, playerID, before, limit)
+				require.NoError(t, err)
+				all = append(all, events...)
+				if len(events) < limit {
+					break
+				}
+				key := events[len(events)-1].Key()
+				before = &key
+			}
+
+			assert.Equal(t, want, kinds(all), "limit %d", limit)
+		})
+	}
+}
@@ -9,6 +9,14 @@ import (
 // txKey is the context key for database transactions
 type txKey struct{}
 
+// afterCommitKey is the context key for hooks deferred until the transaction commits
+type afterCommitKey struct{}
+
+// afterCommitHooks collects the hooks registered while a transaction is open
+type afterCommitHooks struct {
+	fns []func(ctx context.Context)
+}
+
 // TxManager handles database transactions
 type TxManager struct {
 	db *gorm.DB
@@ -21,13 +29,37 @@ func NewTxManager(db *gorm.DB) *TxManager {
 
 // WithTransaction executes fn within a database transaction
 // If fn returns an error, the transaction is rolled back
-// If fn succeeds, the transaction is committed
+// If fn succeeds, the transaction is committed and hooks registered with AfterCommit run
 func (m *TxManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
-	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	hooks := &afterCommitHooks{}
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// Inject transaction into context
 		txCtx := context.WithValue(ctx, txKey{}, tx)
+		txCtx = context.WithValue(txCtx, afterCommitKey{}, hooks)
 		return fn(txCtx)
 	})
+	if err != nil {
+		return err
+	}
+
+	// The commit happened, so its side effects must not be dropped because ctx ran out meanwhile
+	hookCtx := context.WithoutCancel(ctx)
+	for _, hook := range hooks.fns {
+		hook(hookCtx)
+	}
+	return nil
+}
+
+// AfterCommit defers fn until the transaction in ctx commits; it is dropped on rollback.
+// Outside a transaction fn runs immediately. Use it for side effects that cannot be
+// rolled back, such as cache writes, so they never get ahead of the database
+func AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommitHooks)
+	if !ok {
+		fn(ctx)
+		return
+	}
+	hooks.fns = append(hooks.fns, fn)
 }
 
 // DB returns the underlying database connection
@@ -52,3 +84,26 @@ func GetDBOrTx(ctx context.Context, db *gorm.DB) *gorm.DB {
 	}
 	return db.WithContext(ctx)
 }
+
+// ReadSource supplies connections for reads that tolerate replica lag
+type ReadSource interface {
+	Reader() *gorm.DB
+}
+
+// primaryReads serves reads from the primary, used when no router is configured
+type primaryReads struct {
+	db *gorm.DB
+}
+
+func (p primaryReads) Reader() *gorm.DB {
+	return p.db
+}
+
+// GetReadDBOrTx returns the transaction from context if present, otherwise a read connection.
+// Reads inside a transaction must see its writes, so they never go to a replica
+func GetReadDBOrTx(ctx context.Context, reads ReadSource) *gorm.DB {
+	if tx := GetTxFromContext(ctx); tx != nil {
+		return tx.WithContext(ctx)
+	}
+	return reads.Reader().WithContext(ctx)
+}
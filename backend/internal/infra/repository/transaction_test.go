@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxManager_AfterCommit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should run hooks after the transaction commits", func(t *testing.T) {
+		var ran []string
+		err := NewTxManager(setupSpinTestDB(t)).WithTransaction(ctx, func(txCtx context.Context) error {
+			AfterCommit(txCtx, func(hookCtx context.Context) {
+				assert.Nil(t, GetTxFromContext(hookCtx), "hooks run outside the transaction")
+				ran = append(ran, "first")
+			})
+			AfterCommit(txCtx, func(context.Context) { ran = append(ran, "second") })
+			assert.Empty(t, ran, "hooks must wait for commit")
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"first", "second"}, ran)
+	})
+
+	t.Run("should drop hooks when the transaction rolls back", func(t *testing.T) {
+		ran := false
+		err := NewTxManager(setupSpinTestDB(t)).WithTransaction(ctx, func(txCtx context.Context) error {
+			AfterCommit(txCtx, func(context.Context) { ran = true })
+			return errors.New("boom")
+		})
+
+		require.Error(t, err)
+		assert.False(t, ran)
+	})
+
+	t.Run("should run immediately outside a transaction", func(t *testing.T) {
+		ran := false
+		AfterCommit(ctx, func(context.Context) { ran = true })
+		assert.True(t, ran)
+	})
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/transparency"
+	"gorm.io/gorm"
+)
+
+// TransparencyGormRepository implements transparency.Repository using GORM
+type TransparencyGormRepository struct {
+	db *gorm.DB
+}
+
+// NewTransparencyGormRepository creates a new GORM transparency repository
+func NewTransparencyGormRepository(db *gorm.DB) transparency.Repository {
+	return &TransparencyGormRepository{db: db}
+}
+
+// GetLatest retrieves the checkpoint with the highest sequence
+func (r *TransparencyGormRepository) GetLatest(ctx context.Context) (*transparency.Checkpoint, error) {
+	var cp transparency.Checkpoint
+	if err := r.db.WithContext(ctx).Order("sequence DESC").First(&cp).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, transparency.ErrCheckpointNotFound
+		}
+		return nil, fmt.Errorf("failed to get latest checkpoint: %w", err)
+	}
+	return &cp, nil
+}
+
+// GetBySequence retrieves a checkpoint by sequence number
+func (r *TransparencyGormRepository) GetBySequence(ctx context.Context, sequence int64) (*transparency.Checkpoint, error) {
+	var cp transparency.Checkpoint
+	if err := r.db.WithContext(ctx).Where("sequence = ?", sequence).First(&cp).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, transparency.ErrCheckpointNotFound
+		}
+		return nil, fmt.Errorf("failed to get checkpoint: %w", err)
+	}
+	return &cp, nil
+}
+
+// GetCovering retrieves the first checkpoint whose range ends at or after a spin log
+func (r *TransparencyGormRepository) GetCovering(ctx context.Context, createdAt time.Time, spinLogID uuid.UUID) (*transparency.Checkpoint, error) {
+	var cp transparency.Checkpoint
+	err := r.db.WithContext(ctx).
+		Where("to_created_at > ? OR (to_created_at = ? AND last_spin_log_id >= ?)", createdAt, createdAt, spinLogID).
+		Order("sequence ASC").
+		First(&cp).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, transparency.ErrSpinNotCheckpointed
+		}
+		return nil, fmt.Errorf("failed to get covering checkpoint: %w", err)
+	}
+	return &cp, nil
+}
+
+// List lists checkpoints newest first with the total count for pagination
+func (r *TransparencyGormRepository) List(ctx context.Context, limit, offset int) ([]*transparency.Checkpoint, int64, error) {
+	query := r.db.WithContext(ctx).Model(&transparency.Checkpoint{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count checkpoints: %w", err)
+	}
+
+	var checkpoints []*transparency.Checkpoint
+	if err := query.Order("sequence DESC").Offset(offset).Limit(limit).Find(&checkpoints).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list checkpoints: %w", err)
+	}
+	return checkpoints, total, nil
+}
+
+// Create inserts a new checkpoint
+func (r *TransparencyGormRepository) Create(ctx context.Context, cp *transparency.Checkpoint) error {
+	if err := r.db.WithContext(ctx).Create(cp).Error; err != nil {
+		return fmt.Errorf("failed to create checkpoint: %w", err)
+	}
+	return nil
+}
+
+// ListUnanchored lists checkpoints without an anchor oldest first
+func (r *TransparencyGormRepository) ListUnanchored(ctx context.Context, limit int) ([]*transparency.Checkpoint, error) {
+	var checkpoints []*transparency.Checkpoint
+	if err := r.db.WithContext(ctx).Where("anchored_at IS NULL").
+		Order("sequence ASC").Limit(limit).Find(&checkpoints).Error; err != nil {
+		return nil, fmt.Errorf("failed to list unanchored checkpoints: %w", err)
+	}
+	return checkpoints, nil
+}
+
+// SetAnchor records the anchor of a checkpoint once
+func (r *TransparencyGormRepository) SetAnchor(ctx context.Context, id uuid.UUID, name, ref string, anchoredAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&transparency.Checkpoint{}).
+		Where("id = ? AND anchored_at IS NULL", id).
+		Updates(map[string]interface{}{"anchor_name": name, "anchor_ref": ref, "anchored_at": anchoredAt})
+	if result.Error != nil {
+		return fmt.Errorf("failed to set checkpoint anchor: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return transparency.ErrCheckpointNotFound
+	}
+	return nil
+}
+
+// ListLeavesAfter lists spin logs after a (created_at, id) cursor and before a cutoff
+func (r *TransparencyGormRepository) ListLeavesAfter(ctx context.Context, afterCreatedAt time.Time, afterID uuid.UUID, until time.Time, limit int) ([]transparency.Leaf, error) {
+	query := r.db.WithContext(ctx).Model(&provablyfair.SpinLog{}).Where("created_at < ?", until)
+	if !afterCreatedAt.IsZero() {
+		query = query.Where("created_at > ? OR (created_at = ? AND id > ?)", afterCreatedAt, afterCreatedAt, afterID)
+	}
+	return r.findLeaves(query.Limit(limit))
+}
+
+// ListLeaves lists the spin logs of a checkpoint's range
+func (r *TransparencyGormRepository) ListLeaves(ctx context.Context, cp *transparency.Checkpoint) ([]transparency.Leaf, error) {
+	query := r.db.WithContext(ctx).Model(&provablyfair.SpinLog{}).
+		Where("created_at > ? OR (created_at = ? AND id >= ?)", cp.FromCreatedAt, cp.FromCreatedAt, cp.FirstSpinLogID).
+		Where("created_at < ? OR (created_at = ? AND id <= ?)", cp.ToCreatedAt, cp.ToCreatedAt, cp.LastSpinLogID)
+	return r.findLeaves(query)
+}
+
+// GetLeafBySpin retrieves the spin log of a spin
+func (r *TransparencyGormRepository) GetLeafBySpin(ctx context.Context, spinID uuid.UUID) (*transparency.Leaf, error) {
+	leaves, err := r.findLeaves(r.db.WithContext(ctx).Model(&provablyfair.SpinLog{}).Where("spin_id = ?", spinID).Limit(1))
+	if err != nil {
+		return nil, err
+	}
+	if len(leaves) == 0 {
+		return nil, provablyfair.ErrSpinNotFound
+	}
+	return &leaves[0], nil
+}
+
+func (r *TransparencyGormRepository) findLeaves(query *gorm.DB) ([]transparency.Leaf, error) {
+	var leaves []transparency.Leaf
+	if err := query.Select("id AS spin_log_id, spin_id, spin_hash, created_at").
+		Order("created_at ASC, id ASC").Scan(&leaves).Error; err != nil {
+		return nil, fmt.Errorf("failed to list transparency leaves: %w", err)
+	}
+	return leaves, nil
+}
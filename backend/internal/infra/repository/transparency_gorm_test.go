@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/transparency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupTransparencyTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	require.NoError(t, db.Exec(`CREATE TABLE spin_logs (
+		id TEXT PRIMARY KEY,
+		pf_session_id TEXT NOT NULL,
+		spin_id TEXT NOT NULL,
+		spin_hash TEXT NOT NULL,
+		created_at DATETIME NOT NULL
+	)`).Error)
+	require.NoError(t, db.Exec(`CREATE TABLE transparency_checkpoints (
+		id TEXT PRIMARY KEY,
+		sequence INTEGER NOT NULL UNIQUE,
+		first_spin_log_id TEXT NOT NULL,
+		last_spin_log_id TEXT NOT NULL,
+		from_created_at DATETIME NOT NULL,
+		to_created_at DATETIME NOT NULL,
+		leaf_count INTEGER NOT NULL,
+		merkle_root TEXT NOT NULL,
+		prev_hash TEXT NOT NULL,
+		hash TEXT NOT NULL,
+		anchor_name TEXT,
+		anchor_ref TEXT,
+		anchored_at DATETIME,
+		created_at DATETIME NOT NULL
+	)`).Error)
+	return db
+}
+
+func insertTestSpinLog(t *testing.T, db *gorm.DB, hash string, at time.Time) uuid.UUID {
+	id := uuid.New()
+	require.NoError(t, db.Exec(`INSERT INTO spin_logs (id, pf_session_id, spin_id, spin_hash, created_at) VALUES (?, ?, ?, ?, ?)`,
+		id, uuid.New(), uuid.New(), hash, at).Error)
+	return id
+}
+
+func TestTransparencyGormRepository(t *testing.T) {
+	ctx := context.Background()
+	db := setupTransparencyTestDB(t)
+	repo := NewTransparencyGormRepository(db)
+
+	base := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	for i, h := range []string{"a", "b", "c", "d"} {
+		insertTestSpinLog(t, db, h, base.Add(time.Duration(i)*time.Second))
+	}
+
+	_, err := repo.GetLatest(ctx)
+	assert.ErrorIs(t, err, transparency.ErrCheckpointNotFound)
+
+	first, err := repo.ListLeavesAfter(ctx, time.Time{}, uuid.Nil, base.Add(3*time.Second), 2)
+	require.NoError(t, err)
+	require.Len(t, first, 2)
+	assert.Equal(t, "a", first[0].SpinHash)
+
+	cp := &transparency.Checkpoint{
+		ID:             uuid.New(),
+		Sequence:       1,
+		FirstSpinLogID: first[0].SpinLogID,
+		LastSpinLogID:  first[1].SpinLogID,
+		FromCreatedAt:  first[0].CreatedAt,
+		ToCreatedAt:    first[1].CreatedAt,
+		LeafCount:      2,
+		MerkleRoot:     transparency.MerkleRoot([]string{"a", "b"}),
+		PrevHash:       transparency.GenesisHash,
+		CreatedAt:      base,
+	}
+	cp.Hash = cp.ComputeHash()
+	require.NoError(t, repo.Create(ctx, cp))
+
+	t.Run("should page leaves after the cursor before the cutoff", func(t *testing.T) {
+		rest, err := repo.ListLeavesAfter(ctx, cp.ToCreatedAt, cp.LastSpinLogID, base.Add(3*time.Second), 10)
+		require.NoError(t, err)
+		require.Len(t, rest, 1)
+		assert.Equal(t, "c", rest[0].SpinHash)
+	})
+
+	t.Run("should list a checkpoint's leaves and find its covering checkpoint", func(t *testing.T) {
+		leaves, err := repo.ListLeaves(ctx, cp)
+		require.NoError(t, err)
+		assert.Equal(t, first, leaves)
+
+		covering, err := repo.GetCovering(ctx, first[1].CreatedAt, first[1].SpinLogID)
+		require.NoError(t, err)
+		assert.Equal(t, cp.ID, covering.ID)
+
+		_, err = repo.GetCovering(ctx, base.Add(3*time.Second), uuid.New())
+		assert.ErrorIs(t, err, transparency.ErrSpinNotCheckpointed)
+	})
+
+	t.Run("should get leaves by spin", func(t *testing.T) {
+		leaf, err := repo.GetLeafBySpin(ctx, first[0].SpinID)
+		require.NoError(t, err)
+		assert.Equal(t, first[0].SpinLogID, leaf.SpinLogID)
+
+		_, err = repo.GetLeafBySpin(ctx, uuid.New())
+		assert.ErrorIs(t, err, provablyfair.ErrSpinNotFound)
+	})
+
+	t.Run("should set an anchor once", func(t *testing.T) {
+		pending, err := repo.ListUnanchored(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+
+		require.NoError(t, repo.SetAnchor(ctx, cp.ID, "opentimestamps", "proof", base))
+		assert.ErrorIs(t, repo.SetAnchor(ctx, cp.ID, "opentimestamps", "other", base), transparency.ErrCheckpointNotFound)
+
+		got, err := repo.GetBySequence(ctx, 1)
+		require.NoError(t, err)
+		require.NotNil(t, got.AnchorRef)
+		assert.Equal(t, "proof", *got.AnchorRef)
+	})
+}
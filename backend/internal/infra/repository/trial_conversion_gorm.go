@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/trial"
+	"gorm.io/gorm"
+)
+
+// TrialConversionGormRepository implements trial.ConversionRepository using GORM
+type TrialConversionGormRepository struct {
+	db *gorm.DB
+}
+
+// NewTrialConversionGormRepository creates a new GORM trial conversion repository
+func NewTrialConversionGormRepository(db *gorm.DB) trial.ConversionRepository {
+	return &TrialConversionGormRepository{db: db}
+}
+
+// CreateConversion inserts a conversion record
+func (r *TrialConversionGormRepository) CreateConversion(ctx context.Context, conversion *trial.Conversion) error {
+	if err := GetDBOrTx(ctx, r.db).Create(conversion).Error; err != nil {
+		return fmt.Errorf("failed to create trial conversion: %w", err)
+	}
+	return nil
+}
+
+// GetConversionByTrialSession retrieves the conversion of a trial session
+func (r *TrialConversionGormRepository) GetConversionByTrialSession(ctx context.Context, trialSessionID uuid.UUID) (*trial.Conversion, error) {
+	return r.getBy(ctx, "trial_session_id = ?", trialSessionID)
+}
+
+// GetConversionByPlayer retrieves the conversion that created a player
+func (r *TrialConversionGormRepository) GetConversionByPlayer(ctx context.Context, playerID uuid.UUID) (*trial.Conversion, error) {
+	return r.getBy(ctx, "player_id = ?", playerID)
+}
+
+func (r *TrialConversionGormRepository) getBy(ctx context.Context, query string, id uuid.UUID) (*trial.Conversion, error) {
+	var conversion trial.Conversion
+	if err := r.db.WithContext(ctx).Where(query, id).First(&conversion).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, trial.ErrConversionNotFound
+		}
+		return nil, fmt.Errorf("failed to get trial conversion: %w", err)
+	}
+	return &conversion, nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/trial"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupTrialConversionTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	require.NoError(t, db.Exec(`CREATE TABLE trial_conversions (
+		id TEXT PRIMARY KEY,
+		trial_session_id TEXT NOT NULL UNIQUE,
+		player_id TEXT NOT NULL UNIQUE,
+		game_id TEXT,
+		trial_spins INTEGER NOT NULL DEFAULT 0,
+		trial_wagered REAL NOT NULL DEFAULT 0,
+		trial_won REAL NOT NULL DEFAULT 0,
+		trial_balance REAL NOT NULL DEFAULT 0,
+		trial_started_at DATETIME NOT NULL,
+		converted_at DATETIME NOT NULL
+	)`).Error)
+	return db
+}
+
+func TestTrialConversionGormRepository(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTrialConversionGormRepository(setupTrialConversionTestDB(t))
+
+	session := &trial.TrialSession{
+		ID:           uuid.New(),
+		Balance:      90_000,
+		TotalSpins:   12,
+		TotalWagered: 120,
+		TotalWon:     40,
+		CreatedAt:    time.Now().UTC().Add(-time.Hour),
+	}
+	playerID := uuid.New()
+	require.NoError(t, repo.CreateConversion(ctx, trial.NewConversion(session, playerID)))
+
+	t.Run("should find conversion by trial session and player", func(t *testing.T) {
+		got, err := repo.GetConversionByTrialSession(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, playerID, got.PlayerID)
+		assert.Equal(t, 12, got.TrialSpins)
+		assert.Equal(t, 90_000.0, got.TrialBalance)
+
+		got, err = repo.GetConversionByPlayer(ctx, playerID)
+		require.NoError(t, err)
+		assert.Equal(t, session.ID, got.TrialSessionID)
+	})
+
+	t.Run("should reject converting the same trial session twice", func(t *testing.T) {
+		assert.Error(t, repo.CreateConversion(ctx, trial.NewConversion(session, uuid.New())))
+	})
+
+	t.Run("should return not found for unknown ids", func(t *testing.T) {
+		_, err := repo.GetConversionByPlayer(ctx, uuid.New())
+		assert.ErrorIs(t, err, trial.ErrConversionNotFound)
+		_, err = repo.GetConversionByTrialSession(ctx, uuid.New())
+		assert.ErrorIs(t, err, trial.ErrConversionNotFound)
+	})
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/trial"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TrialSettingsGormRepository implements trial.SettingsRepository using GORM
+type TrialSettingsGormRepository struct {
+	db *gorm.DB
+}
+
+// NewTrialSettingsGormRepository creates a new GORM trial settings repository
+func NewTrialSettingsGormRepository(db *gorm.DB) trial.SettingsRepository {
+	return &TrialSettingsGormRepository{db: db}
+}
+
+// GetSettings retrieves the demo settings for a game
+func (r *TrialSettingsGormRepository) GetSettings(ctx context.Context, gameID uuid.UUID) (*trial.Settings, error) {
+	var settings trial.Settings
+	if err := r.db.WithContext(ctx).Where("game_id = ?", gameID).First(&settings).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, trial.ErrSettingsNotFound
+		}
+		return nil, fmt.Errorf("failed to get trial settings: %w", err)
+	}
+	return &settings, nil
+}
+
+// ListSettings lists the demo settings of all configured games
+func (r *TrialSettingsGormRepository) ListSettings(ctx context.Context) ([]*trial.Settings, error) {
+	var settings []*trial.Settings
+	if err := r.db.WithContext(ctx).Order("created_at ASC").Find(&settings).Error; err != nil {
+		return nil, fmt.Errorf("failed to list trial settings: %w", err)
+	}
+	return settings, nil
+}
+
+// UpsertSettings creates or replaces the demo settings for a game
+func (r *TrialSettingsGormRepository) UpsertSettings(ctx context.Context, settings *trial.Settings) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "game_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"starting_balance", "min_bet", "max_bet", "daily_reset", "updated_by", "updated_at"}),
+	}).Create(settings).Error
+	if err != nil {
+		return fmt.Errorf("failed to save trial settings: %w", err)
+	}
+	return nil
+}
+
+// DeleteSettings removes a game's demo settings so it falls back to the defaults
+func (r *TrialSettingsGormRepository) DeleteSettings(ctx context.Context, gameID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("game_id = ?", gameID).Delete(&trial.Settings{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete trial settings: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return trial.ErrSettingsNotFound
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/trial"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupTrialSettingsTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	require.NoError(t, db.Exec(`CREATE TABLE trial_game_settings (
+		game_id TEXT PRIMARY KEY,
+		starting_balance REAL NOT NULL,
+		min_bet REAL NOT NULL DEFAULT 0,
+		max_bet REAL NOT NULL DEFAULT 0,
+		daily_reset INTEGER NOT NULL DEFAULT 1,
+		base_game_config_id TEXT,
+		free_spins_config_id TEXT,
+		updated_by TEXT,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	)`).Error)
+	return db
+}
+
+func TestTrialSettingsGormRepository(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTrialSettingsGormRepository(setupTrialSettingsTestDB(t))
+	gameID := uuid.New()
+	now := time.Now().UTC()
+
+	settings := &trial.Settings{GameID: gameID, StartingBalance: 5000, MinBet: 1, MaxBet: 100, DailyReset: false, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, repo.UpsertSettings(ctx, settings))
+
+	t.Run("should store disabled daily reset", func(t *testing.T) {
+		got, err := repo.GetSettings(ctx, gameID)
+		require.NoError(t, err)
+		assert.Equal(t, 5000.0, got.StartingBalance)
+		assert.False(t, got.DailyReset)
+	})
+
+	t.Run("should replace existing settings", func(t *testing.T) {
+		updated := &trial.Settings{GameID: gameID, StartingBalance: 2000, MinBet: 2, DailyReset: true, CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, repo.UpsertSettings(ctx, updated))
+
+		got, err := repo.GetSettings(ctx, gameID)
+		require.NoError(t, err)
+		assert.Equal(t, 2000.0, got.StartingBalance)
+		assert.Equal(t, 0.0, got.MaxBet)
+		assert.True(t, got.DailyReset)
+
+		all, err := repo.ListSettings(ctx)
+		require.NoError(t, err)
+		assert.Len(t, all, 1)
+	})
+
+	t.Run("should delete settings", func(t *testing.T) {
+		require.NoError(t, repo.DeleteSettings(ctx, gameID))
+		_, err := repo.GetSettings(ctx, gameID)
+		assert.ErrorIs(t, err, trial.ErrSettingsNotFound)
+		assert.ErrorIs(t, repo.DeleteSettings(ctx, gameID), trial.ErrSettingsNotFound)
+	})
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/vip"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// VIPGormRepository implements vip.Repository using GORM
+type VIPGormRepository struct {
+	db *gorm.DB
+}
+
+// NewVIPGormRepository creates a new GORM VIP repository
+func NewVIPGormRepository(db *gorm.DB) vip.Repository {
+	return &VIPGormRepository{db: db}
+}
+
+// CreateTier inserts a new tier
+func (r *VIPGormRepository) CreateTier(ctx context.Context, tier *vip.Tier) error {
+	if err := r.db.WithContext(ctx).Create(tier).Error; err != nil {
+		return fmt.Errorf("failed to create vip tier: %w", err)
+	}
+	return nil
+}
+
+// GetTierByID retrieves a tier by ID
+func (r *VIPGormRepository) GetTierByID(ctx context.Context, id uuid.UUID) (*vip.Tier, error) {
+	var tier vip.Tier
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&tier).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, vip.ErrTierNotFound
+		}
+		return nil, fmt.Errorf("failed to get vip tier: %w", err)
+	}
+	return &tier, nil
+}
+
+// GetTierByLevel retrieves a tier by level
+func (r *VIPGormRepository) GetTierByLevel(ctx context.Context, level int) (*vip.Tier, error) {
+	var tier vip.Tier
+	if err := r.db.WithContext(ctx).Where("level = ?", level).First(&tier).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, vip.ErrTierNotFound
+		}
+		return nil, fmt.Errorf("failed to get vip tier by level: %w", err)
+	}
+	return &tier, nil
+}
+
+// ListTiers lists all tiers ordered by points threshold
+func (r *VIPGormRepository) ListTiers(ctx context.Context) ([]*vip.Tier, error) {
+	var tiers []*vip.Tier
+	if err := r.db.WithContext(ctx).Order("min_points ASC, level ASC").Find(&tiers).Error; err != nil {
+		return nil, fmt.Errorf("failed to list vip tiers: %w", err)
+	}
+	return tiers, nil
+}
+
+// UpdateTier updates a tier
+func (r *VIPGormRepository) UpdateTier(ctx context.Context, tier *vip.Tier) error {
+	tier.UpdatedAt = time.Now()
+	result := r.db.WithContext(ctx).Save(tier)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update vip tier: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return vip.ErrTierNotFound
+	}
+	return nil
+}
+
+// DeleteTier deletes a tier
+func (r *VIPGormRepository) DeleteTier(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&vip.Tier{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete vip tier: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return vip.ErrTierNotFound
+	}
+	return nil
+}
+
+// GetPoints returns a player's lifetime points, 0 if the player has not earned any
+func (r *VIPGormRepository) GetPoints(ctx context.Context, playerID uuid.UUID) (int64, error) {
+	var points vip.PlayerPoints
+	if err := r.db.WithContext(ctx).Where("player_id = ?", playerID).First(&points).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get vip points: %w", err)
+	}
+	return points.Points, nil
+}
+
+// AddPoints atomically adds points to a player's balance and returns the new total
+func (r *VIPGormRepository) AddPoints(ctx context.Context, playerID uuid.UUID, points int64) (int64, error) {
+	row := &vip.PlayerPoints{PlayerID: playerID, Points: points, UpdatedAt: time.Now()}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "player_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"points":     gorm.Expr("player_vip_points.points + ?", points),
+			"updated_at": row.UpdatedAt,
+		}),
+	}).Create(row).Error; err != nil {
+		return 0, fmt.Errorf("failed to add vip points: %w", err)
+	}
+	return r.GetPoints(ctx, playerID)
+}
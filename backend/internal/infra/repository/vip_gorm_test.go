@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/vip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupVIPTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err, "Failed to connect to test database")
+
+	for _, stmt := range []string{
+		`CREATE TABLE vip_tiers (
+			id TEXT PRIMARY KEY,
+			level INTEGER UNIQUE NOT NULL,
+			name TEXT NOT NULL,
+			min_points INTEGER NOT NULL DEFAULT 0,
+			perks TEXT NOT NULL DEFAULT '{}',
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE player_vip_points (
+			player_id TEXT PRIMARY KEY,
+			points INTEGER NOT NULL DEFAULT 0,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	return db
+}
+
+func TestVIPGormRepository_Tiers(t *testing.T) {
+	ctx := context.Background()
+	repo := NewVIPGormRepository(setupVIPTestDB(t))
+
+	gold := &vip.Tier{ID: uuid.New(), Level: 3, Name: "Gold", MinPoints: 5000, Perks: vip.Perks{PointsMultiplier: 1.5, ExtraFreeSpins: 2}}
+	bronze := &vip.Tier{ID: uuid.New(), Level: 1, Name: "Bronze", MinPoints: 0}
+	silver := &vip.Tier{ID: uuid.New(), Level: 2, Name: "Silver", MinPoints: 1000}
+	for _, tier := range []*vip.Tier{gold, bronze, silver} {
+		require.NoError(t, repo.CreateTier(ctx, tier))
+	}
+
+	t.Run("should list tiers by threshold", func(t *testing.T) {
+		tiers, err := repo.ListTiers(ctx)
+		require.NoError(t, err)
+		require.Len(t, tiers, 3)
+		assert.Equal(t, "Bronze", tiers[0].Name)
+		assert.Equal(t, "Silver", tiers[1].Name)
+		assert.Equal(t, "Gold", tiers[2].Name)
+		assert.Equal(t, gold.Perks, tiers[2].Perks)
+	})
+
+	t.Run("should get tier by level", func(t *testing.T) {
+		tier, err := repo.GetTierByLevel(ctx, 2)
+		require.NoError(t, err)
+		assert.Equal(t, silver.ID, tier.ID)
+
+		_, err = repo.GetTierByLevel(ctx, 9)
+		assert.ErrorIs(t, err, vip.ErrTierNotFound)
+	})
+
+	t.Run("should return not found when deleting unknown tier", func(t *testing.T) {
+		require.NoError(t, repo.DeleteTier(ctx, bronze.ID))
+		assert.ErrorIs(t, repo.DeleteTier(ctx, bronze.ID), vip.ErrTierNotFound)
+	})
+}
+
+func TestVIPGormRepository_Points(t *testing.T) {
+	ctx := context.Background()
+	repo := NewVIPGormRepository(setupVIPTestDB(t))
+	playerID := uuid.New()
+
+	points, err := repo.GetPoints(ctx, playerID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), points, "players without points start at zero")
+
+	total, err := repo.AddPoints(ctx, playerID, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), total)
+
+	total, err = repo.AddPoints(ctx, playerID, 25)
+	require.NoError(t, err)
+	assert.Equal(t, int64(35), total, "points should accumulate")
+
+	other, err := repo.AddPoints(ctx, uuid.New(), 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), other)
+}
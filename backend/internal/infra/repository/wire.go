@@ -2,21 +2,58 @@ package repository
 
 import (
 	"github.com/google/wire"
+	"github.com/slotmachine/backend/domain/admin"
+	"github.com/slotmachine/backend/domain/bigwin"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/domain/stats"
+	"github.com/slotmachine/backend/domain/timeline"
+	"github.com/slotmachine/backend/internal/db"
 	"gorm.io/gorm"
 )
 
 // ProviderSet is the Wire provider set for repositories
 var ProviderSet = wire.NewSet(
-	NewPlayerGormRepository,
-	NewSessionGormRepository,
+	ProvidePlayerRepository,
+	ProvideSessionRepository,
 	NewPlayerSessionGormRepository,
-	NewSpinGormRepository,
+	ProvideSpinRepository,
 	NewFreeSpinsGormRepository,
 	NewReelStripGormRepository,
-	NewAdminGormRepository,
+	ProvideReelStripCanaryRepository,
+	ProvideRTPAlertRepository,
+	NewExposureGormRepository,
+	NewFinancialReportGormRepository,
+	NewNotificationGormRepository,
+	NewFreeSpinsSettlementGormRepository,
+	NewImpersonationGormRepository,
+	NewPlayerNoteGormRepository,
+	ProvideAdminRepository,
 	NewGameGormRepository,
+	NewSegmentGormRepository,
+	NewVIPGormRepository,
+	NewJurisdictionGormRepository,
+	ProvideStatsRepository,
+	ProvideBigWinRepository,
+	NewTrialSettingsGormRepository,
+	NewTrialConversionGormRepository,
 	NewProvablyFairGormRepository,
 	NewTxManager,
+	NewPartitionManager,
+	NewArchiveGormRepository,
+	NewSigningKeyGormRepository,
+	NewLaunchGormRepository,
+	NewTransparencyGormRepository,
+	ProvideTimelineRepository,
+	NewDisputeGormRepository,
+	NewKYCGormRepository,
+	NewPrivacyGormRepository,
+	NewCertificationGormRepository,
+	NewAutoplayGormRepository,
+	NewHistoryExportGormRepository,
+	NewBalanceGormRepository,
+	NewApprovalGormRepository,
 )
 
 // ProvideDB is a provider function for *gorm.DB
@@ -24,3 +61,41 @@ var ProviderSet = wire.NewSet(
 func ProvideDB(db *gorm.DB) *gorm.DB {
 	return db
 }
+
+// The providers below route lag-tolerant reads (history, analytics, admin listings)
+// through the read replica router. Writes and balance reads stay on the primary.
+
+// ProvidePlayerRepository provides the player repository with admin listings on replicas
+func ProvidePlayerRepository(router *db.Router) player.Repository {
+	return &PlayerGormRepository{db: router.Primary(), reads: router}
+}
+
+// ProvideSessionRepository provides the session repository with session history on replicas
+func ProvideSessionRepository(router *db.Router) session.Repository {
+	return &SessionGormRepository{db: router.Primary(), reads: router}
+}
+
+// ProvideSpinRepository provides the spin repository with spin history on replicas
+func ProvideSpinRepository(router *db.Router) spin.Repository {
+	return &SpinGormRepository{db: router.Primary(), reads: router}
+}
+
+// ProvideAdminRepository provides the admin repository with admin listings on replicas
+func ProvideAdminRepository(router *db.Router) admin.Repository {
+	return &AdminGormRepository{db: router.Primary(), reads: router}
+}
+
+// ProvideStatsRepository provides the stats repository with analytics reads on replicas
+func ProvideStatsRepository(router *db.Router) stats.Repository {
+	return &StatsGormRepository{db: router.Primary(), reads: router}
+}
+
+// ProvideBigWinRepository provides the big win repository with listings on replicas
+func ProvideBigWinRepository(router *db.Router) bigwin.Repository {
+	return &BigWinGormRepository{db: router.Primary(), reads: router}
+}
+
+// ProvideTimelineRepository provides the timeline repository with player history on replicas
+func ProvideTimelineRepository(router *db.Router) timeline.Repository {
+	return &TimelineGormRepository{db: router.Primary(), reads: router}
+}
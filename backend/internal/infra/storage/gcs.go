@@ -16,9 +16,10 @@ import (
 // GCSStorage handles file storage operations with Google Cloud Storage
 // Implements the Storage interface
 type GCSStorage struct {
-	client     *gcs.Client
-	bucketName string
-	publicURL  string
+	client       *gcs.Client
+	bucketName   string
+	publicURL    string
+	signedURLTTL time.Duration
 }
 
 // Ensure GCSStorage implements Storage interface
@@ -35,9 +36,10 @@ func NewGCSStorage(cfg *config.StorageConfig) (*GCSStorage, error) {
 	}
 
 	storage := &GCSStorage{
-		client:     client,
-		bucketName: cfg.BucketName,
-		publicURL:  cfg.PublicURL,
+		client:       client,
+		bucketName:   cfg.BucketName,
+		publicURL:    cfg.PublicURL,
+		signedURLTTL: time.Duration(cfg.SignedURLTTLMinutes) * time.Minute,
 	}
 
 	// Verify bucket exists
@@ -201,7 +203,7 @@ func (s *GCSStorage) GetBaseURL(themeName string) string {
 // CreateFolder creates an empty folder/prefix in storage
 // In GCS, folders are virtual - we create a placeholder object
 func (s *GCSStorage) CreateFolder(ctx context.Context, themeName string) error {
-	objectName := themeName + "/.folder"
+	objectName := themeName + "/" + FolderMarker
 	bucket := s.client.Bucket(s.bucketName)
 	obj := bucket.Object(objectName)
 	writer := obj.NewWriter(ctx)
@@ -283,6 +285,14 @@ func (s *GCSStorage) FolderExists(ctx context.Context, themeName string) (bool,
 	return true, nil
 }
 
+// Ping checks that the bucket is reachable
+func (s *GCSStorage) Ping(ctx context.Context) error {
+	if _, err := s.client.Bucket(s.bucketName).Attrs(ctx); err != nil {
+		return fmt.Errorf("failed to reach bucket: %w", err)
+	}
+	return nil
+}
+
 // Close closes the GCS client
 func (s *GCSStorage) Close() error {
 	return s.client.Close()
@@ -362,3 +372,35 @@ func (s *GCSStorage) FileExists(ctx context.Context, themeName, fileName string)
 
 	return true, nil
 }
+
+// DownloadFile opens a file from GCS for reading
+func (s *GCSStorage) DownloadFile(ctx context.Context, themeName, fileName string) (io.ReadCloser, error) {
+	objectName := filepath.Join(themeName, fileName)
+
+	reader, err := s.client.Bucket(s.bucketName).Object(objectName).NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return reader, nil
+}
+
+// GeneratePresignedDownloadURL generates a signed GET URL for a file in GCS
+func (s *GCSStorage) GeneratePresignedDownloadURL(ctx context.Context, themeName, fileName string, expiryMinutes int) (*PresignedDownloadInfo, error) {
+	objectName := filepath.Join(themeName, fileName)
+	expiry := downloadExpiry(expiryMinutes, s.signedURLTTL)
+	expiresAt := time.Now().Add(expiry)
+
+	signedURL, err := s.client.Bucket(s.bucketName).SignedURL(objectName, &gcs.SignedURLOptions{
+		Method:  "GET",
+		Expires: expiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signed download URL: %w", err)
+	}
+
+	return &PresignedDownloadInfo{
+		URL:       signedURL,
+		ExpiresAt: expiresAt,
+	}, nil
+}
@@ -16,9 +16,10 @@ import (
 // MinIOStorage handles file storage operations with MinIO/S3
 // Implements the Storage interface
 type MinIOStorage struct {
-	client     *minio.Client
-	bucketName string
-	publicURL  string
+	client       *minio.Client
+	bucketName   string
+	publicURL    string
+	signedURLTTL time.Duration
 }
 
 // Ensure MinIOStorage implements Storage interface
@@ -35,9 +36,10 @@ func NewMinIOStorage(cfg *config.StorageConfig) (*MinIOStorage, error) {
 	}
 
 	storage := &MinIOStorage{
-		client:     client,
-		bucketName: cfg.BucketName,
-		publicURL:  cfg.PublicURL,
+		client:       client,
+		bucketName:   cfg.BucketName,
+		publicURL:    cfg.PublicURL,
+		signedURLTTL: time.Duration(cfg.SignedURLTTLMinutes) * time.Minute,
 	}
 
 	// Ensure bucket exists
@@ -201,7 +203,7 @@ func (s *MinIOStorage) GetBaseURL(themeName string) string {
 func (s *MinIOStorage) CreateFolder(ctx context.Context, themeName string) error {
 	// Create a placeholder file to ensure the "folder" exists
 	// MinIO/S3 doesn't have real folders, but we can create a .folder marker
-	objectName := themeName + "/.folder"
+	objectName := themeName + "/" + FolderMarker
 	_, err := s.client.PutObject(ctx, s.bucketName, objectName, strings.NewReader(""), 0, minio.PutObjectOptions{
 		ContentType: "application/x-directory",
 	})
@@ -262,6 +264,18 @@ func (s *MinIOStorage) RenameFolder(ctx context.Context, oldThemeName, newThemeN
 	return nil
 }
 
+// Ping checks that the bucket is reachable
+func (s *MinIOStorage) Ping(ctx context.Context) error {
+	exists, err := s.client.BucketExists(ctx, s.bucketName)
+	if err != nil {
+		return fmt.Errorf("failed to reach bucket: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("bucket %s does not exist", s.bucketName)
+	}
+	return nil
+}
+
 // FolderExists checks if a folder/prefix exists in storage
 func (s *MinIOStorage) FolderExists(ctx context.Context, themeName string) (bool, error) {
 	prefix := themeName + "/"
@@ -340,3 +354,36 @@ func (s *MinIOStorage) FileExists(ctx context.Context, themeName, fileName strin
 	return true, nil
 }
 
+// DownloadFile opens a file from storage for reading
+func (s *MinIOStorage) DownloadFile(ctx context.Context, themeName, fileName string) (io.ReadCloser, error) {
+	objectName := filepath.Join(themeName, fileName)
+
+	object, err := s.client.GetObject(ctx, s.bucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	// GetObject is lazy - stat to surface missing objects immediately
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return object, nil
+}
+
+// GeneratePresignedDownloadURL generates a presigned GET URL for a file in MinIO
+func (s *MinIOStorage) GeneratePresignedDownloadURL(ctx context.Context, themeName, fileName string, expiryMinutes int) (*PresignedDownloadInfo, error) {
+	objectName := filepath.Join(themeName, fileName)
+	expiry := downloadExpiry(expiryMinutes, s.signedURLTTL)
+
+	presignedURL, err := s.client.PresignedGetObject(ctx, s.bucketName, objectName, expiry, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate presigned download URL: %w", err)
+	}
+
+	return &PresignedDownloadInfo{
+		URL:       presignedURL.String(),
+		ExpiresAt: time.Now().Add(expiry),
+	}, nil
+}
@@ -6,6 +6,9 @@ import (
 	"time"
 )
 
+// FolderMarker is the empty placeholder object CreateFolder writes inside a theme folder
+const FolderMarker = ".folder"
+
 // FileInfo represents information about a stored file
 type FileInfo struct {
 	Name         string    `json:"name"`
@@ -42,6 +45,20 @@ type Storage interface {
 	GeneratePresignedUploadURL(ctx context.Context, themeName, fileName, contentType string, expiryMinutes int) (*PresignedUploadInfo, error)
 	// FileExists checks if a specific file exists in storage
 	FileExists(ctx context.Context, themeName, fileName string) (bool, error)
+	// DownloadFile opens a file from storage for reading
+	// The caller must close the returned reader
+	DownloadFile(ctx context.Context, themeName, fileName string) (io.ReadCloser, error)
+	// GeneratePresignedDownloadURL generates a signed, expiring GET URL for a file
+	// Used for private assets; expiryMinutes <= 0 uses the configured signed URL TTL
+	GeneratePresignedDownloadURL(ctx context.Context, themeName, fileName string, expiryMinutes int) (*PresignedDownloadInfo, error)
+	// Ping checks that the bucket is reachable
+	Ping(ctx context.Context) error
+}
+
+// PresignedDownloadInfo contains a signed download URL and its expiry
+type PresignedDownloadInfo struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
 // PresignedUploadInfo contains information for direct client upload
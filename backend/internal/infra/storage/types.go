@@ -3,6 +3,7 @@ package storage
 import (
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 // getContentType returns the content type based on file extension
@@ -17,6 +18,8 @@ func getContentType(fileName string) string {
 		return "image/gif"
 	case ".webp":
 		return "image/webp"
+	case ".avif":
+		return "image/avif"
 	case ".svg":
 		return "image/svg+xml"
 	case ".json":
@@ -31,3 +34,17 @@ func getContentType(fileName string) string {
 		return "application/octet-stream"
 	}
 }
+
+// defaultSignedURLTTL is used when neither the caller nor config sets a download URL lifetime
+const defaultSignedURLTTL = 60 * time.Minute
+
+// downloadExpiry resolves the lifetime of a signed download URL
+func downloadExpiry(expiryMinutes int, configured time.Duration) time.Duration {
+	if expiryMinutes > 0 {
+		return time.Duration(expiryMinutes) * time.Minute
+	}
+	if configured > 0 {
+		return configured
+	}
+	return defaultSignedURLTTL
+}
@@ -0,0 +1,81 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/slotmachine/backend/domain/launch"
+	"github.com/slotmachine/backend/internal/infra/notifier"
+)
+
+// AuthenticateRequest is the JSON body posted to the wallet's authenticate endpoint
+type AuthenticateRequest struct {
+	OperatorID string `json:"operator_id"`
+	Token      string `json:"token"`
+}
+
+// HTTPWallet validates operator tokens against the operator's HTTP wallet API
+// Requests are signed like outgoing webhooks: X-Signature carries the hex HMAC-SHA256 of the body.
+type HTTPWallet struct {
+	baseURL    string
+	operatorID string
+	secret     string
+	client     *http.Client
+}
+
+// NewHTTPWallet creates a wallet client for an operator's base URL
+func NewHTTPWallet(baseURL, operatorID, secret string, client *http.Client) *HTTPWallet {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPWallet{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		operatorID: operatorID,
+		secret:     secret,
+		client:     client,
+	}
+}
+
+// Ensure HTTPWallet implements launch.Wallet
+var _ launch.Wallet = (*HTTPWallet)(nil)
+
+// Authenticate posts the token to {baseURL}/authenticate; 401 and 403 mean the token was rejected
+func (w *HTTPWallet) Authenticate(ctx context.Context, operatorToken string) (*launch.OperatorPlayer, error) {
+	body, err := json.Marshal(AuthenticateRequest{OperatorID: w.operatorID, Token: operatorToken})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode wallet request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.baseURL+"/authenticate", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build wallet request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(notifier.SignatureHeader, notifier.Sign(w.secret, body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", launch.ErrWalletUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return nil, launch.ErrInvalidOperatorToken
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return nil, fmt.Errorf("%w: unexpected status code %d", launch.ErrWalletUnavailable, resp.StatusCode)
+	}
+
+	var p launch.OperatorPlayer
+	if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+		return nil, fmt.Errorf("%w: invalid response: %v", launch.ErrWalletUnavailable, err)
+	}
+	if p.ExternalID == "" {
+		return nil, fmt.Errorf("%w: response has no player_id", launch.ErrWalletUnavailable)
+	}
+	return &p, nil
+}
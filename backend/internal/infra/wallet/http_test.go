@@ -0,0 +1,59 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/slotmachine/backend/domain/launch"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/infra/notifier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPWallet_Authenticate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path != "/authenticate" || r.Header.Get(notifier.SignatureHeader) != notifier.Sign("secret", body) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var req AuthenticateRequest
+		_ = json.Unmarshal(body, &req)
+		if req.Token != "good" || req.OperatorID != "op" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(launch.OperatorPlayer{ExternalID: "ext-1", Username: "alice", Currency: "EUR", Balance: 12.5})
+	}))
+	defer srv.Close()
+
+	w := NewHTTPWallet(srv.URL+"/", "op", "secret", nil)
+
+	p, err := w.Authenticate(context.Background(), "good")
+	require.NoError(t, err)
+	assert.Equal(t, "ext-1", p.ExternalID)
+	assert.Equal(t, 12.5, p.Balance)
+
+	_, err = w.Authenticate(context.Background(), "bad")
+	assert.ErrorIs(t, err, launch.ErrInvalidOperatorToken)
+}
+
+func TestHTTPWallet_Unavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	_, err := NewHTTPWallet(srv.URL, "op", "secret", nil).Authenticate(context.Background(), "good")
+	assert.ErrorIs(t, err, launch.ErrWalletUnavailable)
+}
+
+func TestProvideWallet(t *testing.T) {
+	assert.Nil(t, ProvideWallet(&config.Config{}))
+	assert.NotNil(t, ProvideWallet(&config.Config{Launch: config.LaunchConfig{WalletURL: "http://wallet"}}))
+}
@@ -0,0 +1,27 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/slotmachine/backend/domain/launch"
+	"github.com/slotmachine/backend/internal/config"
+)
+
+// ProviderSet is the Wire provider set for operator wallets
+var ProviderSet = wire.NewSet(
+	ProvideWallet,
+)
+
+// ProvideWallet builds the operator wallet client from config, or nil when launch is disabled
+func ProvideWallet(cfg *config.Config) launch.Wallet {
+	if cfg.Launch.WalletURL == "" {
+		return nil
+	}
+	timeout := time.Duration(cfg.Launch.WalletTimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return NewHTTPWallet(cfg.Launch.WalletURL, cfg.Launch.OperatorID, cfg.Launch.OperatorSecret, &http.Client{Timeout: timeout})
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/singleflight"
@@ -32,6 +36,26 @@ type Cache struct {
 	Group       singleflight.Group
 	config      *config.Config
 	redisClient RedisCloser
+	stats       sync.Map // key family -> *familyStats
+
+	hooksMu  sync.RWMutex
+	onExpire []func(key string)
+}
+
+// familyStats counts lookups and invalidations for one key family
+type familyStats struct {
+	hits          atomic.Uint64
+	misses        atomic.Uint64
+	invalidations atomic.Uint64
+}
+
+// Stats is a snapshot of one key family's counters
+type Stats struct {
+	Family        string  `json:"family"`
+	Hits          uint64  `json:"hits"`
+	Misses        uint64  `json:"misses"`
+	HitRatio      float64 `json:"hit_ratio"`
+	Invalidations uint64  `json:"invalidations"`
 }
 
 type EventBus interface {
@@ -75,8 +99,10 @@ func NewCache(p NewCacheParams) *Cache {
 
 func (c *Cache) GetWithSingleflight(ctx context.Context, key string, out any, fn func() (interface{}, error), ttls ...*time.Duration) (res any, err error) {
 	if result, found := c.Get(ctx, key); found {
+		c.familyStats(key).hits.Add(1)
 		return result, nil
 	}
+	c.familyStats(key).misses.Add(1)
 
 	val, err, _ := c.Group.Do(key, func() (interface{}, error) {
 		if result, found := c.Get(ctx, key); found {
@@ -113,36 +139,19 @@ func (c *Cache) Set(ctx context.Context, key string, value any, ttl time.Duratio
 		return err
 	}
 
+	// A fill is not a change, so peers keep their copies; writers call Expire
 	c.local.SetWithTTL(key, value, int64(len(data)), ttl)
 	c.local.Wait()
 
-	if c.bus != nil {
-		msg := CacheMessage{Key: key, Type: "expired", SenderID: c.instanceID}
-
-		publishData, _ := json.Marshal(msg)
-		err := c.bus.Publish(c.channel, publishData)
-		if err != nil {
-			fmt.Printf("Failed to publish cache message for key: %s, err: %s", key, err.Error())
-		}
-
-		return err
-	}
-
 	return nil
 }
 
+// Expire drops key on this instance and tells every peer to drop it too
 func (c *Cache) Expire(ctx context.Context, key string) error {
-	if c.bus == nil {
-		return nil
-	}
-	val, found := c.local.Get(key)
-	if !found {
-		return nil
-	}
-
-	data, _ := json.Marshal(val)
-	c.local.SetWithTTL(key, val, int64(len(data)), time.Millisecond)
-	c.local.Wait()
+	c.local.Del(key)
+	c.Group.Forget(key)
+	c.familyStats(key).invalidations.Add(1)
+	c.expired(key)
 
 	if c.bus != nil {
 		msg := CacheMessage{Key: key, Type: "expired", SenderID: c.instanceID}
@@ -158,6 +167,15 @@ func (c *Cache) Expire(ctx context.Context, key string) error {
 	return nil
 }
 
+// Drop removes key from this instance only
+// It is for entries derived from other keys, dropped when those expire; every peer
+// hears that expiry and drops its own copy, so there is nothing to publish.
+func (c *Cache) Drop(key string) {
+	c.local.Del(key)
+	c.Group.Forget(key)
+	c.familyStats(key).invalidations.Add(1)
+}
+
 func (c *Cache) Close() {
 	// Close local cache
 	c.local.Close()
@@ -181,13 +199,73 @@ func (c *Cache) Subscribe() {
 			return
 		}
 
-		if msg.SenderID != c.instanceID {
-			if val, found := c.local.Get(msg.Key); found {
-				switch msg.Type {
-				case "expired":
-					c.local.SetWithTTL(msg.Key, val, 1, time.Duration(msg.TTL)*time.Millisecond)
-				}
-			}
+		if msg.SenderID != c.instanceID && msg.Type == "expired" {
+			c.local.Del(msg.Key)
+			c.Group.Forget(msg.Key)
+			c.familyStats(msg.Key).invalidations.Add(1)
+			c.expired(msg.Key)
 		}
 	})
 }
+
+// OnExpire registers fn to run whenever a key is expired, here or by a peer
+// fn runs on the expiring goroutine, so it must return quickly and not block.
+func (c *Cache) OnExpire(fn func(key string)) {
+	c.hooksMu.Lock()
+	defer c.hooksMu.Unlock()
+	c.onExpire = append(c.onExpire, fn)
+}
+
+func (c *Cache) expired(key string) {
+	c.hooksMu.RLock()
+	defer c.hooksMu.RUnlock()
+	for _, fn := range c.onExpire {
+		fn(key)
+	}
+}
+
+// Stats returns the counters of the given key families, or of every family seen if none are given
+func (c *Cache) Stats(families ...string) []Stats {
+	if len(families) == 0 {
+		c.stats.Range(func(k, _ any) bool {
+			families = append(families, k.(string))
+			return true
+		})
+		sort.Strings(families)
+	}
+
+	out := make([]Stats, 0, len(families))
+	for _, family := range families {
+		st := Stats{Family: family}
+		if v, ok := c.stats.Load(family); ok {
+			fs := v.(*familyStats)
+			st.Hits = fs.hits.Load()
+			st.Misses = fs.misses.Load()
+			st.Invalidations = fs.invalidations.Load()
+		}
+		if total := st.Hits + st.Misses; total > 0 {
+			st.HitRatio = float64(st.Hits) / float64(total)
+		}
+		out = append(out, st)
+	}
+	return out
+}
+
+// Family returns key's family, the segment after the app and env prefix
+func (c *Cache) Family(key string) string {
+	family := strings.TrimPrefix(key, c.setKey(""))
+	if i := strings.IndexByte(family, ':'); i >= 0 {
+		family = family[:i]
+	}
+	return family
+}
+
+// familyStats returns the counters of key's family
+func (c *Cache) familyStats(key string) *familyStats {
+	family := c.Family(key)
+	if v, ok := c.stats.Load(family); ok {
+		return v.(*familyStats)
+	}
+	v, _ := c.stats.LoadOrStore(family, &familyStats{})
+	return v.(*familyStats)
+}
@@ -2,20 +2,44 @@ package cache
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 )
 
+// Key families of the reel strip resolution path, as reported by Stats
+const (
+	FamilyDefaultReelStripConfig = "defaultReelStripConfig"
+	FamilyReelStripConfigByID    = "ReelStripConfigId"
+	FamilyReelStripConfigSet     = "ReelStripConfigSet"
+	FamilyPlayerAssignment       = "playerAssignment"
+	FamilyOperatorDefault        = "operatorReelStripDefault"
+	FamilyReelStripCanary        = "reelStripCanary"
+	FamilyPlayerReelStripConfig  = "playerReelStripConfig"
+	FamilySegmentTarget          = "segmentTarget"
+)
+
+// ReelStripFamilies are the key families a spin reads reel strip data through
+var ReelStripFamilies = []string{
+	FamilyReelStripConfigSet,
+	FamilyReelStripConfigByID,
+	FamilyDefaultReelStripConfig,
+	FamilyPlayerAssignment,
+	FamilyOperatorDefault,
+	FamilyReelStripCanary,
+	FamilyPlayerReelStripConfig,
+}
+
 func (c *Cache) DefaultReelStripConfig(gameMode string) string {
-	return c.setKey("defaultReelStripConfig:%s", gameMode)
+	return c.setKey(FamilyDefaultReelStripConfig+":%s", gameMode)
 }
 
 func (c *Cache) ReelStripConfigById(id uuid.UUID) string {
-	return c.setKey("ReelStripConfigId:%s", id.String())
+	return c.setKey(FamilyReelStripConfigByID+":%s", id.String())
 }
 
 func (c *Cache) ReelStripConfigSetKey(id uuid.UUID) string {
-	return c.setKey("ReelStripConfigSet:%s", id.String())
+	return c.setKey(FamilyReelStripConfigSet+":%s", id.String())
 }
 
 func (c *Cache) ReelStripsDefaultKey(gameMode string) string {
@@ -26,8 +50,58 @@ func (c *Cache) ReelStripsByConfigIdKey(id uuid.UUID) string {
 	return c.setKey("reelStripsByConfigId:%s", id.String())
 }
 
+// ReelStripConfigKeys lists the keys a config change must expire: the config itself and its game mode's default
+func (c *Cache) ReelStripConfigKeys(id uuid.UUID, gameMode string) []string {
+	return []string{
+		c.ReelStripsDefaultKey(gameMode),
+		c.DefaultReelStripConfig(gameMode),
+		c.ReelStripsByConfigIdKey(id),
+		c.ReelStripConfigSetKey(id),
+		c.ReelStripConfigById(id),
+	}
+}
+
 func (c *Cache) PlayerAssignmentKey(playerID uuid.UUID) string {
-	return c.setKey("playerAssignment:%s", playerID.String())
+	return c.setKey(FamilyPlayerAssignment+":%s", playerID.String())
+}
+
+func (c *Cache) OperatorDefaultKey(operatorID string) string {
+	return c.setKey(FamilyOperatorDefault+":%s", operatorID)
+}
+
+// ReelStripCanaryKey is the running canary of a game mode, expired whenever a canary starts or finishes
+func (c *Cache) ReelStripCanaryKey(gameMode string) string {
+	return c.setKey(FamilyReelStripCanary+":%s", gameMode)
+}
+
+// PlayerReelStripConfigKey is the config a player's spins in a game mode last resolved to
+func (c *Cache) PlayerReelStripConfigKey(playerID uuid.UUID, gameMode string) string {
+	return c.setKey(FamilyPlayerReelStripConfig+":%s:%s", playerID.String(), gameMode)
+}
+
+func (c *Cache) SegmentTargetKey(kind string, playerID uuid.UUID) string {
+	return c.setKey(FamilySegmentTarget+":%s:%s", kind, playerID.String())
+}
+
+// KeyPlayerID returns the player a per-player key ends with, e.g. a player assignment or segment target key
+func (c *Cache) KeyPlayerID(key string) (uuid.UUID, bool) {
+	i := strings.LastIndexByte(key, ':')
+	if i < 0 {
+		return uuid.Nil, false
+	}
+	id, err := uuid.Parse(key[i+1:])
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func (c *Cache) VIPTiersKey() string {
+	return c.setKey("vipTiers")
+}
+
+func (c *Cache) GameRulesKey(gameID uuid.UUID) string {
+	return c.setKey("gameRules:%s", gameID.String())
 }
 
 func (c *Cache) setKey(format string, a ...any) string {
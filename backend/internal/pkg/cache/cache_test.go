@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryBus delivers published messages synchronously to every subscriber
+type memoryBus struct {
+	handlers map[string][]func([]byte)
+}
+
+func (b *memoryBus) Publish(channel string, payload any) error {
+	for _, h := range b.handlers[channel] {
+		h(payload.([]byte))
+	}
+	return nil
+}
+
+func (b *memoryBus) Subscribe(channel string, handler func(payload []byte)) {
+	b.handlers[channel] = append(b.handlers[channel], handler)
+}
+
+func newTestCaches(t *testing.T) (*Cache, *Cache) {
+	bus := &memoryBus{handlers: make(map[string][]func([]byte))}
+	cfg := &config.Config{App: config.AppConfig{Name: "slot", Env: "test"}}
+	a := NewCache(NewCacheParams{Bus: bus, Channel: "test:cache", Config: cfg})
+	b := NewCache(NewCacheParams{Bus: bus, Channel: "test:cache", Config: cfg})
+	t.Cleanup(func() {
+		a.Close()
+		b.Close()
+	})
+	return a, b
+}
+
+func TestCache_ExpireInvalidatesPeers(t *testing.T) {
+	ctx := context.Background()
+	a, b := newTestCaches(t)
+	key := a.ReelStripConfigSetKey(uuid.New())
+
+	require.NoError(t, a.Set(ctx, key, "set", 0))
+	require.NoError(t, b.Set(ctx, key, "set", 0))
+
+	_, found := a.Get(ctx, key)
+	assert.True(t, found, "a fill on a peer must not evict the local copy")
+
+	require.NoError(t, a.Expire(ctx, key))
+	_, found = a.Get(ctx, key)
+	assert.False(t, found)
+	_, found = b.Get(ctx, key)
+	assert.False(t, found, "peer copy should be dropped")
+}
+
+func TestCache_Stats(t *testing.T) {
+	ctx := context.Background()
+	a, _ := newTestCaches(t)
+	key := a.ReelStripConfigSetKey(uuid.New())
+
+	loads := 0
+	load := func() (any, error) {
+		loads++
+		return "set", nil
+	}
+	for i := 0; i < 3; i++ {
+		_, err := a.GetWithSingleflight(ctx, key, nil, load)
+		require.NoError(t, err)
+	}
+	require.NoError(t, a.Expire(ctx, key))
+
+	assert.Equal(t, 1, loads)
+	stats := a.Stats(FamilyReelStripConfigSet, FamilyPlayerAssignment)
+	require.Len(t, stats, 2)
+	assert.Equal(t, Stats{
+		Family:        FamilyReelStripConfigSet,
+		Hits:          2,
+		Misses:        1,
+		HitRatio:      2.0 / 3.0,
+		Invalidations: 1,
+	}, stats[0])
+	assert.Equal(t, Stats{Family: FamilyPlayerAssignment}, stats[1])
+}
+
+func TestCache_OnExpireRunsForLocalAndPeerExpiries(t *testing.T) {
+	ctx := context.Background()
+	a, b := newTestCaches(t)
+	key := a.ReelStripConfigSetKey(uuid.New())
+
+	var onA, onB []string
+	a.OnExpire(func(k string) { onA = append(onA, k) })
+	b.OnExpire(func(k string) { onB = append(onB, k) })
+
+	require.NoError(t, a.Expire(ctx, key))
+	assert.Equal(t, []string{key}, onA)
+	assert.Equal(t, []string{key}, onB)
+	assert.Equal(t, FamilyReelStripConfigSet, a.Family(key))
+}
+
+func TestCache_KeyPlayerID(t *testing.T) {
+	a, _ := newTestCaches(t)
+	playerID := uuid.New()
+
+	id, ok := a.KeyPlayerID(a.PlayerAssignmentKey(playerID))
+	assert.True(t, ok)
+	assert.Equal(t, playerID, id)
+
+	id, ok = a.KeyPlayerID(a.SegmentTargetKey("reel_strip", playerID))
+	assert.True(t, ok)
+	assert.Equal(t, playerID, id)
+
+	_, ok = a.KeyPlayerID(a.DefaultReelStripConfig("base_game"))
+	assert.False(t, ok)
+}
+
+func TestCache_DropIsLocal(t *testing.T) {
+	ctx := context.Background()
+	a, b := newTestCaches(t)
+	key := a.PlayerReelStripConfigKey(uuid.New(), "base_game")
+
+	require.NoError(t, a.Set(ctx, key, "config", 0))
+	require.NoError(t, b.Set(ctx, key, "config", 0))
+
+	a.Drop(key)
+	_, found := a.Get(ctx, key)
+	assert.False(t, found)
+	_, found = b.Get(ctx, key)
+	assert.True(t, found, "a drop is not published to peers")
+}
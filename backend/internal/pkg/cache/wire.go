@@ -2,10 +2,16 @@ package cache
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/wire"
-	infraCache "github.com/slotmachine/backend/internal/infra/cache"
+	"github.com/slotmachine/backend/domain/balance"
+	"github.com/slotmachine/backend/domain/launch"
+	"github.com/slotmachine/backend/domain/notification"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/session"
 	"github.com/slotmachine/backend/internal/config"
+	infraCache "github.com/slotmachine/backend/internal/infra/cache"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
@@ -14,6 +20,14 @@ var ProviderSet = wire.NewSet(
 	ProvideCache,
 	ProvideRedisClient,
 	ProvidePFSessionCache,
+	ProvideProcessingStatusStore,
+	ProvideSpinFeedStore,
+	ProvideDashboardStore,
+	ProvideBalanceEventStore,
+	ProvideNotificationEventStore,
+	ProvideBalanceLock,
+	ProvideRefreshTokenStore,
+	ProvideLaunchGrantStore,
 )
 
 // ProvideRedisClient provides the Redis client for session caching
@@ -31,6 +45,54 @@ func ProvidePFSessionCache(redisClient *infraCache.RedisClient, log *logger.Logg
 	return infraCache.NewPFSessionCache(redisClient, log)
 }
 
+// ProvideProcessingStatusStore provides the background processing status store
+func ProvideProcessingStatusStore(redisClient *infraCache.RedisClient) *infraCache.ProcessingStatusStore {
+	return infraCache.NewProcessingStatusStore(redisClient)
+}
+
+// ProvideRefreshTokenStore provides the player refresh token store, or nil without Redis
+func ProvideRefreshTokenStore(redisClient *infraCache.RedisClient) session.RefreshTokenStore {
+	if redisClient == nil {
+		return nil
+	}
+	return infraCache.NewRefreshTokenStore(redisClient)
+}
+
+// ProvideLaunchGrantStore provides the one-time launch grant store, or nil without Redis
+func ProvideLaunchGrantStore(redisClient *infraCache.RedisClient) launch.GrantStore {
+	if redisClient == nil {
+		return nil
+	}
+	return infraCache.NewLaunchGrantStore(redisClient)
+}
+
+// ProvideSpinFeedStore provides the live spin feed store
+func ProvideSpinFeedStore(redisClient *infraCache.RedisClient, log *logger.Logger) *infraCache.SpinFeedStore {
+	return infraCache.NewSpinFeedStore(redisClient, log)
+}
+
+// ProvideDashboardStore provides the live ops dashboard counters
+func ProvideDashboardStore(redisClient *infraCache.RedisClient, log *logger.Logger) *infraCache.DashboardStore {
+	return infraCache.NewDashboardStore(redisClient, log)
+}
+
+// ProvideBalanceEventStore provides the store pushing balance events to connected players
+func ProvideBalanceEventStore(redisClient *infraCache.RedisClient, log *logger.Logger) balance.Store {
+	return infraCache.NewBalanceEventStore(redisClient, log)
+}
+
+// ProvideNotificationEventStore provides the store pushing notifications to connected players
+func ProvideNotificationEventStore(redisClient *infraCache.RedisClient, log *logger.Logger) notification.Store {
+	return infraCache.NewNotificationEventStore(redisClient, log)
+}
+
+// ProvideBalanceLock provides the per-player lock serializing balance updates
+func ProvideBalanceLock(redisClient *infraCache.RedisClient, cfg *config.Config, log *logger.Logger) player.Locker {
+	ttl := time.Duration(cfg.Game.BalanceLockTTLSeconds) * time.Second
+	wait := time.Duration(cfg.Game.BalanceLockWaitMillis) * time.Millisecond
+	return infraCache.NewBalanceLock(redisClient, ttl, wait, log)
+}
+
 func ProvideCache(cfg *config.Config, log *logger.Logger) *Cache {
 	var bus EventBus
 	var redisCloser RedisCloser
@@ -0,0 +1,52 @@
+// Package clock abstracts the time services read and schedule by, so time-dependent behavior
+// can be tested deterministically and simulated forward.
+package clock
+
+import "time"
+
+// Clock tells the time and schedules ticks
+// Services take a Clock instead of calling time.Now, so tests can fix the time and
+// move it forward at will.
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+	// NewTicker returns a ticker delivering ticks every d; d must be positive
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers ticks at intervals, like time.Ticker
+type Ticker interface {
+	// C returns the channel ticks are delivered on
+	C() <-chan time.Time
+	// Stop turns the ticker off; no ticks are delivered after it returns
+	Stop()
+}
+
+// New returns the wall clock
+func New() Clock {
+	return realClock{}
+}
+
+// realClock is the wall clock
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+// realTicker adapts time.Ticker to Ticker
+type realTicker struct {
+	ticker *time.Ticker
+}
+
+func (t realTicker) C() <-chan time.Time {
+	return t.ticker.C
+}
+
+func (t realTicker) Stop() {
+	t.ticker.Stop()
+}
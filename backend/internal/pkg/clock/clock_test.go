@@ -0,0 +1,109 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var start = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+// received returns the tick waiting on c, if any
+func received(c <-chan time.Time) (time.Time, bool) {
+	select {
+	case tick := <-c:
+		return tick, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func TestFake_Now(t *testing.T) {
+	f := NewFake(start)
+	assert.Equal(t, start, f.Now())
+
+	f.Advance(90 * time.Second)
+	assert.Equal(t, start.Add(90*time.Second), f.Now())
+
+	f.Set(start)
+	assert.Equal(t, start, f.Now(), "Set can move the time backwards")
+}
+
+func TestFake_TickerFiresWhenDue(t *testing.T) {
+	f := NewFake(start)
+	ticker := f.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	f.Advance(59 * time.Second)
+	_, ok := received(ticker.C())
+	assert.False(t, ok, "no tick before the interval elapses")
+
+	f.Advance(time.Second)
+	tick, ok := received(ticker.C())
+	require.True(t, ok)
+	assert.Equal(t, start.Add(time.Minute), tick)
+
+	f.Advance(time.Minute)
+	tick, ok = received(ticker.C())
+	require.True(t, ok)
+	assert.Equal(t, start.Add(2*time.Minute), tick)
+}
+
+func TestFake_TickerDropsTicksForSlowReaders(t *testing.T) {
+	f := NewFake(start)
+	ticker := f.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	// Like time.Ticker, only the first of the missed ticks is kept
+	f.Advance(5 * time.Minute)
+	tick, ok := received(ticker.C())
+	require.True(t, ok)
+	assert.Equal(t, start.Add(time.Minute), tick)
+	_, ok = received(ticker.C())
+	assert.False(t, ok)
+
+	// The schedule is unaffected by the dropped ticks
+	f.Advance(time.Minute)
+	tick, ok = received(ticker.C())
+	require.True(t, ok)
+	assert.Equal(t, start.Add(6*time.Minute), tick)
+}
+
+func TestFake_StoppedTickerNeverFires(t *testing.T) {
+	f := NewFake(start)
+	ticker := f.NewTicker(time.Minute)
+	ticker.Stop()
+
+	f.Advance(time.Hour)
+	_, ok := received(ticker.C())
+	assert.False(t, ok)
+}
+
+func TestFake_BlockUntil(t *testing.T) {
+	f := NewFake(start)
+	done := make(chan struct{})
+	go func() {
+		f.BlockUntil(2)
+		close(done)
+	}()
+
+	f.NewTicker(time.Second)
+	select {
+	case <-done:
+		t.Fatal("returned with one ticker running")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	f.NewTicker(time.Second)
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("did not return once two tickers were running")
+	}
+}
+
+func TestFake_NewTickerRejectsNonPositiveInterval(t *testing.T) {
+	assert.Panics(t, func() { NewFake(start).NewTicker(0) })
+}
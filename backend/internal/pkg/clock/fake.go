@@ -0,0 +1,109 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Fake is a clock that only moves when told to
+// Tickers fire as Advance or Set moves the time past their next tick. Like time.Ticker,
+// a ticker whose reader lags keeps a single pending tick and drops the rest.
+type Fake struct {
+	mu      sync.Mutex
+	now     time.Time
+	tickers []*fakeTicker
+	changed chan struct{} // Closed and replaced whenever a ticker is added or stopped
+}
+
+// NewFake returns a fake clock stopped at start
+func NewFake(start time.Time) *Fake {
+	return &Fake{now: start, changed: make(chan struct{})}
+}
+
+// Now returns the fake time
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// NewTicker returns a ticker firing every d of fake time, starting d from now
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("clock: non-positive interval for NewTicker")
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t := &fakeTicker{clock: f, interval: d, next: f.now.Add(d), c: make(chan time.Time, 1)}
+	f.tickers = append(f.tickers, t)
+	f.notify()
+	return t
+}
+
+// Advance moves the time forward by d, firing every tick that falls due on the way
+func (f *Fake) Advance(d time.Duration) {
+	f.Set(f.Now().Add(d))
+}
+
+// Set moves the time to t, firing every tick that falls due on the way
+// Moving the time backwards fires nothing.
+func (f *Fake) Set(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, ticker := range f.tickers {
+		for !ticker.next.After(t) {
+			select {
+			case ticker.c <- ticker.next:
+			default:
+			}
+			ticker.next = ticker.next.Add(ticker.interval)
+		}
+	}
+	f.now = t
+}
+
+// BlockUntil waits until n tickers are running
+// Workers create their ticker on a goroutine of their own; waiting for it keeps a test
+// from advancing the time before the worker is listening.
+func (f *Fake) BlockUntil(n int) {
+	for {
+		f.mu.Lock()
+		running, changed := len(f.tickers), f.changed
+		f.mu.Unlock()
+		if running >= n {
+			return
+		}
+		<-changed
+	}
+}
+
+// notify wakes BlockUntil callers; the caller holds f.mu
+func (f *Fake) notify() {
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+// fakeTicker is a ticker driven by a Fake clock
+type fakeTicker struct {
+	clock    *Fake
+	interval time.Duration
+	next     time.Time
+	c        chan time.Time
+}
+
+func (t *fakeTicker) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTicker) Stop() {
+	f := t.clock
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, ticker := range f.tickers {
+		if ticker == t {
+			f.tickers = append(f.tickers[:i], f.tickers[i+1:]...)
+			f.notify()
+			return
+		}
+	}
+}
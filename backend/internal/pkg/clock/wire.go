@@ -0,0 +1,26 @@
+package clock
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/slotmachine/backend/internal/config"
+)
+
+// ProviderSet is the Wire provider set for the clock
+var ProviderSet = wire.NewSet(
+	ProvideClock,
+)
+
+// ProvideClock returns the wall clock, or a fake clock stopped at CLOCK_FAKE_START when that is set
+func ProvideClock(cfg *config.Config) (Clock, error) {
+	if cfg.Clock.FakeStart == "" {
+		return New(), nil
+	}
+	start, err := time.Parse(time.RFC3339, cfg.Clock.FakeStart)
+	if err != nil {
+		return nil, fmt.Errorf("CLOCK_FAKE_START: %w", err)
+	}
+	return NewFake(start), nil
+}
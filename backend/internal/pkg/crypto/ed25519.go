@@ -0,0 +1,60 @@
+package crypto
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidSigningSeed is returned when an Ed25519 seed is not 32 bytes
+var ErrInvalidSigningSeed = errors.New("invalid signing seed: must be 32 bytes for Ed25519")
+
+// Ed25519Signer signs payloads with a fixed Ed25519 key
+type Ed25519Signer struct {
+	privateKey ed25519.PrivateKey
+	publicKey  ed25519.PublicKey
+	keyID      string
+}
+
+// NewEd25519Signer creates a signer from a 32-byte seed
+// The same seed always yields the same key, so every instance signs with one published key.
+func NewEd25519Signer(seed string) (*Ed25519Signer, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("%w: got %d bytes", ErrInvalidSigningSeed, len(seed))
+	}
+
+	privateKey := ed25519.NewKeyFromSeed([]byte(seed))
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	sum := sha256.Sum256(publicKey)
+
+	return &Ed25519Signer{
+		privateKey: privateKey,
+		publicKey:  publicKey,
+		keyID:      hex.EncodeToString(sum[:8]),
+	}, nil
+}
+
+// Sign returns the Ed25519 signature of payload
+func (s *Ed25519Signer) Sign(payload []byte) []byte {
+	return ed25519.Sign(s.privateKey, payload)
+}
+
+// PublicKey returns the key verifiers check signatures against
+func (s *Ed25519Signer) PublicKey() ed25519.PublicKey {
+	return s.publicKey
+}
+
+// KeyID identifies the public key: the hex of the first 8 bytes of its SHA-256
+func (s *Ed25519Signer) KeyID() string {
+	return s.keyID
+}
+
+// VerifyEd25519 reports whether signature is a valid signature of payload by publicKey
+func VerifyEd25519(publicKey ed25519.PublicKey, payload, signature []byte) bool {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return false
+	}
+	return ed25519.Verify(publicKey, payload, signature)
+}
@@ -0,0 +1,26 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEd25519Signer(t *testing.T) {
+	_, err := NewEd25519Signer("short")
+	assert.ErrorIs(t, err, ErrInvalidSigningSeed)
+
+	signer, err := NewEd25519Signer("spin-signing-dev-seed-32-bytes!!")
+	require.NoError(t, err)
+	again, err := NewEd25519Signer("spin-signing-dev-seed-32-bytes!!")
+	require.NoError(t, err)
+	assert.Equal(t, signer.PublicKey(), again.PublicKey(), "a seed always yields the same key")
+	assert.Len(t, signer.KeyID(), 16)
+
+	payload := []byte(`{"spin_id":"x"}`)
+	signature := signer.Sign(payload)
+	assert.True(t, VerifyEd25519(signer.PublicKey(), payload, signature))
+	assert.False(t, VerifyEd25519(signer.PublicKey(), []byte(`{"spin_id":"y"}`), signature))
+	assert.False(t, VerifyEd25519(nil, payload, signature))
+}
@@ -0,0 +1,19 @@
+package featureflags
+
+import (
+	"time"
+
+	"github.com/slotmachine/backend/internal/config"
+	infraCache "github.com/slotmachine/backend/internal/infra/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// NewFromConfig creates the feature flag service from FEATURE_FLAGS with overrides in Redis
+func NewFromConfig(cfg *config.Config, redisClient *infraCache.RedisClient, log *logger.Logger) (*Service, error) {
+	defaults, err := ParseDefaults(cfg.FeatureFlags.Flags)
+	if err != nil {
+		return nil, err
+	}
+	refresh := time.Duration(cfg.FeatureFlags.RefreshSeconds) * time.Second
+	return NewService(defaults, NewRedisStore(redisClient), refresh, log), nil
+}
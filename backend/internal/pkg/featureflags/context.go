@@ -0,0 +1,31 @@
+package featureflags
+
+import "context"
+
+// Set is the flags evaluated for one player, by name
+type Set map[string]bool
+
+// Enabled reports whether the flag is on
+func (s Set) Enabled(name string) bool {
+	return s[name]
+}
+
+// Disabled reports whether the flag was evaluated and is off
+// Code paths outside a request (simulator, tests) have no set and keep default behaviour.
+func (s Set) Disabled(name string) bool {
+	on, ok := s[name]
+	return ok && !on
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying the evaluated flags
+func NewContext(ctx context.Context, set Set) context.Context {
+	return context.WithValue(ctx, contextKey{}, set)
+}
+
+// FromContext returns the flags carried by the context, or nil
+func FromContext(ctx context.Context) Set {
+	set, _ := ctx.Value(contextKey{}).(Set)
+	return set
+}
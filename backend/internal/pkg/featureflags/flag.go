@@ -0,0 +1,95 @@
+package featureflags
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Built-in flags checked by the server
+const (
+	// WildFeatures gates per-game sticky, expanding and multiplier wilds
+	WildFeatures = "wild_features"
+)
+
+// builtins are the flags every server knows, with their defaults
+// Defaults keep current behaviour; FEATURE_FLAGS and admin overrides change them.
+var builtins = []Flag{
+	{Name: WildFeatures, Enabled: true, Percentage: 100},
+}
+
+var (
+	// ErrFlagNotFound is returned for flags that are neither built in nor configured
+	ErrFlagNotFound = errors.New("feature flag not found")
+	// ErrInvalidFlag is returned when a flag fails validation
+	ErrInvalidFlag = errors.New("invalid feature flag")
+)
+
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// Flag is a feature rolled out to a share of players
+// A player gets the flag when it is enabled and either falls in the rollout percentage
+// or belongs to one of the segments.
+type Flag struct {
+	Name       string      `json:"name"`
+	Enabled    bool        `json:"enabled"`            // Kill switch: false turns the flag off for everyone
+	Percentage int         `json:"percentage"`         // Share of players (0-100), stable per player
+	Segments   []uuid.UUID `json:"segments,omitempty"` // Members of these segments always get the flag
+	UpdatedBy  string      `json:"updated_by,omitempty"`
+	UpdatedAt  *time.Time  `json:"updated_at,omitempty"`
+	Overridden bool        `json:"overridden"` // Set by an admin override rather than config
+}
+
+// Validate checks the flag name and rollout percentage
+func (f *Flag) Validate() error {
+	if !namePattern.MatchString(f.Name) {
+		return fmt.Errorf("%w: name must be lowercase letters, digits and underscores", ErrInvalidFlag)
+	}
+	if f.Percentage < 0 || f.Percentage > 100 {
+		return fmt.Errorf("%w: percentage must be between 0 and 100", ErrInvalidFlag)
+	}
+	return nil
+}
+
+// ParseDefaults parses FEATURE_FLAGS ("name=percentage,...") on top of the built-in flags
+// A percentage of 0 configures the flag but leaves it off.
+func ParseDefaults(spec string) ([]Flag, error) {
+	flags := make([]Flag, len(builtins))
+	copy(flags, builtins)
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, pct, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q must be name=percentage", ErrInvalidFlag, entry)
+		}
+		percentage, err := strconv.Atoi(strings.TrimSpace(pct))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q has a non-numeric percentage", ErrInvalidFlag, entry)
+		}
+		flag := Flag{Name: strings.TrimSpace(name), Enabled: percentage > 0, Percentage: percentage}
+		if err := flag.Validate(); err != nil {
+			return nil, err
+		}
+
+		replaced := false
+		for i := range flags {
+			if flags[i].Name == flag.Name {
+				flags[i] = flag
+				replaced = true
+			}
+		}
+		if !replaced {
+			flags = append(flags, flag)
+		}
+	}
+	return flags, nil
+}
@@ -0,0 +1,231 @@
+package featureflags
+
+import (
+	"context"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// SegmentChecker reports segment membership for segment-targeted flags
+type SegmentChecker interface {
+	IsMember(ctx context.Context, segmentID, playerID uuid.UUID) (bool, error)
+}
+
+// Service evaluates feature flags per player
+// Config defaults are overridden by admin overrides from the store. Overrides are
+// reloaded every refresh interval, so changes reach every server within it.
+type Service struct {
+	store    Store
+	segments SegmentChecker // Optional: nil ignores flag segments
+	refresh  time.Duration
+	logger   *logger.Logger
+	now      func() time.Time
+
+	defaultsMu sync.RWMutex
+	defaults   map[string]Flag // Replaced whole by SetDefaults, never modified
+
+	mu        sync.RWMutex
+	overrides map[string]Flag
+	loadedAt  time.Time
+}
+
+// NewService creates a new feature flag service
+func NewService(defaults []Flag, store Store, refresh time.Duration, log *logger.Logger) *Service {
+	s := &Service{
+		store:   store,
+		refresh: refresh,
+		logger:  log,
+		now:     time.Now,
+	}
+	s.SetDefaults(defaults)
+	return s
+}
+
+// SetDefaults replaces the config defaults, as parsed by ParseDefaults; admin overrides still win
+func (s *Service) SetDefaults(defaults []Flag) {
+	byName := make(map[string]Flag, len(defaults))
+	for _, flag := range defaults {
+		byName[flag.Name] = flag
+	}
+	s.defaultsMu.Lock()
+	s.defaults = byName
+	s.defaultsMu.Unlock()
+}
+
+func (s *Service) defaultFlags() map[string]Flag {
+	s.defaultsMu.RLock()
+	defer s.defaultsMu.RUnlock()
+	return s.defaults
+}
+
+// SetSegmentChecker enables segment-targeted flags
+func (s *Service) SetSegmentChecker(segments SegmentChecker) {
+	s.segments = segments
+}
+
+// Enabled reports whether the flag is on for the subject (a player or trial session ID)
+func (s *Service) Enabled(ctx context.Context, name string, subjectID uuid.UUID) bool {
+	flag, ok := s.flag(ctx, name)
+	if !ok {
+		return false
+	}
+	return s.evaluate(ctx, flag, subjectID)
+}
+
+// Evaluate returns every flag evaluated for the subject
+func (s *Service) Evaluate(ctx context.Context, subjectID uuid.UUID) Set {
+	flags := s.flags(ctx)
+	set := make(Set, len(flags))
+	for _, flag := range flags {
+		set[flag.Name] = s.evaluate(ctx, flag, subjectID)
+	}
+	return set
+}
+
+// List returns every known flag with overrides applied, by name
+func (s *Service) List(ctx context.Context) []Flag {
+	flags := s.flags(ctx)
+	list := make([]Flag, 0, len(flags))
+	for _, flag := range flags {
+		list = append(list, flag)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// Get returns a flag with its override applied
+func (s *Service) Get(ctx context.Context, name string) (*Flag, error) {
+	flag, ok := s.flag(ctx, name)
+	if !ok {
+		return nil, ErrFlagNotFound
+	}
+	return &flag, nil
+}
+
+// SetOverride stores an admin override for a known flag
+func (s *Service) SetOverride(ctx context.Context, flag Flag, updatedBy string) (*Flag, error) {
+	if _, ok := s.defaultFlags()[flag.Name]; !ok {
+		return nil, ErrFlagNotFound
+	}
+	if err := flag.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	flag.UpdatedBy = updatedBy
+	flag.UpdatedAt = &now
+	flag.Overridden = true
+	if err := s.store.Put(ctx, flag); err != nil {
+		return nil, err
+	}
+	s.invalidate()
+	return &flag, nil
+}
+
+// ClearOverride drops a flag's override, restoring its config default
+func (s *Service) ClearOverride(ctx context.Context, name string) (*Flag, error) {
+	flag, ok := s.defaultFlags()[name]
+	if !ok {
+		return nil, ErrFlagNotFound
+	}
+	if err := s.store.Delete(ctx, name); err != nil {
+		return nil, err
+	}
+	s.invalidate()
+	return &flag, nil
+}
+
+// evaluate applies the kill switch, percentage bucket and segments in that order
+func (s *Service) evaluate(ctx context.Context, flag Flag, subjectID uuid.UUID) bool {
+	if !flag.Enabled {
+		return false
+	}
+	if bucket(flag.Name, subjectID) < flag.Percentage {
+		return true
+	}
+	if s.segments == nil {
+		return false
+	}
+	for _, segmentID := range flag.Segments {
+		member, err := s.segments.IsMember(ctx, segmentID, subjectID)
+		if err != nil {
+			s.logger.Debug().Err(err).Str("flag", flag.Name).Str("segment_id", segmentID.String()).Msg("Failed to check flag segment")
+			continue
+		}
+		if member {
+			return true
+		}
+	}
+	return false
+}
+
+// bucket maps a subject to 0-99, independently per flag so rollouts don't share players
+func bucket(name string, subjectID uuid.UUID) int {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	h.Write(subjectID[:])
+	return int(h.Sum32() % 100)
+}
+
+func (s *Service) flag(ctx context.Context, name string) (Flag, bool) {
+	flag, ok := s.defaultFlags()[name]
+	if !ok {
+		return Flag{}, false
+	}
+	if override, ok := s.loadOverrides(ctx)[name]; ok {
+		return override, true
+	}
+	return flag, true
+}
+
+func (s *Service) flags(ctx context.Context) map[string]Flag {
+	overrides := s.loadOverrides(ctx)
+	defaults := s.defaultFlags()
+	flags := make(map[string]Flag, len(defaults))
+	for name, flag := range defaults {
+		if override, ok := overrides[name]; ok {
+			flag = override
+		}
+		flags[name] = flag
+	}
+	return flags
+}
+
+// loadOverrides returns the cached overrides, reloading them once stale
+// A failed reload keeps the previous overrides so a Redis outage doesn't flip flags.
+func (s *Service) loadOverrides(ctx context.Context) map[string]Flag {
+	s.mu.RLock()
+	overrides, loadedAt := s.overrides, s.loadedAt
+	s.mu.RUnlock()
+	if overrides != nil && s.now().Sub(loadedAt) < s.refresh {
+		return overrides
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.overrides != nil && s.now().Sub(s.loadedAt) < s.refresh {
+		return s.overrides
+	}
+	loaded, err := s.store.All(ctx)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to reload feature flag overrides")
+		if s.overrides == nil {
+			s.overrides = map[string]Flag{}
+		}
+		loaded = s.overrides
+	}
+	s.overrides = loaded
+	s.loadedAt = s.now()
+	return s.overrides
+}
+
+func (s *Service) invalidate() {
+	s.mu.Lock()
+	s.overrides = nil
+	s.mu.Unlock()
+}
@@ -0,0 +1,113 @@
+package featureflags
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSegments map[uuid.UUID]uuid.UUID // player -> segment
+
+func (f fakeSegments) IsMember(_ context.Context, segmentID, playerID uuid.UUID) (bool, error) {
+	return f[playerID] == segmentID, nil
+}
+
+func newTestService(flags ...Flag) *Service {
+	return NewService(flags, NewRedisStore(nil), time.Minute, logger.New("error", "json"))
+}
+
+func TestParseDefaults(t *testing.T) {
+	flags, err := ParseDefaults("new_rng=25, wild_features=0")
+	require.NoError(t, err)
+
+	byName := map[string]Flag{}
+	for _, flag := range flags {
+		byName[flag.Name] = flag
+	}
+	assert.Equal(t, 25, byName["new_rng"].Percentage)
+	assert.True(t, byName["new_rng"].Enabled)
+	assert.Equal(t, 0, byName[WildFeatures].Percentage)
+
+	_, err = ParseDefaults("new_rng=150")
+	assert.ErrorIs(t, err, ErrInvalidFlag)
+	_, err = ParseDefaults("Bad-Name=10")
+	assert.ErrorIs(t, err, ErrInvalidFlag)
+}
+
+func TestService_Percentage(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(
+		Flag{Name: "all", Enabled: true, Percentage: 100},
+		Flag{Name: "none", Enabled: true, Percentage: 0},
+		Flag{Name: "half", Enabled: true, Percentage: 50},
+		Flag{Name: "killed", Enabled: false, Percentage: 100},
+	)
+
+	on := 0
+	for i := 0; i < 1000; i++ {
+		playerID := uuid.New()
+		assert.True(t, svc.Enabled(ctx, "all", playerID))
+		assert.False(t, svc.Enabled(ctx, "none", playerID))
+		assert.False(t, svc.Enabled(ctx, "killed", playerID))
+		if svc.Enabled(ctx, "half", playerID) {
+			on++
+			assert.True(t, svc.Enabled(ctx, "half", playerID), "bucket must be stable")
+		}
+	}
+	assert.InDelta(t, 500, on, 100)
+	assert.False(t, svc.Enabled(ctx, "unknown", uuid.New()))
+}
+
+func TestService_Segments(t *testing.T) {
+	ctx := context.Background()
+	segmentID := uuid.New()
+	member, outsider := uuid.New(), uuid.New()
+
+	svc := newTestService(Flag{Name: "beta", Enabled: true, Percentage: 0, Segments: []uuid.UUID{segmentID}})
+	assert.False(t, svc.Enabled(ctx, "beta", member), "segments are ignored without a checker")
+
+	svc.SetSegmentChecker(fakeSegments{member: segmentID})
+	assert.True(t, svc.Enabled(ctx, "beta", member))
+	assert.False(t, svc.Enabled(ctx, "beta", outsider))
+}
+
+func TestService_Overrides(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	svc := newTestService(Flag{Name: "new_rng", Enabled: true, Percentage: 0})
+
+	flag, err := svc.SetOverride(ctx, Flag{Name: "new_rng", Enabled: true, Percentage: 100}, "ops")
+	require.NoError(t, err)
+	assert.True(t, flag.Overridden)
+	assert.Equal(t, "ops", flag.UpdatedBy)
+	assert.True(t, svc.Enabled(ctx, "new_rng", playerID))
+	assert.Equal(t, Set{"new_rng": true}, svc.Evaluate(ctx, playerID))
+
+	_, err = svc.SetOverride(ctx, Flag{Name: "new_rng", Enabled: true, Percentage: 101}, "ops")
+	assert.ErrorIs(t, err, ErrInvalidFlag)
+	_, err = svc.SetOverride(ctx, Flag{Name: "unknown", Enabled: true}, "ops")
+	assert.ErrorIs(t, err, ErrFlagNotFound)
+
+	flag, err = svc.ClearOverride(ctx, "new_rng")
+	require.NoError(t, err)
+	assert.False(t, flag.Overridden)
+	assert.False(t, svc.Enabled(ctx, "new_rng", playerID))
+}
+
+func TestSet_Disabled(t *testing.T) {
+	var unevaluated Set
+	assert.False(t, unevaluated.Disabled(WildFeatures))
+
+	set := Set{WildFeatures: false}
+	assert.True(t, set.Disabled(WildFeatures))
+	assert.False(t, set.Enabled(WildFeatures))
+
+	ctx := NewContext(context.Background(), Set{WildFeatures: true})
+	assert.True(t, FromContext(ctx).Enabled(WildFeatures))
+	assert.Nil(t, FromContext(context.Background()))
+}
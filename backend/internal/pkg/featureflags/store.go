@@ -0,0 +1,99 @@
+package featureflags
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	infraCache "github.com/slotmachine/backend/internal/infra/cache"
+)
+
+// overridesKey is the Redis hash of admin overrides, one JSON flag per field
+const overridesKey = "feature_flags"
+
+// Store persists admin overrides
+type Store interface {
+	All(ctx context.Context) (map[string]Flag, error)
+	Put(ctx context.Context, flag Flag) error
+	Delete(ctx context.Context, name string) error
+}
+
+// RedisStore keeps overrides in Redis so every server sees them,
+// with an in-memory fallback when Redis is unavailable
+type RedisStore struct {
+	redis *infraCache.RedisClient
+
+	mu    sync.Mutex
+	local map[string]Flag
+}
+
+// NewRedisStore creates a new override store
+func NewRedisStore(redis *infraCache.RedisClient) *RedisStore {
+	return &RedisStore{redis: redis, local: make(map[string]Flag)}
+}
+
+func (s *RedisStore) useRedis() bool {
+	return s.redis != nil && s.redis.GetClient() != nil
+}
+
+// All returns every override by flag name
+func (s *RedisStore) All(ctx context.Context) (map[string]Flag, error) {
+	if !s.useRedis() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		flags := make(map[string]Flag, len(s.local))
+		for name, flag := range s.local {
+			flags[name] = flag
+		}
+		return flags, nil
+	}
+
+	values, err := s.redis.GetClient().HGetAll(ctx, overridesKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load feature flag overrides: %w", err)
+	}
+	flags := make(map[string]Flag, len(values))
+	for name, data := range values {
+		var flag Flag
+		if err := json.Unmarshal([]byte(data), &flag); err != nil {
+			return nil, fmt.Errorf("failed to decode feature flag %q: %w", name, err)
+		}
+		flags[name] = flag
+	}
+	return flags, nil
+}
+
+// Put stores an override
+func (s *RedisStore) Put(ctx context.Context, flag Flag) error {
+	if !s.useRedis() {
+		s.mu.Lock()
+		s.local[flag.Name] = flag
+		s.mu.Unlock()
+		return nil
+	}
+
+	data, err := json.Marshal(flag)
+	if err != nil {
+		return fmt.Errorf("failed to encode feature flag: %w", err)
+	}
+	if err := s.redis.GetClient().HSet(ctx, overridesKey, flag.Name, data).Err(); err != nil {
+		return fmt.Errorf("failed to store feature flag override: %w", err)
+	}
+	return nil
+}
+
+// Delete removes an override
+func (s *RedisStore) Delete(ctx context.Context, name string) error {
+	if !s.useRedis() {
+		s.mu.Lock()
+		delete(s.local, name)
+		s.mu.Unlock()
+		return nil
+	}
+
+	if err := s.redis.GetClient().HDel(ctx, overridesKey, name).Err(); err != nil {
+		return fmt.Errorf("failed to delete feature flag override: %w", err)
+	}
+	return nil
+}
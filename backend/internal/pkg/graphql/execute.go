@@ -0,0 +1,575 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// CodeForbidden is the error extension code of fields the caller may not read
+const CodeForbidden = "FORBIDDEN"
+
+// Request is a GraphQL request as sent over HTTP
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Result is a GraphQL response
+// Data is absent when the request failed before execution (parse or validation errors).
+type Result struct {
+	Data   any      `json:"data,omitempty"`
+	Errors []*Error `json:"errors,omitempty"`
+}
+
+// Error is a GraphQL error, located by the response path of the field that raised it
+type Error struct {
+	Message    string         `json:"message"`
+	Path       []any          `json:"path,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+func (e *Error) Error() string { return e.Message }
+
+// errNull propagates a null raised in a non-null position up to the nearest nullable parent
+var errNull = errors.New("null in non-null position")
+
+// Execute runs a query request against the schema
+func (s *Schema) Execute(ctx context.Context, req Request) *Result {
+	doc, err := parse(req.Query)
+	if err != nil {
+		return requestError("Syntax error: " + err.Error())
+	}
+
+	op, err := selectOperation(doc, req.OperationName)
+	if err != nil {
+		return requestError(err.Error())
+	}
+	if op.kind != "query" {
+		return requestError(fmt.Sprintf("%s operations are not supported", op.kind))
+	}
+
+	e := &executor{schema: s, ctx: ctx, doc: doc, defined: make(map[string]bool)}
+	if e.vars, err = coerceVariables(op, req.Variables); err != nil {
+		return requestError(err.Error())
+	}
+	for _, def := range op.variables {
+		e.defined[def.name] = true
+	}
+	if err := e.validate(s.Query, op.selections, 1, make(map[string]bool)); err != nil {
+		return requestError(err.Error())
+	}
+
+	data, err := e.executeSelectionSet(s.Query, nil, op.selections, nil)
+	result := &Result{Errors: e.errors}
+	if err == nil {
+		result.Data = data
+	}
+	return result
+}
+
+func requestError(message string) *Result {
+	return &Result{Errors: []*Error{{Message: message}}}
+}
+
+func selectOperation(doc *document, name string) (*operation, error) {
+	if name == "" {
+		if len(doc.operations) > 1 {
+			return nil, fmt.Errorf("operation name is required when the document has several operations")
+		}
+		return doc.operations[0], nil
+	}
+	for _, op := range doc.operations {
+		if op.name == name {
+			return op, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown operation %q", name)
+}
+
+// coerceVariables applies defaults and checks required variables were provided
+// Values are coerced to their argument types where they are used.
+func coerceVariables(op *operation, provided map[string]any) (map[string]any, error) {
+	vars := make(map[string]any, len(op.variables))
+	for _, def := range op.variables {
+		v, ok := provided[def.name]
+		if !ok && def.hasDef {
+			v, ok = def.defValue, true
+		}
+		if def.nonNull && (!ok || v == nil) {
+			return nil, fmt.Errorf("variable \"$%s\" of non-null type was not provided", def.name)
+		}
+		if ok {
+			vars[def.name] = v
+		}
+	}
+	return vars, nil
+}
+
+type executor struct {
+	schema  *Schema
+	ctx     context.Context
+	doc     *document
+	vars    map[string]any
+	defined map[string]bool // Variables the operation declares
+	errors  []*Error
+}
+
+// validate checks the selections against obj before anything resolves
+func (e *executor) validate(obj *Object, sels []selection, depth int, spreading map[string]bool) error {
+	if e.schema.MaxDepth > 0 && depth > e.schema.MaxDepth {
+		return fmt.Errorf("query exceeds the maximum depth of %d", e.schema.MaxDepth)
+	}
+
+	for _, sel := range sels {
+		switch sel := sel.(type) {
+		case *fieldNode:
+			if err := e.validateDirectives(sel.directives); err != nil {
+				return err
+			}
+			if sel.name == "__typename" {
+				if len(sel.selections) > 0 {
+					return fmt.Errorf("field __typename cannot have a selection of subfields")
+				}
+				continue
+			}
+
+			def, ok := obj.Fields[sel.name]
+			if !ok {
+				return fmt.Errorf("cannot query field %q on type %q", sel.name, obj.Name)
+			}
+			for _, arg := range sel.arguments {
+				if _, ok := def.Args[arg.name]; !ok {
+					return fmt.Errorf("unknown argument %q on field %s.%s", arg.name, obj.Name, sel.name)
+				}
+				if err := e.validateValue(arg.value); err != nil {
+					return err
+				}
+			}
+
+			child, isObject := namedType(def.Type).(*Object)
+			switch {
+			case isObject && len(sel.selections) == 0:
+				return fmt.Errorf("field %s.%s of type %s must have a selection of subfields", obj.Name, sel.name, def.Type)
+			case !isObject && len(sel.selections) > 0:
+				return fmt.Errorf("field %s.%s of type %s cannot have a selection of subfields", obj.Name, sel.name, def.Type)
+			case isObject:
+				if err := e.validate(child, sel.selections, depth+1, spreading); err != nil {
+					return err
+				}
+			}
+
+		case *fragmentSpread:
+			if err := e.validateDirectives(sel.directives); err != nil {
+				return err
+			}
+			frag, ok := e.doc.fragments[sel.name]
+			if !ok {
+				return fmt.Errorf("unknown fragment %q", sel.name)
+			}
+			if spreading[sel.name] {
+				return fmt.Errorf("fragment %q spreads itself", sel.name)
+			}
+			if frag.typeCondition != obj.Name {
+				return fmt.Errorf("fragment %q on %s cannot be spread on type %s", sel.name, frag.typeCondition, obj.Name)
+			}
+			spreading[sel.name] = true
+			if err := e.validate(obj, frag.selections, depth, spreading); err != nil {
+				return err
+			}
+			delete(spreading, sel.name)
+
+		case *inlineFragment:
+			if err := e.validateDirectives(sel.directives); err != nil {
+				return err
+			}
+			if sel.typeCondition != "" && sel.typeCondition != obj.Name {
+				return fmt.Errorf("inline fragment on %s cannot be spread on type %s", sel.typeCondition, obj.Name)
+			}
+			if err := e.validate(obj, sel.selections, depth, spreading); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (e *executor) validateDirectives(dirs []*directive) error {
+	for _, dir := range dirs {
+		if dir.name != "include" && dir.name != "skip" {
+			return fmt.Errorf("unknown directive @%s", dir.name)
+		}
+		if len(dir.arguments) != 1 || dir.arguments[0].name != "if" {
+			return fmt.Errorf("directive @%s takes exactly one argument \"if\"", dir.name)
+		}
+		if err := e.validateValue(dir.arguments[0].value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateValue checks every variable a literal references is declared
+func (e *executor) validateValue(v any) error {
+	switch v := v.(type) {
+	case variableRef:
+		if !e.defined[string(v)] {
+			return fmt.Errorf("variable \"$%s\" is not defined", v)
+		}
+	case []any:
+		for _, item := range v {
+			if err := e.validateValue(item); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		for _, item := range v {
+			if err := e.validateValue(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// collectedField is one response key and every field node merged into it
+type collectedField struct {
+	key   string
+	nodes []*fieldNode
+}
+
+func (e *executor) collectFields(obj *Object, sels []selection, fields []*collectedField, visited map[string]bool) []*collectedField {
+	for _, sel := range sels {
+		switch sel := sel.(type) {
+		case *fieldNode:
+			if !e.included(sel.directives) {
+				continue
+			}
+			key := sel.responseKey()
+			merged := false
+			for _, f := range fields {
+				if f.key == key {
+					f.nodes = append(f.nodes, sel)
+					merged = true
+					break
+				}
+			}
+			if !merged {
+				fields = append(fields, &collectedField{key: key, nodes: []*fieldNode{sel}})
+			}
+		case *fragmentSpread:
+			if visited[sel.name] || !e.included(sel.directives) {
+				continue
+			}
+			visited[sel.name] = true
+			fields = e.collectFields(obj, e.doc.fragments[sel.name].selections, fields, visited)
+		case *inlineFragment:
+			if !e.included(sel.directives) {
+				continue
+			}
+			fields = e.collectFields(obj, sel.selections, fields, visited)
+		}
+	}
+	return fields
+}
+
+// included evaluates @include and @skip
+func (e *executor) included(dirs []*directive) bool {
+	for _, dir := range dirs {
+		cond, _ := e.resolveValue(dir.arguments[0].value).(bool)
+		if (dir.name == "include" && !cond) || (dir.name == "skip" && cond) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *executor) executeSelectionSet(obj *Object, source any, sels []selection, path []any) (*orderedMap, error) {
+	result := &orderedMap{values: make(map[string]any)}
+	for _, field := range e.collectFields(obj, sels, nil, make(map[string]bool)) {
+		node := field.nodes[0]
+		if node.name == "__typename" {
+			result.set(field.key, obj.Name)
+			continue
+		}
+
+		value, err := e.executeField(obj.Fields[node.name], source, field.nodes, appendPath(path, field.key))
+		if err != nil {
+			return nil, err
+		}
+		result.set(field.key, value)
+	}
+	return result, nil
+}
+
+func (e *executor) executeField(def *Field, source any, nodes []*fieldNode, path []any) (any, error) {
+	if def.Permission != "" && (e.schema.Authorize == nil || !e.schema.Authorize(e.ctx, def.Permission)) {
+		e.errors = append(e.errors, &Error{
+			Message:    fmt.Sprintf("not authorized to read %s (requires %s)", nodes[0].name, def.Permission),
+			Path:       path,
+			Extensions: map[string]any{"code": CodeForbidden},
+		})
+		return nullFor(def.Type)
+	}
+
+	args, err := e.coerceArgs(def.Args, nodes[0].arguments)
+	if err != nil {
+		e.addError(path, err)
+		return nullFor(def.Type)
+	}
+
+	var value any
+	if def.Resolve != nil {
+		value, err = def.Resolve(ResolveParams{Context: e.ctx, Source: source, Args: args})
+	} else if m, ok := source.(map[string]any); ok {
+		value = m[nodes[0].name]
+	}
+	if err != nil {
+		e.addError(path, err)
+		return nullFor(def.Type)
+	}
+
+	var sels []selection
+	for _, node := range nodes {
+		sels = append(sels, node.selections...)
+	}
+	return e.completeValue(def.Type, sels, value, path)
+}
+
+// completeValue converts a resolved value to its response shape
+// A null raised below stops at the nearest nullable position, which becomes null itself.
+func (e *executor) completeValue(t Type, sels []selection, value any, path []any) (any, error) {
+	completed, err := e.completeInner(t, sels, value, path)
+	if err == errNull {
+		if _, nonNull := t.(*NonNull); !nonNull {
+			return nil, nil
+		}
+	}
+	return completed, err
+}
+
+func (e *executor) completeInner(t Type, sels []selection, value any, path []any) (any, error) {
+	if nn, ok := t.(*NonNull); ok {
+		completed, err := e.completeInner(nn.OfType, sels, value, path)
+		if err != nil {
+			return nil, err
+		}
+		if completed == nil {
+			e.addError(path, fmt.Errorf("cannot return null for non-null field"))
+			return nil, errNull
+		}
+		return completed, nil
+	}
+
+	if isNil(value) {
+		return nil, nil
+	}
+
+	switch t := t.(type) {
+	case *List:
+		rv := reflect.Indirect(reflect.ValueOf(value))
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			e.addError(path, fmt.Errorf("expected a list, got %T", value))
+			return nil, nil
+		}
+		items := make([]any, rv.Len())
+		for i := range items {
+			item, err := e.completeValue(t.OfType, sels, rv.Index(i).Interface(), appendPath(path, i))
+			if err != nil {
+				return nil, err
+			}
+			items[i] = item
+		}
+		return items, nil
+
+	case *Scalar:
+		serialized, err := t.Serialize(reflect.Indirect(reflect.ValueOf(value)).Interface())
+		if err != nil {
+			e.addError(path, err)
+			return nil, nil
+		}
+		return serialized, nil
+
+	case *Object:
+		result, err := e.executeSelectionSet(t, value, sels, path)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("unknown type %s", t)
+}
+
+func (e *executor) coerceArgs(defs Args, nodes []*argument) (map[string]any, error) {
+	args := make(map[string]any, len(defs))
+	for name, def := range defs {
+		var (
+			value   any
+			present bool
+		)
+		for _, node := range nodes {
+			if node.name != name {
+				continue
+			}
+			if ref, isVar := node.value.(variableRef); isVar {
+				value, present = e.vars[string(ref)]
+			} else {
+				value, present = e.resolveValue(node.value), true
+			}
+		}
+
+		if !present {
+			if def.Default != nil {
+				args[name] = def.Default
+			} else if _, required := def.Type.(*NonNull); required {
+				return nil, fmt.Errorf("argument %q of type %s is required", name, def.Type)
+			}
+			continue
+		}
+
+		coerced, err := coerceInput(def.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("argument %q: %w", name, err)
+		}
+		args[name] = coerced
+	}
+	return args, nil
+}
+
+// resolveValue substitutes variables in a literal
+func (e *executor) resolveValue(v any) any {
+	switch v := v.(type) {
+	case variableRef:
+		return e.vars[string(v)]
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = e.resolveValue(item)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, item := range v {
+			out[k] = e.resolveValue(item)
+		}
+		return out
+	}
+	return v
+}
+
+func coerceInput(t Type, v any) (any, error) {
+	if nn, ok := t.(*NonNull); ok {
+		if v == nil {
+			return nil, fmt.Errorf("expected a non-null %s", nn.OfType)
+		}
+		return coerceInput(nn.OfType, v)
+	}
+	if v == nil {
+		return nil, nil
+	}
+
+	switch t := t.(type) {
+	case *List:
+		items, ok := v.([]any)
+		if !ok {
+			items = []any{v} // A single value is a list of one
+		}
+		out := make([]any, len(items))
+		for i, item := range items {
+			coerced, err := coerceInput(t.OfType, item)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = coerced
+		}
+		return out, nil
+	case *Scalar:
+		if _, isEnum := v.(enumValue); isEnum {
+			return nil, fmt.Errorf("%s cannot represent %v", t.Name, v)
+		}
+		return t.Parse(v)
+	}
+	return nil, fmt.Errorf("%s is not an input type", t)
+}
+
+func (e *executor) addError(path []any, err error) {
+	e.errors = append(e.errors, &Error{Message: err.Error(), Path: path})
+}
+
+func nullFor(t Type) (any, error) {
+	if _, ok := t.(*NonNull); ok {
+		return nil, errNull
+	}
+	return nil, nil
+}
+
+func namedType(t Type) Type {
+	for {
+		switch w := t.(type) {
+		case *List:
+			t = w.OfType
+		case *NonNull:
+			t = w.OfType
+		default:
+			return t
+		}
+	}
+}
+
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// appendPath extends a response path without sharing its backing array
+func appendPath(path []any, elem any) []any {
+	out := make([]any, len(path), len(path)+1)
+	copy(out, path)
+	return append(out, elem)
+}
+
+// orderedMap is a response object whose keys serialize in selection order
+type orderedMap struct {
+	keys   []string
+	values map[string]any
+}
+
+func (m *orderedMap) set(key string, value any) {
+	if _, exists := m.values[key]; !exists {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// MarshalJSON implements json.Marshaler
+func (m *orderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(key)
+		buf.Write(k)
+		buf.WriteByte(':')
+		v, err := json.Marshal(m.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,212 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPlayer struct {
+	ID      string
+	Name    string
+	Email   string
+	Friends []*testPlayer
+}
+
+type permissionsKey struct{}
+
+func testSchema() *Schema {
+	players := map[string]*testPlayer{
+		"1": {ID: "1", Name: "alice", Email: "alice@example.com"},
+		"2": {ID: "2", Name: "bob", Email: "bob@example.com"},
+	}
+	players["1"].Friends = []*testPlayer{players["2"]}
+
+	player := &Object{Name: "Player"}
+	player.Fields = Fields{
+		"id":   {Type: NewNonNull(ID), Resolve: func(p ResolveParams) (any, error) { return p.Source.(*testPlayer).ID, nil }},
+		"name": {Type: String, Resolve: func(p ResolveParams) (any, error) { return p.Source.(*testPlayer).Name, nil }},
+		"email": {
+			Type:       String,
+			Permission: "players:pii",
+			Resolve:    func(p ResolveParams) (any, error) { return p.Source.(*testPlayer).Email, nil },
+		},
+		"friends": {
+			Type:    NewList(NewNonNull(player)),
+			Args:    Args{"limit": {Type: Int, Default: 10}},
+			Resolve: func(p ResolveParams) (any, error) { return p.Source.(*testPlayer).Friends, nil },
+		},
+		"broken": {
+			Type:    NewNonNull(String),
+			Resolve: func(p ResolveParams) (any, error) { return nil, errors.New("lookup failed") },
+		},
+	}
+
+	query := &Object{Name: "Query", Fields: Fields{
+		"player": {
+			Type: player,
+			Args: Args{"id": {Type: NewNonNull(ID)}},
+			Resolve: func(p ResolveParams) (any, error) {
+				if pl, ok := players[p.Args["id"].(string)]; ok {
+					return pl, nil
+				}
+				return nil, nil
+			},
+		},
+		"stats": {
+			Type: &Object{Name: "Stats", Fields: Fields{"count": {Type: Int}}},
+			Resolve: func(p ResolveParams) (any, error) {
+				return map[string]any{"count": len(players)}, nil
+			},
+		},
+	}}
+
+	return &Schema{
+		Query:    query,
+		MaxDepth: 4,
+		Authorize: func(ctx context.Context, permission string) bool {
+			granted, _ := ctx.Value(permissionsKey{}).([]string)
+			for _, g := range granted {
+				if g == permission {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
+
+func execute(t *testing.T, ctx context.Context, req Request) (string, *Result) {
+	t.Helper()
+	result := testSchema().Execute(ctx, req)
+	body, err := json.Marshal(result)
+	require.NoError(t, err)
+	return string(body), result
+}
+
+func TestExecute(t *testing.T) {
+	t.Run("should resolve fields, aliases, fragments and variables in selection order", func(t *testing.T) {
+		body, result := execute(t, context.Background(), Request{
+			Query: `
+				query Lookup($id: ID!, $withFriends: Boolean = true) {
+					__typename
+					me: player(id: $id) { ...PlayerFields friends @include(if: $withFriends) { name } }
+					other: player(id: "2") { ... on Player { name } id }
+					missing: player(id: "9") { id }
+					stats { count }
+				}
+				fragment PlayerFields on Player { id name }`,
+			Variables: map[string]any{"id": "1"},
+		})
+
+		assert.Empty(t, result.Errors)
+		assert.Equal(t,
+			`{"data":{"__typename":"Query","me":{"id":"1","name":"alice","friends":[{"name":"bob"}]},`+
+				`"other":{"name":"bob","id":"2"},"missing":null,"stats":{"count":2}}}`,
+			body)
+	})
+
+	t.Run("should null forbidden fields and report them", func(t *testing.T) {
+		body, result := execute(t, context.Background(), Request{Query: `{ player(id: "1") { name email } }`})
+
+		assert.JSONEq(t, `{"player":{"name":"alice","email":null}}`, mustJSON(t, result.Data))
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, []any{"player", "email"}, result.Errors[0].Path)
+		assert.Equal(t, CodeForbidden, result.Errors[0].Extensions["code"])
+		assert.Contains(t, body, "players:pii")
+	})
+
+	t.Run("should resolve guarded fields once the permission is granted", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), permissionsKey{}, []string{"players:pii"})
+		_, result := execute(t, ctx, Request{Query: `{ player(id: "1") { email } }`})
+
+		assert.Empty(t, result.Errors)
+		assert.JSONEq(t, `{"player":{"email":"alice@example.com"}}`, mustJSON(t, result.Data))
+	})
+
+	t.Run("should propagate a failed non-null field to the nearest nullable parent", func(t *testing.T) {
+		_, result := execute(t, context.Background(), Request{Query: `{ player(id: "1") { name broken } stats { count } }`})
+
+		assert.JSONEq(t, `{"player":null,"stats":{"count":2}}`, mustJSON(t, result.Data))
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, "lookup failed", result.Errors[0].Message)
+		assert.Equal(t, []any{"player", "broken"}, result.Errors[0].Path)
+	})
+
+	t.Run("should null a list when a non-null item fails", func(t *testing.T) {
+		_, result := execute(t, context.Background(), Request{Query: `{ player(id: "1") { friends { broken } } }`})
+
+		assert.JSONEq(t, `{"player":{"friends":null}}`, mustJSON(t, result.Data))
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, []any{"player", "friends", 0, "broken"}, result.Errors[0].Path)
+	})
+
+	t.Run("should skip fields with @skip", func(t *testing.T) {
+		_, result := execute(t, context.Background(), Request{Query: `{ player(id: "1") { id name @skip(if: true) } }`})
+
+		assert.JSONEq(t, `{"player":{"id":"1"}}`, mustJSON(t, result.Data))
+	})
+}
+
+func TestExecute_RequestErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     Request
+		message string
+	}{
+		{"syntax", Request{Query: `{ player(id: "1") { id }`}, "Syntax error"},
+		{"unknown field", Request{Query: `{ player(id: "1") { password } }`}, `cannot query field "password" on type "Player"`},
+		{"unknown argument", Request{Query: `{ player(key: "1") { id } }`}, `unknown argument "key"`},
+		{"missing subselection", Request{Query: `{ player(id: "1") }`}, "must have a selection of subfields"},
+		{"scalar subselection", Request{Query: `{ player(id: "1") { id { x } } }`}, "cannot have a selection of subfields"},
+		{"undefined variable", Request{Query: `{ player(id: $id) { id } }`}, `variable "$id" is not defined`},
+		{"missing required variable", Request{Query: `query($id: ID!) { player(id: $id) { id } }`}, `variable "$id" of non-null type was not provided`},
+		{"mutation", Request{Query: `mutation { player(id: "1") { id } }`}, "mutation operations are not supported"},
+		{"fragment cycle", Request{Query: `{ player(id: "1") { ...A } } fragment A on Player { friends { ...A } }`}, `fragment "A" spreads itself`},
+		{"self spread", Request{Query: `{ player(id: "1") { ...A } } fragment A on Player { ...A }`}, `fragment "A" spreads itself`},
+		{"too deep", Request{Query: `{ player(id: "1") { friends { friends { friends { id } } } } }`}, "maximum depth of 4"},
+		{"ambiguous operation", Request{Query: `query A { stats { count } } query B { stats { count } }`}, "operation name is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, result := execute(t, context.Background(), tt.req)
+
+			assert.Nil(t, result.Data)
+			require.Len(t, result.Errors, 1)
+			assert.Contains(t, result.Errors[0].Message, tt.message)
+		})
+	}
+}
+
+func TestExecute_ArgumentErrors(t *testing.T) {
+	_, result := execute(t, context.Background(), Request{
+		Query:     `query($id: ID) { player(id: $id) { id } stats { count } }`,
+		Variables: map[string]any{"id": true},
+	})
+
+	assert.JSONEq(t, `{"player":null,"stats":{"count":2}}`, mustJSON(t, result.Data))
+	require.Len(t, result.Errors, 1)
+	assert.Contains(t, result.Errors[0].Message, `argument "id"`)
+}
+
+func TestSchema_SDL(t *testing.T) {
+	sdl := testSchema().SDL()
+
+	assert.Contains(t, sdl, "schema {\n  query: Query\n}")
+	assert.Contains(t, sdl, "directive @auth(permission: String!) on FIELD_DEFINITION")
+	assert.Contains(t, sdl, "type Player {\n  broken: String!\n  email: String @auth(permission: \"players:pii\")\n  friends(limit: Int = 10): [Player!]\n")
+	assert.Contains(t, sdl, "  player(id: ID!): Player\n")
+	assert.NotContains(t, sdl, "scalar String")
+}
+
+func mustJSON(t *testing.T, v any) string {
+	t.Helper()
+	body, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(body)
+}
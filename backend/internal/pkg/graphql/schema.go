@@ -0,0 +1,334 @@
+// Package graphql is a small GraphQL query executor for schemas defined in Go.
+//
+// It supports the query subset admin tooling needs: operations with variables,
+// aliases, named and inline fragments, @include/@skip and __typename. There are no
+// mutations, subscriptions, interfaces or introspection; Schema.SDL renders the
+// schema for client tooling instead. Every field may name a permission that the
+// schema's Authorize hook must grant before the field resolves, so one query can
+// mix data the caller may and may not see.
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Type is an output or argument type: *Scalar, *Object, *List or *NonNull
+type Type interface {
+	String() string
+}
+
+// Scalar is a leaf type
+type Scalar struct {
+	Name        string
+	Description string
+
+	// Serialize converts a resolved Go value to its JSON representation
+	Serialize func(v any) (any, error)
+
+	// Parse converts an argument literal or variable value to the Go value resolvers receive
+	Parse func(v any) (any, error)
+}
+
+func (s *Scalar) String() string { return s.Name }
+
+// Object is a type with fields
+type Object struct {
+	Name        string
+	Description string
+	Fields      Fields
+}
+
+func (o *Object) String() string { return o.Name }
+
+// Fields maps field names to their definitions
+type Fields map[string]*Field
+
+// Field is a field of an object type
+type Field struct {
+	Type        Type
+	Description string
+	Args        Args
+
+	// Permission, when set, must be granted by Schema.Authorize before the field resolves
+	Permission string
+
+	// Resolve produces the field's value from its parent's; nil reads Source as a map by field name
+	Resolve ResolveFunc
+}
+
+// Args maps argument names to their definitions
+type Args map[string]*Arg
+
+// Arg is a field argument
+type Arg struct {
+	Type        Type
+	Default     any
+	Description string
+}
+
+// List is a list of another type
+type List struct {
+	OfType Type
+}
+
+func (l *List) String() string { return "[" + l.OfType.String() + "]" }
+
+// NonNull marks a type that is never null
+type NonNull struct {
+	OfType Type
+}
+
+func (n *NonNull) String() string { return n.OfType.String() + "!" }
+
+// NewList returns a list of t
+func NewList(t Type) *List { return &List{OfType: t} }
+
+// NewNonNull returns a non-null t
+func NewNonNull(t Type) *NonNull { return &NonNull{OfType: t} }
+
+// ResolveParams is passed to a field resolver
+type ResolveParams struct {
+	Context context.Context
+	Source  any            // Resolved value of the parent object
+	Args    map[string]any // Coerced arguments, with defaults applied
+}
+
+// ResolveFunc resolves a field's value
+type ResolveFunc func(p ResolveParams) (any, error)
+
+// Schema is an executable GraphQL schema
+type Schema struct {
+	Query *Object
+
+	// Authorize reports whether the request in ctx may read fields guarded by permission
+	// A nil Authorize denies every guarded field.
+	Authorize func(ctx context.Context, permission string) bool
+
+	// MaxDepth limits selection nesting; 0 means unlimited
+	MaxDepth int
+}
+
+// SDL renders the schema in GraphQL schema definition language
+func (s *Schema) SDL() string {
+	types := make(map[string]Type)
+	var collect func(t Type)
+	collect = func(t Type) {
+		switch t := t.(type) {
+		case *List:
+			collect(t.OfType)
+		case *NonNull:
+			collect(t.OfType)
+		case *Scalar:
+			types[t.Name] = t
+		case *Object:
+			if _, seen := types[t.Name]; seen {
+				return
+			}
+			types[t.Name] = t
+			for _, f := range t.Fields {
+				collect(f.Type)
+				for _, a := range f.Args {
+					collect(a.Type)
+				}
+			}
+		}
+	}
+	collect(s.Query)
+
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "schema {\n  query: %s\n}\n", s.Query.Name)
+	b.WriteString("\n\"Fields that resolve only when the caller holds the permission\"\n")
+	b.WriteString("directive @auth(permission: String!) on FIELD_DEFINITION\n")
+	for _, name := range names {
+		switch t := types[name].(type) {
+		case *Scalar:
+			if isBuiltinScalar(t) {
+				continue
+			}
+			b.WriteString("\n")
+			writeDescription(&b, "", t.Description)
+			fmt.Fprintf(&b, "scalar %s\n", t.Name)
+		case *Object:
+			b.WriteString("\n")
+			writeDescription(&b, "", t.Description)
+			fmt.Fprintf(&b, "type %s {\n", t.Name)
+			for _, fieldName := range sortedKeys(t.Fields) {
+				f := t.Fields[fieldName]
+				writeDescription(&b, "  ", f.Description)
+				fmt.Fprintf(&b, "  %s%s: %s", fieldName, renderArgs(f.Args), f.Type)
+				if f.Permission != "" {
+					fmt.Fprintf(&b, " @auth(permission: %q)", f.Permission)
+				}
+				b.WriteString("\n")
+			}
+			b.WriteString("}\n")
+		}
+	}
+	return b.String()
+}
+
+func renderArgs(args Args) string {
+	if len(args) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(args))
+	for _, name := range sortedKeys(args) {
+		a := args[name]
+		part := name + ": " + a.Type.String()
+		if a.Default != nil {
+			part += " = " + renderLiteral(a.Default)
+		}
+		parts = append(parts, part)
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+func renderLiteral(v any) string {
+	if s, ok := v.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprint(v)
+}
+
+func writeDescription(b *strings.Builder, indent, description string) {
+	if description != "" {
+		fmt.Fprintf(b, "%s\"%s\"\n", indent, strings.ReplaceAll(description, `"`, `\"`))
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Built-in scalars
+var (
+	String = &Scalar{
+		Name:      "String",
+		Serialize: serializeString,
+		Parse: func(v any) (any, error) {
+			if s, ok := v.(string); ok {
+				return s, nil
+			}
+			return nil, fmt.Errorf("String cannot represent %v", v)
+		},
+	}
+
+	Int = &Scalar{
+		Name: "Int",
+		Serialize: func(v any) (any, error) {
+			rv := reflect.ValueOf(v)
+			switch rv.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				return rv.Int(), nil
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				return rv.Uint(), nil
+			}
+			return nil, fmt.Errorf("Int cannot represent %T", v)
+		},
+		Parse: func(v any) (any, error) {
+			switch n := v.(type) {
+			case int64:
+				return int(n), nil
+			case int:
+				return n, nil
+			case float64: // JSON variables decode numbers as float64
+				if n == float64(int(n)) {
+					return int(n), nil
+				}
+			}
+			return nil, fmt.Errorf("Int cannot represent %v", v)
+		},
+	}
+
+	Float = &Scalar{
+		Name: "Float",
+		Serialize: func(v any) (any, error) {
+			rv := reflect.ValueOf(v)
+			switch rv.Kind() {
+			case reflect.Float32, reflect.Float64:
+				return rv.Float(), nil
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				return float64(rv.Int()), nil
+			}
+			return nil, fmt.Errorf("Float cannot represent %T", v)
+		},
+		Parse: func(v any) (any, error) {
+			switch n := v.(type) {
+			case float64:
+				return n, nil
+			case int64:
+				return float64(n), nil
+			case int:
+				return float64(n), nil
+			}
+			return nil, fmt.Errorf("Float cannot represent %v", v)
+		},
+	}
+
+	Boolean = &Scalar{
+		Name: "Boolean",
+		Serialize: func(v any) (any, error) {
+			if b, ok := v.(bool); ok {
+				return b, nil
+			}
+			return nil, fmt.Errorf("Boolean cannot represent %T", v)
+		},
+		Parse: func(v any) (any, error) {
+			if b, ok := v.(bool); ok {
+				return b, nil
+			}
+			return nil, fmt.Errorf("Boolean cannot represent %v", v)
+		},
+	}
+
+	ID = &Scalar{
+		Name:      "ID",
+		Serialize: serializeString,
+		Parse: func(v any) (any, error) {
+			switch id := v.(type) {
+			case string:
+				return id, nil
+			case int64:
+				return fmt.Sprint(id), nil
+			case float64:
+				if id == float64(int64(id)) {
+					return fmt.Sprint(int64(id)), nil
+				}
+			}
+			return nil, fmt.Errorf("ID cannot represent %v", v)
+		},
+	}
+)
+
+// serializeString accepts strings, named string types and Stringers such as uuid.UUID
+func serializeString(v any) (any, error) {
+	switch s := v.(type) {
+	case string:
+		return s, nil
+	case fmt.Stringer:
+		return s.String(), nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.String {
+		return rv.String(), nil
+	}
+	return nil, fmt.Errorf("cannot represent %T as a string", v)
+}
+
+func isBuiltinScalar(s *Scalar) bool {
+	return s == String || s == Int || s == Float || s == Boolean || s == ID
+}
@@ -0,0 +1,15 @@
+package i18n
+
+import (
+	"time"
+
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// NewFromConfig creates the translator with admin bundles kept in asset storage
+func NewFromConfig(cfg *config.Config, s storage.Storage, log *logger.Logger) (*Translator, error) {
+	refresh := time.Duration(cfg.I18n.RefreshSeconds) * time.Second
+	return NewTranslator(cfg.I18n.DefaultLocale, NewStorageStore(s), refresh, log)
+}
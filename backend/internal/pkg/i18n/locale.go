@@ -0,0 +1,69 @@
+package i18n
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+
+// NormalizeLocale returns the canonical form of a locale tag (e.g. "pt_br" -> "pt-BR"), or "" if invalid
+func NormalizeLocale(tag string) string {
+	tag = strings.ReplaceAll(strings.TrimSpace(tag), "_", "-")
+	lang, region, hasRegion := strings.Cut(tag, "-")
+	tag = strings.ToLower(lang)
+	if hasRegion {
+		tag += "-" + strings.ToUpper(region)
+	}
+	if !localePattern.MatchString(tag) {
+		return ""
+	}
+	return tag
+}
+
+// language returns the language part of a normalized locale
+func language(locale string) string {
+	lang, _, _ := strings.Cut(locale, "-")
+	return lang
+}
+
+// Negotiate picks the best supported locale for an Accept-Language header
+// Tags are tried by quality; each matches exactly, then by language. Returns fallback when nothing matches.
+func Negotiate(acceptLanguage string, supported []string, fallback string) string {
+	type candidate struct {
+		locale string
+		q      float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if locale := NormalizeLocale(tag); locale != "" && q > 0 {
+			candidates = append(candidates, candidate{locale: locale, q: q})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+
+	for _, c := range candidates {
+		for _, s := range supported {
+			if s == c.locale {
+				return s
+			}
+		}
+		for _, s := range supported {
+			if language(s) == language(c.locale) {
+				return s
+			}
+		}
+	}
+	return fallback
+}
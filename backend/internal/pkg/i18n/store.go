@@ -0,0 +1,87 @@
+package i18n
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/slotmachine/backend/internal/infra/storage"
+)
+
+// bundleFolder is the storage folder holding one <locale>.json bundle per locale, next to the game asset folders
+const bundleFolder = "i18n"
+
+// Store persists admin-managed translation bundles
+type Store interface {
+	All(ctx context.Context) (map[string]Bundle, error)
+	Put(ctx context.Context, locale string, bundle Bundle) error
+	Delete(ctx context.Context, locale string) error
+}
+
+// StorageStore keeps bundles in asset storage
+type StorageStore struct {
+	storage storage.Storage
+}
+
+// NewStorageStore creates a new bundle store
+func NewStorageStore(s storage.Storage) *StorageStore {
+	return &StorageStore{storage: s}
+}
+
+// All loads every stored bundle by locale
+func (s *StorageStore) All(ctx context.Context) (map[string]Bundle, error) {
+	files, err := s.storage.ListFiles(ctx, bundleFolder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list translation bundles: %w", err)
+	}
+
+	bundles := make(map[string]Bundle, len(files))
+	for _, file := range files {
+		locale := NormalizeLocale(strings.TrimSuffix(file.Name, ".json"))
+		if locale == "" || !strings.HasSuffix(file.Name, ".json") {
+			continue
+		}
+		bundle, err := s.load(ctx, file.Name)
+		if err != nil {
+			return nil, err
+		}
+		bundles[locale] = bundle
+	}
+	return bundles, nil
+}
+
+func (s *StorageStore) load(ctx context.Context, fileName string) (Bundle, error) {
+	reader, err := s.storage.DownloadFile(ctx, bundleFolder, fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open translation bundle %s: %w", fileName, err)
+	}
+	defer reader.Close()
+
+	var bundle Bundle
+	if err := json.NewDecoder(reader).Decode(&bundle); err != nil {
+		return nil, fmt.Errorf("failed to decode translation bundle %s: %w", fileName, err)
+	}
+	return bundle, nil
+}
+
+// Put stores a locale's bundle, replacing any previous one
+func (s *StorageStore) Put(ctx context.Context, locale string, bundle Bundle) error {
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode translation bundle: %w", err)
+	}
+	if _, err := s.storage.UploadFile(ctx, bundleFolder, locale+".json", bytes.NewReader(data), int64(len(data)), "application/json"); err != nil {
+		return fmt.Errorf("failed to store translation bundle: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a locale's bundle
+func (s *StorageStore) Delete(ctx context.Context, locale string) error {
+	if err := s.storage.DeleteFile(ctx, bundleFolder, locale+".json"); err != nil {
+		return fmt.Errorf("failed to delete translation bundle: %w", err)
+	}
+	return nil
+}
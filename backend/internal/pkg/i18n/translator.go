@@ -0,0 +1,259 @@
+// Package i18n localizes player-facing strings.
+// Built-in bundles ship with the server; admins add or override translations
+// through bundles kept in asset storage.
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+//go:embed locales/*.json
+var builtinFS embed.FS
+
+// MaxBundleKeys bounds an admin bundle's size
+const MaxBundleKeys = 2000
+
+var (
+	ErrBundleNotFound = errors.New("translation bundle not found")
+	ErrInvalidBundle  = errors.New("invalid translation bundle")
+)
+
+// Bundle maps message keys to translated text
+// Text may contain {name} placeholders filled from Translate args.
+type Bundle map[string]string
+
+// LocaleInfo summarizes a locale's bundles
+type LocaleInfo struct {
+	Locale  string `json:"locale"`
+	Keys    int    `json:"keys"`    // Keys available after merging
+	Builtin bool   `json:"builtin"` // Whether the server ships a bundle for the locale
+	Stored  bool   `json:"stored"`  // Whether an admin bundle exists
+}
+
+// Translator resolves message keys per locale
+// Stored bundles override built-in ones key by key and are reloaded every refresh interval.
+type Translator struct {
+	defaultLocale string
+	builtin       map[string]Bundle
+	store         Store
+	refresh       time.Duration
+	logger        *logger.Logger
+	now           func() time.Time
+
+	mu       sync.RWMutex
+	merged   map[string]Bundle
+	stored   map[string]Bundle
+	loadedAt time.Time
+}
+
+// NewTranslator creates a new translator with the built-in bundles
+func NewTranslator(defaultLocale string, store Store, refresh time.Duration, log *logger.Logger) (*Translator, error) {
+	locale := NormalizeLocale(defaultLocale)
+	if locale == "" {
+		return nil, fmt.Errorf("%w: default locale %q", ErrInvalidBundle, defaultLocale)
+	}
+	builtin, err := loadBuiltin()
+	if err != nil {
+		return nil, err
+	}
+	return &Translator{
+		defaultLocale: locale,
+		builtin:       builtin,
+		store:         store,
+		refresh:       refresh,
+		logger:        log,
+		now:           time.Now,
+	}, nil
+}
+
+func loadBuiltin() (map[string]Bundle, error) {
+	files, err := builtinFS.ReadDir("locales")
+	if err != nil {
+		return nil, err
+	}
+	bundles := make(map[string]Bundle, len(files))
+	for _, file := range files {
+		data, err := builtinFS.ReadFile(path.Join("locales", file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var bundle Bundle
+		if err := json.Unmarshal(data, &bundle); err != nil {
+			return nil, fmt.Errorf("failed to decode built-in bundle %s: %w", file.Name(), err)
+		}
+		bundles[NormalizeLocale(strings.TrimSuffix(file.Name(), ".json"))] = bundle
+	}
+	return bundles, nil
+}
+
+// DefaultLocale returns the locale used when negotiation finds no match
+func (t *Translator) DefaultLocale() string {
+	return t.defaultLocale
+}
+
+// Negotiate picks the best available locale for an Accept-Language header
+func (t *Translator) Negotiate(ctx context.Context, acceptLanguage string) string {
+	if acceptLanguage == "" {
+		return t.defaultLocale
+	}
+	merged := t.load(ctx)
+	supported := make([]string, 0, len(merged))
+	for locale := range merged {
+		supported = append(supported, locale)
+	}
+	sort.Strings(supported)
+	return Negotiate(acceptLanguage, supported, t.defaultLocale)
+}
+
+// Lookup returns the locale's text for a key without falling back to the default locale
+func (t *Translator) Lookup(ctx context.Context, locale, key string) (string, bool) {
+	text, ok := t.load(ctx)[locale][key]
+	return text, ok
+}
+
+// Translate returns the text for a key in the locale, falling back to the default locale, then to fallback
+func (t *Translator) Translate(ctx context.Context, locale, key, fallback string, args map[string]string) string {
+	merged := t.load(ctx)
+	text, ok := merged[locale][key]
+	if !ok {
+		text, ok = merged[t.defaultLocale][key]
+	}
+	if !ok {
+		text = fallback
+	}
+	for name, value := range args {
+		text = strings.ReplaceAll(text, "{"+name+"}", value)
+	}
+	return text
+}
+
+// Bundle returns a locale's merged bundle
+func (t *Translator) Bundle(ctx context.Context, locale string) (Bundle, error) {
+	bundle, ok := t.load(ctx)[locale]
+	if !ok {
+		return nil, ErrBundleNotFound
+	}
+	return bundle, nil
+}
+
+// StoredBundle returns a locale's admin bundle
+func (t *Translator) StoredBundle(ctx context.Context, locale string) (Bundle, error) {
+	t.load(ctx)
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	bundle, ok := t.stored[locale]
+	if !ok {
+		return nil, ErrBundleNotFound
+	}
+	return bundle, nil
+}
+
+// Locales lists every available locale, by locale
+func (t *Translator) Locales(ctx context.Context) []LocaleInfo {
+	merged := t.load(ctx)
+	t.mu.RLock()
+	stored := t.stored
+	t.mu.RUnlock()
+
+	infos := make([]LocaleInfo, 0, len(merged))
+	for locale, bundle := range merged {
+		_, builtin := t.builtin[locale]
+		_, hasStored := stored[locale]
+		infos = append(infos, LocaleInfo{Locale: locale, Keys: len(bundle), Builtin: builtin, Stored: hasStored})
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Locale < infos[j].Locale })
+	return infos
+}
+
+// PutBundle stores an admin bundle for a locale, replacing the previous one
+func (t *Translator) PutBundle(ctx context.Context, locale string, bundle Bundle) (string, error) {
+	normalized := NormalizeLocale(locale)
+	if normalized == "" {
+		return "", fmt.Errorf("%w: locale %q", ErrInvalidBundle, locale)
+	}
+	if len(bundle) == 0 || len(bundle) > MaxBundleKeys {
+		return "", fmt.Errorf("%w: bundle must have 1-%d keys", ErrInvalidBundle, MaxBundleKeys)
+	}
+	for key := range bundle {
+		if strings.TrimSpace(key) == "" {
+			return "", fmt.Errorf("%w: empty key", ErrInvalidBundle)
+		}
+	}
+
+	if err := t.store.Put(ctx, normalized, bundle); err != nil {
+		return "", err
+	}
+	t.invalidate()
+	return normalized, nil
+}
+
+// DeleteBundle removes a locale's admin bundle, restoring its built-in text
+func (t *Translator) DeleteBundle(ctx context.Context, locale string) error {
+	if _, err := t.StoredBundle(ctx, locale); err != nil {
+		return err
+	}
+	if err := t.store.Delete(ctx, locale); err != nil {
+		return err
+	}
+	t.invalidate()
+	return nil
+}
+
+// load returns the merged bundles, reloading stored bundles once stale
+// A failed reload keeps the previous bundles.
+func (t *Translator) load(ctx context.Context) map[string]Bundle {
+	t.mu.RLock()
+	merged, loadedAt := t.merged, t.loadedAt
+	t.mu.RUnlock()
+	if merged != nil && t.now().Sub(loadedAt) < t.refresh {
+		return merged
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.merged != nil && t.now().Sub(t.loadedAt) < t.refresh {
+		return t.merged
+	}
+	stored, err := t.store.All(ctx)
+	if err != nil {
+		t.logger.Warn().Err(err).Msg("Failed to reload translation bundles")
+		stored = t.stored
+	}
+	t.stored = stored
+	t.merged = merge(t.builtin, stored)
+	t.loadedAt = t.now()
+	return t.merged
+}
+
+func (t *Translator) invalidate() {
+	t.mu.Lock()
+	t.merged = nil
+	t.mu.Unlock()
+}
+
+// merge layers stored bundles over built-in ones key by key
+func merge(builtin, stored map[string]Bundle) map[string]Bundle {
+	merged := make(map[string]Bundle, len(builtin)+len(stored))
+	for _, layer := range []map[string]Bundle{builtin, stored} {
+		for locale, bundle := range layer {
+			if merged[locale] == nil {
+				merged[locale] = make(Bundle, len(bundle))
+			}
+			for key, text := range bundle {
+				merged[locale][key] = text
+			}
+		}
+	}
+	return merged
+}
@@ -0,0 +1,123 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	bundles map[string]Bundle
+	err     error
+}
+
+func (m *memoryStore) All(context.Context) (map[string]Bundle, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	bundles := make(map[string]Bundle, len(m.bundles))
+	for locale, bundle := range m.bundles {
+		bundles[locale] = bundle
+	}
+	return bundles, nil
+}
+
+func (m *memoryStore) Put(_ context.Context, locale string, bundle Bundle) error {
+	m.bundles[locale] = bundle
+	return nil
+}
+
+func (m *memoryStore) Delete(_ context.Context, locale string) error {
+	delete(m.bundles, locale)
+	return nil
+}
+
+func newTestTranslator(t *testing.T, store *memoryStore) *Translator {
+	translator, err := NewTranslator("en", store, time.Minute, logger.New("error", "json"))
+	require.NoError(t, err)
+	return translator
+}
+
+func TestNegotiate(t *testing.T) {
+	supported := []string{"de", "en", "pt-BR"}
+
+	assert.Equal(t, "de", Negotiate("de-AT,de;q=0.9,en;q=0.8", supported, "en"))
+	assert.Equal(t, "en", Negotiate("fr-FR, en;q=0.5", supported, "en"))
+	assert.Equal(t, "pt-BR", Negotiate("pt", supported, "en"))
+	assert.Equal(t, "de", Negotiate("en;q=0.2, de;q=0.8", supported, "en"))
+	assert.Equal(t, "en", Negotiate("ja", supported, "en"))
+	assert.Equal(t, "en", Negotiate("de;q=0", supported, "en"))
+}
+
+func TestNormalizeLocale(t *testing.T) {
+	assert.Equal(t, "pt-BR", NormalizeLocale("pt_br"))
+	assert.Equal(t, "en", NormalizeLocale(" EN "))
+	assert.Equal(t, "", NormalizeLocale("../etc"))
+	assert.Equal(t, "", NormalizeLocale(""))
+}
+
+func TestTranslator_Translate(t *testing.T) {
+	ctx := context.Background()
+	store := &memoryStore{bundles: map[string]Bundle{
+		"de": {"reality_check.message": "Sie spielen seit {minutes} Minuten."},
+	}}
+	translator := newTestTranslator(t, store)
+
+	args := map[string]string{"minutes": "60"}
+	assert.Equal(t, "Sie spielen seit 60 Minuten.", translator.Translate(ctx, "de", "reality_check.message", "", args))
+	assert.Contains(t, translator.Translate(ctx, "fr", "reality_check.message", "", args), "playing for 60 minutes")
+	assert.Equal(t, "fallback", translator.Translate(ctx, "de", "missing.key", "fallback", nil))
+
+	_, ok := translator.Lookup(ctx, "de", "bonus.sticky_wilds")
+	assert.False(t, ok, "lookup must not fall back to the default locale")
+	assert.Equal(t, "de", translator.Negotiate(ctx, "de-DE"))
+}
+
+func TestTranslator_Bundles(t *testing.T) {
+	ctx := context.Background()
+	store := &memoryStore{bundles: map[string]Bundle{}}
+	translator := newTestTranslator(t, store)
+
+	locale, err := translator.PutBundle(ctx, "en_gb", Bundle{"bonus.sticky_wilds": "Sticky!"})
+	require.NoError(t, err)
+	assert.Equal(t, "en-GB", locale)
+	assert.Equal(t, "Sticky!", translator.Translate(ctx, "en-GB", "bonus.sticky_wilds", "", nil))
+
+	_, err = translator.PutBundle(ctx, "en", Bundle{"bonus.sticky_wilds": "Sticky wilds"})
+	require.NoError(t, err)
+	bundle, err := translator.Bundle(ctx, "en")
+	require.NoError(t, err)
+	assert.Equal(t, "Sticky wilds", bundle["bonus.sticky_wilds"])
+	assert.Contains(t, bundle, "bonus.expanding_wilds", "stored keys override built-in keys one by one")
+
+	_, err = translator.PutBundle(ctx, "not a locale", Bundle{"k": "v"})
+	assert.ErrorIs(t, err, ErrInvalidBundle)
+	_, err = translator.PutBundle(ctx, "fr", Bundle{})
+	assert.ErrorIs(t, err, ErrInvalidBundle)
+
+	require.NoError(t, translator.DeleteBundle(ctx, "en-GB"))
+	assert.ErrorIs(t, translator.DeleteBundle(ctx, "en-GB"), ErrBundleNotFound)
+	_, err = translator.Bundle(ctx, "en-GB")
+	assert.ErrorIs(t, err, ErrBundleNotFound)
+}
+
+func TestTranslator_ReloadFailureKeepsBundles(t *testing.T) {
+	ctx := context.Background()
+	store := &memoryStore{bundles: map[string]Bundle{"de": {"k": "v"}}}
+	translator := newTestTranslator(t, store)
+
+	now := time.Now()
+	translator.now = func() time.Time { return now }
+	_, ok := translator.Lookup(ctx, "de", "k")
+	require.True(t, ok)
+
+	store.err = errors.New("storage down")
+	now = now.Add(2 * time.Minute)
+	_, ok = translator.Lookup(ctx, "de", "k")
+	assert.True(t, ok)
+}
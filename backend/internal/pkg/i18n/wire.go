@@ -0,0 +1,8 @@
+package i18n
+
+import "github.com/google/wire"
+
+// ProviderSet is the Wire provider set for localization
+var ProviderSet = wire.NewSet(
+	NewFromConfig,
+)
@@ -42,6 +42,21 @@ func New(level, format string) *Logger {
 	}
 }
 
+// SetLevel changes the level of every logger at runtime
+func SetLevel(level string) error {
+	logLevel, err := zerolog.ParseLevel(strings.ToLower(level))
+	if err != nil {
+		return err
+	}
+	zerolog.SetGlobalLevel(logLevel)
+	return nil
+}
+
+// Level returns the level every logger currently logs at
+func Level() string {
+	return zerolog.GlobalLevel().String()
+}
+
 // Info returns a zerolog event for info logging (supports chaining)
 func (l *Logger) Info() *zerolog.Event {
 	return l.logger.Info()
@@ -0,0 +1,173 @@
+// Package pdf writes simple text-only PDF documents: A4 pages of Helvetica lines and
+// table rows, enough for statements and reports without a third-party dependency.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	pageWidth  = 595.0 // A4 in points
+	pageHeight = 842.0
+	margin     = 50.0
+
+	titleSize = 16.0
+	textSize  = 10.0
+)
+
+// text is one positioned run of text on a page
+type text struct {
+	x, y float64
+	size float64
+	bold bool
+	s    string
+}
+
+// Document accumulates lines and flows them onto as many pages as needed
+type Document struct {
+	pages [][]text
+	y     float64 // Baseline of the next line on the last page
+}
+
+// New creates an empty document
+func New() *Document {
+	d := &Document{}
+	d.newPage()
+	return d
+}
+
+// Title adds a heading line
+func (d *Document) Title(s string) {
+	d.add(titleSize, true, text{x: margin, s: s})
+}
+
+// Line adds a line of body text
+func (d *Document) Line(s string) {
+	d.add(textSize, false, text{x: margin, s: s})
+}
+
+// BoldLine adds a line of bold body text
+func (d *Document) BoldLine(s string) {
+	d.add(textSize, true, text{x: margin, s: s})
+}
+
+// Row adds a table row, placing each cell at the matching offset from the left margin
+func (d *Document) Row(offsets []float64, cells ...string) {
+	d.row(false, offsets, cells)
+}
+
+// HeaderRow adds a bold table row, placing each cell at the matching offset from the left margin
+func (d *Document) HeaderRow(offsets []float64, cells ...string) {
+	d.row(true, offsets, cells)
+}
+
+// Gap adds half a line of vertical space
+func (d *Document) Gap() {
+	d.y -= textSize * 0.7
+}
+
+// Pages returns the number of pages written so far
+func (d *Document) Pages() int {
+	return len(d.pages)
+}
+
+func (d *Document) row(bold bool, offsets []float64, cells []string) {
+	runs := make([]text, 0, len(cells))
+	for i, cell := range cells {
+		if i >= len(offsets) {
+			break
+		}
+		runs = append(runs, text{x: margin + offsets[i], s: cell})
+	}
+	d.add(textSize, bold, runs...)
+}
+
+// add places runs on a new line, starting a page when the current one is full
+func (d *Document) add(size float64, bold bool, runs ...text) {
+	if d.y-size < margin {
+		d.newPage()
+	}
+	d.y -= size
+	page := len(d.pages) - 1
+	for _, r := range runs {
+		r.y, r.size, r.bold = d.y, size, bold
+		d.pages[page] = append(d.pages[page], r)
+	}
+	d.y -= size * 0.4
+}
+
+func (d *Document) newPage() {
+	d.pages = append(d.pages, nil)
+	d.y = pageHeight - margin
+}
+
+// WriteTo writes the document as a PDF 1.4 file
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// Objects 1-4 are fixed; each page then takes a page object and a content stream
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 6+2*i))
+		content := pageContent(page)
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	n, err := w.Write(buf.Bytes())
+	return int64(n), err
+}
+
+// pageContent renders a page's runs as a content stream
+func pageContent(runs []text) string {
+	var b strings.Builder
+	for _, r := range runs {
+		font := "F1"
+		if r.bold {
+			font = "F2"
+		}
+		fmt.Fprintf(&b, "BT /%s %.0f Tf %.2f %.2f Td (%s) Tj ET\n", font, r.size, r.x, r.y, escape(r.s))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// escape makes s safe inside a PDF string literal; characters outside printable ASCII become '?'
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
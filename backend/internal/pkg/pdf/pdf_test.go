@@ -0,0 +1,48 @@
+package pdf
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument(t *testing.T) {
+	doc := New()
+	doc.Title("Statement (June)")
+	doc.HeaderRow([]float64{0, 100}, "Date", "Net")
+	for i := 0; i < 100; i++ {
+		doc.Row([]float64{0, 100}, "2026-06-01", strconv.Itoa(i))
+	}
+	doc.Line("Café \\ done")
+	require.Greater(t, doc.Pages(), 1, "long documents flow onto more pages")
+
+	var buf bytes.Buffer
+	_, err := doc.WriteTo(&buf)
+	require.NoError(t, err)
+	out := buf.String()
+
+	assert.True(t, strings.HasPrefix(out, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(out, "%%EOF\n"))
+	assert.Contains(t, out, "/Count "+strconv.Itoa(doc.Pages()))
+	assert.Contains(t, out, `(Statement \(June\))`, "parentheses are escaped")
+	assert.Contains(t, out, `(Caf? \\ done)`, "non-ASCII is replaced and backslashes escaped")
+
+	// Every xref entry points at the start of its object
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(out)
+	require.Len(t, startxref, 2)
+	xref, err := strconv.Atoi(startxref[1])
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(out[xref:], "xref\n"))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(out[xref:], -1)
+	require.Len(t, entries, 4+2*doc.Pages())
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(entry[1])
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(out[offset:], strconv.Itoa(i+1)+" 0 obj\n"), "object %d", i+1)
+	}
+}
@@ -0,0 +1,75 @@
+package runtimeconfig
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/slotmachine/backend/internal/pkg/featureflags"
+)
+
+// ErrInvalidSettings is returned for a runtime config file that can't be applied
+var ErrInvalidSettings = errors.New("invalid runtime settings")
+
+// Settings are the settings that can change without a restart
+// A setting left out of the runtime config file keeps its boot value from the environment.
+type Settings struct {
+	// LogLevel is the zerolog level every logger logs at (e.g. "debug", "info", "warn")
+	LogLevel string `yaml:"log_level" json:"log_level"`
+	// RateLimit holds the default per-path request limits; segment policies still override them
+	RateLimit RateLimit `yaml:"rate_limit" json:"rate_limit"`
+	// FeatureFlags replaces FEATURE_FLAGS ("name=percentage,..."); admin overrides still win
+	FeatureFlags string `yaml:"feature_flags" json:"feature_flags"`
+	// RealityCheckMinutes overrides the reality check interval of jurisdictions, by code
+	RealityCheckMinutes map[string]int `yaml:"reality_check_minutes" json:"reality_check_minutes,omitempty"`
+}
+
+// RateLimit holds request limits per second, per path
+type RateLimit struct {
+	AuthRPS   int `yaml:"auth_rps" json:"auth_rps"`
+	PublicRPS int `yaml:"public_rps" json:"public_rps"`
+}
+
+// Validate checks that every setting the file sets can be applied
+func (s *Settings) Validate() error {
+	if s.LogLevel != "" {
+		if _, err := zerolog.ParseLevel(strings.ToLower(s.LogLevel)); err != nil {
+			return fmt.Errorf("%w: unknown log_level %q", ErrInvalidSettings, s.LogLevel)
+		}
+	}
+	if s.RateLimit.AuthRPS < 0 || s.RateLimit.PublicRPS < 0 {
+		return fmt.Errorf("%w: rate limits must not be negative", ErrInvalidSettings)
+	}
+	if s.FeatureFlags != "" {
+		if _, err := featureflags.ParseDefaults(s.FeatureFlags); err != nil {
+			return fmt.Errorf("%w: feature_flags: %v", ErrInvalidSettings, err)
+		}
+	}
+	for code, minutes := range s.RealityCheckMinutes {
+		if minutes < 0 {
+			return fmt.Errorf("%w: reality check interval of %s must not be negative", ErrInvalidSettings, code)
+		}
+	}
+	return nil
+}
+
+// merge returns s with every setting file sets
+func (s Settings) merge(file Settings) Settings {
+	if file.LogLevel != "" {
+		s.LogLevel = strings.ToLower(file.LogLevel)
+	}
+	if file.RateLimit.AuthRPS > 0 {
+		s.RateLimit.AuthRPS = file.RateLimit.AuthRPS
+	}
+	if file.RateLimit.PublicRPS > 0 {
+		s.RateLimit.PublicRPS = file.RateLimit.PublicRPS
+	}
+	if file.FeatureFlags != "" {
+		s.FeatureFlags = file.FeatureFlags
+	}
+	if file.RealityCheckMinutes != nil {
+		s.RealityCheckMinutes = file.RealityCheckMinutes
+	}
+	return s
+}
@@ -0,0 +1,180 @@
+package runtimeconfig
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"gopkg.in/yaml.v3"
+)
+
+// State is the runtime config in effect
+type State struct {
+	Settings Settings   `json:"settings"`           // Effective settings: boot values with the file applied
+	File     string     `json:"file,omitempty"`     // Runtime config file watched, empty when hot reload is disabled
+	Checksum string     `json:"checksum,omitempty"` // SHA-256 of the file version applied
+	LoadedAt *time.Time `json:"loaded_at,omitempty"`
+	Error    string     `json:"error,omitempty"` // Why the latest file version was not (fully) applied
+}
+
+type applier struct {
+	name  string
+	apply func(Settings) error
+}
+
+// Watcher polls a YAML (or JSON) runtime config file and applies changes without a restart
+// Every instance watches its own copy, e.g. a mounted ConfigMap. A file that fails to parse
+// or validate is rejected whole and the previous settings stay in effect; a missing file
+// restores the boot settings.
+type Watcher struct {
+	path     string
+	interval time.Duration
+	boot     Settings
+	logger   *logger.Logger
+
+	appliers []applier
+
+	mu    sync.RWMutex
+	state State
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewWatcher creates a new runtime config watcher; an empty path disables hot reload
+func NewWatcher(path string, interval time.Duration, boot Settings, log *logger.Logger) *Watcher {
+	return &Watcher{
+		path:     path,
+		interval: interval,
+		boot:     boot,
+		logger:   log,
+		state:    State{Settings: boot, File: path},
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Register adds a component that applies the effective settings whenever they change
+func (w *Watcher) Register(name string, apply func(Settings) error) {
+	w.appliers = append(w.appliers, applier{name: name, apply: apply})
+}
+
+// State returns the runtime config in effect
+func (w *Watcher) State() State {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.state
+}
+
+// Start applies the file once, then polls it in the background; no file or a zero interval disables it
+func (w *Watcher) Start() {
+	if w.path == "" || w.interval <= 0 {
+		close(w.done)
+		w.logger.Info().Msg("Runtime config hot reload disabled")
+		return
+	}
+
+	w.Reload()
+	go w.run()
+	w.logger.Info().Str("file", w.path).Dur("interval", w.interval).Msg("Runtime config watcher started")
+}
+
+// Stop stops the watcher and waits for a running reload to finish
+func (w *Watcher) Stop() {
+	w.stopOnce.Do(func() { close(w.stop) })
+	<-w.done
+}
+
+func (w *Watcher) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			w.Reload()
+		}
+	}
+}
+
+// Reload reads the file and applies it when it changed since the last reload
+func (w *Watcher) Reload() {
+	data, err := os.ReadFile(w.path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		w.reject(fmt.Errorf("failed to read runtime config: %w", err))
+		return
+	}
+
+	checksum := ""
+	if data != nil {
+		sum := sha256.Sum256(data)
+		checksum = hex.EncodeToString(sum[:])
+	}
+	w.mu.RLock()
+	unchanged := w.state.LoadedAt != nil && w.state.Checksum == checksum
+	w.mu.RUnlock()
+	if unchanged {
+		return
+	}
+
+	var file Settings
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		w.reject(fmt.Errorf("%w: %v", ErrInvalidSettings, err))
+		return
+	}
+	if err := file.Validate(); err != nil {
+		w.reject(err)
+		return
+	}
+
+	settings := w.boot.merge(file)
+	var failed []string
+	for _, a := range w.appliers {
+		if err := a.apply(settings); err != nil {
+			w.logger.Error().Err(err).Str("component", a.name).Msg("Failed to apply runtime config")
+			failed = append(failed, fmt.Sprintf("%s: %v", a.name, err))
+		}
+	}
+
+	now := time.Now().UTC()
+	w.mu.Lock()
+	w.state = State{
+		Settings: settings,
+		File:     w.path,
+		Checksum: checksum,
+		LoadedAt: &now,
+		Error:    strings.Join(failed, "; "),
+	}
+	w.mu.Unlock()
+
+	w.logger.Info().
+		Str("checksum", checksum).
+		Str("log_level", settings.LogLevel).
+		Int("auth_rps", settings.RateLimit.AuthRPS).
+		Int("public_rps", settings.RateLimit.PublicRPS).
+		Msg("Applied runtime config")
+}
+
+// reject keeps the settings in effect and records why the file was not applied
+func (w *Watcher) reject(err error) {
+	w.mu.Lock()
+	previous := w.state.Error
+	w.state.Error = err.Error()
+	w.mu.Unlock()
+
+	if previous != err.Error() {
+		w.logger.Error().Err(err).Str("file", w.path).Msg("Rejected runtime config, keeping the settings in effect")
+	}
+}
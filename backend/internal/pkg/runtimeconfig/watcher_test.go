@@ -0,0 +1,74 @@
+package runtimeconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWatcher(t *testing.T) (*Watcher, string, *[]Settings) {
+	path := filepath.Join(t.TempDir(), "runtime.yaml")
+	boot := Settings{LogLevel: "info", RateLimit: RateLimit{AuthRPS: 50, PublicRPS: 50}, FeatureFlags: "wild_features=0"}
+	w := NewWatcher(path, time.Second, boot, logger.New("error", "json"))
+
+	var applied []Settings
+	w.Register("test", func(s Settings) error {
+		applied = append(applied, s)
+		return nil
+	})
+	return w, path, &applied
+}
+
+func TestWatcher_AppliesFileOverBootSettings(t *testing.T) {
+	w, path, applied := newTestWatcher(t)
+
+	// Without a file the boot settings apply
+	w.Reload()
+	require.Len(t, *applied, 1)
+	assert.Equal(t, "info", (*applied)[0].LogLevel)
+
+	require.NoError(t, os.WriteFile(path, []byte("log_level: DEBUG\nrate_limit:\n  public_rps: 20\nreality_check_minutes:\n  UKGC: 30\n"), 0o644))
+	w.Reload()
+	require.Len(t, *applied, 2)
+	state := w.State()
+	assert.Equal(t, "debug", state.Settings.LogLevel)
+	assert.Equal(t, RateLimit{AuthRPS: 50, PublicRPS: 20}, state.Settings.RateLimit, "settings left out keep their boot value")
+	assert.Equal(t, map[string]int{"UKGC": 30}, state.Settings.RealityCheckMinutes)
+	assert.Equal(t, "wild_features=0", state.Settings.FeatureFlags)
+	assert.NotEmpty(t, state.Checksum)
+	assert.Empty(t, state.Error)
+
+	// An unchanged file is not applied again
+	w.Reload()
+	assert.Len(t, *applied, 2)
+
+	// Removing the file restores the boot settings
+	require.NoError(t, os.Remove(path))
+	w.Reload()
+	require.Len(t, *applied, 3)
+	assert.Equal(t, RateLimit{AuthRPS: 50, PublicRPS: 50}, w.State().Settings.RateLimit)
+}
+
+func TestWatcher_RejectsInvalidFileWhole(t *testing.T) {
+	w, path, applied := newTestWatcher(t)
+	require.NoError(t, os.WriteFile(path, []byte("log_level: warn\n"), 0o644))
+	w.Reload()
+	require.Len(t, *applied, 1)
+
+	require.NoError(t, os.WriteFile(path, []byte("log_level: debug\nrate_limit:\n  auth_rps: -1\n"), 0o644))
+	w.Reload()
+	assert.Len(t, *applied, 1, "an invalid file is not applied")
+	state := w.State()
+	assert.Equal(t, "warn", state.Settings.LogLevel, "the previous settings stay in effect")
+	assert.Contains(t, state.Error, "rate limits must not be negative")
+
+	require.NoError(t, os.WriteFile(path, []byte("log_level: verbose\n"), 0o644))
+	w.Reload()
+	assert.ErrorIs(t, (&Settings{LogLevel: "verbose"}).Validate(), ErrInvalidSettings)
+	assert.Contains(t, w.State().Error, "unknown log_level")
+}
@@ -247,6 +247,7 @@ func GetContentType(filename string) string {
 		".jpeg": "image/jpeg",
 		".gif":  "image/gif",
 		".webp": "image/webp",
+		".avif": "image/avif",
 		".svg":  "image/svg+xml",
 		".json": "application/json",
 		".mp3":  "audio/mpeg",
@@ -0,0 +1,57 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the RFC 6238 appendix B SHA-1 test key
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCode_RFC6238Vectors(t *testing.T) {
+	// Appendix B codes are 8 digits; ours are their last 6
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for unix, want := range vectors {
+		code, err := Code(rfcSecret, Step(time.Unix(unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, want, code, unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	now := time.Unix(1_700_000_000, 0)
+
+	code, err := Code(secret, Step(now)-1)
+	require.NoError(t, err)
+	step, ok := Validate(secret, code, now)
+	assert.True(t, ok, "previous step is within skew")
+	assert.Equal(t, Step(now)-1, step)
+
+	code, err = Code(secret, Step(now)-2)
+	require.NoError(t, err)
+	_, ok = Validate(secret, code, now)
+	assert.False(t, ok, "two steps back is outside skew")
+
+	_, ok = Validate(secret, "12345", now)
+	assert.False(t, ok)
+}
+
+func TestURL(t *testing.T) {
+	u := URL("Slot Admin", "alice", "ABC")
+	assert.True(t, strings.HasPrefix(u, "otpauth://totp/Slot%20Admin:alice?"))
+	assert.Contains(t, u, "secret=ABC")
+	assert.Contains(t, u, "issuer=Slot+Admin")
+}
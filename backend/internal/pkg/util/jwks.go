@@ -0,0 +1,36 @@
+package util
+
+import (
+	"crypto/ecdsa"
+	"encoding/base64"
+)
+
+// JWK is a public key in JSON Web Key format (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+}
+
+// JWKSet is a JSON Web Key Set
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// NewES256JWK encodes a P-256 public key as a signature-verification JWK
+func NewES256JWK(kid string, pub *ecdsa.PublicKey) JWK {
+	size := (pub.Curve.Params().BitSize + 7) / 8
+	return JWK{
+		Kty: "EC",
+		Crv: pub.Curve.Params().Name,
+		X:   base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, size))),
+		Y:   base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, size))),
+		Kid: kid,
+		Use: "sig",
+		Alg: "ES256",
+	}
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"crypto/ecdsa"
 	"fmt"
 	"time"
 
@@ -16,16 +17,16 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
-// GenerateJWT generates a new JWT token
+// NewClaims builds admin token claims expiring after expirationHours
 // gameID can be nil for cross-game accounts
-func GenerateJWT(userID, username string, gameID *uuid.UUID, secret string, expirationHours int) (string, error) {
+func NewClaims(userID, username string, gameID *uuid.UUID, expirationHours int) *Claims {
 	var gameIDStr *string
 	if gameID != nil {
 		s := gameID.String()
 		gameIDStr = &s
 	}
 
-	claims := &Claims{
+	return &Claims{
 		UserID:   userID,
 		Username: username,
 		GameID:   gameIDStr,
@@ -34,11 +35,60 @@ func GenerateJWT(userID, username string, gameID *uuid.UUID, secret string, expi
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
+}
 
+// GenerateJWT generates a new HS256 JWT token
+// gameID can be nil for cross-game accounts
+func GenerateJWT(userID, username string, gameID *uuid.UUID, secret string, expirationHours int) (string, error) {
+	return GenerateJWTWithSecret(NewClaims(userID, username, gameID, expirationHours), secret)
+}
+
+// GenerateJWTWithSecret signs claims with HS256
+func GenerateJWTWithSecret(claims *Claims, secret string) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(secret))
 }
 
+// GenerateJWTWithKey signs claims with an ES256 key, naming it in the kid header
+func GenerateJWTWithKey(claims *Claims, kid string, key *ecdsa.PrivateKey) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = kid
+	return token.SignedString(key)
+}
+
+// KeyLookup resolves the public key of a kid header
+type KeyLookup func(kid string) (*ecdsa.PublicKey, error)
+
+// ValidateJWTWithKeys validates an ES256 token against the key named by its kid header.
+// Tokens without a kid are legacy HS256 tokens, accepted only when legacySecret is set
+func ValidateJWTWithKeys(tokenString string, lookup KeyLookup, legacySecret string) (*Claims, error) {
+	claims := &Claims{}
+
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || legacySecret == "" {
+				return nil, fmt.Errorf("missing kid header")
+			}
+			return []byte(legacySecret), nil
+		}
+		if _, ok := token.Method.(*jwt.SigningMethodECDSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return lookup(kid)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.Valid {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	return claims, nil
+}
+
 // ValidateJWT validates a JWT token and returns claims
 func ValidateJWT(tokenString, secret string) (*Claims, error) {
 	claims := &Claims{}
@@ -0,0 +1,106 @@
+package wireformat
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// field is an encodable struct field, resolved the way encoding/json resolves it
+type field struct {
+	name      string // JSON name
+	omitEmpty bool
+	number    int   // Protobuf field number; 0 means JSON and MessagePack only
+	index     []int // Path through embedded structs
+}
+
+var fieldCache sync.Map // reflect.Type -> []field
+
+// structFields lists t's encodable fields in declaration order
+// Embedded structs are flattened and a shallower field shadows a deeper one of the same name.
+func structFields(t reflect.Type) []field {
+	if cached, ok := fieldCache.Load(t); ok {
+		return cached.([]field)
+	}
+
+	type candidate struct {
+		field
+		depth int
+	}
+	var candidates []candidate
+
+	var walk func(t reflect.Type, index []int, depth int)
+	walk = func(t reflect.Type, index []int, depth int) {
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name, opts, _ := strings.Cut(tag, ",")
+			path := append(append([]int{}, index...), i)
+
+			if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+				walk(sf.Type, path, depth+1)
+				continue
+			}
+			if !sf.IsExported() {
+				continue
+			}
+			if name == "" {
+				name = sf.Name
+			}
+			number, _ := strconv.Atoi(sf.Tag.Get("protobuf"))
+			candidates = append(candidates, candidate{
+				field: field{
+					name:      name,
+					omitEmpty: strings.Contains(","+opts+",", ",omitempty,"),
+					number:    number,
+					index:     path,
+				},
+				depth: depth,
+			})
+		}
+	}
+	walk(t, nil, 0)
+
+	shallowest := make(map[string]int, len(candidates))
+	for _, c := range candidates {
+		if d, ok := shallowest[c.name]; !ok || c.depth < d {
+			shallowest[c.name] = c.depth
+		}
+	}
+	fields := make([]field, 0, len(candidates))
+	for _, c := range candidates {
+		if c.depth == shallowest[c.name] {
+			fields = append(fields, c.field)
+			shallowest[c.name] = -1 // Keep only the first at that depth
+		}
+	}
+
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+// isEmpty reports whether v is omitted by an omitempty field, as in encoding/json
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+// Package wireformat encodes API responses as JSON, MessagePack or Protobuf.
+//
+// MessagePack mirrors the JSON encoding: the same keys, omitempty rules and nesting.
+// Protobuf encodes only fields carrying a `protobuf:"N"` tag, and Schema renders the
+// matching .proto file from those tags so clients generate their decoders from the
+// same source as the server.
+package wireformat
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Format is a response encoding
+type Format string
+
+const (
+	JSON        Format = "json"
+	MessagePack Format = "msgpack"
+	Protobuf    Format = "protobuf"
+)
+
+// Media types clients list in Accept to select a binary format
+const (
+	MIMEMessagePack = "application/msgpack"
+	MIMEProtobuf    = "application/x-protobuf"
+)
+
+// mediaTypes maps every accepted media type (including common aliases) to its format
+var mediaTypes = map[string]Format{
+	MIMEMessagePack:            MessagePack,
+	"application/x-msgpack":    MessagePack,
+	"application/vnd.msgpack":  MessagePack,
+	MIMEProtobuf:               Protobuf,
+	"application/protobuf":     Protobuf,
+	"application/vnd.protobuf": Protobuf,
+}
+
+// Negotiate picks the response format for an Accept header
+// The first binary media type listed wins; anything else, including no header, is JSON.
+func Negotiate(accept string) Format {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, _ := strings.Cut(part, ";")
+		format, ok := mediaTypes[strings.ToLower(strings.TrimSpace(mediaType))]
+		if !ok {
+			continue
+		}
+		// q=0 means "not acceptable"
+		if q, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found && strings.Trim(q, "0.") == "" {
+			continue
+		}
+		return format
+	}
+	return JSON
+}
+
+// ContentType returns the media type responses in the format are sent with
+func (f Format) ContentType() string {
+	switch f {
+	case MessagePack:
+		return MIMEMessagePack
+	case Protobuf:
+		return MIMEProtobuf
+	default:
+		return "application/json"
+	}
+}
+
+// Marshal encodes v in the format
+func Marshal(f Format, v any) ([]byte, error) {
+	switch f {
+	case JSON:
+		return json.Marshal(v)
+	case MessagePack:
+		return MarshalMsgpack(v)
+	case Protobuf:
+		return MarshalProto(v)
+	default:
+		return nil, fmt.Errorf("wireformat: unknown format %q", f)
+	}
+}
@@ -0,0 +1,121 @@
+package wireformat
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// MarshalMsgpack encodes v as MessagePack with the same shape as its JSON encoding
+// Times use the standard MessagePack timestamp extension rather than RFC 3339 strings.
+func MarshalMsgpack(v any) ([]byte, error) {
+	return appendMsgpack(nil, reflect.ValueOf(v))
+}
+
+func appendMsgpack(b []byte, v reflect.Value) ([]byte, error) {
+	if !v.IsValid() {
+		return msgp.AppendNil(b), nil
+	}
+	if v.Type() == timeType {
+		return msgp.AppendTimeExt(b, v.Interface().(time.Time)), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return msgp.AppendNil(b), nil
+		}
+		return appendMsgpack(b, v.Elem())
+
+	case reflect.Struct:
+		fields := structFields(v.Type())
+		present := make([]reflect.Value, 0, len(fields))
+		names := make([]string, 0, len(fields))
+		for _, f := range fields {
+			fv, ok := fieldByIndex(v, f.index)
+			if !ok || (f.omitEmpty && isEmpty(fv)) {
+				continue
+			}
+			present = append(present, fv)
+			names = append(names, f.name)
+		}
+		b = msgp.AppendMapHeader(b, uint32(len(present)))
+		for i, fv := range present {
+			b = msgp.AppendString(b, names[i])
+			var err error
+			if b, err = appendMsgpack(b, fv); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return msgp.AppendNil(b), nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return msgp.AppendBytes(b, v.Bytes()), nil
+		}
+		fallthrough
+	case reflect.Array:
+		b = msgp.AppendArrayHeader(b, uint32(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			var err error
+			if b, err = appendMsgpack(b, v.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+
+	case reflect.Map:
+		if v.IsNil() {
+			return msgp.AppendNil(b), nil
+		}
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("wireformat: unsupported map key type %s", v.Type().Key())
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		b = msgp.AppendMapHeader(b, uint32(len(keys)))
+		for _, k := range keys {
+			b = msgp.AppendString(b, k.String())
+			var err error
+			if b, err = appendMsgpack(b, v.MapIndex(k)); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+
+	case reflect.String:
+		return msgp.AppendString(b, v.String()), nil
+	case reflect.Bool:
+		return msgp.AppendBool(b, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return msgp.AppendInt64(b, v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return msgp.AppendUint64(b, v.Uint()), nil
+	case reflect.Float32:
+		return msgp.AppendFloat32(b, float32(v.Float())), nil
+	case reflect.Float64:
+		return msgp.AppendFloat64(b, v.Float()), nil
+	}
+
+	return nil, fmt.Errorf("wireformat: unsupported type %s", v.Type())
+}
+
+// fieldByIndex follows an embedded field path, reporting false through a nil embedded pointer
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
@@ -0,0 +1,190 @@
+package wireformat
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// MarshalProto encodes a struct as a Protobuf message using its `protobuf:"N"` field numbers
+// The encoding matches the message Schema renders for the type: proto3 scalars are omitted
+// when zero, pointers to scalars are proto3 optional fields, times are
+// google.protobuf.Timestamp, and a list of lists becomes a repeated wrapper message.
+func MarshalProto(v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("wireformat: protobuf needs a struct, got %s", rv.Type())
+	}
+	return appendMessage(nil, rv)
+}
+
+func appendMessage(b []byte, v reflect.Value) ([]byte, error) {
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		if secs := t.Unix(); secs != 0 {
+			b = protowire.AppendTag(b, 1, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(secs))
+		}
+		if nanos := t.Nanosecond(); nanos != 0 {
+			b = protowire.AppendTag(b, 2, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(nanos))
+		}
+		return b, nil
+	}
+
+	for _, f := range structFields(v.Type()) {
+		if f.number == 0 {
+			continue
+		}
+		fv, ok := fieldByIndex(v, f.index)
+		if !ok {
+			continue
+		}
+		var err error
+		if b, err = appendProtoField(b, protowire.Number(f.number), fv); err != nil {
+			return nil, fmt.Errorf("%s: %w", f.name, err)
+		}
+	}
+	return b, nil
+}
+
+func appendProtoField(b []byte, num protowire.Number, v reflect.Value) ([]byte, error) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return b, nil
+		}
+		elem := v.Elem()
+		if elem.Kind() == reflect.Struct {
+			return appendProtoField(b, num, elem)
+		}
+		// Optional scalar: presence is explicit, so zero is still written
+		return appendScalar(b, num, elem, true)
+
+	case reflect.Struct:
+		msg, err := appendMessage(nil, v)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendBytes(b, msg), nil
+
+	case reflect.Slice, reflect.Array:
+		return appendRepeated(b, num, v)
+	}
+
+	return appendScalar(b, num, v, false)
+}
+
+func appendRepeated(b []byte, num protowire.Number, v reflect.Value) ([]byte, error) {
+	if v.Len() == 0 {
+		return b, nil
+	}
+	elemType := v.Type().Elem()
+
+	switch {
+	case elemType.Kind() == reflect.Uint8 && v.Kind() == reflect.Slice:
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendBytes(b, v.Bytes()), nil
+
+	case isPackable(elemType):
+		var packed []byte
+		for i := 0; i < v.Len(); i++ {
+			packed = appendPackedValue(packed, v.Index(i))
+		}
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendBytes(b, packed), nil
+
+	case elemType.Kind() == reflect.Slice || elemType.Kind() == reflect.Array:
+		// A list of lists: each inner list is a wrapper message holding it as field 1
+		for i := 0; i < v.Len(); i++ {
+			inner, err := appendRepeated(nil, 1, v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			b = protowire.AppendTag(b, num, protowire.BytesType)
+			b = protowire.AppendBytes(b, inner)
+		}
+		return b, nil
+	}
+
+	// Strings and messages repeat the field once per element
+	for i := 0; i < v.Len(); i++ {
+		elem := v.Index(i)
+		var err error
+		if elem.Kind() == reflect.String {
+			b = protowire.AppendTag(b, num, protowire.BytesType)
+			b = protowire.AppendString(b, elem.String())
+			continue
+		}
+		if elem.Kind() == reflect.Pointer {
+			if elem.IsNil() {
+				return nil, fmt.Errorf("nil element in repeated message")
+			}
+			elem = elem.Elem()
+		}
+		if b, err = appendProtoField(b, num, elem); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// isPackable reports whether repeated values of t use packed encoding
+func isPackable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func appendPackedValue(b []byte, v reflect.Value) []byte {
+	switch v.Kind() {
+	case reflect.Bool:
+		return protowire.AppendVarint(b, protowire.EncodeBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return protowire.AppendVarint(b, uint64(v.Int()))
+	case reflect.Float32:
+		return protowire.AppendFixed32(b, math.Float32bits(float32(v.Float())))
+	case reflect.Float64:
+		return protowire.AppendFixed64(b, math.Float64bits(v.Float()))
+	default:
+		return protowire.AppendVarint(b, v.Uint())
+	}
+}
+
+// appendScalar writes a singular scalar, skipping the zero value unless presence is explicit
+func appendScalar(b []byte, num protowire.Number, v reflect.Value, explicit bool) ([]byte, error) {
+	if !explicit && isEmpty(v) {
+		return b, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendString(b, v.String()), nil
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		b = protowire.AppendTag(b, num, protowire.VarintType)
+		return appendPackedValue(b, v), nil
+	case reflect.Float32:
+		b = protowire.AppendTag(b, num, protowire.Fixed32Type)
+		return appendPackedValue(b, v), nil
+	case reflect.Float64:
+		b = protowire.AppendTag(b, num, protowire.Fixed64Type)
+		return appendPackedValue(b, v), nil
+	}
+
+	return nil, fmt.Errorf("unsupported protobuf type %s", v.Type())
+}
@@ -0,0 +1,153 @@
+package wireformat
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Schema renders the proto3 schema MarshalProto follows for the given root types
+// Messages are named after their Go types; every struct reachable through a tagged
+// field gets a message, so the roots only need to name the top-level responses.
+func Schema(pkg string, roots ...any) (string, error) {
+	s := &schema{messages: make(map[string]string), types: make(map[string]reflect.Type)}
+	for _, root := range roots {
+		t := reflect.TypeOf(root)
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if _, err := s.message(t); err != nil {
+			return "", err
+		}
+	}
+
+	names := make([]string, 0, len(s.messages))
+	for name := range s.messages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var out strings.Builder
+	out.WriteString("// Code generated by scripts/protoschema. DO NOT EDIT.\n\n")
+	out.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(&out, "package %s;\n", pkg)
+	if s.usesTimestamp {
+		out.WriteString("\nimport \"google/protobuf/timestamp.proto\";\n")
+	}
+	for _, name := range names {
+		out.WriteString("\n")
+		out.WriteString(s.messages[name])
+	}
+	return out.String(), nil
+}
+
+type schema struct {
+	messages      map[string]string       // Message name -> rendered definition
+	types         map[string]reflect.Type // Message name -> Go type, to catch name clashes
+	usesTimestamp bool
+}
+
+// message renders the message for a struct type and returns its name
+func (s *schema) message(t reflect.Type) (string, error) {
+	if t == timeType {
+		s.usesTimestamp = true
+		return "google.protobuf.Timestamp", nil
+	}
+
+	name := t.Name()
+	if existing, ok := s.types[name]; ok {
+		if existing != t {
+			return "", fmt.Errorf("wireformat: message name %s used by both %s and %s", name, existing, t)
+		}
+		return name, nil
+	}
+	s.types[name] = t
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "message %s {\n", name)
+	for _, f := range structFields(t) {
+		if f.number == 0 {
+			continue
+		}
+		fieldType, err := s.fieldType(t.FieldByIndex(f.index).Type)
+		if err != nil {
+			return "", fmt.Errorf("%s.%s: %w", name, f.name, err)
+		}
+		fmt.Fprintf(&body, "  %s %s = %d;\n", fieldType, f.name, f.number)
+	}
+	body.WriteString("}\n")
+
+	s.messages[name] = body.String()
+	return name, nil
+}
+
+// fieldType returns the proto field type (with any label) for a Go field type
+func (s *schema) fieldType(t reflect.Type) (string, error) {
+	switch t.Kind() {
+	case reflect.Pointer:
+		if t.Elem().Kind() == reflect.Struct {
+			return s.fieldType(t.Elem())
+		}
+		scalar, err := scalarType(t.Elem())
+		return "optional " + scalar, err
+
+	case reflect.Struct:
+		return s.message(t)
+
+	case reflect.Slice, reflect.Array:
+		elem := t.Elem()
+		if elem.Kind() == reflect.Uint8 && t.Kind() == reflect.Slice {
+			return "bytes", nil
+		}
+		if elem.Kind() == reflect.Pointer && elem.Elem().Kind() == reflect.Struct {
+			elem = elem.Elem()
+		}
+		if elem.Kind() == reflect.Slice || elem.Kind() == reflect.Array {
+			list, err := s.listMessage(elem)
+			return "repeated " + list, err
+		}
+		inner, err := s.fieldType(elem)
+		if err != nil {
+			return "", err
+		}
+		return "repeated " + inner, nil
+	}
+
+	return scalarType(t)
+}
+
+// listMessage renders the wrapper message for one inner list of a list of lists
+func (s *schema) listMessage(t reflect.Type) (string, error) {
+	inner, err := s.fieldType(t.Elem())
+	if err != nil {
+		return "", err
+	}
+	name := strings.ToUpper(inner[:1]) + inner[1:] + "List"
+	if _, ok := s.messages[name]; !ok {
+		s.messages[name] = fmt.Sprintf("message %s {\n  repeated %s values = 1;\n}\n", name, inner)
+	}
+	return name, nil
+}
+
+func scalarType(t reflect.Type) (string, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return "string", nil
+	case reflect.Bool:
+		return "bool", nil
+	case reflect.Int32, reflect.Int16, reflect.Int8:
+		return "int32", nil
+	case reflect.Int, reflect.Int64:
+		return "int64", nil
+	case reflect.Uint32, reflect.Uint16, reflect.Uint8:
+		return "uint32", nil
+	case reflect.Uint, reflect.Uint64:
+		return "uint64", nil
+	case reflect.Float32:
+		return "float", nil
+	case reflect.Float64:
+		return "double", nil
+	}
+	return "", fmt.Errorf("unsupported protobuf type %s", t)
+}
@@ -0,0 +1,138 @@
+package wireformat
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tinylib/msgp/msgp"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+type testInner struct {
+	Reel int `json:"reel" protobuf:"1"`
+	Row  int `json:"row" protobuf:"2"`
+}
+
+type testBase struct {
+	ID   string `json:"id" protobuf:"1"`
+	Note string `json:"note,omitempty"`
+}
+
+type testMessage struct {
+	testBase
+	Win      float64     `json:"win" protobuf:"2"`
+	Capped   *float64    `json:"capped,omitempty" protobuf:"3"`
+	Grid     [][]int     `json:"grid" protobuf:"4"`
+	Inner    []testInner `json:"inner" protobuf:"5"`
+	Tags     []string    `json:"tags,omitempty" protobuf:"6"`
+	JSONOnly string      `json:"json_only"`
+	Skipped  string      `json:"-"`
+}
+
+func TestNegotiate(t *testing.T) {
+	assert.Equal(t, JSON, Negotiate(""))
+	assert.Equal(t, JSON, Negotiate("application/json, */*"))
+	assert.Equal(t, MessagePack, Negotiate("application/x-msgpack"))
+	assert.Equal(t, Protobuf, Negotiate("application/json;q=0.5, application/x-protobuf"))
+	assert.Equal(t, MessagePack, Negotiate("application/msgpack, application/x-protobuf"), "first listed wins")
+	assert.Equal(t, JSON, Negotiate("application/x-protobuf;q=0"), "q=0 is not acceptable")
+}
+
+func TestMarshalMsgpack_MatchesJSON(t *testing.T) {
+	zero := 0.0
+	v := testMessage{
+		testBase: testBase{ID: "s1"},
+		Win:      12.5,
+		Capped:   &zero,
+		Grid:     [][]int{{1, 2}, {3}},
+		Inner:    []testInner{{Reel: 1, Row: 2}},
+		JSONOnly: "x",
+		Skipped:  "never",
+	}
+
+	packed, err := MarshalMsgpack(v)
+	require.NoError(t, err)
+
+	var asJSON bytes.Buffer
+	_, err = msgp.UnmarshalAsJSON(&asJSON, packed)
+	require.NoError(t, err)
+
+	want, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), asJSON.String())
+}
+
+func TestMarshalMsgpack_TimeUsesTimestampExtension(t *testing.T) {
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	packed, err := MarshalMsgpack(struct {
+		At time.Time `json:"at"`
+	}{At: at})
+	require.NoError(t, err)
+
+	_, rest, err := msgp.ReadMapHeaderBytes(packed)
+	require.NoError(t, err)
+	_, rest, err = msgp.ReadStringBytes(rest)
+	require.NoError(t, err)
+	decoded, _, err := msgp.ReadTimeBytes(rest)
+	require.NoError(t, err)
+	assert.True(t, at.Equal(decoded))
+}
+
+func TestMarshalProto(t *testing.T) {
+	zero := 0.0
+	v := &testMessage{
+		testBase: testBase{ID: "s1", Note: "json only"},
+		Capped:   &zero,
+		Grid:     [][]int{{1, 2}},
+		Inner:    []testInner{{Reel: 0, Row: 3}},
+		Tags:     []string{"a", "b"},
+		JSONOnly: "x",
+	}
+
+	b, err := MarshalProto(v)
+	require.NoError(t, err)
+
+	fields := map[protowire.Number][][]byte{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, n, 0)
+		b = b[n:]
+		m := protowire.ConsumeFieldValue(num, typ, b)
+		require.GreaterOrEqual(t, m, 0)
+		fields[num] = append(fields[num], b[:m])
+		b = b[m:]
+	}
+
+	id, _ := protowire.ConsumeString(fields[1][0])
+	assert.Equal(t, "s1", id)
+	assert.NotContains(t, fields, protowire.Number(2), "zero scalars are omitted")
+	capped, _ := protowire.ConsumeFixed64(fields[3][0])
+	assert.Equal(t, 0.0, math.Float64frombits(capped), "optional scalars keep explicit zeros")
+	require.Len(t, fields[4], 1)
+	require.Len(t, fields[5], 1)
+	assert.Len(t, fields[6], 2)
+	assert.Len(t, fields, 5, "untagged fields are not encoded")
+
+	// Grid rows are Int64List wrappers holding a packed field 1
+	row, _ := protowire.ConsumeBytes(fields[4][0])
+	num, _, n := protowire.ConsumeTag(row)
+	assert.Equal(t, protowire.Number(1), num)
+	packed, _ := protowire.ConsumeBytes(row[n:])
+	first, k := protowire.ConsumeVarint(packed)
+	second, _ := protowire.ConsumeVarint(packed[k:])
+	assert.Equal(t, []uint64{1, 2}, []uint64{first, second})
+}
+
+func TestSchema(t *testing.T) {
+	schema, err := Schema("test.v1", testMessage{})
+	require.NoError(t, err)
+
+	assert.Contains(t, schema, "message testMessage {\n  string id = 1;\n  double win = 2;\n  optional double capped = 3;\n  repeated Int64List grid = 4;\n  repeated testInner inner = 5;\n  repeated string tags = 6;\n}\n")
+	assert.Contains(t, schema, "message Int64List {\n  repeated int64 values = 1;\n}\n")
+	assert.Contains(t, schema, "message testInner {\n  int64 reel = 1;\n  int64 row = 2;\n}\n")
+}
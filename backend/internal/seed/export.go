@@ -0,0 +1,229 @@
+package seed
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/reelstrip"
+)
+
+// exportPageSize is the page size used when listing entities for export
+const exportPageSize = 100
+
+// Export builds a manifest of every game, asset, game config and reel strip config
+// in the database, including the reel strips the configs reference.
+// Entities are sorted so that exporting an unchanged database yields the same manifest.
+func (s *Seeder) Export(ctx context.Context) (*Manifest, error) {
+	m := &Manifest{}
+
+	games, err := s.listGames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range games {
+		m.Games = append(m.Games, GameSeed{
+			ID:          g.ID,
+			Name:        g.Name,
+			Description: derefString(g.Description),
+			DevURL:      derefString(g.DevURL),
+			ProdURL:     derefString(g.ProdURL),
+			IsActive:    &g.IsActive,
+		})
+	}
+
+	assets, err := s.listAssets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range assets {
+		seed := AssetSeed{
+			ID:          a.ID,
+			Name:        a.Name,
+			Description: derefString(a.Description),
+			ObjectName:  a.ObjectName,
+			IsPrivate:   a.IsPrivate,
+			IsActive:    &a.IsActive,
+		}
+		fields := []struct {
+			name string
+			raw  json.RawMessage
+			dst  *any
+		}{
+			{"spritesheet_json", a.SpritesheetJSON, &seed.SpritesheetJSON},
+			{"images", a.Images, &seed.Images},
+			{"audios", a.Audios, &seed.Audios},
+			{"videos", a.Videos, &seed.Videos},
+		}
+		for _, f := range fields {
+			if err := decodeJSON(f.raw, f.dst); err != nil {
+				return nil, fmt.Errorf("asset %s: failed to decode %s: %w", a.ID, f.name, err)
+			}
+		}
+		m.Assets = append(m.Assets, seed)
+	}
+
+	configs, err := s.listGameConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range configs {
+		m.GameConfigs = append(m.GameConfigs, GameConfigSeed{
+			ID:       c.ID,
+			GameID:   c.GameID,
+			AssetID:  c.AssetID,
+			IsActive: &c.IsActive,
+		})
+	}
+
+	reelConfigs, err := s.listReelStripConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var stripIDs []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, c := range reelConfigs {
+		ids := []uuid.UUID{c.Reel0StripID, c.Reel1StripID, c.Reel2StripID, c.Reel3StripID, c.Reel4StripID}
+		seed := ReelStripConfigSeed{
+			ID:           c.ID,
+			Name:         c.Name,
+			GameMode:     c.GameMode,
+			Description:  c.Description,
+			ReelStripIDs: ids,
+			TargetRTP:    c.TargetRTP,
+			IsActive:     &c.IsActive,
+			IsDefault:    c.IsDefault,
+			CreatedBy:    c.CreatedBy,
+			Notes:        c.Notes,
+		}
+		if err := decodeJSON(c.Options, &seed.Options); err != nil {
+			return nil, fmt.Errorf("reel strip config %s: failed to decode options: %w", c.ID, err)
+		}
+		m.ReelStripConfigs = append(m.ReelStripConfigs, seed)
+
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				stripIDs = append(stripIDs, id)
+			}
+		}
+	}
+
+	if len(stripIDs) > 0 {
+		strips, err := s.reelStripRepo.GetByIDs(ctx, stripIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load reel strips: %w", err)
+		}
+		if len(strips) != len(stripIDs) {
+			return nil, fmt.Errorf("%w: %d of %d referenced reel strips found", reelstrip.ErrIncompleteSet, len(strips), len(stripIDs))
+		}
+		sort.Slice(strips, func(i, j int) bool {
+			if strips[i].GameMode != strips[j].GameMode {
+				return strips[i].GameMode < strips[j].GameMode
+			}
+			if strips[i].ReelNumber != strips[j].ReelNumber {
+				return strips[i].ReelNumber < strips[j].ReelNumber
+			}
+			return strips[i].ID.String() < strips[j].ID.String()
+		})
+		for _, rs := range strips {
+			m.ReelStrips = append(m.ReelStrips, ReelStripSeed{
+				ID:         rs.ID,
+				GameMode:   rs.GameMode,
+				ReelNumber: rs.ReelNumber,
+				StripData:  rs.StripData,
+				IsActive:   &rs.IsActive,
+				Notes:      rs.Notes,
+			})
+		}
+	}
+
+	return m, nil
+}
+
+// listGames returns every game sorted by name
+func (s *Seeder) listGames(ctx context.Context) ([]*game.Game, error) {
+	var all []*game.Game
+	for page := 1; ; page++ {
+		items, total, err := s.gameRepo.ListGames(ctx, page, exportPageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) == 0 || int64(len(all)) >= total {
+			break
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+	return all, nil
+}
+
+// listAssets returns every asset sorted by name
+func (s *Seeder) listAssets(ctx context.Context) ([]*game.Asset, error) {
+	var all []*game.Asset
+	for page := 1; ; page++ {
+		items, total, err := s.gameRepo.ListAssets(ctx, page, exportPageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) == 0 || int64(len(all)) >= total {
+			break
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+	return all, nil
+}
+
+// listGameConfigs returns every game config sorted by ID
+func (s *Seeder) listGameConfigs(ctx context.Context) ([]*game.GameConfig, error) {
+	var all []*game.GameConfig
+	for page := 1; ; page++ {
+		items, total, err := s.gameRepo.ListGameConfigs(ctx, page, exportPageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) == 0 || int64(len(all)) >= total {
+			break
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].ID.String() < all[j].ID.String() })
+	return all, nil
+}
+
+// listReelStripConfigs returns every reel strip config sorted by name
+func (s *Seeder) listReelStripConfigs(ctx context.Context) ([]*reelstrip.ReelStripConfig, error) {
+	var all []*reelstrip.ReelStripConfig
+	for page := 1; ; page++ {
+		items, total, err := s.reelStripRepo.ListConfigs(ctx, &reelstrip.ConfigListFilters{Page: page, Limit: exportPageSize})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) == 0 || int64(len(all)) >= total {
+			break
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+	return all, nil
+}
+
+// decodeJSON decodes raw JSON into dst, leaving dst nil for empty input
+func decodeJSON(raw json.RawMessage, dst *any) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	return json.Unmarshal(raw, dst)
+}
+
+// derefString returns the value of an optional string
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,284 @@
+package seed
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest is a declarative description of seed data
+// Manifests are YAML files (JSON is accepted as well, being a subset of YAML).
+// Every entity carries a fixed ID so that applying a manifest is idempotent.
+type Manifest struct {
+	Games            []GameSeed            `yaml:"games,omitempty"`
+	Assets           []AssetSeed           `yaml:"assets,omitempty"`
+	GameConfigs      []GameConfigSeed      `yaml:"game_configs,omitempty"`
+	ReelStrips       []ReelStripSeed       `yaml:"reel_strips,omitempty"`
+	ReelStripConfigs []ReelStripConfigSeed `yaml:"reel_strip_configs,omitempty"`
+
+	// dir is the directory of the manifest file, used to resolve *_file references
+	dir string
+}
+
+// GameSeed describes a game
+type GameSeed struct {
+	ID          uuid.UUID `yaml:"id"`
+	Name        string    `yaml:"name"`
+	Description string    `yaml:"description,omitempty"`
+	DevURL      string    `yaml:"dev_url,omitempty"`
+	ProdURL     string    `yaml:"prod_url,omitempty"`
+	IsActive    *bool     `yaml:"is_active,omitempty"`
+}
+
+// AssetSeed describes an asset set
+// JSON fields may be given inline or loaded from a file relative to the manifest
+// (spritesheet_file etc.). A storage folder named ObjectName is created for the asset.
+type AssetSeed struct {
+	ID              uuid.UUID `yaml:"id"`
+	Name            string    `yaml:"name"`
+	Description     string    `yaml:"description,omitempty"`
+	ObjectName      string    `yaml:"object_name"`
+	SpritesheetJSON any       `yaml:"spritesheet_json,omitempty"`
+	SpritesheetFile string    `yaml:"spritesheet_file,omitempty"`
+	Images          any       `yaml:"images,omitempty"`
+	ImagesFile      string    `yaml:"images_file,omitempty"`
+	Audios          any       `yaml:"audios,omitempty"`
+	AudiosFile      string    `yaml:"audios_file,omitempty"`
+	Videos          any       `yaml:"videos,omitempty"`
+	VideosFile      string    `yaml:"videos_file,omitempty"`
+	IsPrivate       bool      `yaml:"is_private,omitempty"`
+	IsActive        *bool     `yaml:"is_active,omitempty"`
+}
+
+// GameConfigSeed links a game to an asset
+type GameConfigSeed struct {
+	ID       uuid.UUID `yaml:"id"`
+	GameID   uuid.UUID `yaml:"game_id"`
+	AssetID  uuid.UUID `yaml:"asset_id"`
+	IsActive *bool     `yaml:"is_active,omitempty"`
+}
+
+// ReelStripSeed describes a single reel strip; the checksum is computed from StripData
+type ReelStripSeed struct {
+	ID         uuid.UUID `yaml:"id"`
+	GameMode   string    `yaml:"game_mode,omitempty"`
+	ReelNumber int       `yaml:"reel_number"`
+	StripData  []string  `yaml:"strip_data,omitempty"`
+	IsActive   *bool     `yaml:"is_active,omitempty"`
+	Notes      string    `yaml:"notes,omitempty"`
+}
+
+// ReelStripConfigSeed describes a reel strip config referencing five reel strips (reel 0-4)
+type ReelStripConfigSeed struct {
+	ID           uuid.UUID   `yaml:"id"`
+	Name         string      `yaml:"name"`
+	GameMode     string      `yaml:"game_mode,omitempty"`
+	Description  string      `yaml:"description,omitempty"`
+	ReelStripIDs []uuid.UUID `yaml:"reel_strip_ids,omitempty"`
+	TargetRTP    float64     `yaml:"target_rtp,omitempty"`
+	IsActive     *bool       `yaml:"is_active,omitempty"`
+	IsDefault    bool        `yaml:"is_default,omitempty"`
+	CreatedBy    string      `yaml:"created_by,omitempty"`
+	Notes        string      `yaml:"notes,omitempty"`
+	Options      any         `yaml:"options,omitempty"`
+}
+
+// LoadManifest reads and validates a single manifest file
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	m, err := ParseManifest(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	m.dir = filepath.Dir(path)
+
+	return m, nil
+}
+
+// LoadManifests loads a manifest file, or every *.yaml and *.yml file of a directory in name order
+// JSON files in a directory are skipped since they are usually spritesheets referenced by manifests
+func LoadManifests(path string) ([]*Manifest, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat manifest path: %w", err)
+	}
+	if !info.IsDir() {
+		m, err := LoadManifest(path)
+		if err != nil {
+			return nil, err
+		}
+		return []*Manifest{m}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest directory: %w", err)
+	}
+
+	var files []string
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(e.Name())) {
+		case ".yaml", ".yml":
+			files = append(files, e.Name())
+		}
+	}
+	sort.Strings(files)
+
+	manifests := make([]*Manifest, 0, len(files))
+	for _, name := range files {
+		m, err := LoadManifest(filepath.Join(path, name))
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, m)
+	}
+
+	return manifests, nil
+}
+
+// ParseManifest decodes and validates manifest content
+func ParseManifest(data []byte) (*Manifest, error) {
+	var m Manifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Validate checks required fields and references that can be resolved within the manifest
+func (m *Manifest) Validate() error {
+	ids := make(map[uuid.UUID]string)
+	claim := func(kind string, id uuid.UUID) error {
+		if id == uuid.Nil {
+			return fmt.Errorf("%s: id is required", kind)
+		}
+		if other, ok := ids[id]; ok {
+			return fmt.Errorf("%s %s: id already used by %s", kind, id, other)
+		}
+		ids[id] = kind
+		return nil
+	}
+
+	for _, g := range m.Games {
+		if err := claim("game", g.ID); err != nil {
+			return err
+		}
+		if g.Name == "" {
+			return fmt.Errorf("game %s: name is required", g.ID)
+		}
+	}
+
+	for _, a := range m.Assets {
+		if err := claim("asset", a.ID); err != nil {
+			return err
+		}
+		if a.Name == "" || a.ObjectName == "" {
+			return fmt.Errorf("asset %s: name and object_name are required", a.ID)
+		}
+		if a.SpritesheetJSON != nil && a.SpritesheetFile != "" {
+			return fmt.Errorf("asset %s: spritesheet_json and spritesheet_file are mutually exclusive", a.ID)
+		}
+	}
+
+	for _, c := range m.GameConfigs {
+		if err := claim("game config", c.ID); err != nil {
+			return err
+		}
+		if c.GameID == uuid.Nil || c.AssetID == uuid.Nil {
+			return fmt.Errorf("game config %s: game_id and asset_id are required", c.ID)
+		}
+	}
+
+	for _, s := range m.ReelStrips {
+		if err := claim("reel strip", s.ID); err != nil {
+			return err
+		}
+		if s.ReelNumber < 0 || s.ReelNumber > 4 {
+			return fmt.Errorf("reel strip %s: %w", s.ID, reelstrip.ErrInvalidReelNumber)
+		}
+		if len(s.StripData) == 0 {
+			return fmt.Errorf("reel strip %s: %w", s.ID, reelstrip.ErrInvalidStripLength)
+		}
+		if !validGameMode(s.GameMode) {
+			return fmt.Errorf("reel strip %s: %w: %q", s.ID, reelstrip.ErrInvalidGameMode, s.GameMode)
+		}
+	}
+
+	for _, c := range m.ReelStripConfigs {
+		if err := claim("reel strip config", c.ID); err != nil {
+			return err
+		}
+		if c.Name == "" {
+			return fmt.Errorf("reel strip config %s: name is required", c.ID)
+		}
+		if len(c.ReelStripIDs) != 5 {
+			return fmt.Errorf("reel strip config %s: %w: expected 5 reel_strip_ids, got %d", c.ID, reelstrip.ErrIncompleteSet, len(c.ReelStripIDs))
+		}
+		if !validGameMode(c.GameMode) {
+			return fmt.Errorf("reel strip config %s: %w: %q", c.ID, reelstrip.ErrInvalidGameMode, c.GameMode)
+		}
+	}
+
+	return nil
+}
+
+// jsonField returns the JSON encoding of an inline value or of a file relative to the manifest
+// Returns fallback when neither is set.
+func (m *Manifest) jsonField(inline any, file, fallback string) (json.RawMessage, error) {
+	if file != "" {
+		path := file
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(m.dir, file)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		if !json.Valid(data) {
+			return nil, fmt.Errorf("%s is not valid JSON", file)
+		}
+		return json.RawMessage(data), nil
+	}
+	if inline == nil {
+		return json.RawMessage(fallback), nil
+	}
+	data, err := json.Marshal(inline)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(data), nil
+}
+
+// validGameMode reports whether mode is a known reel strip game mode
+func validGameMode(mode string) bool {
+	switch reelstrip.GameMode(mode) {
+	case reelstrip.BaseGame, reelstrip.FreeSpins, reelstrip.BonusSpinTrigger, reelstrip.Both:
+		return true
+	}
+	return false
+}
+
+// boolOr dereferences b, defaulting to def when unset
+func boolOr(b *bool, def bool) bool {
+	if b == nil {
+		return def
+	}
+	return *b
+}
@@ -0,0 +1,163 @@
+package seed
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const testManifest = `
+games:
+  - id: a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d
+    name: Test Game
+assets:
+  - id: b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e
+    name: Test Theme
+    object_name: test-theme
+    spritesheet_file: sheet.json
+    images: &images
+      tiles: images/tiles.png
+    audios:
+      noises:
+        - audios/noise_1.mp3
+game_configs:
+  - id: c3d4e5f6-a7b8-4c9d-0e1f-2a3b4c5d6e7f
+    game_id: a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d
+    asset_id: b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e
+    is_active: false
+`
+
+func TestParseManifest(t *testing.T) {
+	t.Run("should parse YAML manifest", func(t *testing.T) {
+		m, err := ParseManifest([]byte(testManifest))
+		require.NoError(t, err)
+
+		require.Len(t, m.Games, 1)
+		assert.Equal(t, uuid.MustParse("a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"), m.Games[0].ID)
+		assert.True(t, boolOr(m.Games[0].IsActive, true))
+
+		require.Len(t, m.GameConfigs, 1)
+		assert.False(t, boolOr(m.GameConfigs[0].IsActive, true))
+
+		images, err := m.jsonField(m.Assets[0].Images, "", `{}`)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"tiles":"images/tiles.png"}`, string(images))
+
+		audios, err := m.jsonField(m.Assets[0].Audios, "", `{}`)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"noises":["audios/noise_1.mp3"]}`, string(audios))
+
+		videos, err := m.jsonField(m.Assets[0].Videos, "", `{}`)
+		require.NoError(t, err)
+		assert.Equal(t, `{}`, string(videos))
+	})
+
+	t.Run("should parse JSON manifest", func(t *testing.T) {
+		m, err := ParseManifest([]byte(`{"games":[{"id":"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d","name":"Test Game"}]}`))
+		require.NoError(t, err)
+		require.Len(t, m.Games, 1)
+		assert.Equal(t, "Test Game", m.Games[0].Name)
+	})
+
+	t.Run("should reject duplicate IDs", func(t *testing.T) {
+		_, err := ParseManifest([]byte(`
+games:
+  - id: a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d
+    name: One
+  - id: a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d
+    name: Two
+`))
+		assert.ErrorContains(t, err, "id already used")
+	})
+
+	t.Run("should reject incomplete reel strip config", func(t *testing.T) {
+		_, err := ParseManifest([]byte(`
+reel_strip_configs:
+  - id: c3d4e5f6-a7b8-4c9d-0e1f-2a3b4c5d6e7f
+    name: base
+    game_mode: base_game
+    reel_strip_ids:
+      - a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d
+`))
+		assert.ErrorIs(t, err, reelstrip.ErrIncompleteSet)
+	})
+
+	t.Run("should reject invalid reel number", func(t *testing.T) {
+		_, err := ParseManifest([]byte(`
+reel_strips:
+  - id: c3d4e5f6-a7b8-4c9d-0e1f-2a3b4c5d6e7f
+    game_mode: base_game
+    reel_number: 5
+    strip_data: [fa, bai]
+`))
+		assert.ErrorIs(t, err, reelstrip.ErrInvalidReelNumber)
+	})
+}
+
+func TestLoadManifests(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "game.yaml"), []byte(testManifest), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sheet.json"), []byte(`{"tile.png":{"frame":{"x":0}}}`), 0o644))
+
+	manifests, err := LoadManifests(dir)
+	require.NoError(t, err)
+	require.Len(t, manifests, 1, "JSON files in a manifest directory should not be loaded as manifests")
+
+	m := manifests[0]
+	sheet, err := m.jsonField(m.Assets[0].SpritesheetJSON, m.Assets[0].SpritesheetFile, `{}`)
+	require.NoError(t, err)
+	assert.True(t, json.Valid(sheet))
+	assert.JSONEq(t, `{"tile.png":{"frame":{"x":0}}}`, string(sheet))
+}
+
+func TestManifestRoundTrip(t *testing.T) {
+	active := false
+	m := &Manifest{
+		Games: []GameSeed{{ID: uuid.MustParse("a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"), Name: "Test Game", IsActive: &active}},
+		ReelStripConfigs: []ReelStripConfigSeed{{
+			ID:           uuid.MustParse("c3d4e5f6-a7b8-4c9d-0e1f-2a3b4c5d6e7f"),
+			Name:         "base",
+			GameMode:     "base_game",
+			ReelStripIDs: []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()},
+			Options:      map[string]any{"tuning": map[string]any{"spins": 1000}},
+		}},
+	}
+
+	data, err := yaml.Marshal(m)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "id: a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d")
+
+	parsed, err := ParseManifest(data)
+	require.NoError(t, err)
+	assert.Equal(t, m.Games, parsed.Games)
+	assert.Equal(t, m.ReelStripConfigs[0].ReelStripIDs, parsed.ReelStripConfigs[0].ReelStripIDs)
+
+	options, err := parsed.jsonField(parsed.ReelStripConfigs[0].Options, "", "null")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"tuning":{"spins":1000}}`, string(options))
+}
+
+func TestChangeString(t *testing.T) {
+	id := uuid.MustParse("a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d")
+
+	assert.Equal(t, "+ game a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d (Test Game)",
+		Change{Kind: "game", ID: id, Name: "Test Game", Action: ActionCreate}.String())
+	assert.Equal(t, "~ asset a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d (Theme): images, is_active",
+		Change{Kind: "asset", ID: id, Name: "Theme", Action: ActionUpdate, Fields: []string{"images", "is_active"}}.String())
+	assert.Equal(t, "! game_config a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d: asset_id",
+		Change{Kind: "game_config", ID: id, Action: ActionConflict, Fields: []string{"asset_id"}}.String())
+}
+
+func TestJSONEqual(t *testing.T) {
+	assert.True(t, jsonEqual([]byte(`{"a":1,"b":[1,2]}`), []byte(`{"b":[1,2],"a":1}`)))
+	assert.True(t, jsonEqual(nil, []byte(`null`)))
+	assert.False(t, jsonEqual([]byte(`{}`), nil))
+	assert.False(t, jsonEqual([]byte(`{"a":1}`), []byte(`{"a":2}`)))
+}
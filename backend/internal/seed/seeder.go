@@ -0,0 +1,360 @@
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// Result counts what applying manifests changed
+type Result struct {
+	Created int
+	Skipped int
+}
+
+// Seeder applies manifests to the database and storage
+// Entities are looked up by ID and only created when missing, so manifests can be
+// applied repeatedly; existing rows are never modified.
+type Seeder struct {
+	gameRepo      game.Repository
+	reelStripRepo reelstrip.Repository
+	storage       storage.Storage
+	logger        *logger.Logger
+}
+
+// NewSeeder creates a new seeder
+func NewSeeder(
+	gameRepo game.Repository,
+	reelStripRepo reelstrip.Repository,
+	s storage.Storage,
+	log *logger.Logger,
+) *Seeder {
+	return &Seeder{
+		gameRepo:      gameRepo,
+		reelStripRepo: reelStripRepo,
+		storage:       s,
+		logger:        log,
+	}
+}
+
+// Apply seeds everything described by the manifest
+// Reel strips are applied before the configs that reference them, and games and
+// assets before the game configs linking them.
+func (s *Seeder) Apply(ctx context.Context, m *Manifest) (*Result, error) {
+	result := &Result{}
+	track := func(created bool) {
+		if created {
+			result.Created++
+		} else {
+			result.Skipped++
+		}
+	}
+
+	for _, rs := range m.ReelStrips {
+		created, err := s.applyReelStrip(ctx, rs)
+		if err != nil {
+			return result, fmt.Errorf("reel strip %s: %w", rs.ID, err)
+		}
+		track(created)
+	}
+
+	for _, rc := range m.ReelStripConfigs {
+		created, err := s.applyReelStripConfig(ctx, m, rc)
+		if err != nil {
+			return result, fmt.Errorf("reel strip config %s: %w", rc.ID, err)
+		}
+		track(created)
+	}
+
+	for _, a := range m.Assets {
+		created, err := s.applyAsset(ctx, m, a)
+		if err != nil {
+			return result, fmt.Errorf("asset %s: %w", a.ID, err)
+		}
+		track(created)
+	}
+
+	for _, g := range m.Games {
+		created, err := s.applyGame(ctx, g)
+		if err != nil {
+			return result, fmt.Errorf("game %s: %w", g.ID, err)
+		}
+		track(created)
+	}
+
+	for _, gc := range m.GameConfigs {
+		created, err := s.applyGameConfig(ctx, gc)
+		if err != nil {
+			return result, fmt.Errorf("game config %s: %w", gc.ID, err)
+		}
+		track(created)
+	}
+
+	return result, nil
+}
+
+// applyReelStrip creates a reel strip if it does not exist yet
+func (s *Seeder) applyReelStrip(ctx context.Context, rs ReelStripSeed) (bool, error) {
+	if _, err := s.reelStripRepo.GetByID(ctx, rs.ID); err == nil {
+		s.logger.Info().Str("reel_strip_id", rs.ID.String()).Msg("Reel strip already exists, skipping")
+		return false, nil
+	} else if !errors.Is(err, reelstrip.ErrReelStripNotFound) {
+		return false, err
+	}
+
+	strip := newReelStrip(rs)
+	if err := s.reelStripRepo.Create(ctx, strip); err != nil {
+		return false, err
+	}
+
+	s.logger.Info().
+		Str("reel_strip_id", strip.ID.String()).
+		Str("game_mode", strip.GameMode).
+		Int("reel_number", strip.ReelNumber).
+		Msg("Reel strip created")
+	return true, nil
+}
+
+// applyReelStripConfig creates a reel strip config if it does not exist yet
+func (s *Seeder) applyReelStripConfig(ctx context.Context, m *Manifest, rc ReelStripConfigSeed) (bool, error) {
+	if _, err := s.reelStripRepo.GetConfigByID(ctx, rc.ID); err == nil {
+		s.logger.Info().Str("config_id", rc.ID.String()).Msg("Reel strip config already exists, skipping")
+		return false, nil
+	} else if !errors.Is(err, reelstrip.ErrConfigNotFound) {
+		return false, err
+	}
+
+	config, err := m.newReelStripConfig(rc)
+	if err != nil {
+		return false, err
+	}
+	if err := s.createReelStripConfig(ctx, config, rc.IsDefault); err != nil {
+		return false, err
+	}
+
+	s.logger.Info().
+		Str("config_id", config.ID.String()).
+		Str("config_name", config.Name).
+		Bool("is_default", rc.IsDefault).
+		Msg("Reel strip config created")
+	return true, nil
+}
+
+// applyAsset creates an asset and its storage folder if the asset does not exist yet
+func (s *Seeder) applyAsset(ctx context.Context, m *Manifest, a AssetSeed) (bool, error) {
+	if _, err := s.gameRepo.GetAssetByID(ctx, a.ID); err == nil {
+		s.logger.Info().Str("asset_id", a.ID.String()).Msg("Asset already exists, skipping")
+		return false, nil
+	} else if !errors.Is(err, game.ErrAssetNotFound) {
+		return false, err
+	}
+
+	asset, err := s.newAsset(m, a)
+	if err != nil {
+		return false, err
+	}
+	if err := s.createAsset(ctx, asset); err != nil {
+		return false, err
+	}
+
+	s.logger.Info().
+		Str("asset_id", asset.ID.String()).
+		Str("object_name", asset.ObjectName).
+		Str("base_url", asset.BaseURL).
+		Msg("Asset created")
+	return true, nil
+}
+
+// applyGame creates a game if it does not exist yet
+func (s *Seeder) applyGame(ctx context.Context, g GameSeed) (bool, error) {
+	if _, err := s.gameRepo.GetGameByID(ctx, g.ID); err == nil {
+		s.logger.Info().Str("game_id", g.ID.String()).Msg("Game already exists, skipping")
+		return false, nil
+	} else if !errors.Is(err, game.ErrGameNotFound) {
+		return false, err
+	}
+
+	record := newGame(g)
+	if err := s.gameRepo.CreateGame(ctx, record); err != nil {
+		return false, err
+	}
+
+	s.logger.Info().Str("game_id", record.ID.String()).Str("name", record.Name).Msg("Game created")
+	return true, nil
+}
+
+// applyGameConfig creates a game config if it does not exist yet
+func (s *Seeder) applyGameConfig(ctx context.Context, gc GameConfigSeed) (bool, error) {
+	if _, err := s.gameRepo.GetGameConfigByID(ctx, gc.ID); err == nil {
+		s.logger.Info().Str("config_id", gc.ID.String()).Msg("Game config already exists, skipping")
+		return false, nil
+	} else if !errors.Is(err, game.ErrGameConfigNotFound) {
+		return false, err
+	}
+
+	config := newGameConfig(gc)
+	if err := s.gameRepo.CreateGameConfig(ctx, config); err != nil {
+		return false, err
+	}
+
+	s.logger.Info().
+		Str("config_id", config.ID.String()).
+		Str("game_id", config.GameID.String()).
+		Str("asset_id", config.AssetID.String()).
+		Msg("Game config created")
+	return true, nil
+}
+
+// createReelStripConfig stores a new reel strip config, making it the default of its mode if requested
+func (s *Seeder) createReelStripConfig(ctx context.Context, config *reelstrip.ReelStripConfig, isDefault bool) error {
+	if err := s.reelStripRepo.CreateConfig(ctx, config); err != nil {
+		return err
+	}
+
+	// Set the default through the repository so any previous default of the mode is unset
+	if isDefault {
+		if err := s.reelStripRepo.SetDefaultConfig(ctx, config.ID, config.GameMode); err != nil {
+			return fmt.Errorf("failed to set default config: %w", err)
+		}
+	}
+	return nil
+}
+
+// createAsset creates the asset's storage folder and stores the asset
+func (s *Seeder) createAsset(ctx context.Context, asset *game.Asset) error {
+	// A missing folder only means uploads go to a prefix that does not exist yet
+	if err := s.storage.CreateFolder(ctx, asset.ObjectName); err != nil {
+		s.logger.Warn().Err(err).Str("object_name", asset.ObjectName).Msg("Failed to create storage folder")
+	}
+	return s.gameRepo.CreateAsset(ctx, asset)
+}
+
+// newReelStrip builds the reel strip described by a seed
+func newReelStrip(rs ReelStripSeed) *reelstrip.ReelStrip {
+	return &reelstrip.ReelStrip{
+		ID:          rs.ID,
+		GameMode:    rs.GameMode,
+		ReelNumber:  rs.ReelNumber,
+		StripData:   rs.StripData,
+		Checksum:    reelstrip.ComputeChecksum(rs.StripData),
+		StripLength: len(rs.StripData),
+		CreatedAt:   time.Now(),
+		IsActive:    boolOr(rs.IsActive, true),
+		Notes:       rs.Notes,
+	}
+}
+
+// newReelStripConfig builds the reel strip config described by a seed
+// IsDefault is left unset; it is applied through SetDefaultConfig.
+func (m *Manifest) newReelStripConfig(rc ReelStripConfigSeed) (*reelstrip.ReelStripConfig, error) {
+	options, err := m.jsonField(rc.Options, "", "null")
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	if string(options) == "null" {
+		options = nil
+	}
+
+	now := time.Now()
+	config := &reelstrip.ReelStripConfig{
+		ID:           rc.ID,
+		Name:         rc.Name,
+		GameMode:     rc.GameMode,
+		Description:  rc.Description,
+		Reel0StripID: rc.ReelStripIDs[0],
+		Reel1StripID: rc.ReelStripIDs[1],
+		Reel2StripID: rc.ReelStripIDs[2],
+		Reel3StripID: rc.ReelStripIDs[3],
+		Reel4StripID: rc.ReelStripIDs[4],
+		TargetRTP:    rc.TargetRTP,
+		IsActive:     boolOr(rc.IsActive, true),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		CreatedBy:    rc.CreatedBy,
+		Notes:        rc.Notes,
+		Options:      options,
+	}
+	if config.IsActive {
+		config.ActivatedAt = &now
+	}
+	return config, nil
+}
+
+// newAsset builds the asset described by a seed, with the base URL of the target storage
+func (s *Seeder) newAsset(m *Manifest, a AssetSeed) (*game.Asset, error) {
+	spritesheetJSON, err := m.jsonField(a.SpritesheetJSON, a.SpritesheetFile, `{}`)
+	if err != nil {
+		return nil, fmt.Errorf("invalid spritesheet: %w", err)
+	}
+	imagesJSON, err := m.jsonField(a.Images, a.ImagesFile, `{}`)
+	if err != nil {
+		return nil, fmt.Errorf("invalid images: %w", err)
+	}
+	audiosJSON, err := m.jsonField(a.Audios, a.AudiosFile, `{}`)
+	if err != nil {
+		return nil, fmt.Errorf("invalid audios: %w", err)
+	}
+	videosJSON, err := m.jsonField(a.Videos, a.VideosFile, `{}`)
+	if err != nil {
+		return nil, fmt.Errorf("invalid videos: %w", err)
+	}
+
+	now := time.Now()
+	return &game.Asset{
+		ID:              a.ID,
+		Name:            a.Name,
+		Description:     optionalString(a.Description),
+		ObjectName:      a.ObjectName,
+		BaseURL:         s.storage.GetBaseURL(a.ObjectName),
+		SpritesheetJSON: spritesheetJSON,
+		Images:          imagesJSON,
+		Audios:          audiosJSON,
+		Videos:          videosJSON,
+		IsPrivate:       a.IsPrivate,
+		IsActive:        boolOr(a.IsActive, true),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}, nil
+}
+
+// newGame builds the game described by a seed
+func newGame(g GameSeed) *game.Game {
+	now := time.Now()
+	return &game.Game{
+		ID:          g.ID,
+		Name:        g.Name,
+		Description: optionalString(g.Description),
+		DevURL:      optionalString(g.DevURL),
+		ProdURL:     optionalString(g.ProdURL),
+		IsActive:    boolOr(g.IsActive, true),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// newGameConfig builds the game config described by a seed
+func newGameConfig(gc GameConfigSeed) *game.GameConfig {
+	now := time.Now()
+	return &game.GameConfig{
+		ID:        gc.ID,
+		GameID:    gc.GameID,
+		AssetID:   gc.AssetID,
+		IsActive:  boolOr(gc.IsActive, true),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// optionalString returns nil for an empty string
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,431 @@
+package seed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/reelstrip"
+)
+
+// Action is what syncing does to one entity
+type Action string
+
+const (
+	ActionCreate    Action = "create"
+	ActionUpdate    Action = "update"
+	ActionUnchanged Action = "unchanged"
+	// ActionConflict marks differences that cannot be applied in place
+	// (reel strip data is immutable, game configs cannot be re-pointed)
+	ActionConflict Action = "conflict"
+)
+
+// Change describes the difference between a manifest entity and the database
+type Change struct {
+	Kind   string
+	ID     uuid.UUID
+	Name   string
+	Action Action
+	Fields []string
+
+	apply func(ctx context.Context) error
+}
+
+// String renders the change as one line of diff output
+func (c Change) String() string {
+	marker := map[Action]string{
+		ActionCreate:    "+",
+		ActionUpdate:    "~",
+		ActionUnchanged: "=",
+		ActionConflict:  "!",
+	}[c.Action]
+
+	line := fmt.Sprintf("%s %s %s", marker, c.Kind, c.ID)
+	if c.Name != "" {
+		line += fmt.Sprintf(" (%s)", c.Name)
+	}
+	if len(c.Fields) > 0 {
+		line += ": " + strings.Join(c.Fields, ", ")
+	}
+	return line
+}
+
+// Plan compares a manifest with the database and returns one change per entity
+// Unlike Apply, existing entities that differ from the manifest are updated.
+// Nothing is written until ApplyPlan is called.
+func (s *Seeder) Plan(ctx context.Context, m *Manifest) ([]Change, error) {
+	var changes []Change
+
+	for _, rs := range m.ReelStrips {
+		c, err := s.planReelStrip(ctx, rs)
+		if err != nil {
+			return nil, fmt.Errorf("reel strip %s: %w", rs.ID, err)
+		}
+		changes = append(changes, c)
+	}
+
+	for _, rc := range m.ReelStripConfigs {
+		c, err := s.planReelStripConfig(ctx, m, rc)
+		if err != nil {
+			return nil, fmt.Errorf("reel strip config %s: %w", rc.ID, err)
+		}
+		changes = append(changes, c)
+	}
+
+	for _, a := range m.Assets {
+		c, err := s.planAsset(ctx, m, a)
+		if err != nil {
+			return nil, fmt.Errorf("asset %s: %w", a.ID, err)
+		}
+		changes = append(changes, c)
+	}
+
+	for _, g := range m.Games {
+		c, err := s.planGame(ctx, g)
+		if err != nil {
+			return nil, fmt.Errorf("game %s: %w", g.ID, err)
+		}
+		changes = append(changes, c)
+	}
+
+	for _, gc := range m.GameConfigs {
+		c, err := s.planGameConfig(ctx, gc)
+		if err != nil {
+			return nil, fmt.Errorf("game config %s: %w", gc.ID, err)
+		}
+		changes = append(changes, c)
+	}
+
+	return changes, nil
+}
+
+// ErrSyncConflict is returned by ApplyPlan when the plan contains conflicts
+var ErrSyncConflict = errors.New("sync plan has conflicts")
+
+// ApplyPlan applies the create and update changes of a plan in order
+// Plans with conflicts are rejected as a whole before anything is written.
+func (s *Seeder) ApplyPlan(ctx context.Context, changes []Change) error {
+	for _, c := range changes {
+		if c.Action == ActionConflict {
+			return fmt.Errorf("%w: %s", ErrSyncConflict, c)
+		}
+	}
+
+	for _, c := range changes {
+		if c.apply == nil {
+			continue
+		}
+		if err := c.apply(ctx); err != nil {
+			return fmt.Errorf("%s %s: %w", c.Kind, c.ID, err)
+		}
+		s.logger.Info().
+			Str("kind", c.Kind).
+			Str("id", c.ID.String()).
+			Str("action", string(c.Action)).
+			Strs("fields", c.Fields).
+			Msg("Sync change applied")
+	}
+	return nil
+}
+
+// planReelStrip compares a reel strip; strip data is immutable once created
+func (s *Seeder) planReelStrip(ctx context.Context, rs ReelStripSeed) (Change, error) {
+	c := Change{Kind: "reel_strip", ID: rs.ID, Name: fmt.Sprintf("%s reel %d", rs.GameMode, rs.ReelNumber)}
+	want := newReelStrip(rs)
+
+	existing, err := s.reelStripRepo.GetByID(ctx, rs.ID)
+	if errors.Is(err, reelstrip.ErrReelStripNotFound) {
+		c.Action = ActionCreate
+		c.apply = func(ctx context.Context) error { return s.reelStripRepo.Create(ctx, want) }
+		return c, nil
+	}
+	if err != nil {
+		return c, err
+	}
+
+	if existing.Checksum != want.Checksum || existing.GameMode != want.GameMode || existing.ReelNumber != want.ReelNumber {
+		c.Action = ActionConflict
+		c.Fields = []string{"strip_data"}
+		return c, nil
+	}
+
+	diff(&c.Fields, "is_active", existing.IsActive, want.IsActive)
+	diff(&c.Fields, "notes", existing.Notes, want.Notes)
+	if len(c.Fields) == 0 {
+		c.Action = ActionUnchanged
+		return c, nil
+	}
+
+	c.Action = ActionUpdate
+	c.apply = func(ctx context.Context) error {
+		existing.IsActive = want.IsActive
+		existing.Notes = want.Notes
+		return s.reelStripRepo.Update(ctx, existing)
+	}
+	return c, nil
+}
+
+// planReelStripConfig compares a reel strip config, including its default flag
+func (s *Seeder) planReelStripConfig(ctx context.Context, m *Manifest, rc ReelStripConfigSeed) (Change, error) {
+	c := Change{Kind: "reel_strip_config", ID: rc.ID, Name: rc.Name}
+	want, err := m.newReelStripConfig(rc)
+	if err != nil {
+		return c, err
+	}
+
+	existing, err := s.reelStripRepo.GetConfigByID(ctx, rc.ID)
+	if errors.Is(err, reelstrip.ErrConfigNotFound) {
+		c.Action = ActionCreate
+		c.apply = func(ctx context.Context) error { return s.createReelStripConfig(ctx, want, rc.IsDefault) }
+		return c, nil
+	}
+	if err != nil {
+		return c, err
+	}
+
+	diff(&c.Fields, "name", existing.Name, want.Name)
+	diff(&c.Fields, "game_mode", existing.GameMode, want.GameMode)
+	diff(&c.Fields, "description", existing.Description, want.Description)
+	diff(&c.Fields, "reel_strip_ids",
+		[5]uuid.UUID{existing.Reel0StripID, existing.Reel1StripID, existing.Reel2StripID, existing.Reel3StripID, existing.Reel4StripID},
+		[5]uuid.UUID{want.Reel0StripID, want.Reel1StripID, want.Reel2StripID, want.Reel3StripID, want.Reel4StripID})
+	diff(&c.Fields, "target_rtp", existing.TargetRTP, want.TargetRTP)
+	diff(&c.Fields, "is_active", existing.IsActive, want.IsActive)
+	diff(&c.Fields, "is_default", existing.IsDefault, rc.IsDefault)
+	diff(&c.Fields, "notes", existing.Notes, want.Notes)
+	if !jsonEqual(existing.Options, want.Options) {
+		c.Fields = append(c.Fields, "options")
+	}
+	if len(c.Fields) == 0 {
+		c.Action = ActionUnchanged
+		return c, nil
+	}
+
+	c.Action = ActionUpdate
+	c.apply = func(ctx context.Context) error {
+		if existing.IsActive != want.IsActive {
+			if want.IsActive {
+				existing.ActivatedAt = &want.UpdatedAt
+			} else {
+				existing.DeactivatedAt = &want.UpdatedAt
+			}
+		}
+		existing.Name = want.Name
+		existing.GameMode = want.GameMode
+		existing.Description = want.Description
+		existing.Reel0StripID = want.Reel0StripID
+		existing.Reel1StripID = want.Reel1StripID
+		existing.Reel2StripID = want.Reel2StripID
+		existing.Reel3StripID = want.Reel3StripID
+		existing.Reel4StripID = want.Reel4StripID
+		existing.TargetRTP = want.TargetRTP
+		existing.IsActive = want.IsActive
+		existing.Notes = want.Notes
+		existing.Options = want.Options
+		existing.UpdatedAt = want.UpdatedAt
+		wasDefault := existing.IsDefault
+		// Becoming default goes through SetDefaultConfig so the previous default is unset
+		existing.IsDefault = wasDefault && rc.IsDefault
+		if err := s.reelStripRepo.UpdateConfig(ctx, existing); err != nil {
+			return err
+		}
+		if rc.IsDefault && !wasDefault {
+			return s.reelStripRepo.SetDefaultConfig(ctx, existing.ID, existing.GameMode)
+		}
+		return nil
+	}
+	return c, nil
+}
+
+// planAsset compares an asset; the base URL follows the target storage
+func (s *Seeder) planAsset(ctx context.Context, m *Manifest, a AssetSeed) (Change, error) {
+	c := Change{Kind: "asset", ID: a.ID, Name: a.Name}
+	want, err := s.newAsset(m, a)
+	if err != nil {
+		return c, err
+	}
+
+	existing, err := s.gameRepo.GetAssetByID(ctx, a.ID)
+	if errors.Is(err, game.ErrAssetNotFound) {
+		c.Action = ActionCreate
+		c.apply = func(ctx context.Context) error { return s.createAsset(ctx, want) }
+		return c, nil
+	}
+	if err != nil {
+		return c, err
+	}
+
+	update := &game.AssetUpdate{}
+	if existing.Name != want.Name {
+		c.Fields = append(c.Fields, "name")
+		update.Name = &want.Name
+	}
+	if derefString(existing.Description) != derefString(want.Description) {
+		c.Fields = append(c.Fields, "description")
+		update.Description = want.Description
+	}
+	if existing.ObjectName != want.ObjectName {
+		c.Fields = append(c.Fields, "object_name")
+		update.ObjectName = &want.ObjectName
+		update.BaseURL = &want.BaseURL
+	}
+	jsonFields := []struct {
+		name          string
+		current, next json.RawMessage
+		dst           *json.RawMessage
+	}{
+		{"spritesheet_json", existing.SpritesheetJSON, want.SpritesheetJSON, &update.SpritesheetJSON},
+		{"images", existing.Images, want.Images, &update.Images},
+		{"audios", existing.Audios, want.Audios, &update.Audios},
+		{"videos", existing.Videos, want.Videos, &update.Videos},
+	}
+	for _, f := range jsonFields {
+		if !jsonEqual(f.current, f.next) {
+			c.Fields = append(c.Fields, f.name)
+			*f.dst = f.next
+		}
+	}
+	if existing.IsPrivate != want.IsPrivate {
+		c.Fields = append(c.Fields, "is_private")
+		update.IsPrivate = &want.IsPrivate
+	}
+	if existing.IsActive != want.IsActive {
+		c.Fields = append(c.Fields, "is_active")
+		update.IsActive = &want.IsActive
+	}
+	if len(c.Fields) == 0 {
+		c.Action = ActionUnchanged
+		return c, nil
+	}
+
+	c.Action = ActionUpdate
+	c.apply = func(ctx context.Context) error {
+		// Files are not copied between environments; a renamed asset starts with an empty folder
+		if update.ObjectName != nil {
+			if err := s.storage.CreateFolder(ctx, want.ObjectName); err != nil {
+				s.logger.Warn().Err(err).Str("object_name", want.ObjectName).Msg("Failed to create storage folder")
+			}
+		}
+		_, err := s.gameRepo.UpdateAsset(ctx, a.ID, update)
+		return err
+	}
+	return c, nil
+}
+
+// planGame compares a game
+func (s *Seeder) planGame(ctx context.Context, g GameSeed) (Change, error) {
+	c := Change{Kind: "game", ID: g.ID, Name: g.Name}
+	want := newGame(g)
+
+	existing, err := s.gameRepo.GetGameByID(ctx, g.ID)
+	if errors.Is(err, game.ErrGameNotFound) {
+		c.Action = ActionCreate
+		c.apply = func(ctx context.Context) error { return s.gameRepo.CreateGame(ctx, want) }
+		return c, nil
+	}
+	if err != nil {
+		return c, err
+	}
+
+	update := &game.GameUpdate{}
+	if existing.Name != want.Name {
+		c.Fields = append(c.Fields, "name")
+		update.Name = &want.Name
+	}
+	if derefString(existing.Description) != g.Description {
+		c.Fields = append(c.Fields, "description")
+		update.Description = &g.Description
+	}
+	if derefString(existing.DevURL) != g.DevURL {
+		c.Fields = append(c.Fields, "dev_url")
+		update.DevURL = &g.DevURL
+	}
+	if derefString(existing.ProdURL) != g.ProdURL {
+		c.Fields = append(c.Fields, "prod_url")
+		update.ProdURL = &g.ProdURL
+	}
+	if existing.IsActive != want.IsActive {
+		c.Fields = append(c.Fields, "is_active")
+		update.IsActive = &want.IsActive
+	}
+	if len(c.Fields) == 0 {
+		c.Action = ActionUnchanged
+		return c, nil
+	}
+
+	c.Action = ActionUpdate
+	c.apply = func(ctx context.Context) error {
+		_, err := s.gameRepo.UpdateGame(ctx, g.ID, update)
+		return err
+	}
+	return c, nil
+}
+
+// planGameConfig compares a game config; only its active flag can change in place
+func (s *Seeder) planGameConfig(ctx context.Context, gc GameConfigSeed) (Change, error) {
+	c := Change{Kind: "game_config", ID: gc.ID}
+	want := newGameConfig(gc)
+
+	existing, err := s.gameRepo.GetGameConfigByID(ctx, gc.ID)
+	if errors.Is(err, game.ErrGameConfigNotFound) {
+		c.Action = ActionCreate
+		c.apply = func(ctx context.Context) error { return s.gameRepo.CreateGameConfig(ctx, want) }
+		return c, nil
+	}
+	if err != nil {
+		return c, err
+	}
+
+	diff(&c.Fields, "game_id", existing.GameID, want.GameID)
+	diff(&c.Fields, "asset_id", existing.AssetID, want.AssetID)
+	if len(c.Fields) > 0 {
+		c.Action = ActionConflict
+		return c, nil
+	}
+
+	if existing.IsActive == want.IsActive {
+		c.Action = ActionUnchanged
+		return c, nil
+	}
+
+	c.Action = ActionUpdate
+	c.Fields = []string{"is_active"}
+	c.apply = func(ctx context.Context) error {
+		if want.IsActive {
+			_, err := s.gameRepo.ActivateGameConfig(ctx, gc.ID)
+			return err
+		}
+		_, err := s.gameRepo.DeactivateGameConfig(ctx, gc.ID)
+		return err
+	}
+	return c, nil
+}
+
+// diff appends field to fields when the two values differ
+func diff[T comparable](fields *[]string, field string, current, next T) {
+	if current != next {
+		*fields = append(*fields, field)
+	}
+}
+
+// jsonEqual reports whether two JSON documents are semantically equal
+// Empty input is treated as null.
+func jsonEqual(a, b json.RawMessage) bool {
+	var va, vb any
+	if len(a) > 0 {
+		if err := json.Unmarshal(a, &va); err != nil {
+			return false
+		}
+	}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, &vb); err != nil {
+			return false
+		}
+	}
+	return reflect.DeepEqual(va, vb)
+}
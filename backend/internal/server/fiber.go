@@ -2,12 +2,15 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/compress"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/recover"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
+	"github.com/slotmachine/backend/internal/api/dto"
 	"github.com/slotmachine/backend/internal/config"
 	"github.com/slotmachine/backend/internal/middleware"
 	"github.com/slotmachine/backend/internal/pkg/logger"
@@ -45,6 +48,7 @@ func NewFiberApp(cfg *config.Config, log *logger.Logger) *fiber.App {
 		AllowOrigins:     cfg.CORS.AllowedOrigins,
 		AllowMethods:     cfg.CORS.AllowedMethods,
 		AllowHeaders:     cfg.CORS.AllowedHeaders,
+		ExposeHeaders:    "X-Spin-Signature,X-Spin-Signature-Kid", // Signatures of binary spin responses
 		AllowCredentials: false,
 		MaxAge:           300,
 	}))
@@ -69,7 +73,7 @@ func requestLogger(log *logger.Logger) fiber.Handler {
 		duration := time.Since(start)
 		tracedLog := log.WithTrace(c)
 
-		if c.Path() != "/health" {
+		if path := c.Path(); path != "/health" && path != "/healthz" && path != "/readyz" {
 			tracedLog.Info().
 				Str("method", c.Method()).
 				Str("path", c.Path()).
@@ -86,12 +90,22 @@ func requestLogger(log *logger.Logger) fiber.Handler {
 // customErrorHandler handles Fiber errors with traceID and clientIP
 func customErrorHandler(log *logger.Logger) fiber.ErrorHandler {
 	return func(c *fiber.Ctx, err error) error {
-		// Default error code
-		code := fiber.StatusInternalServerError
+		resp := dto.ErrorResponse{
+			Error:   domainErrors.CodeInternalError,
+			Message: "Internal server error",
+		}
 
-		// Check if it's a Fiber error
-		if e, ok := err.(*fiber.Error); ok {
-			code = e.Code
+		var coded *domainErrors.Error
+		var fiberErr *fiber.Error
+		switch {
+		case errors.As(err, &coded):
+			resp = dto.ErrorResponse{Error: coded.Code, Message: coded.Message, Details: coded.Details}
+		case errors.As(err, &fiberErr):
+			resp = dto.ErrorResponse{Error: fiberErrorCode(fiberErr.Code), Message: fiberErr.Message}
+		}
+		status := resp.Error.Status()
+		if fiberErr != nil {
+			status = fiberErr.Code
 		}
 
 		// Log the error with trace info
@@ -100,16 +114,32 @@ func customErrorHandler(log *logger.Logger) fiber.ErrorHandler {
 			Err(err).
 			Str("method", c.Method()).
 			Str("path", c.Path()).
-			Int("status", code).
+			Int("status", status).
+			Str("code", string(resp.Error)).
 			Msg("Request error")
 
-		// Return JSON error response
-		return c.Status(code).JSON(fiber.Map{
-			"success": false,
-			"error": fiber.Map{
-				"code":    "INTERNAL_ERROR",
-				"message": err.Error(),
-			},
-		})
+		return c.Status(status).JSON(resp)
+	}
+}
+
+// fiberErrorCode maps Fiber's built-in errors (unknown routes, oversized bodies...) to catalogued codes
+func fiberErrorCode(status int) domainErrors.Code {
+	switch status {
+	case fiber.StatusBadRequest:
+		return domainErrors.CodeInvalidRequest
+	case fiber.StatusUnauthorized:
+		return domainErrors.CodeUnauthorized
+	case fiber.StatusForbidden:
+		return domainErrors.CodeForbidden
+	case fiber.StatusNotFound, fiber.StatusMethodNotAllowed:
+		return domainErrors.CodeNotFound
+	case fiber.StatusRequestEntityTooLarge:
+		return domainErrors.CodeFileTooLarge
+	case fiber.StatusTooManyRequests:
+		return domainErrors.CodeRateLimitExceeded
+	case fiber.StatusServiceUnavailable:
+		return domainErrors.CodeServiceUnavailable
+	default:
+		return domainErrors.CodeInternalError
 	}
 }
@@ -3,10 +3,13 @@ package server
 import (
 	"github.com/gofiber/fiber/v2"
 	adminDomain "github.com/slotmachine/backend/domain/admin"
+	dashboardDomain "github.com/slotmachine/backend/domain/dashboard"
+	domainErrors "github.com/slotmachine/backend/domain/errors"
 	playerDomain "github.com/slotmachine/backend/domain/player"
 	"github.com/slotmachine/backend/internal/api/handler"
 	"github.com/slotmachine/backend/internal/api/middleware"
 	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/i18n"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/slotmachine/backend/internal/service"
 )
@@ -20,21 +23,61 @@ func SetupRoutes(
 	trialRateLimiter *middleware.TrialRateLimiter,
 	authHandler *handler.AuthHandler,
 	playerHandler *handler.PlayerHandler,
+	statsHandler *handler.StatsHandler,
 	sessionHandler *handler.SessionHandler,
+	autoplayHandler *handler.AutoplayHandler,
 	spinHandler *handler.SpinHandler,
 	freeSpinsHandler *handler.FreeSpinsHandler,
 	provablyFairHandler *handler.ProvablyFairHandler,
 	adminReelStripHandler *handler.AdminReelStripHandler,
+	adminReelStripCanaryHandler *handler.AdminReelStripCanaryHandler,
+	adminExposureHandler *handler.AdminExposureHandler,
+	adminRuntimeConfigHandler *handler.AdminRuntimeConfigHandler,
 	adminPlayerAssignmentHandler *handler.AdminPlayerAssignmentHandler,
+	adminSegmentHandler *handler.AdminSegmentHandler,
+	adminVIPHandler *handler.AdminVIPHandler,
+	jurisdictionHandler *handler.JurisdictionHandler,
+	adminJurisdictionHandler *handler.AdminJurisdictionHandler,
+	adminBigWinHandler *handler.AdminBigWinHandler,
+	adminArchiveHandler *handler.AdminArchiveHandler,
+	adminFinancialReportHandler *handler.AdminFinancialReportHandler,
+	adminNotificationHandler *handler.AdminNotificationHandler,
+	adminSessionHandler *handler.AdminSessionHandler,
+	adminImpersonationHandler *handler.AdminImpersonationHandler,
+	adminPlayerNoteHandler *handler.AdminPlayerNoteHandler,
+	adminDashboardHandler *handler.AdminDashboardHandler,
+	adminSpinFeedHandler *handler.AdminSpinFeedHandler,
+	featureFlagHandler *handler.FeatureFlagHandler,
+	adminFeatureFlagHandler *handler.AdminFeatureFlagHandler,
+	translationHandler *handler.TranslationHandler,
+	adminTranslationHandler *handler.AdminTranslationHandler,
+	adminTrialHandler *handler.AdminTrialHandler,
 	adminAuthHandler *handler.AdminAuthHandler,
+	jwksHandler *handler.JWKSHandler,
+	healthHandler *handler.HealthHandler,
+	launchHandler *handler.LaunchHandler,
+	transparencyHandler *handler.TransparencyHandler,
 	adminManagementHandler *handler.AdminManagementHandler,
 	adminPlayerHandler *handler.AdminPlayerHandler,
+	adminGraphQLHandler *handler.AdminGraphQLHandler,
+	adminTimelineHandler *handler.AdminTimelineHandler,
+	adminDisputeHandler *handler.AdminDisputeHandler,
+	adminApprovalHandler *handler.AdminApprovalHandler,
+	kycHandler *handler.KYCHandler,
+	adminKYCHandler *handler.AdminKYCHandler,
+	privacyHandler *handler.PrivacyHandler,
+	historyExportHandler *handler.HistoryExportHandler,
+	notificationHandler *handler.NotificationHandler,
+	balanceHandler *handler.BalanceHandler,
+	adminPrivacyHandler *handler.AdminPrivacyHandler,
+	adminCertificationHandler *handler.AdminCertificationHandler,
 	gameHandler *handler.GameHandler,
 	adminGameHandler *handler.AdminGameHandler,
 	adminUploadHandler *handler.AdminUploadHandler,
 	adminChunkedUploadHandler *handler.AdminChunkedUploadHandler,
 	adminDirectUploadHandler *handler.AdminDirectUploadHandler,
 	trialHandler *handler.TrialHandler,
+	trialConversionHandler *handler.TrialConversionHandler,
 	// Trial-specific handlers (separate endpoints from production)
 	trialSpinHandler *handler.TrialSpinHandler,
 	trialFreeSpinsHandler *handler.TrialFreeSpinsHandler,
@@ -42,7 +85,10 @@ func SetupRoutes(
 	trialPlayerHandler *handler.TrialPlayerHandler,
 	adminService adminDomain.Service,
 	playerService playerDomain.Service,
+	dashboardService dashboardDomain.Service,
 	trialService *service.TrialService,
+	impersonationService *service.ImpersonationService,
+	translator *i18n.Translator,
 ) {
 	// Health check endpoint (no auth required)
 	app.Get("/health", func(c *fiber.Ctx) error {
@@ -54,16 +100,37 @@ func SetupRoutes(
 		})
 	})
 
+	// Kubernetes probes and load balancer gating (no auth required)
+	// Liveness always answers 200; readiness answers 503 while a dependency is down.
+	app.Get("/healthz", healthHandler.Liveness)
+	app.Get("/readyz", healthHandler.Readiness)
+
+	// Public keys for verifying admin tokens (no auth required)
+	app.Get("/.well-known/jwks.json", jwksHandler.GetJWKS)
+
 	// API v1 routes
 	v1 := app.Group("/v1")
+	// Player-facing responses are localized from Accept-Language
+	v1.Use(middleware.LocaleMiddleware(translator))
 
 	// Rate limiters
 	publicRateLimiter := rateLimiter.PublicMiddleware()
 	authRateLimiter := rateLimiter.AuthenticatedMiddleware()
 
 	// Session-based auth middleware (pure session, no JWT)
-	// Now supports trial tokens (prefixed with "trial_")
-	sessionAuthMiddleware := middleware.SessionAuthMiddleware(log, playerService, trialService)
+	// Now supports trial tokens (prefixed with "trial_") and read-only admin impersonation tokens (prefixed with "imp_")
+	sessionAuthMiddleware := middleware.SessionAuthMiddleware(log, playerService, trialService, impersonationService)
+
+	// Error catalog (no auth required) - stable error codes and their localization keys
+	v1.Get("/errors", publicRateLimiter, func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{
+			"success": true,
+			"data":    domainErrors.All(),
+		})
+	})
+
+	// Translations (no auth required) - client-side strings and error message keys
+	v1.Get("/translations", publicRateLimiter, translationHandler.GetTranslations)
 
 	// Public routes (no auth) - Apply public rate limiter
 	auth := v1.Group("/auth")
@@ -71,6 +138,10 @@ func SetupRoutes(
 	auth.Post("/register", authHandler.Register)
 	auth.Post("/login", authHandler.Login)
 	auth.Post("/logout", sessionAuthMiddleware, authHandler.Logout)
+	auth.Post("/logout-all", sessionAuthMiddleware, authHandler.LogoutEverywhere)
+	auth.Post("/refresh", authHandler.Refresh)
+	auth.Post("/revoke", authHandler.RevokeRefreshToken)
+	auth.Post("/launch", launchHandler.Redeem)
 	// Trial mode - start a trial session (no auth required)
 	// SECURITY: Protected by TrialRateLimiter to prevent DoS attacks
 	// - Max 3 concurrent sessions per IP
@@ -78,12 +149,22 @@ func SetupRoutes(
 	// - Global session limit of 100,000
 	auth.Post("/trial", trialRateLimiter.TrialCreationMiddleware(), trialHandler.StartTrial)
 
+	// Operator routes (require a request signed with the operator secret)
+	operator := v1.Group("/operator")
+	operator.Use(publicRateLimiter, middleware.OperatorAuthMiddleware(cfg, log))
+	operator.Post("/launch", launchHandler.Launch)
+	operator.Post("/credits", balanceHandler.OperatorCredit)
+
 	// Trial routes (require trial auth) - completely separate from production
 	trial := v1.Group("/trial")
 	trial.Use(sessionAuthMiddleware, authRateLimiter)
 	// Trial profile/balance (original)
 	trial.Get("/profile", trialHandler.GetTrialProfile)
 	trial.Get("/balance", trialHandler.GetTrialBalance)
+	trial.Get("/features", trialHandler.GetTrialFeatures)
+	trial.Post("/balance/reset", trialHandler.ResetTrialBalance)
+	trial.Post("/balance/top-up", trialHandler.TopUpTrialBalance)
+	trial.Post("/convert", trialConversionHandler.ConvertTrial)
 	// Trial player (new dedicated handler)
 	trial.Get("/player/balance", trialPlayerHandler.GetBalance)
 	// Trial session
@@ -99,6 +180,8 @@ func SetupRoutes(
 
 	// Game assets (no auth required - needed for game initialization)
 	v1.Get("/game-assets", publicRateLimiter, gameHandler.GetGameAssets)
+	v1.Get("/games/:id/assets/manifest", publicRateLimiter, gameHandler.GetAssetManifest)
+	v1.Get("/games/:id/paytable", publicRateLimiter, gameHandler.GetPaytable)
 
 	// Protected routes (require session auth) - Apply authenticated rate limiter
 
@@ -107,25 +190,47 @@ func SetupRoutes(
 	player.Use(sessionAuthMiddleware, authRateLimiter)
 	player.Get("/profile", authHandler.GetProfile)
 	player.Get("/balance", playerHandler.GetBalance)
+	player.Get("/events", balanceHandler.StreamEvents)
+	player.Get("/stats", statsHandler.GetMyStats)
+	player.Get("/stats/daily", statsHandler.GetMyDailyStats)
+	player.Get("/jurisdiction", jurisdictionHandler.GetMyJurisdiction)
+	player.Get("/kyc", kycHandler.GetMyKYC)
+	player.Post("/kyc", kycHandler.StartMyKYC)
+	player.Get("/data-export", middleware.DenyImpersonation(), privacyHandler.ExportMyData)
+	player.Post("/history-exports", historyExportHandler.CreateExport)
+	player.Get("/history-exports", historyExportHandler.ListExports)
+	player.Get("/history-exports/:id", historyExportHandler.GetExport)
+	player.Get("/history-exports/:id/download", middleware.DenyImpersonation(), historyExportHandler.DownloadExport)
+	player.Get("/notifications", notificationHandler.GetUnread)
+	player.Post("/notifications/read-all", notificationHandler.MarkAllRead)
+	player.Post("/notifications/:id/read", notificationHandler.MarkRead)
+	player.Get("/features", featureFlagHandler.GetMyFeatures)
 
 	// Session routes
 	session := v1.Group("/session")
 	session.Use(sessionAuthMiddleware, authRateLimiter)
 	session.Post("/start", sessionHandler.StartSession)
 	session.Post("/:sessionId/end", sessionHandler.EndSession)
+	session.Post("/:sessionId/reality-check", sessionHandler.AcknowledgeRealityCheck)
+	session.Post("/:sessionId/autoplay", autoplayHandler.StartAutoplay)
+	session.Get("/:sessionId/autoplay", autoplayHandler.GetAutoplay)
+	session.Delete("/:sessionId/autoplay", autoplayHandler.StopAutoplay)
 	session.Get("/history", sessionHandler.GetSessionHistory)
 
 	// Spin routes
 	spin := v1.Group("/base-spins")
 	spin.Use(sessionAuthMiddleware, authRateLimiter)
-	spin.Post("/spin", spinHandler.ExecuteSpin)
+	spin.Post("/spin", middleware.CountSpinRequests(dashboardService), spinHandler.ExecuteSpin)
 	spin.Get("/histories", spinHandler.GetSpinHistory)
+	spin.Get("/pending", spinHandler.GetPendingSpin)
+	spin.Post("/pending/ack", spinHandler.AcknowledgeSpin)
 
 	// Free spins routes
 	freeSpins := v1.Group("/free-spins")
 	freeSpins.Use(sessionAuthMiddleware, authRateLimiter)
 	freeSpins.Get("/status", freeSpinsHandler.GetStatus)
-	freeSpins.Post("/spin", freeSpinsHandler.ExecuteFreeSpin)
+	freeSpins.Post("/spin", middleware.CountSpinRequests(dashboardService), freeSpinsHandler.ExecuteFreeSpin)
+	freeSpins.Post("/autoplay", freeSpinsHandler.ExecuteAllFreeSpins)
 
 	// Provably Fair routes
 	pf := v1.Group("/pf")
@@ -138,11 +243,19 @@ func SetupRoutes(
 	// Verification routes (can be public for third-party verification)
 	pfVerify := v1.Group("/pf/verify")
 	pfVerify.Use(authRateLimiter)                                            // Only rate limit, no auth required for verification
+	pfVerify.Get("/signing-key", spinHandler.GetSigningKey)                  // Public key spin responses are signed with
 	pfVerify.Get("/:sessionId", provablyFairHandler.GetVerificationData)     // Get verification data
 	pfVerify.Post("/spin", provablyFairHandler.VerifySpin)                   // Verify single spin hash
 	pfVerify.Post("/spin-with-reel", provablyFairHandler.VerifySpinWithReel) // Verify spin + reel positions
 	pfVerify.Post("/:sessionId", provablyFairHandler.VerifySession)          // Verify session hash chain
 
+	// Spin hash transparency log (public, for auditors)
+	transparencyLog := v1.Group("/transparency")
+	transparencyLog.Use(authRateLimiter)
+	transparencyLog.Get("/checkpoints", transparencyHandler.ListCheckpoints)
+	transparencyLog.Get("/checkpoints/:sequence", transparencyHandler.GetCheckpoint) // Checkpoint with its leaves
+	transparencyLog.Get("/proof/:spinId", transparencyHandler.GetProof)              // Merkle inclusion proof of a spin
+
 	// Admin routes
 	admin := v1.Group("/admin")
 
@@ -157,6 +270,26 @@ func SetupRoutes(
 	adminAuth.Get("/profile", adminAuthMiddleware, authRateLimiter, adminAuthHandler.GetProfile)
 	adminAuth.Post("/change-password", adminAuthMiddleware, authRateLimiter, adminAuthHandler.ChangePassword)
 
+	// Admin two-factor authentication
+	adminTwoFactor := adminAuth.Group("/2fa")
+	adminTwoFactor.Use(adminAuthMiddleware, authRateLimiter)
+	adminTwoFactor.Get("/", adminAuthHandler.GetTwoFactorStatus)
+	adminTwoFactor.Post("/enroll", adminAuthHandler.BeginTwoFactorEnrollment)
+	adminTwoFactor.Post("/enroll/confirm", adminAuthHandler.ConfirmTwoFactorEnrollment)
+	adminTwoFactor.Post("/verify", adminAuthHandler.VerifyTwoFactor)
+	adminTwoFactor.Post("/disable", adminAuthHandler.DisableTwoFactor)
+	adminTwoFactor.Post("/recovery-codes", adminAuthHandler.RegenerateRecoveryCodes)
+	adminTwoFactor.Get("/events", adminAuthHandler.ListTwoFactorEvents)
+
+	// Destructive operations additionally require a recent second-factor verification
+	requireTwoFactor := middleware.AdminTwoFactorMiddleware(cfg, log, adminService)
+
+	// Admin token signing keys
+	adminSigningKeys := adminAuth.Group("/signing-keys")
+	adminSigningKeys.Use(adminAuthMiddleware, authRateLimiter)
+	adminSigningKeys.Get("/", jwksHandler.ListSigningKeys)
+	adminSigningKeys.Post("/rotate", requireTwoFactor, jwksHandler.RotateSigningKey)
+
 	// Admin Management (require admin auth)
 	adminMgmt := admin.Group("/management")
 	adminMgmt.Use(adminAuthMiddleware, authRateLimiter)
@@ -167,8 +300,8 @@ func SetupRoutes(
 	adminUsers.Get("/", adminManagementHandler.ListAdmins)
 	adminUsers.Get("/:id", adminManagementHandler.GetAdmin)
 	adminUsers.Put("/:id", adminManagementHandler.UpdateAdmin)
-	adminUsers.Delete("/:id", adminManagementHandler.DeleteAdmin)
-	adminUsers.Post("/:id/reset-password", adminManagementHandler.ResetPassword)
+	adminUsers.Delete("/:id", requireTwoFactor, adminManagementHandler.DeleteAdmin)
+	adminUsers.Post("/:id/reset-password", requireTwoFactor, adminManagementHandler.ResetPassword)
 	adminUsers.Post("/:id/activate", adminManagementHandler.ActivateAdmin)
 	adminUsers.Post("/:id/deactivate", adminManagementHandler.DeactivateAdmin)
 	adminUsers.Post("/:id/suspend", adminManagementHandler.SuspendAdmin)
@@ -178,19 +311,42 @@ func SetupRoutes(
 	adminReelConfigs.Use(adminAuthMiddleware, authRateLimiter)
 	adminReelConfigs.Post("/", adminReelStripHandler.CreateConfig)
 	adminReelConfigs.Get("/", adminReelStripHandler.ListConfigs)
+	adminReelConfigs.Get("/cache-stats", adminReelStripHandler.CacheStats)
+	adminReelConfigs.Post("/validate", adminReelStripHandler.ValidateStrips)
+	adminReelConfigs.Post("/preview", adminReelStripHandler.PreviewStrips)
+	adminReelConfigs.Get("/weights", adminReelStripHandler.GetWeights)
+	adminReelConfigs.Post("/generate", adminReelStripHandler.GenerateStrips)
 	adminReelConfigs.Get("/:id", adminReelStripHandler.GetConfig)
-	adminReelConfigs.Put("/:id", adminReelStripHandler.UpdateConfig)
-	adminReelConfigs.Post("/:id/activate", adminReelStripHandler.ActivateConfig)
-	adminReelConfigs.Post("/:id/deactivate", adminReelStripHandler.DeactivateConfig)
-	adminReelConfigs.Post("/set-default", adminReelStripHandler.SetDefaultConfig)
+	adminReelConfigs.Put("/:id", requireTwoFactor, adminReelStripHandler.UpdateConfig)
+	adminReelConfigs.Post("/:id/activate", requireTwoFactor, adminReelStripHandler.ActivateConfig)
+	adminReelConfigs.Post("/:id/deactivate", requireTwoFactor, adminReelStripHandler.DeactivateConfig)
+	adminReelConfigs.Delete("/:id", requireTwoFactor, adminReelStripHandler.DeleteConfig)
+	adminReelConfigs.Post("/:id/restore", requireTwoFactor, adminReelStripHandler.RestoreConfig)
+	adminReelConfigs.Post("/set-default", requireTwoFactor, adminReelStripHandler.SetDefaultConfig)
+
+	// Admin - Reel Strip Canaries (trial rollouts of a config to a share of players)
+	adminCanaries := admin.Group("/reel-strip-canaries")
+	adminCanaries.Use(adminAuthMiddleware, authRateLimiter)
+	adminCanaries.Get("/", adminReelStripCanaryHandler.ListCanaries)
+	adminCanaries.Post("/", requireTwoFactor, adminReelStripCanaryHandler.StartCanary)
+	adminCanaries.Get("/:id", adminReelStripCanaryHandler.GetCanary)
+	adminCanaries.Post("/:id/abort", adminReelStripCanaryHandler.AbortCanary)
+
+	// Admin - Operator Reel Strip Defaults (contracted RTP per operator)
+	adminOperatorDefaults := admin.Group("/operator-reel-strip-defaults")
+	adminOperatorDefaults.Use(adminAuthMiddleware, authRateLimiter)
+	adminOperatorDefaults.Get("/", adminReelStripHandler.ListOperatorDefaults)
+	adminOperatorDefaults.Get("/:operatorId", adminReelStripHandler.GetOperatorDefault)
+	adminOperatorDefaults.Put("/:operatorId", requireTwoFactor, adminReelStripHandler.SetOperatorDefault)
+	adminOperatorDefaults.Delete("/:operatorId", requireTwoFactor, adminReelStripHandler.RemoveOperatorDefault)
 
 	// Admin - Player Assignment Management
 	adminAssignments := admin.Group("/player-assignments")
 	adminAssignments.Use(adminAuthMiddleware, authRateLimiter)
-	adminAssignments.Post("/", adminPlayerAssignmentHandler.CreateAssignment)
+	adminAssignments.Post("/", requireTwoFactor, adminPlayerAssignmentHandler.CreateAssignment)
 	adminAssignments.Get("/:playerId", adminPlayerAssignmentHandler.GetPlayerAssignment)
-	adminAssignments.Put("/:playerId", adminPlayerAssignmentHandler.UpdateAssignment)
-	adminAssignments.Post("/:playerId/assign", adminPlayerAssignmentHandler.AssignConfigToPlayer)
+	adminAssignments.Put("/:playerId", requireTwoFactor, adminPlayerAssignmentHandler.UpdateAssignment)
+	adminAssignments.Post("/:playerId/assign", requireTwoFactor, adminPlayerAssignmentHandler.AssignConfigToPlayer)
 	adminAssignments.Delete("/:playerId", adminPlayerAssignmentHandler.RemoveAssignment)
 
 	// Admin - Player Management
@@ -198,10 +354,179 @@ func SetupRoutes(
 	adminPlayers.Use(adminAuthMiddleware, authRateLimiter)
 	adminPlayers.Post("/", adminPlayerHandler.CreatePlayer)
 	adminPlayers.Get("/", adminPlayerHandler.ListPlayers)
+	adminPlayers.Get("/search", adminPlayerHandler.SearchPlayers)
 	adminPlayers.Get("/:id", adminPlayerHandler.GetPlayer)
 	adminPlayers.Post("/:id/activate", adminPlayerHandler.ActivatePlayer)
 	adminPlayers.Post("/:id/deactivate", adminPlayerHandler.DeactivatePlayer)
 	adminPlayers.Post("/:id/force-logout", adminPlayerHandler.ForceLogoutPlayer)
+	adminPlayers.Get("/:id/tags", adminSegmentHandler.ListPlayerTags)
+	adminPlayers.Post("/:id/tags", adminSegmentHandler.AddPlayerTag)
+	adminPlayers.Delete("/:id/tags/:tag", adminSegmentHandler.RemovePlayerTag)
+	adminPlayers.Get("/:id/segments", adminSegmentHandler.GetPlayerSegments)
+	adminPlayers.Get("/:id/vip", adminVIPHandler.GetPlayerStatus)
+	adminPlayers.Put("/:id/jurisdiction", adminJurisdictionHandler.SetPlayerJurisdiction)
+	adminPlayers.Get("/:id/kyc", adminKYCHandler.GetPlayerKYC)
+	adminPlayers.Put("/:id/kyc", adminKYCHandler.SetPlayerKYC)
+	adminPlayers.Post("/:id/kyc/refresh", adminKYCHandler.RefreshPlayerKYC)
+	adminPlayers.Get("/:id/data-export", adminPrivacyHandler.ExportPlayerData)
+	adminPlayers.Post("/:id/erase", requireTwoFactor, adminPrivacyHandler.ErasePlayer)
+	adminPlayers.Post("/:id/balance-adjustments", requireTwoFactor, adminApprovalHandler.ProposeBalanceAdjustment)
+	adminPlayers.Get("/:id/stats", statsHandler.GetPlayerStats)
+	adminPlayers.Get("/:id/timeline", adminTimelineHandler.GetPlayerTimeline)
+	adminPlayers.Get("/:id/notes", adminPlayerNoteHandler.ListNotes)
+	adminPlayers.Post("/:id/notes", adminPlayerNoteHandler.CreateNote)
+	adminPlayers.Put("/:id/notes/:noteId", adminPlayerNoteHandler.UpdateNote)
+	adminPlayers.Delete("/:id/notes/:noteId", adminPlayerNoteHandler.DeleteNote)
+	adminPlayers.Get("/:id/stats/daily", statsHandler.GetPlayerDailyStats)
+	adminPlayers.Get("/:id/trial-conversion", trialConversionHandler.GetPlayerConversion)
+	adminPlayers.Post("/:id/impersonate", middleware.AdminPermissionMiddleware(adminDomain.PermissionPlayersImpersonate), requireTwoFactor, adminImpersonationHandler.ImpersonatePlayer)
+
+	// Admin - Player Impersonation audit (grants and the requests made with them)
+	adminImpersonations := admin.Group("/impersonations")
+	adminImpersonations.Use(adminAuthMiddleware, authRateLimiter, middleware.AdminPermissionMiddleware(adminDomain.PermissionPlayersImpersonate))
+	adminImpersonations.Get("/", adminImpersonationHandler.ListGrants)
+	adminImpersonations.Get("/:id/accesses", adminImpersonationHandler.ListAccesses)
+	adminImpersonations.Delete("/:id", adminImpersonationHandler.RevokeGrant)
+
+	// Admin - GraphQL gateway (composed reads with field-level permissions)
+	adminGraphQL := admin.Group("/graphql")
+	adminGraphQL.Use(adminAuthMiddleware, authRateLimiter)
+	adminGraphQL.Post("/", adminGraphQLHandler.Query)
+	adminGraphQL.Get("/schema", adminGraphQLHandler.Schema)
+
+	// Admin - Spin Disputes (support workflow with provably fair verification)
+	adminDisputes := admin.Group("/disputes")
+	adminDisputes.Use(adminAuthMiddleware, authRateLimiter)
+	adminDisputes.Get("/", adminDisputeHandler.ListDisputes)
+	adminDisputes.Post("/", adminDisputeHandler.OpenDispute)
+	adminDisputes.Get("/:id", adminDisputeHandler.GetDispute)
+	adminDisputes.Post("/:id/assign", adminDisputeHandler.AssignDispute)
+	adminDisputes.Post("/:id/verify", adminDisputeHandler.VerifyDispute)
+	adminDisputes.Post("/:id/notes", adminDisputeHandler.AddDisputeNote)
+	adminDisputes.Post("/:id/close", adminDisputeHandler.CloseDispute)
+
+	// Admin - Change Requests (four-eyes approval of reel strip, RTP and balance changes)
+	adminChangeRequests := admin.Group("/change-requests")
+	adminChangeRequests.Use(adminAuthMiddleware, authRateLimiter)
+	adminChangeRequests.Get("/", adminApprovalHandler.ListChangeRequests)
+	adminChangeRequests.Get("/:id", adminApprovalHandler.GetChangeRequest)
+	adminChangeRequests.Post("/:id/approve", requireTwoFactor, adminApprovalHandler.ApproveChangeRequest)
+	adminChangeRequests.Post("/:id/reject", adminApprovalHandler.RejectChangeRequest)
+
+	// Admin - Certification event log (GLI/BMM test lab submissions)
+	adminCertification := admin.Group("/certification")
+	adminCertification.Use(adminAuthMiddleware, authRateLimiter)
+	adminCertification.Get("/events", adminCertificationHandler.ExportEvents)
+
+	// Admin - Player Segments (targets for reel strip assignments, bonuses and rate limits)
+	adminSegments := admin.Group("/segments")
+	adminSegments.Use(adminAuthMiddleware, authRateLimiter)
+	adminSegments.Get("/", adminSegmentHandler.ListSegments)
+	adminSegments.Post("/", adminSegmentHandler.CreateSegment)
+	adminSegments.Get("/:id", adminSegmentHandler.GetSegment)
+	adminSegments.Put("/:id", adminSegmentHandler.UpdateSegment)
+	adminSegments.Delete("/:id", adminSegmentHandler.DeleteSegment)
+	adminSegments.Get("/:id/members/:playerId", adminSegmentHandler.CheckMembership)
+	adminSegments.Get("/:id/targets", adminSegmentHandler.ListTargets)
+	adminSegments.Post("/:id/targets", adminSegmentHandler.CreateTarget)
+	adminSegments.Put("/:id/targets/:targetId", adminSegmentHandler.UpdateTarget)
+	adminSegments.Delete("/:id/targets/:targetId", adminSegmentHandler.DeleteTarget)
+
+	// Admin - VIP Tiers
+	adminVIP := admin.Group("/vip")
+	adminVIP.Use(adminAuthMiddleware, authRateLimiter)
+	adminVIP.Get("/tiers", adminVIPHandler.ListTiers)
+	adminVIP.Post("/tiers", adminVIPHandler.CreateTier)
+	adminVIP.Get("/tiers/:id", adminVIPHandler.GetTier)
+	adminVIP.Put("/tiers/:id", adminVIPHandler.UpdateTier)
+	adminVIP.Delete("/tiers/:id", adminVIPHandler.DeleteTier)
+
+	// Admin - Jurisdictions
+	adminJurisdictions := admin.Group("/jurisdictions")
+	adminJurisdictions.Use(adminAuthMiddleware, authRateLimiter)
+	adminJurisdictions.Get("/", adminJurisdictionHandler.ListJurisdictions)
+	adminJurisdictions.Post("/", adminJurisdictionHandler.CreateJurisdiction)
+	adminJurisdictions.Get("/:code", adminJurisdictionHandler.GetJurisdiction)
+	adminJurisdictions.Put("/:code", adminJurisdictionHandler.UpdateJurisdiction)
+	adminJurisdictions.Delete("/:code", adminJurisdictionHandler.DeleteJurisdiction)
+
+	// Admin - Big Wins
+	adminPF := admin.Group("/provably-fair")
+	adminPF.Use(adminAuthMiddleware, authRateLimiter)
+	adminPF.Get("/chain-audits", provablyFairHandler.ListChainAudits)
+
+	adminBigWins := admin.Group("/big-wins")
+	adminBigWins.Use(adminAuthMiddleware, authRateLimiter)
+	adminBigWins.Get("/", adminBigWinHandler.ListBigWins)
+	adminBigWins.Get("/:id", adminBigWinHandler.GetBigWin)
+
+	// Admin - Liability Exposure (open free spins and the bonus buy circuit breakers)
+	adminExposure := admin.Group("/exposure")
+	adminExposure.Use(adminAuthMiddleware, authRateLimiter)
+	adminExposure.Get("/", adminExposureHandler.GetExposure)
+
+	// Admin - Runtime Config (settings hot-reloaded from RUNTIME_CONFIG_FILE)
+	adminRuntimeConfig := admin.Group("/runtime-config")
+	adminRuntimeConfig.Use(adminAuthMiddleware, authRateLimiter)
+	adminRuntimeConfig.Get("/", adminRuntimeConfigHandler.GetRuntimeConfig)
+
+	// Admin - Spin Archives
+	adminArchives := admin.Group("/archives")
+	adminArchives.Use(adminAuthMiddleware, authRateLimiter)
+	adminArchives.Get("/", adminArchiveHandler.ListArchives)
+	adminArchives.Get("/:id", adminArchiveHandler.GetArchive)
+	adminArchives.Post("/:id/restore", requireTwoFactor, adminArchiveHandler.RestoreArchive)
+	adminArchives.Delete("/:id/restore", adminArchiveHandler.EvictArchive)
+
+	// Admin - Daily Financial Reports
+	adminFinancialReports := admin.Group("/financial-reports")
+	adminFinancialReports.Use(adminAuthMiddleware, authRateLimiter)
+	adminFinancialReports.Get("/", adminFinancialReportHandler.ListReports)
+	adminFinancialReports.Get("/csv", adminFinancialReportHandler.DownloadReports)
+	adminFinancialReports.Post("/generate", adminFinancialReportHandler.GenerateReport)
+
+	// Admin - Notifications
+	adminNotifications := admin.Group("/notifications")
+	adminNotifications.Use(adminAuthMiddleware, authRateLimiter)
+	adminNotifications.Post("/", adminNotificationHandler.SendNotification)
+
+	// Admin - Game Sessions (force-end with free spins settlement)
+	adminSessions := admin.Group("/sessions")
+	adminSessions.Use(adminAuthMiddleware, authRateLimiter)
+	adminSessions.Post("/:id/end", adminSessionHandler.EndSession)
+	adminSessions.Get("/:id/settlements", adminSessionHandler.ListSettlements)
+
+	// Admin - Ops Dashboard
+	adminDashboard := admin.Group("/dashboard")
+	adminDashboard.Use(adminAuthMiddleware, authRateLimiter)
+	adminDashboard.Get("/", adminDashboardHandler.GetDashboard)
+
+	// Admin - Live Ops Spin Feed
+	adminSpinFeed := admin.Group("/spin-feed")
+	adminSpinFeed.Use(adminAuthMiddleware, authRateLimiter)
+	adminSpinFeed.Get("/", adminSpinFeedHandler.GetFeed)
+	adminSpinFeed.Get("/stream", adminSpinFeedHandler.StreamFeed)
+
+	// Admin - Feature Flags
+	adminFeatureFlags := admin.Group("/feature-flags")
+	adminFeatureFlags.Use(adminAuthMiddleware, authRateLimiter)
+	adminFeatureFlags.Get("/", adminFeatureFlagHandler.ListFlags)
+	adminFeatureFlags.Get("/:name", adminFeatureFlagHandler.GetFlag)
+	adminFeatureFlags.Put("/:name", adminFeatureFlagHandler.SetFlag)
+	adminFeatureFlags.Delete("/:name", adminFeatureFlagHandler.ResetFlag)
+
+	// Admin - Translations
+	adminTranslations := admin.Group("/translations")
+	adminTranslations.Use(adminAuthMiddleware, authRateLimiter)
+	adminTranslations.Get("/", adminTranslationHandler.ListLocales)
+	adminTranslations.Get("/:locale", adminTranslationHandler.GetBundle)
+	adminTranslations.Put("/:locale", adminTranslationHandler.SetBundle)
+	adminTranslations.Delete("/:locale", adminTranslationHandler.DeleteBundle)
+
+	// Admin - Trial (demo) Settings
+	adminTrial := admin.Group("/trial-settings")
+	adminTrial.Use(adminAuthMiddleware, authRateLimiter)
+	adminTrial.Get("/", adminTrialHandler.ListSettings)
 
 	// Admin - Game Management
 	adminGames := admin.Group("/games")
@@ -211,8 +536,12 @@ func SetupRoutes(
 	adminGames.Post("/", adminGameHandler.CreateGame)
 	adminGames.Put("/:id", adminGameHandler.UpdateGame)
 	adminGames.Delete("/:id", adminGameHandler.DeleteGame)
+	adminGames.Post("/:id/restore", adminGameHandler.RestoreGame)
 	adminGames.Post("/:id/activate", adminGameHandler.ActivateGame)
 	adminGames.Post("/:id/deactivate", adminGameHandler.DeactivateGame)
+	adminGames.Get("/:id/trial-settings", adminTrialHandler.GetSettings)
+	adminGames.Put("/:id/trial-settings", adminTrialHandler.UpdateSettings)
+	adminGames.Delete("/:id/trial-settings", adminTrialHandler.DeleteSettings)
 
 	// Admin - Asset Management
 	adminAssets := admin.Group("/assets")
@@ -222,8 +551,13 @@ func SetupRoutes(
 	adminAssets.Post("/", adminGameHandler.CreateAsset)
 	adminAssets.Put("/:id", adminGameHandler.UpdateAsset)
 	adminAssets.Delete("/:id", adminGameHandler.DeleteAsset)
+	adminAssets.Post("/:id/restore", adminGameHandler.RestoreAsset)
 	adminAssets.Post("/:id/activate", adminGameHandler.ActivateAsset)
 	adminAssets.Post("/:id/deactivate", adminGameHandler.DeactivateAsset)
+	adminAssets.Post("/:id/optimize-images", adminGameHandler.OptimizeAssetImages)
+	adminAssets.Post("/:id/rehash", adminGameHandler.RehashAssetFiles)
+	adminAssets.Get("/:id/usage", adminGameHandler.GetAssetUsage)
+	adminAssets.Post("/:id/usage/cleanup", adminGameHandler.CleanupAssetOrphans)
 
 	// Admin - Game Config Management (link games to assets)
 	adminGameConfigs := admin.Group("/game-configs")
@@ -232,8 +566,19 @@ func SetupRoutes(
 	adminGameConfigs.Get("/:id", adminGameHandler.GetGameConfig)
 	adminGameConfigs.Post("/", adminGameHandler.CreateGameConfig)
 	adminGameConfigs.Delete("/:id", adminGameHandler.DeleteGameConfig)
-	adminGameConfigs.Post("/:id/activate", adminGameHandler.ActivateGameConfig)
-	adminGameConfigs.Post("/:id/deactivate", adminGameHandler.DeactivateGameConfig)
+	adminGameConfigs.Post("/:id/restore", adminGameHandler.RestoreGameConfig)
+	adminGameConfigs.Post("/:id/activate", requireTwoFactor, adminGameHandler.ActivateGameConfig)
+	adminGameConfigs.Post("/:id/deactivate", requireTwoFactor, adminGameHandler.DeactivateGameConfig)
+	adminGameConfigs.Put("/:id/multipliers", requireTwoFactor, adminGameHandler.SetGameConfigMultipliers)
+	adminGameConfigs.Delete("/:id/multipliers", requireTwoFactor, adminGameHandler.ResetGameConfigMultipliers)
+	adminGameConfigs.Put("/:id/trigger-rules", requireTwoFactor, adminGameHandler.SetGameConfigTriggerRules)
+	adminGameConfigs.Delete("/:id/trigger-rules", requireTwoFactor, adminGameHandler.ResetGameConfigTriggerRules)
+	adminGameConfigs.Put("/:id/wild-features", requireTwoFactor, adminGameHandler.SetGameConfigWildFeatures)
+	adminGameConfigs.Delete("/:id/wild-features", requireTwoFactor, adminGameHandler.ResetGameConfigWildFeatures)
+	adminGameConfigs.Put("/:id/win-tiers", adminGameHandler.SetGameConfigWinTiers)
+	adminGameConfigs.Delete("/:id/win-tiers", adminGameHandler.ResetGameConfigWinTiers)
+	adminGameConfigs.Put("/:id/timing", adminGameHandler.SetGameConfigTimingProfiles)
+	adminGameConfigs.Delete("/:id/timing", adminGameHandler.ResetGameConfigTimingProfiles)
 
 	// Hide route upload use only direct-upload for now
 	// Admin - File Upload Management
@@ -252,8 +597,8 @@ func SetupRoutes(
 	// adminUpload.Get("/:theme/chunked/:uploadId/status", adminChunkedUploadHandler.GetUploadStatus)
 	// adminUpload.Delete("/:theme/chunked/:uploadId", adminChunkedUploadHandler.AbortChunkedUpload)
 
-	// // Admin - Processing Status (for background upload processing)
-	// adminUpload.Get("/status/:uploadId", adminChunkedUploadHandler.GetProcessingStatus)
+	// Admin - Processing Status (for background processing: chunked uploads, image optimization)
+	adminUpload.Get("/status/:uploadId", adminChunkedUploadHandler.GetProcessingStatus)
 
 	// Admin - Direct Upload (presigned URL for client-side upload to storage)
 	adminDirectUpload := admin.Group("/direct-upload")
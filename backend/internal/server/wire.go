@@ -1,18 +1,65 @@
 package server
 
 import (
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/wire"
+	"github.com/slotmachine/backend/internal/api/middleware"
 	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/featureflags"
 	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/pkg/runtimeconfig"
+	"github.com/slotmachine/backend/internal/service"
 )
 
 // ProviderSet is the Wire provider set for server
 var ProviderSet = wire.NewSet(
 	ProvideFiberApp,
+	ProvideRuntimeConfigWatcher,
 )
 
 // ProvideFiberApp creates a new Fiber application
 func ProvideFiberApp(cfg *config.Config, log *logger.Logger) *fiber.App {
 	return NewFiberApp(cfg, log)
 }
+
+// ProvideRuntimeConfigWatcher provides the runtime config watcher with every hot-reloadable component registered
+// Boot values come from the environment, so removing a setting from the file restores it.
+func ProvideRuntimeConfigWatcher(
+	cfg *config.Config,
+	rateLimiter *middleware.RateLimiter,
+	flags *featureflags.Service,
+	jurisdictions *service.JurisdictionService,
+	log *logger.Logger,
+) *runtimeconfig.Watcher {
+	limits := rateLimiter.Limits()
+	boot := runtimeconfig.Settings{
+		LogLevel:     logger.Level(),
+		RateLimit:    runtimeconfig.RateLimit{AuthRPS: limits.AuthRPS, PublicRPS: limits.PublicRPS},
+		FeatureFlags: cfg.FeatureFlags.Flags,
+	}
+	interval := time.Duration(cfg.Runtime.PollSeconds) * time.Second
+	w := runtimeconfig.NewWatcher(cfg.Runtime.File, interval, boot, log)
+
+	w.Register("logger", func(s runtimeconfig.Settings) error {
+		return logger.SetLevel(s.LogLevel)
+	})
+	w.Register("rate_limiter", func(s runtimeconfig.Settings) error {
+		rateLimiter.SetLimits(middleware.RateLimiterConfig{AuthRPS: s.RateLimit.AuthRPS, PublicRPS: s.RateLimit.PublicRPS})
+		return nil
+	})
+	w.Register("feature_flags", func(s runtimeconfig.Settings) error {
+		defaults, err := featureflags.ParseDefaults(s.FeatureFlags)
+		if err != nil {
+			return err
+		}
+		flags.SetDefaults(defaults)
+		return nil
+	})
+	w.Register("reality_checks", func(s runtimeconfig.Settings) error {
+		jurisdictions.SetRealityCheckOverrides(s.RealityCheckMinutes)
+		return nil
+	})
+	return w
+}
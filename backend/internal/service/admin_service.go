@@ -9,7 +9,9 @@ import (
 	adminDomain "github.com/slotmachine/backend/domain/admin"
 	gameDomain "github.com/slotmachine/backend/domain/game"
 	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/playernote"
 	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/segment"
 	"github.com/slotmachine/backend/domain/session"
 	"github.com/slotmachine/backend/internal/config"
 	"github.com/slotmachine/backend/internal/infra/cache"
@@ -25,7 +27,10 @@ type AdminService struct {
 	reelStripRepo     reelstrip.Repository
 	gameRepo          gameDomain.Repository
 	playerSessionRepo session.PlayerSessionRepository
+	noteRepo          playernote.Repository
 	cache             *cache.RedisClient
+	refreshStore      session.RefreshTokenStore // nil when refresh tokens are unavailable
+	keyring           *JWTKeyring               // nil signs and verifies HS256 tokens with the JWT secret
 	cfg               *config.Config
 	logger            *logger.Logger
 }
@@ -37,7 +42,10 @@ func NewAdminService(
 	reelStripRepo reelstrip.Repository,
 	gameRepo gameDomain.Repository,
 	playerSessionRepo session.PlayerSessionRepository,
+	noteRepo playernote.Repository,
 	redisCache *cache.RedisClient,
+	refreshStore session.RefreshTokenStore,
+	keyring *JWTKeyring,
 	cfg *config.Config,
 	log *logger.Logger,
 ) adminDomain.Service {
@@ -47,7 +55,10 @@ func NewAdminService(
 		reelStripRepo:     reelStripRepo,
 		gameRepo:          gameRepo,
 		playerSessionRepo: playerSessionRepo,
+		noteRepo:          noteRepo,
 		cache:             redisCache,
+		refreshStore:      refreshStore,
+		keyring:           keyring,
 		cfg:               cfg,
 		logger:            log,
 	}
@@ -106,7 +117,7 @@ func (s *AdminService) Login(ctx context.Context, username, password, ip string)
 	}
 
 	// Generate JWT token (admin has no game_id, pass nil)
-	token, err := util.GenerateJWT(adm.ID.String(), adm.Username, nil, s.cfg.JWT.Secret, s.cfg.JWT.ExpirationHours)
+	token, err := s.signToken(util.NewClaims(adm.ID.String(), adm.Username, nil, s.cfg.JWT.ExpirationHours))
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to generate token: %w", err)
 	}
@@ -126,7 +137,7 @@ func (s *AdminService) Login(ctx context.Context, username, password, ip string)
 func (s *AdminService) ValidateToken(ctx context.Context, token string) (*adminDomain.Admin, error) {
 	// log := s.logger.WithTraceContext(ctx)
 	// Parse and validate token
-	claims, err := util.ValidateJWT(token, s.cfg.JWT.Secret)
+	claims, err := s.parseToken(token)
 	if err != nil {
 		return nil, fmt.Errorf("invalid token: %w", err)
 	}
@@ -150,6 +161,22 @@ func (s *AdminService) ValidateToken(ctx context.Context, token string) (*adminD
 	return adm, nil
 }
 
+// signToken signs admin claims with the rotating keyring, or HS256 without one
+func (s *AdminService) signToken(claims *util.Claims) (string, error) {
+	if s.keyring != nil {
+		return s.keyring.Sign(claims)
+	}
+	return util.GenerateJWTWithSecret(claims, s.cfg.JWT.Secret)
+}
+
+// parseToken validates an admin token against the keyring, or HS256 without one
+func (s *AdminService) parseToken(token string) (*util.Claims, error) {
+	if s.keyring != nil {
+		return s.keyring.Validate(token)
+	}
+	return util.ValidateJWT(token, s.cfg.JWT.Secret)
+}
+
 // CreateAdmin creates a new admin
 func (s *AdminService) CreateAdmin(ctx context.Context, req adminDomain.CreateAdminRequest, createdBy uuid.UUID) (*adminDomain.Admin, error) {
 	log := s.logger.WithTraceContext(ctx)
@@ -564,6 +591,25 @@ func (s *AdminService) GetPlayer(ctx context.Context, id uuid.UUID) (interface{}
 		}
 	}
 
+	// Add support notes, with flags apart so they are seen first
+	if s.noteRepo != nil {
+		notes, err := s.noteRepo.ListByPlayer(ctx, p.ID, nil)
+		if err != nil {
+			log.Error().Err(err).Str("player_id", id.String()).Msg("Failed to list player notes")
+		}
+		flags := []*playernote.Note{}
+		others := []*playernote.Note{}
+		for _, n := range notes {
+			if n.Kind == playernote.KindFlag {
+				flags = append(flags, n)
+			} else {
+				others = append(others, n)
+			}
+		}
+		result["flags"] = flags
+		result["notes"] = others
+	}
+
 	return result, nil
 }
 
@@ -589,6 +635,91 @@ func (s *AdminService) ListPlayers(ctx context.Context, filters adminDomain.Play
 		return nil, 0, fmt.Errorf("failed to list players: %w", err)
 	}
 
+	result := s.playerSummaries(ctx, players)
+
+	log.Info().
+		Int("count", len(players)).
+		Int64("total", total).
+		Msg("Listed players")
+
+	return result, total, nil
+}
+
+// SearchPlayers retrieves one keyset page of players matching the filters
+// It returns the cursor of the next page, empty on the last page.
+func (s *AdminService) SearchPlayers(ctx context.Context, filters adminDomain.PlayerSearchFilters) (interface{}, string, error) {
+	log := s.logger.WithTraceContext(ctx)
+
+	sortBy := player.SearchSort(filters.SortBy)
+	if filters.SortBy == "" {
+		sortBy = player.SearchSortCreatedAt
+	}
+	if !sortBy.Valid() {
+		return nil, "", fmt.Errorf("%w: unsupported sort %q", player.ErrInvalidInput, filters.SortBy)
+	}
+
+	limit := filters.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	playerFilters := player.SearchFilters{
+		Username:         filters.Username,
+		Email:            filters.Email,
+		GameID:           filters.GameID,
+		IsActive:         filters.IsActive,
+		MinBalance:       filters.MinBalance,
+		MaxBalance:       filters.MaxBalance,
+		RegisteredFrom:   filters.RegisteredFrom,
+		RegisteredTo:     filters.RegisteredTo,
+		LastLoginFrom:    filters.LastLoginFrom,
+		LastLoginTo:      filters.LastLoginTo,
+		AssignedConfigID: filters.AssignedConfigID,
+		Sort:             sortBy,
+		SortDesc:         filters.SortDesc,
+		Limit:            limit + 1, // One extra row tells whether a next page exists
+	}
+	for _, tag := range filters.Tags {
+		if tag = segment.NormalizeTag(tag); tag != "" {
+			playerFilters.Tags = append(playerFilters.Tags, tag)
+		}
+	}
+	if filters.Cursor != "" {
+		cursor, err := player.DecodeSearchCursor(filters.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		if cursor.Sort != sortBy || cursor.Desc != filters.SortDesc {
+			return nil, "", player.ErrInvalidSearchCursor
+		}
+		playerFilters.After = cursor
+	}
+
+	players, err := s.playerRepo.Search(ctx, playerFilters)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to search players")
+		return nil, "", fmt.Errorf("failed to search players: %w", err)
+	}
+
+	var nextCursor string
+	if len(players) > limit {
+		players = players[:limit]
+		nextCursor = player.NewSearchCursor(players[limit-1], sortBy, filters.SortDesc).Encode()
+	}
+
+	log.Info().
+		Int("count", len(players)).
+		Bool("has_more", nextCursor != "").
+		Msg("Searched players")
+
+	return s.playerSummaries(ctx, players), nextCursor, nil
+}
+
+// playerSummaries converts players to the admin list format with their game,
+// reel strip assignment and active session status
+func (s *AdminService) playerSummaries(ctx context.Context, players []*player.Player) []map[string]interface{} {
+	log := s.logger.WithTraceContext(ctx)
+
 	// Get player IDs for bulk assignment lookup
 	playerIDs := make([]uuid.UUID, len(players))
 	for i, p := range players {
@@ -678,12 +809,7 @@ func (s *AdminService) ListPlayers(ctx context.Context, filters adminDomain.Play
 		result[i] = playerData
 	}
 
-	log.Info().
-		Int("count", len(players)).
-		Int64("total", total).
-		Msg("Listed players")
-
-	return result, total, nil
+	return result
 }
 
 // ActivatePlayer activates a player account
@@ -771,6 +897,15 @@ func (s *AdminService) ForceLogoutPlayer(ctx context.Context, playerID uuid.UUID
 		}
 	}
 
+	// Revoke refresh tokens so the sessions cannot be renewed
+	if s.refreshStore != nil && s.cfg.PlayerAuth.RefreshTokenHours > 0 {
+		ttl := time.Duration(s.cfg.PlayerAuth.RefreshTokenHours) * time.Hour
+		if err := s.refreshStore.RevokePlayer(ctx, playerID, time.Now().UTC(), ttl); err != nil {
+			log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to revoke player refresh tokens")
+			return fmt.Errorf("failed to force logout player: %w", err)
+		}
+	}
+
 	log.Info().
 		Str("player_id", playerID.String()).
 		Str("username", p.Username).
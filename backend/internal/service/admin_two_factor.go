@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	adminDomain "github.com/slotmachine/backend/domain/admin"
+	"github.com/slotmachine/backend/internal/pkg/crypto"
+	"github.com/slotmachine/backend/internal/pkg/totp"
+)
+
+const (
+	// recoveryCodeCount is how many recovery codes an admin gets at a time
+	recoveryCodeCount = 10
+	// recoveryCodeAlphabet omits look-alike characters (0/o, 1/l/i)
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+// BeginTwoFactorEnrollment generates a TOTP secret for the admin to add to an authenticator app
+// The secret is stored but 2FA stays off until a code from it is confirmed.
+func (s *AdminService) BeginTwoFactorEnrollment(ctx context.Context, adminID uuid.UUID, ip string) (*adminDomain.TwoFactorEnrollment, error) {
+	adm, err := s.repo.GetByID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if adm.TwoFactorEnabled {
+		return nil, adminDomain.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	encryptor, err := s.twoFactorEncryptor()
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := encryptor.Encrypt(secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt TOTP secret: %w", err)
+	}
+	if err := s.repo.SetTOTPSecret(ctx, adminID, encrypted); err != nil {
+		return nil, err
+	}
+
+	s.recordTwoFactorEvent(ctx, adminID, adminDomain.TwoFactorEnrollmentStarted, ip, "")
+	return &adminDomain.TwoFactorEnrollment{
+		Secret:     secret,
+		OTPAuthURL: totp.URL(s.cfg.AdminAuth.TwoFactorIssuer, adm.Username, secret),
+	}, nil
+}
+
+// ConfirmTwoFactorEnrollment enables 2FA once the admin proves their app produces valid codes
+// Returns the recovery codes, which are shown once and only stored hashed.
+func (s *AdminService) ConfirmTwoFactorEnrollment(ctx context.Context, adminID uuid.UUID, code, ip string) ([]string, error) {
+	adm, err := s.repo.GetByID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if adm.TwoFactorEnabled {
+		return nil, adminDomain.ErrTwoFactorAlreadyEnabled
+	}
+	if adm.TOTPSecret == "" {
+		return nil, adminDomain.ErrTwoFactorNotEnrolled
+	}
+
+	step, ok, err := s.validateTOTP(adm, normalizeTOTPCode(code))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		s.recordTwoFactorEvent(ctx, adminID, adminDomain.TwoFactorVerifyFailed, ip, "enrollment")
+		return nil, adminDomain.ErrInvalidTwoFactorCode
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.EnableTwoFactor(ctx, adminID, step, hashes); err != nil {
+		return nil, err
+	}
+
+	s.recordTwoFactorEvent(ctx, adminID, adminDomain.TwoFactorEnabled, ip, "")
+	return codes, nil
+}
+
+// VerifyTwoFactor checks a TOTP or recovery code, unlocking destructive operations for the step-up window
+// detail describes what the verification is for (e.g. the operation) in the audit log.
+func (s *AdminService) VerifyTwoFactor(ctx context.Context, adminID uuid.UUID, code, ip, detail string) (time.Time, error) {
+	adm, err := s.repo.GetByID(ctx, adminID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := s.checkTwoFactorCode(ctx, adm, code, ip, detail); err != nil {
+		return time.Time{}, err
+	}
+	return time.Now().Add(s.twoFactorStepUpWindow()), nil
+}
+
+// DisableTwoFactor turns 2FA off after checking a current code
+func (s *AdminService) DisableTwoFactor(ctx context.Context, adminID uuid.UUID, code, ip string) error {
+	adm, err := s.repo.GetByID(ctx, adminID)
+	if err != nil {
+		return err
+	}
+	if err := s.checkTwoFactorCode(ctx, adm, code, ip, "disable"); err != nil {
+		return err
+	}
+	if err := s.repo.DisableTwoFactor(ctx, adminID); err != nil {
+		return err
+	}
+
+	s.recordTwoFactorEvent(ctx, adminID, adminDomain.TwoFactorDisabled, ip, "")
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces the admin's recovery codes after checking a current code
+func (s *AdminService) RegenerateRecoveryCodes(ctx context.Context, adminID uuid.UUID, code, ip string) ([]string, error) {
+	adm, err := s.repo.GetByID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTwoFactorCode(ctx, adm, code, ip, "regenerate recovery codes"); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.ReplaceRecoveryCodes(ctx, adminID, hashes); err != nil {
+		return nil, err
+	}
+
+	s.recordTwoFactorEvent(ctx, adminID, adminDomain.TwoFactorRecoveryCodesReset, ip, "")
+	return codes, nil
+}
+
+// GetTwoFactorStatus summarises the admin's two-factor state
+func (s *AdminService) GetTwoFactorStatus(ctx context.Context, adminID uuid.UUID) (*adminDomain.TwoFactorStatus, error) {
+	adm, err := s.repo.GetByID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &adminDomain.TwoFactorStatus{
+		Enabled:   adm.TwoFactorEnabled,
+		EnabledAt: adm.TwoFactorEnabledAt,
+	}
+	if !adm.TwoFactorEnabled {
+		return status, nil
+	}
+
+	window := s.twoFactorStepUpWindow()
+	if adm.TwoFactorVerifiedWithin(window, time.Now()) {
+		until := adm.TwoFactorVerifiedAt.Add(window)
+		status.VerifiedUntil = &until
+	}
+	if status.RecoveryCodesRemaining, err = s.repo.CountUnusedRecoveryCodes(ctx, adminID); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// ListTwoFactorEvents returns the admin's recent two-factor audit events
+func (s *AdminService) ListTwoFactorEvents(ctx context.Context, adminID uuid.UUID, limit int) ([]*adminDomain.TwoFactorEvent, error) {
+	return s.repo.ListTwoFactorEvents(ctx, adminID, limit)
+}
+
+// checkTwoFactorCode accepts a TOTP code (once) or an unused recovery code
+// Failures count towards the login lockout, so codes can't be brute forced.
+func (s *AdminService) checkTwoFactorCode(ctx context.Context, adm *adminDomain.Admin, code, ip, detail string) error {
+	log := s.logger.WithTraceContext(ctx)
+
+	if !adm.TwoFactorEnabled {
+		return adminDomain.ErrTwoFactorNotEnabled
+	}
+	if adm.IsLocked() {
+		return adminDomain.ErrAccountLocked
+	}
+
+	event := adminDomain.TwoFactorVerified
+	verified := false
+	if totpCode := normalizeTOTPCode(code); isTOTPCode(totpCode) {
+		step, ok, err := s.validateTOTP(adm, totpCode)
+		if err != nil {
+			return err
+		}
+		if ok {
+			// A code is only good once, even within its time step
+			if verified, err = s.repo.AcceptTOTPStep(ctx, adm.ID, step); err != nil {
+				return err
+			}
+		}
+	} else {
+		event = adminDomain.TwoFactorRecoveryCodeUsed
+		var err error
+		if verified, err = s.repo.UseRecoveryCode(ctx, adm.ID, hashRecoveryCode(code)); err != nil {
+			return err
+		}
+	}
+
+	if !verified {
+		if err := s.repo.IncrementFailedAttempts(ctx, adm.ID); err != nil {
+			log.Error().Err(err).Msg("Failed to increment failed attempts")
+		}
+		s.recordTwoFactorEvent(ctx, adm.ID, adminDomain.TwoFactorVerifyFailed, ip, detail)
+		return adminDomain.ErrInvalidTwoFactorCode
+	}
+
+	if adm.FailedLoginAttempts > 0 {
+		if err := s.repo.ResetFailedAttempts(ctx, adm.ID); err != nil {
+			log.Error().Err(err).Msg("Failed to reset failed attempts")
+		}
+	}
+	s.recordTwoFactorEvent(ctx, adm.ID, event, ip, detail)
+	return nil
+}
+
+func (s *AdminService) validateTOTP(adm *adminDomain.Admin, code string) (int64, bool, error) {
+	encryptor, err := s.twoFactorEncryptor()
+	if err != nil {
+		return 0, false, err
+	}
+	secret, err := encryptor.Decrypt(adm.TOTPSecret)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to decrypt TOTP secret: %w", err)
+	}
+	step, ok := totp.Validate(secret, code, time.Now())
+	return step, ok, nil
+}
+
+func (s *AdminService) twoFactorEncryptor() (*crypto.AESEncryptor, error) {
+	encryptor, err := crypto.NewAESEncryptor(s.cfg.AdminAuth.TwoFactorEncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ADMIN_2FA_ENCRYPTION_KEY: %w", err)
+	}
+	return encryptor, nil
+}
+
+func (s *AdminService) twoFactorStepUpWindow() time.Duration {
+	return time.Duration(s.cfg.AdminAuth.TwoFactorStepUpMinutes) * time.Minute
+}
+
+// recordTwoFactorEvent writes the audit trail; a failed write is logged but doesn't fail the operation
+func (s *AdminService) recordTwoFactorEvent(ctx context.Context, adminID uuid.UUID, event adminDomain.TwoFactorEventType, ip, detail string) {
+	log := s.logger.WithTraceContext(ctx)
+
+	if err := s.repo.CreateTwoFactorEvent(ctx, &adminDomain.TwoFactorEvent{
+		AdminID:   adminID,
+		Event:     event,
+		IPAddress: ip,
+		Detail:    detail,
+	}); err != nil {
+		log.Error().Err(err).Str("admin_id", adminID.String()).Str("event", string(event)).Msg("Failed to record two-factor event")
+	}
+
+	entry := log.Info()
+	if event == adminDomain.TwoFactorVerifyFailed {
+		entry = log.Warn()
+	}
+	entry.
+		Str("admin_id", adminID.String()).
+		Str("event", string(event)).
+		Str("ip", ip).
+		Str("detail", detail).
+		Msg("Admin two-factor event")
+}
+
+// normalizeTOTPCode strips the spaces authenticator apps group codes with (e.g. "123 456")
+func normalizeTOTPCode(code string) string {
+	return strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+}
+
+// isTOTPCode reports whether a normalized code looks like a TOTP code rather than a recovery code
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// generateRecoveryCodes returns recovery codes formatted xxxxx-xxxxx and their hashes
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	buf := make([]byte, 10)
+	for i := range codes {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		var sb strings.Builder
+		for j, b := range buf {
+			if j == 5 {
+				sb.WriteByte('-')
+			}
+			sb.WriteByte(recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)])
+		}
+		codes[i] = sb.String()
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode hashes a recovery code, ignoring case, spaces and dashes
+func hashRecoveryCode(code string) string {
+	normalized := strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(code)))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	adminDomain "github.com/slotmachine/backend/domain/admin"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/pkg/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAdminRepository mocks the admin lookups and two-factor writes of adminDomain.Repository
+type MockAdminRepository struct {
+	adminDomain.Repository
+	mock.Mock
+}
+
+func (m *MockAdminRepository) GetByID(ctx context.Context, id uuid.UUID) (*adminDomain.Admin, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*adminDomain.Admin), args.Error(1)
+}
+
+func (m *MockAdminRepository) SetTOTPSecret(ctx context.Context, id uuid.UUID, encryptedSecret string) error {
+	return m.Called(ctx, id, encryptedSecret).Error(0)
+}
+
+func (m *MockAdminRepository) EnableTwoFactor(ctx context.Context, id uuid.UUID, step int64, recoveryCodeHashes []string) error {
+	return m.Called(ctx, id, step, recoveryCodeHashes).Error(0)
+}
+
+func (m *MockAdminRepository) AcceptTOTPStep(ctx context.Context, id uuid.UUID, step int64) (bool, error) {
+	args := m.Called(ctx, id, step)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAdminRepository) UseRecoveryCode(ctx context.Context, id uuid.UUID, codeHash string) (bool, error) {
+	args := m.Called(ctx, id, codeHash)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAdminRepository) IncrementFailedAttempts(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockAdminRepository) CreateTwoFactorEvent(ctx context.Context, event *adminDomain.TwoFactorEvent) error {
+	return m.Called(ctx, event).Error(0)
+}
+
+func newTestAdminService(repo adminDomain.Repository) *AdminService {
+	cfg := &config.Config{AdminAuth: config.AdminAuthConfig{
+		TwoFactorIssuer:        "Slot Admin",
+		TwoFactorEncryptionKey: "admin-2fa-test-key-32-bytes!!!!!",
+		TwoFactorStepUpMinutes: 5,
+	}}
+	return NewAdminService(repo, nil, nil, nil, nil, nil, nil, nil, nil, cfg, logger.New("error", "json")).(*AdminService)
+}
+
+func eventOf(event adminDomain.TwoFactorEventType) any {
+	return mock.MatchedBy(func(e *adminDomain.TwoFactorEvent) bool { return e.Event == event })
+}
+
+func TestAdminService_TwoFactor(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAdminRepository)
+	svc := newTestAdminService(repo)
+	adm := &adminDomain.Admin{ID: uuid.New(), Username: "ops"}
+	repo.On("GetByID", ctx, adm.ID).Return(adm, nil)
+
+	// Enrollment stores the encrypted secret and hands back the plaintext once
+	repo.On("SetTOTPSecret", ctx, adm.ID, mock.Anything).Run(func(args mock.Arguments) {
+		adm.TOTPSecret = args.String(2)
+	}).Return(nil).Once()
+	repo.On("CreateTwoFactorEvent", ctx, eventOf(adminDomain.TwoFactorEnrollmentStarted)).Return(nil).Once()
+
+	enrollment, err := svc.BeginTwoFactorEnrollment(ctx, adm.ID, "10.0.0.1")
+	require.NoError(t, err)
+	assert.NotEqual(t, enrollment.Secret, adm.TOTPSecret, "secret must be stored encrypted")
+	assert.Contains(t, enrollment.OTPAuthURL, "otpauth://totp/Slot%20Admin:ops?")
+
+	// Confirming with a wrong code leaves 2FA off
+	repo.On("CreateTwoFactorEvent", ctx, eventOf(adminDomain.TwoFactorVerifyFailed)).Return(nil)
+	_, err = svc.ConfirmTwoFactorEnrollment(ctx, adm.ID, "000000", "10.0.0.1")
+	assert.ErrorIs(t, err, adminDomain.ErrInvalidTwoFactorCode)
+
+	step := totp.Step(time.Now())
+	code, err := totp.Code(enrollment.Secret, step)
+	require.NoError(t, err)
+	repo.On("EnableTwoFactor", ctx, adm.ID, step, mock.MatchedBy(func(hashes []string) bool {
+		return len(hashes) == recoveryCodeCount
+	})).Return(nil).Once()
+	repo.On("CreateTwoFactorEvent", ctx, eventOf(adminDomain.TwoFactorEnabled)).Return(nil).Once()
+
+	recoveryCodes, err := svc.ConfirmTwoFactorEnrollment(ctx, adm.ID, code, "10.0.0.1")
+	require.NoError(t, err)
+	require.Len(t, recoveryCodes, recoveryCodeCount)
+	adm.TwoFactorEnabled = true
+
+	t.Run("replayed TOTP code counts as a failure", func(t *testing.T) {
+		repo.On("AcceptTOTPStep", ctx, adm.ID, step).Return(false, nil).Once()
+		repo.On("IncrementFailedAttempts", ctx, adm.ID).Return(nil).Once()
+
+		_, err := svc.VerifyTwoFactor(ctx, adm.ID, code, "10.0.0.1", "POST /activate")
+		assert.ErrorIs(t, err, adminDomain.ErrInvalidTwoFactorCode)
+	})
+
+	t.Run("recovery code opens the step-up window", func(t *testing.T) {
+		repo.On("UseRecoveryCode", ctx, adm.ID, hashRecoveryCode(recoveryCodes[0])).Return(true, nil).Once()
+		repo.On("CreateTwoFactorEvent", ctx, eventOf(adminDomain.TwoFactorRecoveryCodeUsed)).Return(nil).Once()
+
+		until, err := svc.VerifyTwoFactor(ctx, adm.ID, " "+strings.ToUpper(recoveryCodes[0])+" ", "10.0.0.1", "POST /activate")
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(5*time.Minute), until, time.Second)
+	})
+
+	t.Run("spaced TOTP code is accepted", func(t *testing.T) {
+		nextStep := step + 1
+		nextCode, err := totp.Code(enrollment.Secret, nextStep)
+		require.NoError(t, err)
+		repo.On("AcceptTOTPStep", ctx, adm.ID, nextStep).Return(true, nil).Once()
+		repo.On("CreateTwoFactorEvent", ctx, eventOf(adminDomain.TwoFactorVerified)).Return(nil).Once()
+
+		_, err = svc.VerifyTwoFactor(ctx, adm.ID, nextCode[:3]+" "+nextCode[3:], "10.0.0.1", "POST /activate")
+		require.NoError(t, err)
+	})
+
+	t.Run("locked accounts can't verify", func(t *testing.T) {
+		lockedUntil := time.Now().Add(time.Minute)
+		adm.LockedUntil = &lockedUntil
+		defer func() { adm.LockedUntil = nil }()
+
+		_, err := svc.VerifyTwoFactor(ctx, adm.ID, code, "10.0.0.1", "")
+		assert.ErrorIs(t, err, adminDomain.ErrAccountLocked)
+	})
+
+	repo.AssertExpectations(t)
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes, err := generateRecoveryCodes()
+	require.NoError(t, err)
+	require.Len(t, codes, recoveryCodeCount)
+
+	seen := make(map[string]bool)
+	for i, code := range codes {
+		assert.Regexp(t, `^[a-z2-9]{5}-[a-z2-9]{5}$`, code)
+		assert.Equal(t, hashes[i], hashRecoveryCode(code))
+		assert.False(t, seen[code])
+		seen[code] = true
+	}
+	assert.Equal(t, hashRecoveryCode("abcde-fghjk"), hashRecoveryCode("ABCDE FGHJK"))
+	assert.False(t, isTOTPCode(codes[0]))
+	assert.True(t, isTOTPCode(normalizeTOTPCode(" 123 456 ")))
+	assert.False(t, isTOTPCode("123 456"), "codes are normalized before the format check")
+}
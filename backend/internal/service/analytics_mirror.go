@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/slotmachine/backend/domain/analytics"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// analyticsDropLogEvery is how many dropped events go by between warnings
+const analyticsDropLogEvery = 1000
+
+// AnalyticsMirror mirrors settled spins into the analytics sink in batches
+// Spins publish their events onto a bounded in-memory buffer and never wait for the sink.
+// A batch that fails to write is retried as is until it succeeds. Identical retries are
+// deduplicated by the sink, so an insert that timed out but went through is not counted
+// twice. While the sink is down, events queue in the buffer, and new events are dropped
+// once it is full.
+type AnalyticsMirror struct {
+	sink      analytics.Sink // Optional: nil disables mirroring
+	batchSize int
+	flush     time.Duration
+	timeout   time.Duration
+	logger    *logger.Logger
+
+	events  chan *analytics.SpinEvent
+	dropped atomic.Int64
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewAnalyticsMirror creates a new analytics mirror
+func NewAnalyticsMirror(cfg *config.Config, sink analytics.Sink, log *logger.Logger) *AnalyticsMirror {
+	m := &AnalyticsMirror{
+		sink:      sink,
+		batchSize: cfg.Analytics.BatchSize,
+		flush:     time.Duration(cfg.Analytics.FlushMillis) * time.Millisecond,
+		timeout:   time.Duration(cfg.Analytics.TimeoutSeconds) * time.Second,
+		logger:    log,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	if sink != nil {
+		m.events = make(chan *analytics.SpinEvent, cfg.Analytics.BufferSize)
+	}
+	return m
+}
+
+// Publish queues a spin event for the sink; it drops the event when the buffer is full
+func (m *AnalyticsMirror) Publish(ctx context.Context, event *analytics.SpinEvent) {
+	if m.sink == nil {
+		return
+	}
+
+	select {
+	case m.events <- event:
+	default:
+		if n := m.dropped.Add(1); n%analyticsDropLogEvery == 1 {
+			m.logger.WithTraceContext(ctx).Warn().Int64("dropped", n).Msg("Analytics buffer full, dropping spin events")
+		}
+	}
+}
+
+// Dropped returns how many spin events were dropped because the buffer was full
+func (m *AnalyticsMirror) Dropped() int64 {
+	return m.dropped.Load()
+}
+
+// Start runs the mirror in the background; no sink disables it
+func (m *AnalyticsMirror) Start() {
+	if m.sink == nil {
+		close(m.done)
+		m.logger.Info().Msg("Analytics mirror disabled")
+		return
+	}
+
+	go m.run()
+	m.logger.Info().
+		Str("sink", m.sink.Name()).
+		Int("batch_size", m.batchSize).
+		Dur("flush", m.flush).
+		Msg("Analytics mirror started")
+}
+
+// Stop stops the mirror and writes the events still buffered
+func (m *AnalyticsMirror) Stop() {
+	m.stopOnce.Do(func() { close(m.stop) })
+	<-m.done
+}
+
+func (m *AnalyticsMirror) run() {
+	defer close(m.done)
+
+	ticker := time.NewTicker(m.flush)
+	defer ticker.Stop()
+
+	batch := make([]*analytics.SpinEvent, 0, m.batchSize)
+	failing := false
+	for {
+		// A full or failed batch stops taking events, so its retry writes the same block
+		events := m.events
+		if failing || len(batch) >= m.batchSize {
+			events = nil
+		}
+
+		select {
+		case <-m.stop:
+			m.drain(batch)
+			return
+		case event := <-events:
+			batch = append(batch, event)
+			if len(batch) < m.batchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+
+		if err := m.write(batch); err != nil {
+			if !failing {
+				m.logger.Error().Err(err).Str("sink", m.sink.Name()).Int("events", len(batch)).Msg("Failed to write spin events, retrying")
+			}
+			failing = true
+			continue
+		}
+		if failing {
+			m.logger.Info().Str("sink", m.sink.Name()).Msg("Analytics sink recovered")
+		}
+		failing = false
+		batch = make([]*analytics.SpinEvent, 0, m.batchSize)
+	}
+}
+
+// drain writes the pending batch and everything still buffered, giving up at the first failure
+func (m *AnalyticsMirror) drain(batch []*analytics.SpinEvent) {
+	for {
+	fill:
+		for len(batch) < m.batchSize {
+			select {
+			case event := <-m.events:
+				batch = append(batch, event)
+			default:
+				break fill
+			}
+		}
+		if len(batch) == 0 {
+			return
+		}
+		if err := m.write(batch); err != nil {
+			m.logger.Error().Err(err).Str("sink", m.sink.Name()).Int("events", len(batch)+len(m.events)).Msg("Failed to write spin events on shutdown, dropping them")
+			return
+		}
+		batch = make([]*analytics.SpinEvent, 0, m.batchSize)
+	}
+}
+
+func (m *AnalyticsMirror) write(batch []*analytics.SpinEvent) error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+	return m.sink.Write(ctx, batch)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/analytics"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSink records the batches written to it, failing the first few when failures is set
+type fakeSink struct {
+	mu       sync.Mutex
+	batches  [][]uuid.UUID
+	failures int
+}
+
+func (s *fakeSink) Name() string { return "fake" }
+
+func (s *fakeSink) Write(_ context.Context, events []*analytics.SpinEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]uuid.UUID, len(events))
+	for i, e := range events {
+		ids[i] = e.SpinID
+	}
+	s.batches = append(s.batches, ids)
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("sink unavailable")
+	}
+	return nil
+}
+
+func (s *fakeSink) written() [][]uuid.UUID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([][]uuid.UUID(nil), s.batches...)
+}
+
+func newTestAnalyticsMirror(sink analytics.Sink, batchSize, bufferSize int) *AnalyticsMirror {
+	cfg := &config.Config{
+		Analytics: config.AnalyticsConfig{BatchSize: batchSize, FlushMillis: 10, TimeoutSeconds: 1, BufferSize: bufferSize},
+	}
+	return NewAnalyticsMirror(cfg, sink, logger.New("error", "json"))
+}
+
+func publishSpins(m *AnalyticsMirror, n int) []uuid.UUID {
+	ids := make([]uuid.UUID, n)
+	for i := range ids {
+		ids[i] = uuid.New()
+		m.Publish(context.Background(), &analytics.SpinEvent{SpinID: ids[i]})
+	}
+	return ids
+}
+
+func TestAnalyticsMirror(t *testing.T) {
+	t.Run("should write full batches and flush partial ones", func(t *testing.T) {
+		sink := &fakeSink{}
+		m := newTestAnalyticsMirror(sink, 2, 10)
+		m.Start()
+		defer m.Stop()
+
+		ids := publishSpins(m, 3)
+		require.Eventually(t, func() bool { return len(sink.written()) == 2 }, time.Second, 5*time.Millisecond)
+
+		assert.Equal(t, [][]uuid.UUID{ids[:2], ids[2:]}, sink.written())
+	})
+
+	t.Run("should retry a failed batch unchanged", func(t *testing.T) {
+		sink := &fakeSink{failures: 2}
+		m := newTestAnalyticsMirror(sink, 2, 10)
+		ids := publishSpins(m, 2)
+		m.Start()
+		defer m.Stop()
+
+		require.Eventually(t, func() bool { return len(sink.written()) == 3 }, time.Second, 5*time.Millisecond)
+		publishSpins(m, 1)
+		require.Eventually(t, func() bool { return len(sink.written()) == 4 }, time.Second, 5*time.Millisecond)
+
+		written := sink.written()
+		for _, batch := range written[:3] {
+			assert.Equal(t, ids, batch, "a retry must resend the identical block")
+		}
+		assert.Len(t, written[3], 1)
+	})
+
+	t.Run("should write buffered events on stop", func(t *testing.T) {
+		sink := &fakeSink{}
+		m := newTestAnalyticsMirror(sink, 100, 10)
+		m.Start()
+		ids := publishSpins(m, 3)
+		m.Stop()
+
+		var written []uuid.UUID
+		for _, batch := range sink.written() {
+			written = append(written, batch...)
+		}
+		assert.Equal(t, ids, written)
+	})
+
+	t.Run("should drop events once the buffer is full", func(t *testing.T) {
+		m := newTestAnalyticsMirror(&fakeSink{}, 10, 2)
+		publishSpins(m, 5)
+		assert.Equal(t, int64(3), m.Dropped())
+	})
+
+	t.Run("should do nothing without a sink", func(t *testing.T) {
+		m := newTestAnalyticsMirror(nil, 10, 2)
+		m.Start()
+		publishSpins(m, 5)
+		m.Stop()
+		assert.Zero(t, m.Dropped())
+	})
+}
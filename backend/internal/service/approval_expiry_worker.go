@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// ApprovalExpiryWorker periodically expires change requests left unreviewed past their expiry
+// Stale proposals would otherwise stay approvable long after the situation they were made for.
+type ApprovalExpiryWorker struct {
+	approvals *ApprovalService
+	interval  time.Duration
+	clock     clock.Clock
+	logger    *logger.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewApprovalExpiryWorker creates a new change request expiry worker
+func NewApprovalExpiryWorker(cfg *config.Config, approvals *ApprovalService, clk clock.Clock, log *logger.Logger) *ApprovalExpiryWorker {
+	return &ApprovalExpiryWorker{
+		approvals: approvals,
+		interval:  time.Duration(cfg.Approval.ExpiryIntervalMinutes) * time.Minute,
+		clock:     clk,
+		logger:    log,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start runs the worker in the background; a zero interval disables it
+func (w *ApprovalExpiryWorker) Start() {
+	if w.interval <= 0 {
+		close(w.done)
+		w.logger.Info().Msg("Change request expiry worker disabled")
+		return
+	}
+
+	go w.run()
+	w.logger.Info().Dur("interval", w.interval).Msg("Change request expiry worker started")
+}
+
+// Stop stops the worker and waits for a running pass to finish
+func (w *ApprovalExpiryWorker) Stop() {
+	w.stopOnce.Do(func() { close(w.stop) })
+	<-w.done
+}
+
+func (w *ApprovalExpiryWorker) run() {
+	defer close(w.done)
+
+	ticker := w.clock.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C():
+			w.Run(context.Background())
+		}
+	}
+}
+
+// Run expires stale change requests and returns how many it expired
+func (w *ApprovalExpiryWorker) Run(ctx context.Context) int {
+	expired, err := w.approvals.ExpireStale(ctx)
+	if err != nil {
+		w.logger.Error().Err(err).Msg("Failed to expire stale change requests")
+	}
+	if expired > 0 {
+		w.logger.Info().Int("expired", expired).Msg("Expired stale change requests")
+	}
+	return expired
+}
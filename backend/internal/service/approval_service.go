@@ -0,0 +1,535 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/approval"
+	"github.com/slotmachine/backend/domain/balance"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+const (
+	defaultChangeRequestLimit = 20
+	maxChangeRequestLimit     = 100
+
+	// approvalExpireBatchSize bounds how many stale change requests one query loads
+	approvalExpireBatchSize = 100
+	// approvalNotifyTimeout bounds each change request notification
+	approvalNotifyTimeout = 10 * time.Second
+)
+
+// ApprovalService implements approval.Service
+// A change is recorded as pending when proposed and applied only when a second admin approves it.
+// Approval claims the pending change before applying it, so concurrent approvals apply it once.
+type ApprovalService struct {
+	repo       approval.Repository
+	reelStrips reelstrip.Service
+	canaries   reelstrip.CanaryService
+	rules      *GameRulesService
+	balances   balance.Service
+	cache      *cache.Cache
+	notifier   approval.Notifier // Optional: nil disables notifications
+	required   bool
+	ttl        time.Duration
+	logger     *logger.Logger
+	now        func() time.Time
+}
+
+// NewApprovalService creates a new approval service
+func NewApprovalService(
+	cfg *config.Config,
+	repo approval.Repository,
+	reelStrips reelstrip.Service,
+	canaries reelstrip.CanaryService,
+	rules *GameRulesService,
+	balances balance.Service,
+	cache *cache.Cache,
+	notifier approval.Notifier,
+	clk clock.Clock,
+	log *logger.Logger,
+) *ApprovalService {
+	return &ApprovalService{
+		repo:       repo,
+		reelStrips: reelStrips,
+		canaries:   canaries,
+		rules:      rules,
+		balances:   balances,
+		cache:      cache,
+		notifier:   notifier,
+		required:   cfg.Approval.Required,
+		ttl:        time.Duration(cfg.Approval.TTLHours) * time.Hour,
+		logger:     log,
+		now:        clk.Now,
+	}
+}
+
+// Required reports whether reel strip changes must be proposed instead of applied directly
+func (s *ApprovalService) Required() bool {
+	return s.required
+}
+
+// Propose records a pending change after checking its params fit the action
+func (s *ApprovalService) Propose(ctx context.Context, action approval.Action, params *approval.Params, proposer approval.Actor) (*approval.ChangeRequest, error) {
+	if !action.Valid() {
+		return nil, approval.ErrInvalidAction
+	}
+	if params == nil {
+		return nil, fmt.Errorf("%w: params are required", approval.ErrInvalidParams)
+	}
+	summary, err := s.describe(ctx, action, params)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now().UTC()
+	cr := &approval.ChangeRequest{
+		ID:             uuid.New(),
+		Action:         action,
+		Params:         params,
+		Summary:        summary,
+		Status:         approval.StatusPending,
+		ProposedBy:     proposer.ID,
+		ProposedByName: proposer.Username,
+		ExpiresAt:      now.Add(s.ttl),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := s.repo.Create(ctx, cr); err != nil {
+		return nil, err
+	}
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("change_request_id", cr.ID.String()).
+		Str("action", string(action)).
+		Str("proposed_by", proposer.Username).
+		Msg("Change proposed for approval")
+
+	s.notify(ctx, cr)
+	return cr, nil
+}
+
+// describe checks the params of an action and summarizes the change for reviewers
+func (s *ApprovalService) describe(ctx context.Context, action approval.Action, p *approval.Params) (string, error) {
+	switch action {
+	case approval.ActionActivateReelStripConfig:
+		if p.ConfigID == nil {
+			return "", fmt.Errorf("%w: config_id is required", approval.ErrInvalidParams)
+		}
+		config, err := s.reelStrips.GetConfigByID(ctx, *p.ConfigID)
+		if err != nil {
+			return "", err
+		}
+		p.GameMode = config.GameMode
+		return fmt.Sprintf("Activate reel strip config %q (%s, target RTP %.2f)", config.Name, config.GameMode, config.TargetRTP), nil
+
+	case approval.ActionSetDefaultReelStripConfig:
+		if p.ConfigID == nil || p.GameMode == "" {
+			return "", fmt.Errorf("%w: config_id and game_mode are required", approval.ErrInvalidParams)
+		}
+		config, err := s.reelStrips.GetConfigByID(ctx, *p.ConfigID)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Make reel strip config %q (target RTP %.2f) the %s default", config.Name, config.TargetRTP, p.GameMode), nil
+
+	case approval.ActionSetOperatorDefault:
+		if p.OperatorID == "" || p.TargetRTP <= 0 || p.TargetRTP > 100 || (p.BaseGameConfigID == nil && p.FreeSpinsConfigID == nil) {
+			return "", reelstrip.ErrInvalidOperatorDefault
+		}
+		return fmt.Sprintf("Set operator %s default reel strips at target RTP %.2f", p.OperatorID, p.TargetRTP), nil
+
+	case approval.ActionStartReelStripCanary:
+		if p.ConfigID == nil {
+			return "", fmt.Errorf("%w: config_id is required", approval.ErrInvalidParams)
+		}
+		req := canaryRequest(p, "")
+		config, err := s.canaries.Prepare(ctx, req)
+		if err != nil {
+			return "", err
+		}
+		p.GameMode = config.GameMode
+		p.CanaryPercent, p.MaxRTPDeviation, p.MinSpins = req.Percent, req.MaxRTPDeviation, req.MinSpins
+		p.TrialHours = int(req.Trial / time.Hour)
+		return fmt.Sprintf("Trial reel strip config %q (%s, target RTP %.2f) on %d%% of players for %d hours, then make it the default unless its realized RTP drifts more than %.2f points",
+			config.Name, config.GameMode, config.TargetRTP, p.CanaryPercent, p.TrialHours, p.MaxRTPDeviation), nil
+
+	case approval.ActionUpdateReelStripConfig:
+		if p.ConfigID == nil || p.ConfigUpdate == nil {
+			return "", fmt.Errorf("%w: config_id and config_update are required", approval.ErrInvalidParams)
+		}
+		config, err := s.reelStrips.GetConfigByID(ctx, *p.ConfigID)
+		if err != nil {
+			return "", err
+		}
+		p.GameMode = config.GameMode
+		if rtp := p.ConfigUpdate.TargetRTP; rtp != nil && *rtp != config.TargetRTP {
+			return fmt.Sprintf("Edit reel strip config %q (%s) and change its target RTP from %.2f to %.2f", config.Name, config.GameMode, config.TargetRTP, *rtp), nil
+		}
+		return fmt.Sprintf("Edit reel strip config %q (%s, target RTP %.2f)", config.Name, config.GameMode, config.TargetRTP), nil
+
+	case approval.ActionAssignPlayerReelStrips:
+		if p.PlayerID == nil || (p.BaseGameConfigID == nil && p.FreeSpinsConfigID == nil) {
+			return "", fmt.Errorf("%w: player_id and a base game or free spins config are required", approval.ErrInvalidParams)
+		}
+		var assigned []string
+		for _, id := range []*uuid.UUID{p.BaseGameConfigID, p.FreeSpinsConfigID} {
+			if id == nil {
+				continue
+			}
+			config, err := s.reelStrips.GetConfigByID(ctx, *id)
+			if err != nil {
+				return "", err
+			}
+			assigned = append(assigned, fmt.Sprintf("%q (%s, target RTP %.2f)", config.Name, config.GameMode, config.TargetRTP))
+		}
+		return fmt.Sprintf("Assign reel strip config %s to player %s", strings.Join(assigned, " and "), p.PlayerID), nil
+
+	case approval.ActionSetGameConfigMultipliers:
+		if err := s.checkGameConfig(ctx, p); err != nil {
+			return "", err
+		}
+		if p.MultiplierLadder == nil {
+			return fmt.Sprintf("Reset the cascade multipliers of game config %s to the default", p.GameConfigID), nil
+		}
+		if err := p.MultiplierLadder.Validate(); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Set the cascade multipliers of game config %s to %v in the base game and %v in free spins",
+			p.GameConfigID, p.MultiplierLadder.BaseGame, p.MultiplierLadder.FreeSpins), nil
+
+	case approval.ActionSetGameConfigTriggerRules:
+		if err := s.checkGameConfig(ctx, p); err != nil {
+			return "", err
+		}
+		if p.TriggerRules == nil {
+			return fmt.Sprintf("Reset the free spins trigger rules of game config %s to the default", p.GameConfigID), nil
+		}
+		if err := p.TriggerRules.Validate(); err != nil {
+			return "", err
+		}
+		r := p.TriggerRules
+		return fmt.Sprintf("Make %d scatters award %d free spins, %d more per extra scatter (retrigger %t), in game config %s",
+			r.MinScatters, r.BaseAward, r.ExtraPerScatter, r.Retrigger, p.GameConfigID), nil
+
+	case approval.ActionSetGameConfigWildFeatures:
+		if err := s.checkGameConfig(ctx, p); err != nil {
+			return "", err
+		}
+		if p.WildFeatures == nil {
+			return fmt.Sprintf("Disable the wild features of game config %s", p.GameConfigID), nil
+		}
+		if err := p.WildFeatures.Validate(); err != nil {
+			return "", err
+		}
+		f := p.WildFeatures
+		return fmt.Sprintf("Set the wild features of game config %s: sticky %t, expanding %t, multiplier %dx",
+			p.GameConfigID, f.StickyWilds, f.ExpandingWilds, f.WildMultiplier), nil
+
+	case approval.ActionAdjustBalance:
+		p.Reason = strings.TrimSpace(p.Reason)
+		if p.PlayerID == nil || p.Amount == 0 || math.IsInf(p.Amount, 0) || math.IsNaN(p.Amount) {
+			return "", fmt.Errorf("%w: player_id and a non-zero amount are required", approval.ErrInvalidParams)
+		}
+		if p.Reason == "" {
+			return "", fmt.Errorf("%w: reason is required", approval.ErrInvalidParams)
+		}
+		return fmt.Sprintf("Adjust balance of player %s by %+.2f: %s", p.PlayerID, p.Amount, p.Reason), nil
+	}
+	return "", approval.ErrInvalidAction
+}
+
+// checkGameConfig checks the game config whose rules a change sets exists
+func (s *ApprovalService) checkGameConfig(ctx context.Context, p *approval.Params) error {
+	if p.GameConfigID == nil {
+		return fmt.Errorf("%w: game_config_id is required", approval.ErrInvalidParams)
+	}
+	_, err := s.rules.GetGameConfig(ctx, *p.GameConfigID)
+	return err
+}
+
+// Get retrieves a change request
+func (s *ApprovalService) Get(ctx context.Context, id uuid.UUID) (*approval.ChangeRequest, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// List lists change requests newest first
+func (s *ApprovalService) List(ctx context.Context, filters approval.ListFilters) ([]*approval.ChangeRequest, int64, error) {
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.Limit < 1 {
+		filters.Limit = defaultChangeRequestLimit
+	}
+	if filters.Limit > maxChangeRequestLimit {
+		filters.Limit = maxChangeRequestLimit
+	}
+	return s.repo.List(ctx, filters)
+}
+
+// Approve applies a pending change proposed by another admin
+// The change is marked failed, and ErrApplyFailed returned, when applying it fails.
+func (s *ApprovalService) Approve(ctx context.Context, id uuid.UUID, reviewer approval.Actor, note string) (*approval.ChangeRequest, error) {
+	cr, err := s.pending(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if cr.ProposedBy == reviewer.ID {
+		return nil, approval.ErrSelfApproval
+	}
+
+	s.review(cr, approval.StatusApproved, reviewer, note)
+	if err := s.repo.Review(ctx, cr); err != nil {
+		return nil, err
+	}
+
+	log := s.logger.WithTraceContext(ctx)
+	if applyErr := s.apply(ctx, cr); applyErr != nil {
+		log.Error().Err(applyErr).Str("change_request_id", cr.ID.String()).Msg("Failed to apply approved change")
+		cr.Status = approval.StatusFailed
+		cr.Error = applyErr.Error()
+	} else {
+		appliedAt := s.now().UTC()
+		cr.AppliedAt = &appliedAt
+	}
+	cr.UpdatedAt = s.now().UTC()
+	if err := s.repo.Update(ctx, cr); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("change_request_id", cr.ID.String()).
+		Str("action", string(cr.Action)).
+		Str("status", string(cr.Status)).
+		Str("reviewed_by", reviewer.Username).
+		Msg("Change request approved")
+
+	s.notify(ctx, cr)
+	if cr.Status == approval.StatusFailed {
+		return cr, fmt.Errorf("%w: %s", approval.ErrApplyFailed, cr.Error)
+	}
+	return cr, nil
+}
+
+// Reject discards a pending change without applying it
+func (s *ApprovalService) Reject(ctx context.Context, id uuid.UUID, reviewer approval.Actor, note string) (*approval.ChangeRequest, error) {
+	cr, err := s.pending(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.review(cr, approval.StatusRejected, reviewer, note)
+	if err := s.repo.Review(ctx, cr); err != nil {
+		return nil, err
+	}
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("change_request_id", cr.ID.String()).
+		Str("action", string(cr.Action)).
+		Str("reviewed_by", reviewer.Username).
+		Msg("Change request rejected")
+
+	s.notify(ctx, cr)
+	return cr, nil
+}
+
+// ExpireStale expires every pending change past its expiry, one batch at a time
+func (s *ApprovalService) ExpireStale(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		stale, err := s.repo.ListStale(ctx, s.now().UTC(), approvalExpireBatchSize)
+		if err != nil {
+			return total, err
+		}
+		for _, cr := range stale {
+			if err := s.expire(ctx, cr); err != nil {
+				if errors.Is(err, approval.ErrNotPending) {
+					continue // Reviewed since it was listed
+				}
+				return total, err
+			}
+			total++
+		}
+		if len(stale) < approvalExpireBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// pending loads a change request that can still be reviewed, expiring it if it is past its expiry
+func (s *ApprovalService) pending(ctx context.Context, id uuid.UUID) (*approval.ChangeRequest, error) {
+	cr, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if cr.Status != approval.StatusPending {
+		return nil, approval.ErrNotPending
+	}
+	if !s.now().Before(cr.ExpiresAt) {
+		if err := s.expire(ctx, cr); err != nil && !errors.Is(err, approval.ErrNotPending) {
+			return nil, err
+		}
+		return nil, approval.ErrExpired
+	}
+	return cr, nil
+}
+
+// expire moves a pending change request to expired
+func (s *ApprovalService) expire(ctx context.Context, cr *approval.ChangeRequest) error {
+	cr.Status = approval.StatusExpired
+	cr.UpdatedAt = s.now().UTC()
+	if err := s.repo.Review(ctx, cr); err != nil {
+		return err
+	}
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("change_request_id", cr.ID.String()).
+		Str("action", string(cr.Action)).
+		Msg("Change request expired without review")
+
+	s.notify(ctx, cr)
+	return nil
+}
+
+// review records the reviewer's decision on a change request
+func (s *ApprovalService) review(cr *approval.ChangeRequest, status approval.Status, reviewer approval.Actor, note string) {
+	now := s.now().UTC()
+	cr.Status = status
+	cr.ReviewedBy = &reviewer.ID
+	cr.ReviewedByName = reviewer.Username
+	cr.ReviewNote = strings.TrimSpace(note)
+	cr.ReviewedAt = &now
+	cr.UpdatedAt = now
+}
+
+// apply makes the approved change take effect and expires the caches it affects
+func (s *ApprovalService) apply(ctx context.Context, cr *approval.ChangeRequest) error {
+	p := cr.Params
+	switch cr.Action {
+	case approval.ActionActivateReelStripConfig:
+		if err := s.reelStrips.ActivateConfig(ctx, *p.ConfigID); err != nil {
+			return err
+		}
+		s.expireKeys(ctx, s.cache.ReelStripConfigKeys(*p.ConfigID, p.GameMode)...)
+
+	case approval.ActionSetDefaultReelStripConfig:
+		if err := s.reelStrips.SetDefaultConfig(ctx, *p.ConfigID, p.GameMode); err != nil {
+			return err
+		}
+		s.expireKeys(ctx, s.cache.ReelStripConfigKeys(*p.ConfigID, p.GameMode)...)
+
+	case approval.ActionSetOperatorDefault:
+		def := &reelstrip.OperatorReelStripDefault{
+			OperatorID:        p.OperatorID,
+			TargetRTP:         p.TargetRTP,
+			BaseGameConfigID:  p.BaseGameConfigID,
+			FreeSpinsConfigID: p.FreeSpinsConfigID,
+			UpdatedBy:         cr.ProposedByName,
+		}
+		if err := s.reelStrips.SetOperatorDefault(ctx, def); err != nil {
+			return err
+		}
+		s.expireKeys(ctx, s.cache.OperatorDefaultKey(p.OperatorID))
+
+	case approval.ActionStartReelStripCanary:
+		if _, err := s.canaries.Start(ctx, canaryRequest(p, cr.ProposedByName)); err != nil {
+			return err
+		}
+
+	case approval.ActionUpdateReelStripConfig:
+		if _, err := s.reelStrips.UpdateConfig(ctx, *p.ConfigID, p.ConfigUpdate); err != nil {
+			return err
+		}
+		s.expireKeys(ctx, s.cache.ReelStripConfigKeys(*p.ConfigID, p.GameMode)...)
+
+	case approval.ActionAssignPlayerReelStrips:
+		assignments := []struct {
+			configID *uuid.UUID
+			gameMode string
+		}{{p.BaseGameConfigID, "base_game"}, {p.FreeSpinsConfigID, "free_spins"}}
+		for _, a := range assignments {
+			if a.configID == nil {
+				continue
+			}
+			if err := s.reelStrips.AssignConfigToPlayer(ctx, *p.PlayerID, *a.configID, a.gameMode, p.Reason, cr.ProposedByName, p.ExpiresAt); err != nil {
+				return err
+			}
+		}
+		s.expireKeys(ctx, s.cache.PlayerAssignmentKey(*p.PlayerID))
+
+	case approval.ActionSetGameConfigMultipliers:
+		if _, err := s.rules.SetGameConfigLadder(ctx, *p.GameConfigID, p.MultiplierLadder); err != nil {
+			return err
+		}
+
+	case approval.ActionSetGameConfigTriggerRules:
+		if _, err := s.rules.SetGameConfigTriggerRules(ctx, *p.GameConfigID, p.TriggerRules); err != nil {
+			return err
+		}
+
+	case approval.ActionSetGameConfigWildFeatures:
+		if _, err := s.rules.SetGameConfigWildFeatures(ctx, *p.GameConfigID, p.WildFeatures); err != nil {
+			return err
+		}
+
+	case approval.ActionAdjustBalance:
+		adj := &balance.Adjustment{
+			PlayerID:    *p.PlayerID,
+			Amount:      p.Amount,
+			Reference:   "change_request:" + cr.ID.String(),
+			Description: p.Reason,
+		}
+		if _, err := s.balances.Adjust(ctx, adj); err != nil {
+			return err
+		}
+
+	default:
+		return approval.ErrInvalidAction
+	}
+	return nil
+}
+
+// canaryRequest builds the canary request of change request params
+func canaryRequest(p *approval.Params, startedBy string) *reelstrip.StartCanaryRequest {
+	return &reelstrip.StartCanaryRequest{
+		ConfigID:        *p.ConfigID,
+		Percent:         p.CanaryPercent,
+		MaxRTPDeviation: p.MaxRTPDeviation,
+		MinSpins:        p.MinSpins,
+		Trial:           time.Duration(p.TrialHours) * time.Hour,
+		StartedBy:       startedBy,
+	}
+}
+
+// expireKeys drops cached entries on every instance; a failure is logged, the entries expire on their own
+func (s *ApprovalService) expireKeys(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := s.cache.Expire(ctx, key); err != nil {
+			s.logger.WithTraceContext(ctx).Warn().Err(err).Str("key", key).Msg("Failed to expire cache after approved change")
+		}
+	}
+}
+
+// notify tells admins about the change request; a failed notification is logged, not returned
+func (s *ApprovalService) notify(ctx context.Context, cr *approval.ChangeRequest) {
+	if s.notifier == nil {
+		return
+	}
+	notifyCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), approvalNotifyTimeout)
+	defer cancel()
+	if err := s.notifier.NotifyChangeRequest(notifyCtx, cr); err != nil {
+		s.logger.Warn().Err(err).
+			Str("notifier", s.notifier.Name()).
+			Str("change_request_id", cr.ID.String()).
+			Msg("Failed to notify change request")
+	}
+}
@@ -0,0 +1,392 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/approval"
+	"github.com/slotmachine/backend/domain/balance"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryApprovalStore is an in-memory approval.Repository
+type memoryApprovalStore struct {
+	mu       sync.Mutex
+	requests map[uuid.UUID]approval.ChangeRequest
+}
+
+func newMemoryApprovalStore() *memoryApprovalStore {
+	return &memoryApprovalStore{requests: make(map[uuid.UUID]approval.ChangeRequest)}
+}
+
+func (m *memoryApprovalStore) Create(ctx context.Context, cr *approval.ChangeRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[cr.ID] = *cr
+	return nil
+}
+
+func (m *memoryApprovalStore) GetByID(ctx context.Context, id uuid.UUID) (*approval.ChangeRequest, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cr, ok := m.requests[id]
+	if !ok {
+		return nil, approval.ErrChangeRequestNotFound
+	}
+	return &cr, nil
+}
+
+func (m *memoryApprovalStore) List(ctx context.Context, filters approval.ListFilters) ([]*approval.ChangeRequest, int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*approval.ChangeRequest
+	for _, cr := range m.requests {
+		if filters.Status == "" || cr.Status == filters.Status {
+			out = append(out, &cr)
+		}
+	}
+	return out, int64(len(out)), nil
+}
+
+func (m *memoryApprovalStore) Update(ctx context.Context, cr *approval.ChangeRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.requests[cr.ID]; !ok {
+		return approval.ErrChangeRequestNotFound
+	}
+	m.requests[cr.ID] = *cr
+	return nil
+}
+
+func (m *memoryApprovalStore) Review(ctx context.Context, cr *approval.ChangeRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if stored, ok := m.requests[cr.ID]; !ok || stored.Status != approval.StatusPending {
+		return approval.ErrNotPending
+	}
+	m.requests[cr.ID] = *cr
+	return nil
+}
+
+func (m *memoryApprovalStore) ListStale(ctx context.Context, now time.Time, limit int) ([]*approval.ChangeRequest, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*approval.ChangeRequest
+	for _, cr := range m.requests {
+		if cr.Status == approval.StatusPending && !cr.ExpiresAt.After(now) {
+			out = append(out, &cr)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ExpiresAt.Before(out[j].ExpiresAt) })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+// configActivator records config activations, edits and player assignments; other reelstrip.Service methods are unused
+type configActivator struct {
+	reelstrip.Service
+	configs   map[uuid.UUID]*reelstrip.ReelStripConfig
+	activated []uuid.UUID
+	assigned  map[string]uuid.UUID
+}
+
+func (a *configActivator) GetConfigByID(ctx context.Context, id uuid.UUID) (*reelstrip.ReelStripConfig, error) {
+	config, ok := a.configs[id]
+	if !ok {
+		return nil, reelstrip.ErrConfigNotFound
+	}
+	return config, nil
+}
+
+func (a *configActivator) ActivateConfig(ctx context.Context, id uuid.UUID) error {
+	a.activated = append(a.activated, id)
+	return nil
+}
+
+func (a *configActivator) UpdateConfig(ctx context.Context, id uuid.UUID, update *reelstrip.ConfigUpdate) (*reelstrip.ReelStripConfig, error) {
+	config, err := a.GetConfigByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	update.Apply(config)
+	return config, nil
+}
+
+func (a *configActivator) AssignConfigToPlayer(ctx context.Context, playerID, configID uuid.UUID, gameMode, reason, assignedBy string, expiresAt *time.Time) error {
+	if a.assigned == nil {
+		a.assigned = map[string]uuid.UUID{}
+	}
+	a.assigned[gameMode] = configID
+	return nil
+}
+
+// adjustmentRecorder records balance adjustments; other balance.Service methods are unused
+type adjustmentRecorder struct {
+	balance.Service
+	adjustments []*balance.Adjustment
+	err         error
+}
+
+func (r *adjustmentRecorder) Adjust(ctx context.Context, adj *balance.Adjustment) (*balance.Transaction, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	r.adjustments = append(r.adjustments, adj)
+	return &balance.Transaction{PlayerID: adj.PlayerID, Amount: adj.Amount}, nil
+}
+
+// changeRequestNotifier records the statuses change requests were notified in
+type changeRequestNotifier struct {
+	statuses []approval.Status
+}
+
+func (n *changeRequestNotifier) Name() string { return "test" }
+
+func (n *changeRequestNotifier) NotifyChangeRequest(ctx context.Context, cr *approval.ChangeRequest) error {
+	n.statuses = append(n.statuses, cr.Status)
+	return nil
+}
+
+type approvalFixture struct {
+	svc      *ApprovalService
+	repo     *memoryApprovalStore
+	configs  *configActivator
+	games    *MockGameRepository
+	balances *adjustmentRecorder
+	notifier *changeRequestNotifier
+}
+
+func newApprovalFixture() *approvalFixture {
+	cfg := &config.Config{
+		App:      config.AppConfig{Name: "test", Env: "test"},
+		Approval: config.ApprovalConfig{Required: true, TTLHours: 24},
+	}
+	f := &approvalFixture{
+		repo:     newMemoryApprovalStore(),
+		configs:  &configActivator{configs: map[uuid.UUID]*reelstrip.ReelStripConfig{}},
+		games:    &MockGameRepository{},
+		balances: &adjustmentRecorder{},
+		notifier: &changeRequestNotifier{},
+	}
+	c := cache.NewCache(cache.NewCacheParams{Channel: "test:approval", Config: cfg})
+	log := logger.New("error", "json")
+	rules := NewGameRulesService(nil, f.games, nil, log)
+	f.svc = NewApprovalService(cfg, f.repo, f.configs, nil, rules, f.balances, c, f.notifier, clock.New(), log)
+	return f
+}
+
+func TestApprovalService_FourEyes(t *testing.T) {
+	ctx := context.Background()
+	alice := approval.Actor{ID: uuid.New(), Username: "alice"}
+	bob := approval.Actor{ID: uuid.New(), Username: "bob"}
+
+	t.Run("should apply a config activation only once a second admin approves it", func(t *testing.T) {
+		f := newApprovalFixture()
+		configID := uuid.New()
+		f.configs.configs[configID] = &reelstrip.ReelStripConfig{ID: configID, Name: "rtp-96", GameMode: "base_game", TargetRTP: 96}
+
+		cr, err := f.svc.Propose(ctx, approval.ActionActivateReelStripConfig, &approval.Params{ConfigID: &configID}, alice)
+		require.NoError(t, err)
+		assert.Equal(t, approval.StatusPending, cr.Status)
+		assert.Equal(t, "base_game", cr.Params.GameMode)
+		assert.Empty(t, f.configs.activated, "nothing applies on proposal")
+
+		_, err = f.svc.Approve(ctx, cr.ID, alice, "")
+		assert.ErrorIs(t, err, approval.ErrSelfApproval)
+		assert.Empty(t, f.configs.activated)
+
+		approved, err := f.svc.Approve(ctx, cr.ID, bob, "checked the simulation report")
+		require.NoError(t, err)
+		assert.Equal(t, approval.StatusApproved, approved.Status)
+		assert.Equal(t, "bob", approved.ReviewedByName)
+		assert.NotNil(t, approved.AppliedAt)
+		assert.Equal(t, []uuid.UUID{configID}, f.configs.activated)
+
+		_, err = f.svc.Approve(ctx, cr.ID, bob, "")
+		assert.ErrorIs(t, err, approval.ErrNotPending, "an approved change cannot be applied twice")
+		assert.Equal(t, []approval.Status{approval.StatusPending, approval.StatusApproved}, f.notifier.statuses)
+	})
+
+	t.Run("should reject proposals for unknown configs", func(t *testing.T) {
+		f := newApprovalFixture()
+		configID := uuid.New()
+		_, err := f.svc.Propose(ctx, approval.ActionActivateReelStripConfig, &approval.Params{ConfigID: &configID}, alice)
+		assert.ErrorIs(t, err, reelstrip.ErrConfigNotFound)
+	})
+
+	t.Run("should apply a balance adjustment referenced by the change request", func(t *testing.T) {
+		f := newApprovalFixture()
+		playerID := uuid.New()
+
+		_, err := f.svc.Propose(ctx, approval.ActionAdjustBalance, &approval.Params{PlayerID: &playerID, Amount: -20}, alice)
+		assert.ErrorIs(t, err, approval.ErrInvalidParams, "a reason is required")
+
+		cr, err := f.svc.Propose(ctx, approval.ActionAdjustBalance, &approval.Params{PlayerID: &playerID, Amount: -20, Reason: " Duplicate payout "}, alice)
+		require.NoError(t, err)
+
+		_, err = f.svc.Approve(ctx, cr.ID, bob, "")
+		require.NoError(t, err)
+		require.Len(t, f.balances.adjustments, 1)
+		adj := f.balances.adjustments[0]
+		assert.Equal(t, playerID, adj.PlayerID)
+		assert.Equal(t, -20.0, adj.Amount)
+		assert.Equal(t, "change_request:"+cr.ID.String(), adj.Reference)
+		assert.Equal(t, "Duplicate payout", adj.Description)
+	})
+
+	t.Run("should mark the change failed when it cannot be applied", func(t *testing.T) {
+		f := newApprovalFixture()
+		f.balances.err = player.ErrInsufficientBalance
+		playerID := uuid.New()
+		cr, err := f.svc.Propose(ctx, approval.ActionAdjustBalance, &approval.Params{PlayerID: &playerID, Amount: -500, Reason: "Chargeback"}, alice)
+		require.NoError(t, err)
+
+		failed, err := f.svc.Approve(ctx, cr.ID, bob, "")
+		assert.ErrorIs(t, err, approval.ErrApplyFailed)
+		assert.Equal(t, approval.StatusFailed, failed.Status)
+		assert.Contains(t, failed.Error, "insufficient balance")
+
+		stored, err := f.svc.Get(ctx, cr.ID)
+		require.NoError(t, err)
+		assert.Equal(t, approval.StatusFailed, stored.Status)
+		assert.Nil(t, stored.AppliedAt)
+	})
+
+	t.Run("should let the proposer withdraw a change by rejecting it", func(t *testing.T) {
+		f := newApprovalFixture()
+		playerID := uuid.New()
+		cr, err := f.svc.Propose(ctx, approval.ActionAdjustBalance, &approval.Params{PlayerID: &playerID, Amount: 10, Reason: "Goodwill"}, alice)
+		require.NoError(t, err)
+
+		rejected, err := f.svc.Reject(ctx, cr.ID, alice, "wrong player")
+		require.NoError(t, err)
+		assert.Equal(t, approval.StatusRejected, rejected.Status)
+		assert.Equal(t, "wrong player", rejected.ReviewNote)
+
+		_, err = f.svc.Approve(ctx, cr.ID, bob, "")
+		assert.ErrorIs(t, err, approval.ErrNotPending)
+		assert.Empty(t, f.balances.adjustments)
+	})
+}
+
+func TestApprovalService_RuleChanges(t *testing.T) {
+	ctx := context.Background()
+	alice := approval.Actor{ID: uuid.New(), Username: "alice"}
+	bob := approval.Actor{ID: uuid.New(), Username: "bob"}
+
+	t.Run("should apply a reel strip config edit once approved", func(t *testing.T) {
+		f := newApprovalFixture()
+		configID := uuid.New()
+		f.configs.configs[configID] = &reelstrip.ReelStripConfig{ID: configID, Name: "rtp-96", GameMode: "base_game", TargetRTP: 96}
+		rtp := 92.5
+
+		cr, err := f.svc.Propose(ctx, approval.ActionUpdateReelStripConfig, &approval.Params{ConfigID: &configID, ConfigUpdate: &reelstrip.ConfigUpdate{TargetRTP: &rtp}}, alice)
+		require.NoError(t, err)
+		assert.Contains(t, cr.Summary, "from 96.00 to 92.50")
+		assert.Equal(t, 96.0, f.configs.configs[configID].TargetRTP, "nothing applies on proposal")
+
+		_, err = f.svc.Approve(ctx, cr.ID, bob, "")
+		require.NoError(t, err)
+		assert.Equal(t, 92.5, f.configs.configs[configID].TargetRTP)
+	})
+
+	t.Run("should assign a player's configs once approved", func(t *testing.T) {
+		f := newApprovalFixture()
+		playerID, configID := uuid.New(), uuid.New()
+		f.configs.configs[configID] = &reelstrip.ReelStripConfig{ID: configID, Name: "rtp-99", GameMode: "free_spins", TargetRTP: 99}
+
+		_, err := f.svc.Propose(ctx, approval.ActionAssignPlayerReelStrips, &approval.Params{PlayerID: &playerID}, alice)
+		assert.ErrorIs(t, err, approval.ErrInvalidParams, "a config is required")
+
+		cr, err := f.svc.Propose(ctx, approval.ActionAssignPlayerReelStrips, &approval.Params{PlayerID: &playerID, FreeSpinsConfigID: &configID}, alice)
+		require.NoError(t, err)
+		assert.Empty(t, f.configs.assigned)
+
+		_, err = f.svc.Approve(ctx, cr.ID, bob, "")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]uuid.UUID{"free_spins": configID}, f.configs.assigned)
+	})
+
+	t.Run("should validate game config rules before proposing them", func(t *testing.T) {
+		f := newApprovalFixture()
+		configID, missing := uuid.New(), uuid.New()
+		f.games.On("GetGameConfigByID", mock.Anything, configID).Return(&game.GameConfig{ID: configID}, nil)
+		f.games.On("GetGameConfigByID", mock.Anything, missing).Return(nil, game.ErrGameConfigNotFound)
+
+		_, err := f.svc.Propose(ctx, approval.ActionSetGameConfigWildFeatures, &approval.Params{GameConfigID: &missing}, alice)
+		assert.ErrorIs(t, err, game.ErrGameConfigNotFound)
+
+		_, err = f.svc.Propose(ctx, approval.ActionSetGameConfigMultipliers, &approval.Params{GameConfigID: &configID, MultiplierLadder: &game.MultiplierLadder{}}, alice)
+		assert.ErrorIs(t, err, game.ErrInvalidMultiplierLadder)
+
+		ladder := &game.MultiplierLadder{BaseGame: []int{1, 2, 3}, FreeSpins: []int{2, 4, 6}}
+		cr, err := f.svc.Propose(ctx, approval.ActionSetGameConfigMultipliers, &approval.Params{GameConfigID: &configID, MultiplierLadder: ladder}, alice)
+		require.NoError(t, err)
+		f.games.AssertNotCalled(t, "UpdateGameConfigLadder", mock.Anything, mock.Anything, mock.Anything)
+
+		f.games.On("UpdateGameConfigLadder", mock.Anything, configID, ladder).Return(&game.GameConfig{ID: configID, MultiplierLadder: ladder}, nil).Once()
+		_, err = f.svc.Approve(ctx, cr.ID, bob, "")
+		require.NoError(t, err)
+		f.games.AssertExpectations(t)
+	})
+}
+
+func TestApprovalService_Expiry(t *testing.T) {
+	ctx := context.Background()
+	alice := approval.Actor{ID: uuid.New(), Username: "alice"}
+	bob := approval.Actor{ID: uuid.New(), Username: "bob"}
+	playerID := uuid.New()
+
+	f := newApprovalFixture()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	f.svc.now = func() time.Time { return now }
+
+	stale, err := f.svc.Propose(ctx, approval.ActionAdjustBalance, &approval.Params{PlayerID: &playerID, Amount: 10, Reason: "Goodwill"}, alice)
+	require.NoError(t, err)
+	now = now.Add(12 * time.Hour)
+	fresh, err := f.svc.Propose(ctx, approval.ActionAdjustBalance, &approval.Params{PlayerID: &playerID, Amount: 5, Reason: "Goodwill"}, alice)
+	require.NoError(t, err)
+
+	now = now.Add(13 * time.Hour)
+	t.Run("should refuse to approve a change past its expiry", func(t *testing.T) {
+		_, err := f.svc.Approve(ctx, stale.ID, bob, "")
+		assert.ErrorIs(t, err, approval.ErrExpired)
+		assert.Empty(t, f.balances.adjustments)
+
+		stored, err := f.svc.Get(ctx, stale.ID)
+		require.NoError(t, err)
+		assert.Equal(t, approval.StatusExpired, stored.Status)
+	})
+
+	t.Run("should expire only stale pending changes", func(t *testing.T) {
+		now = now.Add(12 * time.Hour)
+		third, err := f.svc.Propose(ctx, approval.ActionAdjustBalance, &approval.Params{PlayerID: &playerID, Amount: 1, Reason: "Goodwill"}, alice)
+		require.NoError(t, err)
+
+		expired, err := f.svc.ExpireStale(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, expired)
+
+		stored, err := f.svc.Get(ctx, fresh.ID)
+		require.NoError(t, err)
+		assert.Equal(t, approval.StatusExpired, stored.Status)
+		stored, err = f.svc.Get(ctx, third.ID)
+		require.NoError(t, err)
+		assert.Equal(t, approval.StatusPending, stored.Status)
+	})
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/archive"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// archiveFolderPrefix is the storage folder archives are written under, one folder per table
+const archiveFolderPrefix = "archives/"
+
+// ArchiveService implements archive.Service: expired spin partitions are exported to
+// object storage as gzip-compressed JSON Lines, recorded, and dropped from the hot DB
+type ArchiveService struct {
+	repo            archive.Repository
+	partitions      *repository.PartitionManager
+	storage         storage.Storage
+	retentionMonths int
+	clock           clock.Clock // Timestamps archives and restores
+	logger          *logger.Logger
+}
+
+// NewArchiveService creates a new archive service
+func NewArchiveService(
+	cfg *config.Config,
+	repo archive.Repository,
+	partitions *repository.PartitionManager,
+	store storage.Storage,
+	clk clock.Clock,
+	log *logger.Logger,
+) archive.Service {
+	return &ArchiveService{
+		repo:            repo,
+		partitions:      partitions,
+		storage:         store,
+		retentionMonths: cfg.Archive.RetentionMonths,
+		clock:           clk,
+		logger:          log,
+	}
+}
+
+// ArchiveExpired exports and drops every partition past the retention window
+func (s *ArchiveService) ArchiveExpired(ctx context.Context, now time.Time) ([]*archive.Archive, error) {
+	if s.retentionMonths <= 0 || !s.partitions.Supported() {
+		return nil, nil
+	}
+	now = now.UTC()
+	cutoff := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -s.retentionMonths, 0)
+
+	var created []*archive.Archive
+	for _, table := range repository.PartitionedTables {
+		expired, err := s.partitions.ExpiredPartitions(ctx, table, cutoff)
+		if err != nil {
+			return created, err
+		}
+
+		for _, p := range expired {
+			existing, err := s.repo.GetByPartition(ctx, p.Name)
+			switch {
+			case err == nil && existing.RestoredAt != nil:
+				// Restored for an audit; stays until an admin evicts it
+				continue
+			case err == nil:
+				// Exported by an earlier pass that stopped before dropping
+				if err := s.partitions.DropPartition(ctx, p.Name); err != nil {
+					return created, err
+				}
+				continue
+			case !errors.Is(err, archive.ErrArchiveNotFound):
+				return created, err
+			}
+
+			a, err := s.archivePartition(ctx, table, p)
+			if err != nil {
+				return created, err
+			}
+			created = append(created, a)
+		}
+	}
+	return created, nil
+}
+
+// archivePartition streams one partition to storage, records it, then drops it
+func (s *ArchiveService) archivePartition(ctx context.Context, table string, p repository.PartitionTable) (*archive.Archive, error) {
+	log := s.logger.WithTraceContext(ctx)
+
+	pr, pw := io.Pipe()
+	type exportResult struct {
+		rows int64
+		err  error
+	}
+	exported := make(chan exportResult, 1)
+	go func() {
+		gz := gzip.NewWriter(pw)
+		rows, err := s.partitions.ExportPartition(ctx, p.Name, gz)
+		if closeErr := gz.Close(); err == nil {
+			err = closeErr
+		}
+		pw.CloseWithError(err)
+		exported <- exportResult{rows: rows, err: err}
+	}()
+
+	digest := sha256.New()
+	size := &byteCounter{}
+	objectName := p.Name + ".jsonl.gz"
+	_, uploadErr := s.storage.UploadFileStreaming(ctx, archiveFolderPrefix+table, objectName,
+		io.TeeReader(pr, io.MultiWriter(digest, size)), "application/gzip")
+	pr.CloseWithError(uploadErr)
+	result := <-exported
+	if result.err != nil {
+		return nil, fmt.Errorf("failed to export partition %s: %w", p.Name, result.err)
+	}
+	if uploadErr != nil {
+		return nil, fmt.Errorf("failed to upload archive of %s: %w", p.Name, uploadErr)
+	}
+
+	a := &archive.Archive{
+		ID:            uuid.New(),
+		SourceTable:   table,
+		PartitionName: p.Name,
+		RangeStart:    p.RangeStart,
+		RangeEnd:      p.RangeEnd,
+		ObjectName:    objectName,
+		RowCount:      result.rows,
+		SizeBytes:     size.n,
+		Checksum:      hex.EncodeToString(digest.Sum(nil)),
+		ArchivedAt:    s.clock.Now().UTC(),
+	}
+	if err := s.repo.Create(ctx, a); err != nil {
+		return nil, err
+	}
+	if err := s.partitions.DropPartition(ctx, p.Name); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("partition", p.Name).
+		Int64("rows", a.RowCount).
+		Int64("size_bytes", a.SizeBytes).
+		Msg("Archived spin partition")
+	return a, nil
+}
+
+// GetArchive retrieves an archive record
+func (s *ArchiveService) GetArchive(ctx context.Context, id uuid.UUID) (*archive.Archive, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// ListArchives lists archive records
+func (s *ArchiveService) ListArchives(ctx context.Context, filters archive.ListFilters) ([]*archive.Archive, int64, error) {
+	return s.repo.List(ctx, filters)
+}
+
+// Restore loads an archive back into its partition, verifying its checksum
+func (s *ArchiveService) Restore(ctx context.Context, id uuid.UUID, restoredBy string) (*archive.Archive, error) {
+	a, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a.RestoredAt != nil {
+		return nil, archive.ErrAlreadyRestored
+	}
+
+	object, err := s.storage.DownloadFile(ctx, archiveFolderPrefix+a.SourceTable, a.ObjectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download archive %s: %w", a.ObjectName, err)
+	}
+	defer object.Close()
+
+	digest := sha256.New()
+	verified := io.TeeReader(object, digest)
+	gz, err := gzip.NewReader(verified)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive %s: %w", a.ObjectName, err)
+	}
+	defer gz.Close()
+
+	p := repository.PartitionTable{Name: a.PartitionName, RangeStart: a.RangeStart, RangeEnd: a.RangeEnd}
+	rows, err := s.partitions.RestorePartition(ctx, a.SourceTable, p, gz, func() error {
+		return verifyChecksum(verified, digest, a.Checksum)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now().UTC()
+	if err := s.repo.MarkRestored(ctx, a.ID, now, restoredBy); err != nil {
+		return nil, err
+	}
+	a.RestoredAt = &now
+	a.RestoredBy = restoredBy
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("partition", a.PartitionName).
+		Int64("rows", rows).
+		Str("restored_by", restoredBy).
+		Msg("Restored spin archive")
+	return a, nil
+}
+
+// Evict drops a restored archive's partition again
+func (s *ArchiveService) Evict(ctx context.Context, id uuid.UUID) (*archive.Archive, error) {
+	a, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a.RestoredAt == nil {
+		return nil, archive.ErrNotRestored
+	}
+
+	if err := s.partitions.DropPartition(ctx, a.PartitionName); err != nil {
+		return nil, err
+	}
+	if err := s.repo.ClearRestored(ctx, a.ID); err != nil {
+		return nil, err
+	}
+	a.RestoredAt = nil
+	a.RestoredBy = ""
+	return a, nil
+}
+
+// verifyChecksum drains what the decompressor left unread and compares the object digest
+func verifyChecksum(r io.Reader, digest hash.Hash, want string) error {
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	if hex.EncodeToString(digest.Sum(nil)) != want {
+		return archive.ErrChecksumMismatch
+	}
+	return nil
+}
+
+// byteCounter counts bytes written through it
+type byteCounter struct {
+	n int64
+}
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
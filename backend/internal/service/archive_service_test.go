@@ -0,0 +1,129 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/archive"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// MockArchiveRepository is a mock implementation of archive.Repository
+type MockArchiveRepository struct {
+	mock.Mock
+}
+
+func (m *MockArchiveRepository) Create(ctx context.Context, a *archive.Archive) error {
+	return m.Called(ctx, a).Error(0)
+}
+
+func (m *MockArchiveRepository) GetByID(ctx context.Context, id uuid.UUID) (*archive.Archive, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*archive.Archive), args.Error(1)
+}
+
+func (m *MockArchiveRepository) GetByPartition(ctx context.Context, partitionName string) (*archive.Archive, error) {
+	args := m.Called(ctx, partitionName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*archive.Archive), args.Error(1)
+}
+
+func (m *MockArchiveRepository) List(ctx context.Context, filters archive.ListFilters) ([]*archive.Archive, int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]*archive.Archive), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockArchiveRepository) MarkRestored(ctx context.Context, id uuid.UUID, at time.Time, by string) error {
+	return m.Called(ctx, id, at, by).Error(0)
+}
+
+func (m *MockArchiveRepository) ClearRestored(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+// setupArchiveService builds the service over SQLite, which has no partitioning
+func setupArchiveService(t *testing.T) (*ArchiveService, *MockArchiveRepository) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: gormlogger.Default.LogMode(gormlogger.Silent)})
+	require.NoError(t, err)
+
+	repo := new(MockArchiveRepository)
+	cfg := &config.Config{Archive: config.ArchiveConfig{RetentionMonths: 12}}
+	svc := NewArchiveService(cfg, repo, repository.NewPartitionManager(db), nil, clock.New(), logger.New("error", "json"))
+	return svc.(*ArchiveService), repo
+}
+
+func TestArchiveService_ArchiveExpired_SkipsWithoutPartitioning(t *testing.T) {
+	svc, repo := setupArchiveService(t)
+
+	created, err := svc.ArchiveExpired(context.Background(), time.Now())
+
+	require.NoError(t, err)
+	assert.Empty(t, created)
+	repo.AssertNotCalled(t, "GetByPartition", mock.Anything, mock.Anything)
+}
+
+func TestArchiveService_Restore_AlreadyRestored(t *testing.T) {
+	ctx := context.Background()
+	svc, repo := setupArchiveService(t)
+
+	restoredAt := time.Now().UTC()
+	a := &archive.Archive{ID: uuid.New(), RestoredAt: &restoredAt}
+	repo.On("GetByID", ctx, a.ID).Return(a, nil)
+
+	_, err := svc.Restore(ctx, a.ID, "auditor")
+
+	assert.ErrorIs(t, err, archive.ErrAlreadyRestored)
+	repo.AssertNotCalled(t, "MarkRestored", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestArchiveService_Evict_NotRestored(t *testing.T) {
+	ctx := context.Background()
+	svc, repo := setupArchiveService(t)
+
+	a := &archive.Archive{ID: uuid.New()}
+	repo.On("GetByID", ctx, a.ID).Return(a, nil)
+
+	_, err := svc.Evict(ctx, a.ID)
+
+	assert.ErrorIs(t, err, archive.ErrNotRestored)
+	repo.AssertNotCalled(t, "ClearRestored", mock.Anything, mock.Anything)
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	object := []byte("archived rows")
+	sum := sha256.Sum256(object)
+
+	t.Run("should accept a matching object", func(t *testing.T) {
+		// The decompressor has consumed the first bytes; the rest is drained through the digest
+		digest := sha256.New()
+		digest.Write(object[:4])
+		rest := io.TeeReader(bytes.NewReader(object[4:]), digest)
+		assert.NoError(t, verifyChecksum(rest, digest, hex.EncodeToString(sum[:])))
+	})
+
+	t.Run("should reject a tampered object", func(t *testing.T) {
+		digest := sha256.New()
+		tampered := io.TeeReader(bytes.NewReader([]byte("tampered")), digest)
+		assert.ErrorIs(t, verifyChecksum(tampered, digest, hex.EncodeToString(sum[:])), archive.ErrChecksumMismatch)
+	})
+}
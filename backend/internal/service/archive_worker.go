@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/domain/archive"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// ArchiveWorker periodically moves spin partitions past retention to cold storage
+type ArchiveWorker struct {
+	archives archive.Service
+	interval time.Duration
+	clock    clock.Clock
+	logger   *logger.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewArchiveWorker creates a new archive worker
+func NewArchiveWorker(cfg *config.Config, archives archive.Service, clk clock.Clock, log *logger.Logger) *ArchiveWorker {
+	interval := time.Duration(cfg.Archive.IntervalMinutes) * time.Minute
+	if cfg.Archive.RetentionMonths <= 0 {
+		interval = 0
+	}
+	return &ArchiveWorker{
+		archives: archives,
+		interval: interval,
+		clock:    clk,
+		logger:   log,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the worker in the background; a zero interval or retention disables it
+func (w *ArchiveWorker) Start() {
+	if w.interval <= 0 {
+		close(w.done)
+		w.logger.Info().Msg("Archive worker disabled")
+		return
+	}
+
+	go w.run()
+	w.logger.Info().Dur("interval", w.interval).Msg("Archive worker started")
+}
+
+// Stop stops the worker and waits for a running pass to finish
+func (w *ArchiveWorker) Stop() {
+	w.stopOnce.Do(func() { close(w.stop) })
+	<-w.done
+}
+
+func (w *ArchiveWorker) run() {
+	defer close(w.done)
+
+	ticker := w.clock.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case now := <-ticker.C():
+			w.Run(context.Background(), now)
+		}
+	}
+}
+
+// Run archives every expired partition and returns how many it archived
+func (w *ArchiveWorker) Run(ctx context.Context, now time.Time) int {
+	created, err := w.archives.ArchiveExpired(ctx, now)
+	if err != nil {
+		w.logger.Error().Err(err).Int("archived", len(created)).Msg("Spin archival pass failed")
+	} else if len(created) > 0 {
+		w.logger.Info().Int("archived", len(created)).Msg("Archived expired spin partitions")
+	}
+	return len(created)
+}
@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/pkg/security"
+)
+
+// AssetFileService keeps the asset_files hash records in sync with storage.
+// Uploads are addressed by theme folder (Asset.ObjectName); files uploaded to a
+// folder that no asset points at yet are not recorded - run Rehash after creating
+// the asset to pick them up.
+type AssetFileService struct {
+	gameRepo game.Repository
+	storage  storage.Storage
+	logger   *logger.Logger
+}
+
+// NewAssetFileService creates a new asset file service
+func NewAssetFileService(
+	gameRepo game.Repository,
+	s storage.Storage,
+	log *logger.Logger,
+) *AssetFileService {
+	return &AssetFileService{
+		gameRepo: gameRepo,
+		storage:  s,
+		logger:   log,
+	}
+}
+
+// HashingReader wraps a reader and computes SHA256 and size of everything read through it
+type HashingReader struct {
+	r    io.Reader
+	hash hash.Hash
+	n    int64
+}
+
+// NewHashingReader creates a HashingReader around r
+func NewHashingReader(r io.Reader) *HashingReader {
+	return &HashingReader{r: r, hash: sha256.New()}
+}
+
+// Read implements io.Reader
+func (h *HashingReader) Read(p []byte) (int, error) {
+	n, err := h.r.Read(p)
+	if n > 0 {
+		h.hash.Write(p[:n])
+		h.n += int64(n)
+	}
+	return n, err
+}
+
+// Sum returns the hex SHA256 of the bytes read so far
+func (h *HashingReader) Sum() string {
+	return hex.EncodeToString(h.hash.Sum(nil))
+}
+
+// Size returns the number of bytes read so far
+func (h *HashingReader) Size() int64 {
+	return h.n
+}
+
+// Record stores the hash of a file uploaded to a theme folder
+func (s *AssetFileService) Record(ctx context.Context, objectName, path, sum string, size int64) error {
+	asset, err := s.gameRepo.GetAssetByObjectName(ctx, objectName)
+	if err != nil {
+		if errors.Is(err, game.ErrAssetNotFound) {
+			return nil
+		}
+		return err
+	}
+	return s.recordForAsset(ctx, asset.ID, path, sum, size)
+}
+
+// RecordFromStorage downloads a stored file and records its hash
+// Used when the bytes never passed through the server (presigned direct uploads)
+func (s *AssetFileService) RecordFromStorage(ctx context.Context, objectName, path string) error {
+	asset, err := s.gameRepo.GetAssetByObjectName(ctx, objectName)
+	if err != nil {
+		if errors.Is(err, game.ErrAssetNotFound) {
+			return nil
+		}
+		return err
+	}
+	return s.hashFromStorage(ctx, asset, path)
+}
+
+// Forget removes the hash record of a deleted file
+func (s *AssetFileService) Forget(ctx context.Context, objectName, path string) error {
+	asset, err := s.gameRepo.GetAssetByObjectName(ctx, objectName)
+	if err != nil {
+		if errors.Is(err, game.ErrAssetNotFound) {
+			return nil
+		}
+		return err
+	}
+	return s.gameRepo.DeleteAssetFile(ctx, asset.ID, path)
+}
+
+// ForgetAll removes every hash record of a deleted theme folder
+func (s *AssetFileService) ForgetAll(ctx context.Context, objectName string) error {
+	asset, err := s.gameRepo.GetAssetByObjectName(ctx, objectName)
+	if err != nil {
+		if errors.Is(err, game.ErrAssetNotFound) {
+			return nil
+		}
+		return err
+	}
+	return s.gameRepo.DeleteAssetFiles(ctx, asset.ID)
+}
+
+// Rehash rebuilds the hash records of an asset from the files currently in storage
+// Records of files no longer present in storage are dropped. Returns the number of files hashed.
+func (s *AssetFileService) Rehash(ctx context.Context, assetID uuid.UUID) (int, error) {
+	asset, err := s.gameRepo.GetAssetByID(ctx, assetID)
+	if err != nil {
+		return 0, err
+	}
+
+	files, err := s.storage.ListFiles(ctx, asset.ObjectName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list asset files: %w", err)
+	}
+
+	if err := s.gameRepo.DeleteAssetFiles(ctx, asset.ID); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, f := range files {
+		if f.Name == storage.FolderMarker {
+			continue
+		}
+		if err := s.hashFromStorage(ctx, asset, f.Name); err != nil {
+			return 0, err
+		}
+		count++
+	}
+
+	s.logger.Info().
+		Str("asset_id", asset.ID.String()).
+		Int("files", count).
+		Msg("Asset file hashes rebuilt")
+
+	return count, nil
+}
+
+// AssetUsageReport cross-references the files stored under an asset's folder
+// against the paths its Images/Audios/Videos/SpritesheetJSON fields point at
+type AssetUsageReport struct {
+	AssetID      uuid.UUID          `json:"asset_id"`
+	ObjectName   string             `json:"object_name"`
+	StoredFiles  int                `json:"stored_files"`
+	References   int                `json:"references"`
+	Orphaned     []storage.FileInfo `json:"orphaned"`
+	OrphanedSize int64              `json:"orphaned_size"`
+	Missing      []string           `json:"missing"`
+	// Deleted lists the orphans removed when the report was run with cleanup
+	Deleted []string `json:"deleted,omitempty"`
+}
+
+// Usage builds the usage report of an asset
+// Orphaned files are stored but not referenced; missing files are referenced but not stored.
+// When cleanup is true, orphaned files are deleted from storage along with their hash records.
+func (s *AssetFileService) Usage(ctx context.Context, assetID uuid.UUID, cleanup bool) (*AssetUsageReport, error) {
+	asset, err := s.gameRepo.GetAssetByID(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := game.CollectAssetReferences(asset)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := s.storage.ListFiles(ctx, asset.ObjectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list asset files: %w", err)
+	}
+
+	report := &AssetUsageReport{
+		AssetID:    asset.ID,
+		ObjectName: asset.ObjectName,
+		References: len(refs),
+		Orphaned:   []storage.FileInfo{},
+		Missing:    []string{},
+	}
+
+	stored := make(map[string]struct{}, len(files))
+	for _, f := range files {
+		if f.Name == storage.FolderMarker {
+			continue
+		}
+		stored[f.Name] = struct{}{}
+		report.StoredFiles++
+		if _, ok := refs[f.Name]; !ok {
+			report.Orphaned = append(report.Orphaned, f)
+			report.OrphanedSize += f.Size
+		}
+	}
+
+	for ref := range refs {
+		if _, ok := stored[ref]; !ok {
+			report.Missing = append(report.Missing, ref)
+		}
+	}
+
+	sort.Slice(report.Orphaned, func(i, j int) bool { return report.Orphaned[i].Name < report.Orphaned[j].Name })
+	sort.Strings(report.Missing)
+
+	if cleanup {
+		for _, f := range report.Orphaned {
+			if err := s.storage.DeleteFile(ctx, asset.ObjectName, f.Name); err != nil {
+				return nil, fmt.Errorf("failed to delete orphaned file %s: %w", f.Name, err)
+			}
+			if err := s.gameRepo.DeleteAssetFile(ctx, asset.ID, f.Name); err != nil {
+				return nil, err
+			}
+			report.Deleted = append(report.Deleted, f.Name)
+		}
+
+		s.logger.Info().
+			Str("asset_id", asset.ID.String()).
+			Int("deleted", len(report.Deleted)).
+			Int64("freed_bytes", report.OrphanedSize).
+			Msg("Orphaned asset files deleted")
+	}
+
+	return report, nil
+}
+
+// hashFromStorage streams one stored file through SHA256 and records it
+func (s *AssetFileService) hashFromStorage(ctx context.Context, asset *game.Asset, path string) error {
+	reader, err := s.storage.DownloadFile(ctx, asset.ObjectName, path)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", path, err)
+	}
+	defer reader.Close()
+
+	hr := NewHashingReader(reader)
+	if _, err := io.Copy(io.Discard, hr); err != nil {
+		return fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+
+	return s.recordForAsset(ctx, asset.ID, path, hr.Sum(), hr.Size())
+}
+
+// recordForAsset upserts the hash record for a file of a known asset
+func (s *AssetFileService) recordForAsset(ctx context.Context, assetID uuid.UUID, path, sum string, size int64) error {
+	return s.gameRepo.UpsertAssetFile(ctx, &game.AssetFile{
+		AssetID:     assetID,
+		Path:        path,
+		SHA256:      sum,
+		Size:        size,
+		ContentType: security.GetContentType(path),
+	})
+}
@@ -0,0 +1,349 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/internal/config"
+	infraCache "github.com/slotmachine/backend/internal/infra/cache"
+	"github.com/slotmachine/backend/internal/infra/imaging"
+	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// assetImageQueueSize bounds the number of pending optimization jobs
+const assetImageQueueSize = 64
+
+// ErrImageQueueFull is returned when the optimization queue cannot accept more jobs
+var ErrImageQueueFull = errors.New("image optimization queue is full")
+
+// assetImageJob is a queued optimization request for one asset
+type assetImageJob struct {
+	jobID   string
+	assetID uuid.UUID
+}
+
+// AssetImageResult summarizes a completed optimization job
+type AssetImageResult struct {
+	AssetID         uuid.UUID `json:"asset_id"`
+	ImagesProcessed int       `json:"images_processed"`
+	ImagesSkipped   int       `json:"images_skipped"`
+	VariantsCreated int       `json:"variants_created"`
+	SkippedFormats  []string  `json:"skipped_formats,omitempty"`
+	Errors          []string  `json:"errors,omitempty"`
+}
+
+// AssetImageWorker generates resized WebP/AVIF variants for uploaded theme images.
+// Jobs run in the background on a fixed pool of goroutines; progress is reported
+// through the shared ProcessingStatusStore (same mechanism as chunked uploads).
+type AssetImageWorker struct {
+	gameRepo    game.Repository
+	storage     storage.Storage
+	transcoder  *imaging.Transcoder
+	statusStore *infraCache.ProcessingStatusStore
+	assetFiles  *AssetFileService
+	widths      []int
+	formats     []imaging.Format
+	jobs        chan assetImageJob
+	logger      *logger.Logger
+}
+
+// NewAssetImageWorker creates the worker and starts its goroutine pool
+func NewAssetImageWorker(
+	cfg *config.Config,
+	gameRepo game.Repository,
+	s storage.Storage,
+	statusStore *infraCache.ProcessingStatusStore,
+	assetFiles *AssetFileService,
+	log *logger.Logger,
+) *AssetImageWorker {
+	w := &AssetImageWorker{
+		gameRepo:    gameRepo,
+		storage:     s,
+		transcoder:  imaging.NewTranscoder(&cfg.Imaging),
+		statusStore: statusStore,
+		assetFiles:  assetFiles,
+		widths:      imaging.ParseWidths(cfg.Imaging.VariantWidths),
+		formats:     imaging.ParseFormats(cfg.Imaging.VariantFormats),
+		jobs:        make(chan assetImageJob, assetImageQueueSize),
+		logger:      log,
+	}
+
+	workers := cfg.Imaging.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	for i := 0; i < workers; i++ {
+		go w.run()
+	}
+
+	return w
+}
+
+// Enqueue schedules image optimization for an asset and returns the job ID
+// Poll the job ID via the processing status endpoint for progress
+func (w *AssetImageWorker) Enqueue(ctx context.Context, assetID uuid.UUID) (string, error) {
+	// Fail fast on unknown assets instead of reporting it asynchronously
+	if _, err := w.gameRepo.GetAssetByID(ctx, assetID); err != nil {
+		return "", err
+	}
+
+	jobID := generateImageJobID(assetID)
+	status := &infraCache.ProcessingStatus{
+		UploadID:  jobID,
+		Status:    infraCache.ProcessingStatusProcessing,
+		Progress:  0,
+		Message:   "Queued for image optimization...",
+		StartedAt: time.Now(),
+	}
+	if err := w.statusStore.Save(ctx, status); err != nil {
+		w.logger.Warn().Err(err).Str("job_id", jobID).Msg("Failed to save image optimization status")
+	}
+
+	select {
+	case w.jobs <- assetImageJob{jobID: jobID, assetID: assetID}:
+		return jobID, nil
+	default:
+		now := time.Now()
+		_ = w.statusStore.Update(ctx, jobID, func(s *infraCache.ProcessingStatus) {
+			s.Status = infraCache.ProcessingStatusFailed
+			s.Error = ErrImageQueueFull.Error()
+			s.CompletedAt = &now
+		})
+		return "", ErrImageQueueFull
+	}
+}
+
+// run processes jobs until the queue is closed
+func (w *AssetImageWorker) run() {
+	for job := range w.jobs {
+		w.process(job)
+	}
+}
+
+// process generates variants for every transcodable image of the asset
+func (w *AssetImageWorker) process(job assetImageJob) {
+	// Use background context since the HTTP request context is already done
+	ctx := context.Background()
+	log := w.logger.WithFields(map[string]interface{}{
+		"job_id":   job.jobID,
+		"asset_id": job.assetID.String(),
+	})
+
+	updateStatus := func(progress int, message string) {
+		if err := w.statusStore.Update(ctx, job.jobID, func(s *infraCache.ProcessingStatus) {
+			s.Progress = progress
+			s.Message = message
+		}); err != nil {
+			log.Warn().Err(err).Msg("Failed to update processing status")
+		}
+	}
+
+	failWithError := func(errMsg string) {
+		now := time.Now()
+		if err := w.statusStore.Update(ctx, job.jobID, func(s *infraCache.ProcessingStatus) {
+			s.Status = infraCache.ProcessingStatusFailed
+			s.Error = errMsg
+			s.CompletedAt = &now
+		}); err != nil {
+			log.Error().Err(err).Msg("Failed to update processing status on failure")
+		}
+	}
+
+	asset, err := w.gameRepo.GetAssetByID(ctx, job.assetID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load asset for image optimization")
+		failWithError("Failed to load asset")
+		return
+	}
+
+	images, _, err := game.ParseAssetImages(asset.Images)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to parse asset images")
+		failWithError("Failed to parse asset images")
+		return
+	}
+
+	result := &AssetImageResult{AssetID: asset.ID}
+
+	// Only formats with an installed encoder can be produced
+	var formats []imaging.Format
+	for _, f := range w.formats {
+		if w.transcoder.Available(f) {
+			formats = append(formats, f)
+		} else {
+			result.SkippedFormats = append(result.SkippedFormats, string(f))
+		}
+	}
+	if len(formats) == 0 {
+		failWithError("No image encoders available")
+		return
+	}
+
+	// Process in key order so progress and results are deterministic
+	keys := make([]string, 0, len(images))
+	for key, path := range images {
+		if imaging.IsTranscodable(path) {
+			keys = append(keys, key)
+		} else {
+			result.ImagesSkipped++
+		}
+	}
+	sort.Strings(keys)
+
+	variants := make(map[string][]game.ImageVariant, len(keys))
+	for i, key := range keys {
+		updateStatus(5+90*i/max(len(keys), 1), fmt.Sprintf("Optimizing %s (%d/%d)...", key, i+1, len(keys)))
+
+		generated, err := w.processImage(ctx, asset.ObjectName, images[key], formats)
+		if err != nil {
+			log.Warn().Err(err).Str("image", key).Msg("Failed to optimize image")
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %s", key, err.Error()))
+			continue
+		}
+		variants[key] = generated
+		result.ImagesProcessed++
+		result.VariantsCreated += len(generated)
+	}
+
+	updateStatus(95, "Saving variant references...")
+
+	// Reload right before writing so concurrent admin edits to other image keys are kept
+	if err := w.saveVariants(ctx, job.assetID, variants); err != nil {
+		log.Error().Err(err).Msg("Failed to save image variants")
+		failWithError("Failed to save image variants")
+		return
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		resultJSON = []byte("{}")
+	}
+	now := time.Now()
+	if err := w.statusStore.Update(ctx, job.jobID, func(s *infraCache.ProcessingStatus) {
+		s.Status = infraCache.ProcessingStatusCompleted
+		s.Progress = 100
+		s.Message = "Image optimization completed"
+		s.Result = resultJSON
+		s.CompletedAt = &now
+	}); err != nil {
+		log.Error().Err(err).Msg("Failed to update processing status on completion")
+	}
+
+	log.Info().
+		Int("images_processed", result.ImagesProcessed).
+		Int("variants_created", result.VariantsCreated).
+		Int("errors", len(result.Errors)).
+		Msg("Asset image optimization completed")
+}
+
+// processImage downloads one source image and uploads all its variants
+func (w *AssetImageWorker) processImage(ctx context.Context, objectName, srcPath string, formats []imaging.Format) ([]game.ImageVariant, error) {
+	reader, err := w.storage.DownloadFile(ctx, objectName, srcPath)
+	if err != nil {
+		return nil, fmt.Errorf("download failed: %w", err)
+	}
+	src, err := w.transcoder.Decode(reader)
+	reader.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	srcW, srcH := src.Bounds().Dx(), src.Bounds().Dy()
+
+	// Never upscale - fall back to the original width when every configured width is larger
+	var widths []int
+	for _, width := range w.widths {
+		if width <= srcW {
+			widths = append(widths, width)
+		}
+	}
+	if len(widths) == 0 {
+		widths = []int{srcW}
+	}
+
+	var variants []game.ImageVariant
+	for _, width := range widths {
+		resized := imaging.Resize(src, width)
+		for _, format := range formats {
+			data, err := w.transcoder.Encode(ctx, resized, format)
+			if err != nil {
+				return nil, err
+			}
+
+			variantPath := imaging.VariantPath(srcPath, width, format)
+			if _, err := w.storage.UploadFile(ctx, objectName, variantPath, bytes.NewReader(data), int64(len(data)), ""); err != nil {
+				return nil, fmt.Errorf("upload failed: %w", err)
+			}
+			sum := sha256.Sum256(data)
+			if err := w.assetFiles.Record(ctx, objectName, variantPath, hex.EncodeToString(sum[:]), int64(len(data))); err != nil {
+				return nil, fmt.Errorf("failed to record variant hash: %w", err)
+			}
+
+			variants = append(variants, game.ImageVariant{
+				Path:   variantPath,
+				Format: string(format),
+				Width:  width,
+				Height: imaging.ScaledHeight(srcW, srcH, width),
+			})
+		}
+	}
+
+	return variants, nil
+}
+
+// saveVariants merges generated variants into the asset's Images JSON
+func (w *AssetImageWorker) saveVariants(ctx context.Context, assetID uuid.UUID, variants map[string][]game.ImageVariant) error {
+	asset, err := w.gameRepo.GetAssetByID(ctx, assetID)
+	if err != nil {
+		return err
+	}
+
+	var entries map[string]json.RawMessage
+	if len(asset.Images) > 0 {
+		if err := json.Unmarshal(asset.Images, &entries); err != nil {
+			return fmt.Errorf("failed to parse images JSON: %w", err)
+		}
+	}
+	if entries == nil {
+		entries = make(map[string]json.RawMessage)
+	}
+
+	_, existing, err := game.ParseAssetImages(asset.Images)
+	if err != nil {
+		return err
+	}
+	for key, v := range variants {
+		existing[key] = v
+	}
+
+	variantsJSON, err := json.Marshal(existing)
+	if err != nil {
+		return fmt.Errorf("failed to marshal image variants: %w", err)
+	}
+	entries[game.ImageVariantsKey] = variantsJSON
+
+	imagesJSON, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("failed to marshal images JSON: %w", err)
+	}
+
+	_, err = w.gameRepo.UpdateAsset(ctx, assetID, &game.AssetUpdate{Images: imagesJSON})
+	return err
+}
+
+// generateImageJobID creates a unique job ID for an optimization run
+func generateImageJobID(assetID uuid.UUID) string {
+	data := fmt.Sprintf("image_opt_%s_%d", assetID.String(), time.Now().UnixNano())
+	hash := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(hash[:16])
+}
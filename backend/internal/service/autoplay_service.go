@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/autoplay"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// AutoplayService implements autoplay.Service
+type AutoplayService struct {
+	repo        autoplay.Repository
+	sessionRepo session.Repository
+	maxSpins    int
+	logger      *logger.Logger
+	now         func() time.Time
+}
+
+// NewAutoplayService creates a new autoplay service
+func NewAutoplayService(
+	cfg *config.Config,
+	repo autoplay.Repository,
+	sessionRepo session.Repository,
+	log *logger.Logger,
+) *AutoplayService {
+	return &AutoplayService{
+		repo:        repo,
+		sessionRepo: sessionRepo,
+		maxSpins:    cfg.Game.AutoplayMaxSpins,
+		logger:      log,
+		now:         time.Now,
+	}
+}
+
+// Start begins autoplay in the player's active game session
+func (s *AutoplayService) Start(ctx context.Context, playerID, sessionID uuid.UUID, limits autoplay.Limits) (*autoplay.Contract, error) {
+	if err := limits.Validate(s.maxSpins); err != nil {
+		return nil, err
+	}
+	if err := s.checkSession(ctx, playerID, sessionID); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetActiveBySession(ctx, sessionID); err == nil {
+		return nil, autoplay.ErrAutoplayActive
+	} else if !errors.Is(err, autoplay.ErrAutoplayNotFound) {
+		return nil, err
+	}
+
+	contract := &autoplay.Contract{
+		ID:             uuid.New(),
+		PlayerID:       playerID,
+		SessionID:      sessionID,
+		TotalSpins:     limits.Spins,
+		SingleWinLimit: limits.SingleWinLimit,
+		LossLimit:      limits.LossLimit,
+		Status:         autoplay.StatusActive,
+	}
+	if err := s.repo.Create(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Str("autoplay_id", contract.ID.String()).
+		Str("player_id", playerID.String()).
+		Str("session_id", sessionID.String()).
+		Int("spins", limits.Spins).
+		Msg("Autoplay started")
+	return contract, nil
+}
+
+// GetActive returns the session's running contract
+func (s *AutoplayService) GetActive(ctx context.Context, playerID, sessionID uuid.UUID) (*autoplay.Contract, error) {
+	contract, err := s.repo.GetActiveBySession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if contract.PlayerID != playerID {
+		return nil, autoplay.ErrAutoplayNotFound
+	}
+	return contract, nil
+}
+
+// Stop cancels the session's running contract
+func (s *AutoplayService) Stop(ctx context.Context, playerID, sessionID uuid.UUID) (*autoplay.Contract, error) {
+	contract, err := s.GetActive(ctx, playerID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	contract.Stop(autoplay.StopCancelled, s.now())
+	if err := s.repo.Update(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Str("autoplay_id", contract.ID.String()).
+		Str("player_id", playerID.String()).
+		Int("spins_played", contract.SpinsPlayed).
+		Msg("Autoplay cancelled")
+	return contract, nil
+}
+
+// CheckSpin returns the session's running contract if an autoplay spin of bet may be played
+func (s *AutoplayService) CheckSpin(ctx context.Context, playerID, sessionID uuid.UUID, bet float64) (*autoplay.Contract, error) {
+	contract, err := s.GetActive(ctx, playerID, sessionID)
+	if errors.Is(err, autoplay.ErrAutoplayNotFound) {
+		return nil, autoplay.ErrAutoplayNotActive
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := contract.CheckSpin(bet); err != nil {
+		// The spin could breach the loss limit: autoplay ends here rather than after the loss
+		if errors.Is(err, autoplay.ErrLossLimitReached) {
+			contract.Stop(autoplay.StopLossLimit, s.now())
+			if updateErr := s.repo.Update(ctx, contract); updateErr != nil {
+				return nil, updateErr
+			}
+		}
+		return nil, err
+	}
+	return contract, nil
+}
+
+// RecordSpin counts a settled spin against the contract, stopping it when a stop condition is met
+// Called within the spin's transaction, so the contract only counts spins that committed.
+func (s *AutoplayService) RecordSpin(ctx context.Context, contract *autoplay.Contract, bet, win float64) error {
+	contract.RecordSpin(bet, win, s.now())
+	if err := s.repo.Update(ctx, contract); err != nil {
+		return err
+	}
+
+	if contract.Status != autoplay.StatusActive {
+		s.logger.Info().
+			Str("autoplay_id", contract.ID.String()).
+			Str("player_id", contract.PlayerID.String()).
+			Str("stop_reason", string(*contract.StopReason)).
+			Int("spins_played", contract.SpinsPlayed).
+			Msg("Autoplay stopped")
+	}
+	return nil
+}
+
+// checkSession verifies the game session is the player's and has not ended
+func (s *AutoplayService) checkSession(ctx context.Context, playerID, sessionID uuid.UUID) error {
+	sess, err := s.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if sess.PlayerID != playerID {
+		return session.ErrSessionNotFound
+	}
+	if sess.EndedAt != nil {
+		return session.ErrSessionAlreadyEnded
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/autoplay"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAutoplayRepository is a mock implementation of autoplay.Repository
+type MockAutoplayRepository struct {
+	mock.Mock
+}
+
+func (m *MockAutoplayRepository) Create(ctx context.Context, c *autoplay.Contract) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *MockAutoplayRepository) GetActiveBySession(ctx context.Context, sessionID uuid.UUID) (*autoplay.Contract, error) {
+	args := m.Called(ctx, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*autoplay.Contract), args.Error(1)
+}
+
+func (m *MockAutoplayRepository) Update(ctx context.Context, c *autoplay.Contract) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func newTestAutoplayService() (*AutoplayService, *MockAutoplayRepository, *MockSessionRepository) {
+	repo := new(MockAutoplayRepository)
+	sessionRepo := new(MockSessionRepository)
+	cfg := &config.Config{Game: config.GameConfig{AutoplayMaxSpins: 100}}
+	return NewAutoplayService(cfg, repo, sessionRepo, logger.New("error", "json")), repo, sessionRepo
+}
+
+func TestAutoplayService_Start(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	sessionID := uuid.New()
+	lossLimit := 100.0
+
+	t.Run("should start autoplay in the player's session", func(t *testing.T) {
+		svc, repo, sessionRepo := newTestAutoplayService()
+		sessionRepo.On("GetByID", ctx, sessionID).Return(&session.GameSession{ID: sessionID, PlayerID: playerID}, nil)
+		repo.On("GetActiveBySession", ctx, sessionID).Return(nil, autoplay.ErrAutoplayNotFound)
+		repo.On("Create", ctx, mock.AnythingOfType("*autoplay.Contract")).Return(nil)
+
+		contract, err := svc.Start(ctx, playerID, sessionID, autoplay.Limits{Spins: 25, LossLimit: &lossLimit})
+		require.NoError(t, err)
+		assert.Equal(t, autoplay.StatusActive, contract.Status)
+		assert.Equal(t, 25, contract.RemainingSpins())
+		assert.Equal(t, &lossLimit, contract.LossLimit)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("should reject invalid limits", func(t *testing.T) {
+		svc, repo, _ := newTestAutoplayService()
+		negative := -1.0
+		for _, limits := range []autoplay.Limits{{Spins: 0}, {Spins: 101}, {Spins: 10, LossLimit: &negative}, {Spins: 10, SingleWinLimit: &negative}} {
+			_, err := svc.Start(ctx, playerID, sessionID, limits)
+			assert.ErrorIs(t, err, autoplay.ErrInvalidLimits)
+		}
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject a second autoplay in the session", func(t *testing.T) {
+		svc, repo, sessionRepo := newTestAutoplayService()
+		sessionRepo.On("GetByID", ctx, sessionID).Return(&session.GameSession{ID: sessionID, PlayerID: playerID}, nil)
+		repo.On("GetActiveBySession", ctx, sessionID).Return(&autoplay.Contract{Status: autoplay.StatusActive}, nil)
+
+		_, err := svc.Start(ctx, playerID, sessionID, autoplay.Limits{Spins: 10})
+		assert.ErrorIs(t, err, autoplay.ErrAutoplayActive)
+	})
+
+	t.Run("should reject ended and other players' sessions", func(t *testing.T) {
+		svc, _, sessionRepo := newTestAutoplayService()
+		endedAt := time.Now()
+		endedID := uuid.New()
+		sessionRepo.On("GetByID", ctx, endedID).Return(&session.GameSession{ID: endedID, PlayerID: playerID, EndedAt: &endedAt}, nil)
+		sessionRepo.On("GetByID", ctx, sessionID).Return(&session.GameSession{ID: sessionID, PlayerID: uuid.New()}, nil)
+
+		_, err := svc.Start(ctx, playerID, endedID, autoplay.Limits{Spins: 10})
+		assert.ErrorIs(t, err, session.ErrSessionAlreadyEnded)
+		_, err = svc.Start(ctx, playerID, sessionID, autoplay.Limits{Spins: 10})
+		assert.ErrorIs(t, err, session.ErrSessionNotFound)
+	})
+}
+
+func TestAutoplayService_Spins(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	sessionID := uuid.New()
+
+	newContract := func(spins int, singleWinLimit, lossLimit *float64) *autoplay.Contract {
+		return &autoplay.Contract{
+			ID:             uuid.New(),
+			PlayerID:       playerID,
+			SessionID:      sessionID,
+			TotalSpins:     spins,
+			SingleWinLimit: singleWinLimit,
+			LossLimit:      lossLimit,
+			Status:         autoplay.StatusActive,
+		}
+	}
+	limit := func(v float64) *float64 { return &v }
+
+	t.Run("should reject spins without a running autoplay", func(t *testing.T) {
+		svc, repo, _ := newTestAutoplayService()
+		repo.On("GetActiveBySession", ctx, sessionID).Return(nil, autoplay.ErrAutoplayNotFound)
+
+		_, err := svc.CheckSpin(ctx, playerID, sessionID, 10)
+		assert.ErrorIs(t, err, autoplay.ErrAutoplayNotActive)
+	})
+
+	t.Run("should reject another player's autoplay", func(t *testing.T) {
+		svc, repo, _ := newTestAutoplayService()
+		repo.On("GetActiveBySession", ctx, sessionID).Return(newContract(10, nil, nil), nil)
+
+		_, err := svc.CheckSpin(ctx, uuid.New(), sessionID, 10)
+		assert.ErrorIs(t, err, autoplay.ErrAutoplayNotActive)
+	})
+
+	t.Run("should stop before a spin could exceed the loss limit", func(t *testing.T) {
+		svc, repo, _ := newTestAutoplayService()
+		contract := newContract(10, nil, limit(25))
+		contract.TotalWagered = 20
+		repo.On("GetActiveBySession", ctx, sessionID).Return(contract, nil)
+		repo.On("Update", ctx, contract).Return(nil)
+
+		_, err := svc.CheckSpin(ctx, playerID, sessionID, 10)
+		assert.ErrorIs(t, err, autoplay.ErrLossLimitReached)
+		assert.Equal(t, autoplay.StatusStopped, contract.Status)
+		assert.Equal(t, autoplay.StopLossLimit, *contract.StopReason)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("should stop on the configured conditions", func(t *testing.T) {
+		for _, tc := range []struct {
+			contract *autoplay.Contract
+			win      float64
+			want     autoplay.StopReason
+		}{
+			{newContract(1, nil, nil), 0, autoplay.StopSpinsCompleted},
+			{newContract(10, limit(50), nil), 50, autoplay.StopSingleWin},
+			{newContract(10, nil, limit(10)), 0, autoplay.StopLossLimit},
+		} {
+			svc, repo, _ := newTestAutoplayService()
+			repo.On("Update", ctx, tc.contract).Return(nil)
+
+			require.NoError(t, svc.RecordSpin(ctx, tc.contract, 10, tc.win))
+			assert.Equal(t, autoplay.StatusStopped, tc.contract.Status)
+			require.NotNil(t, tc.contract.StopReason)
+			assert.Equal(t, tc.want, *tc.contract.StopReason)
+			assert.Equal(t, 0, tc.contract.RemainingSpins())
+		}
+	})
+
+	t.Run("should keep running until a condition is met", func(t *testing.T) {
+		svc, repo, _ := newTestAutoplayService()
+		contract := newContract(10, limit(100), limit(50))
+		repo.On("Update", ctx, contract).Return(nil)
+
+		require.NoError(t, svc.RecordSpin(ctx, contract, 10, 40))
+		assert.Equal(t, autoplay.StatusActive, contract.Status)
+		assert.Equal(t, 9, contract.RemainingSpins())
+		assert.Equal(t, -30.0, contract.NetLoss())
+	})
+
+	t.Run("should cancel the running autoplay", func(t *testing.T) {
+		svc, repo, _ := newTestAutoplayService()
+		contract := newContract(10, nil, nil)
+		repo.On("GetActiveBySession", ctx, sessionID).Return(contract, nil)
+		repo.On("Update", ctx, contract).Return(nil)
+
+		stopped, err := svc.Stop(ctx, playerID, sessionID)
+		require.NoError(t, err)
+		assert.Equal(t, autoplay.StopCancelled, *stopped.StopReason)
+		assert.NotNil(t, stopped.StoppedAt)
+	})
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/balance"
+	"github.com/slotmachine/backend/domain/launch"
+	"github.com/slotmachine/backend/domain/notification"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/segment"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// balancePublishTimeout bounds publishing a balance event after the credit committed
+const balancePublishTimeout = 5 * time.Second
+
+// BalanceService implements balance.Service
+// Credits from wallet and bonus systems are applied to the ledger, then pushed to the player's
+// connected clients; caches derived from the balance are invalidated so nothing shows it stale.
+type BalanceService struct {
+	repo     balance.Repository
+	store    balance.Store
+	links    launch.Repository
+	segments segment.Service     // Optional: nil skips invalidating cached segment targets
+	notifier notification.Sender // Optional: nil sends no bonus notifications
+	logger   *logger.Logger
+	now      func() time.Time
+}
+
+// NewBalanceService creates a new balance service
+func NewBalanceService(repo balance.Repository, store balance.Store, links launch.Repository, segments segment.Service, notifier notification.Sender, log *logger.Logger) *BalanceService {
+	return &BalanceService{
+		repo:     repo,
+		store:    store,
+		links:    links,
+		segments: segments,
+		notifier: notifier,
+		logger:   log,
+		now:      time.Now,
+	}
+}
+
+// Credit applies a credit and pushes the new balance to the player's clients
+// The credit is committed before the push; a failed push is logged, not returned.
+func (s *BalanceService) Credit(ctx context.Context, credit *balance.Credit) (*balance.Transaction, error) {
+	if err := credit.Validate(); err != nil {
+		return nil, err
+	}
+
+	t, err := s.apply(ctx, credit.PlayerID, credit.Source.TransactionType(), credit.Amount, credit.Reference, credit.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Str("player_id", credit.PlayerID.String()).
+		Str("source", string(credit.Source)).
+		Str("reference", credit.Reference).
+		Float64("amount", credit.Amount).
+		Float64("new_balance", t.BalanceAfter).
+		Msg("External credit applied")
+
+	s.publish(ctx, t, credit.Source, credit.Reference)
+	if credit.Source == balance.SourceBonus {
+		s.notifyBonus(ctx, t, credit)
+	}
+	return t, nil
+}
+
+// Adjust applies an approved admin adjustment and pushes the new balance to the player's clients
+// A debit larger than the balance fails with player.ErrInsufficientBalance.
+func (s *BalanceService) Adjust(ctx context.Context, adj *balance.Adjustment) (*balance.Transaction, error) {
+	if err := adj.Validate(); err != nil {
+		return nil, err
+	}
+
+	t, err := s.apply(ctx, adj.PlayerID, "adjustment", adj.Amount, adj.Reference, adj.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Str("player_id", adj.PlayerID.String()).
+		Str("reference", adj.Reference).
+		Float64("amount", adj.Amount).
+		Float64("new_balance", t.BalanceAfter).
+		Msg("Balance adjustment applied")
+
+	s.publish(ctx, t, balance.SourceAdjustment, adj.Reference)
+	return t, nil
+}
+
+// apply records the ledger entry and invalidates caches derived from the balance
+func (s *BalanceService) apply(ctx context.Context, playerID uuid.UUID, txType string, amount float64, reference, description string) (*balance.Transaction, error) {
+	t := &balance.Transaction{
+		ID:        uuid.New(),
+		PlayerID:  playerID,
+		Type:      txType,
+		Amount:    amount,
+		Reference: &reference,
+		CreatedAt: s.now().UTC(),
+	}
+	if description != "" {
+		t.Description = &description
+	}
+	if err := s.repo.Credit(ctx, t); err != nil {
+		return nil, err
+	}
+
+	if s.segments != nil {
+		s.segments.InvalidatePlayer(ctx, playerID)
+	}
+	return t, nil
+}
+
+// publish pushes a committed balance change to the player's clients; a failed push is logged
+func (s *BalanceService) publish(ctx context.Context, t *balance.Transaction, source balance.Source, reference string) {
+	publishCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), balancePublishTimeout)
+	defer cancel()
+	event := &balance.Event{
+		Type:      balance.EventBalanceUpdated,
+		PlayerID:  t.PlayerID,
+		Balance:   t.BalanceAfter,
+		Delta:     t.Amount,
+		Source:    source,
+		Reference: reference,
+		CreatedAt: t.CreatedAt,
+	}
+	if err := s.store.Publish(publishCtx, event); err != nil {
+		s.logger.Warn().Err(err).Str("player_id", t.PlayerID.String()).Msg("Failed to push balance update")
+	}
+}
+
+// notifyBonus tells the player about a bonus credit; a failed notification is logged
+func (s *BalanceService) notifyBonus(ctx context.Context, t *balance.Transaction, credit *balance.Credit) {
+	if s.notifier == nil {
+		return
+	}
+	n := &notification.Notification{
+		PlayerID: &t.PlayerID,
+		Type:     notification.TypeBonusGranted,
+		Title:    "Bonus granted",
+		Body:     fmt.Sprintf("A bonus of %.2f was added to your balance.", credit.Amount),
+		Data: map[string]any{
+			"amount":      credit.Amount,
+			"balance":     t.BalanceAfter,
+			"reference":   credit.Reference,
+			"description": credit.Description,
+		},
+	}
+	if err := s.notifier.Send(ctx, n); err != nil {
+		s.logger.Warn().Err(err).Str("player_id", t.PlayerID.String()).Str("reference", credit.Reference).Msg("Failed to notify bonus credit")
+	}
+}
+
+// CreditOperatorPlayer applies a credit to the local player linked to an operator's player
+func (s *BalanceService) CreditOperatorPlayer(ctx context.Context, operatorID, externalID string, credit *balance.Credit) (*balance.Transaction, error) {
+	link, err := s.links.GetLink(ctx, operatorID, externalID)
+	if err != nil {
+		if errors.Is(err, launch.ErrLinkNotFound) {
+			return nil, player.ErrPlayerNotFound
+		}
+		return nil, err
+	}
+	credit.PlayerID = link.PlayerID
+	return s.Credit(ctx, credit)
+}
+
+// Subscribe streams the player's balance events until ctx is cancelled
+func (s *BalanceService) Subscribe(ctx context.Context, playerID uuid.UUID) (<-chan *balance.Event, error) {
+	return s.store.Subscribe(ctx, playerID)
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/balance"
+	"github.com/slotmachine/backend/domain/launch"
+	"github.com/slotmachine/backend/domain/notification"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/segment"
+	"github.com/slotmachine/backend/internal/infra/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockBalanceRepository is a mock implementation of balance.Repository
+type MockBalanceRepository struct {
+	mock.Mock
+}
+
+func (m *MockBalanceRepository) Credit(ctx context.Context, t *balance.Transaction) error {
+	args := m.Called(ctx, t)
+	return args.Error(0)
+}
+
+// invalidationRecorder records segment cache invalidations; other segment.Service methods are unused
+type invalidationRecorder struct {
+	segment.Service
+	players []uuid.UUID
+}
+
+func (r *invalidationRecorder) InvalidatePlayer(ctx context.Context, playerID uuid.UUID) {
+	r.players = append(r.players, playerID)
+}
+
+// sentNotifications records the notifications sent
+type sentNotifications []*notification.Notification
+
+func (s *sentNotifications) Send(ctx context.Context, n *notification.Notification) error {
+	*s = append(*s, n)
+	return nil
+}
+
+func newTestBalanceService() (*BalanceService, *MockBalanceRepository, *memoryLaunchStore, *invalidationRecorder) {
+	log := logger.New("error", "json")
+	repo := new(MockBalanceRepository)
+	links := newMemoryLaunchStore()
+	segments := &invalidationRecorder{}
+	return NewBalanceService(repo, cache.NewBalanceEventStore(nil, log), links, segments, nil, log), repo, links, segments
+}
+
+func TestBalanceService_Credit(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+
+	t.Run("should apply the credit and push the new balance to the player", func(t *testing.T) {
+		svc, repo, _, segments := newTestBalanceService()
+		repo.On("Credit", ctx, mock.AnythingOfType("*balance.Transaction")).Run(func(args mock.Arguments) {
+			tx := args.Get(1).(*balance.Transaction)
+			tx.BalanceBefore, tx.BalanceAfter = 40, 40+tx.Amount
+		}).Return(nil)
+
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		events, err := svc.Subscribe(subCtx, playerID)
+		require.NoError(t, err)
+		others, err := svc.Subscribe(subCtx, uuid.New())
+		require.NoError(t, err)
+
+		tx, err := svc.Credit(ctx, &balance.Credit{PlayerID: playerID, Amount: 10, Source: balance.SourceBonus, Reference: "promo-7"})
+		require.NoError(t, err)
+		assert.Equal(t, "bonus", tx.Type)
+		assert.Equal(t, "promo-7", *tx.Reference)
+		assert.Nil(t, tx.Description)
+
+		select {
+		case event := <-events:
+			assert.Equal(t, balance.EventBalanceUpdated, event.Type)
+			assert.Equal(t, playerID, event.PlayerID)
+			assert.Equal(t, 50.0, event.Balance)
+			assert.Equal(t, 10.0, event.Delta)
+			assert.Equal(t, balance.SourceBonus, event.Source)
+		case <-time.After(time.Second):
+			t.Fatal("balance event was not pushed")
+		}
+		assert.Empty(t, others, "other players are not notified")
+		assert.Equal(t, []uuid.UUID{playerID}, segments.players)
+	})
+
+	t.Run("should notify the player of bonus credits only", func(t *testing.T) {
+		svc, repo, _, _ := newTestBalanceService()
+		sent := &sentNotifications{}
+		svc.notifier = sent
+		repo.On("Credit", ctx, mock.AnythingOfType("*balance.Transaction")).Return(nil)
+
+		_, err := svc.Credit(ctx, &balance.Credit{PlayerID: playerID, Amount: 5, Source: balance.SourceWallet, Reference: "dep-1"})
+		require.NoError(t, err)
+		_, err = svc.Credit(ctx, &balance.Credit{PlayerID: playerID, Amount: 10, Source: balance.SourceBonus, Reference: "promo-8"})
+		require.NoError(t, err)
+
+		require.Len(t, *sent, 1)
+		n := (*sent)[0]
+		assert.Equal(t, notification.TypeBonusGranted, n.Type)
+		assert.Equal(t, playerID, *n.PlayerID)
+		assert.Equal(t, 10.0, n.Data["amount"])
+		assert.Equal(t, "promo-8", n.Data["reference"])
+	})
+
+	t.Run("should reject invalid credits", func(t *testing.T) {
+		svc, repo, _, _ := newTestBalanceService()
+		for credit, want := range map[*balance.Credit]error{
+			{PlayerID: playerID, Amount: 0, Source: balance.SourceWallet, Reference: "r"}:  balance.ErrInvalidAmount,
+			{PlayerID: playerID, Amount: -5, Source: balance.SourceWallet, Reference: "r"}: balance.ErrInvalidAmount,
+			{PlayerID: playerID, Amount: 5, Source: "cashback", Reference: "r"}:            balance.ErrInvalidSource,
+			{PlayerID: playerID, Amount: 5, Source: balance.SourceWallet}:                  balance.ErrReferenceRequired,
+		} {
+			_, err := svc.Credit(ctx, credit)
+			assert.ErrorIs(t, err, want)
+		}
+		repo.AssertNotCalled(t, "Credit", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should not push credits that were not applied", func(t *testing.T) {
+		svc, repo, _, segments := newTestBalanceService()
+		repo.On("Credit", ctx, mock.Anything).Return(balance.ErrDuplicateCredit)
+
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		events, err := svc.Subscribe(subCtx, playerID)
+		require.NoError(t, err)
+
+		_, err = svc.Credit(ctx, &balance.Credit{PlayerID: playerID, Amount: 5, Source: balance.SourceWallet, Reference: "dep-1"})
+		assert.ErrorIs(t, err, balance.ErrDuplicateCredit)
+		assert.Empty(t, events)
+		assert.Empty(t, segments.players)
+	})
+}
+
+func TestBalanceService_CreditOperatorPlayer(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+
+	svc, repo, links, _ := newTestBalanceService()
+	require.NoError(t, links.CreateLink(ctx, &launch.PlayerLink{OperatorID: "op-1", ExternalPlayerID: "ext-1", PlayerID: playerID}))
+	repo.On("Credit", ctx, mock.MatchedBy(func(tx *balance.Transaction) bool { return tx.PlayerID == playerID })).Return(nil)
+
+	tx, err := svc.CreditOperatorPlayer(ctx, "op-1", "ext-1", &balance.Credit{Amount: 5, Source: balance.SourceWallet, Reference: "dep-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "deposit", tx.Type)
+
+	_, err = svc.CreditOperatorPlayer(ctx, "op-1", "ext-2", &balance.Credit{Amount: 5, Source: balance.SourceWallet, Reference: "dep-2"})
+	assert.ErrorIs(t, err, player.ErrPlayerNotFound)
+	repo.AssertNumberOfCalls(t, "Credit", 1)
+}
+
+func TestBalanceService_Adjust(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+
+	t.Run("should record a debit as an adjustment", func(t *testing.T) {
+		svc, repo, _, segments := newTestBalanceService()
+		repo.On("Credit", ctx, mock.AnythingOfType("*balance.Transaction")).Run(func(args mock.Arguments) {
+			tx := args.Get(1).(*balance.Transaction)
+			tx.BalanceBefore, tx.BalanceAfter = 40, 40+tx.Amount
+		}).Return(nil)
+
+		tx, err := svc.Adjust(ctx, &balance.Adjustment{PlayerID: playerID, Amount: -15, Reference: "change_request:1", Description: "Duplicate payout"})
+		require.NoError(t, err)
+		assert.Equal(t, "adjustment", tx.Type)
+		assert.Equal(t, 25.0, tx.BalanceAfter)
+		assert.Equal(t, "Duplicate payout", *tx.Description)
+		assert.Equal(t, []uuid.UUID{playerID}, segments.players)
+	})
+
+	t.Run("should reject zero amounts and missing references", func(t *testing.T) {
+		svc, repo, _, _ := newTestBalanceService()
+
+		_, err := svc.Adjust(ctx, &balance.Adjustment{PlayerID: playerID, Reference: "change_request:2"})
+		assert.ErrorIs(t, err, balance.ErrInvalidAdjustment)
+		_, err = svc.Adjust(ctx, &balance.Adjustment{PlayerID: playerID, Amount: 5})
+		assert.ErrorIs(t, err, balance.ErrReferenceRequired)
+		repo.AssertNotCalled(t, "Credit", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/bigwin"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// BigWinService implements bigwin.Service
+type BigWinService struct {
+	repo          bigwin.Repository
+	notifier      bigwin.Notifier // Optional: nil records big wins without announcing them
+	threshold     float64
+	notifyTimeout time.Duration
+	logger        *logger.Logger
+	wg            sync.WaitGroup // tracks in-flight notifications
+}
+
+// NewBigWinService creates a new big win service
+func NewBigWinService(
+	repo bigwin.Repository,
+	notifier bigwin.Notifier,
+	cfg *config.Config,
+	log *logger.Logger,
+) *BigWinService {
+	timeout := time.Duration(cfg.BigWin.NotifyTimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &BigWinService{
+		repo:          repo,
+		notifier:      notifier,
+		threshold:     cfg.BigWin.ThresholdMultiplier,
+		notifyTimeout: timeout,
+		logger:        log,
+	}
+}
+
+// Check records the spin as a big win when its win reaches the threshold multiple of the bet
+// Notifiers run in the background so a slow endpoint never delays the spin response.
+func (s *BigWinService) Check(ctx context.Context, sp *spin.Spin, proof *bigwin.Proof) (*bigwin.BigWin, error) {
+	if s.threshold <= 0 || sp.BetAmount <= 0 {
+		return nil, nil
+	}
+	multiplier := sp.TotalWin / sp.BetAmount
+	if multiplier < s.threshold {
+		return nil, nil
+	}
+
+	win := &bigwin.BigWin{
+		ID:         uuid.New(),
+		SpinID:     sp.ID,
+		PlayerID:   sp.PlayerID,
+		SessionID:  sp.SessionID,
+		BetAmount:  sp.BetAmount,
+		WinAmount:  sp.TotalWin,
+		Multiplier: math.Round(multiplier*100) / 100,
+		IsFreeSpin: sp.IsFreeSpin,
+		Proof:      proof,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if err := s.repo.Create(ctx, win); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Str("big_win_id", win.ID.String()).
+		Str("spin_id", win.SpinID.String()).
+		Str("player_id", win.PlayerID.String()).
+		Float64("multiplier", win.Multiplier).
+		Msg("Big win detected")
+
+	if s.notifier != nil {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.notify(win)
+		}()
+	}
+	return win, nil
+}
+
+// notify runs the notifier and stamps the big win once delivery succeeds
+func (s *BigWinService) notify(win *bigwin.BigWin) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.notifyTimeout)
+	defer cancel()
+
+	if err := s.notifier.Notify(ctx, win); err != nil {
+		s.logger.Error().Err(err).
+			Str("big_win_id", win.ID.String()).
+			Str("notifier", s.notifier.Name()).
+			Msg("Failed to notify big win")
+		return
+	}
+	if err := s.repo.MarkNotified(ctx, win.ID, time.Now().UTC()); err != nil {
+		s.logger.Error().Err(err).Str("big_win_id", win.ID.String()).Msg("Failed to mark big win notified")
+	}
+}
+
+// GetBigWin retrieves a big win by ID
+func (s *BigWinService) GetBigWin(ctx context.Context, id uuid.UUID) (*bigwin.BigWin, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// ListBigWins lists big wins newest first
+func (s *BigWinService) ListBigWins(ctx context.Context, filters bigwin.ListFilters) ([]*bigwin.BigWin, int64, error) {
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.Limit < 1 || filters.Limit > 100 {
+		filters.Limit = 20
+	}
+	return s.repo.List(ctx, filters)
+}
+
+// bigWinProof assembles the PF proof for a spin; it returns nil when the spin was not recorded in the PF chain
+func bigWinProof(state *provablyfair.PFSessionState, result *provablyfair.SpinResult, clientSeed string) *bigwin.Proof {
+	if state == nil || result == nil {
+		return nil
+	}
+	return &bigwin.Proof{
+		PFSessionID:    state.SessionID,
+		ServerSeedHash: state.ServerSeedHash,
+		ClientSeed:     clientSeed,
+		SpinIndex:      result.SpinIndex,
+		Nonce:          result.Nonce,
+		SpinHash:       result.SpinHash,
+		PrevSpinHash:   result.PrevSpinHash,
+	}
+}
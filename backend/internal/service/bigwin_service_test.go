@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/bigwin"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockBigWinRepository is a mock implementation of bigwin.Repository
+type MockBigWinRepository struct {
+	mock.Mock
+}
+
+func (m *MockBigWinRepository) Create(ctx context.Context, win *bigwin.BigWin) error {
+	return m.Called(ctx, win).Error(0)
+}
+
+func (m *MockBigWinRepository) GetByID(ctx context.Context, id uuid.UUID) (*bigwin.BigWin, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*bigwin.BigWin), args.Error(1)
+}
+
+func (m *MockBigWinRepository) List(ctx context.Context, filters bigwin.ListFilters) ([]*bigwin.BigWin, int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]*bigwin.BigWin), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockBigWinRepository) MarkNotified(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return m.Called(ctx, id, at).Error(0)
+}
+
+// MockBigWinNotifier is a mock implementation of bigwin.Notifier
+type MockBigWinNotifier struct {
+	mock.Mock
+}
+
+func (m *MockBigWinNotifier) Name() string {
+	return "mock"
+}
+
+func (m *MockBigWinNotifier) Notify(ctx context.Context, win *bigwin.BigWin) error {
+	return m.Called(ctx, win).Error(0)
+}
+
+func setupBigWinService(notifier bigwin.Notifier) (*BigWinService, *MockBigWinRepository) {
+	repo := new(MockBigWinRepository)
+	cfg := &config.Config{BigWin: config.BigWinConfig{ThresholdMultiplier: 100, NotifyTimeoutSeconds: 1}}
+	return NewBigWinService(repo, notifier, cfg, logger.New("error", "json")), repo
+}
+
+func TestBigWinService_Check(t *testing.T) {
+	ctx := context.Background()
+	proof := &bigwin.Proof{PFSessionID: uuid.New(), SpinIndex: 7, SpinHash: "hash"}
+
+	t.Run("should ignore wins below the threshold", func(t *testing.T) {
+		svc, repo := setupBigWinService(nil)
+
+		win, err := svc.Check(ctx, &spin.Spin{ID: uuid.New(), BetAmount: 2, TotalWin: 199}, proof)
+		require.NoError(t, err)
+		assert.Nil(t, win)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should record and notify wins at the threshold", func(t *testing.T) {
+		notifier := new(MockBigWinNotifier)
+		svc, repo := setupBigWinService(notifier)
+		sp := &spin.Spin{ID: uuid.New(), PlayerID: uuid.New(), SessionID: uuid.New(), BetAmount: 2, TotalWin: 250, IsFreeSpin: true}
+		repo.On("Create", ctx, mock.Anything).Return(nil)
+		notifier.On("Notify", mock.Anything, mock.Anything).Return(nil)
+		repo.On("MarkNotified", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		win, err := svc.Check(ctx, sp, proof)
+		require.NoError(t, err)
+		require.NotNil(t, win)
+		svc.wg.Wait()
+
+		assert.Equal(t, sp.ID, win.SpinID)
+		assert.Equal(t, 125.0, win.Multiplier)
+		assert.True(t, win.IsFreeSpin)
+		assert.Equal(t, proof, win.Proof)
+		notifier.AssertCalled(t, "Notify", mock.Anything, win)
+		repo.AssertCalled(t, "MarkNotified", mock.Anything, win.ID, mock.Anything)
+	})
+
+	t.Run("should leave big win unmarked when notification fails", func(t *testing.T) {
+		notifier := new(MockBigWinNotifier)
+		svc, repo := setupBigWinService(notifier)
+		repo.On("Create", ctx, mock.Anything).Return(nil)
+		notifier.On("Notify", mock.Anything, mock.Anything).Return(errors.New("endpoint down"))
+
+		win, err := svc.Check(ctx, &spin.Spin{ID: uuid.New(), BetAmount: 1, TotalWin: 100}, proof)
+		require.NoError(t, err)
+		require.NotNil(t, win)
+		svc.wg.Wait()
+
+		repo.AssertNotCalled(t, "MarkNotified", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should skip zero-bet spins", func(t *testing.T) {
+		svc, repo := setupBigWinService(nil)
+
+		win, err := svc.Check(ctx, &spin.Spin{ID: uuid.New(), BetAmount: 0, TotalWin: 100}, nil)
+		require.NoError(t, err)
+		assert.Nil(t, win)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestBigWinProof(t *testing.T) {
+	state := &provablyfair.PFSessionState{SessionID: uuid.New(), ServerSeedHash: "commitment"}
+	result := &provablyfair.SpinResult{SpinIndex: 3, Nonce: 4, SpinHash: "h3", PrevSpinHash: "h2"}
+
+	proof := bigWinProof(state, result, "client")
+	require.NotNil(t, proof)
+	assert.Equal(t, state.SessionID, proof.PFSessionID)
+	assert.Equal(t, "commitment", proof.ServerSeedHash)
+	assert.Equal(t, "client", proof.ClientSeed)
+	assert.Equal(t, int64(3), proof.SpinIndex)
+	assert.Equal(t, "h2", proof.PrevSpinHash)
+
+	assert.Nil(t, bigWinProof(state, nil, "client"), "spins missing from the PF chain have no proof")
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/certification"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/game/engine"
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+const (
+	// certificationExportPage is how many events one export query reads
+	certificationExportPage = 1000
+	// certificationMaxExportRange bounds one export so a download stays a sensible size
+	certificationMaxExportRange = 31 * 24 * time.Hour
+)
+
+// CertificationService implements certification.Service over the append-only event store
+type CertificationService struct {
+	repo    certification.Repository
+	enabled bool
+	logger  *logger.Logger
+}
+
+// NewCertificationService creates a new certification log service
+func NewCertificationService(cfg *config.Config, repo certification.Repository, log *logger.Logger) certification.Service {
+	return &CertificationService{
+		repo:    repo,
+		enabled: cfg.CertLog.Enabled,
+		logger:  log,
+	}
+}
+
+// Enabled reports whether spins are being recorded
+func (s *CertificationService) Enabled() bool {
+	return s.enabled
+}
+
+// Record appends a spin's event; a no-op while logging is disabled
+func (s *CertificationService) Record(ctx context.Context, event *certification.Event) error {
+	if !s.enabled {
+		return nil
+	}
+	return s.repo.Create(ctx, event)
+}
+
+// Export streams the range's events oldest first, one JSON object per line
+func (s *CertificationService) Export(ctx context.Context, from, to time.Time, w io.Writer) (int, error) {
+	if !from.Before(to) || to.Sub(from) > certificationMaxExportRange {
+		return 0, certification.ErrInvalidRange
+	}
+
+	buf := bufio.NewWriter(w)
+	enc := json.NewEncoder(buf)
+	var (
+		afterAt time.Time
+		afterID uuid.UUID
+		written int
+	)
+	for {
+		events, err := s.repo.ListAfter(ctx, from, to, afterAt, afterID, certificationExportPage)
+		if err != nil {
+			return written, err
+		}
+		for _, event := range events {
+			if err := enc.Encode(event); err != nil {
+				return written, err
+			}
+			written++
+		}
+		if len(events) < certificationExportPage {
+			return written, buf.Flush()
+		}
+		last := events[len(events)-1]
+		afterAt, afterID = last.CreatedAt, last.ID
+	}
+}
+
+// newCertificationEvent builds the certification event of a settled spin
+func newCertificationEvent(
+	spinRecord *spin.Spin,
+	draws *rng.RecordingRNG,
+	configID *uuid.UUID,
+	stripChecksums []string,
+	multipliers []int,
+	pfResult *provablyfair.SpinResult,
+) *certification.Event {
+	event := &certification.Event{
+		ID:                 uuid.New(),
+		SpinID:             spinRecord.ID,
+		SessionID:          spinRecord.SessionID,
+		PlayerID:           spinRecord.PlayerID,
+		IsFreeSpin:         spinRecord.IsFreeSpin,
+		FreeSpinsSessionID: spinRecord.FreeSpinsSessionID,
+		GameMode:           spinRecord.GameMode,
+		BetAmount:          spinRecord.BetAmount,
+		EngineVersion:      engine.Version,
+		PaytableVersion:    symbols.PaytableVersion(),
+		RNGProvider:        spinRecord.RNGProvider,
+		RNGDraws:           draws.Draws(),
+		ReelStripConfigID:  configID,
+		StripChecksums:     stripChecksums,
+		Multipliers:        multipliers,
+		ReelPositions:      spinRecord.ReelPositions,
+		Grid:               spinRecord.Grid,
+		Cascades:           spinRecord.Cascades,
+		TotalWin:           spinRecord.TotalWin,
+		ScatterCount:       spinRecord.ScatterCount,
+		FreeSpinsTriggered: spinRecord.FreeSpinsTriggered,
+		BalanceBefore:      spinRecord.BalanceBefore,
+		BalanceAfter:       spinRecord.BalanceAfter,
+		CreatedAt:          spinRecord.CreatedAt,
+	}
+	if pfResult != nil {
+		event.Nonce = pfResult.Nonce
+		event.SpinHash = pfResult.SpinHash
+	}
+	return event
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/certification"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockCertificationRepository is a mock implementation of certification.Repository
+type MockCertificationRepository struct {
+	mock.Mock
+}
+
+func (m *MockCertificationRepository) Create(ctx context.Context, event *certification.Event) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockCertificationRepository) ListAfter(ctx context.Context, from, to, afterCreatedAt time.Time, afterID uuid.UUID, limit int) ([]*certification.Event, error) {
+	args := m.Called(ctx, from, to, afterCreatedAt, afterID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*certification.Event), args.Error(1)
+}
+
+func newTestCertificationService(enabled bool) (certification.Service, *MockCertificationRepository) {
+	repo := new(MockCertificationRepository)
+	cfg := &config.Config{CertLog: config.CertLogConfig{Enabled: enabled}}
+	return NewCertificationService(cfg, repo, logger.New("error", "json")), repo
+}
+
+func TestCertificationService_Record(t *testing.T) {
+	ctx := context.Background()
+	event := &certification.Event{ID: uuid.New()}
+
+	t.Run("should append while enabled", func(t *testing.T) {
+		svc, repo := newTestCertificationService(true)
+		repo.On("Create", ctx, event).Return(nil)
+
+		assert.True(t, svc.Enabled())
+		require.NoError(t, svc.Record(ctx, event))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("should do nothing while disabled", func(t *testing.T) {
+		svc, repo := newTestCertificationService(false)
+
+		assert.False(t, svc.Enabled())
+		require.NoError(t, svc.Record(ctx, event))
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCertificationService_Export(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	t.Run("should page through the range as NDJSON", func(t *testing.T) {
+		svc, repo := newTestCertificationService(false)
+		firstPage := make([]*certification.Event, certificationExportPage)
+		for i := range firstPage {
+			firstPage[i] = &certification.Event{ID: uuid.New(), CreatedAt: from.Add(time.Duration(i) * time.Second)}
+		}
+		last := firstPage[len(firstPage)-1]
+		tail := &certification.Event{ID: uuid.New(), CreatedAt: last.CreatedAt.Add(time.Second)}
+		repo.On("ListAfter", ctx, from, to, time.Time{}, uuid.Nil, certificationExportPage).Return(firstPage, nil)
+		repo.On("ListAfter", ctx, from, to, last.CreatedAt, last.ID, certificationExportPage).Return([]*certification.Event{tail}, nil)
+
+		var buf bytes.Buffer
+		count, err := svc.Export(ctx, from, to, &buf)
+		require.NoError(t, err)
+		assert.Equal(t, certificationExportPage+1, count)
+
+		var lines []certification.Event
+		scanner := bufio.NewScanner(&buf)
+		for scanner.Scan() {
+			var event certification.Event
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+			lines = append(lines, event)
+		}
+		require.Len(t, lines, certificationExportPage+1)
+		assert.Equal(t, firstPage[0].ID, lines[0].ID)
+		assert.Equal(t, tail.ID, lines[len(lines)-1].ID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("should reject empty, inverted and oversized ranges", func(t *testing.T) {
+		svc, repo := newTestCertificationService(false)
+		for _, r := range [][2]time.Time{{from, from}, {to, from}, {from, from.AddDate(0, 0, 32)}} {
+			_, err := svc.Export(ctx, r[0], r[1], &bytes.Buffer{})
+			assert.ErrorIs(t, err, certification.ErrInvalidRange)
+		}
+		repo.AssertNotCalled(t, "ListAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/slotmachine/backend/domain/dashboard"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// DashboardService implements dashboard.Service
+// Spins and spin requests are counted as they happen, so reading the metrics costs a few
+// counter reads instead of queries over the spins table.
+type DashboardService struct {
+	store        dashboard.Store
+	window       time.Duration // Rates cover this many whole minutes
+	activeWindow time.Duration // A session is active while it spun within this long
+	logger       *logger.Logger
+	now          func() time.Time
+}
+
+// NewDashboardService creates a new dashboard service
+func NewDashboardService(store dashboard.Store, cfg *config.Config, log *logger.Logger) *DashboardService {
+	return &DashboardService{
+		store:        store,
+		window:       time.Duration(cfg.Dashboard.RateWindowMinutes) * time.Minute,
+		activeWindow: time.Duration(cfg.Dashboard.ActiveSessionMinutes) * time.Minute,
+		logger:       log,
+		now:          time.Now,
+	}
+}
+
+// Ensure DashboardService implements dashboard.Service
+var _ dashboard.Service = (*DashboardService)(nil)
+
+// RecordSpin counts a settled spin; a failure to count it is logged and otherwise ignored
+func (s *DashboardService) RecordSpin(ctx context.Context, sp *spin.Spin, username string) {
+	sample := dashboard.NewSample(sp, username)
+	if sample.At.IsZero() {
+		sample.At = s.now()
+		sample.BigWin.CreatedAt = sample.At
+	}
+	if err := s.store.RecordSpin(ctx, sample, s.activeWindow); err != nil {
+		s.logger.WithTraceContext(ctx).Warn().Err(err).Str("spin_id", sp.ID.String()).Msg("Failed to count spin on the dashboard")
+	}
+}
+
+// RecordSpinRequest counts a spin request; a failure to count it is logged and otherwise ignored
+func (s *DashboardService) RecordSpinRequest(ctx context.Context, failed bool) {
+	if err := s.store.RecordRequest(ctx, s.now(), failed); err != nil {
+		s.logger.WithTraceContext(ctx).Warn().Err(err).Msg("Failed to count spin request on the dashboard")
+	}
+}
+
+// Metrics computes the live metrics from the counters
+func (s *DashboardService) Metrics(ctx context.Context) (*dashboard.Metrics, error) {
+	now := s.now().UTC()
+	counters, err := s.store.Counters(ctx, now, s.window, s.activeWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	windowMinutes := int(s.window / time.Minute)
+	metrics := &dashboard.Metrics{
+		ActiveSessions:  counters.ActiveSessions,
+		SpinsPerMinute:  roundCents(float64(counters.WindowSpins) / float64(windowMinutes)),
+		SpinsToday:      counters.DaySpins,
+		WageredToday:    roundCents(counters.DayWagered),
+		WonToday:        roundCents(counters.DayWon),
+		BiggestWinToday: counters.BiggestWin,
+		SpinRequests:    counters.WindowRequests,
+		SpinErrors:      counters.WindowErrors,
+		WindowMinutes:   windowMinutes,
+		ComputedAt:      now,
+	}
+	if counters.DayWagered > 0 {
+		rtp := roundCents(counters.DayWon / counters.DayWagered * 100)
+		metrics.RealizedRTPToday = &rtp
+	}
+	if counters.WindowRequests > 0 {
+		metrics.ErrorRate = roundCents(float64(counters.WindowErrors) / float64(counters.WindowRequests) * 100)
+	}
+	return metrics, nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/config"
+	infraCache "github.com/slotmachine/backend/internal/infra/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupDashboardService uses the in-memory fallback of the dashboard store
+func setupDashboardService(now time.Time) *DashboardService {
+	log := logger.New("error", "json")
+	cfg := &config.Config{Dashboard: config.DashboardConfig{RateWindowMinutes: 5, ActiveSessionMinutes: 5}}
+	svc := NewDashboardService(infraCache.NewDashboardStore(nil, log), cfg, log)
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+func TestDashboardService_Metrics(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 12, 30, 15, 0, time.UTC)
+
+	t.Run("should compute rates, realized RTP and the biggest win", func(t *testing.T) {
+		svc := setupDashboardService(now)
+		sessionA, sessionB := uuid.New(), uuid.New()
+		buyCost := 50.0
+
+		spins := []*spin.Spin{
+			{ID: uuid.New(), SessionID: sessionA, BetAmount: 1, TotalWin: 0, CreatedAt: now.Add(-2 * time.Minute)},
+			{ID: uuid.New(), SessionID: sessionA, BetAmount: 1, TotalWin: 30, IsFreeSpin: true, CreatedAt: now.Add(-90 * time.Second)},
+			{ID: uuid.New(), SessionID: sessionB, BetAmount: 1, GameModeCost: &buyCost, TotalWin: 20, CreatedAt: now.Add(-time.Minute)},
+			{ID: uuid.New(), SessionID: uuid.New(), BetAmount: 9, TotalWin: 0, CreatedAt: now.Add(-2 * time.Hour)},
+		}
+		for _, sp := range spins {
+			svc.RecordSpin(ctx, sp, "highroller")
+		}
+		for i := 0; i < 4; i++ {
+			svc.now = func() time.Time { return now.Add(-time.Minute) }
+			svc.RecordSpinRequest(ctx, i == 0)
+		}
+		svc.now = func() time.Time { return now }
+
+		metrics, err := svc.Metrics(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(2), metrics.ActiveSessions, "the session idle for two hours is not active")
+		assert.Equal(t, 0.6, metrics.SpinsPerMinute, "three spins over five minutes")
+		assert.Equal(t, int64(4), metrics.SpinsToday)
+		assert.Equal(t, 60.0, metrics.WageredToday, "free spins stake nothing and bought modes stake their cost")
+		assert.Equal(t, 50.0, metrics.WonToday)
+		require.NotNil(t, metrics.RealizedRTPToday)
+		assert.Equal(t, 83.33, *metrics.RealizedRTPToday)
+		require.NotNil(t, metrics.BiggestWinToday)
+		assert.Equal(t, spins[1].ID, metrics.BiggestWinToday.SpinID)
+		assert.Equal(t, "h********r", metrics.BiggestWinToday.Player)
+		assert.Equal(t, int64(4), metrics.SpinRequests)
+		assert.Equal(t, int64(1), metrics.SpinErrors)
+		assert.Equal(t, 25.0, metrics.ErrorRate)
+		assert.Equal(t, 5, metrics.WindowMinutes)
+	})
+
+	t.Run("should leave realized RTP unset before anything was wagered", func(t *testing.T) {
+		svc := setupDashboardService(now)
+
+		metrics, err := svc.Metrics(ctx)
+		require.NoError(t, err)
+
+		assert.Nil(t, metrics.RealizedRTPToday)
+		assert.Nil(t, metrics.BiggestWinToday)
+		assert.Zero(t, metrics.SpinsPerMinute)
+		assert.Zero(t, metrics.ErrorRate)
+	})
+}
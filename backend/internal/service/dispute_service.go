@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/dispute"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+const (
+	defaultDisputeLimit = 20
+	maxDisputeLimit     = 100
+)
+
+// DisputeService implements dispute.Service
+type DisputeService struct {
+	repo      dispute.Repository
+	spinRepo  spin.Repository
+	pfService provablyfair.Service
+	logger    *logger.Logger
+	now       func() time.Time
+}
+
+// NewDisputeService creates a new dispute service
+func NewDisputeService(
+	repo dispute.Repository,
+	spinRepo spin.Repository,
+	pfService provablyfair.Service,
+	log *logger.Logger,
+) *DisputeService {
+	return &DisputeService{
+		repo:      repo,
+		spinRepo:  spinRepo,
+		pfService: pfService,
+		logger:    log,
+		now:       time.Now,
+	}
+}
+
+// Open opens a dispute for a spin; a spin has at most one dispute that is not closed
+func (s *DisputeService) Open(ctx context.Context, spinID uuid.UUID, reason, actor string) (*dispute.Dispute, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, dispute.ErrReasonRequired
+	}
+
+	spinRecord, err := s.spinRepo.GetByID(ctx, spinID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetOpenBySpin(ctx, spinID); err == nil {
+		return nil, dispute.ErrDisputeExists
+	} else if !errors.Is(err, dispute.ErrDisputeNotFound) {
+		return nil, err
+	}
+
+	now := s.now()
+	d := &dispute.Dispute{
+		ID:        uuid.New(),
+		SpinID:    spinID,
+		PlayerID:  spinRecord.PlayerID,
+		Status:    dispute.StatusOpen,
+		Reason:    reason,
+		OpenedBy:  actor,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.Create(ctx, d); err != nil {
+		return nil, err
+	}
+	if err := s.addEvent(ctx, d.ID, dispute.EventOpened, actor, reason); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Str("dispute_id", d.ID.String()).
+		Str("spin_id", spinID.String()).
+		Str("opened_by", actor).
+		Msg("Dispute opened")
+	return d, nil
+}
+
+// Get returns a dispute with its history
+func (s *DisputeService) Get(ctx context.Context, id uuid.UUID) (*dispute.Dispute, []*dispute.Event, error) {
+	d, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	events, err := s.repo.ListEvents(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if events == nil {
+		events = []*dispute.Event{}
+	}
+	return d, events, nil
+}
+
+// List lists disputes newest first
+func (s *DisputeService) List(ctx context.Context, filters dispute.ListFilters) ([]*dispute.Dispute, int64, error) {
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.Limit <= 0 {
+		filters.Limit = defaultDisputeLimit
+	}
+	if filters.Limit > maxDisputeLimit {
+		filters.Limit = maxDisputeLimit
+	}
+	return s.repo.List(ctx, filters)
+}
+
+// Assign hands a dispute to an admin; an open dispute moves to investigating
+func (s *DisputeService) Assign(ctx context.Context, id, adminID uuid.UUID, actor string) (*dispute.Dispute, error) {
+	d, err := s.getActive(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	d.AssignedTo = &adminID
+	wasOpen := d.Status == dispute.StatusOpen
+	if wasOpen {
+		d.Status = dispute.StatusInvestigating
+	}
+	d.UpdatedAt = s.now()
+	if err := s.repo.Update(ctx, d); err != nil {
+		return nil, err
+	}
+
+	if err := s.addEvent(ctx, id, dispute.EventAssigned, actor, "assigned to "+adminID.String()); err != nil {
+		return nil, err
+	}
+	if wasOpen {
+		if err := s.addEvent(ctx, id, dispute.EventStatusChanged, actor, statusChange(dispute.StatusOpen, d.Status)); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// Verify replays the disputed spin with provably fair verification and attaches the output
+// Spins played outside a provably fair session cannot be verified.
+func (s *DisputeService) Verify(ctx context.Context, id uuid.UUID, actor string) (*dispute.Dispute, error) {
+	d, err := s.getActive(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.pfService.VerifyRecordedSpin(ctx, d.SpinID)
+	if err != nil {
+		return nil, err
+	}
+
+	verification := dispute.Verification(*result)
+	d.Verification = &verification
+	d.UpdatedAt = s.now()
+	if err := s.repo.Update(ctx, d); err != nil {
+		return nil, err
+	}
+
+	outcome := "spin verified"
+	if !result.Valid {
+		outcome = "spin failed verification"
+	}
+	if err := s.addEvent(ctx, id, dispute.EventVerified, actor, outcome); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Str("dispute_id", id.String()).
+		Str("spin_id", d.SpinID.String()).
+		Bool("valid", result.Valid).
+		Msg("Disputed spin verified")
+	return d, nil
+}
+
+// AddNote records a support note in the dispute's history
+func (s *DisputeService) AddNote(ctx context.Context, id uuid.UUID, note, actor string) (*dispute.Event, error) {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return nil, dispute.ErrNoteRequired
+	}
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	event := &dispute.Event{
+		ID:        uuid.New(),
+		DisputeID: id,
+		Kind:      dispute.EventNote,
+		Actor:     actor,
+		Message:   note,
+		CreatedAt: s.now(),
+	}
+	if err := s.repo.AddEvent(ctx, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// Close resolves or rejects a dispute with resolution notes
+func (s *DisputeService) Close(ctx context.Context, id uuid.UUID, status dispute.Status, notes, actor string) (*dispute.Dispute, error) {
+	if !status.Closed() {
+		return nil, dispute.ErrInvalidTransition
+	}
+	notes = strings.TrimSpace(notes)
+	if notes == "" {
+		return nil, dispute.ErrResolutionNotesRequired
+	}
+	d, err := s.getActive(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	from := d.Status
+	now := s.now()
+	d.Status = status
+	d.ResolutionNotes = notes
+	d.ResolvedBy = actor
+	d.ResolvedAt = &now
+	d.UpdatedAt = now
+	if err := s.repo.Update(ctx, d); err != nil {
+		return nil, err
+	}
+	if err := s.addEvent(ctx, id, dispute.EventStatusChanged, actor, statusChange(from, status)+": "+notes); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Str("dispute_id", id.String()).
+		Str("status", string(status)).
+		Str("resolved_by", actor).
+		Msg("Dispute closed")
+	return d, nil
+}
+
+// getActive loads a dispute that may still be changed
+func (s *DisputeService) getActive(ctx context.Context, id uuid.UUID) (*dispute.Dispute, error) {
+	d, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if d.Status.Closed() {
+		return nil, dispute.ErrDisputeClosed
+	}
+	return d, nil
+}
+
+func (s *DisputeService) addEvent(ctx context.Context, disputeID uuid.UUID, kind dispute.EventKind, actor, message string) error {
+	return s.repo.AddEvent(ctx, &dispute.Event{
+		ID:        uuid.New(),
+		DisputeID: disputeID,
+		Kind:      kind,
+		Actor:     actor,
+		Message:   message,
+		CreatedAt: s.now(),
+	})
+}
+
+func statusChange(from, to dispute.Status) string {
+	return string(from) + " -> " + string(to)
+}
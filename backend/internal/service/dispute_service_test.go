@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/dispute"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/pkg/crypto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryDisputeRepo stores disputes and their history in memory
+type memoryDisputeRepo struct {
+	disputes map[uuid.UUID]dispute.Dispute
+	events   []*dispute.Event
+}
+
+func newMemoryDisputeRepo() *memoryDisputeRepo {
+	return &memoryDisputeRepo{disputes: map[uuid.UUID]dispute.Dispute{}}
+}
+
+func (r *memoryDisputeRepo) Create(ctx context.Context, d *dispute.Dispute) error {
+	r.disputes[d.ID] = *d
+	return nil
+}
+
+func (r *memoryDisputeRepo) GetByID(ctx context.Context, id uuid.UUID) (*dispute.Dispute, error) {
+	d, ok := r.disputes[id]
+	if !ok {
+		return nil, dispute.ErrDisputeNotFound
+	}
+	return &d, nil
+}
+
+func (r *memoryDisputeRepo) GetOpenBySpin(ctx context.Context, spinID uuid.UUID) (*dispute.Dispute, error) {
+	for _, d := range r.disputes {
+		if d.SpinID == spinID && !d.Status.Closed() {
+			return &d, nil
+		}
+	}
+	return nil, dispute.ErrDisputeNotFound
+}
+
+func (r *memoryDisputeRepo) Update(ctx context.Context, d *dispute.Dispute) error {
+	if _, ok := r.disputes[d.ID]; !ok {
+		return dispute.ErrDisputeNotFound
+	}
+	r.disputes[d.ID] = *d
+	return nil
+}
+
+func (r *memoryDisputeRepo) List(ctx context.Context, filters dispute.ListFilters) ([]*dispute.Dispute, int64, error) {
+	var out []*dispute.Dispute
+	for _, d := range r.disputes {
+		if filters.Status == "" || d.Status == filters.Status {
+			out = append(out, &d)
+		}
+	}
+	return out, int64(len(out)), nil
+}
+
+func (r *memoryDisputeRepo) AddEvent(ctx context.Context, event *dispute.Event) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *memoryDisputeRepo) ListEvents(ctx context.Context, disputeID uuid.UUID) ([]*dispute.Event, error) {
+	var out []*dispute.Event
+	for _, e := range r.events {
+		if e.DisputeID == disputeID {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func TestDisputeService_Workflow(t *testing.T) {
+	ctx := context.Background()
+	log := logger.New("error", "json")
+
+	enc, err := crypto.NewAESEncryptor("provablyfair-dev-key-32bytes!!!!")
+	require.NoError(t, err)
+	pfRepo := &memoryChainAuditRepo{reveals: map[uuid.UUID]*provablyfair.SessionAudit{}, logs: map[uuid.UUID][]provablyfair.SpinLog{}}
+	pfSession := pfRepo.addSession(t, enc, "theta-commitment", 3, time.Now().UTC())
+	pfService := &ProvablyFairService{repo: pfRepo, hashGenerator: rng.NewHashChainGenerator(), encryptor: enc, logger: log}
+
+	disputedLog := pfRepo.logs[pfSession.ID][1]
+	playerID := uuid.New()
+	spinRepo := new(MockSpinRepository)
+	spinRepo.On("GetByID", mock.Anything, disputedLog.SpinID).Return(&spin.Spin{ID: disputedLog.SpinID, PlayerID: playerID}, nil)
+
+	repo := newMemoryDisputeRepo()
+	svc := NewDisputeService(repo, spinRepo, pfService, log)
+	adminID := uuid.New()
+
+	d, err := svc.Open(ctx, disputedLog.SpinID, "  Win was not paid  ", "support")
+	require.NoError(t, err)
+	assert.Equal(t, dispute.StatusOpen, d.Status)
+	assert.Equal(t, playerID, d.PlayerID)
+	assert.Equal(t, "Win was not paid", d.Reason)
+
+	t.Run("should reject a second dispute while the first is open", func(t *testing.T) {
+		_, err := svc.Open(ctx, disputedLog.SpinID, "again", "support")
+		assert.ErrorIs(t, err, dispute.ErrDisputeExists)
+	})
+
+	t.Run("should move to investigating when assigned", func(t *testing.T) {
+		d, err := svc.Assign(ctx, d.ID, adminID, "lead")
+		require.NoError(t, err)
+		assert.Equal(t, dispute.StatusInvestigating, d.Status)
+		assert.Equal(t, &adminID, d.AssignedTo)
+	})
+
+	t.Run("should attach a passing verification", func(t *testing.T) {
+		d, err := svc.Verify(ctx, d.ID, "support")
+		require.NoError(t, err)
+		require.NotNil(t, d.Verification)
+		assert.True(t, d.Verification.Valid)
+		assert.True(t, d.Verification.ChainLinkValid)
+		assert.Equal(t, int64(2), d.Verification.SpinIndex)
+		assert.NotEmpty(t, d.Verification.ServerSeed, "the seed of an ended session is revealed")
+	})
+
+	t.Run("should require resolution notes and a closing status", func(t *testing.T) {
+		_, err := svc.Close(ctx, d.ID, dispute.StatusRejected, " ", "lead")
+		assert.ErrorIs(t, err, dispute.ErrResolutionNotesRequired)
+		_, err = svc.Close(ctx, d.ID, dispute.StatusInvestigating, "notes", "lead")
+		assert.ErrorIs(t, err, dispute.ErrInvalidTransition)
+	})
+
+	t.Run("should close and record the full history", func(t *testing.T) {
+		_, err := svc.AddNote(ctx, d.ID, "Player shown the verification", "support")
+		require.NoError(t, err)
+
+		closed, err := svc.Close(ctx, d.ID, dispute.StatusRejected, "Spin verified correct", "lead")
+		require.NoError(t, err)
+		assert.Equal(t, dispute.StatusRejected, closed.Status)
+		assert.Equal(t, "lead", closed.ResolvedBy)
+		assert.NotNil(t, closed.ResolvedAt)
+
+		_, events, err := svc.Get(ctx, d.ID)
+		require.NoError(t, err)
+		kinds := make([]dispute.EventKind, len(events))
+		for i, e := range events {
+			kinds[i] = e.Kind
+		}
+		assert.Equal(t, []dispute.EventKind{
+			dispute.EventOpened,
+			dispute.EventAssigned,
+			dispute.EventStatusChanged,
+			dispute.EventVerified,
+			dispute.EventNote,
+			dispute.EventStatusChanged,
+		}, kinds)
+
+		_, err = svc.Assign(ctx, d.ID, adminID, "lead")
+		assert.ErrorIs(t, err, dispute.ErrDisputeClosed)
+	})
+
+	t.Run("should allow a new dispute once the previous one is closed", func(t *testing.T) {
+		_, err := svc.Open(ctx, disputedLog.SpinID, "Still unhappy", "support")
+		assert.NoError(t, err)
+	})
+}
+
+func TestProvablyFairService_VerifyRecordedSpin(t *testing.T) {
+	ctx := context.Background()
+
+	enc, err := crypto.NewAESEncryptor("provablyfair-dev-key-32bytes!!!!")
+	require.NoError(t, err)
+	repo := &memoryChainAuditRepo{reveals: map[uuid.UUID]*provablyfair.SessionAudit{}, logs: map[uuid.UUID][]provablyfair.SpinLog{}}
+	svc := &ProvablyFairService{repo: repo, hashGenerator: rng.NewHashChainGenerator(), encryptor: enc, logger: logger.New("error", "json")}
+
+	t.Run("should withhold the server seed of an active session", func(t *testing.T) {
+		session := repo.addSession(t, enc, "", 2, time.Now().UTC())
+		session.Status = provablyfair.SessionStatusActive
+
+		result, err := svc.VerifyRecordedSpin(ctx, repo.logs[session.ID][0].SpinID)
+		require.NoError(t, err)
+		assert.True(t, result.Valid)
+		assert.False(t, result.SessionEnded)
+		assert.Empty(t, result.ServerSeed)
+	})
+
+	t.Run("should flag a spin that does not extend the chain", func(t *testing.T) {
+		session := repo.addSession(t, enc, "", 3, time.Now().UTC())
+		repo.logs[session.ID][1].SpinHash = "corrupted"
+
+		result, err := svc.VerifyRecordedSpin(ctx, repo.logs[session.ID][2].SpinID)
+		require.NoError(t, err)
+		assert.False(t, result.Valid)
+		assert.False(t, result.ChainLinkValid)
+		assert.True(t, result.SpinHashValid)
+	})
+
+	t.Run("should return not found for a spin outside provably fair play", func(t *testing.T) {
+		_, err := svc.VerifyRecordedSpin(ctx, uuid.New())
+		assert.ErrorIs(t, err, provablyfair.ErrSpinNotFound)
+	})
+}
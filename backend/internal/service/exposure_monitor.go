@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/domain/exposure"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// ExposureMonitor periodically measures open liability, tripping or resetting the bonus buy breaker
+type ExposureMonitor struct {
+	exposures exposure.Service
+	interval  time.Duration
+	logger    *logger.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewExposureMonitor creates a new exposure monitor
+func NewExposureMonitor(cfg *config.Config, exposures exposure.Service, log *logger.Logger) *ExposureMonitor {
+	return &ExposureMonitor{
+		exposures: exposures,
+		interval:  time.Duration(cfg.Exposure.MonitorIntervalSeconds) * time.Second,
+		logger:    log,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start measures exposure once, then keeps measuring in the background; a zero interval disables it
+func (w *ExposureMonitor) Start() {
+	if w.interval <= 0 {
+		close(w.done)
+		w.logger.Info().Msg("Exposure monitor disabled")
+		return
+	}
+
+	go w.run()
+	w.logger.Info().Dur("interval", w.interval).Msg("Exposure monitor started")
+}
+
+// Stop stops the monitor and waits for a running pass to finish
+func (w *ExposureMonitor) Stop() {
+	w.stopOnce.Do(func() { close(w.stop) })
+	<-w.done
+}
+
+func (w *ExposureMonitor) run() {
+	defer close(w.done)
+
+	// Measure right away so the breakers hold from startup rather than from the first tick
+	w.Run(context.Background())
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			w.Run(context.Background())
+		}
+	}
+}
+
+// Run measures open liability once
+func (w *ExposureMonitor) Run(ctx context.Context) {
+	if _, err := w.exposures.Refresh(ctx); err != nil {
+		w.logger.Error().Err(err).Msg("Failed to measure open liability")
+	}
+}
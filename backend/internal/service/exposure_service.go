@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/domain/exposure"
+	"github.com/slotmachine/backend/internal/config"
+	freespinsEngine "github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/wins"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// ExposureService implements exposure.Service
+// It keeps the last measured exposure in memory, so the bonus buy gate costs no query per spin;
+// each instance measures on its own monitor pass and the breakers follow the latest measurement.
+type ExposureService struct {
+	repo     exposure.Repository
+	limits   exposure.Limits
+	lookback time.Duration // How far back pending free spins triggers are still resolved
+	logger   *logger.Logger
+	now      func() time.Time
+
+	mu   sync.RWMutex
+	last *exposure.Exposure
+}
+
+// NewExposureService creates a new exposure service
+func NewExposureService(cfg *config.Config, repo exposure.Repository, log *logger.Logger) *ExposureService {
+	return &ExposureService{
+		repo: repo,
+		limits: exposure.Limits{
+			MaxLiability:               cfg.Exposure.MaxLiability,
+			MaxActiveFreeSpinsSessions: int64(cfg.Exposure.MaxActiveFreeSpinsSessions),
+		},
+		lookback: time.Duration(cfg.Game.ReconcileLookbackHours) * time.Hour,
+		logger:   log,
+		now:      time.Now,
+	}
+}
+
+// CheckBonusBuy rejects bonus buys while the last measured exposure exceeds its limits
+// Before the first measurement bonus buys are allowed.
+func (s *ExposureService) CheckBonusBuy(ctx context.Context) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.last != nil && s.last.BonusBuysPaused {
+		return exposure.ErrBonusBuysPaused
+	}
+	return nil
+}
+
+// Refresh measures the open liability and trips or resets the bonus buy breaker
+func (s *ExposureService) Refresh(ctx context.Context) (*exposure.Exposure, error) {
+	now := s.now().UTC()
+
+	open, err := s.repo.OpenFreeSpins(ctx)
+	if err != nil {
+		return nil, err
+	}
+	triggers, err := s.repo.PendingTriggers(ctx, now.Add(-s.lookback))
+	if err != nil {
+		return nil, err
+	}
+
+	exp := &exposure.Exposure{
+		ActiveFreeSpinsSessions: open.Sessions,
+		RemainingFreeSpins:      open.RemainingSpins,
+		RemainingFreeSpinsStake: open.RemainingStake,
+		MaxWinMultiplier:        wins.MaxWinMultiplier,
+		Limits:                  s.limits,
+		ComputedAt:              now,
+	}
+	// Pending triggers get their base award; segment and VIP bonus spins are only known once awarded
+	for _, t := range triggers {
+		spins := int64(freespinsEngine.CalculateFreeSpinsAward(t.ScatterCount))
+		exp.PendingTriggers += t.Triggers
+		exp.PendingTriggerSpins += spins * t.Triggers
+		exp.PendingTriggerStake += float64(spins) * t.Stake
+	}
+	exp.MaxLiability = (exp.RemainingFreeSpinsStake + exp.PendingTriggerStake) * float64(exp.MaxWinMultiplier)
+
+	if reason := exp.Breach(s.limits); reason != "" {
+		exp.BonusBuysPaused, exp.PausedReason = true, reason
+	}
+
+	s.mu.Lock()
+	wasPaused := s.last != nil && s.last.BonusBuysPaused
+	s.last = exp
+	s.mu.Unlock()
+
+	switch {
+	case exp.BonusBuysPaused && !wasPaused:
+		s.logger.Error().
+			Str("reason", exp.PausedReason).
+			Float64("max_liability", exp.MaxLiability).
+			Int64("active_free_spins_sessions", exp.ActiveFreeSpinsSessions).
+			Msg("Bonus buys paused: open liability exceeds its limits")
+	case !exp.BonusBuysPaused && wasPaused:
+		s.logger.Info().
+			Float64("max_liability", exp.MaxLiability).
+			Int64("active_free_spins_sessions", exp.ActiveFreeSpinsSessions).
+			Msg("Bonus buys resumed: open liability is back within its limits")
+	}
+
+	copied := *exp
+	return &copied, nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/exposure"
+	"github.com/slotmachine/backend/internal/config"
+	freespinsEngine "github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/wins"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubExposureRepository returns canned open free spins and pending triggers
+type stubExposureRepository struct {
+	open     exposure.FreeSpinsTotals
+	triggers []exposure.TriggerTotals
+	since    time.Time
+}
+
+func (r *stubExposureRepository) OpenFreeSpins(ctx context.Context) (*exposure.FreeSpinsTotals, error) {
+	open := r.open
+	return &open, nil
+}
+
+func (r *stubExposureRepository) PendingTriggers(ctx context.Context, since time.Time) ([]exposure.TriggerTotals, error) {
+	r.since = since
+	return r.triggers, nil
+}
+
+func newTestExposureService(repo exposure.Repository, maxLiability float64, maxSessions int) *ExposureService {
+	cfg := &config.Config{
+		Game:     config.GameConfig{ReconcileLookbackHours: 24},
+		Exposure: config.ExposureConfig{MaxLiability: maxLiability, MaxActiveFreeSpinsSessions: maxSessions},
+	}
+	return NewExposureService(cfg, repo, logger.New("error", "json"))
+}
+
+func TestExposureService_Refresh(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should measure open and pending free spins at the max win cap", func(t *testing.T) {
+		repo := &stubExposureRepository{
+			open:     exposure.FreeSpinsTotals{Sessions: 2, RemainingSpins: 14, RemainingStake: 20},
+			triggers: []exposure.TriggerTotals{{ScatterCount: 3, Triggers: 2, Stake: 3}, {ScatterCount: 4, Triggers: 1, Stake: 1}},
+		}
+		svc := newTestExposureService(repo, 0, 0)
+		now := time.Now().UTC()
+		svc.now = func() time.Time { return now }
+
+		exp, err := svc.Refresh(ctx)
+		require.NoError(t, err)
+
+		award3, award4 := freespinsEngine.CalculateFreeSpinsAward(3), freespinsEngine.CalculateFreeSpinsAward(4)
+		pendingStake := float64(award3)*3 + float64(award4)*1
+		assert.Equal(t, int64(2), exp.ActiveFreeSpinsSessions)
+		assert.Equal(t, int64(3), exp.PendingTriggers)
+		assert.Equal(t, int64(award3*2+award4), exp.PendingTriggerSpins)
+		assert.InDelta(t, pendingStake, exp.PendingTriggerStake, 0.001)
+		assert.InDelta(t, (20+pendingStake)*wins.MaxWinMultiplier, exp.MaxLiability, 0.001)
+		assert.Equal(t, now.Add(-24*time.Hour), repo.since)
+		assert.False(t, exp.BonusBuysPaused)
+	})
+
+	t.Run("should pause bonus buys while a limit is exceeded", func(t *testing.T) {
+		repo := &stubExposureRepository{open: exposure.FreeSpinsTotals{Sessions: 3, RemainingSpins: 30, RemainingStake: 30}}
+		svc := newTestExposureService(repo, 0, 2)
+
+		// Bonus buys are allowed before the first measurement
+		require.NoError(t, svc.CheckBonusBuy(ctx))
+
+		exp, err := svc.Refresh(ctx)
+		require.NoError(t, err)
+		assert.True(t, exp.BonusBuysPaused)
+		assert.Contains(t, exp.PausedReason, "3 active free spins sessions")
+		assert.ErrorIs(t, svc.CheckBonusBuy(ctx), exposure.ErrBonusBuysPaused)
+
+		repo.open = exposure.FreeSpinsTotals{Sessions: 2, RemainingSpins: 20, RemainingStake: 20}
+		exp, err = svc.Refresh(ctx)
+		require.NoError(t, err)
+		assert.False(t, exp.BonusBuysPaused)
+		assert.NoError(t, svc.CheckBonusBuy(ctx))
+	})
+
+	t.Run("should pause bonus buys over the liability limit", func(t *testing.T) {
+		repo := &stubExposureRepository{open: exposure.FreeSpinsTotals{Sessions: 1, RemainingSpins: 10, RemainingStake: 10}}
+		svc := newTestExposureService(repo, 10*wins.MaxWinMultiplier-1, 0)
+
+		exp, err := svc.Refresh(ctx)
+		require.NoError(t, err)
+		assert.True(t, exp.BonusBuysPaused)
+		assert.Contains(t, exp.PausedReason, "max liability")
+	})
+
+	t.Run("should reject bonus buys while paused", func(t *testing.T) {
+		repo := &stubExposureRepository{open: exposure.FreeSpinsTotals{Sessions: 3}}
+		gate := newTestExposureService(repo, 0, 2)
+		_, err := gate.Refresh(ctx)
+		require.NoError(t, err)
+
+		service, _, mockPlayerRepo, _, _ := setupSpinServiceForValidation()
+		service.exposure = gate
+
+		_, err = service.ExecuteSpin(ctx, uuid.New(), uuid.New(), 1, "bonus_spin_trigger", "", "")
+		assert.ErrorIs(t, err, exposure.ErrBonusBuysPaused)
+		mockPlayerRepo.AssertNotCalled(t, "GetByID")
+	})
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/financialreport"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// financialReportDayLayout formats report days
+const financialReportDayLayout = "2006-01-02"
+
+// financialReportCSVHeader is the header row of financial report CSV downloads
+var financialReportCSVHeader = []string{
+	"report_date", "game_id", "operator_id", "spins", "paid_spins", "total_wagered", "total_won",
+	"ggr", "free_spin_cost", "bonus_cost", "jackpot_contributions", "generated_at",
+}
+
+// FinancialReportService implements financialreport.Service
+type FinancialReportService struct {
+	repo     financialreport.Repository
+	lookback int // Ended days GenerateDue looks back over for days not reported yet
+	maxDays  int // Longest range one listing or download may cover
+	logger   *logger.Logger
+	now      func() time.Time
+}
+
+// NewFinancialReportService creates a new financial report service
+func NewFinancialReportService(cfg *config.Config, repo financialreport.Repository, clk clock.Clock, log *logger.Logger) *FinancialReportService {
+	return &FinancialReportService{
+		repo:     repo,
+		lookback: cfg.FinancialReport.LookbackDays,
+		maxDays:  cfg.FinancialReport.MaxRangeDays,
+		logger:   log,
+		now:      clk.Now,
+	}
+}
+
+// Ensure FinancialReportService implements financialreport.Service
+var _ financialreport.Service = (*FinancialReportService)(nil)
+
+// Generate sums one ended UTC day by game and operator and replaces its report rows
+func (s *FinancialReportService) Generate(ctx context.Context, day time.Time) ([]*financialreport.DailySummary, error) {
+	day = reportDay(day)
+	now := s.now().UTC()
+	end := day.AddDate(0, 0, 1)
+	if end.After(now) {
+		return nil, financialreport.ErrDayNotOver
+	}
+
+	spins, err := s.repo.SpinTotals(ctx, day, end)
+	if err != nil {
+		return nil, err
+	}
+	bonuses, err := s.repo.BonusTotals(ctx, day, end)
+	if err != nil {
+		return nil, err
+	}
+
+	// Spins and bonus credits group by the same key; a group may have either or both
+	type key struct {
+		gameID     uuid.UUID
+		operatorID string
+	}
+	keyOf := func(gameID *uuid.UUID, operatorID string) key {
+		k := key{operatorID: operatorID}
+		if gameID != nil {
+			k.gameID = *gameID
+		}
+		return k
+	}
+	var rows []*financialreport.DailySummary
+	byKey := make(map[key]*financialreport.DailySummary)
+	row := func(gameID *uuid.UUID, operatorID string) *financialreport.DailySummary {
+		k := keyOf(gameID, operatorID)
+		if r, ok := byKey[k]; ok {
+			return r
+		}
+		r := &financialreport.DailySummary{
+			ID:          uuid.New(),
+			ReportDate:  day,
+			GameID:      gameID,
+			OperatorID:  operatorID,
+			GeneratedAt: now,
+		}
+		byKey[k] = r
+		rows = append(rows, r)
+		return r
+	}
+
+	for _, t := range spins {
+		r := row(t.GameID, t.OperatorID)
+		r.Spins = t.Spins
+		r.PaidSpins = t.PaidSpins
+		r.TotalWagered = roundCents(t.Wagered)
+		r.TotalWon = roundCents(t.Won)
+		r.GGR = roundCents(t.Wagered - t.Won)
+		r.FreeSpinCost = roundCents(t.FreeSpinsWon)
+	}
+	for _, b := range bonuses {
+		row(b.GameID, b.OperatorID).BonusCost = roundCents(b.Amount)
+	}
+
+	if err := s.repo.ReplaceDay(ctx, day, rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// GenerateDue builds every ended day after the latest reported one, starting at most
+// lookback days back; days without play have no rows, so they are rebuilt on each pass
+// until they fall out of the lookback
+func (s *FinancialReportService) GenerateDue(ctx context.Context, now time.Time) (int, error) {
+	today := reportDay(now)
+	start := today.AddDate(0, 0, -s.lookback)
+
+	latest, err := s.repo.LatestDay(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if latest != nil && !reportDay(*latest).Before(start) {
+		start = reportDay(*latest).AddDate(0, 0, 1)
+	}
+
+	generated := 0
+	for day := start; day.Before(today); day = day.AddDate(0, 0, 1) {
+		rows, err := s.Generate(ctx, day)
+		if err != nil {
+			return generated, fmt.Errorf("failed to generate financial report for %s: %w", day.Format(financialReportDayLayout), err)
+		}
+		generated++
+		s.logger.Info().Str("day", day.Format(financialReportDayLayout)).Int("rows", len(rows)).Msg("Generated daily financial report")
+	}
+	return generated, nil
+}
+
+// List returns the report rows in the filters' range
+func (s *FinancialReportService) List(ctx context.Context, filters financialreport.ListFilters) ([]*financialreport.DailySummary, error) {
+	filters.From, filters.To = reportDay(filters.From), reportDay(filters.To)
+	if filters.To.Before(filters.From) || filters.To.Sub(filters.From) >= time.Duration(s.maxDays)*24*time.Hour {
+		return nil, fmt.Errorf("%w: from must not be after to, and the range may cover at most %d days", financialreport.ErrInvalidRange, s.maxDays)
+	}
+	return s.repo.List(ctx, filters)
+}
+
+// CSV renders the report rows in the filters' range as CSV
+func (s *FinancialReportService) CSV(ctx context.Context, filters financialreport.ListFilters) ([]byte, error) {
+	rows, err := s.List(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(financialReportCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		gameID := ""
+		if r.GameID != nil {
+			gameID = r.GameID.String()
+		}
+		if err := w.Write([]string{
+			r.ReportDate.Format(financialReportDayLayout),
+			gameID,
+			r.OperatorID,
+			strconv.FormatInt(r.Spins, 10),
+			strconv.FormatInt(r.PaidSpins, 10),
+			formatAmount(r.TotalWagered),
+			formatAmount(r.TotalWon),
+			formatAmount(r.GGR),
+			formatAmount(r.FreeSpinCost),
+			formatAmount(r.BonusCost),
+			formatAmount(r.JackpotContributions),
+			r.GeneratedAt.UTC().Format(time.RFC3339),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write financial report CSV: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// reportDay truncates t to the start of its UTC day
+func reportDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// roundCents rounds an amount to cents
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/financialreport"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubFinancialReportRepository returns canned totals and keeps replaced days in memory
+type stubFinancialReportRepository struct {
+	spins   []financialreport.SpinTotals
+	bonuses []financialreport.BonusTotals
+	days    map[time.Time][]*financialreport.DailySummary
+	summed  []time.Time
+}
+
+func (r *stubFinancialReportRepository) SpinTotals(ctx context.Context, from, to time.Time) ([]financialreport.SpinTotals, error) {
+	r.summed = append(r.summed, from)
+	return r.spins, nil
+}
+
+func (r *stubFinancialReportRepository) BonusTotals(ctx context.Context, from, to time.Time) ([]financialreport.BonusTotals, error) {
+	return r.bonuses, nil
+}
+
+func (r *stubFinancialReportRepository) ReplaceDay(ctx context.Context, day time.Time, rows []*financialreport.DailySummary) error {
+	r.days[day] = rows
+	return nil
+}
+
+func (r *stubFinancialReportRepository) LatestDay(ctx context.Context) (*time.Time, error) {
+	var latest *time.Time
+	for day, rows := range r.days {
+		if len(rows) > 0 && (latest == nil || day.After(*latest)) {
+			d := day
+			latest = &d
+		}
+	}
+	return latest, nil
+}
+
+func (r *stubFinancialReportRepository) List(ctx context.Context, filters financialreport.ListFilters) ([]*financialreport.DailySummary, error) {
+	var rows []*financialreport.DailySummary
+	for day := filters.From; !day.After(filters.To); day = day.AddDate(0, 0, 1) {
+		rows = append(rows, r.days[day]...)
+	}
+	return rows, nil
+}
+
+func newTestFinancialReportService(repo financialreport.Repository, now time.Time) *FinancialReportService {
+	cfg := &config.Config{FinancialReport: config.FinancialReportConfig{LookbackDays: 3, MaxRangeDays: 31}}
+	return NewFinancialReportService(cfg, repo, clock.NewFake(now), logger.New("error", "json"))
+}
+
+func TestFinancialReportService(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	now := day.AddDate(0, 0, 1).Add(time.Hour)
+	gameID := uuid.New()
+
+	t.Run("should merge spins and bonus credits into one row per game and operator", func(t *testing.T) {
+		repo := &stubFinancialReportRepository{
+			spins: []financialreport.SpinTotals{
+				{GameID: &gameID, Spins: 3, PaidSpins: 2, Wagered: 101, Won: 27.5, FreeSpinsWon: 7},
+				{OperatorID: "acme", Spins: 1, PaidSpins: 1, Wagered: 0.3, Won: 0.1},
+			},
+			bonuses: []financialreport.BonusTotals{
+				{OperatorID: "acme", Amount: 15},
+				{OperatorID: "globex", Amount: 5},
+			},
+			days: map[time.Time][]*financialreport.DailySummary{},
+		}
+
+		rows, err := newTestFinancialReportService(repo, now).Generate(ctx, day.Add(13*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		assert.Equal(t, rows, repo.days[day])
+
+		assert.Equal(t, &gameID, rows[0].GameID)
+		assert.Equal(t, 73.5, rows[0].GGR)
+		assert.Equal(t, 7.0, rows[0].FreeSpinCost)
+		assert.Equal(t, 0.2, rows[1].GGR)
+		assert.Equal(t, 15.0, rows[1].BonusCost)
+		assert.Equal(t, "globex", rows[2].OperatorID)
+		assert.Zero(t, rows[2].Spins)
+		assert.Equal(t, 5.0, rows[2].BonusCost)
+		for _, r := range rows {
+			assert.True(t, day.Equal(r.ReportDate))
+			assert.Zero(t, r.JackpotContributions)
+		}
+	})
+
+	t.Run("should refuse days that have not ended", func(t *testing.T) {
+		repo := &stubFinancialReportRepository{days: map[time.Time][]*financialreport.DailySummary{}}
+		_, err := newTestFinancialReportService(repo, now).Generate(ctx, now)
+		assert.ErrorIs(t, err, financialreport.ErrDayNotOver)
+	})
+
+	t.Run("should generate ended days after the latest report within the lookback", func(t *testing.T) {
+		repo := &stubFinancialReportRepository{
+			spins: []financialreport.SpinTotals{{Spins: 1}},
+			days:  map[time.Time][]*financialreport.DailySummary{},
+		}
+		s := newTestFinancialReportService(repo, now)
+
+		generated, err := s.GenerateDue(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, 3, generated)
+		assert.Equal(t, []time.Time{day.AddDate(0, 0, -2), day.AddDate(0, 0, -1), day}, repo.summed)
+
+		repo.summed = nil
+		generated, err = s.GenerateDue(ctx, now)
+		require.NoError(t, err)
+		assert.Zero(t, generated)
+		assert.Empty(t, repo.summed)
+	})
+
+	t.Run("should render the selected rows as CSV", func(t *testing.T) {
+		repo := &stubFinancialReportRepository{days: map[time.Time][]*financialreport.DailySummary{
+			day: {{ReportDate: day, GameID: &gameID, Spins: 2, PaidSpins: 2, TotalWagered: 2, TotalWon: 1.5, GGR: 0.5, GeneratedAt: now}},
+		}}
+		s := newTestFinancialReportService(repo, now)
+
+		data, err := s.CSV(ctx, financialreport.ListFilters{From: day, To: day})
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		require.Len(t, lines, 2)
+		assert.Equal(t, strings.Join(financialReportCSVHeader, ","), lines[0])
+		assert.Equal(t, "2026-03-01,"+gameID.String()+",,2,2,2.00,1.50,0.50,0.00,0.00,0.00,2026-03-02T01:00:00Z", lines[1])
+
+		_, err = s.CSV(ctx, financialreport.ListFilters{From: day, To: day.AddDate(0, 0, 31)})
+		assert.ErrorIs(t, err, financialreport.ErrInvalidRange)
+		_, err = s.CSV(ctx, financialreport.ListFilters{From: day, To: day.AddDate(0, 0, -1)})
+		assert.ErrorIs(t, err, financialreport.ErrInvalidRange)
+	})
+}
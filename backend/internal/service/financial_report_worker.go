@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/domain/financialreport"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// FinancialReportWorker periodically generates the daily financial reports of ended days
+type FinancialReportWorker struct {
+	reports  financialreport.Service
+	interval time.Duration
+	clock    clock.Clock
+	logger   *logger.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewFinancialReportWorker creates a new financial report worker
+func NewFinancialReportWorker(cfg *config.Config, reports financialreport.Service, clk clock.Clock, log *logger.Logger) *FinancialReportWorker {
+	return &FinancialReportWorker{
+		reports:  reports,
+		interval: time.Duration(cfg.FinancialReport.IntervalMinutes) * time.Minute,
+		clock:    clk,
+		logger:   log,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the worker in the background; a zero interval disables it
+func (w *FinancialReportWorker) Start() {
+	if w.interval <= 0 {
+		close(w.done)
+		w.logger.Info().Msg("Financial report worker disabled")
+		return
+	}
+
+	go w.run()
+	w.logger.Info().Dur("interval", w.interval).Msg("Financial report worker started")
+}
+
+// Stop stops the worker and waits for a running pass to finish
+func (w *FinancialReportWorker) Stop() {
+	w.stopOnce.Do(func() { close(w.stop) })
+	<-w.done
+}
+
+func (w *FinancialReportWorker) run() {
+	defer close(w.done)
+
+	ticker := w.clock.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case now := <-ticker.C():
+			w.Run(context.Background(), now)
+		}
+	}
+}
+
+// Run generates the reports of ended days not reported yet and returns how many it generated
+func (w *FinancialReportWorker) Run(ctx context.Context, now time.Time) int {
+	generated, err := w.reports.GenerateDue(ctx, now)
+	if err != nil {
+		w.logger.Error().Err(err).Int("generated", generated).Msg("Financial report pass failed")
+	}
+	return generated
+}
@@ -6,16 +6,28 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/analytics"
+	"github.com/slotmachine/backend/domain/bigwin"
+	"github.com/slotmachine/backend/domain/certification"
+	"github.com/slotmachine/backend/domain/dashboard"
 	"github.com/slotmachine/backend/domain/freespins"
 	"github.com/slotmachine/backend/domain/player"
 	"github.com/slotmachine/backend/domain/provablyfair"
 	"github.com/slotmachine/backend/domain/session"
 	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/domain/spinfeed"
+	"github.com/slotmachine/backend/domain/stats"
 	"github.com/slotmachine/backend/internal/game/engine"
 	freespinsEngine "github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/pkg/featureflags"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
+// freeSpinsAutoplayMaxSpins bounds how many free spins one autoplay request plays
+const freeSpinsAutoplayMaxSpins = 200
+
 // FreeSpinsService implements the freespins.Service interface
 type FreeSpinsService struct {
 	sessionRepo   session.Repository
@@ -23,7 +35,17 @@ type FreeSpinsService struct {
 	spinRepo      spin.Repository
 	playerRepo    player.Repository
 	gameEngine    *engine.GameEngine
-	pfService     *ProvablyFairService // Required: always use HKDF RNG for provably fair
+	pfService     *ProvablyFairService   // Required: always use HKDF RNG for provably fair
+	stats         stats.Recorder         // Optional: nil disables player stats rollups
+	bigWins       bigwin.Detector        // Optional: nil disables big win detection
+	feed          spinfeed.Publisher     // Optional: nil disables the live spin feed
+	mirror        analytics.Publisher    // Optional: nil disables the analytics mirror
+	dashboard     dashboard.Recorder     // Optional: nil disables the live dashboard counters
+	flags         *featureflags.Service  // Optional: nil keeps every flagged feature at its default
+	certLog       certification.Recorder // Optional: nil disables certification logging
+	shadowEngine  *ShadowEngine          // Optional: nil disables shadow engine comparison
+	txManager     *repository.TxManager  // Optional: nil settles free spins without a transaction
+	nonceRequired bool                   // Reject free spin requests without a client nonce
 	logger        *logger.Logger
 }
 
@@ -103,9 +125,31 @@ func (s *FreeSpinsService) TriggerFreeSpins(
 	return newSession, nil
 }
 
+// SetFeatureFlags enables per-player feature flag rollouts for free spins
+func (s *FreeSpinsService) SetFeatureFlags(flags *featureflags.Service) {
+	s.flags = flags
+}
+
 // ExecuteFreeSpin executes a spin in a free spins session
 // clientSeed is optional: for provably fair sessions, client provides their own seed per-spin
 func (s *FreeSpinsService) ExecuteFreeSpin(ctx context.Context, freeSpinsSessionID uuid.UUID, clientSeed string) (*spin.SpinResult, error) {
+	if _, ok := spin.ClientNonce(ctx); !ok && s.nonceRequired {
+		return nil, provablyfair.ErrClientNonceRequired
+	}
+	return s.executeFreeSpin(ctx, freeSpinsSessionID, clientSeed)
+}
+
+// withTransaction runs fn in a transaction when a TxManager is configured
+func (s *FreeSpinsService) withTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.txManager == nil {
+		return fn(ctx)
+	}
+	return s.txManager.WithTransaction(ctx, fn)
+}
+
+// executeFreeSpin plays the session's next free spin, at the client nonce in ctx when there is one
+// Autoplay plays a whole session in one request, so its spins carry no nonce of their own.
+func (s *FreeSpinsService) executeFreeSpin(ctx context.Context, freeSpinsSessionID uuid.UUID, clientSeed string) (*spin.SpinResult, error) {
 	log := s.logger.WithTraceContext(ctx)
 
 	// Get free spins session
@@ -130,6 +174,11 @@ func (s *FreeSpinsService) ExecuteFreeSpin(ctx context.Context, freeSpinsSession
 	// Record balance before
 	balanceBefore := p.Balance
 
+	// Evaluate flags once so the whole spin sees the same rollout decisions
+	if s.flags != nil {
+		ctx = featureflags.NewContext(ctx, s.flags.Evaluate(ctx, freeSpinsSession.PlayerID))
+	}
+
 	// Create engine session for free spin execution
 	engineSession := &freespinsEngine.Session{
 		ID:                freeSpinsSession.ID,
@@ -141,133 +190,233 @@ func (s *FreeSpinsService) ExecuteFreeSpin(ctx context.Context, freeSpinsSession
 		LockedBetAmount:   freeSpinsSession.LockedBetAmount,
 		TotalWon:          freeSpinsSession.TotalWon,
 		IsActive:          freeSpinsSession.IsActive,
+		StickyWilds:       toEnginePositions(freeSpinsSession.StickyWilds),
 		CreatedAt:         freeSpinsSession.CreatedAt,
 	}
 
-	// Deduct remaining spins
-	if err := s.freespinsRepo.ExecuteSpinWithLock(ctx, freeSpinsSession.ID, -1, freeSpinsSession.LockVersion); err != nil {
-		log.Error().Err(err).Str("player_id", freeSpinsSession.PlayerID.String()).Msg("Failed to deduct remaining spins")
-		return nil, fmt.Errorf("failed to deduct remaining spins: %w", err)
-	}
-	freeSpinsSession.LockVersion++
-	freeSpinsSession.RemainingSpins--
-	freeSpinsSession.SpinsCompleted++
-
 	// Verify active provably fair session exists (required for all spins)
 	var pfResult *provablyfair.SpinResult
-	if _, err := s.pfService.GetSessionState(ctx, freeSpinsSession.SessionID); err != nil {
+	pfState, err := s.pfService.GetSessionState(ctx, freeSpinsSession.SessionID)
+	if err != nil {
 		log.Error().Err(err).Str("session_id", freeSpinsSession.SessionID.String()).Msg("No active provably fair session")
 		return nil, fmt.Errorf("provably fair session required: start a PF session first")
 	}
 
-	// Execute free spin using game engine with HKDF RNG (always provably fair)
-	spinNumber := freeSpinsSession.SpinsCompleted
-	var engineResult *engine.FreeSpinResult
-
-	// Use HKDF-based RNG for provably fair outcomes (RFC 5869 with per-reel key derivation)
-	// Note: thetaSeed is empty for free spins - theta verification only happens on first spin
-	hkdfRNG, _, err := s.pfService.GetHKDFStreamRNG(ctx, freeSpinsSession.SessionID, clientSeed, "")
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to get HKDF stream RNG for free spin")
-		return nil, fmt.Errorf("failed to get HKDF stream RNG: %w", err)
-	}
-	engineResult, err = s.gameEngine.ExecuteFreeSpinWithRNG(ctx, freeSpinsSession.PlayerID, engineSession, spinNumber, hkdfRNG)
-	if err != nil {
-		s.freespinsRepo.RollbackSpin(ctx, freeSpinsSession.ID, 1)
-		log.Error().Err(err).Msg("Failed to execute free spin with HKDF RNG")
-		return nil, fmt.Errorf("failed to execute free spin: %w", err)
-	}
-
-	// Credit win to balance if any
-	newBalance := balanceBefore
-	if engineResult.TotalWin > 0 {
-		newBalance = newBalance + engineResult.TotalWin
-		if err := s.playerRepo.UpdateBalance(ctx, freeSpinsSession.PlayerID, engineResult.TotalWin); err != nil {
-			log.Error().Err(err).Str("player_id", freeSpinsSession.PlayerID.String()).Msg("Failed to credit free spin win")
-			// Continue anyway, spin already executed
+	// A captured request replayed after its spin was played names a nonce the session has passed
+	clientNonce, hasClientNonce := spin.ClientNonce(ctx)
+	if hasClientNonce {
+		if err := pfState.CheckClientNonce(clientNonce); err != nil {
+			log.Warn().Err(err).Str("session_id", freeSpinsSession.SessionID.String()).Msg("Free spin rejected by client nonce")
+			return nil, err
 		}
 	}
 
-	// Update free spins session
-	newRemainingSpins := engineResult.RemainingSpins
-	newTotalWon := freeSpinsSession.TotalWon + engineResult.TotalWin
+	// Claim, spin deduction, outcome, credit, spin record, PF spin log and statistics commit together:
+	// a free spin is either fully settled or never happened, so a failure can't leave the claimed
+	// client nonce ahead of the PF session's
+	spinNumber := freeSpinsSession.SpinsCompleted + 1
+	var (
+		engineResult      *engine.FreeSpinResult
+		spinRecord        *spin.Spin
+		newBalance        = balanceBefore
+		newRemainingSpins int
+		newTotalWon       float64
+		recorder          *rng.RecordingRNG
+	)
+	// Certification logging and shadow engine replays need every value the spin draws
+	certLogging := s.certLog != nil && s.certLog.Enabled()
+	shadowSampled := s.shadowEngine.Sample()
+	err = s.withTransaction(ctx, func(txCtx context.Context) error {
+		// Claim the nonce before anything is settled: a replay that raced the check above finds it taken
+		if hasClientNonce {
+			if err := s.pfService.ClaimClientNonce(txCtx, pfState, clientNonce); err != nil {
+				log.Warn().Err(err).Str("session_id", freeSpinsSession.SessionID.String()).Msg("Free spin rejected by client nonce")
+				return err
+			}
+		}
 
-	// Handle retrigger
-	if engineResult.Retriggered {
-		if err := s.freespinsRepo.AddSpins(ctx, freeSpinsSessionID, engineResult.AdditionalSpins); err != nil {
-			log.Error().Err(err).Str("free_spins_session_id", freeSpinsSessionID.String()).Msg("Failed to add retrigger spins")
+		// Deduct remaining spins
+		if err := s.freespinsRepo.ExecuteSpinWithLock(txCtx, freeSpinsSession.ID, -1, freeSpinsSession.LockVersion); err != nil {
+			log.Error().Err(err).Str("player_id", freeSpinsSession.PlayerID.String()).Msg("Failed to deduct remaining spins")
+			return fmt.Errorf("failed to deduct remaining spins: %w", err)
 		}
 
-		log.Info().
-			Str("free_spins_session_id", freeSpinsSessionID.String()).
-			Int("additional_spins", engineResult.AdditionalSpins).
-			Msg("Free spins retriggered")
-	}
+		// Execute free spin using game engine with the configured RNG (provably fair with HKDF)
+		// The default HKDF provider gives provably fair outcomes (RFC 5869 with per-reel key derivation)
+		// Note: thetaSeed is empty for free spins - theta verification only happens on first spin
+		spinRNG, rngProvider, err := s.pfService.GetSpinRNG(txCtx, freeSpinsSession.SessionID, clientSeed, "")
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get spin RNG for free spin")
+			return fmt.Errorf("failed to get spin RNG: %w", err)
+		}
+		if certLogging || shadowSampled {
+			recorder = rng.NewRecordingRNG(spinRNG)
+			spinRNG = recorder
+		}
+		engineResult, err = s.gameEngine.ExecuteFreeSpinWithRNG(txCtx, freeSpinsSession.PlayerID, engineSession, spinNumber, spinRNG)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to execute free spin")
+			return fmt.Errorf("failed to execute free spin: %w", err)
+		}
+
+		// Credit win to balance if any
+		if engineResult.TotalWin > 0 {
+			newBalance = balanceBefore + engineResult.TotalWin
+			if err := s.playerRepo.UpdateBalanceWithTx(txCtx, freeSpinsSession.PlayerID, engineResult.TotalWin); err != nil {
+				log.Error().Err(err).Str("player_id", freeSpinsSession.PlayerID.String()).Msg("Failed to credit free spin win")
+				return fmt.Errorf("failed to credit free spin win: %w", err)
+			}
+		}
+
+		// Update free spins session
+		newRemainingSpins = engineResult.RemainingSpins
+		newTotalWon = freeSpinsSession.TotalWon + engineResult.TotalWin
+
+		// Handle retrigger
+		if engineResult.Retriggered {
+			if err := s.freespinsRepo.AddSpins(txCtx, freeSpinsSessionID, engineResult.AdditionalSpins); err != nil {
+				log.Error().Err(err).Str("free_spins_session_id", freeSpinsSessionID.String()).Msg("Failed to add retrigger spins")
+				return fmt.Errorf("failed to add retrigger spins: %w", err)
+			}
+
+			log.Info().
+				Str("free_spins_session_id", freeSpinsSessionID.String()).
+				Int("additional_spins", engineResult.AdditionalSpins).
+				Msg("Free spins retriggered")
+		}
+
+		if err := s.freespinsRepo.AddTotalWon(txCtx, freeSpinsSessionID, engineResult.TotalWin); err != nil {
+			log.Error().Err(err).Str("free_spins_session_id", freeSpinsSessionID.String()).Msg("Failed to update total won")
+			return fmt.Errorf("failed to update total won: %w", err)
+		}
+
+		// Hold sticky wilds for the next spin
+		if engineResult.WildFeatures.StickyWilds {
+			if err := s.freespinsRepo.SetStickyWilds(txCtx, freeSpinsSessionID, fromEnginePositions(engineResult.StickyWilds)); err != nil {
+				log.Error().Err(err).Str("free_spins_session_id", freeSpinsSessionID.String()).Msg("Failed to update sticky wilds")
+				return fmt.Errorf("failed to update sticky wilds: %w", err)
+			}
+		}
+
+		// Check if session is complete
+		if newRemainingSpins <= 0 {
+			if err := s.freespinsRepo.CompleteSession(txCtx, freeSpinsSessionID); err != nil {
+				log.Error().Err(err).Str("free_spins_session_id", freeSpinsSessionID.String()).Msg("Failed to complete free spins session")
+				return fmt.Errorf("failed to complete free spins session: %w", err)
+			}
+		}
+
+		// Save spin record
+		spinRecord = &spin.Spin{
+			ID:                 engineResult.SpinID,
+			SessionID:          freeSpinsSession.SessionID, // Free spins don't have a session ID in traditional sense
+			PlayerID:           freeSpinsSession.PlayerID,
+			BetAmount:          freeSpinsSession.LockedBetAmount,
+			BalanceBefore:      balanceBefore,
+			BalanceAfter:       newBalance,
+			Grid:               convertGrid(engineResult.Grid),
+			Cascades:           convertCascades(engineResult.Cascades),
+			TotalWin:           engineResult.TotalWin,
+			ScatterCount:       engineResult.ScatterCount,
+			IsFreeSpin:         true,
+			FreeSpinsSessionID: &freeSpinsSessionID,
+			FreeSpinsTriggered: false,
+			ReelPositions:      engineResult.ReelPositions,
+			RNGProvider:        rngProvider,
+			MathVersion:        engineResult.MathVersion,
+			CreatedAt:          engineResult.Timestamp,
+		}
+
+		if err := s.spinRepo.Create(txCtx, spinRecord); err != nil {
+			log.Error().Err(err).Str("player_id", freeSpinsSession.PlayerID.String()).Msg("Failed to save free spin record")
+			return fmt.Errorf("failed to save free spin: %w", err)
+		}
+
+		// Roll the spin up into the player's daily and lifetime stats
+		if s.stats != nil {
+			if err := s.stats.RecordSpin(txCtx, spinRecord); err != nil {
+				log.Error().Err(err).Str("spin_id", spinRecord.ID.String()).Msg("Failed to record free spin stats")
+				return fmt.Errorf("failed to record free spin stats: %w", err)
+			}
+		}
 
-	if err := s.freespinsRepo.AddTotalWon(ctx, freeSpinsSessionID, engineResult.TotalWin); err != nil {
-		log.Error().Err(err).Str("free_spins_session_id", freeSpinsSessionID.String()).Msg("Failed to update total won")
+		// Record spin in provably fair system (always required)
+		pfResult, err = s.pfService.RecordSpin(txCtx, &provablyfair.RecordSpinInput{
+			GameSessionID: freeSpinsSession.SessionID,
+			SpinID:        engineResult.SpinID,
+			ReelPositions: engineResult.ReelPositions,
+			ClientSeed:    clientSeed,
+			IsFreeSpin:    true,
+			Multipliers:   engineResult.Multipliers,
+			WildFeatures:  toWildFeatures(engineResult.WildFeatures),
+			StickyWilds:   freeSpinsSession.StickyWilds,
+			MathVersion:   engineResult.MathVersion,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to record free spin in provably fair system")
+			return fmt.Errorf("failed to record provably fair free spin: %w", err)
+		}
+
+		// The certification event commits with the spin, so the log has no gaps
+		if certLogging {
+			event := newCertificationEvent(spinRecord, recorder, engineResult.ReelStripConfigID, engineResult.StripChecksums, engineResult.Multipliers, pfResult)
+			if err := s.certLog.Record(txCtx, event); err != nil {
+				log.Error().Err(err).Str("spin_id", spinRecord.ID.String()).Msg("Failed to record free spin certification event")
+				return fmt.Errorf("failed to record certification event: %w", err)
+			}
+		}
+
+		// Update session statistics
+		if err := s.sessionRepo.UpdateStatistics(txCtx, freeSpinsSession.SessionID, 1, 0, engineResult.TotalWin); err != nil {
+			log.Error().Err(err).Str("session_id", freeSpinsSession.SessionID.String()).Msg("Failed to update session statistics")
+			return fmt.Errorf("failed to update session statistics: %w", err)
+		}
+
+		if err := s.playerRepo.UpdateStatistics(txCtx, freeSpinsSession.PlayerID, 1, 0, engineResult.TotalWin); err != nil {
+			log.Error().Err(err).Str("player_id", freeSpinsSession.PlayerID.String()).Msg("Failed to update player statistics")
+			return fmt.Errorf("failed to update player statistics: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Check if session is complete
 	if newRemainingSpins <= 0 {
-		if err := s.freespinsRepo.CompleteSession(ctx, freeSpinsSessionID); err != nil {
-			log.Error().Err(err).Str("free_spins_session_id", freeSpinsSessionID.String()).Msg("Failed to complete free spins session")
-		}
 		log.Info().
 			Str("free_spins_session_id", freeSpinsSessionID.String()).
 			Float64("total_won", newTotalWon).
 			Msg("Free spins session completed")
 	}
 
-	// Convert grid and cascades
-	grid := convertGrid(engineResult.Grid)
-	cascades := convertCascades(engineResult.Cascades)
-
-	// Save spin record
-	spinRecord := &spin.Spin{
-		ID:                 engineResult.SpinID,
-		SessionID:          freeSpinsSession.SessionID, // Free spins don't have a session ID in traditional sense
-		PlayerID:           freeSpinsSession.PlayerID,
-		BetAmount:          freeSpinsSession.LockedBetAmount,
-		BalanceBefore:      balanceBefore,
-		BalanceAfter:       newBalance,
-		Grid:               grid,
-		Cascades:           cascades,
-		TotalWin:           engineResult.TotalWin,
-		ScatterCount:       engineResult.ScatterCount,
-		IsFreeSpin:         true,
-		FreeSpinsSessionID: &freeSpinsSessionID,
-		FreeSpinsTriggered: false,
-		ReelPositions:      engineResult.ReelPositions,
-		CreatedAt:          engineResult.Timestamp,
-	}
-
-	if err := s.spinRepo.Create(ctx, spinRecord); err != nil {
-		log.Error().Err(err).Str("player_id", freeSpinsSession.PlayerID.String()).Msg("Failed to save free spin record")
-	}
-
-	// Record spin in provably fair system (always required)
-	pfResult, err = s.pfService.RecordSpin(ctx, &provablyfair.RecordSpinInput{
-		GameSessionID: freeSpinsSession.SessionID,
-		SpinID:        engineResult.SpinID,
-		ReelPositions: engineResult.ReelPositions,
-		ClientSeed:    clientSeed,
-		IsFreeSpin:    true,
-	})
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to record free spin in provably fair system")
-		// Continue anyway, spin already executed
+	// Replay the settled spin through the shadow engine in the background
+	if shadowSampled {
+		s.shadowEngine.CompareFreeSpin(ctx, freeSpinsSession.PlayerID, engineSession, spinNumber, recorder.Draws(), engineResult)
+	}
+
+	// Record big wins along with the PF proof needed to verify them
+	if s.bigWins != nil {
+		if _, err := s.bigWins.Check(ctx, spinRecord, bigWinProof(pfState, pfResult, clientSeed)); err != nil {
+			log.Error().Err(err).Str("spin_id", spinRecord.ID.String()).Msg("Failed to record big win")
+		}
 	}
 
-	// Update session statistics
-	if err := s.sessionRepo.UpdateStatistics(ctx, freeSpinsSession.SessionID, 1, 0, engineResult.TotalWin); err != nil {
-		log.Error().Err(err).Str("session_id", freeSpinsSession.SessionID.String()).Msg("Failed to update session statistics")
-		// Don't return error
+	// Show the spin on the live ops feed
+	if s.feed != nil {
+		if err := s.feed.Publish(ctx, spinRecord, p.Username); err != nil {
+			log.Warn().Err(err).Str("spin_id", spinRecord.ID.String()).Msg("Failed to publish free spin to feed")
+		}
 	}
 
-	if err := s.playerRepo.UpdateStatistics(ctx, freeSpinsSession.PlayerID, 1, 0, engineResult.TotalWin); err != nil {
-		log.Error().Err(err).Str("player_id", freeSpinsSession.PlayerID.String()).Msg("Failed to update player statistics")
-		// Don't return error
+	// Mirror the spin into the analytics store
+	if s.mirror != nil {
+		s.mirror.Publish(ctx, analytics.NewSpinEvent(spinRecord, freeSpinsSession.ReelStripConfigID))
+	}
+
+	// Count the spin on the ops dashboard
+	if s.dashboard != nil {
+		s.dashboard.RecordSpin(ctx, spinRecord, p.Username)
 	}
 
 	log.Info().
@@ -287,9 +436,11 @@ func (s *FreeSpinsService) ExecuteFreeSpin(ctx context.Context, freeSpinsSession
 		BalanceBefore:           balanceBefore,
 		BalanceAfterBet:         balanceBefore,
 		NewBalance:              newBalance,
-		Grid:                    grid,
-		Cascades:                cascades,
+		Grid:                    spinRecord.Grid,
+		Cascades:                spinRecord.Cascades,
 		SpinTotalWin:            engineResult.TotalWin,
+		WinTier:                 spin.ClassifyWin(engineResult.WinTiers, engineResult.TotalWin, freeSpinsSession.LockedBetAmount),
+		Timing:                  spin.PlanTiming(engineResult.Timing, spinRecord.Cascades.Expansions()),
 		ScatterCount:            engineResult.ScatterCount,
 		IsFreeSpin:              true,
 		FreeSpinsTriggered:      false,
@@ -298,6 +449,7 @@ func (s *FreeSpinsService) ExecuteFreeSpin(ctx context.Context, freeSpinsSession
 		FreeSpinsSessionID:      freeSpinsSession.ID.String(),
 		FreeSpinsRemainingSpins: newRemainingSpins,
 		FreeSessionTotalWin:     newTotalWon,
+		StickyWilds:             convertStickyWilds(engineResult.StickyWilds),
 		Timestamp:               engineResult.Timestamp.Format(time.RFC3339),
 	}
 
@@ -314,6 +466,77 @@ func (s *FreeSpinsService) ExecuteFreeSpin(ctx context.Context, freeSpinsSession
 	return result, nil
 }
 
+// ExecuteAllFreeSpins plays the player's free spins session to completion in one call
+// Spins run through executeFreeSpin one at a time, so settlement, the PF hash chain and
+// retriggers behave exactly as in manual play. A retrigger loop is bounded by
+// freeSpinsAutoplayMaxSpins; whatever is left can be played by calling again.
+func (s *FreeSpinsService) ExecuteAllFreeSpins(ctx context.Context, playerID, freeSpinsSessionID uuid.UUID, clientSeed string) (*freespins.AutoplayResult, error) {
+	freeSpinsSession, err := s.freespinsRepo.GetAvailableSessionByID(ctx, freeSpinsSessionID)
+	if err != nil || freeSpinsSession.PlayerID != playerID {
+		return nil, freespins.ErrFreeSpinsNotFound
+	}
+
+	p, err := s.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, player.ErrPlayerNotFound
+	}
+
+	result := &freespins.AutoplayResult{
+		FreeSpinsSessionID: freeSpinsSessionID,
+		TotalWon:           freeSpinsSession.TotalWon,
+		RemainingSpins:     freeSpinsSession.RemainingSpins,
+		NewBalance:         p.Balance,
+		Spins:              make([]freespins.AutoplaySpin, 0, freeSpinsSession.RemainingSpins),
+	}
+
+	for result.RemainingSpins > 0 && result.SpinsPlayed < freeSpinsAutoplayMaxSpins {
+		// executeFreeSpin reads the player from the context; keep its balance current between spins
+		current := *p
+		current.Balance = result.NewBalance
+		spinResult, err := s.executeFreeSpin(context.WithValue(ctx, "player", &current), freeSpinsSessionID, clientSeed)
+		if err != nil {
+			if result.SpinsPlayed == 0 {
+				return nil, err
+			}
+			// Spins already played are settled; report them and let the client resume
+			s.logger.WithTraceContext(ctx).Error().Err(err).
+				Str("free_spins_session_id", freeSpinsSessionID.String()).
+				Int("spins_played", result.SpinsPlayed).
+				Msg("Free spins autoplay stopped early")
+			break
+		}
+
+		compact := freespins.AutoplaySpin{
+			SpinID:          spinResult.SpinID,
+			Grid:            spinResult.Grid,
+			CascadeCount:    len(spinResult.Cascades),
+			TotalWin:        spinResult.SpinTotalWin,
+			ScatterCount:    spinResult.ScatterCount,
+			Retriggered:     spinResult.FreeSpinsRetriggered,
+			AdditionalSpins: spinResult.FreeSpinsAdditional,
+		}
+		if spinResult.ProvablyFair != nil {
+			compact.Nonce = spinResult.ProvablyFair.Nonce
+			compact.SpinHash = spinResult.ProvablyFair.SpinHash
+		}
+
+		result.Spins = append(result.Spins, compact)
+		result.SpinsPlayed++
+		result.TotalWon = spinResult.FreeSessionTotalWin
+		result.RemainingSpins = spinResult.FreeSpinsRemainingSpins
+		result.NewBalance = spinResult.NewBalance
+	}
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("free_spins_session_id", freeSpinsSessionID.String()).
+		Int("spins_played", result.SpinsPlayed).
+		Float64("total_won", result.TotalWon).
+		Int("remaining_spins", result.RemainingSpins).
+		Msg("Free spins autoplay finished")
+
+	return result, nil
+}
+
 // GetStatus retrieves the status of a free spins session
 func (s *FreeSpinsService) GetStatus(ctx context.Context, freeSpinsSessionID uuid.UUID) (*freespins.FreeSpinsStatus, error) {
 	session, err := s.freespinsRepo.GetByID(ctx, freeSpinsSessionID)
@@ -8,11 +8,20 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/slotmachine/backend/domain/freespins"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/provablyfair"
 	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/game/engine"
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/infra/repository"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
 )
 
 // ============================================================================
@@ -60,6 +69,11 @@ func (m *MockFreeSpinsRepository) UpdateTotalWon(ctx context.Context, id uuid.UU
 	return args.Error(0)
 }
 
+func (m *MockFreeSpinsRepository) SetStickyWilds(ctx context.Context, id uuid.UUID, positions game.GridPositions) error {
+	args := m.Called(ctx, id, positions)
+	return args.Error(0)
+}
+
 func (m *MockFreeSpinsRepository) AddTotalWon(ctx context.Context, id uuid.UUID, totalWon float64) error {
 	args := m.Called(ctx, id, totalWon)
 	return args.Error(0)
@@ -172,6 +186,22 @@ func (m *MockSpinRepository) UpdateFreeSpinsSessionId(ctx context.Context, spinI
 	return args.Error(0)
 }
 
+func (m *MockSpinRepository) GetLatestBySession(ctx context.Context, sessionID uuid.UUID, since *time.Time) (*spin.Spin, error) {
+	args := m.Called(ctx, sessionID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*spin.Spin), args.Error(1)
+}
+
+func (m *MockSpinRepository) ListUnresolvedTriggers(ctx context.Context, since, before time.Time, limit int) ([]*spin.Spin, error) {
+	args := m.Called(ctx, since, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*spin.Spin), args.Error(1)
+}
+
 // Note: For freespins tests, we don't need the actual GameEngine
 // since we're testing the service layer logic, not the engine execution
 
@@ -557,3 +587,98 @@ func TestRetriggerFreeSpins(t *testing.T) {
 		mockFSRepo.AssertExpectations(t)
 	})
 }
+
+// ============================================================================
+// ExecuteAllFreeSpins TESTS
+// ============================================================================
+
+func TestExecuteAllFreeSpins(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should hide other players' sessions", func(t *testing.T) {
+		service, mockFSRepo, _, _, _ := setupFreeSpinsService()
+
+		fsSession := &freespins.FreeSpinsSession{ID: uuid.New(), PlayerID: uuid.New(), RemainingSpins: 10, IsActive: true}
+		mockFSRepo.On("GetAvailableSessionByID", ctx, fsSession.ID).Return(fsSession, nil)
+
+		result, err := service.ExecuteAllFreeSpins(ctx, uuid.New(), fsSession.ID, "")
+
+		assert.Nil(t, result)
+		assert.Equal(t, freespins.ErrFreeSpinsNotFound, err)
+	})
+
+	t.Run("should report a finished session without playing", func(t *testing.T) {
+		service, mockFSRepo, _, mockPlayerRepo, _ := setupFreeSpinsService()
+
+		playerID := uuid.New()
+		fsSession := &freespins.FreeSpinsSession{ID: uuid.New(), PlayerID: playerID, RemainingSpins: 0, TotalWon: 120}
+		mockFSRepo.On("GetAvailableSessionByID", ctx, fsSession.ID).Return(fsSession, nil)
+		mockPlayerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, Balance: 900}, nil)
+
+		result, err := service.ExecuteAllFreeSpins(ctx, playerID, fsSession.ID, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.SpinsPlayed)
+		assert.Equal(t, 120.0, result.TotalWon)
+		assert.Equal(t, 900.0, result.NewBalance)
+		assert.Empty(t, result.Spins)
+		mockFSRepo.AssertNotCalled(t, "ExecuteSpinWithLock")
+	})
+}
+
+// claimingPFRepository claims client nonces in the pf_sessions table of db
+type claimingPFRepository struct {
+	provablyfair.Repository
+	db *gorm.DB
+}
+
+func (r *claimingPFRepository) ClaimClientNonce(ctx context.Context, id uuid.UUID, nonce int64) error {
+	return repository.GetDBOrTx(ctx, r.db).
+		Exec(`UPDATE pf_sessions SET last_client_nonce = ? WHERE id = ? AND last_client_nonce < ?`, nonce, id.String(), nonce).Error
+}
+
+func TestExecuteFreeSpin_SettlementFailure(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: gormlogger.Default.LogMode(gormlogger.Silent)})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1) // Every connection to :memory: opens its own database
+	require.NoError(t, db.Exec(`CREATE TABLE pf_sessions (id TEXT PRIMARY KEY, last_client_nonce INTEGER NOT NULL)`).Error)
+
+	state := &provablyfair.PFSessionState{SessionID: uuid.New(), ServerSeed: "server-seed", Status: provablyfair.SessionStatusActive}
+	require.NoError(t, db.Exec(`INSERT INTO pf_sessions (id, last_client_nonce) VALUES (?, 0)`, state.SessionID.String()).Error)
+
+	service, mockFSRepo, mockSpinRepo, mockPlayerRepo, _ := setupFreeSpinsService()
+	service.txManager = repository.NewTxManager(db)
+	service.gameEngine = engine.NewGameEngine(nil, nil, false)
+	service.pfService = &ProvablyFairService{
+		repo:          &claimingPFRepository{db: db},
+		cache:         &stubPFStateCache{state: state},
+		hashGenerator: rng.NewHashChainGenerator(),
+		logger:        logger.New("error", "json"),
+	}
+
+	p := &player.Player{ID: uuid.New(), Balance: 1000}
+	fsSession := &freespins.FreeSpinsSession{
+		ID: uuid.New(), PlayerID: p.ID, SessionID: uuid.New(),
+		TotalSpinsAwarded: 10, RemainingSpins: 10, LockedBetAmount: 1, IsActive: true,
+	}
+	mockFSRepo.On("GetAvailableSessionByID", mock.Anything, fsSession.ID).Return(fsSession, nil)
+	mockFSRepo.On("ExecuteSpinWithLock", mock.Anything, fsSession.ID, -1, 0).Return(nil)
+	mockFSRepo.On("AddSpins", mock.Anything, fsSession.ID, mock.Anything).Return(nil).Maybe()
+	mockFSRepo.On("AddTotalWon", mock.Anything, fsSession.ID, mock.Anything).Return(nil).Maybe()
+	mockFSRepo.On("SetStickyWilds", mock.Anything, fsSession.ID, mock.Anything).Return(nil).Maybe()
+	mockFSRepo.On("CompleteSession", mock.Anything, fsSession.ID).Return(nil).Maybe()
+	mockPlayerRepo.On("UpdateBalanceWithTx", mock.Anything, p.ID, mock.Anything).Return(nil).Maybe()
+	mockSpinRepo.On("Create", mock.Anything, mock.Anything).Return(errors.New("spins table unavailable"))
+
+	ctx := spin.WithClientNonce(context.WithValue(context.Background(), "player", p), 1)
+	result, err := service.ExecuteFreeSpin(ctx, fsSession.ID, "client-seed")
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	var claimed int64
+	require.NoError(t, db.Raw(`SELECT last_client_nonce FROM pf_sessions WHERE id = ?`, state.SessionID.String()).Scan(&claimed).Error)
+	assert.Equal(t, int64(0), claimed, "the client nonce stayed claimed by a spin that was never settled")
+	assert.NoError(t, state.CheckClientNonce(1), "the session can no longer play its next spin")
+}
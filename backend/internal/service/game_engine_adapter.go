@@ -1,10 +1,12 @@
 package service
 
 import (
+	"github.com/slotmachine/backend/domain/game"
 	"github.com/slotmachine/backend/domain/spin"
 	"github.com/slotmachine/backend/internal/game/cascade"
 	"github.com/slotmachine/backend/internal/game/reels"
 	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/game/wilds"
 	"github.com/slotmachine/backend/internal/game/wins"
 )
 
@@ -38,6 +40,7 @@ func convertCascades(engineCascades []cascade.CascadeResult) spin.Cascades {
 			Wins:            convertCascadeWins(cascadeResult.Wins),
 			TotalCascadeWin: cascadeResult.TotalCascadeWin,
 			WinningTileKind: extractHighestPriorityWinningSymbol(cascadeResult.Wins),
+			ExpandedReels:   cascadeResult.ExpandedReels,
 		}
 	}
 	return result
@@ -89,13 +92,62 @@ func convertCascadeWins(engineWins []wins.CascadeWinDetail) []spin.CascadeWin {
 		}
 
 		result[i] = spin.CascadeWin{
-			Symbol:    string(win.Symbol), // Convert symbols.Symbol to string
-			Count:     win.Count,
-			Ways:      win.Ways,
-			Payout:    win.Payout,
-			WinAmount: win.WinAmount,
-			Positions: positions,
+			Symbol:        string(win.Symbol), // Convert symbols.Symbol to string
+			Count:         win.Count,
+			Ways:          win.Ways,
+			Payout:        win.Payout,
+			WinAmount:     win.WinAmount,
+			Positions:     positions,
+			EffectiveWays: win.EffectiveWays,
 		}
 	}
 	return result
 }
+
+// toEnginePositions converts stored grid positions to engine wild positions
+func toEnginePositions(positions game.GridPositions) []wilds.Position {
+	if len(positions) == 0 {
+		return nil
+	}
+	result := make([]wilds.Position, len(positions))
+	for i, pos := range positions {
+		result[i] = wilds.Position{Reel: pos.Reel, Row: pos.Row}
+	}
+	return result
+}
+
+// fromEnginePositions converts engine wild positions to stored grid positions
+func fromEnginePositions(positions []wilds.Position) game.GridPositions {
+	if len(positions) == 0 {
+		return nil
+	}
+	result := make(game.GridPositions, len(positions))
+	for i, pos := range positions {
+		result[i] = game.GridPosition{Reel: pos.Reel, Row: pos.Row}
+	}
+	return result
+}
+
+// toWildFeatures converts engine wild features to their stored form (nil when none are enabled)
+func toWildFeatures(features wilds.Features) *game.WildFeatures {
+	if features == (wilds.Features{}) {
+		return nil
+	}
+	return &game.WildFeatures{
+		StickyWilds:    features.StickyWilds,
+		ExpandingWilds: features.ExpandingWilds,
+		WildMultiplier: features.WildMultiplier,
+	}
+}
+
+// convertStickyWilds converts engine sticky wild positions to spin grid positions
+func convertStickyWilds(positions []wilds.Position) []spin.GridPosition {
+	if len(positions) == 0 {
+		return nil
+	}
+	result := make([]spin.GridPosition, len(positions))
+	for i, pos := range positions {
+		result[i] = spin.GridPosition{Reel: pos.Reel, Row: pos.Row}
+	}
+	return result
+}
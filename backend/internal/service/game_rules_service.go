@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/game/engine"
+	"github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/multiplier"
+	"github.com/slotmachine/backend/internal/game/wilds"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// gameRulesTTL is how long a game's rules are cached; rule changes also expire them
+const gameRulesTTL = time.Minute
+
+// GameRulesService resolves and manages per-game rules: the cascade multiplier ladder,
+// the free spins trigger rules, the wild features, the win presentation tiers and the animation
+// timing. Games without an active config, or whose config leaves a rule unset, use the engine defaults.
+type GameRulesService struct {
+	playerRepo player.Repository
+	gameRepo   game.Repository
+	cache      *cache.Cache // Optional: nil disables caching
+	logger     *logger.Logger
+}
+
+// NewGameRulesService creates a new game rules service
+func NewGameRulesService(
+	playerRepo player.Repository,
+	gameRepo game.Repository,
+	cache *cache.Cache,
+	log *logger.Logger,
+) *GameRulesService {
+	return &GameRulesService{
+		playerRepo: playerRepo,
+		gameRepo:   gameRepo,
+		cache:      cache,
+		logger:     log,
+	}
+}
+
+// RulesForPlayer returns the rules of the player's game
+// Lookup failures fall back to the default rules so spins are never blocked.
+func (s *GameRulesService) RulesForPlayer(ctx context.Context, playerID uuid.UUID) engine.GameRules {
+	p, err := s.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("player_id", playerID.String()).Msg("Failed to load player for game rules, using default")
+		return engine.DefaultGameRules
+	}
+	if p.GameID == nil {
+		return engine.DefaultGameRules
+	}
+	return s.RulesForGame(ctx, *p.GameID)
+}
+
+// RulesForGame returns the rules of the game's active config
+func (s *GameRulesService) RulesForGame(ctx context.Context, gameID uuid.UUID) engine.GameRules {
+	load := func() (any, error) {
+		config, err := s.gameRepo.GetActiveGameConfig(ctx, gameID)
+		if errors.Is(err, game.ErrNoActiveConfig) {
+			return engine.DefaultGameRules, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return toEngineRules(config), nil
+	}
+
+	var (
+		res any
+		err error
+	)
+	if s.cache == nil {
+		res, err = load()
+	} else {
+		ttl := gameRulesTTL
+		res, err = s.cache.GetWithSingleflight(ctx, s.cache.GameRulesKey(gameID), engine.GameRules{}, load, &ttl)
+	}
+	if err != nil {
+		s.logger.Warn().Err(err).Str("game_id", gameID.String()).Msg("Failed to load game rules, using default")
+		return engine.DefaultGameRules
+	}
+	return res.(engine.GameRules)
+}
+
+// GetGameConfig returns a game config whose rules are managed here
+func (s *GameRulesService) GetGameConfig(ctx context.Context, configID uuid.UUID) (*game.GameConfig, error) {
+	return s.gameRepo.GetGameConfigByID(ctx, configID)
+}
+
+// SetGameConfigLadder validates and stores a config's multiplier ladder; nil resets it to the default
+func (s *GameRulesService) SetGameConfigLadder(ctx context.Context, configID uuid.UUID, ladder *game.MultiplierLadder) (*game.GameConfig, error) {
+	if ladder != nil {
+		if err := ladder.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	config, err := s.gameRepo.UpdateGameConfigLadder(ctx, configID, ladder)
+	if err != nil {
+		return nil, err
+	}
+	s.Expire(ctx, config.GameID)
+	return config, nil
+}
+
+// SetGameConfigTriggerRules validates and stores a config's free spins trigger rules; nil resets them to the default
+func (s *GameRulesService) SetGameConfigTriggerRules(ctx context.Context, configID uuid.UUID, rules *game.TriggerRules) (*game.GameConfig, error) {
+	if rules != nil {
+		if err := rules.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	config, err := s.gameRepo.UpdateGameConfigTriggerRules(ctx, configID, rules)
+	if err != nil {
+		return nil, err
+	}
+	s.Expire(ctx, config.GameID)
+	return config, nil
+}
+
+// SetGameConfigWildFeatures validates and stores a config's wild features; nil disables them
+func (s *GameRulesService) SetGameConfigWildFeatures(ctx context.Context, configID uuid.UUID, features *game.WildFeatures) (*game.GameConfig, error) {
+	if features != nil {
+		if err := features.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	config, err := s.gameRepo.UpdateGameConfigWildFeatures(ctx, configID, features)
+	if err != nil {
+		return nil, err
+	}
+	s.Expire(ctx, config.GameID)
+	return config, nil
+}
+
+// SetGameConfigWinTiers validates and stores a config's win presentation tiers; nil resets them to the default
+func (s *GameRulesService) SetGameConfigWinTiers(ctx context.Context, configID uuid.UUID, tiers *game.WinTiers) (*game.GameConfig, error) {
+	if tiers != nil {
+		if err := tiers.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	config, err := s.gameRepo.UpdateGameConfigWinTiers(ctx, configID, tiers)
+	if err != nil {
+		return nil, err
+	}
+	s.Expire(ctx, config.GameID)
+	return config, nil
+}
+
+// SetGameConfigTimingProfiles validates and stores a config's animation timing; nil resets it to the default
+func (s *GameRulesService) SetGameConfigTimingProfiles(ctx context.Context, configID uuid.UUID, profiles *game.TimingProfiles) (*game.GameConfig, error) {
+	if profiles != nil {
+		if err := profiles.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	config, err := s.gameRepo.UpdateGameConfigTimingProfiles(ctx, configID, profiles)
+	if err != nil {
+		return nil, err
+	}
+	s.Expire(ctx, config.GameID)
+	return config, nil
+}
+
+// Expire drops the cached rules of a game after its configs change
+func (s *GameRulesService) Expire(ctx context.Context, gameID uuid.UUID) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.Expire(ctx, s.cache.GameRulesKey(gameID)); err != nil {
+		s.logger.Warn().Err(err).Str("game_id", gameID.String()).Msg("Failed to expire cached game rules")
+	}
+}
+
+// toEngineRules converts a config's stored rules to engine rules
+func toEngineRules(config *game.GameConfig) engine.GameRules {
+	rules := engine.DefaultGameRules
+	if config.MultiplierLadder != nil {
+		rules.Multipliers = multiplier.Ladder{
+			BaseGame:  config.MultiplierLadder.BaseGame,
+			FreeSpins: config.MultiplierLadder.FreeSpins,
+		}
+	}
+	if config.TriggerRules != nil {
+		rules.FreeSpins = freespins.TriggerRules{
+			MinScatters:     config.TriggerRules.MinScatters,
+			BaseAward:       config.TriggerRules.BaseAward,
+			ExtraPerScatter: config.TriggerRules.ExtraPerScatter,
+			Retrigger:       config.TriggerRules.Retrigger,
+		}
+	}
+	if config.WildFeatures != nil {
+		rules.Wilds = wilds.Features{
+			StickyWilds:    config.WildFeatures.StickyWilds,
+			ExpandingWilds: config.WildFeatures.ExpandingWilds,
+			WildMultiplier: config.WildFeatures.WildMultiplier,
+		}
+	}
+	if config.WinTiers != nil {
+		rules.WinTiers = *config.WinTiers
+	}
+	if config.TimingProfiles != nil {
+		rules.Timing = *config.TimingProfiles
+	}
+	return rules
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/game/engine"
+	"github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/wilds"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGameRulesService_RulesForPlayer(t *testing.T) {
+	ctx := context.Background()
+	log := logger.New("error", "json")
+	playerID := uuid.New()
+	gameID := uuid.New()
+
+	t.Run("should use the active config rules of the player's game", func(t *testing.T) {
+		playerRepo := new(MockPlayerRepository)
+		gameRepo := new(MockGameRepository)
+		playerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, GameID: &gameID}, nil)
+		gameRepo.On("GetActiveGameConfig", ctx, gameID).Return(&game.GameConfig{
+			GameID:           gameID,
+			MultiplierLadder: &game.MultiplierLadder{BaseGame: []int{1, 3, 9}, FreeSpins: []int{3, 9, 27}},
+			TriggerRules:     &game.TriggerRules{MinScatters: 4, BaseAward: 10, ExtraPerScatter: 5},
+			WildFeatures:     &game.WildFeatures{ExpandingWilds: true, WildMultiplier: 2},
+			WinTiers:         &game.WinTiers{{Name: "win"}, {Name: "epic", MinMultiplier: 40, Video: "epic"}},
+			TimingProfiles:   &game.TimingProfiles{Normal: game.TimingProfile{CascadeMs: 700}, MinSpinMs: 3000},
+		}, nil)
+
+		svc := NewGameRulesService(playerRepo, gameRepo, nil, log)
+		rules := svc.RulesForPlayer(ctx, playerID)
+
+		assert.Equal(t, []int{1, 3, 9}, rules.Multipliers.Steps(false))
+		assert.Equal(t, []int{3, 9, 27}, rules.Multipliers.Steps(true))
+		assert.Equal(t, freespins.TriggerRules{MinScatters: 4, BaseAward: 10, ExtraPerScatter: 5}, rules.FreeSpins)
+		assert.Equal(t, wilds.Features{ExpandingWilds: true, WildMultiplier: 2}, rules.Wilds)
+		assert.Equal(t, "epic", rules.WinTiers.Classify(40, 1).Name)
+		assert.Equal(t, 3000, rules.Timing.MinSpinMs)
+	})
+
+	t.Run("should fall back to the default rules", func(t *testing.T) {
+		playerRepo := new(MockPlayerRepository)
+		gameRepo := new(MockGameRepository)
+		otherPlayer := uuid.New()
+		otherGame := uuid.New()
+		playerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, GameID: &gameID}, nil)
+		playerRepo.On("GetByID", ctx, otherPlayer).Return(&player.Player{ID: otherPlayer, GameID: &otherGame}, nil)
+		gameRepo.On("GetActiveGameConfig", ctx, gameID).Return(&game.GameConfig{GameID: gameID}, nil)
+		gameRepo.On("GetActiveGameConfig", ctx, otherGame).Return(nil, game.ErrNoActiveConfig)
+
+		svc := NewGameRulesService(playerRepo, gameRepo, nil, log)
+
+		assert.Equal(t, engine.DefaultGameRules, svc.RulesForPlayer(ctx, playerID))
+		assert.Equal(t, engine.DefaultGameRules, svc.RulesForPlayer(ctx, otherPlayer))
+	})
+
+	t.Run("should not block spins when lookups fail", func(t *testing.T) {
+		playerRepo := new(MockPlayerRepository)
+		gameRepo := new(MockGameRepository)
+		playerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, GameID: &gameID}, nil)
+		gameRepo.On("GetActiveGameConfig", ctx, gameID).Return(nil, errors.New("db down"))
+
+		svc := NewGameRulesService(playerRepo, gameRepo, nil, log)
+
+		assert.Equal(t, engine.DefaultGameRules, svc.RulesForPlayer(ctx, playerID))
+	})
+}
+
+func TestGameRulesService_SetGameConfigLadder(t *testing.T) {
+	ctx := context.Background()
+	log := logger.New("error", "json")
+	configID := uuid.New()
+
+	t.Run("should store a valid ladder", func(t *testing.T) {
+		gameRepo := new(MockGameRepository)
+		ladder := &game.MultiplierLadder{BaseGame: []int{1, 2, 4, 8}, FreeSpins: []int{2, 4, 8, 16}}
+		gameRepo.On("UpdateGameConfigLadder", ctx, configID, ladder).
+			Return(&game.GameConfig{ID: configID, MultiplierLadder: ladder}, nil)
+
+		svc := NewGameRulesService(new(MockPlayerRepository), gameRepo, nil, log)
+		config, err := svc.SetGameConfigLadder(ctx, configID, ladder)
+
+		require.NoError(t, err)
+		assert.Equal(t, ladder, config.MultiplierLadder)
+	})
+
+	t.Run("should reset to the default ladder", func(t *testing.T) {
+		gameRepo := new(MockGameRepository)
+		gameRepo.On("UpdateGameConfigLadder", ctx, configID, (*game.MultiplierLadder)(nil)).
+			Return(&game.GameConfig{ID: configID}, nil)
+
+		svc := NewGameRulesService(new(MockPlayerRepository), gameRepo, nil, log)
+		_, err := svc.SetGameConfigLadder(ctx, configID, nil)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject invalid ladders", func(t *testing.T) {
+		cases := map[string]*game.MultiplierLadder{
+			"empty":      {BaseGame: []int{}, FreeSpins: []int{2}},
+			"zero":       {BaseGame: []int{0, 1}, FreeSpins: []int{2}},
+			"decreasing": {BaseGame: []int{1, 3, 2}, FreeSpins: []int{2}},
+			"too large":  {BaseGame: []int{1}, FreeSpins: []int{2, game.MaxLadderMultiplier + 1}},
+		}
+		for name, ladder := range cases {
+			t.Run(name, func(t *testing.T) {
+				gameRepo := new(MockGameRepository)
+				svc := NewGameRulesService(new(MockPlayerRepository), gameRepo, nil, log)
+
+				_, err := svc.SetGameConfigLadder(ctx, configID, ladder)
+
+				assert.ErrorIs(t, err, game.ErrInvalidMultiplierLadder)
+				gameRepo.AssertNotCalled(t, "UpdateGameConfigLadder", mock.Anything, mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
+
+func TestGameRulesService_SetGameConfigTriggerRules(t *testing.T) {
+	ctx := context.Background()
+	log := logger.New("error", "json")
+	configID := uuid.New()
+
+	t.Run("should store valid rules", func(t *testing.T) {
+		gameRepo := new(MockGameRepository)
+		rules := &game.TriggerRules{MinScatters: 4, BaseAward: 10, ExtraPerScatter: 3, Retrigger: true}
+		gameRepo.On("UpdateGameConfigTriggerRules", ctx, configID, rules).
+			Return(&game.GameConfig{ID: configID, TriggerRules: rules}, nil)
+
+		svc := NewGameRulesService(new(MockPlayerRepository), gameRepo, nil, log)
+		config, err := svc.SetGameConfigTriggerRules(ctx, configID, rules)
+
+		require.NoError(t, err)
+		assert.Equal(t, rules, config.TriggerRules)
+	})
+
+	t.Run("should reject invalid rules", func(t *testing.T) {
+		cases := map[string]*game.TriggerRules{
+			"single scatter": {MinScatters: 1, BaseAward: 10},
+			"no award":       {MinScatters: 3, BaseAward: 0},
+			"negative extra": {MinScatters: 3, BaseAward: 10, ExtraPerScatter: -1},
+			"award too high": {MinScatters: 3, BaseAward: game.MaxFreeSpinsAward + 1},
+		}
+		for name, rules := range cases {
+			t.Run(name, func(t *testing.T) {
+				gameRepo := new(MockGameRepository)
+				svc := NewGameRulesService(new(MockPlayerRepository), gameRepo, nil, log)
+
+				_, err := svc.SetGameConfigTriggerRules(ctx, configID, rules)
+
+				assert.ErrorIs(t, err, game.ErrInvalidTriggerRules)
+				gameRepo.AssertNotCalled(t, "UpdateGameConfigTriggerRules", mock.Anything, mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
+
+func TestGameRulesService_SetGameConfigWildFeatures(t *testing.T) {
+	ctx := context.Background()
+	log := logger.New("error", "json")
+	configID := uuid.New()
+
+	t.Run("should store valid features", func(t *testing.T) {
+		gameRepo := new(MockGameRepository)
+		features := &game.WildFeatures{StickyWilds: true, WildMultiplier: 3}
+		gameRepo.On("UpdateGameConfigWildFeatures", ctx, configID, features).
+			Return(&game.GameConfig{ID: configID, WildFeatures: features}, nil)
+
+		svc := NewGameRulesService(new(MockPlayerRepository), gameRepo, nil, log)
+		config, err := svc.SetGameConfigWildFeatures(ctx, configID, features)
+
+		require.NoError(t, err)
+		assert.Equal(t, features, config.WildFeatures)
+	})
+
+	t.Run("should reject an out of range multiplier", func(t *testing.T) {
+		gameRepo := new(MockGameRepository)
+		svc := NewGameRulesService(new(MockPlayerRepository), gameRepo, nil, log)
+
+		_, err := svc.SetGameConfigWildFeatures(ctx, configID, &game.WildFeatures{WildMultiplier: game.MaxWildMultiplier + 1})
+
+		assert.ErrorIs(t, err, game.ErrInvalidWildFeatures)
+		gameRepo.AssertNotCalled(t, "UpdateGameConfigWildFeatures", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestGameRulesService_SetGameConfigWinTiers(t *testing.T) {
+	ctx := context.Background()
+	log := logger.New("error", "json")
+	configID := uuid.New()
+
+	t.Run("should store valid tiers", func(t *testing.T) {
+		gameRepo := new(MockGameRepository)
+		tiers := &game.WinTiers{{Name: "small"}, {Name: "big", MinMultiplier: 10, Video: "big_win"}}
+		gameRepo.On("UpdateGameConfigWinTiers", ctx, configID, tiers).
+			Return(&game.GameConfig{ID: configID, WinTiers: tiers}, nil)
+
+		svc := NewGameRulesService(new(MockPlayerRepository), gameRepo, nil, log)
+		config, err := svc.SetGameConfigWinTiers(ctx, configID, tiers)
+
+		require.NoError(t, err)
+		assert.Equal(t, tiers, config.WinTiers)
+	})
+
+	t.Run("should reject invalid tiers", func(t *testing.T) {
+		cases := map[string]*game.WinTiers{
+			"empty":          {},
+			"unnamed":        {{MinMultiplier: 1}},
+			"duplicate name": {{Name: "big"}, {Name: "big", MinMultiplier: 10}},
+			"not ascending":  {{Name: "big", MinMultiplier: 20}, {Name: "mega", MinMultiplier: 10}},
+			"negative":       {{Name: "small", MinMultiplier: -1}},
+		}
+		for name, tiers := range cases {
+			t.Run(name, func(t *testing.T) {
+				gameRepo := new(MockGameRepository)
+				svc := NewGameRulesService(new(MockPlayerRepository), gameRepo, nil, log)
+
+				_, err := svc.SetGameConfigWinTiers(ctx, configID, tiers)
+
+				assert.ErrorIs(t, err, game.ErrInvalidWinTiers)
+				gameRepo.AssertNotCalled(t, "UpdateGameConfigWinTiers", mock.Anything, mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
+
+func TestGameRulesService_SetGameConfigTimingProfiles(t *testing.T) {
+	ctx := context.Background()
+	log := logger.New("error", "json")
+	configID := uuid.New()
+
+	t.Run("should store valid profiles", func(t *testing.T) {
+		gameRepo := new(MockGameRepository)
+		profiles := &game.TimingProfiles{
+			Normal:    game.TimingProfile{ReelSpinMs: 1000, CascadeMs: 800},
+			Turbo:     game.TimingProfile{ReelSpinMs: 250, CascadeMs: 300},
+			MinSpinMs: 2500,
+		}
+		gameRepo.On("UpdateGameConfigTimingProfiles", ctx, configID, profiles).
+			Return(&game.GameConfig{ID: configID, TimingProfiles: profiles}, nil)
+
+		svc := NewGameRulesService(new(MockPlayerRepository), gameRepo, nil, log)
+		config, err := svc.SetGameConfigTimingProfiles(ctx, configID, profiles)
+
+		require.NoError(t, err)
+		assert.Equal(t, profiles, config.TimingProfiles)
+	})
+
+	t.Run("should reject invalid profiles", func(t *testing.T) {
+		cases := map[string]*game.TimingProfiles{
+			"negative":        {Normal: game.TimingProfile{CascadeMs: -1}},
+			"too long":        {MinSpinMs: game.MaxTimingMs + 1},
+			"turbo is slower": {Normal: game.TimingProfile{ReelSpinMs: 500}, Turbo: game.TimingProfile{ReelSpinMs: 600}},
+		}
+		for name, profiles := range cases {
+			t.Run(name, func(t *testing.T) {
+				gameRepo := new(MockGameRepository)
+				svc := NewGameRulesService(new(MockPlayerRepository), gameRepo, nil, log)
+
+				_, err := svc.SetGameConfigTimingProfiles(ctx, configID, profiles)
+
+				assert.ErrorIs(t, err, game.ErrInvalidTimingProfiles)
+				gameRepo.AssertNotCalled(t, "UpdateGameConfigTimingProfiles", mock.Anything, mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	infraCache "github.com/slotmachine/backend/internal/infra/cache"
+	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"gorm.io/gorm"
+)
+
+// HealthStatus is the state of one dependency, or of the instance as a whole
+type HealthStatus string
+
+const (
+	HealthOK       HealthStatus = "ok"
+	HealthDown     HealthStatus = "down"
+	HealthDisabled HealthStatus = "disabled" // Not configured, so not required
+)
+
+// DependencyHealth is the result of checking one dependency
+type DependencyHealth struct {
+	Name      string       `json:"name"`
+	Status    HealthStatus `json:"status"`
+	LatencyMs int64        `json:"latency_ms"`
+	Error     string       `json:"error,omitempty"`
+}
+
+// HealthReport is the result of checking every dependency
+type HealthReport struct {
+	Status       HealthStatus       `json:"status"` // ok only when no dependency is down
+	Dependencies []DependencyHealth `json:"dependencies"`
+	CheckedAt    time.Time          `json:"checked_at"`
+}
+
+// healthCheck checks one dependency
+// Infrastructure errors can carry hosts and credentials, so they are only logged; the report
+// shows them when exposeErr is set.
+type healthCheck struct {
+	name      string
+	check     func(ctx context.Context) error
+	exposeErr bool
+}
+
+// errHealthDisabled marks a dependency that is not configured
+var errHealthDisabled = errors.New("disabled")
+
+// HealthService checks the dependencies an instance needs to serve spins
+type HealthService struct {
+	db        *gorm.DB
+	redis     *infraCache.RedisClient // Optional: nil when Redis is disabled or unreachable at startup
+	redisOn   bool
+	storage   storage.Storage
+	reelStrip reelstrip.Repository
+	timeout   time.Duration
+	logger    *logger.Logger
+}
+
+// NewHealthService creates a new health service
+func NewHealthService(
+	cfg *config.Config,
+	db *gorm.DB,
+	redis *infraCache.RedisClient,
+	store storage.Storage,
+	reelStrip reelstrip.Repository,
+	log *logger.Logger,
+) *HealthService {
+	return &HealthService{
+		db:        db,
+		redis:     redis,
+		redisOn:   cfg.Redis.Enabled,
+		storage:   store,
+		reelStrip: reelStrip,
+		timeout:   time.Duration(cfg.Health.CheckTimeoutMillis) * time.Millisecond,
+		logger:    log,
+	}
+}
+
+// Check runs every dependency check concurrently, each bounded by the check timeout
+func (s *HealthService) Check(ctx context.Context) *HealthReport {
+	checks := []healthCheck{
+		{name: "database", check: s.checkDatabase},
+		{name: "redis", check: s.checkRedis},
+		{name: "storage", check: s.checkStorage},
+		{name: "reel_strips", check: s.checkReelStrips, exposeErr: true},
+	}
+
+	report := &HealthReport{
+		Status:       HealthOK,
+		Dependencies: make([]DependencyHealth, len(checks)),
+		CheckedAt:    time.Now().UTC(),
+	}
+
+	var wg sync.WaitGroup
+	for i, hc := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Dependencies[i] = s.run(ctx, hc)
+		}()
+	}
+	wg.Wait()
+
+	for _, dep := range report.Dependencies {
+		if dep.Status == HealthDown {
+			report.Status = HealthDown
+		}
+	}
+	return report
+}
+
+func (s *HealthService) run(ctx context.Context, hc healthCheck) DependencyHealth {
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	err := hc.check(ctx)
+	dep := DependencyHealth{Name: hc.name, Status: HealthOK, LatencyMs: time.Since(start).Milliseconds()}
+
+	switch {
+	case errors.Is(err, errHealthDisabled):
+		dep.Status = HealthDisabled
+	case err != nil:
+		dep.Status = HealthDown
+		dep.Error = "unreachable"
+		if hc.exposeErr {
+			dep.Error = err.Error()
+		}
+		s.logger.Warn().Err(err).Str("dependency", hc.name).Msg("Health check failed")
+	}
+	return dep
+}
+
+func (s *HealthService) checkDatabase(ctx context.Context) error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+func (s *HealthService) checkRedis(ctx context.Context) error {
+	if !s.redisOn {
+		return errHealthDisabled
+	}
+	if s.redis == nil || s.redis.GetClient() == nil {
+		return errors.New("redis is enabled but not connected")
+	}
+	return s.redis.GetClient().Ping(ctx).Err()
+}
+
+func (s *HealthService) checkStorage(ctx context.Context) error {
+	return s.storage.Ping(ctx)
+}
+
+// checkReelStrips requires an active default config for the game modes every spin resolves
+func (s *HealthService) checkReelStrips(ctx context.Context) error {
+	for _, mode := range []reelstrip.GameMode{reelstrip.BaseGame, reelstrip.FreeSpins} {
+		if _, err := s.reelStrip.GetDefaultConfig(ctx, string(mode)); err != nil {
+			if errors.Is(err, reelstrip.ErrNoDefaultConfig) {
+				return fmt.Errorf("no active default config for %s", mode)
+			}
+			return fmt.Errorf("failed to load default config for %s", mode)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// pingStorage is a storage whose Ping returns err; health checks use nothing else
+type pingStorage struct {
+	storage.Storage
+	err error
+}
+
+func (s *pingStorage) Ping(ctx context.Context) error {
+	return s.err
+}
+
+func newTestHealthService(t *testing.T, store storage.Storage, repo *MockReelStripRepository) *HealthService {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: gormlogger.Default.LogMode(gormlogger.Silent)})
+	require.NoError(t, err)
+	cfg := &config.Config{
+		Redis:  config.RedisConfig{Enabled: false},
+		Health: config.HealthConfig{CheckTimeoutMillis: 1000},
+	}
+	return NewHealthService(cfg, db, nil, store, repo, logger.New("error", "json"))
+}
+
+func healthOf(report *HealthReport) map[string]DependencyHealth {
+	deps := make(map[string]DependencyHealth, len(report.Dependencies))
+	for _, dep := range report.Dependencies {
+		deps[dep.Name] = dep
+	}
+	return deps
+}
+
+func TestHealthService_Check(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockReelStripRepository)
+	repo.On("GetDefaultConfig", mock.Anything, mock.Anything).Return(&reelstrip.ReelStripConfig{}, nil)
+	svc := newTestHealthService(t, &pingStorage{}, repo)
+
+	report := svc.Check(ctx)
+	assert.Equal(t, HealthOK, report.Status)
+
+	deps := healthOf(report)
+	assert.Equal(t, HealthOK, deps["database"].Status)
+	assert.Equal(t, HealthDisabled, deps["redis"].Status, "a disabled dependency is not required")
+	assert.Equal(t, HealthOK, deps["storage"].Status)
+	assert.Equal(t, HealthOK, deps["reel_strips"].Status)
+}
+
+func TestHealthService_CheckReportsDownDependencies(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockReelStripRepository)
+	repo.On("GetDefaultConfig", mock.Anything, string(reelstrip.BaseGame)).Return(&reelstrip.ReelStripConfig{}, nil)
+	repo.On("GetDefaultConfig", mock.Anything, string(reelstrip.FreeSpins)).Return(nil, reelstrip.ErrNoDefaultConfig)
+	svc := newTestHealthService(t, &pingStorage{err: errors.New("dial tcp 10.0.0.7:9000: connection refused")}, repo)
+
+	report := svc.Check(ctx)
+	assert.Equal(t, HealthDown, report.Status)
+
+	deps := healthOf(report)
+	assert.Equal(t, HealthOK, deps["database"].Status)
+	assert.Equal(t, HealthDown, deps["storage"].Status)
+	assert.Equal(t, "unreachable", deps["storage"].Error, "infrastructure errors are not exposed")
+	assert.Equal(t, HealthDown, deps["reel_strips"].Status)
+	assert.Equal(t, "no active default config for free_spins", deps["reel_strips"].Error)
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/historyexport"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+const (
+	// historyExportFolderPrefix is the storage folder exports are written under, one folder per player
+	historyExportFolderPrefix = "history-exports/"
+	// historyExportPage is how many spins are read per query while generating an export
+	historyExportPage = 1000
+	// historyExportBatch is how many queued exports one pass generates
+	historyExportBatch = 10
+	// historyExportListLimit is how many of a player's exports are listed
+	historyExportListLimit = 20
+)
+
+// HistoryExportService implements historyexport.Service
+// Exports are queued by the player and generated by the HistoryExportWorker into object
+// storage, where they are downloaded through short-lived signed links.
+type HistoryExportService struct {
+	repo       historyexport.Repository
+	spinRepo   spin.Repository
+	playerRepo player.Repository
+	storage    storage.Storage
+	maxDays    int
+	maxPending int
+	logger     *logger.Logger
+	now        func() time.Time
+}
+
+// NewHistoryExportService creates a new history export service
+func NewHistoryExportService(
+	cfg *config.Config,
+	repo historyexport.Repository,
+	spinRepo spin.Repository,
+	playerRepo player.Repository,
+	store storage.Storage,
+	clk clock.Clock,
+	log *logger.Logger,
+) *HistoryExportService {
+	return &HistoryExportService{
+		repo:       repo,
+		spinRepo:   spinRepo,
+		playerRepo: playerRepo,
+		storage:    store,
+		maxDays:    cfg.History.ExportMaxDays,
+		maxPending: cfg.History.ExportMaxPending,
+		logger:     log,
+		now:        clk.Now,
+	}
+}
+
+// Request queues an export of the player's spins in [from, to)
+func (s *HistoryExportService) Request(ctx context.Context, playerID uuid.UUID, format historyexport.Format, from, to time.Time) (*historyexport.Export, error) {
+	if !format.Valid() {
+		return nil, historyexport.ErrInvalidFormat
+	}
+	if !from.Before(to) || to.Sub(from) > time.Duration(s.maxDays)*24*time.Hour {
+		return nil, fmt.Errorf("%w: the range must cover 1 to %d days", historyexport.ErrInvalidRange, s.maxDays)
+	}
+
+	unfinished, err := s.repo.CountUnfinished(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if unfinished >= int64(s.maxPending) {
+		return nil, historyexport.ErrTooManyPending
+	}
+
+	e := &historyexport.Export{
+		ID:         uuid.New(),
+		PlayerID:   playerID,
+		Format:     format,
+		RangeStart: from.UTC(),
+		RangeEnd:   to.UTC(),
+		Status:     historyexport.StatusPending,
+		CreatedAt:  s.now().UTC(),
+	}
+	if err := s.repo.Create(ctx, e); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Str("export_id", e.ID.String()).
+		Str("player_id", playerID.String()).
+		Str("format", string(format)).
+		Time("from", e.RangeStart).
+		Time("to", e.RangeEnd).
+		Msg("Game history export requested")
+	return e, nil
+}
+
+// Get returns one of the player's exports
+func (s *HistoryExportService) Get(ctx context.Context, playerID, id uuid.UUID) (*historyexport.Export, error) {
+	e, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.PlayerID != playerID {
+		return nil, historyexport.ErrExportNotFound
+	}
+	return e, nil
+}
+
+// List lists the player's recent exports newest first
+func (s *HistoryExportService) List(ctx context.Context, playerID uuid.UUID) ([]*historyexport.Export, error) {
+	return s.repo.ListByPlayer(ctx, playerID, historyExportListLimit)
+}
+
+// Download returns a signed link to one of the player's completed exports
+func (s *HistoryExportService) Download(ctx context.Context, playerID, id uuid.UUID) (*historyexport.Download, error) {
+	e, err := s.Get(ctx, playerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.Status != historyexport.StatusCompleted {
+		return nil, historyexport.ErrExportNotReady
+	}
+
+	info, err := s.storage.GeneratePresignedDownloadURL(ctx, historyExportFolder(playerID), e.ObjectName, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign history export download: %w", err)
+	}
+	return &historyexport.Download{URL: info.URL, FileName: e.FileName(), ExpiresAt: info.ExpiresAt}, nil
+}
+
+// ProcessPending generates queued exports and returns how many it completed
+// Exports that fail are marked failed so the player can request them again.
+func (s *HistoryExportService) ProcessPending(ctx context.Context) (int, error) {
+	pending, err := s.repo.ListPending(ctx, historyExportBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	completed := 0
+	for _, e := range pending {
+		claimed, err := s.repo.Claim(ctx, e.ID)
+		if err != nil {
+			return completed, err
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := s.generate(ctx, e); err != nil {
+			s.logger.Error().Err(err).Str("export_id", e.ID.String()).Msg("Failed to generate game history export")
+			e.Status = historyexport.StatusFailed
+			e.Error = "The export could not be generated; please request it again"
+		} else {
+			e.Status = historyexport.StatusCompleted
+			completed++
+		}
+		now := s.now().UTC()
+		e.CompletedAt = &now
+		if err := s.repo.Update(ctx, e); err != nil {
+			return completed, err
+		}
+	}
+	return completed, nil
+}
+
+// generate writes the export file to storage, filling in its object name, spin count and size
+func (s *HistoryExportService) generate(ctx context.Context, e *historyexport.Export) error {
+	var buf bytes.Buffer
+	var csvWriter *csv.Writer
+	if e.Format == historyexport.FormatCSV {
+		csvWriter = csv.NewWriter(&buf)
+		if err := csvWriter.Write(historyCSVHeader); err != nil {
+			return err
+		}
+	}
+
+	// The repository range is inclusive, exports cover [RangeStart, RangeEnd)
+	summary := newHistorySummary()
+	last := e.RangeEnd.Add(-time.Nanosecond)
+	for offset := 0; ; offset += historyExportPage {
+		spins, err := s.spinRepo.GetByPlayerInTimeRange(ctx, e.PlayerID, e.RangeStart, last, historyExportPage, offset)
+		if err != nil {
+			return err
+		}
+		for _, sp := range spins {
+			summary.add(sp)
+			if csvWriter != nil {
+				if err := csvWriter.Write(historyCSVRow(sp)); err != nil {
+					return err
+				}
+			}
+		}
+		if len(spins) < historyExportPage {
+			break
+		}
+	}
+
+	if csvWriter != nil {
+		csvWriter.Flush()
+		if err := csvWriter.Error(); err != nil {
+			return err
+		}
+	} else {
+		p, err := s.playerRepo.GetByID(ctx, e.PlayerID)
+		if err != nil {
+			return err
+		}
+		if err := writeHistoryStatement(&buf, e, p.Username, summary, s.now().UTC()); err != nil {
+			return err
+		}
+	}
+
+	objectName := e.ID.String() + "." + string(e.Format)
+	size := int64(buf.Len())
+	if _, err := s.storage.UploadFile(ctx, historyExportFolder(e.PlayerID), objectName, &buf, size, e.Format.ContentType()); err != nil {
+		return fmt.Errorf("failed to upload history export: %w", err)
+	}
+	e.ObjectName = objectName
+	e.SpinCount = summary.spins
+	e.SizeBytes = size
+	return nil
+}
+
+// historyExportFolder is the storage folder a player's exports are written to
+func historyExportFolder(playerID uuid.UUID) string {
+	return historyExportFolderPrefix + playerID.String()
+}
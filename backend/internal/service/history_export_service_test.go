@@ -0,0 +1,276 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/historyexport"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockHistoryExportRepository is a mock implementation of historyexport.Repository
+type MockHistoryExportRepository struct {
+	mock.Mock
+}
+
+func (m *MockHistoryExportRepository) Create(ctx context.Context, e *historyexport.Export) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func (m *MockHistoryExportRepository) GetByID(ctx context.Context, id uuid.UUID) (*historyexport.Export, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*historyexport.Export), args.Error(1)
+}
+
+func (m *MockHistoryExportRepository) ListByPlayer(ctx context.Context, playerID uuid.UUID, limit int) ([]*historyexport.Export, error) {
+	args := m.Called(ctx, playerID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*historyexport.Export), args.Error(1)
+}
+
+func (m *MockHistoryExportRepository) CountUnfinished(ctx context.Context, playerID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, playerID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockHistoryExportRepository) ListPending(ctx context.Context, limit int) ([]*historyexport.Export, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*historyexport.Export), args.Error(1)
+}
+
+func (m *MockHistoryExportRepository) Claim(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockHistoryExportRepository) Update(ctx context.Context, e *historyexport.Export) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+// memoryStorage keeps uploaded files in memory; the storage methods exports do not use are left unimplemented
+type memoryStorage struct {
+	storage.Storage
+	files map[string][]byte
+}
+
+func (s *memoryStorage) UploadFile(ctx context.Context, themeName, fileName string, reader io.Reader, size int64, contentType string) (string, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	s.files[themeName+"/"+fileName] = data
+	return themeName + "/" + fileName, nil
+}
+
+func (s *memoryStorage) GeneratePresignedDownloadURL(ctx context.Context, themeName, fileName string, expiryMinutes int) (*storage.PresignedDownloadInfo, error) {
+	return &storage.PresignedDownloadInfo{URL: "https://storage.test/" + themeName + "/" + fileName}, nil
+}
+
+type historyExportTest struct {
+	svc        *HistoryExportService
+	repo       *MockHistoryExportRepository
+	spinRepo   *MockSpinRepository
+	playerRepo *MockPlayerRepository
+	storage    *memoryStorage
+}
+
+func newTestHistoryExportService() *historyExportTest {
+	cfg := &config.Config{History: config.HistoryConfig{ExportMaxDays: 31, ExportMaxPending: 2}}
+	tt := &historyExportTest{
+		repo:       new(MockHistoryExportRepository),
+		spinRepo:   new(MockSpinRepository),
+		playerRepo: new(MockPlayerRepository),
+		storage:    &memoryStorage{files: map[string][]byte{}},
+	}
+	tt.svc = NewHistoryExportService(cfg, tt.repo, tt.spinRepo, tt.playerRepo, tt.storage, clock.New(), logger.New("error", "json"))
+	return tt
+}
+
+func TestHistoryExportService_Request(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	from := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	t.Run("should queue a pending export", func(t *testing.T) {
+		tt := newTestHistoryExportService()
+		tt.repo.On("CountUnfinished", ctx, playerID).Return(int64(1), nil)
+		tt.repo.On("Create", ctx, mock.AnythingOfType("*historyexport.Export")).Return(nil)
+
+		e, err := tt.svc.Request(ctx, playerID, historyexport.FormatCSV, from, to)
+		require.NoError(t, err)
+		assert.Equal(t, historyexport.StatusPending, e.Status)
+		assert.Equal(t, from, e.RangeStart)
+		assert.Equal(t, to, e.RangeEnd)
+		assert.Equal(t, "game-history-2026-06-01-to-2026-06-07.csv", e.FileName())
+		tt.repo.AssertExpectations(t)
+	})
+
+	t.Run("should reject unknown formats and bad ranges", func(t *testing.T) {
+		tt := newTestHistoryExportService()
+
+		_, err := tt.svc.Request(ctx, playerID, "xlsx", from, to)
+		assert.ErrorIs(t, err, historyexport.ErrInvalidFormat)
+		for _, r := range [][2]time.Time{{from, from}, {to, from}, {from, from.AddDate(0, 0, 32)}} {
+			_, err := tt.svc.Request(ctx, playerID, historyexport.FormatPDF, r[0], r[1])
+			assert.ErrorIs(t, err, historyexport.ErrInvalidRange)
+		}
+		tt.repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should limit exports in progress", func(t *testing.T) {
+		tt := newTestHistoryExportService()
+		tt.repo.On("CountUnfinished", ctx, playerID).Return(int64(2), nil)
+
+		_, err := tt.svc.Request(ctx, playerID, historyexport.FormatCSV, from, to)
+		assert.ErrorIs(t, err, historyexport.ErrTooManyPending)
+		tt.repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestHistoryExportService_ProcessPending(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	from := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 2)
+	last := to.Add(-time.Nanosecond)
+	mode, cost := "bonus_buy", 100.0
+	spins := []*spin.Spin{
+		{ID: uuid.New(), PlayerID: playerID, BetAmount: 1, BalanceBefore: 50, BalanceAfter: 49, CreatedAt: from.Add(26 * time.Hour)},
+		{ID: uuid.New(), PlayerID: playerID, BetAmount: 1, TotalWin: 12, IsFreeSpin: true, CreatedAt: from.Add(2 * time.Hour)},
+		{ID: uuid.New(), PlayerID: playerID, BetAmount: 1, TotalWin: 30, GameMode: &mode, GameModeCost: &cost, FreeSpinsTriggered: true, CreatedAt: from.Add(time.Hour)},
+	}
+
+	newExport := func(format historyexport.Format) *historyexport.Export {
+		return &historyexport.Export{ID: uuid.New(), PlayerID: playerID, Format: format, RangeStart: from, RangeEnd: to, Status: historyexport.StatusPending}
+	}
+
+	t.Run("should write every spin in the range as CSV", func(t *testing.T) {
+		tt := newTestHistoryExportService()
+		e := newExport(historyexport.FormatCSV)
+		tt.repo.On("ListPending", ctx, historyExportBatch).Return([]*historyexport.Export{e}, nil)
+		tt.repo.On("Claim", ctx, e.ID).Return(true, nil)
+		tt.spinRepo.On("GetByPlayerInTimeRange", ctx, playerID, from, last, historyExportPage, 0).Return(spins, nil)
+		tt.repo.On("Update", ctx, e).Return(nil)
+
+		completed, err := tt.svc.ProcessPending(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, completed)
+		assert.Equal(t, historyexport.StatusCompleted, e.Status)
+		assert.Equal(t, 3, e.SpinCount)
+		require.NotNil(t, e.CompletedAt)
+
+		data := tt.storage.files[historyExportFolder(playerID)+"/"+e.ObjectName]
+		assert.Equal(t, int64(len(data)), e.SizeBytes)
+		rows, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 4)
+		assert.Equal(t, historyCSVHeader, rows[0])
+		assert.Equal(t, []string{"base", "", "1.00", "0.00", "-1.00", "50.00", "49.00", "false"}, rows[1][3:])
+		assert.Equal(t, []string{"free", "", "0.00", "12.00", "12.00"}, rows[2][3:8], "free spins cost nothing")
+		assert.Equal(t, []string{"base", "bonus_buy", "100.00", "30.00", "-70.00"}, rows[3][3:8], "bought modes cost the mode price")
+		tt.repo.AssertExpectations(t)
+	})
+
+	t.Run("should summarize the range as a PDF statement", func(t *testing.T) {
+		tt := newTestHistoryExportService()
+		e := newExport(historyexport.FormatPDF)
+		tt.repo.On("ListPending", ctx, historyExportBatch).Return([]*historyexport.Export{e}, nil)
+		tt.repo.On("Claim", ctx, e.ID).Return(true, nil)
+		tt.spinRepo.On("GetByPlayerInTimeRange", ctx, playerID, from, last, historyExportPage, 0).Return(spins, nil)
+		tt.playerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, Username: "alice"}, nil)
+		tt.repo.On("Update", ctx, e).Return(nil)
+
+		completed, err := tt.svc.ProcessPending(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, completed)
+		assert.Equal(t, historyexport.StatusCompleted, e.Status)
+
+		data := tt.storage.files[historyExportFolder(playerID)+"/"+e.ObjectName]
+		assert.True(t, bytes.HasPrefix(data, []byte("%PDF-")))
+		assert.Contains(t, string(data), "(Player: alice)")
+		assert.Contains(t, string(data), "(Total staked: 101.00)")
+		assert.Contains(t, string(data), "(2026-06-02)")
+	})
+
+	t.Run("should mark exports that fail and skip those claimed elsewhere", func(t *testing.T) {
+		tt := newTestHistoryExportService()
+		failing, taken := newExport(historyexport.FormatCSV), newExport(historyexport.FormatCSV)
+		tt.repo.On("ListPending", ctx, historyExportBatch).Return([]*historyexport.Export{failing, taken}, nil)
+		tt.repo.On("Claim", ctx, failing.ID).Return(true, nil)
+		tt.repo.On("Claim", ctx, taken.ID).Return(false, nil)
+		tt.spinRepo.On("GetByPlayerInTimeRange", ctx, playerID, from, last, historyExportPage, 0).Return(nil, errors.New("db down"))
+		tt.repo.On("Update", ctx, failing).Return(nil)
+
+		completed, err := tt.svc.ProcessPending(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, completed)
+		assert.Equal(t, historyexport.StatusFailed, failing.Status)
+		assert.NotEmpty(t, failing.Error)
+		assert.NotContains(t, failing.Error, "db down", "internal errors are not shown to players")
+		assert.Equal(t, historyexport.StatusPending, taken.Status)
+		tt.repo.AssertNotCalled(t, "Update", ctx, taken)
+	})
+}
+
+func TestHistoryExportService_Download(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	from := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	e := &historyexport.Export{ID: uuid.New(), PlayerID: playerID, Format: historyexport.FormatPDF, RangeStart: from, RangeEnd: from.AddDate(0, 1, 0)}
+
+	t.Run("should sign a link to a completed export", func(t *testing.T) {
+		tt := newTestHistoryExportService()
+		completed := *e
+		completed.Status = historyexport.StatusCompleted
+		completed.ObjectName = e.ID.String() + ".pdf"
+		tt.repo.On("GetByID", ctx, e.ID).Return(&completed, nil)
+
+		download, err := tt.svc.Download(ctx, playerID, e.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "https://storage.test/"+historyExportFolder(playerID)+"/"+completed.ObjectName, download.URL)
+		assert.Equal(t, "game-history-2026-06-01-to-2026-06-30.pdf", download.FileName)
+	})
+
+	t.Run("should refuse exports that have not completed", func(t *testing.T) {
+		tt := newTestHistoryExportService()
+		processing := *e
+		processing.Status = historyexport.StatusProcessing
+		tt.repo.On("GetByID", ctx, e.ID).Return(&processing, nil)
+
+		_, err := tt.svc.Download(ctx, playerID, e.ID)
+		assert.ErrorIs(t, err, historyexport.ErrExportNotReady)
+	})
+
+	t.Run("should hide other players' exports", func(t *testing.T) {
+		tt := newTestHistoryExportService()
+		tt.repo.On("GetByID", ctx, e.ID).Return(e, nil)
+
+		_, err := tt.svc.Download(ctx, uuid.New(), e.ID)
+		assert.ErrorIs(t, err, historyexport.ErrExportNotFound)
+	})
+}
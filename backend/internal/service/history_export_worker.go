@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/domain/historyexport"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// HistoryExportWorker periodically generates the game-history exports players have requested
+type HistoryExportWorker struct {
+	exports  historyexport.Service
+	interval time.Duration
+	clock    clock.Clock
+	logger   *logger.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewHistoryExportWorker creates a new history export worker
+func NewHistoryExportWorker(cfg *config.Config, exports historyexport.Service, clk clock.Clock, log *logger.Logger) *HistoryExportWorker {
+	return &HistoryExportWorker{
+		exports:  exports,
+		interval: time.Duration(cfg.History.ExportIntervalSeconds) * time.Second,
+		clock:    clk,
+		logger:   log,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the worker in the background; a zero interval disables it
+func (w *HistoryExportWorker) Start() {
+	if w.interval <= 0 {
+		close(w.done)
+		w.logger.Info().Msg("History export worker disabled")
+		return
+	}
+
+	go w.run()
+	w.logger.Info().Dur("interval", w.interval).Msg("History export worker started")
+}
+
+// Stop stops the worker and waits for a running pass to finish
+func (w *HistoryExportWorker) Stop() {
+	w.stopOnce.Do(func() { close(w.stop) })
+	<-w.done
+}
+
+func (w *HistoryExportWorker) run() {
+	defer close(w.done)
+
+	ticker := w.clock.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C():
+			w.Run(context.Background())
+		}
+	}
+}
+
+// Run generates queued exports and returns how many it completed
+func (w *HistoryExportWorker) Run(ctx context.Context) int {
+	completed, err := w.exports.ProcessPending(ctx)
+	if err != nil {
+		w.logger.Error().Err(err).Int("completed", completed).Msg("History export pass failed")
+	} else if completed > 0 {
+		w.logger.Info().Int("completed", completed).Msg("Generated game history exports")
+	}
+	return completed
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/slotmachine/backend/domain/historyexport"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/pkg/pdf"
+)
+
+// historyCSVHeader is the header row of CSV history exports
+var historyCSVHeader = []string{
+	"spin_id", "session_id", "created_at", "type", "game_mode",
+	"stake", "win", "net", "balance_before", "balance_after", "free_spins_triggered",
+}
+
+// historyStatementColumns are the offsets of the daily breakdown columns in PDF statements
+var historyStatementColumns = []float64{0, 90, 170, 260, 350}
+
+// historyStake is what a spin cost the player: nothing for free spins, the mode cost for bought modes
+func historyStake(sp *spin.Spin) float64 {
+	switch {
+	case sp.IsFreeSpin:
+		return 0
+	case sp.GameModeCost != nil:
+		return *sp.GameModeCost
+	default:
+		return sp.BetAmount
+	}
+}
+
+// historyCSVRow formats one spin as a CSV export row
+func historyCSVRow(sp *spin.Spin) []string {
+	spinType := "base"
+	if sp.IsFreeSpin {
+		spinType = "free"
+	}
+	gameMode := ""
+	if sp.GameMode != nil {
+		gameMode = *sp.GameMode
+	}
+	stake := historyStake(sp)
+	return []string{
+		sp.ID.String(),
+		sp.SessionID.String(),
+		sp.CreatedAt.UTC().Format(time.RFC3339),
+		spinType,
+		gameMode,
+		formatAmount(stake),
+		formatAmount(sp.TotalWin),
+		formatAmount(sp.TotalWin - stake),
+		formatAmount(sp.BalanceBefore),
+		formatAmount(sp.BalanceAfter),
+		strconv.FormatBool(sp.FreeSpinsTriggered),
+	}
+}
+
+// historyTotals accumulates the spins of a statement or of one of its days
+type historyTotals struct {
+	spins     int
+	freeSpins int
+	staked    float64
+	won       float64
+}
+
+func (t *historyTotals) add(sp *spin.Spin) {
+	t.spins++
+	if sp.IsFreeSpin {
+		t.freeSpins++
+	}
+	t.staked += historyStake(sp)
+	t.won += sp.TotalWin
+}
+
+// historySummary is the content of a PDF statement
+type historySummary struct {
+	historyTotals
+	largestWin float64
+	days       map[string]*historyTotals // Keyed by UTC date
+}
+
+func newHistorySummary() *historySummary {
+	return &historySummary{days: make(map[string]*historyTotals)}
+}
+
+func (s *historySummary) add(sp *spin.Spin) {
+	s.historyTotals.add(sp)
+	if sp.TotalWin > s.largestWin {
+		s.largestWin = sp.TotalWin
+	}
+	day := sp.CreatedAt.UTC().Format("2006-01-02")
+	totals, ok := s.days[day]
+	if !ok {
+		totals = &historyTotals{}
+		s.days[day] = totals
+	}
+	totals.add(sp)
+}
+
+// writeHistoryStatement writes a PDF statement summarizing the export range with a daily breakdown
+func writeHistoryStatement(w io.Writer, e *historyexport.Export, username string, summary *historySummary, generatedAt time.Time) error {
+	doc := pdf.New()
+	doc.Title("Game History Statement")
+	doc.Gap()
+	doc.Line("Player: " + username)
+	doc.Line("Period: " + e.RangeStart.Format("2006-01-02") + " to " + e.RangeEnd.AddDate(0, 0, -1).Format("2006-01-02") + " (UTC)")
+	doc.Line("Generated: " + generatedAt.Format("2006-01-02 15:04 MST"))
+	doc.Gap()
+
+	doc.BoldLine("Summary")
+	doc.Line("Spins played: " + strconv.Itoa(summary.spins) + " (" + strconv.Itoa(summary.freeSpins) + " free spins)")
+	doc.Line("Total staked: " + formatAmount(summary.staked))
+	doc.Line("Total won: " + formatAmount(summary.won))
+	doc.Line("Net result: " + formatAmount(summary.won-summary.staked))
+	doc.Line("Largest win: " + formatAmount(summary.largestWin))
+	doc.Gap()
+
+	doc.BoldLine("Daily breakdown")
+	if len(summary.days) == 0 {
+		doc.Line("No spins were played in this period.")
+	} else {
+		doc.HeaderRow(historyStatementColumns, "Date", "Spins", "Staked", "Won", "Net")
+		days := make([]string, 0, len(summary.days))
+		for day := range summary.days {
+			days = append(days, day)
+		}
+		sort.Strings(days)
+		for _, day := range days {
+			t := summary.days[day]
+			doc.Row(historyStatementColumns, day, strconv.Itoa(t.spins), formatAmount(t.staked), formatAmount(t.won), formatAmount(t.won-t.staked))
+		}
+	}
+
+	_, err := doc.WriteTo(w)
+	return err
+}
+
+// formatAmount formats a currency amount with two decimals
+func formatAmount(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/admin"
+	"github.com/slotmachine/backend/domain/impersonation"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// maxImpersonationGrants caps one grant listing
+const maxImpersonationGrants = 100
+
+// ImpersonationService issues and checks read-only player impersonation tokens
+// Support staff use them to see exactly what a player sees through the player APIs.
+// Every grant and every request made with one is recorded.
+type ImpersonationService struct {
+	repo       impersonation.Repository
+	playerRepo player.Repository
+	tokenTTL   time.Duration
+	logger     *logger.Logger
+
+	now func() time.Time
+}
+
+// NewImpersonationService creates a new impersonation service
+func NewImpersonationService(cfg *config.Config, repo impersonation.Repository, playerRepo player.Repository, log *logger.Logger) *ImpersonationService {
+	return &ImpersonationService{
+		repo:       repo,
+		playerRepo: playerRepo,
+		tokenTTL:   time.Duration(cfg.AdminAuth.ImpersonationTokenMinutes) * time.Minute,
+		logger:     log,
+		now:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// IsImpersonationToken reports whether a bearer token is an impersonation token
+func IsImpersonationToken(token string) bool {
+	return len(token) > len(impersonation.TokenPrefix) && strings.HasPrefix(token, impersonation.TokenPrefix)
+}
+
+// hashImpersonationToken returns the SHA-256 hex digest impersonation tokens are stored under
+func hashImpersonationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Issue grants the admin a read-only token for the player's APIs and returns it with its grant
+func (s *ImpersonationService) Issue(ctx context.Context, by *admin.Admin, playerID uuid.UUID, reason, ipAddress string) (*impersonation.Grant, string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, "", impersonation.ErrReasonRequired
+	}
+	if _, err := s.playerRepo.GetByID(ctx, playerID); err != nil {
+		return nil, "", player.ErrPlayerNotFound
+	}
+
+	secret, err := generateSessionToken()
+	if err != nil {
+		return nil, "", err
+	}
+	token := impersonation.TokenPrefix + secret
+
+	now := s.now()
+	grant := &impersonation.Grant{
+		ID:            uuid.New(),
+		AdminID:       by.ID,
+		AdminUsername: by.Username,
+		PlayerID:      playerID,
+		Reason:        reason,
+		TokenHash:     hashImpersonationToken(token),
+		IPAddress:     ipAddress,
+		ExpiresAt:     now.Add(s.tokenTTL),
+		CreatedAt:     now,
+	}
+	if err := s.repo.CreateGrant(ctx, grant); err != nil {
+		return nil, "", err
+	}
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("grant_id", grant.ID.String()).
+		Str("admin", by.Username).
+		Str("player_id", playerID.String()).
+		Time("expires_at", grant.ExpiresAt).
+		Msg("Impersonation token issued")
+
+	return grant, token, nil
+}
+
+// Validate returns the active grant a token was issued for, or ErrTokenInvalid
+func (s *ImpersonationService) Validate(ctx context.Context, token string) (*impersonation.Grant, error) {
+	if !IsImpersonationToken(token) {
+		return nil, impersonation.ErrTokenInvalid
+	}
+
+	grant, err := s.repo.GetGrantByTokenHash(ctx, hashImpersonationToken(token))
+	if err != nil {
+		if errors.Is(err, impersonation.ErrGrantNotFound) {
+			return nil, impersonation.ErrTokenInvalid
+		}
+		return nil, err
+	}
+	if !grant.Active(s.now()) {
+		return nil, impersonation.ErrTokenInvalid
+	}
+	return grant, nil
+}
+
+// Revoke ends a grant before it expires
+func (s *ImpersonationService) Revoke(ctx context.Context, grantID, revokedBy uuid.UUID) (*impersonation.Grant, error) {
+	if err := s.repo.RevokeGrant(ctx, grantID, revokedBy, s.now()); err != nil {
+		return nil, err
+	}
+
+	grant, err := s.repo.GetGrant(ctx, grantID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("grant_id", grantID.String()).
+		Str("revoked_by", revokedBy.String()).
+		Msg("Impersonation token revoked")
+
+	return grant, nil
+}
+
+// RecordAccess audits one request made with a grant
+func (s *ImpersonationService) RecordAccess(ctx context.Context, access *impersonation.Access) error {
+	if access.ID == uuid.Nil {
+		access.ID = uuid.New()
+	}
+	if access.CreatedAt.IsZero() {
+		access.CreatedAt = s.now()
+	}
+	return s.repo.RecordAccess(ctx, access)
+}
+
+// ListGrants returns grants matching the filters, newest first
+func (s *ImpersonationService) ListGrants(ctx context.Context, filters impersonation.ListFilters) ([]*impersonation.Grant, error) {
+	if filters.Limit <= 0 || filters.Limit > maxImpersonationGrants {
+		filters.Limit = maxImpersonationGrants
+	}
+	return s.repo.ListGrants(ctx, filters)
+}
+
+// ListAccesses returns the requests made with a grant, oldest first
+func (s *ImpersonationService) ListAccesses(ctx context.Context, grantID uuid.UUID) ([]*impersonation.Access, error) {
+	if _, err := s.repo.GetGrant(ctx, grantID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListAccesses(ctx, grantID)
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/admin"
+	"github.com/slotmachine/backend/domain/impersonation"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubImpersonationRepository keeps grants and accesses in memory
+type stubImpersonationRepository struct {
+	grants   map[uuid.UUID]*impersonation.Grant
+	accesses []*impersonation.Access
+}
+
+func (r *stubImpersonationRepository) CreateGrant(ctx context.Context, grant *impersonation.Grant) error {
+	r.grants[grant.ID] = grant
+	return nil
+}
+
+func (r *stubImpersonationRepository) GetGrant(ctx context.Context, id uuid.UUID) (*impersonation.Grant, error) {
+	if g, ok := r.grants[id]; ok {
+		return g, nil
+	}
+	return nil, impersonation.ErrGrantNotFound
+}
+
+func (r *stubImpersonationRepository) GetGrantByTokenHash(ctx context.Context, tokenHash string) (*impersonation.Grant, error) {
+	for _, g := range r.grants {
+		if g.TokenHash == tokenHash {
+			return g, nil
+		}
+	}
+	return nil, impersonation.ErrGrantNotFound
+}
+
+func (r *stubImpersonationRepository) RevokeGrant(ctx context.Context, id, revokedBy uuid.UUID, at time.Time) error {
+	if g, ok := r.grants[id]; ok && g.RevokedAt == nil {
+		g.RevokedAt = &at
+		g.RevokedBy = &revokedBy
+	}
+	return nil
+}
+
+func (r *stubImpersonationRepository) ListGrants(ctx context.Context, filters impersonation.ListFilters) ([]*impersonation.Grant, error) {
+	var grants []*impersonation.Grant
+	for _, g := range r.grants {
+		if filters.PlayerID == nil || g.PlayerID == *filters.PlayerID {
+			grants = append(grants, g)
+		}
+	}
+	return grants, nil
+}
+
+func (r *stubImpersonationRepository) RecordAccess(ctx context.Context, access *impersonation.Access) error {
+	r.accesses = append(r.accesses, access)
+	return nil
+}
+
+func (r *stubImpersonationRepository) ListAccesses(ctx context.Context, grantID uuid.UUID) ([]*impersonation.Access, error) {
+	var accesses []*impersonation.Access
+	for _, a := range r.accesses {
+		if a.GrantID == grantID {
+			accesses = append(accesses, a)
+		}
+	}
+	return accesses, nil
+}
+
+func newTestImpersonationService(now *time.Time) (*ImpersonationService, *stubImpersonationRepository, *MockPlayerRepository) {
+	repo := &stubImpersonationRepository{grants: map[uuid.UUID]*impersonation.Grant{}}
+	playerRepo := new(MockPlayerRepository)
+	cfg := &config.Config{AdminAuth: config.AdminAuthConfig{ImpersonationTokenMinutes: 30}}
+	s := NewImpersonationService(cfg, repo, playerRepo, logger.New("error", "json"))
+	s.now = func() time.Time { return *now }
+	return s, repo, playerRepo
+}
+
+func TestImpersonationService(t *testing.T) {
+	ctx := context.Background()
+	supporter := &admin.Admin{ID: uuid.New(), Username: "support", Role: admin.RoleAdmin}
+
+	t.Run("issued token validates until it expires", func(t *testing.T) {
+		now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		s, repo, playerRepo := newTestImpersonationService(&now)
+		playerID := uuid.New()
+		playerRepo.On("GetByID", mock.Anything, playerID).Return(&player.Player{ID: playerID}, nil)
+
+		grant, token, err := s.Issue(ctx, supporter, playerID, "  ticket 4711 ", "10.0.0.1")
+		require.NoError(t, err)
+		assert.True(t, IsImpersonationToken(token))
+		assert.Equal(t, "ticket 4711", grant.Reason)
+		assert.Equal(t, now.Add(30*time.Minute), grant.ExpiresAt)
+		assert.NotContains(t, repo.grants[grant.ID].TokenHash, token, "only the hash is stored")
+
+		validated, err := s.Validate(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, grant.ID, validated.ID)
+
+		now = now.Add(31 * time.Minute)
+		_, err = s.Validate(ctx, token)
+		assert.ErrorIs(t, err, impersonation.ErrTokenInvalid)
+	})
+
+	t.Run("revoked token no longer validates", func(t *testing.T) {
+		now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		s, _, playerRepo := newTestImpersonationService(&now)
+		playerID := uuid.New()
+		playerRepo.On("GetByID", mock.Anything, playerID).Return(&player.Player{ID: playerID}, nil)
+
+		grant, token, err := s.Issue(ctx, supporter, playerID, "ticket", "")
+		require.NoError(t, err)
+
+		revoked, err := s.Revoke(ctx, grant.ID, supporter.ID)
+		require.NoError(t, err)
+		require.NotNil(t, revoked.RevokedAt)
+
+		_, err = s.Validate(ctx, token)
+		assert.ErrorIs(t, err, impersonation.ErrTokenInvalid)
+	})
+
+	t.Run("issue requires a reason and an existing player", func(t *testing.T) {
+		now := time.Now().UTC()
+		s, _, playerRepo := newTestImpersonationService(&now)
+		missing := uuid.New()
+		playerRepo.On("GetByID", mock.Anything, missing).Return(nil, player.ErrPlayerNotFound)
+
+		_, _, err := s.Issue(ctx, supporter, uuid.New(), " ", "")
+		assert.ErrorIs(t, err, impersonation.ErrReasonRequired)
+
+		_, _, err = s.Issue(ctx, supporter, missing, "ticket", "")
+		assert.ErrorIs(t, err, player.ErrPlayerNotFound)
+	})
+
+	t.Run("unknown and non-impersonation tokens are invalid", func(t *testing.T) {
+		now := time.Now().UTC()
+		s, _, _ := newTestImpersonationService(&now)
+
+		_, err := s.Validate(ctx, impersonation.TokenPrefix+"unknown")
+		assert.ErrorIs(t, err, impersonation.ErrTokenInvalid)
+		_, err = s.Validate(ctx, "a-player-session-token")
+		assert.ErrorIs(t, err, impersonation.ErrTokenInvalid)
+	})
+
+	t.Run("accesses are recorded against their grant", func(t *testing.T) {
+		now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		s, _, playerRepo := newTestImpersonationService(&now)
+		playerID := uuid.New()
+		playerRepo.On("GetByID", mock.Anything, playerID).Return(&player.Player{ID: playerID}, nil)
+
+		grant, _, err := s.Issue(ctx, supporter, playerID, "ticket", "")
+		require.NoError(t, err)
+		require.NoError(t, s.RecordAccess(ctx, &impersonation.Access{
+			GrantID: grant.ID, AdminID: supporter.ID, PlayerID: playerID, Method: "GET", Path: "/v1/player/balance", Status: 200,
+		}))
+
+		accesses, err := s.ListAccesses(ctx, grant.ID)
+		require.NoError(t, err)
+		require.Len(t, accesses, 1)
+		assert.Equal(t, now, accesses[0].CreatedAt)
+
+		_, err = s.ListAccesses(ctx, uuid.New())
+		assert.ErrorIs(t, err, impersonation.ErrGrantNotFound)
+	})
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/jurisdiction"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// JurisdictionService implements jurisdiction.Service
+type JurisdictionService struct {
+	repo        jurisdiction.Repository
+	defaultCode string
+	logger      *logger.Logger
+
+	realityChecks atomic.Pointer[map[string]int] // Reality check minutes by jurisdiction code, overriding stored rules
+}
+
+// NewJurisdictionService creates a new jurisdiction service
+func NewJurisdictionService(
+	repo jurisdiction.Repository,
+	cfg *config.Config,
+	log *logger.Logger,
+) *JurisdictionService {
+	return &JurisdictionService{
+		repo:        repo,
+		defaultCode: jurisdiction.NormalizeCode(cfg.Jurisdiction.Default),
+		logger:      log,
+	}
+}
+
+// ForPlayer resolves the player's jurisdiction, falling back to the configured default
+func (s *JurisdictionService) ForPlayer(ctx context.Context, jurisdictionCode *string) (*jurisdiction.Jurisdiction, error) {
+	code := s.defaultCode
+	if jurisdictionCode != nil && *jurisdictionCode != "" {
+		code = *jurisdictionCode
+	}
+	if code == "" {
+		return nil, nil
+	}
+
+	j, err := s.repo.GetByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, jurisdiction.ErrJurisdictionNotFound) {
+			s.logger.Warn().Str("jurisdiction", code).Msg("Jurisdiction not configured, no restrictions applied")
+			return nil, nil
+		}
+		return nil, err
+	}
+	if minutes, ok := s.realityCheckOverride(j.Code); ok {
+		overridden := *j
+		overridden.RealityCheckMinutes = minutes
+		return &overridden, nil
+	}
+	return j, nil
+}
+
+// SetRealityCheckOverrides replaces the reality check intervals overriding stored jurisdiction rules
+// A nil or empty map restores the stored intervals.
+func (s *JurisdictionService) SetRealityCheckOverrides(minutes map[string]int) {
+	normalized := make(map[string]int, len(minutes))
+	for code, m := range minutes {
+		normalized[jurisdiction.NormalizeCode(code)] = m
+	}
+	s.realityChecks.Store(&normalized)
+}
+
+func (s *JurisdictionService) realityCheckOverride(code string) (int, bool) {
+	overrides := s.realityChecks.Load()
+	if overrides == nil {
+		return 0, false
+	}
+	minutes, ok := (*overrides)[code]
+	return minutes, ok
+}
+
+// Create validates and stores a new jurisdiction
+func (s *JurisdictionService) Create(ctx context.Context, j *jurisdiction.Jurisdiction) error {
+	j.Code = jurisdiction.NormalizeCode(j.Code)
+	if err := j.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := s.repo.GetByCode(ctx, j.Code); err == nil {
+		return jurisdiction.ErrJurisdictionExists
+	} else if !errors.Is(err, jurisdiction.ErrJurisdictionNotFound) {
+		return err
+	}
+
+	now := time.Now().UTC()
+	j.CreatedAt = now
+	j.UpdatedAt = now
+	return s.repo.Create(ctx, j)
+}
+
+// Get retrieves a jurisdiction by code
+func (s *JurisdictionService) Get(ctx context.Context, code string) (*jurisdiction.Jurisdiction, error) {
+	return s.repo.GetByCode(ctx, jurisdiction.NormalizeCode(code))
+}
+
+// List lists all jurisdictions
+func (s *JurisdictionService) List(ctx context.Context) ([]*jurisdiction.Jurisdiction, error) {
+	return s.repo.List(ctx)
+}
+
+// Update validates and saves a jurisdiction's rules
+func (s *JurisdictionService) Update(ctx context.Context, j *jurisdiction.Jurisdiction) error {
+	j.Code = jurisdiction.NormalizeCode(j.Code)
+	if err := j.Validate(); err != nil {
+		return err
+	}
+	return s.repo.Update(ctx, j)
+}
+
+// Delete deletes a jurisdiction; its players fall back to the default
+func (s *JurisdictionService) Delete(ctx context.Context, code string) error {
+	return s.repo.Delete(ctx, jurisdiction.NormalizeCode(code))
+}
+
+// SetPlayerJurisdiction attaches a player to a jurisdiction (nil detaches)
+func (s *JurisdictionService) SetPlayerJurisdiction(ctx context.Context, playerID uuid.UUID, code *string) error {
+	if code != nil {
+		normalized := jurisdiction.NormalizeCode(*code)
+		if _, err := s.repo.GetByCode(ctx, normalized); err != nil {
+			return err
+		}
+		code = &normalized
+	}
+
+	if err := s.repo.SetPlayerJurisdiction(ctx, playerID, code); err != nil {
+		return err
+	}
+
+	s.logger.Info().
+		Str("player_id", playerID.String()).
+		Interface("jurisdiction", code).
+		Msg("Player jurisdiction updated")
+	return nil
+}
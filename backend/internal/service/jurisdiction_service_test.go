@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/jurisdiction"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockJurisdictionRepository is a mock implementation of jurisdiction.Repository
+type MockJurisdictionRepository struct {
+	mock.Mock
+}
+
+func (m *MockJurisdictionRepository) Create(ctx context.Context, j *jurisdiction.Jurisdiction) error {
+	return m.Called(ctx, j).Error(0)
+}
+
+func (m *MockJurisdictionRepository) GetByCode(ctx context.Context, code string) (*jurisdiction.Jurisdiction, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*jurisdiction.Jurisdiction), args.Error(1)
+}
+
+func (m *MockJurisdictionRepository) List(ctx context.Context) ([]*jurisdiction.Jurisdiction, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*jurisdiction.Jurisdiction), args.Error(1)
+}
+
+func (m *MockJurisdictionRepository) Update(ctx context.Context, j *jurisdiction.Jurisdiction) error {
+	return m.Called(ctx, j).Error(0)
+}
+
+func (m *MockJurisdictionRepository) Delete(ctx context.Context, code string) error {
+	return m.Called(ctx, code).Error(0)
+}
+
+func (m *MockJurisdictionRepository) SetPlayerJurisdiction(ctx context.Context, playerID uuid.UUID, code *string) error {
+	return m.Called(ctx, playerID, code).Error(0)
+}
+
+// stubJurisdictionResolver resolves every player to the same jurisdiction
+type stubJurisdictionResolver struct {
+	j *jurisdiction.Jurisdiction
+}
+
+func (s stubJurisdictionResolver) ForPlayer(ctx context.Context, code *string) (*jurisdiction.Jurisdiction, error) {
+	return s.j, nil
+}
+
+func setupJurisdictionService(defaultCode string) (*JurisdictionService, *MockJurisdictionRepository) {
+	repo := new(MockJurisdictionRepository)
+	cfg := &config.Config{Jurisdiction: config.JurisdictionConfig{Default: defaultCode}}
+	return NewJurisdictionService(repo, cfg, logger.New("error", "json")), repo
+}
+
+func TestJurisdictionService_ForPlayer(t *testing.T) {
+	ctx := context.Background()
+	ukgc := &jurisdiction.Jurisdiction{Code: "UKGC", Name: "UK Gambling Commission", MaxBet: 5}
+	mga := &jurisdiction.Jurisdiction{Code: "MGA", Name: "Malta Gaming Authority"}
+
+	t.Run("should return nil without player code or default", func(t *testing.T) {
+		svc, repo := setupJurisdictionService("")
+
+		j, err := svc.ForPlayer(ctx, nil)
+		require.NoError(t, err)
+		assert.Nil(t, j)
+		repo.AssertNotCalled(t, "GetByCode", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should fall back to the default", func(t *testing.T) {
+		svc, repo := setupJurisdictionService("ukgc")
+		repo.On("GetByCode", ctx, "UKGC").Return(ukgc, nil)
+
+		j, err := svc.ForPlayer(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "UKGC", j.Code)
+	})
+
+	t.Run("should apply runtime reality check overrides without changing stored rules", func(t *testing.T) {
+		svc, repo := setupJurisdictionService("ukgc")
+		stored := &jurisdiction.Jurisdiction{Code: "UKGC", Name: "UK Gambling Commission", RealityCheckMinutes: 60}
+		repo.On("GetByCode", ctx, "UKGC").Return(stored, nil)
+
+		svc.SetRealityCheckOverrides(map[string]int{"ukgc": 30})
+		j, err := svc.ForPlayer(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 30, j.RealityCheckMinutes)
+		assert.Equal(t, 60, stored.RealityCheckMinutes)
+
+		svc.SetRealityCheckOverrides(nil)
+		j, err = svc.ForPlayer(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 60, j.RealityCheckMinutes)
+	})
+
+	t.Run("should prefer the player's code", func(t *testing.T) {
+		svc, repo := setupJurisdictionService("UKGC")
+		repo.On("GetByCode", ctx, "MGA").Return(mga, nil)
+
+		code := "MGA"
+		j, err := svc.ForPlayer(ctx, &code)
+		require.NoError(t, err)
+		assert.Equal(t, "MGA", j.Code)
+	})
+
+	t.Run("should not restrict when the default is not configured", func(t *testing.T) {
+		svc, repo := setupJurisdictionService("XX")
+		repo.On("GetByCode", ctx, "XX").Return(nil, jurisdiction.ErrJurisdictionNotFound)
+
+		j, err := svc.ForPlayer(ctx, nil)
+		require.NoError(t, err)
+		assert.Nil(t, j)
+	})
+}
+
+func TestJurisdictionService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should normalize code and create", func(t *testing.T) {
+		svc, repo := setupJurisdictionService("")
+		repo.On("GetByCode", ctx, "UKGC").Return(nil, jurisdiction.ErrJurisdictionNotFound)
+		repo.On("Create", ctx, mock.AnythingOfType("*jurisdiction.Jurisdiction")).Return(nil)
+
+		j := &jurisdiction.Jurisdiction{Code: " ukgc ", Name: "UK Gambling Commission", MaxBet: 5}
+		require.NoError(t, svc.Create(ctx, j))
+		assert.Equal(t, "UKGC", j.Code)
+		assert.False(t, j.CreatedAt.IsZero())
+	})
+
+	t.Run("should reject duplicate code", func(t *testing.T) {
+		svc, repo := setupJurisdictionService("")
+		repo.On("GetByCode", ctx, "MGA").Return(&jurisdiction.Jurisdiction{Code: "MGA"}, nil)
+
+		err := svc.Create(ctx, &jurisdiction.Jurisdiction{Code: "MGA", Name: "Malta"})
+		assert.ErrorIs(t, err, jurisdiction.ErrJurisdictionExists)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should require disclosed RTP when disclosure is on", func(t *testing.T) {
+		svc, _ := setupJurisdictionService("")
+
+		err := svc.Create(ctx, &jurisdiction.Jurisdiction{Code: "UKGC", Name: "UK", RTPDisclosure: true})
+		assert.ErrorIs(t, err, jurisdiction.ErrInvalidJurisdiction)
+	})
+}
+
+func TestJurisdictionService_SetPlayerJurisdiction(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+
+	t.Run("should reject unknown jurisdiction", func(t *testing.T) {
+		svc, repo := setupJurisdictionService("")
+		repo.On("GetByCode", ctx, "XX").Return(nil, jurisdiction.ErrJurisdictionNotFound)
+
+		code := "xx"
+		err := svc.SetPlayerJurisdiction(ctx, playerID, &code)
+		assert.ErrorIs(t, err, jurisdiction.ErrJurisdictionNotFound)
+		repo.AssertNotCalled(t, "SetPlayerJurisdiction", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should detach with nil code", func(t *testing.T) {
+		svc, repo := setupJurisdictionService("")
+		repo.On("SetPlayerJurisdiction", ctx, playerID, (*string)(nil)).Return(nil)
+
+		require.NoError(t, svc.SetPlayerJurisdiction(ctx, playerID, nil))
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestSpinService_CheckJurisdiction(t *testing.T) {
+	ctx := context.Background()
+	p := &player.Player{ID: uuid.New()}
+	clk := clock.NewFake(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+	freshSession := &session.GameSession{CreatedAt: clk.Now()}
+	ukgc := &jurisdiction.Jurisdiction{Code: "UKGC", MaxBet: 5, MaxWin: 1000, RealityCheckMinutes: 60}
+
+	svc := &SpinService{jurisdictions: stubJurisdictionResolver{j: ukgc}, clock: clk}
+
+	t.Run("should allow stakes within the limit", func(t *testing.T) {
+		j, err := svc.checkJurisdiction(ctx, p, freshSession, 5)
+		require.NoError(t, err)
+		assert.Equal(t, ukgc, j)
+	})
+
+	t.Run("should reject stakes above the limit", func(t *testing.T) {
+		_, err := svc.checkJurisdiction(ctx, p, freshSession, 5.01)
+		assert.ErrorIs(t, err, jurisdiction.ErrBetAboveLimit)
+	})
+
+	t.Run("should reject autoplay", func(t *testing.T) {
+		_, err := svc.checkJurisdiction(spin.WithAutoplay(ctx), p, freshSession, 1)
+		assert.ErrorIs(t, err, jurisdiction.ErrAutoplayNotAllowed)
+	})
+
+	t.Run("should require a reality check once the interval elapses", func(t *testing.T) {
+		started := &session.GameSession{CreatedAt: clk.Now()}
+		clk.Advance(61 * time.Minute)
+		_, err := svc.checkJurisdiction(ctx, p, started, 1)
+		assert.ErrorIs(t, err, jurisdiction.ErrRealityCheckDue)
+
+		acked := clk.Now().Add(-time.Minute)
+		started.RealityCheckAt = &acked
+		_, err = svc.checkJurisdiction(ctx, p, started, 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should skip checks without a resolver", func(t *testing.T) {
+		j, err := (&SpinService{}).checkJurisdiction(ctx, p, freshSession, 1000)
+		require.NoError(t, err)
+		assert.Nil(t, j)
+	})
+}
@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/signingkey"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/crypto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/pkg/util"
+)
+
+// JWTKeyring signs and verifies admin tokens with rotating ES256 keys shared through
+// the database. New keys are published in the JWKS before they sign, and superseded
+// keys stay published until the last token they signed has expired, so rotation never
+// invalidates live sessions
+type JWTKeyring struct {
+	repo             signingkey.Repository
+	encryptor        *crypto.AESEncryptor
+	rotationInterval time.Duration
+	refreshInterval  time.Duration
+	tokenTTL         time.Duration
+	legacySecret     string // empty when legacy HS256 tokens are rejected
+	logger           *logger.Logger
+
+	mu      sync.RWMutex
+	keys    []*signingkey.SigningKey // unexpired keys, oldest activation first
+	public  map[string]*ecdsa.PublicKey
+	private map[string]*ecdsa.PrivateKey // decrypted lazily, only for keys that sign
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewJWTKeyring creates a new JWT keyring
+func NewJWTKeyring(cfg *config.Config, repo signingkey.Repository, log *logger.Logger) (*JWTKeyring, error) {
+	encryptor, err := crypto.NewAESEncryptor(cfg.JWT.KeyEncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT key encryption key: %w", err)
+	}
+
+	k := &JWTKeyring{
+		repo:             repo,
+		encryptor:        encryptor,
+		rotationInterval: time.Duration(cfg.JWT.KeyRotationHours) * time.Hour,
+		refreshInterval:  time.Duration(cfg.JWT.KeyRefreshSeconds) * time.Second,
+		tokenTTL:         time.Duration(cfg.JWT.ExpirationHours) * time.Hour,
+		logger:           log,
+		public:           make(map[string]*ecdsa.PublicKey),
+		private:          make(map[string]*ecdsa.PrivateKey),
+		stop:             make(chan struct{}),
+		done:             make(chan struct{}),
+	}
+	if cfg.JWT.AcceptLegacyHS256 {
+		k.legacySecret = cfg.JWT.Secret
+	}
+	return k, nil
+}
+
+// Start loads the key set, creating the first key when there is none, then refreshes
+// and rotates in the background; a zero refresh interval disables the background loop
+func (k *JWTKeyring) Start() {
+	ctx := context.Background()
+	if err := k.Maintain(ctx, time.Now()); err != nil {
+		k.logger.Error().Err(err).Msg("Failed to load JWT signing keys")
+	}
+
+	if k.refreshInterval <= 0 {
+		close(k.done)
+		k.logger.Info().Msg("JWT keyring refresh disabled")
+		return
+	}
+
+	go k.run()
+	k.logger.Info().
+		Dur("refresh_interval", k.refreshInterval).
+		Dur("rotation_interval", k.rotationInterval).
+		Msg("JWT keyring started")
+}
+
+// Stop stops the keyring and waits for a running pass to finish
+func (k *JWTKeyring) Stop() {
+	k.stopOnce.Do(func() { close(k.stop) })
+	<-k.done
+}
+
+func (k *JWTKeyring) run() {
+	defer close(k.done)
+
+	ticker := time.NewTicker(k.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-k.stop:
+			return
+		case now := <-ticker.C:
+			if err := k.Maintain(context.Background(), now); err != nil {
+				k.logger.Error().Err(err).Msg("JWT keyring maintenance failed")
+			}
+		}
+	}
+}
+
+// Maintain reloads the key set, rotates when the newest key is older than the rotation
+// interval (or there is no key at all) and deletes expired keys
+func (k *JWTKeyring) Maintain(ctx context.Context, now time.Time) error {
+	if err := k.Refresh(ctx, now); err != nil {
+		return err
+	}
+
+	if k.rotationDue(now) {
+		if _, err := k.Rotate(ctx, now); err != nil {
+			return err
+		}
+	}
+
+	deleted, err := k.repo.DeleteExpired(ctx, now)
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		k.logger.Info().Int64("deleted", deleted).Msg("Deleted expired JWT signing keys")
+	}
+	return nil
+}
+
+func (k *JWTKeyring) rotationDue(now time.Time) bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if len(k.keys) == 0 {
+		return true
+	}
+	newest := k.keys[len(k.keys)-1]
+	return k.rotationInterval > 0 && !now.Before(newest.ActivatesAt.Add(k.rotationInterval))
+}
+
+// Refresh reloads the unexpired keys from the database
+func (k *JWTKeyring) Refresh(ctx context.Context, now time.Time) error {
+	keys, err := k.repo.ListValid(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	public := make(map[string]*ecdsa.PublicKey, len(keys))
+	for _, key := range keys {
+		pub, err := parsePublicKey(key.PublicKey)
+		if err != nil {
+			k.logger.Error().Err(err).Str("kid", key.KID).Msg("Skipping unreadable JWT signing key")
+			continue
+		}
+		public[key.KID] = pub
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys = keys
+	k.public = public
+	for kid := range k.private {
+		if _, ok := public[kid]; !ok {
+			delete(k.private, kid)
+		}
+	}
+	return nil
+}
+
+// Rotate creates a new signing key and retires the current ones. The new key activates
+// after two refresh intervals so every instance publishes it before it signs; when no
+// key is active yet it activates immediately
+func (k *JWTKeyring) Rotate(ctx context.Context, now time.Time) (*signingkey.SigningKey, error) {
+	activatesAt := now
+	if _, _, err := k.signer(now); err == nil {
+		activatesAt = now.Add(2 * k.refreshInterval)
+	}
+
+	key, err := k.generateKey(now, activatesAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.repo.Create(ctx, key); err != nil {
+		return nil, err
+	}
+
+	retired, err := k.repo.RetireBefore(ctx, activatesAt, activatesAt.Add(k.tokenTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	k.logger.Info().
+		Str("kid", key.KID).
+		Time("activates_at", activatesAt).
+		Int64("retired", retired).
+		Msg("Rotated JWT signing key")
+
+	return key, k.Refresh(ctx, now)
+}
+
+func (k *JWTKeyring) generateKey(now, activatesAt time.Time) (*signingkey.SigningKey, error) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	privDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signing key: %w", err)
+	}
+	encrypted, err := k.encryptor.Encrypt(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER})))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt signing key: %w", err)
+	}
+
+	pubDER, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode public key: %w", err)
+	}
+
+	kid := make([]byte, 8)
+	if _, err := rand.Read(kid); err != nil {
+		return nil, fmt.Errorf("failed to generate key id: %w", err)
+	}
+
+	return &signingkey.SigningKey{
+		ID:          uuid.New(),
+		KID:         hex.EncodeToString(kid),
+		Algorithm:   signingkey.AlgorithmES256,
+		PrivateKey:  encrypted,
+		PublicKey:   string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})),
+		CreatedAt:   now,
+		ActivatesAt: activatesAt,
+	}, nil
+}
+
+// signer returns the newest key active at now with its decrypted private key
+func (k *JWTKeyring) signer(now time.Time) (string, *ecdsa.PrivateKey, error) {
+	k.mu.RLock()
+	var active *signingkey.SigningKey
+	for i := len(k.keys) - 1; i >= 0; i-- {
+		if k.keys[i].IsActive(now) {
+			active = k.keys[i]
+			break
+		}
+	}
+	var priv *ecdsa.PrivateKey
+	if active != nil {
+		priv = k.private[active.KID]
+	}
+	k.mu.RUnlock()
+
+	if active == nil {
+		return "", nil, signingkey.ErrNoActiveKey
+	}
+	if priv != nil {
+		return active.KID, priv, nil
+	}
+
+	plain, err := k.encryptor.Decrypt(active.PrivateKey)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to decrypt signing key %s: %w", active.KID, err)
+	}
+	priv, err = parsePrivateKey(plain)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parse signing key %s: %w", active.KID, err)
+	}
+
+	k.mu.Lock()
+	k.private[active.KID] = priv
+	k.mu.Unlock()
+	return active.KID, priv, nil
+}
+
+// Sign signs claims with the active key. Before any key is loaded, tokens fall back to
+// HS256 when legacy tokens are accepted
+func (k *JWTKeyring) Sign(claims *util.Claims) (string, error) {
+	kid, priv, err := k.signer(time.Now())
+	if err != nil {
+		if k.legacySecret == "" {
+			return "", err
+		}
+		k.logger.Warn().Err(err).Msg("No JWT signing key available, issuing legacy HS256 token")
+		return util.GenerateJWTWithSecret(claims, k.legacySecret)
+	}
+	return util.GenerateJWTWithKey(claims, kid, priv)
+}
+
+// Validate validates a token against the published keys
+func (k *JWTKeyring) Validate(token string) (*util.Claims, error) {
+	return util.ValidateJWTWithKeys(token, k.lookup, k.legacySecret)
+}
+
+func (k *JWTKeyring) lookup(kid string) (*ecdsa.PublicKey, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	pub, ok := k.public[kid]
+	if !ok {
+		return nil, signingkey.ErrUnknownKeyID
+	}
+	return pub, nil
+}
+
+// JWKS returns the published public keys, including those not yet signing
+func (k *JWTKeyring) JWKS() util.JWKSet {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	set := util.JWKSet{Keys: make([]util.JWK, 0, len(k.keys))}
+	for _, key := range k.keys {
+		if pub, ok := k.public[key.KID]; ok {
+			set.Keys = append(set.Keys, util.NewES256JWK(key.KID, pub))
+		}
+	}
+	return set
+}
+
+// Keys returns the unexpired keys, oldest activation first
+func (k *JWTKeyring) Keys() []*signingkey.SigningKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return append([]*signingkey.SigningKey(nil), k.keys...)
+}
+
+// CacheMaxAge is how long verifiers may cache the JWKS; pre-publication guarantees a
+// new key is listed for at least this long before it signs
+func (k *JWTKeyring) CacheMaxAge() time.Duration {
+	return k.refreshInterval
+}
+
+func parsePrivateKey(pemData string) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(pemData))
+	if block == nil {
+		return nil, fmt.Errorf("invalid PEM")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	priv, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("not an ECDSA key")
+	}
+	return priv, nil
+}
+
+func parsePublicKey(pemData string) (*ecdsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(pemData))
+	if block == nil {
+		return nil, fmt.Errorf("invalid PEM")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	pub, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("not an ECDSA key")
+	}
+	return pub, nil
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/slotmachine/backend/domain/signingkey"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+func setupJWTKeyring(t *testing.T, acceptLegacy bool) *JWTKeyring {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: gormlogger.Default.LogMode(gormlogger.Silent)})
+	require.NoError(t, err)
+	require.NoError(t, db.Exec(`CREATE TABLE jwt_signing_keys (
+		id TEXT PRIMARY KEY,
+		kid TEXT NOT NULL UNIQUE,
+		algorithm TEXT NOT NULL,
+		private_key TEXT NOT NULL,
+		public_key TEXT NOT NULL,
+		created_at DATETIME NOT NULL,
+		activates_at DATETIME NOT NULL,
+		retired_at DATETIME,
+		expires_at DATETIME
+	)`).Error)
+
+	cfg := &config.Config{JWT: config.JWTConfig{
+		Secret:            "legacy-secret",
+		ExpirationHours:   24,
+		KeyEncryptionKey:  "jwt-signing-key-test-key-32bytes",
+		KeyRotationHours:  720,
+		KeyRefreshSeconds: 60,
+		AcceptLegacyHS256: acceptLegacy,
+	}}
+	k, err := NewJWTKeyring(cfg, repository.NewSigningKeyGormRepository(db), logger.New("error", "json"))
+	require.NoError(t, err)
+	return k
+}
+
+func tokenKID(t *testing.T, token string) string {
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &util.Claims{})
+	require.NoError(t, err)
+	kid, _ := parsed.Header["kid"].(string)
+	return kid
+}
+
+func TestJWTKeyring_FirstKeyActivatesImmediately(t *testing.T) {
+	k := setupJWTKeyring(t, false)
+	require.NoError(t, k.Maintain(context.Background(), time.Now()))
+
+	keys := k.Keys()
+	require.Len(t, keys, 1)
+
+	token, err := k.Sign(util.NewClaims("admin-1", "alice", nil, 1))
+	require.NoError(t, err)
+	assert.Equal(t, keys[0].KID, tokenKID(t, token))
+
+	claims, err := k.Validate(token)
+	require.NoError(t, err)
+	assert.Equal(t, "admin-1", claims.UserID)
+
+	jwks := k.JWKS()
+	require.Len(t, jwks.Keys, 1)
+	assert.Equal(t, keys[0].KID, jwks.Keys[0].Kid)
+	assert.Equal(t, "P-256", jwks.Keys[0].Crv)
+}
+
+func TestJWTKeyring_RotationKeepsOldTokensValid(t *testing.T) {
+	ctx := context.Background()
+	k := setupJWTKeyring(t, false)
+	now := time.Now()
+	require.NoError(t, k.Maintain(ctx, now))
+	oldKID := k.Keys()[0].KID
+
+	oldToken, err := k.Sign(util.NewClaims("admin-1", "alice", nil, 1))
+	require.NoError(t, err)
+
+	next, err := k.Rotate(ctx, now)
+	require.NoError(t, err)
+	assert.True(t, next.ActivatesAt.After(now), "rotated key is published before it signs")
+	assert.Len(t, k.JWKS().Keys, 2)
+
+	// The current key keeps signing until the new one activates
+	kid, _, err := k.signer(now)
+	require.NoError(t, err)
+	assert.Equal(t, oldKID, kid)
+	kid, _, err = k.signer(next.ActivatesAt)
+	require.NoError(t, err)
+	assert.Equal(t, next.KID, kid)
+
+	_, err = k.Validate(oldToken)
+	assert.NoError(t, err)
+
+	// The old key is dropped once its last token has expired
+	require.NoError(t, k.Maintain(ctx, next.ActivatesAt.Add(25*time.Hour)))
+	keys := k.Keys()
+	require.Len(t, keys, 1)
+	assert.Equal(t, next.KID, keys[0].KID)
+	_, err = k.Validate(oldToken)
+	assert.Error(t, err)
+}
+
+func TestJWTKeyring_RejectsUnknownKID(t *testing.T) {
+	k := setupJWTKeyring(t, false)
+	other := setupJWTKeyring(t, false)
+	require.NoError(t, k.Maintain(context.Background(), time.Now()))
+	require.NoError(t, other.Maintain(context.Background(), time.Now()))
+
+	token, err := other.Sign(util.NewClaims("admin-1", "alice", nil, 1))
+	require.NoError(t, err)
+
+	_, err = k.Validate(token)
+	assert.ErrorIs(t, err, signingkey.ErrUnknownKeyID)
+}
+
+func TestJWTKeyring_LegacyHS256(t *testing.T) {
+	legacy, err := util.GenerateJWT("admin-1", "alice", nil, "legacy-secret", 1)
+	require.NoError(t, err)
+
+	accepting := setupJWTKeyring(t, true)
+	claims, err := accepting.Validate(legacy)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", claims.Username)
+
+	// Without a loaded key, signing falls back to HS256
+	token, err := accepting.Sign(util.NewClaims("admin-1", "alice", nil, 1))
+	require.NoError(t, err)
+	assert.Empty(t, tokenKID(t, token))
+
+	rejecting := setupJWTKeyring(t, false)
+	_, err = rejecting.Validate(legacy)
+	assert.Error(t, err)
+	_, err = rejecting.Sign(util.NewClaims("admin-1", "alice", nil, 1))
+	assert.ErrorIs(t, err, signingkey.ErrNoActiveKey)
+}
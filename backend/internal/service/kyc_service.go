@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/kyc"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// KYCService implements kyc.Service
+type KYCService struct {
+	repo       kyc.Repository
+	playerRepo player.Repository
+	provider   kyc.Provider // Optional: nil leaves verification to manual admin decisions
+	threshold  float64      // Cumulative wagering unverified players may reach; 0 disables the restriction
+	logger     *logger.Logger
+	now        func() time.Time
+}
+
+// NewKYCService creates a new KYC service
+func NewKYCService(
+	cfg *config.Config,
+	repo kyc.Repository,
+	playerRepo player.Repository,
+	provider kyc.Provider,
+	log *logger.Logger,
+) *KYCService {
+	return &KYCService{
+		repo:       repo,
+		playerRepo: playerRepo,
+		provider:   provider,
+		threshold:  cfg.KYC.SpinThreshold,
+		logger:     log,
+		now:        time.Now,
+	}
+}
+
+// CheckSpin blocks unverified players from wagering past the threshold
+func (s *KYCService) CheckSpin(ctx context.Context, p *player.Player, stake float64) error {
+	if s.threshold <= 0 || kyc.PlayerStatus(p) == kyc.StatusVerified {
+		return nil
+	}
+	if p.TotalWagered+stake > s.threshold {
+		return kyc.ErrVerificationRequired
+	}
+	return nil
+}
+
+// GetStatus returns a player's KYC state
+func (s *KYCService) GetStatus(ctx context.Context, playerID uuid.UUID) (*kyc.Verification, error) {
+	p, err := s.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.verification(p), nil
+}
+
+// StartVerification submits a player to the configured provider
+// Restarting replaces a pending or rejected verification.
+func (s *KYCService) StartVerification(ctx context.Context, playerID uuid.UUID) (*kyc.Session, error) {
+	if s.provider == nil {
+		return nil, kyc.ErrProviderNotConfigured
+	}
+	p, err := s.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if kyc.PlayerStatus(p) == kyc.StatusVerified {
+		return nil, kyc.ErrAlreadyVerified
+	}
+
+	session, err := s.provider.Start(ctx, p)
+	if err != nil {
+		s.logger.WithTraceContext(ctx).Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to start KYC verification")
+		return nil, err
+	}
+
+	name := s.provider.Name()
+	if err := s.repo.UpdatePlayerKYC(ctx, playerID, kyc.Update{
+		Status:    kyc.StatusPending,
+		Provider:  &name,
+		Reference: &session.Reference,
+	}); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Str("player_id", playerID.String()).
+		Str("provider", name).
+		Str("reference", session.Reference).
+		Msg("KYC verification started")
+	return session, nil
+}
+
+// Refresh polls the provider for the outcome of a player's pending verification
+func (s *KYCService) Refresh(ctx context.Context, playerID uuid.UUID) (*kyc.Verification, error) {
+	if s.provider == nil {
+		return nil, kyc.ErrProviderNotConfigured
+	}
+	p, err := s.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if kyc.PlayerStatus(p) != kyc.StatusPending || p.KYCReference == nil {
+		return nil, kyc.ErrNoVerification
+	}
+
+	status, err := s.provider.Status(ctx, *p.KYCReference)
+	if err != nil {
+		s.logger.WithTraceContext(ctx).Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to refresh KYC status")
+		return nil, err
+	}
+	if status == kyc.StatusPending {
+		return s.verification(p), nil
+	}
+	if err := s.record(ctx, p, status, p.KYCProvider, p.KYCReference, s.provider.Name()); err != nil {
+		return nil, err
+	}
+	return s.verification(p), nil
+}
+
+// SetStatus records a manual decision made by an admin
+func (s *KYCService) SetStatus(ctx context.Context, playerID uuid.UUID, status kyc.Status, actor string) (*kyc.Verification, error) {
+	if !status.Valid() {
+		return nil, kyc.ErrInvalidStatus
+	}
+	p, err := s.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.record(ctx, p, status, p.KYCProvider, p.KYCReference, actor); err != nil {
+		return nil, err
+	}
+	return s.verification(p), nil
+}
+
+// record saves a status change to the player, stamping the verification time
+func (s *KYCService) record(ctx context.Context, p *player.Player, status kyc.Status, provider, reference *string, decidedBy string) error {
+	var verifiedAt *time.Time
+	if status == kyc.StatusVerified {
+		now := s.now()
+		verifiedAt = &now
+	}
+	if err := s.repo.UpdatePlayerKYC(ctx, p.ID, kyc.Update{
+		Status:     status,
+		Provider:   provider,
+		Reference:  reference,
+		VerifiedAt: verifiedAt,
+	}); err != nil {
+		return err
+	}
+
+	s.logger.Info().
+		Str("player_id", p.ID.String()).
+		Str("from", string(kyc.PlayerStatus(p))).
+		Str("to", string(status)).
+		Str("decided_by", decidedBy).
+		Msg("KYC status changed")
+
+	p.KYCStatus = string(status)
+	p.KYCVerifiedAt = verifiedAt
+	return nil
+}
+
+// verification reports a player's KYC state and how much more they may wager unverified
+func (s *KYCService) verification(p *player.Player) *kyc.Verification {
+	v := &kyc.Verification{
+		PlayerID:   p.ID,
+		Status:     kyc.PlayerStatus(p),
+		Provider:   p.KYCProvider,
+		Reference:  p.KYCReference,
+		VerifiedAt: p.KYCVerifiedAt,
+	}
+	if s.threshold > 0 && v.Status != kyc.StatusVerified {
+		remaining := s.threshold - p.TotalWagered
+		if remaining < 0 {
+			remaining = 0
+		}
+		v.RemainingWager = &remaining
+		v.Required = remaining == 0
+	}
+	return v
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/kyc"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockKYCRepository is a mock implementation of kyc.Repository
+type MockKYCRepository struct {
+	mock.Mock
+}
+
+func (m *MockKYCRepository) UpdatePlayerKYC(ctx context.Context, playerID uuid.UUID, update kyc.Update) error {
+	args := m.Called(ctx, playerID, update)
+	return args.Error(0)
+}
+
+// stubKYCProvider starts verifications under a fixed reference and reports a fixed outcome
+type stubKYCProvider struct {
+	status kyc.Status
+}
+
+func (p *stubKYCProvider) Name() string { return "stub" }
+
+func (p *stubKYCProvider) Start(ctx context.Context, pl *player.Player) (*kyc.Session, error) {
+	return &kyc.Session{Reference: "ref-" + pl.Username, URL: "https://kyc.example/" + pl.Username}, nil
+}
+
+func (p *stubKYCProvider) Status(ctx context.Context, reference string) (kyc.Status, error) {
+	return p.status, nil
+}
+
+func TestKYCService_CheckSpin(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{KYC: config.KYCConfig{SpinThreshold: 1000}}
+	svc := NewKYCService(cfg, nil, nil, nil, logger.New("error", "json"))
+
+	tests := []struct {
+		name     string
+		player   *player.Player
+		stake    float64
+		expected error
+	}{
+		{"should allow wagering up to the threshold", &player.Player{TotalWagered: 990}, 10, nil},
+		{"should block an unverified player past the threshold", &player.Player{TotalWagered: 995}, 10, kyc.ErrVerificationRequired},
+		{"should block a pending player past the threshold", &player.Player{TotalWagered: 2000, KYCStatus: "pending"}, 1, kyc.ErrVerificationRequired},
+		{"should allow a verified player past the threshold", &player.Player{TotalWagered: 2000, KYCStatus: "verified"}, 10, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, svc.CheckSpin(ctx, tt.player, tt.stake))
+		})
+	}
+
+	t.Run("should not restrict anyone without a threshold", func(t *testing.T) {
+		unrestricted := NewKYCService(&config.Config{}, nil, nil, nil, logger.New("error", "json"))
+		assert.NoError(t, unrestricted.CheckSpin(ctx, &player.Player{TotalWagered: 1e9}, 10))
+	})
+}
+
+func TestKYCService_Verification(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	cfg := &config.Config{KYC: config.KYCConfig{SpinThreshold: 1000}}
+
+	newService := func(provider kyc.Provider) (*KYCService, *MockKYCRepository, *MockPlayerRepository) {
+		repo := new(MockKYCRepository)
+		playerRepo := new(MockPlayerRepository)
+		return NewKYCService(cfg, repo, playerRepo, provider, logger.New("error", "json")), repo, playerRepo
+	}
+
+	t.Run("should record a started verification as pending", func(t *testing.T) {
+		svc, repo, playerRepo := newService(&stubKYCProvider{})
+		playerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, Username: "alice"}, nil)
+		repo.On("UpdatePlayerKYC", ctx, playerID, mock.MatchedBy(func(u kyc.Update) bool {
+			return u.Status == kyc.StatusPending && *u.Provider == "stub" && *u.Reference == "ref-alice" && u.VerifiedAt == nil
+		})).Return(nil)
+
+		session, err := svc.StartVerification(ctx, playerID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://kyc.example/alice", session.URL)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("should refuse to restart a verified player", func(t *testing.T) {
+		svc, _, playerRepo := newService(&stubKYCProvider{})
+		playerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, KYCStatus: "verified"}, nil)
+
+		_, err := svc.StartVerification(ctx, playerID)
+
+		assert.ErrorIs(t, err, kyc.ErrAlreadyVerified)
+	})
+
+	t.Run("should require a provider to start", func(t *testing.T) {
+		svc, _, _ := newService(nil)
+
+		_, err := svc.StartVerification(ctx, playerID)
+
+		assert.ErrorIs(t, err, kyc.ErrProviderNotConfigured)
+	})
+
+	t.Run("should record the provider's decision on refresh", func(t *testing.T) {
+		svc, repo, playerRepo := newService(&stubKYCProvider{status: kyc.StatusVerified})
+		reference := "ref-alice"
+		playerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, KYCStatus: "pending", KYCReference: &reference, TotalWagered: 1500}, nil)
+		repo.On("UpdatePlayerKYC", ctx, playerID, mock.MatchedBy(func(u kyc.Update) bool {
+			return u.Status == kyc.StatusVerified && *u.Reference == reference && u.VerifiedAt != nil
+		})).Return(nil)
+
+		v, err := svc.Refresh(ctx, playerID)
+
+		require.NoError(t, err)
+		assert.Equal(t, kyc.StatusVerified, v.Status)
+		assert.NotNil(t, v.VerifiedAt)
+		assert.False(t, v.Required)
+		assert.Nil(t, v.RemainingWager)
+	})
+
+	t.Run("should leave a pending verification untouched", func(t *testing.T) {
+		svc, repo, playerRepo := newService(&stubKYCProvider{status: kyc.StatusPending})
+		reference := "ref-alice"
+		playerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, KYCStatus: "pending", KYCReference: &reference, TotalWagered: 1500}, nil)
+
+		v, err := svc.Refresh(ctx, playerID)
+
+		require.NoError(t, err)
+		assert.Equal(t, kyc.StatusPending, v.Status)
+		assert.True(t, v.Required)
+		assert.Equal(t, 0.0, *v.RemainingWager)
+		repo.AssertNotCalled(t, "UpdatePlayerKYC", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should apply a manual decision", func(t *testing.T) {
+		svc, repo, playerRepo := newService(nil)
+		playerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, TotalWagered: 400}, nil)
+		repo.On("UpdatePlayerKYC", ctx, playerID, mock.MatchedBy(func(u kyc.Update) bool {
+			return u.Status == kyc.StatusRejected && u.VerifiedAt == nil
+		})).Return(nil)
+
+		v, err := svc.SetStatus(ctx, playerID, kyc.StatusRejected, "admin")
+
+		require.NoError(t, err)
+		assert.Equal(t, kyc.StatusRejected, v.Status)
+		assert.Equal(t, 600.0, *v.RemainingWager)
+
+		_, err = svc.SetStatus(ctx, playerID, "approved", "admin")
+		assert.ErrorIs(t, err, kyc.ErrInvalidStatus)
+	})
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/launch"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/slotmachine/backend/internal/pkg/util"
+)
+
+// LaunchService starts game sessions for operator players and hands out one-time launch URLs
+type LaunchService struct {
+	wallet   launch.Wallet
+	repo     launch.Repository
+	grants   launch.GrantStore
+	players  player.Service
+	playerDB player.Repository
+	gameRepo game.Repository
+	config   *config.Config
+	logger   *logger.Logger
+}
+
+// NewLaunchService creates a new launch service; wallet and grants are nil when launch is disabled
+func NewLaunchService(
+	wallet launch.Wallet,
+	repo launch.Repository,
+	grants launch.GrantStore,
+	players player.Service,
+	playerDB player.Repository,
+	gameRepo game.Repository,
+	cfg *config.Config,
+	log *logger.Logger,
+) launch.Service {
+	return &LaunchService{
+		wallet:   wallet,
+		repo:     repo,
+		grants:   grants,
+		players:  players,
+		playerDB: playerDB,
+		gameRepo: gameRepo,
+		config:   cfg,
+		logger:   log,
+	}
+}
+
+// Launch validates the operator token, links the operator player, logs it in and
+// returns a game URL carrying a one-time launch token
+func (s *LaunchService) Launch(ctx context.Context, req launch.Request) (*launch.Result, error) {
+	log := s.logger.WithTraceContext(ctx)
+
+	if s.wallet == nil || s.grants == nil {
+		return nil, launch.ErrLaunchDisabled
+	}
+	if req.OperatorToken == "" {
+		return nil, launch.ErrInvalidOperatorToken
+	}
+	if _, err := s.gameRepo.GetGameByID(ctx, req.GameID); err != nil {
+		return nil, player.ErrGameNotFound
+	}
+
+	op, err := s.wallet.Authenticate(ctx, req.OperatorToken)
+	if err != nil {
+		if !errors.Is(err, launch.ErrInvalidOperatorToken) {
+			log.Error().Err(err).Msg("Operator wallet authentication failed")
+		}
+		return nil, err
+	}
+
+	p, err := s.linkPlayer(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	// The operator's wallet is the source of truth for the balance
+	if p.Balance != op.Balance {
+		if err := s.playerDB.UpdateBalance(ctx, p.ID, op.Balance); err != nil {
+			log.Error().Err(err).Str("player_id", p.ID.String()).Msg("Failed to sync balance from operator wallet")
+			return nil, fmt.Errorf("failed to sync balance: %w", err)
+		}
+	}
+
+	gameID := req.GameID
+	login, err := s.players.LoginByID(ctx, p.ID, &gameID, &player.LoginOptions{
+		IPAddress:  req.IPAddress,
+		UserAgent:  req.UserAgent,
+		DeviceInfo: "operator:" + s.config.Launch.OperatorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	launchToken, err := generateSessionToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create launch token: %w", err)
+	}
+	ttl := s.launchTokenTTL()
+	grant := &launch.Grant{
+		PlayerID:         p.ID,
+		SessionToken:     login.SessionToken,
+		ExpiresAt:        login.ExpiresAt,
+		RefreshToken:     login.RefreshToken,
+		RefreshExpiresAt: login.RefreshExpiresAt,
+	}
+	if err := s.grants.Put(ctx, launchToken, grant, ttl); err != nil {
+		log.Error().Err(err).Str("player_id", p.ID.String()).Msg("Failed to store launch grant")
+		return nil, fmt.Errorf("failed to create launch token: %w", err)
+	}
+
+	gameURL, err := s.gameURL(launchToken, req.GameID, req.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("player_id", p.ID.String()).
+		Str("operator_id", s.config.Launch.OperatorID).
+		Str("external_player_id", op.ExternalID).
+		Str("game_id", req.GameID.String()).
+		Msg("Game launched for operator player")
+
+	return &launch.Result{
+		URL:         gameURL,
+		LaunchToken: launchToken,
+		ExpiresAt:   time.Now().UTC().Add(ttl),
+		PlayerID:    p.ID,
+	}, nil
+}
+
+// Redeem exchanges a launch token for its session; a second redemption fails
+func (s *LaunchService) Redeem(ctx context.Context, launchToken string) (*player.LoginResult, error) {
+	if s.grants == nil {
+		return nil, launch.ErrLaunchDisabled
+	}
+	if launchToken == "" {
+		return nil, launch.ErrLaunchTokenInvalid
+	}
+
+	grant, err := s.grants.Take(ctx, launchToken)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.players.GetProfile(ctx, grant.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &player.LoginResult{
+		Player:           p,
+		SessionToken:     grant.SessionToken,
+		ExpiresAt:        grant.ExpiresAt,
+		RefreshToken:     grant.RefreshToken,
+		RefreshExpiresAt: grant.RefreshExpiresAt,
+	}, nil
+}
+
+// linkPlayer returns the local player linked to an operator player, creating a
+// cross-game account and link on first launch
+func (s *LaunchService) linkPlayer(ctx context.Context, op *launch.OperatorPlayer) (*player.Player, error) {
+	log := s.logger.WithTraceContext(ctx)
+	operatorID := s.config.Launch.OperatorID
+
+	link, err := s.repo.GetLink(ctx, operatorID, op.ExternalID)
+	if err == nil {
+		p, err := s.playerDB.GetByID(ctx, link.PlayerID)
+		if err != nil {
+			return nil, player.ErrPlayerNotFound
+		}
+		return p, nil
+	}
+	if !errors.Is(err, launch.ErrLinkNotFound) {
+		return nil, err
+	}
+
+	// Operator players never log in with a password, so the hash is of a discarded random secret
+	secret, err := generateSessionToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create operator player: %w", err)
+	}
+	passwordHash, err := util.HashPassword(secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create operator player: %w", err)
+	}
+
+	username := operatorUsername(operatorID, op.ExternalID)
+	p := &player.Player{
+		ID:           uuid.New(),
+		Username:     username,
+		Email:        username + "@operator.invalid",
+		PasswordHash: passwordHash,
+		Balance:      op.Balance,
+		IsActive:     true,
+		IsVerified:   true,
+	}
+	if err := s.playerDB.Create(ctx, p); err != nil {
+		log.Error().Err(err).Str("external_player_id", op.ExternalID).Msg("Failed to create operator player")
+		return nil, fmt.Errorf("failed to create operator player: %w", err)
+	}
+
+	link = &launch.PlayerLink{
+		ID:               uuid.New(),
+		OperatorID:       operatorID,
+		ExternalPlayerID: op.ExternalID,
+		PlayerID:         p.ID,
+		CreatedAt:        time.Now().UTC(),
+	}
+	if err := s.repo.CreateLink(ctx, link); err != nil {
+		// A concurrent first launch may have linked the operator player already
+		existing, getErr := s.repo.GetLink(ctx, operatorID, op.ExternalID)
+		if getErr != nil {
+			log.Error().Err(err).Str("external_player_id", op.ExternalID).Msg("Failed to link operator player")
+			return nil, err
+		}
+		if delErr := s.playerDB.Delete(ctx, p.ID); delErr != nil {
+			log.Warn().Err(delErr).Str("player_id", p.ID.String()).Msg("Failed to remove duplicate operator player")
+		}
+		return s.playerDB.GetByID(ctx, existing.PlayerID)
+	}
+
+	log.Info().
+		Str("player_id", p.ID.String()).
+		Str("operator_id", operatorID).
+		Str("external_player_id", op.ExternalID).
+		Msg("Operator player linked")
+
+	return p, nil
+}
+
+// operatorUsername derives a stable username that fits the username column for any external ID
+func operatorUsername(operatorID, externalID string) string {
+	sum := sha256.Sum256([]byte(operatorID + ":" + externalID))
+	return "op_" + hex.EncodeToString(sum[:])[:24]
+}
+
+// launchTokenTTL is how long a launch URL can be redeemed
+func (s *LaunchService) launchTokenTTL() time.Duration {
+	if s.config.Launch.TokenSeconds <= 0 {
+		return time.Minute
+	}
+	return time.Duration(s.config.Launch.TokenSeconds) * time.Second
+}
+
+// gameURL appends the launch token, game and language to the configured game client URL
+func (s *LaunchService) gameURL(launchToken string, gameID uuid.UUID, language string) (string, error) {
+	u, err := url.Parse(s.config.Launch.GameURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid LAUNCH_GAME_URL: %w", err)
+	}
+	q := u.Query()
+	q.Set("launch_token", launchToken)
+	q.Set("game_id", gameID.String())
+	if language != "" {
+		q.Set("lang", language)
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
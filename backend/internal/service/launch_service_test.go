@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/launch"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubWallet accepts a single operator token
+type stubWallet struct {
+	token  string
+	player launch.OperatorPlayer
+}
+
+func (w *stubWallet) Authenticate(ctx context.Context, operatorToken string) (*launch.OperatorPlayer, error) {
+	if operatorToken != w.token {
+		return nil, launch.ErrInvalidOperatorToken
+	}
+	p := w.player
+	return &p, nil
+}
+
+// memoryLaunchStore is an in-memory launch.Repository and launch.GrantStore
+type memoryLaunchStore struct {
+	mu     sync.Mutex
+	links  map[string]*launch.PlayerLink
+	grants map[string]*launch.Grant
+}
+
+func newMemoryLaunchStore() *memoryLaunchStore {
+	return &memoryLaunchStore{links: make(map[string]*launch.PlayerLink), grants: make(map[string]*launch.Grant)}
+}
+
+func (s *memoryLaunchStore) GetLink(ctx context.Context, operatorID, externalPlayerID string) (*launch.PlayerLink, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	link, ok := s.links[operatorID+":"+externalPlayerID]
+	if !ok {
+		return nil, launch.ErrLinkNotFound
+	}
+	return link, nil
+}
+
+func (s *memoryLaunchStore) GetLinkByPlayer(ctx context.Context, playerID uuid.UUID) (*launch.PlayerLink, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, link := range s.links {
+		if link.PlayerID == playerID {
+			return link, nil
+		}
+	}
+	return nil, launch.ErrLinkNotFound
+}
+
+func (s *memoryLaunchStore) CreateLink(ctx context.Context, link *launch.PlayerLink) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.links[link.OperatorID+":"+link.ExternalPlayerID] = link
+	return nil
+}
+
+func (s *memoryLaunchStore) Put(ctx context.Context, launchToken string, grant *launch.Grant, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.grants[launchToken] = grant
+	return nil
+}
+
+func (s *memoryLaunchStore) Take(ctx context.Context, launchToken string) (*launch.Grant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	grant, ok := s.grants[launchToken]
+	if !ok {
+		return nil, launch.ErrLaunchTokenInvalid
+	}
+	delete(s.grants, launchToken)
+	return grant, nil
+}
+
+// stubLoginService logs players in by ID; other player.Service methods are unused
+type stubLoginService struct {
+	player.Service
+	players map[uuid.UUID]*player.Player
+}
+
+func (s *stubLoginService) LoginByID(ctx context.Context, playerID uuid.UUID, gameID *uuid.UUID, opts *player.LoginOptions) (*player.LoginResult, error) {
+	return &player.LoginResult{Player: s.players[playerID], SessionToken: "session-" + playerID.String(), ExpiresAt: 42}, nil
+}
+
+func (s *stubLoginService) GetProfile(ctx context.Context, playerID uuid.UUID) (*player.Player, error) {
+	return s.players[playerID], nil
+}
+
+func newTestLaunchService(t *testing.T) (*LaunchService, *memoryLaunchStore, *MockPlayerRepository, *stubLoginService, uuid.UUID) {
+	gameID := uuid.New()
+	gameRepo := new(MockGameRepository)
+	gameRepo.On("GetGameByID", mock.Anything, gameID).Return(&game.Game{ID: gameID}, nil)
+	gameRepo.On("GetGameByID", mock.Anything, mock.Anything).Return(nil, game.ErrGameNotFound)
+
+	store := newMemoryLaunchStore()
+	players := &stubLoginService{players: make(map[uuid.UUID]*player.Player)}
+	playerRepo := new(MockPlayerRepository)
+	playerRepo.On("Create", mock.Anything, mock.AnythingOfType("*player.Player")).
+		Run(func(args mock.Arguments) {
+			p := args.Get(1).(*player.Player)
+			players.players[p.ID] = p
+		}).Return(nil)
+
+	cfg := &config.Config{Launch: config.LaunchConfig{OperatorID: "op", GameURL: "https://play.example.com/slot", TokenSeconds: 60}}
+	wallet := &stubWallet{token: "good", player: launch.OperatorPlayer{ExternalID: "ext-1", Balance: 25}}
+	svc := NewLaunchService(wallet, store, store, players, playerRepo, gameRepo, cfg, logger.New("error", "json")).(*LaunchService)
+	return svc, store, playerRepo, players, gameID
+}
+
+func TestLaunchService_LaunchAndRedeem(t *testing.T) {
+	ctx := context.Background()
+	svc, store, playerRepo, players, gameID := newTestLaunchService(t)
+
+	result, err := svc.Launch(ctx, launch.Request{OperatorToken: "good", GameID: gameID, Language: "ja"})
+	require.NoError(t, err)
+
+	u, err := url.Parse(result.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "play.example.com", u.Host)
+	assert.Equal(t, result.LaunchToken, u.Query().Get("launch_token"))
+	assert.Equal(t, gameID.String(), u.Query().Get("game_id"))
+	assert.Equal(t, "ja", u.Query().Get("lang"))
+
+	p := players.players[result.PlayerID]
+	require.NotNil(t, p)
+	assert.Nil(t, p.GameID, "operator players are cross-game accounts")
+	assert.Equal(t, 25.0, p.Balance, "new players start with the wallet balance")
+	assert.LessOrEqual(t, len(p.Username), 50)
+
+	login, err := svc.Redeem(ctx, result.LaunchToken)
+	require.NoError(t, err)
+	assert.Equal(t, "session-"+result.PlayerID.String(), login.SessionToken)
+	assert.Equal(t, result.PlayerID, login.Player.ID)
+
+	_, err = svc.Redeem(ctx, result.LaunchToken)
+	assert.ErrorIs(t, err, launch.ErrLaunchTokenInvalid, "launch tokens work once")
+
+	// A second launch reuses the link and syncs the wallet balance
+	playerRepo.On("GetByID", mock.Anything, result.PlayerID).Return(p, nil)
+	svc.wallet.(*stubWallet).player.Balance = 30
+	playerRepo.On("UpdateBalance", mock.Anything, result.PlayerID, 30.0).Return(nil).Once()
+
+	again, err := svc.Launch(ctx, launch.Request{OperatorToken: "good", GameID: gameID})
+	require.NoError(t, err)
+	assert.Equal(t, result.PlayerID, again.PlayerID)
+	assert.Len(t, store.links, 1)
+	playerRepo.AssertExpectations(t)
+}
+
+func TestLaunchService_Rejects(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _, _, gameID := newTestLaunchService(t)
+
+	_, err := svc.Launch(ctx, launch.Request{OperatorToken: "bad", GameID: gameID})
+	assert.ErrorIs(t, err, launch.ErrInvalidOperatorToken)
+
+	_, err = svc.Launch(ctx, launch.Request{OperatorToken: "good", GameID: uuid.New()})
+	assert.ErrorIs(t, err, player.ErrGameNotFound)
+
+	_, err = svc.Redeem(ctx, "unknown")
+	assert.ErrorIs(t, err, launch.ErrLaunchTokenInvalid)
+
+	disabled := &LaunchService{config: svc.config, logger: svc.logger}
+	_, err = disabled.Launch(ctx, launch.Request{OperatorToken: "good", GameID: gameID})
+	assert.ErrorIs(t, err, launch.ErrLaunchDisabled)
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/notification"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// notificationPublishTimeout bounds pushing a notification after it was stored
+const notificationPublishTimeout = 5 * time.Second
+
+// NotificationService implements notification.Service
+// Notifications are stored before they are pushed, so a player who was offline or missed the
+// push still finds them unread when their next session starts.
+type NotificationService struct {
+	repo   notification.Repository
+	store  notification.Store
+	logger *logger.Logger
+	now    func() time.Time
+}
+
+// NewNotificationService creates a new notification service
+func NewNotificationService(repo notification.Repository, store notification.Store, log *logger.Logger) *NotificationService {
+	return &NotificationService{
+		repo:   repo,
+		store:  store,
+		logger: log,
+		now:    time.Now,
+	}
+}
+
+// Ensure NotificationService implements notification.Service
+var _ notification.Service = (*NotificationService)(nil)
+
+// Send stores the notification and pushes it to its player's clients, or every player's for a broadcast
+// The notification is stored before the push; a failed push is logged, not returned.
+func (s *NotificationService) Send(ctx context.Context, n *notification.Notification) error {
+	if err := n.Validate(); err != nil {
+		return err
+	}
+	if n.ID == uuid.Nil {
+		n.ID = uuid.New()
+	}
+	n.CreatedAt = s.now().UTC()
+	if err := s.repo.Create(ctx, n); err != nil {
+		return err
+	}
+
+	log := s.logger.WithTraceContext(ctx)
+	log.Info().Str("notification_id", n.ID.String()).Str("type", string(n.Type)).Bool("broadcast", n.Broadcast()).Msg("Notification sent")
+
+	publishCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), notificationPublishTimeout)
+	defer cancel()
+	if err := s.store.Publish(publishCtx, &notification.Event{Type: notification.EventNotification, Notification: n}); err != nil {
+		log.Warn().Err(err).Str("notification_id", n.ID.String()).Msg("Failed to push notification")
+	}
+	return nil
+}
+
+// Unread lists the player's unread notifications, oldest first
+func (s *NotificationService) Unread(ctx context.Context, playerID uuid.UUID, limit int) ([]*notification.Notification, error) {
+	return s.repo.Unread(ctx, playerID, s.now().UTC(), limit)
+}
+
+// MarkRead records that the player read a notification
+func (s *NotificationService) MarkRead(ctx context.Context, playerID, notificationID uuid.UUID) error {
+	return s.repo.MarkRead(ctx, playerID, notificationID, s.now().UTC())
+}
+
+// MarkAllRead marks all the player's unread notifications read and returns how many
+func (s *NotificationService) MarkAllRead(ctx context.Context, playerID uuid.UUID) (int64, error) {
+	return s.repo.MarkAllRead(ctx, playerID, s.now().UTC())
+}
+
+// Subscribe streams notifications for the player until ctx is cancelled
+func (s *NotificationService) Subscribe(ctx context.Context, playerID uuid.UUID) (<-chan *notification.Event, error) {
+	return s.store.Subscribe(ctx, playerID)
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/notification"
+	"github.com/slotmachine/backend/internal/infra/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storedNotifications records created notifications; other notification.Repository methods are unused
+type storedNotifications struct {
+	notification.Repository
+	created []*notification.Notification
+}
+
+func (r *storedNotifications) Create(ctx context.Context, n *notification.Notification) error {
+	r.created = append(r.created, n)
+	return nil
+}
+
+func TestNotificationService_Send(t *testing.T) {
+	ctx := context.Background()
+	log := logger.New("error", "json")
+	playerID, otherID := uuid.New(), uuid.New()
+
+	setup := func(t *testing.T) (*NotificationService, *storedNotifications, <-chan *notification.Event, <-chan *notification.Event) {
+		repo := &storedNotifications{}
+		svc := NewNotificationService(repo, cache.NewNotificationEventStore(nil, log), log)
+
+		subCtx, cancel := context.WithCancel(ctx)
+		t.Cleanup(cancel)
+		mine, err := svc.Subscribe(subCtx, playerID)
+		require.NoError(t, err)
+		others, err := svc.Subscribe(subCtx, otherID)
+		require.NoError(t, err)
+		return svc, repo, mine, others
+	}
+	receive := func(t *testing.T, events <-chan *notification.Event) *notification.Event {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(time.Second):
+			t.Fatal("notification was not pushed")
+			return nil
+		}
+	}
+
+	t.Run("should store the notification and push it to its player only", func(t *testing.T) {
+		svc, repo, mine, others := setup(t)
+
+		n := &notification.Notification{PlayerID: &playerID, Type: notification.TypeTournamentStarted, Title: "Spring cup", Body: "The spring cup has started"}
+		require.NoError(t, svc.Send(ctx, n))
+
+		require.Len(t, repo.created, 1)
+		assert.NotEqual(t, uuid.Nil, n.ID)
+		assert.False(t, n.CreatedAt.IsZero())
+
+		event := receive(t, mine)
+		assert.Equal(t, notification.EventNotification, event.Type)
+		assert.Equal(t, n.ID, event.Notification.ID)
+		assert.Empty(t, others, "other players are not notified")
+	})
+
+	t.Run("should push broadcasts to every player", func(t *testing.T) {
+		svc, _, mine, others := setup(t)
+
+		n := &notification.Notification{Type: notification.TypeMaintenance, Title: "Maintenance", Body: "Back at 04:00 UTC"}
+		require.NoError(t, svc.Send(ctx, n))
+
+		assert.Equal(t, n.ID, receive(t, mine).Notification.ID)
+		assert.Equal(t, n.ID, receive(t, others).Notification.ID)
+	})
+
+	t.Run("should reject invalid notifications", func(t *testing.T) {
+		svc, repo, _, _ := setup(t)
+
+		assert.ErrorIs(t, svc.Send(ctx, &notification.Notification{Type: "promo", Title: "t", Body: "b"}), notification.ErrInvalidType)
+		assert.ErrorIs(t, svc.Send(ctx, &notification.Notification{Type: notification.TypeMaintenance, Title: "t"}), notification.ErrContentRequired)
+		assert.Empty(t, repo.created)
+	})
+}
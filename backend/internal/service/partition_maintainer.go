@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// PartitionMaintainer keeps monthly partitions of the spin tables ahead of the clock
+// and detaches those past retention so they can be archived
+type PartitionMaintainer struct {
+	partitions      *repository.PartitionManager
+	interval        time.Duration
+	premakeMonths   int
+	retentionMonths int
+	clock           clock.Clock
+	logger          *logger.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewPartitionMaintainer creates a new partition maintainer
+func NewPartitionMaintainer(cfg *config.Config, partitions *repository.PartitionManager, clk clock.Clock, log *logger.Logger) *PartitionMaintainer {
+	return &PartitionMaintainer{
+		partitions:      partitions,
+		interval:        time.Duration(cfg.Database.PartitionIntervalMinutes) * time.Minute,
+		premakeMonths:   cfg.Database.PartitionPremakeMonths,
+		retentionMonths: cfg.Database.PartitionRetentionMonths,
+		clock:           clk,
+		logger:          log,
+		stop:            make(chan struct{}),
+		done:            make(chan struct{}),
+	}
+}
+
+// Start runs maintenance once, then in the background; a zero interval or a
+// database without partitioning disables it
+func (m *PartitionMaintainer) Start() {
+	if m.interval <= 0 || !m.partitions.Supported() {
+		close(m.done)
+		m.logger.Info().Msg("Partition maintainer disabled")
+		return
+	}
+
+	m.Maintain(context.Background(), m.clock.Now())
+	go m.run()
+	m.logger.Info().
+		Dur("interval", m.interval).
+		Int("premake_months", m.premakeMonths).
+		Int("retention_months", m.retentionMonths).
+		Msg("Partition maintainer started")
+}
+
+// Stop stops the maintainer and waits for a running pass to finish
+func (m *PartitionMaintainer) Stop() {
+	m.stopOnce.Do(func() { close(m.stop) })
+	<-m.done
+}
+
+func (m *PartitionMaintainer) run() {
+	defer close(m.done)
+
+	ticker := m.clock.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stop:
+			return
+		case now := <-ticker.C():
+			m.Maintain(context.Background(), now)
+		}
+	}
+}
+
+// Maintain creates upcoming partitions and detaches expired ones for every partitioned table.
+// A failure on one table is logged and does not stop the others
+func (m *PartitionMaintainer) Maintain(ctx context.Context, now time.Time) {
+	for _, table := range repository.PartitionedTables {
+		created, err := m.partitions.EnsurePartitions(ctx, table, now, m.premakeMonths)
+		if err != nil {
+			m.logger.Error().Err(err).Str("table", table).Msg("Failed to create partitions")
+		}
+		if len(created) > 0 {
+			m.logger.Info().Str("table", table).Strs("partitions", created).Msg("Created partitions")
+		}
+
+		cutoff, ok := m.retentionCutoff(now)
+		if !ok {
+			continue
+		}
+		detached, err := m.partitions.DetachPartitionsBefore(ctx, table, cutoff)
+		if err != nil {
+			m.logger.Error().Err(err).Str("table", table).Msg("Failed to detach partitions")
+		}
+		if len(detached) > 0 {
+			m.logger.Info().Str("table", table).Strs("partitions", detached).Msg("Detached partitions")
+		}
+	}
+}
+
+// retentionCutoff returns the start of the oldest month that stays attached
+func (m *PartitionMaintainer) retentionCutoff(now time.Time) (time.Time, bool) {
+	if m.retentionMonths <= 0 {
+		return time.Time{}, false
+	}
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -m.retentionMonths, 0), true
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// pfAuditBatchSize bounds how many sessions one audit batch re-verifies
+const pfAuditBatchSize = 100
+
+// pfAuditAlertTimeout bounds each alert notification
+const pfAuditAlertTimeout = 10 * time.Second
+
+// PFChainAuditor periodically re-verifies the hash chains of recently ended PF sessions
+// A failed audit means spin logs or seeds were corrupted in storage, or a code change broke
+// hash generation; either way operations hear about it before players or regulators do.
+type PFChainAuditor struct {
+	pfService *ProvablyFairService
+	notifier  provablyfair.ChainAlertNotifier
+	interval  time.Duration
+	lookback  time.Duration
+	logger    *logger.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewPFChainAuditor creates a new PF chain auditor; notifier may be nil to only log failures
+func NewPFChainAuditor(cfg *config.Config, pfService *ProvablyFairService, notifier provablyfair.ChainAlertNotifier, log *logger.Logger) *PFChainAuditor {
+	return &PFChainAuditor{
+		pfService: pfService,
+		notifier:  notifier,
+		interval:  time.Duration(cfg.ProvablyFair.AuditIntervalMinutes) * time.Minute,
+		lookback:  time.Duration(cfg.ProvablyFair.AuditLookbackHours) * time.Hour,
+		logger:    log,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start runs the auditor in the background; a zero interval disables it
+func (a *PFChainAuditor) Start() {
+	if a.interval <= 0 {
+		close(a.done)
+		a.logger.Info().Msg("PF chain auditor disabled")
+		return
+	}
+
+	go a.run()
+	a.logger.Info().
+		Dur("interval", a.interval).
+		Dur("lookback", a.lookback).
+		Msg("PF chain auditor started")
+}
+
+// Stop stops the auditor and waits for a running pass to finish
+func (a *PFChainAuditor) Stop() {
+	a.stopOnce.Do(func() { close(a.stop) })
+	<-a.done
+}
+
+func (a *PFChainAuditor) run() {
+	defer close(a.done)
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.stop:
+			return
+		case now := <-ticker.C:
+			a.Run(context.Background(), now)
+		}
+	}
+}
+
+// Run audits every unaudited session ended within the lookback window and returns how many failed
+func (a *PFChainAuditor) Run(ctx context.Context, now time.Time) int {
+	endedSince := now.UTC().Add(-a.lookback)
+
+	audited, failed := 0, 0
+	for {
+		audits, err := a.pfService.AuditEndedSessions(ctx, endedSince, pfAuditBatchSize)
+		if err != nil {
+			a.logger.Error().Err(err).Msg("Failed to audit PF session chains")
+		}
+		for i := range audits {
+			if !audits[i].Valid {
+				failed++
+				a.alert(ctx, &audits[i])
+			}
+		}
+		audited += len(audits)
+		// Sessions that could not be read stay unaudited, so a short batch means nothing is left this pass
+		if err != nil || len(audits) < pfAuditBatchSize {
+			break
+		}
+	}
+
+	if audited > 0 {
+		a.logger.Info().Int("audited", audited).Int("failed", failed).Msg("Audited PF session chains")
+	}
+	return failed
+}
+
+// alert logs a failed audit and notifies operations
+func (a *PFChainAuditor) alert(ctx context.Context, audit *provablyfair.ChainAudit) {
+	a.logger.Error().
+		Str("pf_session_id", audit.PFSessionID.String()).
+		Str("player_id", audit.PlayerID.String()).
+		Int64("spin_count", audit.SpinCount).
+		Str("reason", audit.Error).
+		Msg("PF hash chain audit failed")
+
+	if a.notifier == nil {
+		return
+	}
+	notifyCtx, cancel := context.WithTimeout(ctx, pfAuditAlertTimeout)
+	defer cancel()
+	if err := a.notifier.NotifyChainFailure(notifyCtx, audit); err != nil {
+		a.logger.Warn().Err(err).Str("notifier", a.notifier.Name()).Msg("Failed to send PF chain audit alert")
+	}
+}
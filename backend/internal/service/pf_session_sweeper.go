@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// pfSweepBatchSize bounds how many orphaned sessions one sweep ends
+const pfSweepBatchSize = 100
+
+// PFSessionSweeper periodically force-ends orphaned provably fair sessions
+// Crashed clients never call EndSession, which would leave their PF session active
+// forever and make every later StartSession fail with ErrSessionAlreadyActive.
+type PFSessionSweeper struct {
+	pfService   *ProvablyFairService
+	interval    time.Duration
+	idleTimeout time.Duration
+	clock       clock.Clock
+	logger      *logger.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewPFSessionSweeper creates a new PF session sweeper
+func NewPFSessionSweeper(cfg *config.Config, pfService *ProvablyFairService, clk clock.Clock, log *logger.Logger) *PFSessionSweeper {
+	return &PFSessionSweeper{
+		pfService:   pfService,
+		interval:    time.Duration(cfg.ProvablyFair.SweepIntervalSeconds) * time.Second,
+		idleTimeout: time.Duration(cfg.ProvablyFair.SessionIdleMinutes) * time.Minute,
+		clock:       clk,
+		logger:      log,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// Start runs the sweeper in the background; a zero interval disables it
+func (s *PFSessionSweeper) Start() {
+	if s.interval <= 0 {
+		close(s.done)
+		s.logger.Info().Msg("PF session sweeper disabled")
+		return
+	}
+
+	go s.run()
+	s.logger.Info().
+		Dur("interval", s.interval).
+		Dur("idle_timeout", s.idleTimeout).
+		Msg("PF session sweeper started")
+}
+
+// Stop stops the sweeper and waits for a running sweep to finish
+func (s *PFSessionSweeper) Stop() {
+	s.stopOnce.Do(func() { close(s.stop) })
+	<-s.done
+}
+
+func (s *PFSessionSweeper) run() {
+	defer close(s.done)
+
+	ticker := s.clock.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C():
+			s.Sweep(context.Background())
+		}
+	}
+}
+
+// Sweep ends every orphaned session, one batch at a time, and returns how many it ended
+func (s *PFSessionSweeper) Sweep(ctx context.Context) int {
+	idleSince := s.clock.Now().UTC().Add(-s.idleTimeout)
+
+	total := 0
+	for {
+		ended, err := s.pfService.EndOrphanedSessions(ctx, idleSince, pfSweepBatchSize)
+		if err != nil {
+			s.logger.Error().Err(err).Msg("Failed to sweep orphaned PF sessions")
+			break
+		}
+		total += ended
+		// A short or fully failed batch means nothing is left that this sweep can end
+		if ended < pfSweepBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		s.logger.Info().Int("ended", total).Msg("Ended orphaned PF sessions")
+	}
+	return total
+}
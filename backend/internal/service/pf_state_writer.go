@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// pfStateProgress is a session's latest unflushed nonce and spin hash
+type pfStateProgress struct {
+	nonce        int64
+	lastSpinHash string
+	spins        int
+}
+
+// PFStateWriter batches PF session-state DB writes (last nonce and spin hash)
+// Spin logs stay synchronous and are the source of truth, so a crash between flushes
+// only leaves pf_sessions behind, which state recovery reconciles from the last spin log.
+type PFStateWriter struct {
+	repo       provablyfair.Repository
+	everySpins int
+	interval   time.Duration
+	logger     *logger.Logger
+
+	mu      sync.Mutex
+	pending map[uuid.UUID]*pfStateProgress
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewPFStateWriter creates a new PF session-state writer
+func NewPFStateWriter(cfg *config.Config, repo provablyfair.Repository, log *logger.Logger) *PFStateWriter {
+	return &PFStateWriter{
+		repo:       repo,
+		everySpins: cfg.ProvablyFair.StateFlushSpins,
+		interval:   time.Duration(cfg.ProvablyFair.StateFlushSeconds) * time.Second,
+		logger:     log,
+		pending:    make(map[uuid.UUID]*pfStateProgress),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Enabled reports whether writes are batched; otherwise callers write through
+func (w *PFStateWriter) Enabled() bool {
+	return w.everySpins > 0
+}
+
+// Start runs the periodic flush in the background; it is a no-op unless batching is enabled
+func (w *PFStateWriter) Start() {
+	if !w.Enabled() || w.interval <= 0 {
+		close(w.done)
+		if w.Enabled() {
+			w.logger.Info().Int("every_spins", w.everySpins).Msg("PF state writer started without periodic flush")
+		}
+		return
+	}
+
+	go w.run()
+	w.logger.Info().
+		Int("every_spins", w.everySpins).
+		Dur("interval", w.interval).
+		Msg("PF state writer started")
+}
+
+// Stop stops the periodic flush and writes everything still buffered
+func (w *PFStateWriter) Stop() {
+	w.stopOnce.Do(func() { close(w.stop) })
+	<-w.done
+	w.FlushAll(context.Background())
+}
+
+func (w *PFStateWriter) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			w.FlushAll(context.Background())
+		}
+	}
+}
+
+// Record buffers a session's progress, flushing it once everySpins spins have accumulated
+func (w *PFStateWriter) Record(ctx context.Context, sessionID uuid.UUID, nonce int64, lastSpinHash string) error {
+	w.mu.Lock()
+	p := w.merge(sessionID, &pfStateProgress{nonce: nonce, lastSpinHash: lastSpinHash, spins: 1})
+	due := p.spins >= w.everySpins
+	w.mu.Unlock()
+
+	if !due {
+		return nil
+	}
+	return w.Flush(ctx, sessionID)
+}
+
+// Flush writes a session's buffered progress, if any. On failure it is buffered again
+func (w *PFStateWriter) Flush(ctx context.Context, sessionID uuid.UUID) error {
+	w.mu.Lock()
+	p, ok := w.pending[sessionID]
+	delete(w.pending, sessionID)
+	w.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	if err := w.repo.UpdateSessionProgress(ctx, sessionID, p.nonce, p.lastSpinHash); err != nil {
+		w.mu.Lock()
+		w.merge(sessionID, p)
+		w.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// FlushAll writes every buffered session and returns how many failed
+func (w *PFStateWriter) FlushAll(ctx context.Context) int {
+	w.mu.Lock()
+	ids := make([]uuid.UUID, 0, len(w.pending))
+	for id := range w.pending {
+		ids = append(ids, id)
+	}
+	w.mu.Unlock()
+
+	failed := 0
+	for _, id := range ids {
+		if err := w.Flush(ctx, id); err != nil {
+			failed++
+			w.logger.Warn().Err(err).Str("session_id", id.String()).Msg("Failed to flush PF session state")
+		}
+	}
+	return failed
+}
+
+// Pending returns how many sessions have unflushed progress
+func (w *PFStateWriter) Pending() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.pending)
+}
+
+// merge folds p into the session's buffered progress, keeping the highest nonce. Callers hold mu
+func (w *PFStateWriter) merge(sessionID uuid.UUID, p *pfStateProgress) *pfStateProgress {
+	existing, ok := w.pending[sessionID]
+	if !ok {
+		copied := *p
+		w.pending[sessionID] = &copied
+		return &copied
+	}
+	if p.nonce > existing.nonce {
+		existing.nonce = p.nonce
+		existing.lastSpinHash = p.lastSpinHash
+	}
+	existing.spins += p.spins
+	return existing
+}
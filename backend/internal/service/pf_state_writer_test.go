@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockPFProgressRepository mocks the session-progress write of provablyfair.Repository
+type MockPFProgressRepository struct {
+	provablyfair.Repository
+	mock.Mock
+}
+
+func (m *MockPFProgressRepository) UpdateSessionProgress(ctx context.Context, id uuid.UUID, nonce int64, lastSpinHash string) error {
+	args := m.Called(ctx, id, nonce, lastSpinHash)
+	return args.Error(0)
+}
+
+func newTestPFStateWriter(repo provablyfair.Repository, everySpins int) *PFStateWriter {
+	cfg := &config.Config{ProvablyFair: config.ProvablyFairConfig{StateFlushSpins: everySpins}}
+	return NewPFStateWriter(cfg, repo, logger.New("error", "json"))
+}
+
+func TestPFStateWriter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("flushes latest progress every N spins", func(t *testing.T) {
+		repo := new(MockPFProgressRepository)
+		w := newTestPFStateWriter(repo, 3)
+		sessionID := uuid.New()
+
+		require.NoError(t, w.Record(ctx, sessionID, 1, "h1"))
+		require.NoError(t, w.Record(ctx, sessionID, 2, "h2"))
+		repo.AssertNotCalled(t, "UpdateSessionProgress", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		repo.On("UpdateSessionProgress", ctx, sessionID, int64(3), "h3").Return(nil).Once()
+		require.NoError(t, w.Record(ctx, sessionID, 3, "h3"))
+		assert.Equal(t, 0, w.Pending())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("flush writes a partial batch", func(t *testing.T) {
+		repo := new(MockPFProgressRepository)
+		w := newTestPFStateWriter(repo, 10)
+		sessionID := uuid.New()
+
+		require.NoError(t, w.Record(ctx, sessionID, 1, "h1"))
+		repo.On("UpdateSessionProgress", ctx, sessionID, int64(1), "h1").Return(nil).Once()
+		require.NoError(t, w.Flush(ctx, sessionID))
+		require.NoError(t, w.Flush(ctx, sessionID), "nothing left to flush")
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("failed flush is buffered again", func(t *testing.T) {
+		repo := new(MockPFProgressRepository)
+		w := newTestPFStateWriter(repo, 10)
+		w.Start()
+		sessionID := uuid.New()
+
+		require.NoError(t, w.Record(ctx, sessionID, 4, "h4"))
+		repo.On("UpdateSessionProgress", ctx, sessionID, int64(4), "h4").Return(errors.New("db down")).Once()
+		assert.Equal(t, 1, w.FlushAll(ctx))
+		assert.Equal(t, 1, w.Pending())
+
+		require.NoError(t, w.Record(ctx, sessionID, 5, "h5"))
+		repo.On("UpdateSessionProgress", ctx, sessionID, int64(5), "h5").Return(nil).Once()
+		w.Stop()
+		assert.Equal(t, 0, w.Pending())
+		repo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/admin"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/playernote"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// PlayerNoteService implements playernote.Service
+type PlayerNoteService struct {
+	repo       playernote.Repository
+	playerRepo player.Repository
+	logger     *logger.Logger
+
+	now func() time.Time
+}
+
+// NewPlayerNoteService creates a new player note service
+func NewPlayerNoteService(repo playernote.Repository, playerRepo player.Repository, log *logger.Logger) *PlayerNoteService {
+	return &PlayerNoteService{
+		repo:       repo,
+		playerRepo: playerRepo,
+		logger:     log,
+		now:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Add records a note or flag on the player, authored by the admin
+func (s *PlayerNoteService) Add(ctx context.Context, by *admin.Admin, playerID uuid.UUID, kind playernote.Kind, body string) (*playernote.Note, error) {
+	if !kind.Valid() {
+		return nil, playernote.ErrInvalidKind
+	}
+	body, err := normalizeNoteBody(kind, body)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.playerRepo.GetByID(ctx, playerID); err != nil {
+		return nil, player.ErrPlayerNotFound
+	}
+	if kind == playernote.KindFlag {
+		if err := s.checkFlagUnique(ctx, playerID, uuid.Nil, body); err != nil {
+			return nil, err
+		}
+	}
+
+	note := &playernote.Note{
+		ID:             uuid.New(),
+		PlayerID:       playerID,
+		Kind:           kind,
+		Body:           body,
+		AuthorID:       by.ID,
+		AuthorUsername: by.Username,
+		CreatedAt:      s.now(),
+	}
+	if err := s.repo.Create(ctx, note); err != nil {
+		return nil, err
+	}
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("note_id", note.ID.String()).
+		Str("player_id", playerID.String()).
+		Str("kind", string(kind)).
+		Str("admin", by.Username).
+		Msg("Player note added")
+
+	return note, nil
+}
+
+// Edit replaces the body of one of the player's notes
+func (s *PlayerNoteService) Edit(ctx context.Context, by *admin.Admin, playerID, noteID uuid.UUID, body string) (*playernote.Note, error) {
+	note, err := s.getPlayerNote(ctx, playerID, noteID)
+	if err != nil {
+		return nil, err
+	}
+	body, err = normalizeNoteBody(note.Kind, body)
+	if err != nil {
+		return nil, err
+	}
+	if note.Kind == playernote.KindFlag {
+		if err := s.checkFlagUnique(ctx, playerID, note.ID, body); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.UpdateBody(ctx, note.ID, body, by.Username, s.now()); err != nil {
+		return nil, err
+	}
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("note_id", note.ID.String()).
+		Str("player_id", playerID.String()).
+		Str("admin", by.Username).
+		Msg("Player note edited")
+
+	return s.repo.GetByID(ctx, note.ID)
+}
+
+// Delete removes one of the player's notes from listings
+func (s *PlayerNoteService) Delete(ctx context.Context, by *admin.Admin, playerID, noteID uuid.UUID) error {
+	note, err := s.getPlayerNote(ctx, playerID, noteID)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, note.ID, by.Username, s.now()); err != nil {
+		return err
+	}
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("note_id", note.ID.String()).
+		Str("player_id", playerID.String()).
+		Str("admin", by.Username).
+		Msg("Player note deleted")
+
+	return nil
+}
+
+// List returns the player's notes, newest first; a nil kind lists notes and flags alike
+func (s *PlayerNoteService) List(ctx context.Context, playerID uuid.UUID, kind *playernote.Kind) ([]*playernote.Note, error) {
+	if kind != nil && !kind.Valid() {
+		return nil, playernote.ErrInvalidKind
+	}
+	if _, err := s.playerRepo.GetByID(ctx, playerID); err != nil {
+		return nil, player.ErrPlayerNotFound
+	}
+
+	notes, err := s.repo.ListByPlayer(ctx, playerID, kind)
+	if err != nil {
+		return nil, err
+	}
+	if notes == nil {
+		notes = []*playernote.Note{}
+	}
+	return notes, nil
+}
+
+// getPlayerNote returns the note if it belongs to the player
+func (s *PlayerNoteService) getPlayerNote(ctx context.Context, playerID, noteID uuid.UUID) (*playernote.Note, error) {
+	note, err := s.repo.GetByID(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	if note.PlayerID != playerID {
+		return nil, playernote.ErrNoteNotFound
+	}
+	return note, nil
+}
+
+// checkFlagUnique rejects a flag the player already carries, ignoring case and the flag being edited
+func (s *PlayerNoteService) checkFlagUnique(ctx context.Context, playerID, exceptID uuid.UUID, body string) error {
+	kind := playernote.KindFlag
+	flags, err := s.repo.ListByPlayer(ctx, playerID, &kind)
+	if err != nil {
+		return err
+	}
+	for _, f := range flags {
+		if f.ID != exceptID && strings.EqualFold(f.Body, body) {
+			return playernote.ErrFlagExists
+		}
+	}
+	return nil
+}
+
+// normalizeNoteBody trims the body and checks it against its kind's length limit
+func normalizeNoteBody(kind playernote.Kind, body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", playernote.ErrBodyRequired
+	}
+	if utf8.RuneCountInString(body) > kind.MaxLength() {
+		return "", playernote.ErrBodyTooLong
+	}
+	return body, nil
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/admin"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/playernote"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPlayerNoteRepository keeps notes in memory
+type stubPlayerNoteRepository struct {
+	notes []*playernote.Note
+}
+
+func (r *stubPlayerNoteRepository) Create(ctx context.Context, note *playernote.Note) error {
+	r.notes = append(r.notes, note)
+	return nil
+}
+
+func (r *stubPlayerNoteRepository) GetByID(ctx context.Context, id uuid.UUID) (*playernote.Note, error) {
+	for _, n := range r.notes {
+		if n.ID == id && n.DeletedAt == nil {
+			return n, nil
+		}
+	}
+	return nil, playernote.ErrNoteNotFound
+}
+
+func (r *stubPlayerNoteRepository) UpdateBody(ctx context.Context, id uuid.UUID, body, editedBy string, at time.Time) error {
+	n, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	n.Body, n.EditedBy, n.EditedAt = body, editedBy, &at
+	return nil
+}
+
+func (r *stubPlayerNoteRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy string, at time.Time) error {
+	n, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	n.DeletedBy, n.DeletedAt = deletedBy, &at
+	return nil
+}
+
+func (r *stubPlayerNoteRepository) ListByPlayer(ctx context.Context, playerID uuid.UUID, kind *playernote.Kind) ([]*playernote.Note, error) {
+	var notes []*playernote.Note
+	for i := len(r.notes) - 1; i >= 0; i-- {
+		n := r.notes[i]
+		if n.PlayerID == playerID && n.DeletedAt == nil && (kind == nil || n.Kind == *kind) {
+			notes = append(notes, n)
+		}
+	}
+	return notes, nil
+}
+
+func TestPlayerNoteService(t *testing.T) {
+	ctx := context.Background()
+	author := &admin.Admin{ID: uuid.New(), Username: "support"}
+	lead := &admin.Admin{ID: uuid.New(), Username: "lead"}
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	setup := func() (*PlayerNoteService, uuid.UUID) {
+		playerRepo := new(MockPlayerRepository)
+		playerID := uuid.New()
+		playerRepo.On("GetByID", mock.Anything, playerID).Return(&player.Player{ID: playerID}, nil)
+		playerRepo.On("GetByID", mock.Anything, mock.Anything).Return(nil, player.ErrPlayerNotFound)
+		s := NewPlayerNoteService(&stubPlayerNoteRepository{}, playerRepo, logger.New("error", "json"))
+		s.now = func() time.Time { return now }
+		return s, playerID
+	}
+
+	t.Run("records the author and the editor", func(t *testing.T) {
+		s, playerID := setup()
+
+		note, err := s.Add(ctx, author, playerID, playernote.KindNote, "  called about a missing win ")
+		require.NoError(t, err)
+		assert.Equal(t, "called about a missing win", note.Body)
+		assert.Equal(t, author.ID, note.AuthorID)
+		assert.Equal(t, "support", note.AuthorUsername)
+		assert.Equal(t, now, note.CreatedAt)
+
+		edited, err := s.Edit(ctx, lead, playerID, note.ID, "resolved")
+		require.NoError(t, err)
+		assert.Equal(t, "resolved", edited.Body)
+		assert.Equal(t, "lead", edited.EditedBy)
+		assert.Equal(t, "support", edited.AuthorUsername)
+	})
+
+	t.Run("rejects invalid notes", func(t *testing.T) {
+		s, playerID := setup()
+
+		_, err := s.Add(ctx, author, playerID, "warning", "x")
+		assert.ErrorIs(t, err, playernote.ErrInvalidKind)
+		_, err = s.Add(ctx, author, playerID, playernote.KindNote, "   ")
+		assert.ErrorIs(t, err, playernote.ErrBodyRequired)
+		_, err = s.Add(ctx, author, playerID, playernote.KindFlag, strings.Repeat("x", playernote.MaxFlagLength+1))
+		assert.ErrorIs(t, err, playernote.ErrBodyTooLong)
+		_, err = s.Add(ctx, author, uuid.New(), playernote.KindNote, "x")
+		assert.ErrorIs(t, err, player.ErrPlayerNotFound)
+	})
+
+	t.Run("a player carries each flag once", func(t *testing.T) {
+		s, playerID := setup()
+
+		flag, err := s.Add(ctx, author, playerID, playernote.KindFlag, "Charge-back risk")
+		require.NoError(t, err)
+		_, err = s.Add(ctx, author, playerID, playernote.KindFlag, "charge-back risk")
+		assert.ErrorIs(t, err, playernote.ErrFlagExists)
+
+		// A note with the same text is not a flag
+		_, err = s.Add(ctx, author, playerID, playernote.KindNote, "charge-back risk")
+		require.NoError(t, err)
+
+		// Re-casing a flag is not a duplicate of itself
+		_, err = s.Edit(ctx, author, playerID, flag.ID, "CHARGE-BACK RISK")
+		require.NoError(t, err)
+
+		require.NoError(t, s.Delete(ctx, lead, playerID, flag.ID))
+		_, err = s.Add(ctx, author, playerID, playernote.KindFlag, "charge-back risk")
+		assert.NoError(t, err, "a deleted flag can be raised again")
+	})
+
+	t.Run("notes are only reachable through their player", func(t *testing.T) {
+		s, playerID := setup()
+		note, err := s.Add(ctx, author, playerID, playernote.KindNote, "x")
+		require.NoError(t, err)
+
+		other := uuid.New()
+		_, err = s.Edit(ctx, author, other, note.ID, "y")
+		assert.ErrorIs(t, err, playernote.ErrNoteNotFound)
+		assert.ErrorIs(t, s.Delete(ctx, author, other, note.ID), playernote.ErrNoteNotFound)
+
+		require.NoError(t, s.Delete(ctx, author, playerID, note.ID))
+		notes, err := s.List(ctx, playerID, nil)
+		require.NoError(t, err)
+		assert.Empty(t, notes)
+		assert.ErrorIs(t, s.Delete(ctx, author, playerID, note.ID), playernote.ErrNoteNotFound)
+	})
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/session"
+)
+
+// refreshEnabled reports whether logins issue refresh tokens
+func (s *PlayerService) refreshEnabled() bool {
+	return s.refreshStore != nil && s.config.PlayerAuth.RefreshTokenHours > 0
+}
+
+// accessTokenTTL is the session token lifetime; it is only shortened when a refresh
+// token can renew the session
+func (s *PlayerService) accessTokenTTL() time.Duration {
+	if s.refreshEnabled() && s.config.PlayerAuth.AccessTokenMinutes > 0 {
+		return time.Duration(s.config.PlayerAuth.AccessTokenMinutes) * time.Minute
+	}
+	expirationHours := s.config.JWT.ExpirationHours
+	if expirationHours <= 0 {
+		expirationHours = 24
+	}
+	return time.Duration(expirationHours) * time.Hour
+}
+
+// refreshTokenTTL is the absolute lifetime of a refresh token family
+func (s *PlayerService) refreshTokenTTL() time.Duration {
+	return time.Duration(s.config.PlayerAuth.RefreshTokenHours) * time.Hour
+}
+
+// hashRefreshToken returns the SHA-256 hex digest refresh tokens are stored under
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueRefreshToken stores a new refresh token of the family for a login session and
+// sets it on the result
+func (s *PlayerService) issueRefreshToken(ctx context.Context, result *player.LoginResult, sess *session.PlayerSession, familyID string, expiresAt time.Time, deviceInfo string) error {
+	token, err := generateSessionToken()
+	if err != nil {
+		return err
+	}
+
+	record := &session.RefreshToken{
+		FamilyID:   familyID,
+		PlayerID:   sess.PlayerID,
+		GameID:     sess.GameID,
+		SessionID:  sess.ID,
+		DeviceInfo: deviceInfo,
+		IssuedAt:   time.Now().UTC(),
+		ExpiresAt:  expiresAt,
+	}
+	if err := s.refreshStore.Save(ctx, hashRefreshToken(token), record); err != nil {
+		return err
+	}
+
+	result.RefreshToken = token
+	result.RefreshExpiresAt = expiresAt.Unix()
+	return nil
+}
+
+// Refresh exchanges a refresh token for a new session token and a rotated refresh token.
+// Presenting an already rotated token revokes its family and ends the family's session
+func (s *PlayerService) Refresh(ctx context.Context, refreshToken string, opts *player.LoginOptions) (*player.LoginResult, error) {
+	log := s.logger.WithTraceContext(ctx)
+
+	if !s.refreshEnabled() {
+		return nil, session.ErrRefreshTokenUnavailable
+	}
+	if refreshToken == "" {
+		return nil, session.ErrRefreshTokenInvalid
+	}
+	if opts == nil {
+		opts = &player.LoginOptions{}
+	}
+
+	tokenHash := hashRefreshToken(refreshToken)
+	record, err := s.refreshStore.Get(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(record.ExpiresAt) {
+		return nil, session.ErrRefreshTokenInvalid
+	}
+
+	revoked, err := s.isRefreshRevoked(ctx, record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check refresh token: %w", err)
+	}
+	if revoked {
+		return nil, session.ErrRefreshTokenRevoked
+	}
+
+	first, err := s.refreshStore.MarkUsed(ctx, tokenHash, record.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+	if !first {
+		log.Warn().
+			Str("player_id", record.PlayerID.String()).
+			Str("family_id", record.FamilyID).
+			Msg("Refresh token reuse detected, revoking token family")
+		s.revokeRefreshFamily(ctx, record)
+		return nil, session.ErrRefreshTokenReused
+	}
+
+	p, err := s.repo.GetByID(ctx, record.PlayerID)
+	if err != nil {
+		return nil, player.ErrPlayerNotFound
+	}
+	if !p.IsActive {
+		s.revokeRefreshFamily(ctx, record)
+		return nil, session.ErrRefreshTokenRevoked
+	}
+
+	// Replace the family's session; another device's session means this family was superseded
+	active, err := s.sessionRepo.GetActiveByPlayerAndGame(ctx, record.PlayerID, record.GameID)
+	if err == nil && active != nil {
+		if active.ID != record.SessionID {
+			return nil, session.ErrPlayerAlreadyLoggedIn
+		}
+		if err := s.deactivateSession(ctx, active, session.LogoutReasonRefresh); err != nil {
+			log.Error().Err(err).Str("session_id", active.ID.String()).Msg("Failed to deactivate refreshed session")
+			return nil, fmt.Errorf("failed to refresh session: %w", err)
+		}
+	}
+
+	if opts.DeviceInfo == "" {
+		opts.DeviceInfo = record.DeviceInfo
+	}
+	newSession, err := s.createSession(ctx, p.ID, record.GameID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &player.LoginResult{
+		Player:       p,
+		SessionToken: newSession.SessionToken,
+		ExpiresAt:    newSession.ExpiresAt.Unix(),
+	}
+	if err := s.issueRefreshToken(ctx, result, newSession, record.FamilyID, record.ExpiresAt, record.DeviceInfo); err != nil {
+		log.Error().Err(err).Str("player_id", p.ID.String()).Msg("Failed to rotate refresh token")
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	log.Info().
+		Str("player_id", p.ID.String()).
+		Str("session_id", newSession.ID.String()).
+		Str("family_id", record.FamilyID).
+		Msg("Player session refreshed")
+
+	return result, nil
+}
+
+// RevokeRefreshToken revokes the family of a refresh token; unknown tokens are ignored
+func (s *PlayerService) RevokeRefreshToken(ctx context.Context, refreshToken string) error {
+	if !s.refreshEnabled() || refreshToken == "" {
+		return nil
+	}
+
+	record, err := s.refreshStore.Get(ctx, hashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, session.ErrRefreshTokenInvalid) {
+			return nil
+		}
+		return err
+	}
+	return s.refreshStore.RevokeFamily(ctx, record.FamilyID, record.ExpiresAt)
+}
+
+// LogoutEverywhere ends every session of a player and revokes all of its refresh tokens
+func (s *PlayerService) LogoutEverywhere(ctx context.Context, playerID uuid.UUID) error {
+	log := s.logger.WithTraceContext(ctx)
+
+	if err := s.sessionRepo.DeactivateAllPlayerSessions(ctx, playerID, session.LogoutReasonRevoked); err != nil {
+		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to deactivate player sessions")
+		return fmt.Errorf("failed to logout everywhere: %w", err)
+	}
+
+	if s.cache != nil {
+		if err := s.cache.DeletePlayerSessions(ctx, playerID.String()); err != nil {
+			log.Warn().Err(err).Str("player_id", playerID.String()).Msg("Failed to clear player sessions from cache")
+			// Don't fail - DB sessions are already invalidated
+		}
+	}
+
+	if s.refreshEnabled() {
+		if err := s.refreshStore.RevokePlayer(ctx, playerID, time.Now().UTC(), s.refreshTokenTTL()); err != nil {
+			log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to revoke refresh tokens")
+			return fmt.Errorf("failed to logout everywhere: %w", err)
+		}
+	}
+
+	log.Info().Str("player_id", playerID.String()).Msg("Player logged out everywhere")
+	return nil
+}
+
+// isRefreshRevoked checks the family and player revocation lists
+func (s *PlayerService) isRefreshRevoked(ctx context.Context, record *session.RefreshToken) (bool, error) {
+	revoked, err := s.refreshStore.IsFamilyRevoked(ctx, record.FamilyID)
+	if err != nil || revoked {
+		return revoked, err
+	}
+
+	revokedAt, err := s.refreshStore.PlayerRevokedAt(ctx, record.PlayerID)
+	if err != nil {
+		return false, err
+	}
+	return !revokedAt.IsZero() && !record.IssuedAt.After(revokedAt), nil
+}
+
+// revokeRefreshFamily revokes a token family and ends its current session
+func (s *PlayerService) revokeRefreshFamily(ctx context.Context, record *session.RefreshToken) {
+	log := s.logger.WithTraceContext(ctx)
+
+	if err := s.refreshStore.RevokeFamily(ctx, record.FamilyID, record.ExpiresAt); err != nil {
+		log.Error().Err(err).Str("family_id", record.FamilyID).Msg("Failed to revoke refresh token family")
+	}
+
+	active, err := s.sessionRepo.GetActiveByPlayerAndGame(ctx, record.PlayerID, record.GameID)
+	if err != nil || active == nil {
+		return
+	}
+	family, err := s.refreshStore.FamilyForSession(ctx, active.ID)
+	if err != nil || family != record.FamilyID {
+		return
+	}
+	if err := s.deactivateSession(ctx, active, session.LogoutReasonRevoked); err != nil {
+		log.Error().Err(err).Str("session_id", active.ID.String()).Msg("Failed to end session of revoked token family")
+	}
+}
+
+// revokeSessionRefreshFamily revokes the token family issued with a login session, if any
+func (s *PlayerService) revokeSessionRefreshFamily(ctx context.Context, sessionID uuid.UUID) {
+	if !s.refreshEnabled() {
+		return
+	}
+
+	log := s.logger.WithTraceContext(ctx)
+	family, err := s.refreshStore.FamilyForSession(ctx, sessionID)
+	if err != nil {
+		log.Warn().Err(err).Str("session_id", sessionID.String()).Msg("Failed to look up refresh token family")
+		return
+	}
+	if family == "" {
+		return
+	}
+	if err := s.refreshStore.RevokeFamily(ctx, family, time.Now().Add(s.refreshTokenTTL())); err != nil {
+		log.Warn().Err(err).Str("family_id", family).Msg("Failed to revoke refresh token family")
+	}
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRefreshTokenStore is an in-memory session.RefreshTokenStore
+type memoryRefreshTokenStore struct {
+	mu            sync.Mutex
+	tokens        map[string]*session.RefreshToken
+	used          map[string]bool
+	sessions      map[uuid.UUID]string
+	revoked       map[string]bool
+	playerRevoked map[uuid.UUID]time.Time
+}
+
+func newMemoryRefreshTokenStore() *memoryRefreshTokenStore {
+	return &memoryRefreshTokenStore{
+		tokens:        make(map[string]*session.RefreshToken),
+		used:          make(map[string]bool),
+		sessions:      make(map[uuid.UUID]string),
+		revoked:       make(map[string]bool),
+		playerRevoked: make(map[uuid.UUID]time.Time),
+	}
+}
+
+func (s *memoryRefreshTokenStore) Save(ctx context.Context, tokenHash string, token *session.RefreshToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[tokenHash] = token
+	s.sessions[token.SessionID] = token.FamilyID
+	return nil
+}
+
+func (s *memoryRefreshTokenStore) Get(ctx context.Context, tokenHash string) (*session.RefreshToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token, ok := s.tokens[tokenHash]
+	if !ok {
+		return nil, session.ErrRefreshTokenInvalid
+	}
+	return token, nil
+}
+
+func (s *memoryRefreshTokenStore) MarkUsed(ctx context.Context, tokenHash string, expiresAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.used[tokenHash] {
+		return false, nil
+	}
+	s.used[tokenHash] = true
+	return true, nil
+}
+
+func (s *memoryRefreshTokenStore) FamilyForSession(ctx context.Context, sessionID uuid.UUID) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sessions[sessionID], nil
+}
+
+func (s *memoryRefreshTokenStore) RevokeFamily(ctx context.Context, familyID string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.revoked[familyID] = true
+	return nil
+}
+
+func (s *memoryRefreshTokenStore) IsFamilyRevoked(ctx context.Context, familyID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.revoked[familyID], nil
+}
+
+func (s *memoryRefreshTokenStore) RevokePlayer(ctx context.Context, playerID uuid.UUID, at time.Time, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.playerRevoked[playerID] = at
+	return nil
+}
+
+func (s *memoryRefreshTokenStore) PlayerRevokedAt(ctx context.Context, playerID uuid.UUID) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.playerRevoked[playerID], nil
+}
+
+func setupRefreshPlayerService() (*PlayerService, *MockPlayerRepository, *MockPlayerSessionRepository, *memoryRefreshTokenStore) {
+	mockRepo := new(MockPlayerRepository)
+	mockSessionRepo := new(MockPlayerSessionRepository)
+	store := newMemoryRefreshTokenStore()
+	cfg := &config.Config{
+		JWT:        config.JWTConfig{ExpirationHours: 24},
+		PlayerAuth: config.PlayerAuthConfig{AccessTokenMinutes: 15, RefreshTokenHours: 720},
+	}
+	svc := NewPlayerService(mockRepo, new(MockGameRepository), mockSessionRepo, nil, store, clock.New(), cfg, logger.New("error", "json")).(*PlayerService)
+	return svc, mockRepo, mockSessionRepo, store
+}
+
+// loginWithRefresh issues a session and refresh token as Login does after authentication
+func loginWithRefresh(t *testing.T, svc *PlayerService, sessionRepo *MockPlayerSessionRepository, p *player.Player) (*player.LoginResult, *session.PlayerSession) {
+	sessionRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Once()
+	sess, err := svc.createSession(context.Background(), p.ID, nil, &player.LoginOptions{})
+	require.NoError(t, err)
+
+	result := &player.LoginResult{Player: p, SessionToken: sess.SessionToken}
+	require.NoError(t, svc.issueRefreshToken(context.Background(), result, sess, uuid.NewString(), time.Now().Add(svc.refreshTokenTTL()), "phone"))
+	return result, sess
+}
+
+func TestPlayerService_Refresh(t *testing.T) {
+	ctx := context.Background()
+	p := &player.Player{ID: uuid.New(), Username: "testuser", IsActive: true}
+
+	t.Run("rotates the refresh token and replaces the session", func(t *testing.T) {
+		svc, repo, sessionRepo, _ := setupRefreshPlayerService()
+		login, sess := loginWithRefresh(t, svc, sessionRepo, p)
+		assert.WithinDuration(t, time.Now().Add(15*time.Minute), sess.ExpiresAt, time.Minute)
+
+		repo.On("GetByID", ctx, p.ID).Return(p, nil)
+		sessionRepo.On("GetActiveByPlayerAndGame", ctx, p.ID, (*uuid.UUID)(nil)).Return(sess, nil).Once()
+		sessionRepo.On("DeactivateSession", ctx, sess.ID, session.LogoutReasonRefresh).Return(nil).Once()
+		sessionRepo.On("Create", ctx, mock.Anything).Return(nil).Once()
+
+		result, err := svc.Refresh(ctx, login.RefreshToken, nil)
+		require.NoError(t, err)
+		assert.NotEqual(t, login.SessionToken, result.SessionToken)
+		assert.NotEmpty(t, result.RefreshToken)
+		assert.NotEqual(t, login.RefreshToken, result.RefreshToken)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("reuse of a rotated token revokes the family", func(t *testing.T) {
+		svc, repo, sessionRepo, store := setupRefreshPlayerService()
+		login, sess := loginWithRefresh(t, svc, sessionRepo, p)
+
+		repo.On("GetByID", ctx, p.ID).Return(p, nil)
+		sessionRepo.On("GetActiveByPlayerAndGame", ctx, p.ID, (*uuid.UUID)(nil)).Return(sess, nil).Once()
+		sessionRepo.On("DeactivateSession", ctx, sess.ID, session.LogoutReasonRefresh).Return(nil).Once()
+		sessionRepo.On("Create", ctx, mock.Anything).Return(nil).Once()
+		rotated, err := svc.Refresh(ctx, login.RefreshToken, nil)
+		require.NoError(t, err)
+
+		// The replayed token ends the session issued from the rotation
+		current := &session.PlayerSession{ID: store.tokens[hashRefreshToken(rotated.RefreshToken)].SessionID, PlayerID: p.ID}
+		sessionRepo.On("GetActiveByPlayerAndGame", ctx, p.ID, (*uuid.UUID)(nil)).Return(current, nil)
+		sessionRepo.On("DeactivateSession", ctx, current.ID, session.LogoutReasonRevoked).Return(nil).Once()
+
+		_, err = svc.Refresh(ctx, login.RefreshToken, nil)
+		assert.ErrorIs(t, err, session.ErrRefreshTokenReused)
+
+		_, err = svc.Refresh(ctx, rotated.RefreshToken, nil)
+		assert.ErrorIs(t, err, session.ErrRefreshTokenRevoked)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("logout everywhere revokes issued tokens", func(t *testing.T) {
+		svc, _, sessionRepo, _ := setupRefreshPlayerService()
+		login, _ := loginWithRefresh(t, svc, sessionRepo, p)
+
+		sessionRepo.On("DeactivateAllPlayerSessions", ctx, p.ID, session.LogoutReasonRevoked).Return(nil).Once()
+		require.NoError(t, svc.LogoutEverywhere(ctx, p.ID))
+
+		_, err := svc.Refresh(ctx, login.RefreshToken, nil)
+		assert.ErrorIs(t, err, session.ErrRefreshTokenRevoked)
+	})
+
+	t.Run("revoked token is rejected", func(t *testing.T) {
+		svc, _, sessionRepo, _ := setupRefreshPlayerService()
+		login, _ := loginWithRefresh(t, svc, sessionRepo, p)
+
+		require.NoError(t, svc.RevokeRefreshToken(ctx, login.RefreshToken))
+		require.NoError(t, svc.RevokeRefreshToken(ctx, "unknown"))
+
+		_, err := svc.Refresh(ctx, login.RefreshToken, nil)
+		assert.ErrorIs(t, err, session.ErrRefreshTokenRevoked)
+	})
+
+	t.Run("unknown token is invalid", func(t *testing.T) {
+		svc, _, _, _ := setupRefreshPlayerService()
+		_, err := svc.Refresh(ctx, "unknown", nil)
+		assert.ErrorIs(t, err, session.ErrRefreshTokenInvalid)
+	})
+
+	t.Run("unavailable without a store", func(t *testing.T) {
+		svc, _, _, _ := setupPlayerService()
+		_, err := svc.Refresh(ctx, "token", nil)
+		assert.ErrorIs(t, err, session.ErrRefreshTokenUnavailable)
+		assert.Equal(t, 24*time.Hour, svc.accessTokenTTL())
+	})
+}
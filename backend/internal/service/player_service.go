@@ -7,7 +7,6 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/slotmachine/backend/domain/game"
@@ -15,18 +14,21 @@ import (
 	"github.com/slotmachine/backend/domain/session"
 	"github.com/slotmachine/backend/internal/config"
 	"github.com/slotmachine/backend/internal/infra/cache"
+	"github.com/slotmachine/backend/internal/pkg/clock"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/slotmachine/backend/internal/pkg/util"
 )
 
 // PlayerService implements the player.Service interface
 type PlayerService struct {
-	repo        player.Repository
-	gameRepo    game.Repository
-	sessionRepo session.PlayerSessionRepository
-	cache       *cache.RedisClient
-	config      *config.Config
-	logger      *logger.Logger
+	repo         player.Repository
+	gameRepo     game.Repository
+	sessionRepo  session.PlayerSessionRepository
+	cache        *cache.RedisClient
+	refreshStore session.RefreshTokenStore // nil disables refresh tokens
+	clock        clock.Clock               // Times session expiry
+	config       *config.Config
+	logger       *logger.Logger
 }
 
 // NewPlayerService creates a new player service
@@ -35,16 +37,20 @@ func NewPlayerService(
 	gameRepo game.Repository,
 	sessionRepo session.PlayerSessionRepository,
 	cache *cache.RedisClient,
+	refreshStore session.RefreshTokenStore,
+	clk clock.Clock,
 	cfg *config.Config,
 	log *logger.Logger,
 ) player.Service {
 	return &PlayerService{
-		repo:        repo,
-		gameRepo:    gameRepo,
-		sessionRepo: sessionRepo,
-		cache:       cache,
-		config:      cfg,
-		logger:      log,
+		repo:         repo,
+		gameRepo:     gameRepo,
+		sessionRepo:  sessionRepo,
+		cache:        cache,
+		refreshStore: refreshStore,
+		clock:        clk,
+		config:       cfg,
+		logger:       log,
 	}
 }
 
@@ -175,6 +181,60 @@ func (s *PlayerService) Login(ctx context.Context, username, password string, ga
 		return nil, player.ErrInvalidCredentials
 	}
 
+	result, err := s.startLogin(ctx, p, gameID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("player_id", p.ID.String()).
+		Str("username", username).
+		Interface("game_id", gameID).
+		Bool("force_logout", opts.ForceLogout).
+		Msg("Player logged in successfully")
+
+	return result, nil
+}
+
+// LoginByID starts a session for an already authenticated player, such as one vouched
+// for by an operator; any existing session for the game is logged out
+func (s *PlayerService) LoginByID(ctx context.Context, playerID uuid.UUID, gameID *uuid.UUID, opts *player.LoginOptions) (*player.LoginResult, error) {
+	log := s.logger.WithTraceContext(ctx)
+
+	if opts == nil {
+		opts = &player.LoginOptions{}
+	}
+	opts.ForceLogout = true
+
+	p, err := s.repo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, player.ErrPlayerNotFound
+	}
+	if err := s.validateGameAccess(p, gameID); err != nil {
+		return nil, err
+	}
+	if !p.IsActive {
+		return nil, fmt.Errorf("player account is not active")
+	}
+
+	result, err := s.startLogin(ctx, p, gameID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("player_id", p.ID.String()).
+		Interface("game_id", gameID).
+		Msg("Player logged in by ID")
+
+	return result, nil
+}
+
+// startLogin replaces or rejects an existing session, creates a new one and issues a
+// refresh token for an authenticated player
+func (s *PlayerService) startLogin(ctx context.Context, p *player.Player, gameID *uuid.UUID, opts *player.LoginOptions) (*player.LoginResult, error) {
+	log := s.logger.WithTraceContext(ctx)
+
 	// Check for existing active session
 	existingSession, err := s.sessionRepo.GetActiveByPlayerAndGame(ctx, p.ID, gameID)
 	if err == nil && existingSession != nil {
@@ -183,7 +243,7 @@ func (s *PlayerService) Login(ctx context.Context, username, password string, ga
 			// Return error - player must explicitly force logout
 			log.Warn().
 				Str("player_id", p.ID.String()).
-				Str("username", username).
+				Str("username", p.Username).
 				Msg("Player already logged in on another device")
 			return nil, session.ErrPlayerAlreadyLoggedIn
 		}
@@ -200,6 +260,44 @@ func (s *PlayerService) Login(ctx context.Context, username, password string, ga
 		}
 	}
 
+	newSession, err := s.createSession(ctx, p.ID, gameID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &player.LoginResult{
+		Player:       p,
+		SessionToken: newSession.SessionToken,
+		ExpiresAt:    newSession.ExpiresAt.Unix(),
+	}
+
+	// Issue a refresh token starting a new token family
+	if s.refreshEnabled() {
+		refreshExpiresAt := s.clock.Now().UTC().Add(s.refreshTokenTTL())
+		if err := s.issueRefreshToken(ctx, result, newSession, uuid.NewString(), refreshExpiresAt, opts.DeviceInfo); err != nil {
+			log.Warn().Err(err).Str("player_id", p.ID.String()).Msg("Failed to issue refresh token")
+			// Don't fail login - the session token still works until it expires
+		}
+	}
+
+	// Update last login timestamp
+	if err := s.repo.UpdateLastLogin(ctx, p.ID); err != nil {
+		log.Error().Err(err).Str("player_id", p.ID.String()).Msg("Failed to update last login")
+		// Don't fail the login for this
+	}
+
+	log.Debug().
+		Str("player_id", p.ID.String()).
+		Str("session_id", newSession.ID.String()).
+		Msg("Player session started")
+
+	return result, nil
+}
+
+// createSession creates an active login session in the database and caches it in Redis
+func (s *PlayerService) createSession(ctx context.Context, playerID uuid.UUID, gameID *uuid.UUID, opts *player.LoginOptions) (*session.PlayerSession, error) {
+	log := s.logger.WithTraceContext(ctx)
+
 	// Generate new session token
 	sessionToken, err := generateSessionToken()
 	if err != nil {
@@ -208,11 +306,8 @@ func (s *PlayerService) Login(ctx context.Context, username, password string, ga
 	}
 
 	// Calculate expiration time
-	expirationHours := s.config.JWT.ExpirationHours
-	if expirationHours <= 0 {
-		expirationHours = 24
-	}
-	expiresAt := time.Now().UTC().Add(time.Duration(expirationHours) * time.Hour)
+	ttl := s.accessTokenTTL()
+	expiresAt := s.clock.Now().UTC().Add(ttl)
 
 	// Create player session
 	var ipAddr, userAgent, deviceInfo *string
@@ -228,21 +323,21 @@ func (s *PlayerService) Login(ctx context.Context, username, password string, ga
 
 	newSession := &session.PlayerSession{
 		ID:             uuid.New(),
-		PlayerID:       p.ID,
+		PlayerID:       playerID,
 		GameID:         gameID,
 		SessionToken:   sessionToken,
 		DeviceInfo:     deviceInfo,
 		IPAddress:      ipAddr,
 		UserAgent:      userAgent,
 		IsActive:       true,
-		CreatedAt:      time.Now().UTC(),
+		CreatedAt:      s.clock.Now().UTC(),
 		ExpiresAt:      expiresAt,
-		LastActivityAt: time.Now().UTC(),
+		LastActivityAt: s.clock.Now().UTC(),
 	}
 
 	// Save session to database
 	if err := s.sessionRepo.Create(ctx, newSession); err != nil {
-		log.Error().Err(err).Str("player_id", p.ID.String()).Msg("Failed to create player session")
+		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to create player session")
 		return nil, fmt.Errorf("failed to create session: %w", err)
 	}
 
@@ -254,35 +349,17 @@ func (s *PlayerService) Login(ctx context.Context, username, password string, ga
 		}
 		sessionData := &cache.SessionData{
 			SessionID: newSession.ID.String(),
-			PlayerID:  p.ID.String(),
+			PlayerID:  playerID.String(),
 			GameID:    gameIDStr,
 			ExpiresAt: expiresAt.Unix(),
 		}
-		if err := s.cache.SetSession(ctx, sessionToken, sessionData, time.Duration(expirationHours)*time.Hour); err != nil {
+		if err := s.cache.SetSession(ctx, sessionToken, sessionData, ttl); err != nil {
 			log.Warn().Err(err).Msg("Failed to cache session in Redis, falling back to DB validation")
 			// Don't fail login - DB validation will still work
 		}
 	}
 
-	// Update last login timestamp
-	if err := s.repo.UpdateLastLogin(ctx, p.ID); err != nil {
-		log.Error().Err(err).Str("player_id", p.ID.String()).Msg("Failed to update last login")
-		// Don't fail the login for this
-	}
-
-	log.Info().
-		Str("player_id", p.ID.String()).
-		Str("session_id", newSession.ID.String()).
-		Str("username", username).
-		Interface("game_id", gameID).
-		Bool("force_logout", opts.ForceLogout).
-		Msg("Player logged in successfully")
-
-	return &player.LoginResult{
-		Player:       p,
-		SessionToken: sessionToken,
-		ExpiresAt:    expiresAt.Unix(),
-	}, nil
+	return newSession, nil
 }
 
 // Logout invalidates the player's session
@@ -333,7 +410,7 @@ func (s *PlayerService) ValidateSession(ctx context.Context, sessionToken string
 			log.Warn().Err(err).Msg("Redis cache error, falling back to DB")
 		} else if cachedSession != nil {
 			// Validate expiration
-			if time.Now().Unix() > cachedSession.ExpiresAt {
+			if s.clock.Now().Unix() > cachedSession.ExpiresAt {
 				// Session expired - remove from cache
 				_ = s.cache.DeleteSession(ctx, sessionToken)
 				return nil, session.ErrPlayerSessionExpired
@@ -386,7 +463,7 @@ func (s *PlayerService) ValidateSession(ctx context.Context, sessionToken string
 	}
 
 	// Check if session is expired
-	if time.Now().UTC().After(sess.ExpiresAt) {
+	if s.clock.Now().UTC().After(sess.ExpiresAt) {
 		// Mark session as expired in database
 		_ = s.sessionRepo.DeactivateSession(ctx, sess.ID, session.LogoutReasonExpired)
 		return nil, session.ErrPlayerSessionExpired
@@ -421,7 +498,7 @@ func (s *PlayerService) ValidateSession(ctx context.Context, sessionToken string
 			ExpiresAt: sess.ExpiresAt.Unix(),
 		}
 		// Calculate remaining TTL
-		ttl := time.Until(sess.ExpiresAt)
+		ttl := sess.ExpiresAt.Sub(s.clock.Now())
 		if ttl > 0 {
 			if err := s.cache.SetSession(ctx, sessionToken, sessionData, ttl); err != nil {
 				log.Warn().Err(err).Msg("Failed to cache session in Redis after DB validation")
@@ -460,6 +537,11 @@ func (s *PlayerService) deactivateSession(ctx context.Context, sess *session.Pla
 		}
 	}
 
+	// A session replaced by refresh keeps its token family; any other logout ends it
+	if reason != session.LogoutReasonRefresh {
+		s.revokeSessionRefreshFamily(ctx, sess.ID)
+	}
+
 	return nil
 }
 
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/slotmachine/backend/domain/game"
 	"github.com/slotmachine/backend/domain/player"
 	"github.com/slotmachine/backend/domain/session"
 	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -92,6 +94,14 @@ func (m *MockPlayerRepository) List(ctx context.Context, filters player.ListFilt
 	return args.Get(0).([]*player.Player), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockPlayerRepository) Search(ctx context.Context, filters player.SearchFilters) ([]*player.Player, error) {
+	args := m.Called(ctx, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*player.Player), args.Error(1)
+}
+
 func (m *MockPlayerRepository) GetByUsernameAndGame(ctx context.Context, username string, gameID *uuid.UUID) (*player.Player, error) {
 	args := m.Called(ctx, username, gameID)
 	if args.Get(0) == nil {
@@ -126,6 +136,11 @@ func (m *MockPlayerRepository) UpdateBalanceWithLockAndTx(ctx context.Context, i
 	return args.Error(0)
 }
 
+func (m *MockPlayerRepository) LockBalanceWithTx(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
 // MockGameRepository is a mock implementation of game.Repository
 type MockGameRepository struct {
 	mock.Mock
@@ -170,6 +185,14 @@ func (m *MockGameRepository) DeleteGame(ctx context.Context, id uuid.UUID) error
 	return args.Error(0)
 }
 
+func (m *MockGameRepository) RestoreGame(ctx context.Context, id uuid.UUID) (*game.Game, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*game.Game), args.Error(1)
+}
+
 func (m *MockGameRepository) GetAssetByID(ctx context.Context, id uuid.UUID) (*game.Asset, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -201,6 +224,45 @@ func (m *MockGameRepository) DeleteAsset(ctx context.Context, id uuid.UUID) erro
 	return args.Error(0)
 }
 
+func (m *MockGameRepository) RestoreAsset(ctx context.Context, id uuid.UUID) (*game.Asset, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*game.Asset), args.Error(1)
+}
+
+func (m *MockGameRepository) GetAssetByObjectName(ctx context.Context, objectName string) (*game.Asset, error) {
+	args := m.Called(ctx, objectName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*game.Asset), args.Error(1)
+}
+
+func (m *MockGameRepository) UpsertAssetFile(ctx context.Context, f *game.AssetFile) error {
+	args := m.Called(ctx, f)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) ListAssetFiles(ctx context.Context, assetID uuid.UUID) ([]*game.AssetFile, error) {
+	args := m.Called(ctx, assetID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*game.AssetFile), args.Error(1)
+}
+
+func (m *MockGameRepository) DeleteAssetFile(ctx context.Context, assetID uuid.UUID, path string) error {
+	args := m.Called(ctx, assetID, path)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) DeleteAssetFiles(ctx context.Context, assetID uuid.UUID) error {
+	args := m.Called(ctx, assetID)
+	return args.Error(0)
+}
+
 func (m *MockGameRepository) GetActiveAssetForGame(ctx context.Context, gameID uuid.UUID) (*game.Asset, error) {
 	args := m.Called(ctx, gameID)
 	if args.Get(0) == nil {
@@ -209,6 +271,54 @@ func (m *MockGameRepository) GetActiveAssetForGame(ctx context.Context, gameID u
 	return args.Get(0).(*game.Asset), args.Error(1)
 }
 
+func (m *MockGameRepository) GetActiveGameConfig(ctx context.Context, gameID uuid.UUID) (*game.GameConfig, error) {
+	args := m.Called(ctx, gameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*game.GameConfig), args.Error(1)
+}
+
+func (m *MockGameRepository) UpdateGameConfigLadder(ctx context.Context, id uuid.UUID, ladder *game.MultiplierLadder) (*game.GameConfig, error) {
+	args := m.Called(ctx, id, ladder)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*game.GameConfig), args.Error(1)
+}
+
+func (m *MockGameRepository) UpdateGameConfigTriggerRules(ctx context.Context, id uuid.UUID, rules *game.TriggerRules) (*game.GameConfig, error) {
+	args := m.Called(ctx, id, rules)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*game.GameConfig), args.Error(1)
+}
+
+func (m *MockGameRepository) UpdateGameConfigWildFeatures(ctx context.Context, id uuid.UUID, features *game.WildFeatures) (*game.GameConfig, error) {
+	args := m.Called(ctx, id, features)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*game.GameConfig), args.Error(1)
+}
+
+func (m *MockGameRepository) UpdateGameConfigWinTiers(ctx context.Context, id uuid.UUID, tiers *game.WinTiers) (*game.GameConfig, error) {
+	args := m.Called(ctx, id, tiers)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*game.GameConfig), args.Error(1)
+}
+
+func (m *MockGameRepository) UpdateGameConfigTimingProfiles(ctx context.Context, id uuid.UUID, profiles *game.TimingProfiles) (*game.GameConfig, error) {
+	args := m.Called(ctx, id, profiles)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*game.GameConfig), args.Error(1)
+}
+
 func (m *MockGameRepository) GetGameConfigByID(ctx context.Context, id uuid.UUID) (*game.GameConfig, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -232,6 +342,14 @@ func (m *MockGameRepository) DeleteGameConfig(ctx context.Context, id uuid.UUID)
 	return args.Error(0)
 }
 
+func (m *MockGameRepository) RestoreGameConfig(ctx context.Context, id uuid.UUID) (*game.GameConfig, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*game.GameConfig), args.Error(1)
+}
+
 func (m *MockGameRepository) ActivateGameConfig(ctx context.Context, id uuid.UUID) (*game.GameConfig, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -314,7 +432,7 @@ func setupPlayerService() (*PlayerService, *MockPlayerRepository, *MockGameRepos
 			ExpirationHours: 24,
 		},
 	}
-	service := NewPlayerService(mockRepo, mockGameRepo, mockSessionRepo, nil, cfg, log).(*PlayerService)
+	service := NewPlayerService(mockRepo, mockGameRepo, mockSessionRepo, nil, nil, clock.New(), cfg, log).(*PlayerService)
 	return service, mockRepo, mockGameRepo, mockSessionRepo
 }
 
@@ -1026,3 +1144,26 @@ func TestCreditWin(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestValidateSession_Expiry(t *testing.T) {
+	ctx := context.Background()
+	service, mockRepo, _, mockSessionRepo := setupPlayerService()
+	clk := clock.NewFake(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	service.clock = clk
+
+	playerID := uuid.New()
+	sess := &session.PlayerSession{ID: uuid.New(), PlayerID: playerID, IsActive: true, ExpiresAt: clk.Now().Add(time.Hour)}
+	mockSessionRepo.On("GetByToken", ctx, "token").Return(sess, nil)
+	mockSessionRepo.On("UpdateLastActivity", mock.Anything, sess.ID).Return(nil).Maybe()
+	mockSessionRepo.On("DeactivateSession", ctx, sess.ID, session.LogoutReasonExpired).Return(nil)
+	mockRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID}, nil)
+
+	result, err := service.ValidateSession(ctx, "token", nil)
+	require.NoError(t, err, "the session expires an hour after the clock's time")
+	assert.Equal(t, playerID, result.Player.ID)
+
+	clk.Advance(2 * time.Hour)
+	_, err = service.ValidateSession(ctx, "token", nil)
+	assert.ErrorIs(t, err, session.ErrPlayerSessionExpired)
+	mockSessionRepo.AssertCalled(t, "DeactivateSession", ctx, sess.ID, session.LogoutReasonExpired)
+}
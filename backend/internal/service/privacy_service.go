@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/privacy"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// PrivacyService implements privacy.Service
+type PrivacyService struct {
+	repo          privacy.Repository
+	sessionRepo   session.Repository
+	playerService player.Service
+	logger        *logger.Logger
+	now           func() time.Time
+}
+
+// NewPrivacyService creates a new privacy service
+func NewPrivacyService(
+	repo privacy.Repository,
+	sessionRepo session.Repository,
+	playerService player.Service,
+	log *logger.Logger,
+) *PrivacyService {
+	return &PrivacyService{
+		repo:          repo,
+		sessionRepo:   sessionRepo,
+		playerService: playerService,
+		logger:        log,
+		now:           time.Now,
+	}
+}
+
+// Export returns every record held about a player
+func (s *PrivacyService) Export(ctx context.Context, playerID uuid.UUID) (*privacy.Archive, error) {
+	sections, err := s.repo.Export(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Str("player_id", playerID.String()).
+		Int("sections", len(sections)).
+		Msg("Player data exported")
+	return &privacy.Archive{
+		PlayerID:    playerID,
+		GeneratedAt: s.now().UTC(),
+		Sections:    sections,
+	}, nil
+}
+
+// Erase anonymizes a player and signs it out everywhere
+// A player in the middle of a game session cannot be erased; the session must end
+// first so that its provably fair chain is closed and revealed.
+func (s *PrivacyService) Erase(ctx context.Context, playerID uuid.UUID, reason, actor string) (*privacy.Erasure, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, privacy.ErrReasonRequired
+	}
+
+	if _, err := s.sessionRepo.GetActiveSessionByPlayer(ctx, playerID); err == nil {
+		return nil, privacy.ErrActiveSession
+	} else if !errors.Is(err, session.ErrSessionNotFound) {
+		return nil, err
+	}
+
+	// Sign out first: erasure deletes the login sessions the logout would look up
+	if err := s.playerService.LogoutEverywhere(ctx, playerID); err != nil {
+		return nil, err
+	}
+
+	erasure, err := s.repo.Erase(ctx, playerID, privacy.Pseudonym(playerID), s.now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	erasure.Reason = reason
+	erasure.ErasedBy = actor
+	erasure.Retained = privacy.RetainedTables
+
+	s.logger.Info().
+		Str("player_id", playerID.String()).
+		Str("erased_by", actor).
+		Str("reason", reason).
+		Msg("Player data erased")
+	return erasure, nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/privacy"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockPrivacyRepository is a mock implementation of privacy.Repository
+type MockPrivacyRepository struct {
+	mock.Mock
+}
+
+func (m *MockPrivacyRepository) Export(ctx context.Context, playerID uuid.UUID) ([]privacy.Section, error) {
+	args := m.Called(ctx, playerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]privacy.Section), args.Error(1)
+}
+
+func (m *MockPrivacyRepository) Erase(ctx context.Context, playerID uuid.UUID, pseudonym string, erasedAt time.Time) (*privacy.Erasure, error) {
+	args := m.Called(ctx, playerID, pseudonym, erasedAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*privacy.Erasure), args.Error(1)
+}
+
+func TestPrivacyService_Erase(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	erasedAt := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	newService := func() (*PrivacyService, *MockPrivacyRepository, *MockSessionRepository, *MockPlayerSessionRepository, *memoryRefreshTokenStore) {
+		repo := new(MockPrivacyRepository)
+		sessionRepo := new(MockSessionRepository)
+		playerService, _, playerSessionRepo, store := setupRefreshPlayerService()
+		svc := NewPrivacyService(repo, sessionRepo, playerService, logger.New("error", "json"))
+		svc.now = func() time.Time { return erasedAt }
+		return svc, repo, sessionRepo, playerSessionRepo, store
+	}
+
+	t.Run("should sign the player out and pseudonymize it", func(t *testing.T) {
+		svc, repo, sessionRepo, playerSessionRepo, store := newService()
+		sessionRepo.On("GetActiveSessionByPlayer", ctx, playerID).Return(nil, session.ErrSessionNotFound)
+		playerSessionRepo.On("DeactivateAllPlayerSessions", ctx, playerID, session.LogoutReasonRevoked).Return(nil)
+		repo.On("Erase", ctx, playerID, privacy.Pseudonym(playerID), erasedAt).
+			Return(&privacy.Erasure{PlayerID: playerID, Pseudonym: privacy.Pseudonym(playerID), ErasedAt: erasedAt}, nil)
+
+		erasure, err := svc.Erase(ctx, playerID, " DSR-42 ", "dpo")
+
+		require.NoError(t, err)
+		assert.Equal(t, "DSR-42", erasure.Reason)
+		assert.Equal(t, "dpo", erasure.ErasedBy)
+		assert.Contains(t, erasure.Retained, "spin_logs")
+		assert.False(t, store.playerRevoked[playerID].IsZero(), "refresh tokens are revoked")
+		playerSessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("should require a reason", func(t *testing.T) {
+		svc, _, _, _, _ := newService()
+
+		_, err := svc.Erase(ctx, playerID, "  ", "dpo")
+
+		assert.ErrorIs(t, err, privacy.ErrReasonRequired)
+	})
+
+	t.Run("should refuse while a game session is active", func(t *testing.T) {
+		svc, repo, sessionRepo, _, _ := newService()
+		sessionRepo.On("GetActiveSessionByPlayer", ctx, playerID).Return(&session.GameSession{ID: uuid.New()}, nil)
+
+		_, err := svc.Erase(ctx, playerID, "DSR-42", "dpo")
+
+		assert.ErrorIs(t, err, privacy.ErrActiveSession)
+		repo.AssertNotCalled(t, "Erase", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPrivacyService_Export(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	repo := new(MockPrivacyRepository)
+	svc := NewPrivacyService(repo, nil, nil, logger.New("error", "json"))
+
+	sections := []privacy.Section{{Name: "player", Records: []privacy.Record{{"id": playerID.String()}}}}
+	repo.On("Export", ctx, playerID).Return(sections, nil)
+
+	archive, err := svc.Export(ctx, playerID)
+
+	require.NoError(t, err)
+	assert.Equal(t, playerID, archive.PlayerID)
+	assert.Equal(t, sections, archive.Sections)
+	assert.False(t, archive.GeneratedAt.IsZero())
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/provablyfair"
+)
+
+// AuditEndedSessions re-verifies the hash chains of up to limit sessions ended since endedSince
+// and records a chain audit for each. Sessions whose data could not be read are skipped and
+// retried on the next pass; only definite results are recorded.
+func (s *ProvablyFairService) AuditEndedSessions(ctx context.Context, endedSince time.Time, limit int) ([]provablyfair.ChainAudit, error) {
+	sessions, err := s.repo.ListUnauditedSessions(ctx, endedSince, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	audits := make([]provablyfair.ChainAudit, 0, len(sessions))
+	for i := range sessions {
+		audit, err := s.auditSessionChain(ctx, &sessions[i])
+		if err != nil {
+			s.logger.Warn().Err(err).Str("session_id", sessions[i].ID.String()).Msg("Failed to audit PF session chain")
+			continue
+		}
+		if err := s.repo.CreateChainAudit(ctx, audit); err != nil {
+			return audits, err
+		}
+		audits = append(audits, *audit)
+	}
+	return audits, nil
+}
+
+// ListChainAudits lists recorded chain audits newest first
+func (s *ProvablyFairService) ListChainAudits(ctx context.Context, failedOnly bool, limit, offset int) ([]provablyfair.ChainAudit, int64, error) {
+	return s.repo.ListChainAudits(ctx, failedOnly, limit, offset)
+}
+
+// auditSessionChain checks the revealed seed against the session's commitment and stored
+// encrypted seed, then re-verifies every spin hash with VerifyHashChain
+func (s *ProvablyFairService) auditSessionChain(ctx context.Context, session *provablyfair.PFSession) (*provablyfair.ChainAudit, error) {
+	audit := &provablyfair.ChainAudit{
+		ID:          uuid.New(),
+		PFSessionID: session.ID,
+		PlayerID:    session.PlayerID,
+		CheckedAt:   s.clock.Now().UTC(),
+	}
+	fail := func(reason string) (*provablyfair.ChainAudit, error) {
+		audit.Error = reason
+		return audit, nil
+	}
+
+	revealed, err := s.repo.GetSessionAudit(ctx, session.ID)
+	if err != nil {
+		if errors.Is(err, provablyfair.ErrSessionNotFound) {
+			return fail("server seed was never revealed")
+		}
+		return nil, err
+	}
+	if revealed.ServerSeedHash != session.ServerSeedHash {
+		return fail(fmt.Sprintf("revealed server seed hash %s does not match session commitment %s", revealed.ServerSeedHash, session.ServerSeedHash))
+	}
+
+	stored, err := s.encryptor.Decrypt(session.EncryptedServerSeed)
+	if err != nil {
+		return fail(fmt.Sprintf("stored server seed cannot be decrypted: %v", err))
+	}
+	if stored != revealed.ServerSeedPlaintext {
+		return fail("stored server seed does not match revealed server seed")
+	}
+
+	spinLogs, err := s.repo.GetSpinLogsBySession(ctx, session.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spin logs: %w", err)
+	}
+	audit.SpinCount = int64(len(spinLogs))
+
+	valid, err := s.hashGenerator.VerifyHashChain(revealed.ServerSeedPlaintext, session.ServerSeedHash, session.ThetaCommitment, spinVerifications(spinLogs))
+	if err != nil {
+		return fail(err.Error())
+	}
+	if !valid {
+		return fail(provablyfair.ErrHashChainBroken.Error())
+	}
+	if n := len(spinLogs); n > 0 && spinLogs[n-1].Nonce > revealed.TotalSpins {
+		return fail(fmt.Sprintf("spin nonce %d logged after the session revealed %d spins", spinLogs[n-1].Nonce, revealed.TotalSpins))
+	}
+
+	audit.Valid = true
+	return audit, nil
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/crypto"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryChainAuditRepo serves ended sessions and records chain audits in memory
+type memoryChainAuditRepo struct {
+	provablyfair.Repository
+	sessions []provablyfair.PFSession
+	reveals  map[uuid.UUID]*provablyfair.SessionAudit
+	logs     map[uuid.UUID][]provablyfair.SpinLog
+	audits   []provablyfair.ChainAudit
+}
+
+func (r *memoryChainAuditRepo) ListUnauditedSessions(ctx context.Context, endedSince time.Time, limit int) ([]provablyfair.PFSession, error) {
+	var out []provablyfair.PFSession
+	for _, s := range r.sessions {
+		audited := false
+		for _, a := range r.audits {
+			audited = audited || a.PFSessionID == s.ID
+		}
+		if !audited && !s.EndedAt.Before(endedSince) && len(out) < limit {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryChainAuditRepo) CreateChainAudit(ctx context.Context, audit *provablyfair.ChainAudit) error {
+	r.audits = append(r.audits, *audit)
+	return nil
+}
+
+func (r *memoryChainAuditRepo) GetSessionAudit(ctx context.Context, pfSessionID uuid.UUID) (*provablyfair.SessionAudit, error) {
+	if a, ok := r.reveals[pfSessionID]; ok {
+		return a, nil
+	}
+	return nil, provablyfair.ErrSessionNotFound
+}
+
+func (r *memoryChainAuditRepo) GetSpinLogsBySession(ctx context.Context, pfSessionID uuid.UUID) ([]provablyfair.SpinLog, error) {
+	return r.logs[pfSessionID], nil
+}
+
+func (r *memoryChainAuditRepo) GetSessionByID(ctx context.Context, id uuid.UUID) (*provablyfair.PFSession, error) {
+	for i := range r.sessions {
+		if r.sessions[i].ID == id {
+			return &r.sessions[i], nil
+		}
+	}
+	return nil, provablyfair.ErrSessionNotFound
+}
+
+func (r *memoryChainAuditRepo) GetSpinLogBySpinID(ctx context.Context, spinID uuid.UUID) (*provablyfair.SpinLog, error) {
+	for _, logs := range r.logs {
+		for i := range logs {
+			if logs[i].SpinID == spinID {
+				return &logs[i], nil
+			}
+		}
+	}
+	return nil, provablyfair.ErrSpinNotFound
+}
+
+func (r *memoryChainAuditRepo) GetSpinLogByIndex(ctx context.Context, pfSessionID uuid.UUID, spinIndex int64) (*provablyfair.SpinLog, error) {
+	for i, l := range r.logs[pfSessionID] {
+		if l.SpinIndex == spinIndex {
+			return &r.logs[pfSessionID][i], nil
+		}
+	}
+	return nil, provablyfair.ErrSpinNotFound
+}
+
+// addSession stores an ended session with a valid chain of spins
+func (r *memoryChainAuditRepo) addSession(t *testing.T, enc *crypto.AESEncryptor, thetaCommitment string, spins int, endedAt time.Time) *provablyfair.PFSession {
+	gen := rng.NewHashChainGenerator()
+	seed, err := gen.GenerateServerSeed()
+	require.NoError(t, err)
+	encrypted, err := enc.Encrypt(seed)
+	require.NoError(t, err)
+
+	session := provablyfair.PFSession{
+		ID:                  uuid.New(),
+		PlayerID:            uuid.New(),
+		ServerSeedHash:      gen.HashServerSeed(seed),
+		EncryptedServerSeed: encrypted,
+		Status:              provablyfair.SessionStatusEnded,
+		EndedAt:             &endedAt,
+		ThetaCommitment:     thetaCommitment,
+	}
+
+	prev := gen.GenerateInitialPrevSpinHash(session.ServerSeedHash, thetaCommitment)
+	for nonce := int64(1); nonce <= int64(spins); nonce++ {
+		hash := gen.GenerateSpinHash(prev, seed, "client", nonce)
+		r.logs[session.ID] = append(r.logs[session.ID], provablyfair.SpinLog{
+			SpinID: uuid.New(), PFSessionID: session.ID, SpinIndex: nonce, Nonce: nonce, ClientSeed: "client", SpinHash: hash, PrevSpinHash: prev,
+		})
+		prev = hash
+	}
+	r.reveals[session.ID] = &provablyfair.SessionAudit{
+		PFSessionID: session.ID, ServerSeedPlaintext: seed, ServerSeedHash: session.ServerSeedHash, TotalSpins: int64(spins),
+	}
+	r.sessions = append(r.sessions, session)
+	return &r.sessions[len(r.sessions)-1]
+}
+
+type recordingChainAlerts struct {
+	alerted []uuid.UUID
+}
+
+func (n *recordingChainAlerts) Name() string { return "recording" }
+
+func (n *recordingChainAlerts) NotifyChainFailure(ctx context.Context, audit *provablyfair.ChainAudit) error {
+	n.alerted = append(n.alerted, audit.PFSessionID)
+	return nil
+}
+
+func TestPFChainAuditor_Run(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	enc, err := crypto.NewAESEncryptor("provablyfair-dev-key-32bytes!!!!")
+	require.NoError(t, err)
+	repo := &memoryChainAuditRepo{reveals: map[uuid.UUID]*provablyfair.SessionAudit{}, logs: map[uuid.UUID][]provablyfair.SpinLog{}}
+
+	legacy := repo.addSession(t, enc, "", 3, now.Add(-time.Hour))
+	dual := repo.addSession(t, enc, "theta-commitment", 2, now.Add(-time.Hour))
+	tampered := repo.addSession(t, enc, "", 3, now.Add(-time.Hour))
+	repo.logs[tampered.ID][1].SpinHash = "corrupted"
+	unrevealed := repo.addSession(t, enc, "", 1, now.Add(-time.Hour))
+	delete(repo.reveals, unrevealed.ID)
+	repo.addSession(t, enc, "", 1, now.Add(-48*time.Hour)) // outside the lookback window
+
+	svc := &ProvablyFairService{repo: repo, hashGenerator: rng.NewHashChainGenerator(), encryptor: enc, clock: clock.NewFake(now), logger: logger.New("error", "json")}
+	alerts := &recordingChainAlerts{}
+	cfg := &config.Config{ProvablyFair: config.ProvablyFairConfig{AuditIntervalMinutes: 15, AuditLookbackHours: 24}}
+	auditor := NewPFChainAuditor(cfg, svc, alerts, logger.New("error", "json"))
+
+	assert.Equal(t, 2, auditor.Run(ctx, now))
+	assert.ElementsMatch(t, []uuid.UUID{tampered.ID, unrevealed.ID}, alerts.alerted)
+	require.Len(t, repo.audits, 4)
+
+	results := map[uuid.UUID]provablyfair.ChainAudit{}
+	for _, a := range repo.audits {
+		results[a.PFSessionID] = a
+	}
+	assert.True(t, results[legacy.ID].Valid)
+	assert.Equal(t, int64(3), results[legacy.ID].SpinCount)
+	assert.True(t, results[dual.ID].Valid, "Dual Commitment chains start from SHA256(server_seed_hash + theta_commitment)")
+	assert.Contains(t, results[tampered.ID].Error, "hash mismatch")
+	assert.Equal(t, now, results[legacy.ID].CheckedAt)
+
+	assert.Equal(t, 0, auditor.Run(ctx, now), "audited sessions are not re-audited")
+	assert.Len(t, repo.audits, 4)
+}
@@ -2,14 +2,20 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/launch"
 	"github.com/slotmachine/backend/domain/provablyfair"
 	"github.com/slotmachine/backend/domain/reelstrip"
 	"github.com/slotmachine/backend/internal/config"
 	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/pkg/clock"
 	"github.com/slotmachine/backend/internal/pkg/crypto"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
@@ -20,7 +26,13 @@ type ProvablyFairService struct {
 	cache         provablyfair.CacheRepository
 	reelstripRepo reelstrip.Repository
 	hashGenerator *rng.HashChainGenerator
+	rngProvider   rng.Provider // Draws spin outcomes; HKDF when nil
 	encryptor     *crypto.AESEncryptor
+	stateWriter   *PFStateWriter
+	links         launch.Repository // Resolves the operator of a player; nil treats every player as direct
+	pregen        *spinPregenerator // Holds each session's next spin derived ahead; nil when disabled
+	clock         clock.Clock       // Timestamps session state, spins and reveals
+	config        config.ProvablyFairConfig
 	logger        *logger.Logger
 }
 
@@ -38,12 +50,17 @@ func NewProvablyFairService(
 		return nil, fmt.Errorf("failed to initialize AES encryptor: %w", err)
 	}
 
+	clk := clock.New()
 	return &ProvablyFairService{
 		repo:          repo,
 		cache:         cache,
 		reelstripRepo: reelstripRepo,
 		hashGenerator: rng.NewHashChainGenerator(),
+		rngProvider:   rng.NewHKDFProvider(),
 		encryptor:     encryptor,
+		pregen:        newSpinPregenerator(cfg.SpinPregen, clk, log),
+		clock:         clk,
+		config:        cfg.ProvablyFair,
 		logger:        log,
 	}, nil
 }
@@ -51,6 +68,73 @@ func NewProvablyFairService(
 // Ensure ProvablyFairService implements Service
 var _ provablyfair.Service = (*ProvablyFairService)(nil)
 
+// SetStateWriter batches session-state DB writes through w instead of writing every spin
+func (s *ProvablyFairService) SetStateWriter(w *PFStateWriter) {
+	s.stateWriter = w
+}
+
+// SetOperatorLinks resolves players to operators for per-operator Dual Commitment enforcement
+func (s *ProvablyFairService) SetOperatorLinks(links launch.Repository) {
+	s.links = links
+}
+
+// SetClock replaces the clock session state, spins, reveals, chain audits and held spins are timed with
+func (s *ProvablyFairService) SetClock(c clock.Clock) {
+	s.clock = c
+	if s.pregen != nil {
+		s.pregen.clock = c
+	}
+}
+
+// SetRNGProvider replaces the provider spin outcomes are drawn with
+func (s *ProvablyFairService) SetRNGProvider(provider rng.Provider) {
+	s.rngProvider = provider
+}
+
+// RNGProvider returns the provider spin outcomes are drawn with
+func (s *ProvablyFairService) RNGProvider() rng.Provider {
+	if s.rngProvider == nil {
+		return rng.NewHKDFProvider()
+	}
+	return s.rngProvider
+}
+
+// DualCommitmentRequired reports whether a player's PF sessions must use the Dual Commitment Protocol
+func (s *ProvablyFairService) DualCommitmentRequired(ctx context.Context, playerID uuid.UUID) bool {
+	operatorID := ""
+	if s.links != nil {
+		link, err := s.links.GetLinkByPlayer(ctx, playerID)
+		switch {
+		case err == nil:
+			operatorID = link.OperatorID
+		case !errors.Is(err, launch.ErrLinkNotFound):
+			s.logger.WithTraceContext(ctx).Warn().Err(err).Str("player_id", playerID.String()).Msg("Failed to resolve player operator, using default Dual Commitment policy")
+		}
+	}
+	return s.config.DualCommitmentRequiredFor(operatorID)
+}
+
+// ValidateThetaCommitment checks a theta_commitment against the player's Dual Commitment policy
+// When the protocol is optional, missing or malformed commitments are accepted and ignored.
+func (s *ProvablyFairService) ValidateThetaCommitment(ctx context.Context, playerID uuid.UUID, thetaCommitment string) error {
+	if !s.DualCommitmentRequired(ctx, playerID) {
+		return nil
+	}
+	if thetaCommitment == "" {
+		return provablyfair.ErrThetaCommitmentRequired
+	}
+	if !isThetaCommitment(thetaCommitment) {
+		return provablyfair.ErrInvalidThetaCommitment
+	}
+	return nil
+}
+
+// isThetaCommitment reports whether c is a hex SHA256 digest
+func isThetaCommitment(c string) bool {
+	decoded, err := hex.DecodeString(c)
+	return err == nil && len(decoded) == sha256.Size
+}
+
 // StartSession creates a new provably fair session with Dual Commitment Protocol
 // thetaCommitment is SHA256(theta_seed) - client's commitment sent BEFORE seeing server_seed
 // Client seed is now provided per-spin, not per-session
@@ -80,11 +164,17 @@ func (s *ProvablyFairService) StartSession(
 		return nil, provablyfair.ErrSessionAlreadyActive
 	}
 
+	// Enforce the Dual Commitment Protocol where the player's operator requires it
+	if err := s.ValidateThetaCommitment(ctx, playerID, thetaCommitment); err != nil {
+		log.Warn().Err(err).Str("player_id", playerID.String()).Msg("Rejected PF session without valid theta_commitment")
+		return nil, err
+	}
+
 	// Validate theta_commitment (should be 64 hex chars = 256 bits)
-	if thetaCommitment != "" && len(thetaCommitment) != 64 {
+	if thetaCommitment != "" && !isThetaCommitment(thetaCommitment) {
 		log.Warn().
 			Str("theta_commitment", thetaCommitment).
-			Msg("Invalid theta_commitment length, ignoring")
+			Msg("Invalid theta_commitment, ignoring")
 		thetaCommitment = ""
 	}
 
@@ -121,7 +211,7 @@ func (s *ProvablyFairService) StartSession(
 		LastNonce:           0,
 		LastSpinHash:        "",
 		Status:              provablyfair.SessionStatusActive,
-		CreatedAt:           time.Now().UTC(),
+		CreatedAt:           s.clock.Now().UTC(),
 		// Dual Commitment Protocol
 		ThetaCommitment: thetaCommitment,
 		ThetaSeed:       "", // Will be revealed on first spin
@@ -148,7 +238,7 @@ func (s *ProvablyFairService) StartSession(
 		LastSpinHash:   initialPrevSpinHash, // SHA256(server_seed_hash + theta_commitment) or server_seed_hash
 		Nonce:          0,
 		Status:         provablyfair.SessionStatusActive,
-		UpdatedAt:      time.Now().UTC(),
+		UpdatedAt:      s.clock.Now().UTC(),
 		// Dual Commitment Protocol
 		ThetaCommitment: thetaCommitment,
 		ThetaSeed:       "", // Will be revealed on first spin
@@ -212,13 +302,15 @@ func (s *ProvablyFairService) GetSessionState(ctx context.Context, gameSessionID
 		LastSpinHash:   session.LastSpinHash,
 		Nonce:          session.LastNonce,
 		Status:         session.Status,
-		UpdatedAt:      time.Now().UTC(),
+		UpdatedAt:      s.clock.Now().UTC(),
 		// Dual Commitment Protocol fields
 		ThetaCommitment: session.ThetaCommitment,
 		ThetaSeed:       session.ThetaSeed,
 		ThetaVerified:   session.ThetaVerified,
 	}
 
+	s.reconcileWithLastSpin(ctx, recoveredState)
+
 	// If no spins yet, calculate initial prevSpinHash using Dual Commitment if present
 	if recoveredState.LastSpinHash == "" {
 		recoveredState.LastSpinHash = s.hashGenerator.GenerateInitialPrevSpinHash(
@@ -265,15 +357,15 @@ func (s *ProvablyFairService) RecordSpin(
 	newNonce := state.Nonce + 1
 
 	// Dual Commitment Protocol: Persist theta verification to DB on first spin
-	// Note: The actual verification happens in GetHKDFStreamRNG BEFORE RNG generation
+	// Note: The actual verification happens in GetSpinRNG BEFORE RNG generation
 	// Here we just persist the verified theta_seed to DB for recovery purposes
 	if newNonce == 1 && state.ThetaCommitment != "" && input.ThetaSeed != "" {
-		// Update DB with verified theta_seed (verification already done in GetHKDFStreamRNG)
+		// Update DB with verified theta_seed (verification already done in GetSpinRNG)
 		if err := s.updateDBThetaVerified(ctx, state.SessionID, input.ThetaSeed); err != nil {
 			log.Error().Err(err).Msg("Failed to persist theta verification to DB")
-			// Don't fail - verification already passed in GetHKDFStreamRNG, continue with spin
+			// Don't fail - verification already passed in GetSpinRNG, continue with spin
 		}
-		// Update state in memory (already set in GetHKDFStreamRNG but may not be persisted)
+		// Update state in memory (already set in GetSpinRNG but may not be persisted)
 		state.ThetaSeed = input.ThetaSeed
 		state.ThetaVerified = true
 	}
@@ -315,7 +407,11 @@ func (s *ProvablyFairService) RecordSpin(
 		ReelStripConfigID: input.ReelStripConfigID,
 		GameMode:          input.GameMode,
 		IsFreeSpin:        input.IsFreeSpin,
-		CreatedAt:         time.Now().UTC(),
+		Multipliers:       provablyfair.IntSlice(input.Multipliers),
+		WildFeatures:      input.WildFeatures,
+		StickyWilds:       input.StickyWilds,
+		MathVersion:       input.MathVersion,
+		CreatedAt:         s.clock.Now().UTC(),
 	}
 
 	// Save spin log to DB
@@ -324,21 +420,40 @@ func (s *ProvablyFairService) RecordSpin(
 		return nil, fmt.Errorf("failed to create spin log: %w", err)
 	}
 
-	// Update session state in Redis
+	// Advance session state once the spin log is durable. Inside the spin transaction this
+	// waits for commit, so a rollback never leaves Redis ahead of the spin log
 	state.Nonce = newNonce
 	state.LastSpinHash = spinHash
-	state.UpdatedAt = time.Now().UTC()
+	state.UpdatedAt = s.clock.Now().UTC()
 
-	if err := s.cache.UpdateSessionState(ctx, state); err != nil {
-		log.Error().Err(err).Msg("Failed to update PF session state in cache")
-		// Don't fail - spin was already recorded
-	}
+	repository.AfterCommit(ctx, func(ctx context.Context) {
+		if err := s.cache.UpdateSessionState(ctx, state); err != nil {
+			log.Error().Err(err).Msg("Failed to update PF session state in cache")
+			// Don't fail - spin was already recorded
+		}
 
-	// Update DB session state
-	if err := s.updateDBSessionState(ctx, state.SessionID, newNonce, spinHash); err != nil {
-		log.Error().Err(err).Msg("Failed to update PF session in DB")
-		// Don't fail - spin was already recorded
-	}
+		// Update DB session state (batched when a state writer is set)
+		if err := s.updateDBSessionState(ctx, state.SessionID, newNonce, spinHash); err != nil {
+			log.Error().Err(err).Msg("Failed to update PF session in DB")
+			// Don't fail - spin was already recorded
+		}
+
+		// Derive the next spin off the request path while the client presents this one
+		if s.pregen != nil {
+			nextClientSeed := input.NextClientSeed
+			if nextClientSeed == "" {
+				nextClientSeed = input.ClientSeed
+			}
+			if nextClientSeed != "" {
+				go s.pregen.prepare(context.WithoutCancel(ctx), input.GameSessionID, s.RNGProvider(), rng.SpinSeed{
+					ServerSeed:   state.ServerSeed,
+					ClientSeed:   nextClientSeed,
+					Nonce:        newNonce + 1,
+					PrevSpinHash: spinHash,
+				})
+			}
+		}
+	})
 
 	log.Debug().
 		Str("session_id", state.SessionID.String()).
@@ -392,20 +507,7 @@ func (s *ProvablyFairService) EndSession(ctx context.Context, gameSessionID uuid
 	}
 
 	// Convert to verification format - each spin has its own client_seed
-	spins := make([]provablyfair.SpinVerification, len(spinLogs))
-	for i, spinLog := range spinLogs {
-		spins[i] = provablyfair.SpinVerification{
-			SpinIndex:         spinLog.SpinIndex,
-			Nonce:             spinLog.Nonce,
-			ClientSeed:        spinLog.ClientSeed, // Per-spin client seed
-			SpinHash:          spinLog.SpinHash,
-			PrevSpinHash:      spinLog.PrevSpinHash,
-			ReelPositions:     []int(spinLog.ReelPositions),
-			ReelStripConfigID: spinLog.ReelStripConfigID,
-			GameMode:          spinLog.GameMode,
-			IsFreeSpin:        spinLog.IsFreeSpin,
-		}
-	}
+	spins := spinVerifications(spinLogs)
 
 	// Create session audit (reveals server seed)
 	audit := &provablyfair.SessionAudit{
@@ -414,7 +516,7 @@ func (s *ProvablyFairService) EndSession(ctx context.Context, gameSessionID uuid
 		ServerSeedPlaintext: state.ServerSeed,
 		ServerSeedHash:      state.ServerSeedHash,
 		TotalSpins:          state.Nonce,
-		RevealedAt:          time.Now().UTC(),
+		RevealedAt:          s.clock.Now().UTC(),
 	}
 
 	if err := s.repo.CreateSessionAudit(ctx, audit); err != nil {
@@ -422,6 +524,13 @@ func (s *ProvablyFairService) EndSession(ctx context.Context, gameSessionID uuid
 		return nil, fmt.Errorf("failed to create session audit: %w", err)
 	}
 
+	// Persist batched progress before the session is closed
+	if s.stateWriter != nil {
+		if err := s.stateWriter.Flush(ctx, state.SessionID); err != nil {
+			log.Warn().Err(err).Msg("Failed to flush PF session state")
+		}
+	}
+
 	// End session in DB
 	if err := s.repo.EndSession(ctx, state.SessionID); err != nil {
 		log.Error().Err(err).Msg("Failed to end PF session in DB")
@@ -448,6 +557,30 @@ func (s *ProvablyFairService) EndSession(ctx context.Context, gameSessionID uuid
 	}, nil
 }
 
+// EndOrphanedSessions force-ends up to limit active sessions whose game session ended or went idle before idleSince
+// Ending creates the audit and reveals the server seed exactly as a player-initiated end would,
+// and unblocks StartSession for players whose client crashed mid-session.
+func (s *ProvablyFairService) EndOrphanedSessions(ctx context.Context, idleSince time.Time, limit int) (int, error) {
+	orphans, err := s.repo.ListOrphanedSessions(ctx, idleSince, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	ended := 0
+	for _, orphan := range orphans {
+		if _, err := s.EndSession(ctx, orphan.GameSessionID); err != nil {
+			s.logger.Warn().
+				Err(err).
+				Str("session_id", orphan.ID.String()).
+				Str("game_session_id", orphan.GameSessionID.String()).
+				Msg("Failed to end orphaned PF session")
+			continue
+		}
+		ended++
+	}
+	return ended, nil
+}
+
 // GetVerificationData returns all data needed to verify a completed session
 // Each spin has its own client_seed stored in spin_logs
 func (s *ProvablyFairService) GetVerificationData(ctx context.Context, pfSessionID uuid.UUID) (*provablyfair.VerificationData, error) {
@@ -475,20 +608,7 @@ func (s *ProvablyFairService) GetVerificationData(ctx context.Context, pfSession
 	}
 
 	// Convert to verification format - each spin has its own client_seed
-	spins := make([]provablyfair.SpinVerification, len(spinLogs))
-	for i, spinLog := range spinLogs {
-		spins[i] = provablyfair.SpinVerification{
-			SpinIndex:         spinLog.SpinIndex,
-			Nonce:             spinLog.Nonce,
-			ClientSeed:        spinLog.ClientSeed, // Per-spin client seed
-			SpinHash:          spinLog.SpinHash,
-			PrevSpinHash:      spinLog.PrevSpinHash,
-			ReelPositions:     []int(spinLog.ReelPositions),
-			ReelStripConfigID: spinLog.ReelStripConfigID,
-			GameMode:          spinLog.GameMode,
-			IsFreeSpin:        spinLog.IsFreeSpin,
-		}
-	}
+	spins := spinVerifications(spinLogs)
 
 	return &provablyfair.VerificationData{
 		SessionID:      pfSessionID,
@@ -520,6 +640,19 @@ func (s *ProvablyFairService) VerifySession(ctx context.Context, pfSessionID uui
 	}
 
 	// Convert to verification format - each spin has its own client_seed
+	spins := spinVerifications(spinLogs)
+
+	// Verify hash chain - the first prevSpinHash also commits to theta_commitment when one was given
+	return s.hashGenerator.VerifyHashChain(
+		serverSeed,
+		session.ServerSeedHash,
+		session.ThetaCommitment,
+		spins,
+	)
+}
+
+// spinVerifications converts spin logs to the verification format
+func spinVerifications(spinLogs []provablyfair.SpinLog) []provablyfair.SpinVerification {
 	spins := make([]provablyfair.SpinVerification, len(spinLogs))
 	for i, spinLog := range spinLogs {
 		spins[i] = provablyfair.SpinVerification{
@@ -532,34 +665,70 @@ func (s *ProvablyFairService) VerifySession(ctx context.Context, pfSessionID uui
 			ReelStripConfigID: spinLog.ReelStripConfigID,
 			GameMode:          spinLog.GameMode,
 			IsFreeSpin:        spinLog.IsFreeSpin,
+			Multipliers:       []int(spinLog.Multipliers),
+			WildFeatures:      spinLog.WildFeatures,
+			StickyWilds:       spinLog.StickyWilds,
+			MathVersion:       spinLog.MathVersion,
 		}
 	}
+	return spins
+}
 
-	// Verify hash chain - uses serverSeedHash as first prevSpinHash
-	return s.hashGenerator.VerifyHashChain(
-		serverSeed,
-		session.ServerSeedHash,
-		spins,
-	)
+// InvalidateSessionState drops a session's cached state so the next spin recovers it from the DB
+// Spin transactions call this to compensate when they roll back: the spin log is the source of
+// truth, and recovery reconciles the nonce and last spin hash from it
+func (s *ProvablyFairService) InvalidateSessionState(ctx context.Context, sessionID uuid.UUID) error {
+	if err := s.cache.DeleteSessionState(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to invalidate PF session state: %w", err)
+	}
+	return nil
+}
+
+// GetSpinLog returns the provably fair record of a game spin
+func (s *ProvablyFairService) GetSpinLog(ctx context.Context, spinID uuid.UUID) (*provablyfair.SpinLog, error) {
+	return s.repo.GetSpinLogBySpinID(ctx, spinID)
 }
 
-// updateDBSessionState updates the session state in the database
+// updateDBSessionState updates the session state in the database, or buffers it for the state writer
 func (s *ProvablyFairService) updateDBSessionState(ctx context.Context, sessionID uuid.UUID, nonce int64, lastSpinHash string) error {
-	session, err := s.repo.GetSessionByID(ctx, sessionID)
-	if err != nil {
-		return err
+	if s.stateWriter != nil {
+		return s.stateWriter.Record(ctx, sessionID, nonce, lastSpinHash)
 	}
+	return s.repo.UpdateSessionProgress(ctx, sessionID, nonce, lastSpinHash)
+}
 
-	session.LastNonce = nonce
-	session.LastSpinHash = lastSpinHash
+// reconcileWithLastSpin advances recovered state to the last logged spin.
+// Session-state writes may lag spin logs (batched, or a failed write), and resuming
+// from a stale nonce would fork the hash chain
+func (s *ProvablyFairService) reconcileWithLastSpin(ctx context.Context, state *provablyfair.PFSessionState) {
+	last, err := s.repo.GetLastSpinLog(ctx, state.SessionID)
+	if err != nil || last.Nonce <= state.Nonce {
+		return
+	}
 
-	return s.repo.UpdateSession(ctx, session)
+	s.logger.WithTraceContext(ctx).Warn().
+		Str("session_id", state.SessionID.String()).
+		Int64("stored_nonce", state.Nonce).
+		Int64("logged_nonce", last.Nonce).
+		Msg("PF session state behind spin log, resuming from last spin")
+	state.Nonce = last.Nonce
+	state.LastSpinHash = last.SpinHash
+}
+
+// ClaimClientNonce holds a spin request's client nonce to the session's next nonce and records
+// it on the session, so the nonce is played at most once even by requests that race the check.
+// Call it in the transaction that settles the spin: a spin that rolls back releases its claim
+func (s *ProvablyFairService) ClaimClientNonce(ctx context.Context, state *provablyfair.PFSessionState, nonce int64) error {
+	if err := state.CheckClientNonce(nonce); err != nil {
+		return err
+	}
+	return s.repo.ClaimClientNonce(ctx, state.SessionID, nonce)
 }
 
-// GetHKDFStreamRNG returns an HKDF-based RNG implementing RFC 5869
-// This provides cryptographic domain separation - each reel has its own
-// independent key derived from the master seed, ensuring no correlation
-// between reel outcomes.
+// GetSpinRNG returns the RNG for the session's next spin and the name of the provider that drew it
+// With the default HKDF provider this is an HKDF-based RNG implementing RFC 5869, which provides
+// cryptographic domain separation - each reel has its own independent key derived from the master
+// seed, ensuring no correlation between reel outcomes.
 //
 // Benefits:
 // - Each reel key is cryptographically independent
@@ -571,7 +740,7 @@ func (s *ProvablyFairService) updateDBSessionState(ctx context.Context, sessionI
 // - thetaSeed is required on first spin if theta_commitment was provided during StartSession
 // - Server verifies SHA256(thetaSeed) === theta_commitment BEFORE generating RNG
 // - This ensures client cannot change their commitment after seeing server_seed
-func (s *ProvablyFairService) GetHKDFStreamRNG(ctx context.Context, gameSessionID uuid.UUID, clientSeed, thetaSeed string) (*rng.HKDFStreamRNG, string, error) {
+func (s *ProvablyFairService) GetSpinRNG(ctx context.Context, gameSessionID uuid.UUID, clientSeed, thetaSeed string) (rng.RNG, string, error) {
 	log := s.logger.WithTraceContext(ctx)
 
 	// Get session state (from Redis or recovered from DB)
@@ -625,30 +794,48 @@ func (s *ProvablyFairService) GetHKDFStreamRNG(ctx context.Context, gameSessionI
 			log.Error().Err(err).Msg("Failed to generate fallback client seed")
 			return nil, "", fmt.Errorf("failed to generate client seed: %w", err)
 		}
-		log.Warn().Msg("Client seed not provided for GetHKDFStreamRNG, using generated fallback")
+		log.Warn().Msg("Client seed not provided for GetSpinRNG, using generated fallback")
 	}
 
-	// Create HKDF-based RNG using server seed, client seed, nonce, and prevSpinHash
-	// This implements RFC 5869 HKDF for per-reel key derivation
+	// Draw the RNG from server seed, client seed, nonce, and prevSpinHash
+	// With HKDF this implements RFC 5869 for per-reel key derivation
 	// prevSpinHash is REQUIRED to maintain hash chain integrity:
 	// - Each spin's RNG depends on ALL previous spins (hash chain)
 	// - Ensures entropy accumulation across the session
 	// - Server cannot pre-compute outcomes for multiple spins
-	hkdfRNG, err := rng.NewHKDFStreamRNG(state.ServerSeed, clientSeed, nextNonce, state.LastSpinHash)
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to create HKDF stream RNG: %w", err)
+	provider := s.RNGProvider()
+	seed := rng.SpinSeed{
+		ServerSeed:   state.ServerSeed,
+		ClientSeed:   clientSeed,
+		Nonce:        nextNonce,
+		PrevSpinHash: state.LastSpinHash,
 	}
 
-	// Get the spin hash for logging/verification
-	spinHash := hkdfRNG.GetSpinHash()
+	// A spin derived ahead from this exact seed draws the same outcome, only sooner
+	spinRNG, pregenerated := s.takePregeneratedSpin(gameSessionID, provider, seed)
+	if !pregenerated {
+		spinRNG, err = provider.ForSpin(ctx, seed)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to create %s RNG: %w", provider.Name(), err)
+		}
+	}
 
 	log.Debug().
 		Str("session_id", state.SessionID.String()).
 		Int64("nonce", nextNonce).
-		Str("spin_hash", spinHash[:16]+"..."). // Truncate for logging
-		Msg("Created HKDF stream RNG for provably fair spin")
+		Str("rng_provider", provider.Name()).
+		Bool("pregenerated", pregenerated).
+		Msg("Created RNG for spin")
+
+	return spinRNG, provider.Name(), nil
+}
 
-	return hkdfRNG, spinHash, nil
+// takePregeneratedSpin returns the session's spin RNG derived ahead from this seed, if one is held
+func (s *ProvablyFairService) takePregeneratedSpin(gameSessionID uuid.UUID, provider rng.Provider, seed rng.SpinSeed) (rng.RNG, bool) {
+	if s.pregen == nil {
+		return nil, false
+	}
+	return s.pregen.take(gameSessionID, provider.Name(), seed)
 }
 
 // VerifySpin verifies a single spin's hash
@@ -747,6 +934,15 @@ func (s *ProvablyFairService) VerifySpinWithReel(
 	}, nil
 }
 
+// EndPlayerSession ends the player's active PF session, whichever game session it belongs to
+func (s *ProvablyFairService) EndPlayerSession(ctx context.Context, playerID uuid.UUID) (*provablyfair.EndSessionResult, error) {
+	state, err := s.GetActiveSessionByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.EndSession(ctx, state.GameSessionID)
+}
+
 // GetActiveSessionByPlayer retrieves the active PF session state by player ID
 func (s *ProvablyFairService) GetActiveSessionByPlayer(ctx context.Context, playerID uuid.UUID) (*provablyfair.PFSessionState, error) {
 	log := s.logger.WithTraceContext(ctx)
@@ -784,9 +980,11 @@ func (s *ProvablyFairService) GetActiveSessionByPlayer(ctx context.Context, play
 		LastSpinHash:   session.LastSpinHash,
 		Nonce:          session.LastNonce,
 		Status:         session.Status,
-		UpdatedAt:      time.Now().UTC(),
+		UpdatedAt:      s.clock.Now().UTC(),
 	}
 
+	s.reconcileWithLastSpin(ctx, recoveredState)
+
 	// If no spins yet, last spin hash is server_seed_hash
 	if recoveredState.LastSpinHash == "" {
 		recoveredState.LastSpinHash = session.ServerSeedHash
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/launch"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvablyFairService_ValidateThetaCommitment(t *testing.T) {
+	ctx := context.Background()
+	valid := strings.Repeat("ab", 32)
+
+	links := newMemoryLaunchStore()
+	strictPlayer, laxPlayer, directPlayer := uuid.New(), uuid.New(), uuid.New()
+	_ = links.CreateLink(ctx, &launch.PlayerLink{OperatorID: "strict", ExternalPlayerID: "a", PlayerID: strictPlayer})
+	_ = links.CreateLink(ctx, &launch.PlayerLink{OperatorID: "lax", ExternalPlayerID: "b", PlayerID: laxPlayer})
+
+	svc := &ProvablyFairService{
+		config: config.ProvablyFairConfig{DualCommitmentRequired: true, DualCommitmentOperators: "lax=false, strict=true"},
+		logger: logger.New("error", "json"),
+	}
+	svc.SetOperatorLinks(links)
+
+	assert.ErrorIs(t, svc.ValidateThetaCommitment(ctx, directPlayer, ""), provablyfair.ErrThetaCommitmentRequired)
+	assert.ErrorIs(t, svc.ValidateThetaCommitment(ctx, strictPlayer, "abc"), provablyfair.ErrInvalidThetaCommitment)
+	assert.ErrorIs(t, svc.ValidateThetaCommitment(ctx, strictPlayer, strings.Repeat("zz", 32)), provablyfair.ErrInvalidThetaCommitment)
+	assert.NoError(t, svc.ValidateThetaCommitment(ctx, strictPlayer, valid))
+	assert.NoError(t, svc.ValidateThetaCommitment(ctx, laxPlayer, ""), "operator override disables enforcement")
+
+	svc.config.DualCommitmentRequired = false
+	assert.NoError(t, svc.ValidateThetaCommitment(ctx, directPlayer, "abc"), "optional mode ignores bad commitments")
+	assert.ErrorIs(t, svc.ValidateThetaCommitment(ctx, strictPlayer, ""), provablyfair.ErrThetaCommitmentRequired, "operator override enables enforcement")
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/internal/game/rng"
+)
+
+// VerifyRecordedSpin replays a recorded spin against its session's hash chain
+// The server seed is read from the session's encrypted copy, so spins of active
+// sessions can be checked too; it is only included in the result once revealed.
+func (s *ProvablyFairService) VerifyRecordedSpin(ctx context.Context, spinID uuid.UUID) (*provablyfair.RecordedSpinVerification, error) {
+	spinLog, err := s.repo.GetSpinLogBySpinID(ctx, spinID)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.repo.GetSessionByID(ctx, spinLog.PFSessionID)
+	if err != nil {
+		return nil, err
+	}
+	serverSeed, err := s.encryptor.Decrypt(session.EncryptedServerSeed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt server seed: %w", err)
+	}
+
+	result := &provablyfair.RecordedSpinVerification{
+		SpinID:            spinID,
+		PFSessionID:       session.ID,
+		SessionEnded:      session.Status == provablyfair.SessionStatusEnded,
+		ServerSeedHash:    session.ServerSeedHash,
+		ClientSeed:        spinLog.ClientSeed,
+		SpinIndex:         spinLog.SpinIndex,
+		Nonce:             spinLog.Nonce,
+		PrevSpinHash:      spinLog.PrevSpinHash,
+		SpinHash:          spinLog.SpinHash,
+		ReelStripConfigID: spinLog.ReelStripConfigID,
+		ReelPositions:     []int(spinLog.ReelPositions),
+		VerifiedAt:        time.Now().UTC(),
+	}
+	if result.SessionEnded {
+		result.ServerSeed = serverSeed
+	}
+
+	// The spin must extend the chain: the first spin links to the session's commitments
+	if spinLog.SpinIndex <= 1 {
+		result.ExpectedPrevSpinHash = s.hashGenerator.GenerateInitialPrevSpinHash(session.ServerSeedHash, session.ThetaCommitment)
+	} else {
+		prev, err := s.repo.GetSpinLogByIndex(ctx, session.ID, spinLog.SpinIndex-1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get previous spin: %w", err)
+		}
+		result.ExpectedPrevSpinHash = prev.SpinHash
+	}
+	result.ChainLinkValid = result.ExpectedPrevSpinHash == spinLog.PrevSpinHash
+
+	result.ExpectedSpinHash = s.hashGenerator.GenerateSpinHash(spinLog.PrevSpinHash, serverSeed, spinLog.ClientSeed, spinLog.Nonce)
+	result.SpinHashValid = result.ExpectedSpinHash == spinLog.SpinHash
+	result.Valid = s.hashGenerator.HashServerSeed(serverSeed) == session.ServerSeedHash &&
+		result.ChainLinkValid && result.SpinHashValid
+
+	if spinLog.ReelStripConfigID != nil {
+		expected, err := s.expectedReelPositions(ctx, *spinLog.ReelStripConfigID, serverSeed, spinLog.ClientSeed, spinLog.Nonce, spinLog.PrevSpinHash)
+		if err != nil {
+			return nil, err
+		}
+		valid := slices.Equal(expected, result.ReelPositions)
+		result.ExpectedReelPositions = expected
+		result.ReelPositionsValid = &valid
+		result.Valid = result.Valid && valid
+	}
+
+	return result, nil
+}
+
+// expectedReelPositions regenerates a spin's reel positions the way the game engine draws them
+func (s *ProvablyFairService) expectedReelPositions(ctx context.Context, configID uuid.UUID, serverSeed, clientSeed string, nonce int64, prevSpinHash string) ([]int, error) {
+	configSet, err := s.reelstripRepo.GetSetByConfigID(ctx, configID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reel strip config: %w", err)
+	}
+	streamRNG, err := rng.NewHKDFStreamRNG(serverSeed, clientSeed, nonce, prevSpinHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HKDF Stream RNG: %w", err)
+	}
+
+	positions := make([]int, len(configSet.Strips))
+	for i, strip := range configSet.Strips {
+		pos, err := streamRNG.Int(len(strip.StripData))
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate expected reel position %d: %w", i, err)
+		}
+		positions[i] = pos
+	}
+	return positions, nil
+}
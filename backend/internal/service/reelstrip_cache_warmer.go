@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// cacheWarmPageSize bounds how many configs one page of a warm pass loads
+const cacheWarmPageSize = 100
+
+// cacheWarmTimeout bounds the warm pass run at startup, before the server accepts spins
+const cacheWarmTimeout = 30 * time.Second
+
+// cacheWarmGameModes are the game modes a spin resolves a default config and canary for
+var cacheWarmGameModes = []reelstrip.GameMode{reelstrip.BaseGame, reelstrip.FreeSpins, reelstrip.BonusSpinTrigger}
+
+// cacheWarmFamilies are the key families whose expiry re-warms the cache
+// Player assignments and operator defaults are per player and operator, so they fill on demand.
+var cacheWarmFamilies = map[string]bool{
+	cache.FamilyReelStripConfigSet:     true,
+	cache.FamilyReelStripConfigByID:    true,
+	cache.FamilyDefaultReelStripConfig: true,
+	cache.FamilyReelStripCanary:        true,
+}
+
+// ReelStripCacheWarmer preloads the reel strip data spins resolve through, so the first spins
+// after a deploy or an admin change don't pay for cold reads
+// It warms once at startup and again shortly after any reel strip config key is expired, here
+// or by a peer, so every instance re-warms after a change. Expiries arriving together are
+// coalesced into one pass.
+type ReelStripCacheWarmer struct {
+	repo     reelstrip.Repository
+	canaries reelstrip.CanaryRepository
+	cache    *cache.Cache
+	enabled  bool
+	debounce time.Duration
+	logger   *logger.Logger
+
+	trigger  chan struct{}
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewReelStripCacheWarmer creates a new reel strip cache warmer
+func NewReelStripCacheWarmer(
+	cfg *config.Config,
+	repo reelstrip.Repository,
+	canaries reelstrip.CanaryRepository,
+	c *cache.Cache,
+	log *logger.Logger,
+) *ReelStripCacheWarmer {
+	return &ReelStripCacheWarmer{
+		repo:     repo,
+		canaries: canaries,
+		cache:    c,
+		enabled:  cfg.CacheWarm.Enabled,
+		debounce: time.Duration(cfg.CacheWarm.DebounceMillis) * time.Millisecond,
+		logger:   log,
+		trigger:  make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start warms the cache, then re-warms it in the background after config changes
+// The first pass runs before Start returns, so the server only accepts spins once it is warm.
+func (w *ReelStripCacheWarmer) Start() {
+	if !w.enabled {
+		close(w.done)
+		w.logger.Info().Msg("Reel strip cache warmer disabled")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cacheWarmTimeout)
+	w.Warm(ctx)
+	cancel()
+
+	w.cache.OnExpire(w.expired)
+	go w.run()
+	w.logger.Info().Dur("debounce", w.debounce).Msg("Reel strip cache warmer started")
+}
+
+// Stop stops the warmer and waits for a running pass to finish
+func (w *ReelStripCacheWarmer) Stop() {
+	w.stopOnce.Do(func() { close(w.stop) })
+	<-w.done
+}
+
+// expired schedules a re-warm when a reel strip config key is expired; it never blocks
+func (w *ReelStripCacheWarmer) expired(key string) {
+	if !cacheWarmFamilies[w.cache.Family(key)] {
+		return
+	}
+	select {
+	case w.trigger <- struct{}{}:
+	default: // A re-warm is already pending
+	}
+}
+
+func (w *ReelStripCacheWarmer) run() {
+	defer close(w.done)
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-w.trigger:
+		}
+
+		// Let the rest of the change land before reading it back
+		select {
+		case <-w.stop:
+			return
+		case <-time.After(w.debounce):
+		}
+		select {
+		case <-w.trigger:
+		default:
+		}
+		w.Warm(context.Background())
+	}
+}
+
+// Warm loads every active config set, and each game mode's default config and running canary,
+// into the cache; it returns how many config sets it loaded
+func (w *ReelStripCacheWarmer) Warm(ctx context.Context) int {
+	start := time.Now()
+	active := true
+
+	warmed, failed := 0, 0
+	for page := 1; ; page++ {
+		configs, _, err := w.repo.ListConfigs(ctx, &reelstrip.ConfigListFilters{
+			IsActive: &active,
+			Page:     page,
+			Limit:    cacheWarmPageSize,
+		})
+		if err != nil {
+			w.logger.Error().Err(err).Msg("Failed to list configs for cache warming")
+			break
+		}
+		for _, cfg := range configs {
+			if _, err := w.repo.GetSetByConfigID(ctx, cfg.ID); err != nil {
+				failed++
+				w.logger.Warn().Err(err).Str("config_id", cfg.ID.String()).Msg("Failed to warm config set")
+				continue
+			}
+			warmed++
+		}
+		if len(configs) < cacheWarmPageSize {
+			break
+		}
+	}
+
+	for _, mode := range cacheWarmGameModes {
+		gameMode := string(mode)
+		if _, err := w.repo.GetDefaultConfig(ctx, gameMode); err != nil && !errors.Is(err, reelstrip.ErrNoDefaultConfig) {
+			failed++
+			w.logger.Warn().Err(err).Str("game_mode", gameMode).Msg("Failed to warm default config")
+		}
+		if w.canaries == nil {
+			continue
+		}
+		if _, err := w.canaries.GetRunning(ctx, gameMode); err != nil && !errors.Is(err, reelstrip.ErrCanaryNotFound) {
+			failed++
+			w.logger.Warn().Err(err).Str("game_mode", gameMode).Msg("Failed to warm running canary")
+		}
+	}
+
+	w.logger.Info().
+		Int("config_sets", warmed).
+		Int("failed", failed).
+		Dur("took", time.Since(start)).
+		Msg("Warmed reel strip cache")
+	return warmed
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestCacheWarmer(t *testing.T, repo *MockReelStripRepository, canaries reelstrip.CanaryRepository) (*ReelStripCacheWarmer, *cache.Cache) {
+	cfg := &config.Config{
+		App:       config.AppConfig{Name: "slot", Env: "test"},
+		CacheWarm: config.CacheWarmConfig{Enabled: true, DebounceMillis: 10},
+	}
+	c := cache.NewCache(cache.NewCacheParams{Channel: "test:warm", Config: cfg})
+	t.Cleanup(c.Close)
+	return NewReelStripCacheWarmer(cfg, repo, canaries, c, logger.New("error", "json")), c
+}
+
+func TestReelStripCacheWarmer_Warm(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockReelStripRepository)
+	canaries := newMemoryCanaryStore()
+	w, _ := newTestCacheWarmer(t, repo, canaries)
+
+	configs := []*reelstrip.ReelStripConfig{{ID: uuid.New()}, {ID: uuid.New()}}
+	repo.On("ListConfigs", ctx, mock.MatchedBy(func(f *reelstrip.ConfigListFilters) bool {
+		return f.IsActive != nil && *f.IsActive && f.Page == 1
+	})).Return(configs, int64(len(configs)), nil)
+	repo.On("GetSetByConfigID", ctx, configs[0].ID).Return(&reelstrip.ReelStripConfigSet{Config: configs[0]}, nil)
+	repo.On("GetSetByConfigID", ctx, configs[1].ID).Return(nil, reelstrip.ErrIncompleteSet)
+	repo.On("GetDefaultConfig", ctx, string(reelstrip.BaseGame)).Return(configs[0], nil)
+	repo.On("GetDefaultConfig", ctx, mock.Anything).Return(nil, reelstrip.ErrNoDefaultConfig)
+
+	// A broken config set is skipped rather than failing the pass
+	assert.Equal(t, 1, w.Warm(ctx))
+	repo.AssertNumberOfCalls(t, "GetDefaultConfig", len(cacheWarmGameModes))
+}
+
+func TestReelStripCacheWarmer_RewarmsAfterConfigExpiry(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockReelStripRepository)
+	w, c := newTestCacheWarmer(t, repo, nil)
+
+	config := &reelstrip.ReelStripConfig{ID: uuid.New(), GameMode: string(reelstrip.BaseGame)}
+	repo.On("ListConfigs", mock.Anything, mock.Anything).Return([]*reelstrip.ReelStripConfig{config}, int64(1), nil)
+	var passes atomic.Int32
+	repo.On("GetSetByConfigID", mock.Anything, config.ID).
+		Run(func(mock.Arguments) { passes.Add(1) }).
+		Return(&reelstrip.ReelStripConfigSet{Config: config}, nil)
+	repo.On("GetDefaultConfig", mock.Anything, mock.Anything).Return(config, nil)
+
+	w.Start()
+	defer w.Stop()
+	assert.Equal(t, int32(1), passes.Load(), "the startup pass runs before Start returns")
+
+	// Keys outside the reel strip config families don't re-warm
+	c.Expire(ctx, c.PlayerAssignmentKey(uuid.New()))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(1), passes.Load())
+
+	// A config change expires several keys at once; they are warmed in one pass
+	for _, key := range c.ReelStripConfigKeys(config.ID, config.GameMode) {
+		c.Expire(ctx, key)
+	}
+	assert.Eventually(t, func() bool { return passes.Load() == 2 }, time.Second, 5*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(2), passes.Load())
+}
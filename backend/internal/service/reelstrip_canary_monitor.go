@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// ReelStripCanaryMonitor periodically checks running reel strip canaries, reverting or promoting them
+type ReelStripCanaryMonitor struct {
+	canaries reelstrip.CanaryService
+	interval time.Duration
+	logger   *logger.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewReelStripCanaryMonitor creates a new reel strip canary monitor
+func NewReelStripCanaryMonitor(cfg *config.Config, canaries reelstrip.CanaryService, log *logger.Logger) *ReelStripCanaryMonitor {
+	return &ReelStripCanaryMonitor{
+		canaries: canaries,
+		interval: time.Duration(cfg.Canary.MonitorIntervalMinutes) * time.Minute,
+		logger:   log,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the monitor in the background; a zero interval disables it
+func (w *ReelStripCanaryMonitor) Start() {
+	if w.interval <= 0 {
+		close(w.done)
+		w.logger.Info().Msg("Reel strip canary monitor disabled")
+		return
+	}
+
+	go w.run()
+	w.logger.Info().Dur("interval", w.interval).Msg("Reel strip canary monitor started")
+}
+
+// Stop stops the monitor and waits for a running pass to finish
+func (w *ReelStripCanaryMonitor) Stop() {
+	w.stopOnce.Do(func() { close(w.stop) })
+	<-w.done
+}
+
+func (w *ReelStripCanaryMonitor) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			w.Run(context.Background())
+		}
+	}
+}
+
+// Run checks the running canaries and returns how many it finished
+func (w *ReelStripCanaryMonitor) Run(ctx context.Context) int {
+	finished, err := w.canaries.Evaluate(ctx)
+	if err != nil {
+		w.logger.Error().Err(err).Msg("Failed to evaluate reel strip canaries")
+	}
+	if finished > 0 {
+		w.logger.Info().Int("finished", finished).Msg("Finished reel strip canaries")
+	}
+	return finished
+}
@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+const (
+	defaultCanaryLimit = 20
+	maxCanaryLimit     = 100
+
+	// canaryNotifyTimeout bounds each finished canary notification
+	canaryNotifyTimeout = 10 * time.Second
+)
+
+// ReelStripCanaryService implements reelstrip.CanaryService
+// A canary serves a newly activated config to a share of players while everyone else keeps the
+// baseline default. Each monitor pass compares the config's realized RTP with its target: a drift
+// beyond the tolerance reverts the canary at once, and a trial that ends within it promotes the
+// config to default.
+type ReelStripCanaryService struct {
+	repo       reelstrip.CanaryRepository
+	reelStrips reelstrip.Service
+	cache      *cache.Cache
+	notifier   reelstrip.CanaryNotifier // Optional: nil disables notifications
+	defaults   config.CanaryConfig
+	logger     *logger.Logger
+	now        func() time.Time
+}
+
+// NewReelStripCanaryService creates a new reel strip canary service
+func NewReelStripCanaryService(
+	cfg *config.Config,
+	repo reelstrip.CanaryRepository,
+	reelStrips reelstrip.Service,
+	cache *cache.Cache,
+	notifier reelstrip.CanaryNotifier,
+	log *logger.Logger,
+) *ReelStripCanaryService {
+	return &ReelStripCanaryService{
+		repo:       repo,
+		reelStrips: reelStrips,
+		cache:      cache,
+		notifier:   notifier,
+		defaults:   cfg.Canary,
+		logger:     log,
+		now:        time.Now,
+	}
+}
+
+// Prepare fills in the configured defaults and checks a canary request, returning the config to trial
+// Proposals for approval are prepared too, so reviewers see the settings the canary will run with.
+func (s *ReelStripCanaryService) Prepare(ctx context.Context, req *reelstrip.StartCanaryRequest) (*reelstrip.ReelStripConfig, error) {
+	if req.Percent == 0 {
+		req.Percent = s.defaults.DefaultPercent
+	}
+	if req.MaxRTPDeviation == 0 {
+		req.MaxRTPDeviation = s.defaults.DefaultMaxRTPDeviation
+	}
+	if req.MinSpins == 0 {
+		req.MinSpins = int64(s.defaults.DefaultMinSpins)
+	}
+	if req.Trial == 0 {
+		req.Trial = time.Duration(s.defaults.DefaultTrialHours) * time.Hour
+	}
+
+	switch {
+	case req.Percent < 1 || req.Percent > 99:
+		return nil, fmt.Errorf("%w: percent must be between 1 and 99", reelstrip.ErrInvalidCanary)
+	case req.MaxRTPDeviation <= 0 || req.MaxRTPDeviation > 100 || math.IsNaN(req.MaxRTPDeviation):
+		return nil, fmt.Errorf("%w: max_rtp_deviation must be between 0 and 100", reelstrip.ErrInvalidCanary)
+	case req.MinSpins < 1:
+		return nil, fmt.Errorf("%w: min_spins must be positive", reelstrip.ErrInvalidCanary)
+	case req.Trial <= 0:
+		return nil, fmt.Errorf("%w: the trial period must be positive", reelstrip.ErrInvalidCanary)
+	}
+
+	config, err := s.reelStrips.GetConfigByID(ctx, req.ConfigID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case config.IsDemo:
+		return nil, reelstrip.ErrDemoConfig
+	case !config.IsActive:
+		return nil, fmt.Errorf("%w: config %q is not active", reelstrip.ErrInvalidCanary, config.Name)
+	case config.GameMode == string(reelstrip.Both):
+		return nil, fmt.Errorf("%w: config %q must target a single game mode", reelstrip.ErrInvalidCanary, config.Name)
+	case config.TargetRTP <= 0:
+		return nil, fmt.Errorf("%w: config %q has no target RTP to judge its realized RTP against", reelstrip.ErrInvalidCanary, config.Name)
+	}
+	return config, nil
+}
+
+// Start starts a canary of an active config against the current default of its game mode
+func (s *ReelStripCanaryService) Start(ctx context.Context, req *reelstrip.StartCanaryRequest) (*reelstrip.Canary, error) {
+	config, err := s.Prepare(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	baseline, err := s.reelStrips.GetDefaultReelSet(ctx, config.GameMode)
+	if err != nil {
+		if errors.Is(err, reelstrip.ErrNoDefaultConfig) {
+			return nil, fmt.Errorf("%w: %s has no default config to trial against", reelstrip.ErrInvalidCanary, config.GameMode)
+		}
+		return nil, err
+	}
+	if baseline.Config.ID == config.ID {
+		return nil, fmt.Errorf("%w: config %q is already the %s default", reelstrip.ErrInvalidCanary, config.Name, config.GameMode)
+	}
+
+	now := s.now().UTC()
+	canary := &reelstrip.Canary{
+		ID:               uuid.New(),
+		GameMode:         config.GameMode,
+		ConfigID:         config.ID,
+		BaselineConfigID: baseline.Config.ID,
+		Percent:          req.Percent,
+		MaxRTPDeviation:  req.MaxRTPDeviation,
+		MinSpins:         req.MinSpins,
+		Status:           reelstrip.CanaryRunning,
+		StartedBy:        req.StartedBy,
+		StartedAt:        now,
+		EndsAt:           now.Add(req.Trial),
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if err := s.repo.Create(ctx, canary); err != nil {
+		return nil, err
+	}
+	s.expireRunning(ctx, canary.GameMode)
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("canary_id", canary.ID.String()).
+		Str("config_id", canary.ConfigID.String()).
+		Str("baseline_config_id", canary.BaselineConfigID.String()).
+		Str("game_mode", canary.GameMode).
+		Int("percent", canary.Percent).
+		Time("ends_at", canary.EndsAt).
+		Str("started_by", canary.StartedBy).
+		Msg("Reel strip canary started")
+
+	return canary, nil
+}
+
+// Get retrieves a canary
+func (s *ReelStripCanaryService) Get(ctx context.Context, id uuid.UUID) (*reelstrip.Canary, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// List lists canaries newest first
+func (s *ReelStripCanaryService) List(ctx context.Context, filters reelstrip.CanaryListFilters) ([]*reelstrip.Canary, int64, error) {
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.Limit < 1 {
+		filters.Limit = defaultCanaryLimit
+	}
+	if filters.Limit > maxCanaryLimit {
+		filters.Limit = maxCanaryLimit
+	}
+	return s.repo.List(ctx, filters)
+}
+
+// Abort stops a running canary; its players go back to the baseline, which stayed the default
+func (s *ReelStripCanaryService) Abort(ctx context.Context, id uuid.UUID, by, reason string) (*reelstrip.Canary, error) {
+	canary, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if canary.Status != reelstrip.CanaryRunning {
+		return nil, reelstrip.ErrCanaryNotRunning
+	}
+
+	msg := "Aborted by " + by
+	if reason = strings.TrimSpace(reason); reason != "" {
+		msg += ": " + reason
+	}
+	if err := s.finish(ctx, canary, reelstrip.CanaryAborted, msg); err != nil {
+		return nil, err
+	}
+	return canary, nil
+}
+
+// Evaluate checks every running canary and returns how many it finished
+// A canary that fails to evaluate is logged and retried on the next pass.
+func (s *ReelStripCanaryService) Evaluate(ctx context.Context) (int, error) {
+	running, err := s.repo.ListRunning(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	finished := 0
+	var errs []error
+	for _, canary := range running {
+		done, err := s.evaluate(ctx, canary)
+		if err != nil {
+			if errors.Is(err, reelstrip.ErrCanaryNotRunning) {
+				continue // Aborted since it was listed
+			}
+			s.logger.WithTraceContext(ctx).Error().Err(err).Str("canary_id", canary.ID.String()).Msg("Failed to evaluate reel strip canary")
+			errs = append(errs, err)
+			continue
+		}
+		if done {
+			finished++
+		}
+	}
+	return finished, errors.Join(errs...)
+}
+
+// evaluate records a canary's realized RTP, then reverts, promotes or leaves it running
+func (s *ReelStripCanaryService) evaluate(ctx context.Context, canary *reelstrip.Canary) (bool, error) {
+	// The baseline is what players outside the canary get; if an admin replaced it the trial is moot
+	current, err := s.reelStrips.GetDefaultReelSet(ctx, canary.GameMode)
+	if err != nil && !errors.Is(err, reelstrip.ErrNoDefaultConfig) {
+		return false, err
+	}
+	if err != nil || current.Config.ID != canary.BaselineConfigID {
+		return true, s.finish(ctx, canary, reelstrip.CanaryAborted, "The game mode default changed during the trial")
+	}
+
+	config, err := s.reelStrips.GetConfigByID(ctx, canary.ConfigID)
+	if err != nil {
+		return false, err
+	}
+	if !config.IsActive {
+		return true, s.finish(ctx, canary, reelstrip.CanaryAborted, "The canary config was deactivated during the trial")
+	}
+
+	perf, err := s.repo.Performance(ctx, canary.ConfigID, canary.StartedAt)
+	if err != nil {
+		return false, err
+	}
+	now := s.now().UTC()
+	canary.Spins, canary.Wagered, canary.Won = perf.Spins, perf.Wagered, perf.Won
+	canary.RealizedRTP = nil
+	rtp, ok := perf.RTP()
+	if ok {
+		canary.RealizedRTP = &rtp
+	}
+	canary.CheckedAt = &now
+	canary.UpdatedAt = now
+
+	judged := ok && perf.Spins >= canary.MinSpins
+	if judged && math.Abs(rtp-config.TargetRTP) > canary.MaxRTPDeviation {
+		reason := fmt.Sprintf("Realized RTP %.2f over %d spins is more than %.2f points from the target %.2f",
+			rtp, perf.Spins, canary.MaxRTPDeviation, config.TargetRTP)
+		return true, s.finish(ctx, canary, reelstrip.CanaryReverted, reason)
+	}
+
+	if now.Before(canary.EndsAt) {
+		return false, s.repo.UpdateProgress(ctx, canary)
+	}
+
+	if !judged {
+		reason := fmt.Sprintf("The trial ended with %d of the %d spins needed to judge the realized RTP", perf.Spins, canary.MinSpins)
+		return true, s.finish(ctx, canary, reelstrip.CanaryReverted, reason)
+	}
+
+	if err := s.reelStrips.SetDefaultConfig(ctx, canary.ConfigID, canary.GameMode); err != nil {
+		return false, err
+	}
+	s.expireKeys(ctx, s.cache.ReelStripConfigKeys(canary.ConfigID, canary.GameMode)...)
+	s.expireKeys(ctx, s.cache.ReelStripConfigKeys(canary.BaselineConfigID, canary.GameMode)...)
+
+	reason := fmt.Sprintf("Realized RTP %.2f over %d spins stayed within %.2f points of the target %.2f",
+		rtp, perf.Spins, canary.MaxRTPDeviation, config.TargetRTP)
+	return true, s.finish(ctx, canary, reelstrip.CanaryPromoted, reason)
+}
+
+// finish records the outcome of a running canary and stops routing players to it
+func (s *ReelStripCanaryService) finish(ctx context.Context, canary *reelstrip.Canary, status reelstrip.CanaryStatus, reason string) error {
+	now := s.now().UTC()
+	canary.Status = status
+	canary.Reason = reason
+	canary.FinishedAt = &now
+	canary.UpdatedAt = now
+	if err := s.repo.Finish(ctx, canary); err != nil {
+		return err
+	}
+	s.expireRunning(ctx, canary.GameMode)
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("canary_id", canary.ID.String()).
+		Str("config_id", canary.ConfigID.String()).
+		Str("game_mode", canary.GameMode).
+		Str("status", string(status)).
+		Str("reason", reason).
+		Msg("Reel strip canary finished")
+
+	s.notify(ctx, canary)
+	return nil
+}
+
+// expireRunning drops the cached running canary of a game mode on every instance
+func (s *ReelStripCanaryService) expireRunning(ctx context.Context, gameMode string) {
+	s.expireKeys(ctx, s.cache.ReelStripCanaryKey(gameMode))
+}
+
+// expireKeys drops cached entries on every instance; a failure is logged, the entries expire on their own
+func (s *ReelStripCanaryService) expireKeys(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := s.cache.Expire(ctx, key); err != nil {
+			s.logger.WithTraceContext(ctx).Warn().Err(err).Str("key", key).Msg("Failed to expire cache after canary change")
+		}
+	}
+}
+
+// notify tells admins how a canary finished; a failed notification is logged, not returned
+func (s *ReelStripCanaryService) notify(ctx context.Context, canary *reelstrip.Canary) {
+	if s.notifier == nil {
+		return
+	}
+	notifyCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), canaryNotifyTimeout)
+	defer cancel()
+	if err := s.notifier.NotifyCanary(notifyCtx, canary); err != nil {
+		s.logger.Warn().Err(err).
+			Str("notifier", s.notifier.Name()).
+			Str("canary_id", canary.ID.String()).
+			Msg("Failed to notify reel strip canary")
+	}
+}
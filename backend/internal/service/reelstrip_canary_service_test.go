@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryCanaryStore is an in-memory reelstrip.CanaryRepository with canned performance
+type memoryCanaryStore struct {
+	canaries map[uuid.UUID]*reelstrip.Canary
+	perf     reelstrip.CanaryPerformance
+}
+
+func newMemoryCanaryStore() *memoryCanaryStore {
+	return &memoryCanaryStore{canaries: map[uuid.UUID]*reelstrip.Canary{}}
+}
+
+func (m *memoryCanaryStore) Create(ctx context.Context, canary *reelstrip.Canary) error {
+	if _, err := m.GetRunning(ctx, canary.GameMode); err == nil {
+		return reelstrip.ErrCanaryRunning
+	}
+	stored := *canary
+	m.canaries[canary.ID] = &stored
+	return nil
+}
+
+func (m *memoryCanaryStore) GetByID(ctx context.Context, id uuid.UUID) (*reelstrip.Canary, error) {
+	canary, ok := m.canaries[id]
+	if !ok {
+		return nil, reelstrip.ErrCanaryNotFound
+	}
+	copied := *canary
+	return &copied, nil
+}
+
+func (m *memoryCanaryStore) GetRunning(ctx context.Context, gameMode string) (*reelstrip.Canary, error) {
+	for _, canary := range m.canaries {
+		if canary.GameMode == gameMode && canary.Status == reelstrip.CanaryRunning {
+			copied := *canary
+			return &copied, nil
+		}
+	}
+	return nil, reelstrip.ErrCanaryNotFound
+}
+
+func (m *memoryCanaryStore) ListRunning(ctx context.Context) ([]*reelstrip.Canary, error) {
+	var running []*reelstrip.Canary
+	for _, canary := range m.canaries {
+		if canary.Status == reelstrip.CanaryRunning {
+			copied := *canary
+			running = append(running, &copied)
+		}
+	}
+	return running, nil
+}
+
+func (m *memoryCanaryStore) List(ctx context.Context, filters reelstrip.CanaryListFilters) ([]*reelstrip.Canary, int64, error) {
+	all, _ := m.ListRunning(ctx)
+	return all, int64(len(all)), nil
+}
+
+func (m *memoryCanaryStore) UpdateProgress(ctx context.Context, canary *reelstrip.Canary) error {
+	return m.save(canary)
+}
+
+func (m *memoryCanaryStore) Finish(ctx context.Context, canary *reelstrip.Canary) error {
+	return m.save(canary)
+}
+
+func (m *memoryCanaryStore) save(canary *reelstrip.Canary) error {
+	stored, ok := m.canaries[canary.ID]
+	if !ok || stored.Status != reelstrip.CanaryRunning {
+		return reelstrip.ErrCanaryNotRunning
+	}
+	*stored = *canary
+	return nil
+}
+
+func (m *memoryCanaryStore) Performance(ctx context.Context, configID uuid.UUID, since time.Time) (*reelstrip.CanaryPerformance, error) {
+	perf := m.perf
+	return &perf, nil
+}
+
+// canaryConfigs serves configs and game mode defaults; other reelstrip.Service methods are unused
+type canaryConfigs struct {
+	reelstrip.Service
+	configs  map[uuid.UUID]*reelstrip.ReelStripConfig
+	defaults map[string]uuid.UUID
+}
+
+func (c *canaryConfigs) GetConfigByID(ctx context.Context, id uuid.UUID) (*reelstrip.ReelStripConfig, error) {
+	config, ok := c.configs[id]
+	if !ok {
+		return nil, reelstrip.ErrConfigNotFound
+	}
+	return config, nil
+}
+
+func (c *canaryConfigs) GetDefaultReelSet(ctx context.Context, gameMode string) (*reelstrip.ReelStripConfigSet, error) {
+	id, ok := c.defaults[gameMode]
+	if !ok {
+		return nil, reelstrip.ErrNoDefaultConfig
+	}
+	return &reelstrip.ReelStripConfigSet{Config: c.configs[id]}, nil
+}
+
+func (c *canaryConfigs) SetDefaultConfig(ctx context.Context, configID uuid.UUID, gameMode string) error {
+	c.defaults[gameMode] = configID
+	return nil
+}
+
+// canaryRecorder records the statuses canaries finished in
+type canaryRecorder struct {
+	statuses []reelstrip.CanaryStatus
+}
+
+func (n *canaryRecorder) Name() string { return "test" }
+
+func (n *canaryRecorder) NotifyCanary(ctx context.Context, canary *reelstrip.Canary) error {
+	n.statuses = append(n.statuses, canary.Status)
+	return nil
+}
+
+type canaryFixture struct {
+	svc        *ReelStripCanaryService
+	repo       *memoryCanaryStore
+	configs    *canaryConfigs
+	notifier   *canaryRecorder
+	now        time.Time
+	baselineID uuid.UUID
+	candidate  uuid.UUID
+}
+
+func newCanaryFixture() *canaryFixture {
+	cfg := &config.Config{
+		App: config.AppConfig{Name: "test", Env: "test"},
+		Canary: config.CanaryConfig{
+			DefaultPercent:         10,
+			DefaultTrialHours:      72,
+			DefaultMaxRTPDeviation: 2,
+			DefaultMinSpins:        1000,
+		},
+	}
+	f := &canaryFixture{
+		repo:       newMemoryCanaryStore(),
+		notifier:   &canaryRecorder{},
+		now:        time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		baselineID: uuid.New(),
+		candidate:  uuid.New(),
+	}
+	f.configs = &canaryConfigs{
+		configs: map[uuid.UUID]*reelstrip.ReelStripConfig{
+			f.baselineID: {ID: f.baselineID, Name: "rtp-96-v1", GameMode: "base_game", TargetRTP: 96, IsActive: true, IsDefault: true},
+			f.candidate:  {ID: f.candidate, Name: "rtp-96-v2", GameMode: "base_game", TargetRTP: 96, IsActive: true},
+		},
+		defaults: map[string]uuid.UUID{"base_game": f.baselineID},
+	}
+	c := cache.NewCache(cache.NewCacheParams{Channel: "test:canary", Config: cfg})
+	f.svc = NewReelStripCanaryService(cfg, f.repo, f.configs, c, f.notifier, logger.New("error", "json"))
+	f.svc.now = func() time.Time { return f.now }
+	return f
+}
+
+func TestReelStripCanaryService_Start(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should start against the current default with the configured defaults", func(t *testing.T) {
+		f := newCanaryFixture()
+
+		canary, err := f.svc.Start(ctx, &reelstrip.StartCanaryRequest{ConfigID: f.candidate, Percent: 25, StartedBy: "alice"})
+		require.NoError(t, err)
+		assert.Equal(t, reelstrip.CanaryRunning, canary.Status)
+		assert.Equal(t, f.baselineID, canary.BaselineConfigID)
+		assert.Equal(t, "base_game", canary.GameMode)
+		assert.Equal(t, 25, canary.Percent)
+		assert.Equal(t, 2.0, canary.MaxRTPDeviation)
+		assert.Equal(t, int64(1000), canary.MinSpins)
+		assert.Equal(t, f.now.Add(72*time.Hour), canary.EndsAt)
+
+		_, err = f.svc.Start(ctx, &reelstrip.StartCanaryRequest{ConfigID: f.candidate})
+		assert.ErrorIs(t, err, reelstrip.ErrCanaryRunning)
+	})
+
+	t.Run("should reject configs that cannot be trialled", func(t *testing.T) {
+		f := newCanaryFixture()
+		demoID, inactiveID := uuid.New(), uuid.New()
+		f.configs.configs[demoID] = &reelstrip.ReelStripConfig{ID: demoID, GameMode: "base_game", TargetRTP: 96, IsActive: true, IsDemo: true}
+		f.configs.configs[inactiveID] = &reelstrip.ReelStripConfig{ID: inactiveID, GameMode: "base_game", TargetRTP: 96}
+
+		_, err := f.svc.Start(ctx, &reelstrip.StartCanaryRequest{ConfigID: demoID})
+		assert.ErrorIs(t, err, reelstrip.ErrDemoConfig)
+
+		_, err = f.svc.Start(ctx, &reelstrip.StartCanaryRequest{ConfigID: inactiveID})
+		assert.ErrorIs(t, err, reelstrip.ErrInvalidCanary)
+
+		_, err = f.svc.Start(ctx, &reelstrip.StartCanaryRequest{ConfigID: f.baselineID})
+		assert.ErrorIs(t, err, reelstrip.ErrInvalidCanary, "the default cannot canary against itself")
+
+		_, err = f.svc.Start(ctx, &reelstrip.StartCanaryRequest{ConfigID: f.candidate, Percent: 100})
+		assert.ErrorIs(t, err, reelstrip.ErrInvalidCanary)
+
+		_, err = f.svc.Start(ctx, &reelstrip.StartCanaryRequest{ConfigID: uuid.New()})
+		assert.ErrorIs(t, err, reelstrip.ErrConfigNotFound)
+	})
+}
+
+func TestReelStripCanaryService_Evaluate(t *testing.T) {
+	ctx := context.Background()
+
+	start := func(t *testing.T, f *canaryFixture) *reelstrip.Canary {
+		canary, err := f.svc.Start(ctx, &reelstrip.StartCanaryRequest{ConfigID: f.candidate, Trial: 24 * time.Hour, StartedBy: "alice"})
+		require.NoError(t, err)
+		return canary
+	}
+
+	t.Run("should revert as soon as the realized RTP drifts beyond the tolerance", func(t *testing.T) {
+		f := newCanaryFixture()
+		canary := start(t, f)
+
+		f.repo.perf = reelstrip.CanaryPerformance{Spins: 1500, Wagered: 1500, Won: 1500 * 0.90}
+		f.now = f.now.Add(time.Hour)
+		finished, err := f.svc.Evaluate(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, finished)
+
+		stored, err := f.svc.Get(ctx, canary.ID)
+		require.NoError(t, err)
+		assert.Equal(t, reelstrip.CanaryReverted, stored.Status)
+		assert.InDelta(t, 90, *stored.RealizedRTP, 0.001)
+		assert.Equal(t, f.baselineID, f.configs.defaults["base_game"], "the baseline stays the default")
+		assert.Equal(t, []reelstrip.CanaryStatus{reelstrip.CanaryReverted}, f.notifier.statuses)
+	})
+
+	t.Run("should not judge the realized RTP before enough spins", func(t *testing.T) {
+		f := newCanaryFixture()
+		canary := start(t, f)
+
+		f.repo.perf = reelstrip.CanaryPerformance{Spins: 10, Wagered: 10, Won: 0}
+		f.now = f.now.Add(time.Hour)
+		finished, err := f.svc.Evaluate(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, finished)
+
+		stored, err := f.svc.Get(ctx, canary.ID)
+		require.NoError(t, err)
+		assert.Equal(t, reelstrip.CanaryRunning, stored.Status)
+		assert.Equal(t, int64(10), stored.Spins)
+		assert.NotNil(t, stored.CheckedAt)
+	})
+
+	t.Run("should promote the config when the trial ends within the tolerance", func(t *testing.T) {
+		f := newCanaryFixture()
+		canary := start(t, f)
+
+		f.repo.perf = reelstrip.CanaryPerformance{Spins: 5000, Wagered: 5000, Won: 5000 * 0.955}
+		f.now = f.now.Add(25 * time.Hour)
+		finished, err := f.svc.Evaluate(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, finished)
+
+		stored, err := f.svc.Get(ctx, canary.ID)
+		require.NoError(t, err)
+		assert.Equal(t, reelstrip.CanaryPromoted, stored.Status)
+		assert.Equal(t, f.candidate, f.configs.defaults["base_game"])
+	})
+
+	t.Run("should revert when the trial ends without enough spins", func(t *testing.T) {
+		f := newCanaryFixture()
+		canary := start(t, f)
+
+		f.repo.perf = reelstrip.CanaryPerformance{Spins: 999, Wagered: 999, Won: 959}
+		f.now = f.now.Add(25 * time.Hour)
+		_, err := f.svc.Evaluate(ctx)
+		require.NoError(t, err)
+
+		stored, err := f.svc.Get(ctx, canary.ID)
+		require.NoError(t, err)
+		assert.Equal(t, reelstrip.CanaryReverted, stored.Status)
+		assert.Equal(t, f.baselineID, f.configs.defaults["base_game"])
+	})
+
+	t.Run("should abort when the default changed during the trial", func(t *testing.T) {
+		f := newCanaryFixture()
+		canary := start(t, f)
+
+		otherID := uuid.New()
+		f.configs.configs[otherID] = &reelstrip.ReelStripConfig{ID: otherID, GameMode: "base_game", TargetRTP: 94, IsActive: true}
+		f.configs.defaults["base_game"] = otherID
+		f.repo.perf = reelstrip.CanaryPerformance{Spins: 5000, Wagered: 5000, Won: 4800}
+		f.now = f.now.Add(25 * time.Hour)
+		_, err := f.svc.Evaluate(ctx)
+		require.NoError(t, err)
+
+		stored, err := f.svc.Get(ctx, canary.ID)
+		require.NoError(t, err)
+		assert.Equal(t, reelstrip.CanaryAborted, stored.Status)
+		assert.Equal(t, otherID, f.configs.defaults["base_game"], "the admin's default is kept")
+	})
+
+	t.Run("should let an admin abort a running canary once", func(t *testing.T) {
+		f := newCanaryFixture()
+		canary := start(t, f)
+
+		aborted, err := f.svc.Abort(ctx, canary.ID, "bob", "player complaints")
+		require.NoError(t, err)
+		assert.Equal(t, reelstrip.CanaryAborted, aborted.Status)
+		assert.Equal(t, "Aborted by bob: player complaints", aborted.Reason)
+
+		_, err = f.svc.Abort(ctx, canary.ID, "bob", "")
+		assert.ErrorIs(t, err, reelstrip.ErrCanaryNotRunning)
+	})
+}
+
+func TestCanary_Includes(t *testing.T) {
+	canary := &reelstrip.Canary{ID: uuid.New(), Percent: 20}
+
+	included := 0
+	for i := 0; i < 10000; i++ {
+		playerID := uuid.New()
+		in := canary.Includes(playerID)
+		assert.Equal(t, in, canary.Includes(playerID), "a player stays in or out of the canary")
+		if in {
+			included++
+		}
+	}
+	assert.InDelta(t, 2000, included, 200)
+}
@@ -2,36 +2,120 @@ package service
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/launch"
 	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/segment"
 	"github.com/slotmachine/backend/internal/game/reels"
 	"github.com/slotmachine/backend/internal/game/rng"
 	"github.com/slotmachine/backend/internal/game/symbols"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/clock"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
+// playerReelStripConfigTTL bounds how long a player's resolved config is reused
+// Segment targets are only re-evaluated once their own cache entry lapses, so this
+// matches their TTL rather than holding a stale segment target any longer.
+const playerReelStripConfigTTL = segmentTargetTTL
+
+// resolutionGameModes are the game modes a player's resolved config is cached for
+var resolutionGameModes = []reelstrip.GameMode{reelstrip.BaseGame, reelstrip.FreeSpins, reelstrip.BonusSpinTrigger}
+
+// resolutionSharedFamilies are the key families whose expiry can change any player's resolved config
+var resolutionSharedFamilies = map[string]bool{
+	cache.FamilyReelStripConfigSet:     true,
+	cache.FamilyReelStripConfigByID:    true,
+	cache.FamilyDefaultReelStripConfig: true,
+	cache.FamilyOperatorDefault:        true,
+	cache.FamilyReelStripCanary:        true,
+}
+
+// playerResolution is the config a player's spins in a game mode resolved to
+type playerResolution struct {
+	ConfigID   uuid.UUID
+	ExpiresAt  *time.Time // Set when it came from an expiring assignment
+	Generation uint64     // Shared-key generation it was resolved under
+}
+
 // ReelStripService implements reelstrip.Service
 type ReelStripService struct {
-	repo   reelstrip.Repository
-	logger *logger.Logger
-	rng    *rng.CryptoRNG
+	repo     reelstrip.Repository
+	segments segment.Resolver           // Optional: nil disables segment-targeted configs
+	links    launch.Repository          // Optional: nil disables operator defaults
+	canaries reelstrip.CanaryRepository // Optional: nil disables canary rollouts
+	cache    *cache.Cache               // Optional: nil resolves every spin's config from scratch
+	clock    clock.Clock                // Times assignment expiries and timestamps config edits
+	logger   *logger.Logger
+	rng      *rng.CryptoRNG
+
+	// generation is bumped whenever a shared key expires, retiring every cached resolution at once
+	generation atomic.Uint64
 }
 
 // NewReelStripService creates a new reel strip service
 func NewReelStripService(repo reelstrip.Repository, log *logger.Logger) reelstrip.Service {
 	return &ReelStripService{
 		repo:   repo,
+		clock:  clock.New(),
 		logger: log,
 		rng:    rng.NewCryptoRNG(),
 	}
 }
 
+// SetSegmentResolver enables reel strip configs targeted at player segments
+func (s *ReelStripService) SetSegmentResolver(segments segment.Resolver) {
+	s.segments = segments
+}
+
+// SetOperatorLinks enables operator default configs, resolving players to operators through links
+func (s *ReelStripService) SetOperatorLinks(links launch.Repository) {
+	s.links = links
+}
+
+// SetClock replaces the clock assignment expiries are timed and config edits timestamped with
+func (s *ReelStripService) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetCanaries enables canary rollouts, routing a share of players to a config on trial
+func (s *ReelStripService) SetCanaries(canaries reelstrip.CanaryRepository) {
+	s.canaries = canaries
+}
+
+// SetResolutionCache caches each player's resolved config so spins skip the resolution chain
+// Resolutions are dropped when an input they depend on is expired, here or by a peer: the
+// player's own assignment or segment targets drop theirs, and config, default, operator
+// default and canary changes drop everyone's.
+func (s *ReelStripService) SetResolutionCache(c *cache.Cache) {
+	s.cache = c
+	c.OnExpire(s.expired)
+}
+
+// expired drops the cached resolutions an expired key fed into; it never blocks
+func (s *ReelStripService) expired(key string) {
+	family := s.cache.Family(key)
+	if resolutionSharedFamilies[family] {
+		s.generation.Add(1)
+		return
+	}
+	if family != cache.FamilyPlayerAssignment && family != cache.FamilySegmentTarget {
+		return
+	}
+	playerID, ok := s.cache.KeyPlayerID(key)
+	if !ok {
+		return
+	}
+	for _, gameMode := range resolutionGameModes {
+		s.cache.Drop(s.cache.PlayerReelStripConfigKey(playerID, string(gameMode)))
+	}
+}
+
 // GetRandomReelSet retrieves a random set of reel strips for a spin (deprecated - use config-based approach)
 func (s *ReelStripService) GetRandomReelSet(ctx context.Context, gameMode string) (*reelstrip.ReelStripSet, error) {
 	log := s.logger.WithTraceContext(ctx)
@@ -163,11 +247,8 @@ func (s *ReelStripService) GenerateAndSaveStrips(ctx context.Context, gameMode s
 
 // GenerateAndSaveStripSet generates one complete set of 5 reel strips and returns their IDs
 func (s *ReelStripService) GenerateAndSaveStripSet(ctx context.Context, gameMode string) ([5]uuid.UUID, error) {
-	log := s.logger.WithTraceContext(ctx)
-	var stripIDs [5]uuid.UUID
-
 	if err := s.validateGameMode(gameMode); err != nil {
-		return stripIDs, err
+		return [5]uuid.UUID{}, err
 	}
 
 	isFreeSpin := gameMode == string(reelstrip.FreeSpins)
@@ -175,27 +256,42 @@ func (s *ReelStripService) GenerateAndSaveStripSet(ctx context.Context, gameMode
 	// Generate one complete set (5 strips, one per reel)
 	generatedStrips, err := reels.GenerateAllReelStrips(isFreeSpin, s.rng)
 	if err != nil {
-		return stripIDs, fmt.Errorf("failed to generate reel strips: %w", err)
+		return [5]uuid.UUID{}, fmt.Errorf("failed to generate reel strips: %w", err)
 	}
 
-	// Create strip models for each reel
-	var allStrips []*reelstrip.ReelStrip
-	for reelNum := 0; reelNum < 5; reelNum++ {
-		stripData := generatedStrips[reelNum]
+	strips := make([][]string, len(generatedStrips))
+	for reelNum, stripData := range generatedStrips {
+		strips[reelNum] = []string(stripData)
+	}
+	return s.CreateStripSet(ctx, gameMode, strips)
+}
 
-		// Convert reels.ReelStrip ([]string) to regular []string
-		stripSlice := []string(stripData)
+// CreateStripSet saves one strip per reel as a new set of 5 reel strips and returns their IDs
+func (s *ReelStripService) CreateStripSet(ctx context.Context, gameMode string, strips [][]string) ([5]uuid.UUID, error) {
+	log := s.logger.WithTraceContext(ctx)
+	var stripIDs [5]uuid.UUID
 
-		// Calculate checksum
-		checksum := s.calculateChecksum(stripSlice)
+	if err := s.validateGameMode(gameMode); err != nil {
+		return stripIDs, err
+	}
+	if len(strips) != 5 {
+		return stripIDs, reelstrip.ErrIncompleteSet
+	}
+
+	// Create strip models for each reel
+	var allStrips []*reelstrip.ReelStrip
+	for reelNum, stripData := range strips {
+		if len(stripData) == 0 {
+			return stripIDs, reelstrip.ErrInvalidStripLength
+		}
 
 		strip := &reelstrip.ReelStrip{
 			ID:          uuid.New(),
 			GameMode:    gameMode,
 			ReelNumber:  reelNum,
-			StripData:   stripSlice,
-			Checksum:    checksum,
-			StripLength: len(stripSlice),
+			StripData:   stripData,
+			Checksum:    s.calculateChecksum(stripData),
+			StripLength: len(stripData),
 			IsActive:    true,
 		}
 
@@ -211,7 +307,7 @@ func (s *ReelStripService) GenerateAndSaveStripSet(ctx context.Context, gameMode
 	log.Info().
 		Int("strips_count", len(allStrips)).
 		Str("game_mode", gameMode).
-		Msg("Successfully generated and saved reel strip set")
+		Msg("Successfully saved reel strip set")
 
 	return stripIDs, nil
 }
@@ -266,10 +362,7 @@ func (s *ReelStripService) validateGameMode(gameMode string) error {
 }
 
 func (s *ReelStripService) calculateChecksum(stripData []string) string {
-	// Convert to JSON for consistent hashing
-	jsonData, _ := json.Marshal(stripData)
-	hash := sha256.Sum256(jsonData)
-	return hex.EncodeToString(hash[:])
+	return reelstrip.ComputeChecksum(stripData)
 }
 
 // ===== New Config-Based Methods =====
@@ -277,11 +370,58 @@ func (s *ReelStripService) calculateChecksum(stripData []string) string {
 // GetReelSetForPlayer retrieves the reel strip set for a specific player
 // This is the main method - it handles player assignments, defaults, and fallbacks
 func (s *ReelStripService) GetReelSetForPlayer(ctx context.Context, playerID uuid.UUID, gameMode string) (*reelstrip.ReelStripConfigSet, error) {
-	log := s.logger.WithTraceContext(ctx)
-
 	if err := s.validateGameMode(gameMode); err != nil {
 		return nil, err
 	}
+	if s.cache == nil {
+		configSet, _, err := s.resolveReelSetForPlayer(ctx, playerID, gameMode)
+		return configSet, err
+	}
+
+	key := s.cache.PlayerReelStripConfigKey(playerID, gameMode)
+	if cached, found := s.cache.Get(ctx, key); found {
+		res := cached.(*playerResolution)
+		if now := s.clock.Now(); res.Generation == s.generation.Load() && (res.ExpiresAt == nil || now.Before(*res.ExpiresAt)) {
+			if configSet, err := s.getRealMoneySet(ctx, res.ConfigID); err == nil {
+				return withAssignmentTTL(configSet, res.ExpiresAt, now), nil
+			}
+		}
+	}
+
+	generation := s.generation.Load()
+	configSet, expiresAt, err := s.resolveReelSetForPlayer(ctx, playerID, gameMode)
+	if err != nil {
+		return nil, err
+	}
+	// Legacy random sets have no config to come back to
+	if configSet.Config != nil {
+		ttl := playerReelStripConfigTTL
+		if expiresAt != nil {
+			ttl = min(ttl, expiresAt.Sub(s.clock.Now()))
+		}
+		if ttl > 0 {
+			s.cache.Set(ctx, key, &playerResolution{ConfigID: configSet.Config.ID, ExpiresAt: expiresAt, Generation: generation}, ttl)
+		}
+	}
+	return configSet, nil
+}
+
+// withAssignmentTTL returns the set carrying the time left at now on the assignment it came from, if any
+func withAssignmentTTL(configSet *reelstrip.ReelStripConfigSet, expiresAt *time.Time, now time.Time) *reelstrip.ReelStripConfigSet {
+	if expiresAt == nil {
+		return configSet
+	}
+	// Sets are shared through the in-process cache, so the TTL goes on a copy
+	assigned := *configSet
+	ttl := expiresAt.Sub(now)
+	assigned.TTL = &ttl
+	return &assigned
+}
+
+// resolveReelSetForPlayer walks the resolution chain for the player's set
+// It also returns when the player's assignment expires, if the set came from one.
+func (s *ReelStripService) resolveReelSetForPlayer(ctx context.Context, playerID uuid.UUID, gameMode string) (*reelstrip.ReelStripConfigSet, *time.Time, error) {
+	log := s.logger.WithTraceContext(ctx)
 
 	// Priority 1: Check player assignment table
 	assignment, err := s.repo.GetPlayerAssignment(ctx, playerID)
@@ -294,53 +434,205 @@ func (s *ReelStripService) GetReelSetForPlayer(ctx context.Context, playerID uui
 		}
 
 		if configID != nil {
-			configSet, err := s.repo.GetSetByConfigID(ctx, *configID)
+			configSet, err := s.getRealMoneySet(ctx, *configID)
 			if err == nil {
-				if assignment.ExpiresAt != nil {
-					ttl := time.Until(*assignment.ExpiresAt)
-					configSet.TTL = &ttl
-				}
-				return configSet, nil
+				return withAssignmentTTL(configSet, assignment.ExpiresAt, s.clock.Now()), assignment.ExpiresAt, nil
 			}
 			log.Warn().Err(err).Str("config_id", configID.String()).Msg("Failed to load assigned config, falling back")
 		}
 	}
 
-	// Priority 2: Check default configuration
+	// Priority 2: Check segment targets
+	if configSet := s.getSegmentReelSet(ctx, playerID, gameMode); configSet != nil {
+		return configSet, nil, nil
+	}
+
+	// Priority 3: Check the player's operator default
+	if configSet := s.getOperatorReelSet(ctx, playerID, gameMode); configSet != nil {
+		return configSet, nil, nil
+	}
+
+	// Priority 4: Check a canary running for the game mode
+	if configSet := s.getCanaryReelSet(ctx, playerID, gameMode); configSet != nil {
+		return configSet, nil, nil
+	}
+
+	// Priority 5: Check default configuration
 	defaultConfig, err := s.repo.GetDefaultConfig(ctx, gameMode)
 	if err == nil && defaultConfig != nil {
-		configSet, err := s.repo.GetSetByConfigID(ctx, defaultConfig.ID)
+		configSet, err := s.getRealMoneySet(ctx, defaultConfig.ID)
 		if err == nil {
-			return configSet, nil
+			return configSet, nil, nil
 		}
 		log.Warn().Err(err).Msg("Failed to load default config, falling back to legacy")
 	}
 
-	// Priority 3: Fallback to legacy random selection (deprecated)
+	// Priority 6: Fallback to legacy random selection (deprecated)
 	log.Warn().Msg("No config found, using legacy random selection (deprecated)")
 	legacySet, err := s.GetRandomReelSet(ctx, gameMode)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get reel set for player: %w", err)
+		return nil, nil, fmt.Errorf("failed to get reel set for player: %w", err)
 	}
 
 	// Convert legacy set to config set
 	return &reelstrip.ReelStripConfigSet{
 		Config: nil, // No config in legacy mode
 		Strips: legacySet.Strips,
-	}, nil
+	}, nil, nil
 }
 
-// GetReelSetByConfig retrieves a reel strip set by configuration ID
-func (s *ReelStripService) GetReelSetByConfig(ctx context.Context, configID uuid.UUID) (*reelstrip.ReelStripConfigSet, error) {
+// getSegmentReelSet loads the config targeted at the player's segments, or nil if none applies
+func (s *ReelStripService) getSegmentReelSet(ctx context.Context, playerID uuid.UUID, gameMode string) *reelstrip.ReelStripConfigSet {
+	if s.segments == nil {
+		return nil
+	}
+	log := s.logger.WithTraceContext(ctx)
+
+	target, err := s.segments.ResolveTarget(ctx, playerID, segment.TargetReelStrip)
+	if err != nil {
+		if !errors.Is(err, segment.ErrNoTarget) {
+			log.Warn().Err(err).Msg("Failed to resolve segment reel strip target, falling back")
+		}
+		return nil
+	}
+
+	settings, err := target.ReelStripSettings()
+	if err != nil {
+		log.Warn().Err(err).Str("target_id", target.ID.String()).Msg("Invalid segment reel strip target, falling back")
+		return nil
+	}
+
+	configID := settings.BaseGameConfigID
+	if gameMode == string(reelstrip.FreeSpins) {
+		configID = settings.FreeSpinsConfigID
+	}
+	if configID == nil {
+		return nil
+	}
+
+	configSet, err := s.getRealMoneySet(ctx, *configID)
+	if err != nil {
+		log.Warn().Err(err).Str("config_id", configID.String()).Msg("Failed to load segment config, falling back")
+		return nil
+	}
+	return configSet
+}
+
+// getOperatorReelSet loads the default config of the player's operator, or nil if none applies
+func (s *ReelStripService) getOperatorReelSet(ctx context.Context, playerID uuid.UUID, gameMode string) *reelstrip.ReelStripConfigSet {
+	if s.links == nil {
+		return nil
+	}
+	log := s.logger.WithTraceContext(ctx)
+
+	link, err := s.links.GetLinkByPlayer(ctx, playerID)
+	if err != nil {
+		if !errors.Is(err, launch.ErrLinkNotFound) {
+			log.Warn().Err(err).Msg("Failed to resolve player operator, falling back")
+		}
+		return nil
+	}
+
+	def, err := s.repo.GetOperatorDefault(ctx, link.OperatorID)
+	if err != nil {
+		if !errors.Is(err, reelstrip.ErrOperatorDefaultNotFound) {
+			log.Warn().Err(err).Str("operator_id", link.OperatorID).Msg("Failed to get operator default, falling back")
+		}
+		return nil
+	}
+
+	configID := def.ConfigID(gameMode)
+	if configID == nil {
+		return nil
+	}
+
+	configSet, err := s.getRealMoneySet(ctx, *configID)
+	if err != nil {
+		log.Warn().Err(err).Str("operator_id", link.OperatorID).Str("config_id", configID.String()).Msg("Failed to load operator default config, falling back")
+		return nil
+	}
+	return configSet
+}
+
+// getCanaryReelSet loads the canary config when the player falls in a running canary, or nil otherwise
+func (s *ReelStripService) getCanaryReelSet(ctx context.Context, playerID uuid.UUID, gameMode string) *reelstrip.ReelStripConfigSet {
+	if s.canaries == nil {
+		return nil
+	}
 	log := s.logger.WithTraceContext(ctx)
 
+	canary, err := s.canaries.GetRunning(ctx, gameMode)
+	if err != nil {
+		if !errors.Is(err, reelstrip.ErrCanaryNotFound) {
+			log.Warn().Err(err).Msg("Failed to get running canary, falling back")
+		}
+		return nil
+	}
+	if !canary.Includes(playerID) {
+		return nil
+	}
+
+	configSet, err := s.getRealMoneySet(ctx, canary.ConfigID)
+	if err != nil {
+		log.Warn().Err(err).Str("canary_id", canary.ID.String()).Msg("Failed to load canary config, falling back")
+		return nil
+	}
+	return configSet
+}
+
+// getRealMoneySet loads a config set and rejects demo-only and deleted configs
+// Every real-money resolution path goes through here.
+func (s *ReelStripService) getRealMoneySet(ctx context.Context, configID uuid.UUID) (*reelstrip.ReelStripConfigSet, error) {
 	configSet, err := s.repo.GetSetByConfigID(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	// The repository still loads deleted sets so past spins stay verifiable
+	if configSet.Config.DeletedAt != nil {
+		return nil, reelstrip.ErrConfigNotFound
+	}
+	if err := configSet.RequireRealMoney(); err != nil {
+		s.logger.WithTraceContext(ctx).Error().Str("config_id", configID.String()).Msg("Refusing demo reel strip config for real-money play")
+		return nil, err
+	}
+	return configSet, nil
+}
+
+// GetReelSetByConfig retrieves a real-money reel strip set by configuration ID
+func (s *ReelStripService) GetReelSetByConfig(ctx context.Context, configID uuid.UUID) (*reelstrip.ReelStripConfigSet, error) {
+	log := s.logger.WithTraceContext(ctx)
+
+	configSet, err := s.getRealMoneySet(ctx, configID)
 	if err != nil {
 		log.Error().Err(err).Str("config_id", configID.String()).Msg("Failed to get reel set by config")
 		return nil, fmt.Errorf("failed to get reel set by config: %w", err)
 	}
 
-	// Validate integrity
+	s.checkSetIntegrity(ctx, configSet)
+	return configSet, nil
+}
+
+// GetDemoReelSet retrieves a demo-only reel strip set for trial play
+func (s *ReelStripService) GetDemoReelSet(ctx context.Context, configID uuid.UUID) (*reelstrip.ReelStripConfigSet, error) {
+	configSet, err := s.repo.GetSetByConfigID(ctx, configID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get demo reel set: %w", err)
+	}
+	if configSet.Config.DeletedAt != nil {
+		return nil, reelstrip.ErrConfigNotFound
+	}
+	if !configSet.IsDemo() {
+		return nil, reelstrip.ErrNotDemoConfig
+	}
+
+	s.checkSetIntegrity(ctx, configSet)
+	return configSet, nil
+}
+
+// checkSetIntegrity logs strips whose checksum or length does not match
+func (s *ReelStripService) checkSetIntegrity(ctx context.Context, configSet *reelstrip.ReelStripConfigSet) {
+	log := s.logger.WithTraceContext(ctx)
+
 	for i, strip := range configSet.Strips {
 		if err := s.ValidateStripIntegrity(strip); err != nil {
 			log.Warn().
@@ -350,8 +642,6 @@ func (s *ReelStripService) GetReelSetByConfig(ctx context.Context, configID uuid
 				Msg("Strip integrity validation failed")
 		}
 	}
-
-	return configSet, nil
 }
 
 // GetDefaultReelSet retrieves the default reel strip set for a game mode
@@ -370,6 +660,15 @@ func (s *ReelStripService) GetDefaultReelSet(ctx context.Context, gameMode strin
 
 // CreateConfig creates a new reel strip configuration
 func (s *ReelStripService) CreateConfig(ctx context.Context, name, gameMode, description string, reelStripIDs [5]uuid.UUID, targetRTP float64, extraInfoJSON []byte) (*reelstrip.ReelStripConfig, error) {
+	return s.createConfig(ctx, name, gameMode, description, reelStripIDs, targetRTP, extraInfoJSON, false)
+}
+
+// CreateDemoConfig creates a demo-only reel strip configuration for trial play
+func (s *ReelStripService) CreateDemoConfig(ctx context.Context, name, gameMode, description string, reelStripIDs [5]uuid.UUID, targetRTP float64) (*reelstrip.ReelStripConfig, error) {
+	return s.createConfig(ctx, name, gameMode, description, reelStripIDs, targetRTP, nil, true)
+}
+
+func (s *ReelStripService) createConfig(ctx context.Context, name, gameMode, description string, reelStripIDs [5]uuid.UUID, targetRTP float64, extraInfoJSON []byte, isDemo bool) (*reelstrip.ReelStripConfig, error) {
 	log := s.logger.WithTraceContext(ctx)
 
 	if err := s.validateGameMode(gameMode); err != nil {
@@ -397,6 +696,7 @@ func (s *ReelStripService) CreateConfig(ctx context.Context, name, gameMode, des
 		TargetRTP:    targetRTP,
 		IsActive:     true,
 		IsDefault:    false,
+		IsDemo:       isDemo,
 		Options:      extraInfoJSON,
 	}
 
@@ -409,6 +709,7 @@ func (s *ReelStripService) CreateConfig(ctx context.Context, name, gameMode, des
 		Str("config_id", config.ID.String()).
 		Str("name", name).
 		Str("game_mode", gameMode).
+		Bool("is_demo", isDemo).
 		Msg("Created reel strip config")
 
 	return config, nil
@@ -457,6 +758,21 @@ func (s *ReelStripService) SetDefaultConfig(ctx context.Context, configID uuid.U
 	return nil
 }
 
+// UpdateConfig edits a configuration's details
+func (s *ReelStripService) UpdateConfig(ctx context.Context, configID uuid.UUID, update *reelstrip.ConfigUpdate) (*reelstrip.ReelStripConfig, error) {
+	config, err := s.repo.GetConfigByID(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	update.Apply(config)
+	config.UpdatedAt = s.clock.Now().UTC()
+	if err := s.repo.UpdateConfig(ctx, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
 // ActivateConfig activates a configuration
 func (s *ReelStripService) ActivateConfig(ctx context.Context, configID uuid.UUID) error {
 	config, err := s.repo.GetConfigByID(ctx, configID)
@@ -479,6 +795,32 @@ func (s *ReelStripService) DeactivateConfig(ctx context.Context, configID uuid.U
 	return s.repo.UpdateConfig(ctx, config)
 }
 
+// DeleteConfig soft deletes a configuration so it is no longer resolved for play
+func (s *ReelStripService) DeleteConfig(ctx context.Context, configID uuid.UUID) error {
+	if err := s.repo.DeleteConfig(ctx, configID); err != nil {
+		return err
+	}
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("config_id", configID.String()).
+		Msg("Deleted reel strip config")
+
+	return nil
+}
+
+// RestoreConfig restores a soft-deleted configuration; it must be activated again before use
+func (s *ReelStripService) RestoreConfig(ctx context.Context, configID uuid.UUID) error {
+	if err := s.repo.RestoreConfig(ctx, configID); err != nil {
+		return err
+	}
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("config_id", configID.String()).
+		Msg("Restored reel strip config")
+
+	return nil
+}
+
 // AssignConfigToPlayer assigns a configuration to a player
 func (s *ReelStripService) AssignConfigToPlayer(ctx context.Context, playerID, configID uuid.UUID, gameMode, reason, assignedBy string, expiresAt *time.Time) error {
 	log := s.logger.WithTraceContext(ctx)
@@ -493,6 +835,10 @@ func (s *ReelStripService) AssignConfigToPlayer(ctx context.Context, playerID, c
 		return fmt.Errorf("config not found: %w", err)
 	}
 
+	if config.IsDemo {
+		return reelstrip.ErrDemoConfig
+	}
+
 	// Verify config game mode matches
 	if config.GameMode != gameMode {
 		return fmt.Errorf("config game mode %s does not match requested game mode %s", config.GameMode, gameMode)
@@ -554,6 +900,70 @@ func (s *ReelStripService) GetPlayerAssignment(ctx context.Context, playerID uui
 	return s.repo.GetPlayerAssignment(ctx, playerID)
 }
 
+// SetOperatorDefault creates or replaces an operator's default configs
+// Each config must be a real-money config for its game mode; configs with a target RTP must be
+// tuned to the operator's.
+func (s *ReelStripService) SetOperatorDefault(ctx context.Context, def *reelstrip.OperatorReelStripDefault) error {
+	if def.OperatorID == "" || def.TargetRTP <= 0 || def.TargetRTP > 100 {
+		return reelstrip.ErrInvalidOperatorDefault
+	}
+	if def.BaseGameConfigID == nil && def.FreeSpinsConfigID == nil {
+		return reelstrip.ErrInvalidOperatorDefault
+	}
+
+	for _, gameMode := range []reelstrip.GameMode{reelstrip.BaseGame, reelstrip.FreeSpins} {
+		configID := def.ConfigID(string(gameMode))
+		if configID == nil {
+			continue
+		}
+		config, err := s.repo.GetConfigByID(ctx, *configID)
+		if err != nil {
+			return fmt.Errorf("config not found: %w", err)
+		}
+		if config.IsDemo {
+			return reelstrip.ErrDemoConfig
+		}
+		if config.GameMode != string(gameMode) {
+			return fmt.Errorf("%w: config game mode %s does not match %s", reelstrip.ErrInvalidOperatorDefault, config.GameMode, gameMode)
+		}
+		if config.TargetRTP > 0 && config.TargetRTP != def.TargetRTP {
+			return fmt.Errorf("%w: config %s targets %.2f, operator %.2f", reelstrip.ErrTargetRTPMismatch, config.Name, config.TargetRTP, def.TargetRTP)
+		}
+	}
+
+	if err := s.repo.UpsertOperatorDefault(ctx, def); err != nil {
+		return fmt.Errorf("failed to set operator default: %w", err)
+	}
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("operator_id", def.OperatorID).
+		Float64("target_rtp", def.TargetRTP).
+		Str("updated_by", def.UpdatedBy).
+		Msg("Set operator reel strip default")
+
+	return nil
+}
+
+// GetOperatorDefault retrieves an operator's default configs
+func (s *ReelStripService) GetOperatorDefault(ctx context.Context, operatorID string) (*reelstrip.OperatorReelStripDefault, error) {
+	return s.repo.GetOperatorDefault(ctx, operatorID)
+}
+
+// ListOperatorDefaults retrieves every operator's default configs
+func (s *ReelStripService) ListOperatorDefaults(ctx context.Context) ([]*reelstrip.OperatorReelStripDefault, error) {
+	return s.repo.ListOperatorDefaults(ctx)
+}
+
+// RemoveOperatorDefault removes an operator's default configs; its players fall back to the global default
+func (s *ReelStripService) RemoveOperatorDefault(ctx context.Context, operatorID string) error {
+	if err := s.repo.DeleteOperatorDefault(ctx, operatorID); err != nil {
+		return err
+	}
+
+	s.logger.WithTraceContext(ctx).Info().Str("operator_id", operatorID).Msg("Removed operator reel strip default")
+	return nil
+}
+
 // RemovePlayerAssignment removes a player's assignment
 func (s *ReelStripService) RemovePlayerAssignment(ctx context.Context, playerID uuid.UUID) error {
 	log := s.logger.WithTraceContext(ctx)
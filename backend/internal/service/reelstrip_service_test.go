@@ -4,9 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/launch"
 	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/clock"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -132,6 +137,11 @@ func (m *MockReelStripRepository) DeleteConfig(ctx context.Context, id uuid.UUID
 	return args.Error(0)
 }
 
+func (m *MockReelStripRepository) RestoreConfig(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
 func (m *MockReelStripRepository) SetDefaultConfig(ctx context.Context, id uuid.UUID, gameMode string) error {
 	args := m.Called(ctx, id, gameMode)
 	return args.Error(0)
@@ -181,6 +191,32 @@ func (m *MockReelStripRepository) GetPlayerAssignmentsByPlayerIDs(ctx context.Co
 	return args.Get(0).(map[uuid.UUID]*reelstrip.PlayerReelStripAssignment), args.Error(1)
 }
 
+func (m *MockReelStripRepository) GetOperatorDefault(ctx context.Context, operatorID string) (*reelstrip.OperatorReelStripDefault, error) {
+	args := m.Called(ctx, operatorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reelstrip.OperatorReelStripDefault), args.Error(1)
+}
+
+func (m *MockReelStripRepository) ListOperatorDefaults(ctx context.Context) ([]*reelstrip.OperatorReelStripDefault, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*reelstrip.OperatorReelStripDefault), args.Error(1)
+}
+
+func (m *MockReelStripRepository) UpsertOperatorDefault(ctx context.Context, def *reelstrip.OperatorReelStripDefault) error {
+	args := m.Called(ctx, def)
+	return args.Error(0)
+}
+
+func (m *MockReelStripRepository) DeleteOperatorDefault(ctx context.Context, operatorID string) error {
+	args := m.Called(ctx, operatorID)
+	return args.Error(0)
+}
+
 func (m *MockReelStripRepository) ListConfigs(ctx context.Context, filters *reelstrip.ConfigListFilters) ([]*reelstrip.ReelStripConfig, int64, error) {
 	args := m.Called(ctx, filters)
 	if args.Get(0) == nil {
@@ -253,6 +289,48 @@ func TestGetStripByID(t *testing.T) {
 	})
 }
 
+func TestCreateStripSet(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should save one strip per reel", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+
+		strips := [][]string{{"fa", "bai"}, {"zhong"}, {"fa"}, {"bai", "bai"}, {"fa", "zhong", "bai"}}
+		var saved []*reelstrip.ReelStrip
+		mockRepo.On("CreateBatch", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			saved = args.Get(1).([]*reelstrip.ReelStrip)
+		}).Return(nil)
+
+		ids, err := service.CreateStripSet(ctx, "base_game", strips)
+
+		require.NoError(t, err)
+		require.Len(t, saved, 5)
+		for reel, strip := range saved {
+			assert.Equal(t, ids[reel], strip.ID)
+			assert.Equal(t, reel, strip.ReelNumber)
+			assert.Equal(t, strips[reel], strip.StripData)
+			assert.Equal(t, len(strips[reel]), strip.StripLength)
+			assert.Equal(t, reelstrip.ComputeChecksum(strips[reel]), strip.Checksum)
+		}
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should reject incomplete sets and empty strips", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+
+		_, err := service.CreateStripSet(ctx, "base_game", [][]string{{"fa"}})
+		assert.ErrorIs(t, err, reelstrip.ErrIncompleteSet)
+
+		_, err = service.CreateStripSet(ctx, "base_game", [][]string{{"fa"}, {"fa"}, {}, {"fa"}, {"fa"}})
+		assert.ErrorIs(t, err, reelstrip.ErrInvalidStripLength)
+
+		_, err = service.CreateStripSet(ctx, "bogus", make([][]string, 5))
+		assert.ErrorIs(t, err, reelstrip.ErrInvalidGameMode)
+
+		mockRepo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+}
+
 // ============================================================================
 // CreateConfig TESTS
 // ============================================================================
@@ -879,6 +957,88 @@ func TestGetReelSetForPlayer(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("should use the operator default before the global default", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+		links := newMemoryLaunchStore()
+		service.SetOperatorLinks(links)
+
+		playerID := uuid.New()
+		baseConfigID := uuid.New()
+		freeSpinsConfigID := uuid.New()
+		require.NoError(t, links.CreateLink(ctx, &launch.PlayerLink{OperatorID: "brand-a", ExternalPlayerID: "p1", PlayerID: playerID}))
+
+		mockConfigSet := func(configID uuid.UUID, gameMode string) *reelstrip.ReelStripConfigSet {
+			return &reelstrip.ReelStripConfigSet{
+				Config: &reelstrip.ReelStripConfig{ID: configID, GameMode: gameMode},
+				Strips: [5]*reelstrip.ReelStrip{
+					createMockReelStrip(0, gameMode),
+					createMockReelStrip(1, gameMode),
+					createMockReelStrip(2, gameMode),
+					createMockReelStrip(3, gameMode),
+					createMockReelStrip(4, gameMode),
+				},
+			}
+		}
+
+		mockRepo.On("GetPlayerAssignment", ctx, playerID).Return(nil, reelstrip.ErrAssignmentNotFound)
+		mockRepo.On("GetOperatorDefault", ctx, "brand-a").Return(&reelstrip.OperatorReelStripDefault{
+			OperatorID:        "brand-a",
+			TargetRTP:         94,
+			BaseGameConfigID:  &baseConfigID,
+			FreeSpinsConfigID: &freeSpinsConfigID,
+		}, nil)
+		mockRepo.On("GetSetByConfigID", ctx, baseConfigID).Return(mockConfigSet(baseConfigID, "base_game"), nil)
+		mockRepo.On("GetSetByConfigID", ctx, freeSpinsConfigID).Return(mockConfigSet(freeSpinsConfigID, "free_spins"), nil)
+
+		configSet, err := service.GetReelSetForPlayer(ctx, playerID, "base_game")
+		require.NoError(t, err)
+		assert.Equal(t, baseConfigID, configSet.Config.ID)
+
+		configSet, err = service.GetReelSetForPlayer(ctx, playerID, "free_spins")
+		require.NoError(t, err)
+		assert.Equal(t, freeSpinsConfigID, configSet.Config.ID)
+
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "GetDefaultConfig", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should use the global default for players without an operator default", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+		links := newMemoryLaunchStore()
+		service.SetOperatorLinks(links)
+
+		linkedPlayerID := uuid.New()
+		unlinkedPlayerID := uuid.New()
+		configID := uuid.New()
+		gameMode := "base_game"
+		require.NoError(t, links.CreateLink(ctx, &launch.PlayerLink{OperatorID: "brand-b", ExternalPlayerID: "p1", PlayerID: linkedPlayerID}))
+
+		mockDefaultConfig := &reelstrip.ReelStripConfig{ID: configID, IsDefault: true}
+		mockConfigSet := &reelstrip.ReelStripConfigSet{
+			Config: mockDefaultConfig,
+			Strips: [5]*reelstrip.ReelStrip{
+				createMockReelStrip(0, gameMode),
+				createMockReelStrip(1, gameMode),
+				createMockReelStrip(2, gameMode),
+				createMockReelStrip(3, gameMode),
+				createMockReelStrip(4, gameMode),
+			},
+		}
+
+		mockRepo.On("GetPlayerAssignment", ctx, mock.Anything).Return(nil, reelstrip.ErrAssignmentNotFound)
+		mockRepo.On("GetOperatorDefault", ctx, "brand-b").Return(nil, reelstrip.ErrOperatorDefaultNotFound)
+		mockRepo.On("GetDefaultConfig", ctx, gameMode).Return(mockDefaultConfig, nil)
+		mockRepo.On("GetSetByConfigID", ctx, configID).Return(mockConfigSet, nil)
+
+		for _, playerID := range []uuid.UUID{linkedPlayerID, unlinkedPlayerID} {
+			configSet, err := service.GetReelSetForPlayer(ctx, playerID, gameMode)
+			require.NoError(t, err)
+			assert.Equal(t, configID, configSet.Config.ID)
+		}
+
+		mockRepo.AssertNumberOfCalls(t, "GetOperatorDefault", 1)
+	})
+
 	t.Run("should return error for invalid game mode", func(t *testing.T) {
 		service, _ := setupReelStripService()
 
@@ -890,6 +1050,110 @@ func TestGetReelSetForPlayer(t *testing.T) {
 		assert.Nil(t, configSet)
 		assert.Equal(t, reelstrip.ErrInvalidGameMode, err)
 	})
+
+	t.Run("should route players in a running canary to its config and the rest to the default", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+		canaries := newMemoryCanaryStore()
+		service.SetCanaries(canaries)
+
+		gameMode := "base_game"
+		defaultID, canaryConfigID := uuid.New(), uuid.New()
+		canary := &reelstrip.Canary{ID: uuid.New(), GameMode: gameMode, ConfigID: canaryConfigID, BaselineConfigID: defaultID, Percent: 50, Status: reelstrip.CanaryRunning}
+		require.NoError(t, canaries.Create(ctx, canary))
+
+		configSet := func(configID uuid.UUID) *reelstrip.ReelStripConfigSet {
+			return &reelstrip.ReelStripConfigSet{Config: &reelstrip.ReelStripConfig{ID: configID, GameMode: gameMode}}
+		}
+		mockRepo.On("GetPlayerAssignment", ctx, mock.Anything).Return(nil, reelstrip.ErrAssignmentNotFound)
+		mockRepo.On("GetDefaultConfig", ctx, mock.Anything).Return(&reelstrip.ReelStripConfig{ID: defaultID}, nil)
+		mockRepo.On("GetSetByConfigID", ctx, defaultID).Return(configSet(defaultID), nil)
+		mockRepo.On("GetSetByConfigID", ctx, canaryConfigID).Return(configSet(canaryConfigID), nil)
+
+		var inside, outside uuid.UUID
+		for inside == uuid.Nil || outside == uuid.Nil {
+			playerID := uuid.New()
+			if canary.Includes(playerID) {
+				inside = playerID
+			} else {
+				outside = playerID
+			}
+		}
+
+		got, err := service.GetReelSetForPlayer(ctx, inside, gameMode)
+		require.NoError(t, err)
+		assert.Equal(t, canaryConfigID, got.Config.ID)
+
+		got, err = service.GetReelSetForPlayer(ctx, outside, gameMode)
+		require.NoError(t, err)
+		assert.Equal(t, defaultID, got.Config.ID)
+
+		got, err = service.GetReelSetForPlayer(ctx, inside, "free_spins")
+		require.NoError(t, err)
+		assert.Equal(t, defaultID, got.Config.ID, "the canary only covers its own game mode")
+	})
+}
+
+// ============================================================================
+// SetOperatorDefault TESTS
+// ============================================================================
+
+func TestSetOperatorDefault(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should save configs tuned to the operator's RTP", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+
+		baseConfigID := uuid.New()
+		def := &reelstrip.OperatorReelStripDefault{OperatorID: "brand-a", TargetRTP: 94, BaseGameConfigID: &baseConfigID}
+
+		mockRepo.On("GetConfigByID", ctx, baseConfigID).Return(&reelstrip.ReelStripConfig{ID: baseConfigID, GameMode: "base_game", TargetRTP: 94}, nil)
+		mockRepo.On("UpsertOperatorDefault", ctx, def).Return(nil)
+
+		require.NoError(t, service.SetOperatorDefault(ctx, def))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should reject configs tuned to another RTP", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+
+		baseConfigID := uuid.New()
+		def := &reelstrip.OperatorReelStripDefault{OperatorID: "brand-a", TargetRTP: 94, BaseGameConfigID: &baseConfigID}
+
+		mockRepo.On("GetConfigByID", ctx, baseConfigID).Return(&reelstrip.ReelStripConfig{ID: baseConfigID, GameMode: "base_game", TargetRTP: 96.5}, nil)
+
+		err := service.SetOperatorDefault(ctx, def)
+		assert.ErrorIs(t, err, reelstrip.ErrTargetRTPMismatch)
+		mockRepo.AssertNotCalled(t, "UpsertOperatorDefault", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject configs for the wrong game mode and demo configs", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+
+		freeSpinsConfigID := uuid.New()
+		demoConfigID := uuid.New()
+		mockRepo.On("GetConfigByID", ctx, freeSpinsConfigID).Return(&reelstrip.ReelStripConfig{ID: freeSpinsConfigID, GameMode: "base_game"}, nil)
+		mockRepo.On("GetConfigByID", ctx, demoConfigID).Return(&reelstrip.ReelStripConfig{ID: demoConfigID, GameMode: "base_game", IsDemo: true}, nil)
+
+		err := service.SetOperatorDefault(ctx, &reelstrip.OperatorReelStripDefault{OperatorID: "brand-a", TargetRTP: 94, FreeSpinsConfigID: &freeSpinsConfigID})
+		assert.ErrorIs(t, err, reelstrip.ErrInvalidOperatorDefault)
+
+		err = service.SetOperatorDefault(ctx, &reelstrip.OperatorReelStripDefault{OperatorID: "brand-a", TargetRTP: 94, BaseGameConfigID: &demoConfigID})
+		assert.ErrorIs(t, err, reelstrip.ErrDemoConfig)
+		mockRepo.AssertNotCalled(t, "UpsertOperatorDefault", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should require an operator, an RTP and a config", func(t *testing.T) {
+		service, _ := setupReelStripService()
+
+		configID := uuid.New()
+		for _, def := range []*reelstrip.OperatorReelStripDefault{
+			{TargetRTP: 94, BaseGameConfigID: &configID},
+			{OperatorID: "brand-a", BaseGameConfigID: &configID},
+			{OperatorID: "brand-a", TargetRTP: 94},
+		} {
+			assert.ErrorIs(t, service.SetOperatorDefault(ctx, def), reelstrip.ErrInvalidOperatorDefault)
+		}
+	})
 }
 
 // ============================================================================
@@ -1002,3 +1266,214 @@ func TestValidateStripIntegrity(t *testing.T) {
 		assert.Equal(t, reelstrip.ErrInvalidStripLength, err)
 	})
 }
+
+// ============================================================================
+// Demo config TESTS
+// ============================================================================
+
+func createMockConfigSet(config *reelstrip.ReelStripConfig, gameMode string) *reelstrip.ReelStripConfigSet {
+	set := &reelstrip.ReelStripConfigSet{Config: config}
+	for i := range set.Strips {
+		set.Strips[i] = createMockReelStrip(i, gameMode)
+	}
+	return set
+}
+
+func TestDemoConfigs(t *testing.T) {
+	ctx := context.Background()
+	gameMode := "base_game"
+	demoConfig := &reelstrip.ReelStripConfig{ID: uuid.New(), GameMode: gameMode, IsDemo: true}
+	realConfig := &reelstrip.ReelStripConfig{ID: uuid.New(), GameMode: gameMode, IsDefault: true}
+
+	t.Run("should skip demo config assigned to a real player", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+		playerID := uuid.New()
+
+		mockRepo.On("GetPlayerAssignment", ctx, playerID).Return(&reelstrip.PlayerReelStripAssignment{PlayerID: playerID, BaseGameConfigID: &demoConfig.ID}, nil)
+		mockRepo.On("GetSetByConfigID", ctx, demoConfig.ID).Return(createMockConfigSet(demoConfig, gameMode), nil)
+		mockRepo.On("GetDefaultConfig", ctx, gameMode).Return(realConfig, nil)
+		mockRepo.On("GetSetByConfigID", ctx, realConfig.ID).Return(createMockConfigSet(realConfig, gameMode), nil)
+
+		configSet, err := service.GetReelSetForPlayer(ctx, playerID, gameMode)
+
+		require.NoError(t, err)
+		assert.Equal(t, realConfig.ID, configSet.Config.ID)
+	})
+
+	t.Run("should reject demo config by ID for real-money play", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+		mockRepo.On("GetSetByConfigID", ctx, demoConfig.ID).Return(createMockConfigSet(demoConfig, gameMode), nil)
+
+		_, err := service.GetReelSetByConfig(ctx, demoConfig.ID)
+
+		assert.ErrorIs(t, err, reelstrip.ErrDemoConfig)
+	})
+
+	t.Run("should only load demo configs for trial play", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+		mockRepo.On("GetSetByConfigID", ctx, demoConfig.ID).Return(createMockConfigSet(demoConfig, gameMode), nil)
+		mockRepo.On("GetSetByConfigID", ctx, realConfig.ID).Return(createMockConfigSet(realConfig, gameMode), nil)
+
+		configSet, err := service.GetDemoReelSet(ctx, demoConfig.ID)
+		require.NoError(t, err)
+		assert.True(t, configSet.IsDemo())
+
+		_, err = service.GetDemoReelSet(ctx, realConfig.ID)
+		assert.ErrorIs(t, err, reelstrip.ErrNotDemoConfig)
+	})
+
+	t.Run("should refuse to assign demo config to a player", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+		mockRepo.On("GetConfigByID", ctx, demoConfig.ID).Return(demoConfig, nil)
+
+		err := service.AssignConfigToPlayer(ctx, uuid.New(), demoConfig.ID, gameMode, "test", "admin", nil)
+
+		assert.ErrorIs(t, err, reelstrip.ErrDemoConfig)
+		mockRepo.AssertNotCalled(t, "CreateAssignment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should create demo config flagged as demo", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+		reelStripIDs := [5]uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+		strips := make([]*reelstrip.ReelStrip, 5)
+		for i := range strips {
+			strips[i] = createMockReelStrip(i, gameMode)
+		}
+		mockRepo.On("GetByIDs", ctx, reelStripIDs[:]).Return(strips, nil)
+		mockRepo.On("CreateConfig", ctx, mock.AnythingOfType("*reelstrip.ReelStripConfig")).Return(nil)
+
+		config, err := service.CreateDemoConfig(ctx, "demo-boosted", gameMode, "", reelStripIDs, 150)
+
+		require.NoError(t, err)
+		assert.True(t, config.IsDemo)
+		assert.False(t, config.IsDefault)
+	})
+}
+
+func TestDeletedConfigs(t *testing.T) {
+	ctx := context.Background()
+	gameMode := "base_game"
+	deletedAt := time.Now()
+
+	t.Run("should not resolve a deleted config for play", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+		realConfig := &reelstrip.ReelStripConfig{ID: uuid.New(), GameMode: gameMode, DeletedAt: &deletedAt}
+		demoConfig := &reelstrip.ReelStripConfig{ID: uuid.New(), GameMode: gameMode, IsDemo: true, DeletedAt: &deletedAt}
+		mockRepo.On("GetSetByConfigID", ctx, realConfig.ID).Return(createMockConfigSet(realConfig, gameMode), nil)
+		mockRepo.On("GetSetByConfigID", ctx, demoConfig.ID).Return(createMockConfigSet(demoConfig, gameMode), nil)
+
+		_, err := service.GetReelSetByConfig(ctx, realConfig.ID)
+		assert.ErrorIs(t, err, reelstrip.ErrConfigNotFound)
+
+		_, err = service.GetDemoReelSet(ctx, demoConfig.ID)
+		assert.ErrorIs(t, err, reelstrip.ErrConfigNotFound)
+	})
+
+	t.Run("should pass delete and restore errors through", func(t *testing.T) {
+		service, mockRepo := setupReelStripService()
+		configID := uuid.New()
+		mockRepo.On("DeleteConfig", ctx, configID).Return(reelstrip.ErrConfigIsDefault)
+		mockRepo.On("RestoreConfig", ctx, configID).Return(reelstrip.ErrConfigNameTaken)
+
+		assert.ErrorIs(t, service.DeleteConfig(ctx, configID), reelstrip.ErrConfigIsDefault)
+		assert.ErrorIs(t, service.RestoreConfig(ctx, configID), reelstrip.ErrConfigNameTaken)
+	})
+}
+
+func TestGetReelSetForPlayer_ResolutionCache(t *testing.T) {
+	ctx := context.Background()
+	gameMode := "base_game"
+
+	setup := func(t *testing.T) (*ReelStripService, *MockReelStripRepository, *cache.Cache) {
+		service, mockRepo := setupReelStripService()
+		c := cache.NewCache(cache.NewCacheParams{
+			Channel: "test",
+			Config:  &config.Config{App: config.AppConfig{Name: "test", Env: "test"}},
+		})
+		t.Cleanup(c.Close)
+		service.SetResolutionCache(c)
+		return service, mockRepo, c
+	}
+
+	defaultConfig := &reelstrip.ReelStripConfig{ID: uuid.New(), IsDefault: true}
+	expectDefault := func(mockRepo *MockReelStripRepository, playerID uuid.UUID) {
+		mockRepo.On("GetPlayerAssignment", ctx, playerID).Return(&reelstrip.PlayerReelStripAssignment{PlayerID: playerID}, nil)
+		mockRepo.On("GetDefaultConfig", ctx, gameMode).Return(defaultConfig, nil)
+		mockRepo.On("GetSetByConfigID", ctx, defaultConfig.ID).Return(createMockConfigSet(defaultConfig, gameMode), nil)
+	}
+
+	t.Run("should reuse the resolved config on later spins", func(t *testing.T) {
+		service, mockRepo, _ := setup(t)
+		playerID := uuid.New()
+		expectDefault(mockRepo, playerID)
+
+		for i := 0; i < 3; i++ {
+			configSet, err := service.GetReelSetForPlayer(ctx, playerID, gameMode)
+			require.NoError(t, err)
+			assert.Equal(t, defaultConfig.ID, configSet.Config.ID)
+		}
+
+		mockRepo.AssertNumberOfCalls(t, "GetPlayerAssignment", 1)
+		mockRepo.AssertNumberOfCalls(t, "GetDefaultConfig", 1)
+	})
+
+	t.Run("should resolve again once the player's assignment changes", func(t *testing.T) {
+		service, mockRepo, c := setup(t)
+		playerID := uuid.New()
+		expectDefault(mockRepo, playerID)
+
+		_, err := service.GetReelSetForPlayer(ctx, playerID, gameMode)
+		require.NoError(t, err)
+
+		require.NoError(t, c.Expire(ctx, c.PlayerAssignmentKey(uuid.New())))
+		_, err = service.GetReelSetForPlayer(ctx, playerID, gameMode)
+		require.NoError(t, err)
+		mockRepo.AssertNumberOfCalls(t, "GetPlayerAssignment", 1)
+
+		require.NoError(t, c.Expire(ctx, c.PlayerAssignmentKey(playerID)))
+		_, err = service.GetReelSetForPlayer(ctx, playerID, gameMode)
+		require.NoError(t, err)
+		mockRepo.AssertNumberOfCalls(t, "GetPlayerAssignment", 2)
+	})
+
+	t.Run("should resolve every player again once a shared config key changes", func(t *testing.T) {
+		service, mockRepo, c := setup(t)
+		playerID := uuid.New()
+		expectDefault(mockRepo, playerID)
+
+		_, err := service.GetReelSetForPlayer(ctx, playerID, gameMode)
+		require.NoError(t, err)
+
+		require.NoError(t, c.Expire(ctx, c.DefaultReelStripConfig(gameMode)))
+		_, err = service.GetReelSetForPlayer(ctx, playerID, gameMode)
+		require.NoError(t, err)
+		mockRepo.AssertNumberOfCalls(t, "GetDefaultConfig", 2)
+	})
+
+	t.Run("should not reuse an assignment past its expiry", func(t *testing.T) {
+		service, mockRepo, _ := setup(t)
+		clk := clock.NewFake(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+		service.SetClock(clk)
+		playerID := uuid.New()
+		configID := uuid.New()
+		expiresAt := clk.Now().Add(time.Minute)
+		assigned := &reelstrip.ReelStripConfig{ID: configID}
+		mockRepo.On("GetPlayerAssignment", ctx, playerID).Return(&reelstrip.PlayerReelStripAssignment{
+			PlayerID: playerID, BaseGameConfigID: &configID, ExpiresAt: &expiresAt,
+		}, nil)
+		mockRepo.On("GetSetByConfigID", ctx, configID).Return(createMockConfigSet(assigned, gameMode), nil)
+
+		for i := 0; i < 2; i++ {
+			configSet, err := service.GetReelSetForPlayer(ctx, playerID, gameMode)
+			require.NoError(t, err)
+			require.NotNil(t, configSet.TTL)
+			assert.Equal(t, time.Minute, *configSet.TTL, "the time left on the assignment")
+		}
+		mockRepo.AssertNumberOfCalls(t, "GetPlayerAssignment", 1)
+
+		clk.Advance(time.Minute)
+		_, err := service.GetReelSetForPlayer(ctx, playerID, gameMode)
+		require.NoError(t, err)
+		mockRepo.AssertNumberOfCalls(t, "GetPlayerAssignment", 2)
+	})
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// rtpMonitorPageSize bounds how many configs one page of a monitor pass loads
+const rtpMonitorPageSize = 100
+
+// rtpAlertTimeout bounds each RTP alert notification
+const rtpAlertTimeout = 10 * time.Second
+
+// RTPMonitor periodically compares the realized RTP of every active config with its target RTP
+// Realized RTP over a rolling window is expected within ZScore standard errors of target; the
+// standard error shrinks with the sample size, so large samples catch small drifts. A config
+// outside that range most likely has broken math or data, and operations are alerted.
+type RTPMonitor struct {
+	reelStrips reelstrip.Service
+	alerts     reelstrip.RTPAlertRepository
+	notifier   reelstrip.RTPAlertNotifier // Optional: nil only logs alerts
+	interval   time.Duration
+	window     time.Duration
+	minSpins   int64
+	zScore     float64
+	cooldown   time.Duration
+	logger     *logger.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewRTPMonitor creates a new realized RTP monitor
+func NewRTPMonitor(
+	cfg *config.Config,
+	reelStrips reelstrip.Service,
+	alerts reelstrip.RTPAlertRepository,
+	notifier reelstrip.RTPAlertNotifier,
+	log *logger.Logger,
+) *RTPMonitor {
+	return &RTPMonitor{
+		reelStrips: reelStrips,
+		alerts:     alerts,
+		notifier:   notifier,
+		interval:   time.Duration(cfg.RTPMonitor.IntervalMinutes) * time.Minute,
+		window:     time.Duration(cfg.RTPMonitor.WindowHours) * time.Hour,
+		minSpins:   int64(cfg.RTPMonitor.MinSpins),
+		zScore:     cfg.RTPMonitor.ZScore,
+		cooldown:   time.Duration(cfg.RTPMonitor.CooldownHours) * time.Hour,
+		logger:     log,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Start runs the monitor in the background; a zero interval disables it
+func (m *RTPMonitor) Start() {
+	if m.interval <= 0 || m.window <= 0 || m.zScore <= 0 {
+		close(m.done)
+		m.logger.Info().Msg("RTP monitor disabled")
+		return
+	}
+
+	go m.run()
+	m.logger.Info().
+		Dur("interval", m.interval).
+		Dur("window", m.window).
+		Float64("z_score", m.zScore).
+		Msg("RTP monitor started")
+}
+
+// Stop stops the monitor and waits for a running pass to finish
+func (m *RTPMonitor) Stop() {
+	m.stopOnce.Do(func() { close(m.stop) })
+	<-m.done
+}
+
+func (m *RTPMonitor) run() {
+	defer close(m.done)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stop:
+			return
+		case now := <-ticker.C:
+			m.Run(context.Background(), now)
+		}
+	}
+}
+
+// Run checks the realized RTP of every active real-money config and returns how many it alerted on
+func (m *RTPMonitor) Run(ctx context.Context, now time.Time) int {
+	now = now.UTC()
+	active, notDemo := true, false
+
+	checked, alerted := 0, 0
+	for page := 1; ; page++ {
+		configs, _, err := m.reelStrips.ListConfigs(ctx, &reelstrip.ConfigListFilters{
+			IsActive: &active,
+			IsDemo:   &notDemo,
+			Page:     page,
+			Limit:    rtpMonitorPageSize,
+		})
+		if err != nil {
+			m.logger.Error().Err(err).Msg("Failed to list configs for RTP monitoring")
+			break
+		}
+		for _, cfg := range configs {
+			if cfg.TargetRTP <= 0 {
+				continue // Nothing to judge the realized RTP against
+			}
+			checked++
+			alert, err := m.check(ctx, cfg, now)
+			if err != nil {
+				m.logger.Error().Err(err).Str("config_id", cfg.ID.String()).Msg("Failed to check realized RTP")
+				continue
+			}
+			if alert != nil {
+				alerted++
+				m.alert(ctx, alert)
+			}
+		}
+		if len(configs) < rtpMonitorPageSize {
+			break
+		}
+	}
+
+	if checked > 0 {
+		m.logger.Info().Int("checked", checked).Int("alerted", alerted).Msg("Checked realized RTP")
+	}
+	return alerted
+}
+
+// check samples a config's spins over the window and records an alert when its realized RTP
+// falls outside the expected range; it returns nil when the config is within range, has too
+// few spins to judge, or was alerted on within the cooldown
+func (m *RTPMonitor) check(ctx context.Context, cfg *reelstrip.ReelStripConfig, now time.Time) (*reelstrip.RTPAlert, error) {
+	since := now.Add(-m.window)
+	sample, err := m.alerts.Sample(ctx, cfg.ID, since, now)
+	if err != nil {
+		return nil, err
+	}
+	realized, ok := sample.RTP()
+	if !ok || sample.Spins < m.minSpins {
+		return nil, nil
+	}
+
+	stdErr := sample.StandardError()
+	margin := m.zScore * stdErr
+	if math.Abs(realized-cfg.TargetRTP) <= margin {
+		return nil, nil
+	}
+
+	last, err := m.alerts.LastAlertedAt(ctx, cfg.ID)
+	if err != nil {
+		return nil, err
+	}
+	if last != nil && now.Sub(*last) < m.cooldown {
+		return nil, nil
+	}
+
+	z := math.Inf(1)
+	if stdErr > 0 {
+		z = (realized - cfg.TargetRTP) / stdErr
+	} else if realized < cfg.TargetRTP {
+		z = math.Inf(-1)
+	}
+	alert := &reelstrip.RTPAlert{
+		ID:          uuid.New(),
+		ConfigID:    cfg.ID,
+		ConfigName:  cfg.Name,
+		GameMode:    cfg.GameMode,
+		TargetRTP:   cfg.TargetRTP,
+		RealizedRTP: realized,
+		LowerBound:  cfg.TargetRTP - margin,
+		UpperBound:  cfg.TargetRTP + margin,
+		ZScore:      clampZScore(z),
+		Spins:       sample.Spins,
+		Wagered:     sample.Wagered,
+		Won:         sample.Won,
+		WindowStart: since,
+		WindowEnd:   now,
+		CreatedAt:   now,
+	}
+	if err := m.alerts.Create(ctx, alert); err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+// clampZScore keeps a z-score within what the alerts table stores; returns that never vary
+// make any drift infinitely unlikely
+func clampZScore(z float64) float64 {
+	const limit = 999999.99
+	return math.Max(-limit, math.Min(limit, z))
+}
+
+// alert logs an RTP alert and notifies operations
+func (m *RTPMonitor) alert(ctx context.Context, alert *reelstrip.RTPAlert) {
+	m.logger.Error().
+		Str("config_id", alert.ConfigID.String()).
+		Str("config_name", alert.ConfigName).
+		Str("game_mode", alert.GameMode).
+		Float64("target_rtp", alert.TargetRTP).
+		Float64("realized_rtp", alert.RealizedRTP).
+		Float64("lower_bound", alert.LowerBound).
+		Float64("upper_bound", alert.UpperBound).
+		Float64("z_score", alert.ZScore).
+		Int64("spins", alert.Spins).
+		Msg("Realized RTP outside expected bounds")
+
+	if m.notifier == nil {
+		return
+	}
+	notifyCtx, cancel := context.WithTimeout(ctx, rtpAlertTimeout)
+	defer cancel()
+	if err := m.notifier.NotifyRTPAlert(notifyCtx, alert); err != nil {
+		m.logger.Warn().Err(err).Str("notifier", m.notifier.Name()).Msg("Failed to send RTP alert")
+	}
+}
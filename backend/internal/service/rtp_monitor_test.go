@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRTPAlertStore is an in-memory reelstrip.RTPAlertRepository with canned samples
+type memoryRTPAlertStore struct {
+	samples map[uuid.UUID]reelstrip.RTPSample
+	alerts  []*reelstrip.RTPAlert
+}
+
+func (m *memoryRTPAlertStore) Sample(ctx context.Context, configID uuid.UUID, since, until time.Time) (*reelstrip.RTPSample, error) {
+	sample := m.samples[configID]
+	return &sample, nil
+}
+
+func (m *memoryRTPAlertStore) Create(ctx context.Context, alert *reelstrip.RTPAlert) error {
+	m.alerts = append(m.alerts, alert)
+	return nil
+}
+
+func (m *memoryRTPAlertStore) LastAlertedAt(ctx context.Context, configID uuid.UUID) (*time.Time, error) {
+	var last *time.Time
+	for _, alert := range m.alerts {
+		if alert.ConfigID == configID && (last == nil || alert.CreatedAt.After(*last)) {
+			last = &alert.CreatedAt
+		}
+	}
+	return last, nil
+}
+
+// monitoredConfigs lists the active configs; other reelstrip.Service methods are unused
+type monitoredConfigs struct {
+	reelstrip.Service
+	configs []*reelstrip.ReelStripConfig
+}
+
+func (c *monitoredConfigs) ListConfigs(ctx context.Context, filters *reelstrip.ConfigListFilters) ([]*reelstrip.ReelStripConfig, int64, error) {
+	if filters.Page > 1 {
+		return nil, int64(len(c.configs)), nil
+	}
+	return c.configs, int64(len(c.configs)), nil
+}
+
+// rtpAlertRecorder captures delivered alerts
+type rtpAlertRecorder struct {
+	alerts []*reelstrip.RTPAlert
+}
+
+func (r *rtpAlertRecorder) Name() string { return "recorder" }
+
+func (r *rtpAlertRecorder) NotifyRTPAlert(ctx context.Context, alert *reelstrip.RTPAlert) error {
+	r.alerts = append(r.alerts, alert)
+	return nil
+}
+
+// rtpSample builds a sample of n unit-stake spins with the given RTP and per-spin return standard deviation
+func rtpSample(n int64, rtp, stdDev float64) reelstrip.RTPSample {
+	count := float64(n)
+	mean := rtp / 100
+	return reelstrip.RTPSample{
+		Spins:             n,
+		Wagered:           count,
+		Won:               count * mean,
+		SumReturns:        count * mean,
+		SumSquaredReturns: stdDev*stdDev*(count-1) + count*mean*mean,
+	}
+}
+
+func TestRTPMonitor_Run(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	newMonitor := func(configs []*reelstrip.ReelStripConfig, samples map[uuid.UUID]reelstrip.RTPSample) (*RTPMonitor, *memoryRTPAlertStore, *rtpAlertRecorder) {
+		cfg := &config.Config{RTPMonitor: config.RTPMonitorConfig{
+			IntervalMinutes: 60,
+			WindowHours:     24,
+			MinSpins:        1000,
+			ZScore:          4,
+			CooldownHours:   24,
+		}}
+		store := &memoryRTPAlertStore{samples: samples}
+		recorder := &rtpAlertRecorder{}
+		return NewRTPMonitor(cfg, &monitoredConfigs{configs: configs}, store, recorder, logger.New("error", "json")), store, recorder
+	}
+	newConfig := func(name string, target float64) *reelstrip.ReelStripConfig {
+		return &reelstrip.ReelStripConfig{ID: uuid.New(), Name: name, GameMode: "base_game", TargetRTP: target, IsActive: true}
+	}
+
+	t.Run("should alert on a config outside the bounds for its sample size", func(t *testing.T) {
+		drifted, healthy, small, untargeted := newConfig("drifted", 96), newConfig("healthy", 96), newConfig("small", 96), newConfig("untargeted", 0)
+		// A return standard deviation of 1 over 10000 spins gives a standard error of 1 RTP point
+		monitor, store, recorder := newMonitor(
+			[]*reelstrip.ReelStripConfig{drifted, healthy, small, untargeted},
+			map[uuid.UUID]reelstrip.RTPSample{
+				drifted.ID:    rtpSample(10000, 90, 1),
+				healthy.ID:    rtpSample(10000, 98, 1),
+				small.ID:      rtpSample(500, 50, 1),
+				untargeted.ID: rtpSample(10000, 50, 1),
+			},
+		)
+
+		assert.Equal(t, 1, monitor.Run(ctx, now))
+		require.Len(t, store.alerts, 1)
+		require.Len(t, recorder.alerts, 1)
+
+		alert := recorder.alerts[0]
+		assert.Equal(t, drifted.ID, alert.ConfigID)
+		assert.InDelta(t, 90, alert.RealizedRTP, 0.001)
+		assert.InDelta(t, 92, alert.LowerBound, 0.01)
+		assert.InDelta(t, 100, alert.UpperBound, 0.01)
+		assert.InDelta(t, -6, alert.ZScore, 0.01)
+		assert.Equal(t, now.Add(-24*time.Hour), alert.WindowStart)
+	})
+
+	t.Run("should tighten the bounds as the sample grows", func(t *testing.T) {
+		config := newConfig("v2", 96)
+		monitor, _, _ := newMonitor(
+			[]*reelstrip.ReelStripConfig{config},
+			map[uuid.UUID]reelstrip.RTPSample{config.ID: rtpSample(10000, 94, 1)},
+		)
+		assert.Equal(t, 0, monitor.Run(ctx, now))
+
+		monitor, _, _ = newMonitor(
+			[]*reelstrip.ReelStripConfig{config},
+			map[uuid.UUID]reelstrip.RTPSample{config.ID: rtpSample(1000000, 94, 1)},
+		)
+		assert.Equal(t, 1, monitor.Run(ctx, now))
+	})
+
+	t.Run("should not alert on the same config again within the cooldown", func(t *testing.T) {
+		config := newConfig("v2", 96)
+		monitor, store, _ := newMonitor(
+			[]*reelstrip.ReelStripConfig{config},
+			map[uuid.UUID]reelstrip.RTPSample{config.ID: rtpSample(10000, 90, 1)},
+		)
+
+		assert.Equal(t, 1, monitor.Run(ctx, now))
+		assert.Equal(t, 0, monitor.Run(ctx, now.Add(time.Hour)))
+		assert.Equal(t, 1, monitor.Run(ctx, now.Add(25*time.Hour)))
+		assert.Len(t, store.alerts, 2)
+	})
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/slotmachine/backend/domain/admin"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+)
+
+// SeedDataValidator checks the data the server cannot serve without, which migrations and
+// seed scripts create rather than the server itself
+type SeedDataValidator struct {
+	admins     admin.Repository
+	reelStrips reelstrip.Repository
+}
+
+// NewSeedDataValidator creates a new seed data validator
+func NewSeedDataValidator(admins admin.Repository, reelStrips reelstrip.Repository) *SeedDataValidator {
+	return &SeedDataValidator{
+		admins:     admins,
+		reelStrips: reelStrips,
+	}
+}
+
+// Validate returns a *config.ValidationError listing all missing seed data
+func (v *SeedDataValidator) Validate(ctx context.Context) error {
+	var problems []string
+
+	active := admin.StatusActive
+	if _, total, err := v.admins.List(ctx, admin.ListFilters{Status: &active, Page: 1, PageSize: 1}); err != nil {
+		problems = append(problems, fmt.Sprintf("failed to list admins: %v", err))
+	} else if total == 0 {
+		problems = append(problems, "no active admin account; run the migrations to create the initial admin")
+	}
+
+	for _, mode := range []reelstrip.GameMode{reelstrip.BaseGame, reelstrip.FreeSpins} {
+		_, err := v.reelStrips.GetDefaultConfig(ctx, string(mode))
+		switch {
+		case errors.Is(err, reelstrip.ErrNoDefaultConfig):
+			problems = append(problems, fmt.Sprintf("no active default reel strip config for %s; run make seed-reelstrips", mode))
+		case err != nil:
+			problems = append(problems, fmt.Sprintf("failed to load the default reel strip config for %s: %v", mode, err))
+		}
+	}
+
+	if len(problems) > 0 {
+		return &config.ValidationError{Problems: problems}
+	}
+	return nil
+}
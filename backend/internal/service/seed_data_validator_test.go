@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	adminDomain "github.com/slotmachine/backend/domain/admin"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (m *MockAdminRepository) List(ctx context.Context, filters adminDomain.ListFilters) ([]*adminDomain.Admin, int64, error) {
+	args := m.Called(ctx, filters)
+	return nil, args.Get(0).(int64), args.Error(1)
+}
+
+func TestSeedDataValidator_Validate(t *testing.T) {
+	ctx := context.Background()
+	admins := new(MockAdminRepository)
+	admins.On("List", ctx, mock.Anything).Return(int64(1), nil)
+	reelStrips := new(MockReelStripRepository)
+	reelStrips.On("GetDefaultConfig", ctx, mock.Anything).Return(&reelstrip.ReelStripConfig{}, nil)
+
+	assert.NoError(t, NewSeedDataValidator(admins, reelStrips).Validate(ctx))
+}
+
+func TestSeedDataValidator_ReportsEverythingMissing(t *testing.T) {
+	ctx := context.Background()
+	admins := new(MockAdminRepository)
+	admins.On("List", ctx, mock.Anything).Return(int64(0), nil)
+	reelStrips := new(MockReelStripRepository)
+	reelStrips.On("GetDefaultConfig", ctx, mock.Anything).Return(nil, reelstrip.ErrNoDefaultConfig)
+
+	err := NewSeedDataValidator(admins, reelStrips).Validate(ctx)
+
+	var verr *config.ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Len(t, verr.Problems, 3, "one problem for the admin and one per game mode")
+}
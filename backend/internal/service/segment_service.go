@@ -0,0 +1,403 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/segment"
+	"github.com/slotmachine/backend/domain/vip"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// segmentTargetTTL is how long a resolved target is cached per player
+// Dynamic segments change with player activity, so admin and activity changes take
+// effect within this window instead of being invalidated explicitly.
+const segmentTargetTTL = 30 * time.Second
+
+// SegmentService implements segment.Service
+type SegmentService struct {
+	repo          segment.Repository
+	playerRepo    player.Repository
+	reelstripRepo reelstrip.Repository
+	cache         *cache.Cache // Optional: nil disables caching of resolved targets
+	vip           vip.Service  // Optional: nil evaluates every player at VIP level 0
+	logger        *logger.Logger
+	now           func() time.Time
+}
+
+// NewSegmentService creates a new segment service
+func NewSegmentService(
+	repo segment.Repository,
+	playerRepo player.Repository,
+	reelstripRepo reelstrip.Repository,
+	cache *cache.Cache,
+	log *logger.Logger,
+) *SegmentService {
+	return &SegmentService{
+		repo:          repo,
+		playerRepo:    playerRepo,
+		reelstripRepo: reelstripRepo,
+		cache:         cache,
+		logger:        log,
+		now:           time.Now,
+	}
+}
+
+// SetVIPService enables vip_level rules
+func (s *SegmentService) SetVIPService(vipService vip.Service) {
+	s.vip = vipService
+}
+
+// AddTag tags a player
+func (s *SegmentService) AddTag(ctx context.Context, playerID uuid.UUID, tag, createdBy string) (*segment.PlayerTag, error) {
+	tag = segment.NormalizeTag(tag)
+	if err := segment.ValidateTag(tag); err != nil {
+		return nil, err
+	}
+	if _, err := s.playerRepo.GetByID(ctx, playerID); err != nil {
+		return nil, err
+	}
+
+	playerTag := &segment.PlayerTag{
+		PlayerID:  playerID,
+		Tag:       tag,
+		CreatedBy: createdBy,
+		CreatedAt: s.now(),
+	}
+	if err := s.repo.AddTag(ctx, playerTag); err != nil {
+		return nil, err
+	}
+	return playerTag, nil
+}
+
+// RemoveTag removes a tag from a player
+func (s *SegmentService) RemoveTag(ctx context.Context, playerID uuid.UUID, tag string) error {
+	return s.repo.RemoveTag(ctx, playerID, segment.NormalizeTag(tag))
+}
+
+// ListTags lists a player's tags
+func (s *SegmentService) ListTags(ctx context.Context, playerID uuid.UUID) ([]*segment.PlayerTag, error) {
+	return s.repo.ListTags(ctx, playerID)
+}
+
+// CreateSegment validates and stores a new segment
+func (s *SegmentService) CreateSegment(ctx context.Context, seg *segment.Segment) error {
+	if err := s.validateSegment(ctx, seg); err != nil {
+		return err
+	}
+
+	now := s.now()
+	if seg.ID == uuid.Nil {
+		seg.ID = uuid.New()
+	}
+	seg.CreatedAt = now
+	seg.UpdatedAt = now
+	return s.repo.CreateSegment(ctx, seg)
+}
+
+// GetSegment retrieves a segment by ID
+func (s *SegmentService) GetSegment(ctx context.Context, id uuid.UUID) (*segment.Segment, error) {
+	return s.repo.GetSegmentByID(ctx, id)
+}
+
+// ListSegments lists segments
+func (s *SegmentService) ListSegments(ctx context.Context, isActive *bool) ([]*segment.Segment, error) {
+	return s.repo.ListSegments(ctx, isActive)
+}
+
+// UpdateSegment validates and saves a segment
+func (s *SegmentService) UpdateSegment(ctx context.Context, seg *segment.Segment) error {
+	if err := s.validateSegment(ctx, seg); err != nil {
+		return err
+	}
+	return s.repo.UpdateSegment(ctx, seg)
+}
+
+// DeleteSegment deletes a segment and its targets
+func (s *SegmentService) DeleteSegment(ctx context.Context, id uuid.UUID) error {
+	return s.repo.DeleteSegment(ctx, id)
+}
+
+// CreateTarget validates and stores a new segment target
+func (s *SegmentService) CreateTarget(ctx context.Context, target *segment.Target) error {
+	if err := s.validateTarget(ctx, target); err != nil {
+		return err
+	}
+
+	now := s.now()
+	if target.ID == uuid.Nil {
+		target.ID = uuid.New()
+	}
+	target.CreatedAt = now
+	target.UpdatedAt = now
+	return s.repo.CreateTarget(ctx, target)
+}
+
+// GetTarget retrieves a segment target by ID
+func (s *SegmentService) GetTarget(ctx context.Context, id uuid.UUID) (*segment.Target, error) {
+	return s.repo.GetTargetByID(ctx, id)
+}
+
+// ListTargets lists segment targets ordered by priority
+func (s *SegmentService) ListTargets(ctx context.Context, filters segment.TargetListFilters) ([]*segment.Target, error) {
+	return s.repo.ListTargets(ctx, filters)
+}
+
+// UpdateTarget validates and saves a segment target
+func (s *SegmentService) UpdateTarget(ctx context.Context, target *segment.Target) error {
+	if err := s.validateTarget(ctx, target); err != nil {
+		return err
+	}
+	return s.repo.UpdateTarget(ctx, target)
+}
+
+// DeleteTarget deletes a segment target
+func (s *SegmentService) DeleteTarget(ctx context.Context, id uuid.UUID) error {
+	return s.repo.DeleteTarget(ctx, id)
+}
+
+// IsMember evaluates whether a player belongs to a segment
+// Inactive segments have no members.
+func (s *SegmentService) IsMember(ctx context.Context, segmentID, playerID uuid.UUID) (bool, error) {
+	seg, err := s.repo.GetSegmentByID(ctx, segmentID)
+	if err != nil {
+		return false, err
+	}
+	if !seg.IsActive {
+		return false, nil
+	}
+
+	facts, err := s.loadFacts(ctx, playerID, seg.Rules.Windows())
+	if err != nil {
+		return false, err
+	}
+	return seg.Rules.Matches(facts), nil
+}
+
+// EvaluatePlayer returns every active segment the player currently belongs to
+func (s *SegmentService) EvaluatePlayer(ctx context.Context, playerID uuid.UUID) ([]*segment.Segment, error) {
+	active := true
+	segments, err := s.repo.ListSegments(ctx, &active)
+	if err != nil {
+		return nil, err
+	}
+
+	var windows []int
+	for _, seg := range segments {
+		windows = append(windows, seg.Rules.Windows()...)
+	}
+	facts, err := s.loadFacts(ctx, playerID, windows)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]*segment.Segment, 0)
+	for _, seg := range segments {
+		if seg.Rules.Matches(facts) {
+			matched = append(matched, seg)
+		}
+	}
+	return matched, nil
+}
+
+// ResolveTarget returns the highest priority active target of a kind that applies to the player
+// Results, including misses, are cached per player for segmentTargetTTL. Unknown players
+// (e.g. admin IDs on shared rate-limited routes) resolve to ErrNoTarget.
+func (s *SegmentService) ResolveTarget(ctx context.Context, playerID uuid.UUID, kind segment.TargetKind) (*segment.Target, error) {
+	if s.cache == nil {
+		return s.resolveTarget(ctx, playerID, kind)
+	}
+
+	ttl := segmentTargetTTL
+	res, err := s.cache.GetWithSingleflight(ctx, s.cache.SegmentTargetKey(string(kind), playerID), &segment.Target{}, func() (any, error) {
+		target, err := s.resolveTarget(ctx, playerID, kind)
+		if errors.Is(err, segment.ErrNoTarget) {
+			return &segment.Target{}, nil
+		}
+		return target, err
+	}, &ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	target := res.(*segment.Target)
+	if target.ID == uuid.Nil {
+		return nil, segment.ErrNoTarget
+	}
+	return target, nil
+}
+
+// InvalidatePlayer drops the player's cached target resolutions so they are evaluated against fresh facts
+func (s *SegmentService) InvalidatePlayer(ctx context.Context, playerID uuid.UUID) {
+	if s.cache == nil {
+		return
+	}
+	for _, kind := range segment.TargetKinds {
+		if err := s.cache.Expire(ctx, s.cache.SegmentTargetKey(string(kind), playerID)); err != nil {
+			s.logger.Warn().Err(err).Str("player_id", playerID.String()).Str("kind", string(kind)).Msg("Failed to invalidate segment target cache")
+		}
+	}
+}
+
+// resolveTarget evaluates the segments of active targets in priority order
+func (s *SegmentService) resolveTarget(ctx context.Context, playerID uuid.UUID, kind segment.TargetKind) (*segment.Target, error) {
+	active := true
+	targets, err := s.repo.ListTargets(ctx, segment.TargetListFilters{Kind: &kind, IsActive: &active})
+	if err != nil {
+		return nil, err
+	}
+	if len(targets) == 0 {
+		return nil, segment.ErrNoTarget
+	}
+
+	ids := make([]uuid.UUID, 0, len(targets))
+	for _, t := range targets {
+		ids = append(ids, t.SegmentID)
+	}
+	segments, err := s.repo.GetSegmentsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*segment.Segment, len(segments))
+	var windows []int
+	for _, seg := range segments {
+		if seg.IsActive {
+			byID[seg.ID] = seg
+			windows = append(windows, seg.Rules.Windows()...)
+		}
+	}
+	if len(byID) == 0 {
+		return nil, segment.ErrNoTarget
+	}
+
+	facts, err := s.loadFacts(ctx, playerID, windows)
+	if errors.Is(err, player.ErrPlayerNotFound) {
+		return nil, segment.ErrNoTarget
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range targets {
+		if seg, ok := byID[t.SegmentID]; ok && seg.Rules.Matches(facts) {
+			return t, nil
+		}
+	}
+	return nil, segment.ErrNoTarget
+}
+
+// loadFacts gathers what segment rules are evaluated against
+// Activity stats are only queried for the windows the rules actually use.
+func (s *SegmentService) loadFacts(ctx context.Context, playerID uuid.UUID, windows []int) (*segment.Facts, error) {
+	p, err := s.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.repo.ListTags(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	facts := &segment.Facts{
+		Tags:         make(map[string]bool, len(tags)),
+		Balance:      p.Balance,
+		TotalSpins:   p.TotalSpins,
+		TotalWagered: p.TotalWagered,
+		TotalWon:     p.TotalWon,
+		CreatedAt:    p.CreatedAt,
+		Now:          now,
+		Activity:     make(map[int]segment.ActivityStats),
+	}
+	for _, t := range tags {
+		facts.Tags[t.Tag] = true
+	}
+
+	if s.vip != nil {
+		status, err := s.vip.GetStatus(ctx, playerID)
+		if err != nil {
+			return nil, err
+		}
+		facts.VIPLevel = status.Level()
+	}
+
+	for _, days := range windows {
+		if _, ok := facts.Activity[days]; ok {
+			continue
+		}
+		stats, err := s.repo.GetActivityStats(ctx, playerID, now.AddDate(0, 0, -days))
+		if err != nil {
+			return nil, err
+		}
+		facts.Activity[days] = *stats
+	}
+	return facts, nil
+}
+
+// validateSegment checks the name and rules and normalizes tag rules
+func (s *SegmentService) validateSegment(ctx context.Context, seg *segment.Segment) error {
+	seg.Name = strings.TrimSpace(seg.Name)
+	if seg.Name == "" {
+		return fmt.Errorf("%w: name is required", segment.ErrInvalidRule)
+	}
+	for i := range seg.Rules {
+		if seg.Rules[i].Field == segment.FieldTag {
+			seg.Rules[i].Tag = segment.NormalizeTag(seg.Rules[i].Tag)
+		}
+	}
+	if err := seg.Rules.Validate(); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.GetSegmentByName(ctx, seg.Name)
+	if err == nil && existing.ID != seg.ID {
+		return segment.ErrSegmentNameTaken
+	}
+	if err != nil && !errors.Is(err, segment.ErrSegmentNotFound) {
+		return err
+	}
+	return nil
+}
+
+// validateTarget checks the target settings and that referenced entities exist
+func (s *SegmentService) validateTarget(ctx context.Context, target *segment.Target) error {
+	if err := target.Validate(); err != nil {
+		return err
+	}
+	if _, err := s.repo.GetSegmentByID(ctx, target.SegmentID); err != nil {
+		return err
+	}
+
+	if target.Kind == segment.TargetReelStrip {
+		settings, _ := target.ReelStripSettings()
+		checks := []struct {
+			id   *uuid.UUID
+			mode reelstrip.GameMode
+		}{
+			{settings.BaseGameConfigID, reelstrip.BaseGame},
+			{settings.FreeSpinsConfigID, reelstrip.FreeSpins},
+		}
+		for _, c := range checks {
+			if c.id == nil {
+				continue
+			}
+			config, err := s.reelstripRepo.GetConfigByID(ctx, *c.id)
+			if err != nil {
+				return err
+			}
+			if config.GameMode != string(c.mode) && config.GameMode != string(reelstrip.Both) {
+				return fmt.Errorf("%w: config %s is for %s, not %s", segment.ErrInvalidSettings, config.ID, config.GameMode, c.mode)
+			}
+		}
+	}
+	return nil
+}
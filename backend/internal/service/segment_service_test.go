@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/segment"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSegmentRepository is a mock implementation of segment.Repository
+type MockSegmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockSegmentRepository) AddTag(ctx context.Context, tag *segment.PlayerTag) error {
+	return m.Called(ctx, tag).Error(0)
+}
+
+func (m *MockSegmentRepository) RemoveTag(ctx context.Context, playerID uuid.UUID, tag string) error {
+	return m.Called(ctx, playerID, tag).Error(0)
+}
+
+func (m *MockSegmentRepository) ListTags(ctx context.Context, playerID uuid.UUID) ([]*segment.PlayerTag, error) {
+	args := m.Called(ctx, playerID)
+	return args.Get(0).([]*segment.PlayerTag), args.Error(1)
+}
+
+func (m *MockSegmentRepository) CreateSegment(ctx context.Context, s *segment.Segment) error {
+	return m.Called(ctx, s).Error(0)
+}
+
+func (m *MockSegmentRepository) GetSegmentByID(ctx context.Context, id uuid.UUID) (*segment.Segment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*segment.Segment), args.Error(1)
+}
+
+func (m *MockSegmentRepository) GetSegmentByName(ctx context.Context, name string) (*segment.Segment, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*segment.Segment), args.Error(1)
+}
+
+func (m *MockSegmentRepository) GetSegmentsByIDs(ctx context.Context, ids []uuid.UUID) ([]*segment.Segment, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]*segment.Segment), args.Error(1)
+}
+
+func (m *MockSegmentRepository) ListSegments(ctx context.Context, isActive *bool) ([]*segment.Segment, error) {
+	args := m.Called(ctx, isActive)
+	return args.Get(0).([]*segment.Segment), args.Error(1)
+}
+
+func (m *MockSegmentRepository) UpdateSegment(ctx context.Context, s *segment.Segment) error {
+	return m.Called(ctx, s).Error(0)
+}
+
+func (m *MockSegmentRepository) DeleteSegment(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockSegmentRepository) CreateTarget(ctx context.Context, t *segment.Target) error {
+	return m.Called(ctx, t).Error(0)
+}
+
+func (m *MockSegmentRepository) GetTargetByID(ctx context.Context, id uuid.UUID) (*segment.Target, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*segment.Target), args.Error(1)
+}
+
+func (m *MockSegmentRepository) ListTargets(ctx context.Context, filters segment.TargetListFilters) ([]*segment.Target, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]*segment.Target), args.Error(1)
+}
+
+func (m *MockSegmentRepository) UpdateTarget(ctx context.Context, t *segment.Target) error {
+	return m.Called(ctx, t).Error(0)
+}
+
+func (m *MockSegmentRepository) DeleteTarget(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockSegmentRepository) GetActivityStats(ctx context.Context, playerID uuid.UUID, since time.Time) (*segment.ActivityStats, error) {
+	args := m.Called(ctx, playerID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*segment.ActivityStats), args.Error(1)
+}
+
+func setupSegmentService() (*SegmentService, *MockSegmentRepository, *MockPlayerRepository) {
+	repo := new(MockSegmentRepository)
+	playerRepo := new(MockPlayerRepository)
+	log := logger.New("error", "json")
+	svc := NewSegmentService(repo, playerRepo, nil, nil, log)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	return svc, repo, playerRepo
+}
+
+func TestSegmentRules(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	facts := &segment.Facts{
+		Tags:         map[string]bool{"vip": true},
+		Balance:      500,
+		TotalWagered: 20000,
+		CreatedAt:    now.AddDate(0, 0, -40),
+		Now:          now,
+		VIPLevel:     2,
+		Activity:     map[int]segment.ActivityStats{7: {Spins: 30, Wagered: 1500, Won: 1200}},
+	}
+
+	tests := []struct {
+		name  string
+		rule  segment.Rule
+		match bool
+	}{
+		{"has tag", segment.Rule{Field: segment.FieldTag, Op: segment.OpHas, Tag: "VIP"}, true},
+		{"not has tag", segment.Rule{Field: segment.FieldTag, Op: segment.OpNotHas, Tag: "vip"}, false},
+		{"lifetime wagered", segment.Rule{Field: segment.FieldTotalWagered, Op: segment.OpGTE, Value: 20000}, true},
+		{"account age", segment.Rule{Field: segment.FieldAccountAge, Op: segment.OpGT, Value: 30}, true},
+		{"vip level", segment.Rule{Field: segment.FieldVIPLevel, Op: segment.OpGTE, Value: 3}, false},
+		{"wagered in window", segment.Rule{Field: segment.FieldWagered, Op: segment.OpGT, Value: 1000, WindowDays: 7}, true},
+		{"spins in window", segment.Rule{Field: segment.FieldSpins, Op: segment.OpLT, Value: 10, WindowDays: 7}, false},
+		{"window not loaded", segment.Rule{Field: segment.FieldWagered, Op: segment.OpGT, Value: 0, WindowDays: 30}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.rule.Validate())
+			assert.Equal(t, tt.match, tt.rule.Matches(facts))
+		})
+	}
+
+	t.Run("should reject invalid rules", func(t *testing.T) {
+		assert.ErrorIs(t, segment.Rules{}.Validate(), segment.ErrInvalidRule)
+		assert.ErrorIs(t, segment.Rule{Field: segment.FieldWagered, Op: segment.OpGT}.Validate(), segment.ErrInvalidRule, "window is required")
+		assert.ErrorIs(t, segment.Rule{Field: segment.FieldBalance, Op: segment.OpHas}.Validate(), segment.ErrInvalidRule)
+		assert.ErrorIs(t, segment.Rule{Field: "country", Op: segment.OpEQ}.Validate(), segment.ErrInvalidRule)
+	})
+}
+
+func TestSegmentService_ResolveTarget(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	vip := &segment.Segment{
+		ID:       uuid.New(),
+		Name:     "vip",
+		Rules:    segment.Rules{{Field: segment.FieldTag, Op: segment.OpHas, Tag: "vip"}},
+		IsActive: true,
+	}
+	highRollers := &segment.Segment{
+		ID:       uuid.New(),
+		Name:     "high-rollers",
+		Rules:    segment.Rules{{Field: segment.FieldWagered, Op: segment.OpGT, Value: 1000, WindowDays: 7}},
+		IsActive: true,
+	}
+	highRollerBonus := &segment.Target{ID: uuid.New(), SegmentID: highRollers.ID, Kind: segment.TargetBonus, Priority: 10, Settings: json.RawMessage(`{"extra_free_spins":5}`)}
+	vipBonus := &segment.Target{ID: uuid.New(), SegmentID: vip.ID, Kind: segment.TargetBonus, Priority: 1, Settings: json.RawMessage(`{"extra_free_spins":2}`)}
+	kind := segment.TargetBonus
+	active := true
+	filters := segment.TargetListFilters{Kind: &kind, IsActive: &active}
+
+	t.Run("should pick the highest priority target whose segment matches", func(t *testing.T) {
+		svc, repo, playerRepo := setupSegmentService()
+		repo.On("ListTargets", ctx, filters).Return([]*segment.Target{highRollerBonus, vipBonus}, nil)
+		repo.On("GetSegmentsByIDs", ctx, []uuid.UUID{highRollers.ID, vip.ID}).Return([]*segment.Segment{vip, highRollers}, nil)
+		playerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID}, nil)
+		repo.On("ListTags", ctx, playerID).Return([]*segment.PlayerTag{{PlayerID: playerID, Tag: "vip"}}, nil)
+		repo.On("GetActivityStats", ctx, playerID, svc.now().AddDate(0, 0, -7)).Return(&segment.ActivityStats{Wagered: 200}, nil)
+
+		target, err := svc.ResolveTarget(ctx, playerID, segment.TargetBonus)
+		require.NoError(t, err)
+		assert.Equal(t, vipBonus.ID, target.ID, "high roller rule does not match, so the vip target applies")
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("should return ErrNoTarget for unknown players", func(t *testing.T) {
+		svc, repo, playerRepo := setupSegmentService()
+		repo.On("ListTargets", ctx, filters).Return([]*segment.Target{vipBonus}, nil)
+		repo.On("GetSegmentsByIDs", ctx, []uuid.UUID{vip.ID}).Return([]*segment.Segment{vip}, nil)
+		playerRepo.On("GetByID", ctx, playerID).Return(nil, player.ErrPlayerNotFound)
+
+		_, err := svc.ResolveTarget(ctx, playerID, segment.TargetBonus)
+		assert.ErrorIs(t, err, segment.ErrNoTarget)
+	})
+
+	t.Run("should skip evaluation when no targets exist", func(t *testing.T) {
+		svc, repo, playerRepo := setupSegmentService()
+		repo.On("ListTargets", ctx, filters).Return([]*segment.Target{}, nil)
+
+		_, err := svc.ResolveTarget(ctx, playerID, segment.TargetBonus)
+		assert.ErrorIs(t, err, segment.ErrNoTarget)
+		playerRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSegmentService_CreateSegment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should normalize tag rules", func(t *testing.T) {
+		svc, repo, _ := setupSegmentService()
+		repo.On("GetSegmentByName", ctx, "VIPs").Return(nil, segment.ErrSegmentNotFound)
+		repo.On("CreateSegment", ctx, mock.Anything).Return(nil)
+
+		seg := &segment.Segment{Name: " VIPs ", Rules: segment.Rules{{Field: segment.FieldTag, Op: segment.OpHas, Tag: " VIP "}}}
+		require.NoError(t, svc.CreateSegment(ctx, seg))
+		assert.Equal(t, "VIPs", seg.Name)
+		assert.Equal(t, "vip", seg.Rules[0].Tag)
+		assert.NotEqual(t, uuid.Nil, seg.ID)
+	})
+
+	t.Run("should reject duplicate names", func(t *testing.T) {
+		svc, repo, _ := setupSegmentService()
+		repo.On("GetSegmentByName", ctx, "vip").Return(&segment.Segment{ID: uuid.New(), Name: "vip"}, nil)
+
+		err := svc.CreateSegment(ctx, &segment.Segment{Name: "vip", Rules: segment.Rules{{Field: segment.FieldTag, Op: segment.OpHas, Tag: "vip"}}})
+		assert.ErrorIs(t, err, segment.ErrSegmentNameTaken)
+		repo.AssertNotCalled(t, "CreateSegment", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/freespins"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// SessionExpiryService force-ends game sessions on timeout or operator request
+// A session can only be ended by the player from the client, so sessions whose client
+// vanished mid-feature would otherwise hold unplayed free spins forever. Before ending
+// such a session, its incomplete free spins are paid out under the configured policy
+// and an audit record of the payout is kept.
+type SessionExpiryService struct {
+	sessions          session.Service
+	sessionRepo       session.Repository
+	freespinsRepo     freespins.Repository
+	freeSpins         freespins.Service
+	settlements       freespins.SettlementRepository
+	playerRepo        player.Repository
+	policy            freespins.SettlementPolicy
+	averageMultiplier float64
+	clock             clock.Clock
+	logger            *logger.Logger
+}
+
+// NewSessionExpiryService creates a new session expiry service
+func NewSessionExpiryService(
+	cfg *config.Config,
+	sessions session.Service,
+	sessionRepo session.Repository,
+	freespinsRepo freespins.Repository,
+	freeSpins freespins.Service,
+	settlements freespins.SettlementRepository,
+	playerRepo player.Repository,
+	clk clock.Clock,
+	log *logger.Logger,
+) *SessionExpiryService {
+	return &SessionExpiryService{
+		sessions:          sessions,
+		sessionRepo:       sessionRepo,
+		freespinsRepo:     freespinsRepo,
+		freeSpins:         freeSpins,
+		settlements:       settlements,
+		playerRepo:        playerRepo,
+		policy:            freespins.SettlementPolicy(cfg.SessionExpiry.SettlementPolicy),
+		averageMultiplier: cfg.SessionExpiry.AverageSpinMultiplier,
+		clock:             clk,
+		logger:            log,
+	}
+}
+
+// ForceEndSession settles the session's incomplete free spins and then ends it
+// The returned settlement is nil when the session had no free spins to settle.
+// A failed settlement leaves the session active so a later attempt can retry it.
+func (s *SessionExpiryService) ForceEndSession(ctx context.Context, sessionID uuid.UUID, reason session.EndReason) (*session.GameSession, *freespins.Settlement, error) {
+	log := s.logger.WithTraceContext(ctx)
+
+	sess, err := s.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, nil, session.ErrSessionNotFound
+	}
+	if sess.EndedAt != nil {
+		return nil, nil, session.ErrSessionAlreadyEnded
+	}
+
+	settlement, err := s.settleFreeSpins(ctx, sess, reason)
+	if err != nil {
+		log.Error().Err(err).Str("session_id", sessionID.String()).Msg("Failed to settle free spins for forced session end")
+		return nil, nil, err
+	}
+
+	ended, err := s.sessions.EndSession(ctx, sessionID)
+	if err != nil {
+		return nil, settlement, err
+	}
+
+	log.Info().
+		Str("session_id", sessionID.String()).
+		Str("player_id", sess.PlayerID.String()).
+		Str("reason", string(reason)).
+		Bool("settled_free_spins", settlement != nil).
+		Msg("Session force-ended")
+
+	return ended, settlement, nil
+}
+
+// ExpireIdleSessions force-ends up to limit sessions with no spin since idleSince and returns how many it ended
+func (s *SessionExpiryService) ExpireIdleSessions(ctx context.Context, idleSince time.Time, limit int) (int, error) {
+	sessions, err := s.sessionRepo.ListIdleSessions(ctx, idleSince, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	ended := 0
+	for _, sess := range sessions {
+		if _, _, err := s.ForceEndSession(ctx, sess.ID, session.EndReasonTimeout); err != nil {
+			// Ended by the player since it was listed
+			if errors.Is(err, session.ErrSessionAlreadyEnded) {
+				continue
+			}
+			s.logger.WithTraceContext(ctx).Error().Err(err).
+				Str("session_id", sess.ID.String()).
+				Msg("Failed to expire idle session")
+			continue
+		}
+		ended++
+	}
+	return ended, nil
+}
+
+// GetSettlements returns the free spins settlements made when the session was force-ended
+func (s *SessionExpiryService) GetSettlements(ctx context.Context, sessionID uuid.UUID) ([]*freespins.Settlement, error) {
+	return s.settlements.ListBySession(ctx, sessionID)
+}
+
+// settleFreeSpins pays out the player's incomplete free spins triggered in this session
+func (s *SessionExpiryService) settleFreeSpins(ctx context.Context, sess *session.GameSession, reason session.EndReason) (*freespins.Settlement, error) {
+	active, err := s.freespinsRepo.GetActiveByPlayer(ctx, sess.PlayerID)
+	if err != nil {
+		if errors.Is(err, freespins.ErrFreeSpinsNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get active free spins: %w", err)
+	}
+	// Free spins from another session are settled when that session ends
+	if active.SessionID != sess.ID {
+		return nil, nil
+	}
+
+	settlement := &freespins.Settlement{
+		ID:                 uuid.New(),
+		FreeSpinsSessionID: active.ID,
+		SessionID:          sess.ID,
+		PlayerID:           sess.PlayerID,
+		Reason:             reason,
+		Policy:             s.policy,
+		RemainingSpins:     active.RemainingSpins,
+	}
+
+	remaining := active.RemainingSpins
+	if s.policy == freespins.SettlementPolicyAutoplay {
+		played, won, left, note := s.autoplay(ctx, active)
+		settlement.SpinsPlayed = played
+		settlement.AmountCredited = roundCents(won)
+		remaining = left
+		if remaining > 0 {
+			// Pay what autoplay could not play at the average value rather than leave it unpaid
+			settlement.Policy = freespins.SettlementPolicyAverage
+			settlement.Note = note
+		}
+	}
+
+	// Also closes a feature autoplay found nothing to play in, so it cannot outlive its session
+	if remaining > 0 || settlement.SpinsPlayed == 0 {
+		amount, err := s.creditAverage(ctx, active.ID, sess, remaining)
+		if err != nil {
+			return nil, err
+		}
+		settlement.SpinsValued = remaining
+		settlement.AmountCredited = roundCents(settlement.AmountCredited + amount)
+	}
+
+	settlement.CreatedAt = s.clock.Now().UTC()
+	if err := s.settlements.Create(ctx, settlement); err != nil {
+		return nil, err
+	}
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("free_spins_session_id", active.ID.String()).
+		Str("session_id", sess.ID.String()).
+		Str("policy", string(settlement.Policy)).
+		Int("spins_played", settlement.SpinsPlayed).
+		Int("spins_valued", settlement.SpinsValued).
+		Float64("amount_credited", settlement.AmountCredited).
+		Msg("Free spins settled")
+
+	return settlement, nil
+}
+
+// autoplay plays the remaining spins server-side, in as many capped rounds as it takes
+// It returns the spins played, the amount they won, the spins still left and, when it
+// stopped early, why.
+func (s *SessionExpiryService) autoplay(ctx context.Context, fs *freespins.FreeSpinsSession) (played int, won float64, remaining int, note string) {
+	remaining = fs.RemainingSpins
+	totalWon := fs.TotalWon
+	for remaining > 0 {
+		result, err := s.freeSpins.ExecuteAllFreeSpins(ctx, fs.PlayerID, fs.ID, "")
+		if err != nil {
+			note = fmt.Sprintf("autoplay stopped after %d spins: %v", played, err)
+			break
+		}
+		played += result.SpinsPlayed
+		totalWon = result.TotalWon
+		remaining = result.RemainingSpins
+		if result.SpinsPlayed == 0 {
+			note = fmt.Sprintf("autoplay stopped after %d spins: no progress", played)
+			break
+		}
+	}
+	return played, totalWon - fs.TotalWon, remaining, note
+}
+
+// creditAverage pays the unplayed spins at the average value and completes the free spins session
+func (s *SessionExpiryService) creditAverage(ctx context.Context, freeSpinsID uuid.UUID, sess *session.GameSession, spins int) (float64, error) {
+	fs, err := s.freespinsRepo.GetByID(ctx, freeSpinsID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reload free spins session: %w", err)
+	}
+	amount := roundCents(float64(spins) * fs.LockedBetAmount * s.averageMultiplier)
+
+	// Close the feature first so no spin can be played against it after it is paid
+	if err := s.freespinsRepo.UpdateSpins(ctx, fs.ID, fs.SpinsCompleted+spins, 0); err != nil {
+		return 0, err
+	}
+	if err := s.freespinsRepo.CompleteSession(ctx, fs.ID); err != nil {
+		return 0, err
+	}
+	if amount <= 0 {
+		return 0, nil
+	}
+
+	if err := s.freespinsRepo.AddTotalWon(ctx, fs.ID, amount); err != nil {
+		return 0, err
+	}
+	if err := s.playerRepo.UpdateBalance(ctx, sess.PlayerID, amount); err != nil {
+		return 0, err
+	}
+	if err := s.sessionRepo.UpdateStatistics(ctx, sess.ID, 0, 0, amount); err != nil {
+		s.logger.WithTraceContext(ctx).Error().Err(err).Str("session_id", sess.ID.String()).Msg("Failed to update session statistics")
+	}
+	if err := s.playerRepo.UpdateStatistics(ctx, sess.PlayerID, 0, 0, amount); err != nil {
+		s.logger.WithTraceContext(ctx).Error().Err(err).Str("player_id", sess.PlayerID.String()).Msg("Failed to update player statistics")
+	}
+	return amount, nil
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/freespins"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubAutoplayService plays free spins from canned rounds, failing once they run out
+type stubAutoplayService struct {
+	freespins.Service
+	rounds []*freespins.AutoplayResult
+}
+
+func (s *stubAutoplayService) ExecuteAllFreeSpins(ctx context.Context, playerID, freeSpinsSessionID uuid.UUID, clientSeed string) (*freespins.AutoplayResult, error) {
+	if len(s.rounds) == 0 {
+		return nil, errors.New("no active PF session")
+	}
+	round := s.rounds[0]
+	s.rounds = s.rounds[1:]
+	return round, nil
+}
+
+// stubSettlementRepository keeps settlements in memory
+type stubSettlementRepository struct {
+	settlements []*freespins.Settlement
+}
+
+func (r *stubSettlementRepository) Create(ctx context.Context, settlement *freespins.Settlement) error {
+	r.settlements = append(r.settlements, settlement)
+	return nil
+}
+
+func (r *stubSettlementRepository) ListBySession(ctx context.Context, sessionID uuid.UUID) ([]*freespins.Settlement, error) {
+	var out []*freespins.Settlement
+	for _, s := range r.settlements {
+		if s.SessionID == sessionID {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+type sessionExpiryFixture struct {
+	service     *SessionExpiryService
+	sessionRepo *MockSessionRepository
+	playerRepo  *MockPlayerRepository
+	fsRepo      *MockFreeSpinsRepository
+	autoplay    *stubAutoplayService
+	settlements *stubSettlementRepository
+	sess        *session.GameSession
+}
+
+func newSessionExpiryFixture(policy freespins.SettlementPolicy) *sessionExpiryFixture {
+	log := logger.New("error", "json")
+	f := &sessionExpiryFixture{
+		sessionRepo: new(MockSessionRepository),
+		playerRepo:  new(MockPlayerRepository),
+		fsRepo:      new(MockFreeSpinsRepository),
+		autoplay:    &stubAutoplayService{},
+		settlements: &stubSettlementRepository{},
+		sess: &session.GameSession{
+			ID:        uuid.New(),
+			PlayerID:  uuid.New(),
+			BetAmount: 2.0,
+			CreatedAt: time.Now().UTC().Add(-2 * time.Hour),
+		},
+	}
+	cfg := &config.Config{SessionExpiry: config.SessionExpiryConfig{
+		SettlementPolicy:      string(policy),
+		AverageSpinMultiplier: 1.5,
+	}}
+	sessions := NewSessionService(f.sessionRepo, f.playerRepo, log)
+	f.service = NewSessionExpiryService(cfg, sessions, f.sessionRepo, f.fsRepo, f.autoplay, f.settlements, f.playerRepo, clock.New(), log)
+
+	// Ending the session itself
+	f.sessionRepo.On("GetByID", mock.Anything, f.sess.ID).Return(f.sess, nil)
+	f.playerRepo.On("GetByID", mock.Anything, f.sess.PlayerID).Return(&player.Player{ID: f.sess.PlayerID, Balance: 100.0}, nil)
+	f.sessionRepo.On("EndSession", mock.Anything, f.sess.ID, 100.0).Return(nil)
+	return f
+}
+
+func (f *sessionExpiryFixture) activeFreeSpins(remaining int) *freespins.FreeSpinsSession {
+	fs := &freespins.FreeSpinsSession{
+		ID:                uuid.New(),
+		PlayerID:          f.sess.PlayerID,
+		SessionID:         f.sess.ID,
+		TotalSpinsAwarded: 10,
+		SpinsCompleted:    10 - remaining,
+		RemainingSpins:    remaining,
+		LockedBetAmount:   2.0,
+		TotalWon:          1.0,
+		IsActive:          true,
+	}
+	f.fsRepo.On("GetActiveByPlayer", mock.Anything, f.sess.PlayerID).Return(fs, nil)
+	return fs
+}
+
+// expectAverageCredit expects spins to be paid at the average value and the feature closed
+func (f *sessionExpiryFixture) expectAverageCredit(fs *freespins.FreeSpinsSession, completed, spins int, amount float64) {
+	reloaded := *fs
+	reloaded.SpinsCompleted = completed
+	f.fsRepo.On("GetByID", mock.Anything, fs.ID).Return(&reloaded, nil)
+	f.fsRepo.On("UpdateSpins", mock.Anything, fs.ID, completed+spins, 0).Return(nil)
+	f.fsRepo.On("CompleteSession", mock.Anything, fs.ID).Return(nil)
+	f.fsRepo.On("AddTotalWon", mock.Anything, fs.ID, amount).Return(nil)
+	f.playerRepo.On("UpdateBalance", mock.Anything, f.sess.PlayerID, amount).Return(nil)
+	f.sessionRepo.On("UpdateStatistics", mock.Anything, f.sess.ID, 0, 0.0, amount).Return(nil)
+	f.playerRepo.On("UpdateStatistics", mock.Anything, f.sess.PlayerID, 0, 0.0, amount).Return(nil)
+}
+
+func TestSessionExpiryService_ForceEndSession(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("average policy credits remaining spins at the average value", func(t *testing.T) {
+		f := newSessionExpiryFixture(freespins.SettlementPolicyAverage)
+		fs := f.activeFreeSpins(4)
+		f.expectAverageCredit(fs, 6, 4, 12.0)
+
+		ended, settlement, err := f.service.ForceEndSession(ctx, f.sess.ID, session.EndReasonAdmin)
+		require.NoError(t, err)
+		require.NotNil(t, ended)
+		require.NotNil(t, settlement)
+
+		assert.Equal(t, freespins.SettlementPolicyAverage, settlement.Policy)
+		assert.Equal(t, session.EndReasonAdmin, settlement.Reason)
+		assert.Equal(t, 4, settlement.RemainingSpins)
+		assert.Equal(t, 4, settlement.SpinsValued)
+		assert.Equal(t, 0, settlement.SpinsPlayed)
+		assert.Equal(t, 12.0, settlement.AmountCredited)
+		assert.Len(t, f.settlements.settlements, 1)
+		f.fsRepo.AssertExpectations(t)
+		f.playerRepo.AssertExpectations(t)
+		f.sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("autoplay policy plays every remaining spin", func(t *testing.T) {
+		f := newSessionExpiryFixture(freespins.SettlementPolicyAutoplay)
+		fs := f.activeFreeSpins(4)
+		f.autoplay.rounds = []*freespins.AutoplayResult{{FreeSpinsSessionID: fs.ID, SpinsPlayed: 4, TotalWon: 6.5}}
+
+		_, settlement, err := f.service.ForceEndSession(ctx, f.sess.ID, session.EndReasonTimeout)
+		require.NoError(t, err)
+		require.NotNil(t, settlement)
+
+		assert.Equal(t, freespins.SettlementPolicyAutoplay, settlement.Policy)
+		assert.Equal(t, 4, settlement.SpinsPlayed)
+		assert.Equal(t, 0, settlement.SpinsValued)
+		assert.Equal(t, 5.5, settlement.AmountCredited, "only wins from the settlement are counted")
+		assert.Empty(t, settlement.Note)
+		f.fsRepo.AssertNotCalled(t, "CompleteSession", mock.Anything, mock.Anything)
+		f.playerRepo.AssertNotCalled(t, "UpdateBalance", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("autoplay falls back to the average value when it stops early", func(t *testing.T) {
+		f := newSessionExpiryFixture(freespins.SettlementPolicyAutoplay)
+		fs := f.activeFreeSpins(4)
+		f.autoplay.rounds = []*freespins.AutoplayResult{{FreeSpinsSessionID: fs.ID, SpinsPlayed: 2, TotalWon: 4.0, RemainingSpins: 2}}
+		f.expectAverageCredit(fs, 8, 2, 6.0)
+
+		_, settlement, err := f.service.ForceEndSession(ctx, f.sess.ID, session.EndReasonTimeout)
+		require.NoError(t, err)
+		require.NotNil(t, settlement)
+
+		assert.Equal(t, freespins.SettlementPolicyAverage, settlement.Policy)
+		assert.Equal(t, 2, settlement.SpinsPlayed)
+		assert.Equal(t, 2, settlement.SpinsValued)
+		assert.Equal(t, 9.0, settlement.AmountCredited)
+		assert.Contains(t, settlement.Note, "autoplay stopped after 2 spins")
+		f.fsRepo.AssertExpectations(t)
+	})
+
+	t.Run("free spins from another session are left alone", func(t *testing.T) {
+		f := newSessionExpiryFixture(freespins.SettlementPolicyAverage)
+		f.fsRepo.On("GetActiveByPlayer", mock.Anything, f.sess.PlayerID).
+			Return(&freespins.FreeSpinsSession{ID: uuid.New(), PlayerID: f.sess.PlayerID, SessionID: uuid.New(), RemainingSpins: 3}, nil)
+
+		_, settlement, err := f.service.ForceEndSession(ctx, f.sess.ID, session.EndReasonAdmin)
+		require.NoError(t, err)
+		assert.Nil(t, settlement)
+		assert.Empty(t, f.settlements.settlements)
+	})
+
+	t.Run("session without free spins is just ended", func(t *testing.T) {
+		f := newSessionExpiryFixture(freespins.SettlementPolicyAutoplay)
+		f.fsRepo.On("GetActiveByPlayer", mock.Anything, f.sess.PlayerID).Return(nil, freespins.ErrFreeSpinsNotFound)
+
+		ended, settlement, err := f.service.ForceEndSession(ctx, f.sess.ID, session.EndReasonTimeout)
+		require.NoError(t, err)
+		assert.NotNil(t, ended)
+		assert.Nil(t, settlement)
+		f.sessionRepo.AssertCalled(t, "EndSession", mock.Anything, f.sess.ID, 100.0)
+	})
+
+	t.Run("ended session is rejected", func(t *testing.T) {
+		f := newSessionExpiryFixture(freespins.SettlementPolicyAutoplay)
+		now := time.Now().UTC()
+		f.sess.EndedAt = &now
+
+		_, _, err := f.service.ForceEndSession(ctx, f.sess.ID, session.EndReasonAdmin)
+		assert.ErrorIs(t, err, session.ErrSessionAlreadyEnded)
+		f.fsRepo.AssertNotCalled(t, "GetActiveByPlayer", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSessionExpiryService_ExpireIdleSessions(t *testing.T) {
+	ctx := context.Background()
+	f := newSessionExpiryFixture(freespins.SettlementPolicyAverage)
+	f.fsRepo.On("GetActiveByPlayer", mock.Anything, f.sess.PlayerID).Return(nil, freespins.ErrFreeSpinsNotFound)
+
+	missing := &session.GameSession{ID: uuid.New(), PlayerID: uuid.New()}
+	f.sessionRepo.On("GetByID", mock.Anything, missing.ID).Return(nil, session.ErrSessionNotFound)
+
+	idleSince := time.Now().UTC().Add(-time.Hour)
+	f.sessionRepo.On("ListIdleSessions", mock.Anything, idleSince, 10).Return([]*session.GameSession{f.sess, missing}, nil)
+
+	ended, err := f.service.ExpireIdleSessions(ctx, idleSince, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, ended)
+}
+
+func TestSessionExpirySweeper_SweepsOnFakeClock(t *testing.T) {
+	f := newSessionExpiryFixture(freespins.SettlementPolicyAverage)
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(start)
+	cfg := &config.Config{SessionExpiry: config.SessionExpiryConfig{SweepIntervalSeconds: 60, IdleMinutes: 30}}
+
+	// The sweep after one interval looks for sessions idle since 30 minutes before it
+	swept := make(chan struct{}, 1)
+	idleSince := start.Add(time.Minute).Add(-30 * time.Minute)
+	f.sessionRepo.On("ListIdleSessions", mock.Anything, idleSince, sessionExpiryBatchSize).
+		Return([]*session.GameSession{}, nil).
+		Run(func(mock.Arguments) { swept <- struct{}{} })
+
+	sweeper := NewSessionExpirySweeper(cfg, f.service, clk, logger.New("error", "json"))
+	sweeper.Start()
+	defer sweeper.Stop()
+
+	clk.BlockUntil(1)
+	clk.Advance(59 * time.Second)
+	select {
+	case <-swept:
+		t.Fatal("swept before the interval elapsed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	clk.Advance(time.Second)
+	select {
+	case <-swept:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no sweep after the interval elapsed")
+	}
+}
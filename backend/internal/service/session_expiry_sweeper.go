@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// sessionExpiryBatchSize bounds how many idle sessions one batch ends
+const sessionExpiryBatchSize = 100
+
+// SessionExpirySweeper periodically force-ends idle game sessions
+// It runs ahead of the PF session sweeper, so free spins left unplayed in an idle
+// session can still be played on its provably fair session before that is ended.
+type SessionExpirySweeper struct {
+	expiry      *SessionExpiryService
+	interval    time.Duration
+	idleTimeout time.Duration
+	clock       clock.Clock
+	logger      *logger.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewSessionExpirySweeper creates a new Session expiry sweeper
+func NewSessionExpirySweeper(cfg *config.Config, expiry *SessionExpiryService, clk clock.Clock, log *logger.Logger) *SessionExpirySweeper {
+	return &SessionExpirySweeper{
+		expiry:      expiry,
+		interval:    time.Duration(cfg.SessionExpiry.SweepIntervalSeconds) * time.Second,
+		idleTimeout: time.Duration(cfg.SessionExpiry.IdleMinutes) * time.Minute,
+		clock:       clk,
+		logger:      log,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// Start runs the sweeper in the background; a zero interval disables it
+func (s *SessionExpirySweeper) Start() {
+	if s.interval <= 0 {
+		close(s.done)
+		s.logger.Info().Msg("Session expiry sweeper disabled")
+		return
+	}
+
+	go s.run()
+	s.logger.Info().
+		Dur("interval", s.interval).
+		Dur("idle_timeout", s.idleTimeout).
+		Msg("Session expiry sweeper started")
+}
+
+// Stop stops the sweeper and waits for a running sweep to finish
+func (s *SessionExpirySweeper) Stop() {
+	s.stopOnce.Do(func() { close(s.stop) })
+	<-s.done
+}
+
+func (s *SessionExpirySweeper) run() {
+	defer close(s.done)
+
+	ticker := s.clock.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C():
+			s.Sweep(context.Background())
+		}
+	}
+}
+
+// Sweep ends every idle session, one batch at a time, and returns how many it ended
+func (s *SessionExpirySweeper) Sweep(ctx context.Context) int {
+	idleSince := s.clock.Now().UTC().Add(-s.idleTimeout)
+
+	total := 0
+	for {
+		ended, err := s.expiry.ExpireIdleSessions(ctx, idleSince, sessionExpiryBatchSize)
+		if err != nil {
+			s.logger.Error().Err(err).Msg("Failed to sweep idle game sessions")
+			break
+		}
+		total += ended
+		// A short or fully failed batch means nothing is left that this sweep can end
+		if ended < sessionExpiryBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		s.logger.Info().Int("ended", total).Msg("Ended idle game sessions")
+	}
+	return total
+}
@@ -3,19 +3,24 @@ package service
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/jurisdiction"
 	"github.com/slotmachine/backend/domain/player"
 	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/pkg/clock"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
 // SessionService implements the session.Service interface
 type SessionService struct {
-	sessionRepo session.Repository
-	playerRepo  player.Repository
-	logger      *logger.Logger
+	sessionRepo   session.Repository
+	playerRepo    player.Repository
+	jurisdictions jurisdiction.Resolver // Optional: nil disables jurisdiction limits
+	txManager     *repository.TxManager // Optional: nil runs takeovers without a transaction
+	clock         clock.Clock           // Timestamps sessions and reality check acknowledgements
+	logger        *logger.Logger
 }
 
 // NewSessionService creates a new session service
@@ -27,14 +32,110 @@ func NewSessionService(
 	return &SessionService{
 		sessionRepo: sessionRepo,
 		playerRepo:  playerRepo,
+		clock:       clock.New(),
 		logger:      log,
 	}
 }
 
+// SetJurisdictionResolver enables jurisdiction limits on session start
+func (s *SessionService) SetJurisdictionResolver(resolver jurisdiction.Resolver) {
+	s.jurisdictions = resolver
+}
+
+// SetTxManager makes session takeovers transactional
+func (s *SessionService) SetTxManager(txManager *repository.TxManager) {
+	s.txManager = txManager
+}
+
+// SetClock replaces the clock sessions and reality check acknowledgements are timestamped with
+func (s *SessionService) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
 // StartSession creates a new game session
 func (s *SessionService) StartSession(ctx context.Context, playerID uuid.UUID, betAmount float64) (*session.GameSession, error) {
 	log := s.logger.WithTraceContext(ctx)
 
+	newSession, err := s.prepareSession(ctx, playerID, betAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if there's already an active session
+	existingSession, _ := s.sessionRepo.GetActiveSessionByPlayer(ctx, playerID)
+	if existingSession != nil {
+		log.Warn().
+			Str("player_id", playerID.String()).
+			Str("existing_session_id", existingSession.ID.String()).
+			Msg("Player already has an active session")
+		return nil, session.ErrActiveSessionExists
+	}
+
+	// Save to database
+	if err := s.sessionRepo.Create(ctx, newSession); err != nil {
+		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to create session")
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	log.Info().
+		Str("session_id", newSession.ID.String()).
+		Str("player_id", playerID.String()).
+		Float64("bet_amount", betAmount).
+		Msg("Session started successfully")
+
+	return newSession, nil
+}
+
+// TakeoverSession ends the player's active session, if any, and starts a new one atomically
+func (s *SessionService) TakeoverSession(ctx context.Context, playerID uuid.UUID, betAmount float64) (*session.GameSession, *session.GameSession, error) {
+	log := s.logger.WithTraceContext(ctx)
+
+	// Validate before touching the previous session so a rejected takeover leaves it running
+	newSession, err := s.prepareSession(ctx, playerID, betAmount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var previous *session.GameSession
+	err = s.withTransaction(ctx, func(txCtx context.Context) error {
+		existing, _ := s.sessionRepo.GetActiveSessionByPlayer(txCtx, playerID)
+		if existing != nil {
+			if err := s.sessionRepo.EndSession(txCtx, existing.ID, newSession.StartingBalance); err != nil {
+				return fmt.Errorf("failed to end previous session: %w", err)
+			}
+			ended, err := s.sessionRepo.GetByID(txCtx, existing.ID)
+			if err != nil {
+				return fmt.Errorf("failed to reload previous session: %w", err)
+			}
+			previous = ended
+		}
+
+		if err := s.sessionRepo.Create(txCtx, newSession); err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to take over session")
+		return nil, nil, err
+	}
+
+	event := log.Info().
+		Str("session_id", newSession.ID.String()).
+		Str("player_id", playerID.String()).
+		Float64("bet_amount", betAmount)
+	if previous != nil {
+		event = event.Str("previous_session_id", previous.ID.String())
+	}
+	event.Msg("Session taken over successfully")
+
+	return newSession, previous, nil
+}
+
+// prepareSession validates a session start and builds the unsaved session
+func (s *SessionService) prepareSession(ctx context.Context, playerID uuid.UUID, betAmount float64) (*session.GameSession, error) {
+	log := s.logger.WithTraceContext(ctx)
+
 	// Validate bet amount
 	if betAmount <= 0 {
 		return nil, fmt.Errorf("bet amount must be positive")
@@ -52,18 +153,20 @@ func (s *SessionService) StartSession(ctx context.Context, playerID uuid.UUID, b
 		return nil, fmt.Errorf("player account is not active")
 	}
 
-	// Check if there's already an active session
-	existingSession, _ := s.sessionRepo.GetActiveSessionByPlayer(ctx, playerID)
-	if existingSession != nil {
-		log.Warn().
-			Str("player_id", playerID.String()).
-			Str("existing_session_id", existingSession.ID.String()).
-			Msg("Player already has an active session")
-		return nil, session.ErrActiveSessionExists
+	// Reject session bets above the player's jurisdiction limit
+	if s.jurisdictions != nil {
+		j, err := s.jurisdictions.ForPlayer(ctx, p.JurisdictionCode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve jurisdiction: %w", err)
+		}
+		if j != nil {
+			if err := j.CheckStake(betAmount); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	// Create new session
-	newSession := &session.GameSession{
+	return &session.GameSession{
 		ID:              uuid.New(),
 		PlayerID:        playerID,
 		BetAmount:       betAmount,
@@ -73,23 +176,17 @@ func (s *SessionService) StartSession(ctx context.Context, playerID uuid.UUID, b
 		TotalWagered:    0.0,
 		TotalWon:        0.0,
 		NetChange:       0.0,
-		CreatedAt:       time.Now().UTC(),
+		CreatedAt:       s.clock.Now().UTC(),
 		EndedAt:         nil,
-	}
+	}, nil
+}
 
-	// Save to database
-	if err := s.sessionRepo.Create(ctx, newSession); err != nil {
-		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to create session")
-		return nil, fmt.Errorf("failed to create session: %w", err)
+// withTransaction runs fn in a transaction when a TxManager is configured
+func (s *SessionService) withTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.txManager == nil {
+		return fn(ctx)
 	}
-
-	log.Info().
-		Str("session_id", newSession.ID.String()).
-		Str("player_id", playerID.String()).
-		Float64("bet_amount", betAmount).
-		Msg("Session started successfully")
-
-	return newSession, nil
+	return s.txManager.WithTransaction(ctx, fn)
 }
 
 // EndSession ends the current game session
@@ -153,6 +250,30 @@ func (s *SessionService) GetSession(ctx context.Context, sessionID uuid.UUID) (*
 	return sess, nil
 }
 
+// AcknowledgeRealityCheck records a reality check acknowledgement, restarting the interval
+func (s *SessionService) AcknowledgeRealityCheck(ctx context.Context, playerID, sessionID uuid.UUID) (*session.GameSession, error) {
+	sess, err := s.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil || sess.PlayerID != playerID {
+		return nil, session.ErrSessionNotFound
+	}
+	if sess.EndedAt != nil {
+		return nil, session.ErrSessionAlreadyEnded
+	}
+
+	now := s.clock.Now().UTC()
+	if err := s.sessionRepo.MarkRealityCheck(ctx, sessionID, now); err != nil {
+		return nil, err
+	}
+	sess.RealityCheckAt = &now
+
+	s.logger.WithTraceContext(ctx).Info().
+		Str("session_id", sessionID.String()).
+		Str("player_id", playerID.String()).
+		Msg("Reality check acknowledged")
+
+	return sess, nil
+}
+
 // GetPlayerSessions retrieves all sessions for a player
 func (s *SessionService) GetPlayerSessions(ctx context.Context, playerID uuid.UUID, page, limit int) ([]*session.GameSession, error) {
 	log := s.logger.WithTraceContext(ctx)
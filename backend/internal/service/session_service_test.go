@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/jurisdiction"
 	"github.com/slotmachine/backend/domain/player"
 	"github.com/slotmachine/backend/domain/session"
 	"github.com/slotmachine/backend/internal/pkg/logger"
@@ -60,6 +61,21 @@ func (m *MockSessionRepository) UpdateStatistics(ctx context.Context, id uuid.UU
 	return args.Error(0)
 }
 
+func (m *MockSessionRepository) RecordBetChange(ctx context.Context, change *session.BetChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) MarkRealityCheck(ctx context.Context, id uuid.UUID, at time.Time) error {
+	args := m.Called(ctx, id, at)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) MarkSpinAcked(ctx context.Context, id uuid.UUID, spinCreatedAt time.Time) error {
+	args := m.Called(ctx, id, spinCreatedAt)
+	return args.Error(0)
+}
+
 func (m *MockSessionRepository) GetByPlayer(ctx context.Context, playerID uuid.UUID, limit, offset int) ([]*session.GameSession, error) {
 	args := m.Called(ctx, playerID, limit, offset)
 	if args.Get(0) == nil {
@@ -68,6 +84,14 @@ func (m *MockSessionRepository) GetByPlayer(ctx context.Context, playerID uuid.U
 	return args.Get(0).([]*session.GameSession), args.Error(1)
 }
 
+func (m *MockSessionRepository) ListIdleSessions(ctx context.Context, idleSince time.Time, limit int) ([]*session.GameSession, error) {
+	args := m.Called(ctx, idleSince, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*session.GameSession), args.Error(1)
+}
+
 // ============================================================================
 // HELPER FUNCTIONS
 // ============================================================================
@@ -264,6 +288,71 @@ func TestStartSession(t *testing.T) {
 	})
 }
 
+// ============================================================================
+// TakeoverSession TESTS
+// ============================================================================
+
+func TestTakeoverSession(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should end the active session and start a new one", func(t *testing.T) {
+		service, mockSessionRepo, mockPlayerRepo := setupSessionService()
+
+		playerID := uuid.New()
+		existing := &session.GameSession{ID: uuid.New(), PlayerID: playerID, StartingBalance: 1000}
+		endingBalance := 900.0
+		endedAt := time.Now().UTC()
+		ended := &session.GameSession{ID: existing.ID, PlayerID: playerID, StartingBalance: 1000, EndingBalance: &endingBalance, EndedAt: &endedAt}
+
+		mockPlayerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, Balance: 900, IsActive: true}, nil)
+		mockSessionRepo.On("GetActiveSessionByPlayer", ctx, playerID).Return(existing, nil)
+		mockSessionRepo.On("EndSession", ctx, existing.ID, 900.0).Return(nil)
+		mockSessionRepo.On("GetByID", ctx, existing.ID).Return(ended, nil)
+		mockSessionRepo.On("Create", ctx, mock.AnythingOfType("*session.GameSession")).Return(nil)
+
+		sess, previous, err := service.TakeoverSession(ctx, playerID, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, playerID, sess.PlayerID)
+		assert.Equal(t, 900.0, sess.StartingBalance)
+		require.NotNil(t, previous)
+		assert.Equal(t, existing.ID, previous.ID)
+		assert.NotNil(t, previous.EndedAt)
+		mockSessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("should start a new session when none is active", func(t *testing.T) {
+		service, mockSessionRepo, mockPlayerRepo := setupSessionService()
+
+		playerID := uuid.New()
+		mockPlayerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, Balance: 500, IsActive: true}, nil)
+		mockSessionRepo.On("GetActiveSessionByPlayer", ctx, playerID).Return(nil, session.ErrSessionNotFound)
+		mockSessionRepo.On("Create", ctx, mock.AnythingOfType("*session.GameSession")).Return(nil)
+
+		sess, previous, err := service.TakeoverSession(ctx, playerID, 10)
+
+		require.NoError(t, err)
+		assert.NotNil(t, sess)
+		assert.Nil(t, previous)
+		mockSessionRepo.AssertNotCalled(t, "EndSession", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should leave the active session running when validation fails", func(t *testing.T) {
+		service, mockSessionRepo, mockPlayerRepo := setupSessionService()
+
+		playerID := uuid.New()
+		mockPlayerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, Balance: 500, IsActive: false}, nil)
+
+		sess, previous, err := service.TakeoverSession(ctx, playerID, 10)
+
+		assert.Error(t, err)
+		assert.Nil(t, sess)
+		assert.Nil(t, previous)
+		mockSessionRepo.AssertNotCalled(t, "GetActiveSessionByPlayer", mock.Anything, mock.Anything)
+		mockSessionRepo.AssertNotCalled(t, "EndSession", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 // ============================================================================
 // EndSession TESTS
 // ============================================================================
@@ -623,3 +712,55 @@ func TestGetPlayerSessions(t *testing.T) {
 		mockSessionRepo.AssertExpectations(t)
 	})
 }
+
+// ============================================================================
+// Jurisdiction TESTS
+// ============================================================================
+
+func TestStartSession_JurisdictionLimit(t *testing.T) {
+	ctx := context.Background()
+
+	service, mockSessionRepo, mockPlayerRepo := setupSessionService()
+	service.SetJurisdictionResolver(stubJurisdictionResolver{j: &jurisdiction.Jurisdiction{Code: "UKGC", MaxBet: 5}})
+
+	playerID := uuid.New()
+	mockPlayerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, Balance: 1000, IsActive: true}, nil)
+
+	sess, err := service.StartSession(ctx, playerID, 10)
+
+	assert.ErrorIs(t, err, jurisdiction.ErrBetAboveLimit)
+	assert.Nil(t, sess)
+	mockSessionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestAcknowledgeRealityCheck(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should restart the reality check interval", func(t *testing.T) {
+		service, mockSessionRepo, _ := setupSessionService()
+
+		playerID := uuid.New()
+		sess := &session.GameSession{ID: uuid.New(), PlayerID: playerID, CreatedAt: time.Now().Add(-2 * time.Hour)}
+		mockSessionRepo.On("GetByID", ctx, sess.ID).Return(sess, nil)
+		mockSessionRepo.On("MarkRealityCheck", ctx, sess.ID, mock.AnythingOfType("time.Time")).Return(nil)
+
+		result, err := service.AcknowledgeRealityCheck(ctx, playerID, sess.ID)
+
+		require.NoError(t, err)
+		require.NotNil(t, result.RealityCheckAt)
+		assert.WithinDuration(t, time.Now(), result.RealityCheckSince(), time.Second)
+		mockSessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("should not acknowledge another player's session", func(t *testing.T) {
+		service, mockSessionRepo, _ := setupSessionService()
+
+		sess := &session.GameSession{ID: uuid.New(), PlayerID: uuid.New()}
+		mockSessionRepo.On("GetByID", ctx, sess.ID).Return(sess, nil)
+
+		_, err := service.AcknowledgeRealityCheck(ctx, uuid.New(), sess.ID)
+
+		assert.ErrorIs(t, err, session.ErrSessionNotFound)
+		mockSessionRepo.AssertNotCalled(t, "MarkRealityCheck", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
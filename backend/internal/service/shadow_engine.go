@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/certification"
+	"github.com/slotmachine/backend/domain/shadow"
+	"github.com/slotmachine/backend/internal/game/cascade"
+	"github.com/slotmachine/backend/internal/game/engine"
+	"github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+const (
+	// shadowMaxInFlight bounds concurrent replays; sampled spins beyond it are skipped
+	shadowMaxInFlight = 8
+	// shadowReplayTimeout bounds one replay, including the candidate's reel strip lookups
+	shadowReplayTimeout = 10 * time.Second
+	// shadowAlertTimeout bounds each divergence notification
+	shadowAlertTimeout = 10 * time.Second
+)
+
+// ShadowEngine replays a sample of spins through a candidate engine and reports any difference
+// The candidate is fed the RNG draws the production engine consumed, so for the same math both
+// produce the same outcome. Replays run in the background after the spin settles; their results
+// are never returned to players.
+type ShadowEngine struct {
+	candidate  engine.Candidate
+	sampleRate float64
+	notifier   shadow.AlertNotifier // Optional: nil only logs divergences
+	logger     *logger.Logger
+
+	inFlight chan struct{}
+	wg       sync.WaitGroup
+	sample   func() float64 // Overridable for testing
+	now      func() time.Time
+}
+
+// NewShadowEngine creates a shadow engine comparison replaying sampleRate of spins through candidate
+func NewShadowEngine(candidate engine.Candidate, sampleRate float64, notifier shadow.AlertNotifier, log *logger.Logger) *ShadowEngine {
+	return &ShadowEngine{
+		candidate:  candidate,
+		sampleRate: sampleRate,
+		notifier:   notifier,
+		logger:     log,
+		inFlight:   make(chan struct{}, shadowMaxInFlight),
+		sample:     rand.Float64,
+		now:        time.Now,
+	}
+}
+
+// Sample reports whether the next spin should be replayed; always false on a nil ShadowEngine
+func (s *ShadowEngine) Sample() bool {
+	return s != nil && s.sample() < s.sampleRate
+}
+
+// CompareBaseSpin replays a settled base game spin from its recorded draws
+func (s *ShadowEngine) CompareBaseSpin(ctx context.Context, playerID uuid.UUID, betAmount float64, gameMode string, draws []certification.RNGDraw, production *engine.SpinResult) {
+	s.compare(ctx, draws, shadowSpin{
+		spinID:   production.SpinID,
+		playerID: playerID,
+		outcome:  baseSpinOutcome(production, len(draws)),
+		cascades: production.Cascades,
+	}, func(ctx context.Context, replay rng.RNG) (*shadowSpin, error) {
+		result, err := s.candidate.ExecuteBaseSpinWithRNG(ctx, playerID, betAmount, gameMode, replay)
+		if err != nil {
+			return nil, err
+		}
+		return &shadowSpin{outcome: baseSpinOutcome(result, 0), cascades: result.Cascades}, nil
+	})
+}
+
+// CompareFreeSpin replays a settled free spin from its recorded draws
+// session must be the engine session the production spin was played with and no longer change.
+func (s *ShadowEngine) CompareFreeSpin(ctx context.Context, playerID uuid.UUID, session *freespins.Session, spinNumber int, draws []certification.RNGDraw, production *engine.FreeSpinResult) {
+	s.compare(ctx, draws, shadowSpin{
+		spinID:     production.SpinID,
+		playerID:   playerID,
+		isFreeSpin: true,
+		outcome:    freeSpinOutcome(production, len(draws)),
+		cascades:   production.Cascades,
+	}, func(ctx context.Context, replay rng.RNG) (*shadowSpin, error) {
+		result, err := s.candidate.ExecuteFreeSpinWithRNG(ctx, playerID, session, spinNumber, replay)
+		if err != nil {
+			return nil, err
+		}
+		return &shadowSpin{outcome: freeSpinOutcome(result, 0), cascades: result.Cascades}, nil
+	})
+}
+
+// shadowSpin is one engine's result for a spin, as compared in shadow mode
+type shadowSpin struct {
+	spinID     uuid.UUID
+	playerID   uuid.UUID
+	isFreeSpin bool
+	outcome    shadow.Outcome
+	cascades   []cascade.CascadeResult
+}
+
+// compare runs the replay in the background, unless too many are already running
+func (s *ShadowEngine) compare(ctx context.Context, draws []certification.RNGDraw, production shadowSpin, replay func(context.Context, rng.RNG) (*shadowSpin, error)) {
+	select {
+	case s.inFlight <- struct{}{}:
+	default:
+		s.logger.Debug().Str("spin_id", production.spinID.String()).Msg("Shadow engine busy, spin not replayed")
+		return
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer func() { <-s.inFlight }()
+
+		replayCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shadowReplayTimeout)
+		defer cancel()
+
+		replayRNG := rng.NewReplayRNG(draws)
+		candidate, err := replay(replayCtx, replayRNG)
+		divergence := &shadow.Divergence{
+			SpinID:     production.spinID,
+			PlayerID:   production.playerID,
+			IsFreeSpin: production.isFreeSpin,
+			Production: production.outcome,
+			DetectedAt: s.now().UTC(),
+		}
+		if err != nil {
+			divergence.Error = err.Error()
+		} else {
+			candidate.outcome.RNGDraws = len(draws) - replayRNG.Remaining()
+			divergence.Shadow = &candidate.outcome
+			divergence.Fields = diffShadowSpins(&production, candidate)
+			if len(divergence.Fields) == 0 {
+				s.logger.Debug().Str("spin_id", production.spinID.String()).Msg("Shadow engine matched production")
+				return
+			}
+		}
+		s.report(divergence)
+	}()
+}
+
+// diffShadowSpins lists the outcome fields that differ between production and the candidate
+func diffShadowSpins(production, candidate *shadowSpin) []string {
+	var fields []string
+	p, c := production.outcome, candidate.outcome
+	if !slices.Equal(p.ReelPositions, c.ReelPositions) {
+		fields = append(fields, "reel_positions")
+	}
+	if !reflect.DeepEqual(p.Grid, c.Grid) {
+		fields = append(fields, "grid")
+	}
+	if !reflect.DeepEqual(production.cascades, candidate.cascades) {
+		fields = append(fields, "cascades")
+	}
+	if p.TotalWin != c.TotalWin {
+		fields = append(fields, "total_win")
+	}
+	if p.ScatterCount != c.ScatterCount {
+		fields = append(fields, "scatter_count")
+	}
+	if p.FreeSpinsAwarded != c.FreeSpinsAwarded {
+		fields = append(fields, "free_spins_awarded")
+	}
+	if p.RNGDraws != c.RNGDraws {
+		fields = append(fields, "rng_draws")
+	}
+	return fields
+}
+
+// report logs a divergence and notifies operations
+func (s *ShadowEngine) report(divergence *shadow.Divergence) {
+	s.logger.Warn().
+		Str("spin_id", divergence.SpinID.String()).
+		Str("player_id", divergence.PlayerID.String()).
+		Bool("is_free_spin", divergence.IsFreeSpin).
+		Strs("fields", divergence.Fields).
+		Str("error", divergence.Error).
+		Msg("Shadow engine diverged from production")
+
+	if s.notifier == nil {
+		return
+	}
+	notifyCtx, cancel := context.WithTimeout(context.Background(), shadowAlertTimeout)
+	defer cancel()
+	if err := s.notifier.NotifyDivergence(notifyCtx, divergence); err != nil {
+		s.logger.Warn().Err(err).Str("notifier", s.notifier.Name()).Msg("Failed to send shadow engine divergence alert")
+	}
+}
+
+// wait blocks until every started replay has finished
+func (s *ShadowEngine) wait() {
+	s.wg.Wait()
+}
+
+func baseSpinOutcome(result *engine.SpinResult, draws int) shadow.Outcome {
+	return shadow.Outcome{
+		ReelPositions:    result.ReelPositions,
+		Grid:             result.Grid,
+		CascadeCount:     len(result.Cascades),
+		TotalWin:         result.TotalWin,
+		ScatterCount:     result.ScatterCount,
+		FreeSpinsAwarded: result.FreeSpinsAwarded,
+		RNGDraws:         draws,
+	}
+}
+
+func freeSpinOutcome(result *engine.FreeSpinResult, draws int) shadow.Outcome {
+	return shadow.Outcome{
+		ReelPositions:    result.ReelPositions,
+		Grid:             result.Grid,
+		CascadeCount:     len(result.Cascades),
+		TotalWin:         result.TotalWin,
+		ScatterCount:     result.ScatterCount,
+		FreeSpinsAwarded: result.AdditionalSpins,
+		RNGDraws:         draws,
+	}
+}
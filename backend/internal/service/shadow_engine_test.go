@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/shadow"
+	"github.com/slotmachine/backend/internal/game/engine"
+	"github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reelEngine is a minimal candidate: one draw per reel, winning the sum of the positions
+type reelEngine struct {
+	bonus      float64 // Added to every win, to simulate a math change
+	extraDraws int     // Drawn after the reels, to simulate a change in RNG consumption
+}
+
+func (e *reelEngine) play(customRNG rng.RNG) ([]int, float64, error) {
+	positions := make([]int, 5)
+	win := e.bonus
+	for i := range positions {
+		n, err := customRNG.Int(100)
+		if err != nil {
+			return nil, 0, err
+		}
+		positions[i] = n
+		win += float64(n)
+	}
+	for i := 0; i < e.extraDraws; i++ {
+		if _, err := customRNG.Int(100); err != nil {
+			return nil, 0, err
+		}
+	}
+	return positions, win, nil
+}
+
+func (e *reelEngine) ExecuteBaseSpinWithRNG(ctx context.Context, playerID uuid.UUID, betAmount float64, gameMode string, customRNG rng.RNG) (*engine.SpinResult, error) {
+	positions, win, err := e.play(customRNG)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.SpinResult{SpinID: uuid.New(), ReelPositions: positions, TotalWin: win}, nil
+}
+
+func (e *reelEngine) ExecuteFreeSpinWithRNG(ctx context.Context, playerID uuid.UUID, session *freespins.Session, spinNumber int, customRNG rng.RNG) (*engine.FreeSpinResult, error) {
+	positions, win, err := e.play(customRNG)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.FreeSpinResult{SpinID: uuid.New(), ReelPositions: positions, TotalWin: win, SpinNumber: spinNumber}, nil
+}
+
+// recordingDivergenceNotifier keeps the divergences it is sent
+type recordingDivergenceNotifier struct {
+	mu          sync.Mutex
+	divergences []*shadow.Divergence
+}
+
+func (n *recordingDivergenceNotifier) Name() string { return "recording" }
+
+func (n *recordingDivergenceNotifier) NotifyDivergence(ctx context.Context, divergence *shadow.Divergence) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.divergences = append(n.divergences, divergence)
+	return nil
+}
+
+func TestShadowEngine(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+
+	// playProduction plays a spin with the production engine, recording its draws
+	playProduction := func(t *testing.T) (*engine.SpinResult, *rng.RecordingRNG) {
+		source, err := rng.NewDeterministicProvider(3).ForSpin(ctx, rng.SpinSeed{ServerSeed: uuid.NewString()})
+		require.NoError(t, err)
+		recorder := rng.NewRecordingRNG(source)
+		result, err := (&reelEngine{}).ExecuteBaseSpinWithRNG(ctx, playerID, 1, "", recorder)
+		require.NoError(t, err)
+		return result, recorder
+	}
+
+	newShadow := func(candidate engine.Candidate) (*ShadowEngine, *recordingDivergenceNotifier) {
+		notifier := &recordingDivergenceNotifier{}
+		return NewShadowEngine(candidate, 1, notifier, logger.New("error", "json")), notifier
+	}
+
+	t.Run("should stay quiet when the candidate matches", func(t *testing.T) {
+		shadowEngine, notifier := newShadow(&reelEngine{})
+		production, recorder := playProduction(t)
+
+		shadowEngine.CompareBaseSpin(ctx, playerID, 1, "", recorder.Draws(), production)
+		shadowEngine.wait()
+		assert.Empty(t, notifier.divergences)
+	})
+
+	t.Run("should report the fields that differ", func(t *testing.T) {
+		shadowEngine, notifier := newShadow(&reelEngine{bonus: 0.5})
+		production, recorder := playProduction(t)
+
+		shadowEngine.CompareBaseSpin(ctx, playerID, 1, "", recorder.Draws(), production)
+		shadowEngine.wait()
+		require.Len(t, notifier.divergences, 1)
+		divergence := notifier.divergences[0]
+		assert.Equal(t, production.SpinID, divergence.SpinID)
+		assert.Equal(t, []string{"total_win"}, divergence.Fields)
+		assert.Equal(t, production.TotalWin, divergence.Production.TotalWin)
+		require.NotNil(t, divergence.Shadow)
+		assert.Equal(t, production.TotalWin+0.5, divergence.Shadow.TotalWin)
+	})
+
+	t.Run("should report a candidate that draws differently", func(t *testing.T) {
+		shadowEngine, notifier := newShadow(&reelEngine{extraDraws: 1})
+		production, recorder := playProduction(t)
+
+		shadowEngine.CompareFreeSpin(ctx, playerID, &freespins.Session{}, 1, recorder.Draws(), &engine.FreeSpinResult{
+			SpinID:        production.SpinID,
+			ReelPositions: production.ReelPositions,
+			TotalWin:      production.TotalWin,
+		})
+		shadowEngine.wait()
+		require.Len(t, notifier.divergences, 1)
+		assert.True(t, notifier.divergences[0].IsFreeSpin)
+		assert.Contains(t, notifier.divergences[0].Error, rng.ErrReplayDiverged.Error())
+		assert.Nil(t, notifier.divergences[0].Shadow)
+	})
+
+	t.Run("should sample at the configured rate", func(t *testing.T) {
+		var disabled *ShadowEngine
+		assert.False(t, disabled.Sample())
+
+		shadowEngine := NewShadowEngine(&reelEngine{}, 0.25, nil, logger.New("error", "json"))
+		shadowEngine.sample = func() float64 { return 0.2 }
+		assert.True(t, shadowEngine.Sample())
+		shadowEngine.sample = func() float64 { return 0.3 }
+		assert.False(t, shadowEngine.Sample())
+	})
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// spinBatchRequest is a spin transaction waiting for its group to commit
+type spinBatchRequest struct {
+	playerID uuid.UUID
+	unit     *repository.GroupUnit
+	done     chan struct{}
+}
+
+// spinGroup is a group being collected, with at most one spin per player
+type spinGroup struct {
+	reqs    []*spinBatchRequest
+	players map[uuid.UUID]struct{}
+}
+
+func newSpinGroup(size int) *spinGroup {
+	return &spinGroup{
+		reqs:    make([]*spinBatchRequest, 0, size),
+		players: make(map[uuid.UUID]struct{}, size),
+	}
+}
+
+// add adds req to the group; false when the group already holds a spin of req's player
+func (g *spinGroup) add(req *spinBatchRequest) bool {
+	if _, ok := g.players[req.playerID]; ok {
+		return false
+	}
+	g.players[req.playerID] = struct{}{}
+	g.reqs = append(g.reqs, req)
+	return true
+}
+
+// SpinBatchWriter commits concurrent spin transactions in groups
+// Each spin keeps its own savepoint, so it settles or rolls back as a whole, and its caller
+// only returns once the group committed: a spin reported settled is always durable. The group's
+// spins and spin logs are inserted with one statement per table, instead of one per spin. A
+// group holds at most one spin per player: a player's next spin starts the next group, so its
+// rows are written after the previous spin's. The balance lock cannot keep them apart, as the
+// database lock is re-entrant within the group's transaction and the Redis lock is optional.
+// A group runs its spins in player ID order, so concurrent groups take the players' balance
+// locks, held until each group commits, in one order and cannot deadlock.
+type SpinBatchWriter struct {
+	txManager   *repository.TxManager
+	enabled     bool
+	maxSpins    int
+	flush       time.Duration
+	workers     int
+	timeout     time.Duration // Bounds a group's transaction; 0 leaves it unbounded
+	unitTimeout time.Duration // Bounds each statement and lock wait of a spin; 0 leaves them unbounded
+	logger      *logger.Logger
+
+	queue chan *spinBatchRequest
+
+	mu      sync.RWMutex
+	stopped bool
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewSpinBatchWriter creates a new spin batch writer
+func NewSpinBatchWriter(cfg *config.Config, txManager *repository.TxManager, log *logger.Logger) *SpinBatchWriter {
+	return &SpinBatchWriter{
+		txManager:   txManager,
+		enabled:     cfg.SpinBatch.Enabled,
+		maxSpins:    cfg.SpinBatch.MaxSpins,
+		flush:       time.Duration(cfg.SpinBatch.FlushMillis) * time.Millisecond,
+		workers:     cfg.SpinBatch.Workers,
+		timeout:     time.Duration(cfg.Game.SpinTimeoutMillis) * time.Millisecond,
+		unitTimeout: time.Duration(cfg.SpinBatch.UnitTimeoutMillis) * time.Millisecond,
+		logger:      log,
+		queue:       make(chan *spinBatchRequest, cfg.SpinBatch.MaxSpins*cfg.SpinBatch.Workers),
+		stopped:     true,
+		stop:        make(chan struct{}),
+	}
+}
+
+// Start runs the group committers in the background; it is a no-op unless batching is enabled
+func (w *SpinBatchWriter) Start() {
+	if !w.enabled {
+		w.logger.Info().Msg("Spin batch writer disabled")
+		return
+	}
+
+	w.mu.Lock()
+	w.stopped = false
+	w.mu.Unlock()
+	for range w.workers {
+		w.wg.Add(1)
+		go w.run()
+	}
+	w.logger.Info().
+		Int("max_spins", w.maxSpins).
+		Dur("flush", w.flush).
+		Int("workers", w.workers).
+		Msg("Spin batch writer started")
+}
+
+// Stop commits the spins still queued and stops the group committers
+// Spins arriving afterwards commit in their own transactions.
+func (w *SpinBatchWriter) Stop() {
+	w.stopOnce.Do(func() {
+		w.mu.Lock()
+		w.stopped = true
+		w.mu.Unlock()
+		close(w.stop)
+	})
+	w.wg.Wait()
+}
+
+// WithTransaction runs fn, a spin of playerID, in a transaction shared with other players' spins
+// and returns once it committed
+// Before Start, after Stop and with batching disabled, fn gets a transaction of its own.
+func (w *SpinBatchWriter) WithTransaction(ctx context.Context, playerID uuid.UUID, fn func(ctx context.Context) error) error {
+	req := &spinBatchRequest{
+		playerID: playerID,
+		unit:     &repository.GroupUnit{Ctx: ctx, Fn: fn},
+		done:     make(chan struct{}),
+	}
+
+	// Holding the read lock while queueing keeps Stop from draining the queue before this spin is in it
+	w.mu.RLock()
+	if w.stopped {
+		w.mu.RUnlock()
+		return w.txManager.WithTransaction(ctx, fn)
+	}
+	w.queue <- req
+	w.mu.RUnlock()
+
+	// Wait for the group even when ctx is done: it may already be committing this spin
+	<-req.done
+	if req.unit.Err != nil {
+		return req.unit.Err
+	}
+	req.unit.RunAfterCommit()
+	return nil
+}
+
+func (w *SpinBatchWriter) run() {
+	defer w.wg.Done()
+
+	for {
+		select {
+		case req := <-w.queue:
+			// A spin that ended a group starts the next one
+			for req != nil {
+				var group *spinGroup
+				group, req = w.collect(req)
+				w.commit(group.reqs)
+			}
+		case <-w.stop:
+			w.drain()
+			return
+		}
+	}
+}
+
+// collect gathers spins queued within the flush interval of the first, up to maxSpins
+// A second spin of a player in the group ends it early and is returned as next.
+func (w *SpinBatchWriter) collect(first *spinBatchRequest) (group *spinGroup, next *spinBatchRequest) {
+	group = newSpinGroup(w.maxSpins)
+	group.add(first)
+	timer := time.NewTimer(w.flush)
+	defer timer.Stop()
+
+	for len(group.reqs) < w.maxSpins {
+		select {
+		case req := <-w.queue:
+			if !group.add(req) {
+				return group, req
+			}
+		case <-timer.C:
+			return group, nil
+		case <-w.stop:
+			return group, nil
+		}
+	}
+	return group, nil
+}
+
+// drain commits everything still queued once the writer stopped taking spins
+func (w *SpinBatchWriter) drain() {
+	var next *spinBatchRequest
+	for {
+		group := newSpinGroup(w.maxSpins)
+		if next != nil {
+			group.add(next)
+			next = nil
+		}
+	fill:
+		for len(group.reqs) < w.maxSpins {
+			select {
+			case req := <-w.queue:
+				if !group.add(req) {
+					next = req
+					break fill
+				}
+			default:
+				break fill
+			}
+		}
+		if len(group.reqs) == 0 {
+			return
+		}
+		w.commit(group.reqs)
+	}
+}
+
+// commit commits a group and hands every spin its outcome
+func (w *SpinBatchWriter) commit(batch []*spinBatchRequest) {
+	ctx := context.Background()
+	if w.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.timeout)
+		defer cancel()
+	}
+
+	slices.SortFunc(batch, func(a, b *spinBatchRequest) int {
+		return bytes.Compare(a.playerID[:], b.playerID[:])
+	})
+	units := make([]*repository.GroupUnit, len(batch))
+	for i, req := range batch {
+		units[i] = req.unit
+	}
+	w.txManager.WithGroupTransaction(ctx, units, w.unitTimeout)
+
+	failed := 0
+	for _, req := range batch {
+		if req.unit.Err != nil {
+			failed++
+		}
+		close(req.done)
+	}
+	w.logger.Debug().Int("spins", len(batch)).Int("failed", failed).Msg("Committed spin batch")
+}
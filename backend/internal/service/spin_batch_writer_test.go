@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+func setupSpinBatchWriter(t *testing.T, enabled bool) (*SpinBatchWriter, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: gormlogger.Default.LogMode(gormlogger.Silent)})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1) // Every connection to :memory: opens its own database
+	require.NoError(t, db.Exec(`CREATE TABLE settlements (id INTEGER PRIMARY KEY)`).Error)
+
+	cfg := &config.Config{
+		SpinBatch: config.SpinBatchConfig{Enabled: enabled, MaxSpins: 10, FlushMillis: 20, Workers: 2},
+	}
+	return NewSpinBatchWriter(cfg, repository.NewTxManager(db), logger.New("error", "json")), db
+}
+
+// settle inserts a settlement in the transaction in txCtx
+func settle(db *gorm.DB, id int) func(txCtx context.Context) error {
+	return func(txCtx context.Context) error {
+		return repository.GetDBOrTx(txCtx, db).Exec(`INSERT INTO settlements (id) VALUES (?)`, id).Error
+	}
+}
+
+func countSettlements(t *testing.T, db *gorm.DB) int64 {
+	var count int64
+	require.NoError(t, db.Raw(`SELECT COUNT(*) FROM settlements`).Scan(&count).Error)
+	return count
+}
+
+func TestSpinBatchWriter_WithTransaction(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should commit concurrent spins and run their hooks before returning", func(t *testing.T) {
+		writer, db := setupSpinBatchWriter(t, true)
+		writer.Start()
+		defer writer.Stop()
+
+		var wg sync.WaitGroup
+		errs := make([]error, 20)
+		hooks := make([]bool, 20)
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = writer.WithTransaction(ctx, uuid.New(), func(txCtx context.Context) error {
+					repository.AfterCommit(txCtx, func(context.Context) { hooks[i] = true })
+					return settle(db, i+1)(txCtx)
+				})
+			}()
+		}
+		wg.Wait()
+
+		for i := range errs {
+			require.NoError(t, errs[i])
+			assert.True(t, hooks[i], "spin %d returned before its hooks ran", i)
+		}
+		assert.Equal(t, int64(20), countSettlements(t, db))
+	})
+
+	t.Run("should roll back only the spin that failed", func(t *testing.T) {
+		writer, db := setupSpinBatchWriter(t, true)
+		writer.Start()
+		defer writer.Stop()
+		failure := errors.New("failed to credit win")
+
+		var wg sync.WaitGroup
+		var failedErr, settledErr error
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			failedErr = writer.WithTransaction(ctx, uuid.New(), func(txCtx context.Context) error {
+				assert.NoError(t, settle(db, 1)(txCtx))
+				return failure
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			settledErr = writer.WithTransaction(ctx, uuid.New(), settle(db, 2))
+		}()
+		wg.Wait()
+
+		assert.ErrorIs(t, failedErr, failure)
+		require.NoError(t, settledErr)
+		assert.Equal(t, int64(1), countSettlements(t, db))
+	})
+
+	t.Run("should commit a player's spins in separate groups", func(t *testing.T) {
+		writer, db := setupSpinBatchWriter(t, true)
+		writer.workers = 1 // Without a second committer both spins would be collected by the same one
+		writer.Start()
+		defer writer.Stop()
+		playerID := uuid.New()
+
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		groups := make([]gorm.ConnPool, 2)
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = writer.WithTransaction(ctx, playerID, func(txCtx context.Context) error {
+					groups[i] = repository.GetTxFromContext(txCtx).Statement.ConnPool
+					return settle(db, i+1)(txCtx)
+				})
+			}()
+		}
+		wg.Wait()
+
+		for i := range errs {
+			require.NoError(t, errs[i])
+		}
+		assert.NotEqual(t, groups[0], groups[1], "both spins of the player were committed in one group")
+		assert.Equal(t, int64(2), countSettlements(t, db))
+	})
+
+	t.Run("should run a group's spins in player ID order", func(t *testing.T) {
+		writer, db := setupSpinBatchWriter(t, true)
+		writer.workers = 1
+		writer.maxSpins = 3 // The group commits as soon as every spin is queued
+		writer.flush = time.Minute
+		writer.Start()
+		defer writer.Stop()
+
+		players := []uuid.UUID{
+			uuid.MustParse("ffffffff-0000-0000-0000-000000000000"),
+			uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			uuid.MustParse("80000000-0000-0000-0000-000000000000"),
+		}
+		var mu sync.Mutex
+		var order []uuid.UUID
+		var wg sync.WaitGroup
+		for i, playerID := range players {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, writer.WithTransaction(ctx, playerID, func(txCtx context.Context) error {
+					mu.Lock()
+					order = append(order, playerID)
+					mu.Unlock()
+					return settle(db, i+1)(txCtx)
+				}))
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, []uuid.UUID{players[1], players[2], players[0]}, order)
+	})
+
+	t.Run("should commit spins in their own transactions when disabled or stopped", func(t *testing.T) {
+		disabled, db := setupSpinBatchWriter(t, false)
+		disabled.Start()
+		require.NoError(t, disabled.WithTransaction(ctx, uuid.New(), settle(db, 1)))
+		assert.Equal(t, int64(1), countSettlements(t, db))
+
+		stopped, db := setupSpinBatchWriter(t, true)
+		stopped.Start()
+		stopped.Stop()
+		require.NoError(t, stopped.WithTransaction(ctx, uuid.New(), settle(db, 1)))
+		assert.Equal(t, int64(1), countSettlements(t, db))
+	})
+}
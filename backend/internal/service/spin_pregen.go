@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// spinPregenerator holds each session's next spin RNG, derived as soon as the previous spin settles
+// A spin's draws depend on the previous spin's hash and on the client seed it is played with, so
+// the next spin can only be derived once its predecessor is recorded, and only for the seed the
+// client committed to. A held spin is used only by a request with exactly that seed; any other
+// request derives its RNG as usual, so outcomes never depend on whether a spin was held.
+type spinPregenerator struct {
+	draws       int
+	ttl         time.Duration
+	maxSessions int
+	logger      *logger.Logger
+
+	mu    sync.Mutex
+	spins map[uuid.UUID]*pregeneratedSpin
+
+	clock clock.Clock // Times held spins' expiry
+}
+
+// pregeneratedSpin is a session's next spin RNG and the seed it was derived from
+type pregeneratedSpin struct {
+	seed      rng.SpinSeed
+	provider  string
+	rng       rng.RNG
+	expiresAt time.Time
+}
+
+// newSpinPregenerator creates the pre-generation store, or returns nil when it is disabled
+func newSpinPregenerator(cfg config.SpinPregenConfig, clk clock.Clock, log *logger.Logger) *spinPregenerator {
+	if !cfg.Enabled {
+		return nil
+	}
+	return &spinPregenerator{
+		draws:       cfg.Draws,
+		ttl:         time.Duration(cfg.TTLSeconds) * time.Second,
+		maxSessions: cfg.MaxSessions,
+		logger:      log,
+		spins:       make(map[uuid.UUID]*pregeneratedSpin),
+		clock:       clk,
+	}
+}
+
+// prepare derives the session's next spin with the provider and holds it for its request
+// Providers that cannot derive spins ahead are skipped.
+func (p *spinPregenerator) prepare(ctx context.Context, sessionID uuid.UUID, provider rng.Provider, seed rng.SpinSeed) {
+	prederiver, ok := provider.(rng.Prederiver)
+	if !ok {
+		return
+	}
+
+	spinRNG, err := prederiver.Prederive(ctx, seed, p.draws)
+	if err != nil {
+		p.logger.WithTraceContext(ctx).Warn().Err(err).Str("session_id", sessionID.String()).Msg("Failed to pre-generate next spin")
+		return
+	}
+
+	now := p.clock.Now()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, held := p.spins[sessionID]; !held && len(p.spins) >= p.maxSessions {
+		p.evictExpired(now)
+		if len(p.spins) >= p.maxSessions {
+			return
+		}
+	}
+	p.spins[sessionID] = &pregeneratedSpin{
+		seed:      seed,
+		provider:  provider.Name(),
+		rng:       spinRNG,
+		expiresAt: now.Add(p.ttl),
+	}
+}
+
+// take returns the session's held spin RNG if it was derived from exactly this seed and provider
+// A held spin is removed whether or not it matches: an RNG is drawn from once.
+func (p *spinPregenerator) take(sessionID uuid.UUID, provider string, seed rng.SpinSeed) (rng.RNG, bool) {
+	p.mu.Lock()
+	held, ok := p.spins[sessionID]
+	delete(p.spins, sessionID)
+	p.mu.Unlock()
+
+	if !ok || held.seed != seed || held.provider != provider || !p.clock.Now().Before(held.expiresAt) {
+		return nil, false
+	}
+	return held.rng, true
+}
+
+// evictExpired drops held spins past their TTL; the caller holds mu
+func (p *spinPregenerator) evictExpired(now time.Time) {
+	for id, held := range p.spins {
+		if !now.Before(held.expiresAt) {
+			delete(p.spins, id)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/game/rng"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPFStateCache serves one session state
+type stubPFStateCache struct {
+	provablyfair.CacheRepository
+	state *provablyfair.PFSessionState
+}
+
+func (c *stubPFStateCache) GetSessionStateByGameSession(ctx context.Context, gameSessionID uuid.UUID) (*provablyfair.PFSessionState, error) {
+	return c.state, nil
+}
+
+// spinDraws returns the first reel position draws of a spin RNG
+func spinDraws(t *testing.T, r rng.RNG) []int {
+	t.Helper()
+	out := make([]int, 8)
+	for i := range out {
+		v, err := r.Intn(90)
+		require.NoError(t, err)
+		out[i] = v
+	}
+	return out
+}
+
+func TestProvablyFairService_GetSpinRNG_Pregenerated(t *testing.T) {
+	ctx := context.Background()
+	gameSessionID := uuid.New()
+	state := &provablyfair.PFSessionState{
+		SessionID:     uuid.New(),
+		GameSessionID: gameSessionID,
+		ServerSeed:    "a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2",
+		Nonce:         4,
+		LastSpinHash:  "5f1c0a7e",
+		Status:        provablyfair.SessionStatusActive,
+	}
+	nextSeed := rng.SpinSeed{ServerSeed: state.ServerSeed, ClientSeed: "next-seed", Nonce: 5, PrevSpinHash: state.LastSpinHash}
+
+	newService := func() *ProvablyFairService {
+		log := logger.New("error", "json")
+		return &ProvablyFairService{
+			cache:         &stubPFStateCache{state: state},
+			hashGenerator: rng.NewHashChainGenerator(),
+			pregen:        newSpinPregenerator(config.SpinPregenConfig{Enabled: true, Draws: 5, TTLSeconds: 60, MaxSessions: 10}, clock.New(), log),
+			logger:        log,
+		}
+	}
+	expected, err := rng.NewHKDFProvider().ForSpin(ctx, nextSeed)
+	require.NoError(t, err)
+	want := spinDraws(t, expected)
+
+	t.Run("held spin with the request's seed draws the same outcome", func(t *testing.T) {
+		svc := newService()
+		svc.pregen.prepare(ctx, gameSessionID, svc.RNGProvider(), nextSeed)
+		require.Len(t, svc.pregen.spins, 1)
+
+		spinRNG, provider, err := svc.GetSpinRNG(ctx, gameSessionID, "next-seed", "")
+		require.NoError(t, err)
+		assert.Equal(t, rng.ProviderHKDF, provider)
+		assert.Equal(t, want, spinDraws(t, spinRNG))
+		assert.Empty(t, svc.pregen.spins, "a held spin is used once")
+	})
+
+	t.Run("request with another seed derives its own spin", func(t *testing.T) {
+		svc := newService()
+		svc.pregen.prepare(ctx, gameSessionID, svc.RNGProvider(), nextSeed)
+
+		spinRNG, _, err := svc.GetSpinRNG(ctx, gameSessionID, "other-seed", "")
+		require.NoError(t, err)
+		assert.NotEqual(t, want, spinDraws(t, spinRNG))
+		assert.Empty(t, svc.pregen.spins, "a mismatched spin is discarded")
+	})
+
+	t.Run("held spin from a stale chain is not used", func(t *testing.T) {
+		svc := newService()
+		stale := nextSeed
+		stale.PrevSpinHash = "00000000"
+		svc.pregen.prepare(ctx, gameSessionID, svc.RNGProvider(), stale)
+
+		_, ok := svc.pregen.take(gameSessionID, rng.ProviderHKDF, nextSeed)
+		assert.False(t, ok)
+	})
+
+	t.Run("expired spin is not used", func(t *testing.T) {
+		svc := newService()
+		clk := clock.NewFake(time.Now())
+		svc.SetClock(clk)
+		svc.pregen.prepare(ctx, gameSessionID, svc.RNGProvider(), nextSeed)
+
+		clk.Advance(time.Minute)
+		_, ok := svc.pregen.take(gameSessionID, rng.ProviderHKDF, nextSeed)
+		assert.False(t, ok)
+	})
+
+	t.Run("providers that cannot derive ahead are skipped", func(t *testing.T) {
+		svc := newService()
+		svc.pregen.prepare(ctx, gameSessionID, rng.NewCryptoProvider(), nextSeed)
+		assert.Empty(t, svc.pregen.spins)
+	})
+
+	t.Run("new sessions are not held past the cap", func(t *testing.T) {
+		svc := newService()
+		svc.pregen.maxSessions = 1
+		svc.pregen.prepare(ctx, gameSessionID, svc.RNGProvider(), nextSeed)
+		svc.pregen.prepare(ctx, uuid.New(), svc.RNGProvider(), nextSeed)
+		assert.Len(t, svc.pregen.spins, 1)
+		assert.Contains(t, svc.pregen.spins, gameSessionID)
+	})
+}
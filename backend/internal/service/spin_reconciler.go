@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// spinReconcileBatchSize bounds how many unresolved spins one batch repairs
+const spinReconcileBatchSize = 100
+
+// SpinReconciler periodically resolves settled spins whose follow-up never happened
+// Debit, outcome and spin record commit atomically, but the free spins award is created
+// afterwards; a crash in between leaves a paid-for trigger without its free spins.
+type SpinReconciler struct {
+	spinService *SpinService
+	interval    time.Duration
+	grace       time.Duration
+	lookback    time.Duration
+	clock       clock.Clock
+	logger      *logger.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewSpinReconciler creates a new spin reconciler
+func NewSpinReconciler(cfg *config.Config, spinService *SpinService, clk clock.Clock, log *logger.Logger) *SpinReconciler {
+	return &SpinReconciler{
+		spinService: spinService,
+		interval:    time.Duration(cfg.Game.ReconcileIntervalSeconds) * time.Second,
+		grace:       time.Duration(cfg.Game.ReconcileGraceSeconds) * time.Second,
+		lookback:    time.Duration(cfg.Game.ReconcileLookbackHours) * time.Hour,
+		clock:       clk,
+		logger:      log,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// Start runs the reconciler in the background; a zero interval disables it
+func (r *SpinReconciler) Start() {
+	if r.interval <= 0 {
+		close(r.done)
+		r.logger.Info().Msg("Spin reconciler disabled")
+		return
+	}
+
+	go r.run()
+	r.logger.Info().
+		Dur("interval", r.interval).
+		Dur("grace", r.grace).
+		Dur("lookback", r.lookback).
+		Msg("Spin reconciler started")
+}
+
+// Stop stops the reconciler and waits for a running pass to finish
+func (r *SpinReconciler) Stop() {
+	r.stopOnce.Do(func() { close(r.stop) })
+	<-r.done
+}
+
+func (r *SpinReconciler) run() {
+	defer close(r.done)
+
+	// Run once at startup: that is right after the crashes this exists for
+	r.Reconcile(context.Background())
+
+	ticker := r.clock.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C():
+			r.Reconcile(context.Background())
+		}
+	}
+}
+
+// Reconcile resolves every unresolved spin in the lookback window and returns how many it resolved
+func (r *SpinReconciler) Reconcile(ctx context.Context) int {
+	now := r.clock.Now().UTC()
+	before := now.Add(-r.grace)
+	since := now.Add(-r.lookback)
+
+	total := 0
+	for {
+		resolved, err := r.spinService.ReconcileUnresolvedSpins(ctx, since, before, spinReconcileBatchSize)
+		if err != nil {
+			r.logger.Error().Err(err).Msg("Failed to reconcile unresolved spins")
+			break
+		}
+		total += resolved
+		// Spins that still fail stay in the window, so only a full batch of successes means more may be left
+		if resolved < spinReconcileBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		r.logger.Info().Int("resolved", total).Msg("Reconciled unresolved spins")
+	}
+	return total
+}
@@ -2,18 +2,36 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand/v2"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/analytics"
+	"github.com/slotmachine/backend/domain/autoplay"
+	"github.com/slotmachine/backend/domain/bigwin"
+	"github.com/slotmachine/backend/domain/certification"
+	"github.com/slotmachine/backend/domain/dashboard"
+	"github.com/slotmachine/backend/domain/exposure"
 	"github.com/slotmachine/backend/domain/freespins"
+	"github.com/slotmachine/backend/domain/jurisdiction"
+	"github.com/slotmachine/backend/domain/kyc"
 	"github.com/slotmachine/backend/domain/player"
 	"github.com/slotmachine/backend/domain/provablyfair"
 	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/segment"
 	"github.com/slotmachine/backend/domain/session"
 	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/domain/spinfeed"
+	"github.com/slotmachine/backend/domain/stats"
+	"github.com/slotmachine/backend/domain/vip"
 	"github.com/slotmachine/backend/internal/game/engine"
+	freespinsEngine "github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/game/rng"
 	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/featureflags"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
@@ -26,8 +44,30 @@ type SpinService struct {
 	freespinsRepo freespins.Repository
 	reelstripRepo reelstrip.Repository
 	txManager     *repository.TxManager
-	pfService     *ProvablyFairService // Required: always use HKDF RNG for provably fair
-	trialService  *TrialService        // Optional: nil if trials are disabled
+	batches       *SpinBatchWriter       // Optional: nil commits every spin in its own transaction
+	pfService     *ProvablyFairService   // Required: always use HKDF RNG for provably fair
+	trialService  *TrialService          // Optional: nil if trials are disabled
+	segments      segment.Resolver       // Optional: nil disables segment bonuses
+	vip           vip.Service            // Optional: nil disables loyalty points and tier perks
+	stats         stats.Recorder         // Optional: nil disables player stats rollups
+	bigWins       bigwin.Detector        // Optional: nil disables big win detection
+	feed          spinfeed.Publisher     // Optional: nil disables the live spin feed
+	mirror        analytics.Publisher    // Optional: nil disables the analytics mirror
+	dashboard     dashboard.Recorder     // Optional: nil disables the live dashboard counters
+	jurisdictions jurisdiction.Resolver  // Optional: nil disables jurisdiction limits
+	kyc           kyc.Gate               // Optional: nil disables KYC restrictions
+	flags         *featureflags.Service  // Optional: nil keeps every flagged feature at its default
+	certLog       certification.Recorder // Optional: nil disables certification logging
+	shadowEngine  *ShadowEngine          // Optional: nil disables shadow engine comparison
+	autoplays     autoplay.Enforcer      // Optional: nil accepts autoplay spins without a contract
+	exposure      exposure.Gate          // Optional: nil never pauses bonus buys
+	betChanges    session.BetChangePolicy
+	balanceLocks  player.Locker // Optional: nil leaves concurrent spins to the optimistic lock alone
+	retries       int           // Times a spin that lost an optimistic lock race is replayed
+	lockWait      time.Duration // How long a spin waits for the player's balance lock
+	nonceRequired bool          // Reject spin requests without a client nonce
+	timeout       time.Duration // Deadline for a spin to settle; 0 leaves it to the request
+	clock         clock.Clock   // Decides when reality checks are due and timestamps free spins sessions
 	logger        *logger.Logger
 }
 
@@ -53,6 +93,7 @@ func NewSpinService(
 		reelstripRepo: reelstripRepo,
 		txManager:     txManager,
 		pfService:     pfService,
+		clock:         clock.New(),
 		logger:        log,
 	}
 }
@@ -62,6 +103,11 @@ func (s *SpinService) SetTrialService(trialService *TrialService) {
 	s.trialService = trialService
 }
 
+// SetFeatureFlags enables per-player feature flag rollouts for spins
+func (s *SpinService) SetFeatureFlags(flags *featureflags.Service) {
+	s.flags = flags
+}
+
 // GenerateInitialGrid generates a demo grid for initial display
 // This ensures frontend has zero RNG - all symbol generation is backend-controlled
 func (s *SpinService) GenerateInitialGrid(ctx context.Context) (spin.Grid, error) {
@@ -77,6 +123,35 @@ func (s *SpinService) GenerateInitialGrid(ctx context.Context) (spin.Grid, error
 	return convertGrid(grid), nil
 }
 
+// checkJurisdiction enforces the player's jurisdiction rules on a spin request
+// Returns the applicable jurisdiction (nil when unrestricted) for capping the win.
+func (s *SpinService) checkJurisdiction(ctx context.Context, p *player.Player, sess *session.GameSession, stake float64) (*jurisdiction.Jurisdiction, error) {
+	if s.jurisdictions == nil {
+		return nil, nil
+	}
+
+	j, err := s.jurisdictions.ForPlayer(ctx, p.JurisdictionCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve jurisdiction: %w", err)
+	}
+	if j == nil {
+		return nil, nil
+	}
+
+	if err := j.CheckStake(stake); err != nil {
+		return nil, err
+	}
+	if spin.IsAutoplay(ctx) {
+		if err := j.CheckAutoplay(); err != nil {
+			return nil, err
+		}
+	}
+	if j.RealityCheckDue(sess.RealityCheckSince(), s.clock.Now()) {
+		return nil, jurisdiction.ErrRealityCheckDue
+	}
+	return j, nil
+}
+
 type GameModeCost struct {
 	BuyCost   float64
 	BetAmount float64
@@ -94,7 +169,121 @@ var gameModeCosts = map[string]GameModeCost{
 // gameMode is optional: bonus_spin_trigger (guaranteed free spins)
 // clientSeed is optional: for provably fair sessions, client provides their own seed per-spin
 // thetaSeed is optional: for Dual Commitment Protocol, revealed on first spin
+// Spins for one player queue behind each other; one that still loses the race for the balance
+// is replayed against the fresh balance, after a jittered backoff.
+// A spin that misses its deadline before committing is rolled back and fails with spin.ErrSpinTimeout.
 func (s *SpinService) ExecuteSpin(ctx context.Context, playerID, sessionID uuid.UUID, betAmount float64, gameMode, clientSeed, thetaSeed string) (*spin.SpinResult, error) {
+	spinCtx, cancel := s.withSpinDeadline(ctx)
+	defer cancel()
+
+	result, err := s.executeSpinLocked(spinCtx, playerID, sessionID, betAmount, gameMode, clientSeed, thetaSeed)
+	if err != nil && ctx.Err() == nil && errors.Is(spinCtx.Err(), context.DeadlineExceeded) {
+		s.logger.WithTraceContext(ctx).Error().Err(err).
+			Str("player_id", playerID.String()).
+			Dur("timeout", s.timeout).
+			Msg("Spin missed its deadline and was rolled back")
+		return nil, fmt.Errorf("%w after %s", spin.ErrSpinTimeout, s.timeout)
+	}
+	return result, err
+}
+
+// withSpinDeadline bounds ctx by the spin timeout; with no timeout the request's own deadline applies
+func (s *SpinService) withSpinDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, s.timeout)
+}
+
+// executeSpinLocked runs ExecuteSpin under the player's balance lock, replaying lost balance races
+func (s *SpinService) executeSpinLocked(ctx context.Context, playerID, sessionID uuid.UUID, betAmount float64, gameMode, clientSeed, thetaSeed string) (*spin.SpinResult, error) {
+	if s.balanceLocks != nil {
+		unlock, err := s.balanceLocks.Lock(ctx, playerID)
+		if err != nil {
+			s.logger.WithTraceContext(ctx).Warn().Err(err).Str("player_id", playerID.String()).Msg("Spin gave up waiting for the player's balance lock")
+			return nil, err
+		}
+		defer unlock()
+	}
+
+	return retryBalanceConflicts(ctx, s.retries, func(attempt int) (*spin.SpinResult, error) {
+		if attempt > 0 {
+			s.logger.WithTraceContext(ctx).Warn().Str("player_id", playerID.String()).Int("attempt", attempt).Msg("Retrying spin after balance lock conflict")
+		}
+		// A replay must see the balance the conflicting update left, not the one cached on the request
+		return s.executeSpin(ctx, playerID, sessionID, betAmount, gameMode, clientSeed, thetaSeed, attempt > 0)
+	})
+}
+
+// claimClientNonce holds the request's client nonce to the provably fair session's next nonce
+// and claims it, in the spin's transaction once the balance is locked, so the session state it
+// checks against is the one the previous spin committed
+func (s *SpinService) claimClientNonce(txCtx context.Context, sessionID uuid.UUID) error {
+	nonce, ok := spin.ClientNonce(txCtx)
+	if !ok {
+		if s.nonceRequired {
+			return provablyfair.ErrClientNonceRequired
+		}
+		return nil
+	}
+	pfState, err := s.pfService.GetSessionState(txCtx, sessionID)
+	if err != nil {
+		return fmt.Errorf("provably fair session required: start a PF session first")
+	}
+	return s.pfService.ClaimClientNonce(txCtx, pfState, nonce)
+}
+
+// withSpinTransaction runs fn in the spin's transaction, shared with concurrent spins when batching is enabled
+func (s *SpinService) withSpinTransaction(ctx context.Context, playerID uuid.UUID, fn func(txCtx context.Context) error) error {
+	if s.batches != nil {
+		return s.batches.WithTransaction(ctx, playerID, fn)
+	}
+	return s.txManager.WithTransaction(ctx, fn)
+}
+
+// lockBalance takes the player's database balance lock for the transaction in txCtx
+// A wait longer than lockWait is reported as player.ErrBalanceBusy.
+func (s *SpinService) lockBalance(txCtx context.Context, playerID uuid.UUID) error {
+	if s.lockWait <= 0 {
+		return s.playerRepo.LockBalanceWithTx(txCtx, playerID)
+	}
+
+	lockCtx, cancel := context.WithTimeout(txCtx, s.lockWait)
+	defer cancel()
+	err := s.playerRepo.LockBalanceWithTx(lockCtx, playerID)
+	if err != nil && txCtx.Err() == nil && errors.Is(lockCtx.Err(), context.DeadlineExceeded) {
+		return player.ErrBalanceBusy
+	}
+	return err
+}
+
+// balanceRetryBase is the backoff before the first replay of a spin; it doubles on each further replay
+const balanceRetryBase = 10 * time.Millisecond
+
+// retryBalanceConflicts runs fn, replaying it up to retries times while it fails with player.ErrNotFoundOrLockChanged
+// Each replay waits a full-jitter exponential backoff so colliding requests spread out.
+func retryBalanceConflicts[T any](ctx context.Context, retries int, fn func(attempt int) (T, error)) (T, error) {
+	for attempt := 0; ; attempt++ {
+		result, err := fn(attempt)
+		if err == nil || !errors.Is(err, player.ErrNotFoundOrLockChanged) || attempt >= retries {
+			return result, err
+		}
+
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		timer := time.NewTimer(rand.N(balanceRetryBase << attempt))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return result, ctx.Err()
+		}
+	}
+}
+
+// executeSpin runs one attempt of ExecuteSpin; reload skips the player cached on the request
+func (s *SpinService) executeSpin(ctx context.Context, playerID, sessionID uuid.UUID, betAmount float64, gameMode, clientSeed, thetaSeed string, reload bool) (*spin.SpinResult, error) {
 	log := s.logger.WithTraceContext(ctx)
 
 	// Validate bet amount
@@ -102,6 +291,11 @@ func (s *SpinService) ExecuteSpin(ctx context.Context, playerID, sessionID uuid.
 		return nil, fmt.Errorf("bet amount must be positive")
 	}
 
+	// Evaluate flags once so the whole spin sees the same rollout decisions
+	if s.flags != nil {
+		ctx = featureflags.NewContext(ctx, s.flags.Evaluate(ctx, playerID))
+	}
+
 	// Calculate deduction based on game mode
 	// If game mode is specified, only deduct the game mode cost (not bet amount)
 	// If no game mode, deduct the bet amount as normal
@@ -113,6 +307,14 @@ func (s *SpinService) ExecuteSpin(ctx context.Context, playerID, sessionID uuid.
 		}
 		totalDeduction = cost.BuyCost // Only game mode cost, no bet amount
 		betAmount = cost.BetAmount    // Deduct bet amount from total
+
+		// Bonus buys pause while open liability exceeds its limits
+		if s.exposure != nil {
+			if err := s.exposure.CheckBonusBuy(ctx); err != nil {
+				log.Warn().Err(err).Str("player_id", playerID.String()).Str("game_mode", gameMode).Msg("Bonus buy rejected by liability circuit breaker")
+				return nil, err
+			}
+		}
 	} else {
 		totalDeduction = betAmount // Normal spin: deduct bet amount
 	}
@@ -120,7 +322,7 @@ func (s *SpinService) ExecuteSpin(ctx context.Context, playerID, sessionID uuid.
 	var p *player.Player
 	var err error
 
-	if ctx.Value("player") != nil {
+	if !reload && ctx.Value("player") != nil {
 		p = ctx.Value("player").(*player.Player)
 	} else {
 		// Get player to check balance
@@ -148,6 +350,45 @@ func (s *SpinService) ExecuteSpin(ctx context.Context, playerID, sessionID uuid.
 		return nil, session.ErrSessionAlreadyEnded
 	}
 
+	// Hold the bet to the session's bet rules; bought features have a fixed bet and are exempt
+	betChanged := gameMode == "" && session.BetChanged(sess.BetAmount, betAmount)
+	if betChanged {
+		if err := s.betChanges.Check(sess.BetAmount, betAmount); err != nil {
+			log.Warn().
+				Err(err).
+				Str("session_id", sessionID.String()).
+				Float64("session_bet", sess.BetAmount).
+				Float64("bet_amount", betAmount).
+				Msg("Spin rejected by session bet rules")
+			return nil, err
+		}
+	}
+
+	// Apply the player's jurisdiction rules before taking the stake
+	j, err := s.checkJurisdiction(ctx, p, sess, totalDeduction)
+	if err != nil {
+		log.Warn().Err(err).Str("player_id", playerID.String()).Msg("Spin rejected by jurisdiction rules")
+		return nil, err
+	}
+
+	// Autoplay spins are held to the session's autoplay contract rather than trusting the client
+	var autoplayContract *autoplay.Contract
+	if s.autoplays != nil && spin.IsAutoplay(ctx) {
+		autoplayContract, err = s.autoplays.CheckSpin(ctx, playerID, sessionID, totalDeduction)
+		if err != nil {
+			log.Warn().Err(err).Str("session_id", sessionID.String()).Msg("Spin rejected by autoplay contract")
+			return nil, err
+		}
+	}
+
+	// Unverified players may only wager up to the KYC threshold
+	if s.kyc != nil {
+		if err := s.kyc.CheckSpin(ctx, p, totalDeduction); err != nil {
+			log.Warn().Err(err).Str("player_id", playerID.String()).Msg("Spin rejected pending KYC verification")
+			return nil, err
+		}
+	}
+
 	// Check if player has sufficient balance
 	if p.Balance < totalDeduction {
 		log.Warn().
@@ -168,35 +409,79 @@ func (s *SpinService) ExecuteSpin(ctx context.Context, playerID, sessionID uuid.
 
 	// Verify active provably fair session exists (required for all spins)
 	var pfResult *provablyfair.SpinResult
-	if _, err := s.pfService.GetSessionState(ctx, sessionID); err != nil {
+	pfState, err := s.pfService.GetSessionState(ctx, sessionID)
+	if err != nil {
 		log.Error().Err(err).Str("session_id", sessionID.String()).Msg("No active provably fair session")
 		return nil, fmt.Errorf("provably fair session required: start a PF session first")
 	}
 
-	// Execute spin within a transaction to ensure atomicity
+	// Prepare game mode fields (nil for normal spins)
+	var gameModePtr *string
+	var gameModeCostPtr *float64
+	if gameMode != "" {
+		gameModePtr = &gameMode
+		gameModeCostPtr = &totalDeduction
+	}
+
+	// Debit, outcome, spin record, PF spin log and statistics commit together:
+	// a spin is either fully settled or never happened
 	var engineResult *engine.SpinResult
-	err = s.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
+	var spinRecord *spin.Spin
+	var winCapped bool
+	// Certification logging and shadow engine replays need every value the spin draws
+	certLogging := s.certLog != nil && s.certLog.Enabled()
+	shadowSampled := s.shadowEngine.Sample()
+	var recorder *rng.RecordingRNG
+	var shadowProduction *engine.SpinResult // Outcome before the jurisdiction cap, as the shadow engine computes it
+	err = s.withSpinTransaction(ctx, playerID, func(txCtx context.Context) error {
+		// Settlements for one player never overlap, on any replica: the database lock holds
+		// even when the Redis lock is unavailable or expired mid-spin
+		if err := s.lockBalance(txCtx, playerID); err != nil {
+			log.Warn().Err(err).Str("player_id", playerID.String()).Msg("Failed to lock balance for spin")
+			return err
+		}
+
+		// A captured request replayed after its spin was played names a nonce the session has passed
+		if err := s.claimClientNonce(txCtx, sessionID); err != nil {
+			log.Warn().Err(err).Str("session_id", sessionID.String()).Msg("Spin rejected by client nonce")
+			return err
+		}
+
 		// Deduct bet + game mode cost from balance with optimistic lock
 		if err := s.playerRepo.UpdateBalanceWithLockAndTx(txCtx, playerID, -totalDeduction, lockVersion); err != nil {
 			log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to deduct bet")
 			return fmt.Errorf("failed to deduct bet: %w", err)
 		}
 
-		// Get HKDF stream RNG from PF service (client provides seed per-spin)
-		// This implements RFC 5869 HKDF for per-reel key derivation
+		// Get the spin RNG from PF service (client provides seed per-spin)
+		// The default HKDF provider implements RFC 5869 HKDF for per-reel key derivation
 		// Dual Commitment Protocol: thetaSeed is verified BEFORE RNG generation
-		hkdfRNG, _, err := s.pfService.GetHKDFStreamRNG(txCtx, sessionID, clientSeed, thetaSeed)
+		spinRNG, rngProvider, err := s.pfService.GetSpinRNG(txCtx, sessionID, clientSeed, thetaSeed)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to get HKDF stream RNG")
-			return fmt.Errorf("failed to get HKDF stream RNG: %w", err)
+			log.Error().Err(err).Msg("Failed to get spin RNG")
+			return fmt.Errorf("failed to get spin RNG: %w", err)
+		}
+
+		if certLogging || shadowSampled {
+			recorder = rng.NewRecordingRNG(spinRNG)
+			spinRNG = recorder
 		}
 
-		// Execute spin with HKDF-based RNG (provably fair with per-reel key derivation)
-		engineResult, err = s.gameEngine.ExecuteBaseSpinWithRNG(txCtx, playerID, betAmount, gameMode, hkdfRNG)
+		// Execute spin with the provider's RNG (provably fair with HKDF)
+		engineResult, err = s.gameEngine.ExecuteBaseSpinWithRNG(txCtx, playerID, betAmount, gameMode, spinRNG)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to execute base spin with HKDF RNG")
+			log.Error().Err(err).Msg("Failed to execute base spin")
 			return fmt.Errorf("failed to execute spin: %w", err)
 		}
+		if shadowSampled {
+			uncapped := *engineResult
+			shadowProduction = &uncapped
+		}
+
+		// Cap the win at the jurisdiction's max win before crediting it
+		if j != nil {
+			engineResult.TotalWin, winCapped = j.CapWin(engineResult.TotalWin)
+		}
 
 		// Credit win to balance if any
 		if engineResult.TotalWin > 0 {
@@ -207,81 +492,163 @@ func (s *SpinService) ExecuteSpin(ctx context.Context, playerID, sessionID uuid.
 			}
 		}
 
+		// Convert engine result to domain spin model
+		spinRecord = &spin.Spin{
+			ID:                 engineResult.SpinID,
+			SessionID:          sessionID,
+			PlayerID:           playerID,
+			BetAmount:          betAmount,
+			BalanceBefore:      balanceBefore,
+			BalanceAfter:       newBalance,
+			Grid:               convertGrid(engineResult.Grid),
+			Cascades:           convertCascades(engineResult.Cascades),
+			TotalWin:           engineResult.TotalWin,
+			ScatterCount:       engineResult.ScatterCount,
+			IsFreeSpin:         false,
+			FreeSpinsSessionID: nil,
+			FreeSpinsTriggered: engineResult.FreeSpinsTriggered,
+			ReelPositions:      engineResult.ReelPositions,
+			GameMode:           gameModePtr,
+			GameModeCost:       gameModeCostPtr,
+			RNGProvider:        rngProvider,
+			MathVersion:        engineResult.MathVersion,
+			CreatedAt:          engineResult.Timestamp,
+		}
+
+		if err := s.spinRepo.Create(txCtx, spinRecord); err != nil {
+			log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to save spin")
+			return fmt.Errorf("failed to save spin: %w", err)
+		}
+
+		// Record spin in provably fair system (always required)
+		// Dual Commitment Protocol: thetaSeed is passed on first spin for verification
+		pfResult, err = s.pfService.RecordSpin(txCtx, &provablyfair.RecordSpinInput{
+			GameSessionID:     sessionID,
+			SpinID:            spinRecord.ID,
+			ClientSeed:        clientSeed, // Per-spin client seed
+			NextClientSeed:    spin.NextClientSeed(ctx),
+			ReelPositions:     engineResult.ReelPositions,
+			ReelStripConfigID: engineResult.ReelStripConfigID,
+			GameMode:          gameModePtr,
+			IsFreeSpin:        false,
+			Multipliers:       engineResult.Multipliers,
+			WildFeatures:      toWildFeatures(engineResult.WildFeatures),
+			MathVersion:       engineResult.MathVersion,
+			ThetaSeed:         thetaSeed, // Dual Commitment Protocol: revealed on first spin
+		})
+		if err != nil {
+			log.Error().Err(err).Str("spin_id", spinRecord.ID.String()).Msg("Failed to record spin in PF system")
+			return fmt.Errorf("failed to record provably fair spin: %w", err)
+		}
+
+		// The certification event commits with the spin, so the log has no gaps
+		if certLogging {
+			event := newCertificationEvent(spinRecord, recorder, engineResult.ReelStripConfigID, engineResult.StripChecksums, engineResult.Multipliers, pfResult)
+			if err := s.certLog.Record(txCtx, event); err != nil {
+				log.Error().Err(err).Str("spin_id", spinRecord.ID.String()).Msg("Failed to record certification event")
+				return fmt.Errorf("failed to record certification event: %w", err)
+			}
+		}
+
+		// Roll the spin up into the player's daily and lifetime stats
+		if s.stats != nil {
+			if err := s.stats.RecordSpin(txCtx, spinRecord); err != nil {
+				log.Error().Err(err).Str("spin_id", spinRecord.ID.String()).Msg("Failed to record spin stats")
+				return fmt.Errorf("failed to record spin stats: %w", err)
+			}
+		}
+
+		// The spin that changes the bet records the change, and its bet becomes the session's
+		if betChanged {
+			change := &session.BetChange{
+				ID:          uuid.New(),
+				SessionID:   sessionID,
+				PlayerID:    playerID,
+				SpinID:      spinRecord.ID,
+				PreviousBet: sess.BetAmount,
+				NewBet:      betAmount,
+				CreatedAt:   spinRecord.CreatedAt,
+			}
+			if err := s.sessionRepo.RecordBetChange(txCtx, change); err != nil {
+				log.Error().Err(err).Str("session_id", sessionID.String()).Msg("Failed to record bet change")
+				return fmt.Errorf("failed to record bet change: %w", err)
+			}
+		}
+
+		// Count the spin against the autoplay contract with the spin, so a stop condition takes effect for the next one
+		if autoplayContract != nil {
+			if err := s.autoplays.RecordSpin(txCtx, autoplayContract, totalDeduction, engineResult.TotalWin); err != nil {
+				log.Error().Err(err).Str("autoplay_id", autoplayContract.ID.String()).Msg("Failed to record autoplay spin")
+				return fmt.Errorf("failed to record autoplay spin: %w", err)
+			}
+		}
+
+		// Update session statistics
+		if err := s.sessionRepo.UpdateStatistics(txCtx, sessionID, 1, betAmount, engineResult.TotalWin); err != nil {
+			log.Error().Err(err).Str("session_id", sessionID.String()).Msg("Failed to update session statistics")
+			return fmt.Errorf("failed to update session statistics: %w", err)
+		}
+
+		// Update player statistics
+		if err := s.playerRepo.UpdateStatistics(txCtx, playerID, 1, betAmount, engineResult.TotalWin); err != nil {
+			log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to update player statistics")
+			return fmt.Errorf("failed to update player statistics: %w", err)
+		}
+
 		return nil
 	})
 
 	if err != nil {
+		// Compensate the Redis side: state read or written during the rolled-back spin
+		// may no longer match the spin log, so force recovery from the DB
+		if invErr := s.pfService.InvalidateSessionState(ctx, pfState.SessionID); invErr != nil {
+			log.Error().Err(invErr).Str("session_id", sessionID.String()).Msg("Failed to invalidate PF session state after rollback")
+		}
 		return nil, err
 	}
 
-	// Prepare game mode fields (nil for normal spins)
-	var gameModePtr *string
-	var gameModeCostPtr *float64
-	if gameMode != "" {
-		gameModePtr = &gameMode
-		gameModeCostPtr = &totalDeduction
+	log.Debug().
+		Str("spin_id", spinRecord.ID.String()).
+		Int64("nonce", pfResult.Nonce).
+		Str("spin_hash", pfResult.SpinHash).
+		Msg("Spin recorded in PF system")
+
+	// Replay the settled spin through the shadow engine in the background
+	if shadowProduction != nil {
+		s.shadowEngine.CompareBaseSpin(ctx, playerID, betAmount, gameMode, recorder.Draws(), shadowProduction)
 	}
 
-	// Convert engine result to domain spin model
-	spinRecord := &spin.Spin{
-		ID:                 engineResult.SpinID,
-		SessionID:          sessionID,
-		PlayerID:           playerID,
-		BetAmount:          betAmount,
-		BalanceBefore:      balanceBefore,
-		BalanceAfter:       newBalance,
-		Grid:               convertGrid(engineResult.Grid),
-		Cascades:           convertCascades(engineResult.Cascades),
-		TotalWin:           engineResult.TotalWin,
-		ScatterCount:       engineResult.ScatterCount,
-		IsFreeSpin:         false,
-		FreeSpinsSessionID: nil,
-		FreeSpinsTriggered: engineResult.FreeSpinsTriggered,
-		ReelPositions:      engineResult.ReelPositions,
-		GameMode:           gameModePtr,
-		GameModeCost:       gameModeCostPtr,
-		CreatedAt:          engineResult.Timestamp,
-	}
-
-	// Save spin to database
-	if err := s.spinRepo.Create(ctx, spinRecord); err != nil {
-		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to save spin")
-		// Don't return error, spin was executed successfully
-	}
-
-	// Record spin in provably fair system (always required)
-	// Dual Commitment Protocol: thetaSeed is passed on first spin for verification
-	pfResult, err = s.pfService.RecordSpin(ctx, &provablyfair.RecordSpinInput{
-		GameSessionID:     sessionID,
-		SpinID:            spinRecord.ID,
-		ClientSeed:        clientSeed, // Per-spin client seed
-		ReelPositions:     engineResult.ReelPositions,
-		ReelStripConfigID: engineResult.ReelStripConfigID,
-		GameMode:          gameModePtr,
-		IsFreeSpin:        false,
-		ThetaSeed:         thetaSeed, // Dual Commitment Protocol: revealed on first spin
-	})
-	if err != nil {
-		log.Error().Err(err).Str("spin_id", spinRecord.ID.String()).Msg("Failed to record spin in PF system")
-		// Don't return error, spin was executed successfully
-	} else {
-		log.Debug().
-			Str("spin_id", spinRecord.ID.String()).
-			Int64("nonce", pfResult.Nonce).
-			Str("spin_hash", pfResult.SpinHash).
-			Msg("Spin recorded in PF system")
+	// Record big wins along with the PF proof needed to verify them
+	if s.bigWins != nil {
+		if _, err := s.bigWins.Check(ctx, spinRecord, bigWinProof(pfState, pfResult, clientSeed)); err != nil {
+			log.Error().Err(err).Str("spin_id", spinRecord.ID.String()).Msg("Failed to record big win")
+			// Don't return error
+		}
+	}
+
+	// Show the spin on the live ops feed
+	if s.feed != nil {
+		if err := s.feed.Publish(ctx, spinRecord, p.Username); err != nil {
+			log.Warn().Err(err).Str("spin_id", spinRecord.ID.String()).Msg("Failed to publish spin to feed")
+		}
 	}
 
-	// Update session statistics
-	if err := s.sessionRepo.UpdateStatistics(ctx, sessionID, 1, betAmount, engineResult.TotalWin); err != nil {
-		log.Error().Err(err).Str("session_id", sessionID.String()).Msg("Failed to update session statistics")
-		// Don't return error
+	// Mirror the spin into the analytics store
+	if s.mirror != nil {
+		s.mirror.Publish(ctx, analytics.NewSpinEvent(spinRecord, engineResult.ReelStripConfigID))
 	}
 
-	// Update player statistics
-	if err := s.playerRepo.UpdateStatistics(ctx, playerID, 1, betAmount, engineResult.TotalWin); err != nil {
-		log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to update player statistics")
-		// Don't return error
+	// Count the spin on the ops dashboard
+	if s.dashboard != nil {
+		s.dashboard.RecordSpin(ctx, spinRecord, p.Username)
+	}
+
+	// Accrue loyalty points on the amount actually debited
+	if s.vip != nil {
+		if _, err := s.vip.AccruePoints(ctx, playerID, totalDeduction); err != nil {
+			log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to accrue VIP points")
+			// Don't return error
+		}
 	}
 
 	log.Info().
@@ -296,21 +663,14 @@ func (s *SpinService) ExecuteSpin(ctx context.Context, playerID, sessionID uuid.
 	var freeSpinsSessionID *uuid.UUID
 	var freeSpinsAwarded int
 	if engineResult.FreeSpinsTriggered {
-		freeSpinsSession, err := s.createFreeSpinsSession(ctx, sess.ID, playerID, spinRecord.ID, engineResult.ScatterCount, betAmount, gameMode)
+		freeSpinsSession, err := s.resolveFreeSpinsTrigger(ctx, spinRecord)
 		if err != nil {
 			log.Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to create free spins session")
-			// Don't return error, spin was executed successfully
+			// Don't return error, spin was executed successfully; the spin reconciler retries the award
 		} else {
 			freeSpinsSessionID = &freeSpinsSession.ID
 			freeSpinsAwarded = freeSpinsSession.TotalSpinsAwarded
 
-			// Update spin record with free spins session ID
-			if err := s.spinRepo.UpdateFreeSpinsSessionId(ctx, spinRecord.ID, *freeSpinsSessionID); err != nil {
-				log.Error().Err(err).Str("spin_id", spinRecord.ID.String()).Msg("Failed to update spin with free spins session ID")
-			} else {
-				spinRecord.FreeSpinsSessionID = freeSpinsSessionID
-			}
-
 			log.Info().
 				Str("free_spins_session_id", freeSpinsSession.ID.String()).
 				Str("player_id", playerID.String()).
@@ -331,6 +691,9 @@ func (s *SpinService) ExecuteSpin(ctx context.Context, playerID, sessionID uuid.
 		Grid:                    spinRecord.Grid,
 		Cascades:                spinRecord.Cascades,
 		SpinTotalWin:            engineResult.TotalWin,
+		WinCapped:               winCapped,
+		WinTier:                 spin.ClassifyWin(engineResult.WinTiers, engineResult.TotalWin, betAmount),
+		Timing:                  spin.PlanTiming(engineResult.Timing, spinRecord.Cascades.Expansions()),
 		ScatterCount:            engineResult.ScatterCount,
 		IsFreeSpin:              false,
 		FreeSpinsTriggered:      engineResult.FreeSpinsTriggered,
@@ -339,6 +702,7 @@ func (s *SpinService) ExecuteSpin(ctx context.Context, playerID, sessionID uuid.
 		GameMode:                gameMode,
 		GameModeCost:            totalDeduction,
 		Timestamp:               spinRecord.CreatedAt.Format(time.RFC3339),
+		Autoplay:                autoplayContract,
 	}
 
 	// Add provably fair data if PF session was active
@@ -374,6 +738,224 @@ func (s *SpinService) GetSpinDetails(ctx context.Context, spinID uuid.UUID) (*sp
 	return spinRecord, nil
 }
 
+// GetPendingSpin returns the session's newest spin the client has not acknowledged
+// The result is rebuilt from the settled spin record, so it matches what ExecuteSpin returned
+func (s *SpinService) GetPendingSpin(ctx context.Context, playerID, sessionID uuid.UUID) (*spin.SpinResult, error) {
+	sess, err := s.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil || sess.PlayerID != playerID {
+		return nil, session.ErrSessionNotFound
+	}
+
+	spinRecord, err := s.spinRepo.GetLatestBySession(ctx, sessionID, sess.SpinAckedAt)
+	if err != nil {
+		if errors.Is(err, spin.ErrSpinNotFound) {
+			return nil, spin.ErrNoPendingSpin
+		}
+		return nil, fmt.Errorf("failed to get pending spin: %w", err)
+	}
+
+	return s.buildSpinResult(ctx, spinRecord), nil
+}
+
+// AcknowledgeSpin confirms the client received a spin, along with every earlier spin of the session
+func (s *SpinService) AcknowledgeSpin(ctx context.Context, playerID, sessionID, spinID uuid.UUID) error {
+	sess, err := s.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil || sess.PlayerID != playerID {
+		return session.ErrSessionNotFound
+	}
+
+	spinRecord, err := s.spinRepo.GetByID(ctx, spinID)
+	if err != nil || spinRecord.SessionID != sessionID {
+		return spin.ErrSpinNotFound
+	}
+
+	if err := s.sessionRepo.MarkSpinAcked(ctx, sessionID, spinRecord.CreatedAt); err != nil {
+		return fmt.Errorf("failed to acknowledge spin: %w", err)
+	}
+
+	s.logger.WithTraceContext(ctx).Debug().
+		Str("session_id", sessionID.String()).
+		Str("spin_id", spinID.String()).
+		Msg("Spin acknowledged")
+
+	return nil
+}
+
+// buildSpinResult rebuilds a spin result from a settled spin record
+// Free spins and provably fair data are best effort: the spin itself is what the client must not lose
+func (s *SpinService) buildSpinResult(ctx context.Context, sp *spin.Spin) *spin.SpinResult {
+	log := s.logger.WithTraceContext(ctx)
+
+	deduction := sp.BetAmount
+	if sp.IsFreeSpin {
+		deduction = 0
+	} else if sp.GameModeCost != nil {
+		deduction = *sp.GameModeCost
+	}
+
+	rules := s.gameEngine.RulesForPlayer(ctx, sp.PlayerID)
+	result := &spin.SpinResult{
+		SpinID:             sp.ID,
+		SessionID:          sp.SessionID,
+		BetAmount:          sp.BetAmount,
+		BalanceBefore:      sp.BalanceBefore,
+		BalanceAfterBet:    sp.BalanceBefore - deduction,
+		NewBalance:         sp.BalanceAfter,
+		Grid:               sp.Grid,
+		Cascades:           sp.Cascades,
+		SpinTotalWin:       sp.TotalWin,
+		WinTier:            spin.ClassifyWin(rules.WinTiers, sp.TotalWin, sp.BetAmount),
+		Timing:             spin.PlanTiming(rules.Timing, sp.Cascades.Expansions()),
+		ScatterCount:       sp.ScatterCount,
+		IsFreeSpin:         sp.IsFreeSpin,
+		FreeSpinsTriggered: sp.FreeSpinsTriggered,
+		Timestamp:          sp.CreatedAt.Format(time.RFC3339),
+	}
+	if sp.GameMode != nil {
+		result.GameMode = *sp.GameMode
+		result.GameModeCost = deduction
+	}
+
+	if sp.FreeSpinsSessionID != nil {
+		result.FreeSpinsSessionID = sp.FreeSpinsSessionID.String()
+		if fsSession, err := s.freespinsRepo.GetByID(ctx, *sp.FreeSpinsSessionID); err != nil {
+			log.Warn().Err(err).Str("spin_id", sp.ID.String()).Msg("Failed to get free spins session for pending spin")
+		} else {
+			result.FreeSpinsRemainingSpins = fsSession.RemainingSpins
+			result.FreeSessionTotalWin = fsSession.TotalWon
+		}
+	}
+
+	if spinLog, err := s.pfService.GetSpinLog(ctx, sp.ID); err != nil {
+		log.Warn().Err(err).Str("spin_id", sp.ID.String()).Msg("Failed to get PF spin log for pending spin")
+	} else {
+		result.ProvablyFair = &spin.SpinProvablyFairData{
+			SpinIndex:    spinLog.SpinIndex,
+			Nonce:        spinLog.Nonce,
+			SpinHash:     spinLog.SpinHash,
+			PrevSpinHash: spinLog.PrevSpinHash,
+		}
+	}
+
+	return result
+}
+
+// segmentBonusSpins returns the extra free spins granted to the player by a segment bonus target
+func (s *SpinService) segmentBonusSpins(ctx context.Context, playerID uuid.UUID) int {
+	if s.segments == nil {
+		return 0
+	}
+
+	target, err := s.segments.ResolveTarget(ctx, playerID, segment.TargetBonus)
+	if err != nil {
+		if !errors.Is(err, segment.ErrNoTarget) {
+			s.logger.Warn().Err(err).Str("player_id", playerID.String()).Msg("Failed to resolve segment bonus, awarding base free spins")
+		}
+		return 0
+	}
+
+	settings, err := target.BonusSettings()
+	if err != nil {
+		s.logger.Warn().Err(err).Str("target_id", target.ID.String()).Msg("Invalid segment bonus target")
+		return 0
+	}
+
+	s.logger.Info().
+		Str("player_id", playerID.String()).
+		Str("segment_id", target.SegmentID.String()).
+		Int("extra_free_spins", settings.ExtraFreeSpins).
+		Msg("Segment bonus applied to free spins award")
+	return settings.ExtraFreeSpins
+}
+
+// vipBonusSpins returns the extra free spins granted by the player's VIP tier
+func (s *SpinService) vipBonusSpins(ctx context.Context, playerID uuid.UUID) int {
+	if s.vip == nil {
+		return 0
+	}
+
+	status, err := s.vip.GetStatus(ctx, playerID)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("player_id", playerID.String()).Msg("Failed to get VIP status, awarding base free spins")
+		return 0
+	}
+	return status.Perks().ExtraFreeSpins
+}
+
+// freeSpinsRules returns the free spins trigger rules of the player's game config
+func (s *SpinService) freeSpinsRules(ctx context.Context, playerID uuid.UUID) freespinsEngine.TriggerRules {
+	if s.gameEngine == nil {
+		return freespinsEngine.DefaultTriggerRules
+	}
+	return s.gameEngine.RulesForPlayer(ctx, playerID).FreeSpins
+}
+
+// resolveFreeSpinsTrigger awards the free spins a settled spin triggered and links the spin to them
+// Creation and linking commit together, so a spin is never left pointing at nothing or awarded twice
+func (s *SpinService) resolveFreeSpinsTrigger(ctx context.Context, sp *spin.Spin) (*freespins.FreeSpinsSession, error) {
+	var gameMode string
+	if sp.GameMode != nil {
+		gameMode = *sp.GameMode
+	}
+
+	var awarded *freespins.FreeSpinsSession
+	err := s.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		fs, err := s.createFreeSpinsSession(txCtx, sp.SessionID, sp.PlayerID, sp.ID, sp.ScatterCount, sp.BetAmount, gameMode)
+		if err != nil {
+			return err
+		}
+		if err := s.spinRepo.UpdateFreeSpinsSessionId(txCtx, sp.ID, fs.ID); err != nil {
+			return fmt.Errorf("failed to link spin to free spins session: %w", err)
+		}
+		awarded = fs
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sp.FreeSpinsSessionID = &awarded.ID
+	return awarded, nil
+}
+
+// ReconcileUnresolvedSpins awards free spins to settled spins that triggered them but were never
+// resolved, e.g. because the process crashed between settling the spin and creating the award.
+// Spins created in [since, before) are checked; it returns how many were resolved.
+// Each award gets its own spin deadline, so one stuck spin can't stall the rest of the batch.
+func (s *SpinService) ReconcileUnresolvedSpins(ctx context.Context, since, before time.Time, limit int) (int, error) {
+	spins, err := s.spinRepo.ListUnresolvedTriggers(ctx, since, before, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	resolved := 0
+	for _, sp := range spins {
+		if ctx.Err() != nil {
+			return resolved, ctx.Err()
+		}
+		awardCtx, cancel := s.withSpinDeadline(ctx)
+		fs, err := s.resolveFreeSpinsTrigger(awardCtx, sp)
+		cancel()
+		if err != nil {
+			// An active free spins session blocks the award until it completes; retry next run
+			s.logger.Warn().Err(err).
+				Str("spin_id", sp.ID.String()).
+				Str("player_id", sp.PlayerID.String()).
+				Msg("Failed to resolve free spins trigger")
+			continue
+		}
+
+		resolved++
+		s.logger.Info().
+			Str("spin_id", sp.ID.String()).
+			Str("player_id", sp.PlayerID.String()).
+			Str("free_spins_session_id", fs.ID.String()).
+			Int("spins_awarded", fs.TotalSpinsAwarded).
+			Msg("Resolved unresolved free spins trigger")
+	}
+	return resolved, nil
+}
+
 // createFreeSpinsSession creates a new free spins session
 func (s *SpinService) createFreeSpinsSession(
 	ctx context.Context,
@@ -383,8 +965,9 @@ func (s *SpinService) createFreeSpinsSession(
 	betAmount float64,
 	gameMode string,
 ) (*freespins.FreeSpinsSession, error) {
-	// Validate scatter count
-	if scatterCount < 3 {
+	// Award by the trigger rules the engine judged the spin with
+	spinsAwarded := s.freeSpinsRules(ctx, playerID).Award(scatterCount)
+	if spinsAwarded <= 0 {
 		return nil, fmt.Errorf("insufficient scatters to trigger free spins")
 	}
 
@@ -394,8 +977,8 @@ func (s *SpinService) createFreeSpinsSession(
 		return nil, freespins.ErrActiveFreeSpinsExists
 	}
 
-	// Calculate free spins awarded (base: 12 for 3 scatters, +2 for each additional)
-	spinsAwarded := 12 + (scatterCount-3)*2
+	spinsAwarded += s.segmentBonusSpins(ctx, playerID)
+	spinsAwarded += s.vipBonusSpins(ctx, playerID)
 
 	// Lookup reel strip config by gameMode (active, default)
 	// If gameMode is empty or config not found, reelStripConfigID will be nil
@@ -426,7 +1009,7 @@ func (s *SpinService) createFreeSpinsSession(
 		IsActive:          true,
 		IsCompleted:       false,
 		ReelStripConfigID: reelStripConfigID,
-		CreatedAt:         time.Now().UTC(),
+		CreatedAt:         s.clock.Now().UTC(),
 		CompletedAt:       nil,
 	}
 
@@ -505,11 +1088,19 @@ func (s *SpinService) ExecuteTrialSpin(ctx context.Context, sessionToken string,
 	}
 
 	// Deduct bet from trial balance in Redis
-	balanceBefore, err := s.trialService.GetTrialBalance(ctx, sessionToken)
+	trialSession, err := s.trialService.ValidateTrialSession(ctx, sessionToken, nil)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get trial balance")
 		return nil, fmt.Errorf("failed to get trial balance: %w", err)
 	}
+	balanceBefore := trialSession.Balance
+
+	// Enforce the game's demo bet limits on regular bets (game mode bets are fixed)
+	if gameMode == "" {
+		if err := s.trialService.CheckBet(ctx, trialSession.GameID, betAmount); err != nil {
+			return nil, err
+		}
+	}
 
 	if balanceBefore < totalDeduction {
 		log.Warn().
@@ -520,8 +1111,12 @@ func (s *SpinService) ExecuteTrialSpin(ctx context.Context, sessionToken string,
 		return nil, player.ErrInsufficientBalance
 	}
 
-	// Execute trial spin using game engine with HUGE RTP
-	engineResult, err := s.gameEngine.ExecuteTrialSpin(ctx, betAmount, gameMode)
+	// Trial rollouts are bucketed by trial session
+	ctx = featureflags.NewContext(ctx, s.trialService.FeatureFlags(ctx, trialSession.ID))
+
+	// Execute trial spin using game engine with HUGE RTP or the game's demo config
+	demoConfigID := s.trialService.DemoConfigID(ctx, trialSession.GameID, false)
+	engineResult, err := s.gameEngine.ExecuteTrialSpin(ctx, betAmount, gameMode, demoConfigID)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to execute trial spin")
 		return nil, fmt.Errorf("failed to execute spin: %w", err)
@@ -579,6 +1174,7 @@ func (s *SpinService) ExecuteTrialSpin(ctx context.Context, sessionToken string,
 	}
 
 	// Build result (similar structure to regular spin, but no DB writes)
+	cascades := convertCascades(engineResult.Cascades)
 	result := &spin.SpinResult{
 		SpinID:                  engineResult.SpinID,
 		SessionID:               trialSessionID, // Use trial session ID
@@ -587,8 +1183,10 @@ func (s *SpinService) ExecuteTrialSpin(ctx context.Context, sessionToken string,
 		BalanceAfterBet:         balanceAfterBet,
 		NewBalance:              newBalance,
 		Grid:                    convertGrid(engineResult.Grid),
-		Cascades:                convertCascades(engineResult.Cascades),
+		Cascades:                cascades,
 		SpinTotalWin:            engineResult.TotalWin,
+		WinTier:                 spin.ClassifyWin(engineResult.WinTiers, engineResult.TotalWin, betAmount),
+		Timing:                  spin.PlanTiming(engineResult.Timing, cascades.Expansions()),
 		ScatterCount:            engineResult.ScatterCount,
 		IsFreeSpin:              false,
 		FreeSpinsTriggered:      engineResult.FreeSpinsTriggered,
@@ -3,16 +3,28 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/autoplay"
+	"github.com/slotmachine/backend/domain/freespins"
+	"github.com/slotmachine/backend/domain/game"
 	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/provablyfair"
 	"github.com/slotmachine/backend/domain/session"
 	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/game/engine"
+	freespinsEngine "github.com/slotmachine/backend/internal/game/freespins"
+	"github.com/slotmachine/backend/internal/infra/repository"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
 )
 
 // ============================================================================
@@ -198,6 +210,233 @@ func TestExecuteSpinValidation(t *testing.T) {
 		mockPlayerRepo.AssertExpectations(t)
 		mockSessionRepo.AssertExpectations(t)
 	})
+
+	t.Run("should enforce the session bet change policy", func(t *testing.T) {
+		for _, tc := range []struct {
+			policy    session.BetChangePolicy
+			betAmount float64
+			want      error
+		}{
+			{session.BetChangeLocked, 20, session.ErrBetLocked},
+			{session.BetChangeLocked, 5, session.ErrBetLocked},
+			{session.BetChangeDecreaseOnly, 20, session.ErrBetIncreaseNotAllowed},
+		} {
+			service, _, mockPlayerRepo, mockSessionRepo, _ := setupSpinServiceForValidation()
+			service.betChanges = tc.policy
+
+			playerID := uuid.New()
+			sessionID := uuid.New()
+			mockPlayerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID, Balance: 10000.0}, nil)
+			mockSessionRepo.On("GetByID", ctx, sessionID).Return(&session.GameSession{ID: sessionID, PlayerID: playerID, BetAmount: 10}, nil)
+
+			result, err := service.ExecuteSpin(ctx, playerID, sessionID, tc.betAmount, "", "", "")
+
+			assert.ErrorIs(t, err, tc.want, "%s with bet %.2f", tc.policy, tc.betAmount)
+			assert.Nil(t, result)
+			mockPlayerRepo.AssertNotCalled(t, "UpdateBalanceWithLockAndTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("should reject autoplay spins without a running autoplay", func(t *testing.T) {
+		service, _, mockPlayerRepo, mockSessionRepo, _ := setupSpinServiceForValidation()
+		autoplays, autoplayRepo, _ := newTestAutoplayService()
+		service.autoplays = autoplays
+
+		playerID := uuid.New()
+		sessionID := uuid.New()
+		mockPlayerRepo.On("GetByID", mock.Anything, playerID).Return(&player.Player{ID: playerID, Balance: 10000.0}, nil)
+		mockSessionRepo.On("GetByID", mock.Anything, sessionID).Return(&session.GameSession{ID: sessionID, PlayerID: playerID, BetAmount: 10}, nil)
+		autoplayRepo.On("GetActiveBySession", mock.Anything, sessionID).Return(nil, autoplay.ErrAutoplayNotFound)
+
+		result, err := service.ExecuteSpin(spin.WithAutoplay(ctx), playerID, sessionID, 10, "", "", "")
+
+		assert.ErrorIs(t, err, autoplay.ErrAutoplayNotActive)
+		assert.Nil(t, result)
+		mockPlayerRepo.AssertNotCalled(t, "UpdateBalanceWithLockAndTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should give up when the player's balance lock stays busy", func(t *testing.T) {
+		service, _, mockPlayerRepo, _, _ := setupSpinServiceForValidation()
+		service.balanceLocks = busyLocker{}
+
+		result, err := service.ExecuteSpin(ctx, uuid.New(), uuid.New(), 10, "", "", "")
+
+		assert.ErrorIs(t, err, player.ErrBalanceBusy)
+		assert.Nil(t, result)
+		mockPlayerRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSpinService_LockBalance(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+
+	t.Run("should report a lock wait that runs out as busy", func(t *testing.T) {
+		service, _, mockPlayerRepo, _, _ := setupSpinServiceForValidation()
+		service.lockWait = 10 * time.Millisecond
+		mockPlayerRepo.On("LockBalanceWithTx", mock.Anything, playerID).
+			Run(func(args mock.Arguments) { <-args.Get(0).(context.Context).Done() }).
+			Return(context.DeadlineExceeded)
+
+		assert.ErrorIs(t, service.lockBalance(ctx, playerID), player.ErrBalanceBusy)
+	})
+
+	t.Run("should pass other lock failures through", func(t *testing.T) {
+		service, _, mockPlayerRepo, _, _ := setupSpinServiceForValidation()
+		service.lockWait = time.Second
+		failure := errors.New("connection reset")
+		mockPlayerRepo.On("LockBalanceWithTx", mock.Anything, playerID).Return(failure)
+
+		assert.ErrorIs(t, service.lockBalance(ctx, playerID), failure)
+	})
+}
+
+func TestSpinService_ExecuteSpinDeadline(t *testing.T) {
+	playerID := uuid.New()
+	sessionID := uuid.New()
+	stuck := func(args mock.Arguments) { <-args.Get(0).(context.Context).Done() }
+
+	t.Run("should fail a spin stuck past its deadline with a timeout", func(t *testing.T) {
+		service, _, mockPlayerRepo, _, _ := setupSpinServiceForValidation()
+		service.timeout = 10 * time.Millisecond
+		mockPlayerRepo.On("GetByID", mock.Anything, playerID).Run(stuck).Return(nil, context.DeadlineExceeded)
+
+		result, err := service.ExecuteSpin(context.Background(), playerID, sessionID, 10, "", "", "")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, spin.ErrSpinTimeout)
+	})
+
+	t.Run("should not report a request the client cancelled as a timeout", func(t *testing.T) {
+		service, _, mockPlayerRepo, _, _ := setupSpinServiceForValidation()
+		service.timeout = time.Second
+		ctx, cancel := context.WithCancel(context.Background())
+		mockPlayerRepo.On("GetByID", mock.Anything, playerID).
+			Run(func(args mock.Arguments) { cancel(); stuck(args) }).
+			Return(nil, context.Canceled)
+
+		_, err := service.ExecuteSpin(ctx, playerID, sessionID, 10, "", "", "")
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, spin.ErrSpinTimeout)
+	})
+}
+
+// nonceClaimRepo claims client nonces as the conditional update on pf_sessions does
+type nonceClaimRepo struct {
+	provablyfair.Repository
+	claimed map[uuid.UUID]int64
+}
+
+func (r *nonceClaimRepo) ClaimClientNonce(ctx context.Context, id uuid.UUID, nonce int64) error {
+	if r.claimed[id] >= nonce {
+		return fmt.Errorf("%w: nonce %d was already played", provablyfair.ErrClientNonceMismatch, nonce)
+	}
+	r.claimed[id] = nonce
+	return nil
+}
+
+func TestSpinService_ClaimClientNonce(t *testing.T) {
+	ctx := context.Background()
+	gameSessionID := uuid.New()
+	state := &provablyfair.PFSessionState{SessionID: uuid.New(), GameSessionID: gameSessionID, Nonce: 4}
+	newService := func(required bool) (*SpinService, *nonceClaimRepo) {
+		repo := &nonceClaimRepo{claimed: map[uuid.UUID]int64{}}
+		pfService := &ProvablyFairService{repo: repo, cache: &stubPFStateCache{state: state}, logger: logger.New("error", "json")}
+		return &SpinService{pfService: pfService, nonceRequired: required}, repo
+	}
+
+	t.Run("should accept and claim the session's next nonce", func(t *testing.T) {
+		service, repo := newService(true)
+
+		assert.NoError(t, service.claimClientNonce(spin.WithClientNonce(ctx, 5), gameSessionID))
+		assert.Equal(t, int64(5), repo.claimed[state.SessionID])
+	})
+
+	t.Run("should reject a replayed or skipped nonce", func(t *testing.T) {
+		service, _ := newService(false)
+		for _, nonce := range []int64{3, 4, 6} {
+			err := service.claimClientNonce(spin.WithClientNonce(ctx, nonce), gameSessionID)
+			assert.ErrorIs(t, err, provablyfair.ErrClientNonceMismatch, "nonce %d", nonce)
+		}
+	})
+
+	t.Run("should reject a replay that raced past the session state", func(t *testing.T) {
+		service, _ := newService(false)
+		require.NoError(t, service.claimClientNonce(spin.WithClientNonce(ctx, 5), gameSessionID))
+
+		// The session state still names nonce 5, as it does for a request checked before the first committed
+		err := service.claimClientNonce(spin.WithClientNonce(ctx, 5), gameSessionID)
+		assert.ErrorIs(t, err, provablyfair.ErrClientNonceMismatch)
+	})
+
+	t.Run("should only require a nonce when configured to", func(t *testing.T) {
+		optional, repo := newService(false)
+		assert.NoError(t, optional.claimClientNonce(ctx, gameSessionID))
+		assert.Empty(t, repo.claimed, "nothing is claimed without a nonce")
+
+		required, _ := newService(true)
+		assert.ErrorIs(t, required.claimClientNonce(ctx, gameSessionID), provablyfair.ErrClientNonceRequired)
+	})
+}
+
+// busyLocker is a player.Locker whose lock is always held by someone else
+type busyLocker struct{}
+
+func (busyLocker) Lock(ctx context.Context, playerID uuid.UUID) (func(), error) {
+	return nil, player.ErrBalanceBusy
+}
+
+func TestRetryBalanceConflicts(t *testing.T) {
+	ctx := context.Background()
+	conflict := fmt.Errorf("failed to deduct bet: %w", player.ErrNotFoundOrLockChanged)
+
+	t.Run("should replay a lost lock race until it succeeds", func(t *testing.T) {
+		var attempts []int
+		result, err := retryBalanceConflicts(ctx, 3, func(attempt int) (string, error) {
+			attempts = append(attempts, attempt)
+			if attempt < 2 {
+				return "", conflict
+			}
+			return "settled", nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "settled", result)
+		assert.Equal(t, []int{0, 1, 2}, attempts)
+	})
+
+	t.Run("should stop after the configured retries", func(t *testing.T) {
+		calls := 0
+		_, err := retryBalanceConflicts(ctx, 2, func(attempt int) (string, error) {
+			calls++
+			return "", conflict
+		})
+
+		assert.ErrorIs(t, err, player.ErrNotFoundOrLockChanged)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("should not replay other errors", func(t *testing.T) {
+		calls := 0
+		_, err := retryBalanceConflicts(ctx, 3, func(attempt int) (string, error) {
+			calls++
+			return "", player.ErrInsufficientBalance
+		})
+
+		assert.ErrorIs(t, err, player.ErrInsufficientBalance)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should stop waiting when the request is cancelled", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := retryBalanceConflicts(cancelled, 3, func(attempt int) (string, error) {
+			return "", conflict
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
 }
 
 // ============================================================================
@@ -421,3 +660,167 @@ func TestGetSpinHistory(t *testing.T) {
 		mockSpinRepo.AssertExpectations(t)
 	})
 }
+
+// ============================================================================
+// Pending spin TESTS
+// ============================================================================
+
+// MockPFSpinLogRepository mocks the spin log lookup of provablyfair.Repository
+type MockPFSpinLogRepository struct {
+	provablyfair.Repository
+	mock.Mock
+}
+
+func (m *MockPFSpinLogRepository) GetSpinLogBySpinID(ctx context.Context, spinID uuid.UUID) (*provablyfair.SpinLog, error) {
+	args := m.Called(ctx, spinID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*provablyfair.SpinLog), args.Error(1)
+}
+
+func TestGetPendingSpin(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	sessionID := uuid.New()
+	ackedAt := time.Now().UTC().Add(-time.Minute)
+
+	t.Run("should rebuild the unacknowledged spin", func(t *testing.T) {
+		service, mockSpinRepo, _, mockSessionRepo, _ := setupSpinServiceForValidation()
+		pfRepo := new(MockPFSpinLogRepository)
+		service.pfService = &ProvablyFairService{repo: pfRepo, logger: service.logger}
+		service.gameEngine = engine.NewGameEngine(nil, nil, false)
+
+		gameMode := "bonus_spin_trigger"
+		cost := 1000.0
+		pending := &spin.Spin{
+			ID:            uuid.New(),
+			SessionID:     sessionID,
+			PlayerID:      playerID,
+			BetAmount:     10,
+			BalanceBefore: 5000,
+			BalanceAfter:  4250,
+			TotalWin:      250,
+			GameMode:      &gameMode,
+			GameModeCost:  &cost,
+			CreatedAt:     time.Now().UTC(),
+		}
+
+		mockSessionRepo.On("GetByID", ctx, sessionID).Return(&session.GameSession{ID: sessionID, PlayerID: playerID, SpinAckedAt: &ackedAt}, nil)
+		mockSpinRepo.On("GetLatestBySession", ctx, sessionID, &ackedAt).Return(pending, nil)
+		pfRepo.On("GetSpinLogBySpinID", ctx, pending.ID).Return(&provablyfair.SpinLog{SpinIndex: 3, Nonce: 4, SpinHash: "hash", PrevSpinHash: "prev"}, nil)
+
+		result, err := service.GetPendingSpin(ctx, playerID, sessionID)
+
+		require.NoError(t, err)
+		assert.Equal(t, pending.ID, result.SpinID)
+		assert.Equal(t, 4000.0, result.BalanceAfterBet, "game mode spins deduct the mode cost")
+		assert.Equal(t, 4250.0, result.NewBalance)
+		assert.Equal(t, gameMode, result.GameMode)
+		assert.Equal(t, cost, result.GameModeCost)
+		require.NotNil(t, result.ProvablyFair)
+		assert.Equal(t, int64(4), result.ProvablyFair.Nonce)
+		assert.Equal(t, "hash", result.ProvablyFair.SpinHash)
+		require.NotNil(t, result.WinTier)
+		assert.Equal(t, "big", result.WinTier.Name, "a 25x win is classified with the default tiers")
+		assert.Equal(t, 25.0, result.WinTier.Multiplier)
+		require.NotNil(t, result.Timing)
+		assert.Equal(t, game.DefaultTimingProfiles.Turbo.ReelSpinMs, result.Timing.Turbo.TotalMs, "a spin without cascades only spins the reels")
+	})
+
+	t.Run("should report no pending spin once everything is acknowledged", func(t *testing.T) {
+		service, mockSpinRepo, _, mockSessionRepo, _ := setupSpinServiceForValidation()
+
+		mockSessionRepo.On("GetByID", ctx, sessionID).Return(&session.GameSession{ID: sessionID, PlayerID: playerID, SpinAckedAt: &ackedAt}, nil)
+		mockSpinRepo.On("GetLatestBySession", ctx, sessionID, &ackedAt).Return(nil, spin.ErrSpinNotFound)
+
+		_, err := service.GetPendingSpin(ctx, playerID, sessionID)
+
+		assert.Equal(t, spin.ErrNoPendingSpin, err)
+	})
+
+	t.Run("should hide other players' sessions", func(t *testing.T) {
+		service, _, _, mockSessionRepo, _ := setupSpinServiceForValidation()
+
+		mockSessionRepo.On("GetByID", ctx, sessionID).Return(&session.GameSession{ID: sessionID, PlayerID: uuid.New()}, nil)
+
+		_, err := service.GetPendingSpin(ctx, playerID, sessionID)
+
+		assert.Equal(t, session.ErrSessionNotFound, err)
+	})
+}
+
+func TestAcknowledgeSpin(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	sessionID := uuid.New()
+
+	t.Run("should advance the acknowledgement mark to the spin", func(t *testing.T) {
+		service, mockSpinRepo, _, mockSessionRepo, _ := setupSpinServiceForValidation()
+
+		sp := &spin.Spin{ID: uuid.New(), SessionID: sessionID, PlayerID: playerID, CreatedAt: time.Now().UTC()}
+		mockSessionRepo.On("GetByID", ctx, sessionID).Return(&session.GameSession{ID: sessionID, PlayerID: playerID}, nil)
+		mockSpinRepo.On("GetByID", ctx, sp.ID).Return(sp, nil)
+		mockSessionRepo.On("MarkSpinAcked", ctx, sessionID, sp.CreatedAt).Return(nil)
+
+		require.NoError(t, service.AcknowledgeSpin(ctx, playerID, sessionID, sp.ID))
+		mockSessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("should reject a spin from another session", func(t *testing.T) {
+		service, mockSpinRepo, _, mockSessionRepo, _ := setupSpinServiceForValidation()
+
+		sp := &spin.Spin{ID: uuid.New(), SessionID: uuid.New(), PlayerID: playerID}
+		mockSessionRepo.On("GetByID", ctx, sessionID).Return(&session.GameSession{ID: sessionID, PlayerID: playerID}, nil)
+		mockSpinRepo.On("GetByID", ctx, sp.ID).Return(sp, nil)
+
+		assert.Equal(t, spin.ErrSpinNotFound, service.AcknowledgeSpin(ctx, playerID, sessionID, sp.ID))
+		mockSessionRepo.AssertNotCalled(t, "MarkSpinAcked")
+	})
+}
+
+// fixedGameRules plays every player with the same rules
+type fixedGameRules engine.GameRules
+
+func (r fixedGameRules) RulesForPlayer(ctx context.Context, playerID uuid.UUID) engine.GameRules {
+	return engine.GameRules(r)
+}
+
+func TestReconcileUnresolvedSpins_TriggerRules(t *testing.T) {
+	ctx := context.Background()
+	since := time.Now().UTC().Add(-time.Hour)
+	before := time.Now().UTC()
+
+	service, mockSpinRepo, _, _, mockFreeSpinsRepo := setupSpinServiceForValidation()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: gormlogger.Default.LogMode(gormlogger.Silent)})
+	require.NoError(t, err)
+	service.txManager = repository.NewTxManager(db)
+
+	// Two scatters trigger 5 spins, each extra scatter adds 3
+	rules := engine.DefaultGameRules
+	rules.FreeSpins = freespinsEngine.TriggerRules{MinScatters: 2, BaseAward: 5, ExtraPerScatter: 3, Retrigger: true}
+	gameEngine := engine.NewGameEngine(nil, nil, false)
+	gameEngine.SetRulesResolver(fixedGameRules(rules))
+	service.gameEngine = gameEngine
+
+	twoScatters := &spin.Spin{ID: uuid.New(), SessionID: uuid.New(), PlayerID: uuid.New(), BetAmount: 10, ScatterCount: 2, FreeSpinsTriggered: true}
+	fourScatters := &spin.Spin{ID: uuid.New(), SessionID: uuid.New(), PlayerID: uuid.New(), BetAmount: 10, ScatterCount: 4, FreeSpinsTriggered: true}
+	mockSpinRepo.On("ListUnresolvedTriggers", ctx, since, before, 10).Return([]*spin.Spin{twoScatters, fourScatters}, nil)
+	for _, sp := range []*spin.Spin{twoScatters, fourScatters} {
+		mockFreeSpinsRepo.On("GetActiveByPlayer", mock.Anything, sp.PlayerID).Return(nil, freespins.ErrFreeSpinsNotFound)
+		mockSpinRepo.On("UpdateFreeSpinsSessionId", mock.Anything, sp.ID, mock.Anything).Return(nil)
+	}
+	awarded := make(map[uuid.UUID]int)
+	mockFreeSpinsRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		fs := args.Get(1).(*freespins.FreeSpinsSession)
+		awarded[fs.PlayerID] = fs.TotalSpinsAwarded
+	}).Return(nil)
+
+	resolved, err := service.ReconcileUnresolvedSpins(ctx, since, before, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, resolved, "a trigger below the default 3 scatters is awarded under the config's rules")
+	assert.Equal(t, 5, awarded[twoScatters.PlayerID])
+	assert.Equal(t, 11, awarded[fourScatters.PlayerID])
+	require.NotNil(t, twoScatters.FreeSpinsSessionID)
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/domain/spinfeed"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// SpinFeedService implements spinfeed.Service
+type SpinFeedService struct {
+	store  spinfeed.Store
+	size   int
+	logger *logger.Logger
+}
+
+// NewSpinFeedService creates a new live spin feed service
+func NewSpinFeedService(store spinfeed.Store, cfg *config.Config, log *logger.Logger) *SpinFeedService {
+	return &SpinFeedService{
+		store:  store,
+		size:   cfg.SpinFeed.Size,
+		logger: log,
+	}
+}
+
+// Publish adds the spin to the feed; it is a no-op when the feed is disabled
+func (s *SpinFeedService) Publish(ctx context.Context, sp *spin.Spin, username string) error {
+	if s.size <= 0 {
+		return nil
+	}
+	return s.store.Push(ctx, spinfeed.NewEntry(sp, username), s.size)
+}
+
+// Recent returns up to limit of the most recent spins, capped at the feed size
+func (s *SpinFeedService) Recent(ctx context.Context, limit int) ([]*spinfeed.Entry, error) {
+	if s.size <= 0 {
+		return []*spinfeed.Entry{}, nil
+	}
+	if limit < 1 || limit > s.size {
+		limit = s.size
+	}
+	return s.store.Recent(ctx, limit)
+}
+
+// Subscribe streams spins as they are published until ctx is cancelled
+func (s *SpinFeedService) Subscribe(ctx context.Context) (<-chan *spinfeed.Entry, error) {
+	return s.store.Subscribe(ctx)
+}
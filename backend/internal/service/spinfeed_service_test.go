@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/internal/config"
+	infraCache "github.com/slotmachine/backend/internal/infra/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupSpinFeedService uses the in-memory fallback of the spin feed store
+func setupSpinFeedService(size int) *SpinFeedService {
+	log := logger.New("error", "json")
+	cfg := &config.Config{SpinFeed: config.SpinFeedConfig{Size: size}}
+	return NewSpinFeedService(infraCache.NewSpinFeedStore(nil, log), cfg, log)
+}
+
+func TestSpinFeedService_Recent(t *testing.T) {
+	ctx := context.Background()
+	svc := setupSpinFeedService(3)
+
+	var ids []uuid.UUID
+	for i := 0; i < 5; i++ {
+		sp := &spin.Spin{ID: uuid.New(), BetAmount: 1, TotalWin: float64(i), Cascades: make(spin.Cascades, i)}
+		ids = append(ids, sp.ID)
+		require.NoError(t, svc.Publish(ctx, sp, "highroller"))
+	}
+
+	entries, err := svc.Recent(ctx, 100)
+	require.NoError(t, err)
+	require.Len(t, entries, 3, "feed is capped at its size")
+	assert.Equal(t, ids[4], entries[0].SpinID, "newest first")
+	assert.Equal(t, 4, entries[0].Cascades)
+	assert.Equal(t, "h********r", entries[0].Player)
+
+	entries, err = svc.Recent(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestSpinFeedService_Subscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	svc := setupSpinFeedService(10)
+
+	stream, err := svc.Subscribe(ctx)
+	require.NoError(t, err)
+
+	sp := &spin.Spin{ID: uuid.New(), BetAmount: 2, IsFreeSpin: true}
+	require.NoError(t, svc.Publish(context.Background(), sp, "abc"))
+
+	select {
+	case entry := <-stream:
+		assert.Equal(t, sp.ID, entry.SpinID)
+		assert.True(t, entry.IsFreeSpin)
+		assert.Equal(t, "***", entry.Player)
+	case <-time.After(time.Second):
+		t.Fatal("expected a feed entry")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-stream:
+		assert.False(t, ok, "stream closes when the subscriber goes away")
+	case <-time.After(time.Second):
+		t.Fatal("expected the stream to close")
+	}
+}
+
+func TestSpinFeedService_Disabled(t *testing.T) {
+	ctx := context.Background()
+	svc := setupSpinFeedService(0)
+
+	require.NoError(t, svc.Publish(ctx, &spin.Spin{ID: uuid.New()}, "player"))
+	entries, err := svc.Recent(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
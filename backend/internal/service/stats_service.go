@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/domain/stats"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// StatsService implements stats.Service
+type StatsService struct {
+	repo   stats.Repository
+	logger *logger.Logger
+}
+
+// NewStatsService creates a new player stats service
+func NewStatsService(repo stats.Repository, log *logger.Logger) *StatsService {
+	return &StatsService{
+		repo:   repo,
+		logger: log,
+	}
+}
+
+// RecordSpin applies a recorded spin to the player's daily and lifetime rollups
+func (s *StatsService) RecordSpin(ctx context.Context, sp *spin.Spin) error {
+	return s.repo.Record(ctx, stats.DeltaFromSpin(sp))
+}
+
+// GetLifetime returns the player's lifetime rollup
+func (s *StatsService) GetLifetime(ctx context.Context, playerID uuid.UUID) (*stats.LifetimeStats, error) {
+	return s.repo.GetLifetime(ctx, playerID)
+}
+
+// GetDaily returns the player's daily rollups for the inclusive UTC day range
+func (s *StatsService) GetDaily(ctx context.Context, playerID uuid.UUID, from, to time.Time) ([]*stats.DailyStats, error) {
+	from, to = stats.Day(from), stats.Day(to)
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w: from must not be after to", stats.ErrInvalidRange)
+	}
+	if days := int(to.Sub(from).Hours()/24) + 1; days > stats.MaxDailyRangeDays {
+		return nil, fmt.Errorf("%w: at most %d days can be requested", stats.ErrInvalidRange, stats.MaxDailyRangeDays)
+	}
+	return s.repo.ListDaily(ctx, playerID, from, to)
+}
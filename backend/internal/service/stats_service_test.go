@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/domain/stats"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockStatsRepository is a mock implementation of stats.Repository
+type MockStatsRepository struct {
+	mock.Mock
+}
+
+func (m *MockStatsRepository) Record(ctx context.Context, delta *stats.Delta) error {
+	return m.Called(ctx, delta).Error(0)
+}
+
+func (m *MockStatsRepository) GetLifetime(ctx context.Context, playerID uuid.UUID) (*stats.LifetimeStats, error) {
+	args := m.Called(ctx, playerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*stats.LifetimeStats), args.Error(1)
+}
+
+func (m *MockStatsRepository) ListDaily(ctx context.Context, playerID uuid.UUID, from, to time.Time) ([]*stats.DailyStats, error) {
+	args := m.Called(ctx, playerID, from, to)
+	return args.Get(0).([]*stats.DailyStats), args.Error(1)
+}
+
+func TestStatsService_RecordSpin(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	at := time.Date(2026, 3, 1, 23, 59, 0, 0, time.UTC)
+
+	t.Run("should count paid spins as wagered", func(t *testing.T) {
+		repo := new(MockStatsRepository)
+		svc := NewStatsService(repo, logger.New("error", "json"))
+		repo.On("Record", ctx, &stats.Delta{
+			PlayerID: playerID,
+			At:       at,
+			Totals:   stats.Totals{Spins: 1, FreeSpinsTriggered: 1, Wagered: 10, Won: 30, BiggestWin: 30},
+		}).Return(nil)
+
+		err := svc.RecordSpin(ctx, &spin.Spin{PlayerID: playerID, BetAmount: 10, TotalWin: 30, FreeSpinsTriggered: true, CreatedAt: at})
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("should count free spins separately", func(t *testing.T) {
+		repo := new(MockStatsRepository)
+		svc := NewStatsService(repo, logger.New("error", "json"))
+		repo.On("Record", ctx, &stats.Delta{
+			PlayerID: playerID,
+			At:       at,
+			Totals:   stats.Totals{FreeSpins: 1, Won: 12, BiggestWin: 12},
+		}).Return(nil)
+
+		err := svc.RecordSpin(ctx, &spin.Spin{PlayerID: playerID, BetAmount: 10, TotalWin: 12, IsFreeSpin: true, CreatedAt: at})
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestStatsService_GetDaily(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	repo := new(MockStatsRepository)
+	svc := NewStatsService(repo, logger.New("error", "json"))
+
+	t.Run("should truncate the range to UTC days", func(t *testing.T) {
+		from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+		repo.On("ListDaily", ctx, playerID, from, to).Return([]*stats.DailyStats{}, nil).Once()
+
+		_, err := svc.GetDaily(ctx, playerID, from.Add(15*time.Hour), to.Add(3*time.Hour))
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("should reject inverted and oversized ranges", func(t *testing.T) {
+		from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+		_, err := svc.GetDaily(ctx, playerID, from, from.AddDate(0, 0, -1))
+		assert.ErrorIs(t, err, stats.ErrInvalidRange)
+
+		_, err = svc.GetDaily(ctx, playerID, from, from.AddDate(0, 0, stats.MaxDailyRangeDays))
+		assert.ErrorIs(t, err, stats.ErrInvalidRange)
+	})
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/timeline"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+const (
+	defaultTimelineLimit = 50
+	maxTimelineLimit     = 200
+)
+
+// TimelineService implements timeline.Service
+type TimelineService struct {
+	repo       timeline.Repository
+	playerRepo player.Repository
+	logger     *logger.Logger
+}
+
+// NewTimelineService creates a new timeline service
+func NewTimelineService(
+	repo timeline.Repository,
+	playerRepo player.Repository,
+	log *logger.Logger,
+) *TimelineService {
+	return &TimelineService{
+		repo:       repo,
+		playerRepo: playerRepo,
+		logger:     log,
+	}
+}
+
+// GetPlayerTimeline returns the page of a player's timeline after cursor (empty for the newest)
+func (s *TimelineService) GetPlayerTimeline(ctx context.Context, playerID uuid.UUID, cursor string, limit int) (*timeline.Page, error) {
+	var before *timeline.Cursor
+	if cursor != "" {
+		c, err := timeline.DecodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		before = c
+	}
+	if limit <= 0 {
+		limit = defaultTimelineLimit
+	}
+	if limit > maxTimelineLimit {
+		limit = maxTimelineLimit
+	}
+
+	if _, err := s.playerRepo.GetByID(ctx, playerID); err != nil {
+		return nil, err
+	}
+
+	// One extra event tells whether a next page exists
+	events, err := s.repo.ListEvents(ctx, playerID, before, limit+1)
+	if err != nil {
+		s.logger.WithTraceContext(ctx).Error().Err(err).Str("player_id", playerID.String()).Msg("Failed to list timeline events")
+		return nil, err
+	}
+
+	page := &timeline.Page{Events: events}
+	if len(events) > limit {
+		page.Events = events[:limit]
+		page.NextCursor = events[limit-1].Key().Encode()
+	}
+	if page.Events == nil {
+		page.Events = []timeline.Event{}
+	}
+	return page, nil
+}
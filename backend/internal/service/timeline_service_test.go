@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/timeline"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTimelineRepository is a mock implementation of timeline.Repository
+type MockTimelineRepository struct {
+	mock.Mock
+}
+
+func (m *MockTimelineRepository) ListEvents(ctx context.Context, playerID uuid.UUID, before *timeline.Cursor, limit int) ([]timeline.Event, error) {
+	args := m.Called(ctx, playerID, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]timeline.Event), args.Error(1)
+}
+
+func TestTimelineService_GetPlayerTimeline(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+	at := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	events := []timeline.Event{
+		{At: at.Add(2 * time.Minute), Kind: timeline.KindSpin, ID: uuid.New()},
+		{At: at.Add(time.Minute), Kind: timeline.KindSpin, ID: uuid.New()},
+		{At: at, Kind: timeline.KindSessionStarted, ID: uuid.New()},
+	}
+
+	newService := func() (*TimelineService, *MockTimelineRepository, *MockPlayerRepository) {
+		repo := new(MockTimelineRepository)
+		playerRepo := new(MockPlayerRepository)
+		return NewTimelineService(repo, playerRepo, logger.New("error", "json")), repo, playerRepo
+	}
+
+	t.Run("should return a cursor at the last event when more remain", func(t *testing.T) {
+		svc, repo, playerRepo := newService()
+		playerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID}, nil)
+		repo.On("ListEvents", ctx, playerID, (*timeline.Cursor)(nil), 3).Return(events, nil)
+
+		page, err := svc.GetPlayerTimeline(ctx, playerID, "", 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, events[:2], page.Events)
+		cursor, err := timeline.DecodeCursor(page.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, events[1].Key().ID, cursor.ID)
+		assert.True(t, events[1].At.Equal(cursor.At))
+	})
+
+	t.Run("should resume after a cursor and end on the last page", func(t *testing.T) {
+		svc, repo, playerRepo := newService()
+		key := events[1].Key()
+		playerRepo.On("GetByID", ctx, playerID).Return(&player.Player{ID: playerID}, nil)
+		repo.On("ListEvents", ctx, playerID, mock.MatchedBy(func(c *timeline.Cursor) bool {
+			return c != nil && c.ID == key.ID && c.Kind == key.Kind && c.At.Equal(key.At)
+		}), 3).Return(events[2:], nil)
+
+		page, err := svc.GetPlayerTimeline(ctx, playerID, key.Encode(), 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, events[2:], page.Events)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("should reject a malformed cursor", func(t *testing.T) {
+		svc, _, _ := newService()
+
+		_, err := svc.GetPlayerTimeline(ctx, playerID, "garbage", 10)
+
+		assert.ErrorIs(t, err, timeline.ErrInvalidCursor)
+	})
+
+	t.Run("should return not found for an unknown player", func(t *testing.T) {
+		svc, _, playerRepo := newService()
+		playerRepo.On("GetByID", ctx, playerID).Return(nil, player.ErrPlayerNotFound)
+
+		_, err := svc.GetPlayerTimeline(ctx, playerID, "", 10)
+
+		assert.ErrorIs(t, err, player.ErrPlayerNotFound)
+	})
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slotmachine/backend/domain/transparency"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// TransparencyPublisher periodically checkpoints new spin hashes and anchors pending checkpoints
+type TransparencyPublisher struct {
+	transparency transparency.Service
+	interval     time.Duration
+	logger       *logger.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewTransparencyPublisher creates a new transparency publisher
+func NewTransparencyPublisher(cfg *config.Config, svc transparency.Service, log *logger.Logger) *TransparencyPublisher {
+	return &TransparencyPublisher{
+		transparency: svc,
+		interval:     time.Duration(cfg.Transparency.IntervalSeconds) * time.Second,
+		logger:       log,
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+}
+
+// Start runs the publisher in the background; a zero interval disables it
+func (p *TransparencyPublisher) Start() {
+	if p.interval <= 0 {
+		close(p.done)
+		p.logger.Info().Msg("Transparency publisher disabled")
+		return
+	}
+
+	go p.run()
+	p.logger.Info().Dur("interval", p.interval).Msg("Transparency publisher started")
+}
+
+// Stop stops the publisher and waits for a running pass to finish
+func (p *TransparencyPublisher) Stop() {
+	p.stopOnce.Do(func() { close(p.stop) })
+	<-p.done
+}
+
+func (p *TransparencyPublisher) run() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case now := <-ticker.C:
+			p.Run(context.Background(), now)
+		}
+	}
+}
+
+// Run publishes checkpoints for settled spin logs and anchors pending ones
+func (p *TransparencyPublisher) Run(ctx context.Context, now time.Time) {
+	if _, err := p.transparency.Publish(ctx, now); err != nil {
+		p.logger.Error().Err(err).Msg("Transparency checkpoint pass failed")
+	}
+	anchored, err := p.transparency.AnchorPending(ctx)
+	if err != nil {
+		p.logger.Warn().Err(err).Int("anchored", anchored).Msg("Transparency anchoring pass failed")
+	} else if anchored > 0 {
+		p.logger.Info().Int("anchored", anchored).Msg("Anchored transparency checkpoints")
+	}
+}
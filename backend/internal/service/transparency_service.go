@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/transparency"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// anchorBatchSize bounds how many checkpoints one pass submits to the anchor
+const anchorBatchSize = 100
+
+// TransparencyService implements transparency.Service: settled spin logs are grouped into
+// hash-chained checkpoints of their Merkle root, optionally anchored externally
+type TransparencyService struct {
+	repo      transparency.Repository
+	anchor    transparency.Anchor
+	batchSize int
+	settle    time.Duration
+	logger    *logger.Logger
+}
+
+// NewTransparencyService creates a new transparency service; anchor may be nil
+func NewTransparencyService(
+	cfg *config.Config,
+	repo transparency.Repository,
+	anchor transparency.Anchor,
+	log *logger.Logger,
+) transparency.Service {
+	batchSize := cfg.Transparency.BatchSize
+	if batchSize <= 0 {
+		batchSize = 10000
+	}
+	return &TransparencyService{
+		repo:      repo,
+		anchor:    anchor,
+		batchSize: batchSize,
+		settle:    time.Duration(cfg.Transparency.SettleSeconds) * time.Second,
+		logger:    log,
+	}
+}
+
+// Publish appends checkpoints until every spin log older than the settle window is covered
+func (s *TransparencyService) Publish(ctx context.Context, now time.Time) ([]*transparency.Checkpoint, error) {
+	until := now.UTC().Add(-s.settle)
+
+	prev, err := s.repo.GetLatest(ctx)
+	if err != nil && !errors.Is(err, transparency.ErrCheckpointNotFound) {
+		return nil, err
+	}
+
+	var created []*transparency.Checkpoint
+	for {
+		var (
+			afterAt  time.Time
+			afterID  uuid.UUID
+			sequence int64 = 1
+			prevHash       = transparency.GenesisHash
+		)
+		if prev != nil {
+			afterAt, afterID = prev.ToCreatedAt, prev.LastSpinLogID
+			sequence, prevHash = prev.Sequence+1, prev.Hash
+		}
+
+		leaves, err := s.repo.ListLeavesAfter(ctx, afterAt, afterID, until, s.batchSize)
+		if err != nil {
+			return created, err
+		}
+		if len(leaves) == 0 {
+			return created, nil
+		}
+
+		hashes := make([]string, len(leaves))
+		for i, l := range leaves {
+			hashes[i] = l.SpinHash
+		}
+		first, last := leaves[0], leaves[len(leaves)-1]
+		cp := &transparency.Checkpoint{
+			ID:             uuid.New(),
+			Sequence:       sequence,
+			FirstSpinLogID: first.SpinLogID,
+			LastSpinLogID:  last.SpinLogID,
+			FromCreatedAt:  first.CreatedAt,
+			ToCreatedAt:    last.CreatedAt,
+			LeafCount:      len(leaves),
+			MerkleRoot:     transparency.MerkleRoot(hashes),
+			PrevHash:       prevHash,
+			CreatedAt:      now.UTC(),
+		}
+		cp.Hash = cp.ComputeHash()
+
+		if err := s.repo.Create(ctx, cp); err != nil {
+			return created, err
+		}
+		s.logger.Info().
+			Int64("sequence", cp.Sequence).
+			Int("leaves", cp.LeafCount).
+			Str("merkle_root", cp.MerkleRoot).
+			Msg("Transparency checkpoint published")
+
+		created = append(created, cp)
+		prev = cp
+		if len(leaves) < s.batchSize {
+			return created, nil
+		}
+	}
+}
+
+// AnchorPending submits unanchored checkpoints in sequence order, stopping at the first failure
+func (s *TransparencyService) AnchorPending(ctx context.Context) (int, error) {
+	if s.anchor == nil {
+		return 0, nil
+	}
+
+	pending, err := s.repo.ListUnanchored(ctx, anchorBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	anchored := 0
+	for _, cp := range pending {
+		ref, err := s.anchor.Anchor(ctx, cp)
+		if err != nil {
+			return anchored, fmt.Errorf("failed to anchor checkpoint %d: %w", cp.Sequence, err)
+		}
+		if err := s.repo.SetAnchor(ctx, cp.ID, s.anchor.Name(), ref, time.Now().UTC()); err != nil {
+			return anchored, err
+		}
+		anchored++
+	}
+	return anchored, nil
+}
+
+// List lists checkpoints newest first
+func (s *TransparencyService) List(ctx context.Context, limit, offset int) ([]*transparency.Checkpoint, int64, error) {
+	return s.repo.List(ctx, limit, offset)
+}
+
+// Get returns a checkpoint and the spin logs it covers
+func (s *TransparencyService) Get(ctx context.Context, sequence int64) (*transparency.Checkpoint, []transparency.Leaf, error) {
+	cp, err := s.repo.GetBySequence(ctx, sequence)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	leaves, err := s.repo.ListLeaves(ctx, cp)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(leaves) == 0 && cp.LeafCount > 0 {
+		return nil, nil, transparency.ErrLeavesUnavailable
+	}
+	return cp, leaves, nil
+}
+
+// Prove builds the inclusion proof of a spin after checking its checkpoint still matches the stored spin logs
+func (s *TransparencyService) Prove(ctx context.Context, spinID uuid.UUID) (*transparency.InclusionProof, error) {
+	leaf, err := s.repo.GetLeafBySpin(ctx, spinID)
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := s.repo.GetCovering(ctx, leaf.CreatedAt, leaf.SpinLogID)
+	if err != nil {
+		return nil, err
+	}
+
+	leaves, err := s.repo.ListLeaves(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+	if len(leaves) == 0 {
+		return nil, transparency.ErrLeavesUnavailable
+	}
+
+	index := -1
+	hashes := make([]string, len(leaves))
+	for i, l := range leaves {
+		hashes[i] = l.SpinHash
+		if l.SpinLogID == leaf.SpinLogID {
+			index = i
+		}
+	}
+	if index < 0 {
+		// Committed after the checkpoint's cutoff with an older timestamp
+		return nil, transparency.ErrSpinNotCheckpointed
+	}
+
+	if len(leaves) != cp.LeafCount || transparency.MerkleRoot(hashes) != cp.MerkleRoot {
+		s.logger.Error().
+			Int64("sequence", cp.Sequence).
+			Int("stored_leaves", len(leaves)).
+			Int("checkpoint_leaves", cp.LeafCount).
+			Msg("Spin logs no longer match transparency checkpoint")
+		return nil, transparency.ErrCheckpointMismatch
+	}
+
+	return &transparency.InclusionProof{
+		Checkpoint: cp,
+		Leaf:       *leaf,
+		LeafIndex:  index,
+		AuditPath:  transparency.AuditPath(hashes, index),
+	}, nil
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/provablyfair"
+	"github.com/slotmachine/backend/domain/transparency"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTransparencyRepo keeps checkpoints and spin log leaves in memory
+type fakeTransparencyRepo struct {
+	checkpoints []*transparency.Checkpoint
+	leaves      []transparency.Leaf
+}
+
+func (r *fakeTransparencyRepo) addLeaf(hash string, at time.Time) transparency.Leaf {
+	l := transparency.Leaf{SpinLogID: uuid.New(), SpinID: uuid.New(), SpinHash: hash, CreatedAt: at}
+	r.leaves = append(r.leaves, l)
+	sort.Slice(r.leaves, func(i, j int) bool { return leafBefore(r.leaves[i], r.leaves[j].CreatedAt, r.leaves[j].SpinLogID) })
+	return l
+}
+
+func leafBefore(l transparency.Leaf, at time.Time, id uuid.UUID) bool {
+	return l.CreatedAt.Before(at) || (l.CreatedAt.Equal(at) && l.SpinLogID.String() < id.String())
+}
+
+func (r *fakeTransparencyRepo) GetLatest(ctx context.Context) (*transparency.Checkpoint, error) {
+	if len(r.checkpoints) == 0 {
+		return nil, transparency.ErrCheckpointNotFound
+	}
+	return r.checkpoints[len(r.checkpoints)-1], nil
+}
+
+func (r *fakeTransparencyRepo) GetBySequence(ctx context.Context, sequence int64) (*transparency.Checkpoint, error) {
+	for _, cp := range r.checkpoints {
+		if cp.Sequence == sequence {
+			return cp, nil
+		}
+	}
+	return nil, transparency.ErrCheckpointNotFound
+}
+
+func (r *fakeTransparencyRepo) GetCovering(ctx context.Context, createdAt time.Time, spinLogID uuid.UUID) (*transparency.Checkpoint, error) {
+	for _, cp := range r.checkpoints {
+		if !leafBefore(transparency.Leaf{CreatedAt: cp.ToCreatedAt, SpinLogID: cp.LastSpinLogID}, createdAt, spinLogID) {
+			return cp, nil
+		}
+	}
+	return nil, transparency.ErrSpinNotCheckpointed
+}
+
+func (r *fakeTransparencyRepo) List(ctx context.Context, limit, offset int) ([]*transparency.Checkpoint, int64, error) {
+	return r.checkpoints, int64(len(r.checkpoints)), nil
+}
+
+func (r *fakeTransparencyRepo) Create(ctx context.Context, cp *transparency.Checkpoint) error {
+	r.checkpoints = append(r.checkpoints, cp)
+	return nil
+}
+
+func (r *fakeTransparencyRepo) ListUnanchored(ctx context.Context, limit int) ([]*transparency.Checkpoint, error) {
+	var pending []*transparency.Checkpoint
+	for _, cp := range r.checkpoints {
+		if cp.AnchoredAt == nil {
+			pending = append(pending, cp)
+		}
+	}
+	return pending, nil
+}
+
+func (r *fakeTransparencyRepo) SetAnchor(ctx context.Context, id uuid.UUID, name, ref string, anchoredAt time.Time) error {
+	for _, cp := range r.checkpoints {
+		if cp.ID == id {
+			cp.AnchorName, cp.AnchorRef, cp.AnchoredAt = &name, &ref, &anchoredAt
+			return nil
+		}
+	}
+	return transparency.ErrCheckpointNotFound
+}
+
+func (r *fakeTransparencyRepo) ListLeavesAfter(ctx context.Context, afterCreatedAt time.Time, afterID uuid.UUID, until time.Time, limit int) ([]transparency.Leaf, error) {
+	var out []transparency.Leaf
+	for _, l := range r.leaves {
+		after := afterCreatedAt.IsZero() || !leafBefore(l, afterCreatedAt, afterID) && l.SpinLogID != afterID
+		if after && l.CreatedAt.Before(until) && len(out) < limit {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeTransparencyRepo) ListLeaves(ctx context.Context, cp *transparency.Checkpoint) ([]transparency.Leaf, error) {
+	var out []transparency.Leaf
+	for _, l := range r.leaves {
+		if !leafBefore(l, cp.FromCreatedAt, cp.FirstSpinLogID) && (leafBefore(l, cp.ToCreatedAt, cp.LastSpinLogID) || l.SpinLogID == cp.LastSpinLogID) {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeTransparencyRepo) GetLeafBySpin(ctx context.Context, spinID uuid.UUID) (*transparency.Leaf, error) {
+	for i := range r.leaves {
+		if r.leaves[i].SpinID == spinID {
+			return &r.leaves[i], nil
+		}
+	}
+	return nil, provablyfair.ErrSpinNotFound
+}
+
+type fakeAnchor struct{ calls int }
+
+func (a *fakeAnchor) Name() string { return "fake" }
+
+func (a *fakeAnchor) Anchor(ctx context.Context, cp *transparency.Checkpoint) (string, error) {
+	a.calls++
+	return "ref-" + cp.Hash[:8], nil
+}
+
+func newTestTransparencyService(repo transparency.Repository, anchor transparency.Anchor) transparency.Service {
+	cfg := &config.Config{Transparency: config.TransparencyConfig{BatchSize: 3, SettleSeconds: 60}}
+	return NewTransparencyService(cfg, repo, anchor, logger.New("error", "json"))
+}
+
+func TestTransparencyService_PublishChainsCheckpoints(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeTransparencyRepo{}
+	anchor := &fakeAnchor{}
+	svc := newTestTransparencyService(repo, anchor)
+
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		repo.addLeaf(uuid.NewString(), now.Add(-10*time.Minute+time.Duration(i)*time.Second))
+	}
+	unsettled := repo.addLeaf("unsettled", now.Add(-time.Second))
+
+	created, err := svc.Publish(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, created, 2)
+	assert.Equal(t, 3, created[0].LeafCount)
+	assert.Equal(t, 2, created[1].LeafCount)
+	assert.Equal(t, transparency.GenesisHash, created[0].PrevHash)
+	assert.Equal(t, created[0].Hash, created[1].PrevHash)
+	assert.Equal(t, created[1].ComputeHash(), created[1].Hash)
+
+	t.Run("should not republish covered spin logs", func(t *testing.T) {
+		again, err := svc.Publish(ctx, now)
+		require.NoError(t, err)
+		assert.Empty(t, again)
+
+		_, err = svc.Prove(ctx, unsettled.SpinID)
+		assert.ErrorIs(t, err, transparency.ErrSpinNotCheckpointed)
+	})
+
+	t.Run("should anchor pending checkpoints", func(t *testing.T) {
+		anchored, err := svc.AnchorPending(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, anchored)
+		assert.Equal(t, "fake", *created[0].AnchorName)
+	})
+
+	t.Run("should prove inclusion against the checkpoint root", func(t *testing.T) {
+		leaf := repo.leaves[4]
+		proof, err := svc.Prove(ctx, leaf.SpinID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), proof.Checkpoint.Sequence)
+		assert.True(t, transparency.VerifyInclusion(leaf.SpinHash, proof.LeafIndex, proof.Checkpoint.LeafCount, proof.AuditPath, proof.Checkpoint.MerkleRoot))
+	})
+
+	t.Run("should detect altered spin logs", func(t *testing.T) {
+		repo.leaves[1].SpinHash = "altered"
+		_, err := svc.Prove(ctx, repo.leaves[0].SpinID)
+		assert.ErrorIs(t, err, transparency.ErrCheckpointMismatch)
+	})
+}
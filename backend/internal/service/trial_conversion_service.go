@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/trial"
+	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// trialSessionStore is the part of TrialService used to convert trial sessions
+type trialSessionStore interface {
+	ValidateTrialSession(ctx context.Context, sessionToken string, requestedGameID *uuid.UUID) (*trial.TrialSession, error)
+	EndTrialSession(ctx context.Context, sessionToken string) error
+}
+
+// ConvertTrialInput holds the account details for a trial conversion
+type ConvertTrialInput struct {
+	SessionToken string
+	Username     string
+	Email        string
+	Password     string
+	GameID       *uuid.UUID // Defaults to the trial session's game
+}
+
+// TrialConversionService converts trial players into real player accounts
+type TrialConversionService struct {
+	trials    trialSessionStore
+	players   player.Service
+	repo      trial.ConversionRepository
+	txManager *repository.TxManager
+	logger    *logger.Logger
+}
+
+// NewTrialConversionService creates a new trial conversion service
+func NewTrialConversionService(
+	trialService *TrialService,
+	players player.Service,
+	repo trial.ConversionRepository,
+	txManager *repository.TxManager,
+	log *logger.Logger,
+) *TrialConversionService {
+	return &TrialConversionService{
+		trials:    trialService,
+		players:   players,
+		repo:      repo,
+		txManager: txManager,
+		logger:    log,
+	}
+}
+
+// Convert registers a real player for the trial session and records the mapping
+// The new account gets the regular starting balance: demo balance and demo statistics
+// are kept on the conversion record only, and the trial token stops working.
+func (s *TrialConversionService) Convert(ctx context.Context, input ConvertTrialInput) (*trial.Conversion, *player.Player, error) {
+	log := s.logger.WithTraceContext(ctx)
+
+	session, err := s.trials.ValidateTrialSession(ctx, input.SessionToken, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := s.repo.GetConversionByTrialSession(ctx, session.ID); err == nil {
+		return nil, nil, trial.ErrAlreadyConverted
+	} else if !errors.Is(err, trial.ErrConversionNotFound) {
+		return nil, nil, err
+	}
+
+	gameID := input.GameID
+	if gameID == nil {
+		gameID = session.GameID
+	}
+
+	// The player and the conversion record are written together, so a failed record
+	// doesn't leave an account behind that blocks converting the trial again
+	var (
+		p          *player.Player
+		conversion *trial.Conversion
+	)
+	err = s.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		if p, err = s.players.Register(txCtx, input.Username, input.Email, input.Password, gameID); err != nil {
+			return err
+		}
+
+		conversion = trial.NewConversion(session, p.ID)
+		if err := s.repo.CreateConversion(txCtx, conversion); err != nil {
+			log.Error().Err(err).
+				Str("trial_session_id", session.ID.String()).
+				Str("player_id", p.ID.String()).
+				Msg("Failed to record trial conversion, rolling back player registration")
+			return fmt.Errorf("failed to record trial conversion: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The trial token must not be usable once the player has a real account
+	if err := s.trials.EndTrialSession(ctx, input.SessionToken); err != nil {
+		log.Warn().Err(err).Str("trial_session_id", session.ID.String()).Msg("Failed to end converted trial session")
+	}
+
+	log.Info().
+		Str("trial_session_id", session.ID.String()).
+		Str("player_id", p.ID.String()).
+		Int("trial_spins", conversion.TrialSpins).
+		Msg("Trial session converted to player account")
+
+	return conversion, p, nil
+}
+
+// GetConversionByPlayer returns the trial conversion that created a player, if any
+func (s *TrialConversionService) GetConversionByPlayer(ctx context.Context, playerID uuid.UUID) (*trial.Conversion, error) {
+	return s.repo.GetConversionByPlayer(ctx, playerID)
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/trial"
+	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// MockTrialSessionStore is a mock implementation of trialSessionStore
+type MockTrialSessionStore struct {
+	mock.Mock
+}
+
+func (m *MockTrialSessionStore) ValidateTrialSession(ctx context.Context, sessionToken string, requestedGameID *uuid.UUID) (*trial.TrialSession, error) {
+	args := m.Called(ctx, sessionToken, requestedGameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*trial.TrialSession), args.Error(1)
+}
+
+func (m *MockTrialSessionStore) EndTrialSession(ctx context.Context, sessionToken string) error {
+	return m.Called(ctx, sessionToken).Error(0)
+}
+
+// MockPlayerRegistrar mocks player.Service; only Register is used by trial conversion
+type MockPlayerRegistrar struct {
+	player.Service
+	mock.Mock
+}
+
+func (m *MockPlayerRegistrar) Register(ctx context.Context, username, email, password string, gameID *uuid.UUID) (*player.Player, error) {
+	args := m.Called(ctx, username, email, password, gameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*player.Player), args.Error(1)
+}
+
+// MockTrialConversionRepository is a mock implementation of trial.ConversionRepository
+type MockTrialConversionRepository struct {
+	mock.Mock
+}
+
+func (m *MockTrialConversionRepository) CreateConversion(ctx context.Context, conversion *trial.Conversion) error {
+	return m.Called(ctx, conversion).Error(0)
+}
+
+func (m *MockTrialConversionRepository) GetConversionByTrialSession(ctx context.Context, trialSessionID uuid.UUID) (*trial.Conversion, error) {
+	args := m.Called(ctx, trialSessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*trial.Conversion), args.Error(1)
+}
+
+func (m *MockTrialConversionRepository) GetConversionByPlayer(ctx context.Context, playerID uuid.UUID) (*trial.Conversion, error) {
+	args := m.Called(ctx, playerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*trial.Conversion), args.Error(1)
+}
+
+func setupTrialConversionService(t *testing.T) (*TrialConversionService, *MockTrialSessionStore, *MockPlayerRegistrar, *MockTrialConversionRepository, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: gormlogger.Default.LogMode(gormlogger.Silent)})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1) // Every connection to :memory: opens its own database
+	require.NoError(t, db.Exec(`CREATE TABLE players (id TEXT PRIMARY KEY)`).Error)
+
+	trials := new(MockTrialSessionStore)
+	players := new(MockPlayerRegistrar)
+	repo := new(MockTrialConversionRepository)
+	svc := &TrialConversionService{
+		trials:    trials,
+		players:   players,
+		repo:      repo,
+		txManager: repository.NewTxManager(db),
+		logger:    logger.New("error", "json"),
+	}
+	return svc, trials, players, repo, db
+}
+
+func TestTrialConversionService_Convert(t *testing.T) {
+	ctx := context.Background()
+	gameID := uuid.New()
+	session := &trial.TrialSession{
+		ID:           uuid.New(),
+		SessionToken: "trial_token",
+		GameID:       &gameID,
+		Balance:      95_000,
+		TotalSpins:   8,
+		TotalWagered: 80,
+		TotalWon:     30,
+	}
+	input := ConvertTrialInput{SessionToken: "trial_token", Username: "newbie", Email: "newbie@example.com", Password: "password123"}
+
+	t.Run("should register player on the trial game and keep trial stats separate", func(t *testing.T) {
+		svc, trials, players, repo, _ := setupTrialConversionService(t)
+		p := &player.Player{ID: uuid.New(), Username: "newbie", Balance: 100_000, GameID: &gameID}
+		trials.On("ValidateTrialSession", ctx, "trial_token", (*uuid.UUID)(nil)).Return(session, nil)
+		repo.On("GetConversionByTrialSession", ctx, session.ID).Return(nil, trial.ErrConversionNotFound)
+		players.On("Register", mock.Anything, "newbie", "newbie@example.com", "password123", &gameID).Return(p, nil)
+		repo.On("CreateConversion", mock.Anything, mock.Anything).Return(nil)
+		trials.On("EndTrialSession", ctx, "trial_token").Return(nil)
+
+		conversion, got, err := svc.Convert(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, p, got)
+		assert.Equal(t, session.ID, conversion.TrialSessionID)
+		assert.Equal(t, p.ID, conversion.PlayerID)
+		assert.Equal(t, 8, conversion.TrialSpins)
+		assert.Equal(t, 0, got.TotalSpins, "trial spins must not be merged into real totals")
+		trials.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("should reject already converted trial sessions", func(t *testing.T) {
+		svc, trials, players, repo, _ := setupTrialConversionService(t)
+		trials.On("ValidateTrialSession", ctx, "trial_token", (*uuid.UUID)(nil)).Return(session, nil)
+		repo.On("GetConversionByTrialSession", ctx, session.ID).Return(&trial.Conversion{ID: uuid.New()}, nil)
+
+		_, _, err := svc.Convert(ctx, input)
+		assert.ErrorIs(t, err, trial.ErrAlreadyConverted)
+		players.AssertNotCalled(t, "Register", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should keep the trial session when registration fails", func(t *testing.T) {
+		svc, trials, players, repo, _ := setupTrialConversionService(t)
+		trials.On("ValidateTrialSession", ctx, "trial_token", (*uuid.UUID)(nil)).Return(session, nil)
+		repo.On("GetConversionByTrialSession", ctx, session.ID).Return(nil, trial.ErrConversionNotFound)
+		players.On("Register", mock.Anything, "newbie", "newbie@example.com", "password123", &gameID).Return(nil, player.ErrPlayerAlreadyExists)
+
+		_, _, err := svc.Convert(ctx, input)
+		assert.True(t, errors.Is(err, player.ErrPlayerAlreadyExists))
+		trials.AssertNotCalled(t, "EndTrialSession", mock.Anything, mock.Anything)
+		repo.AssertNotCalled(t, "CreateConversion", mock.Anything, mock.Anything)
+	})
+	t.Run("should roll back the registration when the conversion is not recorded", func(t *testing.T) {
+		svc, trials, players, repo, db := setupTrialConversionService(t)
+		p := &player.Player{ID: uuid.New(), Username: "newbie", GameID: &gameID}
+		trials.On("ValidateTrialSession", ctx, "trial_token", (*uuid.UUID)(nil)).Return(session, nil)
+		repo.On("GetConversionByTrialSession", ctx, session.ID).Return(nil, trial.ErrConversionNotFound)
+		players.On("Register", mock.Anything, "newbie", "newbie@example.com", "password123", &gameID).Run(func(args mock.Arguments) {
+			txCtx := args.Get(0).(context.Context)
+			require.NoError(t, repository.GetDBOrTx(txCtx, db).Exec(`INSERT INTO players (id) VALUES (?)`, p.ID.String()).Error)
+		}).Return(p, nil)
+		repo.On("CreateConversion", mock.Anything, mock.Anything).Return(errors.New("connection reset"))
+
+		_, _, err := svc.Convert(ctx, input)
+		require.Error(t, err)
+
+		var count int64
+		require.NoError(t, db.Raw(`SELECT COUNT(*) FROM players`).Scan(&count).Error)
+		assert.Zero(t, count, "the registered player must not outlive the failed conversion")
+		trials.AssertNotCalled(t, "EndTrialSession", mock.Anything, mock.Anything)
+	})
+}
@@ -4,19 +4,25 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
 	"github.com/slotmachine/backend/domain/trial"
 	"github.com/slotmachine/backend/internal/infra/cache"
+	"github.com/slotmachine/backend/internal/pkg/featureflags"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
 // TrialService manages trial session lifecycle
 type TrialService struct {
-	cache  *cache.RedisClient
-	logger *logger.Logger
+	cache      *cache.RedisClient
+	settings   trial.SettingsRepository // Optional: nil applies trial.DefaultSettings to every game
+	reelStrips reelstrip.Service        // Optional: nil disables demo reel strip configs
+	flags      *featureflags.Service    // Optional: nil keeps every flagged feature at its default
+	logger     *logger.Logger
 }
 
 // NewTrialService creates a new trial service
@@ -27,6 +33,30 @@ func NewTrialService(cache *cache.RedisClient, logger *logger.Logger) *TrialServ
 	}
 }
 
+// SetSettingsRepository enables per-game demo settings
+func (s *TrialService) SetSettingsRepository(repo trial.SettingsRepository) {
+	s.settings = repo
+}
+
+// SetReelStripService enables demo-only reel strip configs in per-game settings
+func (s *TrialService) SetReelStripService(reelStrips reelstrip.Service) {
+	s.reelStrips = reelStrips
+}
+
+// SetFeatureFlags enables feature flag rollouts for trial sessions
+func (s *TrialService) SetFeatureFlags(flags *featureflags.Service) {
+	s.flags = flags
+}
+
+// FeatureFlags evaluates the flags for a trial session
+// Trial sessions are bucketed by session ID; player segments never match them.
+func (s *TrialService) FeatureFlags(ctx context.Context, trialSessionID uuid.UUID) featureflags.Set {
+	if s.flags == nil {
+		return nil
+	}
+	return s.flags.Evaluate(ctx, trialSessionID)
+}
+
 // TrialSessionResult represents the result of starting a trial session
 type TrialSessionResult struct {
 	Session   *trial.TrialSession
@@ -58,8 +88,9 @@ func (s *TrialService) StartTrialSession(ctx context.Context, gameID *uuid.UUID)
 		return nil, err
 	}
 
-	// Create trial session
+	// Create trial session with the game's demo starting balance
 	session := trial.NewTrialSession(gameID, sessionToken)
+	session.Balance = s.GetSettings(ctx, gameID).StartingBalance
 
 	// Convert to cache data format
 	cacheData := &cache.TrialSessionData{
@@ -70,6 +101,7 @@ func (s *TrialService) StartTrialSession(ctx context.Context, gameID *uuid.UUID)
 		TotalWon:       session.TotalWon,
 		CreatedAt:      session.CreatedAt.Unix(),
 		LastActivityAt: session.LastActivityAt.Unix(),
+		LastResetAt:    session.LastResetAt.Unix(),
 		ExpiresAt:      session.ExpiresAt.Unix(),
 	}
 	if gameID != nil {
@@ -135,6 +167,9 @@ func (s *TrialService) ValidateTrialSession(ctx context.Context, sessionToken st
 		return nil, fmt.Errorf("trial session not authorized for this game")
 	}
 
+	// Restore the starting balance once per UTC day for games that opt in
+	s.applyDailyReset(ctx, sessionToken, cacheData, gameID)
+
 	// Reconstruct session object
 	session := &trial.TrialSession{
 		ID:             sessionID,
@@ -146,6 +181,7 @@ func (s *TrialService) ValidateTrialSession(ctx context.Context, sessionToken st
 		TotalWon:       cacheData.TotalWon,
 		CreatedAt:      time.Unix(cacheData.CreatedAt, 0),
 		LastActivityAt: time.Unix(cacheData.LastActivityAt, 0),
+		LastResetAt:    time.Unix(lastResetAt(cacheData), 0),
 		ExpiresAt:      time.Unix(cacheData.ExpiresAt, 0),
 	}
 
@@ -266,6 +302,199 @@ func (s *TrialService) EndTrialSession(ctx context.Context, sessionToken string)
 	return s.cache.DeleteTrialSession(ctx, sessionToken)
 }
 
+// Demo Balance and Settings Methods
+
+// GetSettings returns the demo settings for a game, falling back to the defaults
+func (s *TrialService) GetSettings(ctx context.Context, gameID *uuid.UUID) *trial.Settings {
+	if s.settings == nil || gameID == nil {
+		return trial.DefaultSettings()
+	}
+
+	settings, err := s.settings.GetSettings(ctx, *gameID)
+	if err != nil {
+		if !errors.Is(err, trial.ErrSettingsNotFound) {
+			s.logger.Warn().Err(err).Str("game_id", gameID.String()).Msg("Failed to load trial settings, using defaults")
+		}
+		return trial.DefaultSettings()
+	}
+	return settings
+}
+
+// CheckBet validates a demo bet against the game's bet limits
+func (s *TrialService) CheckBet(ctx context.Context, gameID *uuid.UUID, betAmount float64) error {
+	return s.GetSettings(ctx, gameID).CheckBet(betAmount)
+}
+
+// ResetTrialBalance restores the trial balance to the game's starting balance
+func (s *TrialService) ResetTrialBalance(ctx context.Context, sessionToken string) (float64, error) {
+	cacheData, gameID, err := s.getTrialSessionData(ctx, sessionToken)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().Unix()
+	cacheData.Balance = s.GetSettings(ctx, gameID).StartingBalance
+	cacheData.LastResetAt = now
+	cacheData.LastActivityAt = now
+	if err := s.cache.UpdateTrialSession(ctx, sessionToken, cacheData); err != nil {
+		return 0, err
+	}
+
+	s.logger.Info().Str("trial_session_id", cacheData.ID).Float64("balance", cacheData.Balance).Msg("Trial balance reset")
+	return cacheData.Balance, nil
+}
+
+// TopUpTrialBalance adds to the trial balance without exceeding the game's starting balance
+func (s *TrialService) TopUpTrialBalance(ctx context.Context, sessionToken string, amount float64) (float64, error) {
+	if amount <= 0 {
+		return 0, trial.ErrInvalidTopUp
+	}
+
+	cacheData, gameID, err := s.getTrialSessionData(ctx, sessionToken)
+	if err != nil {
+		return 0, err
+	}
+
+	cacheData.Balance = max(cacheData.Balance, min(cacheData.Balance+amount, s.GetSettings(ctx, gameID).StartingBalance))
+	cacheData.LastActivityAt = time.Now().Unix()
+	if err := s.cache.UpdateTrialSession(ctx, sessionToken, cacheData); err != nil {
+		return 0, err
+	}
+	return cacheData.Balance, nil
+}
+
+// ListGameSettings lists the games with their own demo settings
+func (s *TrialService) ListGameSettings(ctx context.Context) ([]*trial.Settings, error) {
+	if s.settings == nil {
+		return []*trial.Settings{}, nil
+	}
+	return s.settings.ListSettings(ctx)
+}
+
+// GetGameSettings returns a game's own demo settings, or ErrSettingsNotFound if it uses the defaults
+func (s *TrialService) GetGameSettings(ctx context.Context, gameID uuid.UUID) (*trial.Settings, error) {
+	if s.settings == nil {
+		return nil, trial.ErrSettingsNotFound
+	}
+	return s.settings.GetSettings(ctx, gameID)
+}
+
+// SaveGameSettings validates and stores a game's demo settings
+func (s *TrialService) SaveGameSettings(ctx context.Context, settings *trial.Settings) error {
+	if s.settings == nil {
+		return fmt.Errorf("trial settings are not configured")
+	}
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+	if err := s.validateDemoConfig(ctx, settings.BaseGameConfigID, reelstrip.BaseGame); err != nil {
+		return err
+	}
+	if err := s.validateDemoConfig(ctx, settings.FreeSpinsConfigID, reelstrip.FreeSpins); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	settings.UpdatedAt = now
+	if existing, err := s.settings.GetSettings(ctx, settings.GameID); err == nil {
+		settings.CreatedAt = existing.CreatedAt
+	} else {
+		settings.CreatedAt = now
+	}
+	return s.settings.UpsertSettings(ctx, settings)
+}
+
+// validateDemoConfig checks that a configured demo reel strip config exists, is demo-only and fits the game mode
+func (s *TrialService) validateDemoConfig(ctx context.Context, configID *uuid.UUID, gameMode reelstrip.GameMode) error {
+	if configID == nil {
+		return nil
+	}
+	if s.reelStrips == nil {
+		return fmt.Errorf("%w: demo reel strip configs are not supported", trial.ErrInvalidSettings)
+	}
+
+	config, err := s.reelStrips.GetConfigByID(ctx, *configID)
+	if err != nil {
+		if errors.Is(err, reelstrip.ErrConfigNotFound) {
+			return fmt.Errorf("%w: %s config %s not found", trial.ErrInvalidSettings, gameMode, configID)
+		}
+		return err
+	}
+	switch {
+	case !config.IsDemo:
+		return fmt.Errorf("%w: %s config %s is not a demo config", trial.ErrInvalidSettings, gameMode, configID)
+	case config.GameMode != string(gameMode) && config.GameMode != string(reelstrip.Both):
+		return fmt.Errorf("%w: config %s is for %s, not %s", trial.ErrInvalidSettings, configID, config.GameMode, gameMode)
+	}
+	return nil
+}
+
+// DemoConfigID returns the demo reel strip config to use for a trial game, if any
+func (s *TrialService) DemoConfigID(ctx context.Context, gameID *uuid.UUID, isFreeSpin bool) *uuid.UUID {
+	if s.reelStrips == nil {
+		return nil
+	}
+	return s.GetSettings(ctx, gameID).DemoConfigID(isFreeSpin)
+}
+
+// DeleteGameSettings reverts a game to the default demo settings
+func (s *TrialService) DeleteGameSettings(ctx context.Context, gameID uuid.UUID) error {
+	if s.settings == nil {
+		return trial.ErrSettingsNotFound
+	}
+	return s.settings.DeleteSettings(ctx, gameID)
+}
+
+// getTrialSessionData loads the cached trial session and its game ID
+func (s *TrialService) getTrialSessionData(ctx context.Context, sessionToken string) (*cache.TrialSessionData, *uuid.UUID, error) {
+	if s.cache == nil {
+		return nil, nil, fmt.Errorf("trial mode requires Redis")
+	}
+
+	cacheData, err := s.cache.GetTrialSession(ctx, sessionToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cacheData == nil {
+		return nil, nil, fmt.Errorf("trial session not found")
+	}
+
+	var gameID *uuid.UUID
+	if parsed, err := uuid.Parse(cacheData.GameID); err == nil {
+		gameID = &parsed
+	}
+	return cacheData, gameID, nil
+}
+
+// applyDailyReset restores the starting balance on the first request of a new UTC day
+func (s *TrialService) applyDailyReset(ctx context.Context, sessionToken string, cacheData *cache.TrialSessionData, gameID *uuid.UUID) {
+	now := time.Now().UTC()
+	if !trial.DailyResetDue(time.Unix(lastResetAt(cacheData), 0), now) {
+		return
+	}
+
+	settings := s.GetSettings(ctx, gameID)
+	if !settings.DailyReset {
+		return
+	}
+
+	cacheData.Balance = settings.StartingBalance
+	cacheData.LastResetAt = now.Unix()
+	if err := s.cache.UpdateTrialSession(ctx, sessionToken, cacheData); err != nil {
+		s.logger.Warn().Err(err).Str("trial_session_id", cacheData.ID).Msg("Failed to apply daily trial balance reset")
+		return
+	}
+	s.logger.Info().Str("trial_session_id", cacheData.ID).Float64("balance", cacheData.Balance).Msg("Daily trial balance reset")
+}
+
+// lastResetAt returns when the balance was last reset; sessions created before resets existed use their creation time
+func lastResetAt(cacheData *cache.TrialSessionData) int64 {
+	if cacheData.LastResetAt == 0 {
+		return cacheData.CreatedAt
+	}
+	return cacheData.LastResetAt
+}
+
 // Trial Game Session Methods
 
 // StartTrialGameSession creates a new trial game session
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/trial"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTrialSettingsRepository is a mock implementation of trial.SettingsRepository
+type MockTrialSettingsRepository struct {
+	mock.Mock
+}
+
+func (m *MockTrialSettingsRepository) GetSettings(ctx context.Context, gameID uuid.UUID) (*trial.Settings, error) {
+	args := m.Called(ctx, gameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*trial.Settings), args.Error(1)
+}
+
+func (m *MockTrialSettingsRepository) ListSettings(ctx context.Context) ([]*trial.Settings, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*trial.Settings), args.Error(1)
+}
+
+func (m *MockTrialSettingsRepository) UpsertSettings(ctx context.Context, settings *trial.Settings) error {
+	return m.Called(ctx, settings).Error(0)
+}
+
+func (m *MockTrialSettingsRepository) DeleteSettings(ctx context.Context, gameID uuid.UUID) error {
+	return m.Called(ctx, gameID).Error(0)
+}
+
+func setupTrialService() (*TrialService, *MockTrialSettingsRepository) {
+	repo := new(MockTrialSettingsRepository)
+	svc := NewTrialService(nil, logger.New("error", "json"))
+	svc.SetSettingsRepository(repo)
+	return svc, repo
+}
+
+func TestTrialService_GetSettings(t *testing.T) {
+	ctx := context.Background()
+	gameID := uuid.New()
+
+	t.Run("should use defaults without a game", func(t *testing.T) {
+		svc, repo := setupTrialService()
+		assert.Equal(t, trial.DefaultSettings(), svc.GetSettings(ctx, nil))
+		repo.AssertNotCalled(t, "GetSettings", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should use defaults for unconfigured games", func(t *testing.T) {
+		svc, repo := setupTrialService()
+		repo.On("GetSettings", ctx, gameID).Return(nil, trial.ErrSettingsNotFound)
+		assert.Equal(t, trial.TrialStartingBalance, svc.GetSettings(ctx, &gameID).StartingBalance)
+	})
+
+	t.Run("should enforce game bet limits", func(t *testing.T) {
+		svc, repo := setupTrialService()
+		repo.On("GetSettings", ctx, gameID).Return(&trial.Settings{GameID: gameID, StartingBalance: 1000, MinBet: 1, MaxBet: 50}, nil)
+
+		assert.NoError(t, svc.CheckBet(ctx, &gameID, 50))
+		assert.ErrorIs(t, svc.CheckBet(ctx, &gameID, 0.5), trial.ErrBetOutOfRange)
+		assert.ErrorIs(t, svc.CheckBet(ctx, &gameID, 51), trial.ErrBetOutOfRange)
+	})
+}
+
+func TestTrialService_SaveGameSettings(t *testing.T) {
+	ctx := context.Background()
+	gameID := uuid.New()
+
+	t.Run("should reject invalid settings", func(t *testing.T) {
+		svc, repo := setupTrialService()
+
+		err := svc.SaveGameSettings(ctx, &trial.Settings{GameID: gameID, StartingBalance: 1000, MinBet: 10, MaxBet: 5})
+		assert.ErrorIs(t, err, trial.ErrInvalidSettings)
+		err = svc.SaveGameSettings(ctx, &trial.Settings{GameID: gameID})
+		assert.ErrorIs(t, err, trial.ErrInvalidSettings)
+		repo.AssertNotCalled(t, "UpsertSettings", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should keep the original creation time", func(t *testing.T) {
+		svc, repo := setupTrialService()
+		created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		repo.On("GetSettings", ctx, gameID).Return(&trial.Settings{GameID: gameID, CreatedAt: created}, nil)
+		repo.On("UpsertSettings", ctx, mock.Anything).Return(nil)
+
+		settings := &trial.Settings{GameID: gameID, StartingBalance: 500, MaxBet: 10}
+		require.NoError(t, svc.SaveGameSettings(ctx, settings))
+		assert.Equal(t, created, settings.CreatedAt)
+		assert.True(t, settings.UpdatedAt.After(created))
+	})
+
+	t.Run("should only accept demo reel strip configs", func(t *testing.T) {
+		svc, repo := setupTrialService()
+		reelRepo := new(MockReelStripRepository)
+		svc.SetReelStripService(NewReelStripService(reelRepo, logger.New("error", "json")))
+		demo := &reelstrip.ReelStripConfig{ID: uuid.New(), GameMode: "base_game", IsDemo: true}
+		real := &reelstrip.ReelStripConfig{ID: uuid.New(), GameMode: "base_game"}
+		reelRepo.On("GetConfigByID", ctx, demo.ID).Return(demo, nil)
+		reelRepo.On("GetConfigByID", ctx, real.ID).Return(real, nil)
+
+		err := svc.SaveGameSettings(ctx, &trial.Settings{GameID: gameID, StartingBalance: 500, BaseGameConfigID: &real.ID})
+		assert.ErrorIs(t, err, trial.ErrInvalidSettings)
+		err = svc.SaveGameSettings(ctx, &trial.Settings{GameID: gameID, StartingBalance: 500, FreeSpinsConfigID: &demo.ID})
+		assert.ErrorIs(t, err, trial.ErrInvalidSettings, "base game config cannot be used for free spins")
+		repo.AssertNotCalled(t, "UpsertSettings", mock.Anything, mock.Anything)
+
+		repo.On("GetSettings", ctx, gameID).Return(nil, trial.ErrSettingsNotFound)
+		repo.On("UpsertSettings", ctx, mock.Anything).Return(nil)
+		settings := &trial.Settings{GameID: gameID, StartingBalance: 500, BaseGameConfigID: &demo.ID}
+		require.NoError(t, svc.SaveGameSettings(ctx, settings))
+		assert.Equal(t, &demo.ID, settings.DemoConfigID(false))
+		assert.Nil(t, settings.DemoConfigID(true))
+	})
+}
+
+func TestTrialDailyResetDue(t *testing.T) {
+	last := time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC)
+	assert.False(t, trial.DailyResetDue(last, last.Add(30*time.Minute)))
+	assert.True(t, trial.DailyResetDue(last, last.Add(2*time.Hour)))
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/vip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// vipTiersTTL is how long the tier ladder is cached; tier changes also expire it
+const vipTiersTTL = time.Minute
+
+// VIPService implements vip.Service
+type VIPService struct {
+	repo          vip.Repository
+	cache         *cache.Cache // Optional: nil disables caching of the tier ladder
+	pointsPerUnit float64
+	logger        *logger.Logger
+}
+
+// NewVIPService creates a new VIP service
+func NewVIPService(
+	repo vip.Repository,
+	cache *cache.Cache,
+	cfg *config.Config,
+	log *logger.Logger,
+) *VIPService {
+	return &VIPService{
+		repo:          repo,
+		cache:         cache,
+		pointsPerUnit: cfg.VIP.PointsPerUnit,
+		logger:        log,
+	}
+}
+
+// CreateTier validates and stores a new tier
+func (s *VIPService) CreateTier(ctx context.Context, tier *vip.Tier) error {
+	if err := s.validateTier(ctx, tier); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	if tier.ID == uuid.Nil {
+		tier.ID = uuid.New()
+	}
+	tier.CreatedAt = now
+	tier.UpdatedAt = now
+	if err := s.repo.CreateTier(ctx, tier); err != nil {
+		return err
+	}
+	s.expireTiers(ctx)
+	return nil
+}
+
+// GetTier retrieves a tier by ID
+func (s *VIPService) GetTier(ctx context.Context, id uuid.UUID) (*vip.Tier, error) {
+	return s.repo.GetTierByID(ctx, id)
+}
+
+// ListTiers lists all tiers ordered by points threshold
+func (s *VIPService) ListTiers(ctx context.Context) ([]*vip.Tier, error) {
+	return s.repo.ListTiers(ctx)
+}
+
+// UpdateTier validates and saves a tier
+func (s *VIPService) UpdateTier(ctx context.Context, tier *vip.Tier) error {
+	if err := s.validateTier(ctx, tier); err != nil {
+		return err
+	}
+	if err := s.repo.UpdateTier(ctx, tier); err != nil {
+		return err
+	}
+	s.expireTiers(ctx)
+	return nil
+}
+
+// DeleteTier deletes a tier
+// Players keep their points; they are placed on the remaining ladder.
+func (s *VIPService) DeleteTier(ctx context.Context, id uuid.UUID) error {
+	if err := s.repo.DeleteTier(ctx, id); err != nil {
+		return err
+	}
+	s.expireTiers(ctx)
+	return nil
+}
+
+// GetStatus returns the player's points and tier
+func (s *VIPService) GetStatus(ctx context.Context, playerID uuid.UUID) (*vip.Status, error) {
+	tiers, err := s.tiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	points, err := s.repo.GetPoints(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	return vip.NewStatus(playerID, points, tiers), nil
+}
+
+// AccruePoints credits points for a wager at the player's current tier multiplier
+func (s *VIPService) AccruePoints(ctx context.Context, playerID uuid.UUID, wager float64) (*vip.Status, error) {
+	status, err := s.GetStatus(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	earned := vip.PointsForWager(wager, s.pointsPerUnit, status.Perks())
+	if earned == 0 {
+		return status, nil
+	}
+
+	total, err := s.repo.AddPoints(ctx, playerID, earned)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers, err := s.tiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updated := vip.NewStatus(playerID, total, tiers)
+	if updated.Level() > status.Level() {
+		s.logger.Info().
+			Str("player_id", playerID.String()).
+			Int("from_level", status.Level()).
+			Int("to_level", updated.Level()).
+			Int64("points", total).
+			Msg("Player reached new VIP tier")
+	}
+	return updated, nil
+}
+
+// tiers returns the tier ladder, cached when a cache is configured
+func (s *VIPService) tiers(ctx context.Context) ([]*vip.Tier, error) {
+	if s.cache == nil {
+		return s.repo.ListTiers(ctx)
+	}
+
+	ttl := vipTiersTTL
+	res, err := s.cache.GetWithSingleflight(ctx, s.cache.VIPTiersKey(), []*vip.Tier{}, func() (any, error) {
+		return s.repo.ListTiers(ctx)
+	}, &ttl)
+	if err != nil {
+		return nil, err
+	}
+	return res.([]*vip.Tier), nil
+}
+
+// expireTiers drops the cached tier ladder after a tier change
+func (s *VIPService) expireTiers(ctx context.Context) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.Expire(ctx, s.cache.VIPTiersKey()); err != nil {
+		s.logger.Warn().Err(err).Msg("Failed to expire cached VIP tiers")
+	}
+}
+
+// validateTier checks the tier fields and that levels rise with point thresholds
+func (s *VIPService) validateTier(ctx context.Context, tier *vip.Tier) error {
+	tier.Name = strings.TrimSpace(tier.Name)
+	if tier.Name == "" {
+		return fmt.Errorf("%w: name is required", vip.ErrInvalidTier)
+	}
+	if tier.Level < 1 {
+		return fmt.Errorf("%w: level must be at least 1", vip.ErrInvalidTier)
+	}
+	if tier.MinPoints < 0 {
+		return fmt.Errorf("%w: min_points cannot be negative", vip.ErrInvalidTier)
+	}
+	if tier.Perks.PointsMultiplier < 0 || tier.Perks.ExtraFreeSpins < 0 {
+		return fmt.Errorf("%w: perks cannot be negative", vip.ErrInvalidTier)
+	}
+
+	existing, err := s.repo.GetTierByLevel(ctx, tier.Level)
+	if err == nil && existing.ID != tier.ID {
+		return vip.ErrTierLevelTaken
+	}
+	if err != nil && !errors.Is(err, vip.ErrTierNotFound) {
+		return err
+	}
+
+	tiers, err := s.repo.ListTiers(ctx)
+	if err != nil {
+		return err
+	}
+	for _, other := range tiers {
+		if other.ID == tier.ID {
+			continue
+		}
+		if (other.Level < tier.Level && other.MinPoints >= tier.MinPoints) ||
+			(other.Level > tier.Level && other.MinPoints <= tier.MinPoints) {
+			return fmt.Errorf("%w: level %d must need more points than lower levels and fewer than higher levels (conflicts with %q)", vip.ErrInvalidTier, tier.Level, other.Name)
+		}
+	}
+	return nil
+}
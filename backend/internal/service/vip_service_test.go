@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slotmachine/backend/domain/vip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockVIPRepository is a mock implementation of vip.Repository
+type MockVIPRepository struct {
+	mock.Mock
+}
+
+func (m *MockVIPRepository) CreateTier(ctx context.Context, tier *vip.Tier) error {
+	return m.Called(ctx, tier).Error(0)
+}
+
+func (m *MockVIPRepository) GetTierByID(ctx context.Context, id uuid.UUID) (*vip.Tier, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*vip.Tier), args.Error(1)
+}
+
+func (m *MockVIPRepository) GetTierByLevel(ctx context.Context, level int) (*vip.Tier, error) {
+	args := m.Called(ctx, level)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*vip.Tier), args.Error(1)
+}
+
+func (m *MockVIPRepository) ListTiers(ctx context.Context) ([]*vip.Tier, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*vip.Tier), args.Error(1)
+}
+
+func (m *MockVIPRepository) UpdateTier(ctx context.Context, tier *vip.Tier) error {
+	return m.Called(ctx, tier).Error(0)
+}
+
+func (m *MockVIPRepository) DeleteTier(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockVIPRepository) GetPoints(ctx context.Context, playerID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, playerID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockVIPRepository) AddPoints(ctx context.Context, playerID uuid.UUID, points int64) (int64, error) {
+	args := m.Called(ctx, playerID, points)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func setupVIPService() (*VIPService, *MockVIPRepository) {
+	repo := new(MockVIPRepository)
+	cfg := &config.Config{VIP: config.VIPConfig{PointsPerUnit: 1}}
+	return NewVIPService(repo, nil, cfg, logger.New("error", "json")), repo
+}
+
+func testVIPTiers() []*vip.Tier {
+	return []*vip.Tier{
+		{ID: uuid.New(), Level: 1, Name: "Bronze", MinPoints: 0},
+		{ID: uuid.New(), Level: 2, Name: "Silver", MinPoints: 1000, Perks: vip.Perks{PointsMultiplier: 1.5}},
+		{ID: uuid.New(), Level: 3, Name: "Gold", MinPoints: 5000, Perks: vip.Perks{PointsMultiplier: 2, ExtraFreeSpins: 3}},
+	}
+}
+
+func TestVIPStatus(t *testing.T) {
+	tiers := testVIPTiers()
+	playerID := uuid.New()
+
+	t.Run("should place points between tiers", func(t *testing.T) {
+		status := vip.NewStatus(playerID, 1200, tiers)
+		assert.Equal(t, 2, status.Level())
+		assert.Equal(t, "Gold", status.NextTier.Name)
+		assert.Equal(t, int64(3800), status.PointsToNext())
+	})
+
+	t.Run("should have no next tier at the top", func(t *testing.T) {
+		status := vip.NewStatus(playerID, 9000, tiers)
+		assert.Equal(t, 3, status.Level())
+		assert.Nil(t, status.NextTier)
+		assert.Equal(t, int64(0), status.PointsToNext())
+		assert.Equal(t, 3, status.Perks().ExtraFreeSpins)
+	})
+
+	t.Run("should have no tier below the lowest threshold", func(t *testing.T) {
+		status := vip.NewStatus(playerID, 50, tiers[1:])
+		assert.Equal(t, 0, status.Level())
+		assert.Equal(t, vip.Perks{}, status.Perks())
+	})
+
+	t.Run("should apply the tier multiplier and truncate", func(t *testing.T) {
+		assert.Equal(t, int64(15), vip.PointsForWager(10.5, 1, vip.Perks{PointsMultiplier: 1.5}))
+		assert.Equal(t, int64(10), vip.PointsForWager(10.9, 1, vip.Perks{}))
+		assert.Equal(t, int64(0), vip.PointsForWager(10, 0, vip.Perks{}))
+	})
+}
+
+func TestVIPService_AccruePoints(t *testing.T) {
+	ctx := context.Background()
+	playerID := uuid.New()
+
+	t.Run("should accrue points at the current tier multiplier", func(t *testing.T) {
+		svc, repo := setupVIPService()
+		repo.On("ListTiers", ctx).Return(testVIPTiers(), nil)
+		repo.On("GetPoints", ctx, playerID).Return(int64(4900), nil)
+		repo.On("AddPoints", ctx, playerID, int64(150)).Return(int64(5050), nil)
+
+		status, err := svc.AccruePoints(ctx, playerID, 100)
+		require.NoError(t, err)
+		assert.Equal(t, 3, status.Level(), "player should be promoted to gold")
+		assert.Equal(t, int64(5050), status.Points)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("should skip writes when no points are earned", func(t *testing.T) {
+		svc, repo := setupVIPService()
+		repo.On("ListTiers", ctx).Return(testVIPTiers(), nil)
+		repo.On("GetPoints", ctx, playerID).Return(int64(10), nil)
+
+		status, err := svc.AccruePoints(ctx, playerID, 0.5)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), status.Points)
+		repo.AssertNotCalled(t, "AddPoints", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestVIPService_CreateTier(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should create a tier that fits the ladder", func(t *testing.T) {
+		svc, repo := setupVIPService()
+		repo.On("GetTierByLevel", ctx, 4).Return(nil, vip.ErrTierNotFound)
+		repo.On("ListTiers", ctx).Return(testVIPTiers(), nil)
+		repo.On("CreateTier", ctx, mock.Anything).Return(nil)
+
+		tier := &vip.Tier{Level: 4, Name: " Platinum ", MinPoints: 20000}
+		require.NoError(t, svc.CreateTier(ctx, tier))
+		assert.Equal(t, "Platinum", tier.Name)
+		assert.NotEqual(t, uuid.Nil, tier.ID)
+	})
+
+	t.Run("should reject a duplicate level", func(t *testing.T) {
+		svc, repo := setupVIPService()
+		repo.On("GetTierByLevel", ctx, 2).Return(testVIPTiers()[1], nil)
+
+		err := svc.CreateTier(ctx, &vip.Tier{Level: 2, Name: "Silver+", MinPoints: 2000})
+		assert.ErrorIs(t, err, vip.ErrTierLevelTaken)
+	})
+
+	t.Run("should reject thresholds out of level order", func(t *testing.T) {
+		svc, repo := setupVIPService()
+		repo.On("GetTierByLevel", ctx, 4).Return(nil, vip.ErrTierNotFound)
+		repo.On("ListTiers", ctx).Return(testVIPTiers(), nil)
+
+		err := svc.CreateTier(ctx, &vip.Tier{Level: 4, Name: "Platinum", MinPoints: 3000})
+		assert.ErrorIs(t, err, vip.ErrInvalidTier)
+		repo.AssertNotCalled(t, "CreateTier", mock.Anything, mock.Anything)
+	})
+}
@@ -1,40 +1,249 @@
 package service
 
 import (
+	"time"
+
 	"github.com/google/wire"
+	"github.com/slotmachine/backend/domain/approval"
+	"github.com/slotmachine/backend/domain/autoplay"
+	"github.com/slotmachine/backend/domain/balance"
+	"github.com/slotmachine/backend/domain/bigwin"
+	"github.com/slotmachine/backend/domain/certification"
+	"github.com/slotmachine/backend/domain/dashboard"
+	"github.com/slotmachine/backend/domain/dispute"
+	"github.com/slotmachine/backend/domain/exposure"
+	"github.com/slotmachine/backend/domain/financialreport"
 	"github.com/slotmachine/backend/domain/freespins"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/historyexport"
+	"github.com/slotmachine/backend/domain/jurisdiction"
+	"github.com/slotmachine/backend/domain/kyc"
+	"github.com/slotmachine/backend/domain/launch"
+	"github.com/slotmachine/backend/domain/notification"
 	"github.com/slotmachine/backend/domain/player"
+	"github.com/slotmachine/backend/domain/playernote"
+	"github.com/slotmachine/backend/domain/privacy"
 	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/domain/segment"
 	"github.com/slotmachine/backend/domain/session"
+	"github.com/slotmachine/backend/domain/shadow"
 	"github.com/slotmachine/backend/domain/spin"
+	"github.com/slotmachine/backend/domain/spinfeed"
+	"github.com/slotmachine/backend/domain/stats"
+	"github.com/slotmachine/backend/domain/timeline"
+	"github.com/slotmachine/backend/domain/trial"
+	"github.com/slotmachine/backend/domain/vip"
 	"github.com/slotmachine/backend/internal/config"
 	"github.com/slotmachine/backend/internal/game/engine"
+	"github.com/slotmachine/backend/internal/game/rng"
 	infraCache "github.com/slotmachine/backend/internal/infra/cache"
 	redisCache "github.com/slotmachine/backend/internal/infra/cache"
 	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/clock"
+	"github.com/slotmachine/backend/internal/pkg/featureflags"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
 // ProviderSet is the Wire provider set for services
 var ProviderSet = wire.NewSet(
 	NewPlayerService,
-	NewSessionService,
+	ProvideSessionService,
 	ProvideSpinService,
 	wire.Bind(new(spin.Service), new(*SpinService)),
 	ProvideFreeSpinsService,
 	wire.Bind(new(freespins.Service), new(*FreeSpinsService)),
-	NewReelStripService,
+	ProvideReelStripService,
+	ProvideSegmentService,
+	wire.Bind(new(segment.Service), new(*SegmentService)),
+	NewVIPService,
+	wire.Bind(new(vip.Service), new(*VIPService)),
+	NewJurisdictionService,
+	wire.Bind(new(jurisdiction.Service), new(*JurisdictionService)),
+	NewStatsService,
+	wire.Bind(new(stats.Service), new(*StatsService)),
+	NewBigWinService,
+	wire.Bind(new(bigwin.Service), new(*BigWinService)),
+	ProvideSpinFeedService,
+	ProvideDashboardService,
+	wire.Bind(new(dashboard.Service), new(*DashboardService)),
+	NewPFSessionSweeper,
+	NewSpinReconciler,
+	ProvidePFStateWriter,
+	NewSpinBatchWriter,
+	NewAnalyticsMirror,
+	NewPartitionMaintainer,
+	NewArchiveService,
+	NewArchiveWorker,
+	NewFinancialReportService,
+	wire.Bind(new(financialreport.Service), new(*FinancialReportService)),
+	NewFinancialReportWorker,
+	NewSessionExpiryService,
+	NewSessionExpirySweeper,
+	NewImpersonationService,
+	NewPlayerNoteService,
+	wire.Bind(new(playernote.Service), new(*PlayerNoteService)),
+	NewTransparencyService,
+	NewTransparencyPublisher,
+	NewPFChainAuditor,
+	wire.Bind(new(spinfeed.Service), new(*SpinFeedService)),
+	NewJWTKeyring,
 	NewAdminService,
 	ProvideProvablyFairService,
 	ProvideTrialService,
+	ProvideGameRulesService,
+	ProvideFeatureFlagService,
+	NewTrialConversionService,
+	NewLaunchService,
+	NewAssetImageWorker,
+	NewAssetFileService,
+	NewTimelineService,
+	wire.Bind(new(timeline.Service), new(*TimelineService)),
+	NewDisputeService,
+	NewKYCService,
+	NewPrivacyService,
+	NewCertificationService,
+	ProvideShadowEngine,
+	NewAutoplayService,
+	NewHistoryExportService,
+	NewHistoryExportWorker,
+	NewNotificationService,
+	wire.Bind(new(notification.Service), new(*NotificationService)),
+	wire.Bind(new(notification.Sender), new(*NotificationService)),
+	NewBalanceService,
+	NewApprovalService,
+	NewApprovalExpiryWorker,
+	NewReelStripCanaryService,
+	NewReelStripCanaryMonitor,
+	NewReelStripCacheWarmer,
+	NewHealthService,
+	NewSeedDataValidator,
+	NewRTPMonitor,
+	NewExposureService,
+	NewExposureMonitor,
+	wire.Bind(new(kyc.Service), new(*KYCService)),
+	wire.Bind(new(exposure.Service), new(*ExposureService)),
+	wire.Bind(new(dispute.Service), new(*DisputeService)),
+	wire.Bind(new(privacy.Service), new(*PrivacyService)),
+	wire.Bind(new(autoplay.Service), new(*AutoplayService)),
+	wire.Bind(new(historyexport.Service), new(*HistoryExportService)),
+	wire.Bind(new(balance.Service), new(*BalanceService)),
+	wire.Bind(new(reelstrip.CanaryService), new(*ReelStripCanaryService)),
+	wire.Bind(new(approval.Service), new(*ApprovalService)),
 )
 
-// ProvideTrialService provides the TrialService
+// ProvideTrialService provides the TrialService with per-game demo settings, demo reel strips and feature flags
 func ProvideTrialService(
 	cache *redisCache.RedisClient,
+	settingsRepo trial.SettingsRepository,
+	reelStrips reelstrip.Service,
+	flags *featureflags.Service,
 	log *logger.Logger,
 ) *TrialService {
-	return NewTrialService(cache, log)
+	svc := NewTrialService(cache, log)
+	svc.SetSettingsRepository(settingsRepo)
+	svc.SetReelStripService(reelStrips)
+	svc.SetFeatureFlags(flags)
+	return svc
+}
+
+// ProvideFeatureFlagService provides the feature flag service with segment-targeted flags enabled
+func ProvideFeatureFlagService(
+	cfg *config.Config,
+	redisClient *infraCache.RedisClient,
+	segments segment.Service,
+	log *logger.Logger,
+) (*featureflags.Service, error) {
+	flags, err := featureflags.NewFromConfig(cfg, redisClient, log)
+	if err != nil {
+		return nil, err
+	}
+	flags.SetSegmentChecker(segments)
+	return flags, nil
+}
+
+// ProvideGameRulesService provides the GameRulesService and installs it as the engine's rules resolver
+func ProvideGameRulesService(
+	playerRepo player.Repository,
+	gameRepo game.Repository,
+	cache *cache.Cache,
+	gameEngine *engine.GameEngine,
+	log *logger.Logger,
+) *GameRulesService {
+	svc := NewGameRulesService(playerRepo, gameRepo, cache, log)
+	gameEngine.SetRulesResolver(svc)
+	return svc
+}
+
+// ProvideShadowEngine provides the shadow engine comparison, or nil when it is disabled
+// The candidate is the engine implementation under evaluation and is built here. Until a new
+// implementation is swapped in, a second production engine replays the sampled spins, which
+// checks the recording and replay path end to end.
+func ProvideShadowEngine(
+	cfg *config.Config,
+	reelStripService reelstrip.Service,
+	cache *cache.Cache,
+	rules *GameRulesService,
+	notifier shadow.AlertNotifier,
+	log *logger.Logger,
+) *ShadowEngine {
+	if !cfg.Shadow.Enabled {
+		return nil
+	}
+	candidate := engine.NewGameEngine(reelStripService, cache, true)
+	candidate.SetRulesResolver(rules)
+	return NewShadowEngine(candidate, cfg.Shadow.SampleRate, notifier, log)
+}
+
+// ProvideReelStripService provides the ReelStripService with segment-targeted configs, operator defaults, canaries and resolution caching enabled
+func ProvideReelStripService(
+	repo reelstrip.Repository,
+	segments segment.Service,
+	links launch.Repository,
+	canaries reelstrip.CanaryRepository,
+	cache *cache.Cache,
+	clk clock.Clock,
+	log *logger.Logger,
+) reelstrip.Service {
+	svc := NewReelStripService(repo, log).(*ReelStripService)
+	svc.SetSegmentResolver(segments)
+	svc.SetOperatorLinks(links)
+	svc.SetCanaries(canaries)
+	svc.SetResolutionCache(cache)
+	svc.SetClock(clk)
+	return svc
+}
+
+// ProvideSpinFeedService provides the SpinFeedService backed by the Redis spin feed store
+func ProvideSpinFeedService(
+	store *redisCache.SpinFeedStore,
+	cfg *config.Config,
+	log *logger.Logger,
+) *SpinFeedService {
+	return NewSpinFeedService(store, cfg, log)
+}
+
+// ProvideDashboardService provides the DashboardService backed by the Redis dashboard counters
+func ProvideDashboardService(
+	store *redisCache.DashboardStore,
+	cfg *config.Config,
+	log *logger.Logger,
+) *DashboardService {
+	return NewDashboardService(store, cfg, log)
+}
+
+// ProvideSegmentService provides the SegmentService with VIP tiers available to segment rules
+func ProvideSegmentService(
+	repo segment.Repository,
+	playerRepo player.Repository,
+	reelstripRepo reelstrip.Repository,
+	cache *cache.Cache,
+	vipService vip.Service,
+	log *logger.Logger,
+) *SegmentService {
+	svc := NewSegmentService(repo, playerRepo, reelstripRepo, cache, log)
+	svc.SetVIPService(vipService)
+	return svc
 }
 
 // ProvideSpinService provides a concrete SpinService with required pfService
@@ -47,6 +256,24 @@ func ProvideSpinService(
 	reelstripRepo reelstrip.Repository,
 	txManager *repository.TxManager,
 	pfService *ProvablyFairService,
+	segments segment.Service,
+	vipService vip.Service,
+	statsService stats.Service,
+	bigWinService bigwin.Service,
+	feedService spinfeed.Service,
+	jurisdictions jurisdiction.Service,
+	kycService kyc.Service,
+	flags *featureflags.Service,
+	certLog certification.Service,
+	shadowEngine *ShadowEngine,
+	autoplays autoplay.Service,
+	balanceLocks player.Locker,
+	exposures exposure.Service,
+	batches *SpinBatchWriter,
+	mirror *AnalyticsMirror,
+	dashboards dashboard.Service,
+	clk clock.Clock,
+	cfg *config.Config,
 	log *logger.Logger,
 ) *SpinService {
 	return &SpinService{
@@ -57,12 +284,50 @@ func ProvideSpinService(
 		freespinsRepo: freespinsRepo,
 		reelstripRepo: reelstripRepo,
 		txManager:     txManager,
+		batches:       batches,
 		pfService:     pfService,
 		trialService:  nil, // Trial service set separately via SetTrialService
+		segments:      segments,
+		vip:           vipService,
+		stats:         statsService,
+		bigWins:       bigWinService,
+		feed:          feedService,
+		mirror:        mirror,
+		dashboard:     dashboards,
+		jurisdictions: jurisdictions,
+		kyc:           kycService,
+		flags:         flags,
+		certLog:       certLog,
+		shadowEngine:  shadowEngine,
+		autoplays:     autoplays,
+		exposure:      exposures,
+		betChanges:    session.BetChangePolicy(cfg.Game.BetChangePolicy),
+		balanceLocks:  balanceLocks,
+		retries:       cfg.Game.BalanceRetryAttempts,
+		lockWait:      time.Duration(cfg.Game.BalanceLockWaitMillis) * time.Millisecond,
+		nonceRequired: cfg.Game.SpinNonceRequired,
+		timeout:       time.Duration(cfg.Game.SpinTimeoutMillis) * time.Millisecond,
+		clock:         clk,
 		logger:        log,
 	}
 }
 
+// ProvideSessionService provides the SessionService with jurisdiction limits, transactional takeovers and the shared clock
+func ProvideSessionService(
+	sessionRepo session.Repository,
+	playerRepo player.Repository,
+	jurisdictions jurisdiction.Service,
+	txManager *repository.TxManager,
+	clk clock.Clock,
+	log *logger.Logger,
+) session.Service {
+	svc := NewSessionService(sessionRepo, playerRepo, log).(*SessionService)
+	svc.SetJurisdictionResolver(jurisdictions)
+	svc.SetTxManager(txManager)
+	svc.SetClock(clk)
+	return svc
+}
+
 // ProvideFreeSpinsService provides a concrete FreeSpinsService with required pfService
 func ProvideFreeSpinsService(
 	sessionRepo session.Repository,
@@ -71,6 +336,16 @@ func ProvideFreeSpinsService(
 	playerRepo player.Repository,
 	gameEngine *engine.GameEngine,
 	pfService *ProvablyFairService,
+	statsService stats.Service,
+	bigWinService bigwin.Service,
+	feedService spinfeed.Service,
+	flags *featureflags.Service,
+	certLog certification.Service,
+	shadowEngine *ShadowEngine,
+	mirror *AnalyticsMirror,
+	dashboards dashboard.Service,
+	txManager *repository.TxManager,
+	cfg *config.Config,
 	log *logger.Logger,
 ) *FreeSpinsService {
 	return &FreeSpinsService{
@@ -80,6 +355,16 @@ func ProvideFreeSpinsService(
 		playerRepo:    playerRepo,
 		gameEngine:    gameEngine,
 		pfService:     pfService,
+		stats:         statsService,
+		bigWins:       bigWinService,
+		feed:          feedService,
+		mirror:        mirror,
+		dashboard:     dashboards,
+		flags:         flags,
+		certLog:       certLog,
+		shadowEngine:  shadowEngine,
+		txManager:     txManager,
+		nonceRequired: cfg.Game.SpinNonceRequired,
 		logger:        log,
 	}
 }
@@ -90,6 +375,10 @@ func ProvideProvablyFairService(
 	repo *repository.ProvablyFairGormRepository,
 	cache *infraCache.PFSessionCache,
 	reelstripRepo reelstrip.Repository,
+	stateWriter *PFStateWriter,
+	links launch.Repository,
+	rngProvider rng.Provider,
+	clk clock.Clock,
 	cfg *config.Config,
 	log *logger.Logger,
 ) (*ProvablyFairService, error) {
@@ -97,5 +386,17 @@ func ProvideProvablyFairService(
 	if err != nil {
 		return nil, err
 	}
-	return svc.(*ProvablyFairService), nil
+	pfService := svc.(*ProvablyFairService)
+	if stateWriter.Enabled() {
+		pfService.SetStateWriter(stateWriter)
+	}
+	pfService.SetOperatorLinks(links)
+	pfService.SetRNGProvider(rngProvider)
+	pfService.SetClock(clk)
+	return pfService, nil
+}
+
+// ProvidePFStateWriter provides the PF session-state writer
+func ProvidePFStateWriter(cfg *config.Config, repo *repository.ProvablyFairGormRepository, log *logger.Logger) *PFStateWriter {
+	return NewPFStateWriter(cfg, repo, log)
 }
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_asset_files_asset_path;
+DROP TABLE IF EXISTS asset_files;
@@ -0,0 +1,14 @@
+-- Content hashes and sizes for files stored under each asset folder
+-- Used by the asset manifest endpoint for differential downloads and integrity checks
+CREATE TABLE asset_files (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    asset_id        UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    path            VARCHAR(500) NOT NULL,
+    sha256          CHAR(64) NOT NULL,
+    size            BIGINT NOT NULL,
+    content_type    VARCHAR(100) NOT NULL DEFAULT '',
+    created_at      TIMESTAMP DEFAULT NOW(),
+    updated_at      TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_asset_files_asset_path ON asset_files (asset_id, path);
@@ -0,0 +1,2 @@
+-- Private assets are served through signed, expiring URLs instead of the public base_url
+ALTER TABLE assets ADD COLUMN is_private BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS segment_targets;
+DROP TABLE IF EXISTS segments;
+DROP TABLE IF EXISTS player_tags;
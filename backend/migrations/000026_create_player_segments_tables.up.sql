@@ -0,0 +1,44 @@
+-- Free-form labels attached to players by admins
+CREATE TABLE IF NOT EXISTS player_tags (
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    tag VARCHAR(64) NOT NULL,
+    created_by VARCHAR(100),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (player_id, tag)
+);
+
+CREATE INDEX idx_player_tags_tag ON player_tags(tag);
+
+-- Named player groups defined by rules on tags, lifetime stats and recent activity
+CREATE TABLE IF NOT EXISTS segments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) UNIQUE NOT NULL,
+    description TEXT,
+    rules JSONB NOT NULL DEFAULT '[]'::jsonb,
+    is_active BOOLEAN DEFAULT true,
+    created_by VARCHAR(100),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_segments_active ON segments(is_active);
+
+-- Settings applied to segment members: reel strip configs, bonuses, rate limits
+CREATE TABLE IF NOT EXISTS segment_targets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    segment_id UUID NOT NULL REFERENCES segments(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('reel_strip', 'bonus', 'rate_limit')),
+    priority INTEGER NOT NULL DEFAULT 0,
+    settings JSONB NOT NULL DEFAULT '{}'::jsonb,
+    is_active BOOLEAN DEFAULT true,
+    created_by VARCHAR(100),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_segment_targets_segment ON segment_targets(segment_id);
+CREATE INDEX idx_segment_targets_kind_active ON segment_targets(kind, is_active, priority DESC);
+
+COMMENT ON TABLE segments IS 'Player segments; a player is a member when every rule matches';
+COMMENT ON COLUMN segments.rules IS 'JSON array of rules, e.g. [{"field":"wagered","op":"gt","value":1000,"window_days":7}]';
+COMMENT ON COLUMN segment_targets.priority IS 'Highest priority target of a kind wins when a player matches several segments';
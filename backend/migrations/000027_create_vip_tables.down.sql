@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS player_vip_points;
+DROP TABLE IF EXISTS vip_tiers;
@@ -0,0 +1,22 @@
+-- Loyalty tiers reached by lifetime points, with configurable perks
+CREATE TABLE IF NOT EXISTS vip_tiers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    level INTEGER UNIQUE NOT NULL CHECK (level >= 1),
+    name VARCHAR(50) NOT NULL,
+    min_points BIGINT NOT NULL DEFAULT 0 CHECK (min_points >= 0),
+    perks JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_vip_tiers_min_points ON vip_tiers(min_points);
+
+-- Lifetime loyalty points per player, accrued on every paid spin
+CREATE TABLE IF NOT EXISTS player_vip_points (
+    player_id UUID PRIMARY KEY REFERENCES players(id) ON DELETE CASCADE,
+    points BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON COLUMN vip_tiers.perks IS 'Tier perks: {"points_multiplier": 1.5, "extra_free_spins": 2}';
+COMMENT ON COLUMN player_vip_points.points IS 'Lifetime points; the tier is derived from vip_tiers.min_points';
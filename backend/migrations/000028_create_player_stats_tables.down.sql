@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS player_lifetime_stats;
+DROP TABLE IF EXISTS player_daily_stats;
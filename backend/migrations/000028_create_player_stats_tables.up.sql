@@ -0,0 +1,56 @@
+-- Per-player daily rollups of spin activity (UTC days)
+-- spins/wagered count paid spins only; free spins are counted separately and add to won
+CREATE TABLE IF NOT EXISTS player_daily_stats (
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    spins BIGINT NOT NULL DEFAULT 0,
+    free_spins BIGINT NOT NULL DEFAULT 0,
+    free_spins_triggered BIGINT NOT NULL DEFAULT 0,
+    wagered DECIMAL(15, 2) NOT NULL DEFAULT 0.00,
+    won DECIMAL(15, 2) NOT NULL DEFAULT 0.00,
+    biggest_win DECIMAL(15, 2) NOT NULL DEFAULT 0.00,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (player_id, day)
+);
+
+-- Per-player all-time rollups
+CREATE TABLE IF NOT EXISTS player_lifetime_stats (
+    player_id UUID PRIMARY KEY REFERENCES players(id) ON DELETE CASCADE,
+    spins BIGINT NOT NULL DEFAULT 0,
+    free_spins BIGINT NOT NULL DEFAULT 0,
+    free_spins_triggered BIGINT NOT NULL DEFAULT 0,
+    wagered DECIMAL(15, 2) NOT NULL DEFAULT 0.00,
+    won DECIMAL(15, 2) NOT NULL DEFAULT 0.00,
+    biggest_win DECIMAL(15, 2) NOT NULL DEFAULT 0.00,
+    first_spin_at TIMESTAMP WITH TIME ZONE,
+    last_spin_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Backfill from existing spins; new spins are rolled up incrementally by the spin services
+INSERT INTO player_daily_stats (player_id, day, spins, free_spins, free_spins_triggered, wagered, won, biggest_win)
+SELECT
+    player_id,
+    (created_at AT TIME ZONE 'UTC')::date,
+    SUM(CASE WHEN is_free_spin THEN 0 ELSE 1 END),
+    SUM(CASE WHEN is_free_spin THEN 1 ELSE 0 END),
+    SUM(CASE WHEN free_spins_triggered THEN 1 ELSE 0 END),
+    SUM(CASE WHEN is_free_spin THEN 0 ELSE bet_amount END),
+    COALESCE(SUM(total_win), 0),
+    COALESCE(MAX(total_win), 0)
+FROM spins
+GROUP BY player_id, (created_at AT TIME ZONE 'UTC')::date;
+
+INSERT INTO player_lifetime_stats (player_id, spins, free_spins, free_spins_triggered, wagered, won, biggest_win, first_spin_at, last_spin_at)
+SELECT
+    player_id,
+    SUM(CASE WHEN is_free_spin THEN 0 ELSE 1 END),
+    SUM(CASE WHEN is_free_spin THEN 1 ELSE 0 END),
+    SUM(CASE WHEN free_spins_triggered THEN 1 ELSE 0 END),
+    SUM(CASE WHEN is_free_spin THEN 0 ELSE bet_amount END),
+    COALESCE(SUM(total_win), 0),
+    COALESCE(MAX(total_win), 0),
+    MIN(created_at),
+    MAX(created_at)
+FROM spins
+GROUP BY player_id;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS big_wins;
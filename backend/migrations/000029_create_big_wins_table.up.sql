@@ -0,0 +1,20 @@
+-- Spins whose win crossed the configured multiple of the bet, for marketing and monitoring
+CREATE TABLE IF NOT EXISTS big_wins (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    spin_id UUID UNIQUE NOT NULL REFERENCES spins(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    session_id UUID NOT NULL,
+    bet_amount DECIMAL(10, 2) NOT NULL,
+    win_amount DECIMAL(15, 2) NOT NULL,
+    multiplier DECIMAL(12, 2) NOT NULL,
+    is_free_spin BOOLEAN NOT NULL DEFAULT FALSE,
+    proof JSONB,
+    notified_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_big_wins_player_id ON big_wins(player_id);
+CREATE INDEX idx_big_wins_created_at ON big_wins(created_at DESC);
+
+COMMENT ON COLUMN big_wins.proof IS 'Provably fair proof: PF session, server seed hash, client seed, spin index, nonce and spin hashes';
+COMMENT ON COLUMN big_wins.notified_at IS 'When the configured notifiers accepted the event; NULL if none are configured or delivery failed';
@@ -0,0 +1,14 @@
+-- Per-game demo (trial) settings; games without a row use the built-in defaults
+CREATE TABLE IF NOT EXISTS trial_game_settings (
+    game_id UUID PRIMARY KEY REFERENCES games(id) ON DELETE CASCADE,
+    starting_balance DECIMAL(15, 2) NOT NULL CHECK (starting_balance > 0),
+    min_bet DECIMAL(10, 2) NOT NULL DEFAULT 0 CHECK (min_bet >= 0),
+    max_bet DECIMAL(10, 2) NOT NULL DEFAULT 0 CHECK (max_bet >= 0),
+    daily_reset BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_by VARCHAR(100),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON COLUMN trial_game_settings.max_bet IS '0 means no upper bet limit';
+COMMENT ON COLUMN trial_game_settings.daily_reset IS 'Restore the demo balance to starting_balance once per UTC day';
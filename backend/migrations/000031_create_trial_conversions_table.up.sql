@@ -0,0 +1,18 @@
+-- Maps converted trial sessions to the real player accounts they created.
+-- Trial statistics live here only and are never merged into players or spins.
+CREATE TABLE IF NOT EXISTS trial_conversions (
+    id UUID PRIMARY KEY,
+    trial_session_id UUID NOT NULL UNIQUE,
+    player_id UUID NOT NULL UNIQUE REFERENCES players(id) ON DELETE CASCADE,
+    game_id UUID,
+    trial_spins INTEGER NOT NULL DEFAULT 0,
+    trial_wagered DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    trial_won DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    trial_balance DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    trial_started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    converted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_trial_conversions_converted_at ON trial_conversions(converted_at);
+
+COMMENT ON COLUMN trial_conversions.trial_balance IS 'Demo balance at conversion time; not carried over to the player';
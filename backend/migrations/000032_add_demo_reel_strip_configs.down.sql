@@ -0,0 +1,7 @@
+ALTER TABLE trial_game_settings
+    DROP COLUMN IF EXISTS free_spins_config_id,
+    DROP COLUMN IF EXISTS base_game_config_id;
+
+ALTER TABLE reel_strip_configs DROP CONSTRAINT IF EXISTS chk_reel_strip_configs_demo_not_default;
+DROP INDEX IF EXISTS idx_reel_strip_configs_is_demo;
+ALTER TABLE reel_strip_configs DROP COLUMN IF EXISTS is_demo;
@@ -0,0 +1,17 @@
+-- Demo-only reel strip configs for trial play.
+-- Real-money resolution (defaults, player assignments, segment targets, free spins sessions)
+-- rejects configs with is_demo = TRUE.
+ALTER TABLE reel_strip_configs ADD COLUMN IF NOT EXISTS is_demo BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS idx_reel_strip_configs_is_demo ON reel_strip_configs(is_demo);
+
+-- A demo config can never be the real-money default
+ALTER TABLE reel_strip_configs ADD CONSTRAINT chk_reel_strip_configs_demo_not_default
+    CHECK (NOT (is_demo AND is_default));
+
+ALTER TABLE trial_game_settings
+    ADD COLUMN IF NOT EXISTS base_game_config_id UUID REFERENCES reel_strip_configs(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS free_spins_config_id UUID REFERENCES reel_strip_configs(id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN reel_strip_configs.is_demo IS 'Demo-only (trial) config; never used for real-money play';
+COMMENT ON COLUMN trial_game_settings.base_game_config_id IS 'Demo reel strip config for trial base game spins; NULL uses built-in trial strips';
+COMMENT ON COLUMN trial_game_settings.free_spins_config_id IS 'Demo reel strip config for trial free spins; NULL uses built-in trial strips';
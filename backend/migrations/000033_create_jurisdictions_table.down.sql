@@ -0,0 +1,6 @@
+ALTER TABLE game_sessions DROP COLUMN IF EXISTS reality_check_at;
+
+DROP INDEX IF EXISTS idx_players_jurisdiction_code;
+ALTER TABLE players DROP COLUMN IF EXISTS jurisdiction_code;
+
+DROP TABLE IF EXISTS jurisdictions;
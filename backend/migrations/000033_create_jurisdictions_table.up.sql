@@ -0,0 +1,31 @@
+-- Per-jurisdiction compliance rules (max bet, max win, reality checks, autoplay, RTP disclosure).
+-- Zero limits disable the corresponding rule. Amounts are in the platform currency.
+CREATE TABLE IF NOT EXISTS jurisdictions (
+    code VARCHAR(20) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    max_bet DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    max_win DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    reality_check_minutes INTEGER NOT NULL DEFAULT 0,
+    autoplay_allowed BOOLEAN NOT NULL DEFAULT TRUE,
+    rtp_disclosure BOOLEAN NOT NULL DEFAULT FALSE,
+    disclosed_rtp DECIMAL(5, 2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_jurisdictions_limits CHECK (max_bet >= 0 AND max_win >= 0 AND reality_check_minutes >= 0)
+);
+
+ALTER TABLE players
+    ADD COLUMN IF NOT EXISTS jurisdiction_code VARCHAR(20) REFERENCES jurisdictions(code) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_players_jurisdiction_code ON players(jurisdiction_code);
+
+ALTER TABLE game_sessions ADD COLUMN IF NOT EXISTS reality_check_at TIMESTAMP;
+
+-- Starting rules; review against current licence conditions before going live
+INSERT INTO jurisdictions (code, name, max_bet, max_win, reality_check_minutes, autoplay_allowed, rtp_disclosure, disclosed_rtp) VALUES
+    ('UKGC', 'UK Gambling Commission', 5.00, 0, 60, FALSE, TRUE, 96.50),
+    ('MGA', 'Malta Gaming Authority', 0, 0, 60, TRUE, TRUE, 96.50),
+    ('CURACAO', 'Curacao Gaming Control Board', 0, 0, 0, TRUE, FALSE, 0)
+ON CONFLICT (code) DO NOTHING;
+
+COMMENT ON COLUMN players.jurisdiction_code IS 'Compliance jurisdiction; NULL uses JURISDICTION_DEFAULT';
+COMMENT ON COLUMN game_sessions.reality_check_at IS 'Last reality check acknowledgement; NULL means none since session start';
@@ -0,0 +1,2 @@
+ALTER TABLE spin_logs DROP COLUMN IF EXISTS multipliers;
+ALTER TABLE game_configs DROP COLUMN IF EXISTS multiplier_ladder;
@@ -0,0 +1,5 @@
+-- Per-config cascade multiplier ladder; NULL uses the built-in 1x/2x/3x/5x (2x/4x/6x/10x free spins) ladder
+ALTER TABLE game_configs ADD COLUMN IF NOT EXISTS multiplier_ladder JSONB;
+
+-- Ladder applied to each spin, included in provably fair verification payloads
+ALTER TABLE spin_logs ADD COLUMN IF NOT EXISTS multipliers JSONB;
@@ -0,0 +1,2 @@
+-- Per-config free spins trigger rules; NULL uses the built-in rules (3+ scatters, 12 spins, +2 per extra, retrigger on)
+ALTER TABLE game_configs ADD COLUMN IF NOT EXISTS trigger_rules JSONB;
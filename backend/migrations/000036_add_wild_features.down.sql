@@ -0,0 +1,4 @@
+ALTER TABLE spin_logs DROP COLUMN IF EXISTS sticky_wilds;
+ALTER TABLE spin_logs DROP COLUMN IF EXISTS wild_features;
+ALTER TABLE free_spins_sessions DROP COLUMN IF EXISTS sticky_wilds;
+ALTER TABLE game_configs DROP COLUMN IF EXISTS wild_features;
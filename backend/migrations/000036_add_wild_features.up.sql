@@ -0,0 +1,9 @@
+-- Per-config wild features (sticky, expanding, multiplier); NULL disables them
+ALTER TABLE game_configs ADD COLUMN IF NOT EXISTS wild_features JSONB;
+
+-- Sticky wild positions held for a free spins session's next spin
+ALTER TABLE free_spins_sessions ADD COLUMN IF NOT EXISTS sticky_wilds JSONB;
+
+-- Wild features and incoming sticky wilds needed to replay a spin
+ALTER TABLE spin_logs ADD COLUMN IF NOT EXISTS wild_features JSONB;
+ALTER TABLE spin_logs ADD COLUMN IF NOT EXISTS sticky_wilds JSONB;
@@ -0,0 +1,49 @@
+-- Rebuild plain tables from the attached partitions; detached partitions are not restored
+CREATE TABLE spins_unpartitioned (LIKE spins INCLUDING DEFAULTS INCLUDING CONSTRAINTS);
+INSERT INTO spins_unpartitioned SELECT * FROM spins;
+
+CREATE TABLE spin_logs_unpartitioned (LIKE spin_logs INCLUDING DEFAULTS INCLUDING CONSTRAINTS);
+INSERT INTO spin_logs_unpartitioned SELECT * FROM spin_logs;
+
+DROP TABLE spin_logs;
+DROP TABLE spins;
+
+ALTER TABLE spins_unpartitioned RENAME TO spins;
+ALTER TABLE spins ADD PRIMARY KEY (id);
+ALTER TABLE spins ADD FOREIGN KEY (session_id) REFERENCES game_sessions(id) ON DELETE CASCADE;
+ALTER TABLE spins ADD FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE;
+ALTER TABLE spins ADD FOREIGN KEY (free_spins_session_id) REFERENCES free_spins_sessions(id);
+CREATE INDEX idx_spins_session_id ON spins(session_id);
+CREATE INDEX idx_spins_player_id ON spins(player_id);
+CREATE INDEX idx_spins_created_at ON spins(created_at);
+CREATE INDEX idx_spins_is_free_spin ON spins(is_free_spin);
+CREATE INDEX idx_spins_free_spins_session_id ON spins(free_spins_session_id);
+CREATE INDEX idx_spins_player_created ON spins(player_id, created_at DESC);
+CREATE INDEX idx_spins_session_created ON spins(session_id, created_at);
+CREATE INDEX idx_spins_grid ON spins USING GIN(grid);
+CREATE INDEX idx_spins_cascades ON spins USING GIN(cascades);
+
+ALTER TABLE spin_logs_unpartitioned RENAME TO spin_logs;
+ALTER TABLE spin_logs ADD PRIMARY KEY (id);
+ALTER TABLE spin_logs ADD FOREIGN KEY (pf_session_id) REFERENCES pf_sessions(id) ON DELETE RESTRICT;
+ALTER TABLE spin_logs ADD FOREIGN KEY (spin_id) REFERENCES spins(id) ON DELETE RESTRICT;
+ALTER TABLE spin_logs ADD FOREIGN KEY (reel_strip_config_id) REFERENCES reel_strip_configs(id) ON DELETE RESTRICT;
+CREATE INDEX idx_spin_logs_pf_session_id ON spin_logs(pf_session_id);
+CREATE INDEX idx_spin_logs_spin_id ON spin_logs(spin_id);
+CREATE INDEX idx_spin_logs_created_at ON spin_logs(created_at);
+CREATE INDEX idx_spin_logs_reel_strip_config ON spin_logs(reel_strip_config_id);
+CREATE UNIQUE INDEX idx_spin_logs_session_index ON spin_logs(pf_session_id, spin_index);
+CREATE UNIQUE INDEX idx_spin_logs_session_nonce ON spin_logs(pf_session_id, nonce);
+
+CREATE TRIGGER trigger_prevent_spin_log_update
+    BEFORE UPDATE ON spin_logs
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_spin_log_modification();
+
+CREATE TRIGGER trigger_prevent_spin_log_delete
+    BEFORE DELETE ON spin_logs
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_spin_log_modification();
+
+ALTER TABLE transactions ADD FOREIGN KEY (spin_id) REFERENCES spins(id) ON DELETE SET NULL;
+ALTER TABLE big_wins ADD FOREIGN KEY (spin_id) REFERENCES spins(id) ON DELETE CASCADE;
@@ -0,0 +1,115 @@
+-- Monthly range partitioning on created_at for the append-only spins and spin_logs tables.
+-- The existing tables become a single "legacy" partition, so no rows are copied; the
+-- partition maintainer creates upcoming months and detaches expired ones.
+
+-- Partitioned tables need the partition key in every unique constraint, so spins.id
+-- alone can no longer be referenced by foreign keys
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_spin_id_fkey;
+ALTER TABLE big_wins DROP CONSTRAINT IF EXISTS big_wins_spin_id_fkey;
+ALTER TABLE spin_logs DROP CONSTRAINT IF EXISTS spin_logs_spin_id_fkey;
+
+-- spins
+ALTER TABLE spins RENAME TO spins_legacy;
+ALTER TABLE spins_legacy DROP CONSTRAINT spins_pkey;
+DROP INDEX IF EXISTS idx_spins_session_id;
+DROP INDEX IF EXISTS idx_spins_player_id;
+DROP INDEX IF EXISTS idx_spins_created_at;
+DROP INDEX IF EXISTS idx_spins_is_free_spin;
+DROP INDEX IF EXISTS idx_spins_free_spins_session_id;
+DROP INDEX IF EXISTS idx_spins_player_created;
+DROP INDEX IF EXISTS idx_spins_session_created;
+DROP INDEX IF EXISTS idx_spins_grid;
+DROP INDEX IF EXISTS idx_spins_cascades;
+UPDATE spins_legacy SET created_at = NOW() WHERE created_at IS NULL;
+ALTER TABLE spins_legacy ALTER COLUMN created_at SET NOT NULL;
+
+CREATE TABLE spins (
+    LIKE spins_legacy INCLUDING DEFAULTS INCLUDING CONSTRAINTS,
+    PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+ALTER TABLE spins ADD FOREIGN KEY (session_id) REFERENCES game_sessions(id) ON DELETE CASCADE;
+ALTER TABLE spins ADD FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE;
+ALTER TABLE spins ADD FOREIGN KEY (free_spins_session_id) REFERENCES free_spins_sessions(id);
+
+-- spin_logs
+DROP TRIGGER IF EXISTS trigger_prevent_spin_log_update ON spin_logs;
+DROP TRIGGER IF EXISTS trigger_prevent_spin_log_delete ON spin_logs;
+ALTER TABLE spin_logs RENAME TO spin_logs_legacy;
+ALTER TABLE spin_logs_legacy DROP CONSTRAINT spin_logs_pkey;
+DROP INDEX IF EXISTS idx_spin_logs_pf_session_id;
+DROP INDEX IF EXISTS idx_spin_logs_spin_id;
+DROP INDEX IF EXISTS idx_spin_logs_created_at;
+DROP INDEX IF EXISTS idx_spin_logs_reel_strip_config;
+DROP INDEX IF EXISTS idx_spin_logs_session_index;
+DROP INDEX IF EXISTS idx_spin_logs_session_nonce;
+
+CREATE TABLE spin_logs (
+    LIKE spin_logs_legacy INCLUDING DEFAULTS INCLUDING CONSTRAINTS,
+    PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+ALTER TABLE spin_logs ADD FOREIGN KEY (pf_session_id) REFERENCES pf_sessions(id) ON DELETE RESTRICT;
+ALTER TABLE spin_logs ADD FOREIGN KEY (reel_strip_config_id) REFERENCES reel_strip_configs(id) ON DELETE RESTRICT;
+
+-- Attach the legacy tables up to the end of the current month (or of their newest row),
+-- then create the next months so inserts never land in the default partition
+DO $$
+DECLARE
+    tbl TEXT;
+    bound TIMESTAMPTZ;
+    newest TIMESTAMPTZ;
+    month_start TIMESTAMPTZ;
+BEGIN
+    FOREACH tbl IN ARRAY ARRAY['spins', 'spin_logs'] LOOP
+        EXECUTE format('SELECT MAX(created_at) FROM %I', tbl || '_legacy') INTO newest;
+        bound := date_trunc('month', GREATEST(NOW(), COALESCE(newest, NOW())) AT TIME ZONE 'UTC') AT TIME ZONE 'UTC' + INTERVAL '1 month';
+
+        EXECUTE format('ALTER TABLE %I ATTACH PARTITION %I FOR VALUES FROM (MINVALUE) TO (%L)', tbl, tbl || '_legacy', bound);
+
+        FOR i IN 0..2 LOOP
+            month_start := bound + make_interval(months => i);
+            EXECUTE format(
+                'CREATE TABLE IF NOT EXISTS %I PARTITION OF %I FOR VALUES FROM (%L) TO (%L)',
+                tbl || '_p' || to_char(month_start AT TIME ZONE 'UTC', 'YYYY_MM'), tbl,
+                month_start, month_start + INTERVAL '1 month'
+            );
+        END LOOP;
+
+        EXECUTE format('CREATE TABLE IF NOT EXISTS %I PARTITION OF %I DEFAULT', tbl || '_default', tbl);
+    END LOOP;
+END $$;
+
+-- Indexes are declared on the parents and cascade to every partition
+CREATE INDEX idx_spins_session_id ON spins(session_id);
+CREATE INDEX idx_spins_player_id ON spins(player_id);
+CREATE INDEX idx_spins_created_at ON spins(created_at);
+CREATE INDEX idx_spins_is_free_spin ON spins(is_free_spin);
+CREATE INDEX idx_spins_free_spins_session_id ON spins(free_spins_session_id);
+CREATE INDEX idx_spins_player_created ON spins(player_id, created_at DESC);
+CREATE INDEX idx_spins_session_created ON spins(session_id, created_at);
+CREATE INDEX idx_spins_id ON spins(id);
+CREATE INDEX idx_spins_grid ON spins USING GIN(grid);
+CREATE INDEX idx_spins_cascades ON spins USING GIN(cascades);
+
+CREATE INDEX idx_spin_logs_pf_session_id ON spin_logs(pf_session_id);
+CREATE INDEX idx_spin_logs_spin_id ON spin_logs(spin_id);
+CREATE INDEX idx_spin_logs_created_at ON spin_logs(created_at);
+CREATE INDEX idx_spin_logs_reel_strip_config ON spin_logs(reel_strip_config_id);
+-- Uniqueness of spin_index/nonce per PF session can no longer be enforced across partitions;
+-- the PF service assigns both sequentially from pf_sessions.last_nonce
+CREATE INDEX idx_spin_logs_session_index ON spin_logs(pf_session_id, spin_index);
+CREATE INDEX idx_spin_logs_session_nonce ON spin_logs(pf_session_id, nonce);
+
+CREATE TRIGGER trigger_prevent_spin_log_update
+    BEFORE UPDATE ON spin_logs
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_spin_log_modification();
+
+CREATE TRIGGER trigger_prevent_spin_log_delete
+    BEFORE DELETE ON spin_logs
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_spin_log_modification();
+
+COMMENT ON TABLE spins IS 'Spin results, range-partitioned by month on created_at';
+COMMENT ON TABLE spin_logs IS 'Append-only audit log for provably fair spins - NO UPDATE/DELETE; range-partitioned by month on created_at';
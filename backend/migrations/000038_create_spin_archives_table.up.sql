@@ -0,0 +1,21 @@
+-- Spin table partitions exported to cold storage and dropped from the hot DB
+CREATE TABLE IF NOT EXISTS spin_archives (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source_table VARCHAR(64) NOT NULL,
+    partition_name VARCHAR(64) NOT NULL UNIQUE,
+    range_start TIMESTAMP WITH TIME ZONE,
+    range_end TIMESTAMP WITH TIME ZONE NOT NULL,
+    object_name VARCHAR(255) NOT NULL,
+    row_count BIGINT NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    checksum VARCHAR(64) NOT NULL,
+    archived_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    restored_at TIMESTAMP WITH TIME ZONE,
+    restored_by VARCHAR(255)
+);
+
+CREATE INDEX idx_spin_archives_source_table ON spin_archives(source_table, range_end DESC);
+
+COMMENT ON COLUMN spin_archives.range_start IS 'Inclusive lower bound of the partition; NULL for the unbounded legacy partition';
+COMMENT ON COLUMN spin_archives.checksum IS 'SHA-256 of the gzip-compressed JSON Lines object, verified on restore';
+COMMENT ON COLUMN spin_archives.restored_at IS 'Set while the archived rows are restored into the hot DB for an audit';
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS admin_two_factor_events;
+DROP TABLE IF EXISTS admin_recovery_codes;
+
+ALTER TABLE admins
+    DROP COLUMN IF EXISTS two_factor_verified_at,
+    DROP COLUMN IF EXISTS two_factor_last_step,
+    DROP COLUMN IF EXISTS two_factor_enabled_at,
+    DROP COLUMN IF EXISTS two_factor_enabled,
+    DROP COLUMN IF EXISTS totp_secret;
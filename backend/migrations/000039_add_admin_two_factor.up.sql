@@ -0,0 +1,34 @@
+-- TOTP two-factor authentication for admins
+ALTER TABLE admins
+    ADD COLUMN IF NOT EXISTS totp_secret TEXT,
+    ADD COLUMN IF NOT EXISTS two_factor_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS two_factor_enabled_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS two_factor_last_step BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS two_factor_verified_at TIMESTAMP WITH TIME ZONE;
+
+COMMENT ON COLUMN admins.totp_secret IS 'AES-256-GCM encrypted base32 TOTP secret; set on enrollment, NULL when 2FA is off';
+COMMENT ON COLUMN admins.two_factor_last_step IS 'Last accepted TOTP time step; codes at or before it are rejected as replays';
+COMMENT ON COLUMN admins.two_factor_verified_at IS 'Last successful second-factor check; unlocks destructive operations for the step-up window';
+
+-- Single-use recovery codes, stored as SHA-256 hashes
+CREATE TABLE IF NOT EXISTS admin_recovery_codes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    admin_id UUID NOT NULL REFERENCES admins(id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_admin_recovery_codes_admin_hash ON admin_recovery_codes(admin_id, code_hash);
+
+-- Audit trail of two-factor events
+CREATE TABLE IF NOT EXISTS admin_two_factor_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    admin_id UUID NOT NULL REFERENCES admins(id) ON DELETE CASCADE,
+    event VARCHAR(50) NOT NULL,
+    ip_address VARCHAR(45),
+    detail VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_admin_two_factor_events_admin ON admin_two_factor_events(admin_id, created_at DESC);
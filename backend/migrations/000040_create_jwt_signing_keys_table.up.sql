@@ -0,0 +1,18 @@
+-- Rotating asymmetric keys for admin auth tokens, published at /.well-known/jwks.json
+CREATE TABLE IF NOT EXISTS jwt_signing_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kid VARCHAR(64) NOT NULL UNIQUE,
+    algorithm VARCHAR(16) NOT NULL,
+    private_key TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    activates_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    retired_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE
+);
+
+COMMENT ON COLUMN jwt_signing_keys.private_key IS 'AES-256-GCM encrypted PKCS#8 PEM private key';
+COMMENT ON COLUMN jwt_signing_keys.activates_at IS 'Keys are published before they sign so every instance can verify them first';
+COMMENT ON COLUMN jwt_signing_keys.expires_at IS 'Set on retirement to the expiry of the last token the key could have signed';
+
+CREATE INDEX idx_jwt_signing_keys_expires_at ON jwt_signing_keys(expires_at);
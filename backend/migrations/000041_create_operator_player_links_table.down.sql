@@ -0,0 +1 @@
+DROP TABLE IF EXISTS operator_player_links;
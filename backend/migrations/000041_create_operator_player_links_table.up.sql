@@ -0,0 +1,11 @@
+-- Links an operator's players to the local accounts created for them at game launch
+CREATE TABLE IF NOT EXISTS operator_player_links (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    operator_id VARCHAR(64) NOT NULL,
+    external_player_id VARCHAR(255) NOT NULL,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (operator_id, external_player_id)
+);
+
+CREATE INDEX idx_operator_player_links_player_id ON operator_player_links(player_id);
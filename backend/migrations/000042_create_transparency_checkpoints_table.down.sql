@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS trigger_prevent_transparency_checkpoint_delete ON transparency_checkpoints;
+DROP TRIGGER IF EXISTS trigger_prevent_transparency_checkpoint_update ON transparency_checkpoints;
+DROP FUNCTION IF EXISTS prevent_transparency_checkpoint_modification();
+DROP TABLE IF EXISTS transparency_checkpoints;
@@ -0,0 +1,64 @@
+-- Transparency log: hash-chained Merkle roots over spin_logs.spin_hash (APPEND-ONLY)
+-- Only the anchor columns may be filled in once; everything else is immutable
+CREATE TABLE IF NOT EXISTS transparency_checkpoints (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    sequence BIGINT NOT NULL UNIQUE,
+
+    -- Covered spin_logs range, ordered by (created_at, id)
+    first_spin_log_id UUID NOT NULL,
+    last_spin_log_id UUID NOT NULL,
+    from_created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    to_created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    leaf_count INTEGER NOT NULL,
+
+    -- Hash chain
+    merkle_root VARCHAR(64) NOT NULL,
+    prev_hash VARCHAR(64) NOT NULL,
+    hash VARCHAR(64) NOT NULL,
+
+    -- External anchor (e.g. OpenTimestamps proof)
+    anchor_name VARCHAR(32),
+    anchor_ref TEXT,
+    anchored_at TIMESTAMP WITH TIME ZONE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_transparency_checkpoints_to_created_at ON transparency_checkpoints(to_created_at);
+CREATE INDEX idx_transparency_checkpoints_unanchored ON transparency_checkpoints(sequence) WHERE anchored_at IS NULL;
+
+CREATE OR REPLACE FUNCTION prevent_transparency_checkpoint_modification()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        RAISE EXCEPTION 'transparency_checkpoints table is append-only: DELETE is not allowed';
+    END IF;
+    IF OLD.anchored_at IS NOT NULL
+        OR NEW.sequence IS DISTINCT FROM OLD.sequence
+        OR NEW.first_spin_log_id IS DISTINCT FROM OLD.first_spin_log_id
+        OR NEW.last_spin_log_id IS DISTINCT FROM OLD.last_spin_log_id
+        OR NEW.from_created_at IS DISTINCT FROM OLD.from_created_at
+        OR NEW.to_created_at IS DISTINCT FROM OLD.to_created_at
+        OR NEW.leaf_count IS DISTINCT FROM OLD.leaf_count
+        OR NEW.merkle_root IS DISTINCT FROM OLD.merkle_root
+        OR NEW.prev_hash IS DISTINCT FROM OLD.prev_hash
+        OR NEW.hash IS DISTINCT FROM OLD.hash
+        OR NEW.created_at IS DISTINCT FROM OLD.created_at THEN
+        RAISE EXCEPTION 'transparency_checkpoints table is append-only: only an unset anchor may be recorded';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_prevent_transparency_checkpoint_update
+    BEFORE UPDATE ON transparency_checkpoints
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_transparency_checkpoint_modification();
+
+CREATE TRIGGER trigger_prevent_transparency_checkpoint_delete
+    BEFORE DELETE ON transparency_checkpoints
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_transparency_checkpoint_modification();
+
+COMMENT ON TABLE transparency_checkpoints IS 'Append-only transparency log of Merkle roots over spin hashes';
+COMMENT ON COLUMN transparency_checkpoints.hash IS 'SHA256(sequence|first_spin_log_id|last_spin_log_id|leaf_count|merkle_root|prev_hash)';
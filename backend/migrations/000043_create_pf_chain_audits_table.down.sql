@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_pf_sessions_ended_at;
+DROP TABLE IF EXISTS pf_chain_audits;
@@ -0,0 +1,14 @@
+-- Results of the scheduled self-audit re-verifying ended PF sessions' hash chains
+CREATE TABLE IF NOT EXISTS pf_chain_audits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    pf_session_id UUID NOT NULL UNIQUE REFERENCES pf_sessions(id) ON DELETE RESTRICT,
+    player_id UUID NOT NULL,
+    spin_count BIGINT NOT NULL,
+    valid BOOLEAN NOT NULL,
+    error TEXT,
+    checked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_pf_chain_audits_checked_at ON pf_chain_audits(checked_at);
+CREATE INDEX idx_pf_chain_audits_failed ON pf_chain_audits(checked_at) WHERE valid = false;
+CREATE INDEX IF NOT EXISTS idx_pf_sessions_ended_at ON pf_sessions(ended_at) WHERE status = 'ended';
@@ -0,0 +1,5 @@
+-- Lets the spin reconciler find settled spins whose free spins award was never created
+-- without scanning every partition's spins; resolved triggers drop out of the index
+CREATE INDEX IF NOT EXISTS idx_spins_unresolved_triggers
+    ON spins (created_at)
+    WHERE free_spins_triggered = TRUE AND is_free_spin = FALSE AND free_spins_session_id IS NULL;
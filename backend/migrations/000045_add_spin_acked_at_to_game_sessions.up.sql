@@ -0,0 +1,2 @@
+-- Spins created after this mark are pending: settled, but not confirmed as received by the client
+ALTER TABLE game_sessions ADD COLUMN spin_acked_at TIMESTAMP WITH TIME ZONE;
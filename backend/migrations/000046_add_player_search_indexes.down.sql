@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_player_assignments_free_spins_config;
+DROP INDEX IF EXISTS idx_player_assignments_base_config;
+DROP INDEX IF EXISTS idx_players_last_login_at;
+DROP INDEX IF EXISTS idx_players_username_id;
+DROP INDEX IF EXISTS idx_players_total_wagered_id;
+DROP INDEX IF EXISTS idx_players_balance_id;
+DROP INDEX IF EXISTS idx_players_created_at_id;
+DROP INDEX IF EXISTS idx_players_email_trgm;
+DROP INDEX IF EXISTS idx_players_username_trgm;
@@ -0,0 +1,16 @@
+-- Admin player search: partial username/email matches are served by trigram indexes
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_players_username_trgm ON players USING GIN (LOWER(username) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_players_email_trgm ON players USING GIN (LOWER(email) gin_trgm_ops);
+
+-- Keyset pagination walks (sort column, id) in either direction
+CREATE INDEX IF NOT EXISTS idx_players_created_at_id ON players (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_players_balance_id ON players (balance, id);
+CREATE INDEX IF NOT EXISTS idx_players_total_wagered_id ON players (total_wagered, id);
+CREATE INDEX IF NOT EXISTS idx_players_username_id ON players (username, id);
+CREATE INDEX IF NOT EXISTS idx_players_last_login_at ON players (last_login_at);
+
+-- Assigned config filter looks up active assignments by either config
+CREATE INDEX IF NOT EXISTS idx_player_assignments_base_config ON player_reel_strip_assignments (base_game_config_id) WHERE is_active = true;
+CREATE INDEX IF NOT EXISTS idx_player_assignments_free_spins_config ON player_reel_strip_assignments (free_spins_config_id) WHERE is_active = true;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS dispute_events;
+DROP TABLE IF EXISTS disputes;
@@ -0,0 +1,41 @@
+-- Spin disputes worked by support staff
+-- spin_id has no foreign key: spins is partitioned by created_at
+CREATE TABLE IF NOT EXISTS disputes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    spin_id UUID NOT NULL,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'open'
+        CHECK (status IN ('open', 'investigating', 'resolved', 'rejected')),
+    reason TEXT NOT NULL,
+    opened_by VARCHAR(100),
+    assigned_to UUID REFERENCES admins(id) ON DELETE SET NULL,
+
+    -- Provably fair replay of the spin attached during investigation
+    verification JSONB,
+
+    resolution_notes TEXT,
+    resolved_by VARCHAR(100),
+    resolved_at TIMESTAMP WITH TIME ZONE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- At most one dispute per spin that is not closed
+CREATE UNIQUE INDEX idx_disputes_spin_active ON disputes(spin_id) WHERE status IN ('open', 'investigating');
+CREATE INDEX idx_disputes_spin_id ON disputes(spin_id);
+CREATE INDEX idx_disputes_player_id ON disputes(player_id, created_at DESC);
+CREATE INDEX idx_disputes_status ON disputes(status, created_at DESC);
+CREATE INDEX idx_disputes_assigned_to ON disputes(assigned_to) WHERE assigned_to IS NOT NULL;
+
+-- Dispute history: assignments, status changes, verifications and notes
+CREATE TABLE IF NOT EXISTS dispute_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    dispute_id UUID NOT NULL REFERENCES disputes(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL,
+    actor VARCHAR(100),
+    message TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_dispute_events_dispute_id ON dispute_events(dispute_id, created_at);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_players_kyc_pending;
+
+ALTER TABLE players
+    DROP COLUMN IF EXISTS kyc_verified_at,
+    DROP COLUMN IF EXISTS kyc_reference,
+    DROP COLUMN IF EXISTS kyc_provider,
+    DROP COLUMN IF EXISTS kyc_status;
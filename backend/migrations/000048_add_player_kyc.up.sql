@@ -0,0 +1,10 @@
+-- Identity verification state; kyc_reference identifies the player's latest verification with kyc_provider
+ALTER TABLE players
+    ADD COLUMN IF NOT EXISTS kyc_status VARCHAR(20) NOT NULL DEFAULT 'none'
+        CHECK (kyc_status IN ('none', 'pending', 'verified', 'rejected')),
+    ADD COLUMN IF NOT EXISTS kyc_provider VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS kyc_reference VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS kyc_verified_at TIMESTAMP WITH TIME ZONE;
+
+-- Support queues of players awaiting a decision
+CREATE INDEX IF NOT EXISTS idx_players_kyc_pending ON players(updated_at) WHERE kyc_status = 'pending';
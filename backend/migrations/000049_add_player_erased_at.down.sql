@@ -0,0 +1,2 @@
+ALTER TABLE players
+    DROP COLUMN IF EXISTS erased_at;
@@ -0,0 +1,4 @@
+-- When a player's personal data was erased on request; the row itself is kept,
+-- pseudonymized, because provably fair and financial records still reference it
+ALTER TABLE players
+    ADD COLUMN IF NOT EXISTS erased_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,4 @@
+-- RNG provider each spin was drawn with (hkdf, crypto, deterministic or hardware);
+-- every spin before providers became configurable was drawn with HKDF
+ALTER TABLE spins
+    ADD COLUMN IF NOT EXISTS rng_provider VARCHAR(32) NOT NULL DEFAULT 'hkdf';
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS trigger_prevent_certification_event_delete ON certification_events;
+DROP TRIGGER IF EXISTS trigger_prevent_certification_event_update ON certification_events;
+DROP FUNCTION IF EXISTS prevent_certification_event_modification();
+DROP TABLE IF EXISTS certification_events;
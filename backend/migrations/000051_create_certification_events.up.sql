@@ -0,0 +1,57 @@
+-- Certification log: every input and output of a spin as required by GLI/BMM test labs (APPEND-ONLY)
+-- Written only while CERTIFICATION_LOG_ENABLED is set
+CREATE TABLE IF NOT EXISTS certification_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    spin_id UUID NOT NULL UNIQUE,
+    session_id UUID NOT NULL,
+    player_id UUID NOT NULL,
+    is_free_spin BOOLEAN NOT NULL DEFAULT FALSE,
+    free_spins_session_id UUID,
+    game_mode VARCHAR(32),
+
+    -- Inputs
+    bet_amount DECIMAL(10, 2) NOT NULL,
+    engine_version VARCHAR(32) NOT NULL,
+    paytable_version VARCHAR(32) NOT NULL,
+    rng_provider VARCHAR(32) NOT NULL,
+    rng_draws JSONB NOT NULL,
+    nonce BIGINT NOT NULL,
+    spin_hash VARCHAR(64) NOT NULL,
+    reel_strip_config_id UUID,
+    strip_checksums JSONB NOT NULL,
+    multipliers JSONB,
+
+    -- Outputs
+    reel_positions JSONB NOT NULL,
+    grid JSONB NOT NULL,
+    cascades JSONB,
+    total_win DECIMAL(15, 2) NOT NULL,
+    scatter_count INTEGER NOT NULL,
+    free_spins_triggered BOOLEAN NOT NULL,
+    balance_before DECIMAL(15, 2) NOT NULL,
+    balance_after DECIMAL(15, 2) NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_certification_events_created_at ON certification_events(created_at, id);
+
+CREATE OR REPLACE FUNCTION prevent_certification_event_modification()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'certification_events table is append-only: % is not allowed', TG_OP;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_prevent_certification_event_update
+    BEFORE UPDATE ON certification_events
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_certification_event_modification();
+
+CREATE TRIGGER trigger_prevent_certification_event_delete
+    BEFORE DELETE ON certification_events
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_certification_event_modification();
+
+COMMENT ON TABLE certification_events IS 'Append-only certification log of spin inputs and outputs';
+COMMENT ON COLUMN certification_events.rng_draws IS 'Every RNG call of the spin in order: call, args and result';
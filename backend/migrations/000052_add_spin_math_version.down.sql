@@ -0,0 +1,5 @@
+ALTER TABLE spin_logs
+    DROP COLUMN IF EXISTS math_version;
+
+ALTER TABLE spins
+    DROP COLUMN IF EXISTS math_version;
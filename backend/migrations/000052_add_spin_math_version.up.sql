@@ -0,0 +1,8 @@
+-- Engine build, paytable hash and multiplier ladder hash each spin was computed with,
+-- so past results can be replayed with the exact math that produced them;
+-- spins before versioning are left empty
+ALTER TABLE spins
+    ADD COLUMN IF NOT EXISTS math_version VARCHAR(64) NOT NULL DEFAULT '';
+
+ALTER TABLE spin_logs
+    ADD COLUMN IF NOT EXISTS math_version VARCHAR(64);
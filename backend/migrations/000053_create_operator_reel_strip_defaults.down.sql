@@ -0,0 +1 @@
+DROP TABLE IF EXISTS operator_reel_strip_defaults;
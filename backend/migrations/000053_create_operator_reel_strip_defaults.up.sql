@@ -0,0 +1,15 @@
+-- Operator-level reel strip defaults: each operator's players resolve to its contracted RTP
+-- configs after player assignments and segment targets, before the global default
+CREATE TABLE IF NOT EXISTS operator_reel_strip_defaults (
+    operator_id VARCHAR(100) PRIMARY KEY,
+    target_rtp DECIMAL(5, 2) NOT NULL,
+    base_game_config_id UUID REFERENCES reel_strip_configs(id) ON DELETE RESTRICT,
+    free_spins_config_id UUID REFERENCES reel_strip_configs(id) ON DELETE RESTRICT,
+    updated_by VARCHAR(100),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_operator_reel_strip_defaults_config CHECK (base_game_config_id IS NOT NULL OR free_spins_config_id IS NOT NULL)
+);
+
+COMMENT ON TABLE operator_reel_strip_defaults IS 'Default reel strip configs per operator, for its contracted RTP';
+COMMENT ON COLUMN operator_reel_strip_defaults.target_rtp IS 'RTP contracted with the operator; configs with a target RTP must match it';
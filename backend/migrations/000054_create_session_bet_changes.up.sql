@@ -0,0 +1,15 @@
+-- Bet changes within a game session, recorded when a spin's bet differs from the session's
+CREATE TABLE IF NOT EXISTS session_bet_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    session_id UUID NOT NULL REFERENCES game_sessions(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    spin_id UUID NOT NULL,
+    previous_bet DECIMAL(10, 2) NOT NULL,
+    new_bet DECIMAL(10, 2) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_bet_changes_session_id ON session_bet_changes(session_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_session_bet_changes_player_id ON session_bet_changes(player_id, created_at);
+
+COMMENT ON TABLE session_bet_changes IS 'Bet amount changes within game sessions, per BET_CHANGE_POLICY';
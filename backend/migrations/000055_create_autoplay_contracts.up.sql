@@ -0,0 +1,25 @@
+-- Server-tracked autoplay: spins sent as autoplay are counted against the session's running
+-- contract and refused once one of its stop conditions is met
+CREATE TABLE IF NOT EXISTS autoplay_contracts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    session_id UUID NOT NULL REFERENCES game_sessions(id) ON DELETE CASCADE,
+    total_spins INTEGER NOT NULL CHECK (total_spins > 0),
+    single_win_limit DECIMAL(15, 2),
+    loss_limit DECIMAL(15, 2),
+    spins_played INTEGER NOT NULL DEFAULT 0,
+    total_wagered DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    total_won DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL,
+    stop_reason VARCHAR(20),
+    stopped_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- At most one running contract per session
+CREATE UNIQUE INDEX IF NOT EXISTS idx_autoplay_contracts_active_session ON autoplay_contracts(session_id) WHERE status = 'active';
+CREATE INDEX IF NOT EXISTS idx_autoplay_contracts_player_id ON autoplay_contracts(player_id, created_at);
+
+COMMENT ON TABLE autoplay_contracts IS 'Autoplay runs and their stop conditions, enforced server-side on autoplay spins';
+COMMENT ON COLUMN autoplay_contracts.loss_limit IS 'Autoplay stops before net loss (wagered minus won) could exceed this amount';
@@ -0,0 +1,24 @@
+-- Player game-history downloads: queued by the player, generated in the background into
+-- object storage and downloaded through short-lived signed links
+CREATE TABLE IF NOT EXISTS history_exports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    format VARCHAR(10) NOT NULL,
+    range_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    range_end TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    object_name VARCHAR(255),
+    spin_count INTEGER NOT NULL DEFAULT 0,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    CHECK (range_end > range_start)
+);
+
+CREATE INDEX IF NOT EXISTS idx_history_exports_player_id ON history_exports(player_id, created_at);
+-- The worker polls for queued exports
+CREATE INDEX IF NOT EXISTS idx_history_exports_pending ON history_exports(created_at) WHERE status = 'pending';
+
+COMMENT ON TABLE history_exports IS 'Player-requested CSV and PDF exports of their spin history';
+COMMENT ON COLUMN history_exports.range_end IS 'Exclusive end of the exported range';
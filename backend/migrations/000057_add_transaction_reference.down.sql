@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_transactions_player_reference;
+ALTER TABLE transactions DROP COLUMN IF EXISTS reference;
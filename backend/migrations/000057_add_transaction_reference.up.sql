@@ -0,0 +1,7 @@
+-- Credits applied from outside the spin flow (operator wallet deposits, bonus payouts) carry the
+-- crediting system's reference so a retried credit is not applied twice
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS reference VARCHAR(100);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_player_reference ON transactions(player_id, reference) WHERE reference IS NOT NULL;
+
+COMMENT ON COLUMN transactions.reference IS 'Crediting system''s unique ID for the credit';
@@ -0,0 +1,35 @@
+-- Four-eyes approval: sensitive admin changes are proposed by one admin and take effect
+-- only once a second admin approves them
+CREATE TABLE IF NOT EXISTS change_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    action VARCHAR(50) NOT NULL,
+    params JSONB NOT NULL,
+    summary TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected', 'expired', 'failed')),
+
+    proposed_by UUID NOT NULL REFERENCES admins(id),
+    proposed_by_name VARCHAR(100) NOT NULL,
+    reviewed_by UUID REFERENCES admins(id),
+    reviewed_by_name VARCHAR(100),
+    review_note TEXT,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+
+    -- Why an approved change could not be applied
+    error TEXT,
+    applied_at TIMESTAMP WITH TIME ZONE,
+
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Only a proposer's own rejection (a withdrawal) may leave reviewer and proposer equal
+    CHECK (reviewed_by IS NULL OR reviewed_by <> proposed_by OR status = 'rejected')
+);
+
+CREATE INDEX idx_change_requests_status ON change_requests(status, created_at DESC);
+CREATE INDEX idx_change_requests_pending_expiry ON change_requests(expires_at) WHERE status = 'pending';
+CREATE INDEX idx_change_requests_proposed_by ON change_requests(proposed_by, created_at DESC);
+
+COMMENT ON TABLE change_requests IS 'Admin changes awaiting or given a second admin''s approval';
+COMMENT ON COLUMN change_requests.params IS 'Action arguments, applied as proposed once approved';
@@ -0,0 +1,41 @@
+-- Reel strip canaries: a newly activated config serves a percentage of players for a trial
+-- period before it becomes the game mode default, and is reverted if its realized RTP drifts
+CREATE TABLE IF NOT EXISTS reel_strip_canaries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_mode VARCHAR(20) NOT NULL,
+    config_id UUID NOT NULL REFERENCES reel_strip_configs(id),
+    -- The default the canary would replace, which players outside it keep getting
+    baseline_config_id UUID NOT NULL REFERENCES reel_strip_configs(id),
+    percent INTEGER NOT NULL CHECK (percent BETWEEN 1 AND 99),
+    -- Largest tolerated gap, in RTP percentage points, between realized and target RTP
+    max_rtp_deviation DECIMAL(5,2) NOT NULL CHECK (max_rtp_deviation > 0),
+    -- Spins needed before the realized RTP is judged
+    min_spins BIGINT NOT NULL CHECK (min_spins > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'running'
+        CHECK (status IN ('running', 'promoted', 'reverted', 'aborted')),
+
+    -- Realized performance as of the last monitor pass
+    spins BIGINT NOT NULL DEFAULT 0,
+    wagered DECIMAL(20,2) NOT NULL DEFAULT 0,
+    won DECIMAL(20,2) NOT NULL DEFAULT 0,
+    realized_rtp DECIMAL(7,2),
+    checked_at TIMESTAMP WITH TIME ZONE,
+
+    reason TEXT,
+    started_by VARCHAR(100) NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    finished_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CHECK (config_id <> baseline_config_id),
+    CHECK (ends_at > started_at)
+);
+
+-- At most one canary runs per game mode
+CREATE UNIQUE INDEX idx_reel_strip_canaries_running ON reel_strip_canaries(game_mode) WHERE status = 'running';
+CREATE INDEX idx_reel_strip_canaries_created_at ON reel_strip_canaries(created_at DESC);
+
+COMMENT ON TABLE reel_strip_canaries IS 'Trial rollouts of reel strip configs to a percentage of players';
+COMMENT ON COLUMN reel_strip_canaries.percent IS 'Share of players, bucketed by a hash of canary and player ID, routed to the canary config';
@@ -0,0 +1,26 @@
+-- RTP alerts: configs whose realized RTP over a rolling window fell outside the range their
+-- target RTP allows for the sample size
+CREATE TABLE IF NOT EXISTS rtp_alerts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    config_id UUID NOT NULL REFERENCES reel_strip_configs(id),
+    config_name VARCHAR(100) NOT NULL,
+    game_mode VARCHAR(20) NOT NULL,
+    target_rtp DECIMAL(5,2) NOT NULL,
+    realized_rtp DECIMAL(7,2) NOT NULL,
+    -- Range of realized RTP expected by chance for the sample size
+    lower_bound DECIMAL(7,2) NOT NULL,
+    upper_bound DECIMAL(7,2) NOT NULL,
+    z_score DECIMAL(8,2) NOT NULL,
+    spins BIGINT NOT NULL,
+    wagered DECIMAL(20,2) NOT NULL,
+    won DECIMAL(20,2) NOT NULL,
+    window_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    window_end TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CHECK (window_end > window_start)
+);
+
+CREATE INDEX idx_rtp_alerts_config_created_at ON rtp_alerts(config_id, created_at DESC);
+
+COMMENT ON TABLE rtp_alerts IS 'Reel strip configs whose realized RTP drifted beyond statistical bounds of their target';
@@ -0,0 +1,24 @@
+-- Daily financial summaries: one UTC day of real-money play per game and operator
+CREATE TABLE IF NOT EXISTS daily_financial_summaries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    report_date DATE NOT NULL,
+    -- NULL for cross-game accounts
+    game_id UUID,
+    -- Empty for players who registered directly
+    operator_id VARCHAR(255) NOT NULL DEFAULT '',
+    spins BIGINT NOT NULL,
+    paid_spins BIGINT NOT NULL,
+    total_wagered DECIMAL(20,2) NOT NULL,
+    total_won DECIMAL(20,2) NOT NULL,
+    ggr DECIMAL(20,2) NOT NULL,
+    free_spin_cost DECIMAL(20,2) NOT NULL,
+    bonus_cost DECIMAL(20,2) NOT NULL,
+    jackpot_contributions DECIMAL(20,2) NOT NULL DEFAULT 0,
+    generated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- One row per day, game and operator, so instances generating the same day cannot both insert it
+CREATE UNIQUE INDEX idx_daily_financial_summaries_day_game_operator
+    ON daily_financial_summaries(report_date, COALESCE(game_id, '00000000-0000-0000-0000-000000000000'::uuid), operator_id);
+
+COMMENT ON TABLE daily_financial_summaries IS 'Daily GGR, wagered, won, free spin and bonus cost per game and operator';
@@ -0,0 +1,27 @@
+-- In-game notifications: one row per message, with player_id NULL for broadcasts to every player
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    player_id UUID REFERENCES players(id) ON DELETE CASCADE,
+    type VARCHAR(50) NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    body TEXT NOT NULL,
+    data JSONB,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notifications_player_created ON notifications(player_id, created_at);
+CREATE INDEX idx_notifications_broadcast_created ON notifications(created_at) WHERE player_id IS NULL;
+
+-- Read receipts: a notification is unread for a player until they have a row here
+CREATE TABLE IF NOT EXISTS notification_reads (
+    notification_id UUID NOT NULL REFERENCES notifications(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    read_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (notification_id, player_id)
+);
+
+CREATE INDEX idx_notification_reads_player ON notification_reads(player_id);
+
+COMMENT ON TABLE notifications IS 'In-game messages such as bonus grants, tournaments and maintenance notices';
+COMMENT ON TABLE notification_reads IS 'Read receipts of notifications per player';
@@ -0,0 +1 @@
+ALTER TABLE game_configs DROP COLUMN IF EXISTS win_tiers;
@@ -0,0 +1,2 @@
+-- Per-config win presentation tiers (thresholds and asset keys); NULL uses the default tiers
+ALTER TABLE game_configs ADD COLUMN IF NOT EXISTS win_tiers JSONB;
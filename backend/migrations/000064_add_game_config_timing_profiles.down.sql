@@ -0,0 +1 @@
+ALTER TABLE game_configs DROP COLUMN IF EXISTS timing_profiles;
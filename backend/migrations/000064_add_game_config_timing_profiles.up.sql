@@ -0,0 +1,2 @@
+-- Per-config normal and turbo animation timing, with an optional minimum spin duration; NULL uses the default timing
+ALTER TABLE game_configs ADD COLUMN IF NOT EXISTS timing_profiles JSONB;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS free_spins_settlements;
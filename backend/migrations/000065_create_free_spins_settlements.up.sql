@@ -0,0 +1,19 @@
+-- Audit trail of incomplete free spins paid out when their game session was force-ended
+CREATE TABLE IF NOT EXISTS free_spins_settlements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    free_spins_session_id UUID NOT NULL REFERENCES free_spins_sessions(id),
+    session_id UUID NOT NULL REFERENCES game_sessions(id),
+    player_id UUID NOT NULL REFERENCES players(id),
+    reason VARCHAR(20) NOT NULL,
+    policy VARCHAR(20) NOT NULL,
+    remaining_spins INTEGER NOT NULL,
+    spins_played INTEGER NOT NULL DEFAULT 0,
+    spins_valued INTEGER NOT NULL DEFAULT 0,
+    amount_credited DECIMAL(15,2) NOT NULL DEFAULT 0,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_free_spins_settlements_session_id ON free_spins_settlements(session_id);
+CREATE INDEX idx_free_spins_settlements_player_id ON free_spins_settlements(player_id);
+CREATE INDEX idx_free_spins_settlements_free_spins_session_id ON free_spins_settlements(free_spins_session_id);
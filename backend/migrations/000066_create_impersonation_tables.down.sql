@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS impersonation_accesses;
+DROP TABLE IF EXISTS impersonation_grants;
@@ -0,0 +1,32 @@
+-- Read-only impersonation tokens issued to admins, stored by SHA-256 hash
+CREATE TABLE IF NOT EXISTS impersonation_grants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    admin_id UUID NOT NULL REFERENCES admins(id),
+    admin_username VARCHAR(50) NOT NULL,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    ip_address VARCHAR(45),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    revoked_by UUID REFERENCES admins(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_impersonation_grants_admin_id ON impersonation_grants(admin_id, created_at);
+CREATE INDEX idx_impersonation_grants_player_id ON impersonation_grants(player_id, created_at);
+
+-- Every player API request made with an impersonation token, refused writes included
+CREATE TABLE IF NOT EXISTS impersonation_accesses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    grant_id UUID NOT NULL REFERENCES impersonation_grants(id) ON DELETE CASCADE,
+    admin_id UUID NOT NULL,
+    player_id UUID NOT NULL,
+    method VARCHAR(10) NOT NULL,
+    path TEXT NOT NULL,
+    status INTEGER NOT NULL,
+    ip_address VARCHAR(45),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_impersonation_accesses_grant_id ON impersonation_accesses(grant_id, created_at);
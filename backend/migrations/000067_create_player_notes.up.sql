@@ -0,0 +1,16 @@
+-- Internal support notes and flags on players, with who wrote, edited and deleted them
+CREATE TABLE IF NOT EXISTS player_notes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('note', 'flag')),
+    body TEXT NOT NULL,
+    author_id UUID NOT NULL REFERENCES admins(id),
+    author_username VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    edited_by VARCHAR(50),
+    edited_at TIMESTAMP WITH TIME ZONE,
+    deleted_by VARCHAR(50),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_player_notes_player_id ON player_notes(player_id, created_at);
@@ -0,0 +1,24 @@
+-- Deleted rows are still referenced by spins and audit records, so they are kept
+-- and renamed apart from live rows before the full unique constraints come back
+UPDATE games SET name = left(name, 80) || '-deleted-' || left(id::text, 8)
+    WHERE deleted_at IS NOT NULL;
+UPDATE assets SET name = left(name, 80) || '-deleted-' || left(id::text, 8),
+                  object_name = left(object_name, 80) || '-deleted-' || left(id::text, 8)
+    WHERE deleted_at IS NOT NULL;
+UPDATE reel_strip_configs SET name = left(name, 80) || '-deleted-' || left(id::text, 8)
+    WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_reel_strip_configs_name_live;
+DROP INDEX IF EXISTS idx_assets_object_name_live;
+DROP INDEX IF EXISTS idx_assets_name_live;
+DROP INDEX IF EXISTS idx_games_name_live;
+
+ALTER TABLE reel_strip_configs ADD CONSTRAINT reel_strip_configs_name_key UNIQUE (name);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_assets_object_name ON assets (object_name);
+ALTER TABLE assets ADD CONSTRAINT assets_name_key UNIQUE (name);
+ALTER TABLE games ADD CONSTRAINT games_name_key UNIQUE (name);
+
+ALTER TABLE reel_strip_configs DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE game_configs DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE assets DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE games DROP COLUMN IF EXISTS deleted_at;
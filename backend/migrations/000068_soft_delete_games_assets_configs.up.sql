@@ -0,0 +1,18 @@
+-- Soft delete for games, assets, game configs and reel strip configs
+-- Deleted rows are kept so spins and audit records that reference them stay resolvable
+
+ALTER TABLE games ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE game_configs ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE reel_strip_configs ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+-- Names only need to be unique among live rows, so a deleted name can be reused
+ALTER TABLE games DROP CONSTRAINT IF EXISTS games_name_key;
+ALTER TABLE assets DROP CONSTRAINT IF EXISTS assets_name_key;
+DROP INDEX IF EXISTS idx_assets_object_name;
+ALTER TABLE reel_strip_configs DROP CONSTRAINT IF EXISTS reel_strip_configs_name_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_games_name_live ON games (name) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_assets_name_live ON assets (name) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_assets_object_name_live ON assets (object_name) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reel_strip_configs_name_live ON reel_strip_configs (name) WHERE deleted_at IS NULL;
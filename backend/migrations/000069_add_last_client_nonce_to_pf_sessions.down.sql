@@ -0,0 +1,2 @@
+-- Remove the claimed client nonce from pf_sessions
+ALTER TABLE pf_sessions DROP COLUMN IF EXISTS last_client_nonce;
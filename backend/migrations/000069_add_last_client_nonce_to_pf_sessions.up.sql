@@ -0,0 +1,5 @@
+-- The last client nonce a spin request claimed in each provably fair session
+-- Spins claim their nonce with a conditional update in the transaction that settles them,
+-- so a replayed request racing its original waits on the row and finds the nonce taken
+
+ALTER TABLE pf_sessions ADD COLUMN IF NOT EXISTS last_client_nonce BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,11 @@
+// Package migrations embeds the versioned schema migrations, so every binary applies and
+// checks against exactly the migrations it was built with.
+// Files are named NNNNNN_name.up.sql and NNNNNN_name.down.sql; every up has a down that reverts it.
+package migrations
+
+import "embed"
+
+// FS holds the migration files
+//
+//go:embed *.sql
+var FS embed.FS
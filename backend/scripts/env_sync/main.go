@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/slotmachine/backend/internal/seed"
+	"gopkg.in/yaml.v3"
+)
+
+// env_sync promotes game configuration between environments (e.g. staging -> production).
+//
+//	env_sync export -env .env.staging -out snapshot.yaml
+//	env_sync import -env .env.production -in snapshot.yaml -dry-run
+//	env_sync import -env .env.production -in snapshot.yaml
+//
+// Snapshots use the seed manifest format. IDs are preserved, so importing creates
+// missing entities and updates changed ones in place. Asset files in storage are
+// not copied; only asset records are synced.
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "export":
+		runExport(os.Args[2:])
+	case "import":
+		runImport(os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage:")
+	fmt.Fprintln(os.Stderr, "  env_sync export [-env FILE] -out FILE")
+	fmt.Fprintln(os.Stderr, "  env_sync import [-env FILE] -in FILE [-dry-run]")
+}
+
+// runExport writes a snapshot of the current environment
+func runExport(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	envFile := fs.String("env", "", "Environment file to load before connecting (overrides current env)")
+	out := fs.String("out", "", "Output snapshot file")
+	fs.Parse(args)
+
+	if *out == "" {
+		usage()
+		os.Exit(2)
+	}
+
+	application := initialize(*envFile)
+	log := application.Logger
+	seeder := newSeeder(application)
+
+	startTime := time.Now()
+	m, err := seeder.Export(context.Background())
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to export environment")
+	}
+
+	data, err := yaml.Marshal(m)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to encode snapshot")
+	}
+
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		log.Fatal().Err(err).Str("file", *out).Msg("Failed to write snapshot")
+	}
+
+	fmt.Println("\n=== Environment Export Summary ===")
+	fmt.Printf("Games:              %d\n", len(m.Games))
+	fmt.Printf("Assets:             %d\n", len(m.Assets))
+	fmt.Printf("Game configs:       %d\n", len(m.GameConfigs))
+	fmt.Printf("Reel strip configs: %d\n", len(m.ReelStripConfigs))
+	fmt.Printf("Reel strips:        %d\n", len(m.ReelStrips))
+	fmt.Printf("Output:             %s\n", *out)
+	fmt.Printf("Duration:           %v\n", time.Since(startTime))
+	fmt.Println("==================================")
+}
+
+// runImport diffs a snapshot against the current environment and applies it
+func runImport(args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	envFile := fs.String("env", "", "Environment file to load before connecting (overrides current env)")
+	in := fs.String("in", "", "Snapshot file to import")
+	dryRun := fs.Bool("dry-run", false, "Print the diff without writing anything")
+	fs.Parse(args)
+
+	if *in == "" {
+		usage()
+		os.Exit(2)
+	}
+
+	application := initialize(*envFile)
+	log := application.Logger
+	seeder := newSeeder(application)
+	ctx := context.Background()
+
+	m, err := seed.LoadManifest(*in)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load snapshot")
+	}
+
+	changes, err := seeder.Plan(ctx, m)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to diff snapshot against environment")
+	}
+
+	counts := make(map[seed.Action]int)
+	fmt.Println("\n=== Environment Diff ===")
+	for _, c := range changes {
+		counts[c.Action]++
+		if c.Action != seed.ActionUnchanged {
+			fmt.Println(c)
+		}
+	}
+	fmt.Printf("\n%d to create, %d to update, %d unchanged, %d conflicts\n",
+		counts[seed.ActionCreate], counts[seed.ActionUpdate], counts[seed.ActionUnchanged], counts[seed.ActionConflict])
+	fmt.Println("========================")
+
+	if *dryRun {
+		fmt.Println("Dry run: no changes written")
+		if counts[seed.ActionConflict] > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := seeder.ApplyPlan(ctx, changes); err != nil {
+		log.Fatal().Err(err).Msg("Failed to apply snapshot")
+	}
+
+	log.Info().
+		Int("created", counts[seed.ActionCreate]).
+		Int("updated", counts[seed.ActionUpdate]).
+		Msg("Environment import completed successfully")
+}
+
+// initialize loads the optional env file and wires the application
+func initialize(envFile string) *SyncApplication {
+	if envFile != "" {
+		if err := godotenv.Overload(envFile); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to load env file %s: %v\n", envFile, err)
+			os.Exit(1)
+		}
+	}
+
+	application, err := InitializeSyncApplication()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize application: %v\n", err)
+		os.Exit(1)
+	}
+	return application
+}
+
+// newSeeder creates the seeder backing export and import
+func newSeeder(application *SyncApplication) *seed.Seeder {
+	return seed.NewSeeder(application.GameRepository, application.ReelStripRepository, application.Storage, application.Logger)
+}
@@ -0,0 +1,54 @@
+//go:build wireinject
+// +build wireinject
+
+package main
+
+import (
+	"github.com/google/wire"
+	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/reelstrip"
+	"github.com/slotmachine/backend/internal/config"
+	"github.com/slotmachine/backend/internal/db"
+	"github.com/slotmachine/backend/internal/infra/repository"
+	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/cache"
+	"github.com/slotmachine/backend/internal/pkg/logger"
+)
+
+// SyncApplication holds dependencies for the environment sync tool
+type SyncApplication struct {
+	Config              *config.Config
+	Logger              *logger.Logger
+	GameRepository      game.Repository
+	ReelStripRepository reelstrip.Repository
+	Storage             storage.Storage
+}
+
+// InitializeSyncApplication creates a fully initialized sync application using Wire
+func InitializeSyncApplication() (*SyncApplication, error) {
+	wire.Build(
+		// Config
+		config.ProviderSet,
+
+		// Logger
+		logger.ProviderSet,
+
+		// Database
+		db.ProviderSet,
+
+		// Repository
+		repository.NewGameGormRepository,
+		repository.NewReelStripGormRepository,
+
+		// Cache
+		cache.ProvideCache,
+
+		// Storage
+		storage.ProviderSet,
+
+		// Application struct
+		wire.Struct(new(SyncApplication), "*"),
+	)
+
+	return &SyncApplication{}, nil
+}
@@ -33,13 +33,9 @@ echo ""
 $PSQL_CMD -tc "SELECT 1 FROM pg_database WHERE datname = '$DB_NAME'" | grep -q 1 || \
 $PSQL_CMD -c "CREATE DATABASE $DB_NAME"
 
-# Run migrations in order
-for migration in migrations/*.sql; do
-    if [ -f "$migration" ]; then
-        echo "→ Running $(basename $migration)..."
-        $PSQL_CMD -d $DB_NAME -f "$migration"
-    fi
-done
+# Apply pending migrations, recording the version in schema_migrations
+export DB_HOST DB_PORT DB_USER DB_PASSWORD DB_NAME
+go run ./cmd/migrate up
 
 echo ""
 echo "✓ All migrations completed successfully!"
@@ -0,0 +1,39 @@
+// Command openapi writes the OpenAPI document of the player-facing API
+// The document is derived from the DTOs each route in openapi.Routes is declared with, so
+// regenerate it (make openapi-spec) whenever a DTO or route changes and let clients regenerate from it.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/slotmachine/backend/internal/api/openapi"
+)
+
+func main() {
+	out := flag.String("out", openapi.SpecFile, "Path to write the document to")
+	flag.Parse()
+
+	doc, err := openapi.Spec()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	data, err := doc.MarshalIndent()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*out), 0o755); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("Wrote %s\n", *out)
+}
@@ -0,0 +1,35 @@
+// Command protoschema writes the Protobuf schema of the API's binary response format
+// The schema is derived from the `protobuf:"N"` tags on the DTOs, so regenerate it
+// (make proto-schema) whenever a tagged field changes and let clients regenerate from it.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/slotmachine/backend/internal/api/dto"
+	"github.com/slotmachine/backend/internal/pkg/wireformat"
+)
+
+func main() {
+	out := flag.String("out", "api/proto/slot.proto", "Path to write the schema to")
+	flag.Parse()
+
+	schema, err := wireformat.Schema(dto.ProtoPackage, dto.ProtoMessages...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*out), 0o755); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*out, []byte(schema), 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("Wrote %s\n", *out)
+}
@@ -21,7 +21,7 @@ echo "Building seed script..."
 (cd scripts/seed_assets && go build -o seed_assets)
 
 echo "Running seed..."
-./scripts/seed_assets/seed_assets
+./scripts/seed_assets/seed_assets "$@"
 
 # Cleanup
 rm -f ./scripts/seed_assets/seed_assets
@@ -6,19 +6,22 @@ package main
 import (
 	"github.com/google/wire"
 	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/reelstrip"
 	"github.com/slotmachine/backend/internal/config"
 	"github.com/slotmachine/backend/internal/db"
 	"github.com/slotmachine/backend/internal/infra/repository"
 	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/cache"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
 // SeedApplication holds dependencies for the seed script
 type SeedApplication struct {
-	Config         *config.Config
-	Logger         *logger.Logger
-	GameRepository game.Repository
-	Storage        storage.Storage
+	Config              *config.Config
+	Logger              *logger.Logger
+	GameRepository      game.Repository
+	ReelStripRepository reelstrip.Repository
+	Storage             storage.Storage
 }
 
 // InitializeSeedApplication creates a fully initialized seed application using Wire
@@ -35,6 +38,10 @@ func InitializeSeedApplication() (*SeedApplication, error) {
 
 		// Repository
 		repository.NewGameGormRepository,
+		repository.NewReelStripGormRepository,
+
+		// Cache
+		cache.ProvideCache,
 
 		// Storage
 		storage.ProviderSet,
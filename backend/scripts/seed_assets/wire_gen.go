@@ -8,10 +8,12 @@ package main
 
 import (
 	"github.com/slotmachine/backend/domain/game"
+	"github.com/slotmachine/backend/domain/reelstrip"
 	"github.com/slotmachine/backend/internal/config"
 	"github.com/slotmachine/backend/internal/db"
 	"github.com/slotmachine/backend/internal/infra/repository"
 	"github.com/slotmachine/backend/internal/infra/storage"
+	"github.com/slotmachine/backend/internal/pkg/cache"
 	"github.com/slotmachine/backend/internal/pkg/logger"
 )
 
@@ -29,15 +31,18 @@ func InitializeSeedApplication() (*SeedApplication, error) {
 		return nil, err
 	}
 	gameRepository := repository.NewGameGormRepository(gormDB)
+	cacheCache := cache.ProvideCache(configConfig, loggerLogger)
+	reelstripRepository := repository.NewReelStripGormRepository(gormDB, cacheCache)
 	storageStorage, err := storage.ProvideStorage(configConfig)
 	if err != nil {
 		return nil, err
 	}
 	seedApplication := &SeedApplication{
-		Config:         configConfig,
-		Logger:         loggerLogger,
-		GameRepository: gameRepository,
-		Storage:        storageStorage,
+		Config:              configConfig,
+		Logger:              loggerLogger,
+		GameRepository:      gameRepository,
+		ReelStripRepository: reelstripRepository,
+		Storage:             storageStorage,
 	}
 	return seedApplication, nil
 }
@@ -46,8 +51,9 @@ func InitializeSeedApplication() (*SeedApplication, error) {
 
 // SeedApplication holds dependencies for the seed script
 type SeedApplication struct {
-	Config         *config.Config
-	Logger         *logger.Logger
-	GameRepository game.Repository
-	Storage        storage.Storage
+	Config              *config.Config
+	Logger              *logger.Logger
+	GameRepository      game.Repository
+	ReelStripRepository reelstrip.Repository
+	Storage             storage.Storage
 }
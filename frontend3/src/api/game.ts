@@ -10,6 +10,7 @@ import type {
   FreeSpinsStatusResponse,
   ExecuteFreeSpinRequest,
   SpinHistoryResponse,
+  SpinProvablyFairData,
 } from '@/types/api'
 
 // Helper to get endpoints based on trial mode
@@ -58,6 +59,7 @@ interface ConvertedSpinResponse {
   free_spins_session_id?: string
   free_spins_remaining_spins: number
   free_session_total_win: number
+  provably_fair?: SpinProvablyFairData
   timestamp: string
 
 }
@@ -1,4 +1,5 @@
-import type { WinCombination } from '@/types/global'
+import type { WinCombination, WinIntensity } from '@/types/global'
+import type { WinTierInfo } from '@/types/api'
 import type { GameLogicAPI, TilePosition } from './types'
 import { numberToSymbol } from '@/utils/symbolConverter'
 import { CONFIG } from '@/config/constants'
@@ -12,6 +13,16 @@ const TEST_WIN_ANIMATIONS = Boolean(testWinAnimationsValue && testWinAnimationsV
 const TEST_INTENSITIES = ['small', 'medium', 'big', 'mega'] as const
 let testIntensityIndex = 0
 
+/**
+ * Map the backend's win tier to an overlay intensity
+ * Tiers above mega (e.g. jackpot) use the mega overlay; unknown tiers return null
+ */
+function tierIntensity(tier: WinTierInfo | undefined): WinIntensity | null {
+  if (!tier) return null
+  if (tier.name === 'jackpot') return 'mega'
+  return (TEST_INTENSITIES as readonly string[]).includes(tier.name) ? tier.name as WinIntensity : null
+}
+
 /**
  * Handle win checking
  * Also triggers symbol-specific animation for high-value wins (fa, zhong, bai, bawan)
@@ -194,7 +205,8 @@ export async function handleShowWinOverlay(
   gameStore.markAnimationComplete()
   gameStore.setShowAmountNotification(true)
 
-  let intensity = gameLogic.getWinIntensity(gameStore.allWinsThisSpin)
+  // Prefer the backend's win tier; fall back to per-symbol intensity for older responses
+  let intensity = tierIntensity(gameStore.spinResponse?.win_tier) ?? gameLogic.getWinIntensity(gameStore.allWinsThisSpin)
 
   // 🧪 TEST MODE: Override intensity to cycle through all types during free spins
   if (TEST_WIN_ANIMATIONS && gameStore.inFreeSpinMode) {
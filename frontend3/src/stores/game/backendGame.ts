@@ -123,6 +123,23 @@ export const useBackendGameStore = defineStore('backendGame', () => {
     }
   }
 
+  /**
+   * The nonce the next spin is played at, sent so a replayed request is refused
+   * Undefined without an active provably fair session
+   */
+  function nextClientNonce(): number | undefined {
+    return provablyFairState.value.isActive ? provablyFairState.value.currentNonce : undefined
+  }
+
+  /**
+   * Move the nonce past a spin the server played
+   */
+  function advanceNonce(playedNonce?: number) {
+    if (playedNonce !== undefined) {
+      provablyFairState.value.currentNonce = playedNonce + 1
+    }
+  }
+
   /**
    * Execute a spin
    * Generates a new client seed for provably fair RNG
@@ -163,7 +180,9 @@ export const useBackendGameStore = defineStore('backendGame', () => {
         bet_amount: betAmount,
         theta_seed: thetaSeed,
         game_mode: gameMode,
+        client_nonce: nextClientNonce(),
       }, clientSeed)
+      advanceNonce(response.provably_fair?.nonce)
 
       // Mark theta as revealed after successful first spin
       if (thetaSeed) {
@@ -209,7 +228,9 @@ export const useBackendGameStore = defineStore('backendGame', () => {
 
       const response = await gameApi.executeFreeSpin({
         free_spins_session_id: currentFreeSpinsSessionId.value,
+        client_nonce: nextClientNonce(),
       }, clientSeed)
+      advanceNonce(response.provably_fair?.nonce)
 
       // Update balance from response
       authStore.updateBalance(response.balance_after_bet)
@@ -28,11 +28,14 @@ export interface ExecuteSpinRequest {
   theta_seed?: string
   // Game mode: 'bonus_spin_trigger' for guaranteed free spins
   game_mode?: string
+  // Replay protection: the provably fair nonce this spin is played at (last seen nonce + 1)
+  client_nonce?: number
 }
 
 export interface ExecuteFreeSpinRequest {
   free_spins_session_id: string
   client_seed?: string // Provably fair: client-generated seed for RNG
+  client_nonce?: number // Replay protection: the provably fair nonce this spin is played at
 }
 
 // API Response Types
@@ -73,6 +76,13 @@ export interface SpinVerificationData {
   reel_positions: number[]
 }
 
+// Provably fair data of a played spin
+export interface SpinProvablyFairData {
+  spin_hash: string
+  prev_spin_hash: string
+  nonce: number
+}
+
 export interface SessionProvablyFairData {
   session_id: string
   server_seed_hash: string
@@ -122,6 +132,28 @@ export interface CascadeInfo {
   total_cascade_win: number
 }
 
+// Win presentation tier computed by the backend from the game config's thresholds
+export interface WinTierInfo {
+  name: string
+  multiplier: number  // Win divided by bet
+  video?: string  // Asset key of the video to play
+  audio?: string  // Asset key of the audio to play
+}
+
+// Suggested duration of each step of a spin's presentation, in milliseconds
+export interface TimingPlan {
+  reel_spin_ms: number
+  cascade_ms: number[]  // One entry per cascade
+  total_ms: number  // Never present the spin faster than this
+}
+
+// Backend animation timing for normal and turbo speed
+export interface SpinTiming {
+  normal: TimingPlan
+  turbo: TimingPlan
+  min_spin_ms?: number  // Minimum spin duration, already included in both plans
+}
+
 export interface SpinResponse {
   spin_id: string
   session_id: string
@@ -140,6 +172,9 @@ export interface SpinResponse {
   free_spins_session_id?: string
   free_spins_remaining_spins: number
   free_session_total_win: number
+  win_tier?: WinTierInfo  // Absent when the spin did not win
+  timing?: SpinTiming
+  provably_fair?: SpinProvablyFairData
   timestamp: string
 }
 